@@ -20,6 +20,7 @@ import (
 	"sync"
 
 	eb "github.com/linkall-labs/vanus/client/internal/vanus/eventbus"
+	"github.com/linkall-labs/vanus/client/internal/vanus/net/connection"
 	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/client/pkg/eventbus"
 	"github.com/linkall-labs/vanus/observability/tracing"
@@ -88,3 +89,16 @@ func Connect(endpoints []string) Client {
 		eventbuses: make(map[string]api.Eventbus, 0),
 	}
 }
+
+// SetMaxRecvMsgSize sets the maximum message size in bytes the client can receive, it should be called
+// before Connect. Stores split events of a read into multiple messages to fit it.
+func SetMaxRecvMsgSize(size int) {
+	connection.SetMaxRecvMsgSize(size)
+}
+
+// SetCompressor sets the name of the gRPC compressor for requests to stores, it should be called before
+// Connect. The compressor must have been registered with encoding.RegisterCompressor, stores decompress
+// requests and compress responses with it.
+func SetCompressor(name string) {
+	connection.SetCompressor(name)
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	// standard libraries.
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	// this project.
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/client/pkg/prefetch"
+)
+
+func main() {
+	ctx := context.Background()
+
+	c := client.Connect([]string{"localhost:2048"})
+	eb := c.Eventbus(ctx, "quick-start")
+	ls, err := eb.ListLog(ctx)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	consumer := prefetch.NewConsumer(eb, policy.NewManuallyReadPolicy(ls[0], 0), prefetch.Config{})
+	defer consumer.Close()
+
+	for {
+		e, err := consumer.Next(ctx)
+		if err != nil {
+			if errors.Is(err, prefetch.ErrClosed) {
+				log.Fatal(err.Error())
+			}
+			// back off, the error may persist, e.g. the eventbus is unavailable.
+			log.Print(err.Error())
+			time.Sleep(time.Second)
+			continue
+		}
+		// a slow handler, the consumer reads fewer events ahead.
+		time.Sleep(10 * time.Millisecond)
+		log.Printf("event: %s, stats: %+v\n", e.ID(), consumer.Stats())
+	}
+}
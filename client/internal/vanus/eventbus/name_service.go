@@ -74,6 +74,17 @@ func (ns *NameService) LookupReadableLogs(ctx context.Context, eventbus string)
 	return toLogs(resp.GetLogs()), nil
 }
 
+func (ns *NameService) LookupOrderingScope(ctx context.Context, eventbus string) (metapb.OrderingScope, error) {
+	ctx, span := ns.tracer.Start(ctx, "LookupOrderingScope")
+	defer span.End()
+
+	resp, err := ns.client.GetEventBus(ctx, &metapb.EventBus{Name: eventbus})
+	if err != nil {
+		return metapb.OrderingScope_ORDERING_NONE, err
+	}
+	return resp.GetOrderingScope(), nil
+}
+
 func toLogs(logpbs []*metapb.EventLog) []*record.Eventlog {
 	if len(logpbs) <= 0 {
 		return make([]*record.Eventlog, 0)
@@ -18,6 +18,7 @@ import (
 	// standard libraries.
 	"context"
 	"math"
+	"sync"
 	"time"
 
 	// third-party libraries.
@@ -36,16 +37,39 @@ import (
 	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
+const (
+	// defaultReadableSegmentsTTL bounds how stale cached readable segments can be. A reader waiting at
+	// the end of an eventlog refreshes segments in every pull, so the cache keeps it from querying
+	// controller each time. Only which segments an eventlog has and their blocks are served from the
+	// cache, offsets are always looked up.
+	//
+	// Controller doesn't notify clients once segments are sealed, so cached segments aren't invalidated by
+	// notifications, but by clients which find a segment sealed, see InvalidateReadableSegments. Segments
+	// created by others, e.g. after a segment sealed by another writer, show up once the TTL expires.
+	defaultReadableSegmentsTTL = 5 * time.Second
+)
+
 func NewNameService(endpoints []string) *NameService {
 	return &NameService{
-		client: cluster.NewClusterController(endpoints, insecure.NewCredentials()).EventlogService().RawClient(),
-		tracer: tracing.NewTracer("internal.discovery.eventlog", trace.SpanKindClient),
+		client:   cluster.NewClusterController(endpoints, insecure.NewCredentials()).EventlogService().RawClient(),
+		tracer:   tracing.NewTracer("internal.discovery.eventlog", trace.SpanKindClient),
+		ttl:      defaultReadableSegmentsTTL,
+		readable: make(map[uint64]*cachedSegments),
 	}
 }
 
+type cachedSegments struct {
+	segments []*record.Segment
+	expireAt time.Time
+}
+
 type NameService struct {
 	client ctrlpb.EventLogControllerClient
 	tracer *tracing.Tracer
+
+	ttl      time.Duration
+	readable map[uint64]*cachedSegments
+	mu       sync.RWMutex
 }
 
 func (ns *NameService) LookupWritableSegment(ctx context.Context, logID uint64) (*record.Segment, error) {
@@ -78,6 +102,8 @@ func (ns *NameService) LookupWritableSegment(ctx context.Context, logID uint64)
 	return segments[0], nil
 }
 
+// LookupReadableSegments queries readable segments of the eventlog from controller, they are cached for
+// LookupCachedReadableSegments.
 func (ns *NameService) LookupReadableSegments(ctx context.Context, logID uint64) ([]*record.Segment, error) {
 	ctx, span := ns.tracer.Start(ctx, "LookupReadableSegments")
 	defer span.End()
@@ -95,9 +121,58 @@ func (ns *NameService) LookupReadableSegments(ctx context.Context, logID uint64)
 	}
 
 	segments := toSegments(resp.GetSegments())
+
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	ns.readable[logID] = &cachedSegments{
+		segments: segments,
+		expireAt: time.Now().Add(ns.ttl),
+	}
 	return segments, nil
 }
 
+// LookupCachedReadableSegments is same as LookupReadableSegments, but segments cached in the TTL are returned
+// without querying controller. The end offset of the working segment may be stale in cached segments, so
+// they are only for routing reads, offsets of the eventlog should be looked up by LookupReadableSegments.
+func (ns *NameService) LookupCachedReadableSegments(
+	ctx context.Context, logID uint64,
+) ([]*record.Segment, error) {
+	if segments, ok := ns.cachedReadableSegments(logID); ok {
+		return segments, nil
+	}
+	return ns.LookupReadableSegments(ctx, logID)
+}
+
+// InvalidateReadableSegments drops cached readable segments of the eventlog, it should be called once
+// a segment of the eventlog is known to be sealed, e.g. a write fails with ErrSegmentFull, or a read fails
+// with ErrOffsetOverflow, so the next lookup gets the new segment.
+func (ns *NameService) InvalidateReadableSegments(logID uint64) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	delete(ns.readable, logID)
+}
+
+// cachedReadableSegments returns cached segments of the eventlog, expired ones are removed, so that
+// eventlogs which are no longer read don't stay in the cache.
+func (ns *NameService) cachedReadableSegments(logID uint64) ([]*record.Segment, bool) {
+	ns.mu.RLock()
+	c, ok := ns.readable[logID]
+	ns.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(c.expireAt) {
+		ns.mu.Lock()
+		// segments may have been looked up again since.
+		if ns.readable[logID] == c {
+			delete(ns.readable, logID)
+		}
+		ns.mu.Unlock()
+		return nil, false
+	}
+	return c.segments, true
+}
+
 func toSegments(pbs []*metapb.Segment) []*record.Segment {
 	if len(pbs) == 0 {
 		return make([]*record.Segment, 0)
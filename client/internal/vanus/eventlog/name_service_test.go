@@ -0,0 +1,141 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	"github.com/golang/mock/gomock"
+	"go.opentelemetry.io/otel/trace"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/tracing"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+func newTestNameService(t *testing.T, ttl time.Duration) (*NameService, *ctrlpb.MockEventLogControllerClient) {
+	mockCtrl := gomock.NewController(t)
+	t.Cleanup(mockCtrl.Finish)
+	client := ctrlpb.NewMockEventLogControllerClient(mockCtrl)
+	return &NameService{
+		client:   client,
+		tracer:   tracing.NewTracer("test", trace.SpanKindClient),
+		ttl:      ttl,
+		readable: make(map[uint64]*cachedSegments),
+	}, client
+}
+
+func listSegmentResponse(endOffsets ...int64) *ctrlpb.ListSegmentResponse {
+	resp := &ctrlpb.ListSegmentResponse{}
+	for i, end := range endOffsets {
+		resp.Segments = append(resp.Segments, &metapb.Segment{
+			Id:               uint64(i + 1),
+			EndOffsetInLog:   end,
+			StartOffsetInLog: int64(i) * 10,
+			State:            "sealed",
+		})
+	}
+	return resp
+}
+
+func TestNameService_LookupCachedReadableSegments(t *testing.T) {
+	ctx := context.Background()
+	ns, client := newTestNameService(t, time.Hour)
+	client.EXPECT().ListSegment(gomock.Any(), gomock.Any()).Times(1).Return(listSegmentResponse(10, 20), nil)
+
+	for i := 0; i < 3; i++ {
+		segments, err := ns.LookupCachedReadableSegments(ctx, 1)
+		if err != nil {
+			t.Fatalf("LookupCachedReadableSegments() error = %v", err)
+		}
+		if len(segments) != 2 {
+			t.Fatalf("len(segments) = %d, want 2", len(segments))
+		}
+	}
+
+	// segments are looked up again once they are invalidated.
+	ns.InvalidateReadableSegments(1)
+	client.EXPECT().ListSegment(gomock.Any(), gomock.Any()).Times(1).Return(listSegmentResponse(10, 20, 30), nil)
+	segments, err := ns.LookupCachedReadableSegments(ctx, 1)
+	if err != nil {
+		t.Fatalf("LookupCachedReadableSegments() error = %v", err)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("len(segments) = %d, want 3", len(segments))
+	}
+}
+
+func TestNameService_LookupCachedReadableSegmentsExpired(t *testing.T) {
+	ctx := context.Background()
+	ns, client := newTestNameService(t, -time.Second)
+	client.EXPECT().ListSegment(gomock.Any(), gomock.Any()).Times(2).Return(listSegmentResponse(10), nil)
+
+	for i := 0; i < 2; i++ {
+		if _, err := ns.LookupCachedReadableSegments(ctx, 1); err != nil {
+			t.Fatalf("LookupCachedReadableSegments() error = %v", err)
+		}
+	}
+}
+
+func TestNameService_RemoveExpiredReadableSegments(t *testing.T) {
+	ctx := context.Background()
+	ns, client := newTestNameService(t, -time.Second)
+	client.EXPECT().ListSegment(gomock.Any(), gomock.Any()).Times(1).Return(listSegmentResponse(10), nil)
+	if _, err := ns.LookupReadableSegments(ctx, 1); err != nil {
+		t.Fatalf("LookupReadableSegments() error = %v", err)
+	}
+
+	// expired segments are removed once they are looked up.
+	if _, ok := ns.cachedReadableSegments(1); ok {
+		t.Fatalf("cachedReadableSegments() ok = true, want false")
+	}
+	if len(ns.readable) != 0 {
+		t.Fatalf("len(readable) = %d, want 0", len(ns.readable))
+	}
+}
+
+func TestNameService_LookupReadableSegments(t *testing.T) {
+	ctx := context.Background()
+	ns, client := newTestNameService(t, time.Hour)
+	gomock.InOrder(
+		client.EXPECT().ListSegment(gomock.Any(), gomock.Any()).Return(listSegmentResponse(10), nil),
+		client.EXPECT().ListSegment(gomock.Any(), gomock.Any()).Return(listSegmentResponse(15), nil),
+	)
+
+	// offsets are never served from the cache.
+	for _, want := range []int64{10, 15} {
+		segments, err := ns.LookupReadableSegments(ctx, 1)
+		if err != nil {
+			t.Fatalf("LookupReadableSegments() error = %v", err)
+		}
+		if got := segments[len(segments)-1].EndOffset; got != want {
+			t.Fatalf("EndOffset = %d, want %d", got, want)
+		}
+	}
+
+	// the latest lookup is cached.
+	segments, err := ns.LookupCachedReadableSegments(ctx, 1)
+	if err != nil {
+		t.Fatalf("LookupCachedReadableSegments() error = %v", err)
+	}
+	if got := segments[0].EndOffset; got != 15 {
+		t.Fatalf("EndOffset = %d, want 15", got)
+	}
+}
@@ -16,17 +16,61 @@ package connection
 
 import (
 	"context"
+
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/observability/tracing"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.uber.org/atomic"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// DefaultMaxRecvMsgSize is the default maximum message size of gRPC the client can receive.
+const DefaultMaxRecvMsgSize = 4 * 1024 * 1024
+
+var (
+	maxRecvMsgSize = atomic.NewInt64(DefaultMaxRecvMsgSize)
+	compressor     = atomic.NewString("")
+)
+
+// SetMaxRecvMsgSize sets the maximum message size in bytes the client can receive, it only affects
+// connections made after it. A non-positive size resets it to DefaultMaxRecvMsgSize.
+func SetMaxRecvMsgSize(size int) {
+	if size <= 0 {
+		size = DefaultMaxRecvMsgSize
+	}
+	maxRecvMsgSize.Store(int64(size))
+}
+
+// MaxRecvMsgSize returns the maximum message size in bytes the client can receive.
+func MaxRecvMsgSize() int {
+	return int(maxRecvMsgSize.Load())
+}
+
+// SetCompressor sets the name of the gRPC compressor for requests to stores, e.g. gzip, it only affects
+// connections made after it. An empty name disables compression.
+func SetCompressor(name string) {
+	compressor.Store(name)
+}
+
+// Compressor returns the name of the gRPC compressor for requests to stores.
+func Compressor() string {
+	return compressor.Load()
+}
+
 func Connect(ctx context.Context, endpoint string) (*grpc.ClientConn, error) {
 	opts := []grpc.DialOption{
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
-		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(MaxRecvMsgSize())),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(),
+			tracing.CorrelationUnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(otelgrpc.StreamClientInterceptor(),
+			tracing.CorrelationStreamClientInterceptor()),
+		grpc.WithStatsHandler(metrics.NewTransportStatsHandler(metrics.LabelValueTrafficData, Compressor())),
+	}
+	if name := Compressor(); name != "" {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(name)))
 	}
 	conn, err := grpc.DialContext(ctx, endpoint, opts...)
 	if err != nil {
@@ -17,6 +17,8 @@ package store
 import (
 	// standard libraries
 	"context"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/linkall-labs/vanus/client/pkg/codec"
@@ -26,17 +28,32 @@ import (
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 
 	// first-party libraries
 	// third-party libraries
 	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 
+	"github.com/linkall-labs/vanus/client/internal/vanus/net/connection"
 	"github.com/linkall-labs/vanus/client/internal/vanus/net/rpc"
 	"github.com/linkall-labs/vanus/client/internal/vanus/net/rpc/bare"
 	"github.com/linkall-labs/vanus/client/pkg/primitive"
 )
 
+// SealedError is returned by Read if the read reaches the end of a sealed block, which won't grow anymore.
+// Events from Offset on are in the successor segment.
+type SealedError struct {
+	Offset int64
+}
+
+func (e *SealedError) Error() string {
+	return fmt.Sprintf("the block is sealed at offset %d", e.Offset)
+}
+
 func newBlockStore(endpoint string) (*BlockStore, error) {
 	s := &BlockStore{
 		RefCount: primitive.RefCount{},
@@ -68,6 +85,9 @@ func (s *BlockStore) Close() {
 }
 
 func (s *BlockStore) Append(ctx context.Context, block uint64, event *ce.Event) (int64, error) {
+	ctx = tracing.UpdateRequestMetadata(ctx, func(md *tracing.RequestMetadata) {
+		md.Block = fmt.Sprintf("%016X", block)
+	})
 	_ctx, span := s.tracer.Start(ctx, "Append")
 	defer span.End()
 
@@ -96,16 +116,20 @@ func (s *BlockStore) Append(ctx context.Context, block uint64, event *ce.Event)
 }
 
 func (s *BlockStore) Read(
-	ctx context.Context, block uint64, offset int64, size int16, pollingTimeout uint32,
+	ctx context.Context, block uint64, offset int64, size int16, maxBytes int64, pollingTimeout uint32,
 ) ([]*ce.Event, error) {
 	ctx, span := s.tracer.Start(ctx, "Append")
 	defer span.End()
 
 	req := &segpb.ReadFromBlockRequest{
-		BlockId:        block,
-		Offset:         offset,
-		Number:         int64(size),
-		PollingTimeout: pollingTimeout,
+		BlockId:         block,
+		Offset:          offset,
+		Number:          int64(size),
+		PollingTimeout:  pollingTimeout,
+		MaxBytes:        maxBytes,
+		MaxMessageBytes: int64(connection.MaxRecvMsgSize()),
+		FollowSeal:      true,
+		Raw:             true,
 	}
 
 	client, err := s.client.Get(ctx)
@@ -113,27 +137,86 @@ func (s *BlockStore) Read(
 		return nil, err
 	}
 
-	resp, err := client.(segpb.SegmentServerClient).ReadFromBlock(ctx, req)
+	resp, err := s.readStream(ctx, client.(segpb.SegmentServerClient), req)
+	if status.Code(err) == codes.Unimplemented {
+		// The store doesn't support streaming reads, fall back to ReadFromBlock.
+		resp, err = client.(segpb.SegmentServerClient).ReadFromBlock(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if resp.Sealed {
+		return nil, &SealedError{Offset: resp.SealedOffset}
+	}
+	// Stores which don't support raw reads return events instead of the payload.
+	eventpbs := resp.GetEvents().GetEvents()
+	if len(resp.Payload) > 0 {
+		raw, err2 := decodeFrames(resp.Payload)
+		if err2 != nil {
+			return nil, err2
+		}
+		eventpbs = append(eventpbs, raw...)
+	}
 
-	if batch := resp.GetEvents(); batch != nil {
-		if eventpbs := batch.GetEvents(); len(eventpbs) > 0 {
-			events := make([]*ce.Event, 0, len(eventpbs))
-			for _, eventpb := range eventpbs {
-				event, err2 := codec.FromProto(eventpb)
-				if err2 != nil {
-					// TODO: return events or error?
-					return events, err2
-				}
-				events = append(events, event)
-			}
-			return events, nil
+	events := make([]*ce.Event, 0, len(eventpbs))
+	for _, eventpb := range eventpbs {
+		event, err2 := codec.FromProto(eventpb)
+		if err2 != nil {
+			// TODO: return events or error?
+			return events, err2
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// readStream reads events by ReadFromBlockStream, events of a read may be split into multiple responses
+// to fit the max message size, they are merged into one response along with the continuation hint.
+func (s *BlockStore) readStream(
+	ctx context.Context, client segpb.SegmentServerClient, req *segpb.ReadFromBlockRequest,
+) (*segpb.ReadFromBlockResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.ReadFromBlockStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := &segpb.ReadFromBlockResponse{Events: &cepb.CloudEventBatch{}}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return merged, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		merged.Events.Events = append(merged.Events.Events, resp.GetEvents().GetEvents()...)
+		merged.Payload = append(merged.Payload, resp.Payload...)
+		if resp.Sealed {
+			merged.Sealed = true
+			merged.SealedOffset = resp.SealedOffset
 		}
 	}
+}
 
-	return []*ce.Event{}, err
+// decodeFrames decodes events in the payload of a raw read, each of which is preceded by its size in varint.
+func decodeFrames(payload []byte) ([]*cepb.CloudEvent, error) {
+	var events []*cepb.CloudEvent
+	for len(payload) > 0 {
+		data, n := protowire.ConsumeBytes(payload)
+		if n < 0 {
+			return nil, fmt.Errorf("malformed payload: %w", protowire.ParseError(n))
+		}
+		event := &cepb.CloudEvent{}
+		if err := proto.Unmarshal(data, event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+		payload = payload[n:]
+	}
+	return events, nil
 }
 
 func (s *BlockStore) LookupOffset(ctx context.Context, blockID uint64, t time.Time) (int64, error) {
@@ -157,7 +240,33 @@ func (s *BlockStore) LookupOffset(ctx context.Context, blockID uint64, t time.Ti
 	return res.Offset, nil
 }
 
+// LookupKey returns the offset of the latest event with the partition key in the block, it's -1 if no event
+// has the key.
+func (s *BlockStore) LookupKey(ctx context.Context, blockID uint64, key string) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "LookupKey")
+	defer span.End()
+
+	req := &segpb.LookupKeyInBlockRequest{
+		BlockId:      blockID,
+		PartitionKey: key,
+	}
+
+	client, err := s.client.Get(ctx)
+	if err != nil {
+		return -1, err
+	}
+
+	res, err := client.(segpb.SegmentServerClient).LookupKeyInBlock(ctx, req)
+	if err != nil {
+		return -1, err
+	}
+	return res.Offset, nil
+}
+
 func (s *BlockStore) AppendBatch(ctx context.Context, block uint64, event *cepb.CloudEventBatch) (int64, error) {
+	ctx = tracing.UpdateRequestMetadata(ctx, func(md *tracing.RequestMetadata) {
+		md.Block = fmt.Sprintf("%016X", block)
+	})
 	_ctx, span := s.tracer.Start(ctx, "AppendBatch")
 	defer span.End()
 
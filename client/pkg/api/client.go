@@ -17,14 +17,32 @@ package api
 
 import (
 	"context"
+	"time"
+
 	ce "github.com/cloudevents/sdk-go/v2"
 	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 )
 
+// OrderingScope is the ordering of events an eventbus guarantees.
+type OrderingScope string
+
+const (
+	// OrderingNone guarantees no ordering.
+	OrderingNone = OrderingScope("none")
+	// OrderingPerEventlog delivers events in the order they are stored in each eventlog.
+	OrderingPerEventlog = OrderingScope("per_eventlog")
+	// OrderingPerKey writes events with the same partitionkey extension to the same eventlog in order,
+	// events without the key are rejected by gateways.
+	OrderingPerKey = OrderingScope("per_key")
+)
+
 type Eventbus interface {
 	Writer(opts ...WriteOption) BusWriter
 	Reader(opts ...ReadOption) BusReader
 
+	// OrderingScope returns the ordering declared when the eventbus was created.
+	OrderingScope(ctx context.Context) (OrderingScope, error)
+
 	GetLog(ctx context.Context, logID uint64, opts ...LogOption) (Eventlog, error)
 	ListLog(ctx context.Context, opts ...LogOption) ([]Eventlog, error)
 	Close(ctx context.Context)
@@ -46,4 +64,10 @@ type Eventlog interface {
 	LatestOffset(ctx context.Context) (int64, error)
 	Length(ctx context.Context) (int64, error)
 	QueryOffsetByTime(ctx context.Context, timestamp int64) (int64, error)
+	// ReadFromTime reads at most number events from the first one stored at or after t, it's located by
+	// time indexes of blocks. number must be in [1, math.MaxInt16].
+	ReadFromTime(ctx context.Context, t time.Time, number int) ([]*ce.Event, error)
+	// LookupKey returns the offset of the latest event with the partition key, it's located by key indexes of
+	// blocks. It's -1 if no event has the key.
+	LookupKey(ctx context.Context, key string) (int64, error)
 }
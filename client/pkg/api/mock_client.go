@@ -7,6 +7,7 @@ package api
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	v2 "github.com/cloudevents/sdk-go/v2"
 	gomock "github.com/golang/mock/gomock"
@@ -88,6 +89,21 @@ func (mr *MockEventbusMockRecorder) ListLog(ctx interface{}, opts ...interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLog", reflect.TypeOf((*MockEventbus)(nil).ListLog), varargs...)
 }
 
+// OrderingScope mocks base method.
+func (m *MockEventbus) OrderingScope(ctx context.Context) (OrderingScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrderingScope", ctx)
+	ret0, _ := ret[0].(OrderingScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrderingScope indicates an expected call of OrderingScope.
+func (mr *MockEventbusMockRecorder) OrderingScope(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrderingScope", reflect.TypeOf((*MockEventbus)(nil).OrderingScope), ctx)
+}
+
 // Reader mocks base method.
 func (m *MockEventbus) Reader(opts ...ReadOption) BusReader {
 	m.ctrl.T.Helper()
@@ -333,6 +349,21 @@ func (mr *MockEventlogMockRecorder) Length(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockEventlog)(nil).Length), ctx)
 }
 
+// LookupKey mocks base method.
+func (m *MockEventlog) LookupKey(ctx context.Context, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupKey", ctx, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupKey indicates an expected call of LookupKey.
+func (mr *MockEventlogMockRecorder) LookupKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupKey", reflect.TypeOf((*MockEventlog)(nil).LookupKey), ctx, key)
+}
+
 // QueryOffsetByTime mocks base method.
 func (m *MockEventlog) QueryOffsetByTime(ctx context.Context, timestamp int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -347,3 +378,18 @@ func (mr *MockEventlogMockRecorder) QueryOffsetByTime(ctx, timestamp interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryOffsetByTime", reflect.TypeOf((*MockEventlog)(nil).QueryOffsetByTime), ctx, timestamp)
 }
+
+// ReadFromTime mocks base method.
+func (m *MockEventlog) ReadFromTime(ctx context.Context, t time.Time, number int) ([]*v2.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFromTime", ctx, t, number)
+	ret0, _ := ret[0].([]*v2.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFromTime indicates an expected call of ReadFromTime.
+func (mr *MockEventlogMockRecorder) ReadFromTime(ctx, t, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromTime", reflect.TypeOf((*MockEventlog)(nil).ReadFromTime), ctx, t, number)
+}
@@ -14,6 +14,8 @@
 
 package api
 
+import "context"
+
 const (
 	DefaultPollingTimeout = 3000 // in milliseconds.
 )
@@ -41,9 +43,18 @@ func (wo *WriteOptions) Copy() *WriteOptions {
 type ReadOption func(*ReadOptions)
 
 type ReadOptions struct {
-	BatchSize      int
+	BatchSize int
+	// MaxBytes limits the total size of events in a read, 0 is unlimited.
+	MaxBytes       int
 	PollingTimeout int64
 	Policy         ReadPolicy
+	// GapDetector observes offsets of events read, events from the offset it rejects aren't returned.
+	GapDetector GapDetector
+}
+
+// GapDetector checks offsets of events read from an eventlog are continuous, see package gap.
+type GapDetector interface {
+	Observe(ctx context.Context, eventlogID, offset uint64) error
 }
 
 func (ro *ReadOptions) Apply(opts ...ReadOption) {
@@ -55,8 +66,10 @@ func (ro *ReadOptions) Apply(opts ...ReadOption) {
 func (ro *ReadOptions) Copy() *ReadOptions {
 	return &ReadOptions{
 		BatchSize:      ro.BatchSize,
+		MaxBytes:       ro.MaxBytes,
 		PollingTimeout: ro.PollingTimeout,
 		Policy:         ro.Policy,
+		GapDetector:    ro.GapDetector,
 	}
 }
 
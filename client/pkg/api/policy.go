@@ -22,6 +22,7 @@ type PolicyType string
 
 const (
 	RoundRobin = PolicyType("round_robin")
+	KeyHash    = PolicyType("key_hash")
 	Manually   = PolicyType("manually")
 	Weight     = PolicyType("weight")
 	ReadOnly   = PolicyType("readonly")
@@ -39,6 +39,7 @@ import (
 	"github.com/linkall-labs/vanus/client/pkg/policy"
 	vlog "github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
 	eb "github.com/linkall-labs/vanus/client/internal/vanus/eventbus"
 	el "github.com/linkall-labs/vanus/client/internal/vanus/eventlog"
@@ -125,6 +126,10 @@ type eventbus struct {
 	readableMu      sync.RWMutex
 	readableState   error
 
+	// ordering is cached once it's known, it can't be changed after the eventbus is created.
+	ordering   api.OrderingScope
+	orderingMu sync.Mutex
+
 	tracer *tracing.Tracer
 }
 
@@ -174,6 +179,27 @@ func (b *eventbus) Reader(opts ...api.ReadOption) api.BusReader {
 	return r
 }
 
+func (b *eventbus) OrderingScope(ctx context.Context) (api.OrderingScope, error) {
+	b.orderingMu.Lock()
+	defer b.orderingMu.Unlock()
+	if b.ordering != "" {
+		return b.ordering, nil
+	}
+	scope, err := b.nameService.LookupOrderingScope(ctx, b.cfg.Name)
+	if err != nil {
+		return "", err
+	}
+	switch scope {
+	case metapb.OrderingScope_ORDERING_PER_EVENTLOG:
+		b.ordering = api.OrderingPerEventlog
+	case metapb.OrderingScope_ORDERING_PER_KEY:
+		b.ordering = api.OrderingPerKey
+	default:
+		b.ordering = api.OrderingNone
+	}
+	return b.ordering, nil
+}
+
 func (b *eventbus) GetLog(ctx context.Context, logID uint64, opts ...api.LogOption) (api.Eventlog, error) {
 	_, span := b.tracer.Start(ctx, "pkg.eventbus.getlog")
 	defer span.End()
@@ -567,9 +593,32 @@ func (r *busReader) Read(ctx context.Context, opts ...api.ReadOption) ([]*ce.Eve
 	if err != nil {
 		return []*ce.Event{}, 0, 0, err
 	}
+	if readOpts.GapDetector != nil {
+		if events, err = observeGaps(_ctx, readOpts.GapDetector, lr.Log().ID(), events); err != nil {
+			return []*ce.Event{}, 0, 0, err
+		}
+	}
 	return events, off, lr.Log().ID(), nil
 }
 
+// observeGaps returns events before the first one rejected by the detector, the rejected one is read again
+// next time. The error of the detector is returned only if the first event is rejected.
+func observeGaps(ctx context.Context, detector api.GapDetector, logID uint64, events []*ce.Event) ([]*ce.Event, error) {
+	for i, e := range events {
+		v, _ := e.Extensions()[eventlog.XVanusLogOffset].([]byte)
+		if len(v) != 8 {
+			continue
+		}
+		if err := detector.Observe(ctx, logID, binary.BigEndian.Uint64(v)); err != nil {
+			if i == 0 {
+				return nil, err
+			}
+			return events[:i], nil
+		}
+	}
+	return events, nil
+}
+
 func (r *busReader) Bus() api.Eventbus {
 	return r.ebus
 }
@@ -587,5 +636,8 @@ func (r *busReader) pickReadableLog(ctx context.Context, opts *api.ReadOptions)
 		return nil, stderrors.New("can not pick readable log")
 	}
 
-	return lr.Reader(eventlog.ReaderConfig{PollingTimeout: opts.PollingTimeout}), nil
+	return lr.Reader(eventlog.ReaderConfig{
+		PollingTimeout: opts.PollingTimeout,
+		MaxBytes:       int64(opts.MaxBytes),
+	}), nil
 }
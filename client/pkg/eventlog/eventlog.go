@@ -35,6 +35,7 @@ const (
 
 type ReaderConfig struct {
 	PollingTimeout int64
+	MaxBytes       int64
 }
 
 type Eventlog interface {
@@ -17,8 +17,10 @@ package eventlog
 import (
 	// standard libraries.
 	"context"
+	"fmt"
 	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	"io"
+	"math"
 	"sort"
 	"sync"
 	"time"
@@ -34,6 +36,7 @@ import (
 	"github.com/linkall-labs/vanus/client/pkg/record"
 	vlog "github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util"
 )
 
 const (
@@ -42,6 +45,16 @@ const (
 	pollingPostSpan   = 100 // in milliseconds.
 )
 
+// sealedRetryBackoff is the base wait before retrying an append to a full segment, the controller opens the
+// next segment once the full one is archived.
+const sealedRetryBackoff = 20 * time.Millisecond
+
+// maxThrottledWait caps the wait before retrying a throttled append, whatever the server hints.
+const maxThrottledWait = time.Second
+
+// maxSegmentHops limits successors followed by a read, segments may be sealed empty.
+const maxSegmentHops = 3
+
 func NewEventLog(cfg *el.Config) Eventlog {
 	log := &eventlog{
 		cfg:         cfg,
@@ -210,6 +223,48 @@ func (l *eventlog) QueryOffsetByTime(ctx context.Context, timestamp int64) (int6
 	return target.LookupOffset(ctx, t)
 }
 
+// LookupKey looks up segments from the newest one, since events of a key are appended in order.
+func (l *eventlog) LookupKey(ctx context.Context, key string) (int64, error) {
+	segs := l.fetchReadableSegments(ctx)
+	if n := len(segs); n != 0 && !segs[n-1].Writable() {
+		// the latest event may be in a segment created after the last one is sealed.
+		l.refreshReadableSegments(ctx)
+		segs = l.fetchReadableSegments(ctx)
+	}
+	for i := len(segs) - 1; i >= 0; i-- {
+		off, err := segs[i].LookupKey(ctx, key)
+		if err != nil {
+			return -1, err
+		}
+		if off >= 0 {
+			return off, nil
+		}
+	}
+	return -1, nil
+}
+
+func (l *eventlog) ReadFromTime(ctx context.Context, t time.Time, number int) ([]*ce.Event, error) {
+	// A read is bounded by int16, more events are read by reading on from the last offset returned.
+	if number <= 0 || number > math.MaxInt16 {
+		return nil, errors.ErrInvalidArgument.WithMessage(
+			fmt.Sprintf("the number of events to read must be in [1, %d], got %d", math.MaxInt16, number))
+	}
+	off, err := l.QueryOffsetByTime(ctx, t.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+	if off < 0 {
+		return nil, errors.ErrOffsetOnEnd
+	}
+
+	r := l.Reader(ReaderConfig{})
+	defer r.Close(ctx)
+	if _, err = r.Seek(ctx, off, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return r.Read(ctx, int16(number))
+}
+
 func (l *eventlog) updateWritableSegment(ctx context.Context, r *record.Segment) {
 	if l.writableSegment != nil {
 		if l.writableSegment.ID() == r.ID {
@@ -330,6 +385,12 @@ func (l *eventlog) refreshReadableSegments(ctx context.Context) {
 	_ = l.readableWatcher.Refresh(ctx)
 }
 
+// segmentSealed is called once a segment is found sealed, readable segments cached by name service
+// are stale since then.
+func (l *eventlog) segmentSealed() {
+	l.nameService.InvalidateReadableSegments(l.cfg.ID)
+}
+
 var (
 	_ LogWriter = &logWriter{}
 )
@@ -344,6 +405,9 @@ type logWriter struct {
 }
 
 func (w *logWriter) AppendMany(ctx context.Context, events *cloudevents.CloudEventBatch) (off int64, err error) {
+	ctx = tracing.UpdateRequestMetadata(ctx, func(md *tracing.RequestMetadata) {
+		md.Eventlog = fmt.Sprintf("%016X", w.elog.ID())
+	})
 	retryTimes := defaultRetryTimes
 	for i := 1; i <= retryTimes; i++ {
 		offset, err := w.doAppendBatch(ctx, events)
@@ -354,8 +418,9 @@ func (w *logWriter) AppendMany(ctx context.Context, events *cloudevents.CloudEve
 			vlog.KeyError: err,
 			"offset":      offset,
 		})
-		if errors.Is(err, errors.ErrSegmentFull) {
-			if i < retryTimes {
+		if errors.Is(err, errors.ErrSegmentFull) || errors.Is(err, errors.ErrWriteLeaseExpired) ||
+			errors.Is(err, errors.ErrThrottled) {
+			if i < retryTimes && waitBeforeRetry(ctx, err, i) {
 				continue
 			}
 		}
@@ -374,6 +439,9 @@ func (w *logWriter) Close(ctx context.Context) {
 }
 
 func (w *logWriter) Append(ctx context.Context, event *ce.Event) (int64, error) {
+	ctx = tracing.UpdateRequestMetadata(ctx, func(md *tracing.RequestMetadata) {
+		md.Eventlog = fmt.Sprintf("%016X", w.elog.ID())
+	})
 	// TODO: async for throughput
 
 	retryTimes := defaultRetryTimes
@@ -386,8 +454,9 @@ func (w *logWriter) Append(ctx context.Context, event *ce.Event) (int64, error)
 			vlog.KeyError: err,
 			"offset":      offset,
 		})
-		if errors.Is(err, errors.ErrSegmentFull) {
-			if i < retryTimes {
+		if errors.Is(err, errors.ErrSegmentFull) || errors.Is(err, errors.ErrWriteLeaseExpired) ||
+			errors.Is(err, errors.ErrThrottled) {
+			if i < retryTimes && waitBeforeRetry(ctx, err, i) {
 				continue
 			}
 		}
@@ -397,6 +466,28 @@ func (w *logWriter) Append(ctx context.Context, event *ce.Event) (int64, error)
 	return -1, errors.ErrUnknown
 }
 
+// waitBeforeRetry backs off before the attempt-th retry of an append to a full segment, so that retries
+// aren't used up before the next segment is opened, or waits as the throttled append is hinted. It returns
+// false if ctx is done.
+// Nothing is chained across blocks for a full segment: the store accepts the append that overflows a block
+// and archives the block after it, then the controller opens the next segment, so only the retry is left here.
+func waitBeforeRetry(ctx context.Context, err error, attempt int) bool {
+	if errors.Is(err, errors.ErrThrottled) {
+		wait := errors.RetryAfter(err)
+		if wait <= 0 {
+			wait = time.Duration(attempt) * sealedRetryBackoff
+		}
+		if wait > maxThrottledWait {
+			wait = maxThrottledWait
+		}
+		return util.SleepWithContext(ctx, wait)
+	}
+	if !errors.Is(err, errors.ErrSegmentFull) {
+		return true
+	}
+	return util.SleepWithContext(ctx, time.Duration(attempt)*sealedRetryBackoff)
+}
+
 func (w *logWriter) doAppend(ctx context.Context, event *ce.Event) (int64, error) {
 	segment, err := w.selectWritableSegment(ctx)
 	if err != nil {
@@ -406,6 +497,10 @@ func (w *logWriter) doAppend(ctx context.Context, event *ce.Event) (int64, error
 	if err != nil {
 		if errors.Is(err, errors.ErrSegmentFull) {
 			segment.SetNotWritable()
+			w.elog.segmentSealed()
+		} else if errors.Is(err, errors.ErrWriteLeaseExpired) {
+			// the segment may have been given up, fetch the writable segment again.
+			segment.SetNotWritable()
 		}
 		return -1, err
 	}
@@ -421,6 +516,10 @@ func (w *logWriter) doAppendBatch(ctx context.Context, event *cloudevents.CloudE
 	if err != nil {
 		if errors.Is(err, errors.ErrSegmentFull) {
 			segment.SetNotWritable()
+			w.elog.segmentSealed()
+		} else if errors.Is(err, errors.ErrWriteLeaseExpired) {
+			// the segment may have been given up, fetch the writable segment again.
+			segment.SetNotWritable()
 		}
 		return -1, err
 	}
@@ -470,36 +569,47 @@ func (r *logReader) Close(ctx context.Context) {
 	// TODO: by jiangkai, 2022.10.19
 }
 
+// Read follows the segment chain of the eventlog, a read reaching the end of a sealed segment continues from
+// its successor. It returns ErrOffsetOnEnd if the successor isn't opened yet.
 func (r *logReader) Read(ctx context.Context, size int16) ([]*ce.Event, error) {
-	if r.cur == nil {
-		segment, err := r.elog.selectReadableSegment(ctx, r.pos)
-		if errors.Is(err, errors.ErrOffsetOnEnd) {
-			r.elog.refreshReadableSegments(ctx)
-			segment, err = r.elog.selectReadableSegment(ctx, r.pos)
+	for hops := 0; ; hops++ {
+		if r.cur == nil {
+			segment, err := r.elog.selectReadableSegment(ctx, r.pos)
+			if errors.Is(err, errors.ErrOffsetOnEnd) {
+				r.elog.refreshReadableSegments(ctx)
+				segment, err = r.elog.selectReadableSegment(ctx, r.pos)
+			}
+			if err != nil {
+				return nil, err
+			}
+			r.cur = segment
 		}
-		if err != nil {
+
+		events, err := r.cur.Read(ctx, r.pos, size, r.cfg.MaxBytes, uint32(r.pollingTimeout(ctx)))
+		if err == nil {
+			r.pos += int64(len(events))
+			if r.pos == r.cur.EndOffset() {
+				r.switchSegment(ctx)
+			}
+			return events, nil
+		}
+		if !errors.Is(err, errors.ErrOffsetOverflow) {
 			return nil, err
 		}
-		r.cur = segment
-	}
 
-	events, err := r.cur.Read(ctx, r.pos, size, uint32(r.pollingTimeout(ctx)))
-	if err != nil {
-		if errors.Is(err, errors.ErrOffsetOverflow) {
-			r.elog.refreshReadableSegments(ctx)
-			if r.switchSegment(ctx) {
-				return nil, errors.ErrTryAgain
+		end := r.cur.EndOffset()
+		r.elog.segmentSealed()
+		r.elog.refreshReadableSegments(ctx)
+		if !r.switchSegment(ctx) {
+			if r.pos == end {
+				return nil, errors.ErrOffsetOnEnd
 			}
+			return nil, err
+		}
+		if hops >= maxSegmentHops {
+			return nil, errors.ErrTryAgain
 		}
-		return nil, err
-	}
-
-	r.pos += int64(len(events))
-	if r.pos == r.cur.EndOffset() {
-		r.switchSegment(ctx)
 	}
-
-	return events, nil
 }
 
 func (r *logReader) pollingTimeout(ctx context.Context) int64 {
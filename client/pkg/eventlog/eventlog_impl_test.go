@@ -0,0 +1,91 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	// standard libraries.
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	// this project.
+	el "github.com/linkall-labs/vanus/client/internal/vanus/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/primitive"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+func TestEventlog_ReadFromTime(t *testing.T) {
+	// Invalid numbers are rejected before segments are looked up, so no name service is needed.
+	l := &eventlog{cfg: &el.Config{ID: 1}}
+	for _, number := range []int{-1, 0, math.MaxInt16 + 1} {
+		events, err := l.ReadFromTime(context.Background(), time.Now(), number)
+		if !errors.Is(err, errors.ErrInvalidArgument) {
+			t.Errorf("ReadFromTime(%d) = %v, want ErrInvalidArgument", number, err)
+		}
+		if events != nil {
+			t.Errorf("ReadFromTime(%d) returned %d events", number, len(events))
+		}
+	}
+
+	// Nothing is read before the first segment is created.
+	w := &ReadableSegmentsWatcher{}
+	w.Watcher = primitive.NewWatcher(time.Hour, func() { w.Wakeup() })
+	go w.Run()
+	defer w.Close()
+	l.readableWatcher = w
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := l.ReadFromTime(ctx, time.Now(), 1); !errors.Is(err, errors.ErrOffsetOnEnd) {
+		t.Errorf("ReadFromTime() without segments = %v, want ErrOffsetOnEnd", err)
+	}
+}
+
+func TestWaitBeforeRetry(t *testing.T) {
+	elapsed := func(err error, attempt int) (bool, time.Duration) {
+		start := time.Now()
+		ok := waitBeforeRetry(context.Background(), err, attempt)
+		return ok, time.Since(start)
+	}
+
+	// Retries of a full segment back off longer each time, until the next segment is opened.
+	ok, d := elapsed(errors.ErrSegmentFull, 3)
+	if !ok || d < 3*sealedRetryBackoff {
+		t.Errorf("full segment: waitBeforeRetry() = %v after %s, want true after %s", ok, d, 3*sealedRetryBackoff)
+	}
+
+	// A throttled append waits as hinted, but no longer than maxThrottledWait.
+	ok, d = elapsed(errors.ErrThrottled.WithRetryAfter(50*time.Millisecond), 1)
+	if !ok || d < 50*time.Millisecond || d >= maxThrottledWait {
+		t.Errorf("throttled: waitBeforeRetry() = %v after %s, want true after 50ms", ok, d)
+	}
+
+	// An expired lease is retried at once, on the segment looked up again.
+	ok, d = elapsed(errors.ErrWriteLeaseExpired, 3)
+	if !ok || d >= sealedRetryBackoff {
+		t.Errorf("lease expired: waitBeforeRetry() = %v after %s, want true at once", ok, d)
+	}
+
+	// The wait is given up once the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if waitBeforeRetry(ctx, errors.ErrSegmentFull, 100) {
+		t.Error("waitBeforeRetry() = true, want false once ctx is done")
+	}
+	if d = time.Since(start); d >= time.Second {
+		t.Errorf("waitBeforeRetry() returned after %s, ctx isn't honored", d)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	// standard libraries.
 	"context"
 	"encoding/binary"
+	stderr "errors"
 	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	"math"
 	"sync"
@@ -35,6 +36,7 @@ import (
 
 	// this project.
 
+	"github.com/linkall-labs/vanus/client/internal/vanus/store"
 	"github.com/linkall-labs/vanus/client/pkg/record"
 	"github.com/linkall-labs/vanus/pkg/errors"
 )
@@ -118,6 +120,13 @@ func (s *segment) SetNotWritable() {
 	s.writable.Store(false)
 }
 
+// sealAt records the end offset of the segment learned from the store, which may be earlier than the
+// metadata of the eventlog is refreshed.
+func (s *segment) sealAt(end int64) {
+	s.endOffset.Store(end)
+	s.writable.Store(false)
+}
+
 func (s *segment) Close(ctx context.Context) {
 	s.prefer.Close(ctx)
 }
@@ -187,7 +196,9 @@ func (s *segment) AppendBatch(ctx context.Context, event *cloudevents.CloudEvent
 	return off + s.startOffset, nil
 }
 
-func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeout uint32) ([]*ce.Event, error) {
+func (s *segment) Read(
+	ctx context.Context, from int64, size int16, maxBytes int64, pollingTimeout uint32,
+) ([]*ce.Event, error) {
 	if from < s.startOffset {
 		return nil, errors.ErrOffsetUnderflow
 	}
@@ -195,7 +206,8 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 	defer span.End()
 
 	if eo := s.endOffset.Load(); eo >= 0 {
-		if from > eo {
+		// Reads at the end of a sealed segment continue from its successor.
+		if from >= eo {
 			return nil, errors.ErrOffsetOverflow
 		}
 		if int64(size) > eo-from {
@@ -207,8 +219,13 @@ func (s *segment) Read(ctx context.Context, from int64, size int16, pollingTimeo
 	if b == nil {
 		return nil, errors.ErrBlockNotFound
 	}
-	events, err := b.Read(ctx, from-s.startOffset, size, pollingTimeout)
+	events, err := b.Read(ctx, from-s.startOffset, size, maxBytes, pollingTimeout)
 	if err != nil {
+		var sealed *store.SealedError
+		if stderr.As(err, &sealed) {
+			s.sealAt(s.startOffset + sealed.Offset)
+			return nil, errors.ErrOffsetOverflow
+		}
 		return nil, err
 	}
 
@@ -246,3 +263,13 @@ func (s *segment) setPreferSegmentBlock(prefer *block) {
 func (s *segment) LookupOffset(ctx context.Context, t time.Time) (int64, error) {
 	return s.preferSegmentBlock().LookupOffset(ctx, t)
 }
+
+// LookupKey returns the offset of the latest event with the partition key in the segment, it's -1 if no event
+// has the key.
+func (s *segment) LookupKey(ctx context.Context, key string) (int64, error) {
+	off, err := s.preferSegmentBlock().LookupKey(ctx, key)
+	if err != nil || off < 0 {
+		return -1, err
+	}
+	return off + s.startOffset, nil
+}
@@ -77,7 +77,7 @@ func (w *ReadableSegmentsWatcher) Start() {
 func WatchReadableSegments(log *eventlog) *ReadableSegmentsWatcher {
 	ch := make(chan []*record.Segment, 1)
 	w := primitive.NewWatcher(defaultWatchInterval, func() {
-		rs, err := log.nameService.LookupReadableSegments(context.Background(), log.cfg.ID)
+		rs, err := log.nameService.LookupCachedReadableSegments(context.Background(), log.cfg.ID)
 		if err != nil {
 			ch <- nil
 		} else {
@@ -53,6 +53,10 @@ func (s *block) LookupOffset(ctx context.Context, t time.Time) (int64, error) {
 	return s.store.LookupOffset(ctx, s.id, t)
 }
 
+func (s *block) LookupKey(ctx context.Context, key string) (int64, error) {
+	return s.store.LookupKey(ctx, s.id, key)
+}
+
 func (s *block) Append(ctx context.Context, event *ce.Event) (int64, error) {
 	return s.store.Append(ctx, s.id, event)
 }
@@ -61,7 +65,9 @@ func (s *block) AppendBatch(ctx context.Context, event *cloudevents.CloudEventBa
 	return s.store.AppendBatch(ctx, s.id, event)
 }
 
-func (s *block) Read(ctx context.Context, offset int64, size int16, pollingTimeout uint32) ([]*ce.Event, error) {
+func (s *block) Read(
+	ctx context.Context, offset int64, size int16, maxBytes int64, pollingTimeout uint32,
+) ([]*ce.Event, error) {
 	if offset < 0 {
 		return nil, errors.ErrOffsetUnderflow
 	}
@@ -72,5 +78,5 @@ func (s *block) Read(ctx context.Context, offset int64, size int16, pollingTimeo
 	} else if size < 0 {
 		return nil, errors.ErrInvalidArgument
 	}
-	return s.store.Read(ctx, s.id, offset, size, pollingTimeout)
+	return s.store.Read(ctx, s.id, offset, size, maxBytes, pollingTimeout)
 }
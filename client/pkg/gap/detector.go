@@ -0,0 +1,250 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gap detects gaps of events consumed from eventlogs. Offsets of an eventlog are logical sequence
+// numbers, which increase by one for each event, so a consumer reading an eventlog in order sees every
+// offset once unless events are lost.
+package gap
+
+import (
+	// standard libraries.
+	"context"
+	stderrors "errors"
+	"sync"
+	"time"
+
+	// this project.
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// ErrPaused is returned for events after an unexplained gap in strict mode, until the eventlog is resumed.
+var ErrPaused = stderrors.New("the eventlog is paused by a sequence gap")
+
+type Cause string
+
+const (
+	// CauseRetention means events were removed by retention before they were consumed.
+	CauseRetention Cause = "retention"
+	// CauseMissing means events still exist in the eventlog but weren't delivered, it's a bug.
+	CauseMissing Cause = "missing"
+	// CauseUnknown means the earliest offset of the eventlog is unavailable to tell the cause.
+	CauseUnknown Cause = "unknown"
+)
+
+// Gap is the range [From, To) of offsets which aren't consumed.
+type Gap struct {
+	EventlogID uint64
+	From       uint64
+	To         uint64
+	Cause      Cause
+	DetectedAt time.Time
+}
+
+func (g Gap) Size() uint64 {
+	return g.To - g.From
+}
+
+// EarliestFunc returns the earliest offset of the eventlog, events before it were removed by retention.
+type EarliestFunc func(ctx context.Context, eventlogID uint64) (uint64, error)
+
+// EarliestOf looks up earliest offsets of eventlogs of the eventbus.
+func EarliestOf(bus api.Eventbus) EarliestFunc {
+	return func(ctx context.Context, eventlogID uint64) (uint64, error) {
+		l, err := bus.GetLog(ctx, eventlogID)
+		if err != nil {
+			return 0, err
+		}
+		off, err := l.EarliestOffset(ctx)
+		if err != nil {
+			return 0, err
+		}
+		return uint64(off), nil
+	}
+}
+
+type Config struct {
+	// Strict pauses an eventlog once a gap not caused by retention is found, so that events after it aren't
+	// consumed until the gap is investigated and the eventlog is resumed.
+	Strict bool
+	// Earliest tells gaps caused by retention, all gaps are of CauseUnknown if it's nil.
+	Earliest EarliestFunc
+	// OnGap is called for each gap found, e.g. to record metrics.
+	OnGap func(Gap)
+}
+
+// Stats counts events in gaps of an eventlog by their causes.
+type Stats struct {
+	Events map[Cause]uint64
+	Paused *Gap
+}
+
+type logState struct {
+	next    uint64
+	started bool
+	events  map[Cause]uint64
+	paused  *Gap
+}
+
+type Detector struct {
+	cfg   Config
+	mutex sync.Mutex
+	logs  map[uint64]*logState
+}
+
+func NewDetector(cfg Config) *Detector {
+	return &Detector{
+		cfg:  cfg,
+		logs: make(map[uint64]*logState),
+	}
+}
+
+// Reset expects the next event of the eventlog at offset, e.g. the consumer starts or seeks to it.
+func (d *Detector) Reset(eventlogID, offset uint64) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	s := d.state(eventlogID)
+	s.next = offset
+	s.started = true
+	s.paused = nil
+}
+
+// Resume accepts events of the eventlog paused in strict mode, gaps found before are skipped. It returns
+// false if the eventlog isn't paused.
+func (d *Detector) Resume(eventlogID uint64) bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	s := d.state(eventlogID)
+	if s.paused == nil {
+		return false
+	}
+	s.paused = nil
+	return true
+}
+
+// Observe checks the offset of an event consumed from the eventlog, events must be observed in order of
+// offsets. It returns ErrPaused if the eventlog is paused in strict mode, the event shouldn't be consumed.
+func (d *Detector) Observe(ctx context.Context, eventlogID, offset uint64) error {
+	d.mutex.Lock()
+	s := d.state(eventlogID)
+	if s.paused != nil {
+		d.mutex.Unlock()
+		return ErrPaused
+	}
+	from := s.next
+	// Offsets going backwards are redeliveries, e.g. the consumer seeks back, they aren't gaps.
+	if !s.started || offset <= from {
+		s.next = offset + 1
+		s.started = true
+		d.mutex.Unlock()
+		return nil
+	}
+	d.mutex.Unlock()
+
+	gaps := d.classify(ctx, eventlogID, from, offset)
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	for i := range gaps {
+		g := gaps[i]
+		s.events[g.Cause] += g.Size()
+		log.Warning(ctx, "found a gap of events consumed from the eventlog", map[string]interface{}{
+			"eventlog_id": eventlogID,
+			"from":        g.From,
+			"to":          g.To,
+			"cause":       g.Cause,
+		})
+		if d.cfg.OnGap != nil {
+			d.cfg.OnGap(g)
+		}
+		if d.cfg.Strict && g.Cause != CauseRetention && s.paused == nil {
+			s.paused = &g
+		}
+	}
+	if s.paused != nil {
+		// The gap is reported once, the event after it is observed again once the eventlog is resumed.
+		s.next = offset
+		return ErrPaused
+	}
+	s.next = offset + 1
+	return nil
+}
+
+// classify splits [from, to) by the earliest offset, events before it were removed by retention.
+func (d *Detector) classify(ctx context.Context, eventlogID, from, to uint64) []Gap {
+	now := time.Now()
+	gap := func(from, to uint64, cause Cause) Gap {
+		return Gap{EventlogID: eventlogID, From: from, To: to, Cause: cause, DetectedAt: now}
+	}
+	if d.cfg.Earliest == nil {
+		return []Gap{gap(from, to, CauseUnknown)}
+	}
+	earliest, err := d.cfg.Earliest(ctx, eventlogID)
+	if err != nil {
+		log.Debug(ctx, "get the earliest offset of the eventlog failed", map[string]interface{}{
+			"eventlog_id": eventlogID,
+			log.KeyError:  err,
+		})
+		return []Gap{gap(from, to, CauseUnknown)}
+	}
+	switch {
+	case earliest >= to:
+		return []Gap{gap(from, to, CauseRetention)}
+	case earliest > from:
+		return []Gap{gap(from, earliest, CauseRetention), gap(earliest, to, CauseMissing)}
+	default:
+		return []Gap{gap(from, to, CauseMissing)}
+	}
+}
+
+// Stats returns counts of events in gaps of the eventlog.
+func (d *Detector) Stats(eventlogID uint64) Stats {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	s, ok := d.logs[eventlogID]
+	if !ok {
+		return Stats{Events: map[Cause]uint64{}}
+	}
+	stats := Stats{Events: make(map[Cause]uint64, len(s.events))}
+	for c, n := range s.events {
+		stats.Events[c] = n
+	}
+	if s.paused != nil {
+		g := *s.paused
+		stats.Paused = &g
+	}
+	return stats
+}
+
+// Paused returns gaps which eventlogs are paused at in strict mode.
+func (d *Detector) Paused() []Gap {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	var gaps []Gap
+	for _, s := range d.logs {
+		if s.paused != nil {
+			gaps = append(gaps, *s.paused)
+		}
+	}
+	return gaps
+}
+
+func (d *Detector) state(eventlogID uint64) *logState {
+	s, ok := d.logs[eventlogID]
+	if !ok {
+		s = &logState{events: make(map[Cause]uint64)}
+		d.logs[eventlogID] = s
+	}
+	return s
+}
@@ -38,6 +38,16 @@ func WithBatchSize(size int) api.ReadOption {
 	}
 }
 
+// WithMaxBytes limits the total size of events in a read, at least one event is read even if it's larger.
+func WithMaxBytes(maxBytes int) api.ReadOption {
+	return func(options *api.ReadOptions) {
+		if maxBytes < 0 {
+			maxBytes = 0
+		}
+		options.MaxBytes = maxBytes
+	}
+}
+
 func WithPollingTimeout(d time.Duration) api.ReadOption {
 	return func(options *api.ReadOptions) {
 		if d <= 0 {
@@ -60,6 +70,13 @@ func WithReadPolicy(policy api.ReadPolicy) api.ReadOption {
 	}
 }
 
+// WithGapDetector checks events read are continuous in their eventlogs, e.g. by gap.Detector.
+func WithGapDetector(detector api.GapDetector) api.ReadOption {
+	return func(options *api.ReadOptions) {
+		options.GapDetector = detector
+	}
+}
+
 func WithLogPolicy(policy api.LogPolicy) api.LogOption {
 	return func(options *api.LogOptions) {
 		options.Policy = policy
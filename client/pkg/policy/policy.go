@@ -16,6 +16,7 @@ package policy
 
 import (
 	"context"
+	"hash/fnv"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -69,6 +70,45 @@ func (w *roundRobinWritePolicy) NextLog(ctx context.Context) (api.Eventlog, erro
 	}
 }
 
+var _ api.WritePolicy = (*keyHashWritePolicy)(nil)
+
+// NewKeyHashWritePolicy always chooses the same eventlog for the key as long as the number of eventlogs
+// doesn't change, so that events with the key are stored in order.
+func NewKeyHashWritePolicy(eb api.Eventbus, key string) api.WritePolicy {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return &keyHashWritePolicy{
+		bus:  eb,
+		hash: h.Sum32(),
+	}
+}
+
+type keyHashWritePolicy struct {
+	bus  api.Eventbus
+	hash uint32
+}
+
+func (w *keyHashWritePolicy) Type() api.PolicyType {
+	return api.KeyHash
+}
+
+func (w *keyHashWritePolicy) NextLog(ctx context.Context) (api.Eventlog, error) {
+	for {
+		logs, err := w.bus.ListLog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(logs) == 0 {
+			continue
+		}
+		// Eventlogs are sorted by IDs, so that all writers choose the same one.
+		sort.Slice(logs, func(i, j int) bool {
+			return logs[i].ID() < logs[j].ID()
+		})
+		return logs[w.hash%uint32(len(logs))], nil
+	}
+}
+
 var _ api.ReadPolicy = (*roundRobinReadPolicy)(nil)
 
 func NewRoundRobinReadPolicy(eb api.Eventbus, fromWhere api.ConsumeFromWhere) *roundRobinReadPolicy {
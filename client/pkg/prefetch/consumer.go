@@ -0,0 +1,335 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prefetch consumes events of an eventbus ahead of the application. Events are read into a bounded
+// buffer in the background, and the number of events buffered ahead adapts to how fast the application
+// handles them, so a slow handler is fed by a few large reads instead of a read for each event.
+package prefetch
+
+import (
+	// standard libraries.
+	"context"
+	stderrors "errors"
+	"math"
+	"sync"
+	"time"
+
+	// third-party libraries.
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	// this project.
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	defaultMaxBufferedEvents = 1024
+	defaultMinBatchSize      = 8
+	defaultMaxBatchSize      = 256
+	defaultReadTimeout       = 5 * time.Second
+	defaultRetryInterval     = 100 * time.Millisecond
+	// smoothing is the weight of the latest sample in moving averages of read latency and handling time.
+	smoothing = 0.2
+	// headroom is how many reads the buffer covers, so that the next read finishes before it's drained.
+	headroom = 2
+)
+
+// ErrClosed is returned by Next once the consumer is closed.
+var ErrClosed = stderrors.New("the consumer is closed")
+
+type Config struct {
+	// MaxBufferedEvents bounds events read but not consumed yet, it's 1024 by default.
+	MaxBufferedEvents int
+	// MinBatchSize and MaxBatchSize bound the number of events in a read, they are 8 and 256 by default.
+	MinBatchSize int
+	MaxBatchSize int
+	// ReadTimeout bounds a read including the time polling for new events, it's 5s by default.
+	ReadTimeout time.Duration
+	// RetryInterval is the time waited before reading again after a failed or empty read, or the end of
+	// the eventlog is reached, it's 100ms by default.
+	RetryInterval time.Duration
+}
+
+func (c *Config) complete() {
+	if c.MaxBufferedEvents <= 0 {
+		c.MaxBufferedEvents = defaultMaxBufferedEvents
+	}
+	if c.MinBatchSize <= 0 {
+		c.MinBatchSize = defaultMinBatchSize
+	}
+	if c.MaxBatchSize <= 0 {
+		c.MaxBatchSize = defaultMaxBatchSize
+	}
+	if c.MaxBatchSize > math.MaxInt16 {
+		c.MaxBatchSize = math.MaxInt16
+	}
+	if c.MaxBatchSize > c.MaxBufferedEvents {
+		c.MaxBatchSize = c.MaxBufferedEvents
+	}
+	if c.MinBatchSize > c.MaxBatchSize {
+		c.MinBatchSize = c.MaxBatchSize
+	}
+	if c.ReadTimeout <= 0 {
+		c.ReadTimeout = defaultReadTimeout
+	}
+	if c.RetryInterval <= 0 {
+		c.RetryInterval = defaultRetryInterval
+	}
+}
+
+// Stats describes the buffer of a consumer.
+type Stats struct {
+	// Buffered is the number of events read but not consumed yet.
+	Buffered int
+	// Depth is the number of events the consumer currently tries to keep buffered.
+	Depth  int
+	Paused bool
+}
+
+// Consumer reads events by the read policy ahead of Next, the policy is forwarded by events read, so it
+// shouldn't be used by others.
+type Consumer struct {
+	reader api.BusReader
+	policy api.ReadPolicy
+	cfg    Config
+
+	mutex  sync.Mutex
+	buffer []*ce.Event
+	err    error
+	paused bool
+	closed bool
+	depth  int
+	// latency and handling are moving averages of the time of a read and the time the application
+	// handles an event, in seconds.
+	latency    float64
+	handling   float64
+	returnedAt time.Time
+
+	// readable wakes up Next once events are buffered, and wakeup wakes up the prefetching once there is
+	// room in the buffer.
+	readable chan struct{}
+	wakeup   chan struct{}
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// NewConsumer starts prefetching events of the eventbus by the read policy, opts are applied to each read.
+func NewConsumer(bus api.Eventbus, policy api.ReadPolicy, cfg Config, opts ...api.ReadOption) *Consumer {
+	cfg.complete()
+	opts = append(opts, option.WithReadPolicy(policy))
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Consumer{
+		reader:   bus.Reader(opts...),
+		policy:   policy,
+		cfg:      cfg,
+		depth:    cfg.MinBatchSize,
+		readable: make(chan struct{}, 1),
+		wakeup:   make(chan struct{}, 1),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go c.run(ctx)
+	return c
+}
+
+// Next returns the next event, it waits until an event is read or ctx is done. A failed read is returned
+// once all events read before are consumed, the consumer keeps reading after it.
+func (c *Consumer) Next(ctx context.Context) (*ce.Event, error) {
+	c.mutex.Lock()
+	if !c.returnedAt.IsZero() {
+		// The time since the last event was returned is spent by the application handling it.
+		c.handling = average(c.handling, time.Since(c.returnedAt).Seconds())
+		c.returnedAt = time.Time{}
+		c.adapt()
+	}
+	c.mutex.Unlock()
+
+	for {
+		c.mutex.Lock()
+		if c.closed {
+			c.mutex.Unlock()
+			return nil, ErrClosed
+		}
+		if len(c.buffer) > 0 {
+			e := c.buffer[0]
+			c.buffer[0] = nil
+			c.buffer = c.buffer[1:]
+			c.returnedAt = time.Now()
+			c.mutex.Unlock()
+			notify(c.wakeup)
+			return e, nil
+		}
+		if err := c.err; err != nil {
+			c.err = nil
+			c.mutex.Unlock()
+			return nil, err
+		}
+		c.mutex.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.readable:
+		}
+	}
+}
+
+// Pause stops reading ahead, events already buffered can still be consumed.
+func (c *Consumer) Pause() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.paused = true
+}
+
+// Resume continues reading ahead after Pause.
+func (c *Consumer) Resume() {
+	c.mutex.Lock()
+	c.paused = false
+	c.mutex.Unlock()
+	notify(c.wakeup)
+}
+
+func (c *Consumer) Stats() Stats {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return Stats{Buffered: len(c.buffer), Depth: c.depth, Paused: c.paused}
+}
+
+// Close stops reading, events buffered are dropped, and the read policy isn't rewound to them.
+func (c *Consumer) Close() {
+	c.cancel()
+	<-c.done
+	c.mutex.Lock()
+	c.closed = true
+	c.buffer = nil
+	c.mutex.Unlock()
+	notify(c.readable)
+}
+
+func (c *Consumer) run(ctx context.Context) {
+	defer close(c.done)
+	for {
+		size, ok := c.nextReadSize()
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return
+			case <-c.wakeup:
+			}
+			continue
+		}
+
+		start := time.Now()
+		rctx, cancel := context.WithTimeout(ctx, c.cfg.ReadTimeout)
+		events, _, _, err := c.reader.Read(rctx, option.WithBatchSize(size))
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err == nil && len(events) > 0 {
+			c.policy.Forward(len(events))
+			c.push(events, time.Since(start))
+			continue
+		}
+
+		switch {
+		case stderrors.Is(err, context.DeadlineExceeded):
+			// No new event is written while polling, read again at once.
+			continue
+		case err == nil, errors.Is(err, errors.ErrOffsetOnEnd), errors.Is(err, errors.ErrTryAgain):
+			// An empty read returns at once, back off rather than spinning on it.
+		default:
+			log.Warning(ctx, "prefetch events failed", map[string]interface{}{
+				log.KeyError: err,
+			})
+			c.fail(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(c.cfg.RetryInterval):
+		}
+	}
+}
+
+// nextReadSize returns the number of events to read, it's false if the buffer is deep enough or the
+// consumer is paused.
+func (c *Consumer) nextReadSize() (int, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// Read once half of the depth is consumed, rather than after each event, to batch reads.
+	if c.paused || len(c.buffer) > c.depth/2 {
+		return 0, false
+	}
+	size := c.depth - len(c.buffer)
+	if size < c.cfg.MinBatchSize {
+		size = c.cfg.MinBatchSize
+	}
+	if size > c.cfg.MaxBatchSize {
+		size = c.cfg.MaxBatchSize
+	}
+	if room := c.cfg.MaxBufferedEvents - len(c.buffer); size > room {
+		size = room
+	}
+	return size, size > 0
+}
+
+func (c *Consumer) push(events []*ce.Event, latency time.Duration) {
+	c.mutex.Lock()
+	c.buffer = append(c.buffer, events...)
+	c.latency = average(c.latency, latency.Seconds())
+	c.adapt()
+	c.mutex.Unlock()
+	notify(c.readable)
+}
+
+func (c *Consumer) fail(err error) {
+	c.mutex.Lock()
+	c.err = err
+	c.mutex.Unlock()
+	notify(c.readable)
+}
+
+// adapt sets the depth to the events the application handles during a few reads, so that the buffer is
+// refilled before it's drained, but no more events are held than needed.
+// The depth is kept until both the read latency and the handling time are sampled.
+func (c *Consumer) adapt() {
+	if c.latency == 0 || c.handling == 0 {
+		return
+	}
+	depth := c.cfg.MaxBufferedEvents
+	if d := c.latency / c.handling * headroom; d < float64(depth) {
+		depth = int(math.Ceil(d))
+	}
+	if depth < c.cfg.MinBatchSize {
+		depth = c.cfg.MinBatchSize
+	}
+	c.depth = depth
+}
+
+func average(avg, sample float64) float64 {
+	if avg == 0 {
+		return sample
+	}
+	return avg*(1-smoothing) + sample*smoothing
+}
+
+func notify(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
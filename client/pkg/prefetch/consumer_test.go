@@ -0,0 +1,292 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prefetch
+
+import (
+	// standard libraries.
+	"context"
+	stderrors "errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	ce "github.com/cloudevents/sdk-go/v2"
+
+	// this project.
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+// fakeReader serves reads by read, which is given the number of the read and the batch size.
+type fakeReader struct {
+	mutex sync.Mutex
+	reads int
+	read  func(ctx context.Context, n, size int) ([]*ce.Event, error)
+}
+
+func (r *fakeReader) Read(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+	ro := &api.ReadOptions{}
+	ro.Apply(opts...)
+	r.mutex.Lock()
+	n := r.reads
+	r.reads++
+	r.mutex.Unlock()
+	events, err := r.read(ctx, n, ro.BatchSize)
+	return events, 0, 0, err
+}
+
+func (r *fakeReader) count() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.reads
+}
+
+type fakeBus struct {
+	api.Eventbus
+	reader api.BusReader
+}
+
+func (b *fakeBus) Reader(_ ...api.ReadOption) api.BusReader {
+	return b.reader
+}
+
+type fakePolicy struct {
+	api.ReadPolicy
+	mutex     sync.Mutex
+	forwarded int
+}
+
+func (p *fakePolicy) Forward(diff int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.forwarded += diff
+}
+
+// sequence returns a read serving events numbered from 0 on, up to limit in total.
+func sequence(limit int) func(context.Context, int, int) ([]*ce.Event, error) {
+	var mutex sync.Mutex
+	next := 0
+	return func(_ context.Context, _, size int) ([]*ce.Event, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if next >= limit {
+			return nil, errors.ErrOffsetOnEnd
+		}
+		var events []*ce.Event
+		for ; next < limit && len(events) < size; next++ {
+			events = append(events, newEvent(next))
+		}
+		return events, nil
+	}
+}
+
+func newEvent(i int) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID(strconv.Itoa(i))
+	return &e
+}
+
+func nextWithin(t *testing.T, c *Consumer, d time.Duration) (*ce.Event, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return c.Next(ctx)
+}
+
+func TestConsumer_Next(t *testing.T) {
+	const total = 100
+	reader := &fakeReader{read: sequence(total)}
+	policy := &fakePolicy{}
+	c := NewConsumer(&fakeBus{reader: reader}, policy, Config{RetryInterval: 10 * time.Millisecond})
+	defer c.Close()
+
+	for i := 0; i < total; i++ {
+		e, err := nextWithin(t, c, time.Second)
+		if err != nil {
+			t.Fatalf("Next() failed at %d: %v", i, err)
+		}
+		if e.ID() != strconv.Itoa(i) {
+			t.Fatalf("Next() = %s, want %d", e.ID(), i)
+		}
+	}
+	if _, err := nextWithin(t, c, 50*time.Millisecond); !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Next() at the end = %v, want deadline exceeded", err)
+	}
+	policy.mutex.Lock()
+	defer policy.mutex.Unlock()
+	if policy.forwarded != total {
+		t.Errorf("forwarded = %d, want %d", policy.forwarded, total)
+	}
+}
+
+func TestConsumer_EmptyReads(t *testing.T) {
+	reader := &fakeReader{read: func(context.Context, int, int) ([]*ce.Event, error) {
+		return nil, nil
+	}}
+	c := NewConsumer(&fakeBus{reader: reader}, &fakePolicy{}, Config{RetryInterval: 20 * time.Millisecond})
+	time.Sleep(200 * time.Millisecond)
+	c.Close()
+
+	// About one read each retry interval, rather than a read as fast as possible.
+	if n := reader.count(); n > 20 {
+		t.Errorf("reads = %d, empty reads aren't backed off", n)
+	}
+}
+
+func TestConsumer_ErrorOrdering(t *testing.T) {
+	events := sequence(4)
+	reader := &fakeReader{read: func(ctx context.Context, n, size int) ([]*ce.Event, error) {
+		if n == 1 {
+			return nil, errors.ErrInternal
+		}
+		// Two events are read before the failed read, and two after it.
+		return events(ctx, n, 2)
+	}}
+	c := NewConsumer(&fakeBus{reader: reader}, &fakePolicy{}, Config{RetryInterval: 10 * time.Millisecond})
+	defer c.Close()
+
+	want := []string{"0", "1", "error", "2", "3"}
+	for i, w := range want {
+		e, err := nextWithin(t, c, time.Second)
+		got := "error"
+		if err == nil {
+			got = e.ID()
+		} else if !errors.Is(err, errors.ErrInternal) {
+			t.Fatalf("Next() failed at %d: %v", i, err)
+		}
+		if got != w {
+			t.Fatalf("Next() at %d = %s, want %s", i, got, w)
+		}
+	}
+}
+
+func TestConsumer_PauseResume(t *testing.T) {
+	reader := &fakeReader{read: sequence(1000)}
+	c := NewConsumer(&fakeBus{reader: reader}, &fakePolicy{}, Config{
+		MinBatchSize: 4, RetryInterval: 10 * time.Millisecond,
+	})
+	defer c.Close()
+
+	c.Pause()
+	// Let a read in flight when paused finish.
+	time.Sleep(50 * time.Millisecond)
+	if !c.Stats().Paused {
+		t.Fatal("Stats().Paused = false after Pause")
+	}
+	reads := reader.count()
+	drained := 0
+	for {
+		if _, err := nextWithin(t, c, 50*time.Millisecond); err != nil {
+			if !stderrors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("Next() failed: %v", err)
+			}
+			break
+		}
+		drained++
+	}
+	if n := reader.count(); n != reads {
+		t.Fatalf("reads = %d while paused, want %d", n, reads)
+	}
+
+	c.Resume()
+	e, err := nextWithin(t, c, time.Second)
+	if err != nil {
+		t.Fatalf("Next() after Resume failed: %v", err)
+	}
+	if e.ID() != strconv.Itoa(drained) {
+		t.Errorf("Next() after Resume = %s, want %d", e.ID(), drained)
+	}
+}
+
+func TestConsumer_AdaptiveDepth(t *testing.T) {
+	cfg := Config{MaxBufferedEvents: 256, MinBatchSize: 4, MaxBatchSize: 64, RetryInterval: 10 * time.Millisecond}
+
+	t.Run("slow reads deepen the buffer", func(t *testing.T) {
+		events := sequence(1 << 20)
+		reader := &fakeReader{read: func(ctx context.Context, n, size int) ([]*ce.Event, error) {
+			time.Sleep(20 * time.Millisecond)
+			return events(ctx, n, size)
+		}}
+		c := NewConsumer(&fakeBus{reader: reader}, &fakePolicy{}, cfg)
+		defer c.Close()
+		for i := 0; i < 50; i++ {
+			if _, err := nextWithin(t, c, time.Second); err != nil {
+				t.Fatalf("Next() failed: %v", err)
+			}
+		}
+		if d := c.Stats().Depth; d <= cfg.MinBatchSize {
+			t.Errorf("Depth = %d, want more than %d", d, cfg.MinBatchSize)
+		}
+	})
+
+	t.Run("a slow handler keeps the buffer shallow", func(t *testing.T) {
+		reader := &fakeReader{read: sequence(1 << 20)}
+		c := NewConsumer(&fakeBus{reader: reader}, &fakePolicy{}, cfg)
+		defer c.Close()
+		for i := 0; i < 10; i++ {
+			if _, err := nextWithin(t, c, time.Second); err != nil {
+				t.Fatalf("Next() failed: %v", err)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		st := c.Stats()
+		if st.Depth != cfg.MinBatchSize {
+			t.Errorf("Depth = %d, want %d", st.Depth, cfg.MinBatchSize)
+		}
+		if st.Buffered > cfg.MaxBatchSize {
+			t.Errorf("Buffered = %d, want at most %d", st.Buffered, cfg.MaxBatchSize)
+		}
+	})
+}
+
+func TestConsumer_Close(t *testing.T) {
+	// The read blocks until its context is done, Close must not wait for new events.
+	reader := &fakeReader{read: func(ctx context.Context, _, _ int) ([]*ce.Event, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}}
+	c := NewConsumer(&fakeBus{reader: reader}, &fakePolicy{}, Config{ReadTimeout: time.Minute})
+
+	errC := make(chan error, 1)
+	go func() {
+		_, err := c.Next(context.Background())
+		errC <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	closed := make(chan struct{})
+	go func() {
+		c.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close() is blocked by the read")
+	}
+	select {
+	case err := <-errC:
+		if !stderrors.Is(err, ErrClosed) {
+			t.Errorf("blocked Next() = %v, want ErrClosed", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("blocked Next() isn't woken up by Close")
+	}
+	if _, err := c.Next(context.Background()); !stderrors.Is(err, ErrClosed) {
+		t.Errorf("Next() after Close = %v, want ErrClosed", err)
+	}
+}
@@ -27,8 +27,16 @@ import (
 	embedetcd "github.com/linkall-labs/embed-etcd"
 	"github.com/linkall-labs/vanus/internal/controller"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus"
+	"github.com/linkall-labs/vanus/internal/controller/featuregate"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/internal/controller/quota"
 	"github.com/linkall-labs/vanus/internal/controller/snowflake"
 	"github.com/linkall-labs/vanus/internal/controller/trigger"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
+	"github.com/linkall-labs/vanus/internal/primitive/interceptor/authinterceptor"
+	"github.com/linkall-labs/vanus/internal/primitive/interceptor/correlationinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/interceptor/errinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/interceptor/memberinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
@@ -58,6 +66,7 @@ func main() {
 		})
 		os.Exit(-1)
 	}
+	compression.Apply(cfg.GRPCCompression)
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Port))
 	if err != nil {
 		log.Error(context.Background(), "failed to listen", map[string]interface{}{
@@ -85,7 +94,9 @@ func main() {
 		os.Exit(-1)
 	}
 
-	segmentCtrl := eventbus.NewController(cfg.GetEventbusCtrlConfig(), etcd)
+	// usage is fed by both the eventbus and the trigger controller.
+	usageStore := usage.NewStore(cfg.Usage)
+	segmentCtrl := eventbus.NewController(cfg.GetEventbusCtrlConfig(), etcd, usageStore)
 	if err = segmentCtrl.Start(ctx); err != nil {
 		log.Error(ctx, "start EventbusService Controller failed", map[string]interface{}{
 			log.KeyError: err,
@@ -94,7 +105,7 @@ func main() {
 	}
 
 	//trigger controller
-	triggerCtrlStv := trigger.NewController(cfg.GetTriggerConfig(), etcd)
+	triggerCtrlStv := trigger.NewController(cfg.GetTriggerConfig(), etcd, usageStore)
 	if err = triggerCtrlStv.Start(); err != nil {
 		log.Error(ctx, "start trigger controller fail", map[string]interface{}{
 			log.KeyError: err,
@@ -110,6 +121,14 @@ func main() {
 		os.Exit(-2)
 	}
 
+	featureGateCtrl := featuregate.NewController(cfg.GetFeatureGateConfig())
+	if err = featureGateCtrl.Start(ctx); err != nil {
+		log.Error(ctx, "start feature gate controller failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-2)
+	}
+
 	recoveryOpt := recovery.WithRecoveryHandlerContext(
 		func(ctx context.Context, p interface{}) error {
 			log.Error(ctx, "goroutine panicked", map[string]interface{}{
@@ -120,17 +139,29 @@ func main() {
 		},
 	)
 
+	authProvider, err := auth.NewProvider(cfg.Auth)
+	if err != nil {
+		log.Error(ctx, "create auth provider failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		os.Exit(-1)
+	}
 	grpcServer := grpc.NewServer(
 		grpc.ChainStreamInterceptor(
 			errinterceptor.StreamServerInterceptor(),
+			correlationinterceptor.StreamServerInterceptor(),
 			recovery.StreamServerInterceptor(recoveryOpt),
 			memberinterceptor.StreamServerInterceptor(etcd),
+			authinterceptor.ControllerStreamServerInterceptor(authProvider, cfg.ForwardedAuth),
 			otelgrpc.StreamServerInterceptor(),
 		),
 		grpc.ChainUnaryInterceptor(
 			errinterceptor.UnaryServerInterceptor(),
+			correlationinterceptor.UnaryServerInterceptor(),
 			recovery.UnaryServerInterceptor(recoveryOpt),
 			memberinterceptor.UnaryServerInterceptor(etcd),
+			authinterceptor.ControllerUnaryServerInterceptor(authProvider, cfg.ForwardedAuth),
+			quota.UnaryServerInterceptor(quota.NewLimiter(cfg.Quota)),
 			otelgrpc.UnaryServerInterceptor(),
 		),
 	)
@@ -146,6 +177,9 @@ func main() {
 	ctrlpb.RegisterSegmentControllerServer(grpcServer, segmentCtrl)
 	ctrlpb.RegisterPingServerServer(grpcServer, segmentCtrl)
 	ctrlpb.RegisterTriggerControllerServer(grpcServer, triggerCtrlStv)
+	ctrlpb.RegisterJobControllerServer(grpcServer, job.NewServer(segmentCtrl.JobManager(), triggerCtrlStv.JobManager()))
+	ctrlpb.RegisterFeatureGateControllerServer(grpcServer, featureGateCtrl)
+	ctrlpb.RegisterUsageControllerServer(grpcServer, usage.NewServer(usageStore))
 	log.Info(ctx, "the grpc server ready to work", nil)
 	wg := sync.WaitGroup{}
 	wg.Add(1)
@@ -164,6 +198,7 @@ func main() {
 		snowflakeCtrl.Stop()
 		triggerCtrlStv.Stop(ctx)
 		segmentCtrl.Stop()
+		featureGateCtrl.Stop()
 		etcd.Stop(ctx)
 		grpcServer.GracefulStop()
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/gateway"
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/util/signal"
 )
 
@@ -51,7 +52,7 @@ func main() {
 	}
 	
 	cfg.Observability.T.ServerName = "Vanus Gateway"
-	_ = observability.Initialize(cfg.Observability, nil)
+	_ = observability.Initialize(cfg.Observability, metrics.RegisterGatewayMetrics)
 	log.Info(ctx, "Gateway has started", nil)
 	select {
 	case <-ctx.Done():
@@ -0,0 +1,362 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anomaly
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Metric string
+
+const (
+	// MetricPublishRate is the number of events appended to an eventbus per second.
+	MetricPublishRate Metric = "publish_rate"
+	// MetricDeliveryFailureRate is the number of events failed to deliver by a subscription per second.
+	MetricDeliveryFailureRate Metric = "delivery_failure_rate"
+)
+
+type Reason string
+
+const (
+	ReasonAboveMax Reason = "above_max"
+	ReasonSpike    Reason = "spike"
+	ReasonDrop     Reason = "drop"
+)
+
+const (
+	EventTypeDetected = "com.linkall.vanus.anomaly.detected"
+	EventTypeResolved = "com.linkall.vanus.anomaly.resolved"
+	eventSource       = "https://linkall.com/vanus"
+
+	defaultWindow = time.Minute
+	// warmupRates is the number of rates observed before the baseline is trusted.
+	warmupRates = 5
+	// baselineWeight is the weight of the latest rate in the moving average.
+	baselineWeight = 0.2
+	// idleWindows is the number of windows after which a series not observed is forgotten.
+	idleWindows = 10
+	queueSize   = 1024
+)
+
+type Threshold struct {
+	// Max is the upper bound of the rate, 0 means unbounded.
+	Max float64 `yaml:"max" json:"max,omitempty"`
+	// Deviation is the ratio of the rate to its baseline, above it or below its reciprocal is anomalous.
+	// It must be greater than 1 to take effect.
+	Deviation float64 `yaml:"deviation" json:"deviation,omitempty"`
+}
+
+type Config struct {
+	// Enable emits anomaly events to the system eventbus __anomaly_eb, rates are computed anyway.
+	Enable              bool          `yaml:"enable"`
+	Window              time.Duration `yaml:"window"`
+	PublishRate         Threshold     `yaml:"publish_rate"`
+	DeliveryFailureRate Threshold     `yaml:"delivery_failure_rate"`
+}
+
+func (c Config) threshold(metric Metric) Threshold {
+	switch metric {
+	case MetricPublishRate:
+		return c.PublishRate
+	case MetricDeliveryFailureRate:
+		return c.DeliveryFailureRate
+	}
+	return Threshold{}
+}
+
+// Anomaly is the data of anomaly events.
+type Anomaly struct {
+	Metric Metric `json:"metric"`
+	// Resource is the name of the eventbus or the ID of the subscription.
+	Resource  string    `json:"resource"`
+	Reason    Reason    `json:"reason"`
+	Rate      float64   `json:"rate"`
+	Baseline  float64   `json:"baseline"`
+	Threshold Threshold `json:"threshold"`
+	Resolved  bool      `json:"resolved"`
+	Time      time.Time `json:"time"`
+}
+
+type seriesKey struct {
+	metric   Metric
+	resource string
+}
+
+type series struct {
+	rate      rollingRate
+	baseline  float64
+	observed  int
+	anomalous Reason
+}
+
+// check returns why the rate is anomalous, or an empty reason.
+func (s *series) check(th Threshold, rate float64) Reason {
+	if th.Max > 0 && rate > th.Max {
+		return ReasonAboveMax
+	}
+	if th.Deviation <= 1 || s.observed < warmupRates || s.baseline <= 0 {
+		return ""
+	}
+	if rate > s.baseline*th.Deviation {
+		return ReasonSpike
+	}
+	if rate < s.baseline/th.Deviation {
+		return ReasonDrop
+	}
+	return ""
+}
+
+// update moves the baseline towards the rate, so a lasting change of the rate is resolved eventually.
+func (s *series) update(rate float64) {
+	if s.observed == 0 {
+		s.baseline = rate
+	} else {
+		s.baseline += baselineWeight * (rate - s.baseline)
+	}
+	s.observed++
+}
+
+type writeFunc func(ctx context.Context, events []*ce.Event) error
+
+// Detector computes rolling rates of cumulative counters reported to the controller, and emits anomaly
+// events when they deviate beyond thresholds.
+type Detector struct {
+	cfg   Config
+	write writeFunc
+
+	series    map[seriesKey]*series
+	lastSwept time.Time
+	mutex     sync.Mutex
+
+	queue  chan *Anomaly
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDetector(cfg Config, ctrlAddrs []string) *Detector {
+	w := &busWriter{
+		cl:     cluster.NewClusterController(ctrlAddrs, insecure.NewCredentials()),
+		client: eb.Connect(ctrlAddrs),
+	}
+	return newDetector(cfg, w.write)
+}
+
+func newDetector(cfg Config, write writeFunc) *Detector {
+	if cfg.Window <= 0 {
+		cfg.Window = defaultWindow
+	}
+	return &Detector{
+		cfg:    cfg,
+		write:  write,
+		series: make(map[seriesKey]*series),
+		queue:  make(chan *Anomaly, queueSize),
+		cancel: func() {},
+	}
+}
+
+// Observe records the counter of the resource, and returns its rate per second over the window. ok is false
+// until the counter has been observed twice.
+func (d *Detector) Observe(ctx context.Context, metric Metric, resource string, total uint64,
+	at time.Time) (float64, bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.sweep(at)
+	k := seriesKey{metric: metric, resource: resource}
+	s, exist := d.series[k]
+	if !exist {
+		s = &series{rate: rollingRate{window: d.cfg.Window}}
+		d.series[k] = s
+	}
+	s.rate.observe(total, at)
+	rate, ok := s.rate.rate()
+	if !ok {
+		return 0, false
+	}
+	if g := gaugeOf(metric); g != nil {
+		g.WithLabelValues(resource).Set(rate)
+	}
+
+	th := d.cfg.threshold(metric)
+	reason := s.check(th, rate)
+	if reason != s.anomalous {
+		newAnomaly := func(reason Reason, resolved bool) *Anomaly {
+			return &Anomaly{
+				Metric:    metric,
+				Resource:  resource,
+				Reason:    reason,
+				Rate:      rate,
+				Baseline:  s.baseline,
+				Threshold: th,
+				Resolved:  resolved,
+				Time:      at,
+			}
+		}
+		// A change of the reason, e.g. a spike followed by a drop, resolves the previous anomaly and reports
+		// the new one.
+		if s.anomalous != "" {
+			d.emit(ctx, newAnomaly(s.anomalous, true))
+		}
+		if reason != "" {
+			d.emit(ctx, newAnomaly(reason, false))
+		}
+		s.anomalous = reason
+	}
+	s.update(rate)
+	return rate, true
+}
+
+// sweep forgets series which haven't been observed for a while, e.g. the subscription was deleted.
+func (d *Detector) sweep(now time.Time) {
+	if now.Sub(d.lastSwept) < d.cfg.Window {
+		return
+	}
+	d.lastSwept = now
+	for k, s := range d.series {
+		if now.Sub(s.rate.lastSeen()) > idleWindows*d.cfg.Window {
+			delete(d.series, k)
+			if g := gaugeOf(k.metric); g != nil {
+				g.DeleteLabelValues(k.resource)
+			}
+		}
+	}
+}
+
+func (d *Detector) emit(ctx context.Context, a *Anomaly) {
+	log.Info(ctx, "the rate is anomalous", map[string]interface{}{
+		"metric":   a.Metric,
+		"resource": a.Resource,
+		"reason":   a.Reason,
+		"rate":     a.Rate,
+		"baseline": a.Baseline,
+		"resolved": a.Resolved,
+	})
+	if !d.cfg.Enable {
+		return
+	}
+	select {
+	case d.queue <- a:
+	default:
+		log.Warning(ctx, "the queue of anomaly events is full, drop it", map[string]interface{}{
+			"metric":   a.Metric,
+			"resource": a.Resource,
+		})
+	}
+}
+
+// Start writes anomaly events in background until Stop is called.
+func (d *Detector) Start(ctx context.Context) {
+	if !d.cfg.Enable {
+		return
+	}
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case a := <-d.queue:
+				d.flush(ctx, a)
+			}
+		}
+	}()
+}
+
+func (d *Detector) Stop() {
+	d.cancel()
+	d.wg.Wait()
+}
+
+// flush writes a and anomalies queued behind it, they are dropped if failed since a stale anomaly is
+// misleading.
+func (d *Detector) flush(ctx context.Context, a *Anomaly) {
+	events := []*ce.Event{toEvent(a)}
+	for drained := false; !drained && len(events) < queueSize; {
+		select {
+		case next := <-d.queue:
+			events = append(events, toEvent(next))
+		default:
+			drained = true
+		}
+	}
+	if err := d.write(ctx, events); err != nil {
+		log.Warning(ctx, "failed to write anomaly events", map[string]interface{}{
+			log.KeyError: err,
+			"count":      len(events),
+		})
+		return
+	}
+	for _, e := range events {
+		metrics.AnomalyEventCounter.WithLabelValues(e.Type()).Inc()
+	}
+}
+
+func toEvent(a *Anomaly) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID(uuid.NewString())
+	if a.Resolved {
+		e.SetType(EventTypeResolved)
+	} else {
+		e.SetType(EventTypeDetected)
+	}
+	e.SetSource(eventSource)
+	e.SetSubject(a.Resource)
+	e.SetTime(a.Time)
+	_ = e.SetData(ce.ApplicationJSON, a)
+	return &e
+}
+
+func gaugeOf(metric Metric) *prometheus.GaugeVec {
+	switch metric {
+	case MetricPublishRate:
+		return metrics.EventbusPublishRateGauge
+	case MetricDeliveryFailureRate:
+		return metrics.SubscriptionDeliveryFailureRateGauge
+	}
+	return nil
+}
+
+type busWriter struct {
+	cl     cluster.Cluster
+	client eb.Client
+	writer api.BusWriter
+}
+
+func (w *busWriter) write(ctx context.Context, events []*ce.Event) error {
+	if w.writer == nil {
+		if err := w.cl.EventbusService().CreateSystemEventbusIfNotExist(ctx, primitive.AnomalyEventbusName,
+			"System Eventbus For Anomaly Events"); err != nil {
+			return err
+		}
+		w.writer = w.client.Eventbus(ctx, primitive.AnomalyEventbusName).Writer()
+	}
+	_, err := w.writer.AppendMany(ctx, events)
+	return err
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anomaly
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRollingRate(t *testing.T) {
+	Convey("test rolling rate", t, func() {
+		r := rollingRate{window: time.Minute}
+		now := time.Now()
+
+		r.observe(100, now)
+		_, ok := r.rate()
+		So(ok, ShouldBeFalse)
+
+		r.observe(400, now.Add(30*time.Second))
+		rate, ok := r.rate()
+		So(ok, ShouldBeTrue)
+		So(rate, ShouldEqual, 10)
+
+		Convey("slide the window", func() {
+			r.observe(1000, now.Add(60*time.Second))
+			r.observe(1300, now.Add(90*time.Second))
+			So(r.samples, ShouldHaveLength, 3)
+			rate, _ = r.rate()
+			So(rate, ShouldEqual, 15)
+		})
+
+		Convey("reset by the counter going backwards", func() {
+			r.observe(10, now.Add(60*time.Second))
+			_, ok = r.rate()
+			So(ok, ShouldBeFalse)
+			r.observe(70, now.Add(90*time.Second))
+			rate, _ = r.rate()
+			So(rate, ShouldEqual, 2)
+		})
+	})
+}
+
+func TestDetector_Observe(t *testing.T) {
+	Convey("test detector observe", t, func() {
+		ctx := context.Background()
+		d := newDetector(Config{
+			Enable:              true,
+			Window:              10 * time.Second,
+			PublishRate:         Threshold{Deviation: 3},
+			DeliveryFailureRate: Threshold{Max: 5},
+		}, func(ctx context.Context, events []*ce.Event) error {
+			return nil
+		})
+		now := time.Now()
+
+		drain := func() []*Anomaly {
+			var result []*Anomaly
+			for {
+				select {
+				case a := <-d.queue:
+					result = append(result, a)
+				default:
+					return result
+				}
+			}
+		}
+
+		Convey("above max", func() {
+			var total uint64
+			for i := 0; i < 3; i++ {
+				total += 10
+				d.Observe(ctx, MetricDeliveryFailureRate, "sub", total, now.Add(time.Duration(i)*10*time.Second))
+			}
+			So(drain(), ShouldBeEmpty)
+
+			for i := 3; i < 5; i++ {
+				total += 100
+				rate, ok := d.Observe(ctx, MetricDeliveryFailureRate, "sub", total,
+					now.Add(time.Duration(i)*10*time.Second))
+				So(ok, ShouldBeTrue)
+				So(rate, ShouldEqual, 10)
+			}
+			anomalies := drain()
+			So(anomalies, ShouldHaveLength, 1)
+			So(anomalies[0].Reason, ShouldEqual, ReasonAboveMax)
+			So(anomalies[0].Resolved, ShouldBeFalse)
+
+			total += 10
+			d.Observe(ctx, MetricDeliveryFailureRate, "sub", total, now.Add(50*time.Second))
+			anomalies = drain()
+			So(anomalies, ShouldHaveLength, 1)
+			So(anomalies[0].Reason, ShouldEqual, ReasonAboveMax)
+			So(anomalies[0].Resolved, ShouldBeTrue)
+		})
+
+		Convey("deviate from baseline", func() {
+			var total uint64
+			at := now
+			observe := func(delta uint64) {
+				total += delta
+				at = at.Add(10 * time.Second)
+				d.Observe(ctx, MetricPublishRate, "bus", total, at)
+			}
+			for i := 0; i < warmupRates+1; i++ {
+				observe(1000)
+			}
+			So(drain(), ShouldBeEmpty)
+
+			observe(10000)
+			anomalies := drain()
+			So(anomalies, ShouldHaveLength, 1)
+			So(anomalies[0].Reason, ShouldEqual, ReasonSpike)
+			So(anomalies[0].Baseline, ShouldEqual, 100)
+
+			observe(1000)
+			anomalies = drain()
+			So(anomalies, ShouldHaveLength, 1)
+			So(anomalies[0].Resolved, ShouldBeTrue)
+
+			observe(0)
+			anomalies = drain()
+			So(anomalies, ShouldHaveLength, 1)
+			So(anomalies[0].Reason, ShouldEqual, ReasonDrop)
+		})
+
+		Convey("spike followed by drop", func() {
+			var total uint64
+			at := now
+			observe := func(delta uint64) {
+				total += delta
+				at = at.Add(10 * time.Second)
+				d.Observe(ctx, MetricPublishRate, "bus", total, at)
+			}
+			for i := 0; i < warmupRates+1; i++ {
+				observe(1000)
+			}
+			observe(10000)
+			anomalies := drain()
+			So(anomalies, ShouldHaveLength, 1)
+			So(anomalies[0].Reason, ShouldEqual, ReasonSpike)
+
+			observe(0)
+			anomalies = drain()
+			So(anomalies, ShouldHaveLength, 2)
+			So(anomalies[0].Reason, ShouldEqual, ReasonSpike)
+			So(anomalies[0].Resolved, ShouldBeTrue)
+			So(anomalies[1].Reason, ShouldEqual, ReasonDrop)
+			So(anomalies[1].Resolved, ShouldBeFalse)
+		})
+
+		Convey("forget idle series", func() {
+			d.Observe(ctx, MetricPublishRate, "bus", 0, now)
+			d.Observe(ctx, MetricPublishRate, "other", 0, now)
+			So(d.series, ShouldHaveLength, 2)
+			d.Observe(ctx, MetricPublishRate, "other", 0, now.Add(idleWindows*20*time.Second))
+			So(d.series, ShouldHaveLength, 1)
+		})
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anomaly
+
+import (
+	"time"
+)
+
+type sample struct {
+	total uint64
+	at    time.Time
+}
+
+// rollingRate computes the rate per second of a cumulative counter over a sliding window.
+type rollingRate struct {
+	window  time.Duration
+	samples []sample
+}
+
+// observe adds a sample of the counter. A counter going backwards has been reset, e.g. the reporter
+// restarted, so the samples before it are dropped.
+func (r *rollingRate) observe(total uint64, at time.Time) {
+	if n := len(r.samples); n > 0 {
+		last := r.samples[n-1]
+		if !at.After(last.at) {
+			return
+		}
+		if total < last.total {
+			r.samples = r.samples[:0]
+		}
+	}
+	r.samples = append(r.samples, sample{total: total, at: at})
+
+	// Keep the newest sample out of the window, so that the rate covers the whole window.
+	i := 0
+	for i < len(r.samples)-2 && at.Sub(r.samples[i+1].at) >= r.window {
+		i++
+	}
+	if i > 0 {
+		r.samples = append(r.samples[:0], r.samples[i:]...)
+	}
+}
+
+// rate returns the rate per second over the window, ok is false until there are two samples.
+func (r *rollingRate) rate() (float64, bool) {
+	if len(r.samples) < 2 {
+		return 0, false
+	}
+	first, last := r.samples[0], r.samples[len(r.samples)-1]
+	return float64(last.total-first.total) / last.at.Sub(first.at).Seconds(), true
+}
+
+func (r *rollingRate) lastSeen() time.Time {
+	if len(r.samples) == 0 {
+		return time.Time{}
+	}
+	return r.samples[len(r.samples)-1].at
+}
@@ -15,13 +15,29 @@
 package controller
 
 import (
+	"fmt"
 	"path/filepath"
 
 	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller/anomaly"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/block"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/stats"
+	"github.com/linkall-labs/vanus/internal/controller/featuregate"
+	"github.com/linkall-labs/vanus/internal/controller/importer"
+	"github.com/linkall-labs/vanus/internal/controller/importer/kafka"
+	"github.com/linkall-labs/vanus/internal/controller/ownership"
+	"github.com/linkall-labs/vanus/internal/controller/quota"
+	"github.com/linkall-labs/vanus/internal/controller/slo"
 	"github.com/linkall-labs/vanus/internal/controller/snowflake"
 	"github.com/linkall-labs/vanus/internal/controller/trigger"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/namespace"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
 	"github.com/linkall-labs/vanus/observability"
 )
 
@@ -39,7 +55,40 @@ type Config struct {
 	Replicas             uint                 `yaml:"replicas"`
 	SecretEncryptionSalt string               `yaml:"secret_encryption_salt"`
 	SegmentCapacity      int64                `yaml:"segment_capacity"`
+	BlockAllocation      block.SelectorConfig `yaml:"block_allocation"`
 	Observability        observability.Config `yaml:"observability"`
+	Anomaly              anomaly.Config       `yaml:"anomaly"`
+	BlockStats           stats.Config         `yaml:"block_stats"`
+	// ImportCredentials are credentials of NATS servers and RabbitMQ brokers, imports refer to them by names
+	// so that secrets aren't sent through APIs or stored in jobs.
+	ImportCredentials map[string]importer.Credential `yaml:"import_credentials"`
+	// WriteLease tunes leases which appendable blocks accept appends with, a longer TTL tolerates longer
+	// outages of controllers, but blocks of sealed segments accept appends longer too.
+	WriteLease eventlog.WriteLeaseConfig `yaml:"write_lease"`
+	// trigger workers above the thresholds don't accept new subscriptions.
+	TriggerWorkerAdmission worker.AdmissionConfig `yaml:"trigger_worker_admission"`
+	// Quota throttles admin API requests of each principal, e.g. runaway automation loops.
+	Quota quota.Config `yaml:"quota"`
+	// ForwardedAuth verifies principals forwarded by gateways, requests are served as anonymous ones if
+	// it's disabled, no matter which principals they claim.
+	ForwardedAuth auth.ForwardConfig `yaml:"forwarded_auth"`
+	// Auth authenticates tokens sent to controllers directly, e.g. by vsctl, requests of other components
+	// carry no token and are served as anonymous ones. Forward of it is ignored, see ForwardedAuth.
+	Auth auth.Config `yaml:"auth"`
+	// Ownership protects eventbuses and subscriptions from being modified by principals other than owners.
+	Ownership ownership.Config `yaml:"ownership"`
+	// NamespaceQuota limits subscriptions, delivery rates and transformers of each namespace.
+	NamespaceQuota namespace.Config `yaml:"namespace_quota"`
+	// SLO tracks publish and delivery latency objectives and alerts when their error budgets burn too fast.
+	SLO slo.Config `yaml:"slo"`
+	// Usage keeps the history which eventbuses and subscriptions are ranked by in top-N reports.
+	Usage usage.Config `yaml:"usage"`
+	// GRPCCompression compresses requests to other controllers, stores and trigger workers, only control
+	// traffic is initiated by controllers.
+	GRPCCompression compression.Config `yaml:"grpc_compression"`
+	// KafkaCredentials are SASL credentials of Kafka clusters, imports refer to them by names so that
+	// secrets aren't sent through APIs or stored in jobs.
+	KafkaCredentials map[string]kafka.SASLConfig `yaml:"kafka_credentials"`
 }
 
 func (c *Config) GetEtcdConfig() embedetcd.Config {
@@ -50,13 +99,29 @@ func (c *Config) GetEtcdConfig() embedetcd.Config {
 
 func (c *Config) GetEventbusCtrlConfig() eventbus.Config {
 	return eventbus.Config{
-		IP:               c.IP,
-		Port:             c.Port,
-		KVStoreEndpoints: c.EtcdEndpoints,
-		KVKeyPrefix:      c.MetadataConfig.KeyPrefix,
-		Replicas:         c.Replicas,
-		Topology:         c.Topology,
-		SegmentCapacity:  c.SegmentCapacity,
+		IP:                c.IP,
+		Port:              c.Port,
+		KVStoreEndpoints:  c.EtcdEndpoints,
+		KVKeyPrefix:       c.MetadataConfig.KeyPrefix,
+		Replicas:          c.Replicas,
+		Topology:          c.Topology,
+		SegmentCapacity:   c.SegmentCapacity,
+		BlockAllocation:   c.BlockAllocation,
+		ControllerAddr:    c.GetControllerAddrs(),
+		Anomaly:           c.Anomaly,
+		BlockStats:        c.BlockStats,
+		ImportCredentials: c.ImportCredentials,
+		Ownership:         c.Ownership,
+		SLO:               c.SLO,
+		WriteLease:        c.WriteLease,
+		KafkaCredentials:  c.KafkaCredentials,
+	}
+}
+
+func (c *Config) GetFeatureGateConfig() featuregate.Config {
+	return featuregate.Config{
+		KVEndpoints: c.EtcdEndpoints,
+		KVPrefix:    c.MetadataConfig.KeyPrefix,
 	}
 }
 
@@ -87,6 +152,11 @@ func (c *Config) GetTriggerConfig() trigger.Config {
 		},
 		SecretEncryptionSalt: c.SecretEncryptionSalt,
 		ControllerAddr:       c.GetControllerAddrs(),
+		Anomaly:              c.Anomaly,
+		WorkerAdmission:      c.TriggerWorkerAdmission,
+		Ownership:            c.Ownership,
+		Namespace:            c.NamespaceQuota,
+		SLO:                  c.SLO,
 	}
 }
 
@@ -96,5 +166,24 @@ func InitConfig(filename string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = c.BlockAllocation.Validate(); err != nil {
+		return nil, err
+	}
+	for name, cred := range c.ImportCredentials {
+		if err = cred.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid import credential %s: %w", name, err)
+		}
+	}
+	if err = c.WriteLease.Validate(); err != nil {
+		return nil, err
+	}
+	if err = c.GRPCCompression.Validate(); err != nil {
+		return nil, err
+	}
+	for name, cred := range c.KafkaCredentials {
+		if err = cred.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid kafka credential %s: %w", name, err)
+		}
+	}
 	return c, nil
 }
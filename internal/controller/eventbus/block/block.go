@@ -29,6 +29,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 )
 
 const (
@@ -39,26 +40,33 @@ const (
 
 type Allocator interface {
 	Run(ctx context.Context, kvCli kv.Client, dynamicAllocate bool) error
-	Pick(ctx context.Context, num int) ([]*metadata.Block, error)
-	PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*metadata.Block, error)
+	// Pick picks blocks of mode, blocks in memory are always created, instead of being taken from buffers.
+	Pick(ctx context.Context, num int, eventbus string, mode metapb.StorageMode) ([]*metadata.Block, error)
+	PickByVolumes(ctx context.Context, volumes []vanus.ID, mode metapb.StorageMode) ([]*metadata.Block, error)
 	Stop()
 }
 
-func NewAllocator(defaultBlockCapacity int64, selector VolumeSelector) Allocator {
+// NewAllocator creates an Allocator which selects volumes by #{selector}, #{eventbusSelectors} overrides
+// #{selector} for the eventbus in it.
+func NewAllocator(defaultBlockCapacity int64, selector VolumeSelector,
+	eventbusSelectors map[string]VolumeSelector) Allocator {
 	if defaultBlockCapacity <= 0 {
 		defaultBlockCapacity = defaultBlockSize
 	} else if defaultBlockCapacity < minimumBlockSize {
 		defaultBlockCapacity = minimumBlockSize
 	}
 	return &allocator{
-		blockCapacity:  defaultBlockCapacity,
-		selector:       selector,
-		allocateTicker: time.NewTicker(time.Second),
+		blockCapacity:     defaultBlockCapacity,
+		selector:          selector,
+		eventbusSelectors: eventbusSelectors,
+		allocateTicker:    time.NewTicker(time.Second),
 	}
 }
 
 type allocator struct {
 	selector VolumeSelector
+	// key: eventbus name, value: VolumeSelector
+	eventbusSelectors map[string]VolumeSelector
 	// key: volumeID, value: SkipList of *metadata.Block
 	volumeBlockBuffer sync.Map
 	kvClient          kv.Client
@@ -69,7 +77,9 @@ type allocator struct {
 	blockCapacity     int64
 }
 
-func (al *allocator) PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*metadata.Block, error) {
+func (al *allocator) PickByVolumes(
+	ctx context.Context, volumes []vanus.ID, mode metapb.StorageMode,
+) ([]*metadata.Block, error) {
 	instances := make([]server.Instance, len(volumes))
 	for idx := range volumes {
 		i := al.selector.SelectByID(volumes[idx])
@@ -78,7 +88,7 @@ func (al *allocator) PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*
 		}
 		instances[idx] = i
 	}
-	return al.pick(ctx, instances)
+	return al.pick(ctx, instances, mode)
 }
 
 func (al *allocator) Run(ctx context.Context, kvCli kv.Client, startDynamicAllocate bool) error {
@@ -111,18 +121,26 @@ func (al *allocator) Run(ctx context.Context, kvCli kv.Client, startDynamicAlloc
 	return nil
 }
 
-func (al *allocator) Pick(ctx context.Context, num int) ([]*metadata.Block, error) {
+func (al *allocator) Pick(
+	ctx context.Context, num int, eventbus string, mode metapb.StorageMode,
+) ([]*metadata.Block, error) {
 	al.mutex.Lock()
 	defer al.mutex.Unlock()
-	instances := al.selector.Select(num, al.blockCapacity)
+	selector := al.selector
+	if s, exist := al.eventbusSelectors[eventbus]; exist {
+		selector = s
+	}
+	instances := selector.Select(num, al.blockCapacity)
 	if len(instances) == 0 {
 		return nil, errors.ErrVolumeInstanceNotFound
 	}
 
-	return al.pick(ctx, instances)
+	return al.pick(ctx, instances, mode)
 }
 
-func (al *allocator) pick(ctx context.Context, volumes []server.Instance) ([]*metadata.Block, error) {
+func (al *allocator) pick(
+	ctx context.Context, volumes []server.Instance, mode metapb.StorageMode,
+) ([]*metadata.Block, error) {
 	blockArr := make([]*metadata.Block, len(volumes))
 	for idx := range volumes {
 		var skipList *skiplist.SkipList
@@ -134,8 +152,8 @@ func (al *allocator) pick(ctx context.Context, volumes []server.Instance) ([]*me
 			skipList, _ = v.(*skiplist.SkipList)
 		}
 
-		if !exist || skipList.Len() == 0 {
-			block, err = ins.CreateBlock(ctx, al.blockCapacity)
+		if !exist || skipList.Len() == 0 || mode != metapb.StorageMode_STORAGE_DURABLE {
+			block, err = ins.CreateBlock(ctx, al.blockCapacity, mode)
 			if err != nil {
 				return nil, err
 			}
@@ -176,7 +194,7 @@ func (al *allocator) dynamicAllocateBlockTask(ctx context.Context) {
 				}
 				skipList, _ = v.(*skiplist.SkipList)
 				for skipList.Len() < defaultBlockBufferSizePerVolume {
-					block, err := instance.CreateBlock(ctx, al.blockCapacity)
+					block, err := instance.CreateBlock(ctx, al.blockCapacity, metapb.StorageMode_STORAGE_DURABLE)
 					if err != nil {
 						log.Warning(ctx, "create block failed", map[string]interface{}{
 							"volume_id":   instance.GetMeta().ID,
@@ -27,6 +27,8 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	. "github.com/smartystreets/goconvey/convey"
@@ -40,16 +42,45 @@ func TestAllocator_Pick(t *testing.T) {
 		alloc.kvClient = kvMock
 		kvMock.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 		Convey("get 1 block", func() {
-			blocks, err := alloc.Pick(stdCtx.Background(), 1)
+			blocks, err := alloc.Pick(stdCtx.Background(), 1, "", metapb.StorageMode_STORAGE_DURABLE)
 			So(err, ShouldBeNil)
 			So(blocks, ShouldHaveLength, 1)
 		})
 
 		Convey("get 3 blocks", func() {
-			blocks, err := alloc.Pick(stdCtx.Background(), 3)
+			blocks, err := alloc.Pick(stdCtx.Background(), 3, "", metapb.StorageMode_STORAGE_DURABLE)
 			So(err, ShouldBeNil)
 			So(blocks, ShouldHaveLength, 3)
 		})
+
+		Convey("get blocks with selector of eventbus", func() {
+			alloc.eventbusSelectors = map[string]VolumeSelector{
+				"test": NewVolumeRoundRobin(func() []server.Instance {
+					return nil
+				}),
+			}
+			_, err := alloc.Pick(stdCtx.Background(), 1, "test", metapb.StorageMode_STORAGE_DURABLE)
+			So(err, ShouldEqual, errors.ErrVolumeInstanceNotFound)
+			blocks, err := alloc.Pick(stdCtx.Background(), 1, "other", metapb.StorageMode_STORAGE_DURABLE)
+			So(err, ShouldBeNil)
+			So(blocks, ShouldHaveLength, 1)
+		})
+
+		Convey("get blocks in memory", func() {
+			buffered := &metadata.Block{ID: vanus.NewTestID(), Capacity: defaultBlockSize}
+			for i := uint64(1); i <= 3; i++ {
+				l := skiplist.New(skiplist.String)
+				l.Set(buffered.ID.Key(), buffered)
+				alloc.volumeBlockBuffer.Store(vanus.NewIDFromUint64(i).Key(), l)
+			}
+			blocks, err := alloc.Pick(stdCtx.Background(), 3, "", metapb.StorageMode_STORAGE_MEMORY)
+			So(err, ShouldBeNil)
+			So(blocks, ShouldHaveLength, 3)
+			for _, b := range blocks {
+				So(b.ID, ShouldNotEqual, buffered.ID)
+				So(b.StorageMode, ShouldEqual, metapb.StorageMode_STORAGE_MEMORY)
+			}
+		})
 	})
 }
 
@@ -169,8 +200,8 @@ func TestAllocator_RunWithDynamic(t *testing.T) {
 			Capacity: 64 * 1024 * 1024,
 		})
 		srv1.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-		srv1.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-			size int64) (*metadata.Block, error) {
+		srv1.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
+			size int64, mode metapb.StorageMode) (*metadata.Block, error) {
 			return &metadata.Block{
 				ID:       vanus.NewTestID(),
 				Capacity: size,
@@ -184,8 +215,8 @@ func TestAllocator_RunWithDynamic(t *testing.T) {
 			Capacity: 64 * 1024 * 1024,
 		})
 		srv2.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-		srv2.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-			size int64) (*metadata.Block, error) {
+		srv2.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
+			size int64, mode metapb.StorageMode) (*metadata.Block, error) {
 			return &metadata.Block{
 				ID:       vanus.NewTestID(),
 				Capacity: size,
@@ -199,8 +230,8 @@ func TestAllocator_RunWithDynamic(t *testing.T) {
 			Capacity: 64 * 1024 * 1024,
 		})
 		srv3.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-		srv3.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-			size int64) (*metadata.Block, error) {
+		srv3.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
+			size int64, mode metapb.StorageMode) (*metadata.Block, error) {
 			return &metadata.Block{
 				ID:       vanus.NewTestID(),
 				Capacity: size,
@@ -214,7 +245,7 @@ func TestAllocator_RunWithDynamic(t *testing.T) {
 			mutex.Lock()
 			defer mutex.Unlock()
 			return instanceList
-		})).(*allocator)
+		}), nil).(*allocator)
 		kvMock := kv.NewMockClient(ctrl)
 		alloc.kvClient = kvMock
 
@@ -278,12 +309,13 @@ func getAllocator(ctrl *gomock.Controller) *allocator {
 		Capacity: 64 * 1024 * 1024,
 	})
 	srv1.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-	srv1.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-		size int64) (*metadata.Block, error) {
+	srv1.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
+		size int64, mode metapb.StorageMode) (*metadata.Block, error) {
 		return &metadata.Block{
-			ID:       vanus.NewTestID(),
-			Capacity: size,
-			VolumeID: vanus.NewIDFromUint64(1),
+			ID:          vanus.NewTestID(),
+			Capacity:    size,
+			VolumeID:    vanus.NewIDFromUint64(1),
+			StorageMode: mode,
 		}, nil
 	})
 
@@ -293,12 +325,13 @@ func getAllocator(ctrl *gomock.Controller) *allocator {
 		Capacity: 64 * 1024 * 1024,
 	})
 	srv2.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-	srv2.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-		size int64) (*metadata.Block, error) {
+	srv2.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
+		size int64, mode metapb.StorageMode) (*metadata.Block, error) {
 		return &metadata.Block{
-			ID:       vanus.NewTestID(),
-			Capacity: size,
-			VolumeID: vanus.NewIDFromUint64(2),
+			ID:          vanus.NewTestID(),
+			Capacity:    size,
+			VolumeID:    vanus.NewIDFromUint64(2),
+			StorageMode: mode,
 		}, nil
 	})
 
@@ -308,17 +341,18 @@ func getAllocator(ctrl *gomock.Controller) *allocator {
 		Capacity: 64 * 1024 * 1024,
 	})
 	srv3.EXPECT().ID().AnyTimes().Return(vanus.NewIDFromUint64(uint64(time.Now().UnixNano())))
-	srv3.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
-		size int64) (*metadata.Block, error) {
+	srv3.EXPECT().CreateBlock(gomock.Any(), defaultBlockSize, gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context,
+		size int64, mode metapb.StorageMode) (*metadata.Block, error) {
 		return &metadata.Block{
-			ID:       vanus.NewTestID(),
-			Capacity: size,
-			VolumeID: vanus.NewIDFromUint64(3),
+			ID:          vanus.NewTestID(),
+			Capacity:    size,
+			VolumeID:    vanus.NewIDFromUint64(3),
+			StorageMode: mode,
 		}, nil
 	})
 
 	alloc := NewAllocator(0, NewVolumeRoundRobin(func() []server.Instance {
 		return []server.Instance{srv1, srv2, srv3}
-	}))
+	}), nil)
 	return alloc.(*allocator)
 }
@@ -12,6 +12,7 @@ import (
 	metadata "github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	kv "github.com/linkall-labs/vanus/internal/kv"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
 )
 
 // MockAllocator is a mock of Allocator interface.
@@ -38,33 +39,33 @@ func (m *MockAllocator) EXPECT() *MockAllocatorMockRecorder {
 }
 
 // Pick mocks base method.
-func (m *MockAllocator) Pick(ctx context.Context, num int) ([]*metadata.Block, error) {
+func (m *MockAllocator) Pick(ctx context.Context, num int, eventbus string, mode meta.StorageMode) ([]*metadata.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Pick", ctx, num)
+	ret := m.ctrl.Call(m, "Pick", ctx, num, eventbus, mode)
 	ret0, _ := ret[0].([]*metadata.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Pick indicates an expected call of Pick.
-func (mr *MockAllocatorMockRecorder) Pick(ctx, num interface{}) *gomock.Call {
+func (mr *MockAllocatorMockRecorder) Pick(ctx, num, eventbus, mode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockAllocator)(nil).Pick), ctx, num)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pick", reflect.TypeOf((*MockAllocator)(nil).Pick), ctx, num, eventbus, mode)
 }
 
 // PickByVolumes mocks base method.
-func (m *MockAllocator) PickByVolumes(ctx context.Context, volumes []vanus.ID) ([]*metadata.Block, error) {
+func (m *MockAllocator) PickByVolumes(ctx context.Context, volumes []vanus.ID, mode meta.StorageMode) ([]*metadata.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PickByVolumes", ctx, volumes)
+	ret := m.ctrl.Call(m, "PickByVolumes", ctx, volumes, mode)
 	ret0, _ := ret[0].([]*metadata.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PickByVolumes indicates an expected call of PickByVolumes.
-func (mr *MockAllocatorMockRecorder) PickByVolumes(ctx, volumes interface{}) *gomock.Call {
+func (mr *MockAllocatorMockRecorder) PickByVolumes(ctx, volumes, mode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickByVolumes", reflect.TypeOf((*MockAllocator)(nil).PickByVolumes), ctx, volumes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickByVolumes", reflect.TypeOf((*MockAllocator)(nil).PickByVolumes), ctx, volumes, mode)
 }
 
 // Run mocks base method.
@@ -23,6 +23,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
 // VolumeSelector selector for Block creating. The implementation based on different algorithm, typical
@@ -111,3 +112,214 @@ func (s *volumeRoundRobinSelector) GetAllVolume() []server.Instance {
 	defer s.mutex.Unlock()
 	return s.getVolumes()
 }
+
+const (
+	StrategyRoundRobin       = "round-robin"
+	StrategyCapacityWeighted = "capacity-weighted"
+	StrategyZoneAware        = "zone-aware"
+)
+
+// SelectorConfig decides which strategy is used to select volumes for Block creating.
+type SelectorConfig struct {
+	// Strategy is the default strategy of the cluster, round-robin is used if it's empty.
+	Strategy string `yaml:"strategy"`
+	// Eventbus overrides the default strategy, key is the name of eventbus, value is the strategy.
+	Eventbus map[string]string `yaml:"eventbus"`
+	// Zones tells zone-aware strategy which zone a volume belongs to, key is the volume ID.
+	Zones map[uint64]string `yaml:"zones"`
+}
+
+// Validate checks all strategies in config are registered.
+func (c SelectorConfig) Validate() error {
+	if !isSelectorRegistered(c.Strategy) {
+		return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("unknown allocation strategy: %s", c.Strategy))
+	}
+	for eb, strategy := range c.Eventbus {
+		if !isSelectorRegistered(strategy) {
+			return errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("unknown allocation strategy of eventbus %s: %s", eb, strategy))
+		}
+	}
+	return nil
+}
+
+// SelectorFactory creates a VolumeSelector, #{f} is the callback for getting all server.Instance.
+type SelectorFactory func(cfg SelectorConfig, f func() []server.Instance) VolumeSelector
+
+var (
+	selectorFactoriesMutex sync.RWMutex
+	selectorFactories      = map[string]SelectorFactory{
+		StrategyRoundRobin: func(_ SelectorConfig, f func() []server.Instance) VolumeSelector {
+			return NewVolumeRoundRobin(f)
+		},
+		StrategyCapacityWeighted: func(_ SelectorConfig, f func() []server.Instance) VolumeSelector {
+			return NewVolumeCapacityWeighted(f)
+		},
+		StrategyZoneAware: func(cfg SelectorConfig, f func() []server.Instance) VolumeSelector {
+			return NewVolumeZoneAware(cfg.Zones, f)
+		},
+	}
+)
+
+// RegisterVolumeSelector registers a custom strategy, it should be called before the controller starts.
+func RegisterVolumeSelector(name string, factory SelectorFactory) {
+	selectorFactoriesMutex.Lock()
+	defer selectorFactoriesMutex.Unlock()
+	selectorFactories[name] = factory
+}
+
+func isSelectorRegistered(name string) bool {
+	if name == "" {
+		return true
+	}
+	selectorFactoriesMutex.RLock()
+	defer selectorFactoriesMutex.RUnlock()
+	_, exist := selectorFactories[name]
+	return exist
+}
+
+// NewVolumeSelector creates a VolumeSelector of strategy #{name}, round-robin is used if #{name} is empty.
+func NewVolumeSelector(name string, cfg SelectorConfig, f func() []server.Instance) (VolumeSelector, error) {
+	if name == "" {
+		name = StrategyRoundRobin
+	}
+	selectorFactoriesMutex.RLock()
+	factory, exist := selectorFactories[name]
+	selectorFactoriesMutex.RUnlock()
+	if !exist {
+		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("unknown allocation strategy: %s", name))
+	}
+	return factory(cfg, f), nil
+}
+
+// volumeGetter implements methods which don't depend on strategy.
+type volumeGetter struct {
+	getVolumes func() []server.Instance
+}
+
+func (g volumeGetter) SelectByID(id vanus.ID) server.Instance {
+	volumes := g.getVolumes()
+	for idx := range volumes {
+		if volumes[idx].ID() == id {
+			return volumes[idx]
+		}
+	}
+	return nil
+}
+
+func (g volumeGetter) GetAllVolume() []server.Instance {
+	return g.getVolumes()
+}
+
+// sortedVolumes returns volumes sorted by key of ID, so that selecting is stable.
+func (g volumeGetter) sortedVolumes() []server.Instance {
+	volumes := append([]server.Instance(nil), g.getVolumes()...)
+	sort.Slice(volumes, func(i, j int) bool {
+		return volumes[i].GetMeta().ID.Key() < volumes[j].GetMeta().ID.Key()
+	})
+	return volumes
+}
+
+// NewVolumeCapacityWeighted an implementation which prefers volumes having more free capacity, so that
+// volumes with larger capacity hold more Blocks in a cluster whose volumes have skewed capacities.
+func NewVolumeCapacityWeighted(f func() []server.Instance) VolumeSelector {
+	return &volumeCapacityWeightedSelector{
+		volumeGetter: volumeGetter{getVolumes: f},
+	}
+}
+
+type volumeCapacityWeightedSelector struct {
+	volumeGetter
+	mutex sync.Mutex
+}
+
+// Select picks the volume which has the most free capacity each time, the picked volume is charged #{size}
+// and isn't picked again in the same invoking until every volume has been picked.
+func (s *volumeCapacityWeightedSelector) Select(num int, size int64) []server.Instance {
+	instances := make([]server.Instance, 0)
+	if num == 0 || size == 0 {
+		return instances
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	volumes := s.sortedVolumes()
+	if len(volumes) == 0 {
+		return instances
+	}
+	free := make([]int64, len(volumes))
+	for idx, v := range volumes {
+		free[idx] = v.GetMeta().Capacity - v.GetMeta().Used
+	}
+	picked := make([]bool, len(volumes))
+	for len(instances) < num {
+		if len(instances)%len(volumes) == 0 {
+			for idx := range picked {
+				picked[idx] = false
+			}
+		}
+		best := -1
+		for idx := range volumes {
+			if !picked[idx] && (best < 0 || free[idx] > free[best]) {
+				best = idx
+			}
+		}
+		picked[best] = true
+		free[best] -= size
+		instances = append(instances, volumes[best])
+	}
+	return instances
+}
+
+// NewVolumeZoneAware an implementation which spreads replicas of a Segment across zones. The zone of a
+// volume is looked up in #{zones} by volume ID, volumes not in #{zones} belong to the same unnamed zone.
+func NewVolumeZoneAware(zones map[uint64]string, f func() []server.Instance) VolumeSelector {
+	return &volumeZoneAwareSelector{
+		volumeGetter: volumeGetter{getVolumes: f},
+		zones:        zones,
+		cursors:      map[string]int{},
+	}
+}
+
+type volumeZoneAwareSelector struct {
+	volumeGetter
+	zones map[uint64]string
+	count int
+	// cursors is the round-robin cursor of volumes in each zone.
+	cursors map[string]int
+	mutex   sync.Mutex
+}
+
+// Select picks zones in round-robin, and picks volumes in round-robin within the zone. Replicas are placed in
+// different zones as long as #{num} doesn't exceed the number of zones.
+func (s *volumeZoneAwareSelector) Select(num int, size int64) []server.Instance {
+	instances := make([]server.Instance, 0)
+	if num == 0 || size == 0 {
+		return instances
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	volumes := s.sortedVolumes()
+	if len(volumes) == 0 {
+		return instances
+	}
+	names := make([]string, 0)
+	zones := make(map[string][]server.Instance)
+	for _, v := range volumes {
+		zone := s.zones[v.GetMeta().ID.Uint64()]
+		if _, exist := zones[zone]; !exist {
+			names = append(names, zone)
+		}
+		zones[zone] = append(zones[zone], v)
+	}
+	sort.Strings(names)
+	for idx := 0; idx < num; idx++ {
+		zone := names[(s.count+idx)%len(names)]
+		cursor := s.cursors[zone]
+		instances = append(instances, zones[zone][cursor%len(zones[zone])])
+		s.cursors[zone] = cursor + 1
+	}
+	s.count++
+	return instances
+}
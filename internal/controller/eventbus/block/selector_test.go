@@ -101,3 +101,122 @@ func TestNewVolumeRoundRobinAbnormal(t *testing.T) {
 		So(instances, ShouldHaveLength, 0)
 	})
 }
+
+func newTestInstance(ctrl *gomock.Controller, id uint64, capacity, used int64) server.Instance {
+	ins := server.NewMockInstance(ctrl)
+	ins.EXPECT().GetMeta().Return(&metadata.VolumeMetadata{
+		ID:       vanus.NewIDFromUint64(id),
+		Capacity: capacity,
+		Used:     used,
+	}).AnyTimes()
+	ins.EXPECT().ID().Return(vanus.NewIDFromUint64(id)).AnyTimes()
+	return ins
+}
+
+func TestNewVolumeCapacityWeighted(t *testing.T) {
+	Convey("test capacity-weighted selector with skewed capacities", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		size := int64(64)
+		// volume 1 is four times as large as others.
+		srvs := []server.Instance{
+			newTestInstance(ctrl, 1, 16*size, 0),
+			newTestInstance(ctrl, 2, 4*size, 0),
+			newTestInstance(ctrl, 3, 4*size, size),
+		}
+		selector := NewVolumeCapacityWeighted(func() []server.Instance {
+			return srvs
+		})
+
+		instances := selector.Select(1, size)
+		So(instances, ShouldHaveLength, 1)
+		So(instances[0].ID().Uint64(), ShouldEqual, uint64(1))
+
+		Convey("replicas are placed in different volumes", func() {
+			instances = selector.Select(3, size)
+			So(instances, ShouldHaveLength, 3)
+			So(instances[0].ID().Uint64(), ShouldEqual, uint64(1))
+			So(instances[1].ID().Uint64(), ShouldEqual, uint64(2))
+			So(instances[2].ID().Uint64(), ShouldEqual, uint64(3))
+		})
+
+		Convey("the larger volume holds more blocks", func() {
+			count := map[uint64]int{}
+			for i := 0; i < 16; i++ {
+				ins := selector.Select(1, size)[0]
+				ins.GetMeta().Used += size
+				count[ins.ID().Uint64()]++
+			}
+			So(count[1], ShouldEqual, 14)
+			So(count[2], ShouldEqual, 2)
+			So(count[3], ShouldEqual, 0)
+		})
+
+		Convey("test select instance by id", func() {
+			So(selector.SelectByID(vanus.NewIDFromUint64(2)), ShouldEqual, srvs[1])
+			So(selector.SelectByID(vanus.NewIDFromUint64(4)), ShouldBeNil)
+			So(selector.GetAllVolume(), ShouldHaveLength, 3)
+		})
+	})
+}
+
+func TestNewVolumeZoneAware(t *testing.T) {
+	Convey("test zone-aware selector", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		srvs := []server.Instance{
+			newTestInstance(ctrl, 1, 1024, 0),
+			newTestInstance(ctrl, 2, 1024, 0),
+			newTestInstance(ctrl, 3, 1024, 0),
+			newTestInstance(ctrl, 4, 1024, 0),
+		}
+		zones := map[uint64]string{1: "a", 2: "a", 3: "b", 4: "c"}
+		selector := NewVolumeZoneAware(zones, func() []server.Instance {
+			return srvs
+		})
+
+		instances := selector.Select(3, 64)
+		So(instances, ShouldHaveLength, 3)
+		So(instances[0].ID().Uint64(), ShouldEqual, uint64(1))
+		So(instances[1].ID().Uint64(), ShouldEqual, uint64(3))
+		So(instances[2].ID().Uint64(), ShouldEqual, uint64(4))
+
+		// the leader moves to the next zone, and volumes in zone a are used in turn.
+		instances = selector.Select(3, 64)
+		So(instances[0].ID().Uint64(), ShouldEqual, uint64(3))
+		So(instances[1].ID().Uint64(), ShouldEqual, uint64(4))
+		So(instances[2].ID().Uint64(), ShouldEqual, uint64(2))
+
+		instances = selector.Select(0, 64)
+		So(instances, ShouldHaveLength, 0)
+	})
+}
+
+func TestNewVolumeSelector(t *testing.T) {
+	Convey("test create selector by strategy", t, func() {
+		f := func() []server.Instance { return nil }
+		selector, err := NewVolumeSelector("", SelectorConfig{}, f)
+		So(err, ShouldBeNil)
+		So(selector, ShouldHaveSameTypeAs, &volumeRoundRobinSelector{})
+
+		selector, err = NewVolumeSelector(StrategyCapacityWeighted, SelectorConfig{}, f)
+		So(err, ShouldBeNil)
+		So(selector, ShouldHaveSameTypeAs, &volumeCapacityWeightedSelector{})
+
+		selector, err = NewVolumeSelector(StrategyZoneAware, SelectorConfig{}, f)
+		So(err, ShouldBeNil)
+		So(selector, ShouldHaveSameTypeAs, &volumeZoneAwareSelector{})
+
+		_, err = NewVolumeSelector("custom", SelectorConfig{}, f)
+		So(err, ShouldNotBeNil)
+		So(SelectorConfig{Eventbus: map[string]string{"eb": "custom"}}.Validate(), ShouldNotBeNil)
+
+		RegisterVolumeSelector("custom", func(_ SelectorConfig, f func() []server.Instance) VolumeSelector {
+			return NewVolumeRoundRobin(f)
+		})
+		_, err = NewVolumeSelector("custom", SelectorConfig{}, f)
+		So(err, ShouldBeNil)
+		So(SelectorConfig{Strategy: StrategyZoneAware, Eventbus: map[string]string{"eb": "custom"}}.Validate(),
+			ShouldBeNil)
+	})
+}
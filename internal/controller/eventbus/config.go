@@ -14,15 +14,37 @@
 
 package eventbus
 
-import embedetcd "github.com/linkall-labs/embed-etcd"
+import (
+	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller/anomaly"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/block"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/stats"
+	"github.com/linkall-labs/vanus/internal/controller/importer"
+	"github.com/linkall-labs/vanus/internal/controller/importer/kafka"
+	"github.com/linkall-labs/vanus/internal/controller/ownership"
+	"github.com/linkall-labs/vanus/internal/controller/slo"
+)
 
 type Config struct {
-	IP               string            `yaml:"ip"`
-	Port             int               `yaml:"port"`
-	KVStoreEndpoints []string          `yaml:"kv_store_endpoints"`
-	KVKeyPrefix      string            `yaml:"kv_key_prefix"`
-	EtcdConfig       embedetcd.Config  `yaml:"etcd"`
-	Replicas         uint              `yaml:"replicas"`
-	Topology         map[string]string `yaml:"topology"`
-	SegmentCapacity  int64             `yaml:"segment_capacity"`
+	IP               string               `yaml:"ip"`
+	Port             int                  `yaml:"port"`
+	KVStoreEndpoints []string             `yaml:"kv_store_endpoints"`
+	KVKeyPrefix      string               `yaml:"kv_key_prefix"`
+	EtcdConfig       embedetcd.Config     `yaml:"etcd"`
+	Replicas         uint                 `yaml:"replicas"`
+	Topology         map[string]string    `yaml:"topology"`
+	SegmentCapacity  int64                `yaml:"segment_capacity"`
+	BlockAllocation  block.SelectorConfig `yaml:"block_allocation"`
+	ControllerAddr   []string             `yaml:"-"`
+	Anomaly          anomaly.Config       `yaml:"anomaly"`
+	BlockStats       stats.Config         `yaml:"block_stats"`
+	// ImportCredentials are credentials which imports from NATS and RabbitMQ refer to by names.
+	ImportCredentials map[string]importer.Credential `yaml:"import_credentials"`
+	Ownership         ownership.Config               `yaml:"ownership"`
+	SLO               slo.Config                     `yaml:"slo"`
+	// WriteLease tunes leases which appendable blocks accept appends with.
+	WriteLease eventlog.WriteLeaseConfig `yaml:"write_lease"`
+	// KafkaCredentials are SASL credentials which imports from Kafka refer to by names.
+	KafkaCredentials map[string]kafka.SASLConfig `yaml:"kafka_credentials"`
 }
@@ -21,19 +21,31 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	embedetcd "github.com/linkall-labs/embed-etcd"
+	"github.com/linkall-labs/vanus/internal/controller/anomaly"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/stats"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
+	"github.com/linkall-labs/vanus/internal/controller/importer"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/internal/controller/ownership"
+	"github.com/linkall-labs/vanus/internal/controller/slo"
+	triggerstorage "github.com/linkall-labs/vanus/internal/controller/trigger/storage"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
+	"github.com/linkall-labs/vanus/internal/controller/verifier"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/kv/etcd"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/vsb/dictionary"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/errors"
@@ -43,6 +55,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -55,38 +68,80 @@ var (
 const (
 	maximumEventlogNum   = 64
 	systemEventbusPrefix = "__"
+
+	defaultStreamBatchSize = 100
+	maximumStreamBatchSize = 1000
+
+	// maxDictionarySize allows dictionaries trained by zstd --train in the default size.
+	maxDictionarySize = 128 * 1024
+
+	jobKindDeleteEventlogs job.Kind = "eventlogs-delete"
+
+	jobParamEventbus  = "eventbus"
+	jobParamEventlogs = "eventlogs"
 )
 
-func NewController(cfg Config, member embedetcd.Member) *controller {
+func NewController(cfg Config, member embedetcd.Member, usageStore *usage.Store) *controller {
 	c := &controller{
-		cfg:         &cfg,
-		ssMgr:       server.NewServerManager(),
-		eventBusMap: map[string]*metadata.Eventbus{},
-		member:      member,
-		isLeader:    false,
-		readyNotify: make(chan error, 1),
-		stopNotify:  make(chan error, 1),
+		cfg:              &cfg,
+		ssMgr:            server.NewServerManager(),
+		eventBusMap:      map[string]*metadata.Eventbus{},
+		member:           member,
+		isLeader:         false,
+		readyNotify:      make(chan error, 1),
+		stopNotify:       make(chan error, 1),
+		jobMgr:           job.NewManager("eventbus"),
+		detector:         anomaly.NewDetector(cfg.Anomaly, cfg.ControllerAddr),
+		blockStats:       stats.NewCollector(cfg.BlockStats),
+		guard:            ownership.NewGuard(cfg.Ownership),
+		stopPublishRates: func() {},
+		slo:              slo.NewTracker(cfg.SLO, cfg.ControllerAddr),
+		usage:            usageStore,
 	}
+	c.jobMgr.Register(jobKindDeleteEventlogs, c.deleteEventlogsJob)
+	c.jobMgr.Register(importer.KindKafka, importer.NewKafkaHandler(cfg.ControllerAddr, cfg.KafkaCredentials))
+	c.jobMgr.Register(importer.KindNATS, importer.NewNATSHandler(cfg.ControllerAddr, cfg.ImportCredentials))
+	c.jobMgr.Register(importer.KindRabbitMQ,
+		importer.NewRabbitMQHandler(cfg.ControllerAddr, cfg.ImportCredentials))
+	c.jobMgr.Register(verifier.KindOrdering, verifier.NewOrderingHandler(cfg.ControllerAddr))
 	c.volumeMgr = volume.NewVolumeManager(c.ssMgr)
-	c.eventLogMgr = eventlog.NewManager(c.volumeMgr, cfg.Replicas, cfg.SegmentCapacity)
+	c.eventLogMgr = eventlog.NewManager(c.volumeMgr, cfg.Replicas, cfg.SegmentCapacity, cfg.BlockAllocation)
+	c.eventLogMgr.SetDictionaryResolver(c.dictionaryOf)
+	c.eventLogMgr.SetRetentionResolver(c.retentionOf)
+	c.eventLogMgr.SetWriteLease(cfg.WriteLease)
+	c.ssMgr.OnServerLost(c.failoverServer)
 	return c
 }
 
 type controller struct {
-	cfg             *Config
-	kvStore         kv.Client
-	volumeMgr       volume.Manager
-	eventLogMgr     eventlog.Manager
-	ssMgr           server.Manager
-	eventBusMap     map[string]*metadata.Eventbus
-	member          embedetcd.Member
-	cancelCtx       context.Context
-	cancelFunc      context.CancelFunc
-	membershipMutex sync.Mutex
-	isLeader        bool
-	readyNotify     chan error
-	stopNotify      chan error
-	mutex           sync.Mutex
+	cfg              *Config
+	kvStore          kv.Client
+	volumeMgr        volume.Manager
+	eventLogMgr      eventlog.Manager
+	ssMgr            server.Manager
+	jobMgr           job.Manager
+	detector         *anomaly.Detector
+	blockStats       *stats.Collector
+	guard            *ownership.Guard
+	stopPublishRates context.CancelFunc
+	eventBusMap      map[string]*metadata.Eventbus
+	member           embedetcd.Member
+	cancelCtx        context.Context
+	cancelFunc       context.CancelFunc
+	membershipMutex  sync.Mutex
+	isLeader         bool
+	readyNotify      chan error
+	stopNotify       chan error
+	mutex            sync.Mutex
+	// dictionaries holds zstd dictionaries of eventbuses by their IDs, segments are activated with them
+	// without holding mutex.
+	dictionaries sync.Map
+	// retentions holds retentions of eventbuses which aren't the default by their IDs.
+	retentions sync.Map
+	// slo tracks publish latencies reported by gateways.
+	slo *slo.Tracker
+	// usage is shared with the trigger controller, which ranks eventbuses and subscriptions by their usages.
+	usage *usage.Store
 }
 
 func (ctrl *controller) Start(_ context.Context) error {
@@ -100,6 +155,11 @@ func (ctrl *controller) Start(_ context.Context) error {
 	return nil
 }
 
+// JobManager returns the manager of jobs which run by the eventbus controller.
+func (ctrl *controller) JobManager() job.Manager {
+	return ctrl.jobMgr
+}
+
 func (ctrl *controller) Stop() {
 	ctrl.stop(context.Background(), nil)
 }
@@ -152,10 +212,39 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 	req *ctrlpb.CreateEventBusRequest) (*metapb.EventBus, error) {
 	ctrl.mutex.Lock()
 	defer ctrl.mutex.Unlock()
-	if !ctrl.isReady(ctx) {
+	logNum := req.LogNumber
+	retentionMs, scope := req.RetentionMs, req.OrderingScope
+	var replicas uint
+	if req.Profile != "" {
+		profile, err := ctrl.getEventbusProfile(ctx, req.Profile)
+		if err != nil {
+			return nil, err
+		}
+		if logNum != 0 && int(logNum) != profile.LogNumber {
+			return nil, errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("the number of eventlog conflicts with profile %s", profile.Name))
+		}
+		logNum = int32(profile.LogNumber)
+		replicas = profile.Replicas
+		// settings which aren't set in the request are taken from the profile.
+		if profile.Retention != 0 {
+			if retentionMs != 0 && retentionMs != profile.Retention.Milliseconds() {
+				return nil, errors.ErrInvalidRequest.WithMessage(
+					fmt.Sprintf("the retention conflicts with profile %s", profile.Name))
+			}
+			retentionMs = profile.Retention.Milliseconds()
+		}
+		if profile.OrderingScope != metapb.OrderingScope_ORDERING_NONE {
+			if scope != metapb.OrderingScope_ORDERING_NONE && scope != profile.OrderingScope {
+				return nil, errors.ErrInvalidRequest.WithMessage(
+					fmt.Sprintf("the ordering scope conflicts with profile %s", profile.Name))
+			}
+			scope = profile.OrderingScope
+		}
+	}
+	if !ctrl.canCreateEventbus(ctx, replicas) {
 		return nil, errors.ErrResourceCanNotOp.WithMessage("the cluster isn't ready to create eventbus")
 	}
-	logNum := req.LogNumber
 	if logNum == 0 {
 		logNum = 1
 	}
@@ -163,6 +252,17 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the number of eventlog exceeded,"+
 			" maximum is %d", maximumEventlogNum))
 	}
+	if retentionMs < 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the retention can't be negative")
+	}
+	if _, ok := metapb.OrderingScope_name[int32(scope)]; !ok {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("unknown ordering scope: %d", scope))
+	}
+	if _, ok := metapb.StorageMode_name[int32(req.StorageMode)]; !ok {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("unknown storage mode: %d", req.StorageMode))
+	}
 
 	id, err := vanus.NewID()
 	if err != nil {
@@ -177,8 +277,14 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 		LogNumber:   int(logNum),
 		EventLogs:   make([]*metadata.Eventlog, int(logNum)),
 		Description: req.Description,
+		Profile:     req.Profile,
+		Owner:       ownership.OwnerOf(ctx),
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
+		Retention:   time.Duration(retentionMs) * time.Millisecond,
+		// The scope can't be changed later, or events of a key would be reordered across eventlogs.
+		OrderingScope: scope,
+		StorageMode:   req.StorageMode,
 	}
 	exist, err := ctrl.kvStore.Exists(ctx, metadata.GetEventbusMetadataKey(eb.Name))
 	if err != nil {
@@ -188,7 +294,7 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 		return nil, errors.ErrResourceAlreadyExist.WithMessage("the eventbus already exist")
 	}
 	for idx := 0; idx < eb.LogNumber; idx++ {
-		el, err := ctrl.eventLogMgr.AcquireEventLog(ctx, eb.ID)
+		el, err := ctrl.eventLogMgr.AcquireEventLog(ctx, eb.ID, replicas, eb.StorageMode)
 		if err != nil {
 			return nil, err
 		}
@@ -202,6 +308,9 @@ func (ctrl *controller) createEventBus(ctx context.Context,
 			return nil, err
 		}
 	}
+	if eb.Retention > 0 {
+		ctrl.retentions.Store(eb.ID, eb.Retention)
+	}
 	metrics.EventbusGauge.Set(float64(len(ctrl.eventBusMap)))
 	return ctrl.getEventbus(eb.Name)
 }
@@ -214,6 +323,9 @@ func (ctrl *controller) DeleteEventBus(ctx context.Context, eb *metapb.EventBus)
 	if !exist {
 		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
 	}
+	if err := ctrl.guard.Check(ctx, "eventbus", bus.Name, bus.Owner); err != nil {
+		return nil, err
+	}
 	err := ctrl.kvStore.Delete(ctx, metadata.GetEventbusMetadataKey(eb.Name))
 	if err != nil {
 		return nil, errors.ErrInternal.WithMessage("delete eventbus metadata in kv failed").Wrap(err)
@@ -221,20 +333,68 @@ func (ctrl *controller) DeleteEventBus(ctx context.Context, eb *metapb.EventBus)
 
 	// TODO(wenfeng.wang) notify gateway to cut flow
 	delete(ctrl.eventBusMap, eb.Name)
-	wg := sync.WaitGroup{}
+	ctrl.dictionaries.Delete(bus.ID)
+	ctrl.retentions.Delete(bus.ID)
+	metrics.EventbusGauge.Set(float64(len(ctrl.eventBusMap)))
 
+	// the metadata of eventbus has been deleted, eventlogs are deleted by a job to survive leader changing.
+	logs := make([]string, 0, len(bus.EventLogs))
 	for _, v := range bus.EventLogs {
-		wg.Add(1)
-		go func(logID vanus.ID) {
-			ctrl.eventLogMgr.DeleteEventlog(ctx, logID)
-			wg.Done()
-		}(v.ID)
+		logs = append(logs, v.ID.Key())
+	}
+	_, err = ctrl.jobMgr.Submit(ctx, job.Spec{
+		Kind: jobKindDeleteEventlogs,
+		Key:  bus.ID.Key(),
+		Params: map[string]string{
+			jobParamEventbus:  bus.Name,
+			jobParamEventlogs: strings.Join(logs, ","),
+		},
+	})
+	if err != nil {
+		log.Warning(ctx, "submit the job of deleting eventlogs failed", map[string]interface{}{
+			log.KeyError:        err,
+			log.KeyEventbusName: bus.Name,
+		})
+		return nil, err
 	}
-	wg.Wait()
-	metrics.EventbusGauge.Set(float64(len(ctrl.eventBusMap)))
 	return &emptypb.Empty{}, nil
 }
 
+// deleteEventlogsJob deletes eventlogs one by one, the cursor is the number of deleted eventlogs.
+func (ctrl *controller) deleteEventlogsJob(ctx context.Context, e *job.Execution) error {
+	var logs []string
+	if v := e.Params()[jobParamEventlogs]; v != "" {
+		logs = strings.Split(v, ",")
+	}
+	start := 0
+	if e.Cursor() != "" {
+		n, err := strconv.Atoi(e.Cursor())
+		if err != nil {
+			return errors.ErrInvalidRequest.WithMessage("invalid cursor").Wrap(err)
+		}
+		start = n
+	}
+	for idx := start; idx < len(logs); idx++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		id, err := vanus.NewIDFromString(logs[idx])
+		if err != nil {
+			return err
+		}
+		ctrl.eventLogMgr.DeleteEventlog(ctx, id)
+		err = e.Checkpoint(ctx, strconv.Itoa(idx+1), job.Progress{
+			Done:    int64(idx + 1),
+			Total:   int64(len(logs)),
+			Message: fmt.Sprintf("eventbus %s", e.Params()[jobParamEventbus]),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (ctrl *controller) GetEventBus(ctx context.Context, eb *metapb.EventBus) (*metapb.EventBus, error) {
 	return ctrl.getEventbus(eb.Name)
 }
@@ -274,6 +434,159 @@ func (ctrl *controller) UpdateEventBus(ctx context.Context,
 	return &metapb.EventBus{}, nil
 }
 
+func (ctrl *controller) AnnotateEventBus(ctx context.Context,
+	req *ctrlpb.AnnotateEventBusRequest) (*metapb.EventBus, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+
+	eb, exist := ctrl.eventBusMap[req.Name]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	if err := ctrl.guard.Check(ctx, "eventbus", eb.Name, eb.Owner); err != nil {
+		return nil, err
+	}
+	annotations, err := primitive.ApplyAnnotations(eb.Annotations, req.Annotations, req.RemovedKeys)
+	if err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+
+	// persist a copy, so the eventbus in memory isn't changed if persisting fails.
+	updated := *eb
+	updated.Annotations = annotations
+	updated.UpdatedAt = time.Now()
+	data, _ := json.Marshal(&updated)
+	if err = ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Name), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("update eventbus metadata in kv failed").Wrap(err)
+	}
+	ctrl.eventBusMap[eb.Name] = &updated
+	return ctrl.getEventbus(eb.Name)
+}
+
+func (ctrl *controller) SetEventBusDictionary(ctx context.Context,
+	req *ctrlpb.SetEventBusDictionaryRequest) (*metapb.EventBus, error) {
+	if len(req.Dictionary) != 0 {
+		if err := dictionary.Validate(req.Dictionary); err != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage("the dictionary isn't a zstd dictionary")
+		}
+		if len(req.Dictionary) > maxDictionarySize {
+			return nil, errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("the dictionary is larger than %d bytes", maxDictionarySize))
+		}
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+
+	eb, exist := ctrl.eventBusMap[req.Name]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	if err := ctrl.guard.Check(ctx, "eventbus", eb.Name, eb.Owner); err != nil {
+		return nil, err
+	}
+
+	updated := *eb
+	updated.Dictionary = req.Dictionary
+	if len(req.Dictionary) != 0 {
+		// IDs in dictionaries aren't trusted, blocks tell dictionaries apart by IDs.
+		id, err := ctrl.nextDictionaryID(ctx)
+		if err != nil {
+			return nil, errors.ErrInternal.WithMessage("assign the dictionary ID failed").Wrap(err)
+		}
+		if updated.Dictionary, err = dictionary.SetID(req.Dictionary, id); err != nil {
+			return nil, errors.ErrInternal.WithMessage("assign the dictionary ID failed").Wrap(err)
+		}
+	}
+	updated.UpdatedAt = time.Now()
+	data, _ := json.Marshal(&updated)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Name), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("update eventbus metadata in kv failed").Wrap(err)
+	}
+	ctrl.eventBusMap[eb.Name] = &updated
+	if len(updated.Dictionary) != 0 {
+		ctrl.dictionaries.Store(eb.ID, updated.Dictionary)
+	} else {
+		ctrl.dictionaries.Delete(eb.ID)
+	}
+	return ctrl.getEventbus(eb.Name)
+}
+
+// nextDictionaryID assigns a unique ID to a dictionary, the last assigned one is kept in kv. The caller
+// must hold mutex.
+func (ctrl *controller) nextDictionaryID(ctx context.Context) (uint32, error) {
+	id := uint32(dictionary.MinID)
+	data, err := ctrl.kvStore.Get(ctx, metadata.DictionaryIDKeyInKVStore)
+	if err == nil {
+		last, err2 := strconv.ParseUint(string(data), 10, 32)
+		if err2 != nil {
+			return 0, err2
+		}
+		id = uint32(last) + 1
+	} else if !stdErr.Is(err, kv.ErrKeyNotFound) {
+		return 0, err
+	}
+	if id > dictionary.MaxID {
+		return 0, fmt.Errorf("dictionary IDs are exhausted")
+	}
+	if err = ctrl.kvStore.Set(ctx, metadata.DictionaryIDKeyInKVStore,
+		[]byte(strconv.FormatUint(uint64(id), 10))); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// dictionaryOf returns the zstd dictionary of eventbus id, it returns nil if the eventbus has none.
+func (ctrl *controller) dictionaryOf(id vanus.ID) []byte {
+	if v, ok := ctrl.dictionaries.Load(id); ok {
+		dict, _ := v.([]byte)
+		return dict
+	}
+	return nil
+}
+
+func (ctrl *controller) SetEventBusRetention(ctx context.Context,
+	req *ctrlpb.SetEventBusRetentionRequest) (*metapb.EventBus, error) {
+	if req.RetentionMs < 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the retention can't be negative")
+	}
+
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+
+	eb, exist := ctrl.eventBusMap[req.Name]
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	if err := ctrl.guard.Check(ctx, "eventbus", eb.Name, eb.Owner); err != nil {
+		return nil, err
+	}
+
+	updated := *eb
+	updated.Retention = time.Duration(req.RetentionMs) * time.Millisecond
+	updated.UpdatedAt = time.Now()
+	data, _ := json.Marshal(&updated)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusMetadataKey(eb.Name), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("update eventbus metadata in kv failed").Wrap(err)
+	}
+	ctrl.eventBusMap[eb.Name] = &updated
+	if updated.Retention > 0 {
+		ctrl.retentions.Store(eb.ID, updated.Retention)
+	} else {
+		ctrl.retentions.Delete(eb.ID)
+	}
+	return ctrl.getEventbus(eb.Name)
+}
+
+// retentionOf returns the retention of eventbus id, it returns 0 if the eventbus uses the default.
+func (ctrl *controller) retentionOf(id vanus.ID) time.Duration {
+	if v, ok := ctrl.retentions.Load(id); ok {
+		retention, _ := v.(time.Duration)
+		return retention
+	}
+	return 0
+}
+
 func (ctrl *controller) ListSegment(ctx context.Context,
 	req *ctrlpb.ListSegmentRequest) (*ctrlpb.ListSegmentResponse, error) {
 	el := ctrl.eventLogMgr.GetEventLog(ctx, vanus.NewIDFromUint64(req.EventLogId))
@@ -286,24 +599,204 @@ func (ctrl *controller) ListSegment(ctx context.Context,
 	}, nil
 }
 
-func (ctrl *controller) RegisterSegmentServer(ctx context.Context,
-	req *ctrlpb.RegisterSegmentServerRequest) (*ctrlpb.RegisterSegmentServerResponse, error) {
-	srv, err := server.NewSegmentServer(req.Address)
+// StreamSegments sends segments in batches, the bookmark is {eventlog_id}/{segment_id} of the last segment
+// sent. Segments are ordered by eventlogs and then by segments, whose ids increase as they're created.
+func (ctrl *controller) StreamSegments(req *ctrlpb.StreamListRequest,
+	stream ctrlpb.EventLogController_StreamSegmentsServer) error {
+	afterLog, afterSeg, err := parseSegmentBookmark(req.Bookmark)
 	if err != nil {
-		return nil, err
+		return errors.ErrInvalidRequest.WithMessage("invalid bookmark").Wrap(err)
+	}
+	logs, err := ctrl.eventlogsToStream(req.Eventbus)
+	if err != nil {
+		return err
 	}
+	size := streamBatchSize(req.BatchSize)
+	ctx := stream.Context()
+	resp := &ctrlpb.StreamSegmentsResponse{Bookmark: req.Bookmark}
+	for _, elID := range logs {
+		if elID < afterLog {
+			continue
+		}
+		for _, seg := range ctrl.eventLogMgr.GetEventLogSegmentList(elID) {
+			if elID == afterLog && seg.ID <= afterSeg {
+				continue
+			}
+			resp.Segments = append(resp.Segments, eventlog.Convert2ProtoSegment(ctx, seg)...)
+			resp.Bookmark = elID.Key() + "/" + seg.ID.Key()
+			if len(resp.Segments) < size {
+				continue
+			}
+			if err = stream.Send(resp); err != nil {
+				return err
+			}
+			resp = &ctrlpb.StreamSegmentsResponse{Bookmark: resp.Bookmark}
+		}
+	}
+	resp.End = true
+	return stream.Send(resp)
+}
 
-	if err = ctrl.ssMgr.AddServer(ctx, srv); err != nil {
+// eventlogsToStream returns ids of eventlogs in ascending order, only eventlogs of the eventbus are returned
+// if it's not empty.
+func (ctrl *controller) eventlogsToStream(eventbus string) ([]vanus.ID, error) {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	var logs []vanus.ID
+	for _, eb := range ctrl.eventBusMap {
+		if eventbus != "" && eb.Name != eventbus {
+			continue
+		}
+		for _, el := range eb.EventLogs {
+			logs = append(logs, el.ID)
+		}
+	}
+	if eventbus != "" && len(logs) == 0 {
+		return nil, errors.ErrResourceNotFound.WithMessage("the eventbus doesn't exist")
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i] < logs[j]
+	})
+	return logs, nil
+}
+
+func parseSegmentBookmark(bookmark string) (vanus.ID, vanus.ID, error) {
+	if bookmark == "" {
+		return 0, 0, nil
+	}
+	parts := strings.Split(bookmark, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("the bookmark must be {eventlog_id}/{segment_id}")
+	}
+	elID, err := vanus.NewIDFromString(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	segID, err := vanus.NewIDFromString(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return elID, segID, nil
+}
+
+func streamBatchSize(size uint32) int {
+	if size == 0 {
+		return defaultStreamBatchSize
+	}
+	if size > maximumStreamBatchSize {
+		return maximumStreamBatchSize
+	}
+	return int(size)
+}
+
+func (ctrl *controller) TruncateEventLog(ctx context.Context,
+	req *ctrlpb.TruncateEventLogRequest) (*ctrlpb.TruncateEventLogResponse, error) {
+	if req.Offset <= 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("the offset must be greater than 0")
+	}
+	elID := vanus.NewIDFromUint64(req.EventLogId)
+	el := ctrl.eventLogMgr.GetEventLog(ctx, elID)
+	if el == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+	}
+	var busName string
+	if eb := ctrl.eventbusByID(el.EventbusID); eb != nil {
+		if err := ctrl.guard.Check(ctx, "eventbus", eb.Name, eb.Owner); err != nil {
+			return nil, err
+		}
+		busName = eb.Name
+	}
+	if !req.Force {
+		if err := ctrl.checkConsumedOffsets(ctx, busName, elID, req.Offset); err != nil {
+			return nil, err
+		}
+	}
+
+	segments, err := ctrl.eventLogMgr.TruncateBefore(ctx, elID, req.Offset)
+	if err != nil {
 		return nil, err
 	}
+	log.Info(ctx, "the eventlog has been truncated", map[string]interface{}{
+		"eventlog_id": elID.Key(),
+		"offset":      req.Offset,
+		"force":       req.Force,
+		"deleted":     len(segments),
+	})
+	return &ctrlpb.TruncateEventLogResponse{
+		Segments: eventlog.Convert2ProtoSegment(ctx, segments...),
+	}, nil
+}
 
+func (ctrl *controller) eventbusByID(id vanus.ID) *metadata.Eventbus {
+	ctrl.mutex.Lock()
+	defer ctrl.mutex.Unlock()
+	for _, eb := range ctrl.eventBusMap {
+		if eb.ID == id {
+			return eb
+		}
+	}
+	return nil
+}
+
+// checkConsumedOffsets makes sure that no subscription still needs events of the eventlog below the offset,
+// it relies on offsets committed by trigger controller, which are stored as
+// /trigger/offsets/{subscription_id}/{eventlog_id} in the same kv store. Subscriptions of the eventbus which
+// haven't committed an offset of the eventlog have consumed nothing of it.
+func (ctrl *controller) checkConsumedOffsets(ctx context.Context, eventbus string, elID vanus.ID,
+	offset int64) error {
+	pairs, err := ctrl.kvStore.List(ctx, triggerstorage.KeyPrefixOffset.String())
+	if err != nil {
+		return err
+	}
+	committed := make(map[string]struct{}, len(pairs))
+	for _, pair := range pairs {
+		if filepath.Base(pair.Key) != elID.String() {
+			continue
+		}
+		subID := filepath.Base(filepath.Dir(pair.Key))
+		committed[subID] = struct{}{}
+		// offsets are stored as decimal strings by trigger controller.
+		consumed, err := strconv.ParseUint(string(pair.Value), 10, 64)
+		if err != nil {
+			// the subscription may still need the events, it's up to users to truncate anyway.
+			return errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf(
+				"the offset of subscription %s on the eventlog is unreadable, use force to truncate anyway",
+				subID)).Wrap(err)
+		}
+		if consumed >= uint64(offset) {
+			continue
+		}
+		return errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf(
+			"subscription %s has consumed the eventlog to %d only, use force to truncate anyway", subID, consumed))
+	}
+	if eventbus == "" {
+		return nil
+	}
+	subs, err := triggerstorage.NewSubscriptionStorage(ctrl.kvStore).ListSubscription(ctx)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		if sub.EventBus != eventbus {
+			continue
+		}
+		if _, ok := committed[sub.ID.String()]; !ok {
+			return errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf(
+				"subscription %s hasn't consumed the eventlog yet, use force to truncate anyway", sub.ID))
+		}
+	}
+	return nil
+}
+
+func (ctrl *controller) RegisterSegmentServer(ctx context.Context,
+	req *ctrlpb.RegisterSegmentServerRequest) (*ctrlpb.RegisterSegmentServerResponse, error) {
 	var volInstance server.Instance
-	// need to compare metadata if existed?
 	volInstance = ctrl.volumeMgr.GetVolumeInstanceByID(vanus.NewIDFromUint64(req.VolumeId))
 	if volInstance == nil {
 		volMD := &metadata.VolumeMetadata{
 			ID:     vanus.NewIDFromUint64(req.VolumeId),
 			Blocks: map[uint64]*metadata.Block{},
+			NodeID: req.NodeId,
 		}
 		_volInstance, err := ctrl.volumeMgr.RegisterVolume(ctx, volMD)
 		if err != nil {
@@ -311,6 +804,24 @@ func (ctrl *controller) RegisterSegmentServer(ctx context.Context,
 		}
 		volInstance = _volInstance
 	}
+	if err := ctrl.volumeMgr.VerifyNode(ctx, volInstance, req.NodeId); err != nil {
+		log.Warning(ctx, "reject the registration of segment server", map[string]interface{}{
+			log.KeyError: err,
+			"address":    req.Address,
+			"volume_id":  req.VolumeId,
+			"node_id":    req.NodeId,
+		})
+		return nil, err
+	}
+
+	srv, err := server.NewSegmentServer(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ctrl.ssMgr.AddServer(ctx, srv); err != nil {
+		return nil, err
+	}
 
 	segments := make(map[uint64]*metapb.Segment)
 	blocks, err := ctrl.volumeMgr.GetBlocksOfVolume(ctx, volInstance)
@@ -362,6 +873,22 @@ func (ctrl *controller) UnregisterSegmentServer(ctx context.Context,
 	return &ctrlpb.UnregisterSegmentServerResponse{}, nil
 }
 
+// failoverServer moves appends of eventlogs away from the lost server, so producers don't keep failing.
+func (ctrl *controller) failoverServer(ctx context.Context, srv server.Server) {
+	volIns := ctrl.volumeMgr.LookupVolumeByServerID(srv.ID())
+	if volIns == nil {
+		return
+	}
+	ctrl.volumeMgr.UpdateRouting(ctx, volIns, nil)
+	n := ctrl.eventLogMgr.FailoverVolume(ctx, volIns.ID())
+	log.Info(ctx, "failover the lost segment server", map[string]interface{}{
+		"server_id": srv.ID(),
+		"address":   srv.Address(),
+		"volume_id": volIns.ID(),
+		"segments":  n,
+	})
+}
+
 func (ctrl *controller) QuerySegmentRouteInfo(ctx context.Context,
 	req *ctrlpb.QuerySegmentRouteInfoRequest) (*ctrlpb.QuerySegmentRouteInfoResponse, error) {
 	return &ctrlpb.QuerySegmentRouteInfoResponse{}, nil
@@ -430,6 +957,7 @@ func (ctrl *controller) processHeartbeat(ctx context.Context, req *ctrlpb.Segmen
 	} else {
 		srv.Polish()
 	}
+	now := time.Now()
 	segments := make(map[string][]eventlog.Segment)
 	for _, info := range req.HealthInfo {
 		blockID := vanus.NewIDFromUint64(info.Id)
@@ -437,6 +965,10 @@ func (ctrl *controller) processHeartbeat(ctx context.Context, req *ctrlpb.Segmen
 		if block == nil {
 			continue
 		}
+		ctrl.blockStats.Observe(blockReport(block, info), now)
+		if info.IsCorrupted {
+			ctrl.markBlockCorrupted(ctx, block)
+		}
 		if info.Size == 0 {
 			continue
 		}
@@ -494,17 +1026,27 @@ func (ctrl *controller) Ping(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.Pin
 	return &ctrlpb.PingResponse{
 		LeaderAddr:      ctrl.member.GetLeaderAddr(),
 		IsEventbusReady: ctrl.isReady(ctx),
+		ServerTime:      timestamppb.Now(),
 	}, nil
 }
 
 func (ctrl *controller) isReady(ctx context.Context) bool {
+	return ctrl.canCreateEventbus(ctx, 0)
+}
+
+// canCreateEventbus checks there are enough active stores for segments of #{replicas} replicas, 0 means
+// the default.
+func (ctrl *controller) canCreateEventbus(ctx context.Context, replicas uint) bool {
 	if ctrl.member == nil {
 		return false
 	}
 	if !ctrl.member.IsLeader() && !ctrl.member.IsReady() || ctrl.member.GetLeaderAddr() == "" {
 		return false
 	}
-	return ctrl.ssMgr.CanCreateEventbus(ctx, int(ctrl.cfg.Replicas))
+	if replicas == 0 {
+		replicas = ctrl.cfg.Replicas
+	}
+	return ctrl.ssMgr.CanCreateEventbus(ctx, int(replicas))
 }
 
 func (ctrl *controller) ReportSegmentLeader(ctx context.Context,
@@ -536,20 +1078,38 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context, event em
 			return err
 		}
 
-		if err := ctrl.eventLogMgr.Run(ctx, ctrl.kvStore, true); err != nil {
+		// the data plane may diverge from metadata when the controller was absent, catch up with it
+		// before allocating blocks.
+		lost, err := ctrl.reconcileBlocks(ctx)
+		if err != nil {
+			ctrl.stop(ctx, err)
+			return err
+		}
+
+		if err = ctrl.eventLogMgr.Run(ctx, ctrl.kvStore, true); err != nil {
 			ctrl.stop(ctx, err)
 			return err
 		}
+		if err = ctrl.jobMgr.Run(ctx, ctrl.kvStore); err != nil {
+			ctrl.stop(ctx, err)
+			return err
+		}
+		ctrl.markBlocksLost(lost)
 
 		if err := ctrl.ssMgr.Run(ctx); err != nil {
 			ctrl.stop(ctx, err)
 			return err
 		}
+		ctrl.startPublishRates(ctrl.cancelCtx)
+		ctrl.slo.Start(ctrl.cancelCtx)
 	case embedetcd.EventBecomeFollower:
 		if !ctrl.isLeader {
 			return nil
 		}
 		ctrl.isLeader = false
+		ctrl.stopPublishRates()
+		ctrl.slo.Stop()
+		ctrl.jobMgr.Stop()
 		ctrl.eventLogMgr.Stop()
 		ctrl.ssMgr.Stop(ctx)
 	}
@@ -570,6 +1130,12 @@ func (ctrl *controller) loadEventbus(ctx context.Context) error {
 			return err
 		}
 		ctrl.eventBusMap[filepath.Base(pair.Key)] = busInfo
+		if len(busInfo.Dictionary) != 0 {
+			ctrl.dictionaries.Store(busInfo.ID, busInfo.Dictionary)
+		}
+		if busInfo.Retention > 0 {
+			ctrl.retentions.Store(busInfo.ID, busInfo.Retention)
+		}
 	}
 	metrics.EventbusGauge.Set(float64(len(ctrl.eventBusMap)))
 	return nil
@@ -578,6 +1144,7 @@ func (ctrl *controller) loadEventbus(ctx context.Context) error {
 func (ctrl *controller) stop(ctx context.Context, err error) {
 	ctrl.member.ResignIfLeader()
 	ctrl.cancelFunc()
+	ctrl.jobMgr.Stop()
 	ctrl.stopNotify <- err
 	if err := ctrl.kvStore.Close(); err != nil {
 		log.Warning(ctx, "close kv client error", map[string]interface{}{
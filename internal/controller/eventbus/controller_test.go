@@ -16,16 +16,25 @@ package eventbus
 
 import (
 	stdCtx "context"
+	stdJson "encoding/json"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	embedetcd "github.com/linkall-labs/embed-etcd"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	triggermetadata "github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/vsb/dictionary"
+	dicttest "github.com/linkall-labs/vanus/internal/store/vsb/dictionary/testing"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
@@ -36,7 +45,7 @@ func TestController_CreateEventBus(t *testing.T) {
 	Convey("test create eventbus", t, func() {
 		cfg := Config{}
 		cfg.Topology = map[string]string{"1": "a", "2": "b"}
-		ctrl := NewController(cfg, nil)
+		ctrl := NewController(cfg, nil, usage.NewStore(usage.Config{}))
 		mockCtrl := gomock.NewController(t)
 		kvCli := kv.NewMockClient(mockCtrl)
 		ctrl.kvStore = kvCli
@@ -57,20 +66,25 @@ func TestController_CreateEventBus(t *testing.T) {
 			el := &metadata.Eventlog{
 				ID: vanus.NewTestID(),
 			}
-			elMgr.EXPECT().AcquireEventLog(ctx, gomock.Any()).Times(1).DoAndReturn(func(ctx stdCtx.Context,
-				eventbusID vanus.ID) (*metadata.Eventlog, error) {
-				el.ID = eventbusID
-				el.SegmentNumber = 2
-				return el, nil
-			})
+			elMgr.EXPECT().AcquireEventLog(ctx, gomock.Any(), uint(0), metapb.StorageMode_STORAGE_MEMORY).Times(1).
+				DoAndReturn(func(ctx stdCtx.Context, eventbusID vanus.ID, _ uint,
+					_ metapb.StorageMode) (*metadata.Eventlog, error) {
+					el.ID = eventbusID
+					el.SegmentNumber = 2
+					return el, nil
+				})
 
 			vanus.InitFakeSnowflake()
 			res, err := ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
-				Name:      "test-1",
-				LogNumber: 0,
+				Name:          "test-1",
+				LogNumber:     0,
+				OrderingScope: metapb.OrderingScope_ORDERING_PER_KEY,
+				StorageMode:   metapb.StorageMode_STORAGE_MEMORY,
 			})
 			So(err, ShouldBeNil)
 			So(res.Name, ShouldEqual, "test-1")
+			So(res.OrderingScope, ShouldEqual, metapb.OrderingScope_ORDERING_PER_KEY)
+			So(res.StorageMode, ShouldEqual, metapb.StorageMode_STORAGE_MEMORY)
 			So(res.Id, ShouldNotEqual, 0)
 			So(res.Logs, ShouldHaveLength, 1)
 			So(res.LogNumber, ShouldEqual, 1)
@@ -83,6 +97,98 @@ func TestController_CreateEventBus(t *testing.T) {
 			So(exist, ShouldBeTrue)
 		})
 
+		Convey("test create a eventbus from profile", func() {
+			profile := &metadata.EventbusProfile{Name: "test-profile", LogNumber: 2, Replicas: 5,
+				Retention: 30 * 24 * time.Hour, OrderingScope: metapb.OrderingScope_ORDERING_PER_KEY}
+			data, _ := stdJson.Marshal(profile)
+			kvCli.EXPECT().Get(ctx, metadata.GetEventbusProfileMetadataKey("test-profile")).AnyTimes().Return(data, nil)
+			kvCli.EXPECT().Get(ctx, metadata.GetEventbusProfileMetadataKey("not-exist")).Times(1).
+				Return(nil, kv.ErrKeyNotFound)
+			invalid, _ := stdJson.Marshal(&metadata.EventbusProfile{Name: "invalid", LogNumber: 1, Replicas: 2})
+			kvCli.EXPECT().Get(ctx, metadata.GetEventbusProfileMetadataKey("invalid")).Times(1).Return(invalid, nil)
+			ssMgr := server.NewMockManager(mockCtrl)
+			ctrl.ssMgr = ssMgr
+
+			_, err := ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:    "test-1",
+				Profile: "not-exist",
+			})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+			_, err = ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:      "test-1",
+				LogNumber: 3,
+				Profile:   "test-profile",
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			_, err = ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:        "test-1",
+				Profile:     "test-profile",
+				RetentionMs: time.Hour.Milliseconds(),
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			_, err = ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:          "test-1",
+				Profile:       "test-profile",
+				OrderingScope: metapb.OrderingScope_ORDERING_PER_EVENTLOG,
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			_, err = ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:    "test-1",
+				Profile: "invalid",
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			// capacity is checked against replicas of the profile.
+			ssMgr.EXPECT().CanCreateEventbus(ctx, 5).Times(1).Return(false)
+			_, err = ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:    "test-1",
+				Profile: "test-profile",
+			})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			ssMgr.EXPECT().CanCreateEventbus(ctx, 5).Times(1).Return(true)
+
+			kvCli.EXPECT().Exists(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).Return(false, nil)
+			kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey("test-1"), gomock.Any()).
+				Times(1).Return(nil)
+			elMgr.EXPECT().AcquireEventLog(ctx, gomock.Any(), uint(5), gomock.Any()).Times(2).DoAndReturn(func(ctx stdCtx.Context,
+				eventbusID vanus.ID, replicas uint, _ metapb.StorageMode) (*metadata.Eventlog, error) {
+				return &metadata.Eventlog{ID: vanus.NewTestID(), EventbusID: eventbusID, Replicas: replicas}, nil
+			})
+
+			vanus.InitFakeSnowflake()
+			res, err := ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:    "test-1",
+				Profile: "test-profile",
+			})
+			So(err, ShouldBeNil)
+			So(res.LogNumber, ShouldEqual, 2)
+			So(res.Logs, ShouldHaveLength, 2)
+			So(res.Profile, ShouldEqual, "test-profile")
+			// retention and ordering scope of the profile are applied.
+			So(res.RetentionMs, ShouldEqual, (30 * 24 * time.Hour).Milliseconds())
+			So(res.OrderingScope, ShouldEqual, metapb.OrderingScope_ORDERING_PER_KEY)
+			So(ctrl.retentionOf(vanus.NewIDFromUint64(res.Id)), ShouldEqual, 30*24*time.Hour)
+		})
+
+		Convey("test create a eventbus with unknown ordering scope or storage mode", func() {
+			_, err := ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:          "test-1",
+				OrderingScope: metapb.OrderingScope(100),
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+			_, err = ctrl.CreateEventBus(ctx, &ctrlpb.CreateEventBusRequest{
+				Name:        "test-1",
+				StorageMode: metapb.StorageMode(100),
+			})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
 		Convey("test create a eventbus but exist", func() {
 			kvCli.EXPECT().Exists(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).Return(true, nil)
 
@@ -101,11 +207,83 @@ func TestController_CreateEventBus(t *testing.T) {
 	})
 }
 
+func TestController_CreateEventbusProfile(t *testing.T) {
+	Convey("test create eventbus profile", t, func() {
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+
+		_, err := ctrl.CreateEventbusProfile(ctx, &ctrlpb.EventbusProfile{Name: "p1", LogNumber: 0})
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		_, err = ctrl.CreateEventbusProfile(ctx, &ctrlpb.EventbusProfile{Name: "p1", LogNumber: 2, Replicas: 4})
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		_, err = ctrl.CreateEventbusProfile(ctx, &ctrlpb.EventbusProfile{Name: "p1", LogNumber: 2, RetentionMs: -1})
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		_, err = ctrl.CreateEventbusProfile(ctx, &ctrlpb.EventbusProfile{Name: "p1", LogNumber: 2,
+			OrderingScope: metapb.OrderingScope(100)})
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+		kvCli.EXPECT().Set(ctx, metadata.GetEventbusProfileMetadataKey("p1"), gomock.Any()).Times(1).Return(nil)
+		res, err := ctrl.CreateEventbusProfile(ctx, &ctrlpb.EventbusProfile{Name: "p1", LogNumber: 2, Replicas: 3,
+			RetentionMs: time.Hour.Milliseconds(), OrderingScope: metapb.OrderingScope_ORDERING_PER_EVENTLOG})
+		So(err, ShouldBeNil)
+		So(res.Replicas, ShouldEqual, 3)
+		So(res.RetentionMs, ShouldEqual, time.Hour.Milliseconds())
+		So(res.OrderingScope, ShouldEqual, metapb.OrderingScope_ORDERING_PER_EVENTLOG)
+	})
+}
+
+func TestController_SetEventBusDictionary(t *testing.T) {
+	Convey("test set dictionary of eventbus", t, func() {
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		ctx := stdCtx.Background()
+
+		md := &metadata.Eventbus{ID: vanus.NewTestID(), Name: "test-1", LogNumber: 1}
+		ctrl.eventBusMap["test-1"] = md
+
+		dict := dicttest.Dictionary
+		_, err := ctrl.SetEventBusDictionary(ctx, &ctrlpb.SetEventBusDictionaryRequest{
+			Name:       "test-1",
+			Dictionary: []byte("not a zstd dictionary"),
+		})
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+		// the first ID is assigned.
+		kvCli.EXPECT().Get(ctx, metadata.DictionaryIDKeyInKVStore).Times(1).Return(nil, kv.ErrKeyNotFound)
+		kvCli.EXPECT().Set(ctx, metadata.DictionaryIDKeyInKVStore, []byte("32768")).Times(1).Return(nil)
+		kvCli.EXPECT().Set(ctx, metadata.GetEventbusMetadataKey("test-1"), gomock.Any()).Times(2).Return(nil)
+		res, err := ctrl.SetEventBusDictionary(ctx, &ctrlpb.SetEventBusDictionaryRequest{
+			Name:       "test-1",
+			Dictionary: dict,
+		})
+		So(err, ShouldBeNil)
+		So(res.DictionaryId, ShouldEqual, dictionary.MinID)
+
+		// the same dictionary is assigned another ID.
+		kvCli.EXPECT().Get(ctx, metadata.DictionaryIDKeyInKVStore).Times(1).Return([]byte("32768"), nil)
+		kvCli.EXPECT().Set(ctx, metadata.DictionaryIDKeyInKVStore, []byte("32769")).Times(1).Return(nil)
+		res, err = ctrl.SetEventBusDictionary(ctx, &ctrlpb.SetEventBusDictionaryRequest{
+			Name:       "test-1",
+			Dictionary: dict,
+		})
+		So(err, ShouldBeNil)
+		So(res.DictionaryId, ShouldEqual, dictionary.MinID+1)
+		id, err := dictionary.ID(ctrl.dictionaryOf(md.ID))
+		So(err, ShouldBeNil)
+		So(id, ShouldEqual, dictionary.MinID+1)
+	})
+}
+
 func TestController_DeleteEventBus(t *testing.T) {
 	Convey("test delete a eventbus ", t, func() {
 		cfg := Config{}
 		cfg.Topology = map[string]string{"1": "a", "2": "b"}
-		ctrl := NewController(cfg, nil)
+		ctrl := NewController(cfg, nil, usage.NewStore(usage.Config{}))
 		mockCtrl := gomock.NewController(t)
 		kvCli := kv.NewMockClient(mockCtrl)
 		ctrl.kvStore = kvCli
@@ -157,8 +335,16 @@ func TestController_DeleteEventBus(t *testing.T) {
 			kvCli.EXPECT().Delete(ctx, metadata.GetEventbusMetadataKey("test-1")).Times(1).
 				Return(nil)
 
-			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[0].ID).Times(1)
-			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[1].ID).Times(1)
+			jobMgr := job.NewMockManager(mockCtrl)
+			ctrl.jobMgr = jobMgr
+			jobMgr.EXPECT().Submit(ctx, job.Spec{
+				Kind: jobKindDeleteEventlogs,
+				Key:  md.ID.Key(),
+				Params: map[string]string{
+					jobParamEventbus:  "test-1",
+					jobParamEventlogs: md.EventLogs[0].ID.Key() + "," + md.EventLogs[1].ID.Key(),
+				},
+			}).Times(1).Return(&job.Job{}, nil)
 
 			ctrl.eventBusMap["test-1"] = md
 			_, err := ctrl.DeleteEventBus(stdCtx.Background(), &metapb.EventBus{Name: "test-1"})
@@ -167,5 +353,123 @@ func TestController_DeleteEventBus(t *testing.T) {
 			_, exist := ctrl.eventBusMap["test-1"]
 			So(exist, ShouldBeFalse)
 		})
+
+		Convey("deleting eventlogs by job", func() {
+			e := job.NewTestExecution(map[string]string{
+				jobParamEventbus:  "test-1",
+				jobParamEventlogs: md.EventLogs[0].ID.Key() + "," + md.EventLogs[1].ID.Key(),
+			})
+			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[0].ID).Times(1)
+			elMgr.EXPECT().DeleteEventlog(ctx, md.EventLogs[1].ID).Times(1)
+			So(ctrl.deleteEventlogsJob(ctx, e), ShouldBeNil)
+			So(e.Cursor(), ShouldEqual, "2")
+
+			// the resumed job skips deleted eventlogs.
+			So(ctrl.deleteEventlogsJob(ctx, e), ShouldBeNil)
+		})
+	})
+}
+
+func TestController_TruncateEventLog(t *testing.T) {
+	Convey("test truncate a eventlog", t, func() {
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		mockCtrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventLogMgr = elMgr
+		ctx := stdCtx.Background()
+
+		elID := vanus.NewTestID()
+		subID := vanus.NewTestID()
+		ebID := vanus.NewTestID()
+		ctrl.eventBusMap["test-1"] = &metadata.Eventbus{ID: ebID, Name: "test-1"}
+		elMgr.EXPECT().GetEventLog(ctx, elID).AnyTimes().Return(&metadata.Eventlog{ID: elID, EventbusID: ebID})
+		kvCli.EXPECT().List(ctx, "/trigger/offsets/").AnyTimes().Return([]kv.Pair{
+			{Key: filepath.Join("/trigger/offsets", subID.String(), elID.String()), Value: []byte("100")},
+			{Key: filepath.Join("/trigger/offsets", subID.String(), vanus.NewTestID().String()), Value: []byte("0")},
+		}, nil)
+		sub, _ := stdJson.Marshal(&triggermetadata.Subscription{ID: subID, EventBus: "test-1"})
+		other, _ := stdJson.Marshal(&triggermetadata.Subscription{ID: vanus.NewTestID(), EventBus: "test-2"})
+		subscriptions := []kv.Pair{{Key: "/trigger/subscriptions/" + subID.String(), Value: sub},
+			{Key: "/trigger/subscriptions/other", Value: other}}
+		kvCli.EXPECT().List(ctx, "/trigger/subscriptions/").AnyTimes().DoAndReturn(
+			func(_ stdCtx.Context, _ string) ([]kv.Pair, error) {
+				return subscriptions, nil
+			})
+
+		Convey("test invalid offset", func() {
+			_, err := ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{EventLogId: elID.Uint64()})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("test a subscription is behind the offset", func() {
+			_, err := ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{
+				EventLogId: elID.Uint64(),
+				Offset:     200,
+			})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+		})
+
+		Convey("test a subscription hasn't committed an offset", func() {
+			noOffsetID := vanus.NewTestID()
+			noOffset, _ := stdJson.Marshal(&triggermetadata.Subscription{ID: noOffsetID, EventBus: "test-1"})
+			subscriptions = append(subscriptions, kv.Pair{
+				Key: "/trigger/subscriptions/" + noOffsetID.String(), Value: noOffset,
+			})
+			_, err := ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{
+				EventLogId: elID.Uint64(),
+				Offset:     1,
+			})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+			So(err.Error(), ShouldContainSubstring, noOffsetID.String())
+
+			elMgr.EXPECT().TruncateBefore(ctx, elID, int64(1)).Times(1).Return([]*eventlog.Segment{}, nil)
+			_, err = ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{
+				EventLogId: elID.Uint64(),
+				Offset:     1,
+				Force:      true,
+			})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test an offset is unreadable", func() {
+			unreadable := kv.NewMockClient(mockCtrl)
+			ctrl.kvStore = unreadable
+			unreadable.EXPECT().List(ctx, "/trigger/offsets/").AnyTimes().Return([]kv.Pair{
+				{Key: filepath.Join("/trigger/offsets", subID.String(), elID.String()), Value: []byte("x")},
+			}, nil)
+			_, err := ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{
+				EventLogId: elID.Uint64(),
+				Offset:     100,
+			})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			elMgr.EXPECT().TruncateBefore(ctx, elID, int64(100)).Times(1).Return([]*eventlog.Segment{}, nil)
+			_, err = ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{
+				EventLogId: elID.Uint64(),
+				Offset:     100,
+				Force:      true,
+			})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test truncate success", func() {
+			elMgr.EXPECT().TruncateBefore(ctx, elID, int64(100)).Times(1).Return([]*eventlog.Segment{}, nil)
+			res, err := ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{
+				EventLogId: elID.Uint64(),
+				Offset:     100,
+			})
+			So(err, ShouldBeNil)
+			So(res.Segments, ShouldBeEmpty)
+
+			elMgr.EXPECT().TruncateBefore(ctx, elID, int64(200)).Times(1).Return([]*eventlog.Segment{}, nil)
+			_, err = ctrl.TruncateEventLog(ctx, &ctrlpb.TruncateEventLogRequest{
+				EventLogId: elID.Uint64(),
+				Offset:     200,
+				Force:      true,
+			})
+			So(err, ShouldBeNil)
+		})
 	})
 }
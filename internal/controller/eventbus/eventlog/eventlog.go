@@ -28,6 +28,7 @@ import (
 	"github.com/huandu/skiplist"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/block"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
@@ -35,6 +36,7 @@ import (
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	"github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
@@ -50,9 +52,12 @@ const (
 type Manager interface {
 	Run(ctx context.Context, kvClient kv.Client, startTask bool) error
 	Stop()
-	AcquireEventLog(ctx context.Context, eventbusID vanus.ID) (*metadata.Eventlog, error)
+	// AcquireEventLog creates an eventlog whose segments have #{replicas} replicas, 0 means the default.
+	AcquireEventLog(ctx context.Context, eventbusID vanus.ID, replicas uint,
+		mode metapb.StorageMode) (*metadata.Eventlog, error)
 	GetEventLog(ctx context.Context, id vanus.ID) *metadata.Eventlog
 	DeleteEventlog(ctx context.Context, id vanus.ID)
+	TruncateBefore(ctx context.Context, id vanus.ID, offset int64) ([]*Segment, error)
 	GetEventLogSegmentList(elID vanus.ID) []*Segment
 	GetAppendableSegment(ctx context.Context, eli *metadata.Eventlog,
 		num int) ([]*Segment, error)
@@ -61,14 +66,30 @@ type Manager interface {
 	GetBlock(id vanus.ID) *metadata.Block
 	GetSegment(id vanus.ID) *Segment
 	UpdateSegmentReplicas(ctx context.Context, segID vanus.ID, term uint64) error
+	FailoverVolume(ctx context.Context, volumeID vanus.ID) int
+	// SetDictionaryResolver sets the resolver of compression dictionaries, which segments are activated with.
+	SetDictionaryResolver(resolve DictionaryResolver)
+	// SetRetentionResolver sets the resolver of retentions, full segments older than them are deleted.
+	SetRetentionResolver(resolve RetentionResolver)
+	// SetWriteLease sets the TTL and the renew interval of write leases, it must be called before Run.
+	SetWriteLease(cfg WriteLeaseConfig)
 }
 
+// DictionaryResolver returns the zstd dictionary of an eventbus, it returns nil if the eventbus has none.
+type DictionaryResolver func(eventbusID vanus.ID) []byte
+
+// RetentionResolver returns the retention of an eventbus, it returns 0 if the eventbus uses the default.
+type RetentionResolver func(eventbusID vanus.ID) time.Duration
+
 var mgr = &eventlogManager{
 	segmentReplicaNum:           defaultSegmentReplicaNumber,
 	scaleInterval:               defaultScaleInterval,
 	cleanInterval:               defaultCleanInterval,
 	checkSegmentExpiredInterval: defaultCheckExpiredSegmentInterval,
 	segmentExpiredTime:          defaultSegmentExpiredTime,
+	writeLeaseTTL:               defaultWriteLeaseTTL,
+	writeLeaseRenewInterval:     defaultWriteLeaseRenewInterval,
+	repairInterval:              defaultRepairInterval,
 }
 
 type eventlogManager struct {
@@ -94,18 +115,66 @@ type eventlogManager struct {
 	cleanInterval               time.Duration
 	checkSegmentExpiredInterval time.Duration
 	segmentExpiredTime          time.Duration
+	writeLeaseTTL               time.Duration
+	writeLeaseRenewInterval     time.Duration
+	repairInterval              time.Duration
 	createSegmentMutex          sync.Mutex
+	dictionaryOf                DictionaryResolver
+	retentionOf                 RetentionResolver
 }
 
-func NewManager(volMgr volume.Manager, replicaNum uint, defaultBlockSize int64) Manager {
+func NewManager(volMgr volume.Manager, replicaNum uint, defaultBlockSize int64,
+	allocation block.SelectorConfig) Manager {
 	mgr.volMgr = volMgr
 	if replicaNum > 0 {
 		mgr.segmentReplicaNum = replicaNum
 	}
-	mgr.allocator = block.NewAllocator(defaultBlockSize, block.NewVolumeRoundRobin(mgr.volMgr.GetAllActiveVolumes))
+	mgr.allocator = block.NewAllocator(defaultBlockSize, newVolumeSelector(allocation.Strategy, allocation),
+		newEventbusVolumeSelectors(allocation))
 	return mgr
 }
 
+func (mgr *eventlogManager) SetDictionaryResolver(resolve DictionaryResolver) {
+	mgr.dictionaryOf = resolve
+}
+
+func (mgr *eventlogManager) SetRetentionResolver(resolve RetentionResolver) {
+	mgr.retentionOf = resolve
+}
+
+// retention returns how long events of elog are kept.
+func (mgr *eventlogManager) retention(elog *eventlog) time.Duration {
+	if mgr.retentionOf != nil {
+		if r := mgr.retentionOf(elog.md.EventbusID); r > 0 {
+			return r
+		}
+	}
+	return mgr.segmentExpiredTime
+}
+
+// newVolumeSelector creates selector of strategy, the config has been validated when it was loaded, round-robin
+// is used in case of the strategy is unknown.
+func newVolumeSelector(strategy string, cfg block.SelectorConfig) block.VolumeSelector {
+	selector, err := block.NewVolumeSelector(strategy, cfg, mgr.volMgr.GetAllActiveVolumes)
+	if err != nil {
+		log.Error(context.Background(), "create volume selector failed, use round-robin instead",
+			map[string]interface{}{
+				log.KeyError: err,
+				"strategy":   strategy,
+			})
+		return block.NewVolumeRoundRobin(mgr.volMgr.GetAllActiveVolumes)
+	}
+	return selector
+}
+
+func newEventbusVolumeSelectors(cfg block.SelectorConfig) map[string]block.VolumeSelector {
+	selectors := make(map[string]block.VolumeSelector, len(cfg.Eventbus))
+	for eventbus, strategy := range cfg.Eventbus {
+		selectors[eventbus] = newVolumeSelector(strategy, cfg)
+	}
+	return selectors
+}
+
 func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTask bool) error {
 	if mgr.checkSegmentExpiredInterval == 0 {
 		mgr.checkSegmentExpiredInterval = defaultCheckExpiredSegmentInterval
@@ -116,6 +185,15 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 	if mgr.cleanInterval == 0 {
 		mgr.cleanInterval = defaultCleanInterval
 	}
+	if mgr.writeLeaseTTL == 0 {
+		mgr.writeLeaseTTL = defaultWriteLeaseTTL
+	}
+	if mgr.writeLeaseRenewInterval == 0 {
+		mgr.writeLeaseRenewInterval = defaultWriteLeaseRenewInterval
+	}
+	if mgr.repairInterval == 0 {
+		mgr.repairInterval = defaultRepairInterval
+	}
 	mgr.kvClient = kvClient
 	if err := mgr.allocator.Run(ctx, mgr.kvClient, true); err != nil {
 		return err
@@ -156,6 +234,8 @@ func (mgr *eventlogManager) Run(ctx context.Context, kvClient kv.Client, startTa
 		go mgr.dynamicScaleUpEventLog(cancelCtx)
 		go mgr.cleanAbnormalSegment(cancelCtx)
 		go mgr.checkSegmentExpired(cancelCtx)
+		go mgr.renewWriteLeases(cancelCtx)
+		go mgr.repairCorruptedReplicas(cancelCtx)
 	}
 	return nil
 }
@@ -165,7 +245,8 @@ func (mgr *eventlogManager) Stop() {
 	mgr.allocator.Stop()
 }
 
-func (mgr *eventlogManager) AcquireEventLog(ctx context.Context, eventbusID vanus.ID) (*metadata.Eventlog, error) {
+func (mgr *eventlogManager) AcquireEventLog(ctx context.Context,
+	eventbusID vanus.ID, replicas uint, mode metapb.StorageMode) (*metadata.Eventlog, error) {
 	mgr.mutex.Lock()
 	defer mgr.mutex.Unlock()
 
@@ -178,8 +259,10 @@ func (mgr *eventlogManager) AcquireEventLog(ctx context.Context, eventbusID vanu
 		return nil, err
 	}
 	elMD := &metadata.Eventlog{
-		ID:         id,
-		EventbusID: eventbusID,
+		ID:          id,
+		EventbusID:  eventbusID,
+		Replicas:    replicas,
+		StorageMode: mode,
 	}
 	data, _ := json.Marshal(elMD)
 	if err := mgr.kvClient.Set(ctx, metadata.GetEventlogMetadataKey(elMD.ID), data); err != nil {
@@ -250,6 +333,37 @@ func (mgr *eventlogManager) DeleteEventlog(ctx context.Context, id vanus.ID) {
 	}
 }
 
+// TruncateBefore deletes full segments whose events are all below the offset, the segments are
+// removed from eventlog immediately and their blocks will be cleaned asynchronously.
+func (mgr *eventlogManager) TruncateBefore(ctx context.Context, id vanus.ID, offset int64) ([]*Segment, error) {
+	el := mgr.getEventLog(id)
+	if el == nil {
+		return nil, errors.ErrEventLogNotFound
+	}
+
+	deleted := make([]*Segment, 0)
+	for head := el.head(); head != nil; head = el.head() {
+		if !head.isFull() || head.StartOffsetInLog+int64(head.Number) > offset {
+			break
+		}
+		if err := el.deleteHead(ctx); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, head)
+		_, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head)
+		if !ok {
+			metrics.SegmentDeletedCounterVec.WithLabelValues(metrics.LabelSegmentDeletedBecauseTruncated).Inc()
+		}
+		log.Info(ctx, "the segment has been truncated", map[string]interface{}{
+			"eventlog_id":  id.Key(),
+			"segment_id":   head.ID.Key(),
+			"start_offset": head.StartOffsetInLog,
+			"number":       head.Number,
+		})
+	}
+	return deleted, nil
+}
+
 func (mgr *eventlogManager) GetAppendableSegment(ctx context.Context,
 	eli *metadata.Eventlog, num int) ([]*Segment, error) {
 	result := make([]*Segment, 0)
@@ -413,6 +527,10 @@ func (mgr *eventlogManager) stop() {
 func (mgr *eventlogManager) getSegmentTopology(_ context.Context, seg *Segment) map[uint64]string {
 	var addrs = map[uint64]string{}
 	for _, v := range seg.Replicas.Peers {
+		if v.Lost {
+			// the replica is offline until it's repaired, so it isn't routed to.
+			continue
+		}
 		ins := mgr.volMgr.GetVolumeInstanceByID(v.VolumeID)
 		if ins == nil {
 			log.Error(context.TODO(), "the volume of block not found", map[string]interface{}{
@@ -585,12 +703,13 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 			mgr.eventLogMap.Range(func(key, value interface{}) bool {
 				elog, _ := value.(*eventlog)
 				head := elog.head()
+				retention := mgr.retention(elog)
 				checkCtx := context.Background()
 				for head != nil {
 					switch {
 					case head.LastEventBornTime.Second() == 0:
 						// TODO(wenfeng.wang) fix if set
-						head.LastEventBornTime = time.Now().Add(mgr.segmentExpiredTime)
+						head.LastEventBornTime = time.Now().Add(retention)
 						elog.lock()
 						if err := elog.updateSegment(checkCtx, head); err != nil {
 							log.Warning(ctx, "update segment's metadata failed", map[string]interface{}{
@@ -604,7 +723,7 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 						return true
 					case !head.isFull():
 						return true
-					case time.Since(head.LastEventBornTime.Add(mgr.segmentExpiredTime)) > 0:
+					case time.Since(head.LastEventBornTime.Add(retention)) > 0:
 						err := elog.deleteHead(ctx)
 						if err != nil {
 							log.Warning(ctx, "delete segment error", map[string]interface{}{
@@ -624,10 +743,13 @@ func (mgr *eventlogManager) checkSegmentExpired(ctx context.Context) {
 							"first_event_time": head.FirstEventBornTime,
 							"time":             time.Now(),
 							"number":           head.Number,
+							"retention":        retention,
+							"reclaimed_bytes":  head.Size,
 						})
 						_, ok := mgr.segmentNeedBeClean.LoadOrStore(head.ID.Key(), head)
 						if !ok {
 							metrics.SegmentDeletedCounterVec.WithLabelValues(metrics.LabelSegmentDeletedBecauseExpired).Add(1)
+							metrics.RetentionReclaimedBytesCounter.Add(float64(head.Size))
 						}
 					default:
 						return true
@@ -698,12 +820,19 @@ func (mgr *eventlogManager) createSegment(ctx context.Context, el *eventlog) (*S
 	if srv == nil {
 		return nil, errors.ErrVolumeInstanceNoServer
 	}
-	_, err = srv.GetClient().ActivateSegment(ctx, &segment.ActivateSegmentRequest{
+	req := &segment.ActivateSegmentRequest{
 		EventLogId:     seg.EventLogID.Uint64(),
 		ReplicaGroupId: seg.Replicas.ID.Uint64(),
 		Replicas:       mgr.getSegmentTopology(ctx, seg),
+		Lease:          mgr.writeLeaseOf(seg, seg.GetLeaderBlock()),
+	}
+	if mgr.dictionaryOf != nil {
+		req.CompressionDictionary = mgr.dictionaryOf(el.md.EventbusID)
+	}
+	err = server.StoreRPC.Do(ctx, func(ctx context.Context) error {
+		_, err := srv.GetClient().ActivateSegment(ctx, req)
+		return err
 	})
-
 	if err != nil {
 		log.Warning(context.TODO(), "activate segment failed", map[string]interface{}{
 			log.KeyError: err,
@@ -749,14 +878,18 @@ func (mgr *eventlogManager) generateSegment(ctx context.Context, el *eventlog) (
 	var blocks []*metadata.Block
 	var err error
 	if cur == nil {
-		blocks, err = mgr.allocator.Pick(ctx, int(mgr.segmentReplicaNum))
+		replicas := mgr.segmentReplicaNum
+		if el.md.Replicas > 0 {
+			replicas = el.md.Replicas
+		}
+		blocks, err = mgr.allocator.Pick(ctx, int(replicas), el.md.EventbusName, el.md.StorageMode)
 	} else {
 		// make sure segments of one eventlog located in one SegmentServer
 		volumes := make([]vanus.ID, 0)
 		for _, peer := range cur.Replicas.Peers {
 			volumes = append(volumes, peer.VolumeID)
 		}
-		blocks, err = mgr.allocator.PickByVolumes(ctx, volumes)
+		blocks, err = mgr.allocator.PickByVolumes(ctx, volumes, el.md.StorageMode)
 	}
 
 	if err != nil {
@@ -790,7 +923,8 @@ func (mgr *eventlogManager) generateSegment(ctx context.Context, el *eventlog) (
 			CreateAt:  time.Now(),
 			DestroyAt: time.Now(),
 		},
-		State: StateCreated,
+		State:      StateCreated,
+		LeaseEpoch: el.nextLeaseEpoch(),
 	}
 
 	data, _ := json.Marshal(seg)
@@ -959,7 +1093,7 @@ func (el *eventlog) add(ctx context.Context, seg *Segment) error {
 	if last != nil {
 		last.NextSegmentID = seg.ID
 		seg.PreviousSegmentID = last.ID
-		if last.State == StateFrozen {
+		if last.State == StateFrozen || last.State == StateUnavailable {
 			seg.StartOffsetInLog = last.StartOffsetInLog + int64(last.Number)
 		}
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -188,7 +189,8 @@ func TestEventlogManager_ScaleSegmentTask(t *testing.T) {
 		}
 		vanus.InitFakeSnowflake()
 		alloc.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
-		alloc.EXPECT().Pick(gomock.Any(), 3).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, num int) ([]*metadata.Block, error) {
+		alloc.EXPECT().Pick(gomock.Any(), 3, gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, num int,
+			eventbus string, _ metapb.StorageMode) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       vanus.NewTestID(),
@@ -208,7 +210,7 @@ func TestEventlogManager_ScaleSegmentTask(t *testing.T) {
 			}, nil
 		})
 
-		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, volumes []vanus.ID) ([]*metadata.Block, error) {
+		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, volumes []vanus.ID, _ metapb.StorageMode) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       vanus.NewTestID(),
@@ -241,6 +243,7 @@ func TestEventlogManager_ScaleSegmentTask(t *testing.T) {
 		// suspend those tasks
 		utMgr.cleanInterval = time.Hour
 		utMgr.checkSegmentExpiredInterval = time.Hour
+		utMgr.writeLeaseRenewInterval = time.Hour
 		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(1).Return([]kv.Pair{}, nil)
 		err := utMgr.Run(ctx, kvCli, true)
 		So(err, ShouldBeNil)
@@ -317,7 +320,8 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 			Capacity: 64 * 1024 * 1024 * 1024,
 		}
 		alloc.EXPECT().Run(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(nil)
-		alloc.EXPECT().Pick(gomock.Any(), 3).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, num int) ([]*metadata.Block, error) {
+		alloc.EXPECT().Pick(gomock.Any(), 3, gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, num int,
+			eventbus string, _ metapb.StorageMode) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       vanus.NewTestID(),
@@ -336,7 +340,7 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 				},
 			}, nil
 		})
-		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, volumes []vanus.ID) ([]*metadata.Block, error) {
+		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx stdCtx.Context, volumes []vanus.ID, _ metapb.StorageMode) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       vanus.NewTestID(),
@@ -368,6 +372,7 @@ func TestEventlogManager_CleanSegmentTask(t *testing.T) {
 		utMgr.scaleInterval = 5 * time.Millisecond
 		utMgr.cleanInterval = 5 * time.Millisecond
 		utMgr.checkSegmentExpiredInterval = time.Hour
+		utMgr.writeLeaseRenewInterval = time.Hour
 		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Times(1).Return([]kv.Pair{}, nil)
 		err := utMgr.Run(ctx, kvCli, true)
 		So(err, ShouldBeNil)
@@ -430,7 +435,8 @@ func TestEventlogManager_CreateAndGetEventlog(t *testing.T) {
 			ID:       vanus.NewTestID(),
 			Capacity: 64 * 1024 * 1024 * 1024,
 		}
-		alloc.EXPECT().Pick(ctx, 3).Times(1).DoAndReturn(func(ctx stdCtx.Context, num int) ([]*metadata.Block, error) {
+		alloc.EXPECT().Pick(ctx, 3, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx stdCtx.Context, num int,
+			eventbus string, _ metapb.StorageMode) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       vanus.NewTestID(),
@@ -449,7 +455,7 @@ func TestEventlogManager_CreateAndGetEventlog(t *testing.T) {
 				},
 			}, nil
 		})
-		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx stdCtx.Context, volumes []vanus.ID) ([]*metadata.Block, error) {
+		alloc.EXPECT().PickByVolumes(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx stdCtx.Context, volumes []vanus.ID, _ metapb.StorageMode) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       vanus.NewTestID(),
@@ -479,7 +485,7 @@ func TestEventlogManager_CreateAndGetEventlog(t *testing.T) {
 		grpcCli.EXPECT().ActivateSegment(ctx, gomock.Any()).Times(2).Return(nil, nil)
 
 		eventbusID := vanus.NewTestID()
-		logMD, err := utMgr.AcquireEventLog(ctx, eventbusID)
+		logMD, err := utMgr.AcquireEventLog(ctx, eventbusID, 0, metapb.StorageMode_STORAGE_DURABLE)
 		Convey("validate metadata", func() {
 			So(err, ShouldBeNil)
 			So(logMD.EventbusID, ShouldEqual, eventbusID)
@@ -580,6 +586,62 @@ func TestEventlogManager_DeleteEventlog(t *testing.T) {
 	})
 }
 
+func TestEventlogManager_TruncateBefore(t *testing.T) {
+	Convey("test TruncateBefore", t, func() {
+		utMgr := &eventlogManager{segmentReplicaNum: 3}
+		ctrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(ctrl)
+		utMgr.kvClient = kvCli
+		ctx := stdCtx.Background()
+
+		Convey("the eventlog doesn't exist", func() {
+			segs, err := utMgr.TruncateBefore(ctx, vanus.NewTestID(), 100)
+			So(err, ShouldEqual, errors.ErrEventLogNotFound)
+			So(segs, ShouldBeEmpty)
+		})
+
+		Convey("only full segments below the offset are deleted", func() {
+			md := &metadata.Eventlog{
+				ID:         vanus.NewTestID(),
+				EventbusID: vanus.NewTestID(),
+			}
+			el, err := newEventlog(ctx, md, kvCli, false)
+			So(err, ShouldBeNil)
+			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+			seg1 := createTestSegment(vanus.NewTestID())
+			seg1.Number = 100
+			seg1.State = StateFrozen
+			seg2 := createTestSegment(vanus.NewTestID())
+			seg2.Number = 100
+			seg2.State = StateFrozen
+			seg3 := createTestSegment(vanus.NewTestID())
+			seg3.Number = 50
+			seg3.State = StateWorking
+			So(el.add(ctx, seg1), ShouldBeNil)
+			So(el.add(ctx, seg2), ShouldBeNil)
+			So(el.add(ctx, seg3), ShouldBeNil)
+			utMgr.eventLogMap.Store(md.ID.Key(), el)
+
+			segs, err := utMgr.TruncateBefore(ctx, md.ID, 150)
+			So(err, ShouldBeNil)
+			So(segs, ShouldHaveLength, 1)
+			So(segs[0].ID, ShouldEqual, seg1.ID)
+			So(el.head().ID, ShouldEqual, seg2.ID)
+
+			// the working segment is never deleted
+			segs, err = utMgr.TruncateBefore(ctx, md.ID, 1000)
+			So(err, ShouldBeNil)
+			So(segs, ShouldHaveLength, 1)
+			So(segs[0].ID, ShouldEqual, seg2.ID)
+			So(el.size(), ShouldEqual, 1)
+			So(el.head().ID, ShouldEqual, seg3.ID)
+			So(util.MapLen(&utMgr.segmentNeedBeClean), ShouldEqual, 2)
+		})
+	})
+}
+
 func TestEventlogManager_GetAppendableSegment(t *testing.T) {
 	Convey("test GetAppendableSegment", t, func() {
 		ctrl := gomock.NewController(t)
@@ -596,7 +658,8 @@ func TestEventlogManager_GetAppendableSegment(t *testing.T) {
 			ID:       vanus.NewTestID(),
 			Capacity: 64 * 1024 * 1024 * 1024,
 		}
-		alloc.EXPECT().Pick(ctx, 3).Times(1).DoAndReturn(func(ctx stdCtx.Context, num int) ([]*metadata.Block, error) {
+		alloc.EXPECT().Pick(ctx, 3, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(func(ctx stdCtx.Context, num int,
+			eventbus string, _ metapb.StorageMode) ([]*metadata.Block, error) {
 			return []*metadata.Block{
 				{
 					ID:       vanus.NewTestID(),
@@ -922,6 +985,82 @@ func Test_ExpiredSegmentDeleting(t *testing.T) {
 			So(util.MapLen(&utMgr.segmentNeedBeClean), ShouldEqual, 3)
 		})
 
+		Convey("test clean expired segment with retention of eventbus", func() {
+			kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+			utMgr.SetRetentionResolver(func(eventbusID vanus.ID) time.Duration {
+				if eventbusID == el1.md.EventbusID {
+					return 10 * time.Minute
+				}
+				if eventbusID == el2.md.EventbusID {
+					return 12 * time.Hour
+				}
+				return 0
+			})
+
+			s11 := &Segment{
+				ID:                 vanus.NewTestID(),
+				FirstEventBornTime: time.Now().Add(-1 * time.Hour),
+				LastEventBornTime:  time.Now().Add(-30 * time.Minute),
+				State:              StateFrozen,
+				Size:               1024,
+			}
+			s12 := &Segment{
+				ID:                 vanus.NewTestID(),
+				FirstEventBornTime: time.Now().Add(-30 * time.Minute),
+				LastEventBornTime:  time.Now().Add(-1 * time.Minute),
+				State:              StateFrozen,
+			}
+			el1.segmentList.Set(s11.ID.Uint64(), s11)
+			el1.segmentList.Set(s12.ID.Uint64(), s12)
+			el1.segments = []vanus.ID{s11.ID, s12.ID}
+
+			s21 := &Segment{
+				ID:                 vanus.NewTestID(),
+				FirstEventBornTime: time.Now().Add(-6 * time.Hour),
+				LastEventBornTime:  time.Now().Add(-3 * time.Hour),
+				State:              StateFrozen,
+			}
+			el2.segmentList.Set(s21.ID.Uint64(), s21)
+			el2.segments = []vanus.ID{s21.ID}
+
+			s31 := &Segment{
+				ID:                 vanus.NewTestID(),
+				FirstEventBornTime: time.Now().Add(-6 * time.Hour),
+				LastEventBornTime:  time.Now().Add(-3 * time.Hour),
+				State:              StateFrozen,
+			}
+			s32 := &Segment{
+				ID:                 vanus.NewTestID(),
+				FirstEventBornTime: time.Now().Add(-1 * time.Minute),
+				LastEventBornTime:  time.Now().Add(-1 * time.Millisecond),
+				State:              StateFrozen,
+			}
+			el3.segmentList.Set(s31.ID.Uint64(), s31)
+			el3.segmentList.Set(s32.ID.Uint64(), s32)
+			el3.segments = []vanus.ID{s31.ID, s32.ID}
+			cCtx, cancel := stdCtx.WithCancel(ctx)
+			ch := make(chan struct{})
+			go func() {
+				utMgr.checkSegmentExpired(cCtx)
+				ch <- struct{}{}
+			}()
+			time.Sleep(time.Second)
+			cancel()
+			<-ch
+
+			So(el1.segments, ShouldHaveLength, 1)
+			So(el1.segments[0], ShouldEqual, s12.ID)
+
+			So(el2.segments, ShouldHaveLength, 1)
+			So(el2.segments[0], ShouldEqual, s21.ID)
+
+			So(el3.segments, ShouldHaveLength, 1)
+			So(el3.segments[0], ShouldEqual, s32.ID)
+
+			So(util.MapLen(&utMgr.segmentNeedBeClean), ShouldEqual, 2)
+		})
+
 		Convey("test kv error", func() {
 			kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(kv.ErrUnknown)
 			kvCli.EXPECT().Delete(gomock.Any(), gomock.Any()).AnyTimes().Return(kv.ErrUnknown)
@@ -0,0 +1,163 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+// Gap records a segment given up because its replicas were lost. The segment may hold events appended after
+// the last heartbeat, those events are beyond Number and unreachable from the eventlog until the gap is
+// repaired, and they may overlap offsets of the following segments.
+type Gap struct {
+	EventlogID  vanus.ID  `json:"eventlog_id"`
+	SegmentID   vanus.ID  `json:"segment_id"`
+	VolumeID    vanus.ID  `json:"volume_id"`
+	StartOffset int64     `json:"start_offset"`
+	Number      int32     `json:"number"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// FailoverVolume gives up writable segments which can't make progress without blocks on the volume, and
+// allocates replacement segments on healthy volumes for affected eventlogs. It returns the number of
+// segments given up.
+func (mgr *eventlogManager) FailoverVolume(ctx context.Context, volumeID vanus.ID) int {
+	count := 0
+	mgr.eventLogMap.Range(func(key, value interface{}) bool {
+		el, _ := value.(*eventlog)
+		n := el.failover(ctx, volumeID)
+		if n == 0 {
+			return true
+		}
+		count += n
+
+		if el.currentAppendableSegment() != nil {
+			return true
+		}
+		seg, err := mgr.createSegment(ctx, el)
+		if err == nil {
+			err = el.add(ctx, seg)
+		}
+		if err != nil {
+			// The task of dynamic-scale will retry.
+			log.Warning(ctx, "allocate replacement segment failed", map[string]interface{}{
+				log.KeyError:  err,
+				"eventlog_id": el.md.ID,
+				"volume_id":   volumeID,
+			})
+			return true
+		}
+		metrics.SegmentCreationRuntimeCounterVec.WithLabelValues(metrics.LabelValueResourceDynamicCreate).Inc()
+		log.Info(ctx, "the replacement segment created", map[string]interface{}{
+			"segment_id":  seg.ID.Key(),
+			"eventlog_id": el.md.ID.Key(),
+		})
+		return true
+	})
+	return count
+}
+
+// failover marks blocks on the volume as lost, and gives up writable segments which lose the quorum.
+func (el *eventlog) failover(ctx context.Context, volumeID vanus.ID) int {
+	el.lock()
+	defer el.unlock()
+
+	count := 0
+	for node := el.segmentList.Front(); node != nil; node = node.Next() {
+		seg, _ := node.Value.(*Segment)
+		if seg.State != StateCreated && seg.State != StateWorking || seg.Replicas == nil {
+			continue
+		}
+
+		lost := 0
+		affected := false
+		for _, blk := range seg.Replicas.Peers {
+			if blk.VolumeID == volumeID && !blk.Lost {
+				blk.Lost = true
+				affected = true
+				data, _ := json.Marshal(blk)
+				if err := el.kvClient.Set(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID), data); err != nil {
+					log.Warning(ctx, "save lost block failed", map[string]interface{}{
+						log.KeyError: err,
+						"block_id":   blk.ID,
+					})
+				}
+			}
+			if blk.Lost {
+				lost++
+			}
+		}
+		if !affected || lost <= len(seg.Replicas.Peers)/2 {
+			if affected {
+				// Blocks are persisted with segment.
+				_ = el.updateSegment(ctx, seg)
+			}
+			continue
+		}
+
+		if err := el.giveUp(ctx, seg, volumeID); err != nil {
+			log.Warning(ctx, "give up segment failed", map[string]interface{}{
+				log.KeyError: err,
+				"segment":    seg.String(),
+			})
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		el.writePtr = nil
+	}
+	return count
+}
+
+// giveUp marks the segment unavailable and records the gap, the following segment starts from the last
+// known end of the segment.
+func (el *eventlog) giveUp(ctx context.Context, seg *Segment, volumeID vanus.ID) error {
+	gap := &Gap{
+		EventlogID:  el.md.ID,
+		SegmentID:   seg.ID,
+		VolumeID:    volumeID,
+		StartOffset: seg.StartOffsetInLog,
+		Number:      seg.Number,
+		CreatedAt:   time.Now(),
+	}
+	data, _ := json.Marshal(gap)
+	if err := el.kvClient.Set(ctx, metadata.GetSegmentGapMetadataKey(el.md.ID, seg.ID), data); err != nil {
+		return err
+	}
+
+	state := seg.State
+	seg.State = StateUnavailable
+	data, _ = json.Marshal(seg)
+	if err := el.kvClient.Set(ctx, metadata.GetSegmentMetadataKey(seg.ID), data); err != nil {
+		seg.State = state
+		return err
+	}
+	log.Warning(ctx, "the segment is unavailable because replicas are lost", map[string]interface{}{
+		"segment_id":   seg.ID.Key(),
+		"eventlog_id":  el.md.ID.Key(),
+		"volume_id":    volumeID,
+		"start_offset": seg.StartOffsetInLog,
+		"number":       seg.Number,
+	})
+	return el.markSegmentFull(ctx, seg)
+}
@@ -0,0 +1,102 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	stdCtx "context"
+	stdJson "encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventlogManager_FailoverVolume(t *testing.T) {
+	Convey("test FailoverVolume", t, func() {
+		utMgr := &eventlogManager{segmentReplicaNum: 3}
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		utMgr.kvClient = kvCli
+		ctx := stdCtx.Background()
+
+		md := &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}
+		el, err := newEventlog(ctx, md, kvCli, false)
+		So(err, ShouldBeNil)
+
+		lostVol := vanus.NewTestID()
+		frozen := createTestSegment(lostVol)
+		frozen.Number = 100
+		frozen.State = StateFrozen
+		working := createTestSegment(lostVol)
+		working.Number = 50
+		working.State = StateWorking
+		// only one replica is on the lost volume, the quorum remains.
+		degraded := createTestSegment(vanus.NewTestID())
+		degraded.State = StateWorking
+		for _, blk := range degraded.Replicas.Peers {
+			blk.VolumeID = lostVol
+			break
+		}
+
+		var gap []byte
+		kvCli.EXPECT().Set(ctx, metadata.GetSegmentGapMetadataKey(md.ID, working.ID), gomock.Any()).Times(1).
+			DoAndReturn(func(_ stdCtx.Context, _ string, data []byte) error {
+				gap = data
+				return nil
+			})
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		So(el.add(ctx, frozen), ShouldBeNil)
+		So(el.add(ctx, working), ShouldBeNil)
+		So(el.add(ctx, degraded), ShouldBeNil)
+		utMgr.eventLogMap.Store(md.ID.Key(), el)
+
+		So(utMgr.FailoverVolume(ctx, lostVol), ShouldEqual, 1)
+		So(frozen.State, ShouldEqual, StateFrozen)
+		So(working.State, ShouldEqual, StateUnavailable)
+		for _, blk := range working.Replicas.Peers {
+			So(blk.Lost, ShouldBeTrue)
+		}
+		So(degraded.State, ShouldEqual, StateWorking)
+		So(degraded.StartOffsetInLog, ShouldEqual, int64(150))
+		lost := 0
+		for _, blk := range degraded.Replicas.Peers {
+			if blk.Lost {
+				lost++
+			}
+		}
+		So(lost, ShouldEqual, 1)
+		So(el.currentAppendableSegment(), ShouldEqual, degraded)
+
+		g := &Gap{}
+		So(stdJson.Unmarshal(gap, g), ShouldBeNil)
+		So(g.SegmentID, ShouldEqual, working.ID)
+		So(g.StartOffset, ShouldEqual, int64(100))
+		So(g.Number, ShouldEqual, int32(50))
+
+		// the unavailable segment isn't updated by heartbeats anymore.
+		So(working.isNeedUpdate(Segment{ID: working.ID, Number: 60, State: StateFrozen}), ShouldBeFalse)
+
+		// nothing changes on the second call.
+		So(utMgr.FailoverVolume(ctx, lostVol), ShouldEqual, 0)
+	})
+}
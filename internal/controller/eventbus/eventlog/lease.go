@@ -0,0 +1,165 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+const (
+	// defaultWriteLeaseTTL bounds how long blocks of a sealed or given-up segment keep accepting appends
+	// after the last renewal.
+	defaultWriteLeaseTTL           = 10 * time.Second
+	defaultWriteLeaseRenewInterval = 3 * time.Second
+)
+
+type WriteLeaseConfig struct {
+	// TTL bounds how long blocks of a sealed or given-up segment keep accepting appends after the last
+	// renewal, it's also how long appends survive an outage or a leader election of controllers. It's 10s
+	// by default.
+	TTL time.Duration `yaml:"ttl"`
+	// RenewInterval must be less than TTL, it's a third of TTL by default.
+	RenewInterval time.Duration `yaml:"renew_interval"`
+}
+
+func (c WriteLeaseConfig) Validate() error {
+	if c.TTL < 0 || c.RenewInterval < 0 {
+		return fmt.Errorf("ttl and renew interval of write leases can't be negative")
+	}
+	ttl, interval := c.get()
+	if interval >= ttl {
+		return fmt.Errorf("renew interval %s of write leases must be less than ttl %s", interval, ttl)
+	}
+	return nil
+}
+
+func (c WriteLeaseConfig) get() (time.Duration, time.Duration) {
+	ttl, interval := c.TTL, c.RenewInterval
+	if ttl == 0 {
+		ttl = defaultWriteLeaseTTL
+	}
+	if interval == 0 {
+		interval = defaultWriteLeaseRenewInterval
+		if c.TTL > 0 {
+			interval = ttl / 3
+		}
+	}
+	return ttl, interval
+}
+
+func (mgr *eventlogManager) SetWriteLease(cfg WriteLeaseConfig) {
+	mgr.writeLeaseTTL, mgr.writeLeaseRenewInterval = cfg.get()
+}
+
+// nextLeaseEpoch returns an epoch greater than epochs of all segments in the eventlog, so leases of a
+// newer segment always win over stale ones.
+func (el *eventlog) nextLeaseEpoch() uint64 {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	if last := el.tail(); last != nil {
+		return last.LeaseEpoch + 1
+	}
+	return 1
+}
+
+func (el *eventlog) appendableSegments() []*Segment {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	segs := make([]*Segment, 0, defaultAppendableSegmentNumber)
+	for node := el.segmentList.Front(); node != nil; node = node.Next() {
+		seg, _ := node.Value.(*Segment)
+		if seg.IsAppendable() {
+			segs = append(segs, seg)
+		}
+	}
+	return segs
+}
+
+func (mgr *eventlogManager) writeLeaseOf(seg *Segment, blk *metadata.Block) *segment.WriteLease {
+	return &segment.WriteLease{
+		BlockId: blk.ID.Uint64(),
+		Epoch:   seg.LeaseEpoch,
+		TtlMs:   mgr.writeLeaseTTL.Milliseconds(),
+	}
+}
+
+// renewWriteLeases renews leases of all replicas of appendable segments periodically. Segments which are
+// full or unavailable aren't renewed, their blocks reject appends once leases expire.
+func (mgr *eventlogManager) renewWriteLeases(ctx context.Context) {
+	ticker := time.NewTicker(mgr.writeLeaseRenewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "the task of renew-write-lease stopped", nil)
+			return
+		case <-ticker.C:
+			for volumeID, leases := range mgr.collectWriteLeases() {
+				mgr.sendWriteLeases(ctx, volumeID, leases)
+			}
+		}
+	}
+}
+
+// collectWriteLeases returns leases to be renewed grouped by volume.
+func (mgr *eventlogManager) collectWriteLeases() map[vanus.ID][]*segment.WriteLease {
+	leases := make(map[vanus.ID][]*segment.WriteLease)
+	mgr.eventLogMap.Range(func(_, value interface{}) bool {
+		el, _ := value.(*eventlog)
+		for _, seg := range el.appendableSegments() {
+			for _, blk := range seg.Replicas.Peers {
+				if blk.Lost {
+					continue
+				}
+				leases[blk.VolumeID] = append(leases[blk.VolumeID], mgr.writeLeaseOf(seg, blk))
+			}
+		}
+		return true
+	})
+	return leases
+}
+
+func (mgr *eventlogManager) sendWriteLeases(ctx context.Context, volumeID vanus.ID, leases []*segment.WriteLease) {
+	ins := mgr.volMgr.GetVolumeInstanceByID(volumeID)
+	if ins == nil {
+		return
+	}
+	srv := ins.GetServer()
+	if srv == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, mgr.writeLeaseRenewInterval)
+	defer cancel()
+	req := &segment.RenewWriteLeasesRequest{Leases: leases}
+	err := server.StoreRPC.Do(ctx, func(ctx context.Context) error {
+		_, err := srv.GetClient().RenewWriteLeases(ctx, req)
+		return err
+	})
+	if err != nil {
+		log.Warning(ctx, "renew write leases failed", map[string]interface{}{
+			log.KeyError: err,
+			"volume_id":  volumeID,
+			"leases":     len(leases),
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	stdCtx "context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEventlogManager_RenewWriteLeases(t *testing.T) {
+	Convey("test renew write leases", t, func() {
+		utMgr := &eventlogManager{
+			segmentReplicaNum: 3,
+			writeLeaseTTL:     10 * time.Second,
+		}
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+		utMgr.kvClient = kvCli
+		volMgr := volume.NewMockManager(ctrl)
+		utMgr.volMgr = volMgr
+		ctx := stdCtx.Background()
+
+		md := &metadata.Eventlog{
+			ID:         vanus.NewTestID(),
+			EventbusID: vanus.NewTestID(),
+		}
+		el, err := newEventlog(ctx, md, kvCli, false)
+		So(err, ShouldBeNil)
+		So(el.nextLeaseEpoch(), ShouldEqual, uint64(1))
+
+		vol := vanus.NewTestID()
+		frozen := createTestSegment(vol)
+		frozen.State = StateFrozen
+		frozen.LeaseEpoch = el.nextLeaseEpoch()
+		So(el.add(ctx, frozen), ShouldBeNil)
+		working := createTestSegment(vol)
+		working.State = StateWorking
+		working.LeaseEpoch = el.nextLeaseEpoch()
+		So(el.add(ctx, working), ShouldBeNil)
+		So(working.LeaseEpoch, ShouldEqual, uint64(2))
+		So(el.nextLeaseEpoch(), ShouldEqual, uint64(3))
+		for _, blk := range working.Replicas.Peers {
+			// the lost replica isn't renewed.
+			blk.Lost = true
+			break
+		}
+		utMgr.eventLogMap.Store(md.ID.Key(), el)
+
+		leases := utMgr.collectWriteLeases()
+		So(leases, ShouldHaveLength, 1)
+		So(leases[vol], ShouldHaveLength, 2)
+		for _, l := range leases[vol] {
+			So(l.Epoch, ShouldEqual, working.LeaseEpoch)
+			So(l.TtlMs, ShouldEqual, int64(10000))
+			blk := working.Replicas.Peers[l.BlockId]
+			So(blk, ShouldNotBeNil)
+			So(blk.Lost, ShouldBeFalse)
+		}
+
+		volIns := server.NewMockInstance(ctrl)
+		volMgr.EXPECT().GetVolumeInstanceByID(vol).Times(1).Return(volIns)
+		srv := server.NewMockServer(ctrl)
+		volIns.EXPECT().GetServer().Times(1).Return(srv)
+		grpcCli := segpb.NewMockSegmentServerClient(ctrl)
+		srv.EXPECT().GetClient().Times(1).Return(grpcCli)
+		grpcCli.EXPECT().RenewWriteLeases(gomock.Any(), &segpb.RenewWriteLeasesRequest{Leases: leases[vol]}).
+			Times(1).Return(nil, nil)
+		utMgr.writeLeaseRenewInterval = time.Second
+		utMgr.sendWriteLeases(ctx, vol, leases[vol])
+	})
+}
+
+func TestWriteLeaseConfig(t *testing.T) {
+	Convey("test write lease config", t, func() {
+		So(WriteLeaseConfig{}.Validate(), ShouldBeNil)
+		ttl, interval := WriteLeaseConfig{}.get()
+		So(ttl, ShouldEqual, defaultWriteLeaseTTL)
+		So(interval, ShouldEqual, defaultWriteLeaseRenewInterval)
+
+		// the renew interval follows a longer ttl.
+		ttl, interval = WriteLeaseConfig{TTL: time.Minute}.get()
+		So(ttl, ShouldEqual, time.Minute)
+		So(interval, ShouldEqual, 20*time.Second)
+
+		So(WriteLeaseConfig{TTL: time.Second}.Validate(), ShouldBeNil)
+		So(WriteLeaseConfig{TTL: time.Second, RenewInterval: time.Second}.Validate(), ShouldNotBeNil)
+		So(WriteLeaseConfig{RenewInterval: time.Minute}.Validate(), ShouldNotBeNil)
+		So(WriteLeaseConfig{TTL: -time.Second}.Validate(), ShouldNotBeNil)
+
+		utMgr := &eventlogManager{}
+		utMgr.SetWriteLease(WriteLeaseConfig{TTL: 30 * time.Second, RenewInterval: 5 * time.Second})
+		So(utMgr.writeLeaseTTL, ShouldEqual, 30*time.Second)
+		So(utMgr.writeLeaseRenewInterval, ShouldEqual, 5*time.Second)
+	})
+}
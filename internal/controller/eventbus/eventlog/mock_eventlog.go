@@ -12,6 +12,7 @@ import (
 	metadata "github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	kv "github.com/linkall-labs/vanus/internal/kv"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
 )
 
 // MockManager is a mock of Manager interface.
@@ -38,18 +39,18 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 }
 
 // AcquireEventLog mocks base method.
-func (m *MockManager) AcquireEventLog(ctx context.Context, eventbusID vanus.ID) (*metadata.Eventlog, error) {
+func (m *MockManager) AcquireEventLog(ctx context.Context, eventbusID vanus.ID, replicas uint, mode meta.StorageMode) (*metadata.Eventlog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AcquireEventLog", ctx, eventbusID)
+	ret := m.ctrl.Call(m, "AcquireEventLog", ctx, eventbusID, replicas, mode)
 	ret0, _ := ret[0].(*metadata.Eventlog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AcquireEventLog indicates an expected call of AcquireEventLog.
-func (mr *MockManagerMockRecorder) AcquireEventLog(ctx, eventbusID interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) AcquireEventLog(ctx, eventbusID, replicas, mode interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEventLog", reflect.TypeOf((*MockManager)(nil).AcquireEventLog), ctx, eventbusID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireEventLog", reflect.TypeOf((*MockManager)(nil).AcquireEventLog), ctx, eventbusID, replicas, mode)
 }
 
 // DeleteEventlog mocks base method.
@@ -64,6 +65,20 @@ func (mr *MockManagerMockRecorder) DeleteEventlog(ctx, id interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventlog", reflect.TypeOf((*MockManager)(nil).DeleteEventlog), ctx, id)
 }
 
+// FailoverVolume mocks base method.
+func (m *MockManager) FailoverVolume(ctx context.Context, volumeID vanus.ID) int {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailoverVolume", ctx, volumeID)
+	ret0, _ := ret[0].(int)
+	return ret0
+}
+
+// FailoverVolume indicates an expected call of FailoverVolume.
+func (mr *MockManagerMockRecorder) FailoverVolume(ctx, volumeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailoverVolume", reflect.TypeOf((*MockManager)(nil).FailoverVolume), ctx, volumeID)
+}
+
 // GetAppendableSegment mocks base method.
 func (m *MockManager) GetAppendableSegment(ctx context.Context, eli *metadata.Eventlog, num int) ([]*Segment, error) {
 	m.ctrl.T.Helper()
@@ -164,6 +179,42 @@ func (mr *MockManagerMockRecorder) Run(ctx, kvClient, startTask interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockManager)(nil).Run), ctx, kvClient, startTask)
 }
 
+// SetDictionaryResolver mocks base method.
+func (m *MockManager) SetDictionaryResolver(resolve DictionaryResolver) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDictionaryResolver", resolve)
+}
+
+// SetDictionaryResolver indicates an expected call of SetDictionaryResolver.
+func (mr *MockManagerMockRecorder) SetDictionaryResolver(resolve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDictionaryResolver", reflect.TypeOf((*MockManager)(nil).SetDictionaryResolver), resolve)
+}
+
+// SetRetentionResolver mocks base method.
+func (m *MockManager) SetRetentionResolver(resolve RetentionResolver) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetRetentionResolver", resolve)
+}
+
+// SetRetentionResolver indicates an expected call of SetRetentionResolver.
+func (mr *MockManagerMockRecorder) SetRetentionResolver(resolve interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRetentionResolver", reflect.TypeOf((*MockManager)(nil).SetRetentionResolver), resolve)
+}
+
+// SetWriteLease mocks base method.
+func (m *MockManager) SetWriteLease(cfg WriteLeaseConfig) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetWriteLease", cfg)
+}
+
+// SetWriteLease indicates an expected call of SetWriteLease.
+func (mr *MockManagerMockRecorder) SetWriteLease(cfg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteLease", reflect.TypeOf((*MockManager)(nil).SetWriteLease), cfg)
+}
+
 // Stop mocks base method.
 func (m *MockManager) Stop() {
 	m.ctrl.T.Helper()
@@ -176,6 +227,21 @@ func (mr *MockManagerMockRecorder) Stop() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockManager)(nil).Stop))
 }
 
+// TruncateBefore mocks base method.
+func (m *MockManager) TruncateBefore(ctx context.Context, id vanus.ID, offset int64) ([]*Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateBefore", ctx, id, offset)
+	ret0, _ := ret[0].([]*Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TruncateBefore indicates an expected call of TruncateBefore.
+func (mr *MockManagerMockRecorder) TruncateBefore(ctx, id, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateBefore", reflect.TypeOf((*MockManager)(nil).TruncateBefore), ctx, id, offset)
+}
+
 // UpdateSegment mocks base method.
 func (m_2 *MockManager) UpdateSegment(ctx context.Context, m map[string][]Segment) {
 	m_2.ctrl.T.Helper()
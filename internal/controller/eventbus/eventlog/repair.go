@@ -0,0 +1,242 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+const defaultRepairInterval = time.Minute
+
+type corruptedReplica struct {
+	seg *Segment
+	blk *metadata.Block
+}
+
+func (mgr *eventlogManager) repairCorruptedReplicas(ctx context.Context) {
+	ticker := time.NewTicker(mgr.repairInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info(ctx, "the task of repair-corrupted-replicas stopped", nil)
+			return
+		case <-ticker.C:
+			if count := mgr.repairCorrupted(ctx); count > 0 {
+				log.Info(ctx, "repair-corrupted-replicas completed", map[string]interface{}{
+					"count": count,
+				})
+			}
+		}
+	}
+}
+
+// repairCorrupted replaces corrupted or missing replicas of frozen segments with copies of healthy replicas on
+// other volumes, it returns the number of replicas replaced. Replicas of writable segments are replaced once the
+// segments are frozen, since their data still changes.
+func (mgr *eventlogManager) repairCorrupted(ctx context.Context) int {
+	count := 0
+	mgr.eventLogMap.Range(func(key, value interface{}) bool {
+		el, _ := value.(*eventlog)
+		for _, r := range el.corruptedReplicas() {
+			if err := mgr.repairReplica(ctx, el, r.seg, r.blk); err != nil {
+				log.Warning(ctx, "repair the corrupted replica failed", map[string]interface{}{
+					log.KeyError:  err,
+					"segment_id":  r.seg.ID.Key(),
+					"block_id":    r.blk.ID,
+					"eventlog_id": el.md.ID.Key(),
+				})
+				continue
+			}
+			count++
+		}
+		return ctx.Err() == nil
+	})
+	return count
+}
+
+// repairReplica copies a healthy replica of the segment to a block on a volume which holds no replica of
+// it, and replaces the corrupted replica with the copy.
+func (mgr *eventlogManager) repairReplica(
+	ctx context.Context, el *eventlog, seg *Segment, corrupted *metadata.Block,
+) error {
+	source, srcIns := mgr.healthyReplica(el, seg)
+	if source == nil {
+		return errors.ErrResourceNotFound.WithMessage("no healthy replica to copy from")
+	}
+	target := mgr.replacementVolume(el, seg)
+	if target == nil {
+		return errors.ErrVolumeInstanceNotFound.WithMessage("no volume to hold the copy")
+	}
+
+	blocks, err := mgr.allocator.PickByVolumes(ctx, []vanus.ID{target.ID()}, el.md.StorageMode)
+	if err != nil {
+		return err
+	}
+	blk := blocks[0]
+	err = server.StoreRPC.Do(ctx, func(ctx context.Context) error {
+		_, err := target.GetServer().GetClient().CopyBlock(ctx, &segpb.CopyBlockRequest{
+			Id:            blk.ID.Uint64(),
+			SourceId:      source.ID.Uint64(),
+			SourceAddress: srcIns.Address(),
+		})
+		return err
+	})
+	if err == nil {
+		err = el.replaceReplica(ctx, seg, corrupted, blk, source)
+	}
+	if err != nil {
+		mgr.discardBlock(ctx, blk)
+		return err
+	}
+
+	mgr.globalBlockMap.Store(blk.ID.Key(), blk)
+	mgr.globalBlockMap.Delete(corrupted.ID.Key())
+	// The segment doesn't refer to the corrupted replica anymore, if its server can't delete it now, it's
+	// removed when the controller reconciles blocks on next start.
+	mgr.discardBlock(ctx, corrupted)
+	log.Info(ctx, "the corrupted replica has been replaced", map[string]interface{}{
+		"segment_id":      seg.ID.Key(),
+		"eventlog_id":     el.md.ID.Key(),
+		"block_id":        corrupted.ID,
+		"volume_id":       corrupted.VolumeID,
+		"source_block_id": source.ID,
+		"new_block_id":    blk.ID,
+		"new_volume_id":   blk.VolumeID,
+	})
+	return nil
+}
+
+// healthyReplica returns a replica of the segment which isn't lost, and the volume which holds it.
+func (mgr *eventlogManager) healthyReplica(el *eventlog, seg *Segment) (*metadata.Block, server.Instance) {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	for _, blk := range seg.Replicas.Peers {
+		if blk.Lost {
+			continue
+		}
+		if ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID); ins != nil && ins.GetServer() != nil {
+			return blk, ins
+		}
+	}
+	return nil, nil
+}
+
+// replacementVolume returns an active volume which holds no replica of the segment.
+func (mgr *eventlogManager) replacementVolume(el *eventlog, seg *Segment) server.Instance {
+	el.mutex.RLock()
+	used := make(map[vanus.ID]bool, len(seg.Replicas.Peers))
+	for _, blk := range seg.Replicas.Peers {
+		used[blk.VolumeID] = true
+	}
+	el.mutex.RUnlock()
+	for _, ins := range mgr.volMgr.GetAllActiveVolumes() {
+		if !used[ins.ID()] && ins.GetServer() != nil {
+			return ins
+		}
+	}
+	return nil
+}
+
+// discardBlock deletes the block from its server and kv, failures are logged.
+func (mgr *eventlogManager) discardBlock(ctx context.Context, blk *metadata.Block) {
+	if ins := mgr.volMgr.GetVolumeInstanceByID(blk.VolumeID); ins != nil {
+		if err := ins.DeleteBlock(ctx, blk.ID); err != nil {
+			log.Warning(ctx, "delete block failed", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   blk.ID,
+				"volume_id":  blk.VolumeID,
+			})
+		}
+	}
+	if err := mgr.kvClient.Delete(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID)); err != nil {
+		log.Warning(ctx, "delete block metadata in kv failed", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   blk.ID,
+			"volume_id":  blk.VolumeID,
+		})
+	}
+}
+
+// corruptedReplicas returns corrupted or missing replicas of frozen segments, neither of them comes back.
+func (el *eventlog) corruptedReplicas() []corruptedReplica {
+	el.mutex.RLock()
+	defer el.mutex.RUnlock()
+	var replicas []corruptedReplica
+	for node := el.segmentList.Front(); node != nil; node = node.Next() {
+		seg, _ := node.Value.(*Segment)
+		if !seg.isFull() || seg.Replicas == nil {
+			continue
+		}
+		for _, blk := range seg.Replicas.Peers {
+			if blk.Corrupted || blk.Missing {
+				replicas = append(replicas, corruptedReplica{seg: seg, blk: blk})
+			}
+		}
+	}
+	return replicas
+}
+
+// replaceReplica replaces the corrupted replica of the segment with blk, the source replica becomes the
+// leader if the corrupted one was. Replicas of the segment are replaced as a whole once they are persisted,
+// so readers of the old ones aren't affected.
+func (el *eventlog) replaceReplica(
+	ctx context.Context, seg *Segment, corrupted, blk, source *metadata.Block,
+) error {
+	el.lock()
+	defer el.unlock()
+	if el.get(seg.ID) != seg {
+		return errors.ErrResourceNotFound.WithMessage("the segment has been deleted")
+	}
+	if _, ok := seg.Replicas.Peers[corrupted.ID.Uint64()]; !ok {
+		return errors.ErrResourceNotFound.WithMessage("the replica has been replaced")
+	}
+
+	blk.SegmentID = seg.ID
+	blk.EventlogID = seg.EventLogID
+	data, _ := json.Marshal(blk)
+	if err := el.kvClient.Set(ctx, metadata.GetBlockMetadataKey(blk.VolumeID, blk.ID), data); err != nil {
+		return err
+	}
+
+	replicas := *seg.Replicas
+	replicas.Peers = make(map[uint64]*metadata.Block, len(seg.Replicas.Peers))
+	for id, peer := range seg.Replicas.Peers {
+		if id != corrupted.ID.Uint64() {
+			replicas.Peers[id] = peer
+		}
+	}
+	replicas.Peers[blk.ID.Uint64()] = blk
+	if replicas.Leader == corrupted.ID.Uint64() {
+		replicas.Leader = source.ID.Uint64()
+	}
+	updated := seg.Copy()
+	updated.Replicas = &replicas
+	data, _ = json.Marshal(&updated)
+	if err := el.kvClient.Set(ctx, metadata.GetSegmentMetadataKey(seg.ID), data); err != nil {
+		return err
+	}
+	seg.Replicas = &replicas
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventlog
+
+import (
+	stdCtx "context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/block"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestEventlogManager_RepairCorrupted(t *testing.T) {
+	Convey("test repair corrupted replicas", t, func() {
+		utMgr := &eventlogManager{segmentReplicaNum: 3}
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		utMgr.kvClient = kvCli
+		volMgr := volume.NewMockManager(ctrl)
+		utMgr.volMgr = volMgr
+		alloc := block.NewMockAllocator(ctrl)
+		utMgr.allocator = alloc
+		ctx := stdCtx.Background()
+
+		// volumes 0-2 hold replicas of the segment, volume 3 holds nothing.
+		instances := make([]*server.MockInstance, 4)
+		clients := make([]*segpb.MockSegmentServerClient, 4)
+		addrs := make(map[vanus.ID]string, 4)
+		active := make([]server.Instance, 4)
+		for i := range instances {
+			id := vanus.NewTestID()
+			ins := server.NewMockInstance(ctrl)
+			srv := server.NewMockServer(ctrl)
+			cli := segpb.NewMockSegmentServerClient(ctrl)
+			ins.EXPECT().ID().AnyTimes().Return(id)
+			ins.EXPECT().GetServer().AnyTimes().Return(srv)
+			addrs[id] = id.String()
+			ins.EXPECT().Address().AnyTimes().Return(addrs[id])
+			srv.EXPECT().GetClient().AnyTimes().Return(cli)
+			volMgr.EXPECT().GetVolumeInstanceByID(id).AnyTimes().Return(ins)
+			instances[i], clients[i], active[i] = ins, cli, ins
+		}
+		volMgr.EXPECT().GetAllActiveVolumes().AnyTimes().Return(active)
+
+		md := &metadata.Eventlog{
+			ID:          vanus.NewTestID(),
+			EventbusID:  vanus.NewTestID(),
+			StorageMode: metapb.StorageMode_STORAGE_DURABLE,
+		}
+		el, err := newEventlog(ctx, md, kvCli, false)
+		So(err, ShouldBeNil)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		seg := createTestSegment(vanus.EmptyID())
+		seg.State = StateFrozen
+		seg.EventLogID = md.ID
+		// the leader on volume 2 is corrupted.
+		corrupted := seg.GetLeaderBlock()
+		corrupted.VolumeID = instances[2].ID()
+		i := 0
+		for _, blk := range seg.Replicas.Peers {
+			if blk != corrupted {
+				blk.VolumeID = instances[i].ID()
+				i++
+			}
+		}
+		corrupted.Lost = true
+		corrupted.Corrupted = true
+		working := createTestSegment(instances[0].ID())
+		working.State = StateWorking
+		for _, blk := range working.Replicas.Peers {
+			blk.Corrupted = true
+			break
+		}
+		So(el.add(ctx, seg), ShouldBeNil)
+		So(el.add(ctx, working), ShouldBeNil)
+		utMgr.eventLogMap.Store(md.ID.Key(), el)
+		utMgr.globalBlockMap.Store(corrupted.ID.Key(), corrupted)
+
+		replacement := &metadata.Block{
+			ID:       vanus.NewTestID(),
+			Capacity: 64 * 1024 * 1024,
+			VolumeID: instances[3].ID(),
+		}
+		alloc.EXPECT().PickByVolumes(gomock.Any(), []vanus.ID{instances[3].ID()}, md.StorageMode).Times(1).
+			Return([]*metadata.Block{replacement}, nil)
+		old := seg.Replicas
+
+		Convey("the corrupted replica is replaced with a copy", func() {
+			clients[3].EXPECT().CopyBlock(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ stdCtx.Context, req *segpb.CopyBlockRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+					So(req.Id, ShouldEqual, replacement.ID.Uint64())
+					source := seg.Replicas.Peers[req.SourceId]
+					So(source, ShouldNotBeNil)
+					So(source.Lost, ShouldBeFalse)
+					So(req.SourceAddress, ShouldEqual, addrs[source.VolumeID])
+					return &emptypb.Empty{}, nil
+				})
+			instances[2].EXPECT().DeleteBlock(gomock.Any(), corrupted.ID).Times(1).Return(nil)
+			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(corrupted.VolumeID, corrupted.ID)).
+				Times(1).Return(nil)
+
+			So(utMgr.repairCorrupted(ctx), ShouldEqual, 1)
+			So(seg.Replicas, ShouldNotEqual, old)
+			So(seg.Replicas.Peers, ShouldHaveLength, 3)
+			So(seg.Replicas.Peers, ShouldNotContainKey, corrupted.ID.Uint64())
+			So(seg.Replicas.Peers[replacement.ID.Uint64()], ShouldEqual, replacement)
+			So(replacement.SegmentID, ShouldEqual, seg.ID)
+			So(replacement.EventlogID, ShouldEqual, md.ID)
+			So(seg.GetLeaderBlock(), ShouldNotBeNil)
+			So(seg.GetLeaderBlock().Lost, ShouldBeFalse)
+			// The old replicas are left untouched for readers of them.
+			So(old.Peers, ShouldContainKey, corrupted.ID.Uint64())
+			So(utMgr.GetBlock(corrupted.ID), ShouldBeNil)
+			So(utMgr.GetBlock(replacement.ID), ShouldEqual, replacement)
+
+			// nothing is left to repair.
+			So(utMgr.repairCorrupted(ctx), ShouldEqual, 0)
+		})
+
+		Convey("the missing replica is replaced with a copy", func() {
+			corrupted.Corrupted = false
+			corrupted.Missing = true
+			clients[3].EXPECT().CopyBlock(gomock.Any(), gomock.Any()).Times(1).Return(&emptypb.Empty{}, nil)
+			// the server doesn't hold the missing replica.
+			instances[2].EXPECT().DeleteBlock(gomock.Any(), corrupted.ID).Times(1).Return(errors.ErrResourceNotFound)
+			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(corrupted.VolumeID, corrupted.ID)).
+				Times(1).Return(nil)
+
+			So(utMgr.repairCorrupted(ctx), ShouldEqual, 1)
+			So(seg.Replicas.Peers, ShouldHaveLength, 3)
+			So(seg.Replicas.Peers, ShouldNotContainKey, corrupted.ID.Uint64())
+			So(seg.Replicas.Peers[replacement.ID.Uint64()], ShouldEqual, replacement)
+		})
+
+		Convey("the copy is discarded if copying fails", func() {
+			clients[3].EXPECT().CopyBlock(gomock.Any(), gomock.Any()).Times(1).
+				Return(nil, errors.ErrCorruptedEvent)
+			instances[3].EXPECT().DeleteBlock(gomock.Any(), replacement.ID).Times(1).Return(nil)
+			kvCli.EXPECT().Delete(gomock.Any(), metadata.GetBlockMetadataKey(replacement.VolumeID, replacement.ID)).
+				Times(1).Return(nil)
+
+			So(utMgr.repairCorrupted(ctx), ShouldEqual, 0)
+			So(seg.Replicas, ShouldEqual, old)
+			So(seg.Replicas.Peers, ShouldContainKey, corrupted.ID.Uint64())
+			So(utMgr.GetBlock(corrupted.ID), ShouldEqual, corrupted)
+		})
+	})
+}
@@ -32,6 +32,8 @@ const (
 	StateFrozen   = SegmentState("frozen")
 	StateArchived = SegmentState("archived")
 	StateExpired  = SegmentState("expired")
+	// StateUnavailable means the segment is given up because its replicas are lost, see Gap.
+	StateUnavailable = SegmentState("unavailable")
 )
 
 type Segment struct {
@@ -47,6 +49,8 @@ type Segment struct {
 	Number             int32         `json:"number,omitempty"`
 	FirstEventBornTime time.Time     `json:"first_event_born_time"`
 	LastEventBornTime  time.Time     `json:"last_event_born_time"`
+	// LeaseEpoch is the epoch of write leases of the segment's blocks, it increases along the eventlog.
+	LeaseEpoch uint64 `json:"lease_epoch,omitempty"`
 }
 
 func (seg *Segment) IsAppendable() bool {
@@ -67,7 +71,7 @@ func (seg *Segment) String() string {
 
 // TODO Don't update field in here
 func (seg *Segment) isNeedUpdate(newSeg Segment) bool {
-	if seg.isFull() {
+	if seg.isFull() || seg.State == StateUnavailable {
 		return false
 	}
 	if seg.ID != newSeg.ID {
@@ -121,6 +125,7 @@ func (seg *Segment) Copy() Segment {
 		Number:             seg.Number,
 		FirstEventBornTime: seg.FirstEventBornTime,
 		LastEventBornTime:  seg.LastEventBornTime,
+		LeaseEpoch:         seg.LeaseEpoch,
 	}
 }
 
@@ -147,6 +152,7 @@ func Convert2ProtoSegment(ctx context.Context, ins ...*Segment) []*metapb.Segmen
 					Id:       v.ID.Uint64(),
 					Endpoint: topo[v.ID.Uint64()],
 					VolumeID: v.VolumeID.Uint64(),
+					Lost:     v.Lost,
 				}
 			}
 		}
@@ -126,6 +126,35 @@ func TestConvert2ProtoSegment(t *testing.T) {
 		So(pbSegs[0].Replicas[block3.Uint64()].Id, ShouldEqual, seg.Replicas.Peers[block3.Uint64()].ID.Uint64())
 		So(pbSegs[0].Replicas[block3.Uint64()].VolumeID, ShouldEqual, seg.Replicas.Peers[block3.Uint64()].VolumeID.Uint64())
 		So(pbSegs[0].Replicas[block3.Uint64()].Endpoint, ShouldEqual, "")
+		So(pbSegs[0].Replicas[block3.Uint64()].Lost, ShouldBeFalse)
+
+		// the lost replica is kept in the segment without an endpoint.
+		seg.Replicas.Peers[block1.Uint64()].Lost = true
+		volMgr.EXPECT().GetVolumeInstanceByID(gomock.Any()).Times(2).DoAndReturn(func(id vanus.ID) server.Instance {
+			if id == seg.Replicas.Peers[block2.Uint64()].VolumeID {
+				return ins2
+			}
+			return ins3
+		})
+		ins2.EXPECT().Address().Times(1).Return("127.0.0.1:10002")
+		ins3.EXPECT().Address().Times(1).Return("127.0.0.1:10003")
+		So(mgr.getSegmentTopology(stdCtx.Background(), seg), ShouldResemble, map[uint64]string{
+			block2.Uint64(): "127.0.0.1:10002",
+			block3.Uint64(): "127.0.0.1:10003",
+		})
+		volMgr.EXPECT().GetVolumeInstanceByID(gomock.Any()).Times(2).DoAndReturn(func(id vanus.ID) server.Instance {
+			if id == seg.Replicas.Peers[block2.Uint64()].VolumeID {
+				return ins2
+			}
+			return ins3
+		})
+		ins2.EXPECT().Address().Times(1).Return("127.0.0.1:10002")
+		ins3.EXPECT().Address().Times(1).Return("127.0.0.1:10003")
+		pbSegs = Convert2ProtoSegment(stdCtx.Background(), seg)
+		So(pbSegs[0].Replicas, ShouldHaveLength, 3)
+		So(pbSegs[0].Replicas[block1.Uint64()].Lost, ShouldBeTrue)
+		So(pbSegs[0].Replicas[block1.Uint64()].Endpoint, ShouldEqual, "")
+		So(pbSegs[0].Replicas[block2.Uint64()].Endpoint, ShouldEqual, "127.0.0.1:10002")
 	})
 }
 
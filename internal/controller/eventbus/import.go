@@ -0,0 +1,126 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkall-labs/vanus/internal/controller/importer"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+func (ctrl *controller) ImportEventBus(ctx context.Context,
+	req *ctrlpb.ImportEventBusRequest) (*metapb.Job, error) {
+	if _, err := ctrl.getEventbus(req.Eventbus); err != nil {
+		return nil, err
+	}
+	var (
+		spec job.Spec
+		err  error
+		from string
+	)
+	switch src := req.Source.(type) {
+	case *ctrlpb.ImportEventBusRequest_Kafka:
+		spec, err = ctrl.kafkaImportSpec(req.Eventbus, src.Kafka)
+		from = src.Kafka.Topic
+	case *ctrlpb.ImportEventBusRequest_Nats:
+		spec, err = ctrl.natsImportSpec(req.Eventbus, src.Nats)
+		from = src.Nats.Subject
+	case *ctrlpb.ImportEventBusRequest_Rabbitmq:
+		spec, err = ctrl.rabbitMQImportSpec(req.Eventbus, src.Rabbitmq)
+		from = src.Rabbitmq.Queue
+	default:
+		return nil, errors.ErrInvalidRequest.WithMessage("the source of import is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	j, err := ctrl.jobMgr.Submit(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "the import of eventbus has been submitted", map[string]interface{}{
+		"job_id":   j.ID.Key(),
+		"kind":     spec.Kind,
+		"eventbus": req.Eventbus,
+		"from":     from,
+	})
+	return j.ToPb(), nil
+}
+
+func (ctrl *controller) kafkaImportSpec(eventbus string, src *ctrlpb.KafkaSource) (job.Spec, error) {
+	if _, ok := ctrl.cfg.KafkaCredentials[src.SaslCredential]; src.SaslCredential != "" && !ok {
+		return job.Spec{}, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the kafka credential %s isn't configured", src.SaslCredential))
+	}
+	return importer.KafkaOptions{
+		Eventbus:   eventbus,
+		Brokers:    src.Brokers,
+		Topic:      src.Topic,
+		Start:      src.Start,
+		Continuous: src.Continuous,
+		TLS:        src.Tls,
+		// only the name is passed, see importer.KafkaOptions.
+		SASLCredential: src.SaslCredential,
+	}.Spec()
+}
+
+func (ctrl *controller) natsImportSpec(eventbus string, src *ctrlpb.NATSSource) (job.Spec, error) {
+	if err := ctrl.checkImportCredential(src.Credential); err != nil {
+		return job.Spec{}, err
+	}
+	return importer.NATSOptions{
+		Eventbus:   eventbus,
+		Servers:    src.Servers,
+		Subject:    src.Subject,
+		Durable:    src.Durable,
+		TLS:        src.Tls,
+		Credential: src.Credential,
+		Attributes: attributeMappingFromPb(src.Attributes),
+	}.Spec()
+}
+
+func (ctrl *controller) rabbitMQImportSpec(eventbus string, src *ctrlpb.RabbitMQSource) (job.Spec, error) {
+	if err := ctrl.checkImportCredential(src.Credential); err != nil {
+		return job.Spec{}, err
+	}
+	return importer.RabbitMQOptions{
+		Eventbus:   eventbus,
+		URL:        src.Url,
+		Queue:      src.Queue,
+		Credential: src.Credential,
+		Attributes: attributeMappingFromPb(src.Attributes),
+	}.Spec()
+}
+
+func (ctrl *controller) checkImportCredential(name string) error {
+	if _, ok := ctrl.cfg.ImportCredentials[name]; name != "" && !ok {
+		return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the credential %s isn't configured", name))
+	}
+	return nil
+}
+
+func attributeMappingFromPb(m *ctrlpb.AttributeMapping) importer.AttributeMapping {
+	return importer.AttributeMapping{
+		Type:    m.GetType(),
+		Source:  m.GetSource(),
+		Headers: m.GetHeaders(),
+	}
+}
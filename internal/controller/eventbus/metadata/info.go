@@ -19,6 +19,8 @@ import (
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/vsb/dictionary"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
 )
 
@@ -28,22 +30,43 @@ type Eventbus struct {
 	LogNumber   int         `json:"log_number"`
 	EventLogs   []*Eventlog `json:"event_logs"`
 	Description string      `json:"description"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	Profile     string      `json:"profile,omitempty"`
+	// Annotations are operational notes attached by operators, they don't affect the eventbus.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Owner is the principal who created the eventbus.
+	Owner string `json:"owner,omitempty"`
+	// Dictionary is the zstd dictionary which archived blocks of the eventbus are compressed with.
+	Dictionary []byte    `json:"dictionary,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	// Retention is how long events are kept after they are stored, 0 means the default of the cluster.
+	Retention time.Duration `json:"retention,omitempty"`
+	// OrderingScope is the ordering of events the eventbus guarantees.
+	OrderingScope meta.OrderingScope `json:"ordering_scope,omitempty"`
+	// StorageMode is where blocks of the eventbus keep events.
+	StorageMode meta.StorageMode `json:"storage_mode,omitempty"`
 }
 
 func Convert2ProtoEventBus(ins ...*Eventbus) []*meta.EventBus {
 	pebs := make([]*meta.EventBus, len(ins))
 	for idx := 0; idx < len(ins); idx++ {
 		eb := ins[idx]
+		dictID, _ := dictionary.ID(eb.Dictionary)
 		pebs[idx] = &meta.EventBus{
-			Name:        eb.Name,
-			LogNumber:   int32(eb.LogNumber),
-			Logs:        Convert2ProtoEventLog(eb.EventLogs...),
-			Id:          eb.ID.Uint64(),
-			Description: eb.Description,
-			CreatedAt:   eb.CreatedAt.UnixMilli(),
-			UpdatedAt:   eb.UpdatedAt.UnixMilli(),
+			Name:          eb.Name,
+			LogNumber:     int32(eb.LogNumber),
+			Logs:          Convert2ProtoEventLog(eb.EventLogs...),
+			Id:            eb.ID.Uint64(),
+			Description:   eb.Description,
+			Profile:       eb.Profile,
+			Annotations:   eb.Annotations,
+			Owner:         eb.Owner,
+			DictionaryId:  dictID,
+			CreatedAt:     eb.CreatedAt.UnixMilli(),
+			UpdatedAt:     eb.UpdatedAt.UnixMilli(),
+			RetentionMs:   eb.Retention.Milliseconds(),
+			OrderingScope: eb.OrderingScope,
+			StorageMode:   eb.StorageMode,
 		}
 	}
 	return pebs
@@ -55,6 +78,10 @@ type Eventlog struct {
 	EventbusID    vanus.ID `json:"eventbus_id"`
 	EventbusName  string   `json:"eventbus_name"`
 	SegmentNumber int      `json:"segment_number"`
+	// Replicas of segments, 0 means the default of the cluster.
+	Replicas uint `json:"replicas,omitempty"`
+	// StorageMode of blocks of segments, it's the one of the eventbus.
+	StorageMode meta.StorageMode `json:"storage_mode,omitempty"`
 }
 
 func (el *Eventlog) Eventbus() string {
@@ -77,11 +104,37 @@ func Convert2ProtoEventLog(ins ...*Eventlog) []*meta.EventLog {
 	return pels
 }
 
+// EventbusProfile bundles settings which are applied to eventbuses created from it.
+type EventbusProfile struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	LogNumber   int    `json:"log_number"`
+	Replicas    uint   `json:"replicas,omitempty"`
+	// Retention is how long events are kept, 0 means the default of the cluster.
+	Retention     time.Duration      `json:"retention,omitempty"`
+	OrderingScope meta.OrderingScope `json:"ordering_scope,omitempty"`
+	Builtin       bool               `json:"-"`
+}
+
+func (p *EventbusProfile) Convert2Proto() *ctrlpb.EventbusProfile {
+	return &ctrlpb.EventbusProfile{
+		Name:          p.Name,
+		Description:   p.Description,
+		LogNumber:     int32(p.LogNumber),
+		Replicas:      uint32(p.Replicas),
+		RetentionMs:   p.Retention.Milliseconds(),
+		OrderingScope: p.OrderingScope,
+		Builtin:       p.Builtin,
+	}
+}
+
 type VolumeMetadata struct {
 	ID       vanus.ID          `json:"id"`
 	Capacity int64             `json:"capacity"`
 	Used     int64             `json:"used"`
 	Blocks   map[uint64]*Block `json:"blocks"`
+	// NodeID is the identity of the data directory of the volume, which is bound by the first registration.
+	NodeID string `json:"node_id,omitempty"`
 }
 
 type Block struct {
@@ -91,6 +144,16 @@ type Block struct {
 	VolumeID   vanus.ID `json:"volume_id"`
 	EventlogID vanus.ID `json:"eventlog_id"`
 	SegmentID  vanus.ID `json:"segment_id"`
+	// Lost means the segment server of the volume doesn't hold this block anymore.
+	Lost bool `json:"lost,omitempty"`
+	// Missing means the segment server of the volume is running without the block, e.g. its data directory was
+	// wiped, so it's lost for good and replaced like a corrupted one.
+	Missing bool `json:"missing,omitempty"`
+	// Corrupted means the segment server of the volume reports the block is corrupted, it's lost until it's
+	// replaced with a copy of a healthy replica.
+	Corrupted bool `json:"corrupted,omitempty"`
+	// StorageMode is the mode of the block engine which the block is created in.
+	StorageMode meta.StorageMode `json:"storage_mode,omitempty"`
 }
 
 func (bl *Block) String() string {
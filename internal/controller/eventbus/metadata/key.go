@@ -30,12 +30,23 @@ const (
 	SegmentKeyPrefixInKVStore  = "/vanus/internal/resource/segment"
 
 	EventlogSegmentsKeyPrefixInKVStore = "/vanus/internal/resource/segs_of_eventlog"
+	SegmentGapKeyPrefixInKVStore       = "/vanus/internal/resource/segment_gap"
+
+	// It isn't under EventbusKeyPrefixInKVStore, which is listed as eventbuses.
+	EventbusProfileKeyPrefixInKVStore = "/vanus/internal/resource/profile/eventbus"
+
+	// DictionaryIDKeyInKVStore keeps the last ID assigned to compression dictionaries of eventbuses.
+	DictionaryIDKeyInKVStore = "/vanus/internal/resource/dictionary_id"
 )
 
 func GetEventbusMetadataKey(ebName string) string {
 	return path.Join(EventbusKeyPrefixInKVStore, ebName)
 }
 
+func GetEventbusProfileMetadataKey(name string) string {
+	return path.Join(EventbusProfileKeyPrefixInKVStore, name)
+}
+
 func GetEventlogMetadataKey(elID vanus.ID) string {
 	return path.Join(EventlogKeyPrefixInKVStore, elID.Key())
 }
@@ -51,3 +62,7 @@ func GetSegmentMetadataKey(segmentID vanus.ID) string {
 func GetEventlogSegmentsMetadataKey(eventlogID, segmentID vanus.ID) string {
 	return path.Join(EventlogSegmentsKeyPrefixInKVStore, eventlogID.Key(), segmentID.Key())
 }
+
+func GetSegmentGapMetadataKey(eventlogID, segmentID vanus.ID) string {
+	return path.Join(SegmentGapKeyPrefixInKVStore, eventlogID.Key(), segmentID.Key())
+}
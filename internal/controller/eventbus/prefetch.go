@@ -0,0 +1,100 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+// maxPrefetchSegments limits segments prefetched for each offset, readers take a while to consume them,
+// and later segments are loaded by the readahead of segment servers by then.
+const maxPrefetchSegments = 2
+
+// PrefetchEventlogs asks segment servers to load full segments after the offsets ahead of reads. Segments
+// which fail to be prefetched are skipped, since they are still readable from disks.
+func (ctrl *controller) PrefetchEventlogs(ctx context.Context,
+	req *ctrlpb.PrefetchEventlogsRequest) (*ctrlpb.PrefetchEventlogsResponse, error) {
+	resp := &ctrlpb.PrefetchEventlogsResponse{}
+	for _, info := range req.Offsets {
+		elID := vanus.NewIDFromUint64(info.EventLogId)
+		if ctrl.eventLogMgr.GetEventLog(ctx, elID) == nil {
+			return nil, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+		}
+		off := int64(info.Offset)
+		num := 0
+		for _, seg := range ctrl.eventLogMgr.GetEventLogSegmentList(elID) {
+			if num >= maxPrefetchSegments {
+				break
+			}
+			if seg.StartOffsetInLog+int64(seg.Number) <= off {
+				continue
+			}
+			// the appendable segment is being written, its data are likely cached.
+			if seg.IsAppendable() {
+				break
+			}
+			num++
+			seq := off - seg.StartOffsetInLog
+			if seq < 0 {
+				seq = 0
+			}
+			n, err := ctrl.prefetchSegment(ctx, seg, seq)
+			if err != nil {
+				log.Warning(ctx, "prefetch segment failed", map[string]interface{}{
+					log.KeyError: err,
+					"eventlog":   elID,
+					"segment":    seg.ID,
+				})
+				continue
+			}
+			resp.Blocks++
+			resp.Bytes += n
+		}
+	}
+	return resp, nil
+}
+
+// prefetchSegment prefetches the leader block of seg, which serves reads.
+func (ctrl *controller) prefetchSegment(ctx context.Context, seg *eventlog.Segment, seq int64) (int64, error) {
+	blk := seg.GetLeaderBlock()
+	if blk == nil {
+		return 0, errors.ErrResourceNotFound.WithMessage("the segment has no leader block")
+	}
+	ins := ctrl.volumeMgr.GetVolumeInstanceByID(blk.VolumeID)
+	if ins == nil || ins.GetServer() == nil {
+		return 0, errors.ErrVolumeInstanceNoServer
+	}
+	var res *segpb.PrefetchBlockResponse
+	err := server.StoreRPC.Do(ctx, func(ctx context.Context) error {
+		var err error
+		res, err = ins.GetServer().GetClient().PrefetchBlock(ctx, &segpb.PrefetchBlockRequest{
+			BlockId: blk.ID.Uint64(),
+			Offset:  seq,
+		})
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Bytes, nil
+}
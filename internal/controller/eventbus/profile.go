@@ -0,0 +1,162 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// builtinProfiles can't be overridden or deleted.
+var builtinProfiles = map[string]*metadata.EventbusProfile{
+	"high-throughput": {
+		Name:        "high-throughput",
+		Description: "spread events over many eventlogs to scale out appending and consuming",
+		LogNumber:   16,
+		Builtin:     true,
+	},
+	"lightweight": {
+		Name:        "lightweight",
+		Description: "a single eventlog without replication, for testing or events that can be lost",
+		LogNumber:   1,
+		Replicas:    1,
+		Builtin:     true,
+	},
+	"long-retention": {
+		Name:        "long-retention",
+		Description: "keep events for 30 days, for auditing or replaying history",
+		LogNumber:   1,
+		Retention:   30 * 24 * time.Hour,
+		Builtin:     true,
+	},
+}
+
+func (ctrl *controller) CreateEventbusProfile(ctx context.Context,
+	req *ctrlpb.EventbusProfile) (*ctrlpb.EventbusProfile, error) {
+	if req.Name == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("the name of profile can't be empty")
+	}
+	if _, exist := builtinProfiles[req.Name]; exist {
+		return nil, errors.ErrResourceAlreadyExist.WithMessage("the profile is built-in")
+	}
+	profile := &metadata.EventbusProfile{
+		Name:          req.Name,
+		Description:   req.Description,
+		LogNumber:     int(req.LogNumber),
+		Replicas:      uint(req.Replicas),
+		Retention:     time.Duration(req.RetentionMs) * time.Millisecond,
+		OrderingScope: req.OrderingScope,
+	}
+	if err := validateProfile(profile); err != nil {
+		return nil, err
+	}
+	data, _ := json.Marshal(profile)
+	if err := ctrl.kvStore.Set(ctx, metadata.GetEventbusProfileMetadataKey(profile.Name), data); err != nil {
+		return nil, errors.ErrInternal.WithMessage("save profile in kv failed").Wrap(err)
+	}
+	log.Info(ctx, "the eventbus profile is saved", map[string]interface{}{
+		"profile": string(data),
+	})
+	return profile.Convert2Proto(), nil
+}
+
+func (ctrl *controller) DeleteEventbusProfile(ctx context.Context,
+	req *ctrlpb.DeleteEventbusProfileRequest) (*emptypb.Empty, error) {
+	if _, exist := builtinProfiles[req.Name]; exist {
+		return nil, errors.ErrInvalidRequest.WithMessage("the built-in profile can't be deleted")
+	}
+	// Eventbuses created from the profile are kept as they are.
+	if err := ctrl.kvStore.Delete(ctx, metadata.GetEventbusProfileMetadataKey(req.Name)); err != nil {
+		return nil, errors.ErrInternal.WithMessage("delete profile in kv failed").Wrap(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) ListEventbusProfile(ctx context.Context,
+	_ *emptypb.Empty) (*ctrlpb.ListEventbusProfileResponse, error) {
+	pairs, err := ctrl.kvStore.List(ctx, metadata.EventbusProfileKeyPrefixInKVStore)
+	if err != nil {
+		return nil, err
+	}
+	profiles := make([]*ctrlpb.EventbusProfile, 0, len(builtinProfiles)+len(pairs))
+	for _, p := range builtinProfiles {
+		profiles = append(profiles, p.Convert2Proto())
+	}
+	for _, pair := range pairs {
+		p := &metadata.EventbusProfile{}
+		if err = json.Unmarshal(pair.Value, p); err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, p.Convert2Proto())
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Name < profiles[j].Name
+	})
+	return &ctrlpb.ListEventbusProfileResponse{Profiles: profiles}, nil
+}
+
+func (ctrl *controller) getEventbusProfile(ctx context.Context, name string) (*metadata.EventbusProfile, error) {
+	if p, exist := builtinProfiles[name]; exist {
+		return p, nil
+	}
+	data, err := ctrl.kvStore.Get(ctx, metadata.GetEventbusProfileMetadataKey(name))
+	if stderr.Is(err, kv.ErrKeyNotFound) {
+		return nil, errors.ErrResourceNotFound.WithMessage("the profile doesn't exist")
+	} else if err != nil {
+		return nil, err
+	}
+	p := &metadata.EventbusProfile{}
+	if err = json.Unmarshal(data, p); err != nil {
+		return nil, err
+	}
+	// profiles saved by older versions aren't validated.
+	if err = validateProfile(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func validateProfile(p *metadata.EventbusProfile) error {
+	if p.LogNumber <= 0 || p.LogNumber > maximumEventlogNum {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the number of eventlog of profile %s must be in [1, %d]", p.Name, maximumEventlogNum))
+	}
+	// an even number of replicas tolerates no more failures than one replica fewer.
+	if p.Replicas != 0 && p.Replicas%2 == 0 {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the replicas of profile %s must be odd, or 0 for the default", p.Name))
+	}
+	if p.Retention < 0 {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the retention of profile %s can't be negative", p.Name))
+	}
+	if _, ok := metapb.OrderingScope_name[int32(p.OrderingScope)]; !ok {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("unknown ordering scope of profile %s: %d", p.Name, p.OrderingScope))
+	}
+	return nil
+}
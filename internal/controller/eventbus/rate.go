@@ -0,0 +1,94 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/anomaly"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const publishRateInterval = 10 * time.Second
+
+// startPublishRates observes the number of events appended to each eventbus periodically, so that the
+// detector computes publish rates from them, and records usages of eventbuses. It runs until
+// stopPublishRates is called.
+func (ctrl *controller) startPublishRates(ctx context.Context) {
+	ctx, ctrl.stopPublishRates = context.WithCancel(ctx)
+	ctrl.detector.Start(ctx)
+	go func() {
+		ticker := time.NewTicker(publishRateInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				ctrl.detector.Stop()
+				return
+			case now := <-ticker.C:
+				usages, ends := ctrl.eventbusUsages()
+				ctrl.usage.ObserveEventlogEnds(ends)
+				for name, u := range usages {
+					ctrl.detector.Observe(ctx, anomaly.MetricPublishRate, name, u.published, now)
+					ctrl.usage.ObserveEventbus(name, u.published, u.storage, now)
+				}
+			}
+		}
+	}()
+}
+
+type eventbusUsage struct {
+	// published is the number of events ever appended to the eventbus, which is the sum of the end offsets
+	// of its eventlogs reported by segment heartbeats.
+	published uint64
+	// storage is bytes taken by blocks of the eventbus, replicas are counted once.
+	storage int64
+}
+
+// eventbusUsages returns usages of eventbuses by their names, and end offsets of their eventlogs.
+func (ctrl *controller) eventbusUsages() (map[string]eventbusUsage, map[vanus.ID]uint64) {
+	ctrl.mutex.Lock()
+	eventlogs := make(map[string][]vanus.ID, len(ctrl.eventBusMap))
+	for name, bus := range ctrl.eventBusMap {
+		ids := make([]vanus.ID, 0, len(bus.EventLogs))
+		for _, el := range bus.EventLogs {
+			ids = append(ids, el.ID)
+		}
+		eventlogs[name] = ids
+	}
+	ctrl.mutex.Unlock()
+
+	sizes := ctrl.blockStats.EventlogSizes()
+	usages := make(map[string]eventbusUsage, len(eventlogs))
+	ends := make(map[vanus.ID]uint64)
+	for name, ids := range eventlogs {
+		var u eventbusUsage
+		for _, id := range ids {
+			// Segments allocated in advance don't know their start offsets yet.
+			var end int64
+			for _, seg := range ctrl.eventLogMgr.GetEventLogSegmentList(id) {
+				if off := seg.StartOffsetInLog + int64(seg.Number); off > end {
+					end = off
+				}
+			}
+			ends[id] = uint64(end)
+			u.published += uint64(end)
+			u.storage += sizes[id]
+		}
+		usages[name] = u
+	}
+	return usages, ends
+}
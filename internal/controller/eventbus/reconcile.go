@@ -0,0 +1,223 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+// reconcileBlocks compares blocks recorded in kv with blocks which segment servers actually hold,
+// and repairs discrepancies before the controller begins to allocate blocks:
+//   - a recorded block missing in its server is removed if no segment uses it, otherwise it's marked lost
+//     and missing, so it's replaced with a copy of a healthy replica;
+//   - an unknown block in a server is adopted if a segment refers to it, otherwise it's removed.
+//
+// It returns IDs of lost blocks which are used by segments.
+func (ctrl *controller) reconcileBlocks(ctx context.Context) ([]vanus.ID, error) {
+	referred, err := ctrl.loadBlocksOfSegments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lost := make([]vanus.ID, 0)
+	for _, ins := range ctrl.volumeMgr.GetAllActiveVolumes() {
+		srv := ins.GetServer()
+		if srv == nil {
+			continue
+		}
+		res, err := srv.GetClient().GetBlockInfo(ctx, &segpb.GetBlockInfoRequest{})
+		if err != nil {
+			// don't let an unhealthy server block the controller, it will be reconciled on next start.
+			log.Warning(ctx, "failed to get block inventory of segment server", map[string]interface{}{
+				log.KeyError: err,
+				"volume_id":  ins.ID(),
+				"address":    srv.Address(),
+			})
+			continue
+		}
+		ids, err := ctrl.reconcileVolume(ctx, ins, res.Blocks, referred)
+		if err != nil {
+			return nil, err
+		}
+		lost = append(lost, ids...)
+	}
+	return lost, nil
+}
+
+// loadBlocksOfSegments returns all blocks which are referred by segments in kv.
+func (ctrl *controller) loadBlocksOfSegments(ctx context.Context) (map[uint64]*metadata.Block, error) {
+	pairs, err := ctrl.kvStore.List(ctx, metadata.SegmentKeyPrefixInKVStore)
+	if err != nil {
+		return nil, err
+	}
+	blocks := make(map[uint64]*metadata.Block)
+	for _, pair := range pairs {
+		seg := &eventlog.Segment{}
+		if err = json.Unmarshal(pair.Value, seg); err != nil {
+			return nil, err
+		}
+		if seg.Replicas == nil {
+			continue
+		}
+		for id, bl := range seg.Replicas.Peers {
+			blocks[id] = bl
+		}
+	}
+	return blocks, nil
+}
+
+func (ctrl *controller) reconcileVolume(ctx context.Context, ins server.Instance,
+	actual []*metapb.SegmentHealthInfo, referred map[uint64]*metadata.Block) ([]vanus.ID, error) {
+	md := ins.GetMeta()
+	held := make(map[uint64]*metapb.SegmentHealthInfo, len(actual))
+	for _, info := range actual {
+		held[info.Id] = info
+	}
+
+	lost := make([]vanus.ID, 0)
+	for id, bl := range md.Blocks {
+		if info, ok := held[id]; ok {
+			// The corrupted replica stays lost until it's removed.
+			if bl.Lost && !info.IsCorrupted {
+				bl.Lost = false
+				bl.Missing = false
+				if err := ctrl.saveBlock(ctx, bl); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		if bl.SegmentID == vanus.EmptyID() {
+			if err := ctrl.kvStore.Delete(ctx, metadata.GetBlockMetadataKey(md.ID, bl.ID)); err != nil {
+				return nil, err
+			}
+			md.Used -= bl.Capacity
+			delete(md.Blocks, id)
+			metrics.BlockReconciledCounterVec.WithLabelValues(metrics.LabelBlockReconciledRemoved).Inc()
+			log.Info(ctx, "the block missing in segment server is removed", map[string]interface{}{
+				"volume_id": md.ID,
+				"block_id":  bl.ID,
+			})
+			continue
+		}
+		if !bl.Lost || !bl.Missing {
+			bl.Lost = true
+			bl.Missing = true
+			if err := ctrl.saveBlock(ctx, bl); err != nil {
+				return nil, err
+			}
+		}
+		lost = append(lost, bl.ID)
+		metrics.BlockReconciledCounterVec.WithLabelValues(metrics.LabelBlockReconciledLost).Inc()
+		log.Warning(ctx, "the block missing in segment server is marked as lost", map[string]interface{}{
+			"volume_id":  md.ID,
+			"block_id":   bl.ID,
+			"segment_id": bl.SegmentID,
+		})
+	}
+
+	for id, info := range held {
+		if _, ok := md.Blocks[id]; ok {
+			continue
+		}
+		if bl, ok := referred[id]; ok && bl.VolumeID == md.ID {
+			adopted := &metadata.Block{
+				ID:         bl.ID,
+				Capacity:   info.Capacity,
+				Size:       info.Size,
+				VolumeID:   md.ID,
+				EventlogID: bl.EventlogID,
+				SegmentID:  bl.SegmentID,
+			}
+			if err := ctrl.saveBlock(ctx, adopted); err != nil {
+				return nil, err
+			}
+			md.Used += adopted.Capacity
+			md.Blocks[id] = adopted
+			metrics.BlockReconciledCounterVec.WithLabelValues(metrics.LabelBlockReconciledAdopted).Inc()
+			log.Info(ctx, "the unknown block in segment server is adopted", map[string]interface{}{
+				"volume_id":  md.ID,
+				"block_id":   adopted.ID,
+				"segment_id": adopted.SegmentID,
+			})
+			continue
+		}
+		_, err := ins.GetServer().GetClient().RemoveBlock(ctx, &segpb.RemoveBlockRequest{Id: id})
+		if err != nil && !errors.Is(err, errors.ErrBlockPendingDeletion) {
+			log.Warning(ctx, "failed to remove the unknown block in segment server", map[string]interface{}{
+				log.KeyError: err,
+				"volume_id":  md.ID,
+				"block_id":   vanus.NewIDFromUint64(id),
+			})
+			continue
+		}
+		metrics.BlockReconciledCounterVec.WithLabelValues(metrics.LabelBlockReconciledRemoved).Inc()
+		log.Info(ctx, "the unknown block in segment server is removed", map[string]interface{}{
+			"volume_id": md.ID,
+			"block_id":  vanus.NewIDFromUint64(id),
+		})
+	}
+	return lost, nil
+}
+
+func (ctrl *controller) saveBlock(ctx context.Context, bl *metadata.Block) error {
+	data, _ := json.Marshal(bl)
+	return ctrl.kvStore.Set(ctx, metadata.GetBlockMetadataKey(bl.VolumeID, bl.ID), data)
+}
+
+// markBlockCorrupted marks the replica reported corrupted by its segment server as lost, so that it isn't
+// routed to like a replica which is missing, and it's replaced once its segment is frozen.
+func (ctrl *controller) markBlockCorrupted(ctx context.Context, bl *metadata.Block) {
+	if bl.Lost && bl.Corrupted {
+		return
+	}
+	bl.Lost = true
+	bl.Corrupted = true
+	if err := ctrl.saveBlock(ctx, bl); err != nil {
+		log.Warning(ctx, "save the corrupted block failed", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   bl.ID,
+		})
+	}
+	metrics.BlockReconciledCounterVec.WithLabelValues(metrics.LabelBlockReconciledCorrupted).Inc()
+	log.Warning(ctx, "the corrupted block is marked as lost", map[string]interface{}{
+		"volume_id":   bl.VolumeID,
+		"block_id":    bl.ID,
+		"segment_id":  bl.SegmentID,
+		"eventlog_id": bl.EventlogID,
+	})
+}
+
+// markBlocksLost marks replicas of segments missing in their servers as lost, so they won't be routed to and
+// are repaired once their segments are frozen.
+func (ctrl *controller) markBlocksLost(ids []vanus.ID) {
+	for _, id := range ids {
+		if bl := ctrl.eventLogMgr.GetBlock(id); bl != nil {
+			bl.Lost = true
+			bl.Missing = true
+		}
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/server"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/volume"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_reconcileBlocks(t *testing.T) {
+	Convey("test reconcile blocks with segment servers", t, func() {
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		ctrl.kvStore = kvCli
+		volMgr := volume.NewMockManager(mockCtrl)
+		ctrl.volumeMgr = volMgr
+		ctx := stdCtx.Background()
+
+		volumeID := vanus.NewTestID()
+		segmentID := vanus.NewTestID()
+		matched := &metadata.Block{ID: vanus.NewTestID(), Capacity: 64, VolumeID: volumeID}
+		unused := &metadata.Block{ID: vanus.NewTestID(), Capacity: 64, VolumeID: volumeID}
+		used := &metadata.Block{ID: vanus.NewTestID(), Capacity: 64, VolumeID: volumeID, SegmentID: segmentID}
+		adopted := &metadata.Block{ID: vanus.NewTestID(), Capacity: 64, VolumeID: volumeID, SegmentID: segmentID}
+		unknownID := vanus.NewTestID()
+		md := &metadata.VolumeMetadata{
+			ID:   volumeID,
+			Used: 192,
+			Blocks: map[uint64]*metadata.Block{
+				matched.ID.Uint64(): matched,
+				unused.ID.Uint64():  unused,
+				used.ID.Uint64():    used,
+			},
+		}
+
+		seg := &eventlog.Segment{
+			ID: segmentID,
+			Replicas: &eventlog.ReplicaGroup{
+				Peers: map[uint64]*metadata.Block{
+					used.ID.Uint64():    used,
+					adopted.ID.Uint64(): adopted,
+				},
+			},
+		}
+		data, _ := json.Marshal(seg)
+		kvCli.EXPECT().List(ctx, metadata.SegmentKeyPrefixInKVStore).Times(1).Return([]kv.Pair{
+			{Key: metadata.GetSegmentMetadataKey(segmentID), Value: data},
+		}, nil)
+
+		ins := server.NewMockInstance(mockCtrl)
+		srv := server.NewMockServer(mockCtrl)
+		cli := segpb.NewMockSegmentServerClient(mockCtrl)
+		volMgr.EXPECT().GetAllActiveVolumes().Times(1).Return([]server.Instance{ins})
+		ins.EXPECT().GetMeta().AnyTimes().Return(md)
+		ins.EXPECT().GetServer().AnyTimes().Return(srv)
+		srv.EXPECT().GetClient().AnyTimes().Return(cli)
+		cli.EXPECT().GetBlockInfo(ctx, gomock.Any()).Times(1).Return(&segpb.GetBlockInfoResponse{
+			Blocks: []*metapb.SegmentHealthInfo{
+				{Id: matched.ID.Uint64(), Capacity: 64},
+				{Id: adopted.ID.Uint64(), Capacity: 64},
+				{Id: unknownID.Uint64(), Capacity: 64},
+			},
+		}, nil)
+
+		kvCli.EXPECT().Delete(ctx, metadata.GetBlockMetadataKey(volumeID, unused.ID)).Times(1).Return(nil)
+		kvCli.EXPECT().Set(ctx, metadata.GetBlockMetadataKey(volumeID, used.ID), gomock.Any()).Times(1).Return(nil)
+		kvCli.EXPECT().Set(ctx, metadata.GetBlockMetadataKey(volumeID, adopted.ID), gomock.Any()).
+			Times(1).Return(nil)
+		cli.EXPECT().RemoveBlock(ctx, &segpb.RemoveBlockRequest{Id: unknownID.Uint64()}).Times(1).Return(nil, nil)
+
+		lost, err := ctrl.reconcileBlocks(ctx)
+		So(err, ShouldBeNil)
+		So(lost, ShouldResemble, []vanus.ID{used.ID})
+		So(md.Blocks, ShouldHaveLength, 3)
+		So(md.Blocks[unused.ID.Uint64()], ShouldBeNil)
+		So(md.Blocks[used.ID.Uint64()].Lost, ShouldBeTrue)
+		So(md.Blocks[used.ID.Uint64()].Missing, ShouldBeTrue)
+		So(md.Blocks[adopted.ID.Uint64()].SegmentID, ShouldEqual, segmentID)
+		So(md.Used, ShouldEqual, 192)
+
+		elMgr := eventlog.NewMockManager(mockCtrl)
+		ctrl.eventLogMgr = elMgr
+		peer := &metadata.Block{ID: used.ID}
+		elMgr.EXPECT().GetBlock(used.ID).Times(1).Return(peer)
+		ctrl.markBlocksLost(lost)
+		So(peer.Lost, ShouldBeTrue)
+		So(peer.Missing, ShouldBeTrue)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
@@ -30,7 +31,7 @@ type Instance interface {
 	Address() string
 	Close() error
 	GetMeta() *metadata.VolumeMetadata
-	CreateBlock(context.Context, int64) (*metadata.Block, error)
+	CreateBlock(context.Context, int64, metapb.StorageMode) (*metadata.Block, error)
 	DeleteBlock(context.Context, vanus.ID) error
 	GetServer() Server
 	SetServer(Server)
@@ -53,22 +54,36 @@ func (ins *volumeInstance) GetMeta() *metadata.VolumeMetadata {
 	return ins.md
 }
 
-func (ins *volumeInstance) CreateBlock(ctx context.Context, capacity int64) (*metadata.Block, error) {
+func (ins *volumeInstance) CreateBlock(
+	ctx context.Context, capacity int64, mode metapb.StorageMode,
+) (*metadata.Block, error) {
 	id, err := vanus.NewID()
 	if err != nil {
 		return nil, err
 	}
 	blk := &metadata.Block{
-		ID:       id,
-		Capacity: capacity,
-		VolumeID: ins.md.ID,
+		ID:          id,
+		Capacity:    capacity,
+		VolumeID:    ins.md.ID,
+		StorageMode: mode,
 	}
 	if ins.srv == nil {
 		return nil, errors.ErrVolumeInstanceNoServer
 	}
-	_, err = ins.srv.GetClient().CreateBlock(ctx, &segpb.CreateBlockRequest{
-		Size: blk.Capacity,
-		Id:   blk.ID.Uint64(),
+	attempts := 0
+	err = StoreRPC.Do(ctx, func(ctx context.Context) error {
+		attempts++
+		_, err := ins.srv.GetClient().CreateBlock(ctx, &segpb.CreateBlockRequest{
+			Size:        blk.Capacity,
+			Id:          blk.ID.Uint64(),
+			StorageMode: blk.StorageMode,
+		})
+		// All attempts create the block of the same ID, so it's created by a previous attempt which failed
+		// after reaching the server if it already exists.
+		if attempts > 1 && errors.Is(err, errors.ErrResourceAlreadyExist) {
+			return nil
+		}
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -92,8 +107,12 @@ func (ins *volumeInstance) DeleteBlock(ctx context.Context, id vanus.ID) error {
 	if ins.srv == nil {
 		return nil
 	}
-	_, err := ins.srv.GetClient().RemoveBlock(ctx, &segpb.RemoveBlockRequest{Id: id.Uint64()})
-	if err != nil {
+	err := StoreRPC.Do(ctx, func(ctx context.Context) error {
+		_, err := ins.srv.GetClient().RemoveBlock(ctx, &segpb.RemoveBlockRequest{Id: id.Uint64()})
+		return err
+	})
+	// The segment server deletes a block pending deletion by itself once its readers are completed.
+	if err != nil && !errors.Is(err, errors.ErrBlockPendingDeletion) {
 		return err
 	}
 	ins.metaMutex.Lock()
@@ -20,13 +20,17 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 	"google.golang.org/grpc"
 
 	"github.com/golang/mock/gomock"
 	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestVolumeInstance(t *testing.T) {
@@ -71,7 +75,7 @@ func TestVolumeInstance(t *testing.T) {
 			return &empty.Empty{}, nil
 		}
 		segCli.EXPECT().CreateBlock(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(f)
-		block, err := ins.CreateBlock(ctx, 32*1024*1024)
+		block, err := ins.CreateBlock(ctx, 32*1024*1024, metapb.StorageMode_STORAGE_DURABLE)
 		So(err, ShouldBeNil)
 		So(block.VolumeID, ShouldEqual, md.ID)
 		So(block.Capacity, ShouldEqual, 32*1024*1024)
@@ -84,7 +88,7 @@ func TestVolumeInstance(t *testing.T) {
 			return &empty.Empty{}, nil
 		}
 		segCli.EXPECT().CreateBlock(ctx, gomock.Any(), gomock.Any()).Times(1).DoAndReturn(f)
-		block2, err := ins.CreateBlock(ctx, 64*1024*1024)
+		block2, err := ins.CreateBlock(ctx, 64*1024*1024, metapb.StorageMode_STORAGE_DURABLE)
 		So(err, ShouldBeNil)
 
 		So(md.Used, ShouldEqual, 96*1024*1024)
@@ -102,5 +106,26 @@ func TestVolumeInstance(t *testing.T) {
 		So(md.Used, ShouldEqual, 64*1024*1024)
 		So(md.Blocks[block.ID.Uint64()], ShouldBeNil)
 		So(md.Blocks[block2.ID.Uint64()], ShouldEqual, block2)
+
+		// the first attempt created the block before the connection broke.
+		var ids []uint64
+		f = func(ctx stdCtx.Context, in *segpb.CreateBlockRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+			ids = append(ids, in.Id)
+			if len(ids) == 1 {
+				return nil, status.Error(codes.Unavailable, "connection reset")
+			}
+			return nil, errors.ErrResourceAlreadyExist.WithMessage("the block has already exist")
+		}
+		segCli.EXPECT().CreateBlock(ctx, gomock.Any(), gomock.Any()).Times(2).DoAndReturn(f)
+		block3, err := ins.CreateBlock(ctx, 16*1024*1024, metapb.StorageMode_STORAGE_DURABLE)
+		So(err, ShouldBeNil)
+		So(ids, ShouldResemble, []uint64{block3.ID.Uint64(), block3.ID.Uint64()})
+		So(md.Blocks[block3.ID.Uint64()], ShouldEqual, block3)
+
+		// the block of a new ID conflicts with an existing one.
+		segCli.EXPECT().CreateBlock(ctx, gomock.Any(), gomock.Any()).Times(1).
+			Return(nil, errors.ErrResourceAlreadyExist)
+		_, err = ins.CreateBlock(ctx, 16*1024*1024, metapb.StorageMode_STORAGE_DURABLE)
+		So(errors.Is(err, errors.ErrResourceAlreadyExist), ShouldBeTrue)
 	})
 }
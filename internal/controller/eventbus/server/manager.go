@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/pkg/errors"
@@ -39,8 +40,12 @@ type Manager interface {
 	Run(ctx context.Context) error
 	Stop(ctx context.Context)
 	CanCreateEventbus(ctx context.Context, replicaNum int) bool
+	// OnServerLost registers a handler called after a server is found inactive and removed.
+	OnServerLost(handler LostHandler)
 }
 
+type LostHandler func(ctx context.Context, srv Server)
+
 const (
 	serverStateRunning = "running"
 )
@@ -63,6 +68,8 @@ type segmentServerManager struct {
 	cancel               func()
 	ticker               *time.Ticker
 	onlineServerNumber   int64
+	// handlers are registered before Run.
+	lostHandlers []LostHandler
 }
 
 func (mgr *segmentServerManager) AddServer(ctx context.Context, srv Server) error {
@@ -146,6 +153,9 @@ func (mgr *segmentServerManager) Run(ctx context.Context) error {
 							"address": srv.Address(),
 							"up_time": srv.Uptime(),
 						})
+						for _, handler := range mgr.lostHandlers {
+							handler(newCtx, srv)
+						}
 					}
 					return true
 				})
@@ -183,6 +193,10 @@ func (mgr *segmentServerManager) Stop(ctx context.Context) {
 	})
 }
 
+func (mgr *segmentServerManager) OnServerLost(handler LostHandler) {
+	mgr.lostHandlers = append(mgr.lostHandlers, handler)
+}
+
 func (mgr *segmentServerManager) CanCreateEventbus(ctx context.Context, replicaNum int) bool {
 	activeNum := 0
 	mgr.segmentServerMapByID.Range(func(_, value any) bool {
@@ -261,6 +275,7 @@ func NewSegmentServer(addr string) (Server, error) {
 	}
 	var opts []grpc.DialOption
 	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts = append(opts, compression.ControlDialOptions()...)
 	conn, err := grpc.Dial(addr, opts...)
 	if err != nil {
 		return nil, err
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	metadata "github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
 )
 
 // MockInstance is a mock of Instance interface.
@@ -65,18 +66,18 @@ func (mr *MockInstanceMockRecorder) Close() *gomock.Call {
 }
 
 // CreateBlock mocks base method.
-func (m *MockInstance) CreateBlock(arg0 context.Context, arg1 int64) (*metadata.Block, error) {
+func (m *MockInstance) CreateBlock(arg0 context.Context, arg1 int64, arg2 meta.StorageMode) (*metadata.Block, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBlock", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateBlock", arg0, arg1, arg2)
 	ret0, _ := ret[0].(*metadata.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateBlock indicates an expected call of CreateBlock.
-func (mr *MockInstanceMockRecorder) CreateBlock(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockInstanceMockRecorder) CreateBlock(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockInstance)(nil).CreateBlock), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockInstance)(nil).CreateBlock), arg0, arg1, arg2)
 }
 
 // DeleteBlock mocks base method.
@@ -93,6 +93,18 @@ func (mr *MockManagerMockRecorder) GetServerByServerID(id interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerByServerID", reflect.TypeOf((*MockManager)(nil).GetServerByServerID), id)
 }
 
+// OnServerLost mocks base method.
+func (m *MockManager) OnServerLost(handler LostHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnServerLost", handler)
+}
+
+// OnServerLost indicates an expected call of OnServerLost.
+func (mr *MockManagerMockRecorder) OnServerLost(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnServerLost", reflect.TypeOf((*MockManager)(nil).OnServerLost), handler)
+}
+
 // RemoveServer mocks base method.
 func (m *MockManager) RemoveServer(ctx context.Context, srv Server) error {
 	m.ctrl.T.Helper()
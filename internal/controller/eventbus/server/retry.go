@@ -0,0 +1,45 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/retry"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// StoreRPC retries requests of the controller to segment servers which failed as unavailable. Such requests
+// may have reached the server if the connection broke afterwards, so retried requests must be idempotent,
+// e.g. CreateBlock creates the block of the same ID in all attempts.
+var StoreRPC = &retry.Operation{
+	Name: "store_rpc",
+	Policy: retry.Policy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     2 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	},
+	Retryable: retry.IsUnavailable,
+	Budget:    retry.NewBudget(10, 0.1),
+	OnRetry: func(ctx context.Context, attempt int, err error) {
+		log.Info(ctx, "request to segment server failed, retry it", map[string]interface{}{
+			log.KeyError: err,
+			"attempt":    attempt,
+		})
+	},
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/slo"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (ctrl *controller) ReportPublishLatencies(ctx context.Context,
+	req *ctrlpb.ReportLatenciesRequest) (*emptypb.Empty, error) {
+	now := time.Now()
+	for _, r := range req.Reports {
+		ctrl.slo.Observe(ctx, slo.KindPublish, r.Resource, req.Source, r.Counts, now)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (ctrl *controller) ListEventbusSLO(_ context.Context,
+	req *ctrlpb.ListSLORequest) (*ctrlpb.ListSLOResponse, error) {
+	return slo.ToPb(ctrl.slo.List(slo.KindPublish, req.Resource, time.Now())), nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/eventbus/stats"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+func blockReport(block *metadata.Block, info *metapb.SegmentHealthInfo) stats.Report {
+	r := stats.Report{
+		BlockID:    block.ID,
+		SegmentID:  block.SegmentID,
+		EventlogID: block.EventlogID,
+		VolumeID:   block.VolumeID,
+		Capacity:   info.Capacity,
+		Size:       info.Size,
+		Number:     info.EventNumber,
+		Full:       info.IsFull,
+	}
+	if info.EventNumber > 0 {
+		r.FirstEventBornTime = time.UnixMilli(info.FirstEventBornTime)
+		r.LastEventBornTime = time.UnixMilli(info.LastEventBornTime)
+	}
+	return r
+}
+
+func (ctrl *controller) GetEventlogStats(ctx context.Context,
+	req *ctrlpb.GetEventlogStatsRequest) (*ctrlpb.GetEventlogStatsResponse, error) {
+	type eventlogOfBus struct {
+		id       vanus.ID
+		eventbus string
+	}
+	elID := vanus.NewIDFromUint64(req.EventLogId)
+	var eventlogs []eventlogOfBus
+	ctrl.mutex.Lock()
+	for name, bus := range ctrl.eventBusMap {
+		if req.Eventbus != "" && req.Eventbus != name {
+			continue
+		}
+		for _, el := range bus.EventLogs {
+			if req.EventLogId == 0 || el.ID == elID {
+				eventlogs = append(eventlogs, eventlogOfBus{id: el.ID, eventbus: name})
+			}
+		}
+	}
+	ctrl.mutex.Unlock()
+	if len(eventlogs) == 0 && (req.Eventbus != "" || req.EventLogId != 0) {
+		return nil, errors.ErrResourceNotFound.WithMessage("eventlog not found")
+	}
+	sort.Slice(eventlogs, func(i, j int) bool {
+		if eventlogs[i].eventbus != eventlogs[j].eventbus {
+			return eventlogs[i].eventbus < eventlogs[j].eventbus
+		}
+		return eventlogs[i].id < eventlogs[j].id
+	})
+
+	resp := &ctrlpb.GetEventlogStatsResponse{
+		Eventlogs:     make([]*ctrlpb.EventlogStats, 0, len(eventlogs)),
+		WindowSeconds: int64(ctrl.blockStats.Window().Seconds()),
+	}
+	for _, el := range eventlogs {
+		pb := &ctrlpb.EventlogStats{
+			EventLogId: el.id.Uint64(),
+			Eventbus:   el.eventbus,
+		}
+		if s := ctrl.blockStats.Eventlog(el.id); s != nil {
+			pb.Size = s.Size
+			pb.EventNumber = s.Number
+			pb.BytesPerDay = s.BytesPerDay
+			pb.AverageEventSize = s.AverageEventSize
+			pb.AverageFillSeconds = int64(s.AverageFillTime.Seconds())
+			pb.WritableFillSeconds = int64(s.WritableFillTime.Seconds())
+			for _, b := range s.Blocks {
+				pb.Blocks = append(pb.Blocks, &ctrlpb.BlockStats{
+					Id:               b.BlockID.Uint64(),
+					SegmentId:        b.SegmentID.Uint64(),
+					VolumeId:         b.VolumeID.Uint64(),
+					Capacity:         b.Capacity,
+					Size:             b.Size,
+					EventNumber:      b.Number,
+					IsFull:           b.Full,
+					BytesPerDay:      b.BytesPerDay,
+					AverageEventSize: b.AverageEventSize,
+					FillSeconds:      int64(b.FillTime.Seconds()),
+				})
+			}
+		}
+		resp.Eventlogs = append(resp.Eventlogs, pb)
+	}
+	return resp, nil
+}
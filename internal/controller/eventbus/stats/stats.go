@@ -0,0 +1,264 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	defaultWindow = 24 * time.Hour
+	// maxSamples bounds the history of a block, samples are kept at least window/maxSamples apart.
+	maxSamples = 288
+	// minSpan is the shortest history which growth rates are computed from.
+	minSpan = time.Minute
+	day     = 24 * time.Hour
+)
+
+type Config struct {
+	// Window is how long the history of block sizes is kept, it's 24h by default.
+	Window time.Duration `yaml:"window"`
+}
+
+// Report is the state of a block reported by segment heartbeats.
+type Report struct {
+	BlockID    vanus.ID
+	SegmentID  vanus.ID
+	EventlogID vanus.ID
+	VolumeID   vanus.ID
+	Capacity   int64
+	Size       int64
+	Number     int32
+	Full       bool
+	// FirstEventBornTime and LastEventBornTime are zero if the block is empty.
+	FirstEventBornTime time.Time
+	LastEventBornTime  time.Time
+}
+
+type sample struct {
+	at   time.Time
+	size int64
+}
+
+type history struct {
+	last    Report
+	samples []sample
+}
+
+// Collector keeps the history of block sizes in a window, and computes statistics for capacity planning
+// from it. The history lives in memory of the leader, it starts over after the leader changes.
+type Collector struct {
+	window   time.Duration
+	interval time.Duration
+
+	mutex    sync.RWMutex
+	blocks   map[vanus.ID]*history
+	prunedAt time.Time
+}
+
+func NewCollector(cfg Config) *Collector {
+	if cfg.Window <= 0 {
+		cfg.Window = defaultWindow
+	}
+	return &Collector{
+		window:   cfg.Window,
+		interval: cfg.Window / maxSamples,
+		blocks:   map[vanus.ID]*history{},
+	}
+}
+
+func (c *Collector) Window() time.Duration {
+	return c.window
+}
+
+// Observe records a report received at now.
+func (c *Collector) Observe(r Report, now time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	h, ok := c.blocks[r.BlockID]
+	if !ok {
+		h = &history{}
+		c.blocks[r.BlockID] = h
+	}
+	h.last = r
+	n := len(h.samples)
+	if n >= 2 && now.Sub(h.samples[n-2].at) < c.interval {
+		// Replace the latest sample, so that samples are not denser than the interval.
+		h.samples[n-1] = sample{at: now, size: r.Size}
+	} else {
+		h.samples = append(h.samples, sample{at: now, size: r.Size})
+	}
+	for len(h.samples) > 1 && now.Sub(h.samples[0].at) > c.window {
+		h.samples = h.samples[1:]
+	}
+
+	if now.Sub(c.prunedAt) >= c.interval {
+		c.prunedAt = now
+		for id, b := range c.blocks {
+			// Blocks which aren't reported anymore have been deleted.
+			if now.Sub(b.samples[len(b.samples)-1].at) > c.window {
+				delete(c.blocks, id)
+			}
+		}
+	}
+}
+
+// Block is the statistics of a block.
+type Block struct {
+	Report
+	// BytesPerDay is 0 if the history is too short to tell.
+	BytesPerDay      float64
+	AverageEventSize float64
+	// FillTime is the time taken from the first to the last event if the block is full, otherwise it's the
+	// estimated time until the block is full, 0 if unknown.
+	FillTime time.Duration
+
+	span  time.Duration
+	grown int64
+	from  time.Time
+	to    time.Time
+}
+
+// Eventlog is the statistics of an eventlog, replicas of a segment are counted once.
+type Eventlog struct {
+	ID               vanus.ID
+	Size             int64
+	Number           int64
+	BytesPerDay      float64
+	AverageEventSize float64
+	// AverageFillTime is averaged over blocks filled in the window, 0 if none.
+	AverageFillTime time.Duration
+	// WritableFillTime is the estimated time until the writable block is full, 0 if unknown.
+	WritableFillTime time.Duration
+	Blocks           []Block
+}
+
+// Eventlog returns nil if no block of the eventlog has been reported.
+func (c *Collector) Eventlog(id vanus.ID) *Eventlog {
+	c.mutex.RLock()
+	var blocks []Block
+	for _, h := range c.blocks {
+		if h.last.EventlogID == id {
+			blocks = append(blocks, h.stats())
+		}
+	}
+	c.mutex.RUnlock()
+	if len(blocks) == 0 {
+		return nil
+	}
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].SegmentID != blocks[j].SegmentID {
+			return blocks[i].SegmentID < blocks[j].SegmentID
+		}
+		return blocks[i].BlockID < blocks[j].BlockID
+	})
+	return aggregate(id, blocks)
+}
+
+// EventlogSizes returns bytes taken by each eventlog which has blocks reported, replicas of a segment are
+// counted once.
+func (c *Collector) EventlogSizes() map[vanus.ID]int64 {
+	c.mutex.RLock()
+	segments := map[vanus.ID]Report{}
+	for _, h := range c.blocks {
+		if s, ok := segments[h.last.SegmentID]; !ok || h.last.Size > s.Size {
+			segments[h.last.SegmentID] = h.last
+		}
+	}
+	c.mutex.RUnlock()
+	sizes := make(map[vanus.ID]int64)
+	for _, s := range segments {
+		sizes[s.EventlogID] += s.Size
+	}
+	return sizes
+}
+
+func (h *history) stats() Block {
+	b := Block{Report: h.last}
+	if b.Number > 0 {
+		b.AverageEventSize = float64(b.Size) / float64(b.Number)
+	}
+	first, last := h.samples[0], h.samples[len(h.samples)-1]
+	b.from, b.to = first.at, last.at
+	b.span = last.at.Sub(first.at)
+	b.grown = last.size - first.size
+	if b.span >= minSpan && b.grown > 0 {
+		b.BytesPerDay = float64(b.grown) * float64(day) / float64(b.span)
+	}
+	switch {
+	case b.Full:
+		if !b.FirstEventBornTime.IsZero() {
+			b.FillTime = b.LastEventBornTime.Sub(b.FirstEventBornTime)
+		}
+	case b.BytesPerDay > 0 && b.Capacity > b.Size:
+		b.FillTime = time.Duration(float64(b.Capacity-b.Size) * float64(day) / b.BytesPerDay)
+	}
+	return b
+}
+
+func aggregate(id vanus.ID, blocks []Block) *Eventlog {
+	el := &Eventlog{ID: id, Blocks: blocks}
+	// Replicas of a segment may lag behind each other, the largest one stands for the segment.
+	segments := map[vanus.ID]Block{}
+	for _, b := range blocks {
+		if s, ok := segments[b.SegmentID]; !ok || b.Size > s.Size {
+			segments[b.SegmentID] = b
+		}
+	}
+	var (
+		grown     int64
+		from, to  time.Time
+		filled    time.Duration
+		numFilled int64
+	)
+	for _, s := range segments {
+		el.Size += s.Size
+		el.Number += int64(s.Number)
+		if s.grown > 0 {
+			grown += s.grown
+		}
+		if from.IsZero() || s.from.Before(from) {
+			from = s.from
+		}
+		if s.to.After(to) {
+			to = s.to
+		}
+		if s.Full {
+			// Only blocks filled in the window, or the average is dominated by old blocks.
+			if s.FillTime > 0 && s.grown > 0 {
+				filled += s.FillTime
+				numFilled++
+			}
+		} else if s.FillTime > 0 && (el.WritableFillTime == 0 || s.FillTime < el.WritableFillTime) {
+			el.WritableFillTime = s.FillTime
+		}
+	}
+	if el.Number > 0 {
+		el.AverageEventSize = float64(el.Size) / float64(el.Number)
+	}
+	// Segments follow one another, so the growth of the eventlog is the total bytes grown over the whole
+	// span rather than the sum of rates of segments.
+	if span := to.Sub(from); span >= minSpan && grown > 0 {
+		el.BytesPerDay = float64(grown) * float64(day) / float64(span)
+	}
+	if numFilled > 0 {
+		el.AverageFillTime = filled / time.Duration(numFilled)
+	}
+	return el
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCollector(t *testing.T) {
+	Convey("test collector", t, func() {
+		c := NewCollector(Config{Window: time.Hour})
+		now := time.Now()
+		elID := vanus.ID(1)
+		report := func(block, segment vanus.ID, size int64, number int32) Report {
+			return Report{
+				BlockID:    block,
+				SegmentID:  segment,
+				EventlogID: elID,
+				Capacity:   4000,
+				Size:       size,
+				Number:     number,
+			}
+		}
+
+		Convey("unknown eventlog", func() {
+			So(c.Eventlog(elID), ShouldBeNil)
+		})
+
+		Convey("growth of blocks and the eventlog", func() {
+			full := report(3, 1, 3500, 35)
+			c.Observe(full, now)
+			full.Size, full.Number, full.Full = 4000, 40, true
+			full.FirstEventBornTime = now.Add(-time.Hour)
+			full.LastEventBornTime = now.Add(10 * time.Minute)
+			c.Observe(full, now.Add(10*time.Minute))
+
+			for i, size := range []int64{0, 1000, 2000} {
+				at := now.Add(time.Duration(i) * 30 * time.Minute)
+				c.Observe(report(1, 2, size, int32(size/100)), at)
+				// a replica lagging behind
+				c.Observe(report(2, 2, size*9/10, int32(size/100)), at)
+			}
+
+			el := c.Eventlog(elID)
+			So(el, ShouldNotBeNil)
+			So(el.Blocks, ShouldHaveLength, 3)
+			So(el.Blocks[0].BlockID, ShouldEqual, 3)
+
+			So(el.Blocks[1].BytesPerDay, ShouldEqual, 48000)
+			So(el.Blocks[1].AverageEventSize, ShouldEqual, 100)
+			So(el.Blocks[1].FillTime, ShouldEqual, time.Hour)
+			So(el.Blocks[0].FillTime, ShouldEqual, 70*time.Minute)
+
+			So(el.Size, ShouldEqual, 6000)
+			So(el.Number, ShouldEqual, 60)
+			So(el.BytesPerDay, ShouldEqual, 60000)
+			So(el.AverageFillTime, ShouldEqual, 70*time.Minute)
+			So(el.WritableFillTime, ShouldEqual, time.Hour)
+			So(c.EventlogSizes(), ShouldResemble, map[vanus.ID]int64{elID: 6000})
+
+			Convey("forget blocks which aren't reported anymore", func() {
+				c.Observe(Report{BlockID: 4, SegmentID: 3, EventlogID: elID}, now.Add(3*time.Hour))
+				el = c.Eventlog(elID)
+				So(el.Blocks, ShouldHaveLength, 1)
+				So(el.BytesPerDay, ShouldEqual, 0)
+			})
+		})
+
+		Convey("bound samples of a block", func() {
+			for i := 0; i < 100; i++ {
+				c.Observe(report(1, 1, int64(i), 1), now.Add(time.Duration(i)*time.Second))
+			}
+			So(len(c.blocks[1].samples), ShouldBeLessThanOrEqualTo, 10)
+			for i := 0; i < 2*maxSamples; i++ {
+				c.Observe(report(1, 1, int64(i), 1), now.Add(time.Duration(i)*time.Minute))
+			}
+			So(len(c.blocks[1].samples), ShouldBeLessThanOrEqualTo, maxSamples+1)
+			first := c.blocks[1].samples[0]
+			So(now.Add(time.Duration(2*maxSamples-1)*time.Minute).Sub(first.at), ShouldBeLessThanOrEqualTo, time.Hour)
+		})
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+
+	"github.com/linkall-labs/vanus/internal/controller/verifier"
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+func (ctrl *controller) VerifyEventBusOrdering(ctx context.Context,
+	req *ctrlpb.VerifyEventBusOrderingRequest) (*metapb.Job, error) {
+	if _, err := ctrl.getEventbus(req.Eventbus); err != nil {
+		return nil, err
+	}
+	spec, err := verifier.OrderingOptions{Eventbus: req.Eventbus}.Spec()
+	if err != nil {
+		return nil, err
+	}
+	j, err := ctrl.jobMgr.Submit(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "the ordering verification of eventbus has been submitted", map[string]interface{}{
+		"job_id":   j.ID.Key(),
+		"eventbus": req.Eventbus,
+	})
+	return j.ToPb(), nil
+}
@@ -469,3 +469,64 @@ func TestVolumeMgr_GetBlocksOfVolume(t *testing.T) {
 		So(blocks, ShouldHaveLength, 4)
 	})
 }
+
+func TestVolumeMgr_VerifyNode(t *testing.T) {
+	Convey("test verify node of volume", t, func() {
+		ctrl := gomock.NewController(t)
+		kvCli := kv.NewMockClient(ctrl)
+		mgr := &volumeMgr{kvCli: kvCli}
+		ctx := stdCtx.Background()
+
+		vol := &metadata.VolumeMetadata{
+			ID:       vanus.NewTestID(),
+			Capacity: 16 * 1024 * 1024 * 1024,
+			Blocks:   map[uint64]*metadata.Block{},
+		}
+		ins := server.NewInstance(vol)
+		volKey := filepath.Join(metadata.VolumeKeyPrefixInKVStore, vol.ID.String())
+		blockKey := strings.Join([]string{metadata.BlockKeyPrefixInKVStore, vol.ID.String()}, "/")
+
+		Convey("bind the first node", func() {
+			kvCli.EXPECT().Set(ctx, volKey, gomock.Any()).Times(1).DoAndReturn(
+				func(ctx stdCtx.Context, key string, value []byte) error {
+					md := &metadata.VolumeMetadata{}
+					So(stdJson.Unmarshal(value, md), ShouldBeNil)
+					So(md.NodeID, ShouldEqual, "node-1")
+					So(md.Blocks, ShouldBeEmpty)
+					return nil
+				})
+			So(mgr.VerifyNode(ctx, ins, "node-1"), ShouldBeNil)
+			So(vol.NodeID, ShouldEqual, "node-1")
+
+			// The same node registers again.
+			So(mgr.VerifyNode(ctx, ins, "node-1"), ShouldBeNil)
+		})
+
+		Convey("reject a new node if the volume owns blocks", func() {
+			vol.NodeID = "node-1"
+			block := metadata.Block{
+				ID:       vanus.NewTestID(),
+				Capacity: 64 * 1024 * 1024,
+				VolumeID: vol.ID,
+			}
+			data, _ := stdJson.Marshal(block)
+			kvCli.EXPECT().List(ctx, blockKey).Times(1).Return([]kv.Pair{
+				{
+					Key:   filepath.Join(metadata.BlockKeyPrefixInKVStore, vol.ID.String(), block.ID.String()),
+					Value: data,
+				},
+			}, nil)
+			err := mgr.VerifyNode(ctx, ins, "node-2")
+			So(errors.Is(err, errors.ErrSegmentServerMismatch), ShouldBeTrue)
+			So(vol.NodeID, ShouldEqual, "node-1")
+		})
+
+		Convey("rebind a new node if the volume owns no block", func() {
+			vol.NodeID = "node-1"
+			kvCli.EXPECT().List(ctx, blockKey).Times(1).Return([]kv.Pair{}, nil)
+			kvCli.EXPECT().Set(ctx, volKey, gomock.Any()).Times(1).Return(nil)
+			So(mgr.VerifyNode(ctx, ins, "node-2"), ShouldBeNil)
+			So(vol.NodeID, ShouldEqual, "node-2")
+		})
+	})
+}
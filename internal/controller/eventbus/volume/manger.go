@@ -17,6 +17,7 @@ package volume
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -26,6 +27,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/kv"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
 type Manager interface {
@@ -36,6 +38,7 @@ type Manager interface {
 	GetVolumeInstanceByID(id vanus.ID) server.Instance
 	LookupVolumeByServerID(id vanus.ID) server.Instance
 	GetBlocksOfVolume(ctx context.Context, instance server.Instance) (map[uint64]*metadata.Block, error)
+	VerifyNode(ctx context.Context, instance server.Instance, nodeID string) error
 }
 
 var (
@@ -222,6 +225,48 @@ func (mgr *volumeMgr) UpdateRouting(ctx context.Context, ins server.Instance, sr
 	mgr.volInstanceMap.Store(ins.ID().Key(), ins)
 }
 
+// VerifyNode checks that the segment server registering the volume holds the data directory bound to it. The
+// first node registering the volume is bound to it. A different node is rejected if any block is placed on
+// the volume, since it has lost these blocks, e.g. its data directory was wiped, otherwise it's rebound.
+func (mgr *volumeMgr) VerifyNode(ctx context.Context, instance server.Instance, nodeID string) error {
+	md := instance.GetMeta()
+	if md.NodeID == nodeID {
+		return nil
+	}
+	if md.NodeID != "" {
+		blocks, err := mgr.GetBlocksOfVolume(ctx, instance)
+		if err != nil {
+			return err
+		}
+		for _, bl := range blocks {
+			if !bl.Lost {
+				return errors.ErrSegmentServerMismatch.WithMessage(fmt.Sprintf(
+					"volume %s is bound to node %s but registered by node %s, which may have lost its data "+
+						"directory", md.ID, md.NodeID, nodeID))
+			}
+		}
+		log.Warning(ctx, "rebind the volume without blocks to a new node", map[string]interface{}{
+			"volume_id": md.ID,
+			"old_node":  md.NodeID,
+			"new_node":  nodeID,
+		})
+	}
+
+	// Blocks are persisted separately.
+	data, _ := json.Marshal(&metadata.VolumeMetadata{
+		ID:       md.ID,
+		Capacity: md.Capacity,
+		Used:     md.Used,
+		NodeID:   nodeID,
+	})
+	key := filepath.Join(metadata.VolumeKeyPrefixInKVStore, md.ID.String())
+	if err := mgr.kvCli.Set(ctx, key, data); err != nil {
+		return err
+	}
+	md.NodeID = nodeID
+	return nil
+}
+
 func (mgr *volumeMgr) GetBlocksOfVolume(ctx context.Context,
 	instance server.Instance) (map[uint64]*metadata.Block, error) {
 	pairs, err := mgr.kvCli.List(ctx, strings.Join([]string{metadata.BlockKeyPrefixInKVStore,
@@ -135,3 +135,17 @@ func (mr *MockManagerMockRecorder) UpdateRouting(ctx, ins, srv interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRouting", reflect.TypeOf((*MockManager)(nil).UpdateRouting), ctx, ins, srv)
 }
+
+// VerifyNode mocks base method.
+func (m *MockManager) VerifyNode(ctx context.Context, instance server.Instance, nodeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyNode", ctx, instance, nodeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyNode indicates an expected call of VerifyNode.
+func (mr *MockManagerMockRecorder) VerifyNode(ctx, instance, nodeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyNode", reflect.TypeOf((*MockManager)(nil).VerifyNode), ctx, instance, nodeID)
+}
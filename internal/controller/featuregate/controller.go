@@ -0,0 +1,202 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featuregate
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/kv/etcd"
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// KeyPrefixInKVStore keeps overrides of feature gates, which are shared by all controllers.
+const KeyPrefixInKVStore = "/vanus/internal/cluster/feature_gates"
+
+// rewatchInterval is how long to wait before watching again once a watch failed.
+const rewatchInterval = time.Second
+
+type Config struct {
+	KVEndpoints []string
+	KVPrefix    string
+}
+
+type Controller struct {
+	cfg     Config
+	kvStore kv.Client
+	stopCh  chan struct{}
+	wg      sync.WaitGroup
+}
+
+// make sure Controller implements ctrlpb.FeatureGateControllerServer.
+var _ ctrlpb.FeatureGateControllerServer = (*Controller)(nil)
+
+func NewController(cfg Config) *Controller {
+	return &Controller{cfg: cfg}
+}
+
+func (c *Controller) Start(ctx context.Context) error {
+	store, err := etcd.NewEtcdClientV3(c.cfg.KVEndpoints, c.cfg.KVPrefix)
+	if err != nil {
+		return err
+	}
+	c.kvStore = store
+
+	// Gates of the controller itself are applied once started, and on changes.
+	o, err := c.overrides(ctx)
+	if err != nil {
+		return err
+	}
+	featuregate.Apply(ctx, featuregate.ListOf(o))
+
+	c.stopCh = make(chan struct{})
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.watch(context.Background())
+	}()
+	return nil
+}
+
+func (c *Controller) Stop() {
+	if c.stopCh != nil {
+		close(c.stopCh)
+		c.wg.Wait()
+	}
+	if c.kvStore != nil {
+		_ = c.kvStore.Close()
+	}
+}
+
+func (c *Controller) ListFeatureGates(ctx context.Context, _ *emptypb.Empty) (*ctrlpb.ListFeatureGatesResponse, error) {
+	o, err := c.overrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ctrlpb.ListFeatureGatesResponse{Gates: featuregate.ListOf(o)}, nil
+}
+
+func (c *Controller) SetFeatureGate(ctx context.Context, req *ctrlpb.SetFeatureGateRequest) (*metapb.FeatureGate, error) {
+	f := featuregate.Feature(req.Name)
+	spec, ok := featuregate.Lookup(f)
+	if !ok {
+		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("unknown feature gate: %s", req.Name))
+	}
+	if spec.Stage == featuregate.GA {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the feature gate %s is GA, it can't be changed", req.Name))
+	}
+
+	key := path.Join(KeyPrefixInKVStore, req.Name)
+	var err error
+	if req.Reset_ {
+		err = c.kvStore.Delete(ctx, key)
+	} else {
+		err = c.kvStore.Set(ctx, key, []byte(strconv.FormatBool(req.Enabled)))
+	}
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("save the feature gate failed").Wrap(err)
+	}
+
+	o, err := c.overrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	gates := featuregate.ListOf(o)
+	featuregate.Apply(ctx, gates)
+	log.Info(ctx, "the feature gate is set", map[string]interface{}{
+		"feature": req.Name,
+		"enabled": req.Enabled,
+		"reset":   req.Reset_,
+	})
+	for _, g := range gates {
+		if g.Name == req.Name {
+			return g, nil
+		}
+	}
+	return nil, errors.ErrResourceNotFound.WithMessage("feature gate not found")
+}
+
+// overrides loads overrides from kv, values which can't be parsed are ignored.
+func (c *Controller) overrides(ctx context.Context) (map[featuregate.Feature]bool, error) {
+	pairs, err := c.kvStore.List(ctx, KeyPrefixInKVStore)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("list feature gates failed").Wrap(err)
+	}
+	o := make(map[featuregate.Feature]bool, len(pairs))
+	for _, pair := range pairs {
+		enabled, err := strconv.ParseBool(string(pair.Value))
+		if err != nil {
+			continue
+		}
+		o[featuregate.Feature(path.Base(pair.Key))] = enabled
+	}
+	return o, nil
+}
+
+// watch applies overrides set through other controllers until stopped. Overrides are
+// reloaded after a failed watch as well, since changes may have been missed meanwhile.
+func (c *Controller) watch(ctx context.Context) {
+	for {
+		pairC, errC := c.kvStore.WatchTree(ctx, KeyPrefixInKVStore, c.stopCh)
+		if !c.follow(ctx, pairC, errC) {
+			return
+		}
+		select {
+		case <-c.stopCh:
+			return
+		case <-time.After(rewatchInterval):
+		}
+		c.reload(ctx)
+	}
+}
+
+// follow reloads overrides on every change, it returns false once stopped.
+func (c *Controller) follow(ctx context.Context, pairC chan kv.Pair, errC chan error) bool {
+	for {
+		select {
+		case <-c.stopCh:
+			return false
+		case <-pairC:
+			c.reload(ctx)
+		case err := <-errC:
+			log.Warning(ctx, "watch feature gates failed", map[string]interface{}{
+				log.KeyError: err,
+			})
+			return true
+		}
+	}
+}
+
+func (c *Controller) reload(ctx context.Context) {
+	o, err := c.overrides(ctx)
+	if err != nil {
+		log.Warning(ctx, "reload feature gates failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return
+	}
+	featuregate.Apply(ctx, featuregate.ListOf(o))
+}
@@ -0,0 +1,161 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featuregate
+
+import (
+	"context"
+	"errors"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestController_SetFeatureGate(t *testing.T) {
+	Convey("test set feature gate", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		c := NewController(Config{})
+		c.kvStore = kvCli
+		defer featuregate.Apply(ctx, nil)
+
+		key := path.Join(KeyPrefixInKVStore, string(featuregate.ShadowPublish))
+		stored := map[string][]byte{}
+		kvCli.EXPECT().List(gomock.Any(), KeyPrefixInKVStore).AnyTimes().DoAndReturn(
+			func(ctx context.Context, prefix string) ([]kv.Pair, error) {
+				pairs := make([]kv.Pair, 0, len(stored))
+				for k, v := range stored {
+					pairs = append(pairs, kv.Pair{Key: k, Value: v})
+				}
+				return pairs, nil
+			})
+		kvCli.EXPECT().Set(gomock.Any(), key, gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, key string, value []byte) error {
+				stored[key] = value
+				return nil
+			})
+		kvCli.EXPECT().Delete(gomock.Any(), key).AnyTimes().DoAndReturn(
+			func(ctx context.Context, key string) error {
+				delete(stored, key)
+				return nil
+			})
+
+		Convey("override and reset", func() {
+			g, err := c.SetFeatureGate(ctx, &ctrlpb.SetFeatureGateRequest{
+				Name: string(featuregate.ShadowPublish), Enabled: true,
+			})
+			So(err, ShouldBeNil)
+			So(g.Enabled, ShouldBeTrue)
+			So(g.Overridden, ShouldBeTrue)
+			So(string(stored[key]), ShouldEqual, "true")
+			So(featuregate.Enabled(featuregate.ShadowPublish), ShouldBeTrue)
+
+			res, err := c.ListFeatureGates(ctx, &emptypb.Empty{})
+			So(err, ShouldBeNil)
+			So(res.Gates, ShouldHaveLength, len(featuregate.List()))
+
+			g, err = c.SetFeatureGate(ctx, &ctrlpb.SetFeatureGateRequest{
+				Name: string(featuregate.ShadowPublish), Reset_: true,
+			})
+			So(err, ShouldBeNil)
+			So(g.Enabled, ShouldBeFalse)
+			So(g.Overridden, ShouldBeFalse)
+			So(stored, ShouldBeEmpty)
+			So(featuregate.Enabled(featuregate.ShadowPublish), ShouldBeFalse)
+		})
+
+		Convey("unknown gate", func() {
+			_, err := c.SetFeatureGate(ctx, &ctrlpb.SetFeatureGateRequest{Name: "Unknown", Enabled: true})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestController_watch(t *testing.T) {
+	Convey("test watch feature gates", t, func() {
+		ctx := context.Background()
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		kvCli := kv.NewMockClient(mockCtrl)
+		c := NewController(Config{})
+		c.kvStore = kvCli
+		c.stopCh = make(chan struct{})
+		defer featuregate.Apply(ctx, nil)
+
+		key := path.Join(KeyPrefixInKVStore, string(featuregate.ShadowPublish))
+		var enabled int32
+		kvCli.EXPECT().List(gomock.Any(), KeyPrefixInKVStore).AnyTimes().DoAndReturn(
+			func(ctx context.Context, prefix string) ([]kv.Pair, error) {
+				if atomic.LoadInt32(&enabled) == 0 {
+					return nil, nil
+				}
+				return []kv.Pair{{Key: key, Value: []byte("true")}}, nil
+			})
+		var watches int32
+		pairC := make(chan kv.Pair, 1)
+		errC := make(chan error, 1)
+		kvCli.EXPECT().WatchTree(gomock.Any(), KeyPrefixInKVStore, gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, prefix string, stopCh <-chan struct{}) (chan kv.Pair, chan error) {
+				atomic.AddInt32(&watches, 1)
+				return pairC, errC
+			})
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			c.watch(ctx)
+		}()
+
+		Convey("apply changes made by other controllers", func() {
+			atomic.StoreInt32(&enabled, 1)
+			pairC <- kv.Pair{Key: key, Value: []byte("true"), Action: kv.Create}
+			So(waitFor(func() bool { return featuregate.Enabled(featuregate.ShadowPublish) }), ShouldBeTrue)
+
+			atomic.StoreInt32(&enabled, 0)
+			pairC <- kv.Pair{Key: key, Action: kv.Delete}
+			So(waitFor(func() bool { return !featuregate.Enabled(featuregate.ShadowPublish) }), ShouldBeTrue)
+		})
+
+		Convey("watch again and reload once the watch failed", func() {
+			atomic.StoreInt32(&enabled, 1)
+			errC <- errors.New("watch failed")
+			So(waitFor(func() bool { return atomic.LoadInt32(&watches) == 2 }), ShouldBeTrue)
+			So(featuregate.Enabled(featuregate.ShadowPublish), ShouldBeTrue)
+		})
+
+		close(c.stopCh)
+		<-done
+	})
+}
+
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return cond()
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/controller/importer/kafka"
+	"github.com/linkall-labs/vanus/internal/primitive"
+)
+
+const (
+	// EventTypeKafkaRecord is the type of events converted from plain records.
+	EventTypeKafkaRecord = "vanus.kafka.record"
+	// ExtensionPartitionKey carries the key of the record, as the CloudEvents Kafka protocol binding does.
+	ExtensionPartitionKey = "partitionkey"
+
+	headerContentType = "content-type"
+	// headerPrefixCE prefixes attributes of events in binary content mode of the Kafka protocol binding.
+	headerPrefixCE         = "ce_"
+	contentTypeStructured  = "application/cloudevents+json"
+	contentTypeJSON        = "application/json"
+	contentTypeOctetStream = "application/octet-stream"
+	kafkaSourcePrefix      = "/kafka/"
+	maxExtensionNameLength = 64
+)
+
+// toEvent converts a record to a CloudEvent. Records written in binary or structured content mode of the
+// CloudEvents Kafka protocol binding are decoded as they are, other records are wrapped into events whose
+// IDs are derived from their positions, so that duplicates imported after retries can be told apart. Keys
+// and headers are kept as extensions.
+func toEvent(topic string, r kafka.Record) *ce.Event {
+	contentType := ""
+	for _, h := range r.Headers {
+		if strings.EqualFold(h.Key, headerContentType) {
+			contentType = string(h.Value)
+		}
+	}
+	if strings.HasPrefix(contentType, contentTypeStructured) {
+		e := ce.NewEvent()
+		if err := json.Unmarshal(r.Value, &e); err == nil && e.Validate() == nil {
+			return &e
+		}
+	} else if e := fromBinaryMode(r, contentType); e != nil {
+		return e
+	}
+
+	e := ce.NewEvent()
+	e.SetID(fmt.Sprintf("%s-%d-%d", topic, r.Partition, r.Offset))
+	e.SetSource(kafkaSourcePrefix + topic)
+	e.SetType(EventTypeKafkaRecord)
+	if !r.Timestamp.IsZero() && r.Timestamp.UnixMilli() > 0 {
+		e.SetTime(r.Timestamp)
+	}
+	if contentType == "" {
+		contentType = contentTypeOctetStream
+		if json.Valid(r.Value) {
+			contentType = contentTypeJSON
+		}
+	}
+	_ = e.SetData(contentType, r.Value)
+	setKeyAndHeaders(&e, r, false)
+	return &e
+}
+
+// fromBinaryMode returns nil if the record isn't a valid event in binary content mode.
+func fromBinaryMode(r kafka.Record, contentType string) *ce.Event {
+	attrs := map[string]string{}
+	for _, h := range r.Headers {
+		name := strings.ToLower(h.Key)
+		if strings.HasPrefix(name, headerPrefixCE) {
+			attrs[strings.TrimPrefix(name, headerPrefixCE)] = string(h.Value)
+		}
+	}
+	if attrs["specversion"] == "" {
+		return nil
+	}
+	e := ce.NewEvent(attrs["specversion"])
+	for name, value := range attrs {
+		switch name {
+		case "specversion":
+		case "id":
+			e.SetID(value)
+		case "source":
+			e.SetSource(value)
+		case "type":
+			e.SetType(value)
+		case "subject":
+			e.SetSubject(value)
+		case "dataschema":
+			e.SetDataSchema(value)
+		case "time":
+			t, err := time.Parse(time.RFC3339Nano, value)
+			if err != nil {
+				return nil
+			}
+			e.SetTime(t)
+		default:
+			// Invalid extensions fail the validation below.
+			e.SetExtension(name, value)
+		}
+	}
+	if r.Value != nil {
+		_ = e.SetData(contentType, r.Value)
+	}
+	if e.Validate() != nil {
+		return nil
+	}
+	setKeyAndHeaders(&e, r, true)
+	return &e
+}
+
+// setKeyAndHeaders doesn't overwrite existing attributes. Headers of attributes in binary content mode are
+// skipped if skipCE is true.
+func setKeyAndHeaders(e *ce.Event, r kafka.Record, skipCE bool) {
+	if _, ok := e.Extensions()[ExtensionPartitionKey]; !ok && r.Key != nil {
+		e.SetExtension(ExtensionPartitionKey, attributeValue(r.Key))
+	}
+	for _, h := range r.Headers {
+		if strings.EqualFold(h.Key, headerContentType) ||
+			(skipCE && strings.HasPrefix(strings.ToLower(h.Key), headerPrefixCE)) {
+			continue
+		}
+		name := extensionName(h.Key)
+		if _, ok := e.Extensions()[name]; ok || name == "" || isReservedAttribute(name) {
+			continue
+		}
+		e.SetExtension(name, attributeValue(h.Value))
+	}
+}
+
+// extensionName converts a header name to an extension name, which only contains lowercase letters and
+// digits. It returns empty if nothing is left.
+func extensionName(header string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(header) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			b.WriteRune(c)
+		}
+	}
+	name := b.String()
+	if len(name) > maxExtensionNameLength {
+		name = name[:maxExtensionNameLength]
+	}
+	return name
+}
+
+// isReservedAttribute reports whether the name is a context attribute of CloudEvents or of Vanus.
+func isReservedAttribute(name string) bool {
+	switch name {
+	case "id", "source", "specversion", "type", "datacontenttype", "dataschema", "subject", "time", "data",
+		"contenttype":
+		return true
+	}
+	return strings.HasPrefix(name, primitive.XVanus)
+}
+
+// attributeValue keeps valid UTF-8 as a string, other values are kept as binary.
+func attributeValue(v []byte) interface{} {
+	if utf8.Valid(v) {
+		return string(v)
+	}
+	return v
+}
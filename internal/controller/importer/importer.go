@@ -0,0 +1,502 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package importer imports events from other messaging systems into eventbuses, imports run as jobs of
+// the eventbus controller.
+package importer
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/controller/importer/kafka"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	KindKafka job.Kind = "eventbus-import-kafka"
+
+	paramEventbus   = "eventbus"
+	paramBrokers    = "brokers"
+	paramTopic      = "topic"
+	paramStart      = "start"
+	paramContinuous = "continuous"
+	paramTLS        = "tls"
+	paramSASL       = "sasl_credential"
+
+	StartEarliest = "earliest"
+	StartLatest   = "latest"
+	// StartTimePrefix prefixes an RFC3339 time to start from, like time:2022-10-01T00:00:00Z.
+	StartTimePrefix = "time:"
+	// StartOffsetPrefix prefixes an offset of all partitions, like offset:100, or offsets of partitions, like
+	// offset:0=100,1=200.
+	StartOffsetPrefix = "offset:"
+
+	maxEventsPerAppend       = 128
+	partitionRefreshInterval = time.Minute
+	unavailableBackoff       = time.Second
+)
+
+// KafkaOptions describes an import from a Kafka topic.
+type KafkaOptions struct {
+	Eventbus string
+	Brokers  []string
+	Topic    string
+	// Start is where to start in each partition, it's one of earliest (default), latest, a time prefixed by
+	// StartTimePrefix, or offsets prefixed by StartOffsetPrefix. Partitions not listed in offsets start
+	// from the earliest.
+	Start string
+	// Continuous imports keep importing new records until they are canceled, otherwise the import finishes
+	// once records existing when it starts have been imported.
+	Continuous bool
+	TLS        bool
+	// SASLCredential names a credential configured in controllers, which authenticates the import to
+	// brokers. Job parameters are readable through APIs, so they never carry the credential itself.
+	SASLCredential string
+}
+
+// Spec returns the spec of the import job, imports of the same topic into the same eventbus are
+// deduplicated.
+func (o KafkaOptions) Spec() (job.Spec, error) {
+	if err := o.validate(); err != nil {
+		return job.Spec{}, err
+	}
+	return job.Spec{
+		Kind: KindKafka,
+		Key:  fmt.Sprintf("%s/%s/%s", o.Eventbus, strings.Join(o.Brokers, ","), o.Topic),
+		Params: map[string]string{
+			paramEventbus:   o.Eventbus,
+			paramBrokers:    strings.Join(o.Brokers, ","),
+			paramTopic:      o.Topic,
+			paramStart:      o.Start,
+			paramContinuous: strconv.FormatBool(o.Continuous),
+			paramTLS:        strconv.FormatBool(o.TLS),
+			paramSASL:       o.SASLCredential,
+		},
+	}, nil
+}
+
+func (o KafkaOptions) validate() error {
+	if o.Eventbus == "" {
+		return errors.ErrInvalidRequest.WithMessage("the eventbus is required")
+	}
+	if len(o.Brokers) == 0 {
+		return errors.ErrInvalidRequest.WithMessage("kafka brokers are required")
+	}
+	if o.Topic == "" {
+		return errors.ErrInvalidRequest.WithMessage("the kafka topic is required")
+	}
+	if _, err := parseStart(o.Start); err != nil {
+		return errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+	return nil
+}
+
+func kafkaOptionsFromParams(params map[string]string) (KafkaOptions, error) {
+	o := KafkaOptions{
+		Eventbus:       params[paramEventbus],
+		Topic:          params[paramTopic],
+		Start:          params[paramStart],
+		SASLCredential: params[paramSASL],
+	}
+	if params[paramBrokers] != "" {
+		o.Brokers = strings.Split(params[paramBrokers], ",")
+	}
+	o.Continuous, _ = strconv.ParseBool(params[paramContinuous])
+	o.TLS, _ = strconv.ParseBool(params[paramTLS])
+	return o, o.validate()
+}
+
+// startPosition is parsed from KafkaOptions.Start, it's a timestamp unless byOffset is set.
+type startPosition struct {
+	// timestamp is in Unix milliseconds, or kafka.OffsetEarliest or kafka.OffsetLatest.
+	timestamp int64
+	byOffset  bool
+	// all is the offset of all partitions if offsets is nil.
+	all     int64
+	offsets map[int32]int64
+}
+
+func parseStart(s string) (startPosition, error) {
+	switch {
+	case s == "" || s == StartEarliest:
+		return startPosition{timestamp: kafka.OffsetEarliest}, nil
+	case s == StartLatest:
+		return startPosition{timestamp: kafka.OffsetLatest}, nil
+	case strings.HasPrefix(s, StartTimePrefix):
+		t, err := time.Parse(time.RFC3339, strings.TrimPrefix(s, StartTimePrefix))
+		if err != nil {
+			return startPosition{}, fmt.Errorf("invalid time of start: %s", s)
+		}
+		return startPosition{timestamp: t.UnixMilli()}, nil
+	case strings.HasPrefix(s, StartOffsetPrefix):
+		return parseStartOffsets(strings.TrimPrefix(s, StartOffsetPrefix))
+	}
+	return startPosition{}, fmt.Errorf("invalid start: %s, it's one of %s, %s, %s<RFC3339 time> and "+
+		"%s<offsets>", s, StartEarliest, StartLatest, StartTimePrefix, StartOffsetPrefix)
+}
+
+func parseStartOffsets(s string) (startPosition, error) {
+	if offset, err := strconv.ParseInt(s, 10, 64); err == nil && offset >= 0 {
+		return startPosition{byOffset: true, all: offset}, nil
+	}
+	offsets := map[int32]int64{}
+	for _, item := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(item), "=", 2)
+		if len(kv) != 2 {
+			return startPosition{}, fmt.Errorf("invalid offsets of start: %s", s)
+		}
+		partition, err := strconv.ParseInt(kv[0], 10, 32)
+		if err != nil || partition < 0 {
+			return startPosition{}, fmt.Errorf("invalid partition of start: %s", kv[0])
+		}
+		offset, err := strconv.ParseInt(kv[1], 10, 64)
+		if err != nil || offset < 0 {
+			return startPosition{}, fmt.Errorf("invalid offset of start: %s", kv[1])
+		}
+		offsets[int32(partition)] = offset
+	}
+	return startPosition{byOffset: true, offsets: offsets}, nil
+}
+
+type kafkaSource interface {
+	Partitions(ctx context.Context) ([]int32, error)
+	ListOffsets(ctx context.Context, partitions []int32, timestamp int64) (map[int32]int64, error)
+	Fetch(ctx context.Context, offsets map[int32]int64) (map[int32]*kafka.FetchResult, error)
+	Close() error
+}
+
+// kafkaCursor is the checkpoint of an import.
+type kafkaCursor struct {
+	// Next is the offset to import next in each partition.
+	Next map[int32]int64 `json:"next"`
+	// End is set if the import isn't continuous, records at or after it aren't imported.
+	End      map[int32]int64 `json:"end,omitempty"`
+	Total    int64           `json:"total,omitempty"`
+	Imported int64           `json:"imported"`
+}
+
+type kafkaImporter struct {
+	newSource func(o KafkaOptions) (kafkaSource, error)
+	openBus   func(ctx context.Context, eventbus string) api.BusWriter
+}
+
+// NewKafkaHandler returns the handler of Kafka import jobs, events are written through controllers at
+// ctrlAddrs, which are connected once an import runs. Imports authenticate to brokers with credentials
+// named by KafkaOptions.SASLCredential. Records are imported at least once, they may be imported again if
+// the job is interrupted before it checkpoints.
+func NewKafkaHandler(ctrlAddrs []string, credentials map[string]kafka.SASLConfig) job.Handler {
+	k := &kafkaImporter{
+		newSource: func(o KafkaOptions) (kafkaSource, error) {
+			cfg := kafka.Config{Brokers: o.Brokers, Topic: o.Topic}
+			if o.TLS {
+				cfg.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+			}
+			if o.SASLCredential != "" {
+				cred, ok := credentials[o.SASLCredential]
+				if !ok {
+					return nil, fmt.Errorf("the kafka credential %s isn't configured", o.SASLCredential)
+				}
+				cfg.SASL = &cred
+			}
+			return kafka.NewClient(cfg)
+		},
+		openBus: newBusOpener(ctrlAddrs),
+	}
+	return k.run
+}
+
+// newBusOpener returns a function opening writers of eventbuses, controllers at ctrlAddrs are connected
+// once it's called the first time.
+func newBusOpener(ctrlAddrs []string) func(ctx context.Context, eventbus string) api.BusWriter {
+	var (
+		once   sync.Once
+		client eb.Client
+	)
+	return func(ctx context.Context, eventbus string) api.BusWriter {
+		once.Do(func() {
+			client = eb.Connect(ctrlAddrs)
+		})
+		return client.Eventbus(ctx, eventbus).Writer()
+	}
+}
+
+func (k *kafkaImporter) run(ctx context.Context, e *job.Execution) error {
+	o, err := kafkaOptionsFromParams(e.Params())
+	if err != nil {
+		return err
+	}
+	src, err := k.newSource(o)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	cur, err := k.loadCursor(ctx, e, o, src)
+	if err != nil {
+		return err
+	}
+	writer := k.openBus(ctx, o.Eventbus)
+	refreshedAt := time.Now()
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if o.Continuous && time.Since(refreshedAt) >= partitionRefreshInterval {
+			refreshedAt = time.Now()
+			if err = k.addPartitions(ctx, src, cur); err != nil {
+				return err
+			}
+		}
+		pending := cur.pending(o.Continuous)
+		if len(pending) == 0 {
+			return nil
+		}
+		results, err := src.Fetch(ctx, pending)
+		if err != nil {
+			return err
+		}
+
+		var events []*ce.Event
+		unavailable := false
+		next := make(map[int32]int64, len(results))
+		for partition, r := range results {
+			switch {
+			case stderrors.Is(r.Err, kafka.ErrOffsetOutOfRange):
+				if err = k.resetOffset(ctx, src, cur, partition); err != nil {
+					return err
+				}
+				continue
+			case kafka.IsRetriable(r.Err):
+				unavailable = true
+				continue
+			case r.Err != nil:
+				return r.Err
+			}
+			for _, record := range r.Records {
+				if !o.Continuous && record.Offset >= cur.End[partition] {
+					break
+				}
+				events = append(events, toEvent(o.Topic, record))
+			}
+			next[partition] = r.Next
+		}
+		if err = appendEvents(ctx, writer, events); err != nil {
+			return err
+		}
+		changed := len(events) > 0
+		for partition, offset := range next {
+			if offset != cur.Next[partition] {
+				cur.Next[partition] = offset
+				changed = true
+			}
+		}
+		if !changed {
+			if unavailable {
+				// Wait for leaders of partitions being elected.
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(unavailableBackoff):
+				}
+			}
+			continue
+		}
+		cur.Imported += int64(len(events))
+		if err = checkpoint(ctx, e, cur); err != nil {
+			return err
+		}
+	}
+}
+
+func appendEvents(ctx context.Context, writer api.BusWriter, events []*ce.Event) error {
+	for len(events) > 0 {
+		n := len(events)
+		if n > maxEventsPerAppend {
+			n = maxEventsPerAppend
+		}
+		if _, err := writer.AppendMany(ctx, events[:n]); err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+	return nil
+}
+
+// loadCursor resumes the cursor from the last checkpoint, or resolves the start position.
+func (k *kafkaImporter) loadCursor(ctx context.Context, e *job.Execution, o KafkaOptions,
+	src kafkaSource) (*kafkaCursor, error) {
+	cur := &kafkaCursor{}
+	if e.Cursor() != "" {
+		if err := json.Unmarshal([]byte(e.Cursor()), cur); err != nil {
+			return nil, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+		return cur, nil
+	}
+
+	partitions, err := src.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	start, err := parseStart(o.Start)
+	if err != nil {
+		return nil, err
+	}
+	cur.Next, err = resolveStart(ctx, src, partitions, start)
+	if err != nil {
+		return nil, err
+	}
+	if !o.Continuous {
+		if cur.End, err = src.ListOffsets(ctx, partitions, kafka.OffsetLatest); err != nil {
+			return nil, err
+		}
+		for partition, end := range cur.End {
+			if end > cur.Next[partition] {
+				cur.Total += end - cur.Next[partition]
+			}
+		}
+	}
+	log.Info(ctx, "start importing from kafka", map[string]interface{}{
+		"job_id":   e.ID().Key(),
+		"topic":    o.Topic,
+		"eventbus": o.Eventbus,
+		"next":     cur.Next,
+		"end":      cur.End,
+	})
+	return cur, checkpoint(ctx, e, cur)
+}
+
+func resolveStart(ctx context.Context, src kafkaSource, partitions []int32,
+	start startPosition) (map[int32]int64, error) {
+	next := make(map[int32]int64, len(partitions))
+	if start.byOffset && start.offsets == nil {
+		for _, p := range partitions {
+			next[p] = start.all
+		}
+		return next, nil
+	}
+	timestamp := start.timestamp
+	if start.byOffset {
+		timestamp = kafka.OffsetEarliest
+	}
+	offsets, err := src.ListOffsets(ctx, partitions, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	var latest map[int32]int64
+	for _, p := range partitions {
+		offset, ok := start.offsets[p]
+		if !ok {
+			offset = offsets[p]
+		}
+		if offset < 0 {
+			// No record is at or after the time, start from the end.
+			if latest == nil {
+				if latest, err = src.ListOffsets(ctx, partitions, kafka.OffsetLatest); err != nil {
+					return nil, err
+				}
+			}
+			offset = latest[p]
+		}
+		next[p] = offset
+	}
+	return next, nil
+}
+
+// addPartitions starts importing partitions added to the topic from the earliest.
+func (k *kafkaImporter) addPartitions(ctx context.Context, src kafkaSource, cur *kafkaCursor) error {
+	partitions, err := src.Partitions(ctx)
+	if err != nil {
+		return err
+	}
+	var added []int32
+	for _, p := range partitions {
+		if _, ok := cur.Next[p]; !ok {
+			added = append(added, p)
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+	offsets, err := src.ListOffsets(ctx, added, kafka.OffsetEarliest)
+	if err != nil {
+		return err
+	}
+	for _, p := range added {
+		cur.Next[p] = offsets[p]
+	}
+	return nil
+}
+
+// resetOffset moves the offset into the range of the partition, records may have been deleted by
+// retention.
+func (k *kafkaImporter) resetOffset(ctx context.Context, src kafkaSource, cur *kafkaCursor,
+	partition int32) error {
+	earliest, err := src.ListOffsets(ctx, []int32{partition}, kafka.OffsetEarliest)
+	if err != nil {
+		return err
+	}
+	offset := earliest[partition]
+	if cur.Next[partition] > offset {
+		latest, err := src.ListOffsets(ctx, []int32{partition}, kafka.OffsetLatest)
+		if err != nil {
+			return err
+		}
+		offset = latest[partition]
+	}
+	log.Warning(ctx, "the kafka offset is out of range, reset it", map[string]interface{}{
+		"partition": partition,
+		"from":      cur.Next[partition],
+		"to":        offset,
+	})
+	cur.Next[partition] = offset
+	return nil
+}
+
+// pending returns offsets of partitions which haven't reached their ends. Partitions added after a one-shot
+// import starts have no ends, they aren't imported.
+func (cur *kafkaCursor) pending(continuous bool) map[int32]int64 {
+	pending := make(map[int32]int64, len(cur.Next))
+	for partition, offset := range cur.Next {
+		if !continuous && offset >= cur.End[partition] {
+			continue
+		}
+		pending[partition] = offset
+	}
+	return pending
+}
+
+func checkpoint(ctx context.Context, e *job.Execution, cur *kafkaCursor) error {
+	data, err := json.Marshal(cur)
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	return e.Checkpoint(ctx, string(data), job.Progress{
+		Done:    cur.Imported,
+		Total:   cur.Total,
+		Message: fmt.Sprintf("%d events imported", cur.Imported),
+	})
+}
@@ -0,0 +1,310 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/controller/importer/kafka"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeSource serves records of a topic in memory, partitions are lists of record values whose offsets
+// start from earliest.
+type fakeSource struct {
+	earliest   int64
+	partitions map[int32][]string
+	outOfRange map[int32]bool
+}
+
+func (s *fakeSource) Partitions(ctx context.Context) ([]int32, error) {
+	result := make([]int32, 0, len(s.partitions))
+	for p := range s.partitions {
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+func (s *fakeSource) ListOffsets(ctx context.Context, partitions []int32,
+	timestamp int64) (map[int32]int64, error) {
+	result := map[int32]int64{}
+	for _, p := range partitions {
+		switch timestamp {
+		case kafka.OffsetEarliest:
+			result[p] = s.earliest
+		case kafka.OffsetLatest:
+			result[p] = s.earliest + int64(len(s.partitions[p]))
+		default:
+			result[p] = -1
+		}
+	}
+	return result, nil
+}
+
+func (s *fakeSource) Fetch(ctx context.Context, offsets map[int32]int64) (map[int32]*kafka.FetchResult, error) {
+	result := map[int32]*kafka.FetchResult{}
+	for p, offset := range offsets {
+		if s.outOfRange[p] {
+			delete(s.outOfRange, p)
+			result[p] = &kafka.FetchResult{Err: kafka.ErrOffsetOutOfRange}
+			continue
+		}
+		r := &kafka.FetchResult{Next: offset}
+		values := s.partitions[p]
+		// Return at most 2 records per fetch.
+		for i := offset - s.earliest; i < int64(len(values)) && len(r.Records) < 2; i++ {
+			r.Records = append(r.Records, kafka.Record{Partition: p, Offset: s.earliest + i, Value: []byte(values[i])})
+			r.Next = s.earliest + i + 1
+		}
+		result[p] = r
+	}
+	return result, nil
+}
+
+func (s *fakeSource) Close() error {
+	return nil
+}
+
+func TestParseStart(t *testing.T) {
+	Convey("test parse start", t, func() {
+		start, err := parseStart("")
+		So(err, ShouldBeNil)
+		So(start.timestamp, ShouldEqual, kafka.OffsetEarliest)
+
+		start, err = parseStart(StartLatest)
+		So(err, ShouldBeNil)
+		So(start.timestamp, ShouldEqual, kafka.OffsetLatest)
+
+		start, err = parseStart("time:2022-10-01T00:00:00Z")
+		So(err, ShouldBeNil)
+		So(start.byOffset, ShouldBeFalse)
+		So(start.timestamp, ShouldEqual, time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+
+		start, err = parseStart("time:1970-01-01T00:00:00Z")
+		So(err, ShouldBeNil)
+		So(start.byOffset, ShouldBeFalse)
+		So(start.timestamp, ShouldEqual, 0)
+
+		start, err = parseStart("offset:100")
+		So(err, ShouldBeNil)
+		So(start.byOffset, ShouldBeTrue)
+		So(start.all, ShouldEqual, 100)
+		So(start.offsets, ShouldBeNil)
+
+		start, err = parseStart("offset:0=100, 2=5")
+		So(err, ShouldBeNil)
+		So(start.byOffset, ShouldBeTrue)
+		So(start.offsets, ShouldResemble, map[int32]int64{0: 100, 2: 5})
+
+		for _, s := range []string{"100", "2022-10-01T00:00:00Z", "time:yesterday", "offset:-1", "offset:0=",
+			"offset:a=1", "offset:0=-1", "offset:0:100"} {
+			_, err = parseStart(s)
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
+func TestKafkaOptions_Spec(t *testing.T) {
+	Convey("test spec of kafka options", t, func() {
+		o := KafkaOptions{Eventbus: "bus", Brokers: []string{"b1:9092", "b2:9092"}, Topic: "orders",
+			Start: "latest", Continuous: true, SASLCredential: "prod"}
+		spec, err := o.Spec()
+		So(err, ShouldBeNil)
+		So(spec.Kind, ShouldEqual, KindKafka)
+		So(spec.Key, ShouldEqual, "bus/b1:9092,b2:9092/orders")
+		parsed, err := kafkaOptionsFromParams(spec.Params)
+		So(err, ShouldBeNil)
+		So(parsed, ShouldResemble, o)
+
+		o.Topic = ""
+		_, err = o.Spec()
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestNewKafkaHandler(t *testing.T) {
+	Convey("test kafka handler with an unknown credential", t, func() {
+		o := KafkaOptions{Eventbus: "bus", Brokers: []string{"b1:9092"}, Topic: "orders",
+			Start: "earliest", SASLCredential: "staging"}
+		spec, err := o.Spec()
+		So(err, ShouldBeNil)
+		handler := NewKafkaHandler(nil, map[string]kafka.SASLConfig{
+			"prod": {Mechanism: kafka.SASLPlain, Username: "user"},
+		})
+		err = handler(context.Background(), job.NewTestExecution(spec.Params))
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "staging")
+	})
+}
+
+func TestKafkaImporter_Run(t *testing.T) {
+	Convey("test kafka importer run", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		src := &fakeSource{
+			earliest: 10,
+			partitions: map[int32][]string{
+				0: {"a", "b", "c"},
+				1: {"d"},
+			},
+		}
+		writer := api.NewMockBusWriter(ctrl)
+		var imported []*ce.Event
+		writer.EXPECT().AppendMany(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, events []*ce.Event, opts ...api.WriteOption) (string, error) {
+				imported = append(imported, events...)
+				return "", nil
+			})
+		k := &kafkaImporter{
+			newSource: func(o KafkaOptions) (kafkaSource, error) {
+				return src, nil
+			},
+			openBus: func(ctx context.Context, eventbus string) api.BusWriter {
+				So(eventbus, ShouldEqual, "bus")
+				return writer
+			},
+		}
+		params := map[string]string{
+			paramEventbus: "bus",
+			paramBrokers:  "localhost:9092",
+			paramTopic:    "orders",
+		}
+		cursorOf := func(e *job.Execution) kafkaCursor {
+			cur := kafkaCursor{}
+			So(json.Unmarshal([]byte(e.Cursor()), &cur), ShouldBeNil)
+			return cur
+		}
+
+		Convey("import from the earliest", func() {
+			e := job.NewTestExecution(params)
+			So(k.run(ctx, e), ShouldBeNil)
+			So(imported, ShouldHaveLength, 4)
+			ids := map[string]bool{}
+			for _, event := range imported {
+				ids[event.ID()] = true
+				So(event.Source(), ShouldEqual, "/kafka/orders")
+				So(event.Type(), ShouldEqual, EventTypeKafkaRecord)
+			}
+			So(ids, ShouldContainKey, "orders-0-12")
+			So(ids, ShouldContainKey, "orders-1-10")
+			cur := cursorOf(e)
+			So(cur.Imported, ShouldEqual, 4)
+			So(cur.Total, ShouldEqual, 4)
+			So(cur.Next, ShouldResemble, map[int32]int64{0: 13, 1: 11})
+		})
+
+		Convey("import from offsets", func() {
+			params[paramStart] = "offset:0=12"
+			e := job.NewTestExecution(params)
+			So(k.run(ctx, e), ShouldBeNil)
+			So(imported, ShouldHaveLength, 2)
+			So(cursorOf(e).Total, ShouldEqual, 2)
+		})
+
+		Convey("records appended after the start aren't imported", func() {
+			src.partitions[0] = append(src.partitions[0], "e")
+			e := job.NewTestExecution(params)
+			_, err := k.loadCursor(ctx, e, KafkaOptions{Topic: "orders"}, src)
+			So(err, ShouldBeNil)
+			src.partitions[0] = append(src.partitions[0], "f")
+			So(k.run(ctx, e), ShouldBeNil)
+			So(imported, ShouldHaveLength, 5)
+		})
+
+		Convey("reset the offset out of range", func() {
+			src.outOfRange = map[int32]bool{0: true}
+			params[paramStart] = "offset:0=2"
+			e := job.NewTestExecution(params)
+			So(k.run(ctx, e), ShouldBeNil)
+			So(imported, ShouldHaveLength, 4)
+		})
+
+		Convey("continuous import is canceled", func() {
+			params[paramContinuous] = "true"
+			e := job.NewTestExecution(params)
+			cctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+			defer cancel()
+			So(stderrors.Is(k.run(cctx, e), context.DeadlineExceeded), ShouldBeTrue)
+			So(imported, ShouldHaveLength, 4)
+			So(cursorOf(e).End, ShouldBeNil)
+		})
+	})
+}
+
+func TestToEvent(t *testing.T) {
+	Convey("test convert records to events", t, func() {
+		Convey("plain record", func() {
+			e := toEvent("orders", kafka.Record{
+				Partition: 1,
+				Offset:    5,
+				Timestamp: time.UnixMilli(1000),
+				Key:       []byte("user-1"),
+				Value:     []byte(`{"a":1}`),
+				Headers: []kafka.Header{
+					{Key: "Trace-ID", Value: []byte("t1")},
+					{Key: "id", Value: []byte("ignored")},
+					{Key: "bin", Value: []byte{0xff}},
+				},
+			})
+			So(e.ID(), ShouldEqual, "orders-1-5")
+			So(e.Source(), ShouldEqual, "/kafka/orders")
+			So(e.DataContentType(), ShouldEqual, "application/json")
+			So(string(e.Data()), ShouldEqual, `{"a":1}`)
+			So(e.Time().UnixMilli(), ShouldEqual, 1000)
+			So(e.Extensions()[ExtensionPartitionKey], ShouldEqual, "user-1")
+			So(e.Extensions()["traceid"], ShouldEqual, "t1")
+			So(e.Extensions()["bin"], ShouldResemble, []byte{0xff})
+		})
+
+		Convey("binary content mode", func() {
+			e := toEvent("orders", kafka.Record{
+				Value: []byte("hello"),
+				Headers: []kafka.Header{
+					{Key: "ce_specversion", Value: []byte("1.0")},
+					{Key: "ce_id", Value: []byte("e1")},
+					{Key: "ce_source", Value: []byte("/shop")},
+					{Key: "ce_type", Value: []byte("order.created")},
+					{Key: "ce_region", Value: []byte("eu")},
+					{Key: "content-type", Value: []byte("text/plain")},
+				},
+			})
+			So(e.ID(), ShouldEqual, "e1")
+			So(e.Source(), ShouldEqual, "/shop")
+			So(e.Type(), ShouldEqual, "order.created")
+			So(e.DataContentType(), ShouldEqual, "text/plain")
+			So(e.Extensions()["region"], ShouldEqual, "eu")
+		})
+
+		Convey("structured content mode", func() {
+			e := toEvent("orders", kafka.Record{
+				Value: []byte(`{"specversion":"1.0","id":"e2","source":"/shop","type":"order.paid"}`),
+				Headers: []kafka.Header{
+					{Key: "content-type", Value: []byte("application/cloudevents+json; charset=utf-8")},
+				},
+			})
+			So(e.ID(), ShouldEqual, "e2")
+			So(e.Type(), ShouldEqual, "order.paid")
+		})
+	})
+}
@@ -0,0 +1,395 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kafka reads partitions of a topic by offsets without consumer groups, on top of the kafka-go
+// client.
+package kafka
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/fetch"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+const (
+	// OffsetLatest and OffsetEarliest are special timestamps of ListOffsets.
+	OffsetLatest   = kafkago.LastOffset
+	OffsetEarliest = kafkago.FirstOffset
+
+	// ErrOffsetOutOfRange is set in results of partitions whose offsets have been deleted or not written.
+	ErrOffsetOutOfRange = kafkago.OffsetOutOfRange
+
+	clientID          = "vanus-importer"
+	defaultMaxWait    = 500 * time.Millisecond
+	requestTimeout    = 30 * time.Second
+	dialTimeout       = 10 * time.Second
+	fetchMinBytes     = 1
+	partitionMaxBytes = 1024 * 1024
+
+	// SASL mechanisms of SASLConfig.
+	SASLPlain       = "plain"
+	SASLScramSHA256 = "scram-sha-256"
+	SASLScramSHA512 = "scram-sha-512"
+)
+
+// IsRetriable reports whether err is a temporary error of brokers or a network error.
+func IsRetriable(err error) bool {
+	var code kafkago.Error
+	if errors.As(err, &code) {
+		return code.Temporary()
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, protocol.ErrNoLeader)
+}
+
+// SASLConfig authenticates the client to brokers.
+type SASLConfig struct {
+	// Mechanism is one of plain, scram-sha-256 and scram-sha-512.
+	Mechanism string `yaml:"mechanism"`
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
+}
+
+func (c SASLConfig) Validate() error {
+	if c.Username == "" {
+		return fmt.Errorf("the username of kafka sasl is empty")
+	}
+	_, err := c.mechanism()
+	return err
+}
+
+func (c SASLConfig) mechanism() (sasl.Mechanism, error) {
+	switch c.Mechanism {
+	case SASLPlain:
+		return plain.Mechanism{Username: c.Username, Password: c.Password}, nil
+	case SASLScramSHA256:
+		return scram.Mechanism(scram.SHA256, c.Username, c.Password)
+	case SASLScramSHA512:
+		return scram.Mechanism(scram.SHA512, c.Username, c.Password)
+	}
+	return nil, fmt.Errorf("unsupported kafka sasl mechanism: %q", c.Mechanism)
+}
+
+type Config struct {
+	Brokers []string
+	Topic   string
+	// TLS is nil if brokers listen in plaintext.
+	TLS *tls.Config
+	// SASL is nil if brokers don't authenticate clients.
+	SASL *SASLConfig
+	// MaxWait bounds how long a fetch waits for new records, it's 500ms by default.
+	MaxWait time.Duration
+}
+
+type Record struct {
+	Partition int32
+	Offset    int64
+	Timestamp time.Time
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+}
+
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// FetchResult is the result of fetching a partition.
+type FetchResult struct {
+	Records []Record
+	// Next is the offset to fetch next time.
+	Next          int64
+	HighWatermark int64
+	Err           error
+}
+
+// Client reads partitions of a topic, it's safe for concurrent use. Requests are routed to leaders of
+// partitions by the transport, which caches the metadata of the cluster.
+type Client struct {
+	cfg       Config
+	transport *kafkago.Transport
+	client    *kafkago.Client
+}
+
+func NewClient(cfg Config) (*Client, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("no kafka broker")
+	}
+	if cfg.Topic == "" {
+		return nil, fmt.Errorf("no kafka topic")
+	}
+	if cfg.MaxWait <= 0 {
+		cfg.MaxWait = defaultMaxWait
+	}
+	transport := &kafkago.Transport{
+		DialTimeout:    dialTimeout,
+		ClientID:       clientID,
+		TLS:            cfg.TLS,
+		MetadataTopics: []string{cfg.Topic},
+	}
+	if cfg.SASL != nil {
+		mechanism, err := cfg.SASL.mechanism()
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = mechanism
+	}
+	return &Client{
+		cfg:       cfg,
+		transport: transport,
+		client: &kafkago.Client{
+			Addr:      kafkago.TCP(cfg.Brokers...),
+			Timeout:   requestTimeout,
+			Transport: transport,
+		},
+	}, nil
+}
+
+func (c *Client) Close() error {
+	c.transport.CloseIdleConnections()
+	return nil
+}
+
+// Partitions returns IDs of partitions of the topic in order.
+func (c *Client) Partitions(ctx context.Context) ([]int32, error) {
+	resp, err := c.client.Metadata(ctx, &kafkago.MetadataRequest{Topics: []string{c.cfg.Topic}})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range resp.Topics {
+		if t.Name != c.cfg.Topic {
+			continue
+		}
+		if t.Error != nil {
+			return nil, t.Error
+		}
+		partitions := make([]int32, 0, len(t.Partitions))
+		for _, p := range t.Partitions {
+			partitions = append(partitions, int32(p.ID))
+		}
+		sort.Slice(partitions, func(i, j int) bool {
+			return partitions[i] < partitions[j]
+		})
+		return partitions, nil
+	}
+	return nil, kafkago.UnknownTopicOrPartition
+}
+
+// ListOffsets returns the earliest offsets of partitions whose records are at or after timestamp (Unix
+// milliseconds), or -1 if there is none. timestamp may be OffsetLatest or OffsetEarliest, the latest offsets
+// are the ends of committed transactions.
+func (c *Client) ListOffsets(ctx context.Context, partitions []int32, timestamp int64) (map[int32]int64, error) {
+	requests := make([]kafkago.OffsetRequest, 0, len(partitions))
+	for _, p := range partitions {
+		requests = append(requests, kafkago.OffsetRequest{Partition: int(p), Timestamp: timestamp})
+	}
+	resp, err := c.client.ListOffsets(ctx, &kafkago.ListOffsetsRequest{
+		Topics:         map[string][]kafkago.OffsetRequest{c.cfg.Topic: requests},
+		IsolationLevel: kafkago.ReadCommitted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int32]int64, len(partitions))
+	for _, p := range resp.Topics[c.cfg.Topic] {
+		if p.Error != nil {
+			return nil, p.Error
+		}
+		switch timestamp {
+		case OffsetEarliest:
+			result[int32(p.Partition)] = p.FirstOffset
+		case OffsetLatest:
+			result[int32(p.Partition)] = p.LastOffset
+		default:
+			// There is one offset of the timestamp.
+			for offset := range p.Offsets {
+				result[int32(p.Partition)] = offset
+			}
+		}
+	}
+	for _, p := range partitions {
+		if _, ok := result[p]; !ok {
+			return nil, kafkago.UnknownTopicOrPartition
+		}
+	}
+	return result, nil
+}
+
+// Fetch reads records of partitions from offsets in parallel. It waits up to MaxWait if there is no new
+// record. Errors of partitions are set in their results.
+func (c *Client) Fetch(ctx context.Context, offsets map[int32]int64) (map[int32]*FetchResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.MaxWait+requestTimeout)
+	defer cancel()
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		result = make(map[int32]*FetchResult, len(offsets))
+	)
+	for partition, offset := range offsets {
+		wg.Add(1)
+		go func(partition int32, offset int64) {
+			defer wg.Done()
+			r := c.fetch(ctx, partition, offset)
+			mutex.Lock()
+			result[partition] = r
+			mutex.Unlock()
+		}(partition, offset)
+	}
+	wg.Wait()
+	return result, ctx.Err()
+}
+
+func (c *Client) fetch(ctx context.Context, partition int32, offset int64) *FetchResult {
+	r := &FetchResult{Next: offset}
+	// The request is sent by the transport, rather than by Client.Fetch, to get aborted transactions.
+	msg, err := c.transport.RoundTrip(ctx, c.client.Addr, &fetch.Request{
+		ReplicaID:      -1,
+		MaxWaitTime:    int32(c.cfg.MaxWait / time.Millisecond),
+		MinBytes:       fetchMinBytes,
+		MaxBytes:       partitionMaxBytes,
+		IsolationLevel: int8(kafkago.ReadCommitted),
+		SessionID:      -1,
+		SessionEpoch:   -1,
+		Topics: []fetch.RequestTopic{{
+			Topic: c.cfg.Topic,
+			Partitions: []fetch.RequestPartition{{
+				Partition:          partition,
+				CurrentLeaderEpoch: -1,
+				FetchOffset:        offset,
+				LogStartOffset:     -1,
+				PartitionMaxBytes:  partitionMaxBytes,
+			}},
+		}},
+	})
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	resp, _ := msg.(*fetch.Response)
+	if resp == nil || len(resp.Topics) == 0 || len(resp.Topics[0].Partitions) == 0 {
+		r.Err = kafkago.UnknownTopicOrPartition
+		return r
+	}
+	p := &resp.Topics[0].Partitions[0]
+	r.HighWatermark = p.HighWatermark
+	if p.ErrorCode != 0 {
+		r.Err = kafkago.Error(p.ErrorCode)
+		return r
+	}
+	if p.RecordSet.Records != nil {
+		r.Records, r.Next, r.Err = readRecords(partition, p.RecordSet.Records, offset, p.AbortedTransactions)
+	}
+	return r
+}
+
+// readRecords reads records fetched from offset from. Records of aborted transactions and control records are
+// skipped. It returns the offset next to the last record read, which is from if there is none.
+func readRecords(partition int32, rr protocol.RecordReader, from int64,
+	aborted []fetch.ResponseTransaction) ([]Record, int64, error) {
+	sort.Slice(aborted, func(i, j int) bool {
+		return aborted[i].FirstOffset < aborted[j].FirstOffset
+	})
+	batches := []protocol.RecordReader{rr}
+	if stream, ok := rr.(*protocol.RecordStream); ok {
+		batches = stream.Records
+	}
+	abortedProducers := map[int64]bool{}
+	next := from
+	var records []Record
+	for _, batch := range batches {
+		producerID, transactional, control := int64(-1), false, false
+		switch b := batch.(type) {
+		case *protocol.RecordBatch:
+			producerID, transactional = b.ProducerID, b.Attributes.Transactional()
+		case *protocol.ControlBatch:
+			producerID, control = b.ProducerID, true
+		}
+		first := true
+		for {
+			r, err := batch.ReadRecord()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, next, err
+			}
+			if first {
+				first = false
+				// A transaction is aborted from the first batch of it.
+				for len(aborted) > 0 && aborted[0].FirstOffset <= r.Offset {
+					abortedProducers[aborted[0].ProducerID] = true
+					aborted = aborted[1:]
+				}
+			}
+			record, err := toRecord(partition, r)
+			if err != nil {
+				return nil, next, err
+			}
+			if r.Offset < from {
+				continue
+			}
+			next = r.Offset + 1
+			if control || (transactional && abortedProducers[producerID]) {
+				continue
+			}
+			records = append(records, record)
+		}
+		if control {
+			// A control batch holds the marker which ends a transaction of the producer.
+			delete(abortedProducers, producerID)
+		}
+	}
+	return records, next, nil
+}
+
+// toRecord reads the key and the value of r and releases them.
+func toRecord(partition int32, r *protocol.Record) (Record, error) {
+	record := Record{
+		Partition: partition,
+		Offset:    r.Offset,
+		Timestamp: r.Time,
+	}
+	if r.Key != nil {
+		defer r.Key.Close()
+	}
+	if r.Value != nil {
+		defer r.Value.Close()
+	}
+	var err error
+	if record.Key, err = protocol.ReadAll(r.Key); err != nil {
+		return Record{}, err
+	}
+	if record.Value, err = protocol.ReadAll(r.Value); err != nil {
+		return Record{}, err
+	}
+	for _, h := range r.Headers {
+		record.Headers = append(record.Headers, Header{Key: h.Key, Value: h.Value})
+	}
+	return record, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kafka
+
+import (
+	"testing"
+	"time"
+
+	kafkago "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/protocol"
+	"github.com/segmentio/kafka-go/protocol/fetch"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReadRecords(t *testing.T) {
+	Convey("test read records", t, func() {
+		batch := func() protocol.RecordReader {
+			return &protocol.RecordBatch{
+				BaseOffset: 10,
+				ProducerID: -1,
+				Records: protocol.NewRecordReader(
+					protocol.Record{
+						Offset: 10,
+						Time:   time.UnixMilli(1000),
+						Key:    protocol.NewBytes([]byte("k0")),
+						Value:  protocol.NewBytes([]byte("v0")),
+					},
+					protocol.Record{
+						Offset:  11,
+						Value:   protocol.NewBytes([]byte("v1")),
+						Headers: []protocol.Header{{Key: "h", Value: []byte("x")}},
+					},
+				),
+			}
+		}
+
+		Convey("plain batch", func() {
+			records, next, err := readRecords(3, batch(), 10, nil)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 12)
+			So(records, ShouldHaveLength, 2)
+			So(records[0].Partition, ShouldEqual, 3)
+			So(records[0].Offset, ShouldEqual, 10)
+			So(string(records[0].Key), ShouldEqual, "k0")
+			So(string(records[0].Value), ShouldEqual, "v0")
+			So(records[0].Timestamp.UnixMilli(), ShouldEqual, 1000)
+			So(records[1].Offset, ShouldEqual, 11)
+			So(records[1].Key, ShouldBeNil)
+			So(records[1].Headers, ShouldResemble, []Header{{Key: "h", Value: []byte("x")}})
+		})
+
+		Convey("skip records before the offset", func() {
+			records, next, err := readRecords(0, batch(), 11, nil)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 12)
+			So(records, ShouldHaveLength, 1)
+			So(records[0].Offset, ShouldEqual, 11)
+		})
+
+		Convey("no record", func() {
+			records, next, err := readRecords(0, protocol.NewRecordReader(), 10, nil)
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 10)
+			So(records, ShouldBeEmpty)
+		})
+
+		Convey("aborted transaction", func() {
+			transactional := func(offset int64, value string) protocol.RecordReader {
+				return &protocol.RecordBatch{
+					Attributes: protocol.Transactional,
+					BaseOffset: offset,
+					ProducerID: 7,
+					Records: protocol.NewRecordReader(protocol.Record{
+						Offset: offset,
+						Value:  protocol.NewBytes([]byte(value)),
+					}),
+				}
+			}
+			stream := &protocol.RecordStream{Records: []protocol.RecordReader{
+				transactional(0, "aborted"),
+				&protocol.ControlBatch{
+					Attributes: protocol.Transactional | protocol.Control,
+					BaseOffset: 1,
+					ProducerID: 7,
+					Records:    protocol.NewRecordReader(protocol.Record{Offset: 1}),
+				},
+				transactional(2, "committed"),
+			}}
+			records, next, err := readRecords(0, stream, 0,
+				[]fetch.ResponseTransaction{{ProducerID: 7, FirstOffset: 0}})
+			So(err, ShouldBeNil)
+			So(next, ShouldEqual, 3)
+			So(records, ShouldHaveLength, 1)
+			So(string(records[0].Value), ShouldEqual, "committed")
+		})
+	})
+}
+
+func TestIsRetriable(t *testing.T) {
+	Convey("test is retriable", t, func() {
+		So(IsRetriable(kafkago.NotLeaderForPartition), ShouldBeTrue)
+		So(IsRetriable(kafkago.TopicAuthorizationFailed), ShouldBeFalse)
+		So(IsRetriable(protocol.ErrNoLeader), ShouldBeTrue)
+		So(IsRetriable(ErrOffsetOutOfRange), ShouldBeFalse)
+	})
+}
+
+func TestSASLConfig(t *testing.T) {
+	Convey("test sasl config", t, func() {
+		for _, m := range []string{SASLPlain, SASLScramSHA256, SASLScramSHA512} {
+			c := SASLConfig{Mechanism: m, Username: "user", Password: "secret"}
+			So(c.Validate(), ShouldBeNil)
+			mechanism, err := c.mechanism()
+			So(err, ShouldBeNil)
+			So(mechanism.Name(), ShouldEqual, map[string]string{
+				SASLPlain:       "PLAIN",
+				SASLScramSHA256: "SCRAM-SHA-256",
+				SASLScramSHA512: "SCRAM-SHA-512",
+			}[m])
+		}
+
+		So(SASLConfig{Mechanism: SASLPlain}.Validate(), ShouldNotBeNil)
+		So(SASLConfig{Mechanism: "gssapi", Username: "user"}.Validate(), ShouldNotBeNil)
+
+		_, err := NewClient(Config{Brokers: []string{"b1:9092"}, Topic: "orders",
+			SASL: &SASLConfig{Mechanism: "oauthbearer", Username: "user"}})
+		So(err, ShouldNotBeNil)
+		c, err := NewClient(Config{Brokers: []string{"b1:9092"}, Topic: "orders",
+			SASL: &SASLConfig{Mechanism: SASLPlain, Username: "user"}})
+		So(err, ShouldBeNil)
+		So(c.transport.SASL, ShouldNotBeNil)
+		So(c.Close(), ShouldBeNil)
+	})
+}
@@ -0,0 +1,314 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	paramServers    = "servers"
+	paramSubject    = "subject"
+	paramDurable    = "durable"
+	paramURL        = "url"
+	paramQueue      = "queue"
+	paramCredential = "credential"
+	paramType       = "type"
+	paramSource     = "source"
+	paramHeaders    = "attribute_headers"
+
+	// clientName identifies imports to servers of NATS and RabbitMQ.
+	clientName = "vanus-importer"
+	// messageWait bounds how long a receive waits for messages, so that imports notice canceling.
+	messageWait = time.Second
+)
+
+// Credential authenticates imports from NATS and RabbitMQ, it's configured in controllers and named by
+// imports. NATS accepts either a username and a password or a token, RabbitMQ only the former.
+type Credential struct {
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	Token    string `yaml:"token"`
+}
+
+func (c Credential) Validate() error {
+	if (c.Username == "") == (c.Token == "") {
+		return fmt.Errorf("either the username or the token of credential is required")
+	}
+	return nil
+}
+
+func lookupCredential(credentials map[string]Credential, name string) (Credential, error) {
+	if name == "" {
+		return Credential{}, nil
+	}
+	cred, ok := credentials[name]
+	if !ok {
+		return Credential{}, fmt.Errorf("the credential %s isn't configured", name)
+	}
+	return cred, nil
+}
+
+// AttributeMapping extracts attributes of events from messages which aren't CloudEvents in structured
+// content mode.
+type AttributeMapping struct {
+	// Type and Source are the type and the source of events, defaults of each kind of import are used if
+	// they're empty.
+	Type   string
+	Source string
+	// Headers maps names of headers to names of attributes, names of headers are case-insensitive. Headers
+	// which aren't mapped are kept as extensions.
+	Headers map[string]string
+}
+
+func (m AttributeMapping) validate() error {
+	for header, attr := range m.Headers {
+		if header == "" {
+			return errors.ErrInvalidRequest.WithMessage("the header mapped to an attribute is empty")
+		}
+		if !isMappableAttribute(attr) {
+			return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("invalid attribute %q of header %s, "+
+				"it's one of id, source, type, subject, dataschema, time and extensions", attr, header))
+		}
+	}
+	return nil
+}
+
+func (m AttributeMapping) params(params map[string]string) {
+	params[paramType] = m.Type
+	params[paramSource] = m.Source
+	if len(m.Headers) != 0 {
+		data, _ := json.Marshal(m.Headers)
+		params[paramHeaders] = string(data)
+	}
+}
+
+func attributeMappingFromParams(params map[string]string) (AttributeMapping, error) {
+	m := AttributeMapping{
+		Type:   params[paramType],
+		Source: params[paramSource],
+	}
+	if params[paramHeaders] != "" {
+		if err := json.Unmarshal([]byte(params[paramHeaders]), &m.Headers); err != nil {
+			return m, errors.ErrJSONUnMarshal.Wrap(err)
+		}
+	}
+	return m, m.validate()
+}
+
+// isMappableAttribute reports whether headers can be mapped to the attribute, data and the content type
+// come from messages themselves.
+func isMappableAttribute(name string) bool {
+	switch name {
+	case "id", "source", "type", "subject", "dataschema", "time":
+		return true
+	}
+	return name != "" && extensionName(name) == name && !isReservedAttribute(name)
+}
+
+// message is received from a subject or a queue.
+type message struct {
+	// id is empty if the message has none.
+	id      string
+	subject string
+	time    time.Time
+	headers map[string]string
+	// contentType is empty if the message has none.
+	contentType string
+	data        []byte
+	// ack acknowledges the message once it's imported, it's nil if the message needn't be acknowledged.
+	ack func() error
+}
+
+type messageSource interface {
+	// Receive waits for messages up to messageWait, it returns at most max messages, or none if nothing
+	// arrives in time.
+	Receive(ctx context.Context, max int) ([]message, error)
+	Close() error
+}
+
+// messageCursor is the checkpoint of an import from a subject or a queue, positions of them are kept by
+// their servers, so it only counts imported events.
+type messageCursor struct {
+	Imported int64 `json:"imported"`
+}
+
+// importMessages imports messages until ctx is done. Messages are acknowledged after they're appended, so
+// they're imported at least once.
+func importMessages(ctx context.Context, e *job.Execution, src messageSource, writer api.BusWriter,
+	toEvent func(m message) *ce.Event) error {
+	cur := &messageCursor{}
+	if e.Cursor() != "" {
+		if err := json.Unmarshal([]byte(e.Cursor()), cur); err != nil {
+			return errors.ErrJSONUnMarshal.Wrap(err)
+		}
+	}
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msgs, err := src.Receive(ctx, maxEventsPerAppend)
+		if err != nil {
+			return err
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+		events := make([]*ce.Event, len(msgs))
+		for i, m := range msgs {
+			events[i] = toEvent(m)
+		}
+		if err = appendEvents(ctx, writer, events); err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if m.ack == nil {
+				continue
+			}
+			if err = m.ack(); err != nil {
+				return err
+			}
+		}
+		cur.Imported += int64(len(msgs))
+		data, _ := json.Marshal(cur)
+		if err = e.Checkpoint(ctx, string(data), job.Progress{
+			Done:    cur.Imported,
+			Message: fmt.Sprintf("%d events imported", cur.Imported),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// toEvent converts a message to a CloudEvent. Messages in structured content mode are decoded as they are,
+// others are wrapped into events whose attributes are extracted by the mapping, with defaults of typ and
+// source. Messages without IDs are given random ones.
+func (m AttributeMapping) toEvent(msg message, typ, source string) *ce.Event {
+	if strings.HasPrefix(msg.contentType, contentTypeStructured) {
+		e := ce.NewEvent()
+		if err := json.Unmarshal(msg.data, &e); err == nil && e.Validate() == nil {
+			return &e
+		}
+	}
+
+	e := ce.NewEvent()
+	e.SetID(msg.id)
+	if e.ID() == "" {
+		e.SetID(uuid.NewString())
+	}
+	e.SetType(typ)
+	if m.Type != "" {
+		e.SetType(m.Type)
+	}
+	e.SetSource(source)
+	if m.Source != "" {
+		e.SetSource(m.Source)
+	}
+	if msg.subject != "" {
+		e.SetSubject(msg.subject)
+	}
+	if !msg.time.IsZero() {
+		e.SetTime(msg.time)
+	}
+	contentType := msg.contentType
+	if contentType == "" {
+		contentType = contentTypeOctetStream
+		if json.Valid(msg.data) {
+			contentType = contentTypeJSON
+		}
+	}
+	_ = e.SetData(contentType, msg.data)
+
+	mapped := make(map[string]string, len(m.Headers))
+	for header, attr := range m.Headers {
+		mapped[strings.ToLower(header)] = attr
+	}
+	// Sort headers, so that the same message is always converted to the same event.
+	names := make([]string, 0, len(msg.headers))
+	for name := range msg.headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := msg.headers[name]
+		if attr, ok := mapped[strings.ToLower(name)]; ok {
+			setAttribute(&e, attr, value)
+			continue
+		}
+		if strings.EqualFold(name, headerContentType) {
+			continue
+		}
+		ext := extensionName(name)
+		if _, ok := e.Extensions()[ext]; ok || ext == "" || isReservedAttribute(ext) {
+			continue
+		}
+		e.SetExtension(ext, value)
+	}
+	return &e
+}
+
+// setAttribute ignores values which aren't valid for the attribute, so that the default is kept.
+func setAttribute(e *ce.Event, name, value string) {
+	if value == "" {
+		return
+	}
+	switch name {
+	case "id":
+		e.SetID(value)
+	case "source":
+		if _, err := url.Parse(value); err == nil {
+			e.SetSource(value)
+		}
+	case "type":
+		e.SetType(value)
+	case "subject":
+		e.SetSubject(value)
+	case "dataschema":
+		if _, err := url.Parse(value); err == nil {
+			e.SetDataSchema(value)
+		}
+	case "time":
+		if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+			e.SetTime(t)
+		}
+	default:
+		e.SetExtension(name, value)
+	}
+}
+
+// checkURLWithoutUserInfo rejects URLs carrying user info, job parameters are readable through APIs, so
+// credentials are named instead.
+func checkURLWithoutUserInfo(u string) error {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("invalid url: %s", u))
+	}
+	if parsed.User != nil {
+		return errors.ErrInvalidRequest.WithMessage("the url can't contain user info, use a credential instead")
+	}
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/nats-io/nats.go"
+)
+
+const (
+	KindNATS job.Kind = "eventbus-import-nats"
+
+	// EventTypeNATSMessage is the type of events converted from NATS messages by default.
+	EventTypeNATSMessage = "vanus.nats.message"
+
+	natsSourcePrefix = "/nats/"
+)
+
+// NATSOptions describes an import from a NATS subject.
+type NATSOptions struct {
+	Eventbus string
+	Servers  []string
+	// Subject may contain wildcards, the subject of each message is kept as the subject of its event.
+	Subject string
+	// Durable names a durable JetStream consumer which messages are pulled from, it's created on the
+	// stream of the subject if it doesn't exist. The subject is subscribed if it's empty, then messages
+	// published while the import isn't running are missed.
+	Durable string
+	TLS     bool
+	// Credential names a credential configured in controllers, see KafkaOptions.SASLCredential.
+	Credential string
+	Attributes AttributeMapping
+}
+
+// Spec returns the spec of the import job, imports of the same subject into the same eventbus are
+// deduplicated.
+func (o NATSOptions) Spec() (job.Spec, error) {
+	if err := o.validate(); err != nil {
+		return job.Spec{}, err
+	}
+	params := map[string]string{
+		paramEventbus:   o.Eventbus,
+		paramServers:    strings.Join(o.Servers, ","),
+		paramSubject:    o.Subject,
+		paramDurable:    o.Durable,
+		paramTLS:        strconv.FormatBool(o.TLS),
+		paramCredential: o.Credential,
+	}
+	o.Attributes.params(params)
+	return job.Spec{
+		Kind:   KindNATS,
+		Key:    fmt.Sprintf("%s/%s/%s", o.Eventbus, strings.Join(o.Servers, ","), o.Subject),
+		Params: params,
+	}, nil
+}
+
+func (o NATSOptions) validate() error {
+	if o.Eventbus == "" {
+		return errors.ErrInvalidRequest.WithMessage("the eventbus is required")
+	}
+	if len(o.Servers) == 0 {
+		return errors.ErrInvalidRequest.WithMessage("nats servers are required")
+	}
+	for _, server := range o.Servers {
+		if err := checkURLWithoutUserInfo(server); err != nil {
+			return err
+		}
+	}
+	if o.Subject == "" {
+		return errors.ErrInvalidRequest.WithMessage("the nats subject is required")
+	}
+	return o.Attributes.validate()
+}
+
+func natsOptionsFromParams(params map[string]string) (NATSOptions, error) {
+	o := NATSOptions{
+		Eventbus:   params[paramEventbus],
+		Subject:    params[paramSubject],
+		Durable:    params[paramDurable],
+		Credential: params[paramCredential],
+	}
+	if params[paramServers] != "" {
+		o.Servers = strings.Split(params[paramServers], ",")
+	}
+	o.TLS, _ = strconv.ParseBool(params[paramTLS])
+	var err error
+	if o.Attributes, err = attributeMappingFromParams(params); err != nil {
+		return o, err
+	}
+	return o, o.validate()
+}
+
+type natsImporter struct {
+	newSource func(o NATSOptions) (messageSource, error)
+	openBus   func(ctx context.Context, eventbus string) api.BusWriter
+}
+
+// NewNATSHandler returns the handler of NATS import jobs, events are written through controllers at
+// ctrlAddrs. Imports authenticate to servers with credentials named by NATSOptions.Credential. Messages
+// of JetStream consumers are imported at least once, they're acknowledged after they're written.
+func NewNATSHandler(ctrlAddrs []string, credentials map[string]Credential) job.Handler {
+	n := &natsImporter{
+		newSource: func(o NATSOptions) (messageSource, error) {
+			cred, err := lookupCredential(credentials, o.Credential)
+			if err != nil {
+				return nil, err
+			}
+			return newNATSSource(o, cred)
+		},
+		openBus: newBusOpener(ctrlAddrs),
+	}
+	return n.run
+}
+
+func (n *natsImporter) run(ctx context.Context, e *job.Execution) error {
+	o, err := natsOptionsFromParams(e.Params())
+	if err != nil {
+		return err
+	}
+	src, err := n.newSource(o)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	log.Info(ctx, "start importing from nats", map[string]interface{}{
+		"job_id":   e.ID().Key(),
+		"subject":  o.Subject,
+		"durable":  o.Durable,
+		"eventbus": o.Eventbus,
+	})
+	return importMessages(ctx, e, src, n.openBus(ctx, o.Eventbus), func(m message) *ce.Event {
+		return o.Attributes.toEvent(m, EventTypeNATSMessage, natsSourcePrefix+o.Subject)
+	})
+}
+
+type natsSource struct {
+	conn *nats.Conn
+	sub  *nats.Subscription
+	// pull is set if messages are pulled from a JetStream consumer.
+	pull bool
+}
+
+func newNATSSource(o NATSOptions, cred Credential) (*natsSource, error) {
+	opts := []nats.Option{nats.Name(clientName)}
+	if o.TLS {
+		opts = append(opts, nats.Secure(&tls.Config{MinVersion: tls.VersionTLS12}))
+	}
+	switch {
+	case cred.Token != "":
+		opts = append(opts, nats.Token(cred.Token))
+	case cred.Username != "":
+		opts = append(opts, nats.UserInfo(cred.Username, cred.Password))
+	}
+	conn, err := nats.Connect(strings.Join(o.Servers, ","), opts...)
+	if err != nil {
+		return nil, err
+	}
+	s := &natsSource{conn: conn, pull: o.Durable != ""}
+	if s.pull {
+		var js nats.JetStreamContext
+		if js, err = conn.JetStream(); err == nil {
+			s.sub, err = js.PullSubscribe(o.Subject, o.Durable)
+		}
+	} else {
+		s.sub, err = conn.SubscribeSync(o.Subject)
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *natsSource) Receive(ctx context.Context, max int) ([]message, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, messageWait)
+	defer cancel()
+	if s.pull {
+		// The fetch is canceled with ctx rather than waiting out messageWait.
+		msgs, err := s.sub.Fetch(max, nats.Context(waitCtx))
+		if err != nil && (waitCtx.Err() == nil || ctx.Err() != nil) {
+			return nil, err
+		}
+		return s.toMessages(msgs), nil
+	}
+
+	msg, err := s.sub.NextMsgWithContext(waitCtx)
+	if err != nil {
+		if waitCtx.Err() != nil && ctx.Err() == nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+	msgs := []*nats.Msg{msg}
+	for len(msgs) < max {
+		if pending, _, _ := s.sub.Pending(); pending == 0 {
+			break
+		}
+		if msg, err = s.sub.NextMsg(0); err != nil {
+			break
+		}
+		msgs = append(msgs, msg)
+	}
+	return s.toMessages(msgs), nil
+}
+
+func (s *natsSource) toMessages(msgs []*nats.Msg) []message {
+	result := make([]message, len(msgs))
+	for i, msg := range msgs {
+		m := message{
+			subject: msg.Subject,
+			data:    msg.Data,
+		}
+		if len(msg.Header) != 0 {
+			m.headers = make(map[string]string, len(msg.Header))
+			for name, values := range msg.Header {
+				if len(values) == 0 {
+					continue
+				}
+				m.headers[name] = values[0]
+				if strings.EqualFold(name, headerContentType) {
+					m.contentType = values[0]
+				}
+			}
+		}
+		if s.pull {
+			if meta, err := msg.Metadata(); err == nil {
+				// Sequences identify messages in the stream, so duplicates imported after retries can be told
+				// apart.
+				m.id = fmt.Sprintf("%s-%d", meta.Stream, meta.Sequence.Stream)
+				m.time = meta.Timestamp
+			}
+			m.ack = func(msg *nats.Msg) func() error {
+				return func() error { return msg.Ack() }
+			}(msg)
+		}
+		result[i] = m
+	}
+	return result
+}
+
+// Close doesn't unsubscribe, which deletes the durable consumer.
+func (s *natsSource) Close() error {
+	s.conn.Close()
+	return nil
+}
@@ -0,0 +1,238 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+const (
+	KindRabbitMQ job.Kind = "eventbus-import-rabbitmq"
+
+	// EventTypeRabbitMQMessage is the type of events converted from RabbitMQ messages by default.
+	EventTypeRabbitMQMessage = "vanus.rabbitmq.message"
+
+	rabbitMQSourcePrefix = "/rabbitmq/"
+	// rabbitMQPrefetch bounds unacknowledged messages delivered to an import.
+	rabbitMQPrefetch = 2 * maxEventsPerAppend
+)
+
+// RabbitMQOptions describes an import from a RabbitMQ queue.
+type RabbitMQOptions struct {
+	Eventbus string
+	// URL is like amqp://host:5672/vhost, or amqps:// for TLS. It can't contain user info.
+	URL   string
+	Queue string
+	// Credential names a credential configured in controllers, see KafkaOptions.SASLCredential. The guest
+	// user is used if it's empty.
+	Credential string
+	Attributes AttributeMapping
+}
+
+// Spec returns the spec of the import job, imports of the same queue into the same eventbus are
+// deduplicated.
+func (o RabbitMQOptions) Spec() (job.Spec, error) {
+	if err := o.validate(); err != nil {
+		return job.Spec{}, err
+	}
+	params := map[string]string{
+		paramEventbus:   o.Eventbus,
+		paramURL:        o.URL,
+		paramQueue:      o.Queue,
+		paramCredential: o.Credential,
+	}
+	o.Attributes.params(params)
+	return job.Spec{
+		Kind:   KindRabbitMQ,
+		Key:    fmt.Sprintf("%s/%s/%s", o.Eventbus, o.URL, o.Queue),
+		Params: params,
+	}, nil
+}
+
+func (o RabbitMQOptions) validate() error {
+	if o.Eventbus == "" {
+		return errors.ErrInvalidRequest.WithMessage("the eventbus is required")
+	}
+	if o.URL == "" {
+		return errors.ErrInvalidRequest.WithMessage("the rabbitmq url is required")
+	}
+	if err := checkURLWithoutUserInfo(o.URL); err != nil {
+		return err
+	}
+	if o.Queue == "" {
+		return errors.ErrInvalidRequest.WithMessage("the rabbitmq queue is required")
+	}
+	return o.Attributes.validate()
+}
+
+func rabbitMQOptionsFromParams(params map[string]string) (RabbitMQOptions, error) {
+	o := RabbitMQOptions{
+		Eventbus:   params[paramEventbus],
+		URL:        params[paramURL],
+		Queue:      params[paramQueue],
+		Credential: params[paramCredential],
+	}
+	var err error
+	if o.Attributes, err = attributeMappingFromParams(params); err != nil {
+		return o, err
+	}
+	return o, o.validate()
+}
+
+type rabbitMQImporter struct {
+	newSource func(o RabbitMQOptions) (messageSource, error)
+	openBus   func(ctx context.Context, eventbus string) api.BusWriter
+}
+
+// NewRabbitMQHandler returns the handler of RabbitMQ import jobs, events are written through controllers
+// at ctrlAddrs. Imports authenticate to brokers with credentials named by RabbitMQOptions.Credential.
+// Messages are imported at least once, they're acknowledged after they're written.
+func NewRabbitMQHandler(ctrlAddrs []string, credentials map[string]Credential) job.Handler {
+	r := &rabbitMQImporter{
+		newSource: func(o RabbitMQOptions) (messageSource, error) {
+			cred, err := lookupCredential(credentials, o.Credential)
+			if err != nil {
+				return nil, err
+			}
+			return newRabbitMQSource(o, cred)
+		},
+		openBus: newBusOpener(ctrlAddrs),
+	}
+	return r.run
+}
+
+func (r *rabbitMQImporter) run(ctx context.Context, e *job.Execution) error {
+	o, err := rabbitMQOptionsFromParams(e.Params())
+	if err != nil {
+		return err
+	}
+	src, err := r.newSource(o)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	log.Info(ctx, "start importing from rabbitmq", map[string]interface{}{
+		"job_id":   e.ID().Key(),
+		"queue":    o.Queue,
+		"eventbus": o.Eventbus,
+	})
+	return importMessages(ctx, e, src, r.openBus(ctx, o.Eventbus), func(m message) *ce.Event {
+		return o.Attributes.toEvent(m, EventTypeRabbitMQMessage, rabbitMQSourcePrefix+o.Queue)
+	})
+}
+
+type rabbitMQSource struct {
+	conn       *amqp.Connection
+	deliveries <-chan amqp.Delivery
+}
+
+func newRabbitMQSource(o RabbitMQOptions, cred Credential) (*rabbitMQSource, error) {
+	cfg := amqp.Config{
+		Properties: amqp.Table{"connection_name": clientName},
+	}
+	if cred.Username != "" {
+		cfg.SASL = []amqp.Authentication{&amqp.PlainAuth{Username: cred.Username, Password: cred.Password}}
+	}
+	conn, err := amqp.DialConfig(o.URL, cfg)
+	if err != nil {
+		return nil, err
+	}
+	s := &rabbitMQSource{conn: conn}
+	ch, err := conn.Channel()
+	if err == nil {
+		if err = ch.Qos(rabbitMQPrefetch, 0, false); err == nil {
+			s.deliveries, err = ch.Consume(o.Queue, "", false, false, false, false, nil)
+		}
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *rabbitMQSource) Receive(ctx context.Context, max int) ([]message, error) {
+	timer := time.NewTimer(messageWait)
+	defer timer.Stop()
+
+	var msgs []message
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timer.C:
+		return nil, nil
+	case d, ok := <-s.deliveries:
+		if !ok {
+			return nil, fmt.Errorf("the rabbitmq channel is closed")
+		}
+		msgs = append(msgs, toRabbitMQMessage(d))
+	}
+	for len(msgs) < max {
+		select {
+		case d, ok := <-s.deliveries:
+			if !ok {
+				// Return messages received, the closed channel is found by the next receive.
+				return msgs, nil
+			}
+			msgs = append(msgs, toRabbitMQMessage(d))
+		default:
+			return msgs, nil
+		}
+	}
+	return msgs, nil
+}
+
+func toRabbitMQMessage(d amqp.Delivery) message {
+	m := message{
+		id:          d.MessageId,
+		subject:     d.RoutingKey,
+		time:        d.Timestamp,
+		contentType: d.ContentType,
+		data:        d.Body,
+		ack: func() error {
+			return d.Ack(false)
+		},
+	}
+	if len(d.Headers) != 0 {
+		m.headers = make(map[string]string, len(d.Headers))
+		for name, value := range d.Headers {
+			switch v := value.(type) {
+			case string:
+				m.headers[name] = v
+			case []byte:
+				m.headers[name] = string(v)
+			case time.Time:
+				m.headers[name] = v.Format(time.RFC3339Nano)
+			default:
+				m.headers[name] = fmt.Sprint(v)
+			}
+		}
+	}
+	return m
+}
+
+func (s *rabbitMQSource) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+const (
+	KeyPrefixInKVStore = "/vanus/internal/resource/job"
+)
+
+// Kind identifies the handler of a job.
+type Kind string
+
+type Phase string
+
+const (
+	PhasePending   Phase = "pending"
+	PhaseRunning   Phase = "running"
+	PhaseSucceeded Phase = "succeeded"
+	PhaseFailed    Phase = "failed"
+	PhaseCanceled  Phase = "canceled"
+)
+
+type Progress struct {
+	Done    int64  `json:"done"`
+	Total   int64  `json:"total"`
+	Message string `json:"message,omitempty"`
+}
+
+type Job struct {
+	ID          vanus.ID          `json:"id"`
+	Kind        Kind              `json:"kind"`
+	Key         string            `json:"key,omitempty"`
+	Params      map[string]string `json:"params,omitempty"`
+	Phase       Phase             `json:"phase"`
+	Progress    Progress          `json:"progress"`
+	Cursor      string            `json:"cursor,omitempty"`
+	Attempts    int               `json:"attempts"`
+	MaxAttempts int               `json:"max_attempts"`
+	Error       string            `json:"error,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	FinishedAt  time.Time         `json:"finished_at,omitempty"`
+}
+
+func (j *Job) IsFinished() bool {
+	return j.Phase == PhaseSucceeded || j.Phase == PhaseFailed || j.Phase == PhaseCanceled
+}
+
+func (j *Job) ToPb() *metapb.Job {
+	to := &metapb.Job{
+		Id:              j.ID.Uint64(),
+		Kind:            string(j.Kind),
+		Key:             j.Key,
+		Params:          j.Params,
+		Phase:           string(j.Phase),
+		ProgressDone:    j.Progress.Done,
+		ProgressTotal:   j.Progress.Total,
+		ProgressMessage: j.Progress.Message,
+		Attempts:        int32(j.Attempts),
+		MaxAttempts:     int32(j.MaxAttempts),
+		Error:           j.Error,
+		CreatedAt:       j.CreatedAt.UnixMilli(),
+		UpdatedAt:       j.UpdatedAt.UnixMilli(),
+	}
+	if !j.FinishedAt.IsZero() {
+		to.FinishedAt = j.FinishedAt.UnixMilli()
+	}
+	return to
+}
+
+func getJobKey(prefix string, id vanus.ID) string {
+	return path.Join(KeyPrefixInKVStore, prefix, id.Key())
+}
+
+// Spec describes a job to be submitted.
+type Spec struct {
+	Kind Kind
+	// Key deduplicates jobs, submitting a job whose kind and key are same as an unfinished job returns the
+	// unfinished one. Empty key isn't deduplicated.
+	Key    string
+	Params map[string]string
+	// MaxAttempts is the number of attempts before the job fails, 0 means retrying until it succeeds or
+	// is canceled.
+	MaxAttempts int
+}
+
+// Handler runs a job. It must return when ctx is done, and it should save its progress by
+// Execution.Checkpoint, a job interrupted by leader changing is resumed from the last checkpoint.
+type Handler func(ctx context.Context, e *Execution) error
+
+// Execution is an attempt of running a job.
+type Execution struct {
+	mgr *manager
+	job Job
+}
+
+func (e *Execution) ID() vanus.ID {
+	return e.job.ID
+}
+
+func (e *Execution) Params() map[string]string {
+	return e.job.Params
+}
+
+// Cursor returns the position saved by the last checkpoint, it's empty if the job hasn't been checkpointed.
+func (e *Execution) Cursor() string {
+	return e.job.Cursor
+}
+
+// Checkpoint persists the cursor and the progress of the job.
+func (e *Execution) Checkpoint(ctx context.Context, cursor string, progress Progress) error {
+	e.job.Cursor = cursor
+	e.job.Progress = progress
+	if e.mgr == nil {
+		return nil
+	}
+	return e.mgr.checkpoint(ctx, e.job.ID, cursor, progress)
+}
+
+// NewTestExecution just only used for Unit Test of handlers, its checkpoints aren't persisted.
+func NewTestExecution(params map[string]string) *Execution {
+	return &Execution{job: Job{ID: vanus.NewTestID(), Params: params}}
+}
@@ -0,0 +1,396 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:generate mockgen -source=manager.go  -destination=mock_manager.go -package=job
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	defaultRetryInterval  = 10 * time.Second
+	defaultFinishedJobTTL = 24 * time.Hour
+)
+
+type Manager interface {
+	// Register adds the handler of a kind of jobs, it must be called before Run.
+	Register(kind Kind, handler Handler)
+	// Run loads jobs from kv and resumes unfinished ones, it's called when the controller becomes leader.
+	Run(ctx context.Context, kvClient kv.Client) error
+	// Stop interrupts running jobs without changing their phases, so they are resumed by the next leader.
+	Stop()
+	Submit(ctx context.Context, spec Spec) (*Job, error)
+	GetJob(id vanus.ID) *Job
+	ListJob() []*Job
+	CancelJob(ctx context.Context, id vanus.ID) (*Job, error)
+}
+
+// NewManager returns a manager whose jobs are persisted under the prefix, so managers of different
+// components don't load jobs of each other.
+func NewManager(prefix string) Manager {
+	return &manager{
+		prefix:         prefix,
+		handlers:       map[Kind]Handler{},
+		jobs:           map[vanus.ID]*entry{},
+		retryInterval:  defaultRetryInterval,
+		finishedJobTTL: defaultFinishedJobTTL,
+	}
+}
+
+type entry struct {
+	job    *Job
+	cancel context.CancelFunc
+}
+
+type manager struct {
+	prefix         string
+	handlers       map[Kind]Handler
+	kvClient       kv.Client
+	mutex          sync.RWMutex
+	jobs           map[vanus.ID]*entry
+	cancel         context.CancelFunc
+	ctx            context.Context
+	wg             sync.WaitGroup
+	retryInterval  time.Duration
+	finishedJobTTL time.Duration
+}
+
+// make sure manager implements Manager.
+var _ Manager = (*manager)(nil)
+
+func (m *manager) Register(kind Kind, handler Handler) {
+	m.handlers[kind] = handler
+}
+
+func (m *manager) Run(ctx context.Context, kvClient kv.Client) error {
+	pairs, err := kvClient.List(ctx, path.Join(KeyPrefixInKVStore, m.prefix))
+	if err != nil {
+		return err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.kvClient = kvClient
+	m.jobs = make(map[vanus.ID]*entry, len(pairs))
+	for _, pair := range pairs {
+		j := &Job{}
+		if err = json.Unmarshal(pair.Value, j); err != nil {
+			return err
+		}
+		m.jobs[j.ID] = &entry{job: j}
+	}
+	m.removeExpiredJobs(ctx)
+
+	m.ctx, m.cancel = context.WithCancel(context.Background())
+	for _, e := range m.jobs {
+		if !e.job.IsFinished() {
+			log.Info(ctx, "resume the job", map[string]interface{}{
+				"job_id": e.job.ID.Key(),
+				"kind":   e.job.Kind,
+				"cursor": e.job.Cursor,
+			})
+			m.start(e)
+		}
+	}
+	return nil
+}
+
+func (m *manager) Stop() {
+	m.mutex.Lock()
+	cancel := m.cancel
+	m.cancel = nil
+	m.mutex.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	m.wg.Wait()
+}
+
+func (m *manager) Submit(ctx context.Context, spec Spec) (*Job, error) {
+	if _, ok := m.handlers[spec.Kind]; !ok {
+		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("unknown job kind: %s", spec.Kind))
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.cancel == nil {
+		return nil, errors.ErrServerNotStart.WithMessage("the job manager isn't running")
+	}
+	if spec.Key != "" {
+		for _, e := range m.jobs {
+			if e.job.Kind == spec.Kind && e.job.Key == spec.Key && !e.job.IsFinished() {
+				j := *e.job
+				return &j, nil
+			}
+		}
+	}
+
+	id, err := vanus.NewID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	j := &Job{
+		ID:          id,
+		Kind:        spec.Kind,
+		Key:         spec.Key,
+		Params:      spec.Params,
+		Phase:       PhasePending,
+		MaxAttempts: spec.MaxAttempts,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err = m.persist(ctx, j); err != nil {
+		return nil, err
+	}
+	e := &entry{job: j}
+	m.jobs[id] = e
+	m.start(e)
+	log.Info(ctx, "the job has been submitted", map[string]interface{}{
+		"job_id": id.Key(),
+		"kind":   j.Kind,
+		"key":    j.Key,
+	})
+
+	cp := *j
+	return &cp, nil
+}
+
+func (m *manager) GetJob(id vanus.ID) *Job {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	e, ok := m.jobs[id]
+	if !ok {
+		return nil
+	}
+	j := *e.job
+	return &j
+}
+
+func (m *manager) ListJob() []*Job {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	list := make([]*Job, 0, len(m.jobs))
+	for _, e := range m.jobs {
+		j := *e.job
+		list = append(list, &j)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+	return list
+}
+
+func (m *manager) CancelJob(ctx context.Context, id vanus.ID) (*Job, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e, ok := m.jobs[id]
+	if !ok {
+		return nil, errors.ErrResourceNotFound.WithMessage("job not found")
+	}
+	if e.job.IsFinished() {
+		return nil, errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf("the job has been %s", e.job.Phase))
+	}
+
+	prev := *e.job
+	now := time.Now()
+	e.job.Phase = PhaseCanceled
+	e.job.UpdatedAt = now
+	e.job.FinishedAt = now
+	if err := m.persist(ctx, e.job); err != nil {
+		*e.job = prev
+		return nil, err
+	}
+	if e.cancel != nil {
+		e.cancel()
+	}
+	log.Info(ctx, "the job has been canceled", map[string]interface{}{
+		"job_id": id.Key(),
+		"kind":   e.job.Kind,
+	})
+
+	j := *e.job
+	return &j, nil
+}
+
+// start runs the job in background, the caller must hold the lock.
+func (m *manager) start(e *entry) {
+	ctx, cancel := context.WithCancel(m.ctx)
+	e.cancel = cancel
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer cancel()
+		m.execute(ctx, e)
+	}()
+}
+
+func (m *manager) execute(ctx context.Context, e *entry) {
+	handler := m.handlers[e.job.Kind]
+	for {
+		exec, ok := m.begin(ctx, e, handler != nil)
+		if !ok {
+			return
+		}
+
+		err := handler(ctx, exec)
+		if ctx.Err() != nil {
+			// The job has been canceled, or the manager has stopped and the job will be resumed by the
+			// next leader.
+			return
+		}
+		if m.end(ctx, e, err) {
+			return
+		}
+
+		log.Warning(ctx, "the job failed, retry later", map[string]interface{}{
+			log.KeyError: err,
+			"job_id":     exec.ID().Key(),
+			"kind":       e.job.Kind,
+			"attempts":   exec.job.Attempts,
+		})
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(m.retryInterval):
+		}
+	}
+}
+
+// begin marks the job running and returns its execution, it returns false if the job shouldn't run.
+func (m *manager) begin(ctx context.Context, e *entry, handled bool) (*Execution, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if e.job.IsFinished() {
+		return nil, false
+	}
+
+	now := time.Now()
+	e.job.UpdatedAt = now
+	if !handled {
+		// The controller has been downgraded, jobs of unknown kinds can't be resumed.
+		e.job.Phase = PhaseFailed
+		e.job.Error = fmt.Sprintf("unknown job kind: %s", e.job.Kind)
+		e.job.FinishedAt = now
+		m.persistOrWarn(ctx, e.job)
+		return nil, false
+	}
+	e.job.Phase = PhaseRunning
+	e.job.Attempts++
+	m.persistOrWarn(ctx, e.job)
+	return &Execution{mgr: m, job: *e.job}, true
+}
+
+// end records the result of an attempt, it returns true if the job has finished.
+func (m *manager) end(ctx context.Context, e *entry, err error) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if e.job.IsFinished() {
+		return true
+	}
+
+	now := time.Now()
+	e.job.UpdatedAt = now
+	switch {
+	case err == nil:
+		e.job.Phase = PhaseSucceeded
+		e.job.Error = ""
+	case e.job.MaxAttempts > 0 && e.job.Attempts >= e.job.MaxAttempts:
+		e.job.Phase = PhaseFailed
+		e.job.Error = err.Error()
+	default:
+		e.job.Error = err.Error()
+	}
+	if e.job.IsFinished() {
+		e.job.FinishedAt = now
+		log.Info(ctx, "the job has finished", map[string]interface{}{
+			"job_id":   e.job.ID.Key(),
+			"kind":     e.job.Kind,
+			"phase":    e.job.Phase,
+			"attempts": e.job.Attempts,
+			"error":    e.job.Error,
+		})
+	}
+	m.persistOrWarn(ctx, e.job)
+	if e.job.IsFinished() {
+		m.removeExpiredJobs(ctx)
+	}
+	return e.job.IsFinished()
+}
+
+func (m *manager) checkpoint(ctx context.Context, id vanus.ID, cursor string, progress Progress) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	e, ok := m.jobs[id]
+	if !ok || e.job.IsFinished() {
+		return errors.ErrResourceCanNotOp.WithMessage("the job isn't running")
+	}
+	e.job.Cursor = cursor
+	e.job.Progress = progress
+	e.job.UpdatedAt = time.Now()
+	return m.persist(ctx, e.job)
+}
+
+// removeExpiredJobs deletes jobs which have finished for a while, the caller must hold the lock.
+func (m *manager) removeExpiredJobs(ctx context.Context) {
+	for id, e := range m.jobs {
+		if !e.job.IsFinished() || time.Since(e.job.FinishedAt) < m.finishedJobTTL {
+			continue
+		}
+		if err := m.kvClient.Delete(ctx, getJobKey(m.prefix, id)); err != nil {
+			log.Warning(ctx, "delete finished job in kv failed", map[string]interface{}{
+				log.KeyError: err,
+				"job_id":     id.Key(),
+			})
+			continue
+		}
+		delete(m.jobs, id)
+	}
+}
+
+func (m *manager) persist(ctx context.Context, j *Job) error {
+	data, err := json.Marshal(j)
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	if err = m.kvClient.Set(ctx, getJobKey(m.prefix, j.ID), data); err != nil {
+		return errors.ErrInternal.WithMessage("save job in kv failed").Wrap(err)
+	}
+	return nil
+}
+
+// persistOrWarn is used when the phase of job changes in background, the state in memory is still
+// authoritative if it fails, and it will be saved by the next change.
+func (m *manager) persistOrWarn(ctx context.Context, j *Job) {
+	if err := m.persist(ctx, j); err != nil {
+		log.Warning(ctx, "save job in kv failed", map[string]interface{}{
+			log.KeyError: err,
+			"job_id":     j.ID.Key(),
+			"phase":      j.Phase,
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	stdCtx "context"
+	"encoding/json"
+	stderr "errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func waitPhase(mgr Manager, id vanus.ID, phase Phase) *Job {
+	for i := 0; i < 100; i++ {
+		if j := mgr.GetJob(id); j != nil && j.Phase == phase {
+			return j
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	return mgr.GetJob(id)
+}
+
+func TestManager_Submit(t *testing.T) {
+	vanus.InitFakeSnowflake()
+	ctx := stdCtx.Background()
+
+	Convey("test submit job", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		kvCli.EXPECT().List(gomock.Any(), "/vanus/internal/resource/job/ut").Return(nil, nil)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		mgr := NewManager("ut").(*manager)
+		mgr.retryInterval = 10 * time.Millisecond
+		release := make(chan struct{})
+		attempts := 0
+		mgr.Register("ut", func(ctx stdCtx.Context, e *Execution) error {
+			attempts++
+			if attempts < 3 {
+				return fmt.Errorf("test")
+			}
+			<-release
+			return e.Checkpoint(ctx, "1", Progress{Done: 1, Total: 2})
+		})
+
+		_, err := mgr.Submit(ctx, Spec{Kind: "ut"})
+		So(errors.Is(err, errors.ErrServerNotStart), ShouldBeTrue)
+
+		So(mgr.Run(ctx, kvCli), ShouldBeNil)
+		defer mgr.Stop()
+
+		_, err = mgr.Submit(ctx, Spec{Kind: "unknown"})
+		So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+
+		j, err := mgr.Submit(ctx, Spec{Kind: "ut", Key: "key", Params: map[string]string{"k": "v"}})
+		So(err, ShouldBeNil)
+		So(j.Phase, ShouldEqual, PhasePending)
+
+		Convey("test deduplicate job", func() {
+			dup, err := mgr.Submit(ctx, Spec{Kind: "ut", Key: "key"})
+			So(err, ShouldBeNil)
+			So(dup.ID, ShouldEqual, j.ID)
+			close(release)
+		})
+
+		Convey("test retry job", func() {
+			close(release)
+			cur := waitPhase(mgr, j.ID, PhaseSucceeded)
+			So(cur.Phase, ShouldEqual, PhaseSucceeded)
+			So(cur.Attempts, ShouldEqual, 3)
+			So(cur.Cursor, ShouldEqual, "1")
+			So(cur.Progress.Done, ShouldEqual, 1)
+			So(cur.Error, ShouldBeEmpty)
+			So(cur.FinishedAt.IsZero(), ShouldBeFalse)
+			So(mgr.ListJob(), ShouldHaveLength, 1)
+
+			_, err = mgr.CancelJob(ctx, j.ID)
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+		})
+	})
+
+	Convey("test job fails after max attempts", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		mgr := NewManager("ut").(*manager)
+		mgr.retryInterval = 10 * time.Millisecond
+		mgr.Register("ut", func(ctx stdCtx.Context, e *Execution) error {
+			return fmt.Errorf("test")
+		})
+		So(mgr.Run(ctx, kvCli), ShouldBeNil)
+		defer mgr.Stop()
+
+		j, err := mgr.Submit(ctx, Spec{Kind: "ut", MaxAttempts: 2})
+		So(err, ShouldBeNil)
+		cur := waitPhase(mgr, j.ID, PhaseFailed)
+		So(cur.Phase, ShouldEqual, PhaseFailed)
+		So(cur.Attempts, ShouldEqual, 2)
+		So(cur.Error, ShouldEqual, "test")
+	})
+}
+
+func TestManager_CancelJob(t *testing.T) {
+	vanus.InitFakeSnowflake()
+	ctx := stdCtx.Background()
+
+	Convey("test cancel job", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		mgr := NewManager("ut")
+		started := make(chan struct{})
+		mgr.Register("ut", func(ctx stdCtx.Context, e *Execution) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		So(mgr.Run(ctx, kvCli), ShouldBeNil)
+		defer mgr.Stop()
+
+		_, err := mgr.CancelJob(ctx, vanus.NewTestID())
+		So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+
+		j, err := mgr.Submit(ctx, Spec{Kind: "ut"})
+		So(err, ShouldBeNil)
+		<-started
+		canceled, err := mgr.CancelJob(ctx, j.ID)
+		So(err, ShouldBeNil)
+		So(canceled.Phase, ShouldEqual, PhaseCanceled)
+		mgr.Stop()
+		So(mgr.GetJob(j.ID).Phase, ShouldEqual, PhaseCanceled)
+	})
+
+	Convey("test cancel job failed when saving kv", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		mgr := NewManager("ut")
+		mgr.Register("ut", func(ctx stdCtx.Context, e *Execution) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		So(mgr.Run(ctx, kvCli), ShouldBeNil)
+		defer mgr.Stop()
+
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(nil)
+		j, err := mgr.Submit(ctx, Spec{Kind: "ut"})
+		So(err, ShouldBeNil)
+		So(waitPhase(mgr, j.ID, PhaseRunning).Phase, ShouldEqual, PhaseRunning)
+
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(stderr.New("test"))
+		_, err = mgr.CancelJob(ctx, j.ID)
+		So(err, ShouldNotBeNil)
+		So(mgr.GetJob(j.ID).Phase, ShouldEqual, PhaseRunning)
+	})
+}
+
+func TestManager_Run(t *testing.T) {
+	vanus.InitFakeSnowflake()
+	ctx := stdCtx.Background()
+
+	Convey("test resume jobs", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		kvCli := kv.NewMockClient(ctrl)
+
+		now := time.Now()
+		running := &Job{
+			ID:        vanus.NewTestID(),
+			Kind:      "ut",
+			Phase:     PhaseRunning,
+			Cursor:    "5",
+			Attempts:  1,
+			CreatedAt: now,
+		}
+		unknown := &Job{
+			ID:        vanus.NewTestID(),
+			Kind:      "unknown",
+			Phase:     PhasePending,
+			CreatedAt: now.Add(time.Second),
+		}
+		expired := &Job{
+			ID:         vanus.NewTestID(),
+			Kind:       "ut",
+			Phase:      PhaseSucceeded,
+			FinishedAt: now.Add(-48 * time.Hour),
+		}
+		pairs := make([]kv.Pair, 0)
+		for _, j := range []*Job{running, unknown, expired} {
+			data, _ := json.Marshal(j)
+			pairs = append(pairs, kv.Pair{Key: getJobKey("ut", j.ID), Value: data})
+		}
+		kvCli.EXPECT().List(gomock.Any(), gomock.Any()).Return(pairs, nil)
+		kvCli.EXPECT().Delete(gomock.Any(), getJobKey("ut", expired.ID)).Return(nil)
+		kvCli.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
+
+		mgr := NewManager("ut")
+		cursor := make(chan string, 1)
+		mgr.Register("ut", func(ctx stdCtx.Context, e *Execution) error {
+			cursor <- e.Cursor()
+			return nil
+		})
+		So(mgr.Run(ctx, kvCli), ShouldBeNil)
+		defer mgr.Stop()
+
+		So(<-cursor, ShouldEqual, "5")
+		cur := waitPhase(mgr, running.ID, PhaseSucceeded)
+		So(cur.Phase, ShouldEqual, PhaseSucceeded)
+		So(cur.Attempts, ShouldEqual, 2)
+		So(waitPhase(mgr, unknown.ID, PhaseFailed).Phase, ShouldEqual, PhaseFailed)
+		So(mgr.GetJob(expired.ID), ShouldBeNil)
+		list := mgr.ListJob()
+		So(list, ShouldHaveLength, 2)
+		So(list[0].ID, ShouldEqual, running.ID)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"context"
+	"sort"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+)
+
+type server struct {
+	mgrs []Manager
+}
+
+// NewServer exposes jobs of all managers by a single service.
+func NewServer(mgrs ...Manager) ctrlpb.JobControllerServer {
+	return &server{mgrs: mgrs}
+}
+
+// make sure server implements ctrlpb.JobControllerServer.
+var _ ctrlpb.JobControllerServer = (*server)(nil)
+
+func (s *server) ListJob(_ context.Context, req *ctrlpb.ListJobRequest) (*ctrlpb.ListJobResponse, error) {
+	list := make([]*Job, 0)
+	for _, mgr := range s.mgrs {
+		for _, j := range mgr.ListJob() {
+			if req.Kind != "" && string(j.Kind) != req.Kind {
+				continue
+			}
+			if req.Unfinished && j.IsFinished() {
+				continue
+			}
+			list = append(list, j)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+
+	jobs := make([]*metapb.Job, 0, len(list))
+	for _, j := range list {
+		jobs = append(jobs, j.ToPb())
+	}
+	return &ctrlpb.ListJobResponse{Jobs: jobs}, nil
+}
+
+func (s *server) GetJob(_ context.Context, req *ctrlpb.GetJobRequest) (*metapb.Job, error) {
+	id := vanus.NewIDFromUint64(req.Id)
+	for _, mgr := range s.mgrs {
+		if j := mgr.GetJob(id); j != nil {
+			return j.ToPb(), nil
+		}
+	}
+	return nil, errors.ErrResourceNotFound.WithMessage("job not found")
+}
+
+func (s *server) CancelJob(ctx context.Context, req *ctrlpb.CancelJobRequest) (*metapb.Job, error) {
+	id := vanus.NewIDFromUint64(req.Id)
+	for _, mgr := range s.mgrs {
+		if mgr.GetJob(id) == nil {
+			continue
+		}
+		j, err := mgr.CancelJob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return j.ToPb(), nil
+	}
+	return nil, errors.ErrResourceNotFound.WithMessage("job not found")
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownership
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const defaultAdminRole = "admin"
+
+type Config struct {
+	// Protect restricts modifying and deleting eventbuses and subscriptions to their owners and admins,
+	// so that teams sharing a cluster don't change resources of each other by accident.
+	Protect bool `yaml:"protect"`
+	// AdminRoles may modify resources of any owner, it's admin by default.
+	AdminRoles []string `yaml:"admin_roles"`
+}
+
+// Guard checks whether the principal of a request may modify a resource. A nil Guard allows everything.
+type Guard struct {
+	adminRoles []string
+}
+
+// NewGuard returns nil if protection is disabled.
+func NewGuard(cfg Config) *Guard {
+	if !cfg.Protect {
+		return nil
+	}
+	roles := cfg.AdminRoles
+	if len(roles) == 0 {
+		roles = []string{defaultAdminRole}
+	}
+	return &Guard{adminRoles: roles}
+}
+
+// OwnerOf returns the principal of the request, who owns resources created by the request. It's empty if
+// the request isn't authenticated.
+func OwnerOf(ctx context.Context) string {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		return p.Name
+	}
+	return ""
+}
+
+// Check returns ErrPermissionDenied if the principal of the request is neither the owner nor an admin.
+// Resources without owners, e.g. created before authentication was enabled, aren't protected.
+func (g *Guard) Check(ctx context.Context, kind, name, owner string) error {
+	if g == nil || owner == "" {
+		return nil
+	}
+	p := auth.PrincipalFromContext(ctx)
+	if p != nil {
+		if p.Name == owner {
+			return nil
+		}
+		for _, role := range g.adminRoles {
+			if p.HasRole(role) {
+				return nil
+			}
+		}
+	}
+	return errors.ErrPermissionDenied.WithMessage(
+		fmt.Sprintf("the %s %s is owned by %s, only the owner or admins can modify it", kind, name, owner))
+}
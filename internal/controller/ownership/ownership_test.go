@@ -0,0 +1,59 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ownership
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestGuard_Check(t *testing.T) {
+	Convey("test guard check", t, func() {
+		ctx := context.Background()
+		alice := auth.WithPrincipal(ctx, &auth.Principal{Name: "alice"})
+		bob := auth.WithPrincipal(ctx, &auth.Principal{Name: "bob"})
+		admin := auth.WithPrincipal(ctx, &auth.Principal{Name: "carol", Roles: []string{"ops", "admin"}})
+		claimed := metadata.NewIncomingContext(ctx, metadata.Pairs(
+			auth.PrincipalHeader, "carol", auth.RolesHeader, "admin"))
+
+		Convey("protection disabled", func() {
+			g := NewGuard(Config{})
+			So(g, ShouldBeNil)
+			So(g.Check(bob, "subscription", "s", "alice"), ShouldBeNil)
+		})
+
+		Convey("protection enabled", func() {
+			g := NewGuard(Config{Protect: true})
+			So(OwnerOf(alice), ShouldEqual, "alice")
+			So(OwnerOf(admin), ShouldEqual, "carol")
+			So(OwnerOf(ctx), ShouldBeEmpty)
+
+			So(g.Check(alice, "subscription", "s", "alice"), ShouldBeNil)
+			So(g.Check(admin, "subscription", "s", "alice"), ShouldBeNil)
+			So(errors.Is(g.Check(bob, "subscription", "s", "alice"), errors.ErrPermissionDenied), ShouldBeTrue)
+			So(errors.Is(g.Check(ctx, "subscription", "s", "alice"), errors.ErrPermissionDenied), ShouldBeTrue)
+			// principals claimed by unverified headers are anonymous.
+			So(OwnerOf(claimed), ShouldBeEmpty)
+			So(errors.Is(g.Check(claimed, "subscription", "s", "alice"), errors.ErrPermissionDenied), ShouldBeTrue)
+			// resources without owners aren't protected.
+			So(g.Check(ctx, "subscription", "s", ""), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,199 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	optionspb "github.com/linkall-labs/vanus/proto/pkg/options"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// idleTimeout is how long the limiter of a principal is kept after its last request, its bucket is full
+	// again by then.
+	idleTimeout   = 10 * time.Minute
+	sweepInterval = time.Minute
+	unknownPeer   = "unknown"
+)
+
+// adminMethods are methods marked by the admin option in proto definitions, they are throttled, others are
+// called by stores, trigger workers and clients on the data path.
+var adminMethods = adminMethodsOf(ctrlpb.File_controller_proto)
+
+// adminMethodsOf returns full names of admin methods of services in the file, which are the same as
+// grpc.UnaryServerInfo.FullMethod.
+func adminMethodsOf(fd protoreflect.FileDescriptor) map[string]bool {
+	methods := make(map[string]bool)
+	for i := 0; i < fd.Services().Len(); i++ {
+		sd := fd.Services().Get(i)
+		for j := 0; j < sd.Methods().Len(); j++ {
+			md := sd.Methods().Get(j)
+			if admin, _ := proto.GetExtension(md.Options(), optionspb.E_Admin).(bool); admin {
+				methods[fmt.Sprintf("/%s/%s", sd.FullName(), md.Name())] = true
+			}
+		}
+	}
+	return methods
+}
+
+type Quota struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	// Burst is twice RequestsPerSecond by default.
+	Burst int `yaml:"burst"`
+}
+
+type Config struct {
+	// Default is the quota of each principal on each admin API, throttling is disabled if it's 0.
+	Default Quota `yaml:"default"`
+	// APIs overrides the default quota by method names, e.g. CreateSubscription.
+	APIs map[string]Quota `yaml:"apis"`
+}
+
+func (q Quota) limit() (rate.Limit, int) {
+	burst := q.Burst
+	if burst <= 0 {
+		burst = int(2 * q.RequestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+	return rate.Limit(q.RequestsPerSecond), burst
+}
+
+type limiterKey struct {
+	principal string
+	method    string
+}
+
+type entry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter throttles requests to admin APIs by principals. The principal is the one authenticated by the
+// gateway, or the address of the peer for requests not sent by the gateway.
+type Limiter struct {
+	cfg      Config
+	mutex    sync.Mutex
+	limiters map[limiterKey]*entry
+	sweptAt  time.Time
+}
+
+// NewLimiter returns nil if throttling is disabled.
+func NewLimiter(cfg Config) *Limiter {
+	if cfg.Default.RequestsPerSecond <= 0 && len(cfg.APIs) == 0 {
+		return nil
+	}
+	return &Limiter{
+		cfg:      cfg,
+		limiters: make(map[limiterKey]*entry),
+	}
+}
+
+func (l *Limiter) quota(method string) Quota {
+	name := method[strings.LastIndex(method, "/")+1:]
+	if q, ok := l.cfg.APIs[name]; ok {
+		return q
+	}
+	return l.cfg.Default
+}
+
+// Allow returns ErrTooManyRequests if the principal runs out of the quota of the method.
+func (l *Limiter) Allow(principal, method string, now time.Time) error {
+	if !adminMethods[method] {
+		return nil
+	}
+	q := l.quota(method)
+	if q.RequestsPerSecond <= 0 {
+		return nil
+	}
+	l.mutex.Lock()
+	l.sweep(now)
+	key := limiterKey{principal: principal, method: method}
+	e, ok := l.limiters[key]
+	if !ok {
+		e = &entry{limiter: rate.NewLimiter(q.limit())}
+		l.limiters[key] = e
+	}
+	e.lastSeen = now
+	l.mutex.Unlock()
+
+	if e.limiter.AllowN(now, 1) {
+		return nil
+	}
+	metrics.ThrottledRequestCounter.WithLabelValues(method).Inc()
+	return errors.ErrTooManyRequests.WithMessage(fmt.Sprintf(
+		"%s exceeds the quota of %g requests per second", principal, q.RequestsPerSecond))
+}
+
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < sweepInterval {
+		return
+	}
+	l.sweptAt = now
+	for key, e := range l.limiters {
+		if now.Sub(e.lastSeen) > idleTimeout {
+			delete(l.limiters, key)
+		}
+	}
+}
+
+// UnaryServerInterceptor passes all requests through if l is nil.
+func UnaryServerInterceptor(l *Limiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if l == nil {
+			return handler(ctx, req)
+		}
+		principal := principalOf(ctx)
+		if err := l.Allow(principal, info.FullMethod, time.Now()); err != nil {
+			log.Warning(ctx, "request is throttled", map[string]interface{}{
+				log.KeyError: err,
+				"principal":  principal,
+				"method":     info.FullMethod,
+			})
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func principalOf(ctx context.Context) string {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		return p.Name
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return unknownPeer
+}
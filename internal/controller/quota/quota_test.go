@@ -0,0 +1,128 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package quota
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	createSubscription = "/linkall.vanus.controller.TriggerController/CreateSubscription"
+	listSubscription   = "/linkall.vanus.controller.TriggerController/ListSubscription"
+	heartbeat          = "/linkall.vanus.controller.TriggerController/TriggerWorkerHeartbeat"
+)
+
+func TestLimiter_Allow(t *testing.T) {
+	Convey("test limiter allow", t, func() {
+		So(NewLimiter(Config{}), ShouldBeNil)
+
+		l := NewLimiter(Config{
+			Default: Quota{RequestsPerSecond: 1, Burst: 2},
+			APIs:    map[string]Quota{"ListSubscription": {RequestsPerSecond: 10}},
+		})
+		now := time.Now()
+
+		Convey("throttle by principals", func() {
+			So(l.Allow("alice", createSubscription, now), ShouldBeNil)
+			So(l.Allow("alice", createSubscription, now), ShouldBeNil)
+			err := l.Allow("alice", createSubscription, now)
+			So(errors.Is(err, errors.ErrTooManyRequests), ShouldBeTrue)
+			So(l.Allow("bob", createSubscription, now), ShouldBeNil)
+			So(l.Allow("alice", createSubscription, now.Add(time.Second)), ShouldBeNil)
+		})
+
+		Convey("override by apis", func() {
+			for i := 0; i < 20; i++ {
+				So(l.Allow("alice", listSubscription, now), ShouldBeNil)
+			}
+			So(l.Allow("alice", listSubscription, now), ShouldNotBeNil)
+		})
+
+		Convey("skip internal apis", func() {
+			for i := 0; i < 10; i++ {
+				So(l.Allow("alice", heartbeat, now), ShouldBeNil)
+			}
+			So(l.limiters, ShouldBeEmpty)
+		})
+
+		Convey("sweep idle limiters", func() {
+			So(l.Allow("alice", createSubscription, now), ShouldBeNil)
+			So(l.Allow("bob", createSubscription, now.Add(idleTimeout)), ShouldBeNil)
+			So(l.limiters, ShouldHaveLength, 2)
+			So(l.Allow("bob", createSubscription, now.Add(idleTimeout+sweepInterval)), ShouldBeNil)
+			So(l.limiters, ShouldHaveLength, 1)
+		})
+	})
+}
+
+func TestAdminMethods(t *testing.T) {
+	Convey("test admin methods", t, func() {
+		So(adminMethods[createSubscription], ShouldBeTrue)
+		So(adminMethods[listSubscription], ShouldBeTrue)
+		So(adminMethods["/linkall.vanus.controller.EventBusController/CreateEventBus"], ShouldBeTrue)
+		So(adminMethods["/linkall.vanus.controller.UsageController/ListTopUsage"], ShouldBeTrue)
+		So(adminMethods[heartbeat], ShouldBeFalse)
+		So(adminMethods["/linkall.vanus.controller.EventBusController/GetEventBus"], ShouldBeFalse)
+		So(adminMethods["/linkall.vanus.controller.TriggerController/CommitOffset"], ShouldBeFalse)
+	})
+}
+
+func TestPrincipalOf(t *testing.T) {
+	Convey("test principal of requests", t, func() {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 3000},
+		})
+		So(principalOf(ctx), ShouldEqual, "10.0.0.1")
+
+		// principals claimed by headers aren't trusted unless they are verified by the interceptor.
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(auth.PrincipalHeader, "alice"))
+		So(principalOf(ctx), ShouldEqual, "10.0.0.1")
+
+		ctx = auth.WithPrincipal(ctx, &auth.Principal{Name: "bob"})
+		So(principalOf(ctx), ShouldEqual, "bob")
+
+		So(principalOf(context.Background()), ShouldEqual, unknownPeer)
+	})
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	Convey("test unary server interceptor", t, func() {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: createSubscription}
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{Name: "alice"})
+
+		resp, err := UnaryServerInterceptor(nil)(ctx, nil, info, handler)
+		So(err, ShouldBeNil)
+		So(resp, ShouldEqual, "ok")
+
+		interceptor := UnaryServerInterceptor(NewLimiter(Config{Default: Quota{RequestsPerSecond: 1, Burst: 1}}))
+		_, err = interceptor(ctx, nil, info, handler)
+		So(err, ShouldBeNil)
+		_, err = interceptor(ctx, nil, info, handler)
+		So(errors.Is(err, errors.ErrTooManyRequests), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,463 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slo
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/latency"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Kind string
+
+const (
+	// KindPublish is the latency from receiving events by a gateway to appending them.
+	KindPublish Kind = "publish"
+	// KindDelivery is the latency from appending events to delivering them to the sink.
+	KindDelivery Kind = "delivery"
+)
+
+const (
+	EventTypeBurning   = "com.linkall.vanus.slo.burning"
+	EventTypeRecovered = "com.linkall.vanus.slo.recovered"
+	eventSource        = "https://linkall.com/vanus"
+
+	defaultBurnRate   = 14.4
+	defaultLongWindow = time.Hour
+	// shortWindowRatio is the ratio of the long window to the short one, the short window lets alerts
+	// recover soon after bad events stop.
+	shortWindowRatio = 12
+	slotDuration     = time.Minute
+	queueSize        = 1024
+
+	windowShort = "short"
+	windowLong  = "long"
+)
+
+var (
+	defaultPublish  = Objective{Latency: 100 * time.Millisecond, Target: 0.99}
+	defaultDelivery = Objective{Latency: time.Second, Target: 0.99}
+)
+
+type Objective struct {
+	// Latency is the threshold of good events. It should be one of latency.Bounds, since events in the
+	// bucket straddling it are counted as bad.
+	Latency time.Duration `yaml:"latency" json:"latency"`
+	// Target is the expected ratio of good events, e.g. 0.99.
+	Target float64 `yaml:"target" json:"target"`
+}
+
+func (o Objective) valid() bool {
+	return o.Latency > 0 && o.Target > 0 && o.Target < 1
+}
+
+type Config struct {
+	// Enable emits alert events to the system eventbus __slo_eb, burn rates are computed anyway.
+	Enable   bool      `yaml:"enable"`
+	Publish  Objective `yaml:"publish"`
+	Delivery Objective `yaml:"delivery"`
+	// Eventbuses overrides Publish by names of eventbuses.
+	Eventbuses map[string]Objective `yaml:"eventbuses"`
+	// Subscriptions overrides Delivery by IDs of subscriptions.
+	Subscriptions map[string]Objective `yaml:"subscriptions"`
+	// BurnRate is the threshold of burn rates over both windows to alert. The default 14.4 spends 2% of
+	// a 30-day error budget in an hour.
+	BurnRate float64 `yaml:"burn_rate"`
+	// LongWindow is 1h by default, the short window is 1/12 of it.
+	LongWindow time.Duration `yaml:"long_window"`
+}
+
+func (c Config) objective(kind Kind, resource string) Objective {
+	switch kind {
+	case KindPublish:
+		if o, ok := c.Eventbuses[resource]; ok && o.valid() {
+			return o
+		}
+		return c.Publish
+	case KindDelivery:
+		if o, ok := c.Subscriptions[resource]; ok && o.valid() {
+			return o
+		}
+		return c.Delivery
+	}
+	return Objective{}
+}
+
+// Status is the data of alert events, and the result of listing SLOs.
+type Status struct {
+	Kind Kind `json:"kind"`
+	// Resource is the name of the eventbus or the ID of the subscription.
+	Resource  string    `json:"resource"`
+	Objective Objective `json:"objective"`
+	// Total and Bad are the number of events over the long window.
+	Total         uint64        `json:"total"`
+	Bad           uint64        `json:"bad"`
+	P99           time.Duration `json:"p99"`
+	ShortBurnRate float64       `json:"short_burn_rate"`
+	LongBurnRate  float64       `json:"long_burn_rate"`
+	Burning       bool          `json:"burning"`
+	Time          time.Time     `json:"time"`
+}
+
+type seriesKey struct {
+	kind     Kind
+	resource string
+}
+
+type slot struct {
+	start  time.Time
+	counts latency.Histogram
+}
+
+type source struct {
+	last latency.Histogram
+	at   time.Time
+}
+
+type series struct {
+	// sources are cumulative counts last reported by each reporter, e.g. gateways or trigger workers.
+	sources map[string]*source
+	// slots are counts added in each minute, ordered by start.
+	slots    []slot
+	burning  bool
+	lastSeen time.Time
+}
+
+// add counts events reported at into the slot of that minute.
+func (s *series) add(delta latency.Histogram, at time.Time) {
+	start := at.Truncate(slotDuration)
+	n := len(s.slots)
+	if n == 0 || s.slots[n-1].start.Before(start) {
+		s.slots = append(s.slots, slot{start: start, counts: make(latency.Histogram, len(delta))})
+		n++
+	}
+	s.slots[n-1].counts.Add(delta)
+}
+
+// sum returns counts of slots which overlap [since, now].
+func (s *series) sum(since time.Time) latency.Histogram {
+	var h latency.Histogram
+	for i := len(s.slots) - 1; i >= 0 && s.slots[i].start.Add(slotDuration).After(since); i-- {
+		if h == nil {
+			h = make(latency.Histogram, len(s.slots[i].counts))
+		}
+		h.Add(s.slots[i].counts)
+	}
+	return h
+}
+
+func (s *series) trim(since time.Time) {
+	i := 0
+	for i < len(s.slots) && !s.slots[i].start.Add(slotDuration).After(since) {
+		i++
+	}
+	if i > 0 {
+		s.slots = append(s.slots[:0], s.slots[i:]...)
+	}
+}
+
+type writeFunc func(ctx context.Context, events []*ce.Event) error
+
+// Tracker computes burn rates of latency SLOs from cumulative histograms reported to the controller, and
+// emits alert events when error budgets burn too fast over both the short and the long window.
+type Tracker struct {
+	cfg   Config
+	write writeFunc
+
+	series    map[seriesKey]*series
+	lastSwept time.Time
+	mutex     sync.Mutex
+
+	queue  chan *Status
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewTracker(cfg Config, ctrlAddrs []string) *Tracker {
+	w := &busWriter{
+		cl:     cluster.NewClusterController(ctrlAddrs, insecure.NewCredentials()),
+		client: eb.Connect(ctrlAddrs),
+	}
+	return newTracker(cfg, w.write)
+}
+
+func newTracker(cfg Config, write writeFunc) *Tracker {
+	if !cfg.Publish.valid() {
+		cfg.Publish = defaultPublish
+	}
+	if !cfg.Delivery.valid() {
+		cfg.Delivery = defaultDelivery
+	}
+	if cfg.BurnRate <= 0 {
+		cfg.BurnRate = defaultBurnRate
+	}
+	if cfg.LongWindow < shortWindowRatio*slotDuration {
+		cfg.LongWindow = defaultLongWindow
+	}
+	return &Tracker{
+		cfg:    cfg,
+		write:  write,
+		series: make(map[seriesKey]*series),
+		queue:  make(chan *Status, queueSize),
+		cancel: func() {},
+	}
+}
+
+// Observe records cumulative counts of the resource reported by source. Counts first reported by a
+// source only set its baseline, since they may have been counted long ago, e.g. by a reporter
+// which was reporting to the previous leader.
+func (t *Tracker) Observe(ctx context.Context, kind Kind, resource, src string, counts latency.Histogram,
+	at time.Time) {
+	if !counts.Valid() {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.sweep(at)
+	k := seriesKey{kind: kind, resource: resource}
+	s, exist := t.series[k]
+	if !exist {
+		s = &series{sources: map[string]*source{}}
+		t.series[k] = s
+	}
+	s.lastSeen = at
+	prev, exist := s.sources[src]
+	if !exist {
+		s.sources[src] = &source{last: counts, at: at}
+		return
+	}
+	if !at.After(prev.at) {
+		return
+	}
+	s.add(counts.Sub(prev.last), at)
+	prev.last, prev.at = counts, at
+	s.trim(at.Add(-t.cfg.LongWindow))
+
+	st := t.evaluate(k, s, at)
+	metrics.SLOBurnRateGauge.WithLabelValues(string(kind), resource, windowShort).Set(st.ShortBurnRate)
+	metrics.SLOBurnRateGauge.WithLabelValues(string(kind), resource, windowLong).Set(st.LongBurnRate)
+	metrics.SLOP99LatencyGauge.WithLabelValues(string(kind), resource).Set(st.P99.Seconds())
+	if st.Burning != s.burning {
+		s.burning = st.Burning
+		t.emit(ctx, &st)
+	}
+}
+
+func (t *Tracker) evaluate(k seriesKey, s *series, now time.Time) Status {
+	o := t.cfg.objective(k.kind, k.resource)
+	long := s.sum(now.Add(-t.cfg.LongWindow))
+	short := s.sum(now.Add(-t.cfg.LongWindow / shortWindowRatio))
+	st := Status{
+		Kind:          k.kind,
+		Resource:      k.resource,
+		Objective:     o,
+		Total:         long.Total(),
+		Bad:           long.Above(o.Latency),
+		P99:           long.Quantile(0.99),
+		ShortBurnRate: burnRate(short, o),
+		LongBurnRate:  burnRate(long, o),
+		Time:          now,
+	}
+	st.Burning = st.ShortBurnRate > t.cfg.BurnRate && st.LongBurnRate > t.cfg.BurnRate
+	return st
+}
+
+// burnRate returns the ratio of bad events to the error budget, 1 spends the budget exactly in the
+// period of the SLO.
+func burnRate(h latency.Histogram, o Objective) float64 {
+	total := h.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(h.Above(o.Latency)) / float64(total) / (1 - o.Target)
+}
+
+// List returns statuses of resources of the kind ordered by resources, or only the status of resource
+// if it isn't empty.
+func (t *Tracker) List(kind Kind, resource string, now time.Time) []Status {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	result := make([]Status, 0)
+	for k, s := range t.series {
+		if k.kind != kind || (resource != "" && k.resource != resource) {
+			continue
+		}
+		result = append(result, t.evaluate(k, s, now))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Resource < result[j].Resource
+	})
+	return result
+}
+
+// ToPb converts statuses to the response of listing SLOs.
+func ToPb(statuses []Status) *ctrlpb.ListSLOResponse {
+	resp := &ctrlpb.ListSLOResponse{Statuses: make([]*ctrlpb.SLOStatus, 0, len(statuses))}
+	for _, st := range statuses {
+		resp.Statuses = append(resp.Statuses, &ctrlpb.SLOStatus{
+			Kind:               string(st.Kind),
+			Resource:           st.Resource,
+			ObjectiveLatencyMs: uint64(st.Objective.Latency.Milliseconds()),
+			ObjectiveTarget:    st.Objective.Target,
+			TotalEvents:        st.Total,
+			BadEvents:          st.Bad,
+			P99LatencyMs:       float64(st.P99) / float64(time.Millisecond),
+			ShortBurnRate:      st.ShortBurnRate,
+			LongBurnRate:       st.LongBurnRate,
+			Burning:            st.Burning,
+		})
+	}
+	return resp
+}
+
+// sweep forgets reporters and series which haven't been observed over the long window, e.g. the gateway
+// was scaled in or the subscription was deleted.
+func (t *Tracker) sweep(now time.Time) {
+	if now.Sub(t.lastSwept) < slotDuration {
+		return
+	}
+	t.lastSwept = now
+	since := now.Add(-t.cfg.LongWindow)
+	for k, s := range t.series {
+		if s.lastSeen.Before(since) {
+			delete(t.series, k)
+			metrics.SLOBurnRateGauge.DeleteLabelValues(string(k.kind), k.resource, windowShort)
+			metrics.SLOBurnRateGauge.DeleteLabelValues(string(k.kind), k.resource, windowLong)
+			metrics.SLOP99LatencyGauge.DeleteLabelValues(string(k.kind), k.resource)
+			continue
+		}
+		for name, src := range s.sources {
+			if src.at.Before(since) {
+				delete(s.sources, name)
+			}
+		}
+	}
+}
+
+func (t *Tracker) emit(ctx context.Context, st *Status) {
+	log.Info(ctx, "the burning of error budget changed", map[string]interface{}{
+		"kind":            st.Kind,
+		"resource":        st.Resource,
+		"short_burn_rate": st.ShortBurnRate,
+		"long_burn_rate":  st.LongBurnRate,
+		"burning":         st.Burning,
+	})
+	if !t.cfg.Enable {
+		return
+	}
+	select {
+	case t.queue <- st:
+	default:
+		log.Warning(ctx, "the queue of slo alert events is full, drop it", map[string]interface{}{
+			"kind":     st.Kind,
+			"resource": st.Resource,
+		})
+	}
+}
+
+// Start writes alert events in background until Stop is called.
+func (t *Tracker) Start(ctx context.Context) {
+	if !t.cfg.Enable {
+		return
+	}
+	ctx, t.cancel = context.WithCancel(ctx)
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case st := <-t.queue:
+				t.flush(ctx, st)
+			}
+		}
+	}()
+}
+
+func (t *Tracker) Stop() {
+	t.cancel()
+	t.wg.Wait()
+}
+
+// flush writes st and statuses queued behind it, they are dropped if failed like anomaly events.
+func (t *Tracker) flush(ctx context.Context, st *Status) {
+	events := []*ce.Event{toEvent(st)}
+	for drained := false; !drained && len(events) < queueSize; {
+		select {
+		case next := <-t.queue:
+			events = append(events, toEvent(next))
+		default:
+			drained = true
+		}
+	}
+	if err := t.write(ctx, events); err != nil {
+		log.Warning(ctx, "failed to write slo alert events", map[string]interface{}{
+			log.KeyError: err,
+			"count":      len(events),
+		})
+		return
+	}
+	for _, e := range events {
+		metrics.SLOAlertEventCounter.WithLabelValues(e.Type()).Inc()
+	}
+}
+
+func toEvent(st *Status) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID(uuid.NewString())
+	if st.Burning {
+		e.SetType(EventTypeBurning)
+	} else {
+		e.SetType(EventTypeRecovered)
+	}
+	e.SetSource(eventSource)
+	e.SetSubject(st.Resource)
+	e.SetTime(st.Time)
+	_ = e.SetData(ce.ApplicationJSON, st)
+	return &e
+}
+
+type busWriter struct {
+	cl     cluster.Cluster
+	client eb.Client
+	writer api.BusWriter
+}
+
+func (w *busWriter) write(ctx context.Context, events []*ce.Event) error {
+	if w.writer == nil {
+		if err := w.cl.EventbusService().CreateSystemEventbusIfNotExist(ctx, primitive.SLOEventbusName,
+			"System Eventbus For SLO Alert Events"); err != nil {
+			return err
+		}
+		w.writer = w.client.Eventbus(ctx, primitive.SLOEventbusName).Writer()
+	}
+	_, err := w.writer.AppendMany(ctx, events)
+	return err
+}
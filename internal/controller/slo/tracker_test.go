@@ -0,0 +1,114 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/latency"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTracker_Observe(t *testing.T) {
+	Convey("test tracker observe", t, func() {
+		ctx := context.Background()
+		tr := newTracker(Config{
+			Enable: true,
+			Eventbuses: map[string]Objective{
+				"strict": {Latency: 5 * time.Millisecond, Target: 0.999},
+			},
+		}, func(ctx context.Context, events []*ce.Event) error {
+			return nil
+		})
+		now := time.Now().Truncate(time.Minute)
+
+		// hist returns counts of good events within 5ms and events failed.
+		hist := func(good, failed uint64) latency.Histogram {
+			h := make(latency.Histogram, latency.Overflow+1)
+			h[0] = good
+			h[latency.Overflow] = failed
+			return h
+		}
+		drain := func() []*Status {
+			var result []*Status
+			for {
+				select {
+				case st := <-tr.queue:
+					result = append(result, st)
+				default:
+					return result
+				}
+			}
+		}
+
+		Convey("burn and recover", func() {
+			tr.Observe(ctx, KindPublish, "bus", "gw-1", hist(0, 0), now)
+			tr.Observe(ctx, KindPublish, "bus", "gw-1", hist(1000, 0), now.Add(10*time.Second))
+			So(drain(), ShouldBeEmpty)
+
+			tr.Observe(ctx, KindPublish, "bus", "gw-1", hist(1500, 500), now.Add(20*time.Second))
+			statuses := drain()
+			So(statuses, ShouldHaveLength, 1)
+			So(statuses[0].Burning, ShouldBeTrue)
+			So(statuses[0].LongBurnRate, ShouldAlmostEqual, 25)
+
+			// bad events slide out of the short window.
+			tr.Observe(ctx, KindPublish, "bus", "gw-1", hist(101500, 500), now.Add(6*time.Minute))
+			statuses = drain()
+			So(statuses, ShouldHaveLength, 1)
+			So(statuses[0].Burning, ShouldBeFalse)
+			So(statuses[0].ShortBurnRate, ShouldEqual, 0)
+
+			list := tr.List(KindPublish, "", now.Add(6*time.Minute))
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Total, ShouldEqual, 102000)
+			So(list[0].Bad, ShouldEqual, 500)
+			So(tr.List(KindDelivery, "", now), ShouldBeEmpty)
+		})
+
+		Convey("sum sources and reset", func() {
+			tr.Observe(ctx, KindDelivery, "sub", "worker-1", hist(50, 0), now)
+			tr.Observe(ctx, KindDelivery, "sub", "worker-2", hist(70, 0), now)
+			tr.Observe(ctx, KindDelivery, "sub", "worker-1", hist(150, 0), now.Add(10*time.Second))
+			tr.Observe(ctx, KindDelivery, "sub", "worker-2", hist(170, 0), now.Add(10*time.Second))
+			// worker-1 restarted.
+			tr.Observe(ctx, KindDelivery, "sub", "worker-1", hist(10, 0), now.Add(20*time.Second))
+
+			list := tr.List(KindDelivery, "sub", now.Add(20*time.Second))
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Total, ShouldEqual, 210)
+			So(list[0].Objective, ShouldResemble, defaultDelivery)
+		})
+
+		Convey("override objectives", func() {
+			tr.Observe(ctx, KindPublish, "strict", "gw-1", hist(0, 0), now)
+			tr.Observe(ctx, KindPublish, "strict", "gw-1", hist(100, 0), now.Add(10*time.Second))
+			list := tr.List(KindPublish, "strict", now.Add(10*time.Second))
+			So(list, ShouldHaveLength, 1)
+			So(list[0].Objective.Target, ShouldEqual, 0.999)
+		})
+
+		Convey("forget idle series", func() {
+			tr.Observe(ctx, KindPublish, "bus", "gw-1", hist(0, 0), now)
+			tr.Observe(ctx, KindPublish, "other", "gw-1", hist(0, 0), now)
+			So(tr.series, ShouldHaveLength, 2)
+			tr.Observe(ctx, KindPublish, "other", "gw-1", hist(0, 0), now.Add(2*time.Hour))
+			So(tr.series, ShouldHaveLength, 1)
+		})
+	})
+}
@@ -15,6 +15,11 @@
 package trigger
 
 import (
+	"github.com/linkall-labs/vanus/internal/controller/anomaly"
+	"github.com/linkall-labs/vanus/internal/controller/ownership"
+	"github.com/linkall-labs/vanus/internal/controller/slo"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/namespace"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
 	"github.com/linkall-labs/vanus/internal/primitive"
 )
 
@@ -25,4 +30,14 @@ type Config struct {
 	SecretEncryptionSalt string
 
 	ControllerAddr []string
+
+	Anomaly anomaly.Config
+
+	WorkerAdmission worker.AdmissionConfig
+
+	Ownership ownership.Config
+
+	Namespace namespace.Config
+
+	SLO slo.Config
 }
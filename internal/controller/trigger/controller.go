@@ -16,29 +16,40 @@ package trigger
 
 import (
 	"context"
+	"encoding/json"
 	stdErr "errors"
 	"fmt"
 	"io"
 	"os"
+	"reflect"
+	"sort"
 	"sync"
 	"time"
 
 	embedetcd "github.com/linkall-labs/embed-etcd"
 	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/internal/controller/anomaly"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/internal/controller/ownership"
+	"github.com/linkall-labs/vanus/internal/controller/slo"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/namespace"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/storage"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/validation"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
 	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/kv"
+	"github.com/linkall-labs/vanus/internal/kv/etcd"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
-	"github.com/linkall-labs/vanus/pkg/util"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
 	"google.golang.org/grpc/credentials/insecure"
@@ -50,39 +61,74 @@ var (
 )
 
 const (
-	defaultGcSubscriptionInterval = time.Second * 10
+	jobKindGcSubscription job.Kind = "subscription-gc"
+	// jobKindPrefetchOffsets prefetches events after offsets which a subscription is reset to.
+	jobKindPrefetchOffsets job.Kind = "subscription-prefetch"
+
+	jobParamSubscriptionID = "subscription_id"
+	jobParamTriggerWorker  = "trigger_worker"
+	jobParamOffsets        = "offsets"
+	prefetchRetries        = 3
+
+	defaultStreamBatchSize = 100
+	maximumStreamBatchSize = 1000
+
+	prefetchTimeout = 10 * time.Second
 )
 
-func NewController(config Config, member embedetcd.Member) *controller {
+func NewController(config Config, member embedetcd.Member, usageStore *usage.Store) *controller {
 	ctrl := &controller{
-		config:                config,
-		member:                member,
-		needCleanSubscription: map[vanus.ID]string{},
-		state:                 primitive.ServerStateCreated,
-		cl:                    cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
-		ebClient:              eb.Connect(config.ControllerAddr),
+		config:   config,
+		member:   member,
+		jobMgr:   job.NewManager("trigger"),
+		state:    primitive.ServerStateCreated,
+		cl:       cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
+		ebClient: eb.Connect(config.ControllerAddr),
+		detector: anomaly.NewDetector(config.Anomaly, config.ControllerAddr),
+		guard:    ownership.NewGuard(config.Ownership),
+		slo:      slo.NewTracker(config.SLO, config.ControllerAddr),
+		usage:    usageStore,
 	}
 	ctrl.ctx, ctrl.stopFunc = context.WithCancel(context.Background())
+	ctrl.jobMgr.Register(jobKindGcSubscription, ctrl.gcSubscriptionJob)
+	ctrl.jobMgr.Register(jobKindRebalance, ctrl.rebalanceJob)
+	ctrl.jobMgr.Register(jobKindPrefetchOffsets, ctrl.prefetchOffsetsJob)
 	return ctrl
 }
 
 type controller struct {
-	config                Config
-	member                embedetcd.Member
-	storage               storage.Storage
-	secretStorage         secret.Storage
-	subscriptionManager   subscription.Manager
-	workerManager         worker.Manager
-	scheduler             *worker.SubscriptionScheduler
-	needCleanSubscription map[vanus.ID]string
-	lock                  sync.Mutex
-	membershipMutex       sync.Mutex
-	isLeader              bool
-	ctx                   context.Context
-	stopFunc              context.CancelFunc
-	state                 primitive.ServerState
-	cl                    cluster.Cluster
-	ebClient              eb.Client
+	config              Config
+	member              embedetcd.Member
+	storage             storage.Storage
+	secretStorage       secret.Storage
+	kvClient            kv.Client
+	subscriptionManager subscription.Manager
+	workerManager       worker.Manager
+	scheduler           *worker.SubscriptionScheduler
+	jobMgr              job.Manager
+	membershipMutex     sync.Mutex
+	isLeader            bool
+	ctx                 context.Context
+	stopFunc            context.CancelFunc
+	state               primitive.ServerState
+	cl                  cluster.Cluster
+	ebClient            eb.Client
+	detector            *anomaly.Detector
+	guard               *ownership.Guard
+	// quotaMutex serializes checking quotas of namespaces and adding subscriptions to them.
+	quotaMutex sync.Mutex
+	// slo tracks delivery latencies reported by trigger workers.
+	slo *slo.Tracker
+	// rebalancePlans wait for approval.
+	rebalancePlans rebalancePlans
+	// usage is shared with the eventbus controller, which reports ends of eventlogs that lags are
+	// computed against.
+	usage *usage.Store
+}
+
+// JobManager returns the manager of jobs which run by the trigger controller.
+func (ctrl *controller) JobManager() job.Manager {
+	return ctrl.jobMgr
 }
 
 func (ctrl *controller) CommitOffset(ctx context.Context,
@@ -122,6 +168,9 @@ func (ctrl *controller) ResetOffsetToTimestamp(ctx context.Context,
 	if sub == nil {
 		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
 	}
+	if err := ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
 	if sub.Phase != metadata.SubscriptionPhaseStopped {
 		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription must be disable can reset offset")
 	}
@@ -129,11 +178,205 @@ func (ctrl *controller) ResetOffsetToTimestamp(ctx context.Context,
 	if err != nil {
 		return nil, errors.ErrInternal.WithMessage("reset offset by timestamp error").Wrap(err)
 	}
+	if len(offsets) != 0 {
+		// Prefetching is best effort, the offset is reset even if it isn't submitted.
+		if err = ctrl.submitPrefetchOffsets(ctx, subID, request.Timestamp, offsets); err != nil {
+			log.Warning(ctx, "submit prefetching eventlogs for reset offsets failed", map[string]interface{}{
+				log.KeyError:          err,
+				log.KeySubscriptionID: subID,
+			})
+		}
+	}
 	return &ctrlpb.ResetOffsetToTimestampResponse{
 		Offsets: convert.ToPbOffsetInfos(offsets),
 	}, nil
 }
 
+func (ctrl *controller) submitPrefetchOffsets(ctx context.Context, subID vanus.ID, timestamp uint64,
+	offsets info.ListOffsetInfo) error {
+	data, err := json.Marshal(offsets)
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	_, err = ctrl.jobMgr.Submit(ctx, job.Spec{
+		Kind: jobKindPrefetchOffsets,
+		Key:  fmt.Sprintf("%s-%d", subID.Key(), timestamp),
+		Params: map[string]string{
+			jobParamSubscriptionID: subID.Key(),
+			jobParamOffsets:        string(data),
+		},
+		MaxAttempts: prefetchRetries,
+	})
+	return err
+}
+
+// prefetchOffsetsJob asks segment servers to load events after offsets which the subscription is reset to,
+// so that it doesn't wait for disks once it's resumed. Old events are likely evicted from the page cache.
+func (ctrl *controller) prefetchOffsetsJob(ctx context.Context, e *job.Execution) error {
+	subID, err := vanus.NewIDFromString(e.Params()[jobParamSubscriptionID])
+	if err != nil {
+		return err
+	}
+	var offsets info.ListOffsetInfo
+	if err = json.Unmarshal([]byte(e.Params()[jobParamOffsets]), &offsets); err != nil {
+		return errors.ErrJSONUnMarshal.Wrap(err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, prefetchTimeout)
+	defer cancel()
+	res, err := ctrl.cl.EventlogService().RawClient().PrefetchEventlogs(ctx, &ctrlpb.PrefetchEventlogsRequest{
+		Offsets: convert.ToPbOffsetInfos(offsets),
+	})
+	if err != nil {
+		return err
+	}
+	log.Info(ctx, "prefetch eventlogs for reset offsets", map[string]interface{}{
+		log.KeySubscriptionID: subID,
+		"blocks":              res.Blocks,
+		"bytes":               res.Bytes,
+	})
+	return nil
+}
+
+func (ctrl *controller) ExportOffsets(ctx context.Context,
+	request *ctrlpb.ExportOffsetsRequest) (*ctrlpb.ExportOffsetsResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	var subs []*metadata.Subscription
+	if len(request.SubscriptionIds) > 0 {
+		for _, id := range request.SubscriptionIds {
+			sub := ctrl.subscriptionManager.GetSubscription(ctx, vanus.ID(id))
+			if sub == nil {
+				return nil, errors.ErrResourceNotFound.WithMessage(
+					fmt.Sprintf("subscrption %d not exist", vanus.ID(id)))
+			}
+			subs = append(subs, sub)
+		}
+	} else {
+		subs = ctrl.subscriptionManager.ListSubscription(ctx)
+	}
+	exportedAt := time.Now().UnixMilli()
+	resp := new(ctrlpb.ExportOffsetsResponse)
+	for _, sub := range subs {
+		if sub.Phase == metadata.SubscriptionPhaseToDelete ||
+			(request.Eventbus != "" && sub.EventBus != request.Eventbus) {
+			continue
+		}
+		offsets, err := ctrl.subscriptionManager.ExportOffset(ctx, sub.ID)
+		if err != nil {
+			return nil, errors.ErrInternal.WithMessage(
+				fmt.Sprintf("export offsets of subscription %s error", sub.ID)).Wrap(err)
+		}
+		to := &ctrlpb.SubscriptionOffsets{
+			SubscriptionId:   sub.ID.Uint64(),
+			SubscriptionName: sub.Name,
+			Eventbus:         sub.EventBus,
+			ExportedAt:       exportedAt,
+		}
+		for _, o := range offsets {
+			to.Offsets = append(to.Offsets, &ctrlpb.ExportedOffset{
+				EventLogId: o.EventLogID.Uint64(),
+				Offset:     o.Offset,
+				Timestamp:  o.Timestamp,
+			})
+		}
+		resp.Subscriptions = append(resp.Subscriptions, to)
+	}
+	return resp, nil
+}
+
+func (ctrl *controller) ImportOffsets(ctx context.Context,
+	request *ctrlpb.ImportOffsetsRequest) (*ctrlpb.ImportOffsetsResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	resp := new(ctrlpb.ImportOffsetsResponse)
+	for _, exported := range request.Subscriptions {
+		result := &ctrlpb.ImportOffsetsResult{SourceSubscriptionId: exported.SubscriptionId}
+		resp.Results = append(resp.Results, result)
+		offsets, err := ctrl.importOffsets(ctx, exported, request.ByTimestamp, result)
+		if err != nil {
+			result.Error = err.Error()
+			log.Warning(ctx, "import offset error", map[string]interface{}{
+				log.KeyError:          err,
+				log.KeySubscriptionID: vanus.ID(exported.SubscriptionId),
+			})
+			continue
+		}
+		result.Offsets = convert.ToPbOffsetInfos(offsets)
+	}
+	return resp, nil
+}
+
+func (ctrl *controller) importOffsets(ctx context.Context, exported *ctrlpb.SubscriptionOffsets,
+	byTimestamp bool, result *ctrlpb.ImportOffsetsResult) (info.ListOffsetInfo, error) {
+	sub, err := ctrl.findSubscriptionToImport(ctx, exported)
+	if err != nil {
+		return nil, err
+	}
+	result.SubscriptionId = sub.ID.Uint64()
+	if err = ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
+	if sub.Phase != metadata.SubscriptionPhaseStopped {
+		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription must be disable can import offset")
+	}
+	if byTimestamp {
+		return ctrl.subscriptionManager.ResetOffsetByTimestamp(ctx, sub.ID, uint64(remapTimestamp(exported)))
+	}
+	offsets := make(info.ListOffsetInfo, len(exported.Offsets))
+	for i, o := range exported.Offsets {
+		offsets[i] = info.OffsetInfo{EventLogID: vanus.ID(o.EventLogId), Offset: o.Offset}
+	}
+	if err = ctrl.subscriptionManager.ImportOffset(ctx, sub.ID, offsets); err != nil {
+		return nil, err
+	}
+	return offsets, nil
+}
+
+// findSubscriptionToImport finds the subscription with the same id, or the only one with the same name and
+// eventbus, since ids differ in another cluster.
+func (ctrl *controller) findSubscriptionToImport(ctx context.Context,
+	exported *ctrlpb.SubscriptionOffsets) (*metadata.Subscription, error) {
+	if sub := ctrl.subscriptionManager.GetSubscription(ctx, vanus.ID(exported.SubscriptionId)); sub != nil {
+		return sub, nil
+	}
+	if exported.SubscriptionName == "" {
+		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	var found *metadata.Subscription
+	for _, sub := range ctrl.subscriptionManager.ListSubscription(ctx) {
+		if sub.Phase == metadata.SubscriptionPhaseToDelete ||
+			sub.Name != exported.SubscriptionName || sub.EventBus != exported.Eventbus {
+			continue
+		}
+		if found != nil {
+			return nil, errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("more than one subscription named %s in eventbus %s", sub.Name, sub.EventBus))
+		}
+		found = sub
+	}
+	if found == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	return found, nil
+}
+
+// remapTimestamp returns the earliest time of unconsumed events, so no event is skipped after re-mapping,
+// or the time of exporting if all events have been consumed.
+func remapTimestamp(exported *ctrlpb.SubscriptionOffsets) int64 {
+	var ts int64
+	for _, o := range exported.Offsets {
+		if o.Timestamp > 0 && (ts == 0 || o.Timestamp < ts) {
+			ts = o.Timestamp
+		}
+	}
+	if ts == 0 {
+		return exported.ExportedAt
+	}
+	return ts
+}
+
 func (ctrl *controller) CreateSubscription(ctx context.Context,
 	request *ctrlpb.CreateSubscriptionRequest) (*meta.Subscription, error) {
 	if ctrl.state != primitive.ServerStateRunning {
@@ -147,7 +390,20 @@ func (ctrl *controller) CreateSubscription(ctx context.Context,
 		return nil, err
 	}
 	sub := convert.FromPbSubscriptionRequest(request.Subscription)
+	if id := sub.Signing.MaskedKeyID(); id != "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the secret of signing key %s is masked, which doesn't keep any stored secret", id))
+	}
+	if err = ctrl.config.Namespace.Authorize(ctx, namespace.Of(sub)); err != nil {
+		return nil, err
+	}
+	ctrl.quotaMutex.Lock()
+	defer ctrl.quotaMutex.Unlock()
+	if err = ctrl.checkQuota(ctx, sub, vanus.EmptyID()); err != nil {
+		return nil, err
+	}
 	sub.ID, err = vanus.NewID()
+	sub.Owner = ownership.OwnerOf(ctx)
 	sub.CreatedAt = time.Now()
 	sub.UpdatedAt = time.Now()
 	if err != nil {
@@ -179,6 +435,9 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	if sub == nil {
 		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
 	}
+	if err := ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
 	if sub.Phase != metadata.SubscriptionPhaseStopped {
 		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription must be disabled can update")
 	}
@@ -194,16 +453,36 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 		}
 	}
 	update := convert.FromPbSubscriptionRequest(request.Subscription)
+	if namespace.Of(update) != namespace.Of(sub) {
+		return nil, errors.ErrInvalidRequest.WithMessage("can not change namespace")
+	}
+	// Masked secrets keep secrets of stored keys, but a new key must come with its secret.
+	primitive.FillSigningKeys(update.Signing, sub.Signing)
+	if id := update.Signing.MaskedKeyID(); id != "" {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("signing key %s doesn't exist, its secret can't be masked", id))
+	}
+	ctrl.quotaMutex.Lock()
+	defer ctrl.quotaMutex.Unlock()
+	if err := ctrl.checkQuota(ctx, update, sub.ID); err != nil {
+		return nil, err
+	}
 	transChange := 0
 	if !sub.Transformer.Exist() && update.Transformer.Exist() {
 		transChange = 1
 	} else if sub.Transformer.Exist() && !update.Transformer.Exist() {
 		transChange = -1
 	}
+	selectorChanged := !reflect.DeepEqual(sub.NodeSelector, update.NodeSelector)
 	change := sub.Update(update)
 	if !change {
 		return nil, errors.ErrInvalidRequest.WithMessage("no change")
 	}
+	if selectorChanged {
+		if err := ctrl.unassignUnmatchedWorker(ctx, sub); err != nil {
+			return nil, err
+		}
+	}
 	sub.UpdatedAt = time.Now()
 	if err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub); err != nil {
 		return nil, err
@@ -215,6 +494,31 @@ func (ctrl *controller) UpdateSubscription(ctx context.Context,
 	return resp, nil
 }
 
+// unassignUnmatchedWorker unassigns the subscription from its trigger worker if the worker doesn't match
+// the node selector any more, so that the subscription is scheduled on a matched worker once it's resumed.
+func (ctrl *controller) unassignUnmatchedWorker(ctx context.Context, sub *metadata.Subscription) error {
+	if sub.TriggerWorker == "" {
+		return nil
+	}
+	if tWorker := ctrl.workerManager.GetTriggerWorker(sub.TriggerWorker); tWorker != nil {
+		twInfo := tWorker.GetInfo()
+		if twInfo.Match(sub.NodeSelector) {
+			return nil
+		}
+		if err := tWorker.UnAssignSubscription(sub.ID); err != nil {
+			return err
+		}
+	}
+	log.Info(ctx, "unassign subscription from trigger worker which doesn't match node selector", map[string]interface{}{
+		log.KeySubscriptionID:    sub.ID,
+		log.KeyTriggerWorkerAddr: sub.TriggerWorker,
+		"node_selector":          sub.NodeSelector,
+	})
+	metrics.CtrlTriggerGauge.WithLabelValues(sub.TriggerWorker).Dec()
+	sub.TriggerWorker = ""
+	return nil
+}
+
 func (ctrl *controller) DeleteSubscription(ctx context.Context,
 	request *ctrlpb.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
 	if ctrl.state != primitive.ServerStateRunning {
@@ -223,19 +527,17 @@ func (ctrl *controller) DeleteSubscription(ctx context.Context,
 	subID := vanus.ID(request.Id)
 	sub := ctrl.subscriptionManager.GetSubscription(ctx, subID)
 	if sub != nil {
+		if err := ctrl.checkOwner(ctx, sub); err != nil {
+			return nil, err
+		}
 		sub.Phase = metadata.SubscriptionPhaseToDelete
 		err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub)
 		if err != nil {
 			return nil, err
 		}
-		go func(subID vanus.ID, addr string) {
-			err := ctrl.gcSubscription(ctrl.ctx, subID, addr)
-			if err != nil {
-				ctrl.lock.Lock()
-				defer ctrl.lock.Unlock()
-				ctrl.needCleanSubscription[subID] = addr
-			}
-		}(subID, sub.TriggerWorker)
+		if err = ctrl.submitGcSubscription(ctx, sub); err != nil {
+			return nil, err
+		}
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -250,6 +552,9 @@ func (ctrl *controller) DisableSubscription(ctx context.Context,
 	if sub == nil {
 		return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("subscrption %d not exist", subID))
 	}
+	if err := ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
 	if sub.Phase == metadata.SubscriptionPhaseStopped {
 		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription is disable")
 	}
@@ -275,6 +580,9 @@ func (ctrl *controller) ResumeSubscription(ctx context.Context,
 	if sub == nil {
 		return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("subscrption %d not exist", subID))
 	}
+	if err := ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
 	if sub.Phase != metadata.SubscriptionPhaseStopped {
 		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription is not disable")
 	}
@@ -287,6 +595,118 @@ func (ctrl *controller) ResumeSubscription(ctx context.Context,
 	return &emptypb.Empty{}, nil
 }
 
+func (ctrl *controller) ResumeEventlogs(ctx context.Context,
+	request *ctrlpb.ResumeEventlogsRequest) (*ctrlpb.ResumeEventlogsResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	subID := vanus.ID(request.SubscriptionId)
+	sub := ctrl.subscriptionManager.GetSubscription(ctx, subID)
+	if sub == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("subscrption %d not exist", subID))
+	}
+	if err := ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
+	if sub.Phase != metadata.SubscriptionPhaseRunning {
+		return nil, errors.ErrResourceCanNotOp.WithMessage("subscription is not running")
+	}
+	tWorker := ctrl.workerManager.GetTriggerWorker(sub.TriggerWorker)
+	if tWorker == nil {
+		return nil, errors.ErrResourceCanNotOp.WithMessage("trigger worker of subscription not exist")
+	}
+	ids, err := tWorker.ResumeEventlogs(ctx, subID)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "resume eventlogs paused by sequence gaps", map[string]interface{}{
+		log.KeySubscriptionID:    subID,
+		log.KeyTriggerWorkerAddr: sub.TriggerWorker,
+		"eventlogs":              ids,
+	})
+	resp := &ctrlpb.ResumeEventlogsResponse{EventlogIds: make([]uint64, len(ids))}
+	for i, id := range ids {
+		resp.EventlogIds[i] = id.Uint64()
+	}
+	return resp, nil
+}
+
+func (ctrl *controller) AnnotateSubscription(ctx context.Context,
+	request *ctrlpb.AnnotateSubscriptionRequest) (*meta.Subscription, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	subID := vanus.ID(request.Id)
+	sub := ctrl.subscriptionManager.GetSubscription(ctx, subID)
+	if sub == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("subscrption %d not exist", subID))
+	}
+	if err := ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
+	annotations, err := primitive.ApplyAnnotations(sub.Annotations, request.Annotations, request.RemovedKeys)
+	if err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+	// update a copy, the subscription in memory is shared with readers.
+	updated := *sub
+	updated.Annotations = annotations
+	updated.UpdatedAt = time.Now()
+	if err = ctrl.subscriptionManager.UpdateSubscription(ctx, &updated); err != nil {
+		return nil, err
+	}
+	offsets, _ := ctrl.subscriptionManager.GetOffset(ctx, updated.ID)
+	return convert.ToPbSubscription(&updated, offsets), nil
+}
+
+// RotateSigningKey adds and retires signing keys of a running subscription, the trigger worker running it
+// signs events with the rotated keys once it's reassigned.
+func (ctrl *controller) RotateSigningKey(ctx context.Context,
+	request *ctrlpb.RotateSigningKeyRequest) (*meta.Subscription, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if request.NewKey == nil && request.RetiredKeyId == "" {
+		return nil, errors.ErrInvalidRequest.WithMessage("at least one of new key and retired key must be set")
+	}
+	var newKey *primitive.SigningKey
+	if request.NewKey != nil {
+		if err := validation.ValidateSigningKey(ctx, request.NewKey); err != nil {
+			return nil, err
+		}
+		newKey = &primitive.SigningKey{ID: request.NewKey.Id, Secret: request.NewKey.Secret}
+	}
+	subID := vanus.ID(request.Id)
+	sub := ctrl.subscriptionManager.GetSubscription(ctx, subID)
+	if sub == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("subscrption %d not exist", subID))
+	}
+	if err := ctrl.checkOwner(ctx, sub); err != nil {
+		return nil, err
+	}
+	signing, err := primitive.RotateSigningKey(sub.Signing, newKey, request.RetiredKeyId)
+	if err != nil {
+		return nil, errors.ErrInvalidRequest.WithMessage(err.Error())
+	}
+	sub.Signing = signing
+	sub.UpdatedAt = time.Now()
+	if err = ctrl.subscriptionManager.UpdateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	if sub.TriggerWorker != "" && sub.Phase == metadata.SubscriptionPhaseRunning {
+		if tWorker := ctrl.workerManager.GetTriggerWorker(sub.TriggerWorker); tWorker != nil {
+			tWorker.AssignSubscription(sub.ID)
+		}
+	}
+	offsets, _ := ctrl.subscriptionManager.GetOffset(ctx, sub.ID)
+	return convert.ToPbSubscription(sub, offsets), nil
+}
+
+// checkOwner makes sure that the subscription is modified by its owner or admins if protection is enabled.
+func (ctrl *controller) checkOwner(ctx context.Context, sub *metadata.Subscription) error {
+	return ctrl.guard.Check(ctx, "subscription", sub.Name, sub.Owner)
+}
+
 func (ctrl *controller) GetSubscription(ctx context.Context,
 	request *ctrlpb.GetSubscriptionRequest) (*meta.Subscription, error) {
 	if ctrl.state != primitive.ServerStateRunning {
@@ -346,9 +766,29 @@ func (ctrl *controller) triggerWorkerHeartbeatRequest(ctx context.Context,
 				log.KeyTriggerWorkerAddr: req.Address,
 				log.KeySubscriptionID:    subscriptionID,
 			})
+			continue
+		}
+		if sub := ctrl.subscriptionManager.GetSubscription(ctx, subscriptionID); sub != nil {
+			if subInfo.SinkResolution != nil {
+				sub.SinkResolution = convert.FromPbSinkResolution(subInfo.SinkResolution)
+			}
+			sub.DeliveryPhase = primitive.DeliveryPhase(subInfo.DeliveryPhase)
+			sub.DataLosses = convert.FromPbDataLosses(subInfo.DataLosses)
+			crash := convert.FromPbCrashStatus(subInfo.CrashStatus)
+			if crash != nil && crash.CrashLooping && (sub.CrashStatus == nil || !sub.CrashStatus.CrashLooping) {
+				log.Warning(ctx, "the delivery pipeline of subscription is crash looping", map[string]interface{}{
+					log.KeyTriggerWorkerAddr: req.Address,
+					log.KeySubscriptionID:    subscriptionID,
+					"stage":                  crash.LastStage,
+					"panic":                  crash.LastPanic,
+				})
+			}
+			sub.CrashStatus = crash
+			sub.PausedGaps = convert.FromPbSequenceGaps(subInfo.PausedGaps)
 		}
 	}
-	err := ctrl.workerManager.UpdateTriggerWorkerInfo(ctx, req.Address)
+	err := ctrl.workerManager.UpdateTriggerWorkerInfo(ctx, req.Address,
+		fromPbTriggerWorkerResources(req.Resources))
 	if err != nil {
 		log.Info(context.Background(), "unknown trigger worker", map[string]interface{}{
 			log.KeyTriggerWorkerAddr: req.Address,
@@ -356,10 +796,18 @@ func (ctrl *controller) triggerWorkerHeartbeatRequest(ctx context.Context,
 		return errors.ErrResourceNotFound.WithMessage("unknown trigger worker")
 	}
 	for _, subInfo := range req.SubscriptionInfo {
-		if len(subInfo.Offsets) == 0 {
+		ctrl.detector.Observe(ctx, anomaly.MetricDeliveryFailureRate, vanus.ID(subInfo.SubscriptionId).String(),
+			subInfo.FailedEvents, now)
+		ctrl.slo.Observe(ctx, slo.KindDelivery, vanus.ID(subInfo.SubscriptionId).String(), req.Address,
+			subInfo.DeliveryLatencies, now)
+		offsets := convert.FromPbOffsetInfos(subInfo.Offsets)
+		if sub := ctrl.subscriptionManager.GetSubscription(ctx, vanus.ID(subInfo.SubscriptionId)); sub != nil {
+			ctrl.usage.ObserveSubscription(sub.ID, sub.EventBus, subInfo.DeliveredEvents, subInfo.FailedEvents,
+				offsets, now)
+		}
+		if len(offsets) == 0 {
 			continue
 		}
-		offsets := convert.FromPbOffsetInfos(subInfo.Offsets)
 		err = ctrl.subscriptionManager.SaveOffset(ctx, vanus.ID(subInfo.SubscriptionId), offsets, false)
 		if err != nil {
 			log.Warning(ctx, "heartbeat commit offset error", map[string]interface{}{
@@ -375,8 +823,9 @@ func (ctrl *controller) RegisterTriggerWorker(ctx context.Context,
 	request *ctrlpb.RegisterTriggerWorkerRequest) (*ctrlpb.RegisterTriggerWorkerResponse, error) {
 	log.Info(ctx, "register trigger worker", map[string]interface{}{
 		log.KeyTriggerWorkerAddr: request.Address,
+		"labels":                 request.Labels,
 	})
-	err := ctrl.workerManager.AddTriggerWorker(ctx, request.Address)
+	err := ctrl.workerManager.AddTriggerWorker(ctx, request.Address, request.Labels)
 	if err != nil {
 		log.Warning(ctx, "register trigger worker error", map[string]interface{}{
 			"addr":       request.Address,
@@ -408,6 +857,134 @@ func (ctrl *controller) ListSubscription(ctx context.Context,
 	return &ctrlpb.ListSubscriptionResponse{Subscription: list}, nil
 }
 
+// ListSubscriptionSLO returns delivery latency SLOs of subscriptions reported by trigger workers since this
+// controller became the leader.
+func (ctrl *controller) ListSubscriptionSLO(_ context.Context,
+	req *ctrlpb.ListSLORequest) (*ctrlpb.ListSLOResponse, error) {
+	return slo.ToPb(ctrl.slo.List(slo.KindDelivery, req.Resource, time.Now())), nil
+}
+
+// StreamSubscriptions sends subscriptions in batches ordered by ids, the bookmark is the id of the last
+// subscription sent.
+func (ctrl *controller) StreamSubscriptions(req *ctrlpb.StreamListRequest,
+	stream ctrlpb.TriggerController_StreamSubscriptionsServer) error {
+	if ctrl.state != primitive.ServerStateRunning {
+		return errors.ErrServerNotStart
+	}
+	var after vanus.ID
+	if req.Bookmark != "" {
+		id, err := vanus.NewIDFromString(req.Bookmark)
+		if err != nil {
+			return errors.ErrInvalidRequest.WithMessage("invalid bookmark").Wrap(err)
+		}
+		after = id
+	}
+	size := int(req.BatchSize)
+	if size == 0 {
+		size = defaultStreamBatchSize
+	} else if size > maximumStreamBatchSize {
+		size = maximumStreamBatchSize
+	}
+	ctx := stream.Context()
+	subscriptions := ctrl.subscriptionManager.ListSubscription(ctx)
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].ID < subscriptions[j].ID
+	})
+	resp := &ctrlpb.StreamSubscriptionsResponse{Bookmark: req.Bookmark}
+	for _, sub := range subscriptions {
+		if sub.ID <= after || (req.Eventbus != "" && sub.EventBus != req.Eventbus) {
+			continue
+		}
+		offsets, _ := ctrl.subscriptionManager.GetOffset(ctx, sub.ID)
+		resp.Subscriptions = append(resp.Subscriptions, convert.ToPbSubscription(sub, offsets))
+		resp.Bookmark = sub.ID.Key()
+		if len(resp.Subscriptions) < size {
+			continue
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		resp = &ctrlpb.StreamSubscriptionsResponse{Bookmark: resp.Bookmark}
+	}
+	resp.End = true
+	return stream.Send(resp)
+}
+
+// ListTriggerWorker returns trigger workers with their resources, the condition tells whether the cluster
+// needs more trigger workers to schedule new subscriptions.
+func (ctrl *controller) ListTriggerWorker(ctx context.Context,
+	_ *emptypb.Empty) (*ctrlpb.ListTriggerWorkerResponse, error) {
+	tWorkers := ctrl.workerManager.ListTriggerWorker()
+	list := make([]*ctrlpb.TriggerWorkerStatus, 0, len(tWorkers))
+	for _, tWorker := range tWorkers {
+		twInfo := tWorker.GetInfo()
+		assigned := len(tWorker.GetAssignedSubscriptions())
+		list = append(list, &ctrlpb.TriggerWorkerStatus{
+			Address:               twInfo.Addr,
+			Phase:                 string(twInfo.Phase),
+			Labels:                twInfo.Labels,
+			Resources:             toPbTriggerWorkerResources(twInfo.Resources),
+			AssignedSubscriptions: uint32(assigned),
+			UnavailableReason:     ctrl.config.WorkerAdmission.UnavailableReason(twInfo, assigned),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Address < list[j].Address
+	})
+	return &ctrlpb.ListTriggerWorkerResponse{Workers: list, Condition: ctrl.triggerWorkerCondition()}, nil
+}
+
+// triggerWorkerCondition returns a message if no running trigger worker accepts new subscriptions.
+func (ctrl *controller) triggerWorkerCondition() string {
+	twInfos := ctrl.workerManager.GetActiveRunningTriggerWorker()
+	if len(twInfos) == 0 {
+		return "no trigger worker is running, the cluster needs more trigger workers"
+	}
+	for _, twInfo := range twInfos {
+		assigned := 0
+		if tWorker := ctrl.workerManager.GetTriggerWorker(twInfo.Addr); tWorker != nil {
+			assigned = len(tWorker.GetAssignedSubscriptions())
+		}
+		if ctrl.config.WorkerAdmission.UnavailableReason(twInfo, assigned) == "" {
+			return ""
+		}
+	}
+	return fmt.Sprintf("all of %d running trigger workers reach their capacity, "+
+		"the cluster needs more trigger workers", len(twInfos))
+}
+
+func fromPbTriggerWorkerResources(res *ctrlpb.TriggerWorkerResources) *metadata.TriggerWorkerResources {
+	if res == nil {
+		return nil
+	}
+	return &metadata.TriggerWorkerResources{
+		CPUCores:           res.CpuCores,
+		CPULimit:           res.CpuLimit,
+		MemoryBytes:        res.MemoryBytes,
+		MemoryLimit:        res.MemoryLimit,
+		Subscriptions:      res.Subscriptions,
+		MaxSubscriptions:   res.MaxSubscriptions,
+		EventsPerSecond:    res.EventsPerSecond,
+		MaxEventsPerSecond: res.MaxEventsPerSecond,
+	}
+}
+
+func toPbTriggerWorkerResources(res *metadata.TriggerWorkerResources) *ctrlpb.TriggerWorkerResources {
+	if res == nil {
+		return nil
+	}
+	return &ctrlpb.TriggerWorkerResources{
+		CpuCores:           res.CPUCores,
+		CpuLimit:           res.CPULimit,
+		MemoryBytes:        res.MemoryBytes,
+		MemoryLimit:        res.MemoryLimit,
+		Subscriptions:      res.Subscriptions,
+		MaxSubscriptions:   res.MaxSubscriptions,
+		EventsPerSecond:    res.EventsPerSecond,
+		MaxEventsPerSecond: res.MaxEventsPerSecond,
+	}
+}
+
 // gcSubscription before delete subscription,need
 //
 // 1.trigger worker remove subscription
@@ -428,17 +1005,24 @@ func (ctrl *controller) gcSubscription(ctx context.Context, id vanus.ID, addr st
 	return nil
 }
 
-func (ctrl *controller) gcSubscriptions(ctx context.Context) {
-	util.UntilWithContext(ctx, func(ctx context.Context) {
-		ctrl.lock.Lock()
-		defer ctrl.lock.Unlock()
-		for ID, addr := range ctrl.needCleanSubscription {
-			err := ctrl.gcSubscription(ctx, ID, addr)
-			if err == nil {
-				delete(ctrl.needCleanSubscription, ID)
-			}
-		}
-	}, defaultGcSubscriptionInterval)
+func (ctrl *controller) submitGcSubscription(ctx context.Context, sub *metadata.Subscription) error {
+	_, err := ctrl.jobMgr.Submit(ctx, job.Spec{
+		Kind: jobKindGcSubscription,
+		Key:  sub.ID.Key(),
+		Params: map[string]string{
+			jobParamSubscriptionID: sub.ID.Key(),
+			jobParamTriggerWorker:  sub.TriggerWorker,
+		},
+	})
+	return err
+}
+
+func (ctrl *controller) gcSubscriptionJob(ctx context.Context, e *job.Execution) error {
+	id, err := vanus.NewIDFromString(e.Params()[jobParamSubscriptionID])
+	if err != nil {
+		return err
+	}
+	return ctrl.gcSubscription(ctx, id, e.Params()[jobParamTriggerWorker])
 }
 
 func (ctrl *controller) requeueSubscription(ctx context.Context, id vanus.ID, addr string) error {
@@ -474,6 +1058,10 @@ func (ctrl *controller) init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	err = ctrl.jobMgr.Run(ctx, ctrl.kvClient)
+	if err != nil {
+		return err
+	}
 	// restart,need reschedule
 	for _, sub := range ctrl.subscriptionManager.ListSubscription(ctx) {
 		switch sub.Phase {
@@ -482,7 +1070,10 @@ func (ctrl *controller) init(ctx context.Context) error {
 		case metadata.SubscriptionPhasePending, metadata.SubscriptionPhaseStopping:
 			ctrl.scheduler.EnqueueSubscription(sub.ID)
 		case metadata.SubscriptionPhaseToDelete:
-			ctrl.needCleanSubscription[sub.ID] = sub.TriggerWorker
+			// the job is deduplicated if it has been resumed.
+			if err = ctrl.submitGcSubscription(ctx, sub); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -514,7 +1105,8 @@ func (ctrl *controller) membershipChangedProcessor(ctx context.Context,
 		ctrl.workerManager.Start()
 		ctrl.subscriptionManager.Start()
 		ctrl.scheduler.Run()
-		go ctrl.gcSubscriptions(ctx)
+		ctrl.detector.Start(ctrl.ctx)
+		ctrl.slo.Start(ctrl.ctx)
 		ctrl.state = primitive.ServerStateRunning
 		ctrl.isLeader = true
 	case embedetcd.EventBecomeFollower:
@@ -536,6 +1128,9 @@ func (ctrl *controller) stop(_ context.Context) error {
 	ctrl.member.ResignIfLeader()
 	ctrl.state = primitive.ServerStateStopping
 	ctrl.stopFunc()
+	ctrl.jobMgr.Stop()
+	ctrl.detector.Stop()
+	ctrl.slo.Stop()
 	ctrl.scheduler.Stop()
 	ctrl.workerManager.Stop()
 	ctrl.subscriptionManager.Stop()
@@ -545,11 +1140,13 @@ func (ctrl *controller) stop(_ context.Context) error {
 }
 
 func (ctrl *controller) Start() error {
-	s, err := storage.NewStorage(ctrl.config.Storage)
+	// storage and the job manager share the client, it's closed with storage.
+	client, err := etcd.NewEtcdClientV3(ctrl.config.Storage.ServerList, ctrl.config.Storage.KeyPrefix)
 	if err != nil {
 		return err
 	}
-	ctrl.storage = s
+	ctrl.kvClient = client
+	ctrl.storage = storage.NewStorageWithClient(client)
 	secretStorage, err := storage.NewSecretStorage(ctrl.config.Storage, ctrl.config.SecretEncryptionSalt)
 	if err != nil {
 		return err
@@ -558,7 +1155,8 @@ func (ctrl *controller) Start() error {
 	ctrl.subscriptionManager = subscription.NewSubscriptionManager(ctrl.storage, ctrl.secretStorage, ctrl.ebClient)
 	ctrl.workerManager = worker.NewTriggerWorkerManager(worker.Config{}, ctrl.storage,
 		ctrl.subscriptionManager, ctrl.requeueSubscription)
-	ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+	ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+		ctrl.config.WorkerAdmission)
 
 	go ctrl.member.RegisterMembershipChangedProcessor(ctrl.membershipChangedProcessor)
 	return nil
@@ -18,27 +18,34 @@ import (
 	"context"
 	stdJson "encoding/json"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/linkall-labs/vanus/internal/controller/job"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
+	"github.com/linkall-labs/vanus/internal/convert"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/errors"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
 )
 
 func TestController_CommitOffset(t *testing.T) {
 	Convey("test reset offset", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
@@ -77,7 +84,7 @@ func TestController_ResetOffsetToTimestamp(t *testing.T) {
 	Convey("test reset offset", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
@@ -107,6 +114,162 @@ func TestController_ResetOffsetToTimestamp(t *testing.T) {
 			})
 			So(err, ShouldBeNil)
 		})
+		Convey("reset offset submits prefetching", func() {
+			sub := &metadata.Subscription{
+				ID:    subID,
+				Phase: metadata.SubscriptionPhaseStopped,
+			}
+			jobMgr := job.NewMockManager(mockCtrl)
+			ctrl.jobMgr = jobMgr
+			ts := uint64(time.Now().Unix())
+			offsets := info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: 10}}
+			data, _ := stdJson.Marshal(offsets)
+			spec := job.Spec{
+				Kind: jobKindPrefetchOffsets,
+				Key:  fmt.Sprintf("%s-%d", subID.Key(), ts),
+				Params: map[string]string{
+					jobParamSubscriptionID: subID.Key(),
+					jobParamOffsets:        string(data),
+				},
+				MaxAttempts: prefetchRetries,
+			}
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).AnyTimes().Return(sub)
+			subManager.EXPECT().ResetOffsetByTimestamp(gomock.Any(), gomock.Eq(subID), gomock.Eq(ts)).Return(offsets, nil)
+			Convey("submit success", func() {
+				jobMgr.EXPECT().Submit(gomock.Any(), gomock.Eq(spec)).Return(&job.Job{}, nil)
+				res, err := ctrl.ResetOffsetToTimestamp(ctx, &ctrlpb.ResetOffsetToTimestampRequest{
+					SubscriptionId: subID.Uint64(),
+					Timestamp:      ts,
+				})
+				So(err, ShouldBeNil)
+				So(res.Offsets, ShouldHaveLength, 1)
+			})
+			Convey("submit fail", func() {
+				jobMgr.EXPECT().Submit(gomock.Any(), gomock.Eq(spec)).Return(nil, fmt.Errorf("error"))
+				res, err := ctrl.ResetOffsetToTimestamp(ctx, &ctrlpb.ResetOffsetToTimestampRequest{
+					SubscriptionId: subID.Uint64(),
+					Timestamp:      ts,
+				})
+				So(err, ShouldBeNil)
+				So(res.Offsets, ShouldHaveLength, 1)
+			})
+		})
+	})
+}
+
+func TestController_PrefetchOffsetsJob(t *testing.T) {
+	Convey("test prefetch offsets job", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		mockCl := cluster.NewMockCluster(mockCtrl)
+		ctrl.cl = mockCl
+		logSvc := cluster.NewMockEventlogService(mockCtrl)
+		logCli := ctrlpb.NewMockEventLogControllerClient(mockCtrl)
+		mockCl.EXPECT().EventlogService().AnyTimes().Return(logSvc)
+		logSvc.EXPECT().RawClient().AnyTimes().Return(logCli)
+
+		subID := vanus.NewTestID()
+		offsets := info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: 10}}
+		data, _ := stdJson.Marshal(offsets)
+		e := job.NewTestExecution(map[string]string{
+			jobParamSubscriptionID: subID.Key(),
+			jobParamOffsets:        string(data),
+		})
+		Convey("prefetch success", func() {
+			logCli.EXPECT().PrefetchEventlogs(gomock.Any(), gomock.Eq(&ctrlpb.PrefetchEventlogsRequest{
+				Offsets: convert.ToPbOffsetInfos(offsets),
+			})).Return(&ctrlpb.PrefetchEventlogsResponse{Blocks: 1, Bytes: 1024}, nil)
+			So(ctrl.prefetchOffsetsJob(ctx, e), ShouldBeNil)
+		})
+		Convey("prefetch fail", func() {
+			logCli.EXPECT().PrefetchEventlogs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			So(ctrl.prefetchOffsetsJob(ctx, e), ShouldNotBeNil)
+		})
+		Convey("invalid offsets", func() {
+			e = job.NewTestExecution(map[string]string{
+				jobParamSubscriptionID: subID.Key(),
+				jobParamOffsets:        "invalid",
+			})
+			So(ctrl.prefetchOffsetsJob(ctx, e), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestController_ExportImportOffsets(t *testing.T) {
+	Convey("test export and import offsets", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.state = primitive.ServerStateRunning
+
+		logID := vanus.NewTestID()
+		sub1 := &metadata.Subscription{ID: vanus.NewTestID(), Name: "sub1", EventBus: "bus1",
+			Phase: metadata.SubscriptionPhaseStopped}
+		sub2 := &metadata.Subscription{ID: vanus.NewTestID(), Name: "sub2", EventBus: "bus2"}
+		subManager.EXPECT().ListSubscription(gomock.Any()).AnyTimes().Return(
+			[]*metadata.Subscription{sub1, sub2})
+		Convey("export offsets of eventbus", func() {
+			subManager.EXPECT().ExportOffset(gomock.Any(), sub1.ID).Return([]subscription.ExportedOffset{
+				{OffsetInfo: info.OffsetInfo{EventLogID: logID, Offset: 100}, Timestamp: 1000},
+			}, nil)
+			resp, err := ctrl.ExportOffsets(ctx, &ctrlpb.ExportOffsetsRequest{Eventbus: "bus1"})
+			So(err, ShouldBeNil)
+			So(resp.Subscriptions, ShouldHaveLength, 1)
+			So(resp.Subscriptions[0].SubscriptionName, ShouldEqual, "sub1")
+			So(resp.Subscriptions[0].Offsets[0].Offset, ShouldEqual, 100)
+			So(resp.Subscriptions[0].Offsets[0].Timestamp, ShouldEqual, 1000)
+		})
+		Convey("import absolute offsets", func() {
+			subManager.EXPECT().GetSubscription(gomock.Any(), sub1.ID).Return(sub1)
+			subManager.EXPECT().ImportOffset(gomock.Any(), sub1.ID, info.ListOffsetInfo{
+				{EventLogID: logID, Offset: 100},
+			}).Return(nil)
+			resp, err := ctrl.ImportOffsets(ctx, &ctrlpb.ImportOffsetsRequest{
+				Subscriptions: []*ctrlpb.SubscriptionOffsets{{
+					SubscriptionId: sub1.ID.Uint64(),
+					Offsets:        []*ctrlpb.ExportedOffset{{EventLogId: logID.Uint64(), Offset: 100}},
+				}},
+			})
+			So(err, ShouldBeNil)
+			So(resp.Results[0].Error, ShouldBeEmpty)
+			So(resp.Results[0].Offsets, ShouldHaveLength, 1)
+		})
+		Convey("import offsets by timestamp into subscription with same name", func() {
+			sourceID := vanus.NewTestID()
+			subManager.EXPECT().GetSubscription(gomock.Any(), sourceID).Return(nil)
+			subManager.EXPECT().ResetOffsetByTimestamp(gomock.Any(), sub1.ID, uint64(1000)).Return(nil, nil)
+			resp, err := ctrl.ImportOffsets(ctx, &ctrlpb.ImportOffsetsRequest{
+				Subscriptions: []*ctrlpb.SubscriptionOffsets{{
+					SubscriptionId:   sourceID.Uint64(),
+					SubscriptionName: "sub1",
+					Eventbus:         "bus1",
+					Offsets: []*ctrlpb.ExportedOffset{
+						{EventLogId: 1, Offset: 100, Timestamp: 2000},
+						{EventLogId: 2, Offset: 200, Timestamp: 1000},
+						{EventLogId: 3, Offset: 300},
+					},
+					ExportedAt: 3000,
+				}},
+				ByTimestamp: true,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Results[0].Error, ShouldBeEmpty)
+			So(resp.Results[0].SubscriptionId, ShouldEqual, sub1.ID.Uint64())
+		})
+		Convey("import offsets into running subscription", func() {
+			subManager.EXPECT().GetSubscription(gomock.Any(), sub2.ID).Return(sub2)
+			resp, err := ctrl.ImportOffsets(ctx, &ctrlpb.ImportOffsetsRequest{
+				Subscriptions: []*ctrlpb.SubscriptionOffsets{{SubscriptionId: sub2.ID.Uint64()}},
+				ByTimestamp:   true,
+			})
+			So(err, ShouldBeNil)
+			So(resp.Results[0].Error, ShouldNotBeEmpty)
+		})
 	})
 }
 
@@ -114,13 +277,14 @@ func TestController_CreateSubscription(t *testing.T) {
 	Convey("test create subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
-		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
 
 		ctrl.state = primitive.ServerStateRunning
 		Convey("create subscription", func() {
@@ -150,13 +314,14 @@ func TestController_UpdateSubscription(t *testing.T) {
 	Convey("test update subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
-		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
 
 		subID := vanus.NewTestID()
 		ctrl.state = primitive.ServerStateRunning
@@ -344,6 +509,141 @@ func TestController_UpdateSubscription(t *testing.T) {
 			So(resp.Transformer, ShouldNotBeNil)
 			So(sub.Transformer, ShouldBeNil)
 		})
+		Convey("update node selector", func() {
+			tWorker := worker.NewMockTriggerWorker(mockCtrl)
+			workerManager.EXPECT().GetTriggerWorker(gomock.Eq("test-addr")).Return(tWorker)
+			tWorker.EXPECT().GetInfo().Return(metadata.TriggerWorkerInfo{
+				Addr:   "test-addr",
+				Labels: map[string]string{"zone": "a"},
+			})
+			subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil)
+			request := &ctrlpb.UpdateSubscriptionRequest{
+				Id: subID.Uint64(),
+				Subscription: &ctrlpb.SubscriptionRequest{
+					EventBus:     "test-eb",
+					Sink:         "test-sink",
+					NodeSelector: map[string]string{"zone": "b"},
+				},
+			}
+			Convey("unassign the unmatched trigger worker", func() {
+				tWorker.EXPECT().UnAssignSubscription(gomock.Eq(subID)).Return(nil)
+				_, err := ctrl.UpdateSubscription(ctx, request)
+				So(err, ShouldBeNil)
+				So(_sub.TriggerWorker, ShouldBeEmpty)
+				So(_sub.NodeSelector, ShouldResemble, map[string]string{"zone": "b"})
+			})
+			Convey("keep the matched trigger worker", func() {
+				request.Subscription.NodeSelector = map[string]string{"zone": "a"}
+				_, err := ctrl.UpdateSubscription(ctx, request)
+				So(err, ShouldBeNil)
+				So(_sub.TriggerWorker, ShouldEqual, "test-addr")
+			})
+		})
+		Convey("update signing with masked secrets", func() {
+			stored := strings.Repeat("a", 32)
+			_sub.Signing = &primitive.SigningConfig{
+				Method: primitive.SigningHMAC,
+				Keys:   []primitive.SigningKey{{ID: "k1", Secret: stored}},
+			}
+			_sub.SigningMethod = primitive.SigningHMAC
+			request := &ctrlpb.UpdateSubscriptionRequest{
+				Id: subID.Uint64(),
+				Subscription: &ctrlpb.SubscriptionRequest{
+					EventBus: "test-eb",
+					Sink:     "test-sink",
+					Signing: &metapb.SigningConfig{
+						Keys: []*metapb.SigningKey{
+							{Id: "k1", Secret: primitive.SecretsMask},
+							{Id: "k2", Secret: primitive.SecretsMask},
+						},
+					},
+				},
+			}
+			Convey("masked secret of a new key", func() {
+				_, err := ctrl.UpdateSubscription(ctx, request)
+				So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+				So(_sub.Signing.Keys, ShouldHaveLength, 1)
+			})
+			Convey("masked secret keeps the stored secret", func() {
+				request.Subscription.Signing.Keys[1].Secret = strings.Repeat("b", 32)
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil)
+				resp, err := ctrl.UpdateSubscription(ctx, request)
+				So(err, ShouldBeNil)
+				So(_sub.Signing.Keys, ShouldResemble, []primitive.SigningKey{
+					{ID: "k1", Secret: stored}, {ID: "k2", Secret: strings.Repeat("b", 32)},
+				})
+				So(resp.Signing.Keys[0].Secret, ShouldEqual, primitive.SecretsMask)
+			})
+		})
+	})
+}
+
+func TestController_RotateSigningKey(t *testing.T) {
+	Convey("test rotate signing key", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.state = primitive.ServerStateRunning
+
+		subID := vanus.NewTestID()
+		oldSecret, newSecret := strings.Repeat("o", 32), strings.Repeat("n", 32)
+		sub := &metadata.Subscription{
+			ID:            subID,
+			Phase:         metadata.SubscriptionPhaseRunning,
+			TriggerWorker: "test-addr",
+			SigningMethod: primitive.SigningHMAC,
+			Signing: &primitive.SigningConfig{
+				Method: primitive.SigningHMAC,
+				Keys:   []primitive.SigningKey{{ID: "old", Secret: oldSecret}},
+			},
+		}
+		subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).AnyTimes().Return(sub)
+		subManager.EXPECT().GetOffset(gomock.Any(), gomock.Eq(subID)).AnyTimes().Return(nil, nil)
+
+		Convey("invalid requests", func() {
+			for _, req := range []*ctrlpb.RotateSigningKeyRequest{
+				{Id: subID.Uint64()},
+				{Id: subID.Uint64(), NewKey: &metapb.SigningKey{Id: "new", Secret: primitive.SecretsMask}},
+				{Id: subID.Uint64(), NewKey: &metapb.SigningKey{Id: "new", Secret: "short"}},
+				{Id: subID.Uint64(), NewKey: &metapb.SigningKey{Id: "old", Secret: newSecret}},
+				{Id: subID.Uint64(), RetiredKeyId: "unknown"},
+				{Id: subID.Uint64(), RetiredKeyId: "old"},
+			} {
+				_, err := ctrl.RotateSigningKey(ctx, req)
+				So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+			}
+			So(sub.Signing.Keys, ShouldHaveLength, 1)
+		})
+
+		Convey("add the new key and then retire the old key", func() {
+			tWorker := worker.NewMockTriggerWorker(mockCtrl)
+			workerManager.EXPECT().GetTriggerWorker("test-addr").Times(2).Return(tWorker)
+			tWorker.EXPECT().AssignSubscription(subID).Times(2)
+			subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+
+			resp, err := ctrl.RotateSigningKey(ctx, &ctrlpb.RotateSigningKeyRequest{
+				Id:     subID.Uint64(),
+				NewKey: &metapb.SigningKey{Id: "new", Secret: newSecret},
+			})
+			So(err, ShouldBeNil)
+			So(resp.Signing.Keys, ShouldHaveLength, 2)
+			So(resp.Signing.Keys[1].Secret, ShouldEqual, primitive.SecretsMask)
+			So(sub.Signing.Keys, ShouldResemble, []primitive.SigningKey{
+				{ID: "old", Secret: oldSecret}, {ID: "new", Secret: newSecret},
+			})
+
+			_, err = ctrl.RotateSigningKey(ctx, &ctrlpb.RotateSigningKeyRequest{
+				Id:           subID.Uint64(),
+				RetiredKeyId: "old",
+			})
+			So(err, ShouldBeNil)
+			So(sub.Signing.Keys, ShouldResemble, []primitive.SigningKey{{ID: "new", Secret: newSecret}})
+		})
 	})
 }
 
@@ -351,13 +651,14 @@ func TestController_DeleteSubscription(t *testing.T) {
 	Convey("test delete subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
-		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
 
 		subID := vanus.NewTestID()
 		request := &ctrlpb.DeleteSubscriptionRequest{
@@ -371,37 +672,165 @@ func TestController_DeleteSubscription(t *testing.T) {
 		})
 		Convey("delete subscription exist", func() {
 			addr := "test"
-			tWorker := worker.NewMockTriggerWorker(mockCtrl)
 			sub := &metadata.Subscription{
 				ID:            subID,
 				TriggerWorker: addr,
 			}
+			jobMgr := job.NewMockManager(mockCtrl)
+			ctrl.jobMgr = jobMgr
 			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
 			subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil)
-			workerManager.EXPECT().GetTriggerWorker(addr).Return(tWorker)
-			tWorker.EXPECT().UnAssignSubscription(gomock.Eq(subID)).Return(nil)
+			spec := job.Spec{
+				Kind: jobKindGcSubscription,
+				Key:  subID.Key(),
+				Params: map[string]string{
+					jobParamSubscriptionID: subID.Key(),
+					jobParamTriggerWorker:  addr,
+				},
+			}
 			Convey("delete subscription success", func() {
-				subManager.EXPECT().DeleteSubscription(gomock.Any(), gomock.Eq(subID)).Return(nil)
+				jobMgr.EXPECT().Submit(gomock.Any(), gomock.Eq(spec)).Return(&job.Job{}, nil)
 				_, err := ctrl.DeleteSubscription(ctx, request)
 				So(err, ShouldBeNil)
 				So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseToDelete)
-				time.Sleep(10 * time.Millisecond)
-				ctrl.lock.Lock()
-				_, exist := ctrl.needCleanSubscription[subID]
-				ctrl.lock.Unlock()
-				So(exist, ShouldBeFalse)
 			})
 			Convey("delete subscription fail", func() {
-				subManager.EXPECT().DeleteSubscription(gomock.Any(), gomock.Eq(subID)).Return(fmt.Errorf("error"))
+				jobMgr.EXPECT().Submit(gomock.Any(), gomock.Eq(spec)).Return(nil, fmt.Errorf("error"))
 				_, err := ctrl.DeleteSubscription(ctx, request)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestController_AnnotateSubscription(t *testing.T) {
+	Convey("test annotate subscription", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.state = primitive.ServerStateRunning
+
+		subID := vanus.NewTestID()
+		Convey("annotate subscription no exist", func() {
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(nil)
+			_, err := ctrl.AnnotateSubscription(ctx, &ctrlpb.AnnotateSubscriptionRequest{Id: subID.Uint64()})
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+		})
+		Convey("annotate subscription exist", func() {
+			sub := &metadata.Subscription{
+				ID:          subID,
+				Annotations: map[string]string{"owner": "team-a", "note": "paused due to incident #123"},
+			}
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
+			Convey("invalid annotation key", func() {
+				_, err := ctrl.AnnotateSubscription(ctx, &ctrlpb.AnnotateSubscriptionRequest{
+					Id:          subID.Uint64(),
+					Annotations: map[string]string{"bad key": "value"},
+				})
+				So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+				So(sub.Annotations, ShouldHaveLength, 2)
+			})
+			Convey("update and remove annotations", func() {
+				var stored *metadata.Subscription
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, s *metadata.Subscription) error {
+						stored = s
+						return nil
+					})
+				subManager.EXPECT().GetOffset(gomock.Any(), gomock.Eq(subID)).Return(nil, nil)
+				resp, err := ctrl.AnnotateSubscription(ctx, &ctrlpb.AnnotateSubscriptionRequest{
+					Id:          subID.Uint64(),
+					Annotations: map[string]string{"owner": "team-b"},
+					RemovedKeys: []string{"note"},
+				})
 				So(err, ShouldBeNil)
-				So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseToDelete)
-				time.Sleep(10 * time.Millisecond)
-				ctrl.lock.Lock()
-				_, exist := ctrl.needCleanSubscription[subID]
-				ctrl.lock.Unlock()
-				So(exist, ShouldBeTrue)
+				So(resp.Annotations, ShouldResemble, map[string]string{"owner": "team-b"})
+				So(stored, ShouldNotEqual, sub)
+				So(stored.Annotations, ShouldResemble, map[string]string{"owner": "team-b"})
+				So(sub.Annotations, ShouldResemble,
+					map[string]string{"owner": "team-a", "note": "paused due to incident #123"})
 			})
+			Convey("update fail", func() {
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
+				_, err := ctrl.AnnotateSubscription(ctx, &ctrlpb.AnnotateSubscriptionRequest{
+					Id:          subID.Uint64(),
+					Annotations: map[string]string{"owner": "team-b"},
+				})
+				So(err, ShouldNotBeNil)
+				So(sub.Annotations, ShouldHaveLength, 2)
+				So(sub.Annotations["owner"], ShouldEqual, "team-a")
+			})
+		})
+	})
+}
+
+func TestController_ResumeEventlogs(t *testing.T) {
+	Convey("test resume eventlogs", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.state = primitive.ServerStateRunning
+
+		subID := vanus.NewTestID()
+		Convey("subscription not running", func() {
+			sub := &metadata.Subscription{ID: subID, Phase: metadata.SubscriptionPhaseStopped}
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
+			_, err := ctrl.ResumeEventlogs(ctx, &ctrlpb.ResumeEventlogsRequest{SubscriptionId: subID.Uint64()})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+		})
+		Convey("resume on the trigger worker", func() {
+			sub := &metadata.Subscription{
+				ID:            subID,
+				Phase:         metadata.SubscriptionPhaseRunning,
+				TriggerWorker: "test",
+			}
+			subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Eq(subID)).Return(sub)
+			tWorker := worker.NewMockTriggerWorker(mockCtrl)
+			workerManager.EXPECT().GetTriggerWorker(gomock.Eq("test")).Return(tWorker)
+			eventlogID := vanus.NewTestID()
+			tWorker.EXPECT().ResumeEventlogs(gomock.Any(), gomock.Eq(subID)).Return([]vanus.ID{eventlogID}, nil)
+			resp, err := ctrl.ResumeEventlogs(ctx, &ctrlpb.ResumeEventlogsRequest{SubscriptionId: subID.Uint64()})
+			So(err, ShouldBeNil)
+			So(resp.EventlogIds, ShouldResemble, []uint64{eventlogID.Uint64()})
+		})
+	})
+}
+
+func TestController_GcSubscriptionJob(t *testing.T) {
+	Convey("test gc subscription job", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+
+		subID := vanus.NewTestID()
+		addr := "test"
+		tWorker := worker.NewMockTriggerWorker(mockCtrl)
+		workerManager.EXPECT().GetTriggerWorker(addr).Return(tWorker)
+		tWorker.EXPECT().UnAssignSubscription(gomock.Eq(subID)).Return(nil)
+		e := job.NewTestExecution(map[string]string{
+			jobParamSubscriptionID: subID.Key(),
+			jobParamTriggerWorker:  addr,
+		})
+		Convey("gc subscription success", func() {
+			subManager.EXPECT().DeleteSubscription(gomock.Any(), gomock.Eq(subID)).Return(nil)
+			So(ctrl.gcSubscriptionJob(ctx, e), ShouldBeNil)
+		})
+		Convey("gc subscription fail", func() {
+			subManager.EXPECT().DeleteSubscription(gomock.Any(), gomock.Eq(subID)).Return(fmt.Errorf("error"))
+			So(ctrl.gcSubscriptionJob(ctx, e), ShouldNotBeNil)
 		})
 	})
 }
@@ -410,13 +839,14 @@ func TestController_GetSubscription(t *testing.T) {
 	Convey("test get subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
-		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
 
 		subID := vanus.NewTestID()
 		request := &ctrlpb.GetSubscriptionRequest{
@@ -447,13 +877,14 @@ func TestController_ListSubscription(t *testing.T) {
 	Convey("test list subscription", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
-		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
 		Convey("list subscription", func() {
 			list := []*metadata.Subscription{
 				{ID: vanus.NewTestID(), EventBus: "bus1"},
@@ -468,17 +899,79 @@ func TestController_ListSubscription(t *testing.T) {
 	})
 }
 
+type subscriptionStream struct {
+	grpc.ServerStream
+	responses []*ctrlpb.StreamSubscriptionsResponse
+}
+
+func (s *subscriptionStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *subscriptionStream) Send(resp *ctrlpb.StreamSubscriptionsResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func TestController_StreamSubscriptions(t *testing.T) {
+	Convey("test stream subscriptions", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.state = primitive.ServerStateRunning
+
+		list := make([]*metadata.Subscription, 5)
+		for i := range list {
+			list[i] = &metadata.Subscription{ID: vanus.ID(5 - i), EventBus: "bus1"}
+		}
+		list[0].EventBus = "bus2"
+		subManager.EXPECT().ListSubscription(gomock.Any()).AnyTimes().Return(list)
+		subManager.EXPECT().GetOffset(gomock.Any(), gomock.Any()).AnyTimes().Return(info.ListOffsetInfo{}, nil)
+
+		stream := &subscriptionStream{}
+		err := ctrl.StreamSubscriptions(&ctrlpb.StreamListRequest{BatchSize: 2}, stream)
+		So(err, ShouldBeNil)
+		So(stream.responses, ShouldHaveLength, 3)
+		So(stream.responses[0].Subscriptions[0].Id, ShouldEqual, 1)
+		So(stream.responses[0].Bookmark, ShouldEqual, vanus.ID(2).Key())
+		So(stream.responses[1].End, ShouldBeFalse)
+		So(stream.responses[2].Subscriptions, ShouldHaveLength, 1)
+		So(stream.responses[2].Subscriptions[0].Id, ShouldEqual, 5)
+		So(stream.responses[2].End, ShouldBeTrue)
+
+		Convey("resume from bookmark", func() {
+			stream = &subscriptionStream{}
+			req := &ctrlpb.StreamListRequest{Bookmark: vanus.ID(2).Key(), Eventbus: "bus1"}
+			err = ctrl.StreamSubscriptions(req, stream)
+			So(err, ShouldBeNil)
+			So(stream.responses, ShouldHaveLength, 1)
+			So(stream.responses[0].Subscriptions, ShouldHaveLength, 2)
+			So(stream.responses[0].Subscriptions[0].Id, ShouldEqual, 3)
+			So(stream.responses[0].Bookmark, ShouldEqual, vanus.ID(4).Key())
+			So(stream.responses[0].End, ShouldBeTrue)
+		})
+
+		Convey("invalid bookmark", func() {
+			err = ctrl.StreamSubscriptions(&ctrlpb.StreamListRequest{Bookmark: "x"}, &subscriptionStream{})
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+	})
+}
+
 func TestController_TriggerWorkerHeartbeat(t *testing.T) {
 	Convey("test trigger worker heartbeat", t, func() {
 		mockCtrl := gomock.NewController(t)
 		defer mockCtrl.Finish()
-		ctrl := NewController(Config{}, nil)
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
 		ctx := context.Background()
 		workerManager := worker.NewMockManager(mockCtrl)
 		ctrl.workerManager = workerManager
 		subManager := subscription.NewMockManager(mockCtrl)
 		ctrl.subscriptionManager = subManager
-		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager)
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
 		subID1 := vanus.NewTestID()
 		sub1 := &metapb.SubscriptionInfo{
 			SubscriptionId: subID1.Uint64(),
@@ -511,13 +1004,14 @@ func TestController_TriggerWorkerHeartbeat(t *testing.T) {
 		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID1), request.Address, gomock.Any()).AnyTimes().Return(fmt.Errorf("error"))
 		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID2), request.Address, gomock.Any()).AnyTimes().Return(nil)
 		subManager.EXPECT().Heartbeat(gomock.Any(), gomock.Eq(subID3), request.Address, gomock.Any()).AnyTimes().Return(nil)
+		subManager.EXPECT().GetSubscription(gomock.Any(), gomock.Any()).AnyTimes().Return(nil)
 		Convey("heartbeat error", func() {
-			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address)).Return(fmt.Errorf("error"))
+			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address), gomock.Any()).Return(fmt.Errorf("error"))
 			err := ctrl.triggerWorkerHeartbeatRequest(ctx, request)
 			So(err, ShouldNotBeNil)
 		})
 		Convey("heartbeat success", func() {
-			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address)).Return(nil)
+			workerManager.EXPECT().UpdateTriggerWorkerInfo(gomock.Any(), gomock.Eq(request.Address), gomock.Any()).Return(nil)
 			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Eq(subID1), gomock.Any(), false).Return(nil)
 			subManager.EXPECT().SaveOffset(gomock.Any(), gomock.Eq(subID2), gomock.Any(), false).Return(fmt.Errorf("error"))
 			err := ctrl.triggerWorkerHeartbeatRequest(ctx, request)
@@ -19,6 +19,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/linkall-labs/vanus/client/pkg/gap"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/pkg/util"
@@ -34,9 +35,25 @@ const (
 )
 
 type TriggerWorkerInfo struct {
-	ID    string             `json:"-"`
-	Addr  string             `json:"addr"`
-	Phase TriggerWorkerPhase `json:"phase"`
+	ID     string             `json:"-"`
+	Addr   string             `json:"addr"`
+	Phase  TriggerWorkerPhase `json:"phase"`
+	Labels map[string]string  `json:"labels,omitempty"`
+	// Resources is reported in heartbeats, it's nil until the first heartbeat with resources.
+	Resources *TriggerWorkerResources `json:"-"`
+}
+
+// TriggerWorkerResources is the resource usage and the capacity of a trigger worker, a zero capacity is
+// unlimited or unknown.
+type TriggerWorkerResources struct {
+	CPUCores           float64
+	CPULimit           float64
+	MemoryBytes        uint64
+	MemoryLimit        uint64
+	Subscriptions      uint32
+	MaxSubscriptions   uint32
+	EventsPerSecond    float64
+	MaxEventsPerSecond float64
 }
 
 func NewTriggerWorkerInfo(addr string) *TriggerWorkerInfo {
@@ -48,6 +65,16 @@ func NewTriggerWorkerInfo(addr string) *TriggerWorkerInfo {
 	return twInfo
 }
 
+// Match returns true if labels of the trigger worker contain all of selector.
+func (tw *TriggerWorkerInfo) Match(selector map[string]string) bool {
+	for k, v := range selector {
+		if l, ok := tw.Labels[k]; !ok || l != v {
+			return false
+		}
+	}
+	return true
+}
+
 func (tw *TriggerWorkerInfo) String() string {
 	return fmt.Sprintf("addr:%s,phase:%v", tw.Addr, tw.Phase)
 }
@@ -80,11 +107,42 @@ type Subscription struct {
 	Description        string                          `json:"description"`
 	CreatedAt          time.Time                       `json:"created_at"`
 	UpdatedAt          time.Time                       `json:"updated_at"`
+	NodeSelector       map[string]string               `json:"node_selector,omitempty"`
+	// SigningMethod is set if events are signed, keys are kept in Signing and stored as secrets.
+	SigningMethod primitive.SigningMethod  `json:"signing_method,omitempty"`
+	Signing       *primitive.SigningConfig `json:"-"`
+	// Annotations record operational context, e.g. why the subscription is disabled, and never affect delivery.
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Namespace is the one which the subscription is counted against quotas of, it's default if empty.
+	Namespace string `json:"namespace,omitempty"`
 
 	// not from api
-	Phase         SubscriptionPhase `json:"phase"`
-	TriggerWorker string            `json:"trigger_worker,omitempty"`
-	HeartbeatTime time.Time         `json:"-"`
+	// Owner is the principal who created the subscription.
+	Owner          string            `json:"owner,omitempty"`
+	Phase          SubscriptionPhase `json:"phase"`
+	TriggerWorker  string            `json:"trigger_worker,omitempty"`
+	HeartbeatTime  time.Time         `json:"-"`
+	SinkResolution *SinkResolution   `json:"-"`
+	// UnschedulableReason is set by the scheduler when no trigger worker matches NodeSelector or all of
+	// them reach their capacity.
+	UnschedulableReason string `json:"-"`
+	// DeliveryPhase is reported by the trigger worker if backfilling is enabled.
+	DeliveryPhase primitive.DeliveryPhase `json:"-"`
+	// DataLosses are the recent events removed by retention before they were consumed, which are reported by
+	// the trigger worker.
+	DataLosses []primitive.DataLoss `json:"-"`
+	// CrashStatus is reported by the trigger worker once the delivery pipeline panicked.
+	CrashStatus *primitive.CrashStatus `json:"-"`
+	// PausedGaps are reported by the trigger worker, eventlogs are paused at them in strict sequence mode.
+	PausedGaps []gap.Gap `json:"-"`
+}
+
+// SinkResolution is the state of resolving the hostname of sink, which is reported by the trigger worker.
+type SinkResolution struct {
+	Host       string
+	Addrs      []string
+	ResolvedAt time.Time
+	Error      string
 }
 
 // Update property change from api .
@@ -135,5 +193,15 @@ func (s *Subscription) Update(update *Subscription) bool {
 		change = true
 		s.Transformer = update.Transformer
 	}
+	if !reflect.DeepEqual(s.NodeSelector, update.NodeSelector) {
+		change = true
+		s.NodeSelector = update.NodeSelector
+	}
+	primitive.FillSigningKeys(update.Signing, s.Signing)
+	if !reflect.DeepEqual(s.Signing, update.Signing) {
+		change = true
+		s.Signing = update.Signing
+		s.SigningMethod = update.SigningMethod
+	}
 	return change
 }
@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+// Default is the namespace of subscriptions which don't specify one.
+const Default = "default"
+
+// Quota limits subscriptions of a namespace, a zero limit is unlimited.
+type Quota struct {
+	MaxSubscriptions int `yaml:"max_subscriptions"`
+	// MaxDeliveryRate limits the sum of rate limits of subscriptions in events per second, subscriptions must
+	// set rate limits in the namespace if it's set.
+	MaxDeliveryRate uint64 `yaml:"max_delivery_rate"`
+	// MaxTransformerActions budgets transformers, each action of pipelines and each template counts as one,
+	// since trigger workers don't account CPU time of transformers.
+	MaxTransformerActions int `yaml:"max_transformer_actions"`
+}
+
+type Config struct {
+	// Default is the quota of each namespace, quotas are disabled if it's zero.
+	Default Quota `yaml:"default"`
+	// Namespaces overrides the default quota by names of namespaces.
+	Namespaces map[string]Quota `yaml:"namespaces"`
+	// Roles restricts subscriptions of a namespace to principals granted one of the roles, namespaces which
+	// aren't listed are open to everyone.
+	Roles map[string][]string `yaml:"roles"`
+}
+
+// Authorize returns ErrPermissionDenied if the principal of the request may not put subscriptions into the
+// namespace, so that quotas of a namespace can't be taken by other teams.
+func (c Config) Authorize(ctx context.Context, namespace string) error {
+	roles, ok := c.Roles[namespace]
+	if !ok {
+		return nil
+	}
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		for _, role := range roles {
+			if p.HasRole(role) {
+				return nil
+			}
+		}
+	}
+	return errors.ErrPermissionDenied.WithMessage(
+		fmt.Sprintf("subscriptions of namespace %s are restricted to roles %v", namespace, roles))
+}
+
+func (c Config) QuotaOf(namespace string) Quota {
+	if q, ok := c.Namespaces[namespace]; ok {
+		return q
+	}
+	return c.Default
+}
+
+// Of returns the namespace of the subscription.
+func Of(sub *metadata.Subscription) string {
+	if sub.Namespace == "" {
+		return Default
+	}
+	return sub.Namespace
+}
+
+// Usage is resources taken by subscriptions of a namespace, disabled subscriptions are counted as well, so
+// that resuming them doesn't exceed quotas.
+type Usage struct {
+	Subscriptions      int
+	DeliveryRate       uint64
+	TransformerActions int
+}
+
+func (u *Usage) Add(sub *metadata.Subscription) {
+	u.Subscriptions++
+	u.DeliveryRate += uint64(sub.Config.RateLimit)
+	u.TransformerActions += transformerActions(sub)
+}
+
+func transformerActions(sub *metadata.Subscription) int {
+	if !sub.Transformer.Exist() {
+		return 0
+	}
+	n := len(sub.Transformer.Pipeline)
+	if sub.Transformer.Template != "" {
+		n++
+	}
+	return n
+}
+
+// Check returns ErrQuotaExceeded if adding sub to usage of the namespace exceeds the quota.
+func (q Quota) Check(namespace string, usage Usage, sub *metadata.Subscription) error {
+	if q.MaxSubscriptions > 0 && usage.Subscriptions+1 > q.MaxSubscriptions {
+		return errors.ErrQuotaExceeded.WithMessage(fmt.Sprintf(
+			"namespace %s has reached the quota of %d subscriptions", namespace, q.MaxSubscriptions))
+	}
+	if q.MaxDeliveryRate > 0 {
+		rate := uint64(sub.Config.RateLimit)
+		if rate == 0 {
+			return errors.ErrQuotaExceeded.WithMessage(fmt.Sprintf(
+				"namespace %s has a delivery rate quota, the rate limit of the subscription must be set", namespace))
+		}
+		if usage.DeliveryRate+rate > q.MaxDeliveryRate {
+			return errors.ErrQuotaExceeded.WithMessage(fmt.Sprintf(
+				"namespace %s delivers %d events per second, adding %d exceeds the quota of %d",
+				namespace, usage.DeliveryRate, rate, q.MaxDeliveryRate))
+		}
+	}
+	if q.MaxTransformerActions > 0 {
+		n := transformerActions(sub)
+		if n > 0 && usage.TransformerActions+n > q.MaxTransformerActions {
+			return errors.ErrQuotaExceeded.WithMessage(fmt.Sprintf(
+				"namespace %s has %d transformer actions, adding %d exceeds the quota of %d",
+				namespace, usage.TransformerActions, n, q.MaxTransformerActions))
+		}
+	}
+	return nil
+}
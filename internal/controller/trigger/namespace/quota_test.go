@@ -0,0 +1,96 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConfig_QuotaOf(t *testing.T) {
+	Convey("test quota of namespaces", t, func() {
+		c := Config{
+			Default:    Quota{MaxSubscriptions: 10},
+			Namespaces: map[string]Quota{"team": {MaxSubscriptions: 2}},
+		}
+		So(c.QuotaOf("team").MaxSubscriptions, ShouldEqual, 2)
+		So(c.QuotaOf(Default).MaxSubscriptions, ShouldEqual, 10)
+		So(Of(&metadata.Subscription{}), ShouldEqual, Default)
+		So(Of(&metadata.Subscription{Namespace: "team"}), ShouldEqual, "team")
+	})
+}
+
+func TestQuota_Check(t *testing.T) {
+	Convey("test check quota", t, func() {
+		sub := &metadata.Subscription{
+			Config: primitive.SubscriptionConfig{RateLimit: 100},
+			Transformer: &primitive.Transformer{
+				Pipeline: []*primitive.Action{{}, {}},
+				Template: "{}",
+			},
+		}
+		usage := Usage{}
+		usage.Add(sub)
+		So(usage.Subscriptions, ShouldEqual, 1)
+		So(usage.DeliveryRate, ShouldEqual, 100)
+		So(usage.TransformerActions, ShouldEqual, 3)
+
+		Convey("unlimited", func() {
+			So(Quota{}.Check(Default, usage, sub), ShouldBeNil)
+		})
+
+		Convey("subscriptions", func() {
+			q := Quota{MaxSubscriptions: 2}
+			So(q.Check(Default, usage, sub), ShouldBeNil)
+			usage.Add(sub)
+			So(errors.Is(q.Check(Default, usage, sub), errors.ErrQuotaExceeded), ShouldBeTrue)
+		})
+
+		Convey("delivery rate", func() {
+			q := Quota{MaxDeliveryRate: 200}
+			So(q.Check(Default, usage, sub), ShouldBeNil)
+			So(q.Check(Default, usage, &metadata.Subscription{}), ShouldNotBeNil)
+			usage.Add(sub)
+			So(errors.Is(q.Check(Default, usage, sub), errors.ErrQuotaExceeded), ShouldBeTrue)
+		})
+
+		Convey("transformer actions", func() {
+			q := Quota{MaxTransformerActions: 4}
+			So(q.Check(Default, usage, sub), ShouldNotBeNil)
+			So(q.Check(Default, usage, &metadata.Subscription{}), ShouldBeNil)
+		})
+	})
+}
+
+func TestConfig_Authorize(t *testing.T) {
+	Convey("test authorize namespaces", t, func() {
+		c := Config{Roles: map[string][]string{"team": {"team", "ops"}}}
+		ctx := context.Background()
+		So(c.Authorize(ctx, Default), ShouldBeNil)
+
+		err := c.Authorize(ctx, "team")
+		So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		err = c.Authorize(auth.WithPrincipal(ctx, &auth.Principal{Name: "bob", Roles: []string{"dev"}}), "team")
+		So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		So(c.Authorize(auth.WithPrincipal(ctx, &auth.Principal{Name: "alice", Roles: []string{"ops"}}), "team"),
+			ShouldBeNil)
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"sort"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/namespace"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+// checkQuota checks sub against the quota of its namespace, the subscription except isn't counted, e.g. the
+// one being updated.
+func (ctrl *controller) checkQuota(ctx context.Context, sub *metadata.Subscription, except vanus.ID) error {
+	ns := namespace.Of(sub)
+	q := ctrl.config.Namespace.QuotaOf(ns)
+	if q == (namespace.Quota{}) {
+		// The namespace is unlimited.
+		return nil
+	}
+	usage := namespace.Usage{}
+	for _, s := range ctrl.subscriptionManager.ListSubscription(ctx) {
+		if s.ID != except && namespace.Of(s) == ns {
+			usage.Add(s)
+		}
+	}
+	if err := q.Check(ns, usage, sub); err != nil {
+		log.Info(ctx, "the subscription exceeds the quota of namespace", map[string]interface{}{
+			log.KeyError: err,
+			"namespace":  ns,
+			"name":       sub.Name,
+		})
+		return err
+	}
+	return nil
+}
+
+func (ctrl *controller) usageOfNamespaces(ctx context.Context) map[string]*namespace.Usage {
+	usages := make(map[string]*namespace.Usage)
+	for ns := range ctrl.config.Namespace.Namespaces {
+		usages[ns] = &namespace.Usage{}
+	}
+	for _, sub := range ctrl.subscriptionManager.ListSubscription(ctx) {
+		ns := namespace.Of(sub)
+		usage, ok := usages[ns]
+		if !ok {
+			usage = &namespace.Usage{}
+			usages[ns] = usage
+		}
+		usage.Add(sub)
+	}
+	return usages
+}
+
+func (ctrl *controller) ListNamespaceUsage(ctx context.Context,
+	request *ctrlpb.ListNamespaceUsageRequest) (*ctrlpb.ListNamespaceUsageResponse, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	usages := ctrl.usageOfNamespaces(ctx)
+	if request.Namespace != "" {
+		usage, ok := usages[request.Namespace]
+		if !ok {
+			usage = &namespace.Usage{}
+		}
+		usages = map[string]*namespace.Usage{request.Namespace: usage}
+	}
+
+	resp := &ctrlpb.ListNamespaceUsageResponse{Usages: make([]*ctrlpb.NamespaceUsage, 0, len(usages))}
+	for ns, usage := range usages {
+		q := ctrl.config.Namespace.QuotaOf(ns)
+		resp.Usages = append(resp.Usages, &ctrlpb.NamespaceUsage{
+			Namespace:          ns,
+			Subscriptions:      uint32(usage.Subscriptions),
+			DeliveryRate:       usage.DeliveryRate,
+			TransformerActions: uint32(usage.TransformerActions),
+			Quota: &ctrlpb.NamespaceQuota{
+				MaxSubscriptions:      uint32(q.MaxSubscriptions),
+				MaxDeliveryRate:       q.MaxDeliveryRate,
+				MaxTransformerActions: uint32(q.MaxTransformerActions),
+			},
+		})
+	}
+	sort.Slice(resp.Usages, func(i, j int) bool {
+		return resp.Usages[i].Namespace < resp.Usages[j].Namespace
+	})
+	return resp, nil
+}
@@ -0,0 +1,327 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	jobKindRebalance job.Kind = "subscription-rebalance"
+	// jobKeyRebalance keeps at most one rebalance running.
+	jobKeyRebalance  = "rebalance"
+	jobParamPlanID   = "plan_id"
+	jobParamMoves    = "moves"
+	rebalanceRetries = 3
+
+	// rebalancePlanTTL is how long a plan waits for approval, e.g. until a maintenance window. Moves which
+	// are out of date by then are skipped.
+	rebalancePlanTTL = 24 * time.Hour
+)
+
+type rebalancePlan struct {
+	pb    *ctrlpb.RebalancePlan
+	moves []worker.RebalanceMove
+}
+
+// rebalancePlans keeps plans waiting for approval in memory of the leader, plans are lost if the leader
+// changes, and they should be planned again.
+type rebalancePlans struct {
+	mutex sync.Mutex
+	plans map[vanus.ID]*rebalancePlan
+}
+
+func (p *rebalancePlans) add(plan *rebalancePlan, now time.Time) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.plans == nil {
+		p.plans = make(map[vanus.ID]*rebalancePlan)
+	}
+	for id, old := range p.plans {
+		if now.UnixMilli() >= old.pb.ExpiresAt {
+			delete(p.plans, id)
+		}
+	}
+	p.plans[vanus.NewIDFromUint64(plan.pb.Id)] = plan
+}
+
+func (p *rebalancePlans) get(id vanus.ID, now time.Time) *rebalancePlan {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	plan, ok := p.plans[id]
+	if !ok {
+		return nil
+	}
+	if now.UnixMilli() >= plan.pb.ExpiresAt {
+		delete(p.plans, id)
+		return nil
+	}
+	return plan
+}
+
+func (ctrl *controller) PlanRebalance(ctx context.Context,
+	request *ctrlpb.PlanRebalanceRequest) (*ctrlpb.RebalancePlan, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	if _, ok := ctrlpb.RebalanceMode_name[int32(request.Mode)]; !ok {
+		return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("unknown rebalance mode: %d", request.Mode))
+	}
+	if request.MaxMoves < 0 {
+		return nil, errors.ErrInvalidRequest.WithMessage("max moves must not be negative")
+	}
+
+	loads := ctrl.triggerWorkerLoads(ctx)
+	moves := worker.PlanRebalance(loads, ctrl.config.WorkerAdmission, int(request.MaxMoves))
+	id, err := vanus.NewID()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	plan := &rebalancePlan{
+		pb: &ctrlpb.RebalancePlan{
+			Id:        id.Uint64(),
+			Mode:      request.Mode,
+			CreatedAt: now.UnixMilli(),
+			ExpiresAt: now.Add(rebalancePlanTTL).UnixMilli(),
+		},
+		moves: moves,
+	}
+	ctrl.fillRebalancePlan(ctx, plan.pb, loads, moves)
+	log.Info(ctx, "plan rebalance of subscriptions", map[string]interface{}{
+		"plan_id": id.Key(),
+		"mode":    request.Mode.String(),
+		"moves":   len(moves),
+	})
+	if len(moves) == 0 {
+		return plan.pb, nil
+	}
+	if request.Mode == ctrlpb.RebalanceMode_REBALANCE_AUTO {
+		if err = ctrl.executeRebalance(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+	ctrl.rebalancePlans.add(plan, now)
+	return plan.pb, nil
+}
+
+func (ctrl *controller) ApproveRebalance(ctx context.Context,
+	request *ctrlpb.ApproveRebalanceRequest) (*ctrlpb.RebalancePlan, error) {
+	if ctrl.state != primitive.ServerStateRunning {
+		return nil, errors.ErrServerNotStart
+	}
+	id := vanus.NewIDFromUint64(request.PlanId)
+	plan := ctrl.rebalancePlans.get(id, time.Now())
+	if plan == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf(
+			"rebalance plan %s not found, it may have expired or the controller leader has changed", id.Key()))
+	}
+	// approving a plan twice returns the job of the first approval.
+	if plan.pb.JobId == 0 {
+		if err := ctrl.executeRebalance(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+	return plan.pb, nil
+}
+
+// triggerWorkerLoads returns running trigger workers and running subscriptions assigned to them, others are
+// changing phases and aren't moved.
+func (ctrl *controller) triggerWorkerLoads(ctx context.Context) []worker.WorkerLoad {
+	twInfos := ctrl.workerManager.GetActiveRunningTriggerWorker()
+	loads := make([]worker.WorkerLoad, 0, len(twInfos))
+	for _, twInfo := range twInfos {
+		load := worker.WorkerLoad{Info: twInfo}
+		if tWorker := ctrl.workerManager.GetTriggerWorker(twInfo.Addr); tWorker != nil {
+			for _, subID := range tWorker.GetAssignedSubscriptions() {
+				sub := ctrl.subscriptionManager.GetSubscription(ctx, subID)
+				if sub != nil && sub.Phase == metadata.SubscriptionPhaseRunning {
+					load.Subscriptions = append(load.Subscriptions, sub)
+				}
+			}
+		}
+		loads = append(loads, load)
+	}
+	return loads
+}
+
+func (ctrl *controller) fillRebalancePlan(ctx context.Context, pb *ctrlpb.RebalancePlan,
+	loads []worker.WorkerLoad, moves []worker.RebalanceMove) {
+	counts := make(map[string]int, len(loads))
+	for i := range loads {
+		counts[loads[i].Info.Addr] = len(loads[i].Subscriptions)
+		pb.Workers = append(pb.Workers, &ctrlpb.TriggerWorkerLoad{
+			Addr:                loads[i].Info.Addr,
+			SubscriptionsBefore: int32(len(loads[i].Subscriptions)),
+		})
+	}
+	for _, m := range moves {
+		counts[m.From]--
+		counts[m.To]++
+		move := &ctrlpb.SubscriptionMove{
+			SubscriptionId:        m.SubscriptionID.Uint64(),
+			From:                  m.From,
+			To:                    m.To,
+			PausedEventsPerSecond: m.PausedEventsPerSecond,
+		}
+		if sub := ctrl.subscriptionManager.GetSubscription(ctx, m.SubscriptionID); sub != nil {
+			move.SubscriptionName = sub.Name
+		}
+		pb.Moves = append(pb.Moves, move)
+		pb.PausedEventsPerSecond += m.PausedEventsPerSecond
+	}
+	for _, w := range pb.Workers {
+		w.SubscriptionsAfter = int32(counts[w.Addr])
+	}
+	sort.Slice(pb.Workers, func(i, j int) bool {
+		return pb.Workers[i].Addr < pb.Workers[j].Addr
+	})
+}
+
+func (ctrl *controller) executeRebalance(ctx context.Context, plan *rebalancePlan) error {
+	data, err := json.Marshal(plan.moves)
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	planID := vanus.NewIDFromUint64(plan.pb.Id).Key()
+	j, err := ctrl.jobMgr.Submit(ctx, job.Spec{
+		Kind: jobKindRebalance,
+		Key:  jobKeyRebalance,
+		Params: map[string]string{
+			jobParamPlanID: planID,
+			jobParamMoves:  string(data),
+		},
+		MaxAttempts: rebalanceRetries,
+	})
+	if err != nil {
+		return err
+	}
+	if j.Params[jobParamPlanID] != planID {
+		return errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf(
+			"the rebalance job %s of plan %s is unfinished", j.ID.Key(), j.Params[jobParamPlanID]))
+	}
+	plan.pb.JobId = j.ID.Uint64()
+	return nil
+}
+
+// rebalanceJob executes moves of a plan in order, the cursor is the index of the next move and the number
+// of skipped moves.
+func (ctrl *controller) rebalanceJob(ctx context.Context, e *job.Execution) error {
+	var moves []worker.RebalanceMove
+	if err := json.Unmarshal([]byte(e.Params()[jobParamMoves]), &moves); err != nil {
+		return errors.ErrJSONUnMarshal.Wrap(err)
+	}
+	next, skipped := 0, 0
+	if cursor := e.Cursor(); cursor != "" {
+		n, s, _ := strings.Cut(cursor, ",")
+		next, _ = strconv.Atoi(n)
+		skipped, _ = strconv.Atoi(s)
+	}
+	for i := next; i < len(moves); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		moved, err := ctrl.moveSubscription(ctx, moves[i])
+		if err != nil {
+			return err
+		}
+		if !moved {
+			skipped++
+		}
+		err = e.Checkpoint(ctx, fmt.Sprintf("%d,%d", i+1, skipped), job.Progress{
+			Done:    int64(i + 1),
+			Total:   int64(len(moves)),
+			Message: fmt.Sprintf("%d moves are skipped since they are out of date", skipped),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveSubscription returns false if the move is out of date, e.g. the subscription has been disabled or
+// moved, or the target worker has left or doesn't match the node selector anymore.
+func (ctrl *controller) moveSubscription(ctx context.Context, m worker.RebalanceMove) (bool, error) {
+	sub := ctrl.subscriptionManager.GetSubscription(ctx, m.SubscriptionID)
+	if sub == nil || sub.Phase != metadata.SubscriptionPhaseRunning || sub.TriggerWorker != m.From {
+		return false, nil
+	}
+	target := ctrl.workerManager.GetTriggerWorker(m.To)
+	if target == nil || target.GetPhase() != metadata.TriggerWorkerPhaseRunning {
+		return false, nil
+	}
+	twInfo := target.GetInfo()
+	if !twInfo.Match(sub.NodeSelector) {
+		return false, nil
+	}
+	// Subscriptions are shared with readers of the manager, the move is made on a copy which replaces sub
+	// once it's stored.
+	moved := *sub
+	moved.TriggerWorker = m.To
+	moved.Phase = metadata.SubscriptionPhasePending
+	src := ctrl.workerManager.GetTriggerWorker(m.From)
+	if src != nil {
+		if err := src.UnAssignSubscription(sub.ID); err != nil {
+			// The subscription is still on the source worker, keep it assigned there.
+			src.AssignSubscription(sub.ID)
+			return false, err
+		}
+	}
+	if err := ctrl.subscriptionManager.UpdateSubscription(ctx, &moved); err != nil {
+		ctrl.rollbackMove(ctx, sub, src)
+		return false, err
+	}
+	metrics.CtrlTriggerGauge.WithLabelValues(m.From).Dec()
+	metrics.CtrlTriggerGauge.WithLabelValues(m.To).Inc()
+	ctrl.scheduler.EnqueueSubscription(sub.ID)
+	log.Info(ctx, "move subscription for rebalance", map[string]interface{}{
+		log.KeySubscriptionID: sub.ID,
+		"from":                m.From,
+		"to":                  m.To,
+	})
+	return true, nil
+}
+
+// rollbackMove assigns sub to the source worker again after the move failed to be stored. The update may
+// have been stored partially, e.g. before secrets failed, so sub is stored again as well.
+func (ctrl *controller) rollbackMove(ctx context.Context, sub *metadata.Subscription, src worker.TriggerWorker) {
+	if src != nil {
+		src.AssignSubscription(sub.ID)
+	}
+	if err := ctrl.subscriptionManager.UpdateSubscription(ctx, sub); err != nil {
+		log.Warning(ctx, "roll back subscription move failed", map[string]interface{}{
+			log.KeySubscriptionID: sub.ID,
+			log.KeyError:          err,
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	stdJson "encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
+	"github.com/linkall-labs/vanus/internal/controller/trigger/worker"
+	"github.com/linkall-labs/vanus/internal/controller/usage"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestController_MoveSubscription(t *testing.T) {
+	Convey("test move subscription", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
+
+		subID := vanus.NewTestID()
+		sub := &metadata.Subscription{
+			ID:            subID,
+			Phase:         metadata.SubscriptionPhaseRunning,
+			TriggerWorker: "a",
+			NodeSelector:  map[string]string{"zone": "z1"},
+		}
+		subManager.EXPECT().GetSubscription(gomock.Any(), subID).AnyTimes().Return(sub)
+		src := worker.NewMockTriggerWorker(mockCtrl)
+		workerManager.EXPECT().GetTriggerWorker("a").AnyTimes().Return(src)
+		target := worker.NewMockTriggerWorker(mockCtrl)
+		workerManager.EXPECT().GetTriggerWorker("b").AnyTimes().Return(target)
+		target.EXPECT().GetPhase().AnyTimes().Return(metadata.TriggerWorkerPhaseRunning)
+		target.EXPECT().GetInfo().AnyTimes().Return(metadata.TriggerWorkerInfo{
+			Addr: "b", Labels: map[string]string{"zone": "z1"},
+		})
+		m := worker.RebalanceMove{SubscriptionID: subID, From: "a", To: "b"}
+
+		Convey("move subscription", func() {
+			src.EXPECT().UnAssignSubscription(subID).Return(nil)
+			var stored *metadata.Subscription
+			subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, s *metadata.Subscription) error {
+					stored = s
+					return nil
+				})
+			moved, err := ctrl.moveSubscription(ctx, m)
+			So(err, ShouldBeNil)
+			So(moved, ShouldBeTrue)
+			So(stored, ShouldNotEqual, sub)
+			So(stored.TriggerWorker, ShouldEqual, "b")
+			So(stored.Phase, ShouldEqual, metadata.SubscriptionPhasePending)
+			So(sub.TriggerWorker, ShouldEqual, "a")
+			So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseRunning)
+		})
+
+		Convey("roll back if the move fails to be stored", func() {
+			src.EXPECT().UnAssignSubscription(subID).Return(nil)
+			gomock.InOrder(
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Not(sub)).Return(fmt.Errorf("error")),
+				src.EXPECT().AssignSubscription(subID),
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), sub).Return(nil),
+			)
+			moved, err := ctrl.moveSubscription(ctx, m)
+			So(err, ShouldNotBeNil)
+			So(moved, ShouldBeFalse)
+			So(sub.TriggerWorker, ShouldEqual, "a")
+			So(sub.Phase, ShouldEqual, metadata.SubscriptionPhaseRunning)
+		})
+
+		Convey("keep the subscription assigned if it fails to be unassigned", func() {
+			src.EXPECT().UnAssignSubscription(subID).Return(fmt.Errorf("error"))
+			src.EXPECT().AssignSubscription(subID)
+			moved, err := ctrl.moveSubscription(ctx, m)
+			So(err, ShouldNotBeNil)
+			So(moved, ShouldBeFalse)
+			So(sub.TriggerWorker, ShouldEqual, "a")
+		})
+
+		Convey("skip moves out of date", func() {
+			moved, err := ctrl.moveSubscription(ctx, worker.RebalanceMove{SubscriptionID: subID, From: "c", To: "b"})
+			So(err, ShouldBeNil)
+			So(moved, ShouldBeFalse)
+
+			sub.NodeSelector = map[string]string{"zone": "z2"}
+			moved, err = ctrl.moveSubscription(ctx, m)
+			So(err, ShouldBeNil)
+			So(moved, ShouldBeFalse)
+		})
+	})
+}
+
+func TestController_RebalanceJob(t *testing.T) {
+	Convey("test rebalance job", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+		ctrl := NewController(Config{}, nil, usage.NewStore(usage.Config{}))
+		ctx := context.Background()
+		workerManager := worker.NewMockManager(mockCtrl)
+		ctrl.workerManager = workerManager
+		subManager := subscription.NewMockManager(mockCtrl)
+		ctrl.subscriptionManager = subManager
+		ctrl.scheduler = worker.NewSubscriptionScheduler(ctrl.workerManager, ctrl.subscriptionManager,
+			worker.AdmissionConfig{})
+
+		src := worker.NewMockTriggerWorker(mockCtrl)
+		workerManager.EXPECT().GetTriggerWorker("a").AnyTimes().Return(src)
+		target := worker.NewMockTriggerWorker(mockCtrl)
+		workerManager.EXPECT().GetTriggerWorker("b").AnyTimes().Return(target)
+		target.EXPECT().GetPhase().AnyTimes().Return(metadata.TriggerWorkerPhaseRunning)
+		target.EXPECT().GetInfo().AnyTimes().Return(metadata.TriggerWorkerInfo{Addr: "b"})
+
+		// The second subscription has been deleted since the plan, so its move is skipped.
+		ids := []vanus.ID{vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID()}
+		moves := make([]worker.RebalanceMove, 0, len(ids))
+		for i, id := range ids {
+			moves = append(moves, worker.RebalanceMove{SubscriptionID: id, From: "a", To: "b"})
+			if i == 1 {
+				subManager.EXPECT().GetSubscription(gomock.Any(), id).AnyTimes().Return(nil)
+				continue
+			}
+			sub := &metadata.Subscription{ID: id, Phase: metadata.SubscriptionPhaseRunning, TriggerWorker: "a"}
+			subManager.EXPECT().GetSubscription(gomock.Any(), id).AnyTimes().Return(sub)
+		}
+		data, _ := stdJson.Marshal(moves)
+		e := job.NewTestExecution(map[string]string{jobParamMoves: string(data)})
+
+		Convey("execute moves in order", func() {
+			src.EXPECT().UnAssignSubscription(gomock.Any()).Times(2).Return(nil)
+			subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Times(2).Return(nil)
+			So(ctrl.rebalanceJob(ctx, e), ShouldBeNil)
+			So(e.Cursor(), ShouldEqual, "3,1")
+		})
+
+		Convey("resume from the cursor after a failed move", func() {
+			src.EXPECT().UnAssignSubscription(ids[0]).Return(nil)
+			src.EXPECT().UnAssignSubscription(ids[2]).Times(2).Return(nil)
+			src.EXPECT().AssignSubscription(ids[2])
+			gomock.InOrder(
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil),
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error")),
+				// the failed move is rolled back.
+				subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil),
+			)
+			So(ctrl.rebalanceJob(ctx, e), ShouldNotBeNil)
+			So(e.Cursor(), ShouldEqual, "2,1")
+
+			// The job is retried, the first two moves aren't executed again.
+			subManager.EXPECT().UpdateSubscription(gomock.Any(), gomock.Any()).Return(nil)
+			So(ctrl.rebalanceJob(ctx, e), ShouldBeNil)
+			So(e.Cursor(), ShouldEqual, "3,1")
+		})
+	})
+}
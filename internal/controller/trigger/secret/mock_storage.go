@@ -50,6 +50,20 @@ func (mr *MockStorageMockRecorder) Delete(ctx, subID interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorage)(nil).Delete), ctx, subID)
 }
 
+// DeleteSigning mocks base method.
+func (m *MockStorage) DeleteSigning(ctx context.Context, subID vanus.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSigning", ctx, subID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSigning indicates an expected call of DeleteSigning.
+func (mr *MockStorageMockRecorder) DeleteSigning(ctx, subID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSigning", reflect.TypeOf((*MockStorage)(nil).DeleteSigning), ctx, subID)
+}
+
 // Read mocks base method.
 func (m *MockStorage) Read(ctx context.Context, subID vanus.ID, credentialType primitive.CredentialType) (primitive.SinkCredential, error) {
 	m.ctrl.T.Helper()
@@ -65,6 +79,21 @@ func (mr *MockStorageMockRecorder) Read(ctx, subID, credentialType interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockStorage)(nil).Read), ctx, subID, credentialType)
 }
 
+// ReadSigning mocks base method.
+func (m *MockStorage) ReadSigning(ctx context.Context, subID vanus.ID) (*primitive.SigningConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadSigning", ctx, subID)
+	ret0, _ := ret[0].(*primitive.SigningConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadSigning indicates an expected call of ReadSigning.
+func (mr *MockStorageMockRecorder) ReadSigning(ctx, subID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadSigning", reflect.TypeOf((*MockStorage)(nil).ReadSigning), ctx, subID)
+}
+
 // Write mocks base method.
 func (m *MockStorage) Write(ctx context.Context, subID vanus.ID, credential primitive.SinkCredential) error {
 	m.ctrl.T.Helper()
@@ -78,3 +107,17 @@ func (mr *MockStorageMockRecorder) Write(ctx, subID, credential interface{}) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockStorage)(nil).Write), ctx, subID, credential)
 }
+
+// WriteSigning mocks base method.
+func (m *MockStorage) WriteSigning(ctx context.Context, subID vanus.ID, signing *primitive.SigningConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteSigning", ctx, subID, signing)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteSigning indicates an expected call of WriteSigning.
+func (mr *MockStorageMockRecorder) WriteSigning(ctx, subID, signing interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSigning", reflect.TypeOf((*MockStorage)(nil).WriteSigning), ctx, subID, signing)
+}
@@ -26,4 +26,7 @@ type Storage interface {
 	Read(ctx context.Context, subID vanus.ID, credentialType primitive.CredentialType) (primitive.SinkCredential, error)
 	Write(ctx context.Context, subID vanus.ID, credential primitive.SinkCredential) error
 	Delete(ctx context.Context, subID vanus.ID) error
+	ReadSigning(ctx context.Context, subID vanus.ID) (*primitive.SigningConfig, error)
+	WriteSigning(ctx context.Context, subID vanus.ID, signing *primitive.SigningConfig) error
+	DeleteSigning(ctx context.Context, subID vanus.ID) error
 }
@@ -86,6 +86,15 @@ func (f *fake) UpdateOffset(ctx context.Context, subscriptionID vanus.ID, info p
 	sub[info.EventLogID] = info
 	return nil
 }
+func (f *fake) SetOffsets(ctx context.Context, offsets map[vanus.ID]pInfo.ListOffsetInfo) error {
+	for subscriptionID, infos := range offsets {
+		for _, info := range infos {
+			_ = f.CreateOffset(ctx, subscriptionID, info)
+		}
+	}
+	return nil
+}
+
 func (f *fake) GetOffsets(ctx context.Context, subscriptionID vanus.ID) (pInfo.ListOffsetInfo, error) {
 	sub, exist := f.offset[subscriptionID]
 	if !exist {
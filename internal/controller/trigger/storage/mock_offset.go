@@ -79,6 +79,20 @@ func (mr *MockOffsetStorageMockRecorder) GetOffsets(ctx, subscriptionID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffsets", reflect.TypeOf((*MockOffsetStorage)(nil).GetOffsets), ctx, subscriptionID)
 }
 
+// SetOffsets mocks base method.
+func (m *MockOffsetStorage) SetOffsets(ctx context.Context, offsets map[vanus.ID]info.ListOffsetInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOffsets", ctx, offsets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOffsets indicates an expected call of SetOffsets.
+func (mr *MockOffsetStorageMockRecorder) SetOffsets(ctx, offsets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOffsets", reflect.TypeOf((*MockOffsetStorage)(nil).SetOffsets), ctx, offsets)
+}
+
 // UpdateOffset mocks base method.
 func (m *MockOffsetStorage) UpdateOffset(ctx context.Context, subscriptionID vanus.ID, info info.OffsetInfo) error {
 	m.ctrl.T.Helper()
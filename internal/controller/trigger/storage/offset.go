@@ -30,6 +30,8 @@ type OffsetStorage interface {
 	CreateOffset(ctx context.Context, subscriptionID vanus.ID, info info.OffsetInfo) error
 	UpdateOffset(ctx context.Context, subscriptionID vanus.ID, info info.OffsetInfo) error
 	GetOffsets(ctx context.Context, subscriptionID vanus.ID) (info.ListOffsetInfo, error)
+	// SetOffsets creates or updates offsets of subscriptions in batches.
+	SetOffsets(ctx context.Context, offsets map[vanus.ID]info.ListOffsetInfo) error
 	DeleteOffset(ctx context.Context, subscriptionID vanus.ID) error
 }
 
@@ -83,6 +85,22 @@ func (s *offsetStorage) UpdateOffset(ctx context.Context, subscriptionID vanus.I
 	return s.client.Update(ctx, s.getKey(subscriptionID, info.EventLogID), s.int64ToByteArr(info.Offset))
 }
 
+func (s *offsetStorage) SetOffsets(ctx context.Context, offsets map[vanus.ID]info.ListOffsetInfo) error {
+	var pairs []kv.Pair
+	for subscriptionID, infos := range offsets {
+		for _, info := range infos {
+			pairs = append(pairs, kv.Pair{
+				Key:   s.getKey(subscriptionID, info.EventLogID),
+				Value: s.int64ToByteArr(info.Offset),
+			})
+		}
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+	return s.client.BatchSet(ctx, pairs)
+}
+
 func (s *offsetStorage) GetOffsets(ctx context.Context, subscriptionID vanus.ID) (info.ListOffsetInfo, error) {
 	l, err := s.client.List(ctx, s.getSubKey(subscriptionID))
 	if err != nil {
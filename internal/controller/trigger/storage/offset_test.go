@@ -65,6 +65,30 @@ func TestUpdateOffset(t *testing.T) {
 	})
 }
 
+func TestSetOffsets(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvClient := kv.NewMockClient(ctrl)
+	s := NewOffsetStorage(kvClient).(*offsetStorage)
+	subID := vanus.ID(1)
+	eventLogID := vanus.ID(1)
+	offset := uint64(100)
+	Convey("set offsets", t, func() {
+		kvClient.EXPECT().BatchSet(ctx, []kv.Pair{
+			{Key: s.getKey(subID, eventLogID), Value: s.int64ToByteArr(offset)},
+		}).Return(nil)
+		err := s.SetOffsets(ctx, map[vanus.ID]info.ListOffsetInfo{
+			subID: {{EventLogID: eventLogID, Offset: offset}},
+		})
+		So(err, ShouldBeNil)
+	})
+	Convey("set no offsets", t, func() {
+		err := s.SetOffsets(ctx, map[vanus.ID]info.ListOffsetInfo{})
+		So(err, ShouldBeNil)
+	})
+}
+
 func TestGetOffset(t *testing.T) {
 	ctx := context.Background()
 	ctrl := gomock.NewController(t)
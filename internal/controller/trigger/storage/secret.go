@@ -147,3 +147,46 @@ func (p *SecretStorage) Delete(ctx context.Context, subID vanus.ID) error {
 	key := p.getKey(subID)
 	return p.client.Delete(ctx, key)
 }
+
+func (p *SecretStorage) getSigningKey(subID vanus.ID) string {
+	return path.Join(KeyPrefixSecret.String(), "signing", subID.String())
+}
+
+func (p *SecretStorage) ReadSigning(ctx context.Context, subID vanus.ID) (*primitive.SigningConfig, error) {
+	v, err := p.client.Get(ctx, p.getSigningKey(subID))
+	if err != nil {
+		return nil, err
+	}
+	signing := &primitive.SigningConfig{}
+	if err = json.Unmarshal(v, signing); err != nil {
+		return nil, errors.ErrJSONUnMarshal.Wrap(err)
+	}
+	for i := range signing.Keys {
+		secret, err := crypto.AESDecrypt(signing.Keys[i].Secret, p.cipherKey)
+		if err != nil {
+			return nil, errors.ErrAESDecrypt.Wrap(err)
+		}
+		signing.Keys[i].Secret = secret
+	}
+	return signing, nil
+}
+
+func (p *SecretStorage) WriteSigning(ctx context.Context, subID vanus.ID, signing *primitive.SigningConfig) error {
+	save := &primitive.SigningConfig{Method: signing.Method, Keys: make([]primitive.SigningKey, len(signing.Keys))}
+	for i, k := range signing.Keys {
+		secret, err := crypto.AESEncrypt(k.Secret, p.cipherKey)
+		if err != nil {
+			return errors.ErrAESEncrypt.Wrap(err)
+		}
+		save.Keys[i] = primitive.SigningKey{ID: k.ID, Secret: secret}
+	}
+	v, err := json.Marshal(save)
+	if err != nil {
+		return errors.ErrJSONMarshal.Wrap(err)
+	}
+	return p.client.Set(ctx, p.getSigningKey(subID), v)
+}
+
+func (p *SecretStorage) DeleteSigning(ctx context.Context, subID vanus.ID) error {
+	return p.client.Delete(ctx, p.getSigningKey(subID))
+}
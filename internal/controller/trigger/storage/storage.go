@@ -39,11 +39,16 @@ func NewStorage(config primitive.KvStorageConfig) (Storage, error) {
 	if err != nil {
 		return nil, err
 	}
+	return NewStorageWithClient(client), nil
+}
+
+// NewStorageWithClient returns a Storage on client, which may be shared with others but is closed by Close.
+func NewStorageWithClient(client kv.Client) Storage {
 	s := &storage{client: client}
 	s.SubscriptionStorage = NewSubscriptionStorage(client)
 	s.OffsetStorage = NewOffsetStorage(client)
 	s.TriggerWorkerStorage = NewTriggerWorkerStorage(client)
-	return s, nil
+	return s
 }
 
 func (s *storage) Close() {
@@ -66,6 +66,21 @@ func (mr *MockManagerMockRecorder) DeleteSubscription(ctx, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockManager)(nil).DeleteSubscription), ctx, id)
 }
 
+// ExportOffset mocks base method.
+func (m *MockManager) ExportOffset(ctx context.Context, id vanus.ID) ([]ExportedOffset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOffset", ctx, id)
+	ret0, _ := ret[0].([]ExportedOffset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOffset indicates an expected call of ExportOffset.
+func (mr *MockManagerMockRecorder) ExportOffset(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOffset", reflect.TypeOf((*MockManager)(nil).ExportOffset), ctx, id)
+}
+
 // GetOffset mocks base method.
 func (m *MockManager) GetOffset(ctx context.Context, id vanus.ID) (info.ListOffsetInfo, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +139,20 @@ func (mr *MockManagerMockRecorder) Heartbeat(ctx, id, addr, time interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockManager)(nil).Heartbeat), ctx, id, addr, time)
 }
 
+// ImportOffset mocks base method.
+func (m *MockManager) ImportOffset(ctx context.Context, id vanus.ID, offsets info.ListOffsetInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportOffset", ctx, id, offsets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportOffset indicates an expected call of ImportOffset.
+func (mr *MockManagerMockRecorder) ImportOffset(ctx, id, offsets interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportOffset", reflect.TypeOf((*MockManager)(nil).ImportOffset), ctx, id, offsets)
+}
+
 // Init mocks base method.
 func (m *MockManager) Init(ctx context.Context) error {
 	m.ctrl.T.Helper()
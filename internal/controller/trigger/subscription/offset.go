@@ -16,13 +16,28 @@ package subscription
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
+// ExportedOffset is a committed offset with the time when the event at it was written, so that it can be
+// re-mapped by time in another cluster, where offsets of the same event differ.
+type ExportedOffset struct {
+	info.OffsetInfo
+	// Timestamp is in milliseconds, 0 means the offset is at the end of the eventlog.
+	Timestamp int64
+}
+
 func (m *manager) SaveOffset(ctx context.Context, id vanus.ID, offsets info.ListOffsetInfo, commit bool) error {
 	subscription := m.GetSubscription(ctx, id)
 	if subscription == nil {
@@ -67,6 +82,104 @@ func (m *manager) GetOffset(ctx context.Context, id vanus.ID) (info.ListOffsetIn
 	return offsets, nil
 }
 
+func (m *manager) ExportOffset(ctx context.Context, id vanus.ID) ([]ExportedOffset, error) {
+	subscription := m.GetSubscription(ctx, id)
+	if subscription == nil {
+		return nil, ErrSubscriptionNotExist
+	}
+	offsets, err := m.offsetManager.GetOffset(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	bus := m.ebCli.Eventbus(ctx, subscription.EventBus)
+	logs, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	logMap := make(map[uint64]api.Eventlog, len(logs))
+	for _, l := range logs {
+		logMap[l.ID()] = l
+	}
+	result := make([]ExportedOffset, 0, len(logs))
+	for _, o := range offsets {
+		// offsets of the retry eventbus aren't exported.
+		l, ok := logMap[o.EventLogID.Uint64()]
+		if !ok {
+			continue
+		}
+		ts, err := readEventTime(ctx, bus, l, int64(o.Offset))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ExportedOffset{OffsetInfo: o, Timestamp: ts})
+	}
+	return result, nil
+}
+
+// readEventTime returns the time when the event at off was written, events before the earliest offset have
+// expired, so the earliest event is read instead.
+func readEventTime(ctx context.Context, bus api.Eventbus, l api.Eventlog, off int64) (int64, error) {
+	earliest, err := l.EarliestOffset(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if off < earliest {
+		off = earliest
+	}
+	events, _, _, err := bus.Reader(
+		option.WithDisablePolling(),
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(l, off)),
+		option.WithBatchSize(1),
+	).Read(ctx)
+	if err != nil {
+		if errors.Is(err, errors.ErrOffsetOnEnd) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+	v, ok := events[0].Extensions()[segpb.XVanusStime]
+	if !ok {
+		return 0, fmt.Errorf("the event at offset %d of eventlog %d has no write time", off, l.ID())
+	}
+	t, err := types.ToTime(v)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixMilli(), nil
+}
+
+func (m *manager) ImportOffset(ctx context.Context, id vanus.ID, offsets info.ListOffsetInfo) error {
+	subscription := m.GetSubscription(ctx, id)
+	if subscription == nil {
+		return ErrSubscriptionNotExist
+	}
+	logs, err := m.ebCli.Eventbus(ctx, subscription.EventBus).ListLog(ctx)
+	if err != nil {
+		return err
+	}
+	logIDs := make(map[uint64]struct{}, len(logs))
+	for _, l := range logs {
+		logIDs[l.ID()] = struct{}{}
+	}
+	for _, o := range offsets {
+		if _, ok := logIDs[o.EventLogID.Uint64()]; !ok {
+			return errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("eventlog %s doesn't belong to eventbus %s", o.EventLogID, subscription.EventBus))
+		}
+	}
+	if err = m.offsetManager.Offset(ctx, id, offsets, true); err != nil {
+		return err
+	}
+	log.Info(ctx, "import offset", map[string]interface{}{
+		log.KeySubscriptionID: id,
+		"offsets":             offsets,
+	})
+	return nil
+}
+
 func (m *manager) GetOrSaveOffset(ctx context.Context, id vanus.ID) (info.ListOffsetInfo, error) {
 	subscription := m.GetSubscription(ctx, id)
 	if subscription == nil {
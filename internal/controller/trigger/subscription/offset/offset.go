@@ -18,6 +18,7 @@ package offset
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/controller/trigger/storage"
@@ -39,6 +40,10 @@ const (
 	defaultCloseWaitTime  = 2 * time.Second
 )
 
+// manager caches offsets of subscriptions and writes them back to the storage periodically, offsets of all
+// subscriptions changed in an interval are flushed in a batch. Offsets which haven't been flushed are lost
+// if the controller crashes, so events after the flushed offsets are delivered again by the new leader,
+// which is acceptable as triggers deliver events at least once.
 type manager struct {
 	subscriptionOffset sync.Map
 	storage            storage.OffsetStorage
@@ -47,6 +52,9 @@ type manager struct {
 	ctx                context.Context
 	stop               context.CancelFunc
 	wg                 sync.WaitGroup
+	// flushMutex serializes flushes and removing subscriptions, so that offsets of a removed
+	// subscription won't be written back after they are deleted.
+	flushMutex sync.Mutex
 }
 
 func NewOffsetManager(storage storage.OffsetStorage, commitInterval time.Duration) Manager {
@@ -70,6 +78,7 @@ func (m *manager) GetOffset(ctx context.Context, subscriptionID vanus.ID) (info.
 	return subOffset.getOffsets(), nil
 }
 
+// Offset updates offsets in the cache, they are written to the storage immediately if commit is true.
 func (m *manager) Offset(ctx context.Context, subscriptionID vanus.ID, offsets info.ListOffsetInfo, commit bool) error {
 	subOffset, err := m.getSubscriptionOffset(ctx, subscriptionID)
 	if err != nil {
@@ -77,7 +86,7 @@ func (m *manager) Offset(ctx context.Context, subscriptionID vanus.ID, offsets i
 	}
 	subOffset.offset(offsets)
 	if commit {
-		subOffset.commitOffset(ctx, m.storage)
+		return m.flush(ctx, []*subscriptionOffset{subOffset})
 	}
 	return nil
 }
@@ -95,10 +104,12 @@ func (m *manager) getSubscriptionOffset(ctx context.Context, id vanus.ID) (*subs
 }
 
 func (m *manager) RemoveRegisterSubscription(ctx context.Context, id vanus.ID) error {
+	m.flushMutex.Lock()
+	defer m.flushMutex.Unlock()
 	subOffset, exist := m.subscriptionOffset.Load(id)
 	if exist {
 		// stop commit
-		subOffset.(*subscriptionOffset).stop()
+		subOffset.(*subscriptionOffset).stopped = true
 		m.subscriptionOffset.Delete(id)
 	}
 	return m.storage.DeleteOffset(ctx, id)
@@ -130,24 +141,63 @@ func (m *manager) Start() {
 }
 
 func (m *manager) commit(ctx context.Context) {
-	var wg sync.WaitGroup
+	var subOffsets []*subscriptionOffset
 	m.subscriptionOffset.Range(func(key, value interface{}) bool {
-		_subscriptionOffset, _ := value.(*subscriptionOffset)
-		wg.Add(1)
-		go func(_subscriptionOffset *subscriptionOffset) {
-			defer wg.Done()
-			_subscriptionOffset.commitOffset(ctx, m.storage)
-		}(_subscriptionOffset)
+		subOffsets = append(subOffsets, value.(*subscriptionOffset))
 		return true
 	})
-	wg.Wait()
+	if err := m.flush(ctx, subOffsets); err != nil {
+		log.Warning(ctx, "commit offset fail", map[string]interface{}{
+			"subscriptions": len(subOffsets),
+			log.KeyError:    err,
+		})
+	}
+}
+
+// flush writes offsets changed since the last flush in a batch. Offsets stay dirty if the write fails, so
+// they are retried in the next flush.
+func (m *manager) flush(ctx context.Context, subOffsets []*subscriptionOffset) error {
+	m.flushMutex.Lock()
+	defer m.flushMutex.Unlock()
+	batch := make(map[vanus.ID]info.ListOffsetInfo)
+	var flushing []*eventLogOffset
+	var values []uint64
+	for _, subOffset := range subOffsets {
+		if subOffset.stopped {
+			continue
+		}
+		subOffset.offsets.Range(func(key, value interface{}) bool {
+			elOffset, _ := value.(*eventLogOffset)
+			offset, dirty := elOffset.dirty()
+			if dirty {
+				batch[subOffset.subscriptionID] = append(batch[subOffset.subscriptionID], info.OffsetInfo{
+					EventLogID: elOffset.eventLogID,
+					Offset:     offset,
+				})
+				flushing = append(flushing, elOffset)
+				values = append(values, offset)
+			}
+			return true
+		})
+	}
+	if len(flushing) == 0 {
+		return nil
+	}
+	if err := m.storage.SetOffsets(ctx, batch); err != nil {
+		return err
+	}
+	for i, elOffset := range flushing {
+		elOffset.commit = values[i]
+		elOffset.persisted = true
+	}
+	return nil
 }
 
 type subscriptionOffset struct {
 	subscriptionID vanus.ID
 	offsets        sync.Map
-	stopped        bool
-	lock           sync.Mutex
+	// stopped is guarded by flushMutex of the manager.
+	stopped bool
 }
 
 func initSubscriptionOffset(ctx context.Context,
@@ -162,11 +212,10 @@ func initSubscriptionOffset(ctx context.Context,
 	}
 	for _, o := range list {
 		subOffset.offsets.Store(o.EventLogID, &eventLogOffset{
-			subscriptionID: subscriptionID,
-			eventLogID:     o.EventLogID,
-			offset:         o.Offset,
-			commit:         o.Offset,
-			checkExist:     true,
+			eventLogID: o.EventLogID,
+			offset:     o.Offset,
+			commit:     o.Offset,
+			persisted:  true,
 		})
 	}
 	return subOffset, nil
@@ -177,9 +226,8 @@ func (o *subscriptionOffset) getEventLogOffset(info info.OffsetInfo) *eventLogOf
 	elOffset, exist := o.offsets.Load(info.EventLogID)
 	if !exist {
 		elOffset = &eventLogOffset{
-			subscriptionID: o.subscriptionID,
-			eventLogID:     info.EventLogID,
-			offset:         info.Offset,
+			eventLogID: info.EventLogID,
+			offset:     info.Offset,
 		}
 		elOffset, _ = o.offsets.LoadOrStore(info.EventLogID, elOffset)
 	}
@@ -199,81 +247,31 @@ func (o *subscriptionOffset) getOffsets() info.ListOffsetInfo {
 		elOffset, _ := value.(*eventLogOffset)
 		offsets = append(offsets, info.OffsetInfo{
 			EventLogID: elOffset.eventLogID,
-			Offset:     elOffset.offset,
+			Offset:     elOffset.getOffset(),
 		})
 		return true
 	})
 	return offsets
 }
 
-func (o *subscriptionOffset) stop() {
-	o.lock.Lock()
-	defer o.lock.Unlock()
-	o.stopped = true
-}
-
-func (o *subscriptionOffset) commitOffset(ctx context.Context, storage storage.OffsetStorage) {
-	o.offsets.Range(func(key, value interface{}) bool {
-		o.lock.Lock()
-		defer o.lock.Unlock()
-		if o.stopped {
-			return false
-		}
-		elOffset, _ := value.(*eventLogOffset)
-		err := elOffset.commitOffset(ctx, storage)
-		if err != nil {
-			log.Warning(ctx, "commit offset fail", map[string]interface{}{
-				log.KeySubscriptionID: o.subscriptionID,
-				log.KeyEventlogID:     elOffset.eventLogID,
-				"offset":              elOffset.offset,
-				log.KeyError:          err,
-			})
-		}
-		return true
-	})
-}
-
 type eventLogOffset struct {
-	subscriptionID vanus.ID
-	eventLogID     vanus.ID
-	offset         uint64
-	commit         uint64
-	checkExist     bool
+	eventLogID vanus.ID
+	offset     uint64
+	// commit and persisted are guarded by flushMutex of the manager.
+	commit    uint64
+	persisted bool
 }
 
 func (o *eventLogOffset) setOffset(offset uint64) {
-	o.offset = offset
+	atomic.StoreUint64(&o.offset, offset)
 }
 
-func (o *eventLogOffset) commitOffset(ctx context.Context, storage storage.OffsetStorage) error {
-	offset := o.offset
-	if !o.checkExist {
-		err := storage.CreateOffset(ctx, o.subscriptionID, info.OffsetInfo{
-			EventLogID: o.eventLogID,
-			Offset:     offset,
-		})
-		if err != nil {
-			return err
-		}
-		log.Info(ctx, "create offset", map[string]interface{}{
-			log.KeySubscriptionID: o.subscriptionID,
-			log.KeyEventlogID:     o.eventLogID,
-			"offset":              offset,
-		})
-		o.checkExist = true
-		o.commit = offset
-		return nil
-	}
-	if o.commit == offset {
-		return nil
-	}
-	err := storage.UpdateOffset(ctx, o.subscriptionID, info.OffsetInfo{
-		EventLogID: o.eventLogID,
-		Offset:     offset,
-	})
-	if err != nil {
-		return err
-	}
-	o.commit = offset
-	return nil
+func (o *eventLogOffset) getOffset() uint64 {
+	return atomic.LoadUint64(&o.offset)
+}
+
+// dirty returns the current offset and whether it needs to be flushed.
+func (o *eventLogOffset) dirty() (uint64, bool) {
+	offset := o.getOffset()
+	return offset, !o.persisted || offset != o.commit
 }
@@ -84,8 +84,11 @@ func TestSetOffset(t *testing.T) {
 		})
 		Convey("set offset with commit", func() {
 			storage.EXPECT().GetOffsets(gomock.Any(), subscriptionID).Return(info.ListOffsetInfo{}, nil)
-			storage.EXPECT().CreateOffset(gomock.Any(), subscriptionID, gomock.Any()).Return(nil)
-			m.Offset(ctx, subscriptionID, []info.OffsetInfo{{EventLogID: eventLogID, Offset: offset}}, true)
+			storage.EXPECT().SetOffsets(gomock.Any(), map[vanus.ID]info.ListOffsetInfo{
+				subscriptionID: {{EventLogID: eventLogID, Offset: offset}},
+			}).Return(nil)
+			err := m.Offset(ctx, subscriptionID, []info.OffsetInfo{{EventLogID: eventLogID, Offset: offset}}, true)
+			So(err, ShouldBeNil)
 			offsets, _ := m.GetOffset(ctx, subscriptionID)
 			So(len(offsets), ShouldEqual, 1)
 			So(offsets[0].Offset, ShouldEqual, offset)
@@ -103,29 +106,63 @@ func TestCommit(t *testing.T) {
 	subscriptionID := vanus.ID(1)
 	eventLogID := vanus.ID(1)
 	offset := uint64(1)
+	batch := func(offset uint64) map[vanus.ID]info.ListOffsetInfo {
+		return map[vanus.ID]info.ListOffsetInfo{
+			subscriptionID: {{EventLogID: eventLogID, Offset: offset}},
+		}
+	}
 
 	Convey("commit", t, func() {
 		Convey("commit with storage create", func() {
 			storage.EXPECT().GetOffsets(gomock.Any(), subscriptionID).Return(info.ListOffsetInfo{}, nil)
-			storage.EXPECT().CreateOffset(gomock.Any(), subscriptionID, gomock.Any()).Return(nil)
+			storage.EXPECT().SetOffsets(gomock.Any(), batch(offset)).Return(nil)
 			m.Offset(ctx, subscriptionID, []info.OffsetInfo{{EventLogID: eventLogID, Offset: offset}}, false)
 			offsets, _ := m.GetOffset(ctx, subscriptionID)
 			So(len(offsets), ShouldEqual, 1)
 			So(offsets[0].Offset, ShouldEqual, offset)
 			m.commit(ctx)
+			Convey("commit nothing if unchanged", func() {
+				m.commit(ctx)
+			})
 			Convey("commit with storage update", func() {
 				offset++
 				m.Offset(ctx, subscriptionID, []info.OffsetInfo{{EventLogID: eventLogID, Offset: offset}}, false)
-				storage.EXPECT().UpdateOffset(gomock.Any(), subscriptionID, gomock.Any()).Return(nil)
+				storage.EXPECT().SetOffsets(gomock.Any(), batch(offset)).Return(nil)
 				m.commit(ctx)
 				Convey("commit with storage error", func() {
 					offset++
 					m.Offset(ctx, subscriptionID, []info.OffsetInfo{{EventLogID: eventLogID, Offset: offset}}, false)
-					storage.EXPECT().UpdateOffset(gomock.Any(), subscriptionID, gomock.Any()).Return(fmt.Errorf("error"))
+					storage.EXPECT().SetOffsets(gomock.Any(), batch(offset)).Return(fmt.Errorf("error"))
 					m.commit(ctx)
+					Convey("retry in the next commit", func() {
+						storage.EXPECT().SetOffsets(gomock.Any(), batch(offset)).Return(nil)
+						m.commit(ctx)
+					})
 				})
 			})
 		})
+		Convey("commit offsets of subscriptions in a batch", func() {
+			otherID := vanus.ID(2)
+			storage.EXPECT().GetOffsets(gomock.Any(), gomock.Any()).Times(2).Return(info.ListOffsetInfo{}, nil)
+			m.Offset(ctx, subscriptionID, []info.OffsetInfo{{EventLogID: eventLogID, Offset: 1}}, false)
+			m.Offset(ctx, otherID, []info.OffsetInfo{{EventLogID: eventLogID, Offset: 2}}, false)
+			storage.EXPECT().SetOffsets(gomock.Any(), map[vanus.ID]info.ListOffsetInfo{
+				subscriptionID: {{EventLogID: eventLogID, Offset: 1}},
+				otherID:        {{EventLogID: eventLogID, Offset: 2}},
+			}).Return(nil)
+			m.commit(ctx)
+			_ = m.RemoveRegisterSubscription(ctx, otherID)
+		})
+		Convey("commit nothing after the subscription is removed", func() {
+			storage.EXPECT().GetOffsets(gomock.Any(), subscriptionID).Return(info.ListOffsetInfo{}, nil)
+			subOffset, _ := m.getSubscriptionOffset(ctx, subscriptionID)
+			subOffset.offset(info.ListOffsetInfo{{EventLogID: eventLogID, Offset: offset}})
+			err := m.RemoveRegisterSubscription(ctx, subscriptionID)
+			So(err, ShouldBeNil)
+			err = m.flush(ctx, []*subscriptionOffset{subOffset})
+			So(err, ShouldBeNil)
+		})
+		_ = m.RemoveRegisterSubscription(ctx, subscriptionID)
 	})
 }
 
@@ -182,3 +182,37 @@ func TestResetOffsetByTimestamp(t *testing.T) {
 		So(err, ShouldBeNil)
 	})
 }
+
+func TestImportOffset(t *testing.T) {
+	Convey("test import offset", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		storage := storage.NewMockStorage(ctrl)
+		secret := secret.NewMockStorage(ctrl)
+		mockClient := client.NewMockClient(ctrl)
+
+		m := NewSubscriptionManager(storage, secret, mockClient).(*manager)
+		offsetManager := offset.NewMockManager(ctrl)
+		m.offsetManager = offsetManager
+		storage.MockSubscriptionStorage.EXPECT().CreateSubscription(ctx, gomock.Any()).AnyTimes().Return(nil)
+		id := vanus.NewTestID()
+		_ = m.AddSubscription(ctx, &metadata.Subscription{ID: id, EventBus: "bus"})
+		logID := vanus.NewTestID()
+
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockEventLog := api.NewMockEventlog(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), "bus").AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().ListLog(gomock.Any()).AnyTimes().Return([]api.Eventlog{mockEventLog}, nil)
+		mockEventLog.EXPECT().ID().AnyTimes().Return(logID.Uint64())
+		Convey("eventlog of another eventbus", func() {
+			err := m.ImportOffset(ctx, id, info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: 10}})
+			So(err, ShouldNotBeNil)
+		})
+		Convey("import success", func() {
+			offsets := info.ListOffsetInfo{{EventLogID: logID, Offset: 10}}
+			offsetManager.EXPECT().Offset(ctx, id, offsets, true).Return(nil)
+			So(m.ImportOffset(ctx, id, offsets), ShouldBeNil)
+		})
+	})
+}
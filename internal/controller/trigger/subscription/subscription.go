@@ -27,6 +27,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/trigger/secret"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/storage"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription/offset"
+	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
@@ -41,6 +42,10 @@ type Manager interface {
 	// GetOffset get offset only from etcd, it doesn't contain retry eb offset
 	GetOffset(ctx context.Context, id vanus.ID) (info.ListOffsetInfo, error)
 	ResetOffsetByTimestamp(ctx context.Context, id vanus.ID, timestamp uint64) (info.ListOffsetInfo, error)
+	// ExportOffset returns committed offsets in the eventbus of the subscription, without retry eb offset.
+	ExportOffset(ctx context.Context, id vanus.ID) ([]ExportedOffset, error)
+	// ImportOffset commits offsets of eventlogs of the subscription's eventbus.
+	ImportOffset(ctx context.Context, id vanus.ID, offsets info.ListOffsetInfo) error
 	ListSubscription(ctx context.Context) []*metadata.Subscription
 	GetSubscription(ctx context.Context, id vanus.ID) *metadata.Subscription
 	AddSubscription(ctx context.Context, subscription *metadata.Subscription) error
@@ -67,6 +72,9 @@ type manager struct {
 	offsetManager   offset.Manager
 	lock            sync.RWMutex
 	subscriptionMap map[vanus.ID]*metadata.Subscription
+	// signings are signing configs which have been stored, subscriptions are updated in place, so changes
+	// of signing configs are found by comparing with them.
+	signings map[vanus.ID]*primitive.SigningConfig
 }
 
 func NewSubscriptionManager(storage storage.Storage, secretStorage secret.Storage, ebCli eb.Client) Manager {
@@ -75,6 +83,7 @@ func NewSubscriptionManager(storage storage.Storage, secretStorage secret.Storag
 		storage:         storage,
 		secretStorage:   secretStorage,
 		subscriptionMap: map[vanus.ID]*metadata.Subscription{},
+		signings:        map[vanus.ID]*primitive.SigningConfig{},
 		offsetManager:   offset.NewOffsetManager(storage, defaultCommitInterval),
 	}
 	return m
@@ -108,6 +117,9 @@ func (m *manager) AddSubscription(ctx context.Context, subscription *metadata.Su
 			return err
 		}
 	}
+	if err := m.updateSigning(ctx, subscription); err != nil {
+		return err
+	}
 	if err := m.storage.CreateSubscription(ctx, subscription); err != nil {
 		return err
 	}
@@ -130,6 +142,9 @@ func (m *manager) UpdateSubscription(ctx context.Context, sub *metadata.Subscrip
 		return err
 	}
 	m.subscriptionMap[sub.ID] = sub
+	if err := m.updateSigning(ctx, sub); err != nil {
+		return err
+	}
 	if reflect.DeepEqual(curr.SinkCredential, sub.SinkCredential) {
 		return nil
 	}
@@ -145,6 +160,25 @@ func (m *manager) UpdateSubscription(ctx context.Context, sub *metadata.Subscrip
 	return nil
 }
 
+// updateSigning stores the signing config of the subscription if it changed.
+func (m *manager) updateSigning(ctx context.Context, sub *metadata.Subscription) error {
+	if reflect.DeepEqual(m.signings[sub.ID], sub.Signing) {
+		return nil
+	}
+	if sub.Signing == nil {
+		if err := m.secretStorage.DeleteSigning(ctx, sub.ID); err != nil {
+			return err
+		}
+		delete(m.signings, sub.ID)
+		return nil
+	}
+	if err := m.secretStorage.WriteSigning(ctx, sub.ID, sub.Signing); err != nil {
+		return err
+	}
+	m.signings[sub.ID] = sub.Signing
+	return nil
+}
+
 // DeleteSubscription will do
 // 1.delete offset
 // 2.delete subscription .
@@ -164,6 +198,12 @@ func (m *manager) DeleteSubscription(ctx context.Context, id vanus.ID) error {
 	if err := m.secretStorage.Delete(ctx, id); err != nil {
 		return err
 	}
+	if _, ok := m.signings[id]; ok {
+		if err := m.secretStorage.DeleteSigning(ctx, id); err != nil {
+			return err
+		}
+		delete(m.signings, id)
+	}
 	delete(m.subscriptionMap, id)
 	metrics.SubscriptionGauge.WithLabelValues(subscription.EventBus).Dec()
 	if subscription.Transformer.Exist() {
@@ -210,6 +250,14 @@ func (m *manager) Init(ctx context.Context) error {
 			}
 			sub.SinkCredential = credential
 		}
+		if sub.SigningMethod != "" {
+			signing, err := m.secretStorage.ReadSigning(ctx, sub.ID)
+			if err != nil {
+				return err
+			}
+			sub.Signing = signing
+			m.signings[sub.ID] = signing
+		}
 		m.subscriptionMap[sub.ID] = sub
 		metrics.SubscriptionGauge.WithLabelValues(sub.EventBus).Inc()
 		if sub.Transformer.Exist() {
@@ -152,6 +152,23 @@ func TestSubscription(t *testing.T) {
 						So(err, ShouldBeNil)
 					})
 				})
+				Convey("test update subscription signing", func() {
+					sub := m.GetSubscription(ctx, subID)
+					sub.Signing = &primitive.SigningConfig{
+						Method: primitive.SigningHMAC,
+						Keys:   []primitive.SigningKey{{ID: "k1", Secret: "secret"}},
+					}
+					sub.SigningMethod = primitive.SigningHMAC
+					secret.EXPECT().WriteSigning(gomock.Any(), gomock.Eq(subID), gomock.Eq(sub.Signing)).Return(nil)
+					So(m.UpdateSubscription(ctx, sub), ShouldBeNil)
+					// unchanged signing isn't written again.
+					So(m.UpdateSubscription(ctx, sub), ShouldBeNil)
+
+					sub.Signing = nil
+					sub.SigningMethod = ""
+					secret.EXPECT().DeleteSigning(gomock.Any(), gomock.Eq(subID)).Return(nil)
+					So(m.UpdateSubscription(ctx, sub), ShouldBeNil)
+				})
 			})
 			Convey("test delete subscription", func() {
 				mm := m.(*manager)
@@ -18,11 +18,16 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strings"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/cel"
+	"github.com/linkall-labs/vanus/internal/primitive/protodecode"
+	"github.com/linkall-labs/vanus/internal/primitive/sinktemplate"
+	"github.com/linkall-labs/vanus/internal/primitive/timewindow"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/arg"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/runtime"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/wasm"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
@@ -46,6 +51,13 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 	if err := validateSinkCredential(ctx, request.Sink, request.SinkCredential); err != nil {
 		return err
 	}
+	if err := validateSinkTemplate(ctx, request.Sink, request.Protocol,
+		request.Config.GetSinkAllowedHosts()); err != nil {
+		return err
+	}
+	if err := validateSigning(ctx, request.Protocol, request.Signing); err != nil {
+		return err
+	}
 	if request.EventBus == "" {
 		return errors.ErrInvalidRequest.WithMessage("eventBus is empty")
 	}
@@ -55,6 +67,22 @@ func ValidateSubscriptionRequest(ctx context.Context, request *ctrlpb.Subscripti
 	if err := validateTransformer(ctx, request.Transformer); err != nil {
 		return err
 	}
+	if request.Config.GetPassThrough() {
+		if err := validatePassThrough(ctx, request); err != nil {
+			return err
+		}
+	}
+	if err := validateProtobufDecoding(ctx, request.Config); err != nil {
+		return err
+	}
+	if err := validateDeliveryContentType(ctx, request.Config); err != nil {
+		return err
+	}
+	for k := range request.NodeSelector {
+		if k == "" {
+			return errors.ErrInvalidRequest.WithMessage("node selector key is empty")
+		}
+	}
 	return nil
 }
 
@@ -94,6 +122,10 @@ func ValidateSinkAndProtocol(ctx context.Context,
 				WithMessage("protocol is gcloud functions, sink credential can not be nil and credential type is gcloud")
 		}
 	case metapb.Protocol_HTTP:
+		if sinktemplate.IsTemplate(sink) {
+			// the template is validated with allowed hosts.
+			break
+		}
 		if _, err := url.Parse(sink); err != nil {
 			return errors.ErrInvalidRequest.
 				WithMessage("protocol is http, sink is url,url parse error").Wrap(err)
@@ -103,6 +135,89 @@ func ValidateSinkAndProtocol(ctx context.Context,
 	return nil
 }
 
+func validateSinkTemplate(ctx context.Context, sink string, protocol metapb.Protocol, allowedHosts []string) error {
+	for _, h := range allowedHosts {
+		if err := sinktemplate.ValidateAllowedHost(h); err != nil {
+			return errors.ErrInvalidRequest.WithMessage("sink allowed hosts is invalid").Wrap(err)
+		}
+	}
+	if !sinktemplate.IsTemplate(sink) {
+		return nil
+	}
+	if protocol != metapb.Protocol_HTTP {
+		return errors.ErrInvalidRequest.WithMessage("sink template is only supported by http protocol")
+	}
+	if _, err := sinktemplate.Parse(sink, allowedHosts); err != nil {
+		return errors.ErrInvalidRequest.WithMessage("sink template is invalid").Wrap(err)
+	}
+	return nil
+}
+
+// minSigningSecretLength is the size of HMAC-SHA256 output, shorter secrets weaken signatures.
+const minSigningSecretLength = 32
+
+func validateSigning(ctx context.Context, protocol metapb.Protocol, signing *metapb.SigningConfig) error {
+	if signing == nil {
+		return nil
+	}
+	if protocol != metapb.Protocol_HTTP {
+		return errors.ErrInvalidRequest.WithMessage("signing is only supported by the http protocol")
+	}
+	switch signing.Method {
+	case metapb.SigningConfig_HMAC, metapb.SigningConfig_JWS:
+	default:
+		return errors.ErrInvalidRequest.WithMessage("signing method is invalid")
+	}
+	if len(signing.Keys) == 0 {
+		return errors.ErrInvalidRequest.WithMessage("signing keys are empty")
+	}
+	ids := make(map[string]bool, len(signing.Keys))
+	for _, k := range signing.Keys {
+		if ids[k.Id] {
+			return errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("signing key id %s is duplicated", k.Id))
+		}
+		ids[k.Id] = true
+		// A masked secret is round-tripped from responses, it keeps the stored secret of the key.
+		if k.Secret == primitive.SecretsMask && isSigningKeyID(k.Id) {
+			continue
+		}
+		if err := ValidateSigningKey(ctx, k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateSigningKey checks a new signing key, its secret can't be masked.
+func ValidateSigningKey(ctx context.Context, k *metapb.SigningKey) error {
+	if !isSigningKeyID(k.Id) {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("signing key id %q is invalid, it only contains letters, digits, - and _", k.Id))
+	}
+	if k.Secret == primitive.SecretsMask {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the secret of signing key %s is masked, which doesn't keep any stored secret", k.Id))
+	}
+	if len(k.Secret) < minSigningSecretLength {
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the secret of signing key %s is shorter than %d", k.Id, minSigningSecretLength))
+	}
+	return nil
+}
+
+// isSigningKeyID reports whether id can be put into signature headers, t is the timestamp of HMAC signatures.
+func isSigningKeyID(id string) bool {
+	if id == "" || id == "t" {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') && c != '-' && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
 func validateSinkCredential(ctx context.Context, sink string, credential *metapb.SinkCredential) error {
 	if credential == nil {
 		return nil
@@ -183,9 +298,102 @@ func validateTransformer(ctx context.Context, transformer *metapb.Transformer) e
 			}
 		}
 	}
+	if fn := transformer.Wasm; fn != nil {
+		err := wasm.Validate(ctx, &primitive.WasmFunction{
+			Module:        fn.Module,
+			MemoryLimitMB: fn.MemoryLimitMb,
+			TimeoutMs:     fn.TimeoutMs,
+		})
+		if err != nil {
+			return errors.ErrInvalidRequest.WithMessage(
+				fmt.Sprintf("transformer wasm is invalid:[%s]", err.Error()))
+		}
+	}
 	return nil
 }
 
+// validatePassThrough rejects features which parse the event data as JSON, since the data of a pass-through
+// subscription may be binary.
+func validatePassThrough(ctx context.Context, request *ctrlpb.SubscriptionRequest) error {
+	if t := request.Transformer; t != nil && (len(t.Define) > 0 || len(t.Pipeline) > 0 || t.Template != "" ||
+		t.Wasm != nil) {
+		return errors.ErrInvalidRequest.WithMessage("transformer can't be used in pass-through mode")
+	}
+	for _, f := range request.Filters {
+		if filterParsesData(f) {
+			return errors.ErrInvalidRequest.WithMessage(
+				"cel filters and filters of data can't be used in pass-through mode")
+		}
+	}
+	if sinktemplate.IsTemplate(request.Sink) {
+		t, err := sinktemplate.Parse(request.Sink, request.Config.GetSinkAllowedHosts())
+		if err == nil && t.HasData() {
+			return errors.ErrInvalidRequest.WithMessage(
+				"sink template with data variables can't be used in pass-through mode")
+		}
+	}
+	return nil
+}
+
+func validateProtobufDecoding(ctx context.Context, config *metapb.SubscriptionConfig) error {
+	decoding := config.GetProtobufDecoding()
+	if decoding == nil {
+		return nil
+	}
+	if config.PassThrough {
+		return errors.ErrInvalidRequest.WithMessage("protobuf decoding can't be used in pass-through mode")
+	}
+	if _, err := protodecode.NewDecoder(decoding.DescriptorSet, decoding.MessageName); err != nil {
+		return errors.ErrInvalidRequest.WithMessage("protobuf decoding is invalid").Wrap(err)
+	}
+	return nil
+}
+
+func validateDeliveryContentType(ctx context.Context, config *metapb.SubscriptionConfig) error {
+	contentType := config.GetDeliveryContentType()
+	if contentType == "" {
+		return nil
+	}
+	if contentType != "application/json" && !protodecode.IsProtobuf(contentType) {
+		return errors.ErrInvalidRequest.WithMessage(
+			"delivery content type must be application/json, application/protobuf or application/x-protobuf")
+	}
+	if config.PassThrough {
+		return errors.ErrInvalidRequest.WithMessage("delivery content type can't be used in pass-through mode")
+	}
+	if config.ProtobufDecoding == nil {
+		return errors.ErrInvalidRequest.WithMessage("delivery content type requires protobuf decoding")
+	}
+	return nil
+}
+
+func filterParsesData(f *metapb.Filter) bool {
+	if f == nil {
+		return false
+	}
+	if f.Cel != "" {
+		return true
+	}
+	for _, m := range []map[string]string{f.Exact, f.Prefix, f.Suffix} {
+		for k := range m {
+			if k == "data" || strings.HasPrefix(k, "data.") {
+				return true
+			}
+		}
+	}
+	if filterParsesData(f.Not) {
+		return true
+	}
+	for _, list := range [][]*metapb.Filter{f.All, f.Any} {
+		for _, sub := range list {
+			if filterParsesData(sub) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func ValidateFilterList(ctx context.Context, filters []*metapb.Filter) error {
 	if len(filters) == 0 {
 		return nil
@@ -235,6 +443,34 @@ func ValidateFilter(ctx context.Context, f *metapb.Filter) error {
 	if err := ValidateFilterList(ctx, f.Any); err != nil {
 		return errors.ErrInvalidRequest.WithMessage("any filter dialect invalid").Wrap(err)
 	}
+	if f.Time != nil {
+		if err := validateTimeFilter(f.Time); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateTimeFilter(f *metapb.TimeFilter) error {
+	switch f.Basis {
+	case "", primitive.TimeBasisEvent, primitive.TimeBasisIngestion:
+	default:
+		return errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("time filter basis must be %s or %s", primitive.TimeBasisEvent, primitive.TimeBasisIngestion))
+	}
+	if f.Since == "" && f.Until == "" && f.Daily == "" && len(f.Weekdays) == 0 {
+		return errors.ErrInvalidRequest.WithMessage("time filter window is empty")
+	}
+	_, err := timewindow.Parse(timewindow.Spec{
+		Since:    f.Since,
+		Until:    f.Until,
+		Daily:    f.Daily,
+		Weekdays: f.Weekdays,
+		TimeZone: f.TimeZone,
+	})
+	if err != nil {
+		return errors.ErrInvalidRequest.WithMessage("time filter is invalid").Wrap(err)
+	}
 	return nil
 }
 
@@ -318,7 +554,13 @@ func hasMultipleDialects(f *metapb.Filter) bool {
 		}
 		dialectFound = true
 	}
-	if f.Cel != "" && dialectFound {
+	if f.Cel != "" {
+		if dialectFound {
+			return true
+		}
+		dialectFound = true
+	}
+	if f.Time != nil && dialectFound {
 		return true
 	}
 	return false
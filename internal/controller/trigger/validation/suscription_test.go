@@ -16,10 +16,14 @@ package validation
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/linkall-labs/vanus/internal/primitive"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 
@@ -50,6 +54,28 @@ func TestSubscriptionRequestValidator(t *testing.T) {
 	})
 }
 
+func TestValidateTimeFilter(t *testing.T) {
+	ctx := context.Background()
+	Convey("test validate time filter", t, func() {
+		So(ValidateFilter(ctx, &metapb.Filter{Time: &metapb.TimeFilter{
+			Basis:    "ingestion",
+			Daily:    "09:00-17:00",
+			Weekdays: []string{"mon", "fri"},
+			TimeZone: "Asia/Shanghai",
+		}}), ShouldBeNil)
+		So(ValidateFilter(ctx, &metapb.Filter{Time: &metapb.TimeFilter{}}), ShouldNotBeNil)
+		So(ValidateFilter(ctx, &metapb.Filter{Time: &metapb.TimeFilter{
+			Basis: "arrival",
+			Since: "2022-01-01T00:00:00Z",
+		}}), ShouldNotBeNil)
+		So(ValidateFilter(ctx, &metapb.Filter{Time: &metapb.TimeFilter{Daily: "25:00-26:00"}}), ShouldNotBeNil)
+		So(ValidateFilter(ctx, &metapb.Filter{
+			Cel:  "$type.(string) =='test'",
+			Time: &metapb.TimeFilter{Since: "2022-01-01T00:00:00Z"},
+		}), ShouldNotBeNil)
+	})
+}
+
 func TestValidateSubscriptionConfig(t *testing.T) {
 	ctx := context.Background()
 	Convey("test validate subscription config", t, func() {
@@ -90,6 +116,12 @@ func TestValidateTransformer(t *testing.T) {
 			}
 			So(validateTransformer(ctx, trans), ShouldNotBeNil)
 		})
+		Convey("test wasm invalid", func() {
+			trans := &metapb.Transformer{
+				Wasm: &metapb.WasmFunction{Module: []byte("not wasm")},
+			}
+			So(validateTransformer(ctx, trans), ShouldNotBeNil)
+		})
 		Convey("test pipeline valid", func() {
 			trans := &metapb.Transformer{
 				Pipeline: []*metapb.Action{
@@ -109,6 +141,152 @@ func TestValidateTransformer(t *testing.T) {
 	})
 }
 
+func TestValidateSinkTemplate(t *testing.T) {
+	ctx := context.Background()
+	sink := "https://{data.region}.example.com/hook"
+	Convey("sink template without allowed hosts", t, func() {
+		So(validateSinkTemplate(ctx, sink, metapb.Protocol_HTTP, nil), ShouldNotBeNil)
+	})
+	Convey("sink template with grpc protocol", t, func() {
+		So(validateSinkTemplate(ctx, sink, metapb.Protocol_GRPC, []string{"*.example.com"}), ShouldNotBeNil)
+	})
+	Convey("invalid allowed hosts", t, func() {
+		So(validateSinkTemplate(ctx, "https://example.com", metapb.Protocol_HTTP, []string{"*"}), ShouldNotBeNil)
+	})
+	Convey("all valid", t, func() {
+		So(ValidateSinkAndProtocol(ctx, sink, metapb.Protocol_HTTP, nil), ShouldBeNil)
+		So(validateSinkTemplate(ctx, sink, metapb.Protocol_HTTP, []string{"*.example.com"}), ShouldBeNil)
+		So(validateSinkTemplate(ctx, "https://example.com", metapb.Protocol_HTTP, nil), ShouldBeNil)
+	})
+}
+
+func TestValidateSigning(t *testing.T) {
+	ctx := context.Background()
+	Convey("test validate signing", t, func() {
+		secret := strings.Repeat("s", minSigningSecretLength)
+		signing := &metapb.SigningConfig{
+			Method: metapb.SigningConfig_JWS,
+			Keys:   []*metapb.SigningKey{{Id: "old", Secret: primitive.SecretsMask}, {Id: "new_1", Secret: secret}},
+		}
+		So(validateSigning(ctx, metapb.Protocol_HTTP, nil), ShouldBeNil)
+		So(validateSigning(ctx, metapb.Protocol_HTTP, signing), ShouldBeNil)
+		So(validateSigning(ctx, metapb.Protocol_GRPC, signing), ShouldNotBeNil)
+
+		Convey("invalid keys", func() {
+			for _, k := range []*metapb.SigningKey{
+				{Id: "", Secret: secret},
+				{Id: "t", Secret: secret},
+				{Id: "a,b", Secret: secret},
+				{Id: "new_1", Secret: secret},
+				{Id: "short", Secret: "secret"},
+			} {
+				signing.Keys = append(signing.Keys[:2], k)
+				So(validateSigning(ctx, metapb.Protocol_HTTP, signing), ShouldNotBeNil)
+			}
+			signing.Keys = nil
+			So(validateSigning(ctx, metapb.Protocol_HTTP, signing), ShouldNotBeNil)
+		})
+
+		Convey("new keys can't be masked", func() {
+			So(ValidateSigningKey(ctx, &metapb.SigningKey{Id: "new", Secret: secret}), ShouldBeNil)
+			So(ValidateSigningKey(ctx, &metapb.SigningKey{Id: "new", Secret: primitive.SecretsMask}), ShouldNotBeNil)
+			So(ValidateSigningKey(ctx, &metapb.SigningKey{Id: "t", Secret: secret}), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestValidatePassThrough(t *testing.T) {
+	ctx := context.Background()
+	Convey("test validate pass-through", t, func() {
+		request := &ctrlpb.SubscriptionRequest{
+			Sink:   "https://{subject}.example.com/hook",
+			Config: &metapb.SubscriptionConfig{PassThrough: true, SinkAllowedHosts: []string{"*.example.com"}},
+			Filters: []*metapb.Filter{
+				{Exact: map[string]string{"type": "image"}},
+				{Sql: "source = 'camera'"},
+			},
+		}
+		Convey("filters of attributes", func() {
+			So(validatePassThrough(ctx, request), ShouldBeNil)
+		})
+		Convey("transformer", func() {
+			request.Transformer = &metapb.Transformer{Template: "{\"id\": \"<id>\"}"}
+			So(validatePassThrough(ctx, request), ShouldNotBeNil)
+		})
+		Convey("cel filter", func() {
+			request.Filters = append(request.Filters, &metapb.Filter{
+				Not: &metapb.Filter{Cel: "$type.(string) == 'test'"},
+			})
+			So(validatePassThrough(ctx, request), ShouldNotBeNil)
+		})
+		Convey("filter of data", func() {
+			request.Filters = append(request.Filters, &metapb.Filter{
+				Any: []*metapb.Filter{{Prefix: map[string]string{"data.name": "a"}}},
+			})
+			So(validatePassThrough(ctx, request), ShouldNotBeNil)
+		})
+		Convey("sink template with data variables", func() {
+			request.Sink = "https://{data.region}.example.com/hook"
+			So(validatePassThrough(ctx, request), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestValidateProtobufDecoding(t *testing.T) {
+	ctx := context.Background()
+	Convey("test validate protobuf decoding", t, func() {
+		descriptorSet, _ := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+			Name:        proto.String("order.proto"),
+			Package:     proto.String("example.v1"),
+			MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("OrderCreated")}},
+		}}})
+		config := &metapb.SubscriptionConfig{ProtobufDecoding: &metapb.ProtobufDecoding{
+			DescriptorSet: descriptorSet,
+			MessageName:   "example.v1.OrderCreated",
+		}}
+		So(validateProtobufDecoding(ctx, nil), ShouldBeNil)
+		So(validateProtobufDecoding(ctx, config), ShouldBeNil)
+
+		Convey("unknown message", func() {
+			config.ProtobufDecoding.MessageName = "example.v1.OrderDeleted"
+			So(validateProtobufDecoding(ctx, config), ShouldNotBeNil)
+		})
+		Convey("invalid descriptor set", func() {
+			config.ProtobufDecoding.DescriptorSet = []byte("invalid")
+			So(validateProtobufDecoding(ctx, config), ShouldNotBeNil)
+		})
+		Convey("pass-through", func() {
+			config.PassThrough = true
+			So(validateProtobufDecoding(ctx, config), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestValidateDeliveryContentType(t *testing.T) {
+	ctx := context.Background()
+	Convey("test validate delivery content type", t, func() {
+		config := &metapb.SubscriptionConfig{
+			ProtobufDecoding:    &metapb.ProtobufDecoding{MessageName: "example.v1.OrderCreated"},
+			DeliveryContentType: "application/protobuf",
+		}
+		So(validateDeliveryContentType(ctx, nil), ShouldBeNil)
+		So(validateDeliveryContentType(ctx, config), ShouldBeNil)
+
+		Convey("unsupported content type", func() {
+			config.DeliveryContentType = "application/avro"
+			So(validateDeliveryContentType(ctx, config), ShouldNotBeNil)
+		})
+		Convey("without protobuf decoding", func() {
+			config.ProtobufDecoding = nil
+			So(validateDeliveryContentType(ctx, config), ShouldNotBeNil)
+		})
+		Convey("pass-through", func() {
+			config.PassThrough = true
+			So(validateDeliveryContentType(ctx, config), ShouldNotBeNil)
+		})
+	})
+}
+
 func TestValidateSinkAndProtocol(t *testing.T) {
 	ctx := context.Background()
 	Convey("sink is empty", t, func() {
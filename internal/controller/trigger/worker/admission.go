@@ -0,0 +1,74 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"fmt"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+)
+
+const (
+	defaultMaxCPUUsage    = 0.8
+	defaultMaxMemoryUsage = 0.8
+)
+
+// AdmissionConfig decides whether a trigger worker accepts new subscriptions by the resources it reports.
+type AdmissionConfig struct {
+	// ratio of used CPU to the limit of the worker, 0.8 by default.
+	MaxCPUUsage float64 `yaml:"max_cpu_usage"`
+	// ratio of used memory to the limit of the worker, 0.8 by default.
+	MaxMemoryUsage float64 `yaml:"max_memory_usage"`
+}
+
+func (c *AdmissionConfig) init() {
+	if c.MaxCPUUsage <= 0 {
+		c.MaxCPUUsage = defaultMaxCPUUsage
+	}
+	if c.MaxMemoryUsage <= 0 {
+		c.MaxMemoryUsage = defaultMaxMemoryUsage
+	}
+}
+
+// UnavailableReason returns why the trigger worker doesn't accept new subscriptions, it's empty if it does.
+// assigned is the number of subscriptions assigned to the worker, which may be started after the last
+// heartbeat.
+func (c AdmissionConfig) UnavailableReason(twInfo metadata.TriggerWorkerInfo, assigned int) string {
+	res := twInfo.Resources
+	if res == nil {
+		return ""
+	}
+	c.init()
+	subscriptions := res.Subscriptions
+	if uint32(assigned) > subscriptions {
+		subscriptions = uint32(assigned)
+	}
+	if res.MaxSubscriptions > 0 && subscriptions >= res.MaxSubscriptions {
+		return fmt.Sprintf("%d subscriptions reach the maximum %d", subscriptions, res.MaxSubscriptions)
+	}
+	if res.MaxEventsPerSecond > 0 && res.EventsPerSecond >= res.MaxEventsPerSecond {
+		return fmt.Sprintf("%.0f events per second reach the maximum %.0f", res.EventsPerSecond,
+			res.MaxEventsPerSecond)
+	}
+	if res.CPULimit > 0 && res.CPUCores >= res.CPULimit*c.MaxCPUUsage {
+		return fmt.Sprintf("CPU usage %.0f%% is above %.0f%%", res.CPUCores/res.CPULimit*100,
+			c.MaxCPUUsage*100)
+	}
+	if res.MemoryLimit > 0 && float64(res.MemoryBytes) >= float64(res.MemoryLimit)*c.MaxMemoryUsage {
+		return fmt.Sprintf("memory usage %.0f%% is above %.0f%%",
+			float64(res.MemoryBytes)/float64(res.MemoryLimit)*100, c.MaxMemoryUsage*100)
+	}
+	return ""
+}
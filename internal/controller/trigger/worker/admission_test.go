@@ -0,0 +1,76 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAdmissionConfig_UnavailableReason(t *testing.T) {
+	Convey("test admission unavailable reason", t, func() {
+		admission := AdmissionConfig{}
+		info := func(res metadata.TriggerWorkerResources) metadata.TriggerWorkerInfo {
+			return metadata.TriggerWorkerInfo{Addr: "test", Resources: &res}
+		}
+
+		Convey("no resources reported", func() {
+			So(admission.UnavailableReason(metadata.TriggerWorkerInfo{Addr: "test"}, 100), ShouldBeEmpty)
+		})
+
+		Convey("unlimited capacity", func() {
+			So(admission.UnavailableReason(info(metadata.TriggerWorkerResources{
+				CPUCores:        4,
+				MemoryBytes:     1 << 30,
+				Subscriptions:   100,
+				EventsPerSecond: 10000,
+			}), 100), ShouldBeEmpty)
+		})
+
+		Convey("subscriptions", func() {
+			res := metadata.TriggerWorkerResources{Subscriptions: 1, MaxSubscriptions: 3}
+			So(admission.UnavailableReason(info(res), 2), ShouldBeEmpty)
+			So(admission.UnavailableReason(info(res), 3), ShouldNotBeEmpty)
+			res.Subscriptions = 3
+			So(admission.UnavailableReason(info(res), 0), ShouldNotBeEmpty)
+		})
+
+		Convey("events per second", func() {
+			res := metadata.TriggerWorkerResources{EventsPerSecond: 999, MaxEventsPerSecond: 1000}
+			So(admission.UnavailableReason(info(res), 0), ShouldBeEmpty)
+			res.EventsPerSecond = 1000
+			So(admission.UnavailableReason(info(res), 0), ShouldNotBeEmpty)
+		})
+
+		Convey("cpu", func() {
+			res := metadata.TriggerWorkerResources{CPUCores: 1.5, CPULimit: 2}
+			So(admission.UnavailableReason(info(res), 0), ShouldBeEmpty)
+			res.CPUCores = 1.6
+			So(admission.UnavailableReason(info(res), 0), ShouldEqual, "CPU usage 80% is above 80%")
+			admission.MaxCPUUsage = 0.9
+			So(admission.UnavailableReason(info(res), 0), ShouldBeEmpty)
+		})
+
+		Convey("memory", func() {
+			res := metadata.TriggerWorkerResources{MemoryBytes: 700, MemoryLimit: 1000}
+			So(admission.UnavailableReason(info(res), 0), ShouldBeEmpty)
+			res.MemoryBytes = 900
+			So(admission.UnavailableReason(info(res), 0), ShouldEqual, "memory usage 90% is above 80%")
+		})
+	})
+}
@@ -40,18 +40,21 @@ const (
 )
 
 type Manager interface {
-	AddTriggerWorker(ctx context.Context, addr string) error
+	AddTriggerWorker(ctx context.Context, addr string, labels map[string]string) error
 	GetTriggerWorker(addr string) TriggerWorker
 	RemoveTriggerWorker(ctx context.Context, addr string)
-	UpdateTriggerWorkerInfo(ctx context.Context, addr string) error
+	UpdateTriggerWorkerInfo(ctx context.Context, addr string, resources *metadata.TriggerWorkerResources) error
 	GetActiveRunningTriggerWorker() []metadata.TriggerWorkerInfo
+	ListTriggerWorker() []TriggerWorker
 	Init(ctx context.Context) error
 	Start()
 	Stop()
 }
 
 var (
-	ErrTriggerWorkerNotFound = fmt.Errorf("trigger worker not found")
+	ErrTriggerWorkerNotFound  = fmt.Errorf("trigger worker not found")
+	ErrNoMatchedTriggerWorker = fmt.Errorf("no trigger worker matches node selector")
+	ErrTriggerWorkersFull     = fmt.Errorf("all trigger workers reach their capacity")
 )
 
 type OnTriggerWorkerRemoveSubscription func(ctx context.Context, subId vanus.ID, addr string) error
@@ -125,7 +128,7 @@ func (m *manager) GetTriggerWorker(addr string) TriggerWorker {
 	return tWorker
 }
 
-func (m *manager) AddTriggerWorker(ctx context.Context, addr string) error {
+func (m *manager) AddTriggerWorker(ctx context.Context, addr string, labels map[string]string) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	tWorker, exist := m.triggerWorkers[addr]
@@ -146,6 +149,7 @@ func (m *manager) AddTriggerWorker(ctx context.Context, addr string) error {
 		})
 		tWorker.Reset()
 	}
+	tWorker.SetLabels(labels)
 	err := m.storage.SaveTriggerWorker(ctx, tWorker.GetInfo())
 	if err != nil {
 		return err
@@ -179,7 +183,8 @@ func (m *manager) RemoveTriggerWorker(ctx context.Context, addr string) {
 	m.cleanTriggerWorker(ctx, tWorker)
 }
 
-func (m *manager) UpdateTriggerWorkerInfo(ctx context.Context, addr string) error {
+func (m *manager) UpdateTriggerWorkerInfo(ctx context.Context, addr string,
+	resources *metadata.TriggerWorkerResources) error {
 	tWorker := m.GetTriggerWorker(addr)
 	if tWorker == nil {
 		return ErrTriggerWorkerNotFound
@@ -198,12 +203,12 @@ func (m *manager) UpdateTriggerWorkerInfo(ctx context.Context, addr string) erro
 			})
 		}
 	}
+	tWorker.SetResources(resources)
 	tWorker.Polish()
 	return nil
 }
 
 func (m *manager) startTriggerWorker(ctx context.Context, tWorker TriggerWorker) {
-	assignSubscription := tWorker.GetAssignedSubscriptions()
 	err := tWorker.RemoteStart(ctx)
 	if err != nil {
 		log.Warning(ctx, "trigger worker start error", map[string]interface{}{
@@ -215,9 +220,13 @@ func (m *manager) startTriggerWorker(ctx context.Context, tWorker TriggerWorker)
 	log.Info(ctx, "trigger worker start success", map[string]interface{}{
 		log.KeyTriggerWorkerAddr: tWorker.GetAddr(),
 	})
-	// trigger worker restart need assign to trigger worker again
-	for _, id := range assignSubscription {
-		tWorker.AssignSubscription(id)
+	// trigger worker restart need resync assigned subscriptions, its stale subscriptions are dropped
+	err = tWorker.ResyncSubscriptions(ctx)
+	if err != nil {
+		log.Warning(ctx, "trigger worker resync subscriptions error", map[string]interface{}{
+			log.KeyError:             err,
+			log.KeyTriggerWorkerAddr: tWorker.GetAddr(),
+		})
 	}
 }
 func (m *manager) cleanTriggerWorker(ctx context.Context, tWorker TriggerWorker) {
@@ -282,6 +291,10 @@ func (m *manager) GetActiveRunningTriggerWorker() []metadata.TriggerWorkerInfo {
 	return runningTriggerWorker
 }
 
+func (m *manager) ListTriggerWorker() []TriggerWorker {
+	return m.getTriggerWorkers()
+}
+
 func (m *manager) getTriggerWorkers() []TriggerWorker {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
@@ -95,15 +95,17 @@ func TestAddTriggerWorker(t *testing.T) {
 		subManager := subscription.NewMockManager(ctrl)
 		twManager := NewTriggerWorkerManager(Config{}, workerStorage, subManager, nil)
 		workerStorage.EXPECT().SaveTriggerWorker(ctx, gomock.Any()).AnyTimes().Return(nil)
-		err := twManager.AddTriggerWorker(ctx, addr)
+		err := twManager.AddTriggerWorker(ctx, addr, nil)
 		So(err, ShouldBeNil)
 		tWorker := twManager.GetTriggerWorker(addr)
 		So(tWorker, ShouldNotBeNil)
 		Convey("test repeat add trigger worker", func() {
-			err = twManager.AddTriggerWorker(ctx, addr)
+			labels := map[string]string{"region": "eu"}
+			err = twManager.AddTriggerWorker(ctx, addr, labels)
 			So(err, ShouldBeNil)
 			tWorker = twManager.GetTriggerWorker(addr)
 			So(tWorker, ShouldNotBeNil)
+			So(tWorker.GetInfo().Labels, ShouldResemble, labels)
 		})
 	})
 }
@@ -157,15 +159,16 @@ func TestManager_UpdateTriggerWorkerInfo(t *testing.T) {
 		workerStorage := storage.NewMockTriggerWorkerStorage(ctrl)
 		twManager := NewTriggerWorkerManager(Config{}, workerStorage, nil, getTestTriggerWorkerRemoveSubscription()).(*manager)
 		Convey("trigger worker not exist", func() {
-			err := twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err := twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldNotBeNil)
 		})
 		tWorker.EXPECT().Polish().AnyTimes().Return()
+		tWorker.EXPECT().SetResources(gomock.Any()).AnyTimes().Return()
 		tWorker.EXPECT().GetInfo().AnyTimes().Return(metadata.TriggerWorkerInfo{})
 		Convey("trigger worker running", func() {
 			twManager.triggerWorkers[addr] = tWorker
 			tWorker.EXPECT().GetPhase().AnyTimes().Return(metadata.TriggerWorkerPhaseRunning)
-			err := twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err := twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldBeNil)
 		})
 
@@ -175,10 +178,10 @@ func TestManager_UpdateTriggerWorkerInfo(t *testing.T) {
 			tWorker.EXPECT().SetPhase(metadata.TriggerWorkerPhaseRunning).AnyTimes().Return()
 			tWorker.EXPECT().GetAddr().Return(addr)
 			workerStorage.EXPECT().SaveTriggerWorker(gomock.Any(), gomock.Any()).Return(nil)
-			err := twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err := twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldBeNil)
 			workerStorage.EXPECT().SaveTriggerWorker(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
-			err = twManager.UpdateTriggerWorkerInfo(ctx, addr)
+			err = twManager.UpdateTriggerWorkerInfo(ctx, addr, nil)
 			So(err, ShouldBeNil)
 		})
 	})
@@ -204,8 +207,8 @@ func TestPendingTriggerWorkerHandler(t *testing.T) {
 			tWorker.EXPECT().GetPendingTime().AnyTimes().Return(time.Now().Add(twManager.config.StartWorkerDuration * -1))
 			time.Sleep(time.Millisecond)
 			tWorker.EXPECT().GetAssignedSubscriptions().AnyTimes().Return([]vanus.ID{vanus.NewTestID()})
-			tWorker.EXPECT().AssignSubscription(gomock.Any()).AnyTimes().Return()
 			tWorker.EXPECT().RemoteStart(ctx).Return(nil)
+			tWorker.EXPECT().ResyncSubscriptions(ctx).Return(nil)
 			twManager.pendingTriggerWorkerHandler(ctx, tWorker)
 			tWorker.EXPECT().RemoteStart(ctx).Return(fmt.Errorf("start trigget worker error"))
 			twManager.pendingTriggerWorkerHandler(ctx, tWorker)
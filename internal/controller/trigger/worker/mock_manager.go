@@ -36,17 +36,17 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 }
 
 // AddTriggerWorker mocks base method.
-func (m *MockManager) AddTriggerWorker(ctx context.Context, addr string) error {
+func (m *MockManager) AddTriggerWorker(ctx context.Context, addr string, labels map[string]string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTriggerWorker", ctx, addr)
+	ret := m.ctrl.Call(m, "AddTriggerWorker", ctx, addr, labels)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddTriggerWorker indicates an expected call of AddTriggerWorker.
-func (mr *MockManagerMockRecorder) AddTriggerWorker(ctx, addr interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) AddTriggerWorker(ctx, addr, labels interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTriggerWorker", reflect.TypeOf((*MockManager)(nil).AddTriggerWorker), ctx, addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTriggerWorker", reflect.TypeOf((*MockManager)(nil).AddTriggerWorker), ctx, addr, labels)
 }
 
 // GetActiveRunningTriggerWorker mocks base method.
@@ -91,6 +91,20 @@ func (mr *MockManagerMockRecorder) Init(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockManager)(nil).Init), ctx)
 }
 
+// ListTriggerWorker mocks base method.
+func (m *MockManager) ListTriggerWorker() []TriggerWorker {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTriggerWorker")
+	ret0, _ := ret[0].([]TriggerWorker)
+	return ret0
+}
+
+// ListTriggerWorker indicates an expected call of ListTriggerWorker.
+func (mr *MockManagerMockRecorder) ListTriggerWorker() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggerWorker", reflect.TypeOf((*MockManager)(nil).ListTriggerWorker))
+}
+
 // RemoveTriggerWorker mocks base method.
 func (m *MockManager) RemoveTriggerWorker(ctx context.Context, addr string) {
 	m.ctrl.T.Helper()
@@ -128,15 +142,15 @@ func (mr *MockManagerMockRecorder) Stop() *gomock.Call {
 }
 
 // UpdateTriggerWorkerInfo mocks base method.
-func (m *MockManager) UpdateTriggerWorkerInfo(ctx context.Context, addr string) error {
+func (m *MockManager) UpdateTriggerWorkerInfo(ctx context.Context, addr string, resources *metadata.TriggerWorkerResources) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTriggerWorkerInfo", ctx, addr)
+	ret := m.ctrl.Call(m, "UpdateTriggerWorkerInfo", ctx, addr, resources)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateTriggerWorkerInfo indicates an expected call of UpdateTriggerWorkerInfo.
-func (mr *MockManagerMockRecorder) UpdateTriggerWorkerInfo(ctx, addr interface{}) *gomock.Call {
+func (mr *MockManagerMockRecorder) UpdateTriggerWorkerInfo(ctx, addr, resources interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerWorkerInfo", reflect.TypeOf((*MockManager)(nil).UpdateTriggerWorkerInfo), ctx, addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTriggerWorkerInfo", reflect.TypeOf((*MockManager)(nil).UpdateTriggerWorkerInfo), ctx, addr, resources)
 }
@@ -213,6 +213,47 @@ func (mr *MockTriggerWorkerMockRecorder) Reset() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockTriggerWorker)(nil).Reset))
 }
 
+// ResumeEventlogs mocks base method.
+func (m *MockTriggerWorker) ResumeEventlogs(ctx context.Context, id vanus.ID) ([]vanus.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeEventlogs", ctx, id)
+	ret0, _ := ret[0].([]vanus.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeEventlogs indicates an expected call of ResumeEventlogs.
+func (mr *MockTriggerWorkerMockRecorder) ResumeEventlogs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeEventlogs", reflect.TypeOf((*MockTriggerWorker)(nil).ResumeEventlogs), ctx, id)
+}
+
+// ResyncSubscriptions mocks base method.
+func (m *MockTriggerWorker) ResyncSubscriptions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResyncSubscriptions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResyncSubscriptions indicates an expected call of ResyncSubscriptions.
+func (mr *MockTriggerWorkerMockRecorder) ResyncSubscriptions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncSubscriptions", reflect.TypeOf((*MockTriggerWorker)(nil).ResyncSubscriptions), ctx)
+}
+
+// SetLabels mocks base method.
+func (m *MockTriggerWorker) SetLabels(labels map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetLabels", labels)
+}
+
+// SetLabels indicates an expected call of SetLabels.
+func (mr *MockTriggerWorkerMockRecorder) SetLabels(labels interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLabels", reflect.TypeOf((*MockTriggerWorker)(nil).SetLabels), labels)
+}
+
 // SetPhase mocks base method.
 func (m *MockTriggerWorker) SetPhase(arg0 metadata.TriggerWorkerPhase) {
 	m.ctrl.T.Helper()
@@ -225,6 +266,18 @@ func (mr *MockTriggerWorkerMockRecorder) SetPhase(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPhase", reflect.TypeOf((*MockTriggerWorker)(nil).SetPhase), arg0)
 }
 
+// SetResources mocks base method.
+func (m *MockTriggerWorker) SetResources(res *metadata.TriggerWorkerResources) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetResources", res)
+}
+
+// SetResources indicates an expected call of SetResources.
+func (mr *MockTriggerWorkerMockRecorder) SetResources(res interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResources", reflect.TypeOf((*MockTriggerWorker)(nil).SetResources), res)
+}
+
 // Start mocks base method.
 func (m *MockTriggerWorker) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,141 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"sort"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+// WorkerLoad is a running trigger worker and subscriptions assigned to it.
+type WorkerLoad struct {
+	Info          metadata.TriggerWorkerInfo
+	Subscriptions []*metadata.Subscription
+}
+
+// RebalanceMove moves a subscription from a trigger worker to another one.
+type RebalanceMove struct {
+	SubscriptionID vanus.ID `json:"subscription_id"`
+	From           string   `json:"from"`
+	To             string   `json:"to"`
+	// PausedEventsPerSecond estimates events per second whose delivery pauses while the subscription moves.
+	PausedEventsPerSecond float64 `json:"paused_events_per_second,omitempty"`
+}
+
+// PlanRebalance moves subscriptions from the most loaded trigger workers to the least loaded ones which match
+// their node selectors and accept new subscriptions, until no move narrows the gap between numbers of
+// subscriptions of workers, or maxMoves are planned, 0 is unlimited. Each subscription moves at most once.
+func PlanRebalance(loads []WorkerLoad, admission AdmissionConfig, maxMoves int) []RebalanceMove {
+	counts := make(map[string]int, len(loads))
+	for i := range loads {
+		counts[loads[i].Info.Addr] = len(loads[i].Subscriptions)
+	}
+	// Subscriptions are tried in the order of IDs, so that the plan is stable.
+	subs := make(map[string][]*metadata.Subscription, len(loads))
+	for i := range loads {
+		list := append([]*metadata.Subscription{}, loads[i].Subscriptions...)
+		sort.Slice(list, func(a, b int) bool {
+			return list[a].ID < list[b].ID
+		})
+		subs[loads[i].Info.Addr] = list
+	}
+
+	moves := make([]RebalanceMove, 0)
+	for maxMoves <= 0 || len(moves) < maxMoves {
+		move, ok := planMove(loads, counts, subs, admission)
+		if !ok {
+			break
+		}
+		moves = append(moves, move)
+	}
+	return moves
+}
+
+// planMove plans a move from the most loaded worker which has a movable subscription. A move to a worker
+// with at least two subscriptions less always narrows the gap, so planning ends.
+func planMove(loads []WorkerLoad, counts map[string]int, subs map[string][]*metadata.Subscription,
+	admission AdmissionConfig) (RebalanceMove, bool) {
+	sources := make([]*WorkerLoad, len(loads))
+	for i := range loads {
+		sources[i] = &loads[i]
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		ci, cj := counts[sources[i].Info.Addr], counts[sources[j].Info.Addr]
+		if ci != cj {
+			return ci > cj
+		}
+		return sources[i].Info.Addr < sources[j].Info.Addr
+	})
+
+	for _, src := range sources {
+		from := src.Info.Addr
+		for idx, sub := range subs[from] {
+			target := leastLoadedTarget(loads, counts, from, sub, admission)
+			if target == nil {
+				continue
+			}
+			to := target.Info.Addr
+			// the subscription is removed from candidates of both workers, so that it moves only once.
+			subs[from] = append(subs[from][:idx:idx], subs[from][idx+1:]...)
+			counts[from]--
+			counts[to]++
+			return RebalanceMove{
+				SubscriptionID:        sub.ID,
+				From:                  from,
+				To:                    to,
+				PausedEventsPerSecond: averageRate(src),
+			}, true
+		}
+	}
+	return RebalanceMove{}, false
+}
+
+func leastLoadedTarget(loads []WorkerLoad, counts map[string]int, from string, sub *metadata.Subscription,
+	admission AdmissionConfig) *WorkerLoad {
+	var target *WorkerLoad
+	for i := range loads {
+		to := loads[i].Info.Addr
+		if to == from || counts[to] > counts[from]-2 || !loads[i].Info.Match(sub.NodeSelector) {
+			continue
+		}
+		if admission.UnavailableReason(loads[i].Info, counts[to]) != "" {
+			continue
+		}
+		if target == nil || counts[to] < counts[target.Info.Addr] ||
+			(counts[to] == counts[target.Info.Addr] && to < target.Info.Addr) {
+			target = &loads[i]
+		}
+	}
+	return target
+}
+
+// averageRate estimates the delivery rate of a subscription of the worker, since rates of subscriptions
+// aren't reported.
+func averageRate(load *WorkerLoad) float64 {
+	res := load.Info.Resources
+	if res == nil || res.EventsPerSecond <= 0 {
+		return 0
+	}
+	n := len(load.Subscriptions)
+	if int(res.Subscriptions) > n {
+		n = int(res.Subscriptions)
+	}
+	if n == 0 {
+		return 0
+	}
+	return res.EventsPerSecond / float64(n)
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPlanRebalance(t *testing.T) {
+	Convey("test plan rebalance", t, func() {
+		newLoad := func(addr string, n int, first uint64) WorkerLoad {
+			load := WorkerLoad{Info: metadata.TriggerWorkerInfo{Addr: addr}}
+			for i := 0; i < n; i++ {
+				load.Subscriptions = append(load.Subscriptions, &metadata.Subscription{
+					ID:            vanus.NewIDFromUint64(first + uint64(i)),
+					TriggerWorker: addr,
+				})
+			}
+			return load
+		}
+		countsAfter := func(loads []WorkerLoad, moves []RebalanceMove) map[string]int {
+			counts := map[string]int{}
+			for i := range loads {
+				counts[loads[i].Info.Addr] = len(loads[i].Subscriptions)
+			}
+			for _, m := range moves {
+				counts[m.From]--
+				counts[m.To]++
+			}
+			return counts
+		}
+
+		Convey("balance subscriptions", func() {
+			loads := []WorkerLoad{newLoad("a", 6, 100), newLoad("b", 1, 200), newLoad("c", 0, 300)}
+			loads[0].Info.Resources = &metadata.TriggerWorkerResources{EventsPerSecond: 600}
+			moves := PlanRebalance(loads, AdmissionConfig{}, 0)
+			So(moves, ShouldHaveLength, 3)
+			So(countsAfter(loads, moves), ShouldResemble, map[string]int{"a": 3, "b": 2, "c": 2})
+			So(moves[0].SubscriptionID, ShouldEqual, vanus.NewIDFromUint64(100))
+			So(moves[0].To, ShouldEqual, "c")
+			So(moves[0].PausedEventsPerSecond, ShouldEqual, 100)
+			ids := map[vanus.ID]bool{}
+			for _, m := range moves {
+				So(ids[m.SubscriptionID], ShouldBeFalse)
+				ids[m.SubscriptionID] = true
+			}
+		})
+
+		Convey("balanced workers", func() {
+			loads := []WorkerLoad{newLoad("a", 3, 100), newLoad("b", 2, 200)}
+			So(PlanRebalance(loads, AdmissionConfig{}, 0), ShouldBeEmpty)
+		})
+
+		Convey("limit the number of moves", func() {
+			loads := []WorkerLoad{newLoad("a", 6, 100), newLoad("b", 0, 200)}
+			So(PlanRebalance(loads, AdmissionConfig{}, 2), ShouldHaveLength, 2)
+		})
+
+		Convey("respect node selectors", func() {
+			loads := []WorkerLoad{newLoad("a", 4, 100), newLoad("b", 0, 200)}
+			loads[0].Info.Labels = map[string]string{"zone": "a"}
+			for _, sub := range loads[0].Subscriptions[:3] {
+				sub.NodeSelector = map[string]string{"zone": "a"}
+			}
+			moves := PlanRebalance(loads, AdmissionConfig{}, 0)
+			So(moves, ShouldHaveLength, 1)
+			So(moves[0].SubscriptionID, ShouldEqual, vanus.NewIDFromUint64(103))
+		})
+
+		Convey("skip workers which don't accept new subscriptions", func() {
+			loads := []WorkerLoad{newLoad("a", 6, 100), newLoad("b", 0, 200), newLoad("c", 0, 300)}
+			loads[1].Info.Resources = &metadata.TriggerWorkerResources{MaxSubscriptions: 1}
+			moves := PlanRebalance(loads, AdmissionConfig{}, 0)
+			So(countsAfter(loads, moves), ShouldResemble, map[string]int{"a": 3, "b": 1, "c": 2})
+		})
+	})
+}
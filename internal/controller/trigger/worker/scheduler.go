@@ -16,8 +16,10 @@ package worker
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
 	"github.com/linkall-labs/vanus/internal/primitive/queue"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
@@ -33,6 +35,7 @@ type SubscriptionScheduler struct {
 	normalQueue         queue.Queue
 	maxRetryPrintLog    int
 	policy              TriggerWorkerPolicy
+	admission           AdmissionConfig
 	workerManager       Manager
 	subscriptionManager subscription.Manager
 	ctx                 context.Context
@@ -40,11 +43,13 @@ type SubscriptionScheduler struct {
 }
 
 func NewSubscriptionScheduler(workerManager Manager,
-	subscriptionManager subscription.Manager) *SubscriptionScheduler {
+	subscriptionManager subscription.Manager,
+	admission AdmissionConfig) *SubscriptionScheduler {
 	s := &SubscriptionScheduler{
 		normalQueue:         queue.New(),
 		maxRetryPrintLog:    defaultRetryPrintLog,
 		policy:              &RoundRobinPolicy{},
+		admission:           admission,
 		workerManager:       workerManager,
 		subscriptionManager: subscriptionManager,
 	}
@@ -108,7 +113,23 @@ func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanu
 				time.Sleep(time.Second)
 				continue
 			}
-			twInfo := s.policy.Acquire(ctx, twInfos)
+			candidates := matchTriggerWorkers(twInfos, subscription.NodeSelector)
+			if len(candidates) == 0 {
+				// retry later, other subscriptions in the queue can be scheduled in the meantime.
+				subscription.UnschedulableReason = fmt.Sprintf(
+					"none of %d running trigger workers matches node selector %v", len(twInfos),
+					subscription.NodeSelector)
+				return ErrNoMatchedTriggerWorker
+			}
+			matched := len(candidates)
+			candidates = s.availableTriggerWorkers(candidates)
+			if len(candidates) == 0 {
+				subscription.UnschedulableReason = fmt.Sprintf(
+					"all of %d matched trigger workers reach their capacity, the cluster needs more trigger workers",
+					matched)
+				return ErrTriggerWorkersFull
+			}
+			twInfo := s.policy.Acquire(ctx, candidates)
 			twAddr = twInfo.Addr
 			break
 		}
@@ -117,6 +138,7 @@ func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanu
 	if tWorker == nil {
 		return ErrTriggerWorkerNotFound
 	}
+	subscription.UnschedulableReason = ""
 	if subscription.TriggerWorker == "" {
 		subscription.TriggerWorker = twAddr
 		err := s.subscriptionManager.UpdateSubscription(ctx, subscription)
@@ -128,3 +150,38 @@ func (s *SubscriptionScheduler) handler(ctx context.Context, subscriptionID vanu
 	tWorker.AssignSubscription(subscriptionID)
 	return nil
 }
+
+// availableTriggerWorkers filters out trigger workers which don't accept new subscriptions.
+func (s *SubscriptionScheduler) availableTriggerWorkers(
+	twInfos []metadata.TriggerWorkerInfo) []metadata.TriggerWorkerInfo {
+	available := make([]metadata.TriggerWorkerInfo, 0, len(twInfos))
+	for i := range twInfos {
+		if twInfos[i].Resources == nil {
+			available = append(available, twInfos[i])
+			continue
+		}
+		assigned := 0
+		if tWorker := s.workerManager.GetTriggerWorker(twInfos[i].Addr); tWorker != nil {
+			assigned = len(tWorker.GetAssignedSubscriptions())
+		}
+		if s.admission.UnavailableReason(twInfos[i], assigned) == "" {
+			available = append(available, twInfos[i])
+		}
+	}
+	return available
+}
+
+// matchTriggerWorkers returns trigger workers whose labels match the node selector of a subscription.
+func matchTriggerWorkers(twInfos []metadata.TriggerWorkerInfo,
+	selector map[string]string) []metadata.TriggerWorkerInfo {
+	if len(selector) == 0 {
+		return twInfos
+	}
+	matched := make([]metadata.TriggerWorkerInfo, 0, len(twInfos))
+	for i := range twInfos {
+		if twInfos[i].Match(selector) {
+			matched = append(matched, twInfos[i])
+		}
+	}
+	return matched
+}
@@ -38,7 +38,7 @@ func TestSubscriptionSchedulerHandler(t *testing.T) {
 		tWorker := NewMockTriggerWorker(ctrl)
 		subscriptionManager := subscription.NewMockManager(ctrl)
 		workerManager := NewMockManager(ctrl)
-		scheduler := NewSubscriptionScheduler(workerManager, subscriptionManager)
+		scheduler := NewSubscriptionScheduler(workerManager, subscriptionManager, AdmissionConfig{})
 
 		Convey("test handler subscription is nil", func() {
 			subscriptionManager.EXPECT().GetSubscription(ctx, subscriptionID).Return(nil)
@@ -71,6 +71,69 @@ func TestSubscriptionSchedulerHandler(t *testing.T) {
 			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).AnyTimes().Return(nil)
 			scheduler.handler(ctx, subscriptionID)
 		})
+
+		Convey("test scheduler handler with node selector", func() {
+			sub := &metadata.Subscription{
+				ID:           subscriptionID,
+				Phase:        metadata.SubscriptionPhaseCreated,
+				NodeSelector: map[string]string{"region": "eu"},
+			}
+			subscriptionManager.EXPECT().GetSubscription(ctx, subscriptionID).AnyTimes().Return(sub)
+			workerManager.EXPECT().GetActiveRunningTriggerWorker().Times(1).Return([]metadata.TriggerWorkerInfo{
+				{Addr: "us", Labels: map[string]string{"region": "us"}},
+				{Addr: "none"},
+			})
+			err := scheduler.handler(ctx, subscriptionID)
+			So(err, ShouldEqual, ErrNoMatchedTriggerWorker)
+			So(sub.TriggerWorker, ShouldBeEmpty)
+			So(sub.UnschedulableReason, ShouldNotBeEmpty)
+
+			workerManager.EXPECT().GetActiveRunningTriggerWorker().Times(1).Return([]metadata.TriggerWorkerInfo{
+				{Addr: "us", Labels: map[string]string{"region": "us"}},
+				{Addr: workerAddr, Labels: map[string]string{"region": "eu", "zone": "a"}},
+			})
+			workerManager.EXPECT().GetTriggerWorker(workerAddr).Return(tWorker)
+			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).Return(nil)
+			err = scheduler.handler(ctx, subscriptionID)
+			So(err, ShouldBeNil)
+			So(sub.TriggerWorker, ShouldEqual, workerAddr)
+			So(sub.UnschedulableReason, ShouldBeEmpty)
+		})
+
+		Convey("test scheduler handler with trigger workers reaching capacity", func() {
+			sub := &metadata.Subscription{
+				ID:    subscriptionID,
+				Phase: metadata.SubscriptionPhaseCreated,
+			}
+			subscriptionManager.EXPECT().GetSubscription(ctx, subscriptionID).AnyTimes().Return(sub)
+			fullWorker := NewMockTriggerWorker(ctrl)
+			fullWorker.EXPECT().GetAssignedSubscriptions().AnyTimes().Return([]vanus.ID{1, 2})
+			workerManager.EXPECT().GetTriggerWorker("full").AnyTimes().Return(fullWorker)
+			full := metadata.TriggerWorkerInfo{
+				Addr:      "full",
+				Resources: &metadata.TriggerWorkerResources{Subscriptions: 1, MaxSubscriptions: 2},
+			}
+			workerManager.EXPECT().GetActiveRunningTriggerWorker().Times(1).Return([]metadata.TriggerWorkerInfo{full})
+			err := scheduler.handler(ctx, subscriptionID)
+			So(err, ShouldEqual, ErrTriggerWorkersFull)
+			So(sub.TriggerWorker, ShouldBeEmpty)
+			So(sub.UnschedulableReason, ShouldContainSubstring, "needs more trigger workers")
+
+			workerManager.EXPECT().GetActiveRunningTriggerWorker().Times(1).Return([]metadata.TriggerWorkerInfo{
+				full,
+				{
+					Addr:      workerAddr,
+					Resources: &metadata.TriggerWorkerResources{CPUCores: 0.5, CPULimit: 2},
+				},
+			})
+			tWorker.EXPECT().GetAssignedSubscriptions().AnyTimes().Return(nil)
+			workerManager.EXPECT().GetTriggerWorker(workerAddr).Times(2).Return(tWorker)
+			subscriptionManager.EXPECT().UpdateSubscription(ctx, gomock.Any()).Return(nil)
+			err = scheduler.handler(ctx, subscriptionID)
+			So(err, ShouldBeNil)
+			So(sub.TriggerWorker, ShouldEqual, workerAddr)
+			So(sub.UnschedulableReason, ShouldBeEmpty)
+		})
 	})
 }
 
@@ -82,7 +145,7 @@ func TestSubscriptionSchedulerRun(t *testing.T) {
 		defer ctrl.Finish()
 		subscriptionManager := subscription.NewMockManager(ctrl)
 		workerManager := NewMockManager(ctrl)
-		scheduler := NewSubscriptionScheduler(workerManager, subscriptionManager)
+		scheduler := NewSubscriptionScheduler(workerManager, subscriptionManager, AdmissionConfig{})
 		Convey("test scheduler run handler no error", func() {
 			subscriptionManager.EXPECT().GetSubscription(scheduler.ctx, gomock.Any()).Return(nil)
 			scheduler.EnqueueNormalSubscription(subscriptionID)
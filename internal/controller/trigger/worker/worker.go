@@ -24,6 +24,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/controller/trigger/subscription"
 	"github.com/linkall-labs/vanus/internal/convert"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
 	"github.com/linkall-labs/vanus/internal/primitive/queue"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/observability/log"
@@ -41,6 +42,7 @@ type TriggerWorker interface {
 	Close() error
 	IsActive() bool
 	Reset()
+	SetLabels(labels map[string]string)
 	GetInfo() metadata.TriggerWorkerInfo
 	GetAddr() string
 	SetPhase(metadata.TriggerWorkerPhase)
@@ -48,9 +50,14 @@ type TriggerWorker interface {
 	GetPendingTime() time.Time
 	GetHeartbeatTime() time.Time
 	Polish()
+	SetResources(res *metadata.TriggerWorkerResources)
 	AssignSubscription(id vanus.ID)
 	UnAssignSubscription(id vanus.ID) error
 	GetAssignedSubscriptions() []vanus.ID
+	ResyncSubscriptions(ctx context.Context) error
+	// ResumeEventlogs resumes eventlogs of the subscription paused by sequence gaps on the worker, it returns
+	// IDs of the eventlogs resumed.
+	ResumeEventlogs(ctx context.Context, id vanus.ID) ([]vanus.ID, error)
 }
 
 // triggerWorker send subscription to trigger worker server.
@@ -149,10 +156,30 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 		}
 		return nil
 	}
-	offsets, err := tw.subscriptionManager.GetOrSaveOffset(ctx, subscriptionID)
+	pSub, err := tw.toSubscription(ctx, sub)
 	if err != nil {
 		return err
 	}
+	err = tw.addSubscription(ctx, pSub)
+	if err != nil {
+		return err
+	}
+	// modify subscription to running
+	sub.Phase = metadata.SubscriptionPhaseRunning
+	err = tw.subscriptionManager.UpdateSubscription(ctx, sub)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// toSubscription builds the subscription which trigger worker runs, the revision is the update time of subscription.
+func (tw *triggerWorker) toSubscription(ctx context.Context,
+	sub *metadata.Subscription) (*primitive.Subscription, error) {
+	offsets, err := tw.subscriptionManager.GetOrSaveOffset(ctx, sub.ID)
+	if err != nil {
+		return nil, err
+	}
 	filters := append([]*primitive.SubscriptionFilter(nil), sub.Filters...)
 	if sub.Source != "" {
 		filters = append(filters, &primitive.SubscriptionFilter{
@@ -176,7 +203,7 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 			})
 		}
 	}
-	err = tw.addSubscription(ctx, &primitive.Subscription{
+	return &primitive.Subscription{
 		ID:              sub.ID,
 		Filters:         filters,
 		Sink:            sub.Sink,
@@ -187,17 +214,9 @@ func (tw *triggerWorker) handler(ctx context.Context, subscriptionID vanus.ID) e
 		Protocol:        sub.Protocol,
 		ProtocolSetting: sub.ProtocolSetting,
 		SinkCredential:  sub.SinkCredential,
-	})
-	if err != nil {
-		return err
-	}
-	// modify subscription to running
-	sub.Phase = metadata.SubscriptionPhaseRunning
-	err = tw.subscriptionManager.UpdateSubscription(ctx, sub)
-	if err != nil {
-		return err
-	}
-	return nil
+		Signing:         sub.Signing,
+		Revision:        uint64(sub.UpdatedAt.UnixNano()),
+	}, nil
 }
 
 func (tw *triggerWorker) IsActive() bool {
@@ -220,7 +239,16 @@ func (tw *triggerWorker) Reset() {
 	tw.pendingTime = time.Now()
 }
 
+// SetLabels replaces labels of the trigger worker, they are used to match node selectors of subscriptions.
+func (tw *triggerWorker) SetLabels(labels map[string]string) {
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+	tw.info.Labels = labels
+}
+
 func (tw *triggerWorker) GetInfo() metadata.TriggerWorkerInfo {
+	tw.lock.RLock()
+	defer tw.lock.RUnlock()
 	return *tw.info
 }
 
@@ -246,6 +274,17 @@ func (tw *triggerWorker) Polish() {
 	tw.heartbeatTime = time.Now()
 }
 
+// SetResources updates resources reported by the trigger worker, nil is ignored for workers which don't
+// report them.
+func (tw *triggerWorker) SetResources(res *metadata.TriggerWorkerResources) {
+	if res == nil {
+		return
+	}
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+	tw.info.Resources = res
+}
+
 func (tw *triggerWorker) AssignSubscription(id vanus.ID) {
 	_, exist := tw.assignSubscriptionIDs.Load(id)
 	var msg string
@@ -292,6 +331,64 @@ func (tw *triggerWorker) GetAssignedSubscriptions() []vanus.ID {
 	return ids
 }
 
+// ResyncSubscriptions sends the full set of assigned subscriptions to the restarted trigger worker, which drops
+// the subscriptions not in the set and reports the ones it started. The assigned subscriptions which aren't
+// started are left to the subscription queue.
+func (tw *triggerWorker) ResyncSubscriptions(ctx context.Context) error {
+	ids := tw.GetAssignedSubscriptions()
+	subs := make(map[vanus.ID]*metadata.Subscription, len(ids))
+	request := &trigger.ResyncSubscriptionsRequest{}
+	for _, id := range ids {
+		sub := tw.subscriptionManager.GetSubscription(ctx, id)
+		if sub == nil || sub.Phase == metadata.SubscriptionPhaseStopping ||
+			sub.Phase == metadata.SubscriptionPhaseStopped {
+			continue
+		}
+		pSub, err := tw.toSubscription(ctx, sub)
+		if err != nil {
+			log.Warning(ctx, "trigger worker resync get subscription offset error", map[string]interface{}{
+				log.KeyError:             err,
+				log.KeyTriggerWorkerAddr: tw.info.Addr,
+				log.KeySubscriptionID:    id,
+			})
+			continue
+		}
+		subs[id] = sub
+		request.Subscriptions = append(request.Subscriptions, convert.ToPbAddSubscription(pSub))
+	}
+	resp, err := tw.client.ResyncSubscriptions(ctx, request)
+	if err != nil {
+		for _, id := range ids {
+			tw.subscriptionQueue.Add(id)
+		}
+		return errors.ErrTriggerWorker.WithMessage("resync subscriptions error").Wrap(err)
+	}
+	started := make(map[vanus.ID]struct{}, len(resp.StartedSubscriptionIds))
+	for _, id := range resp.StartedSubscriptionIds {
+		started[vanus.NewIDFromUint64(id)] = struct{}{}
+	}
+	for _, id := range ids {
+		sub, exist := subs[id]
+		if _, ok := started[id]; !exist || !ok {
+			tw.subscriptionQueue.Add(id)
+			continue
+		}
+		if sub.Phase == metadata.SubscriptionPhaseRunning {
+			continue
+		}
+		sub.Phase = metadata.SubscriptionPhaseRunning
+		if err = tw.subscriptionManager.UpdateSubscription(ctx, sub); err != nil {
+			tw.subscriptionQueue.Add(id)
+		}
+	}
+	log.Info(ctx, "trigger worker resync subscriptions", map[string]interface{}{
+		log.KeyTriggerWorkerAddr: tw.info.Addr,
+		"assigned":               len(ids),
+		"started":                len(started),
+	})
+	return nil
+}
+
 func (tw *triggerWorker) GetPendingTime() time.Time {
 	tw.lock.RLock()
 	defer tw.lock.RUnlock()
@@ -311,6 +408,7 @@ func (tw *triggerWorker) init(ctx context.Context) error {
 	var err error
 	var opts []grpc.DialOption
 	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts = append(opts, compression.ControlDialOptions()...)
 	tw.cc, err = grpc.DialContext(ctx, tw.info.Addr, opts...)
 	if err != nil {
 		return errors.ErrTriggerWorker.WithMessage("grpc dial error").Wrap(err)
@@ -363,3 +461,16 @@ func (tw *triggerWorker) removeSubscription(ctx context.Context, id vanus.ID) er
 	}
 	return nil
 }
+
+func (tw *triggerWorker) ResumeEventlogs(ctx context.Context, id vanus.ID) ([]vanus.ID, error) {
+	request := &trigger.ResumeEventlogsRequest{SubscriptionId: uint64(id)}
+	resp, err := tw.client.ResumeEventlogs(ctx, request)
+	if err != nil {
+		return nil, errors.ErrTriggerWorker.WithMessage("resume eventlogs error").Wrap(err)
+	}
+	ids := make([]vanus.ID, len(resp.EventlogIds))
+	for i, eventlogID := range resp.EventlogIds {
+		ids[i] = vanus.NewIDFromUint64(eventlogID)
+	}
+	return ids, nil
+}
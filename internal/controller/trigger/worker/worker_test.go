@@ -29,6 +29,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
 )
 
 func TestTriggerWorker_AssignSubscription(t *testing.T) {
@@ -150,6 +151,59 @@ func TestTriggerWorker_Handler(t *testing.T) {
 	})
 }
 
+func TestTriggerWorker_ResyncSubscriptions(t *testing.T) {
+	Convey("test trigger worker resync subscriptions", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		subscriptionManager := subscription.NewMockManager(ctrl)
+		client := pbtrigger.NewMockTriggerWorkerClient(ctrl)
+		addr := "test"
+		tWorker := NewTriggerWorkerByAddr(addr, subscriptionManager).(*triggerWorker)
+		tWorker.client = client
+		started, failed, stopped := vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID()
+		for _, id := range []vanus.ID{started, failed, stopped} {
+			tWorker.assignSubscriptionIDs.Store(id, time.Now())
+		}
+		updatedAt := time.Now()
+		startedSub := &metadata.Subscription{ID: started, UpdatedAt: updatedAt}
+		subscriptionManager.EXPECT().GetSubscription(ctx, started).AnyTimes().Return(startedSub)
+		subscriptionManager.EXPECT().GetSubscription(ctx, failed).AnyTimes().Return(&metadata.Subscription{ID: failed})
+		subscriptionManager.EXPECT().GetSubscription(ctx, stopped).AnyTimes().Return(&metadata.Subscription{
+			ID: stopped, Phase: metadata.SubscriptionPhaseStopped,
+		})
+		subscriptionManager.EXPECT().GetOrSaveOffset(ctx, gomock.Any()).AnyTimes().Return(info.ListOffsetInfo{}, nil)
+
+		Convey("resync success", func() {
+			client.EXPECT().ResyncSubscriptions(ctx, gomock.Any()).Times(1).DoAndReturn(
+				func(ctx context.Context, in *pbtrigger.ResyncSubscriptionsRequest,
+					opts ...grpc.CallOption) (*pbtrigger.ResyncSubscriptionsResponse, error) {
+					So(in.Subscriptions, ShouldHaveLength, 2)
+					for _, sub := range in.Subscriptions {
+						if sub.Id == started.Uint64() {
+							So(sub.Revision, ShouldEqual, uint64(updatedAt.UnixNano()))
+						}
+					}
+					return &pbtrigger.ResyncSubscriptionsResponse{
+						StartedSubscriptionIds: []uint64{started.Uint64()},
+					}, nil
+				})
+			subscriptionManager.EXPECT().UpdateSubscription(ctx, startedSub).Times(1).Return(nil)
+			err := tWorker.ResyncSubscriptions(ctx)
+			So(err, ShouldBeNil)
+			So(startedSub.Phase, ShouldEqual, metadata.SubscriptionPhaseRunning)
+			So(tWorker.subscriptionQueue.Len(), ShouldEqual, 2)
+		})
+		Convey("resync error", func() {
+			client.EXPECT().ResyncSubscriptions(ctx, gomock.Any()).Times(1).Return(nil, fmt.Errorf("error"))
+			err := tWorker.ResyncSubscriptions(ctx)
+			So(err, ShouldNotBeNil)
+			So(tWorker.subscriptionQueue.Len(), ShouldEqual, 3)
+		})
+		_ = tWorker.Close()
+	})
+}
+
 func TestTriggerWorker_IsActive(t *testing.T) {
 	Convey("test trigger worker isActive", t, func() {
 		ctrl := gomock.NewController(t)
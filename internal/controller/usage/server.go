@@ -0,0 +1,76 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+const (
+	defaultWindow = time.Hour
+	defaultLimit  = 10
+	maximumLimit  = 1000
+)
+
+type server struct {
+	store *Store
+}
+
+// NewServer exposes reports of the store.
+func NewServer(store *Store) ctrlpb.UsageControllerServer {
+	return &server{store: store}
+}
+
+// make sure server implements ctrlpb.UsageControllerServer.
+var _ ctrlpb.UsageControllerServer = (*server)(nil)
+
+func (s *server) ListTopUsage(_ context.Context,
+	req *ctrlpb.ListTopUsageRequest) (*ctrlpb.ListTopUsageResponse, error) {
+	kind, metric := Kind(req.Kind), Metric(req.Metric)
+	if !Supports(kind, metric) {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("metric %q of %q isn't supported", metric, kind))
+	}
+	window := defaultWindow
+	if req.WindowSeconds > 0 {
+		window = time.Duration(req.WindowSeconds) * time.Second
+	}
+	if window < s.store.MinWindow() || window > s.store.Retention() {
+		return nil, errors.ErrInvalidRequest.WithMessage(
+			fmt.Sprintf("the window must be between %s and %s", s.store.MinWindow(), s.store.Retention()))
+	}
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = defaultLimit
+	} else if limit > maximumLimit {
+		limit = maximumLimit
+	}
+
+	entries := s.store.Top(kind, metric, window, limit, time.Now())
+	resp := &ctrlpb.ListTopUsageResponse{Entries: make([]*ctrlpb.UsageEntry, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &ctrlpb.UsageEntry{
+			Resource: e.Resource,
+			Eventbus: e.Eventbus,
+			Value:    e.Value,
+		})
+	}
+	return resp, nil
+}
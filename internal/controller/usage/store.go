@@ -0,0 +1,296 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	defaultRetention = 24 * time.Hour
+	// maxSamples bounds the history of a resource, samples are kept at least retention/maxSamples apart.
+	maxSamples = 288
+	// minSpan is the shortest history which rates are computed from.
+	minSpan = time.Minute
+)
+
+type Kind string
+
+const (
+	KindEventbus     Kind = "eventbus"
+	KindSubscription Kind = "subscription"
+)
+
+type Metric string
+
+const (
+	MetricThroughput  Metric = "throughput"
+	MetricStorage     Metric = "storage"
+	MetricFailureRate Metric = "failure_rate"
+	MetricLag         Metric = "lag"
+)
+
+// Supports reports whether the metric is collected for resources of the kind.
+func Supports(kind Kind, metric Metric) bool {
+	switch kind {
+	case KindEventbus:
+		return metric == MetricThroughput || metric == MetricStorage
+	case KindSubscription:
+		return metric == MetricThroughput || metric == MetricFailureRate || metric == MetricLag
+	}
+	return false
+}
+
+type Config struct {
+	// Retention is how long the history of resources is kept, which is the longest window of reports, it's
+	// 24h by default.
+	Retention time.Duration `yaml:"retention"`
+}
+
+type key struct {
+	kind     Kind
+	resource string
+}
+
+type sample struct {
+	at time.Time
+	// events and failed are counters, which are events published to an eventbus, or events delivered and
+	// failed to deliver by a subscription.
+	events uint64
+	failed uint64
+	// storage and lag are gauges, which are the peaks since the previous sample.
+	storage int64
+	lag     uint64
+}
+
+type series struct {
+	// eventbus is the one which the subscription consumes.
+	eventbus string
+	// rawEvents and rawFailed are counters last reported, they start over once the reporter restarts, e.g.
+	// the subscription moves to another trigger worker. Bases make up for that, so that counters of samples
+	// only grow.
+	rawEvents  uint64
+	rawFailed  uint64
+	baseEvents uint64
+	baseFailed uint64
+	samples    []sample
+}
+
+// Store aggregates throughput, storage, failure rates and lags of eventbuses and subscriptions reported to
+// the controller, and ranks resources by them over windows. The history lives in memory of the leader, it
+// starts over after the leader changes.
+type Store struct {
+	retention time.Duration
+	interval  time.Duration
+
+	mutex  sync.RWMutex
+	series map[key]*series
+	// ends are end offsets of eventlogs, which lags of subscriptions are computed against.
+	ends     map[vanus.ID]uint64
+	prunedAt time.Time
+}
+
+func NewStore(cfg Config) *Store {
+	if cfg.Retention <= 0 {
+		cfg.Retention = defaultRetention
+	}
+	return &Store{
+		retention: cfg.Retention,
+		interval:  cfg.Retention / maxSamples,
+		series:    map[key]*series{},
+		ends:      map[vanus.ID]uint64{},
+	}
+}
+
+func (s *Store) Retention() time.Duration {
+	return s.retention
+}
+
+// MinWindow is the shortest window of reports, which covers at least two samples.
+func (s *Store) MinWindow() time.Duration {
+	if w := 2 * s.interval; w > minSpan {
+		return w
+	}
+	return minSpan
+}
+
+// ObserveEventbus records the number of events ever published to the eventbus and bytes it takes at now.
+func (s *Store) ObserveEventbus(name string, published uint64, storage int64, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.observe(key{kind: KindEventbus, resource: name}, "", published, 0, sample{storage: storage}, now)
+}
+
+// ObserveEventlogEnds replaces end offsets of eventlogs.
+func (s *Store) ObserveEventlogEnds(ends map[vanus.ID]uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ends = ends
+}
+
+// ObserveSubscription records the number of events delivered and failed to deliver by the subscription
+// since its trigger started, and how far offsets it consumed are behind ends of eventlogs at now.
+func (s *Store) ObserveSubscription(id vanus.ID, eventbus string, delivered, failed uint64,
+	offsets info.ListOffsetInfo, now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var lag uint64
+	for _, o := range offsets {
+		if end, ok := s.ends[o.EventLogID]; ok && end > o.Offset {
+			lag += end - o.Offset
+		}
+	}
+	s.observe(key{kind: KindSubscription, resource: id.String()}, eventbus, delivered, failed,
+		sample{lag: lag}, now)
+}
+
+func (s *Store) observe(k key, eventbus string, events, failed uint64, gauges sample, now time.Time) {
+	se, ok := s.series[k]
+	if !ok {
+		se = &series{}
+		s.series[k] = se
+	}
+	se.eventbus = eventbus
+	if events < se.rawEvents {
+		se.baseEvents += se.rawEvents
+	}
+	if failed < se.rawFailed {
+		se.baseFailed += se.rawFailed
+	}
+	se.rawEvents, se.rawFailed = events, failed
+
+	cur := sample{
+		at:      now,
+		events:  se.baseEvents + events,
+		failed:  se.baseFailed + failed,
+		storage: gauges.storage,
+		lag:     gauges.lag,
+	}
+	n := len(se.samples)
+	if n >= 2 && now.Sub(se.samples[n-2].at) < s.interval {
+		// Replace the latest sample, so that samples are not denser than the interval, but keep the peaks.
+		last := se.samples[n-1]
+		if last.storage > cur.storage {
+			cur.storage = last.storage
+		}
+		if last.lag > cur.lag {
+			cur.lag = last.lag
+		}
+		se.samples[n-1] = cur
+	} else {
+		se.samples = append(se.samples, cur)
+	}
+	for len(se.samples) > 1 && now.Sub(se.samples[0].at) > s.retention {
+		se.samples = se.samples[1:]
+	}
+
+	if now.Sub(s.prunedAt) >= s.interval {
+		s.prunedAt = now
+		for k, se := range s.series {
+			// Resources which aren't reported anymore have been deleted.
+			if now.Sub(se.samples[len(se.samples)-1].at) > s.retention {
+				delete(s.series, k)
+			}
+		}
+	}
+}
+
+// Entry is a resource and its value of a metric over a window.
+type Entry struct {
+	// Resource is the name of the eventbus or the ID of the subscription.
+	Resource string
+	// Eventbus is the one which the subscription consumes, it's empty for eventbuses.
+	Eventbus string
+	Value    float64
+}
+
+// Top returns at most limit resources of the kind with the largest values of the metric over the window
+// which ends at now. Rates are computed over samples in the window, gauges are their peaks in the window.
+// Resources which aren't reported in the window, or whose history is too short for rates, are skipped.
+func (s *Store) Top(kind Kind, metric Metric, window time.Duration, limit int, now time.Time) []Entry {
+	from := now.Add(-window)
+	entries := make([]Entry, 0)
+	s.mutex.RLock()
+	for k, se := range s.series {
+		if k.kind != kind {
+			continue
+		}
+		if v, ok := se.value(metric, from); ok {
+			entries = append(entries, Entry{Resource: k.resource, Eventbus: se.eventbus, Value: v})
+		}
+	}
+	s.mutex.RUnlock()
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Value != entries[j].Value {
+			return entries[i].Value > entries[j].Value
+		}
+		return entries[i].Resource < entries[j].Resource
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+func (se *series) value(metric Metric, from time.Time) (float64, bool) {
+	i := sort.Search(len(se.samples), func(i int) bool {
+		return !se.samples[i].at.Before(from)
+	})
+	in := se.samples[i:]
+	if len(in) == 0 {
+		return 0, false
+	}
+	switch metric {
+	case MetricStorage:
+		var peak int64
+		for _, smp := range in {
+			if smp.storage > peak {
+				peak = smp.storage
+			}
+		}
+		return float64(peak), true
+	case MetricLag:
+		var peak uint64
+		for _, smp := range in {
+			if smp.lag > peak {
+				peak = smp.lag
+			}
+		}
+		return float64(peak), true
+	}
+
+	first, last := in[0], in[len(in)-1]
+	span := last.at.Sub(first.at)
+	if span < minSpan {
+		return 0, false
+	}
+	events, failed := last.events-first.events, last.failed-first.failed
+	switch metric {
+	case MetricThroughput:
+		return float64(events) / span.Seconds(), true
+	case MetricFailureRate:
+		if events+failed == 0 {
+			return 0, true
+		}
+		return float64(failed) / float64(events+failed), true
+	}
+	return 0, false
+}
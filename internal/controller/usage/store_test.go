@@ -0,0 +1,96 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStore(t *testing.T) {
+	Convey("test usage store", t, func() {
+		s := NewStore(Config{Retention: 24 * time.Hour})
+		now := time.Now()
+		at := func(minutes int) time.Time {
+			return now.Add(time.Duration(minutes) * time.Minute)
+		}
+
+		Convey("rank eventbuses", func() {
+			for i := 0; i <= 12; i++ {
+				s.ObserveEventbus("small", uint64(i*600), int64(1000+i), at(i*5))
+				s.ObserveEventbus("large", uint64(i*6000), 500, at(i*5))
+			}
+			top := s.Top(KindEventbus, MetricThroughput, time.Hour, 10, at(60))
+			So(top, ShouldHaveLength, 2)
+			So(top[0].Resource, ShouldEqual, "large")
+			So(top[0].Value, ShouldEqual, 20)
+			So(top[1].Value, ShouldEqual, 2)
+
+			top = s.Top(KindEventbus, MetricStorage, time.Hour, 1, at(60))
+			So(top, ShouldHaveLength, 1)
+			So(top[0].Resource, ShouldEqual, "small")
+			So(top[0].Value, ShouldEqual, 1012)
+
+			So(s.Top(KindSubscription, MetricThroughput, time.Hour, 10, at(60)), ShouldBeEmpty)
+		})
+
+		Convey("counters start over", func() {
+			id := vanus.NewTestID()
+			s.ObserveSubscription(id, "bus", 0, 0, nil, at(0))
+			s.ObserveSubscription(id, "bus", 4000, 40, nil, at(10))
+			// the subscription moves to another trigger worker
+			s.ObserveSubscription(id, "bus", 2000, 20, nil, at(20))
+			top := s.Top(KindSubscription, MetricThroughput, time.Hour, 10, at(20))
+			So(top, ShouldHaveLength, 1)
+			So(top[0].Resource, ShouldEqual, id.String())
+			So(top[0].Eventbus, ShouldEqual, "bus")
+			So(top[0].Value, ShouldEqual, 5)
+
+			top = s.Top(KindSubscription, MetricFailureRate, time.Hour, 10, at(20))
+			So(top[0].Value, ShouldAlmostEqual, 60.0/6060)
+		})
+
+		Convey("peak lags in the window", func() {
+			el := vanus.NewTestID()
+			id := vanus.NewTestID()
+			offsets := func(off uint64) info.ListOffsetInfo {
+				return info.ListOffsetInfo{{EventLogID: el, Offset: off}}
+			}
+			s.ObserveEventlogEnds(map[vanus.ID]uint64{el: 1000})
+			s.ObserveSubscription(id, "bus", 0, 0, offsets(100), at(0))
+			s.ObserveSubscription(id, "bus", 0, 0, offsets(1000), at(30))
+			// a sample replaced within the interval keeps its peak
+			s.ObserveEventlogEnds(map[vanus.ID]uint64{el: 1500})
+			s.ObserveSubscription(id, "bus", 0, 0, offsets(1200), at(31))
+			s.ObserveSubscription(id, "bus", 0, 0, offsets(1500), at(32))
+
+			So(s.Top(KindSubscription, MetricLag, time.Hour, 10, at(32))[0].Value, ShouldEqual, 900)
+			So(s.Top(KindSubscription, MetricLag, 10*time.Minute, 10, at(32))[0].Value, ShouldEqual, 300)
+			So(s.Top(KindSubscription, MetricLag, 10*time.Minute, 10, at(60)), ShouldBeEmpty)
+		})
+
+		Convey("forget resources which aren't reported anymore", func() {
+			s.ObserveEventbus("deleted", 0, 0, at(0))
+			s.ObserveEventbus("alive", 0, 0, at(0))
+			s.ObserveEventbus("alive", 0, 0, at(25*60))
+			So(s.series, ShouldHaveLength, 1)
+			So(s.MinWindow(), ShouldEqual, 10*time.Minute)
+		})
+	})
+}
@@ -0,0 +1,316 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package verifier checks invariants of events stored in eventbuses, verifications run as jobs of the
+// eventbus controller.
+package verifier
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	KindOrdering job.Kind = "eventbus-verify-ordering"
+
+	paramEventbus = "eventbus"
+
+	// extensionPartitionKey is the extension events of per-key ordered eventbuses are routed by.
+	extensionPartitionKey = "partitionkey"
+
+	readBatchSize = 64
+	// checkpointEvents is the number of events checked between updates of the progress.
+	checkpointEvents = 10000
+	// maxReportedViolations is the number of violations listed in the result, the rest are only counted.
+	maxReportedViolations = 20
+	maxAttempts           = 3
+)
+
+// OrderingOptions describes a verification of the ordering of events with partition keys in an eventbus.
+type OrderingOptions struct {
+	Eventbus string
+}
+
+// Spec returns the spec of the verification job, verifications of the same eventbus are deduplicated.
+func (o OrderingOptions) Spec() (job.Spec, error) {
+	if o.Eventbus == "" {
+		return job.Spec{}, errors.ErrInvalidRequest.WithMessage("the eventbus is required")
+	}
+	return job.Spec{
+		Kind:        KindOrdering,
+		Key:         o.Eventbus,
+		Params:      map[string]string{paramEventbus: o.Eventbus},
+		MaxAttempts: maxAttempts,
+	}, nil
+}
+
+type ViolationKind string
+
+const (
+	// ViolationSplit means events of a key are stored in more than one eventlog, consumers may receive them
+	// out of order, e.g. the number of eventlogs changed so that the key is hashed to another eventlog.
+	ViolationSplit ViolationKind = "split"
+	// ViolationTime means an event of a key is stored after a later event of the key in an eventlog.
+	ViolationTime ViolationKind = "time"
+)
+
+// Location is where an event is stored.
+type Location struct {
+	EventlogID uint64
+	Offset     int64
+	Time       time.Time
+}
+
+// Violation is a pair of events of the key which break the ordering, Prev is stored before Event.
+type Violation struct {
+	Kind  ViolationKind
+	Key   string
+	Prev  Location
+	Event Location
+}
+
+func (v Violation) String() string {
+	if v.Kind == ViolationSplit {
+		return fmt.Sprintf("key %s is in eventlog %d at offset %d and eventlog %d at offset %d",
+			v.Key, v.Prev.EventlogID, v.Prev.Offset, v.Event.EventlogID, v.Event.Offset)
+	}
+	return fmt.Sprintf("key %s at offset %d of eventlog %d is earlier than offset %d (%s < %s)",
+		v.Key, v.Event.Offset, v.Event.EventlogID, v.Prev.Offset,
+		v.Event.Time.Format(time.RFC3339Nano), v.Prev.Time.Format(time.RFC3339Nano))
+}
+
+type keyState struct {
+	// last is the last event of the key in the first eventlog it's found in.
+	last  Location
+	split bool
+}
+
+// orderingChecker checks events with partition keys are in one eventlog, and their times don't go backwards
+// in it. Events without time are only checked for splits.
+type orderingChecker struct {
+	keys       map[string]*keyState
+	checked    int64
+	keyless    int64
+	violations int64
+	reported   []Violation
+}
+
+func newOrderingChecker() *orderingChecker {
+	return &orderingChecker{keys: make(map[string]*keyState)}
+}
+
+// check must be called in order of offsets for events of an eventlog.
+func (c *orderingChecker) check(ctx context.Context, eventlogID uint64, offset int64, e *ce.Event) {
+	c.checked++
+	key, _ := e.Extensions()[extensionPartitionKey].(string)
+	if key == "" {
+		c.keyless++
+		return
+	}
+	loc := Location{EventlogID: eventlogID, Offset: offset, Time: e.Time()}
+	s, ok := c.keys[key]
+	if !ok {
+		c.keys[key] = &keyState{last: loc}
+		return
+	}
+	if s.last.EventlogID != eventlogID {
+		// Events in different eventlogs have no order, a split is reported once per key.
+		if !s.split {
+			s.split = true
+			c.report(ctx, Violation{Kind: ViolationSplit, Key: key, Prev: s.last, Event: loc})
+		}
+		return
+	}
+	if !loc.Time.IsZero() && loc.Time.Before(s.last.Time) {
+		c.report(ctx, Violation{Kind: ViolationTime, Key: key, Prev: s.last, Event: loc})
+	}
+	s.last = loc
+}
+
+func (c *orderingChecker) report(ctx context.Context, v Violation) {
+	c.violations++
+	if len(c.reported) < maxReportedViolations {
+		c.reported = append(c.reported, v)
+	}
+	log.Warning(ctx, "found a violation of the ordering of events with partition keys", map[string]interface{}{
+		"kind":             v.Kind,
+		"key":              v.Key,
+		"eventlog_id":      v.Event.EventlogID,
+		"offset":           v.Event.Offset,
+		"prev_eventlog_id": v.Prev.EventlogID,
+		"prev_offset":      v.Prev.Offset,
+	})
+}
+
+// summary is the progress message of the verification, which lists the first violations.
+func (c *orderingChecker) summary() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "checked %d events of %d keys, %d events without key, %d violations",
+		c.checked, len(c.keys), c.keyless, c.violations)
+	for _, v := range c.reported {
+		b.WriteString("; ")
+		b.WriteString(v.String())
+	}
+	if c.violations > int64(len(c.reported)) {
+		fmt.Fprintf(&b, "; %d more violations are logged", c.violations-int64(len(c.reported)))
+	}
+	return b.String()
+}
+
+type orderingVerifier struct {
+	openBus func(ctx context.Context, eventbus string) api.Eventbus
+}
+
+// NewOrderingHandler returns the handler of ordering verification jobs, eventbuses are read through
+// controllers at ctrlAddrs, which are connected once a verification runs. Events stored when the
+// verification starts are checked, a verification interrupted by leader changing starts over.
+func NewOrderingHandler(ctrlAddrs []string) job.Handler {
+	var (
+		once   sync.Once
+		client eb.Client
+	)
+	v := &orderingVerifier{
+		openBus: func(ctx context.Context, eventbus string) api.Eventbus {
+			once.Do(func() {
+				client = eb.Connect(ctrlAddrs)
+			})
+			return client.Eventbus(ctx, eventbus)
+		},
+	}
+	return v.run
+}
+
+// logRange is the range [from, to) of offsets of an eventlog to check.
+type logRange struct {
+	log  api.Eventlog
+	from int64
+	to   int64
+}
+
+func (v *orderingVerifier) run(ctx context.Context, e *job.Execution) error {
+	eventbus := e.Params()[paramEventbus]
+	if eventbus == "" {
+		return errors.ErrInvalidRequest.WithMessage("the eventbus is required")
+	}
+	bus := v.openBus(ctx, eventbus)
+	ranges, total, err := listRanges(ctx, bus)
+	if err != nil {
+		return err
+	}
+
+	c := newOrderingChecker()
+	for _, r := range ranges {
+		if err = v.checkLog(ctx, e, bus, r, c, total); err != nil {
+			return err
+		}
+	}
+	log.Info(ctx, "the ordering of eventbus has been verified", map[string]interface{}{
+		"job_id":     e.ID().Key(),
+		"eventbus":   eventbus,
+		"checked":    c.checked,
+		"violations": c.violations,
+	})
+	return e.Checkpoint(ctx, "", job.Progress{Done: c.checked, Total: total, Message: c.summary()})
+}
+
+// listRanges returns offsets of eventlogs to check sorted by IDs of eventlogs, and the number of events in
+// them.
+func listRanges(ctx context.Context, bus api.Eventbus) ([]logRange, int64, error) {
+	logs, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.Slice(logs, func(i, j int) bool {
+		return logs[i].ID() < logs[j].ID()
+	})
+	ranges := make([]logRange, 0, len(logs))
+	var total int64
+	for _, l := range logs {
+		from, err := l.EarliestOffset(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		to, err := l.LatestOffset(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+		if to <= from {
+			continue
+		}
+		ranges = append(ranges, logRange{log: l, from: from, to: to})
+		total += to - from
+	}
+	return ranges, total, nil
+}
+
+func (v *orderingVerifier) checkLog(ctx context.Context, e *job.Execution, bus api.Eventbus, r logRange,
+	c *orderingChecker, total int64) error {
+	lastCheckpoint := c.checked
+	for off := r.from; off < r.to; {
+		events, _, _, err := bus.Reader(
+			option.WithDisablePolling(),
+			option.WithReadPolicy(policy.NewManuallyReadPolicy(r.log, off)),
+			option.WithBatchSize(readBatchSize),
+		).Read(ctx)
+		switch {
+		case errors.Is(err, errors.ErrOffsetOnEnd):
+			return nil
+		case errors.Is(err, errors.ErrOffsetUnderflow):
+			// Events were removed by retention while they were being checked.
+			if off, err = r.log.EarliestOffset(ctx); err != nil {
+				return err
+			}
+			continue
+		case err != nil:
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		for i, event := range events {
+			offset := off + int64(i)
+			if v, ok := event.Extensions()[eventlog.XVanusLogOffset].([]byte); ok && len(v) == 8 {
+				offset = int64(binary.BigEndian.Uint64(v))
+			}
+			if offset >= r.to {
+				return nil
+			}
+			c.check(ctx, r.log.ID(), offset, event)
+		}
+		off += int64(len(events))
+		if c.checked-lastCheckpoint >= checkpointEvents {
+			lastCheckpoint = c.checked
+			err = e.Checkpoint(ctx, "", job.Progress{Done: c.checked, Total: total, Message: c.summary()})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
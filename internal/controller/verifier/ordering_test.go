@@ -0,0 +1,163 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/internal/controller/job"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newKeyedEvent(key string, t time.Time) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID("id")
+	e.SetSource("test")
+	e.SetType("test")
+	if key != "" {
+		e.SetExtension(extensionPartitionKey, key)
+	}
+	if !t.IsZero() {
+		e.SetTime(t)
+	}
+	return &e
+}
+
+func TestOrderingChecker(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	Convey("test ordering checker", t, func() {
+		c := newOrderingChecker()
+
+		Convey("test ordered events", func() {
+			c.check(ctx, 1, 0, newKeyedEvent("a", now))
+			c.check(ctx, 1, 1, newKeyedEvent("b", now.Add(-time.Second)))
+			c.check(ctx, 1, 2, newKeyedEvent("a", now.Add(time.Second)))
+			c.check(ctx, 1, 3, newKeyedEvent("a", time.Time{}))
+			c.check(ctx, 2, 0, newKeyedEvent("", now))
+			So(c.checked, ShouldEqual, 5)
+			So(c.keyless, ShouldEqual, 1)
+			So(c.violations, ShouldEqual, 0)
+			So(c.summary(), ShouldEqual, "checked 5 events of 2 keys, 1 events without key, 0 violations")
+		})
+
+		Convey("test events of a key in eventlogs", func() {
+			c.check(ctx, 1, 0, newKeyedEvent("a", now))
+			c.check(ctx, 2, 5, newKeyedEvent("a", now))
+			c.check(ctx, 2, 6, newKeyedEvent("a", now))
+			So(c.violations, ShouldEqual, 1)
+			So(c.reported[0], ShouldResemble, Violation{
+				Kind:  ViolationSplit,
+				Key:   "a",
+				Prev:  Location{EventlogID: 1, Offset: 0, Time: now},
+				Event: Location{EventlogID: 2, Offset: 5, Time: now},
+			})
+			So(c.summary(), ShouldContainSubstring, "key a is in eventlog 1 at offset 0 and eventlog 2 at offset 5")
+		})
+
+		Convey("test events of a key going backwards", func() {
+			c.check(ctx, 1, 0, newKeyedEvent("a", now))
+			c.check(ctx, 1, 1, newKeyedEvent("a", now.Add(-time.Second)))
+			c.check(ctx, 1, 2, newKeyedEvent("a", now))
+			So(c.violations, ShouldEqual, 1)
+			So(c.reported[0].Kind, ShouldEqual, ViolationTime)
+			So(c.reported[0].Prev.Offset, ShouldEqual, 0)
+			So(c.reported[0].Event.Offset, ShouldEqual, 1)
+		})
+
+		Convey("test too many violations", func() {
+			for i := 0; i < maxReportedViolations+5; i++ {
+				c.check(ctx, 1, int64(2*i), newKeyedEvent("a", now))
+				c.check(ctx, 1, int64(2*i+1), newKeyedEvent("a", now.Add(-time.Second)))
+			}
+			So(c.violations, ShouldEqual, maxReportedViolations+5)
+			So(c.reported, ShouldHaveLength, maxReportedViolations)
+			So(c.summary(), ShouldEndWith, "; 5 more violations are logged")
+		})
+	})
+}
+
+func TestOrderingVerifier(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	Convey("test ordering verifier", t, func() {
+		mockCtrl := gomock.NewController(t)
+		defer mockCtrl.Finish()
+
+		// events of eventlogs, offsets of eventlog 2 start from 10.
+		events := map[uint64][]*ce.Event{
+			1: {newKeyedEvent("a", now), newKeyedEvent("b", now), newKeyedEvent("a", now.Add(time.Second))},
+			2: {newKeyedEvent("c", now), newKeyedEvent("b", now.Add(time.Second))},
+		}
+		earliest := map[uint64]int64{1: 0, 2: 10}
+		for id, list := range events {
+			for i, e := range list {
+				off := make([]byte, 8)
+				binary.BigEndian.PutUint64(off, uint64(earliest[id]+int64(i)))
+				e.SetExtension(eventlog.XVanusLogOffset, off)
+			}
+		}
+
+		bus := api.NewMockEventbus(mockCtrl)
+		var logs []api.Eventlog
+		for _, id := range []uint64{2, 1} {
+			l := api.NewMockEventlog(mockCtrl)
+			l.EXPECT().ID().AnyTimes().Return(id)
+			l.EXPECT().EarliestOffset(gomock.Any()).AnyTimes().Return(earliest[id], nil)
+			l.EXPECT().LatestOffset(gomock.Any()).AnyTimes().Return(earliest[id]+int64(len(events[id])), nil)
+			logs = append(logs, l)
+		}
+		bus.EXPECT().ListLog(gomock.Any()).Return(logs, nil)
+		bus.EXPECT().Reader(gomock.Any()).AnyTimes().DoAndReturn(func(opts ...api.ReadOption) api.BusReader {
+			options := &api.ReadOptions{}
+			options.Apply(opts...)
+			l, _ := options.Policy.NextLog(ctx)
+			off := options.Policy.Offset()
+			reader := api.NewMockBusReader(mockCtrl)
+			reader.EXPECT().Read(gomock.Any()).DoAndReturn(
+				func(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+					list := events[l.ID()]
+					i := off - earliest[l.ID()]
+					if i >= int64(len(list)) {
+						return nil, 0, 0, errors.ErrOffsetOnEnd
+					}
+					// Return at most 2 events per read.
+					end := i + 2
+					if end > int64(len(list)) {
+						end = int64(len(list))
+					}
+					return list[i:end], 0, 0, nil
+				})
+			return reader
+		})
+
+		v := &orderingVerifier{openBus: func(ctx context.Context, eventbus string) api.Eventbus {
+			So(eventbus, ShouldEqual, "test")
+			return bus
+		}}
+		e := job.NewTestExecution(map[string]string{paramEventbus: "test"})
+		So(v.run(ctx, e), ShouldBeNil)
+	})
+}
@@ -15,6 +15,9 @@
 package convert
 
 import (
+	"time"
+
+	"github.com/linkall-labs/vanus/client/pkg/gap"
 	"github.com/linkall-labs/vanus/internal/controller/trigger/metadata"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/info"
@@ -40,6 +43,12 @@ func FromPbSubscriptionRequest(sub *ctrl.SubscriptionRequest) *metadata.Subscrip
 		EventBus:           sub.EventBus,
 		Name:               sub.Name,
 		Description:        sub.Description,
+		NodeSelector:       sub.NodeSelector,
+		Signing:            fromPbSigningConfig(sub.Signing),
+		Namespace:          sub.Namespace,
+	}
+	if to.Signing != nil {
+		to.SigningMethod = to.Signing.Method
 	}
 	return to
 }
@@ -94,6 +103,40 @@ func toPbProtocolSettings(from *primitive.ProtocolSetting) *pb.ProtocolSetting {
 	return to
 }
 
+func fromPbSigningConfig(from *pb.SigningConfig) *primitive.SigningConfig {
+	if from == nil {
+		return nil
+	}
+	to := &primitive.SigningConfig{
+		Method: primitive.SigningHMAC,
+		Keys:   make([]primitive.SigningKey, len(from.Keys)),
+	}
+	if from.Method == pb.SigningConfig_JWS {
+		to.Method = primitive.SigningJWS
+	}
+	for i, k := range from.Keys {
+		to.Keys[i] = primitive.SigningKey{ID: k.Id, Secret: k.Secret}
+	}
+	return to
+}
+
+func toPbSigningConfig(from *primitive.SigningConfig) *pb.SigningConfig {
+	if from == nil {
+		return nil
+	}
+	to := &pb.SigningConfig{
+		Method: pb.SigningConfig_HMAC,
+		Keys:   make([]*pb.SigningKey, len(from.Keys)),
+	}
+	if from.Method == primitive.SigningJWS {
+		to.Method = pb.SigningConfig_JWS
+	}
+	for i, k := range from.Keys {
+		to.Keys[i] = &pb.SigningKey{Id: k.ID, Secret: k.Secret}
+	}
+	return to
+}
+
 func fromPbSinkCredentialType(from *pb.SinkCredential) *primitive.CredentialType {
 	if from == nil {
 		return nil
@@ -206,11 +249,22 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 		return primitive.SubscriptionConfig{}
 	}
 	to := primitive.SubscriptionConfig{
-		RateLimit:          config.RateLimit,
-		MaxRetryAttempts:   config.MaxRetryAttempts,
-		DeliveryTimeout:    config.DeliveryTimeout,
-		DeadLetterEventbus: config.DeadLetterEventbus,
-		OrderedEvent:       config.OrderedEvent,
+		RateLimit:           config.RateLimit,
+		MaxRetryAttempts:    config.MaxRetryAttempts,
+		DeliveryTimeout:     config.DeliveryTimeout,
+		DeadLetterEventbus:  config.DeadLetterEventbus,
+		OrderedEvent:        config.OrderedEvent,
+		SinkAllowedHosts:    config.SinkAllowedHosts,
+		PassThrough:         config.PassThrough,
+		BackfillRateLimit:   config.BackfillRateLimit,
+		DeliveryDeadline:    config.DeliveryDeadline,
+		DeliveryContentType: config.DeliveryContentType,
+	}
+	if d := config.ProtobufDecoding; d != nil {
+		to.ProtobufDecoding = &primitive.ProtobufDecoding{
+			DescriptorSet: d.DescriptorSet,
+			MessageName:   d.MessageName,
+		}
 	}
 	switch config.OffsetType {
 	case pb.SubscriptionConfig_LATEST:
@@ -226,11 +280,22 @@ func fromPbSubscriptionConfig(config *pb.SubscriptionConfig) primitive.Subscript
 
 func toPbSubscriptionConfig(config primitive.SubscriptionConfig) *pb.SubscriptionConfig {
 	to := &pb.SubscriptionConfig{
-		RateLimit:          config.RateLimit,
-		MaxRetryAttempts:   config.MaxRetryAttempts,
-		DeliveryTimeout:    config.DeliveryTimeout,
-		DeadLetterEventbus: config.DeadLetterEventbus,
-		OrderedEvent:       config.OrderedEvent,
+		RateLimit:           config.RateLimit,
+		MaxRetryAttempts:    config.MaxRetryAttempts,
+		DeliveryTimeout:     config.DeliveryTimeout,
+		DeadLetterEventbus:  config.DeadLetterEventbus,
+		OrderedEvent:        config.OrderedEvent,
+		SinkAllowedHosts:    config.SinkAllowedHosts,
+		PassThrough:         config.PassThrough,
+		BackfillRateLimit:   config.BackfillRateLimit,
+		DeliveryDeadline:    config.DeliveryDeadline,
+		DeliveryContentType: config.DeliveryContentType,
+	}
+	if d := config.ProtobufDecoding; d != nil {
+		to.ProtobufDecoding = &pb.ProtobufDecoding{
+			DescriptorSet: d.DescriptorSet,
+			MessageName:   d.MessageName,
+		}
 	}
 	switch config.OffsetType {
 	case primitive.LatestOffset:
@@ -256,6 +321,8 @@ func FromPbAddSubscription(sub *pbtrigger.AddSubscriptionRequest) *primitive.Sub
 		Filters:         fromPbFilters(sub.Filters),
 		Transformer:     fromPbTransformer(sub.Transformer),
 		Config:          fromPbSubscriptionConfig(sub.Config),
+		Signing:         fromPbSigningConfig(sub.Signing),
+		Revision:        sub.Revision,
 	}
 	return to
 }
@@ -272,6 +339,8 @@ func ToPbAddSubscription(sub *primitive.Subscription) *pbtrigger.AddSubscription
 		Config:           toPbSubscriptionConfig(sub.Config),
 		Protocol:         toPbProtocol(sub.Protocol),
 		ProtocolSettings: toPbProtocolSettings(sub.ProtocolSetting),
+		Signing:          toPbSigningConfig(sub.Signing),
+		Revision:         sub.Revision,
 	}
 	return to
 }
@@ -294,13 +363,140 @@ func ToPbSubscription(sub *metadata.Subscription, offsets info.ListOffsetInfo) *
 		Description:      sub.Description,
 		CreatedAt:        sub.CreatedAt.UnixMilli(),
 		UpdatedAt:        sub.UpdatedAt.UnixMilli(),
+		NodeSelector:     sub.NodeSelector,
+		Annotations:      sub.Annotations,
+		Signing:          toPbSigningConfig(sub.Signing.Masked()),
 	}
 	if sub.Phase == metadata.SubscriptionPhaseStopped {
 		to.Disable = true
 	}
+	to.SinkResolution = toPbSinkResolution(sub.SinkResolution)
+	to.UnschedulableReason = sub.UnschedulableReason
+	to.DeliveryPhase = string(sub.DeliveryPhase)
+	to.DataLosses = ToPbDataLosses(sub.DataLosses)
+	to.CrashStatus = ToPbCrashStatus(sub.CrashStatus)
+	to.PausedGaps = ToPbSequenceGaps(sub.PausedGaps)
+	to.Owner = sub.Owner
+	to.Namespace = sub.Namespace
+	return to
+}
+
+func toPbSinkResolution(r *metadata.SinkResolution) *pb.SinkResolution {
+	if r == nil {
+		return nil
+	}
+	return &pb.SinkResolution{
+		Host:       r.Host,
+		Addresses:  r.Addrs,
+		ResolvedAt: r.ResolvedAt.UnixMilli(),
+		Error:      r.Error,
+	}
+}
+
+func FromPbSinkResolution(r *pb.SinkResolution) *metadata.SinkResolution {
+	if r == nil {
+		return nil
+	}
+	return &metadata.SinkResolution{
+		Host:       r.Host,
+		Addrs:      r.Addresses,
+		ResolvedAt: time.UnixMilli(r.ResolvedAt),
+		Error:      r.Error,
+	}
+}
+
+func ToPbDataLosses(losses []primitive.DataLoss) []*pb.DataLoss {
+	if len(losses) == 0 {
+		return nil
+	}
+	to := make([]*pb.DataLoss, len(losses))
+	for i, l := range losses {
+		to[i] = &pb.DataLoss{
+			EventlogId: l.EventlogID.Uint64(),
+			FromOffset: l.FromOffset,
+			ToOffset:   l.ToOffset,
+			DetectedAt: l.DetectedAt.UnixMilli(),
+		}
+	}
+	return to
+}
+
+func FromPbDataLosses(losses []*pb.DataLoss) []primitive.DataLoss {
+	if len(losses) == 0 {
+		return nil
+	}
+	to := make([]primitive.DataLoss, len(losses))
+	for i, l := range losses {
+		to[i] = primitive.DataLoss{
+			EventlogID: vanus.NewIDFromUint64(l.EventlogId),
+			FromOffset: l.FromOffset,
+			ToOffset:   l.ToOffset,
+			DetectedAt: time.UnixMilli(l.DetectedAt),
+		}
+	}
 	return to
 }
 
+func ToPbSequenceGaps(gaps []gap.Gap) []*pb.SequenceGap {
+	if len(gaps) == 0 {
+		return nil
+	}
+	to := make([]*pb.SequenceGap, len(gaps))
+	for i, g := range gaps {
+		to[i] = &pb.SequenceGap{
+			EventlogId: g.EventlogID,
+			FromOffset: g.From,
+			ToOffset:   g.To,
+			Cause:      string(g.Cause),
+			DetectedAt: g.DetectedAt.UnixMilli(),
+		}
+	}
+	return to
+}
+
+func FromPbSequenceGaps(gaps []*pb.SequenceGap) []gap.Gap {
+	if len(gaps) == 0 {
+		return nil
+	}
+	to := make([]gap.Gap, len(gaps))
+	for i, g := range gaps {
+		to[i] = gap.Gap{
+			EventlogID: g.EventlogId,
+			From:       g.FromOffset,
+			To:         g.ToOffset,
+			Cause:      gap.Cause(g.Cause),
+			DetectedAt: time.UnixMilli(g.DetectedAt),
+		}
+	}
+	return to
+}
+
+func ToPbCrashStatus(s *primitive.CrashStatus) *pb.CrashStatus {
+	if s == nil {
+		return nil
+	}
+	return &pb.CrashStatus{
+		Panics:       s.Panics,
+		LastStage:    s.LastStage,
+		LastPanic:    s.LastPanic,
+		LastPanicAt:  s.LastPanicAt.UnixMilli(),
+		CrashLooping: s.CrashLooping,
+	}
+}
+
+func FromPbCrashStatus(s *pb.CrashStatus) *primitive.CrashStatus {
+	if s == nil {
+		return nil
+	}
+	return &primitive.CrashStatus{
+		Panics:       s.Panics,
+		LastStage:    s.LastStage,
+		LastPanic:    s.LastPanic,
+		LastPanicAt:  time.UnixMilli(s.LastPanicAt),
+		CrashLooping: s.CrashLooping,
+	}
+}
+
 func fromPbFilters(filters []*pb.Filter) []*primitive.SubscriptionFilter {
 	if len(filters) == 0 {
 		return nil
@@ -341,6 +537,16 @@ func fromPbFilter(filter *pb.Filter) *primitive.SubscriptionFilter {
 	if len(filter.Any) > 0 {
 		return &primitive.SubscriptionFilter{Any: fromPbFilters(filter.Any)}
 	}
+	if filter.Time != nil {
+		return &primitive.SubscriptionFilter{Time: &primitive.TimeFilter{
+			Basis:    filter.Time.Basis,
+			Since:    filter.Time.Since,
+			Until:    filter.Time.Until,
+			Daily:    filter.Time.Daily,
+			Weekdays: filter.Time.Weekdays,
+			TimeZone: filter.Time.TimeZone,
+		}}
+	}
 	return nil
 }
 
@@ -377,6 +583,16 @@ func toPbFilter(filter *primitive.SubscriptionFilter) *pb.Filter {
 	if len(filter.Any) > 0 {
 		return &pb.Filter{Any: toPbFilters(filter.Any)}
 	}
+	if filter.Time != nil {
+		return &pb.Filter{Time: &pb.TimeFilter{
+			Basis:    filter.Time.Basis,
+			Since:    filter.Time.Since,
+			Until:    filter.Time.Until,
+			Daily:    filter.Time.Daily,
+			Weekdays: filter.Time.Weekdays,
+			TimeZone: filter.Time.TimeZone,
+		}}
+	}
 	return nil
 }
 
@@ -426,6 +642,18 @@ func fromPbTransformer(transformer *pb.Transformer) *primitive.Transformer {
 		Define:   transformer.Define,
 		Template: transformer.Template,
 		Pipeline: fromPbActions(transformer.Pipeline),
+		Wasm:     fromPbWasmFunction(transformer.Wasm),
+	}
+}
+
+func fromPbWasmFunction(fn *pb.WasmFunction) *primitive.WasmFunction {
+	if fn == nil {
+		return nil
+	}
+	return &primitive.WasmFunction{
+		Module:        fn.Module,
+		MemoryLimitMB: fn.MemoryLimitMb,
+		TimeoutMs:     fn.TimeoutMs,
 	}
 }
 
@@ -474,5 +702,17 @@ func ToPbTransformer(transformer *primitive.Transformer) *pb.Transformer {
 		Define:   transformer.Define,
 		Template: transformer.Template,
 		Pipeline: toPbActions(transformer.Pipeline),
+		Wasm:     toPbWasmFunction(transformer.Wasm),
+	}
+}
+
+func toPbWasmFunction(fn *primitive.WasmFunction) *pb.WasmFunction {
+	if fn == nil {
+		return nil
+	}
+	return &pb.WasmFunction{
+		Module:        fn.Module,
+		MemoryLimitMb: fn.MemoryLimitMB,
+		TimeoutMs:     fn.TimeoutMs,
 	}
 }
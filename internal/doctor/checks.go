@@ -0,0 +1,189 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/kv/etcd"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	checkTimeout = 5 * time.Second
+	probeKey     = "doctor/probe"
+)
+
+// unsuitableFileSystems lose data or don't sync files as stores expect.
+var unsuitableFileSystems = map[string]string{
+	"tmpfs":     "data is lost on reboot, use a disk",
+	"overlayfs": "data is lost with the container, mount a volume",
+	"nfs":       "network file systems may not sync files reliably, use a local disk",
+	"cifs":      "network file systems may not sync files reliably, use a local disk",
+	"fuse":      "fuse file systems may not sync files reliably, use a local disk",
+}
+
+func checkPort(port int) (Status, string) {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("port %d is in use, stop the process listening on it or change "+
+			"the port in the config file: %s", port, err)
+	}
+	_ = l.Close()
+	return StatusOK, "available"
+}
+
+// checkDataDir syncs a temporary file in the directory, a missing directory isn't created but its nearest
+// existing parent is checked, which components create it under at startup.
+func checkDataDir(dir string) (Status, string) {
+	if dir == "" {
+		return StatusFailed, "the data dir is empty, set it in the config file"
+	}
+	info, err := os.Stat(dir)
+	if os.IsNotExist(err) {
+		return checkMissingDir(dir)
+	}
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("stat the dir failed, check its permissions: %s", err)
+	}
+	if !info.IsDir() {
+		return StatusFailed, "it isn't a dir, remove the file or change the data dir in the config file"
+	}
+	f, err := os.CreateTemp(dir, ".doctor-*")
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("the dir isn't writable, e.g. run chown on it for the user "+
+			"running vanus: %s", err)
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	_, err = f.Write([]byte("vanus"))
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("write to the dir failed, check the disk: %s", err)
+	}
+
+	fsType, err := fileSystemType(dir)
+	if err != nil {
+		return StatusWarning, fmt.Sprintf("writable, but the file system type is unknown: %s", err)
+	}
+	if advice, ok := unsuitableFileSystems[fsType]; ok {
+		return StatusWarning, fmt.Sprintf("writable, but it's on %s, %s", fsType, advice)
+	}
+	return StatusOK, fmt.Sprintf("writable, on %s", fsType)
+}
+
+func checkMissingDir(dir string) (Status, string) {
+	parent := filepath.Dir(filepath.Clean(dir))
+	for {
+		info, err := os.Stat(parent)
+		if err == nil {
+			if !info.IsDir() {
+				return StatusFailed, fmt.Sprintf("it can't be created since %s isn't a dir, change the data "+
+					"dir in the config file", parent)
+			}
+			break
+		}
+		if !os.IsNotExist(err) || parent == filepath.Dir(parent) {
+			return StatusFailed, fmt.Sprintf("stat %s failed, check its permissions: %s", parent, err)
+		}
+		parent = filepath.Dir(parent)
+	}
+	if err := unix.Access(parent, unix.W_OK|unix.X_OK); err != nil {
+		return StatusFailed, fmt.Sprintf("it doesn't exist and %s isn't writable, create the dir or run "+
+			"chown on %s for the user running vanus: %s", parent, parent, err)
+	}
+	return StatusWarning, fmt.Sprintf("it doesn't exist, it's created under %s at startup", parent)
+}
+
+// checkKV writes and deletes a key under the key prefix, which requires the permission of components.
+func checkKV(ctx context.Context, endpoints []string, keyPrefix string, embedded bool) (Status, string) {
+	failed := StatusFailed
+	hint := "check kv endpoints in the config file and that etcd is running"
+	if embedded {
+		// the kv is embedded in controllers, it's expected to be unreachable before they start.
+		failed = StatusWarning
+		hint = "it's expected if controllers serving the embedded kv haven't started, otherwise check " +
+			"kv endpoints in the config file"
+	}
+	cli, err := etcd.NewEtcdClientV3(endpoints, keyPrefix)
+	if err != nil {
+		return failed, fmt.Sprintf("connect to %v failed, %s: %s", endpoints, hint, err)
+	}
+	defer func() {
+		_ = cli.Close()
+	}()
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	if err = cli.Set(ctx, probeKey, []byte(time.Now().Format(time.RFC3339))); err != nil {
+		return failed, fmt.Sprintf("write to %v failed, %s, and that the user of etcd can write keys "+
+			"under %q: %s", endpoints, hint, keyPrefix, err)
+	}
+	if err = cli.Delete(ctx, probeKey); err != nil {
+		return StatusFailed, fmt.Sprintf("delete keys from %v failed, check that the user of etcd can "+
+			"delete keys under %q: %s", endpoints, keyPrefix, err)
+	}
+	return StatusOK, fmt.Sprintf("%v is readable and writable", endpoints)
+}
+
+func checkReachable(ctx context.Context, addr string) (Status, string) {
+	d := net.Dialer{Timeout: checkTimeout}
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return StatusWarning, fmt.Sprintf("unreachable, start controllers first or check controller "+
+			"addresses in the config file and firewalls: %s", err)
+	}
+	_ = conn.Close()
+	return StatusOK, "reachable"
+}
+
+// checkOpenFiles checks the soft limit, which is what the process gets.
+func checkOpenFiles(min uint64) (Status, string) {
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		return StatusWarning, fmt.Sprintf("get the limit failed: %s", err)
+	}
+	if uint64(limit.Cur) < min {
+		return StatusWarning, fmt.Sprintf("%d is lower than %d, raise it by ulimit -n %d, or LimitNOFILE "+
+			"of the systemd unit", limit.Cur, min, min)
+	}
+	return StatusOK, fmt.Sprintf("%d", limit.Cur)
+}
+
+func checkClock(ctx context.Context, server string, max time.Duration) (Status, string) {
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	offset, err := queryClockOffset(ctx, server)
+	if err != nil {
+		return StatusWarning, fmt.Sprintf("query %s failed, check the clock manually or use another NTP "+
+			"server: %s", server, err)
+	}
+	if offset > max || offset < -max {
+		return StatusFailed, fmt.Sprintf("the clock is off by %s from %s, which breaks IDs and leases, "+
+			"sync it by chrony or ntpd", offset, server)
+	}
+	return StatusOK, fmt.Sprintf("off by %s from %s", offset, server)
+}
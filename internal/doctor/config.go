@@ -0,0 +1,77 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+// Config files are decoded into the slim structs below rather than configs of components, so that vsctl,
+// which runs doctor, doesn't link servers. Only fields which checks need are read, other fields are
+// validated by components when they start.
+
+type metricsConfig struct {
+	Enable bool `yaml:"enable"`
+	Port   int  `yaml:"port"`
+}
+
+type observabilityConfig struct {
+	M metricsConfig `yaml:"metrics"`
+}
+
+type metadataConfig struct {
+	KeyPrefix string `yaml:"key_prefix"`
+}
+
+type embedEtcdConfig struct {
+	DataDir          string `yaml:"data_dir"`
+	ListenClientAddr string `yaml:"listen_client_addr"`
+	ListenPeerAddr   string `yaml:"listen_peer_addr"`
+}
+
+type controllerConfig struct {
+	Port           int                 `yaml:"port"`
+	EtcdEndpoints  []string            `yaml:"etcd"`
+	DataDir        string              `yaml:"data_dir"`
+	MetadataConfig metadataConfig      `yaml:"metadata"`
+	EtcdConfig     embedEtcdConfig     `yaml:"embed_etcd"`
+	Observability  observabilityConfig `yaml:"observability"`
+}
+
+type storeConfig struct {
+	ControllerAddresses []string `yaml:"controllers"`
+	Port                int      `yaml:"port"`
+	Volume              struct {
+		Dir string `yaml:"dir"`
+	} `yaml:"volume"`
+	Observability observabilityConfig `yaml:"observability"`
+}
+
+type triggerConfig struct {
+	Port           int                 `yaml:"port"`
+	ControllerAddr []string            `yaml:"controllers"`
+	Observability  observabilityConfig `yaml:"observability"`
+}
+
+type timerConfig struct {
+	Port           int                 `yaml:"port"`
+	EtcdEndpoints  []string            `yaml:"etcd"`
+	CtrlEndpoints  []string            `yaml:"controllers"`
+	MetadataConfig metadataConfig      `yaml:"metadata"`
+	Observability  observabilityConfig `yaml:"observability"`
+}
+
+type gatewayConfig struct {
+	Port           int                 `yaml:"port"`
+	SinkPort       int                 `yaml:"sink_port"`
+	ControllerAddr []string            `yaml:"controllers"`
+	Observability  observabilityConfig `yaml:"observability"`
+}
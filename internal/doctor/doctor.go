@@ -0,0 +1,217 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package doctor checks a host before a component of Vanus is deployed on it, so that misconfigurations are
+// reported with how to fix them rather than failing at startup.
+package doctor
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+)
+
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusWarning Status = "warning"
+	StatusFailed  Status = "failed"
+)
+
+const (
+	ComponentController = "controller"
+	ComponentStore      = "store"
+	ComponentTrigger    = "trigger"
+	ComponentTimer      = "timer"
+	ComponentGateway    = "gateway"
+
+	defaultMaxClockSkew = time.Second
+	defaultMinOpenFiles = 65536
+	defaultMetricsPort  = 2112
+)
+
+var Components = []string{
+	ComponentController, ComponentStore, ComponentTrigger, ComponentTimer, ComponentGateway,
+}
+
+type Result struct {
+	Component string `json:"component"`
+	Check     string `json:"check"`
+	Status    Status `json:"status"`
+	// Detail tells how to fix the problem if the check isn't ok.
+	Detail string `json:"detail"`
+}
+
+type Options struct {
+	Component  string
+	ConfigFile string
+	// NTPServer is the server which the clock is compared with, the clock isn't checked if it's empty.
+	NTPServer    string
+	MaxClockSkew time.Duration
+	MinOpenFiles uint64
+}
+
+// plan is what a component needs from the host, which is read from its config file.
+type plan struct {
+	ports       []int
+	dataDirs    []string
+	kvEndpoints []string
+	kvKeyPrefix string
+	// kvEmbedded means the kv is served by controllers, it's unreachable until they start.
+	kvEmbedded  bool
+	controllers []string
+}
+
+// Run checks the host for the component, results of all checks are returned even if some of them fail.
+func Run(ctx context.Context, opts Options) []Result {
+	if opts.MaxClockSkew <= 0 {
+		opts.MaxClockSkew = defaultMaxClockSkew
+	}
+	if opts.MinOpenFiles == 0 {
+		opts.MinOpenFiles = defaultMinOpenFiles
+	}
+	add := func(results []Result, check string, status Status, detail string) []Result {
+		return append(results, Result{Component: opts.Component, Check: check, Status: status, Detail: detail})
+	}
+
+	var results []Result
+	p, err := loadPlan(opts.Component, opts.ConfigFile)
+	if err != nil {
+		return add(results, "config", StatusFailed, fmt.Sprintf("fix %s: %s", opts.ConfigFile, err))
+	}
+	results = add(results, "config", StatusOK, opts.ConfigFile)
+	for _, port := range p.ports {
+		status, detail := checkPort(port)
+		results = add(results, fmt.Sprintf("port %d", port), status, detail)
+	}
+	for _, dir := range p.dataDirs {
+		status, detail := checkDataDir(dir)
+		results = add(results, fmt.Sprintf("data dir %s", dir), status, detail)
+	}
+	if len(p.kvEndpoints) > 0 {
+		status, detail := checkKV(ctx, p.kvEndpoints, p.kvKeyPrefix, p.kvEmbedded)
+		results = add(results, "kv", status, detail)
+	}
+	for _, addr := range p.controllers {
+		status, detail := checkReachable(ctx, addr)
+		results = add(results, fmt.Sprintf("controller %s", addr), status, detail)
+	}
+	status, detail := checkOpenFiles(opts.MinOpenFiles)
+	results = add(results, "open files limit", status, detail)
+	if opts.NTPServer != "" {
+		status, detail = checkClock(ctx, opts.NTPServer, opts.MaxClockSkew)
+		results = add(results, "clock skew", status, detail)
+	}
+	return results
+}
+
+func loadPlan(component, file string) (*plan, error) {
+	switch component {
+	case ComponentController:
+		cfg := new(controllerConfig)
+		if err := primitive.LoadConfig(file, cfg); err != nil {
+			return nil, err
+		}
+		p := &plan{
+			ports:       append([]int{cfg.Port}, metricsPorts(cfg.Observability)...),
+			kvEndpoints: cfg.EtcdEndpoints,
+			kvKeyPrefix: cfg.MetadataConfig.KeyPrefix,
+			kvEmbedded:  true,
+		}
+		for _, addr := range []string{cfg.EtcdConfig.ListenClientAddr, cfg.EtcdConfig.ListenPeerAddr} {
+			if port, err := portOf(addr); err == nil {
+				p.ports = append(p.ports, port)
+			}
+		}
+		if cfg.DataDir == "" {
+			return nil, fmt.Errorf("data_dir is required")
+		}
+		p.dataDirs = []string{cfg.DataDir}
+		// the same as the data dir which controllers run the embedded etcd with.
+		etcdDir := filepath.Join(cfg.DataDir, cfg.EtcdConfig.DataDir)
+		if filepath.Clean(etcdDir) != filepath.Clean(cfg.DataDir) {
+			p.dataDirs = append(p.dataDirs, etcdDir)
+		}
+		return p, nil
+	case ComponentStore:
+		cfg := new(storeConfig)
+		if err := primitive.LoadConfig(file, cfg); err != nil {
+			return nil, err
+		}
+		return &plan{
+			ports:       append([]int{cfg.Port}, metricsPorts(cfg.Observability)...),
+			dataDirs:    []string{cfg.Volume.Dir},
+			controllers: cfg.ControllerAddresses,
+		}, nil
+	case ComponentTrigger:
+		cfg := new(triggerConfig)
+		if err := primitive.LoadConfig(file, cfg); err != nil {
+			return nil, err
+		}
+		return &plan{
+			ports:       append([]int{cfg.Port}, metricsPorts(cfg.Observability)...),
+			controllers: cfg.ControllerAddr,
+		}, nil
+	case ComponentTimer:
+		cfg := new(timerConfig)
+		if err := primitive.LoadConfig(file, cfg); err != nil {
+			return nil, err
+		}
+		return &plan{
+			ports:       append([]int{cfg.Port}, metricsPorts(cfg.Observability)...),
+			kvEndpoints: cfg.EtcdEndpoints,
+			kvKeyPrefix: cfg.MetadataConfig.KeyPrefix,
+			controllers: cfg.CtrlEndpoints,
+		}, nil
+	case ComponentGateway:
+		cfg := new(gatewayConfig)
+		if err := primitive.LoadConfig(file, cfg); err != nil {
+			return nil, err
+		}
+		// the receiver of CloudEvents listens on the port next to the gRPC one.
+		ports := []int{cfg.Port, cfg.Port + 1}
+		if cfg.SinkPort != 0 {
+			ports = append(ports, cfg.SinkPort)
+		}
+		return &plan{
+			ports:       append(ports, metricsPorts(cfg.Observability)...),
+			controllers: cfg.ControllerAddr,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown component %s, it's one of %v", component, Components)
+}
+
+func metricsPorts(cfg observabilityConfig) []int {
+	if !cfg.M.Enable {
+		return nil
+	}
+	if cfg.M.Port == 0 {
+		return []int{defaultMetricsPort}
+	}
+	return []int{cfg.M.Port}
+}
+
+func portOf(addr string) (int, error) {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(port)
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRun(t *testing.T) {
+	Convey("test run doctor", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+		file := filepath.Join(dir, "trigger.yaml")
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		port := l.Addr().(*net.TCPAddr).Port
+		_ = l.Close()
+
+		Convey("trigger", func() {
+			err = os.WriteFile(file, []byte(fmt.Sprintf("port: %d\ncontrollers:\n  - 127.0.0.1:%d\n", port, port)),
+				0o600)
+			So(err, ShouldBeNil)
+			results := Run(ctx, Options{Component: ComponentTrigger, ConfigFile: file})
+			checks := map[string]Status{}
+			for _, r := range results {
+				checks[r.Check] = r.Status
+			}
+			So(checks["config"], ShouldEqual, StatusOK)
+			So(checks[fmt.Sprintf("port %d", port)], ShouldEqual, StatusOK)
+			So(checks[fmt.Sprintf("controller 127.0.0.1:%d", port)], ShouldEqual, StatusWarning)
+			So(checks, ShouldContainKey, "open files limit")
+			So(checks, ShouldNotContainKey, "clock skew")
+		})
+
+		Convey("invalid config", func() {
+			results := Run(ctx, Options{Component: ComponentTrigger, ConfigFile: filepath.Join(dir, "none.yaml")})
+			So(results, ShouldHaveLength, 1)
+			So(results[0].Status, ShouldEqual, StatusFailed)
+
+			results = Run(ctx, Options{Component: "unknown", ConfigFile: file})
+			So(results, ShouldHaveLength, 1)
+			So(results[0].Status, ShouldEqual, StatusFailed)
+		})
+	})
+}
+
+func TestCheckPort(t *testing.T) {
+	Convey("test check port", t, func() {
+		l, err := net.Listen("tcp", ":0")
+		So(err, ShouldBeNil)
+		port := l.Addr().(*net.TCPAddr).Port
+		status, _ := checkPort(port)
+		So(status, ShouldEqual, StatusFailed)
+		_ = l.Close()
+		status, _ = checkPort(port)
+		So(status, ShouldEqual, StatusOK)
+	})
+}
+
+func TestCheckDataDir(t *testing.T) {
+	Convey("test check data dir", t, func() {
+		dir := t.TempDir()
+		status, _ := checkDataDir(dir)
+		So(status, ShouldNotEqual, StatusFailed)
+		entries, err := os.ReadDir(dir)
+		So(err, ShouldBeNil)
+		So(entries, ShouldBeEmpty)
+
+		status, _ = checkDataDir(filepath.Join(dir, "missing", "data"))
+		So(status, ShouldEqual, StatusWarning)
+		entries, err = os.ReadDir(dir)
+		So(err, ShouldBeNil)
+		So(entries, ShouldBeEmpty)
+
+		file := filepath.Join(dir, "file")
+		So(os.WriteFile(file, nil, 0o600), ShouldBeNil)
+		status, _ = checkDataDir(filepath.Join(file, "data"))
+		So(status, ShouldEqual, StatusFailed)
+		status, _ = checkDataDir("")
+		So(status, ShouldEqual, StatusFailed)
+	})
+}
+
+func TestQueryClockOffset(t *testing.T) {
+	Convey("test query clock offset", t, func() {
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		So(err, ShouldBeNil)
+		defer conn.Close()
+		skew := 3 * time.Second
+		go func() {
+			buf := make([]byte, ntpPacketSize)
+			_, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			resp := make([]byte, ntpPacketSize)
+			resp[0] = 0x24
+			now := toNTPTime(time.Now().Add(-skew))
+			binary.BigEndian.PutUint64(resp[32:], now)
+			binary.BigEndian.PutUint64(resp[40:], now)
+			_, _ = conn.WriteTo(resp, addr)
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		offset, err := queryClockOffset(ctx, conn.LocalAddr().String())
+		So(err, ShouldBeNil)
+		So(offset, ShouldAlmostEqual, skew, 100*time.Millisecond)
+	})
+
+	Convey("test ntp time", t, func() {
+		now := time.Now()
+		So(fromNTPTime(toNTPTime(now)).Sub(now), ShouldAlmostEqual, 0, time.Microsecond)
+	})
+}
@@ -0,0 +1,47 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package doctor
+
+import (
+	"fmt"
+	"syscall"
+)
+
+// magic numbers of file systems in statfs(2).
+var fileSystemTypes = map[int64]string{
+	0xEF53:     "ext4",
+	0x58465342: "xfs",
+	0x9123683E: "btrfs",
+	0x2FC12FC1: "zfs",
+	0x01021994: "tmpfs",
+	0x794C7630: "overlayfs",
+	0x6969:     "nfs",
+	0xFF534D42: "cifs",
+	0x65735546: "fuse",
+}
+
+func fileSystemType(dir string) (string, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(dir, &st); err != nil {
+		return "", err
+	}
+	if name, ok := fileSystemTypes[int64(st.Type)]; ok {
+		return name, nil
+	}
+	return fmt.Sprintf("0x%X", st.Type), nil
+}
@@ -15,8 +15,14 @@
 package gateway
 
 import (
+	"github.com/linkall-labs/vanus/internal/gateway/middleware"
 	"github.com/linkall-labs/vanus/internal/gateway/proxy"
+	"github.com/linkall-labs/vanus/internal/gateway/shadow"
+	"github.com/linkall-labs/vanus/internal/gateway/transport"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
 	"github.com/linkall-labs/vanus/observability"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -27,6 +33,20 @@ type Config struct {
 	Observability        observability.Config `yaml:"observability"`
 	ControllerAddr       []string             `yaml:"controllers"`
 	GRPCReflectionEnable bool                 `yaml:"grpc_reflection_enable"`
+	Metering             metering.Config      `yaml:"metering"`
+	// maximum message size in bytes received from stores, reads are split to fit it, 0 is 4MB.
+	MaxRecvMsgSize int `yaml:"max_recv_msg_size"`
+	// GRPC tunes the gRPC server on port, and HTTP tunes CloudEvents receivers on port+1 and sink_port.
+	GRPC transport.GRPCConfig `yaml:"grpc"`
+	HTTP transport.HTTPConfig `yaml:"http"`
+	// Auth authenticates requests to port and port+1, sink_port is left open for deliveries.
+	Auth auth.Config `yaml:"auth"`
+	// Middlewares process events published to port and port+1 in order.
+	Middlewares []middleware.Config `yaml:"middlewares"`
+	// Shadow mirrors a percentage of events accepted by port and port+1 to a secondary cluster.
+	Shadow shadow.Config `yaml:"shadow"`
+	// GRPCCompression compresses requests to controllers and stores, e.g. to cut cross-AZ traffic.
+	GRPCCompression compression.Config `yaml:"grpc_compression"`
 }
 
 func (c Config) GetProxyConfig() proxy.Config {
@@ -37,6 +57,9 @@ func (c Config) GetProxyConfig() proxy.Config {
 		CloudEventReceiverPort: c.GetCloudEventReceiverPort(),
 		GRPCReflectionEnable:   c.GRPCReflectionEnable,
 		Credentials:            insecure.NewCredentials(),
+		GRPC:                   c.GRPC,
+		HTTP:                   c.HTTP,
+		UsageAdminRoles:        c.Metering.AdminRoles,
 	}
 }
 
@@ -50,5 +73,8 @@ func InitConfig(filename string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = c.GRPCCompression.Validate(); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
@@ -16,25 +16,37 @@ package gateway
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net"
 	"net/http"
 	"strings"
 	"sync"
+	"time"
 
 	v2 "github.com/cloudevents/sdk-go/v2"
-	"github.com/cloudevents/sdk-go/v2/client"
 	"github.com/cloudevents/sdk-go/v2/protocol"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/cloudevents/sdk-go/v2/types"
 	"github.com/google/uuid"
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/gateway/middleware"
 	"github.com/linkall-labs/vanus/internal/gateway/proxy"
+	"github.com/linkall-labs/vanus/internal/gateway/shadow"
+	"github.com/linkall-labs/vanus/internal/gateway/transport"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	"github.com/linkall-labs/vanus/internal/primitive/latency"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/util"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -52,24 +64,73 @@ type EventData struct {
 
 type ceGateway struct {
 	// ceClient  v2.Client
-	busWriter  sync.Map
-	config     Config
-	client     eb.Client
-	proxySrv   *proxy.ControllerProxy
-	tracer     *tracing.Tracer
-	ceListener net.Listener
+	busWriter   sync.Map
+	config      Config
+	client      eb.Client
+	proxySrv    *proxy.ControllerProxy
+	tracer      *tracing.Tracer
+	ceSrv       *http.Server
+	meter       metering.Meter
+	auth        auth.Provider
+	middlewares middleware.Chain
+	shadow      *shadow.Shadower
+	latencies   *latency.Recorder
+	stopReport  context.CancelFunc
 }
 
 func NewGateway(config Config) *ceGateway {
+	eb.SetMaxRecvMsgSize(config.MaxRecvMsgSize)
+	compression.Apply(config.GRPCCompression)
+	client := eb.Connect(config.ControllerAddr)
+	meter := metering.NewMeter(config.Metering, fmt.Sprintf("gateway-%s", util.GetLocalIP()), client)
+	proxyCfg := config.GetProxyConfig()
+	proxyCfg.Meter = meter
+	latencies := latency.NewRecorder()
+	proxyCfg.Latencies = latencies
 	return &ceGateway{
-		config:   config,
-		client:   eb.Connect(config.ControllerAddr),
-		proxySrv: proxy.NewControllerProxy(config.GetProxyConfig()),
-		tracer:   tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		config:     config,
+		client:     client,
+		proxySrv:   proxy.NewControllerProxy(proxyCfg),
+		tracer:     tracing.NewTracer("cloudevents", trace.SpanKindServer),
+		meter:      meter,
+		latencies:  latencies,
+		stopReport: func() {},
 	}
 }
 
 func (ga *ceGateway) Start(ctx context.Context) error {
+	provider, err := auth.NewProvider(ga.config.Auth)
+	if err != nil {
+		return err
+	}
+	ga.auth = provider
+	ga.proxySrv.SetAuthProvider(provider, ga.config.Auth.Forward)
+	chain, err := middleware.NewChain(ga.config.Middlewares)
+	if err != nil {
+		return err
+	}
+	ga.middlewares = chain
+	ga.proxySrv.SetMiddlewares(chain)
+	shadower, err := shadow.New(ga.config.Shadow)
+	if err != nil {
+		return err
+	}
+	ga.shadow = shadower
+	ga.proxySrv.SetShadower(shadower)
+	ga.shadow.Start()
+	featuregate.Sync(ctx, cluster.NewClusterController(ga.config.ControllerAddr, insecure.NewCredentials()).
+		FeatureGateService().RawClient())
+
+	if ga.config.Metering.Enable {
+		ctrl := cluster.NewClusterController(ga.config.ControllerAddr, insecure.NewCredentials())
+		err = ctrl.EventbusService().CreateSystemEventbusIfNotExist(ctx, primitive.MeteringEventbusName,
+			"System Eventbus For Usage Metering")
+		if err != nil {
+			return err
+		}
+		ga.meter.Start(ctx)
+	}
+	ga.startReportLatencies(ctx)
 	if err := ga.startCloudEventsReceiver(ctx); err != nil {
 		return err
 	}
@@ -81,41 +142,56 @@ func (ga *ceGateway) Start(ctx context.Context) error {
 
 func (ga *ceGateway) Stop() {
 	ga.proxySrv.Stop()
-	if err := ga.ceListener.Close(); err != nil {
-		log.Warning(context.Background(), "close CloudEvents listener error", map[string]interface{}{
+	ga.meter.Stop(context.Background())
+	ga.stopReport()
+	ga.shadow.Stop()
+	if ga.ceSrv == nil {
+		return
+	}
+	if err := ga.ceSrv.Close(); err != nil {
+		log.Warning(context.Background(), "close CloudEvents server error", map[string]interface{}{
 			log.KeyError: err,
 		})
 	}
 }
 
 func (ga *ceGateway) startCloudEventsReceiver(ctx context.Context) error {
-	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", ga.config.GetCloudEventReceiverPort()))
+	ls, err := transport.Listen("cloudevents", ga.config.GetCloudEventReceiverPort())
 	if err != nil {
 		return err
 	}
 
-	c, err := client.NewHTTP(cehttp.WithListener(ls), cehttp.WithRequestDataAtContextMiddleware())
+	h, err := transport.NewCloudEventsHandler(ctx, ga.receive)
 	if err != nil {
+		_ = ls.Close()
 		return err
 	}
 
-	ga.ceListener = ls
+	srv := ga.config.HTTP.NewServer(transport.CorrelationHandler(auth.Handler(ga.auth, h)))
+	ga.ceSrv = srv
 	go func() {
-		if err := c.StartReceiver(ctx, ga.receive); err != nil {
+		if err := srv.Serve(ls); err != nil && err != http.ErrServerClosed {
 			panic(fmt.Sprintf("start CloudEvents receiver failed: %s", err.Error()))
 		}
 	}()
+	go func() {
+		<-ctx.Done()
+		_ = srv.Close()
+	}()
 	return nil
 }
 
 func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, protocol.Result) {
-	_ctx, span := ga.tracer.Start(ctx, "receive")
-	defer span.End()
-	ebName := getEventBusFromPath(requestDataFromContext(_ctx))
-
+	ebName := getEventBusFromPath(requestDataFromContext(ctx))
 	if ebName == "" {
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid eventbus name")
 	}
+	// The eventbus is known before the span starts, so that the trace is sampled by the rate of the eventbus.
+	_ctx, span := ga.tracer.Start(tracing.WithRequestMetadata(ctx, tracing.RequestMetadata{
+		Operation: tracing.OperationPublish,
+		Eventbus:  ebName,
+	}), "receive")
+	defer span.End()
 
 	extensions := event.Extensions()
 	err := checkExtension(extensions)
@@ -123,16 +199,49 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, pr
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
 	}
 
-	event.SetExtension(primitive.XVanusEventbus, ebName)
-	if eventTime, ok := extensions[primitive.XVanusDeliveryTime]; ok {
+	tenant := metering.TenantFromContext(_ctx)
+	if len(ga.middlewares) > 0 {
+		err = ga.middlewares.Process(_ctx, &middleware.Event{Eventbus: ebName, Tenant: tenant, Event: &event})
+		if err != nil {
+			return nil, v2.NewHTTPResult(middleware.StatusCode(err), err.Error())
+		}
+	}
+
+	eventTime, delayed := extensions[primitive.XVanusDeliveryTime]
+	if delayed {
 		// validate event time
-		if _, err := types.ParseTime(eventTime.(string)); err != nil {
+		if _, err = types.ParseTime(eventTime.(string)); err != nil {
 			log.Error(_ctx, "invalid format of event time", map[string]interface{}{
 				log.KeyError: err,
 				"eventTime":  eventTime.(string),
 			})
 			return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid delivery time")
 		}
+	}
+
+	bus := ga.client.Eventbus(ctx, ebName)
+	key, _ := types.ToString(extensions[proxy.ExtensionPartitionKey])
+	writeOpts, err := proxy.OrderedWriteOptions(_ctx, bus, key)
+	if errors.Is(err, proxy.ErrMissingPartitionKey) {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
+	if err != nil {
+		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
+	}
+
+	event.SetExtension(primitive.XVanusEventbus, ebName)
+	// the delayed event is written to timer eventbus, but it's still metered to the original eventbus
+	meteredEventbus := ebName
+	event.SetExtension(primitive.XVanusTenant, tenant)
+	if traceParent := tracing.InjectTraceParent(_ctx); traceParent != "" {
+		event.SetExtension(primitive.XVanusTraceParent, traceParent)
+	}
+	if delayed {
+		if len(writeOpts) > 0 {
+			// The timer writes delayed events back without their keys being considered.
+			return nil, v2.NewHTTPResult(http.StatusBadRequest, "delayed events aren't supported by the "+
+				"eventbus ordered by key")
+		}
 		ebName = primitive.TimerEventbusName
 	}
 
@@ -141,7 +250,16 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, pr
 		v, _ = ga.busWriter.LoadOrStore(ebName, ga.client.Eventbus(ctx, ebName).Writer())
 	}
 	writer, _ := v.(api.BusWriter)
-	eventID, err := writer.AppendOne(_ctx, &event)
+	start := time.Now()
+	_ctx = tracing.WithRequestMetadata(_ctx, tracing.RequestMetadata{
+		Operation: tracing.OperationPublish,
+		Eventbus:  meteredEventbus,
+		Events:    1,
+		Bytes:     len(event.Data()),
+	})
+	eventID, err := writer.AppendOne(_ctx, &event, writeOpts...)
+	metrics.ObserveRequest(_ctx, start, err)
+	ga.latencies.Record(meteredEventbus, start, 1, err)
 	if err != nil {
 		log.Warning(_ctx, "append to failed", map[string]interface{}{
 			log.KeyError: err,
@@ -149,6 +267,8 @@ func (ga *ceGateway) receive(ctx context.Context, event v2.Event) (*v2.Event, pr
 		})
 		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
 	}
+	ga.meter.Record(tenant, meteredEventbus, metering.KindProduced, 1, len(event.Data()))
+	ga.shadow.MirrorEvent(_ctx, ebName, &event)
 	eventData := EventData{
 		BusName: ebName,
 		EventID: eventID,
@@ -159,6 +159,7 @@ func TestGateway_EventID(t *testing.T) {
 	mockBusWriter := api.NewMockBusWriter(ctrl)
 	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
 	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
+	mockEventbus.EXPECT().OrderingScope(Any()).AnyTimes().Return(api.OrderingNone, nil)
 	mockBusWriter.EXPECT().AppendOne(Any(), Any()).AnyTimes().Return("AABBCC", nil)
 
 	cfg := Config{
@@ -0,0 +1,70 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/latency"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/cluster"
+	"github.com/linkall-labs/vanus/pkg/util"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const latencyReportInterval = 10 * time.Second
+
+// startReportLatencies reports cumulative publish latencies of eventbuses to the controller periodically,
+// which tracks SLOs of eventbuses with them. It runs until stopReport is called.
+func (ga *ceGateway) startReportLatencies(ctx context.Context) {
+	ctx, ga.stopReport = context.WithCancel(ctx)
+	client := cluster.NewClusterController(ga.config.ControllerAddr, insecure.NewCredentials()).
+		EventbusService().RawClient()
+	source := fmt.Sprintf("gateway-%s", util.GetLocalIP())
+	go func() {
+		ticker := time.NewTicker(latencyReportInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				req := toLatenciesRequest(source, ga.latencies.Snapshot())
+				if len(req.Reports) == 0 {
+					continue
+				}
+				if _, err := client.ReportPublishLatencies(ctx, req); err != nil {
+					log.Warning(ctx, "failed to report publish latencies", map[string]interface{}{
+						log.KeyError: err,
+					})
+				}
+			}
+		}
+	}()
+}
+
+func toLatenciesRequest(source string, histograms map[string]latency.Histogram) *ctrlpb.ReportLatenciesRequest {
+	req := &ctrlpb.ReportLatenciesRequest{
+		Source:  source,
+		Reports: make([]*ctrlpb.LatencyReport, 0, len(histograms)),
+	}
+	for resource, h := range histograms {
+		req.Reports = append(req.Reports, &ctrlpb.LatencyReport{Resource: resource, Counts: h})
+	}
+	return req
+}
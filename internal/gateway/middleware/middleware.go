@@ -0,0 +1,154 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package middleware runs events published to the gateway through a chain of middleware, which may
+// mutate, validate or reject them before they're written to eventbuses.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Event is an event being published, middleware changes it in place.
+type Event struct {
+	Eventbus string
+	Tenant   string
+	Event    *v2.Event
+}
+
+type Middleware interface {
+	// Process returns an error to reject the event, a Rejection tells the status code returned to
+	// HTTP clients.
+	Process(ctx context.Context, e *Event) error
+}
+
+// Func adapts a function to Middleware.
+type Func func(ctx context.Context, e *Event) error
+
+func (f Func) Process(ctx context.Context, e *Event) error {
+	return f(ctx, e)
+}
+
+// Factory creates a middleware, decode decodes its options.
+type Factory func(decode func(v interface{}) error) (Middleware, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{}
+)
+
+// Register makes a middleware available by name in the gateway config, it's usually called in init of
+// the package of the middleware, which is linked by a blank import in the gateway main package. It panics
+// if name is registered twice.
+func Register(name string, f Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if f == nil {
+		panic("middleware: register nil factory of " + name)
+	}
+	if _, ok := factories[name]; ok {
+		panic("middleware: register twice for " + name)
+	}
+	factories[name] = f
+}
+
+// Registered returns names of registered middleware in order.
+func Registered() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type Config struct {
+	Name    string    `yaml:"name"`
+	Options yaml.Node `yaml:"options"`
+}
+
+type named struct {
+	name string
+	Middleware
+}
+
+// Chain runs middleware in the configured order.
+type Chain []named
+
+func NewChain(cfgs []Config) (Chain, error) {
+	chain := make(Chain, 0, len(cfgs))
+	for i := range cfgs {
+		cfg := &cfgs[i]
+		factoriesMu.RLock()
+		f, ok := factories[cfg.Name]
+		factoriesMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown middleware: %s", cfg.Name)
+		}
+		m, err := f(func(v interface{}) error {
+			if cfg.Options.IsZero() {
+				return nil
+			}
+			return cfg.Options.Decode(v)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create middleware %s failed: %w", cfg.Name, err)
+		}
+		chain = append(chain, named{name: cfg.Name, Middleware: m})
+	}
+	return chain, nil
+}
+
+func (c Chain) Process(ctx context.Context, e *Event) error {
+	for _, m := range c {
+		if err := m.Process(ctx, e); err != nil {
+			if r, ok := err.(*Rejection); ok {
+				return &Rejection{Code: r.Code, Reason: fmt.Sprintf("rejected by %s: %s", m.name, r.Reason)}
+			}
+			return fmt.Errorf("middleware %s failed: %w", m.name, err)
+		}
+	}
+	return nil
+}
+
+// Rejection rejects an event, Code is the HTTP status code.
+type Rejection struct {
+	Code   int
+	Reason string
+}
+
+func Reject(code int, format string, args ...interface{}) error {
+	return &Rejection{Code: code, Reason: fmt.Sprintf(format, args...)}
+}
+
+func (r *Rejection) Error() string {
+	return r.Reason
+}
+
+// StatusCode returns the HTTP status code of err returned by a Chain.
+func StatusCode(err error) int {
+	if r, ok := err.(*Rejection); ok {
+		return r.Code
+	}
+	return http.StatusInternalServerError
+}
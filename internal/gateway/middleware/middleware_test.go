@@ -0,0 +1,202 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/yaml.v3"
+)
+
+func newChain(t *testing.T, config string) (Chain, error) {
+	var cfgs []Config
+	if err := yaml.Unmarshal([]byte(config), &cfgs); err != nil {
+		t.Fatal(err)
+	}
+	return NewChain(cfgs)
+}
+
+func newEvent(typ string, data string) *Event {
+	e := v2.NewEvent()
+	e.SetID("id")
+	e.SetSource("source")
+	e.SetType(typ)
+	_ = e.SetData(v2.ApplicationJSON, []byte(data))
+	return &Event{Eventbus: "orders", Tenant: "default", Event: &e}
+}
+
+func TestChain(t *testing.T) {
+	Convey("test middleware chain", t, func() {
+		ctx := context.Background()
+
+		Convey("unknown middleware", func() {
+			_, err := newChain(t, `[{name: unknown}]`)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("custom middleware", func() {
+			called := 0
+			Register("test_custom", func(decode func(v interface{}) error) (Middleware, error) {
+				opts := struct {
+					Reject bool `yaml:"reject"`
+				}{}
+				if err := decode(&opts); err != nil {
+					return nil, err
+				}
+				return Func(func(ctx context.Context, e *Event) error {
+					called++
+					if opts.Reject {
+						return Reject(http.StatusForbidden, "forbidden")
+					}
+					return nil
+				}), nil
+			})
+			defer func() {
+				factoriesMu.Lock()
+				delete(factories, "test_custom")
+				factoriesMu.Unlock()
+			}()
+			So(Registered(), ShouldContain, "test_custom")
+
+			chain, err := newChain(t, `[{name: test_custom}, {name: test_custom, options: {reject: true}}]`)
+			So(err, ShouldBeNil)
+			err = chain.Process(ctx, newEvent("t", `{}`))
+			So(called, ShouldEqual, 2)
+			So(StatusCode(err), ShouldEqual, http.StatusForbidden)
+			So(err.Error(), ShouldEqual, "rejected by test_custom: forbidden")
+		})
+
+		Convey("stamp", func() {
+			chain, err := newChain(t, `
+- name: stamp
+  options:
+    attributes:
+      region: us-east-1
+      origin: "{tenant}/{eventbus}"
+`)
+			So(err, ShouldBeNil)
+			e := newEvent("t", `{}`)
+			e.Event.SetExtension("region", "eu-west-1")
+			So(chain.Process(ctx, e), ShouldBeNil)
+			So(e.Event.Extensions()["region"], ShouldEqual, "eu-west-1")
+			So(e.Event.Extensions()["origin"], ShouldEqual, "default/orders")
+
+			_, err = newChain(t, `[{name: stamp, options: {attributes: {xvanusfoo: bar}}}]`)
+			So(err, ShouldNotBeNil)
+			_, err = newChain(t, `[{name: stamp, options: {attributes: {Foo: bar}}}]`)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("size limit", func() {
+			chain, err := newChain(t, `[{name: size_limit, options: {max_data_bytes: 8, max_attributes: 1}}]`)
+			So(err, ShouldBeNil)
+			So(chain.Process(ctx, newEvent("t", `{}`)), ShouldBeNil)
+
+			err = chain.Process(ctx, newEvent("t", `{"a":"123456"}`))
+			So(StatusCode(err), ShouldEqual, http.StatusRequestEntityTooLarge)
+
+			e := newEvent("t", `{}`)
+			e.Event.SetExtension("a", "1")
+			e.Event.SetExtension("b", "2")
+			So(StatusCode(chain.Process(ctx, e)), ShouldEqual, http.StatusBadRequest)
+
+			_, err = newChain(t, `[{name: size_limit, options: {eventbuses: {orders: {max_attributes: -1}}}}]`)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("attribute size limit", func() {
+			chain, err := newChain(t, `
+- name: size_limit
+  options:
+    max_attribute_name_length: 8
+    max_attribute_value_bytes: 16
+    eventbuses:
+      orders:
+        max_attribute_value_bytes: 8
+`)
+			So(err, ShouldBeNil)
+
+			e := newEvent("t", `{}`)
+			e.Eventbus = "payments"
+			e.Event.SetExtension("region", "us-east-1")
+			So(chain.Process(ctx, e), ShouldBeNil)
+
+			e.Event.SetExtension("verylongname", "1")
+			So(StatusCode(chain.Process(ctx, e)), ShouldEqual, http.StatusBadRequest)
+
+			e = newEvent("t", `{}`)
+			e.Eventbus = "payments"
+			e.Event.SetSubject("a subject longer than the limit")
+			So(StatusCode(chain.Process(ctx, e)), ShouldEqual, http.StatusRequestEntityTooLarge)
+
+			// The override of orders inherits the limit of names.
+			e = newEvent("t", `{}`)
+			e.Event.SetExtension("region", "us-east-1")
+			So(StatusCode(chain.Process(ctx, e)), ShouldEqual, http.StatusRequestEntityTooLarge)
+			e = newEvent("t", `{}`)
+			e.Event.SetExtension("verylongname", "1")
+			So(StatusCode(chain.Process(ctx, e)), ShouldEqual, http.StatusBadRequest)
+		})
+
+		Convey("schema", func() {
+			file := filepath.Join(t.TempDir(), "order.json")
+			So(os.WriteFile(file, []byte(`{
+  "type": "object",
+  "required": ["id", "amount"],
+  "additionalProperties": false,
+  "properties": {
+    "id": {"type": "string", "pattern": "^o-[0-9]+$"},
+    "amount": {"type": "integer", "minimum": 1},
+    "status": {"enum": ["new", "paid"]},
+    "tags": {"type": "array", "items": {"type": "string", "maxLength": 3}}
+  }
+}`), 0o600), ShouldBeNil)
+			chain, err := newChain(t, `
+- name: schema
+  options:
+    schemas:
+      - type: order.created
+        file: `+file)
+			So(err, ShouldBeNil)
+
+			So(chain.Process(ctx, newEvent("order.created", `{"id":"o-1","amount":2,"tags":["a"]}`)), ShouldBeNil)
+			So(chain.Process(ctx, newEvent("other", `not json`)), ShouldBeNil)
+
+			for _, data := range []string{
+				`not json`,
+				`{"id":"o-1"}`,
+				`{"id":"x","amount":2}`,
+				`{"id":"o-1","amount":1.5}`,
+				`{"id":"o-1","amount":0}`,
+				`{"id":"o-1","amount":2,"status":"lost"}`,
+				`{"id":"o-1","amount":2,"tags":["long"]}`,
+				`{"id":"o-1","amount":2,"extra":true}`,
+			} {
+				err = chain.Process(ctx, newEvent("order.created", data))
+				So(StatusCode(err), ShouldEqual, http.StatusBadRequest)
+			}
+
+			chain, err = newChain(t, `[{name: schema, options: {require: true}}]`)
+			So(err, ShouldBeNil)
+			So(StatusCode(chain.Process(ctx, newEvent("other", `{}`))), ShouldEqual, http.StatusBadRequest)
+		})
+	})
+}
@@ -0,0 +1,275 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+)
+
+const NameSchema = "schema"
+
+func init() {
+	Register(NameSchema, newSchemaValidator)
+}
+
+type SchemaOptions struct {
+	Schemas []SchemaRule `yaml:"schemas"`
+	// Require rejects events which match no rule.
+	Require bool `yaml:"require"`
+}
+
+// SchemaRule validates JSON data of events of Type, which are published to Eventbus if it isn't empty.
+type SchemaRule struct {
+	Type     string `yaml:"type"`
+	Eventbus string `yaml:"eventbus"`
+	// File is a JSON Schema, keywords type, enum, required, properties, additionalProperties, items,
+	// minimum, maximum, minLength, maxLength and pattern are supported, others are ignored.
+	File string `yaml:"file"`
+}
+
+type schemaValidator struct {
+	rules   []compiledRule
+	require bool
+}
+
+type compiledRule struct {
+	SchemaRule
+	schema *jsonSchema
+}
+
+func newSchemaValidator(decode func(v interface{}) error) (Middleware, error) {
+	opts := SchemaOptions{}
+	if err := decode(&opts); err != nil {
+		return nil, err
+	}
+	v := &schemaValidator{require: opts.Require}
+	for _, rule := range opts.Schemas {
+		if rule.Type == "" {
+			return nil, fmt.Errorf("the event type of schema is required")
+		}
+		data, err := os.ReadFile(rule.File)
+		if err != nil {
+			return nil, err
+		}
+		schema, err := parseJSONSchema(data)
+		if err != nil {
+			return nil, fmt.Errorf("parse schema %s failed: %w", rule.File, err)
+		}
+		v.rules = append(v.rules, compiledRule{SchemaRule: rule, schema: schema})
+	}
+	return v, nil
+}
+
+func (v *schemaValidator) Process(_ context.Context, e *Event) error {
+	rule := v.match(e)
+	if rule == nil {
+		if v.require {
+			return Reject(http.StatusBadRequest, "no schema for event type %s", e.Event.Type())
+		}
+		return nil
+	}
+	var data interface{}
+	if err := json.Unmarshal(e.Event.Data(), &data); err != nil {
+		return Reject(http.StatusBadRequest, "the data of event isn't JSON: %s", err)
+	}
+	if err := rule.schema.validate(data, "$"); err != nil {
+		return Reject(http.StatusBadRequest, "the data of event doesn't match the schema: %s", err)
+	}
+	return nil
+}
+
+// match returns the rule for the eventbus of e in preference to the one for all eventbuses.
+func (v *schemaValidator) match(e *Event) *compiledRule {
+	var fallback *compiledRule
+	for i := range v.rules {
+		rule := &v.rules[i]
+		if rule.Type != e.Event.Type() {
+			continue
+		}
+		if rule.Eventbus == e.Eventbus {
+			return rule
+		}
+		if rule.Eventbus == "" && fallback == nil {
+			fallback = rule
+		}
+	}
+	return fallback
+}
+
+type jsonSchema struct {
+	Type                 schemaTypes            `json:"type"`
+	Enum                 []interface{}          `json:"enum"`
+	Required             []string               `json:"required"`
+	Properties           map[string]*jsonSchema `json:"properties"`
+	AdditionalProperties *bool                  `json:"additionalProperties"`
+	Items                *jsonSchema            `json:"items"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+	MinLength            *int                   `json:"minLength"`
+	MaxLength            *int                   `json:"maxLength"`
+	Pattern              string                 `json:"pattern"`
+
+	pattern *regexp.Regexp
+}
+
+// schemaTypes is a type name or an array of them.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*t = schemaTypes{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+func parseJSONSchema(data []byte) (*jsonSchema, error) {
+	s := &jsonSchema{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, err
+	}
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *jsonSchema) compile() error {
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return err
+		}
+		s.pattern = re
+	}
+	for _, p := range s.Properties {
+		if err := p.compile(); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		return s.Items.compile()
+	}
+	return nil
+}
+
+func (s *jsonSchema) validate(v interface{}, path string) error {
+	if len(s.Type) > 0 && !s.matchType(v) {
+		return fmt.Errorf("%s should be %v", path, []string(s.Type))
+	}
+	if len(s.Enum) > 0 {
+		found := false
+		for _, item := range s.Enum {
+			if reflect.DeepEqual(item, v) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s should be one of %v", path, s.Enum)
+		}
+	}
+
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for _, name := range s.Required {
+			if _, ok := val[name]; !ok {
+				return fmt.Errorf("%s.%s is required", path, name)
+			}
+		}
+		for name, item := range val {
+			if p, ok := s.Properties[name]; ok {
+				if err := p.validate(item, path+"."+name); err != nil {
+					return err
+				}
+			} else if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+				return fmt.Errorf("%s.%s isn't allowed", path, name)
+			}
+		}
+	case []interface{}:
+		if s.Items != nil {
+			for i, item := range val {
+				if err := s.Items.validate(item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case float64:
+		if s.Minimum != nil && val < *s.Minimum {
+			return fmt.Errorf("%s should be at least %v", path, *s.Minimum)
+		}
+		if s.Maximum != nil && val > *s.Maximum {
+			return fmt.Errorf("%s should be at most %v", path, *s.Maximum)
+		}
+	case string:
+		n := utf8.RuneCountInString(val)
+		if s.MinLength != nil && n < *s.MinLength {
+			return fmt.Errorf("%s should have at least %d characters", path, *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			return fmt.Errorf("%s should have at most %d characters", path, *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(val) {
+			return fmt.Errorf("%s should match %s", path, s.Pattern)
+		}
+	}
+	return nil
+}
+
+func (s *jsonSchema) matchType(v interface{}) bool {
+	for _, t := range s.Type {
+		switch val := v.(type) {
+		case nil:
+			if t == "null" {
+				return true
+			}
+		case bool:
+			if t == "boolean" {
+				return true
+			}
+		case float64:
+			if t == "number" || (t == "integer" && val == math.Trunc(val)) {
+				return true
+			}
+		case string:
+			if t == "string" {
+				return true
+			}
+		case []interface{}:
+			if t == "array" {
+				return true
+			}
+		case map[string]interface{}:
+			if t == "object" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+const NameSizeLimit = "size_limit"
+
+func init() {
+	Register(NameSizeLimit, newSizeLimit)
+}
+
+// SizeLimits limits events, a limit of 0 is unlimited.
+type SizeLimits struct {
+	// MaxDataBytes limits the size of data of events.
+	MaxDataBytes int `yaml:"max_data_bytes"`
+	// MaxAttributes limits the number of extension attributes of events.
+	MaxAttributes int `yaml:"max_attributes"`
+	// MaxAttributeNameLength limits the length of names of extension attributes.
+	MaxAttributeNameLength int `yaml:"max_attribute_name_length"`
+	// MaxAttributeValueBytes limits the size of values of attributes in their canonical string form, both
+	// extension attributes and string context attributes like subject are limited.
+	MaxAttributeValueBytes int `yaml:"max_attribute_value_bytes"`
+}
+
+type SizeLimitOptions struct {
+	SizeLimits `yaml:",inline"`
+	// Eventbuses overrides limits by names of eventbuses, limits which are 0 in an override inherit the
+	// limits above.
+	Eventbuses map[string]SizeLimits `yaml:"eventbuses"`
+}
+
+func (l SizeLimits) validate() error {
+	if l.MaxDataBytes < 0 || l.MaxAttributes < 0 || l.MaxAttributeNameLength < 0 || l.MaxAttributeValueBytes < 0 {
+		return fmt.Errorf("limits can't be negative")
+	}
+	return nil
+}
+
+// inherit returns l with limits which are 0 replaced by those of base.
+func (l SizeLimits) inherit(base SizeLimits) SizeLimits {
+	if l.MaxDataBytes == 0 {
+		l.MaxDataBytes = base.MaxDataBytes
+	}
+	if l.MaxAttributes == 0 {
+		l.MaxAttributes = base.MaxAttributes
+	}
+	if l.MaxAttributeNameLength == 0 {
+		l.MaxAttributeNameLength = base.MaxAttributeNameLength
+	}
+	if l.MaxAttributeValueBytes == 0 {
+		l.MaxAttributeValueBytes = base.MaxAttributeValueBytes
+	}
+	return l
+}
+
+type sizeLimit struct {
+	limits     SizeLimits
+	eventbuses map[string]SizeLimits
+}
+
+func newSizeLimit(decode func(v interface{}) error) (Middleware, error) {
+	opts := SizeLimitOptions{}
+	if err := decode(&opts); err != nil {
+		return nil, err
+	}
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	s := &sizeLimit{limits: opts.SizeLimits, eventbuses: make(map[string]SizeLimits, len(opts.Eventbuses))}
+	for eventbus, limits := range opts.Eventbuses {
+		if err := limits.validate(); err != nil {
+			return nil, fmt.Errorf("eventbus %s: %w", eventbus, err)
+		}
+		s.eventbuses[eventbus] = limits.inherit(opts.SizeLimits)
+	}
+	return s, nil
+}
+
+func (s *sizeLimit) Process(_ context.Context, e *Event) error {
+	limits, ok := s.eventbuses[e.Eventbus]
+	if !ok {
+		limits = s.limits
+	}
+
+	if n := len(e.Event.Data()); limits.MaxDataBytes > 0 && n > limits.MaxDataBytes {
+		return Reject(http.StatusRequestEntityTooLarge,
+			"the data of event is %d bytes, exceeds the limit %d", n, limits.MaxDataBytes)
+	}
+	exts := e.Event.Extensions()
+	if n := len(exts); limits.MaxAttributes > 0 && n > limits.MaxAttributes {
+		return Reject(http.StatusBadRequest,
+			"the event has %d extension attributes, exceeds the limit %d", n, limits.MaxAttributes)
+	}
+	if limits.MaxAttributeNameLength == 0 && limits.MaxAttributeValueBytes == 0 {
+		return nil
+	}
+
+	for name, v := range exts {
+		if n := len(name); limits.MaxAttributeNameLength > 0 && n > limits.MaxAttributeNameLength {
+			return Reject(http.StatusBadRequest,
+				"the event has an attribute name of %d characters, exceeds the limit %d",
+				n, limits.MaxAttributeNameLength)
+		}
+		if limits.MaxAttributeValueBytes > 0 {
+			value, err := types.Format(v)
+			if err != nil {
+				return Reject(http.StatusBadRequest, "the value of attribute %s is invalid: %s", name, err)
+			}
+			if err = checkValueSize(name, value, limits.MaxAttributeValueBytes); err != nil {
+				return err
+			}
+		}
+	}
+	if limits.MaxAttributeValueBytes > 0 {
+		ctx := e.Event.Context
+		for _, attr := range [...]struct{ name, value string }{
+			{"id", ctx.GetID()},
+			{"source", ctx.GetSource()},
+			{"type", ctx.GetType()},
+			{"subject", ctx.GetSubject()},
+			{"dataschema", ctx.GetDataSchema()},
+		} {
+			if err := checkValueSize(attr.name, attr.value, limits.MaxAttributeValueBytes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func checkValueSize(name, value string, limit int) error {
+	if n := len(value); n > limit {
+		return Reject(http.StatusRequestEntityTooLarge,
+			"the value of attribute %s is %d bytes, exceeds the limit %d", name, n, limit)
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+)
+
+const NameStamp = "stamp"
+
+func init() {
+	Register(NameStamp, newStamp)
+}
+
+type StampOptions struct {
+	// Attributes are set to events, {eventbus}, {tenant} and {time} in values are replaced with the
+	// eventbus, the tenant and the time the event is received.
+	Attributes map[string]string `yaml:"attributes"`
+	// Overwrite replaces attributes events already have.
+	Overwrite bool `yaml:"overwrite"`
+}
+
+// stamp sets extension attributes to events, e.g. the region of the gateway.
+type stamp struct {
+	opts StampOptions
+}
+
+func newStamp(decode func(v interface{}) error) (Middleware, error) {
+	opts := StampOptions{}
+	if err := decode(&opts); err != nil {
+		return nil, err
+	}
+	for name := range opts.Attributes {
+		if !validExtensionName(name) {
+			return nil, fmt.Errorf("invalid attribute name: %s", name)
+		}
+		if strings.HasPrefix(name, primitive.XVanus) {
+			return nil, fmt.Errorf("attribute %s is reserved", name)
+		}
+	}
+	return &stamp{opts: opts}, nil
+}
+
+func (s *stamp) Process(_ context.Context, e *Event) error {
+	replacer := strings.NewReplacer(
+		"{eventbus}", e.Eventbus,
+		"{tenant}", e.Tenant,
+		"{time}", time.Now().UTC().Format(time.RFC3339),
+	)
+	extensions := e.Event.Extensions()
+	for name, value := range s.opts.Attributes {
+		if _, ok := extensions[name]; ok && !s.opts.Overwrite {
+			continue
+		}
+		e.Event.SetExtension(name, replacer.Replace(value))
+	}
+	return nil
+}
+
+// validExtensionName checks the name by the CloudEvents spec, which allows lower-case letters and digits.
+func validExtensionName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -17,9 +17,11 @@ package proxy
 import (
 	"context"
 	"errors"
+	"io"
 
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -32,9 +34,15 @@ func (cp *ControllerProxy) CreateEventBus(ctx context.Context,
 	return cp.eventbusCtrl.CreateEventBus(ctx, req)
 }
 
+// DeleteEventBus refuses to delete the eventbus which subscriptions depend on, unless force is set.
 func (cp *ControllerProxy) DeleteEventBus(ctx context.Context,
-	req *metapb.EventBus) (*emptypb.Empty, error) {
-	return cp.eventbusCtrl.DeleteEventBus(ctx, req)
+	req *proxypb.DeleteEventBusRequest) (*emptypb.Empty, error) {
+	if !req.GetForce() {
+		if err := cp.checkDeletionImpact(ctx, &proxypb.GetDeletionImpactRequest{Eventbus: req.GetName()}); err != nil {
+			return nil, err
+		}
+	}
+	return cp.eventbusCtrl.DeleteEventBus(ctx, &metapb.EventBus{Name: req.GetName()})
 }
 
 func (cp *ControllerProxy) GetEventBus(ctx context.Context,
@@ -42,6 +50,36 @@ func (cp *ControllerProxy) GetEventBus(ctx context.Context,
 	return cp.eventbusCtrl.GetEventBus(ctx, req)
 }
 
+func (cp *ControllerProxy) AnnotateEventBus(ctx context.Context,
+	req *ctrlpb.AnnotateEventBusRequest) (*metapb.EventBus, error) {
+	return cp.eventbusCtrl.AnnotateEventBus(ctx, req)
+}
+
+func (cp *ControllerProxy) SetEventBusDictionary(ctx context.Context,
+	req *ctrlpb.SetEventBusDictionaryRequest) (*metapb.EventBus, error) {
+	return cp.eventbusCtrl.SetEventBusDictionary(ctx, req)
+}
+
+func (cp *ControllerProxy) SetEventBusRetention(ctx context.Context,
+	req *ctrlpb.SetEventBusRetentionRequest) (*metapb.EventBus, error) {
+	return cp.eventbusCtrl.SetEventBusRetention(ctx, req)
+}
+
+func (cp *ControllerProxy) ListEventbusSLO(ctx context.Context,
+	req *ctrlpb.ListSLORequest) (*ctrlpb.ListSLOResponse, error) {
+	return cp.eventbusCtrl.ListEventbusSLO(ctx, req)
+}
+
+func (cp *ControllerProxy) ImportEventBus(ctx context.Context,
+	req *ctrlpb.ImportEventBusRequest) (*metapb.Job, error) {
+	return cp.eventbusCtrl.ImportEventBus(ctx, req)
+}
+
+func (cp *ControllerProxy) VerifyEventBusOrdering(ctx context.Context,
+	req *ctrlpb.VerifyEventBusOrderingRequest) (*metapb.Job, error) {
+	return cp.eventbusCtrl.VerifyEventBusOrdering(ctx, req)
+}
+
 func (cp *ControllerProxy) ListEventBus(ctx context.Context,
 	req *emptypb.Empty) (*ctrlpb.ListEventbusResponse, error) {
 	return cp.eventbusCtrl.ListEventBus(ctx, req)
@@ -57,6 +95,51 @@ func (cp *ControllerProxy) ListSegment(ctx context.Context,
 	return cp.eventlogCtrl.ListSegment(ctx, req)
 }
 
+func (cp *ControllerProxy) TruncateEventLog(ctx context.Context,
+	req *ctrlpb.TruncateEventLogRequest) (*ctrlpb.TruncateEventLogResponse, error) {
+	return cp.eventlogCtrl.TruncateEventLog(ctx, req)
+}
+
+func (cp *ControllerProxy) StreamSegments(req *ctrlpb.StreamListRequest,
+	srv proxypb.ControllerProxy_StreamSegmentsServer) error {
+	stream, err := cp.eventlogCtrl.StreamSegments(srv.Context(), req)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = srv.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (cp *ControllerProxy) GetEventlogStats(ctx context.Context,
+	req *ctrlpb.GetEventlogStatsRequest) (*ctrlpb.GetEventlogStatsResponse, error) {
+	return cp.eventlogCtrl.GetEventlogStats(ctx, req)
+}
+
+func (cp *ControllerProxy) CreateEventbusProfile(ctx context.Context,
+	req *ctrlpb.EventbusProfile) (*ctrlpb.EventbusProfile, error) {
+	return cp.eventbusCtrl.CreateEventbusProfile(ctx, req)
+}
+
+func (cp *ControllerProxy) DeleteEventbusProfile(ctx context.Context,
+	req *ctrlpb.DeleteEventbusProfileRequest) (*emptypb.Empty, error) {
+	return cp.eventbusCtrl.DeleteEventbusProfile(ctx, req)
+}
+
+func (cp *ControllerProxy) ListEventbusProfile(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListEventbusProfileResponse, error) {
+	return cp.eventbusCtrl.ListEventbusProfile(ctx, req)
+}
+
 func (cp *ControllerProxy) CreateSubscription(ctx context.Context,
 	req *ctrlpb.CreateSubscriptionRequest) (*metapb.Subscription, error) {
 	return cp.triggerCtrl.CreateSubscription(ctx, req)
@@ -67,8 +150,15 @@ func (cp *ControllerProxy) UpdateSubscription(ctx context.Context,
 	return cp.triggerCtrl.UpdateSubscription(ctx, req)
 }
 
+// DeleteSubscription refuses to delete the subscription which hasn't consumed all events, unless force is set.
 func (cp *ControllerProxy) DeleteSubscription(ctx context.Context,
 	req *ctrlpb.DeleteSubscriptionRequest) (*emptypb.Empty, error) {
+	if !req.GetForce() {
+		err := cp.checkDeletionImpact(ctx, &proxypb.GetDeletionImpactRequest{SubscriptionId: req.GetId()})
+		if err != nil {
+			return nil, err
+		}
+	}
 	return cp.triggerCtrl.DeleteSubscription(ctx, req)
 }
 
@@ -82,17 +172,115 @@ func (cp *ControllerProxy) ListSubscription(ctx context.Context,
 	return cp.triggerCtrl.ListSubscription(ctx, req)
 }
 
+func (cp *ControllerProxy) StreamSubscriptions(req *ctrlpb.StreamListRequest,
+	srv proxypb.ControllerProxy_StreamSubscriptionsServer) error {
+	stream, err := cp.triggerCtrl.StreamSubscriptions(srv.Context(), req)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err = srv.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (cp *ControllerProxy) ListTriggerWorker(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListTriggerWorkerResponse, error) {
+	return cp.triggerCtrl.ListTriggerWorker(ctx, req)
+}
+
+func (cp *ControllerProxy) PlanRebalance(ctx context.Context,
+	req *ctrlpb.PlanRebalanceRequest) (*ctrlpb.RebalancePlan, error) {
+	return cp.triggerCtrl.PlanRebalance(ctx, req)
+}
+
+func (cp *ControllerProxy) ApproveRebalance(ctx context.Context,
+	req *ctrlpb.ApproveRebalanceRequest) (*ctrlpb.RebalancePlan, error) {
+	return cp.triggerCtrl.ApproveRebalance(ctx, req)
+}
+
 func (cp *ControllerProxy) DisableSubscription(ctx context.Context,
 	req *ctrlpb.DisableSubscriptionRequest) (*emptypb.Empty, error) {
 	return cp.triggerCtrl.DisableSubscription(ctx, req)
 }
 
+func (cp *ControllerProxy) AnnotateSubscription(ctx context.Context,
+	req *ctrlpb.AnnotateSubscriptionRequest) (*metapb.Subscription, error) {
+	return cp.triggerCtrl.AnnotateSubscription(ctx, req)
+}
+
+func (cp *ControllerProxy) RotateSigningKey(ctx context.Context,
+	req *ctrlpb.RotateSigningKeyRequest) (*metapb.Subscription, error) {
+	return cp.triggerCtrl.RotateSigningKey(ctx, req)
+}
+
 func (cp *ControllerProxy) ResumeSubscription(ctx context.Context,
 	req *ctrlpb.ResumeSubscriptionRequest) (*emptypb.Empty, error) {
 	return cp.triggerCtrl.ResumeSubscription(ctx, req)
 }
 
+func (cp *ControllerProxy) ResumeEventlogs(ctx context.Context,
+	req *ctrlpb.ResumeEventlogsRequest) (*ctrlpb.ResumeEventlogsResponse, error) {
+	return cp.triggerCtrl.ResumeEventlogs(ctx, req)
+}
+
 func (cp *ControllerProxy) ResetOffsetToTimestamp(ctx context.Context,
 	req *ctrlpb.ResetOffsetToTimestampRequest) (*ctrlpb.ResetOffsetToTimestampResponse, error) {
 	return cp.triggerCtrl.ResetOffsetToTimestamp(ctx, req)
 }
+
+func (cp *ControllerProxy) ExportOffsets(ctx context.Context,
+	req *ctrlpb.ExportOffsetsRequest) (*ctrlpb.ExportOffsetsResponse, error) {
+	return cp.triggerCtrl.ExportOffsets(ctx, req)
+}
+
+func (cp *ControllerProxy) ImportOffsets(ctx context.Context,
+	req *ctrlpb.ImportOffsetsRequest) (*ctrlpb.ImportOffsetsResponse, error) {
+	return cp.triggerCtrl.ImportOffsets(ctx, req)
+}
+
+func (cp *ControllerProxy) ListNamespaceUsage(ctx context.Context,
+	req *ctrlpb.ListNamespaceUsageRequest) (*ctrlpb.ListNamespaceUsageResponse, error) {
+	return cp.triggerCtrl.ListNamespaceUsage(ctx, req)
+}
+
+func (cp *ControllerProxy) ListSubscriptionSLO(ctx context.Context,
+	req *ctrlpb.ListSLORequest) (*ctrlpb.ListSLOResponse, error) {
+	return cp.triggerCtrl.ListSubscriptionSLO(ctx, req)
+}
+
+func (cp *ControllerProxy) ListJob(ctx context.Context,
+	req *ctrlpb.ListJobRequest) (*ctrlpb.ListJobResponse, error) {
+	return cp.jobCtrl.ListJob(ctx, req)
+}
+
+func (cp *ControllerProxy) GetJob(ctx context.Context, req *ctrlpb.GetJobRequest) (*metapb.Job, error) {
+	return cp.jobCtrl.GetJob(ctx, req)
+}
+
+func (cp *ControllerProxy) CancelJob(ctx context.Context, req *ctrlpb.CancelJobRequest) (*metapb.Job, error) {
+	return cp.jobCtrl.CancelJob(ctx, req)
+}
+
+func (cp *ControllerProxy) ListFeatureGates(ctx context.Context,
+	req *emptypb.Empty) (*ctrlpb.ListFeatureGatesResponse, error) {
+	return cp.gateCtrl.ListFeatureGates(ctx, req)
+}
+
+func (cp *ControllerProxy) SetFeatureGate(ctx context.Context,
+	req *ctrlpb.SetFeatureGateRequest) (*metapb.FeatureGate, error) {
+	return cp.gateCtrl.SetFeatureGate(ctx, req)
+}
+
+func (cp *ControllerProxy) ListTopUsage(ctx context.Context,
+	req *ctrlpb.ListTopUsageRequest) (*ctrlpb.ListTopUsageResponse, error) {
+	return cp.usageCtrl.ListTopUsage(ctx, req)
+}
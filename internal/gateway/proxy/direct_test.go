@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	. "github.com/smartystreets/goconvey/convey"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -30,7 +31,7 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		eventbusCtrl.EXPECT().GetEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		eventbusCtrl.EXPECT().ListEventBus(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.CreateEventBus(stdCtx.Background(), &ctrlpb.CreateEventBusRequest{})
-		_, _ = cp.DeleteEventBus(stdCtx.Background(), &metapb.EventBus{})
+		_, _ = cp.DeleteEventBus(stdCtx.Background(), &proxypb.DeleteEventBusRequest{Force: true})
 		_, _ = cp.GetEventBus(stdCtx.Background(), &metapb.EventBus{})
 		_, _ = cp.ListEventBus(stdCtx.Background(), &emptypb.Empty{})
 		_, err := cp.UpdateEventBus(stdCtx.Background(), &ctrlpb.UpdateEventBusRequest{})
@@ -50,7 +51,7 @@ func TestControllerProxy_ProxyMethod(t *testing.T) {
 		triggerCtrl.EXPECT().ListSubscription(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
 		_, _ = cp.CreateSubscription(stdCtx.Background(), &ctrlpb.CreateSubscriptionRequest{})
 		_, _ = cp.UpdateSubscription(stdCtx.Background(), &ctrlpb.UpdateSubscriptionRequest{})
-		_, _ = cp.DeleteSubscription(stdCtx.Background(), &ctrlpb.DeleteSubscriptionRequest{})
+		_, _ = cp.DeleteSubscription(stdCtx.Background(), &ctrlpb.DeleteSubscriptionRequest{Force: true})
 		_, _ = cp.GetSubscription(stdCtx.Background(), &ctrlpb.GetSubscriptionRequest{})
 		_, _ = cp.ListSubscription(stdCtx.Background(), &emptypb.Empty{})
 	})
@@ -0,0 +1,133 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	DependentKindSubscription = "subscription"
+	DependentKindDeadLetter   = "dead_letter"
+)
+
+// GetDeletionImpact reports what depends on the eventbus or subscription to be deleted, nothing is deleted.
+func (cp *ControllerProxy) GetDeletionImpact(ctx context.Context,
+	req *proxypb.GetDeletionImpactRequest) (*proxypb.GetDeletionImpactResponse, error) {
+	if (req.GetEventbus() == "") == (req.GetSubscriptionId() == 0) {
+		return nil, errors.ErrInvalidRequest.WithMessage("one of eventbus and subscription_id must be set")
+	}
+
+	if req.GetSubscriptionId() != 0 {
+		sub, err := cp.triggerCtrl.GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{
+			Id: req.GetSubscriptionId(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		lag, err := cp.subscriptionLag(ctx, sub)
+		if err != nil {
+			return nil, err
+		}
+		return &proxypb.GetDeletionImpactResponse{Lag: lag}, nil
+	}
+
+	if _, err := cp.eventbusCtrl.GetEventBus(ctx, &metapb.EventBus{Name: req.GetEventbus()}); err != nil {
+		return nil, err
+	}
+	subs, err := cp.triggerCtrl.ListSubscription(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	res := &proxypb.GetDeletionImpactResponse{}
+	for _, sub := range subs.GetSubscription() {
+		var kind string
+		switch req.GetEventbus() {
+		case sub.GetEventBus():
+			kind = DependentKindSubscription
+		case sub.GetConfig().GetDeadLetterEventbus():
+			kind = DependentKindDeadLetter
+		default:
+			continue
+		}
+		dependent := &proxypb.Dependent{
+			Kind:             kind,
+			SubscriptionId:   sub.GetId(),
+			SubscriptionName: sub.GetName(),
+		}
+		if kind == DependentKindSubscription {
+			if dependent.Lag, err = cp.subscriptionLag(ctx, sub); err != nil {
+				return nil, err
+			}
+		}
+		res.Dependents = append(res.Dependents, dependent)
+	}
+	return res, nil
+}
+
+// checkDeletionImpact returns an error if subscriptions depend on the eventbus to be deleted, or the
+// subscription to be deleted hasn't consumed all events.
+func (cp *ControllerProxy) checkDeletionImpact(ctx context.Context, req *proxypb.GetDeletionImpactRequest) error {
+	res, err := cp.GetDeletionImpact(ctx, req)
+	if err != nil {
+		return err
+	}
+	if n := len(res.GetDependents()); n > 0 {
+		return errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf(
+			"%d subscriptions depend on the eventbus, use force to delete anyway", n))
+	}
+	if res.GetLag() > 0 {
+		return errors.ErrResourceCanNotOp.WithMessage(fmt.Sprintf(
+			"the subscription hasn't consumed %d events, use force to delete anyway", res.GetLag()))
+	}
+	return nil
+}
+
+// subscriptionLag sums up events between the committed offset of subscription and the latest offset of each
+// eventlog, an eventlog without committed offset is counted from its earliest offset.
+func (cp *ControllerProxy) subscriptionLag(ctx context.Context, sub *metapb.Subscription) (int64, error) {
+	ls, err := cp.client.Eventbus(ctx, sub.GetEventBus()).ListLog(ctx)
+	if err != nil {
+		return 0, err
+	}
+	committed := make(map[uint64]int64, len(sub.GetOffsets()))
+	for _, off := range sub.GetOffsets() {
+		committed[off.GetEventLogId()] = int64(off.GetOffset())
+	}
+	var lag int64
+	for _, l := range ls {
+		latest, err := l.LatestOffset(ctx)
+		if err != nil {
+			return 0, err
+		}
+		off, ok := committed[l.ID()]
+		if !ok {
+			if off, err = l.EarliestOffset(ctx); err != nil {
+				return 0, err
+			}
+		}
+		if latest > off {
+			lag += latest - off
+		}
+	}
+	return lag, nil
+}
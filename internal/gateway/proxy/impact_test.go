@@ -0,0 +1,138 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestControllerProxy_GetDeletionImpact(t *testing.T) {
+	Convey("test get deletion impact", t, func() {
+		cp := NewControllerProxy(Config{
+			Endpoints:   []string{"127.0.0.1:20001"},
+			Credentials: insecure.NewCredentials(),
+		})
+		ctx := stdCtx.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		cp.client = mockClient
+		eventbusCtrl := ctrlpb.NewMockEventBusControllerClient(ctrl)
+		cp.eventbusCtrl = eventbusCtrl
+		triggerCtrl := ctrlpb.NewMockTriggerControllerClient(ctrl)
+		cp.triggerCtrl = triggerCtrl
+
+		bus := api.NewMockEventbus(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), "ut").AnyTimes().Return(bus)
+		el1 := api.NewMockEventlog(ctrl)
+		el1.EXPECT().ID().AnyTimes().Return(uint64(1))
+		el1.EXPECT().LatestOffset(gomock.Any()).AnyTimes().Return(int64(100), nil)
+		el2 := api.NewMockEventlog(ctrl)
+		el2.EXPECT().ID().AnyTimes().Return(uint64(2))
+		el2.EXPECT().LatestOffset(gomock.Any()).AnyTimes().Return(int64(50), nil)
+		el2.EXPECT().EarliestOffset(gomock.Any()).AnyTimes().Return(int64(10), nil)
+		bus.EXPECT().ListLog(gomock.Any()).AnyTimes().Return([]api.Eventlog{el1, el2}, nil)
+
+		reader := &metapb.Subscription{
+			Id:       1,
+			Name:     "reader",
+			EventBus: "ut",
+			Offsets:  []*metapb.OffsetInfo{{EventLogId: 1, Offset: 80}},
+		}
+		deadLetter := &metapb.Subscription{
+			Id:       2,
+			Name:     "dead-letter",
+			EventBus: "other",
+			Config:   &metapb.SubscriptionConfig{DeadLetterEventbus: "ut"},
+		}
+
+		Convey("test invalid request", func() {
+			_, err := cp.GetDeletionImpact(ctx, &proxypb.GetDeletionImpactRequest{})
+			So(err, ShouldNotBeNil)
+			_, err = cp.GetDeletionImpact(ctx, &proxypb.GetDeletionImpactRequest{Eventbus: "ut", SubscriptionId: 1})
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("test eventbus", func() {
+			eventbusCtrl.EXPECT().GetEventBus(ctx, &metapb.EventBus{Name: "ut"}).Times(1).
+				Return(&metapb.EventBus{Name: "ut"}, nil)
+			triggerCtrl.EXPECT().ListSubscription(ctx, gomock.Any()).Times(1).Return(
+				&ctrlpb.ListSubscriptionResponse{Subscription: []*metapb.Subscription{
+					reader, deadLetter, {Id: 3, EventBus: "other"},
+				}}, nil)
+			res, err := cp.GetDeletionImpact(ctx, &proxypb.GetDeletionImpactRequest{Eventbus: "ut"})
+			So(err, ShouldBeNil)
+			So(res.Dependents, ShouldHaveLength, 2)
+			So(res.Dependents[0].Kind, ShouldEqual, DependentKindSubscription)
+			So(res.Dependents[0].SubscriptionId, ShouldEqual, uint64(1))
+			So(res.Dependents[0].Lag, ShouldEqual, int64(20+40))
+			So(res.Dependents[1].Kind, ShouldEqual, DependentKindDeadLetter)
+			So(res.Dependents[1].SubscriptionName, ShouldEqual, "dead-letter")
+		})
+
+		Convey("test subscription", func() {
+			triggerCtrl.EXPECT().GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: 1}).Times(1).
+				Return(reader, nil)
+			res, err := cp.GetDeletionImpact(ctx, &proxypb.GetDeletionImpactRequest{SubscriptionId: 1})
+			So(err, ShouldBeNil)
+			So(res.Dependents, ShouldBeEmpty)
+			So(res.Lag, ShouldEqual, int64(60))
+		})
+
+		Convey("test delete eventbus", func() {
+			eventbusCtrl.EXPECT().GetEventBus(ctx, &metapb.EventBus{Name: "ut"}).Times(1).
+				Return(&metapb.EventBus{Name: "ut"}, nil)
+			triggerCtrl.EXPECT().ListSubscription(ctx, gomock.Any()).Times(1).Return(
+				&ctrlpb.ListSubscriptionResponse{Subscription: []*metapb.Subscription{reader}}, nil)
+			_, err := cp.DeleteEventBus(ctx, &proxypb.DeleteEventBusRequest{Name: "ut"})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			eventbusCtrl.EXPECT().DeleteEventBus(ctx, &metapb.EventBus{Name: "ut"}).Times(1).Return(nil, nil)
+			_, err = cp.DeleteEventBus(ctx, &proxypb.DeleteEventBusRequest{Name: "ut", Force: true})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("test delete subscription", func() {
+			triggerCtrl.EXPECT().GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: 1}).Times(1).
+				Return(reader, nil)
+			_, err := cp.DeleteSubscription(ctx, &ctrlpb.DeleteSubscriptionRequest{Id: 1})
+			So(errors.Is(err, errors.ErrResourceCanNotOp), ShouldBeTrue)
+
+			// the subscription has consumed all events.
+			consumed := &metapb.Subscription{
+				Id:       1,
+				EventBus: "ut",
+				Offsets:  []*metapb.OffsetInfo{{EventLogId: 1, Offset: 100}, {EventLogId: 2, Offset: 50}},
+			}
+			triggerCtrl.EXPECT().GetSubscription(ctx, &ctrlpb.GetSubscriptionRequest{Id: 1}).Times(1).
+				Return(consumed, nil)
+			req := &ctrlpb.DeleteSubscriptionRequest{Id: 1}
+			triggerCtrl.EXPECT().DeleteSubscription(ctx, req).Times(1).Return(nil, nil)
+			_, err = cp.DeleteSubscription(ctx, req)
+			So(err, ShouldBeNil)
+		})
+	})
+}
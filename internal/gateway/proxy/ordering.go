@@ -0,0 +1,176 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	stderr "errors"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ExtensionPartitionKey is the extension events of per-key ordered eventbuses are routed by, as the
+// CloudEvents partitioning extension defines.
+const ExtensionPartitionKey = "partitionkey"
+
+// ErrMissingPartitionKey is returned if an event published to a per-key ordered eventbus has no key.
+var ErrMissingPartitionKey = stderr.New("the eventbus is ordered by key, the partitionkey extension is required")
+
+// OrderedWriteOptions returns options to write an event with the partition key to bus, which keep the
+// ordering declared by bus. Events of per-key ordered eventbuses must have the key.
+func OrderedWriteOptions(ctx context.Context, bus api.Eventbus, key string) ([]api.WriteOption, error) {
+	scope, err := bus.OrderingScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if scope != api.OrderingPerKey {
+		return nil, nil
+	}
+	if key == "" {
+		return nil, ErrMissingPartitionKey
+	}
+	return []api.WriteOption{option.WithWritePolicy(policy.NewKeyHashWritePolicy(bus, key))}, nil
+}
+
+// appendBatch writes the batch to bus by w. Events of per-key ordered eventbuses are split by their keys,
+// events of each key are written to the eventlog of the key in the order of the batch.
+func appendBatch(ctx context.Context, bus api.Eventbus, w api.BusWriter, batch *cloudevents.CloudEventBatch) error {
+	scope, err := bus.OrderingScope(ctx)
+	if err != nil {
+		return err
+	}
+	if scope != api.OrderingPerKey {
+		return w.AppendBatch(ctx, batch)
+	}
+
+	var keys []string
+	groups := make(map[string][]*cloudevents.CloudEvent)
+	for _, e := range batch.GetEvents() {
+		key := e.GetAttributes()[ExtensionPartitionKey].GetCeString()
+		if key == "" {
+			return ErrMissingPartitionKey
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], e)
+	}
+	for _, key := range keys {
+		opt := option.WithWritePolicy(policy.NewKeyHashWritePolicy(bus, key))
+		if err = w.AppendBatch(ctx, &cloudevents.CloudEventBatch{Events: groups[key]}, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// latestOfKey returns the latest event with the partition key in the per-key ordered eventbus, it's nil if no
+// event has the key. Stores locate events by key indexes of their blocks. Events of the key are written to
+// the eventlog the key is hashed to, so it's looked up first. Other eventlogs only have events of the key
+// written before the eventbus is scaled, the latest of them by write time is returned then.
+func latestOfKey(ctx context.Context, bus api.Eventbus, key string) (*v2.Event, error) {
+	scope, err := bus.OrderingScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if scope != api.OrderingPerKey {
+		return nil, errors.ErrInvalidRequest.WithMessage("events can only be got by partition keys of " +
+			"eventbuses ordered by key")
+	}
+	l, err := policy.NewKeyHashWritePolicy(bus, key).NextLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if e, err := readKey(ctx, bus, l, key); err != nil || e != nil {
+		return e, err
+	}
+
+	logs, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		latest     *v2.Event
+		latestTime stdtime.Time
+	)
+	for _, other := range logs {
+		if other.ID() == l.ID() {
+			continue
+		}
+		e, err := readKey(ctx, bus, other, key)
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			continue
+		}
+		if t := writeTime(e); latest == nil || t.After(latestTime) {
+			latest, latestTime = e, t
+		}
+	}
+	return latest, nil
+}
+
+// readKey reads the latest event with the partition key in the eventlog.
+func readKey(ctx context.Context, bus api.Eventbus, l api.Eventlog, key string) (*v2.Event, error) {
+	off, err := l.LookupKey(ctx, key)
+	if err != nil || off < 0 {
+		return nil, err
+	}
+	events, _, _, err := bus.Reader(
+		option.WithDisablePolling(),
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(l, off)),
+		option.WithBatchSize(1),
+	).Read(ctx)
+	if errors.Is(err, errors.ErrOffsetUnderflow) {
+		// the event was removed by retention after it's looked up.
+		return nil, nil
+	}
+	if err != nil || len(events) == 0 {
+		return nil, err
+	}
+	return events[0], nil
+}
+
+// writeTime returns the time the event is written to its block, it's zero if the store didn't record it.
+func writeTime(e *v2.Event) stdtime.Time {
+	t, _ := types.ToTime(e.Extensions()[segpb.XVanusStime])
+	return t
+}
+
+func (cp *ControllerProxy) getByPartitionKey(ctx context.Context,
+	req *proxypb.GetEventRequest) (*proxypb.GetEventResponse, error) {
+	e, err := latestOfKey(ctx, cp.client.Eventbus(ctx, req.GetEventbus()), req.GetPartitionKey())
+	if err != nil {
+		return nil, err
+	}
+	if e == nil {
+		return nil, errors.ErrResourceNotFound.WithMessage("no event with the partition key is found")
+	}
+	data, _ := e.MarshalJSON()
+	return &proxypb.GetEventResponse{
+		Events: []*wrapperspb.BytesValue{wrapperspb.Bytes(data)},
+	}, nil
+}
@@ -0,0 +1,195 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAppendBatch(t *testing.T) {
+	Convey("test append batch", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := stdCtx.Background()
+		bus := api.NewMockEventbus(ctrl)
+		w := api.NewMockBusWriter(ctrl)
+
+		event := func(id, key string) *cloudevents.CloudEvent {
+			e := &cloudevents.CloudEvent{Id: id, Attributes: map[string]*cloudevents.CloudEvent_CloudEventAttributeValue{}}
+			if key != "" {
+				e.Attributes[ExtensionPartitionKey] = &cloudevents.CloudEvent_CloudEventAttributeValue{
+					Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: key},
+				}
+			}
+			return e
+		}
+
+		Convey("not ordered by key", func() {
+			bus.EXPECT().OrderingScope(gomock.Any()).Return(api.OrderingPerEventlog, nil)
+			batch := &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{event("1", "")}}
+			w.EXPECT().AppendBatch(gomock.Any(), batch).Return(nil)
+			So(appendBatch(ctx, bus, w, batch), ShouldBeNil)
+		})
+
+		Convey("ordered by key", func() {
+			bus.EXPECT().OrderingScope(gomock.Any()).AnyTimes().Return(api.OrderingPerKey, nil)
+
+			batch := &cloudevents.CloudEventBatch{Events: []*cloudevents.CloudEvent{
+				event("1", "a"), event("2", "b"), event("3", "a"),
+			}}
+			var written [][]string
+			w.EXPECT().AppendBatch(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).DoAndReturn(
+				func(_ stdCtx.Context, b *cloudevents.CloudEventBatch, opts ...api.WriteOption) error {
+					wo := &api.WriteOptions{}
+					wo.Apply(opts...)
+					So(wo.Policy.Type(), ShouldEqual, api.KeyHash)
+					var ids []string
+					for _, e := range b.Events {
+						ids = append(ids, e.Id)
+					}
+					written = append(written, ids)
+					return nil
+				})
+			So(appendBatch(ctx, bus, w, batch), ShouldBeNil)
+			So(written, ShouldResemble, [][]string{{"1", "3"}, {"2"}})
+
+			batch.Events = append(batch.Events, event("4", ""))
+			So(appendBatch(ctx, bus, w, batch), ShouldEqual, ErrMissingPartitionKey)
+
+			opts, err := OrderedWriteOptions(ctx, bus, "")
+			So(err, ShouldEqual, ErrMissingPartitionKey)
+			So(opts, ShouldBeNil)
+			opts, err = OrderedWriteOptions(ctx, bus, "a")
+			So(err, ShouldBeNil)
+			So(opts, ShouldHaveLength, 1)
+		})
+	})
+}
+
+func TestLatestOfKey(t *testing.T) {
+	Convey("test get the latest event of a partition key", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := stdCtx.Background()
+
+		// events of eventlogs by IDs, events before earliest were removed by retention.
+		events := map[uint64][]*v2.Event{}
+		earliest := map[uint64]int64{}
+		appendEvent := func(logID uint64, id, key string, stime int64) {
+			e := v2.NewEvent()
+			e.SetID(id)
+			e.SetSource("test")
+			e.SetType("test")
+			e.SetExtension(ExtensionPartitionKey, key)
+			e.SetExtension(segpb.XVanusStime, stdtime.UnixMilli(stime))
+			events[logID] = append(events[logID], &e)
+		}
+
+		bus := api.NewMockEventbus(ctrl)
+		var logs []api.Eventlog
+		for id := uint64(1); id <= 2; id++ {
+			id := id
+			l := api.NewMockEventlog(ctrl)
+			l.EXPECT().ID().AnyTimes().Return(id)
+			l.EXPECT().LookupKey(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+				func(_ stdCtx.Context, key string) (int64, error) {
+					for off := len(events[id]) - 1; off >= 0; off-- {
+						if events[id][off].Extensions()[ExtensionPartitionKey] == key {
+							return int64(off), nil
+						}
+					}
+					return -1, nil
+				})
+			logs = append(logs, l)
+		}
+		scope := api.OrderingPerKey
+		bus.EXPECT().OrderingScope(gomock.Any()).AnyTimes().DoAndReturn(
+			func(stdCtx.Context) (api.OrderingScope, error) {
+				return scope, nil
+			})
+		bus.EXPECT().ListLog(gomock.Any()).AnyTimes().Return(logs, nil)
+		bus.EXPECT().Reader(gomock.Any()).AnyTimes().DoAndReturn(func(opts ...api.ReadOption) api.BusReader {
+			options := &api.ReadOptions{}
+			options.Apply(opts...)
+			l, _ := options.Policy.NextLog(ctx)
+			off := options.Policy.Offset()
+			reader := api.NewMockBusReader(ctrl)
+			reader.EXPECT().Read(gomock.Any()).DoAndReturn(
+				func(stdCtx.Context, ...api.ReadOption) ([]*v2.Event, int64, uint64, error) {
+					if off < earliest[l.ID()] {
+						return nil, 0, 0, errors.ErrOffsetUnderflow
+					}
+					return events[l.ID()][off : off+1], 0, 0, nil
+				})
+			return reader
+		})
+
+		// "a" is hashed to one eventlog, and the other one is the eventlog it was hashed to before scaling.
+		hashed, err := policy.NewKeyHashWritePolicy(bus, "a").NextLog(ctx)
+		So(err, ShouldBeNil)
+		other := logs[0].ID()
+		if other == hashed.ID() {
+			other = logs[1].ID()
+		}
+
+		Convey("in the eventlog the key is hashed to", func() {
+			appendEvent(other, "1", "a", 1)
+			appendEvent(hashed.ID(), "2", "a", 2)
+			appendEvent(hashed.ID(), "3", "b", 3)
+			appendEvent(hashed.ID(), "4", "a", 4)
+			e, err := latestOfKey(ctx, bus, "a")
+			So(err, ShouldBeNil)
+			So(e.ID(), ShouldEqual, "4")
+
+			e, err = latestOfKey(ctx, bus, "c")
+			So(err, ShouldBeNil)
+			So(e, ShouldBeNil)
+		})
+
+		Convey("in the other eventlogs", func() {
+			appendEvent(other, "1", "a", 1)
+			appendEvent(other, "2", "a", 2)
+			appendEvent(hashed.ID(), "3", "b", 3)
+			e, err := latestOfKey(ctx, bus, "a")
+			So(err, ShouldBeNil)
+			So(e.ID(), ShouldEqual, "2")
+		})
+
+		Convey("removed by retention", func() {
+			appendEvent(hashed.ID(), "1", "a", 1)
+			earliest[hashed.ID()] = 1
+			e, err := latestOfKey(ctx, bus, "a")
+			So(err, ShouldBeNil)
+			So(e, ShouldBeNil)
+		})
+
+		Convey("of eventbuses not ordered by key", func() {
+			scope = api.OrderingPerEventlog
+			_, err = latestOfKey(ctx, bus, "a")
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+	})
+}
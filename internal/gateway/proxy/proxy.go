@@ -18,8 +18,8 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/binary"
+	stderr "errors"
 	"fmt"
-	"net"
 	"net/http"
 	"os"
 	"runtime/debug"
@@ -29,7 +29,6 @@ import (
 	stdtime "time"
 
 	v2 "github.com/cloudevents/sdk-go/v2"
-	"github.com/cloudevents/sdk-go/v2/client"
 	"github.com/cloudevents/sdk-go/v2/protocol"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 	"github.com/cloudevents/sdk-go/v2/types"
@@ -37,15 +36,25 @@ import (
 	vanuspb "github.com/linkall-labs/sdk/proto/pkg/vanus"
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/codec"
 	"github.com/linkall-labs/vanus/client/pkg/option"
 	"github.com/linkall-labs/vanus/client/pkg/policy"
 	"github.com/linkall-labs/vanus/internal/convert"
+	"github.com/linkall-labs/vanus/internal/gateway/middleware"
+	"github.com/linkall-labs/vanus/internal/gateway/shadow"
+	"github.com/linkall-labs/vanus/internal/gateway/transport"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/interceptor/authinterceptor"
+	"github.com/linkall-labs/vanus/internal/primitive/interceptor/correlationinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/interceptor/errinterceptor"
+	"github.com/linkall-labs/vanus/internal/primitive/latency"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/filter"
 	"github.com/linkall-labs/vanus/internal/trigger/transform"
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
 	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
@@ -90,6 +99,12 @@ type Config struct {
 	CloudEventReceiverPort int
 	Credentials            credentials.TransportCredentials
 	GRPCReflectionEnable   bool
+	Meter                  metering.Meter
+	Latencies              *latency.Recorder
+	GRPC                   transport.GRPCConfig
+	HTTP                   transport.HTTPConfig
+	// UsageAdminRoles may query usages of any tenant, it's admin if it's empty.
+	UsageAdminRoles []string
 }
 
 var (
@@ -136,10 +151,38 @@ type ControllerProxy struct {
 	eventbusCtrl ctrlpb.EventBusControllerClient
 	eventlogCtrl ctrlpb.EventLogControllerClient
 	triggerCtrl  ctrlpb.TriggerControllerClient
+	jobCtrl      ctrlpb.JobControllerClient
+	gateCtrl     ctrlpb.FeatureGateControllerClient
+	usageCtrl    ctrlpb.UsageControllerClient
 	grpcSrv      *grpc.Server
+	sinkSrv      *http.Server
 	ctrl         cluster.Cluster
+	meter        metering.Meter
 	writerMap    sync.Map
 	cache        sync.Map
+	auth         auth.Provider
+	authForward  auth.ForwardConfig
+	middlewares  middleware.Chain
+	shadow       *shadow.Shadower
+	latencies    *latency.Recorder
+}
+
+// SetMiddlewares sets middleware which events published to the proxy run through, it must be called
+// before Start.
+func (cp *ControllerProxy) SetMiddlewares(chain middleware.Chain) {
+	cp.middlewares = chain
+}
+
+// SetAuthProvider enables authentication of requests to the proxy, principals are forwarded to
+// controllers if forward is enabled. It must be called before Start.
+func (cp *ControllerProxy) SetAuthProvider(p auth.Provider, forward auth.ForwardConfig) {
+	cp.auth = p
+	cp.authForward = forward
+}
+
+// SetShadower mirrors events published to the proxy to a secondary cluster, it must be called before Start.
+func (cp *ControllerProxy) SetShadower(s *shadow.Shadower) {
+	cp.shadow = s
 }
 
 func (cp *ControllerProxy) Publish(ctx context.Context, req *vanuspb.PublishRequest) (*emptypb.Empty, error) {
@@ -149,15 +192,25 @@ func (cp *ControllerProxy) Publish(ctx context.Context, req *vanuspb.PublishRequ
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid eventbus name")
 	}
 
+	tenant := metering.TenantFromContext(ctx)
 	for idx := range req.Events.Events {
 		e := req.Events.Events[idx]
 		err := checkExtension(e.Attributes)
 		if err != nil {
 			return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
 		}
+		if len(cp.middlewares) > 0 {
+			if e, err = cp.processEvent(_ctx, req.EventbusName, tenant, e); err != nil {
+				return nil, err
+			}
+			req.Events.Events[idx] = e
+		}
 		e.Attributes[primitive.XVanusEventbus] = &cloudevents.CloudEvent_CloudEventAttributeValue{
 			Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: req.EventbusName},
 		}
+		e.Attributes[primitive.XVanusTenant] = &cloudevents.CloudEvent_CloudEventAttributeValue{
+			Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: tenant},
+		}
 		if eventTime, ok := e.Attributes[primitive.XVanusDeliveryTime]; ok {
 			// validate event time
 			if _, err := types.ParseTime(eventTime.String()); err != nil {
@@ -172,7 +225,21 @@ func (cp *ControllerProxy) Publish(ctx context.Context, req *vanuspb.PublishRequ
 		}
 	}
 
-	err := cp.client.Eventbus(ctx, req.GetEventbusName()).Writer().AppendBatch(_ctx, req.GetEvents())
+	size := dataSize(req.Events.Events)
+	start := stdtime.Now()
+	_ctx = tracing.WithRequestMetadata(_ctx, tracing.RequestMetadata{
+		Operation: tracing.OperationPublish,
+		Eventbus:  req.EventbusName,
+		Events:    len(req.Events.Events),
+		Bytes:     size,
+	})
+	bus := cp.client.Eventbus(ctx, req.GetEventbusName())
+	err := appendBatch(_ctx, bus, bus.Writer(), req.GetEvents())
+	metrics.ObserveRequest(_ctx, start, err)
+	cp.latencies.Record(req.EventbusName, start, len(req.Events.Events), err)
+	if stderr.Is(err, ErrMissingPartitionKey) {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		log.Warning(_ctx, "append to failed", map[string]interface{}{
 			log.KeyError: err,
@@ -180,6 +247,8 @@ func (cp *ControllerProxy) Publish(ctx context.Context, req *vanuspb.PublishRequ
 		})
 		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
 	}
+	cp.meter.Record(tenant, req.EventbusName, metering.KindProduced, len(req.Events.Events), size)
+	cp.shadow.Mirror(_ctx, req.EventbusName, req.GetEvents())
 	return &emptypb.Empty{}, nil
 }
 
@@ -215,17 +284,20 @@ func (cp *ControllerProxy) Subscribe(req *vanuspb.SubscribeRequest, stream vanus
 		updateSubscriptionReq := &ctrlpb.UpdateSubscriptionRequest{
 			Id: subscriptionID.Uint64(),
 			Subscription: &ctrlpb.SubscriptionRequest{
-				Source:      meta.Source,
-				Types:       meta.Types,
-				Config:      meta.Config,
-				Filters:     meta.Filters,
-				Sink:        newSink,
-				Protocol:    meta.Protocol,
-				EventBus:    meta.EventBus,
-				Transformer: meta.Transformer,
-				Name:        meta.Name,
-				Description: meta.Description,
-				Disable:     meta.Disable,
+				Source:       meta.Source,
+				Types:        meta.Types,
+				Config:       meta.Config,
+				Filters:      meta.Filters,
+				Sink:         newSink,
+				Protocol:     meta.Protocol,
+				EventBus:     meta.EventBus,
+				Transformer:  meta.Transformer,
+				Name:         meta.Name,
+				Description:  meta.Description,
+				Disable:      meta.Disable,
+				NodeSelector: meta.NodeSelector,
+				Signing:      meta.Signing,
+				Namespace:    meta.Namespace,
 			},
 		}
 		_, err = cp.triggerCtrl.UpdateSubscription(_ctx, updateSubscriptionReq)
@@ -312,6 +384,25 @@ func (cp *ControllerProxy) Ack(stream vanuspb.Client_AckServer) error {
 	}
 }
 
+// processEvent runs the event through middleware, it's converted to the SDK event and back for them.
+func (cp *ControllerProxy) processEvent(
+	ctx context.Context, eventbus, tenant string, e *cloudevents.CloudEvent,
+) (*cloudevents.CloudEvent, error) {
+	event, err := codec.FromProto(e)
+	if err != nil {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
+	err = cp.middlewares.Process(ctx, &middleware.Event{Eventbus: eventbus, Tenant: tenant, Event: event})
+	if err != nil {
+		return nil, v2.NewHTTPResult(middleware.StatusCode(err), err.Error())
+	}
+	processed, err := ToProto(event)
+	if err != nil {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
+	return processed, nil
+}
+
 func ToProto(e *v2.Event) (*cloudevents.CloudEvent, error) {
 	container := &cloudevents.CloudEvent{
 		Id:          e.ID(),
@@ -403,6 +494,7 @@ func (cp *ControllerProxy) Send(ctx context.Context, batch *cloudevents.BatchEve
 		return nil, v2.NewHTTPResult(http.StatusBadRequest, "invalid eventbus name")
 	}
 
+	tenant := metering.TenantFromContext(ctx)
 	for idx := range batch.Events.Events {
 		e := batch.Events.Events[idx]
 		err := checkExtension(e.Attributes)
@@ -412,9 +504,18 @@ func (cp *ControllerProxy) Send(ctx context.Context, batch *cloudevents.BatchEve
 		if e.Attributes == nil {
 			e.Attributes = make(map[string]*cloudevents.CloudEvent_CloudEventAttributeValue, 0)
 		}
+		if len(cp.middlewares) > 0 {
+			if e, err = cp.processEvent(_ctx, batch.EventbusName, tenant, e); err != nil {
+				return nil, err
+			}
+			batch.Events.Events[idx] = e
+		}
 		e.Attributes[primitive.XVanusEventbus] = &cloudevents.CloudEvent_CloudEventAttributeValue{
 			Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: batch.EventbusName},
 		}
+		e.Attributes[primitive.XVanusTenant] = &cloudevents.CloudEvent_CloudEventAttributeValue{
+			Attr: &cloudevents.CloudEvent_CloudEventAttributeValue_CeString{CeString: tenant},
+		}
 		if eventTime, ok := e.Attributes[primitive.XVanusDeliveryTime]; ok {
 			// validate event time
 			if _, err := types.ParseTime(eventTime.String()); err != nil {
@@ -435,8 +536,21 @@ func (cp *ControllerProxy) Send(ctx context.Context, batch *cloudevents.BatchEve
 			cp.client.Eventbus(ctx, batch.GetEventbusName()).Writer())
 	}
 
+	size := dataSize(batch.Events.Events)
+	start := stdtime.Now()
+	_ctx = tracing.WithRequestMetadata(_ctx, tracing.RequestMetadata{
+		Operation: tracing.OperationPublish,
+		Eventbus:  batch.EventbusName,
+		Events:    len(batch.Events.Events),
+		Bytes:     size,
+	})
 	w, _ := val.(api.BusWriter)
-	err := w.AppendBatch(_ctx, batch.GetEvents())
+	err := appendBatch(_ctx, cp.client.Eventbus(ctx, batch.GetEventbusName()), w, batch.GetEvents())
+	metrics.ObserveRequest(_ctx, start, err)
+	cp.latencies.Record(batch.EventbusName, start, len(batch.Events.Events), err)
+	if stderr.Is(err, ErrMissingPartitionKey) {
+		return nil, v2.NewHTTPResult(http.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		log.Warning(_ctx, "append to failed", map[string]interface{}{
 			log.KeyError: err,
@@ -444,10 +558,26 @@ func (cp *ControllerProxy) Send(ctx context.Context, batch *cloudevents.BatchEve
 		})
 		return nil, v2.NewHTTPResult(http.StatusInternalServerError, err.Error())
 	}
-
+	cp.meter.Record(tenant, batch.EventbusName, metering.KindProduced, len(batch.Events.Events), size)
+	cp.shadow.Mirror(_ctx, batch.EventbusName, batch.GetEvents())
 	return &emptypb.Empty{}, nil
 }
 
+func dataSize(events []*cloudevents.CloudEvent) int {
+	size := 0
+	for _, e := range events {
+		switch data := e.Data.(type) {
+		case *cloudevents.CloudEvent_BinaryData:
+			size += len(data.BinaryData)
+		case *cloudevents.CloudEvent_TextData:
+			size += len(data.TextData)
+		case *cloudevents.CloudEvent_ProtoData:
+			size += len(data.ProtoData.GetValue())
+		}
+	}
+	return size
+}
+
 func checkExtension(extensions map[string]*cloudevents.CloudEvent_CloudEventAttributeValue) error {
 	if len(extensions) == 0 {
 		return nil
@@ -466,14 +596,27 @@ func checkExtension(extensions map[string]*cloudevents.CloudEvent_CloudEventAttr
 
 func NewControllerProxy(cfg Config) *ControllerProxy {
 	ctrl := cluster.NewClusterController(cfg.Endpoints, insecure.NewCredentials())
+	meter := cfg.Meter
+	if meter == nil {
+		meter = metering.NewMeter(metering.Config{}, "", nil)
+	}
+	latencies := cfg.Latencies
+	if latencies == nil {
+		latencies = latency.NewRecorder()
+	}
 	return &ControllerProxy{
 		cfg:          cfg,
 		ctrl:         ctrl,
+		meter:        meter,
+		latencies:    latencies,
 		client:       eb.Connect(cfg.Endpoints),
 		tracer:       tracing.NewTracer("controller-proxy", trace.SpanKindServer),
 		eventbusCtrl: ctrl.EventbusService().RawClient(),
 		eventlogCtrl: ctrl.EventlogService().RawClient(),
 		triggerCtrl:  ctrl.TriggerService().RawClient(),
+		jobCtrl:      ctrl.JobService().RawClient(),
+		gateCtrl:     ctrl.FeatureGateService().RawClient(),
+		usageCtrl:    ctrl.UsageService().RawClient(),
 	}
 }
 
@@ -488,18 +631,30 @@ func (cp *ControllerProxy) Start() error {
 		},
 	)
 
-	cp.grpcSrv = grpc.NewServer(
-		grpc.ChainStreamInterceptor(
-			errinterceptor.StreamServerInterceptor(),
-			recovery.StreamServerInterceptor(recoveryOpt),
-			otelgrpc.StreamServerInterceptor(),
-		),
-		grpc.ChainUnaryInterceptor(
-			errinterceptor.UnaryServerInterceptor(),
-			recovery.UnaryServerInterceptor(recoveryOpt),
-			otelgrpc.UnaryServerInterceptor(),
-		),
-	)
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		errinterceptor.StreamServerInterceptor(),
+		correlationinterceptor.StreamServerInterceptor(),
+		recovery.StreamServerInterceptor(recoveryOpt),
+	}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		errinterceptor.UnaryServerInterceptor(),
+		correlationinterceptor.UnaryServerInterceptor(),
+		recovery.UnaryServerInterceptor(recoveryOpt),
+	}
+	if cp.auth != nil {
+		streamInterceptors = append(streamInterceptors,
+			authinterceptor.StreamServerInterceptor(cp.auth, cp.authForward))
+		unaryInterceptors = append(unaryInterceptors,
+			authinterceptor.UnaryServerInterceptor(cp.auth, cp.authForward))
+	}
+	streamInterceptors = append(streamInterceptors, otelgrpc.StreamServerInterceptor())
+	unaryInterceptors = append(unaryInterceptors, otelgrpc.UnaryServerInterceptor())
+
+	opts := append([]grpc.ServerOption{
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+	}, cp.cfg.GRPC.ServerOptions()...)
+	cp.grpcSrv = grpc.NewServer(opts...)
 
 	// for debug in developing stage
 	if cp.cfg.GRPCReflectionEnable {
@@ -510,7 +665,7 @@ func (cp *ControllerProxy) Start() error {
 	cloudevents.RegisterCloudEventsServer(cp.grpcSrv, cp)
 	vanuspb.RegisterClientServer(cp.grpcSrv, cp)
 
-	proxyListen, err := net.Listen("tcp", fmt.Sprintf(":%d", cp.cfg.ProxyPort))
+	proxyListen, err := transport.Listen("proxy", cp.cfg.ProxyPort)
 	if err != nil {
 		return err
 	}
@@ -526,19 +681,20 @@ func (cp *ControllerProxy) Start() error {
 	}()
 	log.Info(context.Background(), "the grpc proxy ready to work", nil)
 
-	sinkListen, err := net.Listen("tcp", fmt.Sprintf(":%d", cp.cfg.SinkPort))
+	sinkListen, err := transport.Listen("sink", cp.cfg.SinkPort)
 	if err != nil {
 		return err
 	}
 
-	c, err := client.NewHTTP(cehttp.WithListener(sinkListen), cehttp.WithRequestDataAtContextMiddleware())
+	h, err := transport.NewCloudEventsHandler(context.Background(), cp.receive)
 	if err != nil {
 		return err
 	}
+	cp.sinkSrv = cp.cfg.HTTP.NewServer(h)
 
 	wg.Add(1)
 	go func() {
-		if err := c.StartReceiver(context.Background(), cp.receive); err != nil {
+		if err := cp.sinkSrv.Serve(sinkListen); err != nil && err != http.ErrServerClosed {
 			panic(fmt.Sprintf("start CloudEvents receiver failed: %s", err.Error()))
 		}
 		wg.Done()
@@ -597,6 +753,9 @@ func (cp *ControllerProxy) Stop() {
 	if cp.grpcSrv != nil {
 		cp.grpcSrv.GracefulStop()
 	}
+	if cp.sinkSrv != nil {
+		_ = cp.sinkSrv.Close()
+	}
 }
 
 func (cp *ControllerProxy) ClusterInfo(_ context.Context, _ *emptypb.Empty) (*proxypb.ClusterInfoResponse, error) {
@@ -649,6 +808,9 @@ func (cp *ControllerProxy) GetEvent(ctx context.Context,
 	if req.EventId != "" {
 		return cp.getByEventID(ctx, req)
 	}
+	if req.PartitionKey != "" {
+		return cp.getByPartitionKey(ctx, req)
+	}
 
 	var (
 		offset = req.Offset
@@ -663,14 +825,28 @@ func (cp *ControllerProxy) GetEvent(ctx context.Context,
 		num = maximumNumberPerGetRequest
 	}
 
-	ls, err := cp.client.Eventbus(ctx, req.GetEventbus()).ListLog(ctx)
-	if err != nil {
-		return nil, err
+	var l api.Eventlog
+	if req.EventlogId > 0 {
+		el, err := cp.client.Eventbus(ctx, req.GetEventbus()).GetLog(ctx, req.EventlogId)
+		if err != nil {
+			return nil, err
+		}
+		l = el
+	} else {
+		// the first eventlog is read if the eventlog isn't specified.
+		ls, err := cp.client.Eventbus(ctx, req.GetEventbus()).ListLog(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(ls) == 0 {
+			return nil, errors.ErrResourceNotFound.WithMessage("the eventbus has no eventlog")
+		}
+		l = ls[0]
 	}
 
 	events, _, _, err := cp.client.Eventbus(ctx, req.GetEventbus()).Reader(
 		option.WithDisablePolling(),
-		option.WithReadPolicy(policy.NewManuallyReadPolicy(ls[0], offset)),
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(l, offset)),
 		option.WithBatchSize(int(num)),
 	).Read(ctx)
 	if err != nil {
@@ -729,7 +905,12 @@ func (cp *ControllerProxy) ValidateSubscription(ctx context.Context,
 	res.FilterResult = true
 	t := transform.NewTransformer(sub.Transformer)
 	if t != nil {
+		defer t.Close()
 		if err := t.Execute(&e); err != nil {
+			if stderr.Is(err, transform.ErrFiltered) {
+				res.FilterResult = false
+				return res, nil
+			}
 			return nil, errors.ErrTransformInputParse.Wrap(err)
 		}
 	}
@@ -177,6 +177,33 @@ func TestControllerProxy_GetEvent(t *testing.T) {
 			}
 		})
 
+		Convey("test get events of an eventlog", func() {
+			id := vanus.NewTestID().Uint64()
+			el := api.NewMockEventlog(ctrl)
+			utEB1.EXPECT().GetLog(gomock.Any(), id).Times(1).Return(el, nil)
+			utEB1.EXPECT().Reader(gomock.Any()).Times(1).DoAndReturn(func(
+				opts ...api.ReadOption) api.BusReader {
+				opt := &api.ReadOptions{}
+				opt.Apply(opts...)
+				So(opt.Policy, ShouldResemble, policy.NewManuallyReadPolicy(el, 10))
+				return reader
+			})
+
+			e := v2.NewEvent()
+			e.SetID("ut")
+			e.SetSource("ut")
+			e.SetType("ut")
+			reader.EXPECT().Read(gomock.Any()).Times(1).Return([]*v2.Event{&e}, int64(10), id, nil)
+			res, err := cp.GetEvent(stdCtx.Background(), &proxypb.GetEventRequest{
+				Eventbus:   "ut1",
+				EventlogId: id,
+				Offset:     10,
+				Number:     1,
+			})
+			So(err, ShouldBeNil)
+			So(res.Events, ShouldHaveLength, 1)
+		})
+
 		Convey("test get events by eventID", func() {
 			b := make([]byte, 16)
 			id := vanus.NewTestID().Uint64()
@@ -344,8 +371,9 @@ func TestControllerProxy_ValidateSubscription(t *testing.T) {
 			ctx := stdCtx.Background()
 
 			// mock eventbus
+			eventlogID := vanus.NewTestID().Uint64()
 			cli.EXPECT().Eventbus(gomock.Any(), gomock.Any()).Times(2).Return(eb)
-			eb.EXPECT().ListLog(gomock.Any()).Times(1).Return([]api.Eventlog{nil}, nil)
+			eb.EXPECT().GetLog(gomock.Any(), eventlogID).Times(1).Return(api.NewMockEventlog(ctrl), nil)
 			rd := api.NewMockBusReader(ctrl)
 			eb.EXPECT().Reader(gomock.Any(), gomock.Any(), gomock.Any()).Times(1).Return(rd)
 			rd.EXPECT().Read(gomock.Any()).Times(1).Return([]*v2.Event{&e}, int64(0), uint64(0), nil)
@@ -366,7 +394,7 @@ func TestControllerProxy_ValidateSubscription(t *testing.T) {
 			res, err := cp.ValidateSubscription(ctx, &proxypb.ValidateSubscriptionRequest{
 				SubscriptionId: vanus.NewTestID().Uint64(),
 				Eventbus:       "test",
-				Eventlog:       vanus.NewTestID().Uint64(),
+				Eventlog:       eventlogID,
 				Offset:         123,
 			})
 
@@ -0,0 +1,199 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+)
+
+const (
+	usageReadBatchSize        = 64
+	maximumUsageRecordsToRead = 100000
+	// usageRecordDisorder bounds how far records are out of order of their end times, which are appended by
+	// reporters independently, so they're disordered by clock skews and append latencies.
+	usageRecordDisorder   = stdtime.Minute
+	defaultUsageAdminRole = "admin"
+)
+
+// GetUsage aggregates usage records in the metering eventbus into per tenant and eventbus usages.
+func (cp *ControllerProxy) GetUsage(ctx context.Context,
+	req *proxypb.GetUsageRequest) (*proxypb.GetUsageResponse, error) {
+	tenant, err := cp.usageTenant(ctx, req.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+	start, end := req.GetStartTime(), req.GetEndTime()
+	if end == 0 {
+		end = stdtime.Now().UnixMilli()
+	}
+	if start >= end {
+		return nil, errors.ErrInvalidRequest.WithMessage("the start_time must be less than end_time")
+	}
+
+	bus := cp.client.Eventbus(ctx, primitive.MeteringEventbusName)
+	ls, err := bus.ListLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	agg := newUsageAggregator(tenant, req.GetEventbus(),
+		stdtime.UnixMilli(start), stdtime.UnixMilli(end))
+	res := &proxypb.GetUsageResponse{Complete: true}
+	for _, l := range ls {
+		off, err := l.QueryOffsetByTime(ctx, start)
+		if err != nil {
+			return nil, err
+		}
+		if off < 0 {
+			continue
+		}
+		for {
+			if agg.scanned >= maximumUsageRecordsToRead {
+				res.Complete = false
+				break
+			}
+			events, _, _, err := bus.Reader(
+				option.WithDisablePolling(),
+				option.WithReadPolicy(policy.NewManuallyReadPolicy(l, off)),
+				option.WithBatchSize(usageReadBatchSize),
+			).Read(ctx)
+			if err != nil {
+				if errors.Is(err, errors.ErrOffsetOnEnd) {
+					break
+				}
+				return nil, err
+			}
+			if len(events) == 0 || !agg.add(ctx, events) {
+				break
+			}
+			off += int64(len(events))
+		}
+	}
+	res.Usages = agg.result()
+	return res, nil
+}
+
+// usageTenant returns the tenant whose usages the request may query, which is the tenant of the request
+// unless the principal is an admin. Admins query requested, all tenants if it's empty.
+func (cp *ControllerProxy) usageTenant(ctx context.Context, requested string) (string, error) {
+	if p := auth.PrincipalFromContext(ctx); p != nil {
+		roles := cp.cfg.UsageAdminRoles
+		if len(roles) == 0 {
+			roles = []string{defaultUsageAdminRole}
+		}
+		for _, role := range roles {
+			if p.HasRole(role) {
+				return requested, nil
+			}
+		}
+	}
+	tenant := metering.TenantFromContext(ctx)
+	if requested != "" && requested != tenant {
+		return "", errors.ErrPermissionDenied.WithMessage(
+			fmt.Sprintf("usages of the tenant %s can only be queried by itself or admins", requested))
+	}
+	return tenant, nil
+}
+
+type usageAggregator struct {
+	tenant   string
+	eventbus string
+	start    stdtime.Time
+	end      stdtime.Time
+	scanned  int
+	usages   map[[2]string]*proxypb.Usage
+}
+
+func newUsageAggregator(tenant, eventbus string, start, end stdtime.Time) *usageAggregator {
+	return &usageAggregator{
+		tenant:   tenant,
+		eventbus: eventbus,
+		start:    start,
+		end:      end,
+		usages:   map[[2]string]*proxypb.Usage{},
+	}
+}
+
+// add accumulates records of events, a record belongs to the range which its end time falls into. Records
+// are appended roughly in order of end time, it returns false once a record ends usageRecordDisorder after
+// the range, no record of the range is expected after it.
+func (a *usageAggregator) add(ctx context.Context, events []*v2.Event) bool {
+	for _, e := range events {
+		a.scanned++
+		if e.Type() != metering.EventType {
+			continue
+		}
+		u := &metering.Usage{}
+		if err := json.Unmarshal(e.Data(), u); err != nil {
+			log.Warning(ctx, "invalid usage record", map[string]interface{}{
+				log.KeyError: err,
+				"event_id":   e.ID(),
+			})
+			continue
+		}
+		if !u.End.Before(a.end.Add(usageRecordDisorder)) {
+			return false
+		}
+		if u.End.Before(a.start) || !u.End.Before(a.end) {
+			continue
+		}
+		if (a.tenant != "" && u.Tenant != a.tenant) || (a.eventbus != "" && u.Eventbus != a.eventbus) {
+			continue
+		}
+		k := [2]string{u.Tenant, u.Eventbus}
+		usage, ok := a.usages[k]
+		if !ok {
+			usage = &proxypb.Usage{Tenant: u.Tenant, Eventbus: u.Eventbus}
+			a.usages[k] = usage
+		}
+		switch u.Kind {
+		case metering.KindProduced:
+			usage.ProducedEvents += u.Events
+			usage.ProducedBytes += u.Bytes
+		case metering.KindDelivered:
+			usage.DeliveredEvents += u.Events
+			usage.DeliveredBytes += u.Bytes
+		}
+	}
+	return true
+}
+
+func (a *usageAggregator) result() []*proxypb.Usage {
+	usages := make([]*proxypb.Usage, 0, len(a.usages))
+	for _, u := range a.usages {
+		usages = append(usages, u)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Tenant != usages[j].Tenant {
+			return usages[i].Tenant < usages[j].Tenant
+		}
+		return usages[i].Eventbus < usages[j].Eventbus
+	})
+	return usages
+}
@@ -0,0 +1,139 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	stdCtx "context"
+	"testing"
+	stdtime "time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUsageAggregator_Add(t *testing.T) {
+	Convey("test usage aggregator", t, func() {
+		ctx := stdCtx.Background()
+		start := stdtime.UnixMilli(1_000_000)
+		end := start.Add(stdtime.Hour)
+		record := func(tenant string, kind metering.Kind, events uint64, endAt stdtime.Time) *v2.Event {
+			e := v2.NewEvent()
+			e.SetID("id")
+			e.SetType(metering.EventType)
+			e.SetSource("test")
+			_ = e.SetData(v2.ApplicationJSON, &metering.Usage{
+				Tenant:   tenant,
+				Eventbus: "bus",
+				Kind:     kind,
+				Events:   events,
+				Bytes:    events * 10,
+				End:      endAt,
+			})
+			return &e
+		}
+
+		Convey("records out of order are counted", func() {
+			agg := newUsageAggregator("", "", start, end)
+			So(agg.add(ctx, []*v2.Event{
+				record("t1", metering.KindProduced, 1, start.Add(stdtime.Minute)),
+				// a record after the range which is appended before records of the range.
+				record("t1", metering.KindProduced, 100, end.Add(stdtime.Second)),
+				record("t1", metering.KindProduced, 2, end.Add(-stdtime.Second)),
+				record("t1", metering.KindDelivered, 3, end.Add(-stdtime.Minute)),
+				// a record before the range.
+				record("t1", metering.KindProduced, 100, start.Add(-stdtime.Second)),
+			}), ShouldBeTrue)
+			So(agg.add(ctx, []*v2.Event{
+				record("t2", metering.KindProduced, 4, end.Add(-stdtime.Millisecond)),
+			}), ShouldBeTrue)
+
+			usages := agg.result()
+			So(usages, ShouldHaveLength, 2)
+			So(usages[0].Tenant, ShouldEqual, "t1")
+			So(usages[0].ProducedEvents, ShouldEqual, 3)
+			So(usages[0].ProducedBytes, ShouldEqual, 30)
+			So(usages[0].DeliveredEvents, ShouldEqual, 3)
+			So(usages[1].Tenant, ShouldEqual, "t2")
+			So(usages[1].ProducedEvents, ShouldEqual, 4)
+			So(agg.scanned, ShouldEqual, 6)
+		})
+
+		Convey("scanning stops once records are far beyond the range", func() {
+			agg := newUsageAggregator("", "", start, end)
+			So(agg.add(ctx, []*v2.Event{
+				record("t1", metering.KindProduced, 1, start.Add(stdtime.Minute)),
+				record("t1", metering.KindProduced, 100, end.Add(usageRecordDisorder)),
+				record("t1", metering.KindProduced, 100, end.Add(-stdtime.Second)),
+			}), ShouldBeFalse)
+			usages := agg.result()
+			So(usages, ShouldHaveLength, 1)
+			So(usages[0].ProducedEvents, ShouldEqual, 1)
+		})
+
+		Convey("records are filtered by tenant", func() {
+			agg := newUsageAggregator("t2", "", start, end)
+			So(agg.add(ctx, []*v2.Event{
+				record("t1", metering.KindProduced, 1, start.Add(stdtime.Minute)),
+				record("t2", metering.KindProduced, 2, start.Add(stdtime.Minute)),
+			}), ShouldBeTrue)
+			usages := agg.result()
+			So(usages, ShouldHaveLength, 1)
+			So(usages[0].Tenant, ShouldEqual, "t2")
+		})
+	})
+}
+
+func TestControllerProxy_UsageTenant(t *testing.T) {
+	Convey("test tenant of usage queries", t, func() {
+		cp := &ControllerProxy{}
+		alice := auth.WithPrincipal(stdCtx.Background(), &auth.Principal{Name: "alice"})
+		admin := auth.WithPrincipal(stdCtx.Background(), &auth.Principal{Name: "bob", Roles: []string{"admin"}})
+
+		Convey("principals query their own tenants", func() {
+			tenant, err := cp.usageTenant(alice, "")
+			So(err, ShouldBeNil)
+			So(tenant, ShouldEqual, "alice")
+			tenant, err = cp.usageTenant(alice, "alice")
+			So(err, ShouldBeNil)
+			So(tenant, ShouldEqual, "alice")
+			_, err = cp.usageTenant(alice, "bob")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+
+		Convey("anonymous requests query the default tenant", func() {
+			tenant, err := cp.usageTenant(stdCtx.Background(), "")
+			So(err, ShouldBeNil)
+			So(tenant, ShouldEqual, metering.DefaultTenant)
+			_, err = cp.usageTenant(stdCtx.Background(), "alice")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+
+		Convey("admins query any tenant", func() {
+			tenant, err := cp.usageTenant(admin, "alice")
+			So(err, ShouldBeNil)
+			So(tenant, ShouldEqual, "alice")
+			tenant, err = cp.usageTenant(admin, "")
+			So(err, ShouldBeNil)
+			So(tenant, ShouldBeEmpty)
+
+			cp.cfg.UsageAdminRoles = []string{"ops"}
+			_, err = cp.usageTenant(admin, "alice")
+			So(errors.Is(err, errors.ErrPermissionDenied), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package shadow mirrors events accepted by the gateway to a secondary cluster, e.g. to validate a
+// migration or to load test a new cluster with the production traffic.
+package shadow
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/codec"
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+)
+
+const (
+	defaultQueueSize = 10000
+	defaultWorkers   = 4
+	defaultTimeout   = 5 * time.Second
+)
+
+type Config struct {
+	Enable bool `yaml:"enable"`
+	// Controllers are endpoints of controllers of the secondary cluster, events are appended to eventbuses
+	// with the same names there.
+	Controllers []string `yaml:"controllers"`
+	// Percentage of accepted events which are mirrored, in (0, 100].
+	Percentage float64 `yaml:"percentage"`
+	// QueueSize bounds batches waiting to be mirrored, more batches are dropped, 0 is 10000.
+	QueueSize int `yaml:"queue_size"`
+	// Workers is the number of concurrent appends to the secondary cluster, 0 is 4.
+	Workers int           `yaml:"workers"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+func (c Config) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+	if len(c.Controllers) == 0 {
+		return fmt.Errorf("controllers of the shadow cluster are required")
+	}
+	if c.Percentage <= 0 || c.Percentage > 100 {
+		return fmt.Errorf("the shadow percentage must be in (0, 100], but it's %v", c.Percentage)
+	}
+	if c.QueueSize < 0 || c.Workers < 0 || c.Timeout < 0 {
+		return fmt.Errorf("queue_size, workers and timeout of shadow can't be negative")
+	}
+	return nil
+}
+
+func (c Config) GetQueueSize() int {
+	if c.QueueSize == 0 {
+		return defaultQueueSize
+	}
+	return c.QueueSize
+}
+
+func (c Config) GetWorkers() int {
+	if c.Workers == 0 {
+		return defaultWorkers
+	}
+	return c.Workers
+}
+
+func (c Config) GetTimeout() time.Duration {
+	if c.Timeout == 0 {
+		return defaultTimeout
+	}
+	return c.Timeout
+}
+
+type appendFunc func(ctx context.Context, eventbus string, batch *cloudevents.CloudEventBatch) error
+
+type item struct {
+	eventbus string
+	batch    *cloudevents.CloudEventBatch
+}
+
+// Shadower mirrors events asynchronously, the primary append never waits for it and isn't affected by
+// failures of the secondary cluster. A nil Shadower mirrors nothing.
+type Shadower struct {
+	cfg     Config
+	append  appendFunc
+	client  eb.Client
+	writers sync.Map
+	queue   chan *item
+	sample  func() float64
+
+	mutex  sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// New returns nil if shadowing is disabled.
+func New(cfg Config) (*Shadower, error) {
+	if !cfg.Enable {
+		return nil, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	s := newShadower(cfg, nil)
+	s.client = eb.Connect(cfg.Controllers)
+	s.append = s.appendToCluster
+	return s, nil
+}
+
+func newShadower(cfg Config, fn appendFunc) *Shadower {
+	return &Shadower{
+		cfg:    cfg,
+		append: fn,
+		queue:  make(chan *item, cfg.GetQueueSize()),
+		sample: rand.Float64,
+	}
+}
+
+func (s *Shadower) Start() {
+	if s == nil {
+		return
+	}
+	for i := 0; i < s.cfg.GetWorkers(); i++ {
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			for it := range s.queue {
+				s.send(it)
+			}
+		}()
+	}
+	log.Info(context.Background(), "shadow publishing is started", map[string]interface{}{
+		"controllers": s.cfg.Controllers,
+		"percentage":  s.cfg.Percentage,
+	})
+}
+
+// Stop sends batches in the queue and waits for them.
+func (s *Shadower) Stop() {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if s.closed {
+		s.mutex.Unlock()
+		return
+	}
+	s.closed = true
+	close(s.queue)
+	s.mutex.Unlock()
+	s.wg.Wait()
+	if s.client != nil {
+		s.client.Disconnect(context.Background())
+	}
+}
+
+// MirrorEvent mirrors an event accepted by the CloudEvents receiver, the event is copied.
+func (s *Shadower) MirrorEvent(ctx context.Context, eventbus string, event *v2.Event) {
+	if s == nil || !s.hit() {
+		return
+	}
+	e, err := codec.ToProto(event)
+	if err != nil {
+		log.Warning(ctx, "convert the shadow event failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   eventbus,
+		})
+		return
+	}
+	s.enqueue(eventbus, []*cloudevents.CloudEvent{e})
+}
+
+// Mirror mirrors sampled events of a batch accepted by the proxy, the batch must not be changed after.
+func (s *Shadower) Mirror(ctx context.Context, eventbus string, batch *cloudevents.CloudEventBatch) {
+	if s == nil {
+		return
+	}
+	events := make([]*cloudevents.CloudEvent, 0, len(batch.GetEvents()))
+	for _, e := range batch.GetEvents() {
+		if s.hit() {
+			events = append(events, e)
+		}
+	}
+	s.enqueue(eventbus, events)
+}
+
+func (s *Shadower) hit() bool {
+	if !featuregate.Enabled(featuregate.ShadowPublish) {
+		return false
+	}
+	return s.cfg.Percentage >= 100 || s.sample()*100 < s.cfg.Percentage
+}
+
+func (s *Shadower) enqueue(eventbus string, events []*cloudevents.CloudEvent) {
+	if len(events) == 0 {
+		return
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	if s.closed {
+		return
+	}
+	select {
+	case s.queue <- &item{eventbus: eventbus, batch: &cloudevents.CloudEventBatch{Events: events}}:
+	default:
+		metrics.GatewayShadowEventCounterVec.WithLabelValues(
+			eventbus, metrics.LabelValueShadowDropped).Add(float64(len(events)))
+	}
+}
+
+func (s *Shadower) send(it *item) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.GetTimeout())
+	defer cancel()
+	result := metrics.LabelValueRequestSuccess
+	if err := s.append(ctx, it.eventbus, it.batch); err != nil {
+		result = metrics.LabelValueRequestFail
+		log.Debug(ctx, "mirror events to the shadow cluster failed", map[string]interface{}{
+			log.KeyError: err,
+			"eventbus":   it.eventbus,
+			"events":     len(it.batch.Events),
+		})
+	}
+	metrics.GatewayShadowEventCounterVec.WithLabelValues(it.eventbus, result).Add(float64(len(it.batch.Events)))
+}
+
+func (s *Shadower) appendToCluster(ctx context.Context, eventbus string,
+	batch *cloudevents.CloudEventBatch) error {
+	v, ok := s.writers.Load(eventbus)
+	if !ok {
+		v, _ = s.writers.LoadOrStore(eventbus, s.client.Eventbus(ctx, eventbus).Writer())
+	}
+	w, _ := v.(api.BusWriter)
+	return w.AppendBatch(ctx, batch)
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadow
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	"github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConfig_Validate(t *testing.T) {
+	Convey("test shadow config validate", t, func() {
+		So(Config{}.Validate(), ShouldBeNil)
+		So(Config{Enable: true, Percentage: 10}.Validate(), ShouldNotBeNil)
+		cfg := Config{Enable: true, Controllers: []string{"127.0.0.1:2048"}}
+		So(cfg.Validate(), ShouldNotBeNil)
+		cfg.Percentage = 101
+		So(cfg.Validate(), ShouldNotBeNil)
+		cfg.Percentage = 50
+		So(cfg.Validate(), ShouldBeNil)
+		So(cfg.GetQueueSize(), ShouldEqual, defaultQueueSize)
+		So(cfg.GetWorkers(), ShouldEqual, defaultWorkers)
+		So(cfg.GetTimeout(), ShouldEqual, defaultTimeout)
+
+		s, err := New(Config{})
+		So(err, ShouldBeNil)
+		So(s, ShouldBeNil)
+		// a nil shadower is a no-op.
+		s.Start()
+		s.Mirror(context.Background(), "bus", &cloudevents.CloudEventBatch{})
+		s.Stop()
+	})
+}
+
+func TestShadower_Mirror(t *testing.T) {
+	Convey("test shadower mirror", t, func() {
+		ctx := context.Background()
+		featuregate.Apply(ctx, []*metapb.FeatureGate{{
+			Name: string(featuregate.ShadowPublish), Enabled: true, Overridden: true,
+		}})
+		defer featuregate.Apply(ctx, nil)
+		var mutex sync.Mutex
+		mirrored := map[string][]string{}
+		release := make(chan struct{})
+		fn := func(ctx context.Context, eventbus string, batch *cloudevents.CloudEventBatch) error {
+			<-release
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, e := range batch.Events {
+				mirrored[eventbus] = append(mirrored[eventbus], e.Id)
+			}
+			return nil
+		}
+		batchOf := func(n int) *cloudevents.CloudEventBatch {
+			batch := &cloudevents.CloudEventBatch{}
+			for i := 0; i < n; i++ {
+				batch.Events = append(batch.Events, &cloudevents.CloudEvent{Id: fmt.Sprintf("%d", i)})
+			}
+			return batch
+		}
+
+		Convey("sample by percentage", func() {
+			s := newShadower(Config{Percentage: 50, Workers: 1, Timeout: time.Second}, fn)
+			samples := []float64{0.1, 0.6, 0.49, 0.5}
+			s.sample = func() float64 {
+				v := samples[0]
+				samples = samples[1:]
+				return v
+			}
+			s.Start()
+			s.Mirror(ctx, "bus", batchOf(4))
+			close(release)
+			s.Stop()
+			So(mirrored["bus"], ShouldResemble, []string{"0", "2"})
+		})
+
+		Convey("drop once the queue is full", func() {
+			s := newShadower(Config{Percentage: 100, QueueSize: 1, Workers: 1}, fn)
+			s.Start()
+			for i := 0; i < 10; i++ {
+				s.Mirror(ctx, "bus", batchOf(1))
+			}
+			e := v2.NewEvent()
+			e.SetID("event")
+			e.SetSource("test")
+			e.SetType("test")
+			s.MirrorEvent(ctx, "other", &e)
+			close(release)
+			s.Stop()
+			// one is being sent by the worker and one is waiting in the queue at most.
+			So(len(mirrored["bus"])+len(mirrored["other"]), ShouldBeLessThanOrEqualTo, 2)
+
+			// events are ignored after stopped.
+			s.Mirror(ctx, "bus", batchOf(1))
+		})
+	})
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/cloudevents/sdk-go/v2/client"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/tracing"
+)
+
+// NewCloudEventsHandler returns a handler which passes received CloudEvents to fn, the request data is
+// available in the context of fn by cehttp.RequestDataFromContext. Unlike client.StartReceiver, the handler
+// is served by a server configured by HTTPConfig.
+func NewCloudEventsHandler(ctx context.Context, fn interface{}) (http.Handler, error) {
+	p, err := cehttp.New()
+	if err != nil {
+		return nil, err
+	}
+	r, err := client.NewHTTPReceiveHandler(ctx, p, fn)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.ServeHTTP(w, req.WithContext(cehttp.WithRequestDataAtContext(req.Context(), req)))
+	}), nil
+}
+
+// CorrelationHandler carries the correlation ID of the request, which is given by the user or generated, in
+// the context passed to h, and returns it in the response header, so that the user can report it.
+func CorrelationHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		id := tracing.CorrelationIDOrNew(req.Header.Get(tracing.CorrelationHeader))
+		w.Header().Set(tracing.CorrelationHeader, id)
+		h.ServeHTTP(w, req.WithContext(log.WithCorrelationID(req.Context(), id)))
+	})
+}
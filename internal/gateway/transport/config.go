@@ -0,0 +1,104 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	defaultReadHeaderTimeout = 10 * time.Second
+)
+
+// GRPCConfig tunes gRPC servers of the gateway, zero values keep defaults of gRPC.
+type GRPCConfig struct {
+	// KeepaliveTime is the idle time after which the server pings the client.
+	KeepaliveTime time.Duration `yaml:"keepalive_time"`
+	// KeepaliveTimeout is the time the server waits for the ack of a ping before closing the connection.
+	KeepaliveTimeout time.Duration `yaml:"keepalive_timeout"`
+	// MinPingInterval is the minimum interval clients are allowed to ping, clients pinging more
+	// frequently are disconnected.
+	MinPingInterval time.Duration `yaml:"min_ping_interval"`
+	// PermitPingWithoutStream allows clients to ping when there is no active stream.
+	PermitPingWithoutStream bool `yaml:"permit_ping_without_stream"`
+	// MaxConnectionIdle is the idle time after which the connection is closed by a GOAWAY.
+	MaxConnectionIdle time.Duration `yaml:"max_connection_idle"`
+	// MaxConnectionAge is the lifetime of a connection, which spreads clients to new instances.
+	MaxConnectionAge time.Duration `yaml:"max_connection_age"`
+	// MaxConcurrentStreams limits streams of each connection.
+	MaxConcurrentStreams uint32 `yaml:"max_concurrent_streams"`
+}
+
+func (c GRPCConfig) ServerOptions() []grpc.ServerOption {
+	opts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: c.MaxConnectionIdle,
+			MaxConnectionAge:  c.MaxConnectionAge,
+			Time:              c.KeepaliveTime,
+			Timeout:           c.KeepaliveTimeout,
+		}),
+	}
+	if c.MinPingInterval > 0 || c.PermitPingWithoutStream {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             c.MinPingInterval,
+			PermitWithoutStream: c.PermitPingWithoutStream,
+		}))
+	}
+	if c.MaxConcurrentStreams > 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(c.MaxConcurrentStreams))
+	}
+	return opts
+}
+
+// HTTPConfig tunes HTTP servers of the gateway, zero values keep defaults of net/http.
+type HTTPConfig struct {
+	// ReadHeaderTimeout is the time to read headers of a request, 0 is 10s.
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	// IdleTimeout is the time a keep-alive connection waits for the next request.
+	IdleTimeout time.Duration `yaml:"idle_timeout"`
+	// DisableKeepAlive closes the connection after each request.
+	DisableKeepAlive bool `yaml:"disable_keep_alive"`
+	// EnableH2C serves HTTP/2 without TLS besides HTTP/1.1, so producers can multiplex requests on a
+	// connection.
+	EnableH2C bool `yaml:"enable_h2c"`
+	// MaxConcurrentStreams limits streams of each HTTP/2 connection, 0 is 250.
+	MaxConcurrentStreams uint32 `yaml:"max_concurrent_streams"`
+}
+
+func (c HTTPConfig) NewServer(handler http.Handler) *http.Server {
+	readHeaderTimeout := c.ReadHeaderTimeout
+	if readHeaderTimeout == 0 {
+		readHeaderTimeout = defaultReadHeaderTimeout
+	}
+	if c.EnableH2C {
+		handler = h2c.NewHandler(handler, &http2.Server{
+			MaxConcurrentStreams: c.MaxConcurrentStreams,
+			IdleTimeout:          c.IdleTimeout,
+		})
+	}
+	srv := &http.Server{
+		Handler:           handler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		IdleTimeout:       c.IdleTimeout,
+	}
+	srv.SetKeepAlivesEnabled(!c.DisableKeepAlive)
+	return srv
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestGRPCConfig_ServerOptions(t *testing.T) {
+	Convey("test grpc server options", t, func() {
+		So(GRPCConfig{}.ServerOptions(), ShouldHaveLength, 1)
+
+		c := GRPCConfig{
+			KeepaliveTime:        time.Minute,
+			MinPingInterval:      10 * time.Second,
+			MaxConcurrentStreams: 1000,
+		}
+		So(c.ServerOptions(), ShouldHaveLength, 3)
+	})
+}
+
+func TestHTTPConfig_NewServer(t *testing.T) {
+	Convey("test new http server", t, func() {
+		h := http.NotFoundHandler()
+		srv := HTTPConfig{}.NewServer(h)
+		So(srv.ReadHeaderTimeout, ShouldEqual, defaultReadHeaderTimeout)
+		So(srv.IdleTimeout, ShouldEqual, 0)
+
+		srv = HTTPConfig{
+			ReadHeaderTimeout: time.Second,
+			IdleTimeout:       time.Minute,
+			EnableH2C:         true,
+		}.NewServer(h)
+		So(srv.ReadHeaderTimeout, ShouldEqual, time.Second)
+		So(srv.IdleTimeout, ShouldEqual, time.Minute)
+		// The handler is wrapped to serve h2c.
+		_, ok := srv.Handler.(http.HandlerFunc)
+		So(ok, ShouldBeFalse)
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Listen announces on the port, connections accepted by the listener are recorded in metrics labeled by
+// server.
+func Listen(server string, port int) (net.Listener, error) {
+	ls, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, err
+	}
+	return NewListener(ls, server), nil
+}
+
+func NewListener(ls net.Listener, server string) net.Listener {
+	return &listener{
+		Listener: ls,
+		open:     metrics.GatewayConnectionGaugeVec.WithLabelValues(server),
+		accepted: metrics.GatewayConnectionAcceptedCounterVec.WithLabelValues(server),
+		duration: metrics.GatewayConnectionDurationHistogramVec.WithLabelValues(server),
+		in:       metrics.GatewayConnectionByteCounterVec.WithLabelValues(server, metrics.LabelValueDirectionIn),
+		out:      metrics.GatewayConnectionByteCounterVec.WithLabelValues(server, metrics.LabelValueDirectionOut),
+	}
+}
+
+type listener struct {
+	net.Listener
+	open     prometheus.Gauge
+	accepted prometheus.Counter
+	duration prometheus.Observer
+	in       prometheus.Counter
+	out      prometheus.Counter
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	c, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	l.accepted.Inc()
+	l.open.Inc()
+	return &conn{Conn: c, ls: l, start: time.Now()}, nil
+}
+
+type conn struct {
+	net.Conn
+	ls    *listener
+	start time.Time
+	once  sync.Once
+}
+
+func (c *conn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	if n > 0 {
+		c.ls.in.Add(float64(n))
+	}
+	return n, err
+}
+
+func (c *conn) Write(b []byte) (int, error) {
+	n, err := c.Conn.Write(b)
+	if n > 0 {
+		c.ls.out.Add(float64(n))
+	}
+	return n, err
+}
+
+func (c *conn) Close() error {
+	c.once.Do(func() {
+		c.ls.open.Dec()
+		c.ls.duration.Observe(time.Since(c.start).Seconds())
+	})
+	return c.Conn.Close()
+}
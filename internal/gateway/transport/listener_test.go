@@ -0,0 +1,59 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package transport
+
+import (
+	"net"
+	"testing"
+
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestListener(t *testing.T) {
+	Convey("test connection metrics", t, func() {
+		ls, err := Listen("ut", 0)
+		So(err, ShouldBeNil)
+		defer ls.Close()
+
+		open := metrics.GatewayConnectionGaugeVec.WithLabelValues("ut")
+		accepted := metrics.GatewayConnectionAcceptedCounterVec.WithLabelValues("ut")
+		in := metrics.GatewayConnectionByteCounterVec.WithLabelValues("ut", metrics.LabelValueDirectionIn)
+		out := metrics.GatewayConnectionByteCounterVec.WithLabelValues("ut", metrics.LabelValueDirectionOut)
+
+		cc, err := net.Dial("tcp", ls.Addr().String())
+		So(err, ShouldBeNil)
+		defer cc.Close()
+		sc, err := ls.Accept()
+		So(err, ShouldBeNil)
+		So(testutil.ToFloat64(accepted), ShouldEqual, 1)
+		So(testutil.ToFloat64(open), ShouldEqual, 1)
+
+		_, err = cc.Write([]byte("ping"))
+		So(err, ShouldBeNil)
+		buf := make([]byte, 4)
+		_, err = sc.Read(buf)
+		So(err, ShouldBeNil)
+		_, err = sc.Write([]byte("pong!"))
+		So(err, ShouldBeNil)
+		So(testutil.ToFloat64(in), ShouldEqual, 4)
+		So(testutil.ToFloat64(out), ShouldEqual, 5)
+
+		So(sc.Close(), ShouldBeNil)
+		_ = sc.Close()
+		So(testutil.ToFloat64(open), ShouldEqual, 0)
+	})
+}
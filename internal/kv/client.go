@@ -31,6 +31,8 @@ type Client interface {
 	Get(ctx context.Context, key string) ([]byte, error)
 	Create(ctx context.Context, key string, value []byte) error
 	Set(ctx context.Context, key string, value []byte) error
+	// BatchSet sets values of pairs, it isn't atomic if there are too many pairs to be put in a transaction.
+	BatchSet(ctx context.Context, pairs []Pair) error
 	Update(ctx context.Context, key string, value []byte) error
 	Exists(ctx context.Context, key string) (bool, error)
 	SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error
@@ -29,6 +29,11 @@ const (
 	dialTimeout          = 5 * time.Second
 	dialKeepAliveTime    = 1 * time.Second
 	dialKeepAliveTimeout = 3 * time.Second
+	// maxTxnOps is the default limit of operations in a transaction of etcd.
+	maxTxnOps = 128
+	// slowOperationThreshold is far beyond the latency of a healthy etcd, operations slower than it gate
+	// block allocation and offset commits noticeably.
+	slowOperationThreshold = 200 * time.Millisecond
 )
 
 type etcdClient3 struct {
@@ -46,7 +51,7 @@ func NewEtcdClientV3(endpoints []string, keyPrefix string) (kvdef.Client, error)
 	if err != nil {
 		return nil, err
 	}
-	return &etcdClient3{client: client, keyPrefix: keyPrefix}, nil
+	return kvdef.NewInstrumentedClient(&etcdClient3{client: client, keyPrefix: keyPrefix}, slowOperationThreshold), nil
 }
 
 func (c *etcdClient3) Get(ctx context.Context, key string) ([]byte, error) {
@@ -54,7 +59,11 @@ func (c *etcdClient3) Get(ctx context.Context, key string) ([]byte, error) {
 	log.Debug(ctx, "call etcd exists", map[string]interface{}{
 		"key": key,
 	})
-	resp, err := c.client.Get(ctx, key)
+	var resp *v3client.GetResponse
+	err := idempotent.Do(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.client.Get(ctx, key)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -90,8 +99,34 @@ func (c *etcdClient3) Set(ctx context.Context, key string, value []byte) error {
 	log.Debug(ctx, "call etcd exists", map[string]interface{}{
 		"key": key,
 	})
-	_, err := c.client.Put(ctx, key, string(value))
-	return err
+	return idempotent.Do(ctx, func(ctx context.Context) error {
+		_, err := c.client.Put(ctx, key, string(value))
+		return err
+	})
+}
+
+func (c *etcdClient3) BatchSet(ctx context.Context, pairs []kvdef.Pair) error {
+	log.Debug(ctx, "call etcd batch set", map[string]interface{}{
+		"count": len(pairs),
+	})
+	for start := 0; start < len(pairs); start += maxTxnOps {
+		end := start + maxTxnOps
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+		ops := make([]v3client.Op, 0, end-start)
+		for _, pair := range pairs[start:end] {
+			ops = append(ops, v3client.OpPut(path.Join(c.keyPrefix, pair.Key), string(pair.Value)))
+		}
+		err := idempotent.Do(ctx, func(ctx context.Context) error {
+			_, err := c.client.Txn(ctx).Then(ops...).Commit()
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *etcdClient3) Update(ctx context.Context, key string, value []byte) error {
@@ -121,7 +156,11 @@ func (c *etcdClient3) Exists(ctx context.Context, key string) (bool, error) {
 	log.Debug(ctx, "call etcd exists", map[string]interface{}{
 		"key": key,
 	})
-	resp, err := c.client.Get(ctx, key)
+	var resp *v3client.GetResponse
+	err := idempotent.Do(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.client.Get(ctx, key)
+		return err
+	})
 	if err != nil {
 		return false, err
 	}
@@ -130,18 +169,24 @@ func (c *etcdClient3) Exists(ctx context.Context, key string) (bool, error) {
 
 func (c *etcdClient3) SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
 	key = path.Join(c.keyPrefix, key)
-	resp, err := c.client.Grant(ctx, ttl.Nanoseconds()/int64(time.Second))
-	if err != nil {
+	// A lease granted by a failed attempt isn't revoked, it expires after ttl anyway.
+	return idempotent.Do(ctx, func(ctx context.Context) error {
+		resp, err := c.client.Grant(ctx, ttl.Nanoseconds()/int64(time.Second))
+		if err != nil {
+			return err
+		}
+		_, err = c.client.Put(ctx, key, string(value), v3client.WithLease(resp.ID))
 		return err
-	}
-	leaseID := resp.ID
-	_, err = c.client.Put(ctx, key, string(value), v3client.WithLease(leaseID))
-	return err
+	})
 }
 
 func (c *etcdClient3) Delete(ctx context.Context, key string) error {
 	key = path.Join(c.keyPrefix, key)
-	resp, err := c.client.Delete(ctx, key)
+	var resp *v3client.DeleteResponse
+	err := idempotent.Do(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.client.Delete(ctx, key)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -154,7 +199,11 @@ func (c *etcdClient3) Delete(ctx context.Context, key string) error {
 
 func (c *etcdClient3) DeleteDir(ctx context.Context, key string) error {
 	key = path.Join(c.keyPrefix, key)
-	resp, err := c.client.Delete(ctx, key, v3client.WithPrefix())
+	var resp *v3client.DeleteResponse
+	err := idempotent.Do(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.client.Delete(ctx, key, v3client.WithPrefix())
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -167,7 +216,11 @@ func (c *etcdClient3) DeleteDir(ctx context.Context, key string) error {
 
 func (c *etcdClient3) List(ctx context.Context, key string) ([]kvdef.Pair, error) {
 	key = path.Join(c.keyPrefix, key)
-	resp, err := c.client.Get(ctx, key, v3client.WithPrefix())
+	var resp *v3client.GetResponse
+	err := idempotent.Do(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.client.Get(ctx, key, v3client.WithPrefix())
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +236,11 @@ func (c *etcdClient3) List(ctx context.Context, key string) ([]kvdef.Pair, error
 
 func (c *etcdClient3) ListKey(ctx context.Context, path string) (map[string]struct{}, error) {
 	path = strings.TrimSuffix(path, "/")
-	resp, err := c.client.Get(ctx, path, v3client.WithPrefix(), v3client.WithKeysOnly())
+	var resp *v3client.GetResponse
+	err := idempotent.Do(ctx, func(ctx context.Context) (err error) {
+		resp, err = c.client.Get(ctx, path, v3client.WithPrefix(), v3client.WithKeysOnly())
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
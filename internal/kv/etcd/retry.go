@@ -0,0 +1,45 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcd
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/retry"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// idempotent retries operations which have the same result when they're applied twice, e.g. reads, puts and
+// deletes. Conditional operations like Create and CompareAndSwap aren't retried, a retry of them which was
+// applied would fail with a misleading error.
+var idempotent = &retry.Operation{
+	Name: "kv",
+	Policy: retry.Policy{
+		MaxAttempts:    3,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	},
+	Retryable: retry.IsTransient,
+	Budget:    retry.NewBudget(20, 0.1),
+	OnRetry: func(ctx context.Context, attempt int, err error) {
+		log.Info(ctx, "kv operation failed, retry it", map[string]interface{}{
+			log.KeyError: err,
+			"attempt":    attempt,
+		})
+	},
+}
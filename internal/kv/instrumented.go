@@ -0,0 +1,201 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+const (
+	opGet              = "get"
+	opCreate           = "create"
+	opSet              = "set"
+	opBatchSet         = "batch_set"
+	opUpdate           = "update"
+	opExists           = "exists"
+	opSetWithTTL       = "set_with_ttl"
+	opDelete           = "delete"
+	opDeleteDir        = "delete_dir"
+	opList             = "list"
+	opCompareAndSwap   = "compare_and_swap"
+	opCompareAndDelete = "compare_and_delete"
+
+	// maxPrefixDepth keeps the cardinality of the prefix label bounded, keys are named like
+	// /vanus/internal/resource/<kind>/<id>.
+	maxPrefixDepth = 4
+)
+
+// instrumentedClient observes latencies, results and payload sizes of operations by key prefix, and logs
+// operations slower than slowThreshold. Watches are passed through, they last as long as the caller wants.
+type instrumentedClient struct {
+	Client
+	slowThreshold time.Duration
+}
+
+// NewInstrumentedClient wraps c, slow operations aren't logged if slowThreshold isn't positive.
+func NewInstrumentedClient(c Client, slowThreshold time.Duration) Client {
+	return &instrumentedClient{Client: c, slowThreshold: slowThreshold}
+}
+
+func (c *instrumentedClient) Get(ctx context.Context, key string) ([]byte, error) {
+	start := time.Now()
+	value, err := c.Client.Get(ctx, key)
+	c.observe(ctx, opGet, key, len(value), start, err)
+	return value, err
+}
+
+func (c *instrumentedClient) Create(ctx context.Context, key string, value []byte) error {
+	start := time.Now()
+	err := c.Client.Create(ctx, key, value)
+	c.observe(ctx, opCreate, key, len(value), start, err)
+	return err
+}
+
+func (c *instrumentedClient) Set(ctx context.Context, key string, value []byte) error {
+	start := time.Now()
+	err := c.Client.Set(ctx, key, value)
+	c.observe(ctx, opSet, key, len(value), start, err)
+	return err
+}
+
+// BatchSet is observed by the prefix of the first pair.
+func (c *instrumentedClient) BatchSet(ctx context.Context, pairs []Pair) error {
+	start := time.Now()
+	err := c.Client.BatchSet(ctx, pairs)
+	if len(pairs) == 0 {
+		return err
+	}
+	size := 0
+	for _, pair := range pairs {
+		size += len(pair.Value)
+	}
+	c.observe(ctx, opBatchSet, pairs[0].Key, size, start, err)
+	return err
+}
+
+func (c *instrumentedClient) Update(ctx context.Context, key string, value []byte) error {
+	start := time.Now()
+	err := c.Client.Update(ctx, key, value)
+	c.observe(ctx, opUpdate, key, len(value), start, err)
+	return err
+}
+
+func (c *instrumentedClient) Exists(ctx context.Context, key string) (bool, error) {
+	start := time.Now()
+	exists, err := c.Client.Exists(ctx, key)
+	c.observe(ctx, opExists, key, 0, start, err)
+	return exists, err
+}
+
+func (c *instrumentedClient) SetWithTTL(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	start := time.Now()
+	err := c.Client.SetWithTTL(ctx, key, value, ttl)
+	c.observe(ctx, opSetWithTTL, key, len(value), start, err)
+	return err
+}
+
+func (c *instrumentedClient) Delete(ctx context.Context, key string) error {
+	start := time.Now()
+	err := c.Client.Delete(ctx, key)
+	c.observe(ctx, opDelete, key, 0, start, err)
+	return err
+}
+
+func (c *instrumentedClient) DeleteDir(ctx context.Context, path string) error {
+	start := time.Now()
+	err := c.Client.DeleteDir(ctx, path)
+	c.observe(ctx, opDeleteDir, path, 0, start, err)
+	return err
+}
+
+func (c *instrumentedClient) List(ctx context.Context, path string) ([]Pair, error) {
+	start := time.Now()
+	pairs, err := c.Client.List(ctx, path)
+	size := 0
+	for _, pair := range pairs {
+		size += len(pair.Value)
+	}
+	c.observe(ctx, opList, path, size, start, err)
+	return pairs, err
+}
+
+func (c *instrumentedClient) CompareAndSwap(ctx context.Context, key string, preValue, value []byte) error {
+	start := time.Now()
+	err := c.Client.CompareAndSwap(ctx, key, preValue, value)
+	c.observe(ctx, opCompareAndSwap, key, len(value), start, err)
+	return err
+}
+
+func (c *instrumentedClient) CompareAndDelete(ctx context.Context, key string, preValue []byte) error {
+	start := time.Now()
+	err := c.Client.CompareAndDelete(ctx, key, preValue)
+	c.observe(ctx, opCompareAndDelete, key, 0, start, err)
+	return err
+}
+
+func (c *instrumentedClient) observe(ctx context.Context, op, key string, size int, start time.Time, err error) {
+	elapsed := time.Since(start)
+	prefix := prefixOf(key)
+	metrics.KVOperationLatencyHistogramVec.WithLabelValues(op, prefix).Observe(elapsed.Seconds())
+	metrics.KVOperationCounterVec.WithLabelValues(op, prefix, resultOf(err)).Inc()
+	if size > 0 {
+		metrics.KVPayloadBytesHistogramVec.WithLabelValues(op, prefix).Observe(float64(size))
+	}
+	if c.slowThreshold > 0 && elapsed >= c.slowThreshold {
+		log.Warning(ctx, "slow kv operation", map[string]interface{}{
+			log.KeyError: err,
+			"operation":  op,
+			"key":        key,
+			"size":       size,
+			"elapsed":    elapsed,
+		})
+	}
+}
+
+// resultOf tells failures of the store from rejections by conditions of the operation, which are expected
+// by callers.
+func resultOf(err error) string {
+	switch {
+	case err == nil:
+		return metrics.LabelValueRequestSuccess
+	case errors.Is(err, ErrKeyNotFound), errors.Is(err, ErrNodeExist), errors.Is(err, ErrSetFailed):
+		return metrics.LabelValueKVRejected
+	}
+	return metrics.LabelValueRequestFail
+}
+
+// prefixOf returns leading segments of key before the first one looks like an ID, i.e. contains a digit,
+// and at most maxPrefixDepth segments.
+func prefixOf(key string) string {
+	segments := strings.Split(strings.Trim(key, "/"), "/")
+	n := 0
+	for n < len(segments) && n < maxPrefixDepth && segments[n] != "" {
+		if strings.IndexFunc(segments[n], isDigit) >= 0 {
+			break
+		}
+		n++
+	}
+	return "/" + strings.Join(segments[:n], "/")
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPrefixOf(t *testing.T) {
+	Convey("test prefix of keys", t, func() {
+		So(prefixOf("/vanus/internal/resource/eventbus/my-bus"), ShouldEqual, "/vanus/internal/resource/eventbus")
+		So(prefixOf("/vanus/internal/resource/volume/block/0000000000000a1b"), ShouldEqual,
+			"/vanus/internal/resource/volume")
+		So(prefixOf("/trigger/offsets/0000000000000a1b/0000000000000c2d"), ShouldEqual, "/trigger/offsets")
+		So(prefixOf("/vanus/internal/cluster/start_at"), ShouldEqual, "/vanus/internal/cluster/start_at")
+		So(prefixOf("/trigger/subscriptions/"), ShouldEqual, "/trigger/subscriptions")
+		So(prefixOf(""), ShouldEqual, "/")
+	})
+}
+
+func TestInstrumentedClient(t *testing.T) {
+	Convey("test instrumented client", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+		mc := NewMockClient(ctrl)
+		c := NewInstrumentedClient(mc, 0)
+
+		mc.EXPECT().Get(ctx, "/trigger/secret/a").Return([]byte("value"), nil)
+		value, err := c.Get(ctx, "/trigger/secret/a")
+		So(err, ShouldBeNil)
+		So(string(value), ShouldEqual, "value")
+
+		mc.EXPECT().Create(ctx, "/trigger/secret/a", []byte("value")).Return(ErrNodeExist)
+		err = c.Create(ctx, "/trigger/secret/a", []byte("value"))
+		So(err, ShouldEqual, ErrNodeExist)
+		So(resultOf(err), ShouldEqual, "rejected")
+
+		mc.EXPECT().BatchSet(ctx, nil).Return(nil)
+		So(c.BatchSet(ctx, nil), ShouldBeNil)
+	})
+}
@@ -35,6 +35,20 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
+// BatchSet mocks base method.
+func (m *MockClient) BatchSet(ctx context.Context, pairs []Pair) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchSet", ctx, pairs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BatchSet indicates an expected call of BatchSet.
+func (mr *MockClientMockRecorder) BatchSet(ctx, pairs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchSet", reflect.TypeOf((*MockClient)(nil).BatchSet), ctx, pairs)
+}
+
 // Close mocks base method.
 func (m *MockClient) Close() error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,57 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package primitive
+
+import (
+	"fmt"
+	"regexp"
+)
+
+const (
+	maxAnnotationNumber      = 32
+	maxAnnotationKeyLength   = 128
+	maxAnnotationValueLength = 1024
+)
+
+var annotationKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_./-]*[a-zA-Z0-9])?$`)
+
+// ApplyAnnotations returns annotations which are updated by set and removed, annotations is left unchanged.
+// Values are free text, but keys are limited to alphanumerics, '-', '_', '.' and '/', so they are easy to
+// type in a command line.
+func ApplyAnnotations(annotations, set map[string]string, removed []string) (map[string]string, error) {
+	result := make(map[string]string, len(annotations)+len(set))
+	for k, v := range annotations {
+		result[k] = v
+	}
+	for _, k := range removed {
+		delete(result, k)
+	}
+	for k, v := range set {
+		if len(k) > maxAnnotationKeyLength || !annotationKeyRegexp.MatchString(k) {
+			return nil, fmt.Errorf("invalid annotation key: %q", k)
+		}
+		if len(v) > maxAnnotationValueLength {
+			return nil, fmt.Errorf("the value of annotation %s exceeds %d bytes", k, maxAnnotationValueLength)
+		}
+		result[k] = v
+	}
+	if len(result) > maxAnnotationNumber {
+		return nil, fmt.Errorf("the number of annotations exceeds %d", maxAnnotationNumber)
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
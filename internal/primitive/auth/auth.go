@@ -0,0 +1,104 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+const (
+	// Header is the HTTP header or gRPC metadata key which carries the token.
+	Header       = "authorization"
+	bearerPrefix = "bearer "
+	// PrincipalHeader is the gRPC metadata key which forwards the authenticated principal to the controller,
+	// it's only trusted along with SignatureHeader.
+	PrincipalHeader = "x-vanus-principal"
+	// RolesHeader carries roles of the forwarded principal, one value for each role.
+	RolesHeader = "x-vanus-roles"
+
+	ProviderStatic   = "static"
+	ProviderOIDC     = "oidc"
+	ProviderExternal = "external"
+)
+
+// Principal is the identity which a token is issued to.
+type Principal struct {
+	Name  string
+	Roles []string
+}
+
+// HasRole reports whether the principal is granted role.
+func (p *Principal) HasRole(role string) bool {
+	for _, r := range p.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Provider validates tokens carried by requests. It returns ErrUnauthenticated if the token is invalid.
+type Provider interface {
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+type Config struct {
+	// Provider is one of static, oidc or external, authentication is disabled if it's empty.
+	Provider string         `yaml:"provider"`
+	Static   StaticConfig   `yaml:"static"`
+	OIDC     OIDCConfig     `yaml:"oidc"`
+	External ExternalConfig `yaml:"external"`
+	// Forward signs principals forwarded to controllers, it must be the same as the one of controllers.
+	Forward ForwardConfig `yaml:"forward"`
+}
+
+// NewProvider returns nil if authentication is disabled.
+func NewProvider(cfg Config) (Provider, error) {
+	switch cfg.Provider {
+	case "":
+		return nil, nil
+	case ProviderStatic:
+		return NewStaticProvider(cfg.Static)
+	case ProviderOIDC:
+		return NewOIDCProvider(cfg.OIDC)
+	case ProviderExternal:
+		return NewExternalProvider(cfg.External)
+	}
+	return nil, fmt.Errorf("unknown auth provider: %s", cfg.Provider)
+}
+
+// TokenFromHeader extracts the token from the value of Header, the bearer scheme is optional.
+func TokenFromHeader(v string) string {
+	v = strings.TrimSpace(v)
+	if len(v) >= len(bearerPrefix) && strings.EqualFold(v[:len(bearerPrefix)], bearerPrefix) {
+		v = strings.TrimSpace(v[len(bearerPrefix):])
+	}
+	return v
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns nil if the request is neither authenticated by this service nor forwarded
+// with a valid signature.
+func PrincipalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	authpb "github.com/linkall-labs/vanus/proto/pkg/auth"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTokenFromHeader(t *testing.T) {
+	Convey("test token from header", t, func() {
+		So(TokenFromHeader("Bearer abc"), ShouldEqual, "abc")
+		So(TokenFromHeader("bearer  abc "), ShouldEqual, "abc")
+		So(TokenFromHeader("abc"), ShouldEqual, "abc")
+		So(TokenFromHeader(""), ShouldEqual, "")
+	})
+}
+
+func TestStaticProvider(t *testing.T) {
+	Convey("test static provider", t, func() {
+		ctx := context.Background()
+		file := filepath.Join(t.TempDir(), "tokens.yaml")
+		So(os.WriteFile(file, []byte(`
+tokens:
+  - token: t1
+    principal: alice
+    roles: ["admin"]
+`), 0o600), ShouldBeNil)
+		p, err := NewStaticProvider(StaticConfig{File: file})
+		So(err, ShouldBeNil)
+
+		principal, err := p.Authenticate(ctx, "t1")
+		So(err, ShouldBeNil)
+		So(principal.Name, ShouldEqual, "alice")
+		So(principal.HasRole("admin"), ShouldBeTrue)
+
+		_, err = p.Authenticate(ctx, "t2")
+		So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		_, err = p.Authenticate(ctx, "")
+		So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+		Convey("reload the modified file", func() {
+			So(os.WriteFile(file, []byte("tokens: [{token: t2, principal: bob}]"), 0o600), ShouldBeNil)
+			later := time.Now().Add(time.Second)
+			So(os.Chtimes(file, later, later), ShouldBeNil)
+			sp, _ := p.(*staticProvider)
+			sp.checkedAt = time.Time{}
+
+			principal, err = p.Authenticate(ctx, "t2")
+			So(err, ShouldBeNil)
+			So(principal.Name, ShouldEqual, "bob")
+			_, err = p.Authenticate(ctx, "t1")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("reject the file without principal", func() {
+			So(os.WriteFile(file, []byte("tokens: [{token: t3}]"), 0o600), ShouldBeNil)
+			_, err = NewStaticProvider(StaticConfig{File: file})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+type fakeAuthClient struct {
+	resp *authpb.AuthenticateResponse
+	err  error
+}
+
+func (c *fakeAuthClient) Authenticate(ctx context.Context, in *authpb.AuthenticateRequest,
+	opts ...grpc.CallOption) (*authpb.AuthenticateResponse, error) {
+	return c.resp, c.err
+}
+
+func TestExternalProvider(t *testing.T) {
+	Convey("test external provider", t, func() {
+		ctx := context.Background()
+		client := &fakeAuthClient{}
+		p := newExternalProvider(client, time.Second)
+
+		client.resp = &authpb.AuthenticateResponse{Authenticated: true, Principal: "alice", Roles: []string{"dev"}}
+		principal, err := p.Authenticate(ctx, "t1")
+		So(err, ShouldBeNil)
+		So(principal.Name, ShouldEqual, "alice")
+		So(principal.Roles, ShouldResemble, []string{"dev"})
+
+		client.resp = &authpb.AuthenticateResponse{Reason: "revoked"}
+		_, err = p.Authenticate(ctx, "t1")
+		So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		So(err.Error(), ShouldContainSubstring, "revoked")
+
+		client.resp, client.err = nil, context.DeadlineExceeded
+		_, err = p.Authenticate(ctx, "t1")
+		So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+	})
+}
+
+func TestTLSConfig(t *testing.T) {
+	Convey("test tls config of external provider", t, func() {
+		creds, err := TLSConfig{}.credentials()
+		So(err, ShouldBeNil)
+		So(creds.Info().SecurityProtocol, ShouldEqual, "insecure")
+
+		creds, err = TLSConfig{Enable: true}.credentials()
+		So(err, ShouldBeNil)
+		So(creds.Info().SecurityProtocol, ShouldEqual, "tls")
+
+		dir := t.TempDir()
+		_, err = TLSConfig{Enable: true, CAFile: filepath.Join(dir, "none.pem")}.credentials()
+		So(err, ShouldNotBeNil)
+		file := filepath.Join(dir, "ca.pem")
+		So(os.WriteFile(file, []byte("not a certificate"), 0o600), ShouldBeNil)
+		_, err = TLSConfig{Enable: true, CAFile: file}.credentials()
+		So(err, ShouldNotBeNil)
+		_, err = TLSConfig{Enable: true, CertFile: file, KeyFile: file}.credentials()
+		So(err, ShouldNotBeNil)
+	})
+}
+
+type fakeProvider struct{}
+
+func (fakeProvider) Authenticate(_ context.Context, token string) (*Principal, error) {
+	if token != "t1" {
+		return nil, errors.ErrUnauthenticated
+	}
+	return &Principal{Name: "alice"}, nil
+}
+
+func TestHandler(t *testing.T) {
+	Convey("test http handler", t, func() {
+		var principal *Principal
+		h := Handler(fakeProvider{}, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal = PrincipalFromContext(r.Context())
+		}))
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/gateway/bus", nil))
+		So(w.Code, ShouldEqual, http.StatusUnauthorized)
+		So(w.Header().Get("WWW-Authenticate"), ShouldEqual, "Bearer")
+		So(principal, ShouldBeNil)
+
+		w = httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "/gateway/bus", nil)
+		r.Header.Set("Authorization", "Bearer t1")
+		h.ServeHTTP(w, r)
+		So(w.Code, ShouldEqual, http.StatusOK)
+		So(principal.Name, ShouldEqual, "alice")
+	})
+}
+
+func TestForwardConfig(t *testing.T) {
+	Convey("test forward config", t, func() {
+		now := time.Now()
+		cfg := ForwardConfig{Secret: "s3cret"}
+		md := metadata.Pairs(cfg.ForwardHeaders(&Principal{Name: "alice", Roles: []string{"admin", "ops"}}, now)...)
+
+		p, err := cfg.Verify(md, now.Add(time.Minute))
+		So(err, ShouldBeNil)
+		So(p.Name, ShouldEqual, "alice")
+		So(p.Roles, ShouldResemble, []string{"admin", "ops"})
+
+		Convey("ignore principals if disabled", func() {
+			p, err = ForwardConfig{}.Verify(md, now)
+			So(err, ShouldBeNil)
+			So(p, ShouldBeNil)
+			p, err = cfg.Verify(metadata.MD{}, now)
+			So(err, ShouldBeNil)
+			So(p, ShouldBeNil)
+		})
+
+		Convey("reject unsigned or tampered principals", func() {
+			_, err = cfg.Verify(metadata.Pairs(PrincipalHeader, "alice", RolesHeader, "admin"), now)
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			tampered := md.Copy()
+			tampered.Append(RolesHeader, "root")
+			_, err = cfg.Verify(tampered, now)
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			_, err = ForwardConfig{Secret: "other"}.Verify(md, now)
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		})
+
+		Convey("reject expired signatures", func() {
+			_, err = cfg.Verify(md, now.Add(forwardMaxAge+time.Minute))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	authpb "github.com/linkall-labs/vanus/proto/pkg/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const defaultExternalTimeout = 3 * time.Second
+
+type ExternalConfig struct {
+	// Address is the endpoint of the gRPC service linkall.vanus.auth.AuthProvider.
+	Address string        `yaml:"address"`
+	Timeout time.Duration `yaml:"timeout"`
+	TLS     TLSConfig     `yaml:"tls"`
+}
+
+// TLSConfig secures the connection to the provider, which carries tokens.
+type TLSConfig struct {
+	Enable bool `yaml:"enable"`
+	// CAFile verifies the certificate of the provider, system roots are used if it's empty.
+	CAFile string `yaml:"ca_file"`
+	// CertFile and KeyFile are presented to the provider if it requires client certificates.
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+	// ServerName overrides the host of address which the certificate is verified against.
+	ServerName string `yaml:"server_name"`
+}
+
+func (c TLSConfig) credentials() (credentials.TransportCredentials, error) {
+	if !c.Enable {
+		return insecure.NewCredentials(), nil
+	}
+	cfg := &tls.Config{ServerName: c.ServerName, MinVersion: tls.VersionTLS12}
+	if c.CAFile != "" {
+		data, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate in %s", c.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// externalProvider delegates authentication to an identity system implementing the AuthProvider service.
+type externalProvider struct {
+	client  authpb.AuthProviderClient
+	timeout time.Duration
+}
+
+func NewExternalProvider(cfg ExternalConfig) (Provider, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("the address of external auth provider is required")
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultExternalTimeout
+	}
+	creds, err := cfg.TLS.credentials()
+	if err != nil {
+		return nil, fmt.Errorf("load tls config of external auth provider failed: %w", err)
+	}
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return newExternalProvider(authpb.NewAuthProviderClient(conn), cfg.Timeout), nil
+}
+
+func newExternalProvider(client authpb.AuthProviderClient, timeout time.Duration) *externalProvider {
+	return &externalProvider{client: client, timeout: timeout}
+}
+
+func (p *externalProvider) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	resp, err := p.client.Authenticate(ctx, &authpb.AuthenticateRequest{Token: token})
+	if err != nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("the external auth provider is unavailable").Wrap(err)
+	}
+	if !resp.Authenticated {
+		reason := resp.Reason
+		if reason == "" {
+			reason = "invalid token"
+		}
+		return nil, errors.ErrUnauthenticated.WithMessage(reason)
+	}
+	if resp.Principal == "" {
+		return nil, errors.ErrUnauthenticated.WithMessage("the external auth provider returns no principal")
+	}
+	return &Principal{Name: resp.Principal, Roles: resp.Roles}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// SignatureHeader carries the HMAC of the forwarded principal, which is signed with the secret shared
+	// by gateways and controllers.
+	SignatureHeader = "x-vanus-principal-signature"
+	// SignedAtHeader is the unix time in seconds when the forwarded principal is signed.
+	SignedAtHeader = "x-vanus-principal-signed-at"
+	// forwardMaxAge limits how long a signature captured from a request can be replayed.
+	forwardMaxAge = 5 * time.Minute
+)
+
+// ForwardConfig is shared by gateways and controllers, controllers only trust principals forwarded with
+// a signature of the secret.
+type ForwardConfig struct {
+	// Secret signs principals forwarded by gateways, principals are neither forwarded nor trusted if it's
+	// empty, and requests are served as anonymous ones.
+	Secret string `yaml:"secret"`
+}
+
+func (c ForwardConfig) Enabled() bool {
+	return c.Secret != ""
+}
+
+// ForwardHeaders returns gRPC metadata pairs which forward the principal to controllers.
+func (c ForwardConfig) ForwardHeaders(p *Principal, now time.Time) []string {
+	signedAt := strconv.FormatInt(now.Unix(), 10)
+	kv := []string{PrincipalHeader, p.Name, SignedAtHeader, signedAt,
+		SignatureHeader, c.sign(p.Name, p.Roles, signedAt)}
+	for _, role := range p.Roles {
+		kv = append(kv, RolesHeader, role)
+	}
+	return kv
+}
+
+// Verify returns the principal forwarded in md, or nil if there is none or forwarding is disabled. It
+// returns ErrUnauthenticated if the signature is invalid or expired.
+func (c ForwardConfig) Verify(md metadata.MD, now time.Time) (*Principal, error) {
+	names := md.Get(PrincipalHeader)
+	if !c.Enabled() || len(names) == 0 {
+		return nil, nil
+	}
+	signedAt, signature := first(md, SignedAtHeader), first(md, SignatureHeader)
+	roles := md.Get(RolesHeader)
+	if len(names) != 1 || signature == "" ||
+		!hmac.Equal([]byte(signature), []byte(c.sign(names[0], roles, signedAt))) {
+		return nil, errors.ErrUnauthenticated.WithMessage("invalid signature of the forwarded principal")
+	}
+	sec, err := strconv.ParseInt(signedAt, 10, 64)
+	if err != nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("invalid signing time of the forwarded principal")
+	}
+	if age := now.Sub(time.Unix(sec, 0)); age > forwardMaxAge || age < -forwardMaxAge {
+		return nil, errors.ErrUnauthenticated.WithMessage("the signature of the forwarded principal is expired")
+	}
+	return &Principal{Name: names[0], Roles: roles}, nil
+}
+
+// sign prefixes each field with its length, so that fields can't be shifted into each other.
+func (c ForwardConfig) sign(name string, roles []string, signedAt string) string {
+	mac := hmac.New(sha256.New, []byte(c.Secret))
+	write := func(s string) {
+		var l [8]byte
+		binary.BigEndian.PutUint64(l[:], uint64(len(s)))
+		mac.Write(l[:])
+		mac.Write([]byte(s))
+	}
+	write(name)
+	write(signedAt)
+	for _, role := range roles {
+		write(role)
+	}
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func first(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
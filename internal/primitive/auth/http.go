@@ -0,0 +1,42 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"net/http"
+
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// Handler authenticates requests before passing them to next, the principal is put into the context of
+// the request. next is returned as is if p is nil.
+func Handler(p Provider, next http.Handler) http.Handler {
+	if p == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := p.Authenticate(r.Context(), TokenFromHeader(r.Header.Get(Header)))
+		if err != nil {
+			log.Debug(r.Context(), "authenticate request failed", map[string]interface{}{
+				log.KeyError: err,
+				"remote":     r.RemoteAddr,
+			})
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+	})
+}
@@ -0,0 +1,378 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	defaultPrincipalClaim = "sub"
+	defaultRolesClaim     = "roles"
+	// clockLeeway tolerates the clock skew between the identity provider and Vanus.
+	clockLeeway = time.Minute
+	// keysRefreshInterval limits fetching keys for unknown key IDs, which may be forged.
+	keysRefreshInterval = time.Minute
+	oidcRequestTimeout  = 10 * time.Second
+)
+
+type OIDCConfig struct {
+	Issuer string `yaml:"issuer"`
+	// Audience is expected in the aud claim, usually it's the client ID. It isn't checked if it's empty.
+	Audience string `yaml:"audience"`
+	// PrincipalClaim is the claim of the principal name, it's sub by default.
+	PrincipalClaim string `yaml:"principal_claim"`
+	// RolesClaim is the claim of roles in a string or a string array, it's roles by default.
+	RolesClaim string `yaml:"roles_claim"`
+}
+
+// oidcProvider validates ID tokens (JWT) signed by an OpenID Connect issuer, keys of the issuer are
+// discovered from its metadata.
+type oidcProvider struct {
+	cfg    OIDCConfig
+	client *http.Client
+
+	mutex     sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+	// fetching is closed once the fetch in flight is done, requests of unknown keys wait for it rather than
+	// fetching again.
+	fetching chan struct{}
+	fetchErr error
+}
+
+func NewOIDCProvider(cfg OIDCConfig) (Provider, error) {
+	if cfg.Issuer == "" {
+		return nil, fmt.Errorf("the issuer of oidc auth provider is required")
+	}
+	if cfg.PrincipalClaim == "" {
+		cfg.PrincipalClaim = defaultPrincipalClaim
+	}
+	if cfg.RolesClaim == "" {
+		cfg.RolesClaim = defaultRolesClaim
+	}
+	return &oidcProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: oidcRequestTimeout},
+	}, nil
+}
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+func (p *oidcProvider) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.ErrUnauthenticated.WithMessage("malformed token")
+	}
+	header := jwtHeader{}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("malformed token header")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("malformed token signature")
+	}
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifySignature(header.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	claims := map[string]interface{}{}
+	if err = decodeSegment(parts[1], &claims); err != nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("malformed token claims")
+	}
+	if err = p.verifyClaims(claims, time.Now()); err != nil {
+		return nil, err
+	}
+	name, _ := claims[p.cfg.PrincipalClaim].(string)
+	if name == "" {
+		return nil, errors.ErrUnauthenticated.WithMessage(
+			fmt.Sprintf("the token has no claim %s", p.cfg.PrincipalClaim))
+	}
+	return &Principal{Name: name, Roles: stringsOf(claims[p.cfg.RolesClaim])}, nil
+}
+
+func (p *oidcProvider) verifyClaims(claims map[string]interface{}, now time.Time) error {
+	if iss, _ := claims["iss"].(string); iss != p.cfg.Issuer {
+		return errors.ErrUnauthenticated.WithMessage("the token isn't issued by the issuer")
+	}
+	if p.cfg.Audience != "" {
+		found := false
+		for _, aud := range stringsOf(claims["aud"]) {
+			if aud == p.cfg.Audience {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.ErrUnauthenticated.WithMessage("the token isn't issued to the audience")
+		}
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok || now.Add(-clockLeeway).After(time.Unix(int64(exp), 0)) {
+		return errors.ErrUnauthenticated.WithMessage("the token is expired")
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(clockLeeway).Before(time.Unix(int64(nbf), 0)) {
+		return errors.ErrUnauthenticated.WithMessage("the token isn't valid yet")
+	}
+	return nil
+}
+
+// key returns the public key by its ID, keys are fetched again if it's unknown, e.g. the issuer rotated
+// its keys. Keys are fetched without holding the mutex, so tokens signed by known keys are verified during
+// the fetch, and only one fetch is in flight.
+func (p *oidcProvider) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mutex.Lock()
+	if k := p.lookup(kid); k != nil {
+		p.mutex.Unlock()
+		return k, nil
+	}
+	fetching := p.fetching
+	if fetching == nil {
+		if time.Since(p.fetchedAt) < keysRefreshInterval {
+			p.mutex.Unlock()
+			return nil, errors.ErrUnauthenticated.WithMessage("unknown key of the token")
+		}
+		fetching = make(chan struct{})
+		p.fetching = fetching
+		go p.refreshKeys(fetching)
+	}
+	p.mutex.Unlock()
+
+	select {
+	case <-fetching:
+	case <-ctx.Done():
+		return nil, errors.ErrUnauthenticated.WithMessage("fetch keys of the issuer failed").Wrap(ctx.Err())
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if k := p.lookup(kid); k != nil {
+		return k, nil
+	}
+	if p.fetchErr != nil {
+		return nil, errors.ErrUnauthenticated.WithMessage("fetch keys of the issuer failed").Wrap(p.fetchErr)
+	}
+	return nil, errors.ErrUnauthenticated.WithMessage("unknown key of the token")
+}
+
+// refreshKeys fetches keys out of requests, a request which gives up waiting doesn't fail others.
+func (p *oidcProvider) refreshKeys(done chan struct{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), oidcRequestTimeout)
+	defer cancel()
+	keys, err := p.fetchKeys(ctx)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.fetchedAt = time.Now()
+	p.fetchErr = err
+	if err == nil {
+		p.keys = keys
+	}
+	p.fetching = nil
+	close(done)
+}
+
+func (p *oidcProvider) lookup(kid string) crypto.PublicKey {
+	if kid == "" && len(p.keys) == 1 {
+		for _, k := range p.keys {
+			return k
+		}
+	}
+	return p.keys[kid]
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (p *oidcProvider) fetchKeys(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	discovery := struct {
+		JWKSURI string `json:"jwks_uri"`
+	}{}
+	wellKnown := strings.TrimSuffix(p.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJSON(ctx, wellKnown, &discovery); err != nil {
+		return nil, err
+	}
+	if discovery.JWKSURI == "" {
+		return nil, fmt.Errorf("no jwks_uri in the metadata of the issuer")
+	}
+	set := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+	if err := p.getJSON(ctx, discovery.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		// Keys of unsupported types are skipped, tokens signed by them are rejected as unknown.
+		if k, err := jwk.publicKey(); err == nil {
+			keys[jwk.Kid] = k
+		}
+	}
+	return keys, nil
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (jwk *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %s", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type: %s", jwk.Kty)
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return errors.ErrUnauthenticated.WithMessage(fmt.Sprintf("unsupported signing algorithm: %s", alg))
+	}
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	ok := false
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		ok = strings.HasPrefix(alg, "RS") && rsa.VerifyPKCS1v15(k, hash, digest, sig) == nil
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if strings.HasPrefix(alg, "ES") && len(sig) == 2*size {
+			r := new(big.Int).SetBytes(sig[:size])
+			s := new(big.Int).SetBytes(sig[size:])
+			ok = ecdsa.Verify(k, digest, r, s)
+		}
+	}
+	if !ok {
+		return errors.ErrUnauthenticated.WithMessage("invalid token signature")
+	}
+	return nil
+}
+
+func decodeSegment(seg string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+// stringsOf converts a claim of a string or a string array.
+func stringsOf(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		if val == "" {
+			return nil
+		}
+		return []string{val}
+	case []interface{}:
+		result := make([]string, 0, len(val))
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func signToken(key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	header, _ := json.Marshal(jwtHeader{Alg: "RS256", Kid: kid})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+	sig, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	return signed + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestOIDCProvider(t *testing.T) {
+	Convey("test oidc provider", t, func() {
+		ctx := context.Background()
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		So(err, ShouldBeNil)
+
+		var srv *httptest.Server
+		var fetched int32
+		// gate holds requests of keys until it's closed.
+		var gate atomic.Value
+		open := make(chan struct{})
+		close(open)
+		gate.Store(open)
+		srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/.well-known/openid-configuration":
+				_ = json.NewEncoder(w).Encode(map[string]string{"jwks_uri": srv.URL + "/keys"})
+			case "/keys":
+				atomic.AddInt32(&fetched, 1)
+				<-gate.Load().(chan struct{})
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{
+					"keys": []jsonWebKey{{
+						Kty: "RSA",
+						Kid: "k1",
+						Use: "sig",
+						N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+						E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+					}},
+				})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer srv.Close()
+
+		p, err := NewOIDCProvider(OIDCConfig{Issuer: srv.URL, Audience: "vanus"})
+		So(err, ShouldBeNil)
+		claims := map[string]interface{}{
+			"iss":   srv.URL,
+			"aud":   []string{"vanus", "other"},
+			"sub":   "alice",
+			"roles": []string{"admin", "dev"},
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		}
+
+		principal, err := p.Authenticate(ctx, signToken(key, "k1", claims))
+		So(err, ShouldBeNil)
+		So(principal.Name, ShouldEqual, "alice")
+		So(principal.Roles, ShouldResemble, []string{"admin", "dev"})
+
+		Convey("reject invalid tokens", func() {
+			claims["exp"] = time.Now().Add(-time.Hour).Unix()
+			_, err = p.Authenticate(ctx, signToken(key, "k1", claims))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			claims["exp"] = time.Now().Add(time.Hour).Unix()
+			claims["aud"] = "other"
+			_, err = p.Authenticate(ctx, signToken(key, "k1", claims))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			claims["aud"] = "vanus"
+			other, _ := rsa.GenerateKey(rand.Reader, 2048)
+			_, err = p.Authenticate(ctx, signToken(other, "k1", claims))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			_, err = p.Authenticate(ctx, "not-a-token")
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		})
+
+		Convey("limit fetching unknown keys", func() {
+			_, err = p.Authenticate(ctx, signToken(key, "k2", claims))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+			_, err = p.Authenticate(ctx, signToken(key, "k2", claims))
+			So(err, ShouldNotBeNil)
+			So(atomic.LoadInt32(&fetched), ShouldEqual, 1)
+		})
+
+		Convey("fetch keys once without blocking known keys", func() {
+			held := make(chan struct{})
+			gate.Store(held)
+			op, _ := p.(*oidcProvider)
+			op.mutex.Lock()
+			op.fetchedAt = time.Time{}
+			op.mutex.Unlock()
+
+			errs := make(chan error, 2)
+			for i := 0; i < 2; i++ {
+				go func() {
+					_, err := p.Authenticate(ctx, signToken(key, "k2", claims))
+					errs <- err
+				}()
+			}
+			for atomic.LoadInt32(&fetched) < 2 {
+				time.Sleep(time.Millisecond)
+			}
+			// known keys are verified during the fetch.
+			principal, err = p.Authenticate(ctx, signToken(key, "k1", claims))
+			So(err, ShouldBeNil)
+			So(principal.Name, ShouldEqual, "alice")
+
+			// the request which gives up waiting doesn't cancel the fetch.
+			cctx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, err = p.Authenticate(cctx, signToken(key, "k2", claims))
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			close(held)
+			So(errors.Is(<-errs, errors.ErrUnauthenticated), ShouldBeTrue)
+			So(errors.Is(<-errs, errors.ErrUnauthenticated), ShouldBeTrue)
+			So(atomic.LoadInt32(&fetched), ShouldEqual, 2)
+		})
+	})
+}
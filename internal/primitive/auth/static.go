@@ -0,0 +1,131 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const staticReloadInterval = 10 * time.Second
+
+type StaticConfig struct {
+	// File lists tokens, e.g.
+	//   tokens:
+	//     - token: "<token>"
+	//       principal: alice
+	//       roles: ["admin"]
+	File string `yaml:"file"`
+}
+
+type staticToken struct {
+	Token     string   `yaml:"token"`
+	Principal string   `yaml:"principal"`
+	Roles     []string `yaml:"roles"`
+}
+
+// staticProvider authenticates tokens listed in a file, which is reloaded once it's modified.
+type staticProvider struct {
+	file string
+
+	mutex     sync.RWMutex
+	tokens    map[[sha256.Size]byte]*Principal
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+func NewStaticProvider(cfg StaticConfig) (Provider, error) {
+	if cfg.File == "" {
+		return nil, fmt.Errorf("the token file of static auth provider is required")
+	}
+	p := &staticProvider{file: cfg.File}
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *staticProvider) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	p.reloadIfModified(ctx)
+
+	// Tokens are looked up by their digests, so that the time taken doesn't leak them.
+	digest := sha256.Sum256([]byte(token))
+	p.mutex.RLock()
+	principal, ok := p.tokens[digest]
+	p.mutex.RUnlock()
+	if token == "" || !ok {
+		return nil, errors.ErrUnauthenticated.WithMessage("invalid token")
+	}
+	return principal, nil
+}
+
+func (p *staticProvider) reloadIfModified(ctx context.Context) {
+	now := time.Now()
+	p.mutex.RLock()
+	due := now.Sub(p.checkedAt) >= staticReloadInterval
+	p.mutex.RUnlock()
+	if !due {
+		return
+	}
+	if err := p.load(); err != nil {
+		// Keep tokens loaded before, the file may be being written.
+		log.Warning(ctx, "reload token file failed", map[string]interface{}{
+			log.KeyError: err,
+			"file":       p.file,
+		})
+	}
+}
+
+func (p *staticProvider) load() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.checkedAt = time.Now()
+
+	fi, err := os.Stat(p.file)
+	if err != nil {
+		return err
+	}
+	if p.tokens != nil && fi.ModTime().Equal(p.modTime) {
+		return nil
+	}
+	data, err := os.ReadFile(p.file)
+	if err != nil {
+		return err
+	}
+	list := struct {
+		Tokens []staticToken `yaml:"tokens"`
+	}{}
+	if err = yaml.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	tokens := make(map[[sha256.Size]byte]*Principal, len(list.Tokens))
+	for _, t := range list.Tokens {
+		if t.Token == "" || t.Principal == "" {
+			return fmt.Errorf("both token and principal are required in token file")
+		}
+		tokens[sha256.Sum256([]byte(t.Token))] = &Principal{Name: t.Principal, Roles: t.Roles}
+	}
+	p.tokens = tokens
+	p.modTime = fi.ModTime()
+	return nil
+}
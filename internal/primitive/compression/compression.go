@@ -0,0 +1,161 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/cluster/raw_client"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	None = ""
+	Gzip = gzip.Name
+	Zstd = "zstd"
+
+	// zstdMaxMemory bounds the size of a decompressed message, messages are rejected by gRPC once they
+	// exceed the maximum receive size anyway, it only guards against messages which decompress to huge
+	// sizes.
+	zstdMaxMemory = 256 * 1024 * 1024
+)
+
+// Both compressors are registered once the package is imported, so that servers of every component can
+// decompress requests compressed by either of them, no matter which one clients are configured with.
+func init() {
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest))
+	if err != nil {
+		panic(err)
+	}
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(zstdMaxMemory))
+	if err != nil {
+		panic(err)
+	}
+	encoding.RegisterCompressor(&zstdCompressor{enc: enc, dec: dec})
+}
+
+// Config chooses gRPC compressors of traffic initiated by the component, each one of gzip and zstd, or
+// empty to disable compression. Servers compress responses with the compressor of requests.
+type Config struct {
+	// Control is traffic to controllers, e.g. metadata requests and heartbeats, and traffic from
+	// controllers to stores and trigger workers.
+	Control string `yaml:"control"`
+	// Data is traffic of events, i.e. appends and reads of gateways and trigger workers to stores, and
+	// replication between stores.
+	Data string `yaml:"data"`
+}
+
+func (c Config) Validate() error {
+	if err := validate(c.Control); err != nil {
+		return fmt.Errorf("invalid compressor of control traffic: %w", err)
+	}
+	if err := validate(c.Data); err != nil {
+		return fmt.Errorf("invalid compressor of data traffic: %w", err)
+	}
+	return nil
+}
+
+func validate(name string) error {
+	switch name {
+	case None, Gzip, Zstd:
+		return nil
+	}
+	return fmt.Errorf("unknown compressor: %s", name)
+}
+
+var (
+	control = atomic.NewString(None)
+	data    = atomic.NewString(None)
+)
+
+// Apply sets compressors of connections made after it in this process, including ones made by clients of
+// the cluster and the SDK.
+func Apply(cfg Config) {
+	control.Store(cfg.Control)
+	data.Store(cfg.Data)
+	raw_client.SetCompressor(cfg.Control)
+	eb.SetCompressor(cfg.Data)
+}
+
+// ControlDialOptions returns options of connections of control traffic dialed directly by gRPC.
+func ControlDialOptions() []grpc.DialOption {
+	return dialOptions(metrics.LabelValueTrafficControl, control.Load())
+}
+
+// DataDialOptions returns options of connections of data traffic dialed directly by gRPC.
+func DataDialOptions() []grpc.DialOption {
+	return dialOptions(metrics.LabelValueTrafficData, data.Load())
+}
+
+func dialOptions(traffic, name string) []grpc.DialOption {
+	opts := []grpc.DialOption{
+		grpc.WithStatsHandler(metrics.NewTransportStatsHandler(traffic, name)),
+	}
+	if name != None {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(name)))
+	}
+	return opts
+}
+
+// zstdCompressor compresses each message as a whole, both EncodeAll and DecodeAll are safe to be called
+// concurrently, unlike streams of zstd which hold goroutines until they are closed.
+type zstdCompressor struct {
+	enc *zstd.Encoder
+	dec *zstd.Decoder
+}
+
+func (c *zstdCompressor) Name() string {
+	return Zstd
+}
+
+func (c *zstdCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	return &zstdWriter{enc: c.enc, w: w}, nil
+}
+
+func (c *zstdCompressor) Decompress(r io.Reader) (io.Reader, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	dst, err := c.dec.DecodeAll(src, nil)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(dst), nil
+}
+
+// zstdWriter buffers the message and writes it compressed once it's closed.
+type zstdWriter struct {
+	enc *zstd.Encoder
+	w   io.Writer
+	buf bytes.Buffer
+}
+
+func (w *zstdWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *zstdWriter) Close() error {
+	_, err := w.w.Write(w.enc.EncodeAll(w.buf.Bytes(), nil))
+	return err
+}
@@ -0,0 +1,69 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compression
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/encoding"
+)
+
+func TestZstdCompressor(t *testing.T) {
+	Convey("test zstd compressor", t, func() {
+		c := encoding.GetCompressor(Zstd)
+		So(c, ShouldNotBeNil)
+		So(encoding.GetCompressor(Gzip), ShouldNotBeNil)
+
+		data := bytes.Repeat([]byte("{\"specversion\":\"1.0\",\"type\":\"test\"}"), 1024)
+		buf := bytes.Buffer{}
+		w, err := c.Compress(&buf)
+		So(err, ShouldBeNil)
+		_, err = w.Write(data[:100])
+		So(err, ShouldBeNil)
+		_, err = w.Write(data[100:])
+		So(err, ShouldBeNil)
+		So(buf.Len(), ShouldEqual, 0)
+		So(w.Close(), ShouldBeNil)
+		So(buf.Len(), ShouldBeLessThan, len(data)/10)
+
+		r, err := c.Decompress(&buf)
+		So(err, ShouldBeNil)
+		result, err := io.ReadAll(r)
+		So(err, ShouldBeNil)
+		So(result, ShouldResemble, data)
+
+		_, err = c.Decompress(bytes.NewReader([]byte("not zstd")))
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	Convey("test validate config", t, func() {
+		So(Config{}.Validate(), ShouldBeNil)
+		So(Config{Control: Gzip, Data: Zstd}.Validate(), ShouldBeNil)
+		So(Config{Control: "snappy"}.Validate(), ShouldNotBeNil)
+		So(Config{Data: "lz4"}.Validate(), ShouldNotBeNil)
+	})
+}
+
+func TestDialOptions(t *testing.T) {
+	Convey("test dial options", t, func() {
+		So(dialOptions("data", None), ShouldHaveLength, 1)
+		So(dialOptions("data", Zstd), ShouldHaveLength, 2)
+	})
+}
@@ -19,12 +19,20 @@ const (
 	RetryEventbusName        = "__retry_eb"
 	DeadLetterEventbusName   = "__dl_eb"
 	TimerEventbusName        = "__Timer_RS"
+	MeteringEventbusName     = "__metering_eb"
+	AnomalyEventbusName      = "__anomaly_eb"
+	DataLossEventbusName     = "__data_loss_eb"
+	SLOEventbusName          = "__slo_eb"
 
 	XVanus               = "xvanus"
 	XVanusEventbus       = XVanus + "eventbus"
 	XVanusDeliveryTime   = XVanus + "deliverytime"
 	XVanusRetryAttempts  = XVanus + "retryattempts"
 	XVanusSubscriptionID = XVanus + "subscriptionid"
+	XVanusTenant         = XVanus + "tenant"
+	XVanusTraceParent    = XVanus + "traceparent"
+	// XVanusFirstAttemptTime is the time the first delivery attempt of a retried event failed.
+	XVanusFirstAttemptTime = XVanus + "firstattempttime"
 
 	LastDeliveryTime  = "lastdeliverytime"
 	LastDeliveryError = "lastdeliveryerror"
@@ -0,0 +1,188 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package featuregate gates risky subsystems, so that they're rolled out per cluster without rebuilds.
+// Gates and their defaults are known by the code, the controller stores overrides of them, which are
+// synced to all components.
+package featuregate
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type Feature string
+
+type Stage string
+
+const (
+	// Alpha gates are disabled by default.
+	Alpha Stage = "alpha"
+	// Beta gates are enabled by default, and may be disabled if something goes wrong.
+	Beta Stage = "beta"
+	// GA gates are always enabled, they're kept until the code checking them is removed.
+	GA Stage = "ga"
+)
+
+const (
+	BlockCompression Feature = "BlockCompression"
+	AppendFairness   Feature = "AppendFairness"
+	ShadowPublish    Feature = "ShadowPublish"
+)
+
+type Spec struct {
+	Default     bool
+	Stage       Stage
+	Description string
+}
+
+var known = map[Feature]Spec{
+	BlockCompression: {
+		Default:     true,
+		Stage:       Beta,
+		Description: "compress archived blocks by vsb.compression of stores",
+	},
+	AppendFairness: {
+		Default:     true,
+		Stage:       Beta,
+		Description: "share appends of a store fairly among eventlogs by append_fairness of stores",
+	},
+	ShadowPublish: {
+		Default:     false,
+		Stage:       Alpha,
+		Description: "mirror published events to the shadow cluster by shadow of gateways",
+	},
+}
+
+const defaultSyncInterval = 30 * time.Second
+
+var (
+	mutex     sync.RWMutex
+	overrides = map[Feature]bool{}
+)
+
+// Lookup returns the spec of a gate known by this binary.
+func Lookup(f Feature) (Spec, bool) {
+	spec, ok := known[f]
+	return spec, ok
+}
+
+// Enabled reports whether the gate is enabled in the cluster, unknown gates are disabled.
+func Enabled(f Feature) bool {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return enabledIn(f, overrides)
+}
+
+func enabledIn(f Feature, o map[Feature]bool) bool {
+	spec, ok := known[f]
+	if !ok {
+		return false
+	}
+	if spec.Stage == GA {
+		return true
+	}
+	if enabled, ok := o[f]; ok {
+		return enabled
+	}
+	return spec.Default
+}
+
+// List returns all known gates with their effective states, in order of names.
+func List() []*metapb.FeatureGate {
+	mutex.RLock()
+	defer mutex.RUnlock()
+	return ListOf(overrides)
+}
+
+// ListOf returns all known gates with states by overrides o.
+func ListOf(o map[Feature]bool) []*metapb.FeatureGate {
+	gates := make([]*metapb.FeatureGate, 0, len(known))
+	for f, spec := range known {
+		_, overridden := o[f]
+		gates = append(gates, &metapb.FeatureGate{
+			Name:           string(f),
+			Stage:          string(spec.Stage),
+			DefaultEnabled: spec.Default,
+			Enabled:        enabledIn(f, o),
+			Overridden:     overridden && spec.Stage != GA,
+			Description:    spec.Description,
+		})
+	}
+	sort.Slice(gates, func(i, j int) bool {
+		return gates[i].Name < gates[j].Name
+	})
+	return gates
+}
+
+// Apply replaces overrides by those of the cluster, gates unknown by this binary are ignored.
+func Apply(ctx context.Context, gates []*metapb.FeatureGate) {
+	o := make(map[Feature]bool, len(gates))
+	for _, g := range gates {
+		if _, ok := known[Feature(g.Name)]; ok && g.Overridden {
+			o[Feature(g.Name)] = g.Enabled
+		}
+	}
+
+	mutex.Lock()
+	old := overrides
+	overrides = o
+	mutex.Unlock()
+
+	for f := range known {
+		was, is := enabledIn(f, old), enabledIn(f, o)
+		if was != is {
+			log.Info(ctx, "the feature gate is changed", map[string]interface{}{
+				"feature": f,
+				"enabled": is,
+			})
+		}
+	}
+}
+
+// Sync pulls overrides from the controller periodically until ctx is done, gates keep their states if the
+// controller is unavailable.
+func Sync(ctx context.Context, client ctrlpb.FeatureGateControllerClient) {
+	pull := func() {
+		res, err := client.ListFeatureGates(ctx, &emptypb.Empty{})
+		if err != nil {
+			log.Debug(ctx, "pull feature gates failed", map[string]interface{}{
+				log.KeyError: err,
+			})
+			return
+		}
+		Apply(ctx, res.GetGates())
+	}
+
+	go func() {
+		pull()
+		ticker := time.NewTicker(defaultSyncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				pull()
+			}
+		}
+	}()
+}
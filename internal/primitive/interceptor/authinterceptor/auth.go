@@ -0,0 +1,145 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authinterceptor
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// StreamServerInterceptor authenticates requests of gateways, principals are forwarded to controllers
+// if forward is enabled.
+func StreamServerInterceptor(p auth.Provider, forward auth.ForwardConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context(), p, forward)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func UnaryServerInterceptor(p auth.Provider, forward auth.ForwardConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticate(ctx, p, forward)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func authenticate(ctx context.Context, p auth.Provider, forward auth.ForwardConfig) (context.Context, error) {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(auth.Header); len(v) > 0 {
+			token = auth.TokenFromHeader(v[0])
+		}
+	}
+	principal, err := p.Authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	// forward the principal in requests sent to the controller, which throttles requests and protects
+	// resources by principals. Headers of principals sent by clients are never forwarded, since outgoing
+	// metadata is built from scratch.
+	if forward.Enabled() {
+		ctx = metadata.AppendToOutgoingContext(ctx, forward.ForwardHeaders(principal, time.Now())...)
+	}
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// ForwardedStreamServerInterceptor trusts principals forwarded by gateways only if they are signed with
+// the secret of forward, headers of principals are ignored if forward is disabled.
+func ForwardedStreamServerInterceptor(forward auth.ForwardConfig) grpc.StreamServerInterceptor {
+	return ControllerStreamServerInterceptor(nil, forward)
+}
+
+func ForwardedUnaryServerInterceptor(forward auth.ForwardConfig) grpc.UnaryServerInterceptor {
+	return ControllerUnaryServerInterceptor(nil, forward)
+}
+
+// ControllerStreamServerInterceptor trusts principals forwarded by gateways like
+// ForwardedStreamServerInterceptor, and authenticates tokens sent to controllers directly by p, e.g. by
+// vsctl. Requests without tokens, e.g. of stores and trigger workers, are served as anonymous ones.
+func ControllerStreamServerInterceptor(p auth.Provider, forward auth.ForwardConfig) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		ctx, err := verifyController(stream.Context(), p, forward)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+func ControllerUnaryServerInterceptor(p auth.Provider, forward auth.ForwardConfig) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := verifyController(ctx, p, forward)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func verifyController(ctx context.Context, p auth.Provider, forward auth.ForwardConfig) (context.Context, error) {
+	ctx, err := verifyForwarded(ctx, forward)
+	if err != nil || p == nil || auth.PrincipalFromContext(ctx) != nil {
+		return ctx, err
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	v := md.Get(auth.Header)
+	if len(v) == 0 {
+		return ctx, nil
+	}
+	principal, err := p.Authenticate(ctx, auth.TokenFromHeader(v[0]))
+	if err != nil {
+		return nil, err
+	}
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+func verifyForwarded(ctx context.Context, forward auth.ForwardConfig) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+	principal, err := forward.Verify(md, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if principal == nil {
+		return ctx, nil
+	}
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// serverStream overrides the context of the stream to carry the principal.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
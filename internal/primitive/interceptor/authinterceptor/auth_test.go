@@ -0,0 +1,106 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authinterceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type staticProvider struct{}
+
+func (staticProvider) Authenticate(_ context.Context, token string) (*auth.Principal, error) {
+	if token != "t1" {
+		return nil, errors.ErrUnauthenticated
+	}
+	return &auth.Principal{Name: "alice", Roles: []string{"admin"}}, nil
+}
+
+func TestForwarding(t *testing.T) {
+	Convey("test forwarding principals from gateways to controllers", t, func() {
+		forward := auth.ForwardConfig{Secret: "s3cret"}
+		info := &grpc.UnaryServerInfo{FullMethod: "/test"}
+		var principal *auth.Principal
+		controller := ForwardedUnaryServerInterceptor(forward)
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			principal = auth.PrincipalFromContext(ctx)
+			return nil, nil
+		}
+		// gateway forwards metadata of its outgoing context as incoming metadata of the controller.
+		gateway := func(ctx context.Context, req interface{}) (interface{}, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			return controller(metadata.NewIncomingContext(context.Background(), md), req, info, handler)
+		}
+
+		Convey("forward the authenticated principal", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				auth.Header, "Bearer t1", auth.PrincipalHeader, "mallory"))
+			_, err := UnaryServerInterceptor(staticProvider{}, forward)(ctx, nil, info, gateway)
+			So(err, ShouldBeNil)
+			So(principal.Name, ShouldEqual, "alice")
+			So(principal.HasRole("admin"), ShouldBeTrue)
+		})
+
+		Convey("reject principals claimed by clients", func() {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				auth.PrincipalHeader, "mallory", auth.RolesHeader, "admin"))
+			_, err := controller(ctx, nil, info, handler)
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			principal = nil
+			_, err = ForwardedUnaryServerInterceptor(auth.ForwardConfig{})(ctx, nil, info, handler)
+			So(err, ShouldBeNil)
+			So(principal, ShouldBeNil)
+		})
+
+		Convey("reject expired signatures", func() {
+			md := metadata.Pairs(forward.ForwardHeaders(&auth.Principal{Name: "alice"},
+				time.Now().Add(-time.Hour))...)
+			_, err := controller(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+		})
+
+		Convey("authenticate tokens sent to controllers directly", func() {
+			direct := ControllerUnaryServerInterceptor(staticProvider{}, forward)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.Header, "Bearer t1"))
+			_, err := direct(ctx, nil, info, handler)
+			So(err, ShouldBeNil)
+			So(principal.Name, ShouldEqual, "alice")
+
+			ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.Header, "Bearer t2"))
+			_, err = direct(ctx, nil, info, handler)
+			So(errors.Is(err, errors.ErrUnauthenticated), ShouldBeTrue)
+
+			// requests of components carry no token.
+			principal = nil
+			_, err = direct(metadata.NewIncomingContext(context.Background(), metadata.MD{}), nil, info, handler)
+			So(err, ShouldBeNil)
+			So(principal, ShouldBeNil)
+
+			// principals forwarded by gateways are trusted without tokens.
+			md := metadata.Pairs(forward.ForwardHeaders(&auth.Principal{Name: "bob"}, time.Now())...)
+			_, err = direct(metadata.NewIncomingContext(context.Background(), md), nil, info, handler)
+			So(err, ShouldBeNil)
+			So(principal.Name, ShouldEqual, "bob")
+		})
+	})
+}
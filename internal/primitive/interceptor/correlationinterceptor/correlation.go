@@ -0,0 +1,81 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlationinterceptor
+
+import (
+	"context"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// StreamServerInterceptor carries the correlation ID of the request, which is forwarded by the caller or
+// generated if it's absent, in the context, returns it in the response header and attaches it to errors.
+// It should be chained right after errinterceptor, which encodes the ID into the returned error.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		ctx, id := correlate(stream.Context())
+		_ = stream.SetHeader(metadata.Pairs(tracing.CorrelationHeader, id))
+		err := handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+		return withCorrelationID(err, id)
+	}
+}
+
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, id := correlate(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(tracing.CorrelationHeader, id))
+		res, err := handler(ctx, req)
+		return res, withCorrelationID(err, id)
+	}
+}
+
+func correlate(ctx context.Context) (context.Context, string) {
+	id := tracing.CorrelationIDOrNew(tracing.CorrelationIDFromIncoming(ctx))
+	return log.WithCorrelationID(ctx, id), id
+}
+
+func withCorrelationID(err error, id string) error {
+	if err == nil {
+		return nil
+	}
+	e, ok := err.(*errors.ErrorType)
+	if !ok {
+		// errors returned by other components are received as gRPC status.
+		if s, isStatus := status.FromError(err); isStatus {
+			e, ok = errors.Convert(s.Message())
+		}
+	}
+	if !ok {
+		e = errors.ErrUnknown.WithMessage(err.Error())
+	}
+	return e.WithCorrelationID(id)
+}
+
+// serverStream overrides the context of the stream to carry the correlation ID.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
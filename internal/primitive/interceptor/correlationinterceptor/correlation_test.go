@@ -0,0 +1,80 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package correlationinterceptor
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	Convey("test unary server interceptor", t, func() {
+		interceptor := UnaryServerInterceptor()
+		info := &grpc.UnaryServerInfo{FullMethod: "/test"}
+		var got string
+		invoke := func(ctx context.Context, err error) error {
+			_, e := interceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = log.CorrelationID(ctx)
+				return nil, err
+			})
+			return e
+		}
+
+		Convey("generate an id", func() {
+			So(invoke(context.Background(), nil), ShouldBeNil)
+			So(got, ShouldHaveLength, 32)
+		})
+
+		Convey("use the forwarded id", func() {
+			ctx := metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs(tracing.CorrelationHeader, "req-1"))
+			err := invoke(ctx, errors.ErrResourceNotFound.WithMessage("not found"))
+			So(got, ShouldEqual, "req-1")
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+			So(errors.CorrelationID(err), ShouldEqual, "req-1")
+		})
+
+		Convey("replace an invalid id", func() {
+			ctx := metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs(tracing.CorrelationHeader, "bad id\n"))
+			So(invoke(ctx, nil), ShouldBeNil)
+			So(got, ShouldHaveLength, 32)
+		})
+
+		Convey("attach the id to other errors", func() {
+			ctx := metadata.NewIncomingContext(context.Background(),
+				metadata.Pairs(tracing.CorrelationHeader, "req-2"))
+			err := invoke(ctx, stderrors.New("oops"))
+			So(errors.Is(err, errors.ErrUnknown), ShouldBeTrue)
+			So(errors.CorrelationID(err), ShouldEqual, "req-2")
+
+			received := status.Error(codes.Unknown,
+				errors.ConvertToGRPCError(errors.ErrResourceNotFound.WithMessage("not found")).Error())
+			err = invoke(ctx, received)
+			So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+			So(errors.CorrelationID(err), ShouldEqual, "req-2")
+		})
+	})
+}
@@ -0,0 +1,207 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package latency
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Bounds are upper bounds of latency buckets shared by reporters and the controller, so that histograms
+// reported by different components can be summed.
+var Bounds = [...]time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+	30 * time.Second,
+	time.Minute,
+}
+
+const (
+	// Overflow is the index of the bucket of latencies above all bounds and failures, which never meet
+	// any objective.
+	Overflow = len(Bounds)
+	buckets  = Overflow + 1
+)
+
+// BucketOf returns the index of the bucket which counts the latency.
+func BucketOf(latency time.Duration) int {
+	return sort.Search(len(Bounds), func(i int) bool {
+		return latency <= Bounds[i]
+	})
+}
+
+// Histogram is the number of events in each bucket of Bounds and the overflow bucket.
+type Histogram []uint64
+
+// Valid reports whether the histogram has a count for each bucket, histograms reported by components of
+// other versions may not.
+func (h Histogram) Valid() bool {
+	return len(h) == buckets
+}
+
+func (h Histogram) Total() uint64 {
+	var total uint64
+	for _, n := range h {
+		total += n
+	}
+	return total
+}
+
+// Above returns the number of events slower than latency, or failed. A bucket straddling latency is
+// counted as slower, so objectives should be one of Bounds.
+func (h Histogram) Above(latency time.Duration) uint64 {
+	first := BucketOf(latency)
+	if first < Overflow && Bounds[first] == latency {
+		first++
+	}
+	var n uint64
+	for i := first; i < len(h); i++ {
+		n += h[i]
+	}
+	return n
+}
+
+// Quantile estimates the latency at q by interpolating inside the bucket, like histogram_quantile of
+// Prometheus. It returns the last bound if the quantile falls into the overflow bucket, and 0 if the
+// histogram is empty.
+func (h Histogram) Quantile(q float64) time.Duration {
+	total := h.Total()
+	if total == 0 {
+		return 0
+	}
+	rank := q * float64(total)
+	var seen uint64
+	for i, n := range h {
+		if i >= Overflow {
+			break
+		}
+		if float64(seen+n) >= rank && n > 0 {
+			var lower time.Duration
+			if i > 0 {
+				lower = Bounds[i-1]
+			}
+			ratio := (rank - float64(seen)) / float64(n)
+			return lower + time.Duration(ratio*float64(Bounds[i]-lower))
+		}
+		seen += n
+	}
+	return Bounds[Overflow-1]
+}
+
+// Sub returns counts added to h since prev. If any count went backwards, the reporter restarted, so all of
+// h is new.
+func (h Histogram) Sub(prev Histogram) Histogram {
+	delta := make(Histogram, len(h))
+	if len(prev) != len(h) {
+		copy(delta, h)
+		return delta
+	}
+	for i := range h {
+		if h[i] < prev[i] {
+			copy(delta, h)
+			return delta
+		}
+		delta[i] = h[i] - prev[i]
+	}
+	return delta
+}
+
+// Add adds counts of other to h in place.
+func (h Histogram) Add(other Histogram) {
+	for i := 0; i < len(h) && i < len(other); i++ {
+		h[i] += other[i]
+	}
+}
+
+// Counter counts latencies of a resource since it's created, it's safe for concurrent use.
+type Counter struct {
+	counts [buckets]uint64
+}
+
+func (c *Counter) Observe(latency time.Duration, n int) {
+	atomic.AddUint64(&c.counts[BucketOf(latency)], uint64(n))
+}
+
+// Fail counts n failed events, which are never good.
+func (c *Counter) Fail(n int) {
+	atomic.AddUint64(&c.counts[Overflow], uint64(n))
+}
+
+// Histogram returns a copy of counts.
+func (c *Counter) Histogram() Histogram {
+	h := make(Histogram, buckets)
+	for i := range c.counts {
+		h[i] = atomic.LoadUint64(&c.counts[i])
+	}
+	return h
+}
+
+// Recorder counts latencies of many resources, e.g. eventbuses published to by a gateway.
+type Recorder struct {
+	counters sync.Map
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+func (r *Recorder) counter(resource string) *Counter {
+	v, ok := r.counters.Load(resource)
+	if !ok {
+		v, _ = r.counters.LoadOrStore(resource, &Counter{})
+	}
+	c, _ := v.(*Counter)
+	return c
+}
+
+func (r *Recorder) Observe(resource string, latency time.Duration, n int) {
+	r.counter(resource).Observe(latency, n)
+}
+
+func (r *Recorder) Fail(resource string, n int) {
+	r.counter(resource).Fail(n)
+}
+
+// Record counts n events of the request to resource which started at start, they are failed if err isn't
+// nil.
+func (r *Recorder) Record(resource string, start time.Time, n int, err error) {
+	if err != nil {
+		r.Fail(resource, n)
+		return
+	}
+	r.Observe(resource, time.Since(start), n)
+}
+
+// Snapshot returns cumulative histograms of all resources ever observed.
+func (r *Recorder) Snapshot() map[string]Histogram {
+	result := map[string]Histogram{}
+	r.counters.Range(func(key, value interface{}) bool {
+		c, _ := value.(*Counter)
+		result[key.(string)] = c.Histogram()
+		return true
+	})
+	return result
+}
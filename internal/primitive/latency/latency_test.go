@@ -0,0 +1,87 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package latency
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBucketOf(t *testing.T) {
+	Convey("test bucket of", t, func() {
+		So(BucketOf(0), ShouldEqual, 0)
+		So(BucketOf(5*time.Millisecond), ShouldEqual, 0)
+		So(BucketOf(6*time.Millisecond), ShouldEqual, 1)
+		So(BucketOf(time.Second), ShouldEqual, 7)
+		So(BucketOf(time.Hour), ShouldEqual, Overflow)
+	})
+}
+
+func TestHistogram(t *testing.T) {
+	Convey("test histogram", t, func() {
+		c := &Counter{}
+		c.Observe(3*time.Millisecond, 90)
+		c.Observe(80*time.Millisecond, 5)
+		c.Observe(200*time.Millisecond, 4)
+		c.Fail(1)
+		h := c.Histogram()
+		So(h.Valid(), ShouldBeTrue)
+		So(h.Total(), ShouldEqual, 100)
+
+		Convey("count events above latency", func() {
+			So(h.Above(100*time.Millisecond), ShouldEqual, 5)
+			So(h.Above(50*time.Millisecond), ShouldEqual, 10)
+			// the bucket (50ms, 100ms] straddles 60ms, so it's counted as slower.
+			So(h.Above(60*time.Millisecond), ShouldEqual, 10)
+			So(h.Above(time.Hour), ShouldEqual, 1)
+		})
+
+		Convey("estimate quantiles", func() {
+			So(h.Quantile(0.5), ShouldEqual, 5*time.Millisecond*50/90)
+			So(h.Quantile(0.95), ShouldEqual, 100*time.Millisecond)
+			So(h.Quantile(1), ShouldEqual, Bounds[Overflow-1])
+			So(Histogram(make([]uint64, buckets)).Quantile(0.99), ShouldEqual, 0)
+		})
+
+		Convey("subtract previous counts", func() {
+			c.Observe(3*time.Millisecond, 10)
+			delta := c.Histogram().Sub(h)
+			So(delta.Total(), ShouldEqual, 10)
+			So(delta[0], ShouldEqual, 10)
+
+			restarted := (&Counter{}).Histogram()
+			restarted[0] = 1
+			So(restarted.Sub(h), ShouldResemble, restarted)
+		})
+	})
+}
+
+func TestRecorder(t *testing.T) {
+	Convey("test recorder", t, func() {
+		r := NewRecorder()
+		r.Record("bus", time.Now(), 3, nil)
+		r.Record("bus", time.Now(), 2, errors.New("test"))
+		r.Observe("other", time.Minute, 1)
+
+		snapshot := r.Snapshot()
+		So(snapshot, ShouldHaveLength, 2)
+		So(snapshot["bus"].Total(), ShouldEqual, 5)
+		So(snapshot["bus"][Overflow], ShouldEqual, 2)
+		So(snapshot["other"][BucketOf(time.Minute)], ShouldEqual, 1)
+	})
+}
@@ -0,0 +1,280 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+	eb "github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+type Kind string
+
+const (
+	KindProduced  Kind = "produced"
+	KindDelivered Kind = "delivered"
+
+	DefaultTenant = "default"
+	EventType     = "com.linkall.vanus.usage.record"
+	eventSource   = "https://linkall.com/vanus"
+
+	defaultFlushInterval = time.Minute
+)
+
+type Config struct {
+	Enable        bool          `yaml:"enable"`
+	FlushInterval time.Duration `yaml:"flush_interval"`
+	// AdminRoles may query usages of any tenant from gateways, others only query their own tenants. It's
+	// admin by default.
+	AdminRoles []string `yaml:"admin_roles"`
+}
+
+// Usage is a usage record covering events of one tenant, eventbus and kind
+// which were counted by a single reporter during [Start, End).
+type Usage struct {
+	Tenant   string    `json:"tenant"`
+	Eventbus string    `json:"eventbus"`
+	Kind     Kind      `json:"kind"`
+	Events   uint64    `json:"events"`
+	Bytes    uint64    `json:"bytes"`
+	Reporter string    `json:"reporter"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+}
+
+type Meter interface {
+	Record(tenant, eventbus string, kind Kind, events, bytes int)
+	Start(ctx context.Context)
+	Stop(ctx context.Context)
+}
+
+// TenantFromContext returns the tenant of a request, which is the authenticated principal. Requests are of
+// DefaultTenant if authentication is disabled, since nothing else identifies the tenant reliably.
+func TenantFromContext(ctx context.Context) string {
+	if p := auth.PrincipalFromContext(ctx); p != nil && p.Name != "" {
+		return p.Name
+	}
+	return DefaultTenant
+}
+
+// TenantOf returns the tenant which the event belongs to.
+func TenantOf(e *ce.Event) string {
+	if v, ok := e.Extensions()[primitive.XVanusTenant]; ok {
+		if tenant, ok := v.(string); ok && tenant != "" {
+			return tenant
+		}
+	}
+	return DefaultTenant
+}
+
+// EventbusOf returns the eventbus which the event was produced to.
+func EventbusOf(e *ce.Event) string {
+	if v, ok := e.Extensions()[primitive.XVanusEventbus]; ok {
+		if eventbus, ok := v.(string); ok {
+			return eventbus
+		}
+	}
+	return ""
+}
+
+type key struct {
+	tenant   string
+	eventbus string
+	kind     Kind
+}
+
+type counter struct {
+	events uint64
+	bytes  uint64
+}
+
+type meter struct {
+	reporter      string
+	flushInterval time.Duration
+	client        eb.Client
+	writer        api.BusWriter
+
+	counters    map[key]*counter
+	windowStart time.Time
+	mutex       sync.Mutex
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewMeter creates a Meter which flushes usage records to primitive.MeteringEventbusName
+// periodically, a disabled Meter drops all records.
+func NewMeter(cfg Config, reporter string, client eb.Client) Meter {
+	if !cfg.Enable {
+		return &nopMeter{}
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultFlushInterval
+	}
+	return &meter{
+		reporter:      reporter,
+		flushInterval: cfg.FlushInterval,
+		client:        client,
+		counters:      make(map[key]*counter),
+		windowStart:   time.Now(),
+		cancel:        func() {},
+	}
+}
+
+func (m *meter) Record(tenant, eventbus string, kind Kind, events, bytes int) {
+	if events <= 0 {
+		return
+	}
+	if tenant == "" {
+		tenant = DefaultTenant
+	}
+	k := key{tenant: tenant, eventbus: eventbus, kind: kind}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	c, ok := m.counters[k]
+	if !ok {
+		c = &counter{}
+		m.counters[k] = c
+	}
+	c.events += uint64(events)
+	c.bytes += uint64(bytes)
+}
+
+func (m *meter) Start(ctx context.Context) {
+	m.writer = m.client.Eventbus(ctx, primitive.MeteringEventbusName).Writer()
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(m.flushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.flush(ctx)
+			}
+		}
+	}()
+	log.Info(ctx, "the meter started", map[string]interface{}{
+		"reporter":       m.reporter,
+		"flush_interval": m.flushInterval,
+	})
+}
+
+func (m *meter) Stop(ctx context.Context) {
+	m.cancel()
+	m.wg.Wait()
+	m.flush(ctx)
+}
+
+func (m *meter) flush(ctx context.Context) {
+	if m.writer == nil {
+		// the meter isn't started, usage is kept until it's started.
+		return
+	}
+
+	m.mutex.Lock()
+	counters, start, end := m.counters, m.windowStart, time.Now()
+	m.counters = make(map[key]*counter, len(counters))
+	m.windowStart = end
+	m.mutex.Unlock()
+
+	if len(counters) == 0 {
+		return
+	}
+
+	events := make([]*ce.Event, 0, len(counters))
+	for _, u := range m.usages(counters, start, end) {
+		e := ce.NewEvent()
+		e.SetID(uuid.NewString())
+		e.SetType(EventType)
+		e.SetSource(eventSource)
+		e.SetTime(end)
+		e.SetExtension(primitive.XVanusTenant, u.Tenant)
+		_ = e.SetData(ce.ApplicationJSON, u)
+		events = append(events, &e)
+	}
+
+	if _, err := m.writer.AppendMany(ctx, events); err != nil {
+		log.Warning(ctx, "failed to write usage records, they will be merged into next window",
+			map[string]interface{}{
+				log.KeyError: err,
+				"count":      len(events),
+			})
+		m.restore(counters, start)
+	}
+}
+
+// restore merges counters which failed to be flushed back, so usage is delayed rather than lost.
+func (m *meter) restore(counters map[key]*counter, start time.Time) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for k, c := range counters {
+		cur, ok := m.counters[k]
+		if !ok {
+			m.counters[k] = c
+			continue
+		}
+		cur.events += c.events
+		cur.bytes += c.bytes
+	}
+	m.windowStart = start
+}
+
+func (m *meter) usages(counters map[key]*counter, start, end time.Time) []*Usage {
+	usages := make([]*Usage, 0, len(counters))
+	for k, c := range counters {
+		usages = append(usages, &Usage{
+			Tenant:   k.tenant,
+			Eventbus: k.eventbus,
+			Kind:     k.kind,
+			Events:   c.events,
+			Bytes:    c.bytes,
+			Reporter: m.reporter,
+			Start:    start,
+			End:      end,
+		})
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].Tenant != usages[j].Tenant {
+			return usages[i].Tenant < usages[j].Tenant
+		}
+		if usages[i].Eventbus != usages[j].Eventbus {
+			return usages[i].Eventbus < usages[j].Eventbus
+		}
+		return usages[i].Kind < usages[j].Kind
+	})
+	return usages
+}
+
+type nopMeter struct{}
+
+func (m *nopMeter) Record(_, _ string, _ Kind, _, _ int) {}
+
+func (m *nopMeter) Start(_ context.Context) {}
+
+func (m *nopMeter) Stop(_ context.Context) {}
@@ -0,0 +1,120 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/auth"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMeter(t *testing.T) {
+	Convey("test meter", t, func() {
+		ctx := context.Background()
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		mockClient := client.NewMockClient(ctrl)
+		mockEventbus := api.NewMockEventbus(ctrl)
+		mockWriter := api.NewMockBusWriter(ctrl)
+		mockClient.EXPECT().Eventbus(gomock.Any(), primitive.MeteringEventbusName).AnyTimes().Return(mockEventbus)
+		mockEventbus.EXPECT().Writer().AnyTimes().Return(mockWriter)
+
+		m, _ := NewMeter(Config{Enable: true, FlushInterval: time.Hour}, "test", mockClient).(*meter)
+		So(m, ShouldNotBeNil)
+		m.Start(ctx)
+		defer m.Stop(ctx)
+
+		Convey("test flush aggregated usage", func() {
+			m.Record("t1", "bus1", KindProduced, 1, 10)
+			m.Record("t1", "bus1", KindProduced, 2, 20)
+			m.Record("", "bus1", KindDelivered, 1, 10)
+			m.Record("t1", "bus1", KindDelivered, 0, 0)
+
+			var events []*ce.Event
+			mockWriter.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).DoAndReturn(
+				func(_ context.Context, es []*ce.Event, _ ...api.WriteOption) (string, error) {
+					events = es
+					return "", nil
+				})
+			m.flush(ctx)
+			So(events, ShouldHaveLength, 2)
+			u := &Usage{}
+			So(events[0].DataAs(u), ShouldBeNil)
+			So(u.Tenant, ShouldEqual, DefaultTenant)
+			So(u.Kind, ShouldEqual, KindDelivered)
+			So(u.Events, ShouldEqual, 1)
+			So(events[1].DataAs(u), ShouldBeNil)
+			So(u.Tenant, ShouldEqual, "t1")
+			So(u.Kind, ShouldEqual, KindProduced)
+			So(u.Events, ShouldEqual, 3)
+			So(u.Bytes, ShouldEqual, 30)
+			So(u.Reporter, ShouldEqual, "test")
+			So(TenantOf(events[1]), ShouldEqual, "t1")
+			So(m.counters, ShouldBeEmpty)
+
+			// nothing to flush
+			m.flush(ctx)
+		})
+
+		Convey("test usage is kept when flush failed", func() {
+			m.Record("t1", "bus1", KindProduced, 1, 10)
+			start := m.windowStart
+			mockWriter.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).Return("", errors.New("test"))
+			m.flush(ctx)
+			So(m.windowStart, ShouldEqual, start)
+			So(m.counters, ShouldHaveLength, 1)
+
+			m.Record("t1", "bus1", KindProduced, 1, 10)
+			c := m.counters[key{tenant: "t1", eventbus: "bus1", kind: KindProduced}]
+			So(c.events, ShouldEqual, 2)
+			So(c.bytes, ShouldEqual, 20)
+			mockWriter.EXPECT().AppendMany(gomock.Any(), gomock.Any()).Times(1).Return("", nil)
+		})
+	})
+
+	Convey("test meter which isn't started", t, func() {
+		ctx := context.Background()
+		m, _ := NewMeter(Config{Enable: true}, "test", nil).(*meter)
+		So(m, ShouldNotBeNil)
+		m.Record("t1", "bus1", KindProduced, 1, 10)
+		So(func() { m.Stop(ctx) }, ShouldNotPanic)
+		So(m.counters, ShouldHaveLength, 1)
+	})
+
+	Convey("test tenant of requests", t, func() {
+		ctx := context.Background()
+		So(TenantFromContext(ctx), ShouldEqual, DefaultTenant)
+		ctx = auth.WithPrincipal(ctx, &auth.Principal{Name: "alice"})
+		So(TenantFromContext(ctx), ShouldEqual, "alice")
+	})
+
+	Convey("test disabled meter", t, func() {
+		m := NewMeter(Config{}, "test", nil)
+		_, ok := m.(*nopMeter)
+		So(ok, ShouldBeTrue)
+		m.Start(context.Background())
+		m.Record("t1", "bus1", KindProduced, 1, 10)
+		m.Stop(context.Background())
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protodecode
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// IsProtobuf reports whether the media type is of protobuf payloads.
+func IsProtobuf(mediaType string) bool {
+	mediaType = strings.ToLower(mediaType)
+	return mediaType == "application/protobuf" || mediaType == "application/x-protobuf"
+}
+
+// Decoder decodes protobuf payloads of a message to JSON. Fields are named as they are in the .proto file,
+// e.g. order_id rather than orderId, so that filters match the schema.
+type Decoder struct {
+	messageType protoreflect.MessageType
+	marshaler   protojson.MarshalOptions
+}
+
+// NewDecoder looks the message up in a serialized google.protobuf.FileDescriptorSet, which must contain all
+// dependencies of the message.
+func NewDecoder(descriptorSet []byte, messageName string) (*Decoder, error) {
+	if messageName == "" {
+		return nil, fmt.Errorf("the message name is empty")
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorSet, set); err != nil {
+		return nil, fmt.Errorf("invalid descriptor set: %w", err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid descriptor set: %w", err)
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(messageName))
+	if err != nil {
+		return nil, fmt.Errorf("message %s isn't found in the descriptor set", messageName)
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s isn't a message", messageName)
+	}
+	return &Decoder{
+		messageType: dynamicpb.NewMessageType(md),
+		marshaler:   protojson.MarshalOptions{UseProtoNames: true},
+	}, nil
+}
+
+func (d *Decoder) Decode(data []byte) ([]byte, error) {
+	msg := d.messageType.New().Interface()
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("decode protobuf payload failed: %w", err)
+	}
+	return d.marshaler.Marshal(msg)
+}
+
+// Encode is the inverse of Decode, it encodes JSON payloads of the message to protobuf. Fields can be named
+// as they are in the .proto file or in lowerCamelCase.
+func (d *Decoder) Encode(data []byte) ([]byte, error) {
+	msg := d.messageType.New().Interface()
+	if err := protojson.Unmarshal(data, msg); err != nil {
+		return nil, fmt.Errorf("encode JSON payload failed: %w", err)
+	}
+	return proto.Marshal(msg)
+}
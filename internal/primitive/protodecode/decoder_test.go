@@ -0,0 +1,101 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protodecode
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func orderDescriptorSet() *descriptorpb.FileDescriptorSet {
+	return &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+		Name:    proto.String("order.proto"),
+		Package: proto.String("example.v1"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("OrderCreated"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("order_id"),
+				JsonName: proto.String("orderId"),
+				Number:   proto.Int32(1),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}, {
+				Name:     proto.String("amount"),
+				JsonName: proto.String("amount"),
+				Number:   proto.Int32(2),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}},
+		}},
+	}}}
+}
+
+func TestDecoder(t *testing.T) {
+	Convey("test protobuf decoder", t, func() {
+		set := orderDescriptorSet()
+		descriptorSet, err := proto.Marshal(set)
+		So(err, ShouldBeNil)
+
+		Convey("decode payloads", func() {
+			d, err := NewDecoder(descriptorSet, "example.v1.OrderCreated")
+			So(err, ShouldBeNil)
+
+			fd, err := protodesc.NewFile(set.File[0], nil)
+			So(err, ShouldBeNil)
+			md := fd.Messages().ByName("OrderCreated")
+			msg := dynamicpb.NewMessage(md)
+			msg.Set(md.Fields().ByName("order_id"), protoreflect.ValueOfString("o-1"))
+			msg.Set(md.Fields().ByName("amount"), protoreflect.ValueOfInt32(42))
+			data, err := proto.Marshal(msg)
+			So(err, ShouldBeNil)
+
+			result, err := d.Decode(data)
+			So(err, ShouldBeNil)
+			So(string(result), ShouldEqualJSON, `{"order_id":"o-1","amount":42}`)
+
+			_, err = d.Decode([]byte{0xff, 0xff})
+			So(err, ShouldNotBeNil)
+
+			encoded, err := d.Encode([]byte(`{"orderId":"o-1","amount":42}`))
+			So(err, ShouldBeNil)
+			So(encoded, ShouldResemble, data)
+
+			_, err = d.Encode([]byte(`{"unknown":1}`))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("invalid config", func() {
+			_, err = NewDecoder(descriptorSet, "")
+			So(err, ShouldNotBeNil)
+			_, err = NewDecoder(descriptorSet, "example.v1.Unknown")
+			So(err, ShouldNotBeNil)
+			_, err = NewDecoder(descriptorSet, "example.v1")
+			So(err, ShouldNotBeNil)
+			_, err = NewDecoder([]byte("invalid"), "example.v1.OrderCreated")
+			So(err, ShouldNotBeNil)
+		})
+
+		So(IsProtobuf("application/x-protobuf"), ShouldBeTrue)
+		So(IsProtobuf("application/protobuf"), ShouldBeTrue)
+		So(IsProtobuf("application/json"), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	stderr "errors"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Always retries any error, it suits operations whose failures are all worth retrying, e.g. writes which
+// are allowed to be duplicated.
+func Always(error) bool {
+	return true
+}
+
+// Any reports whether err is retryable by any of classifiers.
+func Any(classifiers ...Classifier) Classifier {
+	return func(err error) bool {
+		for _, c := range classifiers {
+			if c(err) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// IsUnavailable reports whether the request failed before reaching the server, e.g. the connection is
+// broken. It's retryable even if the request isn't idempotent.
+func IsUnavailable(err error) bool {
+	return codeOf(err) == codes.Unavailable
+}
+
+// IsTransient reports whether err is likely resolved shortly, e.g. the leader is changing or the server is
+// overloaded. The request may have been processed, so it's retryable only if the request is idempotent.
+func IsTransient(err error) bool {
+	if stderr.Is(err, context.Canceled) || stderr.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, errors.ErrNotLeader) || errors.Is(err, errors.ErrTryAgain) {
+		return true
+	}
+	switch codeOf(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// codeOf returns the gRPC code of err, it also recognizes errors converted by clients such as etcd's, which
+// carry the code but aren't gRPC statuses any more.
+func codeOf(err error) codes.Code {
+	var coder interface{ Code() codes.Code }
+	if stderr.As(err, &coder) {
+		return coder.Code()
+	}
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+	return codes.Unknown
+}
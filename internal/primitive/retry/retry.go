@@ -0,0 +1,106 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/util"
+)
+
+const (
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 5 * time.Second
+	defaultMultiplier     = 2
+)
+
+// Policy decides how many times an operation is attempted and how long to wait between attempts.
+type Policy struct {
+	// MaxAttempts includes the first attempt, the operation is attempted only once if it's less than 2.
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Multiplier grows the backoff after each attempt, a backoff is constant if it's 1.
+	Multiplier float64
+	// Jitter randomizes a backoff by up to the fraction of it, so that callers don't retry in lockstep.
+	Jitter float64
+}
+
+// Backoff returns how long to wait before the attempt following the given one, attempts start from 1.
+func (p Policy) Backoff(attempt int) time.Duration {
+	initial, max, multiplier := p.InitialBackoff, p.MaxBackoff, p.Multiplier
+	if initial <= 0 {
+		initial = defaultInitialBackoff
+	}
+	if max <= 0 {
+		max = defaultMaxBackoff
+	}
+	if multiplier < 1 {
+		multiplier = defaultMultiplier
+	}
+	backoff := float64(initial)
+	for i := 1; i < attempt && backoff < float64(max); i++ {
+		backoff *= multiplier
+	}
+	if backoff > float64(max) {
+		backoff = float64(max)
+	}
+	if p.Jitter > 0 {
+		backoff += backoff * p.Jitter * (2*rand.Float64() - 1) //nolint:gosec // it's not for security.
+	}
+	return time.Duration(backoff)
+}
+
+// Classifier reports whether an operation failed with err may succeed if it's attempted again.
+type Classifier func(err error) bool
+
+// Operation is a kind of call which shares a policy, a classifier and a budget. It's safe for concurrent use.
+type Operation struct {
+	// Name identifies the operation in errors and logs.
+	Name      string
+	Policy    Policy
+	Retryable Classifier
+	// Budget is shared by all calls of the operation, retries are unlimited if it's nil.
+	Budget *Budget
+	// OnRetry is called before waiting for the next attempt, it's optional.
+	OnRetry func(ctx context.Context, attempt int, err error)
+}
+
+// Do calls fn until it succeeds, fails with an error which isn't retryable, the attempts or the budget run
+// out, or ctx is done. It returns the error of the last attempt.
+func (op *Operation) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil {
+			op.Budget.onSuccess()
+			return nil
+		}
+		op.Budget.onFailure()
+		if attempt >= op.Policy.MaxAttempts || op.Retryable == nil || !op.Retryable(err) {
+			return err
+		}
+		if ctx.Err() != nil || !op.Budget.allow() {
+			return err
+		}
+		if op.OnRetry != nil {
+			op.OnRetry(ctx, attempt, err)
+		}
+		if !util.SleepWithContext(ctx, op.Policy.Backoff(attempt)) {
+			return err
+		}
+	}
+}
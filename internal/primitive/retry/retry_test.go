@@ -0,0 +1,132 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPolicy_Backoff(t *testing.T) {
+	Convey("test policy backoff", t, func() {
+		p := Policy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second, Multiplier: 2}
+		So(p.Backoff(1), ShouldEqual, 100*time.Millisecond)
+		So(p.Backoff(2), ShouldEqual, 200*time.Millisecond)
+		So(p.Backoff(4), ShouldEqual, 800*time.Millisecond)
+		So(p.Backoff(5), ShouldEqual, time.Second)
+		So(p.Backoff(100), ShouldEqual, time.Second)
+
+		p.Multiplier = 1
+		So(p.Backoff(3), ShouldEqual, 100*time.Millisecond)
+
+		p.Jitter = 0.5
+		for i := 0; i < 10; i++ {
+			So(p.Backoff(1), ShouldBeBetweenOrEqual, 50*time.Millisecond, 150*time.Millisecond)
+		}
+	})
+}
+
+func TestOperation_Do(t *testing.T) {
+	Convey("test operation do", t, func() {
+		ctx := context.Background()
+		unavailable := status.Error(codes.Unavailable, "unavailable")
+		op := &Operation{
+			Name:      "test",
+			Policy:    Policy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			Retryable: IsUnavailable,
+		}
+		calls := 0
+		failTimes := func(n int, err error) func(context.Context) error {
+			return func(context.Context) error {
+				calls++
+				if calls <= n {
+					return err
+				}
+				return nil
+			}
+		}
+
+		Convey("succeed after retries", func() {
+			retries := 0
+			op.OnRetry = func(ctx context.Context, attempt int, err error) {
+				retries++
+			}
+			So(op.Do(ctx, failTimes(2, unavailable)), ShouldBeNil)
+			So(calls, ShouldEqual, 3)
+			So(retries, ShouldEqual, 2)
+		})
+
+		Convey("run out of attempts", func() {
+			So(op.Do(ctx, failTimes(3, unavailable)), ShouldEqual, unavailable)
+			So(calls, ShouldEqual, 3)
+		})
+
+		Convey("not retryable", func() {
+			So(op.Do(ctx, failTimes(1, errors.ErrInvalidRequest)), ShouldEqual, errors.ErrInvalidRequest)
+			So(calls, ShouldEqual, 1)
+		})
+
+		Convey("context done", func() {
+			op.Policy.InitialBackoff = time.Hour
+			ctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			So(op.Do(ctx, failTimes(1, unavailable)), ShouldEqual, unavailable)
+			So(calls, ShouldEqual, 1)
+		})
+
+		Convey("run out of budget", func() {
+			op.Budget = NewBudget(4, 1)
+			So(op.Do(ctx, failTimes(3, unavailable)), ShouldEqual, unavailable)
+			// 2 failures leave 2 tokens, which is no more than half of them.
+			So(calls, ShouldEqual, 2)
+
+			// successes return tokens, so that retries are allowed again.
+			So(op.Do(ctx, failTimes(0, nil)), ShouldBeNil)
+			So(op.Do(ctx, failTimes(0, nil)), ShouldBeNil)
+			calls = 0
+			So(op.Do(ctx, failTimes(1, unavailable)), ShouldBeNil)
+			So(calls, ShouldEqual, 2)
+		})
+	})
+}
+
+func TestIsTransient(t *testing.T) {
+	Convey("test is transient", t, func() {
+		So(IsTransient(status.Error(codes.Unavailable, "")), ShouldBeTrue)
+		So(IsTransient(status.Error(codes.ResourceExhausted, "")), ShouldBeTrue)
+		So(IsTransient(status.Error(codes.InvalidArgument, "")), ShouldBeFalse)
+		So(IsTransient(errors.ErrNotLeader), ShouldBeTrue)
+		So(IsTransient(errors.ErrInvalidRequest), ShouldBeFalse)
+		So(IsTransient(context.Canceled), ShouldBeFalse)
+		So(IsTransient(coded(codes.Unavailable)), ShouldBeTrue)
+		So(IsUnavailable(coded(codes.Aborted)), ShouldBeFalse)
+	})
+}
+
+type coded codes.Code
+
+func (c coded) Code() codes.Code {
+	return codes.Code(c)
+}
+
+func (c coded) Error() string {
+	return codes.Code(c).String()
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package primitive
+
+import "fmt"
+
+type SigningMethod string
+
+const (
+	SigningHMAC SigningMethod = "hmac"
+	SigningJWS  SigningMethod = "jws"
+)
+
+// SigningConfig makes events delivered over HTTP signed with all keys, so that a key can be rotated without
+// the sink rejecting events.
+type SigningConfig struct {
+	Method SigningMethod `json:"method"`
+	Keys   []SigningKey  `json:"keys"`
+}
+
+type SigningKey struct {
+	ID     string `json:"id"`
+	Secret string `json:"secret"`
+}
+
+// Masked returns a copy of the config whose secrets are masked.
+func (c *SigningConfig) Masked() *SigningConfig {
+	if c == nil {
+		return nil
+	}
+	masked := &SigningConfig{Method: c.Method, Keys: make([]SigningKey, len(c.Keys))}
+	for i, k := range c.Keys {
+		masked.Keys[i] = SigningKey{ID: k.ID, Secret: SecretsMask}
+	}
+	return masked
+}
+
+// FillSigningKeys replaces masked secrets in dst with secrets of keys with the same ID in src.
+func FillSigningKeys(dst, src *SigningConfig) {
+	if dst == nil || src == nil {
+		return
+	}
+	for i := range dst.Keys {
+		if dst.Keys[i].Secret != SecretsMask {
+			continue
+		}
+		for _, k := range src.Keys {
+			if k.ID == dst.Keys[i].ID {
+				dst.Keys[i].Secret = k.Secret
+				break
+			}
+		}
+	}
+}
+
+// MaskedKeyID returns the ID of the first key whose secret is masked, which is left by FillSigningKeys if
+// there is no key with the same ID to keep the secret of.
+func (c *SigningConfig) MaskedKeyID() string {
+	if c == nil {
+		return ""
+	}
+	for _, k := range c.Keys {
+		if k.Secret == SecretsMask {
+			return k.ID
+		}
+	}
+	return ""
+}
+
+// RotateSigningKey returns a copy of signing in which newKey is added and the key of retired is removed,
+// signing is left unchanged. Events are signed with all keys, so a key is rotated by adding the new key,
+// switching the sink to verify with it and then retiring the old key.
+func RotateSigningKey(signing *SigningConfig, newKey *SigningKey, retired string) (*SigningConfig, error) {
+	if signing == nil {
+		return nil, fmt.Errorf("the subscription isn't signed")
+	}
+	rotated := &SigningConfig{Method: signing.Method, Keys: make([]SigningKey, 0, len(signing.Keys)+1)}
+	found := retired == ""
+	for _, k := range signing.Keys {
+		if k.ID == retired {
+			found = true
+			continue
+		}
+		if newKey != nil && k.ID == newKey.ID {
+			return nil, fmt.Errorf("signing key %s already exists", k.ID)
+		}
+		rotated.Keys = append(rotated.Keys, k)
+	}
+	if !found {
+		return nil, fmt.Errorf("signing key %s doesn't exist", retired)
+	}
+	if newKey != nil {
+		rotated.Keys = append(rotated.Keys, *newKey)
+	}
+	if len(rotated.Keys) == 0 {
+		return nil, fmt.Errorf("the last signing key can't be retired")
+	}
+	return rotated, nil
+}
@@ -0,0 +1,253 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinktemplate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/trigger/util"
+	pkgUtil "github.com/linkall-labs/vanus/pkg/util"
+)
+
+const (
+	dataVariablePrefix = "data."
+	wildcardPrefix     = "*."
+	// placeholder is used to check whether a rendered template is a valid URL.
+	placeholder = "placeholder"
+)
+
+var (
+	ErrVariableNotFound = errors.New("the variable of sink template isn't found in event")
+	ErrHostNotAllowed   = errors.New("the host of rendered sink isn't allowed")
+)
+
+type part struct {
+	literal string
+	// attr is the name of event attribute, and path is the JSON path of event data.
+	attr string
+	path string
+}
+
+func (p part) isVariable() bool {
+	return p.attr != "" || p.path != ""
+}
+
+// Template is a sink whose variables are rendered from the event, e.g. https://{data.region}.example.com/{subject}.
+// A variable is either an attribute name or a data key prefixed by "data.", and the host of the rendered sink
+// must match the allow-list, so a crafted event can't redirect deliveries to an arbitrary endpoint.
+type Template struct {
+	raw          string
+	parts        []part
+	allowedHosts []string
+	hasData      bool
+}
+
+// IsTemplate returns true if the sink contains variables.
+func IsTemplate(sink string) bool {
+	return strings.Contains(sink, "{")
+}
+
+func Parse(sink string, allowedHosts []string) (*Template, error) {
+	if len(allowedHosts) == 0 {
+		return nil, errors.New("allowed hosts can't be empty if sink is a template")
+	}
+	for _, h := range allowedHosts {
+		if err := ValidateAllowedHost(h); err != nil {
+			return nil, err
+		}
+	}
+	schemeEnd := strings.Index(sink, "://")
+	if schemeEnd < 0 || strings.Index(sink, "{") < schemeEnd {
+		return nil, errors.New("the scheme of sink template can't contain variables")
+	}
+	if scheme := strings.ToLower(sink[:schemeEnd]); scheme != "http" && scheme != "https" {
+		return nil, fmt.Errorf("the scheme of sink template must be http or https, but got %s", scheme)
+	}
+
+	t := &Template{raw: sink, allowedHosts: allowedHosts}
+	rest := sink
+	for rest != "" {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			t.parts = append(t.parts, part{literal: rest})
+			break
+		}
+		if strings.Contains(rest[:start], "}") {
+			return nil, errors.New("unexpected '}' in sink template")
+		}
+		if start > 0 {
+			t.parts = append(t.parts, part{literal: rest[:start]})
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, errors.New("unclosed variable in sink template")
+		}
+		p, err := parseVariable(rest[start+1 : start+end])
+		if err != nil {
+			return nil, err
+		}
+		if p.path != "" {
+			t.hasData = true
+		}
+		t.parts = append(t.parts, p)
+		rest = rest[start+end+1:]
+	}
+
+	example, _ := t.render(func(part) (string, error) {
+		return placeholder, nil
+	})
+	if _, err := url.Parse(example); err != nil {
+		return nil, fmt.Errorf("sink template isn't a valid url: %w", err)
+	}
+	return t, nil
+}
+
+func parseVariable(name string) (part, error) {
+	if strings.Contains(name, "{") {
+		return part{}, errors.New("nested variable in sink template")
+	}
+	if strings.HasPrefix(name, dataVariablePrefix) {
+		key := name[len(dataVariablePrefix):]
+		if key == "" {
+			return part{}, fmt.Errorf("invalid variable %s in sink template", name)
+		}
+		return part{path: "$." + key}, nil
+	}
+	if name == "" {
+		return part{}, errors.New("empty variable in sink template")
+	}
+	if err := pkgUtil.ValidateEventAttrName(name); err != nil {
+		return part{}, fmt.Errorf("invalid variable %s in sink template: %w", name, err)
+	}
+	return part{attr: name}, nil
+}
+
+// ValidateAllowedHost checks the pattern of allowed host, which is a hostname or "*." followed by a domain.
+func ValidateAllowedHost(host string) error {
+	domain := strings.TrimPrefix(host, wildcardPrefix)
+	if domain == "" || strings.ContainsAny(domain, "*/:@?#{} ") {
+		return fmt.Errorf("invalid allowed host %q", host)
+	}
+	return nil
+}
+
+// MatchHost returns true if the host matches one of allowed hosts, a wildcard matches one level of subdomain.
+func MatchHost(host string, allowedHosts []string) bool {
+	host = strings.ToLower(host)
+	for _, pattern := range allowedHosts {
+		pattern = strings.ToLower(pattern)
+		if !strings.HasPrefix(pattern, wildcardPrefix) {
+			if host == pattern {
+				return true
+			}
+			continue
+		}
+		suffix := pattern[1:]
+		if strings.HasSuffix(host, suffix) {
+			label := host[:len(host)-len(suffix)]
+			if label != "" && !strings.Contains(label, ".") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (t *Template) String() string {
+	return t.raw
+}
+
+// HasData returns true if some variables are rendered from the event data.
+func (t *Template) HasData() bool {
+	return t.hasData
+}
+
+// Render returns the sink of the event, values of variables are escaped as path segments.
+func (t *Template) Render(event *ce.Event) (string, error) {
+	var data interface{}
+	if t.hasData {
+		if err := json.Unmarshal(event.Data(), &data); err != nil {
+			return "", fmt.Errorf("the data of event isn't json: %w", err)
+		}
+	}
+	sink, err := t.render(func(p part) (string, error) {
+		var v interface{}
+		if p.attr != "" {
+			var ok bool
+			if v, ok = util.LookupAttribute(*event, p.attr); !ok {
+				return "", fmt.Errorf("%w: %s", ErrVariableNotFound, p.attr)
+			}
+		} else {
+			var err error
+			if v, err = util.LookupData(data, p.path); err != nil {
+				return "", fmt.Errorf("%w: data%s", ErrVariableNotFound, p.path[1:])
+			}
+		}
+		s, err := stringify(v)
+		if err != nil {
+			return "", err
+		}
+		return url.PathEscape(s), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(sink)
+	if err != nil {
+		return "", fmt.Errorf("rendered sink %s isn't a valid url: %w", sink, err)
+	}
+	if !MatchHost(u.Hostname(), t.allowedHosts) {
+		return "", fmt.Errorf("%w: %s", ErrHostNotAllowed, u.Hostname())
+	}
+	return sink, nil
+}
+
+func (t *Template) render(value func(p part) (string, error)) (string, error) {
+	var sb strings.Builder
+	for _, p := range t.parts {
+		if !p.isVariable() {
+			sb.WriteString(p.literal)
+			continue
+		}
+		v, err := value(p)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(v)
+	}
+	return sb.String(), nil
+}
+
+func stringify(v interface{}) (string, error) {
+	switch val := v.(type) {
+	case string:
+		if val == "" {
+			return "", errors.New("the value of variable is empty")
+		}
+		return val, nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case bool, int, int32, int64:
+		return fmt.Sprint(val), nil
+	default:
+		return "", fmt.Errorf("the value of variable must be a string, number or bool, but got %T", v)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sinktemplate
+
+import (
+	"errors"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("test parse sink template", t, func() {
+		allowed := []string{"*.example.com"}
+		Convey("test valid template", func() {
+			tpl, err := Parse("https://{data.region}.example.com/hook/{subject}", allowed)
+			So(err, ShouldBeNil)
+			So(tpl.parts, ShouldHaveLength, 4)
+			So(tpl.parts[0].literal, ShouldEqual, "https://")
+			So(tpl.parts[1].path, ShouldEqual, "$.region")
+			So(tpl.parts[3].attr, ShouldEqual, "subject")
+			So(tpl.hasData, ShouldBeTrue)
+		})
+		Convey("test invalid template", func() {
+			_, err := Parse("https://{data.region}.example.com", nil)
+			So(err, ShouldNotBeNil)
+			_, err = Parse("https://{data.region}.example.com", []string{"*.example.com/hook"})
+			So(err, ShouldNotBeNil)
+			_, err = Parse("{source}://example.com", allowed)
+			So(err, ShouldNotBeNil)
+			_, err = Parse("ftp://{subject}.example.com", allowed)
+			So(err, ShouldNotBeNil)
+			_, err = Parse("https://{data.region.example.com", allowed)
+			So(err, ShouldNotBeNil)
+			_, err = Parse("https://{}.example.com", allowed)
+			So(err, ShouldNotBeNil)
+			_, err = Parse("https://{data.}.example.com", allowed)
+			So(err, ShouldNotBeNil)
+			_, err = Parse("https://{Subject}.example.com", allowed)
+			So(err, ShouldNotBeNil)
+			_, err = Parse("https://}{subject}.example.com", allowed)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestMatchHost(t *testing.T) {
+	Convey("test match host", t, func() {
+		allowed := []string{"*.example.com", "hook.vanus.ai"}
+		So(MatchHost("us.example.com", allowed), ShouldBeTrue)
+		So(MatchHost("US.Example.com", allowed), ShouldBeTrue)
+		So(MatchHost("hook.vanus.ai", allowed), ShouldBeTrue)
+		So(MatchHost("example.com", allowed), ShouldBeFalse)
+		So(MatchHost("a.b.example.com", allowed), ShouldBeFalse)
+		So(MatchHost("evilexample.com", allowed), ShouldBeFalse)
+		So(MatchHost("a.vanus.ai", allowed), ShouldBeFalse)
+	})
+}
+
+func TestTemplate_Render(t *testing.T) {
+	Convey("test render sink template", t, func() {
+		tpl, err := Parse("https://{data.region}.example.com/hook/{subject}?tenant={data.tenant.id}",
+			[]string{"*.example.com"})
+		So(err, ShouldBeNil)
+		newEvent := func(subject string, data map[string]interface{}) *ce.Event {
+			e := ce.NewEvent()
+			e.SetSubject(subject)
+			_ = e.SetData(ce.ApplicationJSON, data)
+			return &e
+		}
+
+		Convey("test render success", func() {
+			sink, err := tpl.Render(newEvent("a b", map[string]interface{}{
+				"region": "us",
+				"tenant": map[string]interface{}{"id": 1},
+			}))
+			So(err, ShouldBeNil)
+			So(sink, ShouldEqual, "https://us.example.com/hook/a%20b?tenant=1")
+		})
+
+		Convey("test variable not found", func() {
+			_, err = tpl.Render(newEvent("a", map[string]interface{}{"region": "us"}))
+			So(errors.Is(err, ErrVariableNotFound), ShouldBeTrue)
+		})
+
+		Convey("test host isn't allowed", func() {
+			_, err = tpl.Render(newEvent("a", map[string]interface{}{
+				"region": "a.evil",
+				"tenant": map[string]interface{}{"id": 1},
+			}))
+			So(errors.Is(err, ErrHostNotAllowed), ShouldBeTrue)
+		})
+
+		Convey("test value isn't scalar", func() {
+			_, err = tpl.Render(newEvent("a", map[string]interface{}{
+				"region": []string{"us"},
+				"tenant": map[string]interface{}{"id": 1},
+			}))
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
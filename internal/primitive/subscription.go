@@ -17,6 +17,7 @@ package primitive
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
@@ -35,6 +36,8 @@ type Subscription struct {
 	Protocol        Protocol               `json:"protocol,omitempty"`
 	ProtocolSetting *ProtocolSetting       `json:"protocolSetting,omitempty"`
 	SinkCredential  SinkCredential         `json:"sink_credential,omitempty"`
+	Signing         *SigningConfig         `json:"signing,omitempty"`
+	Revision        uint64                 `json:"revision,omitempty"`
 }
 
 func (sub *Subscription) String() string {
@@ -64,6 +67,16 @@ const (
 	Timestamp      OffsetType = 2
 )
 
+// DeliveryPhase is the phase of a subscription with backfilling enabled.
+type DeliveryPhase string
+
+const (
+	// DeliveryPhaseBackfilling delivers history at BackfillRateLimit.
+	DeliveryPhaseBackfilling DeliveryPhase = "backfilling"
+	// DeliveryPhaseFollowing delivers new events at RateLimit once the subscription catches up.
+	DeliveryPhaseFollowing DeliveryPhase = "following"
+)
+
 type SubscriptionConfig struct {
 	RateLimit uint32 `json:"rate_limit,omitempty"`
 	// consumer from
@@ -74,6 +87,44 @@ type SubscriptionConfig struct {
 	DeadLetterEventbus string     `json:"dead_letter_eventbus,omitempty"`
 	// send event with ordered
 	OrderedEvent bool `json:"ordered_event"`
+	// hosts which the sink template can be rendered to
+	SinkAllowedHosts []string `json:"sink_allowed_hosts,omitempty"`
+	// deliver events without parsing or changing the data
+	PassThrough bool `json:"pass_through,omitempty"`
+	// deliver events at the rate until the subscription catches up with the eventbus, 0 disables backfilling
+	BackfillRateLimit uint32 `json:"backfill_rate_limit,omitempty"`
+	// decode protobuf payloads to JSON before filtering
+	ProtobufDecoding *ProtobufDecoding `json:"protobuf_decoding,omitempty"`
+	// the total time in milliseconds failed events are retried for, 0 is unlimited
+	DeliveryDeadline uint32 `json:"delivery_deadline,omitempty"`
+	// the datacontenttype events are delivered in, the data is converted with ProtobufDecoding
+	DeliveryContentType string `json:"delivery_content_type,omitempty"`
+}
+
+// DataLoss records events removed by retention before the subscription consumed them, the subscription
+// skips to the earliest offset of the eventlog.
+type DataLoss struct {
+	EventlogID vanus.ID  `json:"eventlog_id"`
+	FromOffset uint64    `json:"from_offset"`
+	ToOffset   uint64    `json:"to_offset"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// CrashStatus records panics recovered in the delivery pipeline of a subscription.
+type CrashStatus struct {
+	Panics      uint64    `json:"panics"`
+	LastStage   string    `json:"last_stage"`
+	LastPanic   string    `json:"last_panic"`
+	LastPanicAt time.Time `json:"last_panic_at"`
+	// CrashLooping is set if the pipeline keeps panicking, e.g. a transformer template panics for every event.
+	CrashLooping bool `json:"crash_looping"`
+}
+
+// ProtobufDecoding decodes protobuf payloads of the message in the descriptor set.
+type ProtobufDecoding struct {
+	// DescriptorSet is a serialized google.protobuf.FileDescriptorSet.
+	DescriptorSet []byte `json:"descriptor_set"`
+	MessageName   string `json:"message_name"`
 }
 
 // GetMaxRetryAttempts return MaxRetryAttempts if nil return -1.
@@ -101,6 +152,22 @@ type SubscriptionFilter struct {
 	All    SubscriptionFilterList `json:"all,omitempty"`
 	Any    SubscriptionFilterList `json:"any,omitempty"`
 	CEL    string                 `json:"cel,omitempty"`
+	Time   *TimeFilter            `json:"time,omitempty"`
+}
+
+const (
+	TimeBasisEvent     = "event"
+	TimeBasisIngestion = "ingestion"
+)
+
+// TimeFilter passes events whose event time or ingestion time is in the window.
+type TimeFilter struct {
+	Basis    string   `json:"basis,omitempty"`
+	Since    string   `json:"since,omitempty"`
+	Until    string   `json:"until,omitempty"`
+	Daily    string   `json:"daily,omitempty"`
+	Weekdays []string `json:"weekdays,omitempty"`
+	TimeZone string   `json:"time_zone,omitempty"`
 }
 
 type SubscriptionFilterList []*SubscriptionFilter
@@ -117,6 +184,16 @@ type Transformer struct {
 	Define   map[string]string `json:"define,omitempty"`
 	Pipeline []*Action         `json:"pipeline,omitempty"`
 	Template string            `json:"template,omitempty"`
+	// Wasm runs after Define, Pipeline and Template if any.
+	Wasm *WasmFunction `json:"wasm,omitempty"`
+}
+
+// WasmFunction is a WebAssembly module which transforms or filters events in a sandbox, the module is
+// encoded in base64 in JSON.
+type WasmFunction struct {
+	Module        []byte `json:"module"`
+	MemoryLimitMB uint32 `json:"memory_limit_mb,omitempty"`
+	TimeoutMs     uint32 `json:"timeout_ms,omitempty"`
 }
 
 func (t *Transformer) String() string {
@@ -131,12 +208,22 @@ func (t *Transformer) Exist() bool {
 	if t == nil {
 		return false
 	}
-	if t.Template == "" && len(t.Pipeline) == 0 {
+	if t.Template == "" && len(t.Pipeline) == 0 && !t.HasWasm() {
 		return false
 	}
 	return true
 }
 
+// HasBuiltin reports whether the transformer has steps of the built-in language, which parse the data as
+// JSON.
+func (t *Transformer) HasBuiltin() bool {
+	return t != nil && (len(t.Define) > 0 || len(t.Pipeline) > 0 || t.Template != "")
+}
+
+func (t *Transformer) HasWasm() bool {
+	return t != nil && t.Wasm != nil && len(t.Wasm.Module) > 0
+}
+
 type Action struct {
 	Command []interface{} `json:"command"`
 }
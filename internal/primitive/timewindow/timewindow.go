@@ -0,0 +1,145 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timewindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Spec describes a window by an absolute range and a recurring daily window, which are both optional.
+type Spec struct {
+	// Since and Until are RFC3339 timestamps, the range is [Since, Until).
+	Since string
+	Until string
+	// Daily is like 09:00-17:00, it wraps midnight if the end is earlier than the start.
+	Daily string
+	// Weekdays are like mon or fri.
+	Weekdays []string
+	// TimeZone is an IANA time zone name of Daily and Weekdays, it's UTC if empty.
+	TimeZone string
+}
+
+type Window struct {
+	since, until time.Time
+	daily        bool
+	// start and end are minutes of the day.
+	start, end int
+	weekdays   map[time.Weekday]bool
+	loc        *time.Location
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func Parse(spec Spec) (*Window, error) {
+	w := &Window{loc: time.UTC}
+	var err error
+	if spec.Since != "" {
+		if w.since, err = time.Parse(time.RFC3339, spec.Since); err != nil {
+			return nil, fmt.Errorf("invalid since: %w", err)
+		}
+	}
+	if spec.Until != "" {
+		if w.until, err = time.Parse(time.RFC3339, spec.Until); err != nil {
+			return nil, fmt.Errorf("invalid until: %w", err)
+		}
+	}
+	if !w.since.IsZero() && !w.until.IsZero() && !w.since.Before(w.until) {
+		return nil, fmt.Errorf("since must be before until")
+	}
+	if spec.TimeZone != "" {
+		if w.loc, err = time.LoadLocation(spec.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone: %w", err)
+		}
+	}
+	if spec.Daily != "" {
+		parts := strings.Split(spec.Daily, "-")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid daily window %s, it should be like 09:00-17:00", spec.Daily)
+		}
+		if w.start, err = parseClock(parts[0]); err != nil {
+			return nil, err
+		}
+		if w.end, err = parseClock(parts[1]); err != nil {
+			return nil, err
+		}
+		if w.start == w.end {
+			return nil, fmt.Errorf("the daily window %s is empty", spec.Daily)
+		}
+		w.daily = true
+	}
+	if len(spec.Weekdays) > 0 {
+		w.weekdays = make(map[time.Weekday]bool, len(spec.Weekdays))
+		for _, name := range spec.Weekdays {
+			d, ok := weekdayNames[strings.ToLower(strings.TrimSpace(name))]
+			if !ok {
+				return nil, fmt.Errorf("invalid weekday: %s", name)
+			}
+			w.weekdays[d] = true
+		}
+	}
+	return w, nil
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %s, it should be like 09:00", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Contains reports whether t is in the window.
+func (w *Window) Contains(t time.Time) bool {
+	if !w.since.IsZero() && t.Before(w.since) {
+		return false
+	}
+	if !w.until.IsZero() && !t.Before(w.until) {
+		return false
+	}
+	if !w.daily && w.weekdays == nil {
+		return true
+	}
+
+	local := t.In(w.loc)
+	day := local.Weekday()
+	if w.daily {
+		minute := local.Hour()*60 + local.Minute()
+		if w.start < w.end {
+			if minute < w.start || minute >= w.end {
+				return false
+			}
+		} else {
+			// The window wraps midnight, the part after midnight belongs to the day before.
+			switch {
+			case minute >= w.start:
+			case minute < w.end:
+				day = (day + 6) % 7
+			default:
+				return false
+			}
+		}
+	}
+	return w.weekdays == nil || w.weekdays[day]
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package timewindow
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("test parse time window", t, func() {
+		for _, spec := range []Spec{
+			{Since: "2022-01-01"},
+			{Since: "2022-01-02T00:00:00Z", Until: "2022-01-01T00:00:00Z"},
+			{Daily: "09:00"},
+			{Daily: "9h-17h"},
+			{Daily: "09:00-09:00"},
+			{Weekdays: []string{"monday"}},
+			{TimeZone: "Mars/Olympus"},
+		} {
+			_, err := Parse(spec)
+			So(err, ShouldNotBeNil)
+		}
+	})
+}
+
+func TestWindow_Contains(t *testing.T) {
+	Convey("test time window contains", t, func() {
+		at := func(s string) time.Time {
+			v, _ := time.Parse(time.RFC3339, s)
+			return v
+		}
+
+		Convey("absolute range", func() {
+			w, err := Parse(Spec{Since: "2022-01-01T00:00:00Z", Until: "2022-02-01T00:00:00Z"})
+			So(err, ShouldBeNil)
+			So(w.Contains(at("2022-01-01T00:00:00Z")), ShouldBeTrue)
+			So(w.Contains(at("2021-12-31T23:59:59Z")), ShouldBeFalse)
+			So(w.Contains(at("2022-02-01T00:00:00Z")), ShouldBeFalse)
+		})
+
+		Convey("business hours", func() {
+			w, err := Parse(Spec{Daily: "09:00-17:00", Weekdays: []string{"mon", "tue", "wed", "thu", "fri"},
+				TimeZone: "Asia/Shanghai"})
+			So(err, ShouldBeNil)
+			// 2022-01-03 is Monday.
+			So(w.Contains(at("2022-01-03T09:00:00+08:00")), ShouldBeTrue)
+			So(w.Contains(at("2022-01-03T01:30:00Z")), ShouldBeTrue)
+			So(w.Contains(at("2022-01-03T17:00:00+08:00")), ShouldBeFalse)
+			So(w.Contains(at("2022-01-03T08:59:00+08:00")), ShouldBeFalse)
+			So(w.Contains(at("2022-01-02T10:00:00+08:00")), ShouldBeFalse)
+		})
+
+		Convey("overnight window", func() {
+			w, err := Parse(Spec{Daily: "22:00-06:00", Weekdays: []string{"fri"}})
+			So(err, ShouldBeNil)
+			// 2022-01-07 is Friday.
+			So(w.Contains(at("2022-01-07T23:00:00Z")), ShouldBeTrue)
+			So(w.Contains(at("2022-01-08T05:59:00Z")), ShouldBeTrue)
+			So(w.Contains(at("2022-01-08T06:00:00Z")), ShouldBeFalse)
+			So(w.Contains(at("2022-01-07T05:00:00Z")), ShouldBeFalse)
+			So(w.Contains(at("2022-01-08T23:00:00Z")), ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,241 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm runs WebAssembly user functions which transform or filter events in a sandbox. A function is
+// instantiated for each event, so it has no state across events. It has no access to the file system, the
+// network or the environment, and it's limited in memory and the time taken by an event.
+//
+// A module must export:
+//   - memory, the linear memory.
+//   - alloc(size i32) i32, which returns the address of a buffer of size bytes.
+//   - transform(ptr i32, size i32) i64, which receives the event in JSON at ptr, and returns the address
+//     and the size of the transformed event in JSON packed as address<<32 | size. The event is filtered
+//     out if it returns 0.
+//
+// Modules compiled for WASI reactors, e.g. by TinyGo or Rust, are supported, _initialize is called before
+// alloc.
+package wasm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+const (
+	DefaultMemoryLimitMB = 16
+	MaxMemoryLimitMB     = 128
+	DefaultTimeout       = 100 * time.Millisecond
+	MaxTimeout           = time.Second
+	// MaxModuleSize keeps subscriptions small enough to be stored in the kv store.
+	MaxModuleSize = 1 << 20
+
+	pagesPerMB = 16 // a page of WebAssembly is 64KiB.
+
+	exportMemory    = "memory"
+	exportAlloc     = "alloc"
+	exportTransform = "transform"
+	funcInitialize  = "_initialize"
+)
+
+// Function is a compiled user function, it's safe for concurrent use.
+type Function struct {
+	runtime wazero.Runtime
+	module  wazero.CompiledModule
+	timeout time.Duration
+
+	mutex  sync.RWMutex
+	closed bool
+}
+
+// New compiles the module of fn, it fails if the module is invalid or exceeds limits.
+func New(ctx context.Context, fn *primitive.WasmFunction) (*Function, error) {
+	if len(fn.Module) == 0 {
+		return nil, fmt.Errorf("the wasm module is empty")
+	}
+	if len(fn.Module) > MaxModuleSize {
+		return nil, fmt.Errorf("the wasm module is larger than %d bytes", MaxModuleSize)
+	}
+	memoryLimit := fn.MemoryLimitMB
+	if memoryLimit == 0 {
+		memoryLimit = DefaultMemoryLimitMB
+	}
+	if memoryLimit > MaxMemoryLimitMB {
+		return nil, fmt.Errorf("the memory limit of wasm function can't be greater than %dMB", MaxMemoryLimitMB)
+	}
+	timeout := time.Duration(fn.TimeoutMs) * time.Millisecond
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	if timeout > MaxTimeout {
+		return nil, fmt.Errorf("the timeout of wasm function can't be greater than %s", MaxTimeout)
+	}
+
+	// Functions are interrupted once the context is done, so an endless loop can't hold a worker.
+	r := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(memoryLimit*pagesPerMB).
+		WithCloseOnContextDone(true))
+	// WASI is provided without preopened directories or environment variables, output is discarded.
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, r); err != nil {
+		_ = r.Close(ctx)
+		return nil, err
+	}
+	compiled, err := r.CompileModule(ctx, fn.Module)
+	if err != nil {
+		_ = r.Close(ctx)
+		return nil, fmt.Errorf("compile wasm module failed: %w", err)
+	}
+	if err = checkExports(compiled); err != nil {
+		_ = r.Close(ctx)
+		return nil, err
+	}
+	return &Function{runtime: r, module: compiled, timeout: timeout}, nil
+}
+
+// Validate checks the module and limits of fn.
+func Validate(ctx context.Context, fn *primitive.WasmFunction) error {
+	f, err := New(ctx, fn)
+	if err != nil {
+		return err
+	}
+	return f.Close(ctx)
+}
+
+func checkExports(m wazero.CompiledModule) error {
+	if _, ok := m.ExportedMemories()[exportMemory]; !ok {
+		return fmt.Errorf("the wasm module doesn't export %s", exportMemory)
+	}
+	funcs := m.ExportedFunctions()
+	if err := checkSignature(funcs, exportAlloc,
+		[]api.ValueType{api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32}); err != nil {
+		return err
+	}
+	return checkSignature(funcs, exportTransform,
+		[]api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI64})
+}
+
+func checkSignature(funcs map[string]api.FunctionDefinition, name string, params, results []api.ValueType) error {
+	def, ok := funcs[name]
+	if !ok {
+		return fmt.Errorf("the wasm module doesn't export function %s", name)
+	}
+	if !equalTypes(def.ParamTypes(), params) || !equalTypes(def.ResultTypes(), results) {
+		return fmt.Errorf("the signature of function %s is (%s) -> (%s), expected (%s) -> (%s)", name,
+			typeNames(def.ParamTypes()), typeNames(def.ResultTypes()), typeNames(params), typeNames(results))
+	}
+	return nil
+}
+
+func equalTypes(a, b []api.ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func typeNames(types []api.ValueType) string {
+	var s string
+	for i, t := range types {
+		if i > 0 {
+			s += ", "
+		}
+		s += api.ValueTypeName(t)
+	}
+	return s
+}
+
+// Run calls the function with event, and replaces event with the result. It returns false if the event is
+// filtered out.
+func (f *Function) Run(ctx context.Context, event *ce.Event) (bool, error) {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	if f.closed {
+		return false, fmt.Errorf("the wasm function is closed")
+	}
+
+	in, err := event.MarshalJSON()
+	if err != nil {
+		return false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+	mod, err := f.runtime.InstantiateModule(ctx, f.module,
+		wazero.NewModuleConfig().WithName("").WithStartFunctions(funcInitialize))
+	if err != nil {
+		return false, f.callError(ctx, funcInitialize, err)
+	}
+	defer func() {
+		_ = mod.Close(context.Background())
+	}()
+
+	res, err := mod.ExportedFunction(exportAlloc).Call(ctx, uint64(len(in)))
+	if err != nil {
+		return false, f.callError(ctx, exportAlloc, err)
+	}
+	ptr := uint32(res[0])
+	if !mod.Memory().Write(ptr, in) {
+		return false, fmt.Errorf("the buffer returned by %s is out of memory", exportAlloc)
+	}
+	res, err = mod.ExportedFunction(exportTransform).Call(ctx, uint64(ptr), uint64(len(in)))
+	if err != nil {
+		return false, f.callError(ctx, exportTransform, err)
+	}
+	if res[0] == 0 {
+		return false, nil
+	}
+
+	out, ok := mod.Memory().Read(uint32(res[0]>>32), uint32(res[0]))
+	if !ok {
+		return false, fmt.Errorf("the event returned by %s is out of memory", exportTransform)
+	}
+	// out is a view of the memory, which is released once the module is closed.
+	result := ce.NewEvent()
+	if err = json.Unmarshal(out, &result); err != nil {
+		return false, fmt.Errorf("the event returned by %s is invalid: %w", exportTransform, err)
+	}
+	*event = result
+	return true, nil
+}
+
+func (f *Function) callError(ctx context.Context, name string, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("the wasm function takes longer than %s", f.timeout)
+	}
+	return fmt.Errorf("call %s of wasm function failed: %w", name, err)
+}
+
+// Close waits for running calls, and releases the compiled module.
+func (f *Function) Close(ctx context.Context) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	return f.runtime.Close(ctx)
+}
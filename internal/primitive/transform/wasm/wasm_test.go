@@ -0,0 +1,113 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm
+
+import (
+	"context"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// makeModule assembles a module which exports a memory of a page, alloc returning 1024, and transform with
+// the body.
+func makeModule(transform []byte) []byte {
+	section := func(id byte, content ...byte) []byte {
+		return append([]byte{id, byte(len(content))}, content...)
+	}
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	// (i32) -> i32, (i32, i32) -> i64
+	module = append(module, section(0x01, 0x02, 0x60, 0x01, 0x7f, 0x01, 0x7f, 0x60, 0x02, 0x7f, 0x7f, 0x01, 0x7e)...)
+	module = append(module, section(0x03, 0x02, 0x00, 0x01)...)
+	module = append(module, section(0x05, 0x01, 0x00, 0x01)...)
+	exports := []byte{0x03}
+	exports = append(exports, 0x06, 'm', 'e', 'm', 'o', 'r', 'y', 0x02, 0x00)
+	exports = append(exports, 0x05, 'a', 'l', 'l', 'o', 'c', 0x00, 0x00)
+	exports = append(exports, 0x09, 't', 'r', 'a', 'n', 's', 'f', 'o', 'r', 'm', 0x00, 0x01)
+	module = append(module, section(0x07, exports...)...)
+	// alloc: i32.const 1024
+	code := []byte{0x02, 0x05, 0x00, 0x41, 0x80, 0x08, 0x0b, byte(len(transform))}
+	code = append(code, transform...)
+	return append(module, section(0x0a, code...)...)
+}
+
+var (
+	// transform returns the input: (i64(ptr) << 32) | i64(size)
+	echoBody = []byte{0x00, 0x20, 0x00, 0xad, 0x42, 0x20, 0x86, 0x20, 0x01, 0xad, 0x84, 0x0b}
+	// transform returns 0.
+	dropBody = []byte{0x00, 0x42, 0x00, 0x0b}
+	// transform loops forever.
+	loopBody = []byte{0x00, 0x03, 0x40, 0x0c, 0x00, 0x0b, 0x00, 0x0b}
+)
+
+func TestFunction(t *testing.T) {
+	ctx := context.Background()
+	event := ce.NewEvent()
+	event.SetID("1")
+	event.SetSource("test")
+	event.SetType("test")
+	_ = event.SetData(ce.ApplicationJSON, map[string]interface{}{"key": "value"})
+
+	Convey("test wasm function", t, func() {
+		Convey("echo", func() {
+			f, err := New(ctx, &primitive.WasmFunction{Module: makeModule(echoBody)})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(f.Close(ctx), ShouldBeNil)
+			}()
+			e := event.Clone()
+			ok, err := f.Run(ctx, &e)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeTrue)
+			So(e.ID(), ShouldEqual, "1")
+			So(string(e.Data()), ShouldEqual, `{"key":"value"}`)
+		})
+
+		Convey("filter out", func() {
+			f, err := New(ctx, &primitive.WasmFunction{Module: makeModule(dropBody)})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(f.Close(ctx), ShouldBeNil)
+			}()
+			e := event.Clone()
+			ok, err := f.Run(ctx, &e)
+			So(err, ShouldBeNil)
+			So(ok, ShouldBeFalse)
+		})
+
+		Convey("interrupt endless loop", func() {
+			f, err := New(ctx, &primitive.WasmFunction{Module: makeModule(loopBody), TimeoutMs: 10})
+			So(err, ShouldBeNil)
+			defer func() {
+				So(f.Close(ctx), ShouldBeNil)
+			}()
+			e := event.Clone()
+			_, err = f.Run(ctx, &e)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("invalid functions", func() {
+			So(Validate(ctx, &primitive.WasmFunction{}), ShouldNotBeNil)
+			So(Validate(ctx, &primitive.WasmFunction{Module: []byte("not wasm")}), ShouldNotBeNil)
+			So(Validate(ctx, &primitive.WasmFunction{
+				Module: makeModule(echoBody), MemoryLimitMB: MaxMemoryLimitMB + 1,
+			}), ShouldNotBeNil)
+			So(Validate(ctx, &primitive.WasmFunction{Module: makeModule(echoBody), TimeoutMs: 2000}), ShouldNotBeNil)
+			So(Validate(ctx, &primitive.WasmFunction{Module: makeModule(echoBody)}), ShouldBeNil)
+		})
+	})
+}
@@ -17,6 +17,7 @@ package log
 import (
 	// standard libraries.
 	"context"
+	"encoding/binary"
 	"errors"
 
 	// third-party libraries.
@@ -446,11 +447,11 @@ func (l *Log) appendToWAL(ctx context.Context, entries []raftpb.Entry, remark bo
 func (l *Log) doAppendToWAL(ctx context.Context, entries []raftpb.Entry, cb func([]int64, error)) {
 	ctx, span := l.tracer.Start(ctx, "doAppendToWAL")
 
-	ents := make([][]byte, len(entries))
+	ents := make([][][]byte, len(entries))
 	for i, entry := range entries {
 		// reset node ID.
 		entry.NodeId = l.nodeID.Uint64()
-		ent, err := entry.Marshal()
+		ent, err := marshalEntry(&entry)
 		if err != nil {
 			cb(nil, err)
 			return
@@ -458,7 +459,7 @@ func (l *Log) doAppendToWAL(ctx context.Context, entries []raftpb.Entry, cb func
 		ents[i] = ent
 	}
 
-	l.wal.Append(ctx, ents, walog.WithCallback(func(re walog.Result) {
+	l.wal.AppendVec(ctx, ents, walog.WithCallback(func(re walog.Result) {
 		span.End()
 
 		if re.Err != nil {
@@ -473,3 +474,35 @@ func (l *Log) doAppendToWAL(ctx context.Context, entries []raftpb.Entry, cb func
 		cb(offsets, nil)
 	}))
 }
+
+// entryDataTag is the tag of field data of raftpb.Entry, which is field 4 of wire type bytes.
+const entryDataTag = 4<<3 | 2
+
+// marshalEntry marshals entry in pieces which reference its data, they're the same as entry.Marshal() once
+// they are joined. Fields are marshaled in order of their numbers, so fields before data go to the first
+// piece and fields after data go to the last one.
+func marshalEntry(entry *raftpb.Entry) ([][]byte, error) {
+	if len(entry.Data) == 0 {
+		data, err := entry.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{data}, nil
+	}
+
+	head := raftpb.Entry{Type: entry.Type, Term: entry.Term, Index: entry.Index}
+	tail := raftpb.Entry{NodeId: entry.NodeId, PrevTerm: entry.PrevTerm}
+	buf := make([]byte, head.Size()+1+binary.MaxVarintLen64+tail.Size())
+	n, err := head.MarshalTo(buf)
+	if err != nil {
+		return nil, err
+	}
+	buf[n] = entryDataTag
+	n++
+	n += binary.PutUvarint(buf[n:], uint64(len(entry.Data)))
+	m, err := tail.MarshalTo(buf[n:])
+	if err != nil {
+		return nil, err
+	}
+	return [][]byte{buf[:n], entry.Data, buf[n : n+m]}, nil
+}
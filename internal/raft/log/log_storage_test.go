@@ -0,0 +1,89 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	// standard libraries.
+	"bytes"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/raft/raftpb"
+)
+
+func TestMarshalEntry(t *testing.T) {
+	Convey("marshal entry in pieces", t, func() {
+		entries := []raftpb.Entry{{
+			Term:  1,
+			Index: 1,
+			Type:  raftpb.EntryNormal,
+		}, {
+			Term:     3,
+			Index:    4,
+			Type:     raftpb.EntryConfChange,
+			Data:     []byte("hello world"),
+			NodeId:   nodeID1.Uint64(),
+			PrevTerm: 2,
+		}, {
+			Term:  1 << 40,
+			Index: 1 << 50,
+			Data:  bytes.Repeat([]byte{'a'}, 1<<16),
+		}}
+		for i := range entries {
+			entry := &entries[i]
+			pieces, err := marshalEntry(entry)
+			So(err, ShouldBeNil)
+			data, err := entry.Marshal()
+			So(err, ShouldBeNil)
+			So(bytes.Join(pieces, nil), ShouldResemble, data)
+
+			if len(entry.Data) != 0 {
+				So(pieces, ShouldHaveLength, 3)
+				So(&pieces[1][0], ShouldEqual, &entry.Data[0])
+			}
+
+			var result raftpb.Entry
+			So(result.Unmarshal(bytes.Join(pieces, nil)), ShouldBeNil)
+			So(result, ShouldResemble, *entry)
+		}
+	})
+}
+
+func BenchmarkMarshalEntry(b *testing.B) {
+	entry := &raftpb.Entry{
+		Term:   3,
+		Index:  1024,
+		Type:   raftpb.EntryNormal,
+		Data:   bytes.Repeat([]byte{'a'}, 64*1024),
+		NodeId: nodeID1.Uint64(),
+	}
+
+	b.Run("Marshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = entry.Marshal()
+		}
+	})
+
+	b.Run("Pieces", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = marshalEntry(entry)
+		}
+	})
+}
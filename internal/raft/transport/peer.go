@@ -28,6 +28,9 @@ import (
 	// first-party libraries.
 	vsraftpb "github.com/linkall-labs/vanus/proto/pkg/raft"
 	"github.com/linkall-labs/vanus/raft/raftpb"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
 )
 
 const (
@@ -71,6 +74,7 @@ func (p *peer) run(callback string) {
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	}
+	opts = append(opts, compression.DataDialOptions()...)
 
 	preface := raftpb.Message{
 		Context: []byte(callback),
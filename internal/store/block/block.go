@@ -31,6 +31,8 @@ var (
 	ErrExceeded       = errors.New("the offset exceeded")
 	ErrOnEnd          = errors.New("the offset on end")
 	ErrNotSupported   = errors.New("not supported")
+	// ErrCorrupted means the checksum of a stored entry mismatches, e.g. the disk is corrupted.
+	ErrCorrupted = errors.New("corrupted entry")
 )
 
 type SeekKeyFlag uint64
@@ -44,6 +46,9 @@ const (
 	SeekPrefix
 	SeekPrefixLast
 	SeekPrefixLastOrPrev
+	// SeekLastKey seeks the last entry with the key, it's used by indexes which aren't ordered, e.g. the
+	// partition key index.
+	SeekLastKey
 )
 
 type Seeker interface {
@@ -51,7 +56,9 @@ type Seeker interface {
 }
 
 type Reader interface {
-	Read(ctx context.Context, seq int64, num int) ([]Entry, error)
+	// Read reads at most num entries from seq, and stops once their total size reaches maxBytes if it's
+	// positive. At least one entry is read even if it's larger than maxBytes.
+	Read(ctx context.Context, seq int64, num int, maxBytes int) ([]Entry, error)
 }
 
 type AppendCallback = func(seqs []int64, err error)
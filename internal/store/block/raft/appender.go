@@ -423,6 +423,14 @@ func (a *appender) Append(ctx context.Context, entries []block.Entry, cb block.A
 	a.appendMu.Lock()
 	span.AddEvent("Got append lock")
 
+	// Don't propose entries for a request which has been given up, the wait for the lock may be long on a
+	// slow disk. Once proposed, entries are appended even if the request is given up.
+	if err := ctx.Err(); err != nil {
+		a.appendMu.Unlock()
+		cb(nil, err)
+		return
+	}
+
 	if !a.isLeader() {
 		a.appendMu.Unlock()
 		cb(nil, block.ErrNotLeader)
@@ -57,6 +57,53 @@ type Raw interface {
 	Delete(context.Context) error
 }
 
+// OriginRecorder is implemented by raws which keep the eventlog and the write lease epoch of Block in its
+// metadata, zero values leave recorded ones unchanged.
+type OriginRecorder interface {
+	RecordOrigin(ctx context.Context, eventlogID vanus.ID, epoch uint64) error
+}
+
+// DictionaryUser is implemented by raws which compress sealed data of Block with a zstd dictionary.
+type DictionaryUser interface {
+	UseDictionary(ctx context.Context, dict []byte) error
+}
+
+// Prefetcher is implemented by raws which can load data of Block into memory ahead of reads, it returns
+// the number of bytes requested to be loaded.
+type Prefetcher interface {
+	Prefetch(ctx context.Context, seq int64) (int64, error)
+}
+
+// Cloner is implemented by raws which can copy Block to a file while appends continue, the copy is a
+// consistent block of committed entries, which can be opened by the engine.
+type Cloner interface {
+	Clone(ctx context.Context, path string) error
+}
+
+// ChecksumVerifier is implemented by raws which keep a checksum of the whole Block once it's archived, it's
+// verified in steps, so that reads can be paced.
+type ChecksumVerifier interface {
+	// VerifyChecksum checksums at most maxBytes of Block after p, and returns the progress after them. It
+	// returns ErrCorrupted once the checksum mismatches, and ErrNotSupported if Block has no checksum.
+	VerifyChecksum(ctx context.Context, p ChecksumProgress, maxBytes int) (ChecksumProgress, error)
+}
+
+// ChecksumProgress is the progress of verifying the checksum of Block, the zero value starts verifying.
+type ChecksumProgress struct {
+	// Size and Sum are the size of data covered by the checksum and the checksum, verifying starts over if
+	// they are changed, e.g. Block is compressed.
+	Size int64
+	Sum  uint32
+	// Done is the size of data checksummed, and Partial is the checksum of it.
+	Done    int64
+	Partial uint32
+}
+
+// Finished returns true if all data of Block is checksummed.
+func (p ChecksumProgress) Finished() bool {
+	return p.Size != 0 && p.Done >= p.Size
+}
+
 type Statistics struct {
 	ID        vanus.ID
 	Capacity  uint64
@@ -25,7 +25,8 @@ import (
 )
 
 const (
-	VSB = "vsb"
+	VSB    = "vsb"
+	Memory = "memory"
 )
 
 var (
@@ -15,25 +15,44 @@
 package store
 
 import (
+	// standard libraries.
+	"fmt"
+
+	// third-party libraries.
+	"github.com/ncw/directio"
+
 	// first-party libraries.
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/pkg/util"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
 	"github.com/linkall-labs/vanus/internal/store/config"
 )
 
 type Config struct {
-	ControllerAddresses []string             `yaml:"controllers"`
-	IP                  string               `yaml:"ip"`
-	Port                int                  `yaml:"port"`
-	Volume              VolumeInfo           `yaml:"volume"`
-	MetaStore           config.SyncStore     `yaml:"meta_store"`
-	OffsetStore         config.AsyncStore    `yaml:"offset_store"`
-	Raft                config.Raft          `yaml:"raft"`
-	VSB                 config.VSB           `yaml:"vsb"`
-	Observability       observability.Config `yaml:"observability"`
+	ControllerAddresses []string               `yaml:"controllers"`
+	IP                  string                 `yaml:"ip"`
+	Port                int                    `yaml:"port"`
+	Volume              VolumeInfo             `yaml:"volume"`
+	MetaStore           config.SyncStore       `yaml:"meta_store"`
+	OffsetStore         config.AsyncStore      `yaml:"offset_store"`
+	Raft                config.Raft            `yaml:"raft"`
+	VSB                 config.VSB             `yaml:"vsb"`
+	MemoryBlock         config.MemoryBlock     `yaml:"memory_block"`
+	GRPC                config.GRPC            `yaml:"grpc"`
+	AppendFairness      config.AppendFairness  `yaml:"append_fairness"`
+	AppendRateLimit     config.AppendRateLimit `yaml:"append_rate_limit"`
+	ReadCache           config.ReadCache       `yaml:"read_cache"`
+	Scrub               config.Scrub           `yaml:"scrub"`
+	Integrity           config.Integrity       `yaml:"integrity"`
+	// DisableDirectIO opens files of WAL and meta stores through the page cache, e.g. for development on
+	// platforms or file systems without direct I/O.
+	DisableDirectIO bool                 `yaml:"disable_direct_io"`
+	Observability   observability.Config `yaml:"observability"`
+	// GRPCCompression compresses heartbeats to controllers and replication to other stores.
+	GRPCCompression compression.Config `yaml:"grpc_compression"`
 }
 
 func (c *Config) Validate() error {
@@ -49,6 +68,30 @@ func (c *Config) Validate() error {
 	if err := c.VSB.Validate(); err != nil {
 		return err
 	}
+	if c.Volume.DirectIO && c.VSB.FlushBatchSize%directio.BlockSize != 0 {
+		return fmt.Errorf("flush batch size of vsb must be a multiple of %d for direct I/O", directio.BlockSize)
+	}
+	if err := c.MemoryBlock.Validate(); err != nil {
+		return err
+	}
+	if err := c.GRPC.Validate(); err != nil {
+		return err
+	}
+	if err := c.AppendFairness.Validate(); err != nil {
+		return err
+	}
+	if err := c.AppendRateLimit.Validate(); err != nil {
+		return err
+	}
+	if err := c.ReadCache.Validate(); err != nil {
+		return err
+	}
+	if err := c.Scrub.Validate(); err != nil {
+		return err
+	}
+	if err := c.GRPCCompression.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -56,6 +99,9 @@ type VolumeInfo struct {
 	ID       uint16 `json:"id"`
 	Dir      string `json:"dir"`
 	Capacity uint64 `json:"capacity"`
+	// DirectIO appends to block files of the volume with O_DIRECT and O_DSYNC, for predictable latency on
+	// fast devices like NVMe. It falls back to buffered I/O if the file system rejects O_DIRECT.
+	DirectIO bool `json:"direct_io" yaml:"direct_io"`
 }
 
 func InitConfig(filename string) (*Config, error) {
@@ -0,0 +1,93 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"fmt"
+)
+
+const (
+	defaultReadCacheCapacity = 64 * baseMB
+
+	defaultReadAheadMaxEvents   = 1024
+	defaultReadAheadMaxBytes    = 4 * baseMB
+	defaultReadAheadConcurrency = 4
+)
+
+// ReadCache keeps events recently read from blocks in memory, so that reads of the same events by different
+// subscriptions don't hit the disk again.
+type ReadCache struct {
+	Enable bool `yaml:"enable"`
+	// Capacity is the total size of cached events in bytes, 0 is 64MB.
+	Capacity  int       `yaml:"capacity"`
+	ReadAhead ReadAhead `yaml:"read_ahead"`
+}
+
+func (c *ReadCache) Validate() error {
+	if c.Capacity < 0 {
+		return fmt.Errorf("capacity of read cache must not be negative")
+	}
+	return c.ReadAhead.Validate()
+}
+
+func (c *ReadCache) GetCapacity() int {
+	if c.Capacity == 0 {
+		return defaultReadCacheCapacity
+	}
+	return c.Capacity
+}
+
+// ReadAhead detects consumers reading a block sequentially, and reads events after them into the read cache
+// before they're requested, e.g. trigger workers streaming a large backlog.
+type ReadAhead struct {
+	Enable bool `yaml:"enable"`
+	// MaxEvents is the most events read ahead of a consumer, 0 is 1024. The window starts from twice the
+	// events of a read and doubles as the consumer keeps reading sequentially.
+	MaxEvents int `yaml:"max_events"`
+	// MaxBytes bounds the size of events read ahead at once, 0 is 4MB.
+	MaxBytes int `yaml:"max_bytes"`
+	// Concurrency is the most reads ahead in flight on the server, 0 is 4. Reads ahead are skipped rather
+	// than queued once it's reached.
+	Concurrency int `yaml:"concurrency"`
+}
+
+func (c *ReadAhead) Validate() error {
+	if c.MaxEvents < 0 || c.MaxBytes < 0 || c.Concurrency < 0 {
+		return fmt.Errorf("max_events, max_bytes and concurrency of read ahead must not be negative")
+	}
+	return nil
+}
+
+func (c *ReadAhead) GetMaxEvents() int {
+	if c.MaxEvents == 0 {
+		return defaultReadAheadMaxEvents
+	}
+	return c.MaxEvents
+}
+
+func (c *ReadAhead) GetMaxBytes() int {
+	if c.MaxBytes == 0 {
+		return defaultReadAheadMaxBytes
+	}
+	return c.MaxBytes
+}
+
+func (c *ReadAhead) GetConcurrency() int {
+	if c.Concurrency == 0 {
+		return defaultReadAheadConcurrency
+	}
+	return c.Concurrency
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"fmt"
+)
+
+const (
+	defaultFairnessQuantum          = 256 * baseKB
+	defaultFairnessMaxInflightBytes = 8 * baseMB
+)
+
+// AppendFairness shares disk writes among eventlogs of the server, appends are queued by eventlogs once
+// MaxInflightBytes are being appended, and queues are served in turns of Quantum bytes.
+type AppendFairness struct {
+	Enable bool `yaml:"enable"`
+	// Quantum is the number of bytes an eventlog may append in its turn, 0 is 256KB.
+	Quantum int `yaml:"quantum"`
+	// MaxInflightBytes is the number of bytes being appended before appends are queued, 0 is 8MB.
+	MaxInflightBytes int `yaml:"max_inflight_bytes"`
+}
+
+func (c *AppendFairness) Validate() error {
+	if c.Quantum < 0 || c.MaxInflightBytes < 0 {
+		return fmt.Errorf("quantum and max inflight bytes of append fairness must not be negative")
+	}
+	return nil
+}
+
+func (c *AppendFairness) GetQuantum() int {
+	if c.Quantum == 0 {
+		return defaultFairnessQuantum
+	}
+	return c.Quantum
+}
+
+func (c *AppendFairness) GetMaxInflightBytes() int {
+	if c.MaxInflightBytes == 0 {
+		return defaultFairnessMaxInflightBytes
+	}
+	return c.MaxInflightBytes
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"fmt"
+)
+
+const (
+	// DefaultMaxMsgSize is the default maximum message size of gRPC, and it is also the message size
+	// which clients can receive by default.
+	DefaultMaxMsgSize = 4 * baseMB
+	minMaxMsgSize     = 64 * baseKB
+)
+
+type GRPC struct {
+	// MaxRecvMsgSize is the maximum message size in bytes the server can receive, 0 is DefaultMaxMsgSize.
+	MaxRecvMsgSize int `yaml:"max_recv_msg_size"`
+	// MaxSendMsgSize is the maximum message size in bytes the server can send, 0 is DefaultMaxMsgSize.
+	// Responses of streaming reads are split to fit it.
+	MaxSendMsgSize int `yaml:"max_send_msg_size"`
+}
+
+func (c *GRPC) Validate() error {
+	if c.MaxRecvMsgSize != 0 && c.MaxRecvMsgSize < minMaxMsgSize {
+		return fmt.Errorf("grpc max recv msg size must not less than %dKB", minMaxMsgSize/baseKB)
+	}
+	if c.MaxSendMsgSize != 0 && c.MaxSendMsgSize < minMaxMsgSize {
+		return fmt.Errorf("grpc max send msg size must not less than %dKB", minMaxMsgSize/baseKB)
+	}
+	return nil
+}
+
+func (c *GRPC) RecvMsgSize() int {
+	if c.MaxRecvMsgSize == 0 {
+		return DefaultMaxMsgSize
+	}
+	return c.MaxRecvMsgSize
+}
+
+func (c *GRPC) SendMsgSize() int {
+	if c.MaxSendMsgSize == 0 {
+		return DefaultMaxMsgSize
+	}
+	return c.MaxSendMsgSize
+}
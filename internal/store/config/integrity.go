@@ -0,0 +1,22 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// Integrity configures signed manifests of sealed blocks.
+type Integrity struct {
+	// SigningKeyFile is a PKCS #8 PEM file of the Ed25519 private key manifests are signed with, manifests
+	// can't be exported if it's empty.
+	SigningKeyFile string `yaml:"signing_key_file"`
+}
@@ -18,10 +18,21 @@
 package config
 
 import (
+	// standard libraries.
+	"context"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/io/engine"
 )
 
 func buildIOEngineEx(cfg IO) engine.Interface {
+	if cfg.Engine == Uring {
+		// io_uring is only available on Linux, the same config works on other platforms by psync.
+		log.Warning(context.Background(), "io_uring isn't supported on this platform, use psync instead", nil)
+		return buildPsyncEngine(cfg)
+	}
 	panic("io engine is not supported")
 }
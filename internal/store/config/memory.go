@@ -0,0 +1,59 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"fmt"
+	"time"
+)
+
+const (
+	defaultMemoryBlockFlushInterval = time.Second
+	defaultMemoryBlockMemoryLimit   = 1024 * baseMB
+)
+
+// MemoryBlock configures blocks of eventbuses in the memory storage mode.
+type MemoryBlock struct {
+	// FlushInterval is the period of flushing blocks to the volume in the background, 0 is 1s. Blocks are
+	// always flushed, since raft logs are compacted and can't rebuild blocks lost in a restart.
+	FlushInterval time.Duration `yaml:"flush_interval"`
+	// MemoryLimit bounds bytes of all memory blocks, appends which need more are rejected, 0 is 1GB.
+	MemoryLimit int64 `yaml:"memory_limit"`
+}
+
+func (c *MemoryBlock) Validate() error {
+	if c.FlushInterval < 0 {
+		return fmt.Errorf("flush interval of memory block must not be negative")
+	}
+	if c.MemoryLimit < 0 {
+		return fmt.Errorf("memory limit of memory block must not be negative")
+	}
+	return nil
+}
+
+func (c *MemoryBlock) GetFlushInterval() time.Duration {
+	if c.FlushInterval == 0 {
+		return defaultMemoryBlockFlushInterval
+	}
+	return c.FlushInterval
+}
+
+func (c *MemoryBlock) GetMemoryLimit() int64 {
+	if c.MemoryLimit == 0 {
+		return defaultMemoryBlockMemoryLimit
+	}
+	return c.MemoryLimit
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"fmt"
+	"time"
+)
+
+const (
+	defaultScrubInterval = 24 * time.Hour
+	defaultScrubRate     = 16 * baseMB
+)
+
+// Scrub re-reads archived blocks in the background and verifies checksums of their events, so that bit rot
+// is found before the events are read.
+type Scrub struct {
+	Enable bool `yaml:"enable"`
+	// Interval is the pause between two passes over all blocks, 0 is 24h.
+	Interval time.Duration `yaml:"interval"`
+	// Rate limits bytes read per second, so that scrubbing doesn't starve reads of consumers, 0 is 16MB.
+	Rate int `yaml:"rate"`
+}
+
+func (c *Scrub) Validate() error {
+	if c.Interval < 0 {
+		return fmt.Errorf("interval of scrub must not be negative")
+	}
+	if c.Rate < 0 {
+		return fmt.Errorf("rate of scrub must not be negative")
+	}
+	return nil
+}
+
+func (c *Scrub) GetInterval() time.Duration {
+	if c.Interval == 0 {
+		return defaultScrubInterval
+	}
+	return c.Interval
+}
+
+func (c *Scrub) GetRate() int {
+	if c.Rate == 0 {
+		return defaultScrubRate
+	}
+	return c.Rate
+}
@@ -0,0 +1,38 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	// standard libraries.
+	"fmt"
+)
+
+// AppendRateLimit limits appends to each block by token buckets, so that a noisy producer can't starve
+// blocks of other eventlogs on the same disk. Appends beyond the limits are rejected with ErrThrottled,
+// which carries the time to retry after. A bucket holds tokens of one second.
+type AppendRateLimit struct {
+	Enable bool `yaml:"enable"`
+	// BytesPerSecond is the size of events appended to a block per second, 0 is unlimited.
+	BytesPerSecond int `yaml:"bytes_per_second"`
+	// EventsPerSecond is the number of events appended to a block per second, 0 is unlimited.
+	EventsPerSecond int `yaml:"events_per_second"`
+}
+
+func (c *AppendRateLimit) Validate() error {
+	if c.BytesPerSecond < 0 || c.EventsPerSecond < 0 {
+		return fmt.Errorf("bytes and events per second of append rate limit must not be negative")
+	}
+	return nil
+}
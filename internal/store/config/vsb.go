@@ -15,16 +15,78 @@
 package config
 
 import (
+	// standard libraries.
+	"fmt"
+	"time"
+
 	// this project.
+	"github.com/linkall-labs/vanus/internal/store/io/engine/fsync"
 	"github.com/linkall-labs/vanus/internal/store/vsb"
 )
 
+const (
+	defaultSparseIndexStride       = 16
+	defaultSparseIndexMaxEntrySize = 256
+)
+
 type VSB struct {
 	FlushBatchSize int `yaml:"flush_batch_size"`
-	IO             `yaml:"io"`
+	// RepairIndex rebuilds indexes and corrects the header of blocks which are inconsistent with their
+	// entries when they are opened, otherwise such blocks fail to open.
+	RepairIndex bool `yaml:"repair_index"`
+	IO          `yaml:"io"`
+	Sync        SyncConfig `yaml:"sync"`
+	// MmapRead reads archived blocks from memory maps, which saves a syscall for each read.
+	MmapRead bool `yaml:"mmap_read"`
+	// Compression is one of snappy and zstd, blocks are compressed once they are archived if it's set.
+	Compression vsb.Compression   `yaml:"compression"`
+	Preallocate PreallocateConfig `yaml:"preallocate"`
+	// MetaWAL logs indexes of entries appended to working blocks, so that they are replayed instead of
+	// rescanning whole blocks after a crash.
+	MetaWAL     bool              `yaml:"meta_wal"`
+	SparseIndex SparseIndexConfig `yaml:"sparse_index"`
+}
+
+// SparseIndexConfig keeps indexes of every Stride-th entry in memory for archived blocks whose average entry
+// size is at most MaxEntrySize, entries between them are found by scanning.
+type SparseIndexConfig struct {
+	Enable       bool `yaml:"enable"`
+	Stride       int  `yaml:"stride"`
+	MaxEntrySize int  `yaml:"max_entry_size"`
+}
+
+// PreallocateConfig allocates files of blocks in full when they are created, so appends don't wait for the
+// file system to grow them. Spare files of each block size are kept ready, including files of deleted
+// blocks, which are zeroed for reuse.
+type PreallocateConfig struct {
+	Enable bool `yaml:"enable"`
+	Spares int  `yaml:"spares"`
+}
+
+// SyncConfig replaces synchronous writes of block files with syncing them in groups. Mode is one of sync,
+// interval and async, each write is synchronous if it's empty.
+type SyncConfig struct {
+	Mode     fsync.Mode    `yaml:"mode"`
+	Interval time.Duration `yaml:"interval"`
 }
 
 func (c *VSB) Validate() error {
+	switch c.Sync.Mode {
+	case "", fsync.ModeSync, fsync.ModeInterval, fsync.ModeAsync:
+	default:
+		return fmt.Errorf("unknown sync mode of vsb: %s", c.Sync.Mode)
+	}
+	switch c.Compression {
+	case vsb.CompressionNone, vsb.CompressionSnappy, vsb.CompressionZstd:
+	default:
+		return fmt.Errorf("unknown compression of vsb: %s", c.Compression)
+	}
+	if c.Preallocate.Spares < 0 {
+		return fmt.Errorf("spares of vsb preallocation can not be negative")
+	}
+	if c.SparseIndex.Stride < 0 || c.SparseIndex.MaxEntrySize < 0 {
+		return fmt.Errorf("stride and max entry size of vsb sparse index can not be negative")
+	}
 	return nil
 }
 
@@ -32,8 +94,36 @@ func (c *VSB) Options() (opts []vsb.Option) {
 	if c.FlushBatchSize != 0 {
 		opts = append(opts, vsb.WithFlushBatchSize(c.FlushBatchSize))
 	}
+	if c.RepairIndex {
+		opts = append(opts, vsb.WithIndexRepair(true))
+	}
 	if c.IO.Engine != "" {
 		opts = append(opts, vsb.WithIOEngine(buildIOEngine(c.IO)))
 	}
+	if c.Sync.Mode != "" {
+		opts = append(opts, vsb.WithSyncMode(c.Sync.Mode, c.Sync.Interval))
+	}
+	if c.MmapRead {
+		opts = append(opts, vsb.WithMmapRead(true))
+	}
+	if c.Compression != vsb.CompressionNone {
+		opts = append(opts, vsb.WithCompression(c.Compression))
+	}
+	if c.Preallocate.Enable {
+		opts = append(opts, vsb.WithPreallocation(c.Preallocate.Spares))
+	}
+	if c.MetaWAL {
+		opts = append(opts, vsb.WithMetaWAL(true))
+	}
+	if c.SparseIndex.Enable {
+		stride, maxEntrySize := c.SparseIndex.Stride, c.SparseIndex.MaxEntrySize
+		if stride == 0 {
+			stride = defaultSparseIndexStride
+		}
+		if maxEntrySize == 0 {
+			maxEntrySize = defaultSparseIndexMaxEntrySize
+		}
+		opts = append(opts, vsb.WithSparseIndex(stride, maxEntrySize))
+	}
 	return opts
 }
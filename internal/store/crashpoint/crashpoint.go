@@ -0,0 +1,88 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crashpoint kills the process at named points of the store, so that tests can exercise recovery
+// from crashes at the exact moments which are hard to hit by killing the process from outside.
+package crashpoint
+
+import (
+	// standard libraries.
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Env enables crash points of the process, e.g. "wal.append.persisted:3,vsb.seal.before_header" kills the
+// process when the first point is hit for the 3rd time or the second point is hit. It's read once at
+// startup.
+const Env = "VANUS_CRASH_POINTS"
+
+const (
+	// WALAppendPersisted is hit after records are written to WAL but before the append is acknowledged.
+	WALAppendPersisted = "wal.append.persisted"
+	// BlockAppendBeforeWrite is hit after entries are committed by raft but before they are written to
+	// the block.
+	BlockAppendBeforeWrite = "vsb.append.before_write"
+	// BlockSealBeforeIndex is hit after the end entry is written but before indexes are.
+	BlockSealBeforeIndex = "vsb.seal.before_index"
+	// BlockSealBeforeHeader is hit after indexes are written but before the header is updated.
+	BlockSealBeforeHeader = "vsb.seal.before_header"
+)
+
+// points is nil unless crash points are enabled, so that Inject costs nothing in production.
+var points map[string]*int64
+
+func init() {
+	points = parse(os.Getenv(Env))
+}
+
+func parse(v string) map[string]*int64 {
+	if v == "" {
+		return nil
+	}
+	result := make(map[string]*int64)
+	for _, item := range strings.Split(v, ",") {
+		name, count := strings.TrimSpace(item), int64(1)
+		if i := strings.LastIndexByte(name, ':'); i >= 0 {
+			if n, err := strconv.ParseInt(name[i+1:], 10, 64); err == nil && n > 0 {
+				count = n
+			}
+			name = name[:i]
+		}
+		if name != "" {
+			result[name] = &count
+		}
+	}
+	return result
+}
+
+// Inject kills the process without any cleanup like kill -9 does, if the point is enabled and has been
+// hit as many times as configured.
+func Inject(name string) {
+	if points == nil {
+		return
+	}
+	remaining, ok := points[name]
+	if !ok || atomic.AddInt64(remaining, -1) != 0 {
+		return
+	}
+	_, _ = fmt.Fprintf(os.Stderr, "crashpoint: %s\n", name)
+	p, err := os.FindProcess(os.Getpid())
+	if err == nil {
+		_ = p.Kill()
+	}
+	os.Exit(137)
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crashpoint
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParse(t *testing.T) {
+	Convey("test parse crash points", t, func() {
+		So(parse(""), ShouldBeNil)
+
+		points := parse("wal.append.persisted:3, vsb.seal.before_header,vsb.append.before_write:x,:2")
+		So(points, ShouldHaveLength, 3)
+		So(*points[WALAppendPersisted], ShouldEqual, 3)
+		So(*points[BlockSealBeforeHeader], ShouldEqual, 1)
+		So(*points[BlockAppendBeforeWrite], ShouldEqual, 1)
+	})
+
+	Convey("test inject disabled crash points", t, func() {
+		points = parse(BlockSealBeforeIndex + ":2")
+		defer func() {
+			points = nil
+		}()
+		Inject(BlockSealBeforeHeader)
+		Inject(BlockSealBeforeIndex)
+		So(*points[BlockSealBeforeIndex], ShouldEqual, 1)
+	})
+}
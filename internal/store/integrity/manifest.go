@@ -0,0 +1,198 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package integrity signs manifests of sealed blocks, which list digests of their events, so that regulated
+// users can prove offline that stored events haven't been altered.
+package integrity
+
+import (
+	// standard libraries.
+	"bufio"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strconv"
+
+	// first-party libraries.
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+const (
+	// canonicalHeader is the first line of the canonical form, it changes with the form.
+	canonicalHeader = "vanus-block-manifest/v1"
+	keyIDSize       = 8
+)
+
+var (
+	ErrKeyMismatch      = errors.New("integrity: the manifest isn't signed by the key")
+	ErrInvalidSignature = errors.New("integrity: invalid signature of the manifest")
+	ErrDigestMismatch   = errors.New("integrity: events don't match the digest")
+)
+
+// NewDigest returns the hash which digests of blocks are computed with. Events are written to it in order,
+// each of which is a CloudEvent message preceded by its size in varint.
+func NewDigest() hash.Hash {
+	return sha256.New()
+}
+
+// KeyID identifies the public key in manifests signed by its private key.
+func KeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:keyIDSize])
+}
+
+// Signer signs manifests with an Ed25519 private key.
+type Signer struct {
+	key   ed25519.PrivateKey
+	keyID string
+}
+
+func NewSigner(key ed25519.PrivateKey) *Signer {
+	pub, _ := key.Public().(ed25519.PublicKey)
+	return &Signer{key: key, keyID: KeyID(pub)}
+}
+
+// LoadSigner reads the Ed25519 private key in a PKCS #8 PEM file, e.g. one generated by
+// `openssl genpkey -algorithm ed25519`.
+func LoadSigner(file string) (*Signer, error) {
+	der, err := readPEM(file, "PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse private key in %s: %w", file, err)
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("the private key in %s isn't an Ed25519 key", file)
+	}
+	return NewSigner(edKey), nil
+}
+
+// Sign sets the key ID and the signature of the manifest.
+func (s *Signer) Sign(m *segpb.BlockManifest) {
+	m.KeyId = s.keyID
+	m.Signature = ed25519.Sign(s.key, Canonical(m))
+}
+
+// LoadPublicKey reads the Ed25519 public key in a PKIX PEM file, e.g. one exported by
+// `openssl pkey -pubout`.
+func LoadPublicKey(file string) (ed25519.PublicKey, error) {
+	der, err := readPEM(file, "PUBLIC KEY")
+	if err != nil {
+		return nil, err
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse public key in %s: %w", file, err)
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("the public key in %s isn't an Ed25519 key", file)
+	}
+	return edKey, nil
+}
+
+func readPEM(file string, typ string) ([]byte, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	b, _ := pem.Decode(data)
+	if b == nil || b.Type != typ {
+		return nil, fmt.Errorf("no %s block in %s", typ, file)
+	}
+	return b.Bytes, nil
+}
+
+// Verify checks that the manifest is signed by the private key of pub and hasn't been altered since.
+func Verify(m *segpb.BlockManifest, pub ed25519.PublicKey) error {
+	if m.KeyId != KeyID(pub) {
+		return ErrKeyMismatch
+	}
+	if !ed25519.Verify(pub, Canonical(m), m.Signature) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Canonical returns the form of the manifest which is signed, which is independent of how the manifest is
+// serialized. It consists of lines:
+//
+//	vanus-block-manifest/v1
+//	volume_id=<volume ID>
+//	server_id=<server ID>
+//	generated_at=<Unix milliseconds>
+//	key_id=<key ID>
+//	block=<block ID>,<event number>,<size>,<SHA-256 in hex>
+//
+// with a block line for each block in order, numbers are in decimal, each line ends with '\n'.
+func Canonical(m *segpb.BlockManifest) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(canonicalHeader + "\n")
+	fmt.Fprintf(&buf, "volume_id=%d\nserver_id=%d\ngenerated_at=%d\nkey_id=%s\n",
+		m.VolumeId, m.ServerId, m.GeneratedAt, m.KeyId)
+	for _, b := range m.Blocks {
+		buf.WriteString("block=")
+		buf.WriteString(strconv.FormatUint(b.Id, 10))
+		buf.WriteByte(',')
+		buf.WriteString(strconv.FormatInt(b.EventNumber, 10))
+		buf.WriteByte(',')
+		buf.WriteString(strconv.FormatInt(b.Size, 10))
+		buf.WriteByte(',')
+		buf.WriteString(hex.EncodeToString(b.Sha256))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// VerifyEvents checks events of the block exported from r, which are encoded as payloads of raw reads,
+// against its digest in a verified manifest.
+func VerifyEvents(d *segpb.BlockDigest, r io.Reader) error {
+	h := NewDigest()
+	br := bufio.NewReader(io.TeeReader(r, h))
+	var num, size int64
+	for {
+		n, err := binary.ReadUvarint(br)
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return fmt.Errorf("%w: truncated event %d", ErrDigestMismatch, num)
+		}
+		if _, err = br.Discard(int(n)); err != nil {
+			return fmt.Errorf("%w: truncated event %d", ErrDigestMismatch, num)
+		}
+		num++
+		size += int64(uvarintSize(n)) + int64(n)
+	}
+	if num != d.EventNumber || size != d.Size || !bytes.Equal(h.Sum(nil), d.Sha256) {
+		return fmt.Errorf("%w: block %d", ErrDigestMismatch, d.Id)
+	}
+	return nil
+}
+
+func uvarintSize(v uint64) int {
+	var buf [binary.MaxVarintLen64]byte
+	return binary.PutUvarint(buf[:], v)
+}
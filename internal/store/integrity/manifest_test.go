@@ -0,0 +1,119 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity
+
+import (
+	// standard libraries.
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+)
+
+func TestManifest(t *testing.T) {
+	Convey("test manifest", t, func() {
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		So(err, ShouldBeNil)
+		signer := NewSigner(key)
+
+		events := bytes.Repeat([]byte{3, 'a', 'b', 'c'}, 10)
+		sum := sha256.Sum256(events)
+		m := &segpb.BlockManifest{
+			VolumeId:    1,
+			ServerId:    2,
+			GeneratedAt: 1700000000000,
+			Blocks: []*segpb.BlockDigest{
+				{Id: 3, EventNumber: 10, Size: int64(len(events)), Sha256: sum[:]},
+				{Id: 4, EventNumber: 0, Size: 0, Sha256: make([]byte, sha256.Size)},
+			},
+		}
+		signer.Sign(m)
+		So(m.KeyId, ShouldEqual, KeyID(pub))
+		So(Verify(m, pub), ShouldBeNil)
+
+		Convey("canonical form", func() {
+			So(string(Canonical(m)), ShouldStartWith, "vanus-block-manifest/v1\nvolume_id=1\nserver_id=2\n"+
+				"generated_at=1700000000000\nkey_id="+KeyID(pub)+"\nblock=3,10,40,")
+		})
+
+		Convey("altered manifest", func() {
+			m.Blocks[0].EventNumber = 9
+			So(Verify(m, pub), ShouldEqual, ErrInvalidSignature)
+		})
+
+		Convey("reordered blocks", func() {
+			m.Blocks[0], m.Blocks[1] = m.Blocks[1], m.Blocks[0]
+			So(Verify(m, pub), ShouldEqual, ErrInvalidSignature)
+		})
+
+		Convey("another key", func() {
+			other, _, _ := ed25519.GenerateKey(rand.Reader)
+			So(Verify(m, other), ShouldEqual, ErrKeyMismatch)
+		})
+
+		Convey("verify events", func() {
+			So(VerifyEvents(m.Blocks[0], bytes.NewReader(events)), ShouldBeNil)
+
+			altered := append([]byte{}, events...)
+			altered[1] = 'x'
+			err = VerifyEvents(m.Blocks[0], bytes.NewReader(altered))
+			So(errors.Is(err, ErrDigestMismatch), ShouldBeTrue)
+
+			err = VerifyEvents(m.Blocks[0], bytes.NewReader(events[:len(events)-2]))
+			So(errors.Is(err, ErrDigestMismatch), ShouldBeTrue)
+
+			err = VerifyEvents(m.Blocks[0], bytes.NewReader(events[:len(events)-4]))
+			So(errors.Is(err, ErrDigestMismatch), ShouldBeTrue)
+		})
+
+		Convey("load keys from PEM files", func() {
+			dir := t.TempDir()
+			der, err := x509.MarshalPKCS8PrivateKey(key)
+			So(err, ShouldBeNil)
+			keyFile := filepath.Join(dir, "manifest.key")
+			So(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600),
+				ShouldBeNil)
+			der, err = x509.MarshalPKIXPublicKey(pub)
+			So(err, ShouldBeNil)
+			pubFile := filepath.Join(dir, "manifest.pub")
+			So(os.WriteFile(pubFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600),
+				ShouldBeNil)
+
+			loaded, err := LoadSigner(keyFile)
+			So(err, ShouldBeNil)
+			loadedPub, err := LoadPublicKey(pubFile)
+			So(err, ShouldBeNil)
+			loaded.Sign(m)
+			So(Verify(m, loadedPub), ShouldBeNil)
+
+			_, err = LoadSigner(pubFile)
+			So(err, ShouldNotBeNil)
+			_, err = LoadPublicKey(keyFile)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsync
+
+import (
+	// standard libraries.
+	"time"
+)
+
+type Mode string
+
+const (
+	// ModeSync calls back writes after their data is synced, writes completed meanwhile share one sync.
+	ModeSync Mode = "sync"
+	// ModeInterval calls back writes once they are written, and syncs written files periodically.
+	ModeInterval Mode = "interval"
+	// ModeAsync never syncs files, the operating system flushes them.
+	ModeAsync Mode = "async"
+)
+
+const (
+	defaultInterval = 100 * time.Millisecond
+)
+
+type config struct {
+	mode     Mode
+	interval time.Duration
+}
+
+func defaultConfig() config {
+	cfg := config{
+		mode:     ModeSync,
+		interval: defaultInterval,
+	}
+	return cfg
+}
+
+type Option func(*config)
+
+func makeConfig(opts ...Option) config {
+	cfg := defaultConfig()
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+func WithMode(mode Mode) Option {
+	return func(cfg *config) {
+		cfg.mode = mode
+	}
+}
+
+// WithInterval sets the period of syncing files in ModeInterval.
+func WithInterval(interval time.Duration) Option {
+	return func(cfg *config) {
+		if interval > 0 {
+			cfg.interval = interval
+		}
+	}
+}
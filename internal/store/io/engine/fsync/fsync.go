@@ -0,0 +1,235 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fsync decorates an io engine whose files aren't opened with O_SYNC, it syncs written files by the
+// configured Mode instead of each write.
+package fsync
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"os"
+	"sync"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/io"
+	"github.com/linkall-labs/vanus/internal/store/io/engine"
+	"github.com/linkall-labs/vanus/internal/store/io/zone"
+)
+
+// Notifier notifies once data written to files is durable.
+type Notifier interface {
+	// AfterSync calls fn once data written to f before is synced.
+	AfterSync(f *os.File, fn func())
+}
+
+type completion struct {
+	n  int
+	cb io.WriteCallback
+}
+
+type fsync struct {
+	e   engine.Interface
+	cfg config
+
+	mu sync.Mutex
+	// pending are writes waiting for syncing their files in ModeSync.
+	pending map[*os.File][]completion
+	// dirty are files written since they were synced last time in ModeInterval.
+	dirty map[*os.File]struct{}
+	// waiters wait for the next sync of their dirty files in ModeInterval.
+	waiters map[*os.File][]func()
+	// closed is set once Close starts, files are synced by AfterSync itself since then.
+	closed bool
+
+	kickC  chan struct{}
+	closeC chan struct{}
+	doneC  chan struct{}
+}
+
+// Make sure fsync implements engine.Interface.
+var _ engine.Interface = (*fsync)(nil)
+
+// Make sure fsync implements Notifier.
+var _ Notifier = (*fsync)(nil)
+
+func New(e engine.Interface, opts ...Option) engine.Interface {
+	cfg := makeConfig(opts...)
+	if cfg.mode == ModeAsync {
+		return e
+	}
+	s := &fsync{
+		e:       e,
+		cfg:     cfg,
+		pending: make(map[*os.File][]completion),
+		dirty:   make(map[*os.File]struct{}),
+		waiters: make(map[*os.File][]func()),
+		kickC:   make(chan struct{}, 1),
+		closeC:  make(chan struct{}),
+		doneC:   make(chan struct{}),
+	}
+	if cfg.mode == ModeInterval {
+		go s.runInterval()
+	} else {
+		go s.runGroup()
+	}
+	return s
+}
+
+// Close syncs dirty files and notifies their waiters before it returns.
+func (s *fsync) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.mu.Unlock()
+	close(s.closeC)
+	<-s.doneC
+	s.e.Close()
+}
+
+func (s *fsync) WriteAt(z zone.Interface, b []byte, off int64, so, eo int, cb io.WriteCallback) {
+	f, _ := z.Raw(off)
+	s.e.WriteAt(z, b, off, so, eo, func(n int, err error) {
+		if err != nil {
+			cb(n, err)
+			return
+		}
+		s.mu.Lock()
+		if s.cfg.mode == ModeInterval {
+			s.dirty[f] = struct{}{}
+			s.mu.Unlock()
+			cb(n, nil)
+			return
+		}
+		s.pending[f] = append(s.pending[f], completion{n: n, cb: cb})
+		s.mu.Unlock()
+		select {
+		case s.kickC <- struct{}{}:
+		default:
+		}
+	})
+}
+
+// AfterSync calls fn once data written to f before is synced. Writes are called back after their data is
+// synced in ModeSync, so fn is called immediately then. Once the engine is closed, there is no next sync in
+// ModeInterval, so f is synced before AfterSync returns.
+func (s *fsync) AfterSync(f *os.File, fn func()) {
+	if s.cfg.mode != ModeInterval {
+		fn()
+		return
+	}
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		if syncFile(f) {
+			fn()
+		}
+		return
+	}
+	s.dirty[f] = struct{}{}
+	s.waiters[f] = append(s.waiters[f], fn)
+	s.mu.Unlock()
+}
+
+// runGroup syncs files of pending writes, writes completed while a group is being synced make up the next
+// group.
+func (s *fsync) runGroup() {
+	defer close(s.doneC)
+	for {
+		select {
+		case <-s.kickC:
+			s.syncPending()
+		case <-s.closeC:
+			s.syncPending()
+			return
+		}
+	}
+}
+
+func (s *fsync) syncPending() {
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = make(map[*os.File][]completion, len(pending))
+	s.mu.Unlock()
+
+	for f, group := range pending {
+		err := f.Sync()
+		for _, c := range group {
+			if err != nil {
+				c.cb(0, err)
+			} else {
+				c.cb(c.n, nil)
+			}
+		}
+	}
+}
+
+func (s *fsync) runInterval() {
+	defer close(s.doneC)
+	ticker := time.NewTicker(s.cfg.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.syncDirty()
+		case <-s.closeC:
+			s.syncDirty()
+			return
+		}
+	}
+}
+
+// syncDirty syncs dirty files and notifies their waiters. Waiters of files which fail to sync wait for the
+// next sync, they are dropped if the engine is closed, as if it crashed.
+func (s *fsync) syncDirty() {
+	s.mu.Lock()
+	dirty, waiters := s.dirty, s.waiters
+	s.dirty = make(map[*os.File]struct{}, len(dirty))
+	s.waiters = make(map[*os.File][]func(), len(waiters))
+	s.mu.Unlock()
+
+	for f := range dirty {
+		if !syncFile(f) {
+			// Waiters wait for the next sync, ahead of those arriving meanwhile.
+			if fns := waiters[f]; len(fns) != 0 {
+				s.mu.Lock()
+				if !s.closed {
+					s.dirty[f] = struct{}{}
+					s.waiters[f] = append(fns, s.waiters[f]...)
+				}
+				s.mu.Unlock()
+			}
+			continue
+		}
+		for _, fn := range waiters[f] {
+			fn()
+		}
+	}
+}
+
+func syncFile(f *os.File) bool {
+	// The file may be closed after it was written, it's synced when it's closed.
+	if err := f.Sync(); err != nil && !stderr.Is(err, os.ErrClosed) {
+		log.Error(context.Background(), "sync file failed", map[string]interface{}{
+			log.KeyError: err,
+			"file":       f.Name(),
+		})
+		return false
+	}
+	return true
+}
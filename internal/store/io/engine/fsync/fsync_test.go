@@ -0,0 +1,132 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsync
+
+import (
+	// standard libraries.
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/io/engine/psync"
+	enginetest "github.com/linkall-labs/vanus/internal/store/io/engine/testing"
+	"github.com/linkall-labs/vanus/internal/store/io/zone/file"
+)
+
+func TestFsync(t *testing.T) {
+	f, err := os.CreateTemp("", "wal-engine-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+
+	for _, mode := range []Mode{ModeSync, ModeInterval, ModeAsync} {
+		Convey("fsync in "+string(mode)+" mode", t, func() {
+			e := New(psync.New(), WithMode(mode))
+			defer e.Close()
+			enginetest.DoEngineTest(e, f)
+		})
+	}
+
+	Convey("group writes in sync mode", t, func() {
+		e := New(psync.New())
+		z, err := file.New(f)
+		So(err, ShouldBeNil)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		written := 0
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			e.WriteAt(z, []byte{byte(i)}, int64(i), 0, 0, func(n int, err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				if err == nil {
+					written += n
+				}
+				wg.Done()
+			})
+		}
+		wg.Wait()
+		So(written, ShouldEqual, 100)
+
+		e.Close()
+		buf := make([]byte, 100)
+		_, err = f.ReadAt(buf, 0)
+		So(err, ShouldBeNil)
+		So(buf[99], ShouldEqual, 99)
+	})
+
+	Convey("notify after sync", t, func() {
+		z, err := file.New(f)
+		So(err, ShouldBeNil)
+
+		Convey("in sync mode", func() {
+			e := New(psync.New())
+			defer e.Close()
+
+			notified := false
+			e.(Notifier).AfterSync(f, func() {
+				notified = true
+			})
+			So(notified, ShouldBeTrue)
+		})
+
+		Convey("in interval mode", func() {
+			e := New(psync.New(), WithMode(ModeInterval), WithInterval(time.Hour))
+
+			written := make(chan struct{})
+			e.WriteAt(z, []byte{1}, 0, 0, 0, func(n int, err error) {
+				close(written)
+			})
+			<-written
+
+			notified := make(chan struct{})
+			e.(Notifier).AfterSync(f, func() {
+				close(notified)
+			})
+			select {
+			case <-notified:
+				t.Fatal("notified before the file is synced")
+			case <-time.After(50 * time.Millisecond):
+			}
+
+			// Close syncs dirty files at last.
+			e.Close()
+			_, ok := <-notified
+			So(ok, ShouldBeFalse)
+
+			// Files are synced by AfterSync once the engine is closed.
+			synced := false
+			e.(Notifier).AfterSync(f, func() {
+				synced = true
+			})
+			So(synced, ShouldBeTrue)
+		})
+
+		Convey("in async mode", func() {
+			e := New(psync.New(), WithMode(ModeAsync))
+			defer e.Close()
+
+			_, ok := e.(Notifier)
+			So(ok, ShouldBeFalse)
+		})
+	})
+}
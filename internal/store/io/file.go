@@ -14,6 +14,79 @@
 
 package io
 
+import (
+	// standard libraries.
+	"os"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
 const (
 	defaultFilePerm = 0o644
 )
+
+// Opener opens files of the store which are written by io engines, e.g. files of WAL. Flags like O_DIRECT
+// and O_NOATIME aren't supported by all platforms and file systems, so they're hidden behind it.
+type Opener interface {
+	OpenFile(path string, wronly bool, sync bool) (*os.File, error)
+	CreateFile(path string, size int64, wronly bool, sync bool) (*os.File, error)
+}
+
+var opener Opener = newDirectOpener()
+
+// SetOpener replaces the Opener used by OpenFile and CreateFile, it must be called before any file is opened.
+func SetOpener(o Opener) {
+	opener = o
+}
+
+func OpenFile(path string, wronly bool, sync bool) (*os.File, error) {
+	return opener.OpenFile(path, wronly, sync)
+}
+
+func CreateFile(path string, size int64, wronly bool, sync bool) (*os.File, error) {
+	return opener.CreateFile(path, size, wronly, sync)
+}
+
+// plainOpener opens files through the page cache by flags of the os package, it works on all platforms.
+type plainOpener struct{}
+
+// NewPlainOpener returns an Opener without direct I/O, e.g. for development on macOS or in containers whose
+// file systems reject O_DIRECT.
+func NewPlainOpener() Opener {
+	return plainOpener{}
+}
+
+func (plainOpener) OpenFile(path string, wronly bool, sync bool) (*os.File, error) {
+	return os.OpenFile(path, plainFlag(0, wronly, sync), 0)
+}
+
+func (plainOpener) CreateFile(path string, size int64, wronly bool, sync bool) (*os.File, error) {
+	f, err := os.OpenFile(path, plainFlag(os.O_CREATE|os.O_EXCL, wronly, sync), defaultFilePerm)
+	if err != nil {
+		return nil, err
+	}
+	return resize(f, size)
+}
+
+func plainFlag(flag int, wronly bool, sync bool) int {
+	if wronly {
+		flag |= os.O_WRONLY
+	} else {
+		flag |= os.O_RDWR
+	}
+	if sync {
+		flag |= os.O_SYNC
+	}
+	return flag
+}
+
+func resize(f *os.File, size int64) (*os.File, error) {
+	if err := f.Truncate(size); err != nil {
+		if err2 := f.Close(); err2 != nil {
+			return f, errors.Chain(err, err2)
+		}
+		return nil, err
+	}
+	return f, nil
+}
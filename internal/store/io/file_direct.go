@@ -0,0 +1,83 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"os"
+	"sync/atomic"
+	"syscall"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// directOpener opens files with direct I/O, and falls back to plainOpener once the file system rejects it,
+// e.g. tmpfs, or overlayfs of some container runtimes.
+type directOpener struct {
+	unsupported int32
+}
+
+// NewDirectOpener returns an Opener with direct I/O, which falls back to buffered I/O on its own, e.g. for
+// files of a volume which may be on another file system than WAL.
+func NewDirectOpener() Opener {
+	return newDirectOpener()
+}
+
+func newDirectOpener() *directOpener {
+	return &directOpener{}
+}
+
+func (o *directOpener) OpenFile(path string, wronly bool, sync bool) (*os.File, error) {
+	if atomic.LoadInt32(&o.unsupported) == 0 {
+		f, err := openDirect(path, directFlag(0, wronly, sync), 0)
+		if !o.rejected(path, err) {
+			return f, err
+		}
+	}
+	return plainOpener{}.OpenFile(path, wronly, sync)
+}
+
+func (o *directOpener) CreateFile(path string, size int64, wronly bool, sync bool) (*os.File, error) {
+	if atomic.LoadInt32(&o.unsupported) == 0 {
+		f, err := openDirect(path, directFlag(os.O_CREATE|os.O_EXCL, wronly, sync), defaultFilePerm)
+		if !o.rejected(path, err) {
+			if err != nil {
+				return nil, err
+			}
+			return resize(f, size)
+		}
+		// The file may have been created before the flags were checked, it didn't exist because of O_EXCL.
+		_ = os.Remove(path)
+	}
+	return plainOpener{}.CreateFile(path, size, wronly, sync)
+}
+
+// rejected reports whether err means direct I/O isn't supported, files are opened without it since then.
+func (o *directOpener) rejected(path string, err error) bool {
+	if err == nil || !stderr.Is(err, syscall.EINVAL) {
+		return false
+	}
+	if atomic.CompareAndSwapInt32(&o.unsupported, 0, 1) {
+		log.Warning(context.Background(), "direct I/O isn't supported by the file system, use buffered I/O",
+			map[string]interface{}{
+				"path":       path,
+				log.KeyError: err,
+			})
+	}
+	return true
+}
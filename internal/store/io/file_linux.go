@@ -19,38 +19,25 @@ package io
 
 import (
 	// standard libraries.
+	stderr "errors"
 	"os"
 	"syscall"
 
 	// third-party libraries.
 	"github.com/ncw/directio"
-
-	// first-party libraries.
-	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
-func OpenFile(path string, wronly bool, sync bool) (*os.File, error) {
-	flag := makeFlag(syscall.O_NOATIME, wronly, sync)
-	return directio.OpenFile(path, flag, 0)
-}
-
-func CreateFile(path string, size int64, wronly bool, sync bool) (*os.File, error) {
-	flag := makeFlag(os.O_CREATE|os.O_EXCL|syscall.O_NOATIME, wronly, sync)
-	f, err := directio.OpenFile(path, flag, defaultFilePerm)
-	if err != nil {
-		return nil, err
-	}
-	// Resize file.
-	if err = f.Truncate(size); err != nil {
-		if err2 := f.Close(); err2 != nil {
-			return f, errors.Chain(err, err2)
-		}
-		return nil, err
+// openDirect opens the file with O_NOATIME if it's allowed, which is only for owners of files unless the
+// process has CAP_FOWNER.
+func openDirect(path string, flag int, perm os.FileMode) (*os.File, error) {
+	f, err := directio.OpenFile(path, flag|syscall.O_NOATIME, perm)
+	if stderr.Is(err, syscall.EPERM) {
+		return directio.OpenFile(path, flag, perm)
 	}
-	return f, nil
+	return f, err
 }
 
-func makeFlag(flag int, wronly bool, sync bool) int {
+func directFlag(flag int, wronly bool, sync bool) int {
 	if wronly {
 		flag |= os.O_WRONLY
 	} else {
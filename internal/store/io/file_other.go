@@ -23,40 +23,13 @@ import (
 
 	// third-party libraries.
 	"github.com/ncw/directio"
-
-	// first-party libraries.
-	"github.com/linkall-labs/vanus/pkg/errors"
 )
 
-func OpenFile(path string, wronly bool, sync bool) (*os.File, error) {
-	flag := makeFlag(0, wronly, sync)
-	return directio.OpenFile(path, flag, 0)
-}
-
-func CreateFile(path string, size int64, wronly bool, sync bool) (*os.File, error) {
-	flag := makeFlag(os.O_CREATE|os.O_EXCL, wronly, sync)
-	f, err := directio.OpenFile(path, flag, defaultFilePerm)
-	if err != nil {
-		return nil, err
-	}
-	// Resize file.
-	if err = f.Truncate(size); err != nil {
-		if err2 := f.Close(); err2 != nil {
-			return f, errors.Chain(err, err2)
-		}
-		return nil, err
-	}
-	return f, nil
+// openDirect disables caches of the file by the way of the platform, e.g. F_NOCACHE on macOS.
+func openDirect(path string, flag int, perm os.FileMode) (*os.File, error) {
+	return directio.OpenFile(path, flag, perm)
 }
 
-func makeFlag(flag int, wronly bool, sync bool) int {
-	if wronly {
-		flag |= os.O_WRONLY
-	} else {
-		flag |= os.O_RDWR
-	}
-	if sync {
-		flag |= os.O_SYNC
-	}
-	return flag
+func directFlag(flag int, wronly bool, sync bool) int {
+	return plainFlag(flag, wronly, sync)
 }
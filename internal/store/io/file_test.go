@@ -0,0 +1,58 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	// standard libraries.
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOpener(t *testing.T) {
+	Convey("test opener", t, func() {
+		dir := t.TempDir()
+		const size = 8 * 1024
+
+		test := func(o Opener) {
+			path := filepath.Join(dir, "file")
+			f, err := o.CreateFile(path, size, true, true)
+			So(err, ShouldBeNil)
+			fi, err := f.Stat()
+			So(err, ShouldBeNil)
+			So(fi.Size(), ShouldEqual, size)
+			So(f.Close(), ShouldBeNil)
+
+			_, err = o.CreateFile(path, size, true, true)
+			So(os.IsExist(err), ShouldBeTrue)
+
+			f, err = o.OpenFile(path, false, true)
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+		}
+
+		Convey("plain opener", func() {
+			test(NewPlainOpener())
+		})
+
+		Convey("direct opener", func() {
+			// Direct I/O is used if the file system supports it, otherwise files are opened without it.
+			test(newDirectOpener())
+		})
+	})
+}
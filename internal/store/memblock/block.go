@@ -0,0 +1,602 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memblock
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/util/clock"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+const FormatMagic = uint32(0x00626d76) // ASCII of "vmb" in little endian
+
+// The header of Block is kept at the beginning of data, so that offsets of entries are the same in memory
+// and in the flushed file.
+const (
+	headerSize = 4 + 4 + 8
+
+	magicOffset    = 0
+	capacityOffset = 8
+)
+
+// initialBufferSize is the capacity of the buffer of a new Block, buffers grow as entries are appended, so
+// that memory isn't reserved for blocks which are never filled.
+const initialBufferSize = 64 * 1024
+
+var errCorruptedFragment = errors.New("memblock: corrupted fragment")
+
+type appendContext struct {
+	seq      int64
+	offset   int64
+	archived uint32
+}
+
+// Make sure appendContext implements block.AppendContext.
+var _ block.AppendContext = (*appendContext)(nil)
+
+func (c *appendContext) WriteOffset() int64 {
+	return c.offset
+}
+
+func (c *appendContext) Archived() bool {
+	return c.archived != 0
+}
+
+// memBlock keeps the header and entries of Block in memory. Entries are committed once they are copied,
+// so they're readable right after CommitAppend.
+type memBlock struct {
+	id       vanus.ID
+	capacity int64
+
+	mu sync.RWMutex
+	// data is only appended, so slices of it taken by reads are never overwritten. It's copied to a larger
+	// buffer once it's full, and cap(data) is accounted in memory of engine until Block is deleted.
+	data    []byte
+	freed   bool
+	indexes []index.Index
+	actx    appendContext
+
+	enc codec.EntryEncoder
+	dec codec.EntryDecoder
+	lis block.ArchivedListener
+	clk *clock.Monotonic
+	e   *engine
+
+	// fmu guards f and flushed, f is nil if Block isn't flushed.
+	fmu     sync.Mutex
+	path    string
+	f       *os.File
+	flushed int64
+}
+
+// Make sure memBlock implements block.Raw.
+var _ block.Raw = (*memBlock)(nil)
+
+func newBlock(e *engine, id vanus.ID, capacity int64) *memBlock {
+	dec, _ := codec.NewDecoder(true, codec.IndexSize)
+	size := capacity
+	if size > initialBufferSize {
+		size = initialBufferSize
+	}
+	data := make([]byte, headerSize, headerSize+size)
+	e.allocate(int64(cap(data)))
+	binary.LittleEndian.PutUint32(data[magicOffset:], FormatMagic)
+	binary.LittleEndian.PutUint64(data[capacityOffset:], uint64(capacity))
+	return &memBlock{
+		id:       id,
+		capacity: capacity,
+		data:     data,
+		actx: appendContext{
+			offset: headerSize,
+		},
+		enc: codec.NewEncoder(),
+		dec: dec,
+		lis: e.lis,
+		clk: e.clk,
+		e:   e,
+	}
+}
+
+func (b *memBlock) ID() vanus.ID {
+	return b.id
+}
+
+func (b *memBlock) Open(context.Context) error {
+	return nil
+}
+
+// Close flushes Block if it's flushed, data in memory is kept until Block is deleted.
+func (b *memBlock) Close(context.Context) error {
+	if err := b.flush(); err != nil {
+		return err
+	}
+	b.fmu.Lock()
+	defer b.fmu.Unlock()
+	if b.f == nil {
+		return nil
+	}
+	b.e.blocks.Delete(b.id)
+	err := b.f.Close()
+	b.f = nil
+	return err
+}
+
+func (b *memBlock) Delete(context.Context) error {
+	b.e.blocks.Delete(b.id)
+	b.free()
+	b.fmu.Lock()
+	defer b.fmu.Unlock()
+	if b.f != nil {
+		_ = b.f.Close()
+		b.f = nil
+	}
+	if b.path == "" {
+		return nil
+	}
+	if err := os.Remove(b.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// flush writes data appended since the last flush to the file, and syncs it.
+func (b *memBlock) flush() error {
+	b.fmu.Lock()
+	defer b.fmu.Unlock()
+	if b.f == nil {
+		return nil
+	}
+
+	b.mu.RLock()
+	data := b.data
+	b.mu.RUnlock()
+
+	if int64(len(data)) <= b.flushed {
+		return nil
+	}
+	if _, err := b.f.WriteAt(data[b.flushed:], b.flushed); err != nil {
+		return err
+	}
+	if err := b.f.Sync(); err != nil {
+		return err
+	}
+	b.flushed = int64(len(data))
+	return nil
+}
+
+// load rebuilds Block from flushed entries, it stops at the first entry which is torn.
+func (b *memBlock) load(data []byte) error {
+	for off := 0; off < len(data); {
+		n, entry, err := b.dec.Unmarshal(data[off:])
+		if err != nil {
+			break
+		}
+		if ceschema.SequenceNumber(entry) != b.actx.seq {
+			return errCorruptedFragment
+		}
+		b.append(data[off:off+n], entry)
+		off += n
+		if b.actx.Archived() {
+			break
+		}
+	}
+	return nil
+}
+
+// free releases memory of the buffer from engine, the buffer itself is kept for reads in flight.
+func (b *memBlock) free() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.freed {
+		b.freed = true
+		b.e.allocate(-int64(cap(b.data)))
+	}
+}
+
+// growth returns the capacity which the buffer grows to for n more bytes, it's the current capacity if
+// the buffer is large enough. The buffer doubles, but not beyond the capacity of Block unless n requires.
+func (b *memBlock) growth(n int) int {
+	c, need := cap(b.data), len(b.data)+n
+	if need <= c {
+		return c
+	}
+	c *= 2
+	if limit := headerSize + int(b.capacity); c > limit {
+		c = limit
+	}
+	if c < need {
+		c = need
+	}
+	return c
+}
+
+// append appends the data of entry, the caller must hold mu if Block is shared.
+func (b *memBlock) append(data []byte, entry block.Entry) {
+	if c := b.growth(len(data)); c > cap(b.data) {
+		buf := make([]byte, len(b.data), c)
+		copy(buf, b.data)
+		if !b.freed {
+			b.e.allocate(int64(c - cap(b.data)))
+		}
+		b.data = buf
+	}
+	off := int64(len(b.data))
+	b.data = append(b.data, data...)
+	b.actx.seq++
+	b.actx.offset = int64(len(b.data))
+	if ceschema.EntryType(entry) == ceschema.End {
+		atomic.StoreUint32(&b.actx.archived, 1)
+		return
+	}
+	b.indexes = append(b.indexes, index.NewIndex(off, int32(len(data)), index.WithEntry(entry)))
+}
+
+func (b *memBlock) status() block.Statistics {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.stat()
+}
+
+func (b *memBlock) stat() block.Statistics {
+	s := block.Statistics{
+		ID:              b.id,
+		Capacity:        uint64(b.capacity),
+		Archived:        b.actx.Archived(),
+		EntryNum:        uint32(len(b.indexes)),
+		FirstEntryStime: -1,
+		LastEntryStime:  -1,
+	}
+	if sz := len(b.indexes); sz != 0 {
+		s.EntrySize = uint64(b.indexes[sz-1].EndOffset() - b.indexes[0].StartOffset())
+		s.FirstEntryStime = b.indexes[0].Stime()
+		s.LastEntryStime = b.indexes[sz-1].Stime()
+	}
+	return s
+}
+
+func (b *memBlock) NewAppendContext(last block.Fragment) block.AppendContext {
+	if last != nil {
+		_, entry, _ := b.dec.UnmarshalLast(last.Payload())
+		actx := &appendContext{
+			seq:    ceschema.SequenceNumber(entry) + 1,
+			offset: last.EndOffset(),
+		}
+		if ceschema.EntryType(entry) == ceschema.End {
+			actx.archived = 1
+		}
+		return actx
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	actx := b.actx
+	return &actx
+}
+
+func (b *memBlock) PrepareAppend(
+	ctx context.Context, appendCtx block.AppendContext, entries ...block.Entry,
+) ([]int64, block.Fragment, bool, error) {
+	actx, _ := appendCtx.(*appendContext)
+
+	num := int64(len(entries))
+	ents := make([]block.Entry, num)
+	seqs := make([]int64, num)
+
+	now := b.now()
+	for i := int64(0); i < num; i++ {
+		seq := actx.seq + i
+		ents[i] = wrapEntry(entries[i], ceschema.CloudEvent, seq, now)
+		seqs[i] = seq
+	}
+
+	frag, err := b.newFragment(ctx, actx.offset, ents)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if err = b.checkMemory(frag); err != nil {
+		return nil, nil, false, err
+	}
+
+	actx.offset = frag.EndOffset()
+	actx.seq += num
+
+	return seqs, frag, actx.offset-headerSize >= b.capacity, nil
+}
+
+func (b *memBlock) PrepareArchive(ctx context.Context, appendCtx block.AppendContext) (block.Fragment, error) {
+	actx, _ := appendCtx.(*appendContext)
+
+	end := wrapEntry(&block.EmptyEntryExt{}, ceschema.End, actx.seq, b.now())
+	frag, err := b.newFragment(ctx, actx.offset, []block.Entry{end})
+	if err != nil {
+		return nil, err
+	}
+
+	actx.offset = frag.EndOffset()
+	actx.seq++
+	actx.archived = 1
+
+	return frag, nil
+}
+
+// checkMemory rejects frag if the buffer needs to grow for it, but memory of engine is exhausted.
+func (b *memBlock) checkMemory(frag block.Fragment) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	// Fragments prepared but not committed yet are ahead of data.
+	n := int(frag.EndOffset()) - len(b.data)
+	if c := b.growth(n); c > cap(b.data) {
+		return b.e.checkMemory(int64(c - cap(b.data)))
+	}
+	return nil
+}
+
+func (b *memBlock) newFragment(ctx context.Context, offset int64, entries []block.Entry) (block.Fragment, error) {
+	sz := 0
+	for _, entry := range entries {
+		sz += b.enc.Size(entry)
+	}
+	data := make([]byte, 8+sz)
+	binary.LittleEndian.PutUint64(data, uint64(offset))
+	for off := 8; off < len(data); {
+		n, err := b.enc.MarshalTo(ctx, entries[0], data[off:])
+		if err != nil {
+			return nil, err
+		}
+		entries = entries[1:]
+		off += n
+	}
+	return block.NewFragment(data), nil
+}
+
+func (b *memBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb block.CommitAppendCallback) {
+	if frag == nil {
+		cb()
+		return
+	}
+
+	b.mu.Lock()
+	if off := b.actx.offset; frag.StartOffset() != off {
+		b.mu.Unlock()
+		if frag.EndOffset() <= off {
+			log.Info(ctx, "memblock: data of fragment has been written, skip this entry.", map[string]interface{}{
+				"block_id":              b.id,
+				"expected":              off,
+				"fragment_start_offset": frag.StartOffset(),
+				"fragment_end_offset":   frag.EndOffset(),
+			})
+			return
+		}
+		log.Error(ctx, "memblock: missing some fragments.", map[string]interface{}{
+			"block_id": b.id,
+			"expected": off,
+			"found":    frag.StartOffset(),
+		})
+		return
+	}
+	if err := b.appendFragment(frag.Payload()); err != nil {
+		b.mu.Unlock()
+		log.Error(ctx, "memblock: the fragment is corrupted.", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   b.id,
+			"offset":     frag.StartOffset(),
+		})
+		return
+	}
+	archived := b.actx.Archived()
+	stat := b.stat()
+	b.mu.Unlock()
+
+	cb()
+
+	if archived && b.lis != nil {
+		b.lis.OnArchived(stat)
+	}
+}
+
+// appendFragment appends entries in payload, which is checked before any of them is appended. The caller
+// must hold mu.
+func (b *memBlock) appendFragment(payload []byte) error {
+	type item struct {
+		n     int
+		entry block.Entry
+	}
+	items := make([]item, 0)
+	seq := b.actx.seq
+	for off := 0; off < len(payload); {
+		n, entry, err := b.dec.Unmarshal(payload[off:])
+		if err != nil {
+			return err
+		}
+		if ceschema.SequenceNumber(entry) != seq {
+			return errCorruptedFragment
+		}
+		off += n
+		if ceschema.EntryType(entry) == ceschema.End && off != len(payload) {
+			// End entry must be the last.
+			return errCorruptedFragment
+		}
+		items = append(items, item{n: n, entry: entry})
+		seq++
+	}
+
+	for off, i := 0, 0; i < len(items); i++ {
+		b.append(payload[off:off+items[i].n], items[i].entry)
+		off += items[i].n
+	}
+	return nil
+}
+
+func (b *memBlock) Read(ctx context.Context, seq int64, num int, maxBytes int) ([]block.Entry, error) {
+	b.mu.RLock()
+	data, indexes, archived := b.data, b.indexes, b.actx.Archived()
+	b.mu.RUnlock()
+
+	start, sz := int(seq), len(indexes)
+	if start >= sz {
+		if start == sz && !archived {
+			return nil, block.ErrOnEnd
+		}
+		return nil, block.ErrExceeded
+	}
+
+	end := start + num - 1
+	if end >= sz {
+		end = sz - 1
+	}
+	if maxBytes > 0 {
+		limit := indexes[start].StartOffset() + int64(maxBytes)
+		end = start + sort.Search(end-start, func(i int) bool {
+			return indexes[start+i+1].EndOffset() > limit
+		})
+	}
+
+	entries := make([]block.Entry, 0, end-start+1)
+	for i := start; i <= end; i++ {
+		idx := indexes[i]
+		_, entry, err := b.dec.Unmarshal(data[idx.StartOffset():idx.EndOffset()])
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func (b *memBlock) Seek(ctx context.Context, idx int64, key block.Entry, flag block.SeekKeyFlag) (int64, error) {
+	b.mu.RLock()
+	indexes := b.indexes
+	b.mu.RUnlock()
+
+	if idx == ceschema.PartitionKeyIndex {
+		if flag != block.SeekLastKey {
+			return -1, block.ErrNotSupported
+		}
+		return b.seekLastKey(ctx, indexes, ceschema.PartitionKey(key))
+	}
+
+	// TODO(james.yin): support non-stime index.
+	stime := ceschema.Stime(key)
+	sz := len(indexes)
+	ge := sort.Search(sz, func(i int) bool {
+		return indexes[i].Stime() >= stime
+	})
+	found := ge < sz && indexes[ge].Stime() == stime
+
+	// Results are the same as ones of vsb.
+	switch flag {
+	case block.SeekKeyExact:
+		if found {
+			return int64(ge), nil
+		}
+		return -1, nil
+	case block.SeekKeyOrNext:
+		return seqOrNone(ge, sz), nil
+	case block.SeekKeyOrPrev:
+		if ge < sz && !found {
+			return int64(ge) - 1, nil
+		}
+		return seqOrNone(ge, sz), nil
+	case block.SeekAfterKey:
+		gt := sort.Search(sz, func(i int) bool {
+			return indexes[i].Stime() > stime
+		})
+		return seqOrNone(gt, sz), nil
+	case block.SeekBeforeKey:
+		return int64(ge) - 1, nil
+	default:
+		return -1, block.ErrNotSupported
+	}
+}
+
+// seekLastKey returns the sequence number of the last entry with the partition key like vsb.
+func (b *memBlock) seekLastKey(ctx context.Context, indexes []index.Index, key []byte) (int64, error) {
+	if len(key) == 0 {
+		return -1, nil
+	}
+	hash := index.HashKey(key)
+	for seq := len(indexes) - 1; seq >= 0; seq-- {
+		if indexes[seq].KeyHash() != hash {
+			continue
+		}
+		entries, err := b.Read(ctx, int64(seq), 1, 0)
+		if err != nil {
+			return -1, err
+		}
+		if bytes.Equal(ceschema.PartitionKey(entries[0]), key) {
+			return int64(seq), nil
+		}
+	}
+	return -1, nil
+}
+
+func seqOrNone(seq, sz int) int64 {
+	if seq < sz {
+		return int64(seq)
+	}
+	return -1
+}
+
+func (b *memBlock) Snapshot(ctx context.Context) (block.Fragment, error) {
+	b.mu.RLock()
+	payload := b.data[headerSize:]
+	b.mu.RUnlock()
+
+	data := make([]byte, 8+len(payload))
+	binary.LittleEndian.PutUint64(data, uint64(headerSize))
+	copy(data[8:], payload)
+	return block.NewFragment(data), nil
+}
+
+func (b *memBlock) ApplySnapshot(ctx context.Context, snap block.Fragment) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cur := b.actx.offset
+	if snap.StartOffset() > cur {
+		return block.ErrSnapshotOutOfOrder
+	}
+	if snap.EndOffset() <= cur {
+		return nil
+	}
+	return b.appendFragment(snap.Payload()[cur-snap.StartOffset():])
+}
+
+// now returns the ingestion timestamp in milliseconds, it falls back to the wall clock if the block is
+// created without a clock.
+func (b *memBlock) now() int64 {
+	if b.clk == nil {
+		return time.Now().UnixMilli()
+	}
+	return b.clk.NowMilli()
+}
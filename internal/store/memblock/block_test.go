@@ -0,0 +1,179 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memblock
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+func TestMemBlock(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("memory block", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		dir, err := os.MkdirTemp("", "memblock-*")
+		So(err, ShouldBeNil)
+		defer func() {
+			So(os.RemoveAll(dir), ShouldBeNil)
+		}()
+
+		e, err := newEngine(makeConfig(WithFlush(dir, 0)))
+		So(err, ShouldBeNil)
+		defer e.Close()
+
+		id := vanus.NewTestID()
+		r, err := e.Create(ctx, id, vsbtest.EntrySize0+vsbtest.EntrySize1)
+		So(err, ShouldBeNil)
+
+		commit := func(frag block.Fragment) {
+			done := false
+			r.CommitAppend(ctx, frag, func() {
+				done = true
+			})
+			So(done, ShouldBeTrue)
+		}
+		check := func(r block.Raw) {
+			entries, err := r.Read(ctx, 0, 3, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 2)
+			cetest.CheckEntry0(entries[0], false, true)
+			cetest.CheckEntry1(entries[1], false, true)
+
+			entries, err = r.Read(ctx, 0, 3, vsbtest.EntrySize0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+		}
+
+		actx := r.NewAppendContext(nil)
+		seqs, frag, full, err := r.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl), cetest.MakeEntry1(ctrl))
+		So(err, ShouldBeNil)
+		So(seqs, ShouldResemble, []int64{0, 1})
+		So(frag.StartOffset(), ShouldEqual, headerSize)
+		So(frag.Size(), ShouldEqual, vsbtest.EntrySize0+vsbtest.EntrySize1)
+		So(full, ShouldBeTrue)
+		commit(frag)
+
+		check(r)
+		_, err = r.Read(ctx, 2, 1, 0)
+		So(err, ShouldEqual, block.ErrOnEnd)
+
+		frag, err = r.PrepareArchive(ctx, actx)
+		So(err, ShouldBeNil)
+		So(actx.Archived(), ShouldBeTrue)
+		commit(frag)
+
+		stat, _ := e.GetBlockStatistics(id, r)
+		So(stat.Archived, ShouldBeTrue)
+		So(stat.EntryNum, ShouldEqual, 2)
+		So(stat.EntrySize, ShouldEqual, vsbtest.EntrySize0+vsbtest.EntrySize1)
+		_, err = r.Read(ctx, 2, 1, 0)
+		So(err, ShouldEqual, block.ErrExceeded)
+
+		Convey("apply snapshot", func() {
+			snap, err := r.Snapshot(ctx)
+			So(err, ShouldBeNil)
+
+			r2, err := e.Create(ctx, vanus.NewTestID(), vsbtest.EntrySize0+vsbtest.EntrySize1)
+			So(err, ShouldBeNil)
+			So(r2.ApplySnapshot(ctx, snap), ShouldBeNil)
+			check(r2)
+			So(r2.NewAppendContext(nil).Archived(), ShouldBeTrue)
+			So(r2.Delete(ctx), ShouldBeNil)
+		})
+
+		Convey("recover flushed block", func() {
+			So(r.Close(ctx), ShouldBeNil)
+
+			e2, err := newEngine(makeConfig(WithFlush(dir, 0)))
+			So(err, ShouldBeNil)
+			defer e2.Close()
+			blocks, err := e2.Recover(ctx)
+			So(err, ShouldBeNil)
+			So(blocks, ShouldHaveLength, 1)
+			So(blocks[id], ShouldNotBeNil)
+
+			check(blocks[id])
+			stat, _ = e2.GetBlockStatistics(id, blocks[id])
+			So(stat.Archived, ShouldBeTrue)
+			So(stat.EntryNum, ShouldEqual, 2)
+
+			So(blocks[id].Delete(ctx), ShouldBeNil)
+			blocks, err = e2.Recover(ctx)
+			So(err, ShouldBeNil)
+			So(blocks, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestMemBlock_MemoryLimit(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("memory limit of memory blocks", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		e, err := newEngine(makeConfig(WithMemoryLimit(headerSize + vsbtest.EntrySize0)))
+		So(err, ShouldBeNil)
+		defer e.Close()
+
+		r, err := e.Create(ctx, vanus.NewTestID(), vsbtest.EntrySize0)
+		So(err, ShouldBeNil)
+		b, _ := r.(*memBlock)
+		So(cap(b.data), ShouldEqual, headerSize+vsbtest.EntrySize0)
+		So(e.used, ShouldEqual, headerSize+vsbtest.EntrySize0)
+
+		actx := r.NewAppendContext(nil)
+		_, frag, full, err := r.PrepareAppend(ctx, actx, cetest.MakeEntry0(ctrl))
+		So(err, ShouldBeNil)
+		So(full, ShouldBeTrue)
+		r.CommitAppend(ctx, frag, func() {})
+		So(e.used, ShouldEqual, headerSize+vsbtest.EntrySize0)
+
+		_, _, _, err = r.PrepareAppend(ctx, actx, cetest.MakeEntry1(ctrl))
+		So(errors.Is(err, errors.ErrTooManyRequests), ShouldBeTrue)
+		So(actx.WriteOffset(), ShouldEqual, headerSize+vsbtest.EntrySize0)
+
+		Convey("grow lazily", func() {
+			e.limit = 0
+			r2, err := e.Create(ctx, vanus.NewTestID(), 4*initialBufferSize)
+			So(err, ShouldBeNil)
+			b2, _ := r2.(*memBlock)
+			So(cap(b2.data), ShouldEqual, headerSize+initialBufferSize)
+			So(e.used, ShouldEqual, 2*headerSize+vsbtest.EntrySize0+initialBufferSize)
+
+			So(r2.Delete(ctx), ShouldBeNil)
+			So(r.Delete(ctx), ShouldBeNil)
+			So(e.used, ShouldEqual, 0)
+		})
+	})
+}
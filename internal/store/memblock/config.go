@@ -0,0 +1,79 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memblock
+
+import (
+	// standard libraries.
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/util/clock"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+type config struct {
+	lis   block.ArchivedListener
+	clock *clock.Monotonic
+	// flushDir is the directory which blocks are flushed to, blocks are kept in memory only if it's empty.
+	flushDir      string
+	flushInterval time.Duration
+	// memoryLimit bounds bytes of buffers of all blocks, it's unlimited if it's 0.
+	memoryLimit int64
+}
+
+type Option func(*config)
+
+func makeConfig(opts ...Option) config {
+	cfg := config{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.clock == nil {
+		cfg.clock = &clock.Monotonic{}
+	}
+	return cfg
+}
+
+func WithArchivedListener(lis block.ArchivedListener) Option {
+	return func(cfg *config) {
+		cfg.lis = lis
+	}
+}
+
+// WithClock issues ingestion timestamps from clk, which should be shared with other engines.
+func WithClock(clk *clock.Monotonic) Option {
+	return func(cfg *config) {
+		cfg.clock = clk
+	}
+}
+
+// WithFlush writes data of blocks to files in dir every interval in the background, and once blocks are
+// closed, so that blocks are recovered after a restart. Data appended since the last flush is lost on a crash.
+func WithFlush(dir string, interval time.Duration) Option {
+	return func(cfg *config) {
+		cfg.flushDir = dir
+		cfg.flushInterval = interval
+	}
+}
+
+// WithMemoryLimit rejects appends which need to grow buffers of blocks beyond limit bytes in total.
+// Fragments committed by raft are always applied, so the limit may be exceeded by followers briefly.
+func WithMemoryLimit(limit int64) Option {
+	return func(cfg *config) {
+		cfg.memoryLimit = limit
+	}
+}
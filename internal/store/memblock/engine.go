@@ -0,0 +1,253 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package memblock implements blocks which keep entries in memory, for eventbuses which value latency over
+// durability. Entries are encoded as vsb does, so they are read and replicated in the same way.
+package memblock
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/linkall-labs/vanus/pkg/util/clock"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/block/raw"
+)
+
+const (
+	memExt          = ".mem"
+	defaultDirPerm  = 0o755
+	defaultFilePerm = 0o644
+)
+
+type engine struct {
+	lis block.ArchivedListener
+	clk *clock.Monotonic
+
+	// dir is empty if blocks aren't flushed.
+	dir           string
+	flushInterval time.Duration
+	// blocks are flushed periodically, key: vanus.ID, value: *memBlock.
+	blocks sync.Map
+	closeC chan struct{}
+	wg     sync.WaitGroup
+
+	// limit bounds used, which is bytes of buffers of all blocks.
+	limit int64
+	used  int64
+}
+
+// Make sure engine implements raw.Engine.
+var _ raw.Engine = (*engine)(nil)
+
+func Initialize(opts ...Option) error {
+	cfg := makeConfig(opts...)
+	e, err := newEngine(cfg)
+	if err != nil {
+		return err
+	}
+	return raw.RegisterEngine(raw.Memory, e)
+}
+
+func newEngine(cfg config) (*engine, error) {
+	e := &engine{
+		lis:           cfg.lis,
+		clk:           cfg.clock,
+		dir:           cfg.flushDir,
+		flushInterval: cfg.flushInterval,
+		closeC:        make(chan struct{}),
+		limit:         cfg.memoryLimit,
+	}
+	if e.dir != "" {
+		if err := os.MkdirAll(e.dir, defaultDirPerm); err != nil {
+			return nil, err
+		}
+		if e.flushInterval > 0 {
+			e.wg.Add(1)
+			go e.runFlush()
+		}
+	}
+	return e, nil
+}
+
+func (e *engine) Close() {
+	close(e.closeC)
+	e.wg.Wait()
+}
+
+func (e *engine) GetBlockStatistics(id vanus.ID, r block.Raw) (block.Statistics, error) {
+	if r != nil {
+		b, _ := r.(*memBlock)
+		return b.status(), nil
+	}
+	return block.Statistics{}, nil
+}
+
+func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block.Raw, error) {
+	b := newBlock(e, id, capacity)
+	if e.dir == "" {
+		return b, nil
+	}
+
+	path := e.resolvePath(id)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_RDWR, defaultFilePerm)
+	if err != nil {
+		b.free()
+		return nil, err
+	}
+	if _, err = f.WriteAt(b.data, 0); err == nil {
+		err = f.Sync()
+	}
+	if err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		b.free()
+		return nil, err
+	}
+	b.path = path
+	b.f = f
+	b.flushed = headerSize
+	e.blocks.Store(id, b)
+	return b, nil
+}
+
+// Recover loads blocks flushed to files, entries after a torn write are dropped. Nothing is recovered if
+// blocks aren't flushed.
+func (e *engine) Recover(ctx context.Context) (map[vanus.ID]block.Raw, error) {
+	blocks := make(map[vanus.ID]block.Raw)
+	if e.dir == "" {
+		return blocks, nil
+	}
+
+	files, err := os.ReadDir(e.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		filename := file.Name()
+		if !file.Type().IsRegular() || filepath.Ext(filename) != memExt {
+			continue
+		}
+		id, err2 := vanus.NewIDFromString(filename[:len(filename)-len(memExt)])
+		if err2 != nil {
+			err = err2
+			break
+		}
+		b, err2 := e.open(ctx, id)
+		if err2 != nil {
+			err = err2
+			break
+		}
+		blocks[id] = b
+	}
+
+	if err != nil {
+		for _, b := range blocks {
+			_ = b.Close(ctx)
+		}
+		return nil, err
+	}
+	return blocks, nil
+}
+
+func (e *engine) open(ctx context.Context, id vanus.ID) (*memBlock, error) {
+	path := e.resolvePath(id)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < headerSize || binary.LittleEndian.Uint32(data[magicOffset:]) != FormatMagic {
+		return nil, raw.ErrInvalidFormat
+	}
+
+	b := newBlock(e, id, int64(binary.LittleEndian.Uint64(data[capacityOffset:])))
+	if err = b.load(data[headerSize:]); err != nil {
+		b.free()
+		return nil, err
+	}
+	if n := len(b.data); n < len(data) {
+		log.Info(ctx, "drop the torn tail of the flushed memory block", map[string]interface{}{
+			"block_id": id,
+			"offset":   n,
+			"length":   len(data) - n,
+		})
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		b.free()
+		return nil, err
+	}
+	b.path = path
+	b.f = f
+	b.flushed = int64(len(b.data))
+	e.blocks.Store(id, b)
+	return b, nil
+}
+
+func (e *engine) runFlush() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.closeC:
+			return
+		case <-ticker.C:
+			e.flushAll()
+		}
+	}
+}
+
+func (e *engine) flushAll() {
+	e.blocks.Range(func(_, v interface{}) bool {
+		b, _ := v.(*memBlock)
+		if err := b.flush(); err != nil {
+			log.Warning(context.Background(), "flush memory block failed", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   b.id,
+			})
+		}
+		return true
+	})
+}
+
+func (e *engine) resolvePath(id vanus.ID) string {
+	return filepath.Join(e.dir, id.String()+memExt)
+}
+
+// allocate accounts n bytes of buffers, n is negative if buffers are released.
+func (e *engine) allocate(n int64) {
+	atomic.AddInt64(&e.used, n)
+}
+
+// checkMemory returns ErrTooManyRequests if buffers can't grow n bytes more within the limit.
+func (e *engine) checkMemory(n int64) error {
+	if e.limit <= 0 || atomic.LoadInt64(&e.used)+n <= e.limit {
+		return nil
+	}
+	return errors.ErrTooManyRequests.WithMessage("memory of memory blocks is exhausted")
+}
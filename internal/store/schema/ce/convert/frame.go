@@ -0,0 +1,254 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	// standard libraries.
+	"time"
+
+	// third-party libraries.
+	"google.golang.org/protobuf/encoding/protowire"
+
+	// first-party libraries.
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+)
+
+// Field numbers in cloudevents.proto.
+const (
+	idField          protowire.Number = 1
+	sourceField      protowire.Number = 2
+	specVersionField protowire.Number = 3
+	typeField        protowire.Number = 4
+	attributesField  protowire.Number = 5
+	binaryDataField  protowire.Number = 6
+
+	mapKeyField   protowire.Number = 1
+	mapValueField protowire.Number = 2
+
+	ceIntegerField   protowire.Number = 2
+	ceStringField    protowire.Number = 3
+	ceTimestampField protowire.Number = 7
+
+	secondsField protowire.Number = 1
+	nanosField   protowire.Number = 2
+)
+
+// frame holds attributes of an entry which are read once for both sizing and encoding.
+type frame struct {
+	e               block.Entry
+	id              string
+	source          string
+	specVersion     string
+	typ             string
+	dataContentType string
+	dataSchema      string
+	subject         string
+	time            time.Time
+	seq             int32
+	stime           time.Time
+	data            []byte
+}
+
+// AppendFrame appends e to b as a CloudEvent message preceded by its size in varint, the message is same
+// as ToPb(e) in wire format, but it's encoded from e directly without building and marshaling one.
+func AppendFrame(b []byte, e block.Entry) []byte {
+	w := ceWrapper{e: e}
+	f := frame{
+		e:               e,
+		id:              w.ID(),
+		source:          w.Source(),
+		specVersion:     w.SpecVersion(),
+		typ:             w.Type(),
+		dataContentType: w.DataContentType(),
+		dataSchema:      w.DataSchema(),
+		subject:         w.Subject(),
+		time:            w.Time(),
+		seq:             int32(ceschema.SequenceNumber(e)),
+		stime:           time.UnixMilli(ceschema.Stime(e)),
+		data:            w.Data(),
+	}
+	b = protowire.AppendVarint(b, uint64(f.size()))
+	return f.append(b)
+}
+
+func (f *frame) size() int {
+	n := sizeString(idField, f.id) + sizeString(sourceField, f.source) +
+		sizeString(specVersionField, f.specVersion) + sizeString(typeField, f.typ)
+	if f.dataContentType != "" {
+		n += sizeAttr(len(dataContentTypeAttr), sizeStringValue(len(f.dataContentType)))
+	}
+	if f.dataSchema != "" {
+		n += sizeAttr(len(dataSchemaAttr), sizeStringValue(len(f.dataSchema)))
+	}
+	if f.subject != "" {
+		n += sizeAttr(len(subjectAttr), sizeStringValue(len(f.subject)))
+	}
+	if !f.time.IsZero() {
+		n += sizeAttr(len(timeAttr), sizeTimestampValue(f.time))
+	}
+	f.rangeExtensions(func(attr, val []byte) {
+		n += sizeAttr(len(attr), sizeStringValue(len(val)))
+	})
+	n += sizeAttr(len(segpb.XVanusBlockOffset), sizeIntegerValue(f.seq))
+	n += sizeAttr(len(segpb.XVanusStime), sizeTimestampValue(f.stime))
+	if f.data != nil {
+		n += protowire.SizeTag(binaryDataField) + protowire.SizeBytes(len(f.data))
+	}
+	return n
+}
+
+func (f *frame) append(b []byte) []byte {
+	b = appendString(b, idField, f.id)
+	b = appendString(b, sourceField, f.source)
+	b = appendString(b, specVersionField, f.specVersion)
+	b = appendString(b, typeField, f.typ)
+	if f.dataContentType != "" {
+		b = appendStringAttr(b, dataContentTypeAttr, f.dataContentType)
+	}
+	if f.dataSchema != "" {
+		b = appendStringAttr(b, dataSchemaAttr, f.dataSchema)
+	}
+	if f.subject != "" {
+		b = appendStringAttr(b, subjectAttr, f.subject)
+	}
+	if !f.time.IsZero() {
+		b = appendTimestampAttr(b, timeAttr, f.time)
+	}
+	f.rangeExtensions(func(attr, val []byte) {
+		b = appendAttrHead(b, len(attr), sizeStringValue(len(val)))
+		b = append(b, attr...)
+		b = protowire.AppendTag(b, mapValueField, protowire.BytesType)
+		b = protowire.AppendVarint(b, uint64(sizeStringValue(len(val))))
+		b = protowire.AppendTag(b, ceStringField, protowire.BytesType)
+		b = protowire.AppendBytes(b, val)
+	})
+	b = appendIntegerAttr(b, segpb.XVanusBlockOffset, f.seq)
+	b = appendTimestampAttr(b, segpb.XVanusStime, f.stime)
+	if f.data != nil {
+		b = protowire.AppendTag(b, binaryDataField, protowire.BytesType)
+		b = protowire.AppendBytes(b, f.data)
+	}
+	return b
+}
+
+// rangeExtensions ranges extension attributes except XVanusBlockOffset and XVanusStime, which are
+// overwritten as ToPb does.
+func (f *frame) rangeExtensions(cb func(attr, val []byte)) {
+	f.e.RangeExtensionAttributes(block.OnExtensionAttributeFunc(func(attr, val []byte) {
+		if s := string(attr); s == segpb.XVanusBlockOffset || s == segpb.XVanusStime {
+			return
+		}
+		cb(attr, val)
+	}))
+}
+
+func sizeString(num protowire.Number, s string) int {
+	if s == "" {
+		return 0
+	}
+	return protowire.SizeTag(num) + protowire.SizeBytes(len(s))
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// sizeAttr returns the size of an entry in attributes, including its tag.
+func sizeAttr(keyLen, valueSize int) int {
+	return protowire.SizeTag(attributesField) + protowire.SizeBytes(sizeAttrEntry(keyLen, valueSize))
+}
+
+func sizeAttrEntry(keyLen, valueSize int) int {
+	return protowire.SizeTag(mapKeyField) + protowire.SizeBytes(keyLen) +
+		protowire.SizeTag(mapValueField) + protowire.SizeBytes(valueSize)
+}
+
+// appendAttrHead appends an entry in attributes up to its key, the caller appends the key and the value.
+func appendAttrHead(b []byte, keyLen, valueSize int) []byte {
+	b = protowire.AppendTag(b, attributesField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(sizeAttrEntry(keyLen, valueSize)))
+	b = protowire.AppendTag(b, mapKeyField, protowire.BytesType)
+	return protowire.AppendVarint(b, uint64(keyLen))
+}
+
+func sizeStringValue(n int) int {
+	return protowire.SizeTag(ceStringField) + protowire.SizeBytes(n)
+}
+
+func appendStringAttr(b []byte, key, val string) []byte {
+	size := sizeStringValue(len(val))
+	b = appendAttrHead(b, len(key), size)
+	b = append(b, key...)
+	b = protowire.AppendTag(b, mapValueField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(size))
+	b = protowire.AppendTag(b, ceStringField, protowire.BytesType)
+	return protowire.AppendString(b, val)
+}
+
+func sizeIntegerValue(v int32) int {
+	return protowire.SizeTag(ceIntegerField) + protowire.SizeVarint(uint64(v))
+}
+
+func appendIntegerAttr(b []byte, key string, v int32) []byte {
+	size := sizeIntegerValue(v)
+	b = appendAttrHead(b, len(key), size)
+	b = append(b, key...)
+	b = protowire.AppendTag(b, mapValueField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(size))
+	b = protowire.AppendTag(b, ceIntegerField, protowire.VarintType)
+	return protowire.AppendVarint(b, uint64(v))
+}
+
+func sizeTimestamp(t time.Time) int {
+	n := 0
+	if sec := t.Unix(); sec != 0 {
+		n += protowire.SizeTag(secondsField) + protowire.SizeVarint(uint64(sec))
+	}
+	if nanos := t.Nanosecond(); nanos != 0 {
+		n += protowire.SizeTag(nanosField) + protowire.SizeVarint(uint64(nanos))
+	}
+	return n
+}
+
+func sizeTimestampValue(t time.Time) int {
+	return protowire.SizeTag(ceTimestampField) + protowire.SizeBytes(sizeTimestamp(t))
+}
+
+func appendTimestampAttr(b []byte, key string, t time.Time) []byte {
+	size := sizeTimestampValue(t)
+	b = appendAttrHead(b, len(key), size)
+	b = append(b, key...)
+	b = protowire.AppendTag(b, mapValueField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(size))
+	b = protowire.AppendTag(b, ceTimestampField, protowire.BytesType)
+	b = protowire.AppendVarint(b, uint64(sizeTimestamp(t)))
+	if sec := t.Unix(); sec != 0 {
+		b = protowire.AppendTag(b, secondsField, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(sec))
+	}
+	if nanos := t.Nanosecond(); nanos != 0 {
+		b = protowire.AppendTag(b, nanosField, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(nanos))
+	}
+	return b
+}
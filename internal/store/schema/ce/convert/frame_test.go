@@ -0,0 +1,61 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+)
+
+func TestAppendFrame(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	entry0 := cetest.MakeStoredEntry0(ctrl)
+	entry1 := cetest.MakeStoredEntry1(ctrl)
+
+	Convey("append entries as frames", t, func() {
+		var payload []byte
+		payload = AppendFrame(payload, entry0)
+		payload = AppendFrame(payload, entry1)
+
+		var events []*cepb.CloudEvent
+		for len(payload) > 0 {
+			data, n := protowire.ConsumeBytes(payload)
+			So(n, ShouldBeGreaterThan, 0)
+			event := &cepb.CloudEvent{}
+			So(proto.Unmarshal(data, event), ShouldBeNil)
+			events = append(events, event)
+			payload = payload[n:]
+		}
+		So(events, ShouldHaveLength, 2)
+		So(proto.Equal(events[0], ToPb(entry0)), ShouldBeTrue)
+		So(proto.Equal(events[1], ToPb(entry1)), ShouldBeTrue)
+		cetest.CheckEvent0(events[0])
+		cetest.CheckEvent1(events[1])
+	})
+}
@@ -34,3 +34,11 @@ func SequenceNumber(entry block.Entry) int64 {
 func Stime(entry block.Entry) int64 {
 	return entry.GetInt64(StimeOrdinal)
 }
+
+// partitionKeyAttr is the extension attribute events of per-key ordered eventbuses are routed by.
+var partitionKeyAttr = []byte("partitionkey")
+
+// PartitionKey returns the value of the partitionkey extension attribute, it's nil if the Entry has no key.
+func PartitionKey(entry block.Entry) []byte {
+	return entry.GetExtensionAttribute(partitionKeyAttr)
+}
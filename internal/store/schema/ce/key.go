@@ -10,7 +10,13 @@
 
 package ce
 
-import "github.com/linkall-labs/vanus/internal/store/block"
+import (
+	// standard libraries.
+	"bytes"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
 
 type stimeKey struct {
 	block.EmptyEntry
@@ -27,3 +33,19 @@ func (e *stimeKey) GetInt64(ordinal int) int64 {
 func StimeKey(stime int64) block.Entry {
 	return &stimeKey{stime: stime}
 }
+
+type partitionKeyKey struct {
+	block.EmptyEntry
+	key []byte
+}
+
+func (e *partitionKeyKey) GetExtensionAttribute(attr []byte) []byte {
+	if bytes.Equal(attr, partitionKeyAttr) {
+		return e.key
+	}
+	return e.EmptyEntry.GetExtensionAttribute(attr)
+}
+
+func PartitionKeyKey(key []byte) block.Entry {
+	return &partitionKeyKey{key: key}
+}
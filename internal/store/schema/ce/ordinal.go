@@ -34,3 +34,11 @@ const (
 	SubjectOrdinal
 	TimeOrdinal
 )
+
+// Indexes of entries, which are sought by Seek.
+const (
+	// StimeIndex orders entries by stime.
+	StimeIndex = iota
+	// PartitionKeyIndex locates entries by their partition keys, it isn't ordered.
+	PartitionKeyIndex
+)
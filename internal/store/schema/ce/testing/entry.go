@@ -39,6 +39,7 @@ func MakeEntry0(ctrl *Controller) block.EntryExt {
 	})
 	entry.EXPECT().ExtensionAttributeCount().AnyTimes().Return(0)
 	entry.EXPECT().RangeExtensionAttributes(Any()).AnyTimes().Return()
+	entry.EXPECT().GetExtensionAttribute(Any()).AnyTimes().Return(nil)
 	return entry
 }
 
@@ -62,6 +63,7 @@ func MakeEntry1(ctrl *Controller) block.EntryExt {
 		cb.OnAttribute([]byte("attr1"), []byte("value1"))
 		cb.OnAttribute([]byte("attr2"), []byte("value2"))
 	})
+	entry.EXPECT().GetExtensionAttribute(Any()).AnyTimes().Return(nil)
 	return entry
 }
 
@@ -90,6 +92,7 @@ func MakeStoredEntry0(ctrl *Controller) block.EntryExt {
 	})
 	entry.EXPECT().ExtensionAttributeCount().AnyTimes().Return(0)
 	entry.EXPECT().RangeExtensionAttributes(Any()).AnyTimes().Return()
+	entry.EXPECT().GetExtensionAttribute(Any()).AnyTimes().Return(nil)
 	return entry
 }
 
@@ -127,6 +130,7 @@ func MakeStoredEntry1(ctrl *Controller) block.EntryExt {
 		cb.OnAttribute([]byte("attr1"), []byte("value1"))
 		cb.OnAttribute([]byte("attr2"), []byte("value2"))
 	})
+	entry.EXPECT().GetExtensionAttribute(Any()).AnyTimes().Return(nil)
 	return entry
 }
 
@@ -142,6 +146,7 @@ func MakeStoredEndEntry(ctrl *Controller) block.EntryExt {
 	})
 	entry.EXPECT().ExtensionAttributeCount().AnyTimes().Return(0)
 	entry.EXPECT().RangeExtensionAttributes(Any()).AnyTimes().Return()
+	entry.EXPECT().GetExtensionAttribute(Any()).AnyTimes().Return(nil)
 	return entry
 }
 
@@ -17,20 +17,34 @@ package segment
 import (
 	// standard libraries.
 	"context"
+	"time"
 
 	// third-party libraries.
 	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
 )
 
+// streamMsgReserved is reserved in each message of ReadFromBlockStream for the headers of the response
+// and the batch.
+const streamMsgReserved = 16
+
+// exportChunkSize is the maximum size of messages of ExportBlock.
+const exportChunkSize = 1024 * 1024
+
 type segmentServer struct {
 	srv Server
+	// maxSendMsgSize is the maximum message size in bytes the server can send, 0 is config.DefaultMaxMsgSize.
+	maxSendMsgSize int
 }
 
 // Make sure segmentServer implements segpb.SegmentServerServer.
@@ -62,7 +76,7 @@ func (s *segmentServer) Status(ctx context.Context, req *emptypb.Empty) (*segpb.
 
 func (s *segmentServer) CreateBlock(ctx context.Context, req *segpb.CreateBlockRequest) (*emptypb.Empty, error) {
 	blockID := vanus.NewIDFromUint64(req.Id)
-	if err := s.srv.CreateBlock(ctx, blockID, req.Size); err != nil {
+	if err := s.srv.CreateBlock(ctx, blockID, req.Size, req.StorageMode); err != nil {
 		return nil, err
 	}
 
@@ -81,12 +95,75 @@ func (s *segmentServer) RemoveBlock(ctx context.Context, req *segpb.RemoveBlockR
 func (s *segmentServer) GetBlockInfo(
 	ctx context.Context, req *segpb.GetBlockInfoRequest,
 ) (*segpb.GetBlockInfoResponse, error) {
-	// TODO(james.yin): implements GetBlockInfo()
-	// if err := s.srv.GetBlockInfo(ctx, 0); err != nil {
-	// 	return nil, err
-	// }
+	ids := make([]vanus.ID, len(req.Ids))
+	for i, id := range req.Ids {
+		ids[i] = vanus.NewIDFromUint64(id)
+	}
+	infos, err := s.srv.GetBlockInfo(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segpb.GetBlockInfoResponse{Blocks: infos}, nil
+}
+
+func (s *segmentServer) GetBlockStats(
+	ctx context.Context, req *segpb.GetBlockStatsRequest,
+) (*segpb.GetBlockStatsResponse, error) {
+	ids := make([]vanus.ID, len(req.Ids))
+	for i, id := range req.Ids {
+		ids[i] = vanus.NewIDFromUint64(id)
+	}
+	stats, err := s.srv.GetBlockStats(ctx, ids...)
+	if err != nil {
+		return nil, err
+	}
 
-	return &segpb.GetBlockInfoResponse{}, nil
+	return &segpb.GetBlockStatsResponse{Blocks: stats}, nil
+}
+
+func (s *segmentServer) ExportBlockManifest(
+	ctx context.Context, req *segpb.ExportBlockManifestRequest,
+) (*segpb.BlockManifest, error) {
+	ids := make([]vanus.ID, len(req.Ids))
+	for i, id := range req.Ids {
+		ids[i] = vanus.NewIDFromUint64(id)
+	}
+	return s.srv.ExportBlockManifest(
+		ctx, vanus.NewIDFromUint64(req.StartAfter), int(req.Limit), ids...)
+}
+
+func (s *segmentServer) ExportBlock(
+	req *segpb.ExportBlockRequest, stream segpb.SegmentServer_ExportBlockServer,
+) error {
+	data, err := s.srv.ExportBlock(stream.Context(), vanus.NewIDFromUint64(req.Id))
+	if err != nil {
+		return err
+	}
+
+	// Chunks are kept below the default limit of messages received by gRPC clients.
+	limit := s.streamMsgSize(exportChunkSize)
+	for len(data) > 0 {
+		n := limit
+		if n > len(data) {
+			n = len(data)
+		}
+		if err = stream.Send(&segpb.ExportBlockResponse{Data: data[:n]}); err != nil {
+			return err
+		}
+		data = data[n:]
+	}
+	return nil
+}
+
+func (s *segmentServer) CopyBlock(ctx context.Context, req *segpb.CopyBlockRequest) (*emptypb.Empty, error) {
+	err := s.srv.CopyBlock(ctx, vanus.NewIDFromUint64(req.Id), vanus.NewIDFromUint64(req.SourceId),
+		req.SourceAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
 }
 
 func (s *segmentServer) ActivateSegment(
@@ -100,10 +177,16 @@ func (s *segmentServer) ActivateSegment(
 		replicas[blockID] = endpoint
 	}
 
-	if err := s.srv.ActivateSegment(ctx, logID, segID, replicas); err != nil {
+	if err := s.srv.ActivateSegment(ctx, logID, segID, replicas, req.CompressionDictionary); err != nil {
 		return nil, err
 	}
 
+	if req.Lease != nil {
+		if err := s.srv.RenewWriteLeases(ctx, req.Lease); err != nil {
+			return nil, err
+		}
+	}
+
 	return &segpb.ActivateSegmentResponse{}, nil
 }
 
@@ -113,6 +196,16 @@ func (s *segmentServer) InactivateSegment(
 	return &emptypb.Empty{}, nil
 }
 
+func (s *segmentServer) RenewWriteLeases(
+	ctx context.Context, req *segpb.RenewWriteLeasesRequest,
+) (*emptypb.Empty, error) {
+	if err := s.srv.RenewWriteLeases(ctx, req.Leases...); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *segmentServer) AppendToBlock(
 	ctx context.Context, req *segpb.AppendToBlockRequest,
 ) (*segpb.AppendToBlockResponse, error) {
@@ -130,8 +223,27 @@ func (s *segmentServer) ReadFromBlock(
 	ctx context.Context, req *segpb.ReadFromBlockRequest,
 ) (*segpb.ReadFromBlockResponse, error) {
 	blockID := vanus.NewIDFromUint64(req.BlockId)
-	events, err := s.srv.ReadFromBlock(ctx, blockID, req.Offset, int(req.Number), req.PollingTimeout)
+	if req.Raw {
+		payload, err := s.srv.ReadRawFromBlock(ctx, blockID, req.Offset, int(req.Number), int(req.MaxBytes),
+			req.PollingTimeout)
+		if err != nil {
+			if resp := s.sealedHint(ctx, req, err); resp != nil {
+				return resp, nil
+			}
+			return nil, err
+		}
+		return &segpb.ReadFromBlockResponse{
+			Events:  &cepb.CloudEventBatch{},
+			Payload: payload,
+		}, nil
+	}
+
+	events, err := s.srv.ReadFromBlock(ctx, blockID, req.Offset, int(req.Number), int(req.MaxBytes),
+		req.PollingTimeout)
 	if err != nil {
+		if resp := s.sealedHint(ctx, req, err); resp != nil {
+			return resp, nil
+		}
 		return nil, err
 	}
 
@@ -140,6 +252,135 @@ func (s *segmentServer) ReadFromBlock(
 	}, nil
 }
 
+func (s *segmentServer) ReadFromBlockStream(
+	req *segpb.ReadFromBlockRequest, stream segpb.SegmentServer_ReadFromBlockStreamServer,
+) error {
+	if req.Raw {
+		return s.readRawStream(req, stream)
+	}
+
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	events, err := s.srv.ReadFromBlock(stream.Context(), blockID, req.Offset, int(req.Number), int(req.MaxBytes),
+		req.PollingTimeout)
+	if err != nil {
+		if resp := s.sealedHint(stream.Context(), req, err); resp != nil {
+			return stream.Send(resp)
+		}
+		return err
+	}
+
+	// Send blocks when the flow control window of the stream is exhausted, so a slow client doesn't make
+	// the server buffer all events.
+	limit := s.streamMsgSize(req.MaxMessageBytes)
+	for len(events) > 0 {
+		n := splitEvents(events, limit)
+		resp := &segpb.ReadFromBlockResponse{
+			Events: &cepb.CloudEventBatch{Events: events[:n]},
+		}
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+		events = events[n:]
+	}
+	return nil
+}
+
+func (s *segmentServer) readRawStream(
+	req *segpb.ReadFromBlockRequest, stream segpb.SegmentServer_ReadFromBlockStreamServer,
+) error {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	payload, err := s.srv.ReadRawFromBlock(stream.Context(), blockID, req.Offset, int(req.Number),
+		int(req.MaxBytes), req.PollingTimeout)
+	if err != nil {
+		if resp := s.sealedHint(stream.Context(), req, err); resp != nil {
+			return stream.Send(resp)
+		}
+		return err
+	}
+
+	limit := s.streamMsgSize(req.MaxMessageBytes)
+	for len(payload) > 0 {
+		n := splitFrames(payload, limit)
+		resp := &segpb.ReadFromBlockResponse{
+			Events:  &cepb.CloudEventBatch{},
+			Payload: payload[:n],
+		}
+		if err = stream.Send(resp); err != nil {
+			return err
+		}
+		payload = payload[n:]
+	}
+	return nil
+}
+
+// sealedHint returns the continuation hint instead of err if the read is at or beyond the end of a sealed
+// block, so the client continues from the successor segment rather than guessing whether it exists. It
+// returns nil if the client doesn't follow hints or the block isn't sealed.
+func (s *segmentServer) sealedHint(
+	ctx context.Context, req *segpb.ReadFromBlockRequest, err error,
+) *segpb.ReadFromBlockResponse {
+	if !req.FollowSeal || !errors.Is(err, errors.ErrOffsetOverflow) {
+		return nil
+	}
+	infos, err := s.srv.GetBlockInfo(ctx, vanus.NewIDFromUint64(req.BlockId))
+	if err != nil || len(infos) == 0 {
+		return nil
+	}
+	info := infos[0]
+	if !info.IsFull || req.Offset < int64(info.EventNumber) {
+		return nil
+	}
+	return &segpb.ReadFromBlockResponse{
+		Events:       &cepb.CloudEventBatch{},
+		Sealed:       true,
+		SealedOffset: int64(info.EventNumber),
+	}
+}
+
+// streamMsgSize returns the size available for events in a message of ReadFromBlockStream, which is
+// limited by both the server and the client.
+func (s *segmentServer) streamMsgSize(accepted int64) int {
+	size := s.maxSendMsgSize
+	if size <= 0 {
+		size = config.DefaultMaxMsgSize
+	}
+	if accepted > 0 && accepted < int64(size) {
+		size = int(accepted)
+	}
+	return size - streamMsgReserved
+}
+
+// splitEvents returns the number of leading events which fit in limit bytes when they are encoded in a
+// batch. At least one event is returned even if it exceeds the limit.
+func splitEvents(events []*cepb.CloudEvent, limit int) int {
+	size := 0
+	for i, event := range events {
+		size += protowire.SizeTag(1) + protowire.SizeBytes(proto.Size(event))
+		if size > limit && i > 0 {
+			return i
+		}
+	}
+	return len(events)
+}
+
+// splitFrames returns the size of leading length-delimited frames in payload which fit in limit bytes.
+// At least one frame is returned even if it exceeds the limit.
+func splitFrames(payload []byte, limit int) int {
+	size := 0
+	for size < len(payload) {
+		_, n := protowire.ConsumeBytes(payload[size:])
+		if n < 0 {
+			// The payload is encoded by this server, so it can't be malformed, send the rest anyway.
+			return len(payload)
+		}
+		if size+n > limit && size > 0 {
+			return size
+		}
+		size += n
+	}
+	return size
+}
+
 func (s *segmentServer) LookupOffsetInBlock(
 	ctx context.Context, req *segpb.LookupOffsetInBlockRequest,
 ) (*segpb.LookupOffsetInBlockResponse, error) {
@@ -151,3 +392,52 @@ func (s *segmentServer) LookupOffsetInBlock(
 
 	return &segpb.LookupOffsetInBlockResponse{Offset: off}, nil
 }
+
+func (s *segmentServer) LookupKeyInBlock(
+	ctx context.Context, req *segpb.LookupKeyInBlockRequest,
+) (*segpb.LookupKeyInBlockResponse, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	off, err := s.srv.LookupKeyInBlock(ctx, blockID, req.PartitionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segpb.LookupKeyInBlockResponse{Offset: off}, nil
+}
+
+func (s *segmentServer) PrefetchBlock(
+	ctx context.Context, req *segpb.PrefetchBlockRequest,
+) (*segpb.PrefetchBlockResponse, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	n, err := s.srv.PrefetchBlock(ctx, blockID, req.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segpb.PrefetchBlockResponse{Bytes: n}, nil
+}
+
+func (s *segmentServer) ListInflightRequests(
+	ctx context.Context, req *segpb.ListInflightRequestsRequest,
+) (*segpb.ListInflightRequestsResponse, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	reqs, err := s.srv.ListInflightRequests(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segpb.ListInflightRequestsResponse{Requests: reqs}, nil
+}
+
+func (s *segmentServer) AbortInflightRequests(
+	ctx context.Context, req *segpb.AbortInflightRequestsRequest,
+) (*segpb.AbortInflightRequestsResponse, error) {
+	blockID := vanus.NewIDFromUint64(req.BlockId)
+	minAge := time.Duration(req.MinAgeMs) * time.Millisecond
+	n, err := s.srv.AbortInflightRequests(ctx, blockID, minAge)
+	if err != nil {
+		return nil, err
+	}
+
+	return &segpb.AbortInflightRequestsResponse{Aborted: int32(n)}, nil
+}
@@ -17,15 +17,18 @@ package segment
 import (
 	// standard libraries.
 	"context"
+	"strings"
 	"testing"
 
 	. "github.com/golang/mock/gomock"
 	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	// first-party libraries.
 	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
 	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 
 	// this project.
@@ -73,9 +76,9 @@ func TestSegmentServer(t *testing.T) {
 		})
 
 		Convey("CreateBlock()", func() {
-			srv.EXPECT().CreateBlock(Any(), Not(vanus.EmptyID()), Not(0)).Return(nil)
-			srv.EXPECT().CreateBlock(Any(), Eq(vanus.EmptyID()), Any()).Return(errors.ErrInvalidRequest)
-			srv.EXPECT().CreateBlock(Any(), Any(), Eq(int64(0))).Return(errors.ErrInvalidRequest)
+			srv.EXPECT().CreateBlock(Any(), Not(vanus.EmptyID()), Not(0), Any()).Return(nil)
+			srv.EXPECT().CreateBlock(Any(), Eq(vanus.EmptyID()), Any(), Any()).Return(errors.ErrInvalidRequest)
+			srv.EXPECT().CreateBlock(Any(), Any(), Eq(int64(0)), Any()).Return(errors.ErrInvalidRequest)
 
 			req := &segpb.CreateBlockRequest{
 				Id:   vanus.NewTestID().Uint64(),
@@ -119,16 +122,63 @@ func TestSegmentServer(t *testing.T) {
 		})
 
 		Convey("GetBlockInfo()", func() {
-			// FIXME: not implement.
-			req := &segpb.GetBlockInfoRequest{}
+			blockID := vanus.NewTestID()
+			srv.EXPECT().GetBlockInfo(Any(), blockID).Return([]*metapb.SegmentHealthInfo{
+				{Id: blockID.Uint64()},
+			}, nil)
+
+			req := &segpb.GetBlockInfoRequest{Ids: []uint64{blockID.Uint64()}}
 			resp, err := ss.GetBlockInfo(context.Background(), req)
 			So(err, ShouldBeNil)
-			So(resp, ShouldNotBeNil)
+			So(resp.Blocks, ShouldHaveLength, 1)
+			So(resp.Blocks[0].Id, ShouldEqual, blockID.Uint64())
+
+			srv.EXPECT().GetBlockInfo(Any()).Return(nil, errors.ErrServiceState)
+			_, err = ss.GetBlockInfo(context.Background(), &segpb.GetBlockInfoRequest{})
+			So(err, ShouldEqual, errors.ErrServiceState)
+		})
+
+		Convey("GetBlockStats()", func() {
+			blockID := vanus.NewTestID()
+			srv.EXPECT().GetBlockStats(Any(), blockID).Return([]*segpb.BlockStats{
+				{Id: blockID.Uint64(), AppendEventsPerSecond: 10},
+			}, nil)
+
+			req := &segpb.GetBlockStatsRequest{Ids: []uint64{blockID.Uint64()}}
+			resp, err := ss.GetBlockStats(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp.Blocks, ShouldHaveLength, 1)
+			So(resp.Blocks[0].Id, ShouldEqual, blockID.Uint64())
+			So(resp.Blocks[0].AppendEventsPerSecond, ShouldEqual, 10)
+
+			srv.EXPECT().GetBlockStats(Any()).Return(nil, errors.ErrServiceState)
+			_, err = ss.GetBlockStats(context.Background(), &segpb.GetBlockStatsRequest{})
+			So(err, ShouldEqual, errors.ErrServiceState)
+		})
+
+		Convey("ExportBlockManifest()", func() {
+			blockID := vanus.NewTestID()
+			srv.EXPECT().ExportBlockManifest(Any(), vanus.ID(0), 0, blockID).Return(&segpb.BlockManifest{
+				Blocks: []*segpb.BlockDigest{{Id: blockID.Uint64(), EventNumber: 10}},
+			}, nil)
+
+			req := &segpb.ExportBlockManifestRequest{Ids: []uint64{blockID.Uint64()}}
+			resp, err := ss.ExportBlockManifest(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp.Blocks, ShouldHaveLength, 1)
+			So(resp.Blocks[0].EventNumber, ShouldEqual, 10)
+
+			srv.EXPECT().ExportBlockManifest(Any(), blockID, 10).Return(nil, errors.ErrInvalidRequest)
+			_, err = ss.ExportBlockManifest(context.Background(), &segpb.ExportBlockManifestRequest{
+				StartAfter: blockID.Uint64(),
+				Limit:      10,
+			})
+			So(err, ShouldEqual, errors.ErrInvalidRequest)
 		})
 
 		Convey("ActivateSegment()", func() {
 			// TODO(james.yin):
-			srv.EXPECT().ActivateSegment(Any(), Any(), Any(), Any()).Return(nil)
+			srv.EXPECT().ActivateSegment(Any(), Any(), Any(), Any(), Any()).Return(nil)
 
 			req := &segpb.ActivateSegmentRequest{
 				EventLogId:     vanus.NewTestID().Uint64(),
@@ -185,14 +235,16 @@ func TestSegmentServer(t *testing.T) {
 
 		Convey("ReadFromBlock()", func() {
 			id := vanus.NewTestID()
-			srv.EXPECT().ReadFromBlock(Any(), Not(vanus.EmptyID()), Any(), Not(0),
+			srv.EXPECT().ReadFromBlock(Any(), Not(vanus.EmptyID()), Any(), Not(0), Eq(1024),
 				Any()).Return(make([]*cepb.CloudEvent, 1), nil)
-			srv.EXPECT().ReadFromBlock(Any(), Eq(vanus.EmptyID()), Any(), Any(), Any()).Return(nil, errors.ErrInvalidRequest)
-			srv.EXPECT().ReadFromBlock(Any(), Any(), Any(), Eq(0), Any()).Return(nil, errors.ErrResourceNotFound)
+			srv.EXPECT().ReadFromBlock(Any(), Eq(vanus.EmptyID()), Any(), Any(), Any(),
+				Any()).Return(nil, errors.ErrInvalidRequest)
+			srv.EXPECT().ReadFromBlock(Any(), Any(), Any(), Eq(0), Any(), Any()).Return(nil, errors.ErrResourceNotFound)
 
 			req := &segpb.ReadFromBlockRequest{
-				BlockId: id.Uint64(),
-				Number:  1,
+				BlockId:  id.Uint64(),
+				Number:   1,
+				MaxBytes: 1024,
 			}
 			resp, err := ss.ReadFromBlock(context.Background(), req)
 			So(err, ShouldBeNil)
@@ -210,5 +262,123 @@ func TestSegmentServer(t *testing.T) {
 			_, err = ss.ReadFromBlock(context.Background(), req)
 			So(err, ShouldEqual, errors.ErrResourceNotFound)
 		})
+
+		Convey("ReadFromBlock() raw", func() {
+			id := vanus.NewTestID()
+			payload := protowire.AppendBytes(nil, []byte("event"))
+			srv.EXPECT().ReadRawFromBlock(Any(), Eq(id), Any(), Eq(1), Any(), Any()).Return(payload, nil)
+
+			req := &segpb.ReadFromBlockRequest{
+				BlockId: id.Uint64(),
+				Number:  1,
+				Raw:     true,
+			}
+			resp, err := ss.ReadFromBlock(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp.Payload, ShouldResemble, payload)
+			So(resp.GetEvents().GetEvents(), ShouldBeEmpty)
+		})
+
+		Convey("ReadFromBlock() at the end of a sealed block", func() {
+			id := vanus.NewTestID()
+			srv.EXPECT().ReadFromBlock(Any(), Eq(id), Any(), Any(), Any(), Any()).AnyTimes().
+				Return(nil, errors.ErrOffsetOverflow)
+			srv.EXPECT().GetBlockInfo(Any(), Eq(id)).AnyTimes().Return([]*metapb.SegmentHealthInfo{{
+				Id:          id.Uint64(),
+				EventNumber: 10,
+				IsFull:      true,
+			}}, nil)
+
+			req := &segpb.ReadFromBlockRequest{
+				BlockId:    id.Uint64(),
+				Offset:     10,
+				Number:     1,
+				FollowSeal: true,
+			}
+			resp, err := ss.ReadFromBlock(context.Background(), req)
+			So(err, ShouldBeNil)
+			So(resp.Sealed, ShouldBeTrue)
+			So(resp.SealedOffset, ShouldEqual, 10)
+			So(resp.GetEvents().GetEvents(), ShouldBeEmpty)
+
+			req.FollowSeal = false
+			_, err = ss.ReadFromBlock(context.Background(), req)
+			So(err, ShouldEqual, errors.ErrOffsetOverflow)
+
+			Convey("stream", func() {
+				stream := segpb.NewMockSegmentServer_ReadFromBlockStreamServer(ctrl)
+				stream.EXPECT().Context().AnyTimes().Return(context.Background())
+				stream.EXPECT().Send(Any()).Times(1).DoAndReturn(func(resp *segpb.ReadFromBlockResponse) error {
+					So(resp.Sealed, ShouldBeTrue)
+					So(resp.SealedOffset, ShouldEqual, 10)
+					return nil
+				})
+				req.FollowSeal = true
+				So(ss.ReadFromBlockStream(req, stream), ShouldBeNil)
+			})
+		})
+
+		Convey("ReadFromBlockStream()", func() {
+			id := vanus.NewTestID()
+			events := make([]*cepb.CloudEvent, 5)
+			for i := range events {
+				events[i] = &cepb.CloudEvent{Id: strings.Repeat("a", 100)}
+			}
+			srv.EXPECT().ReadFromBlock(Any(), Eq(id), Any(), Eq(5), Any(), Any()).Return(events, nil)
+			srv.EXPECT().ReadFromBlock(Any(), Eq(vanus.EmptyID()), Any(), Any(), Any(),
+				Any()).Return(nil, errors.ErrInvalidRequest)
+
+			stream := segpb.NewMockSegmentServer_ReadFromBlockStreamServer(ctrl)
+			stream.EXPECT().Context().AnyTimes().Return(context.Background())
+			var batches [][]*cepb.CloudEvent
+			stream.EXPECT().Send(Any()).Times(3).DoAndReturn(func(resp *segpb.ReadFromBlockResponse) error {
+				batches = append(batches, resp.GetEvents().GetEvents())
+				return nil
+			})
+
+			// Each message holds 2 events at most.
+			req := &segpb.ReadFromBlockRequest{
+				BlockId:         id.Uint64(),
+				Number:          5,
+				MaxMessageBytes: streamMsgReserved + 250,
+			}
+			err := ss.ReadFromBlockStream(req, stream)
+			So(err, ShouldBeNil)
+			So(batches, ShouldResemble, [][]*cepb.CloudEvent{events[:2], events[2:4], events[4:]})
+
+			err = ss.ReadFromBlockStream(&segpb.ReadFromBlockRequest{}, stream)
+			So(err, ShouldEqual, errors.ErrInvalidRequest)
+		})
+	})
+}
+
+func TestSplitEvents(t *testing.T) {
+	Convey("split events by message size", t, func() {
+		events := []*cepb.CloudEvent{
+			{Id: strings.Repeat("a", 1000)},
+			{Id: "b"},
+		}
+		// The first event is returned even if it exceeds the limit.
+		So(splitEvents(events, 100), ShouldEqual, 1)
+		So(splitEvents(events[1:], 100), ShouldEqual, 1)
+		So(splitEvents(events, 2048), ShouldEqual, 2)
+
+		ss := segmentServer{maxSendMsgSize: 1024}
+		So(ss.streamMsgSize(0), ShouldEqual, 1024-streamMsgReserved)
+		So(ss.streamMsgSize(512), ShouldEqual, 512-streamMsgReserved)
+		So(ss.streamMsgSize(4096), ShouldEqual, 1024-streamMsgReserved)
+	})
+}
+
+func TestSplitFrames(t *testing.T) {
+	Convey("split frames by message size", t, func() {
+		payload := protowire.AppendBytes(nil, []byte(strings.Repeat("a", 1000)))
+		payload = protowire.AppendBytes(payload, []byte("b"))
+		first := len(payload) - 2
+
+		// The first frame is returned even if it exceeds the limit.
+		So(splitFrames(payload, 100), ShouldEqual, first)
+		So(splitFrames(payload[first:], 100), ShouldEqual, 2)
+		So(splitFrames(payload, 2048), ShouldEqual, len(payload))
 	})
 }
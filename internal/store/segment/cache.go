@@ -0,0 +1,236 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"container/list"
+	"sync"
+
+	// third-party libraries.
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/metrics"
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+type cacheKey struct {
+	block vanus.ID
+	seq   int64
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	event *cepb.CloudEvent
+	size  int
+	// frame is the length-delimited event served to raw reads, it's encoded once the event is read raw.
+	frame []byte
+}
+
+// cost is the memory held by the entry.
+func (e *cacheEntry) cost() int {
+	return e.size + len(e.frame)
+}
+
+// readCache keeps events recently read from blocks by their indexes, so that a range of events read by a
+// subscription is served from memory once another subscription has read it. Committed entries of a block
+// never change, so cached events are only dropped by LRU eviction or once the block is removed. Cached
+// events are shared by responses, they must not be modified. Raw reads are served by frames kept along
+// with events, so the frame of an event is encoded only once. A nil readCache caches nothing.
+type readCache struct {
+	capacity    int
+	volumeIDStr string
+
+	mu      sync.Mutex
+	size    int
+	lru     *list.List // *cacheEntry, the most recently used is at the front.
+	entries map[cacheKey]*list.Element
+}
+
+func newReadCache(cfg config.ReadCache, volumeIDStr string) *readCache {
+	if !cfg.Enable {
+		return nil
+	}
+	return &readCache{
+		capacity:    cfg.GetCapacity(),
+		volumeIDStr: volumeIDStr,
+		lru:         list.New(),
+		entries:     make(map[cacheKey]*list.Element),
+	}
+}
+
+// get returns cached events from seq in a row, at most num events are returned, and it stops once their
+// total size reaches maxBytes if it's positive. full reports whether the read is limited by num or maxBytes
+// rather than a missing event.
+func (c *readCache) get(block vanus.ID, seq int64, num, maxBytes int) (events []*cepb.CloudEvent, size int, full bool) {
+	entries, size, full := c.lookup(block, seq, num, maxBytes)
+	for i := range entries {
+		events = append(events, entries[i].event)
+	}
+	return events, size, full
+}
+
+// getFrames is get of raw reads, it returns frames of cached events in a row, n is the number of them.
+func (c *readCache) getFrames(block vanus.ID, seq int64, num, maxBytes int) (payload []byte, n, size int, full bool) {
+	entries, size, full := c.lookup(block, seq, num, maxBytes)
+	var encoded []cacheEntry
+	for i := range entries {
+		if entries[i].frame == nil {
+			// Cached events are immutable, so they are encoded out of the lock.
+			entries[i].frame = frameOf(entries[i].event)
+			encoded = append(encoded, entries[i])
+		}
+		payload = append(payload, entries[i].frame...)
+	}
+	c.keepFrames(encoded)
+	return payload, len(entries), size, full
+}
+
+// lookup returns copies of cached entries from seq, which can be used out of the lock.
+func (c *readCache) lookup(block vanus.ID, seq int64, num, maxBytes int) (entries []cacheEntry, size int, full bool) {
+	if c == nil || num <= 0 {
+		return nil, 0, false
+	}
+
+	c.mu.Lock()
+	for len(entries) < num {
+		elem, ok := c.entries[cacheKey{block: block, seq: seq + int64(len(entries))}]
+		if !ok {
+			break
+		}
+		entry, _ := elem.Value.(*cacheEntry)
+		if maxBytes > 0 && len(entries) > 0 && size+entry.size > maxBytes {
+			full = true
+			break
+		}
+		c.lru.MoveToFront(elem)
+		entries = append(entries, *entry)
+		size += entry.size
+	}
+	c.mu.Unlock()
+
+	if len(entries) == num || (maxBytes > 0 && size >= maxBytes) {
+		full = true
+	}
+	if len(entries) > 0 {
+		metrics.ReadCacheEventCounterVec.WithLabelValues(c.volumeIDStr, metrics.LabelValueCacheHit).
+			Add(float64(len(entries)))
+	}
+	return entries, size, full
+}
+
+// keepFrames stores frames encoded by getFrames, entries evicted in the meantime are skipped.
+func (c *readCache) keepFrames(encoded []cacheEntry) {
+	if len(encoded) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i := range encoded {
+		elem, ok := c.entries[encoded[i].key]
+		if !ok {
+			continue
+		}
+		if entry, _ := elem.Value.(*cacheEntry); entry.frame == nil {
+			entry.frame = encoded[i].frame
+			c.size += len(entry.frame)
+		}
+	}
+	c.evict()
+}
+
+// put caches events read from seq, events larger than the capacity aren't cached.
+func (c *readCache) put(block vanus.ID, seq int64, events []*cepb.CloudEvent) {
+	c.add(block, seq, events, nil, metrics.LabelValueCacheMiss)
+}
+
+// putFrames caches events read raw from seq along with their frames.
+func (c *readCache) putFrames(block vanus.ID, seq int64, events []*cepb.CloudEvent, frames [][]byte) {
+	c.add(block, seq, events, frames, metrics.LabelValueCacheMiss)
+}
+
+// fill caches events read ahead of consumers from seq, frames are optional.
+func (c *readCache) fill(block vanus.ID, seq int64, events []*cepb.CloudEvent, frames [][]byte) {
+	c.add(block, seq, events, frames, metrics.LabelValueCacheReadAhead)
+}
+
+func (c *readCache) add(block vanus.ID, seq int64, events []*cepb.CloudEvent, frames [][]byte, result string) {
+	if c == nil || len(events) == 0 {
+		return
+	}
+	metrics.ReadCacheEventCounterVec.WithLabelValues(c.volumeIDStr, result).Add(float64(len(events)))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, event := range events {
+		key := cacheKey{block: block, seq: seq + int64(i)}
+		if elem, ok := c.entries[key]; ok {
+			c.lru.MoveToFront(elem)
+			continue
+		}
+		entry := &cacheEntry{key: key, event: event, size: proto.Size(event)}
+		if frames != nil {
+			entry.frame = frames[i]
+		}
+		if entry.cost() > c.capacity {
+			continue
+		}
+		c.entries[key] = c.lru.PushFront(entry)
+		c.size += entry.cost()
+	}
+	c.evict()
+}
+
+func (c *readCache) evict() {
+	for c.size > c.capacity {
+		c.remove(c.lru.Back())
+	}
+	metrics.ReadCacheBytesGaugeVec.WithLabelValues(c.volumeIDStr).Set(float64(c.size))
+}
+
+// invalidate drops cached events of block.
+func (c *readCache) invalidate(block vanus.ID) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if entry, _ := elem.Value.(*cacheEntry); entry.key.block == block {
+			c.remove(elem)
+		}
+		elem = next
+	}
+	metrics.ReadCacheBytesGaugeVec.WithLabelValues(c.volumeIDStr).Set(float64(c.size))
+}
+
+func (c *readCache) remove(elem *list.Element) {
+	entry, _ := c.lru.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.cost()
+}
+
+// frameOf encodes event as a frame of raw reads, which is the length-delimited message.
+func frameOf(event *cepb.CloudEvent) []byte {
+	data, _ := proto.Marshal(event)
+	return protowire.AppendBytes(nil, data)
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"fmt"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+func TestReadCache(t *testing.T) {
+	Convey("test read cache", t, func() {
+		eventsOf := func(from, to int) []*cepb.CloudEvent {
+			events := make([]*cepb.CloudEvent, 0, to-from)
+			for i := from; i < to; i++ {
+				events = append(events, &cepb.CloudEvent{Id: fmt.Sprintf("event-%04d", i)})
+			}
+			return events
+		}
+		eventSize := proto.Size(eventsOf(0, 1)[0])
+		block := vanus.NewTestID()
+
+		Convey("disabled", func() {
+			c := newReadCache(config.ReadCache{}, "1")
+			So(c, ShouldBeNil)
+			c.put(block, 0, eventsOf(0, 4))
+			events, _, full := c.get(block, 0, 4, 0)
+			So(events, ShouldBeEmpty)
+			So(full, ShouldBeFalse)
+			c.invalidate(block)
+		})
+
+		Convey("get events in a row", func() {
+			c := newReadCache(config.ReadCache{Enable: true}, "1")
+			c.put(block, 10, eventsOf(10, 20))
+
+			events, size, full := c.get(block, 12, 4, 0)
+			So(full, ShouldBeTrue)
+			So(events, ShouldHaveLength, 4)
+			So(events[0].Id, ShouldEqual, "event-0012")
+			So(size, ShouldEqual, 4*eventSize)
+
+			events, _, full = c.get(block, 15, 10, 0)
+			So(full, ShouldBeFalse)
+			So(events, ShouldHaveLength, 5)
+
+			events, _, full = c.get(block, 10, 10, 3*eventSize+1)
+			So(full, ShouldBeTrue)
+			So(events, ShouldHaveLength, 3)
+
+			events, _, full = c.get(block, 5, 10, 0)
+			So(full, ShouldBeFalse)
+			So(events, ShouldBeEmpty)
+
+			events, _, _ = c.get(vanus.NewTestID(), 10, 10, 0)
+			So(events, ShouldBeEmpty)
+		})
+
+		Convey("evict the least recently used", func() {
+			c := newReadCache(config.ReadCache{Enable: true, Capacity: 4 * eventSize}, "1")
+			c.put(block, 0, eventsOf(0, 2))
+			c.put(block, 2, eventsOf(2, 4))
+			_, _, full := c.get(block, 0, 2, 0)
+			So(full, ShouldBeTrue)
+
+			c.put(block, 4, eventsOf(4, 6))
+			So(c.size, ShouldEqual, 4*eventSize)
+			_, _, full = c.get(block, 0, 2, 0)
+			So(full, ShouldBeTrue)
+			events, _, _ := c.get(block, 2, 2, 0)
+			So(events, ShouldBeEmpty)
+			_, _, full = c.get(block, 4, 2, 0)
+			So(full, ShouldBeTrue)
+		})
+
+		Convey("get frames of events", func() {
+			c := newReadCache(config.ReadCache{Enable: true}, "1")
+			c.put(block, 0, eventsOf(0, 4))
+			frameSize := len(frameOf(eventsOf(0, 1)[0]))
+
+			payload, n, size, full := c.getFrames(block, 1, 4, 0)
+			So(full, ShouldBeFalse)
+			So(n, ShouldEqual, 3)
+			So(size, ShouldEqual, 3*eventSize)
+			So(payload, ShouldHaveLength, 3*frameSize)
+			for i := 0; i < n; i++ {
+				data, m := protowire.ConsumeBytes(payload)
+				So(m, ShouldEqual, frameSize)
+				event := &cepb.CloudEvent{}
+				So(proto.Unmarshal(data, event), ShouldBeNil)
+				So(event.Id, ShouldEqual, fmt.Sprintf("event-%04d", i+1))
+				payload = payload[m:]
+			}
+			// Encoded frames are kept along with events.
+			So(c.size, ShouldEqual, 4*eventSize+3*frameSize)
+
+			payload, n, _, full = c.getFrames(block, 0, 2, 0)
+			So(full, ShouldBeTrue)
+			So(n, ShouldEqual, 2)
+			So(payload, ShouldHaveLength, 2*frameSize)
+			So(c.size, ShouldEqual, 4*(eventSize+frameSize))
+
+			frames := [][]byte{frameOf(eventsOf(4, 5)[0]), frameOf(eventsOf(5, 6)[0])}
+			c.putFrames(block, 4, eventsOf(4, 6), frames)
+			So(c.size, ShouldEqual, 6*(eventSize+frameSize))
+			events, _, full := c.get(block, 4, 2, 0)
+			So(full, ShouldBeTrue)
+			So(events[1].Id, ShouldEqual, "event-0005")
+
+			c.invalidate(block)
+			So(c.size, ShouldEqual, 0)
+			payload, n, _, _ = c.getFrames(block, 0, 2, 0)
+			So(payload, ShouldBeEmpty)
+			So(n, ShouldEqual, 0)
+		})
+
+		Convey("invalidate a block", func() {
+			c := newReadCache(config.ReadCache{Enable: true}, "1")
+			other := vanus.NewTestID()
+			c.put(block, 0, eventsOf(0, 4))
+			c.put(other, 0, eventsOf(0, 4))
+			c.invalidate(block)
+			events, _, _ := c.get(block, 0, 4, 0)
+			So(events, ShouldBeEmpty)
+			events, _, _ = c.get(other, 0, 4, 0)
+			So(events, ShouldHaveLength, 4)
+			So(c.size, ShouldEqual, 4*eventSize)
+		})
+	})
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"io"
+
+	// third-party libraries.
+	"google.golang.org/grpc"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+// snapshotFetcher returns the snapshot of Block id exported by the segment server at addr.
+type snapshotFetcher func(ctx context.Context, addr string, id vanus.ID) ([]byte, error)
+
+// ExportBlock returns the snapshot of full Block id, which is restored by CopyBlock of another server.
+func (s *server) ExportBlock(ctx context.Context, id vanus.ID) ([]byte, error) {
+	ctx, span := s.tracer.Start(ctx, "ExportBlock")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return nil, err
+	}
+
+	b, ref, err := s.acquireReplica(id)
+	if err != nil {
+		return nil, err
+	}
+	defer ref.release()
+
+	if s.scrubber.isCorrupted(id) {
+		return nil, errors.ErrCorruptedEvent.WithMessage("the block is corrupted")
+	}
+	if !b.Status().IsFull {
+		return nil, errors.ErrInvalidRequest.WithMessage("the block isn't full")
+	}
+
+	snap, err := b.Export(ctx)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMessage("export the block failed").Wrap(err)
+	}
+	return block.MarshalFragment(ctx, snap)
+}
+
+// CopyBlock fills empty Block id with the snapshot of full Block sourceID in the segment server at addr, so
+// that a corrupted replica of a sealed segment is replaced. Entries are copied as they are stored, so their
+// sequences and timestamps are kept, and they are verified against their checksums before the copy succeeds.
+func (s *server) CopyBlock(ctx context.Context, id vanus.ID, sourceID vanus.ID, addr string) error {
+	ctx, span := s.tracer.Start(ctx, "CopyBlock")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	b, ref, err := s.acquireReplica(id)
+	if err != nil {
+		return err
+	}
+	defer ref.release()
+
+	if info := b.Status(); info.IsFull || info.EventNumber != 0 {
+		return errors.ErrInvalidRequest.WithMessage("the block isn't empty")
+	}
+
+	log.Info(ctx, "Copy block.", map[string]interface{}{
+		"block_id":        id,
+		"source_block_id": sourceID,
+		"source_address":  addr,
+	})
+
+	data, err := s.fetchSnapshot(ctx, addr, sourceID)
+	if err != nil {
+		log.Warning(ctx, "Fetch the snapshot of the source block failed.", map[string]interface{}{
+			"block_id":        id,
+			"source_block_id": sourceID,
+			log.KeyError:      err,
+		})
+		return errors.ErrInternal.WithMessage("fetch the snapshot of the source block failed").Wrap(err)
+	}
+	if len(data) < 8 {
+		return errors.ErrInternal.WithMessage("the snapshot of the source block is malformed")
+	}
+
+	if err = b.Restore(ctx, block.NewFragment(data)); err != nil {
+		return errors.ErrInternal.WithMessage("restore the block failed").Wrap(err)
+	}
+	if err = verifyReplica(ctx, b); err != nil {
+		log.Warning(ctx, "Verify the copied block failed.", map[string]interface{}{
+			"block_id":        id,
+			"source_block_id": sourceID,
+			log.KeyError:      err,
+		})
+		if stderr.Is(err, block.ErrCorrupted) {
+			return errors.ErrCorruptedEvent.WithMessage("the copied block is corrupted").Wrap(err)
+		}
+		return errors.ErrInternal.WithMessage("verify the copied block failed").Wrap(err)
+	}
+
+	log.Info(ctx, "The block has been copied.", map[string]interface{}{
+		"block_id":        id,
+		"source_block_id": sourceID,
+		"size":            len(data) - 8,
+	})
+	return nil
+}
+
+// verifyReplica reads all entries of the block, so that entries mismatching their checksums are found. It
+// fails if the block isn't full.
+func verifyReplica(ctx context.Context, b Replica) error {
+	info := b.Status()
+	if !info.IsFull {
+		return errors.ErrInternal.WithMessage("the block isn't full")
+	}
+	for seq := int64(0); seq < int64(info.EventNumber); {
+		entries, err := b.Read(ctx, seq, scrubChunkNum, scrubChunkSize)
+		if err != nil {
+			return err
+		}
+		seq += int64(len(entries))
+	}
+	return nil
+}
+
+// fetchSnapshot receives the snapshot of Block id from ExportBlock of the segment server at addr.
+func (s *server) fetchSnapshot(ctx context.Context, addr string, id vanus.ID) ([]byte, error) {
+	if s.fetcher != nil {
+		return s.fetcher(ctx, addr, id)
+	}
+
+	opts := append([]grpc.DialOption{grpc.WithTransportCredentials(s.credentials)},
+		compression.DataDialOptions()...)
+	conn, err := grpc.DialContext(ctx, addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	stream, err := segpb.NewSegmentServerClient(conn).ExportBlock(ctx, &segpb.ExportBlockRequest{Id: id.Uint64()})
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	for {
+		resp, err := stream.Recv()
+		if stderr.Is(err, io.EOF) {
+			return data, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, resp.Data...)
+	}
+}
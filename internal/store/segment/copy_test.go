@@ -0,0 +1,112 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/binary"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+func TestServer_CopyBlock(t *testing.T) {
+	Convey("copy a full block from another server", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		data := make([]byte, 8+1024)
+		binary.LittleEndian.PutUint64(data, 4096)
+		for i := 8; i < len(data); i++ {
+			data[i] = byte(i)
+		}
+
+		source := &server{
+			state:    primitive.ServerStateRunning,
+			scrubber: newScrubber(config.Scrub{}, "1"),
+		}
+		srcID := vanus.NewTestID()
+		src := NewMockReplica(ctrl)
+		src.EXPECT().Status().AnyTimes().Return(&metapb.SegmentHealthInfo{IsFull: true, EventNumber: 10})
+		src.EXPECT().Export(Any()).AnyTimes().Return(block.NewFragment(data), nil)
+		source.replicas.Store(srcID, src)
+
+		const addr = "127.0.0.1:11811"
+		target := &server{
+			state:    primitive.ServerStateRunning,
+			scrubber: newScrubber(config.Scrub{}, "1"),
+			fetcher: func(ctx context.Context, a string, id vanus.ID) ([]byte, error) {
+				So(a, ShouldEqual, addr)
+				return source.ExportBlock(ctx, id)
+			},
+		}
+		id := vanus.NewTestID()
+		dst := NewMockReplica(ctrl)
+		status := &metapb.SegmentHealthInfo{}
+		dst.EXPECT().Status().AnyTimes().DoAndReturn(func() *metapb.SegmentHealthInfo {
+			return status
+		})
+		target.replicas.Store(id, dst)
+		restore := func() {
+			dst.EXPECT().Restore(Any(), Any()).Times(1).DoAndReturn(func(_ context.Context, snap block.Fragment) error {
+				So(snap.StartOffset(), ShouldEqual, int64(4096))
+				So(snap.Payload(), ShouldResemble, data[8:])
+				status = &metapb.SegmentHealthInfo{IsFull: true, EventNumber: 10}
+				return nil
+			})
+		}
+
+		Convey("entries of the copy are verified", func() {
+			restore()
+			dst.EXPECT().Read(Any(), int64(0), Any(), Any()).Times(1).Return(make([]block.Entry, 6), nil)
+			dst.EXPECT().Read(Any(), int64(6), Any(), Any()).Times(1).Return(make([]block.Entry, 4), nil)
+			So(target.CopyBlock(ctx, id, srcID, addr), ShouldBeNil)
+		})
+
+		Convey("the corrupted copy fails", func() {
+			restore()
+			dst.EXPECT().Read(Any(), int64(0), Any(), Any()).Times(1).Return(nil, block.ErrCorrupted)
+			err := target.CopyBlock(ctx, id, srcID, addr)
+			So(errors.Is(err, errors.ErrCorruptedEvent), ShouldBeTrue)
+		})
+
+		Convey("the block which isn't empty isn't overwritten", func() {
+			status = &metapb.SegmentHealthInfo{EventNumber: 1}
+			err := target.CopyBlock(ctx, id, srcID, addr)
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("the corrupted source isn't exported", func() {
+			So(source.scrubber.markCorrupted(srcID), ShouldBeTrue)
+			_, err := source.ExportBlock(ctx, srcID)
+			So(errors.Is(err, errors.ErrCorruptedEvent), ShouldBeTrue)
+			So(target.CopyBlock(ctx, id, srcID, addr), ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,232 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	digestFileName = "block_digests.json"
+	digestFilePerm = 0o644
+)
+
+// sealedDigest is the digest of a block recorded when it's sealed, manifests sign it rather than the
+// current bytes of the block, so that altered events can't be signed.
+type sealedDigest struct {
+	EventNumber int64  `json:"event_number"`
+	Size        int64  `json:"size"`
+	Sha256      []byte `json:"sha256"`
+}
+
+func (d sealedDigest) equal(o sealedDigest) bool {
+	return d.EventNumber == o.EventNumber && d.Size == o.Size && bytes.Equal(d.Sha256, o.Sha256)
+}
+
+// digestLedger keeps digests of sealed blocks, and blocks which are sealed but not digested yet.
+type digestLedger struct {
+	mu      sync.RWMutex
+	digests map[vanus.ID]sealedDigest
+	pending map[vanus.ID]struct{}
+	notifyC chan struct{}
+
+	// fileMu serializes writes of the file.
+	fileMu sync.Mutex
+	path   string
+}
+
+func newDigestLedger() *digestLedger {
+	return &digestLedger{
+		digests: make(map[vanus.ID]sealedDigest),
+		pending: make(map[vanus.ID]struct{}),
+		notifyC: make(chan struct{}, 1),
+	}
+}
+
+func (l *digestLedger) get(id vanus.ID) (sealedDigest, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	d, ok := l.digests[id]
+	return d, ok
+}
+
+// enqueue adds the block to be digested, it does nothing if the block has been digested.
+func (l *digestLedger) enqueue(id vanus.ID) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	if _, ok := l.digests[id]; ok {
+		l.mu.Unlock()
+		return
+	}
+	l.pending[id] = struct{}{}
+	l.mu.Unlock()
+
+	select {
+	case l.notifyC <- struct{}{}:
+	default:
+	}
+}
+
+// takePending returns blocks enqueued since the last call.
+func (l *digestLedger) takePending() []vanus.ID {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	ids := make([]vanus.ID, 0, len(l.pending))
+	for id := range l.pending {
+		ids = append(ids, id)
+	}
+	l.pending = make(map[vanus.ID]struct{})
+	return ids
+}
+
+// record keeps the digest and persists it, digests recorded before are never replaced.
+func (l *digestLedger) record(id vanus.ID, d sealedDigest) error {
+	l.mu.Lock()
+	if _, ok := l.digests[id]; ok {
+		l.mu.Unlock()
+		return nil
+	}
+	l.digests[id] = d
+	l.mu.Unlock()
+	return l.persist()
+}
+
+func (l *digestLedger) forget(id vanus.ID) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	delete(l.pending, id)
+	if _, ok := l.digests[id]; !ok {
+		l.mu.Unlock()
+		return
+	}
+	delete(l.digests, id)
+	l.mu.Unlock()
+
+	if err := l.persist(); err != nil {
+		log.Warning(context.Background(), "Persist digests of blocks failed.", map[string]interface{}{
+			"block_id":   id,
+			log.KeyError: err,
+		})
+	}
+}
+
+// load restores digests persisted in path of blocks which are known, and persists digests to it after then.
+func (l *digestLedger) load(path string, known func(id vanus.ID) bool) error {
+	if l == nil {
+		return nil
+	}
+	l.fileMu.Lock()
+	defer l.fileMu.Unlock()
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	persisted := make(map[vanus.ID]sealedDigest)
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &persisted); err != nil {
+			return err
+		}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.path = path
+	for id, d := range persisted {
+		// The block may have been deleted after it's digested.
+		if known(id) {
+			l.digests[id] = d
+		}
+	}
+	return nil
+}
+
+// persist writes digests to the file loaded before, it does nothing if no file is loaded.
+func (l *digestLedger) persist() error {
+	l.fileMu.Lock()
+	defer l.fileMu.Unlock()
+
+	l.mu.RLock()
+	path := l.path
+	persisted := make(map[vanus.ID]sealedDigest, len(l.digests))
+	for id, d := range l.digests {
+		persisted[id] = d
+	}
+	l.mu.RUnlock()
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(persisted)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(path, data, digestFilePerm)
+}
+
+// runDigester digests blocks once they are sealed. Sealed blocks which aren't digested, e.g. ones sealed
+// before a signing key is configured, are digested at first.
+func (s *server) runDigester() {
+	s.replicas.Range(func(key, value interface{}) bool {
+		if b, _ := value.(Replica); b.Status().IsFull {
+			s.digests.enqueue(b.ID())
+		}
+		return true
+	})
+
+	for {
+		select {
+		case <-s.closeC:
+			return
+		case <-s.digests.notifyC:
+		}
+		for _, id := range s.digests.takePending() {
+			s.digestSealed(id)
+		}
+	}
+}
+
+func (s *server) digestSealed(id vanus.ID) {
+	v, ok := s.replicas.Load(id)
+	if !ok {
+		// The block has been deleted.
+		return
+	}
+	ctx := context.Background()
+	d, err := s.digestReplica(ctx, v.(Replica))
+	if err == nil {
+		err = s.digests.record(id, d)
+	}
+	if err != nil {
+		log.Warning(ctx, "Record the digest of the sealed block failed.", map[string]interface{}{
+			"block_id":   id,
+			log.KeyError: err,
+		})
+	}
+}
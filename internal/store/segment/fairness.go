@@ -0,0 +1,173 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"sync"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+type appendTask struct {
+	size int
+	run  func(done func())
+}
+
+// appendQueue is the queue of an eventlog, deficit is the token bucket which is refilled by a quantum at the
+// start of its turn.
+type appendQueue struct {
+	tasks   []*appendTask
+	deficit int
+	serving bool
+}
+
+// appendScheduler shares disk writes among eventlogs by deficit round robin, so that a hot eventlog can't
+// monopolize the device and starve appends of others. Appends run at once while fewer than maxInflight
+// bytes are being appended, otherwise they are queued by eventlogs, and each queue takes a quantum of bytes
+// in its turn. Blocks whose eventlogs are unknown are queued by themselves. A nil appendScheduler runs
+// appends at once.
+type appendScheduler struct {
+	quantum     int
+	maxInflight int
+
+	mu     sync.Mutex
+	owners map[vanus.ID]vanus.ID // block ID -> eventlog ID
+	queues map[vanus.ID]*appendQueue
+	// ring holds keys of non-empty queues in their turns.
+	ring     []vanus.ID
+	inflight int
+}
+
+func newAppendScheduler(cfg config.AppendFairness) *appendScheduler {
+	if !cfg.Enable {
+		return nil
+	}
+	return &appendScheduler{
+		quantum:     cfg.GetQuantum(),
+		maxInflight: cfg.GetMaxInflightBytes(),
+		owners:      make(map[vanus.ID]vanus.ID),
+		queues:      make(map[vanus.ID]*appendQueue),
+	}
+}
+
+// bind records the eventlog of block.
+func (s *appendScheduler) bind(block, eventlog vanus.ID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.owners[block] = eventlog
+}
+
+func (s *appendScheduler) unbind(block vanus.ID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.owners, block)
+}
+
+// submit runs the append of size bytes to block in its turn, run must call done once the append completes.
+func (s *appendScheduler) submit(block vanus.ID, size int, run func(done func())) {
+	if s == nil || !featuregate.Enabled(featuregate.AppendFairness) {
+		run(func() {})
+		return
+	}
+	task := &appendTask{size: size, run: run}
+
+	s.mu.Lock()
+	key, ok := s.owners[block]
+	if !ok {
+		key = block
+	}
+	q := s.queues[key]
+	if q == nil {
+		q = &appendQueue{}
+		s.queues[key] = q
+		s.ring = append(s.ring, key)
+	}
+	q.tasks = append(q.tasks, task)
+	ready := s.dispatch()
+	s.mu.Unlock()
+
+	s.start(ready)
+}
+
+func (s *appendScheduler) start(tasks []*appendTask) {
+	for _, task := range tasks {
+		size := task.size
+		task.run(func() {
+			s.release(size)
+		})
+	}
+}
+
+// release is called by done of appends, which runs in propose callbacks on goroutines of raft nodes. Tasks
+// it dispatches propose through raft nodes too, so they are started on another goroutine, otherwise a node
+// would block on proposing to itself.
+func (s *appendScheduler) release(size int) {
+	s.mu.Lock()
+	s.inflight -= size
+	ready := s.dispatch()
+	s.mu.Unlock()
+
+	if len(ready) != 0 {
+		go s.start(ready)
+	}
+}
+
+// dispatch takes tasks in turns of queues until maxInflight bytes are being appended, the queue at the head
+// of ring keeps its turn if it's interrupted. It must be called with mu held.
+func (s *appendScheduler) dispatch() []*appendTask {
+	var ready []*appendTask
+	for len(s.ring) != 0 {
+		key := s.ring[0]
+		q := s.queues[key]
+		if !q.serving {
+			q.serving = true
+			q.deficit += s.quantum
+		}
+		for len(q.tasks) != 0 {
+			task := q.tasks[0]
+			if task.size > q.deficit {
+				break
+			}
+			// A task larger than maxInflight runs alone.
+			if s.inflight != 0 && s.inflight+task.size > s.maxInflight {
+				return ready
+			}
+			q.tasks[0] = nil
+			q.tasks = q.tasks[1:]
+			q.deficit -= task.size
+			s.inflight += task.size
+			ready = append(ready, task)
+		}
+		q.serving = false
+		s.ring = s.ring[1:]
+		if len(q.tasks) == 0 {
+			// Idle queues don't save tokens.
+			delete(s.queues, key)
+			continue
+		}
+		s.ring = append(s.ring, key)
+	}
+	return ready
+}
@@ -0,0 +1,182 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
+	"github.com/linkall-labs/vanus/raft"
+	"github.com/linkall-labs/vanus/raft/raftpb"
+)
+
+func TestAppendScheduler(t *testing.T) {
+	Convey("test append scheduler", t, func() {
+		Convey("disabled", func() {
+			s := newAppendScheduler(config.AppendFairness{})
+			So(s, ShouldBeNil)
+			ran := false
+			s.submit(vanus.NewTestID(), 100, func(done func()) {
+				ran = true
+				done()
+			})
+			So(ran, ShouldBeTrue)
+		})
+
+		Convey("share appends among eventlogs", func() {
+			s := newAppendScheduler(config.AppendFairness{Enable: true, Quantum: 100, MaxInflightBytes: 100})
+			hot, cold := vanus.NewTestID(), vanus.NewTestID()
+			block0, block1, block2 := vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID()
+			s.bind(block0, hot)
+			s.bind(block1, hot)
+			s.bind(block2, cold)
+
+			var mu sync.Mutex
+			var started []string
+			pending := make(chan func(), 5)
+			submit := func(block vanus.ID, name string) {
+				s.submit(block, 100, func(done func()) {
+					mu.Lock()
+					started = append(started, name)
+					mu.Unlock()
+					pending <- done
+				})
+			}
+
+			submit(block0, "hot-1")
+			submit(block1, "hot-2")
+			submit(block0, "hot-3")
+			submit(block1, "hot-4")
+			submit(block2, "cold-1")
+			mu.Lock()
+			So(started, ShouldResemble, []string{"hot-1"})
+			mu.Unlock()
+
+			// Queued appends are started on other goroutines once done is called.
+			for i := 0; i < 5; i++ {
+				(<-pending)()
+			}
+			So(started, ShouldResemble, []string{"hot-1", "hot-2", "cold-1", "hot-3", "hot-4"})
+			So(s.inflight, ShouldEqual, 0)
+			So(s.queues, ShouldBeEmpty)
+			So(s.ring, ShouldBeEmpty)
+		})
+
+		Convey("run large append alone", func() {
+			s := newAppendScheduler(config.AppendFairness{Enable: true, Quantum: 100, MaxInflightBytes: 100})
+			ran := false
+			s.submit(vanus.NewTestID(), 1000, func(done func()) {
+				ran = true
+				done()
+			})
+			So(ran, ShouldBeTrue)
+			So(s.inflight, ShouldEqual, 0)
+		})
+
+		Convey("queue appends proposed through a raft node", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			node := startTestRaftNode(ctx)
+
+			s := newAppendScheduler(config.AppendFairness{Enable: true, Quantum: 100, MaxInflightBytes: 100})
+			block := vanus.NewTestID()
+			s.bind(block, vanus.NewTestID())
+
+			// done is called in propose callbacks, which run on the goroutine of the node.
+			const n = 10
+			var wg sync.WaitGroup
+			wg.Add(n)
+			for i := 0; i < n; i++ {
+				s.submit(block, 100, func(done func()) {
+					node.Propose(ctx, raft.ProposeData{
+						Data: []byte("event"),
+						Callback: func(err error) {
+							done()
+							wg.Done()
+						},
+					})
+				})
+			}
+
+			finished := make(chan struct{})
+			go func() {
+				wg.Wait()
+				close(finished)
+			}()
+			select {
+			case <-finished:
+			case <-time.After(5 * time.Second):
+				So("appends are blocked", ShouldBeEmpty)
+			}
+			So(s.queues, ShouldBeEmpty)
+		})
+	})
+}
+
+// startTestRaftNode starts a raft node of a single voter and waits for it to be elected.
+func startTestRaftNode(ctx context.Context) raft.Node {
+	storage := raft.NewMemoryStorage()
+	_ = storage.ApplySnapshot(raftpb.Snapshot{Metadata: raftpb.SnapshotMetadata{
+		Index: 1, Term: 1, ConfState: raftpb.ConfState{Voters: []uint64{1}},
+	}})
+	node := raft.RestartNode(&raft.Config{
+		ID:              1,
+		ElectionTick:    10,
+		HeartbeatTick:   1,
+		Storage:         storage,
+		MaxSizePerMsg:   1024 * 1024,
+		MaxInflightMsgs: 256,
+	})
+
+	elected := make(chan struct{})
+	var once sync.Once
+	go func() {
+		t := time.NewTicker(10 * time.Millisecond)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				node.Tick()
+			case rd := <-node.Ready():
+				if !raft.IsEmptyHardState(rd.HardState) {
+					_ = storage.SetHardState(rd.HardState)
+				}
+				if n := len(rd.Entries); n != 0 {
+					_ = storage.Append(rd.Entries)
+					_ = node.ReportLogged(ctx, rd.Entries[n-1].Index, rd.Entries[n-1].Term)
+				}
+				if rd.SoftState != nil && rd.SoftState.RaftState == raft.StateLeader {
+					once.Do(func() { close(elected) })
+				}
+				node.Advance()
+			case <-ctx.Done():
+				node.Stop()
+				return
+			}
+		}
+	}()
+	_ = node.Campaign(ctx)
+	<-elected
+	return node
+}
@@ -0,0 +1,200 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	inflightAppend = "append"
+	inflightRead   = "read"
+
+	defaultInflightReportInterval = time.Second
+)
+
+var errRequestAborted = errors.ErrInternal.WithMessage("the request is aborted")
+
+type inflightRequest struct {
+	blockID vanus.ID
+	typ     string
+	start   time.Time
+	cancel  context.CancelFunc
+	aborted int32
+}
+
+func (r *inflightRequest) age(now time.Time) time.Duration {
+	return now.Sub(r.start)
+}
+
+func (r *inflightRequest) isAborted() bool {
+	return atomic.LoadInt32(&r.aborted) != 0
+}
+
+// inflightTracker tracks uncompleted append and read requests, so that requests stuck on a failing disk
+// can be found and aborted, and blocks are closed only after requests are drained. The zero value is ready
+// to use.
+type inflightTracker struct {
+	mu       sync.Mutex
+	requests map[*inflightRequest]struct{}
+	// closing rejects new requests once it's set.
+	closing bool
+	// drained is closed once the last request is untracked after closing is set.
+	drained chan struct{}
+	// reported is the label sets of metrics set by the last report, only report accesses it.
+	reported map[inflightKey]struct{}
+}
+
+type inflightKey struct {
+	block string
+	typ   string
+}
+
+// track registers a request, the returned context is canceled once the request is aborted. It fails with
+// ErrBlockClosing once the tracker is draining.
+func (t *inflightTracker) track(
+	ctx context.Context, blockID vanus.ID, typ string,
+) (context.Context, *inflightRequest, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closing {
+		return ctx, nil, errors.ErrBlockClosing.WithMessage("the segment server is closing")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	req := &inflightRequest{
+		blockID: blockID,
+		typ:     typ,
+		start:   time.Now(),
+		cancel:  cancel,
+	}
+	if t.requests == nil {
+		t.requests = make(map[*inflightRequest]struct{})
+	}
+	t.requests[req] = struct{}{}
+	return ctx, req, nil
+}
+
+func (t *inflightTracker) untrack(req *inflightRequest) {
+	t.mu.Lock()
+	delete(t.requests, req)
+	if len(t.requests) == 0 && t.drained != nil {
+		close(t.drained)
+		t.drained = nil
+	}
+	t.mu.Unlock()
+	req.cancel()
+}
+
+// drain rejects new requests, and waits until in-flight requests are completed. Requests still in flight
+// when ctx is done are aborted, and the error of ctx is returned.
+func (t *inflightTracker) drain(ctx context.Context) error {
+	t.mu.Lock()
+	t.closing = true
+	if len(t.requests) == 0 {
+		t.mu.Unlock()
+		return nil
+	}
+	if t.drained == nil {
+		t.drained = make(chan struct{})
+	}
+	drained := t.drained
+	t.mu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		t.abort(0, 0)
+		return ctx.Err()
+	}
+}
+
+// list returns requests of block blockID, or all blocks if blockID is 0, the oldest first.
+func (t *inflightTracker) list(blockID vanus.ID) []*inflightRequest {
+	t.mu.Lock()
+	reqs := make([]*inflightRequest, 0, len(t.requests))
+	for req := range t.requests {
+		if blockID == 0 || req.blockID == blockID {
+			reqs = append(reqs, req)
+		}
+	}
+	t.mu.Unlock()
+
+	sort.Slice(reqs, func(i, j int) bool {
+		return reqs[i].start.Before(reqs[j].start)
+	})
+	return reqs
+}
+
+// abort cancels requests of block blockID, or all blocks if blockID is 0, which have been in flight for
+// at least minAge, and returns how many requests are aborted.
+func (t *inflightTracker) abort(blockID vanus.ID, minAge time.Duration) int {
+	now := time.Now()
+	var n int
+	for _, req := range t.list(blockID) {
+		if req.age(now) < minAge {
+			break
+		}
+		if atomic.CompareAndSwapInt32(&req.aborted, 0, 1) {
+			req.cancel()
+			n++
+		}
+	}
+	return n
+}
+
+// report refreshes metrics of in-flight requests, metrics of blocks without in-flight requests are removed.
+// Only metrics set by the last report are removed, so that series which are still reported don't vanish
+// between scrapes.
+func (t *inflightTracker) report(volume string) {
+	counts := make(map[inflightKey]int)
+	ages := make(map[inflightKey]time.Duration)
+	now := time.Now()
+	for _, req := range t.list(0) {
+		k := inflightKey{block: req.blockID.String(), typ: req.typ}
+		if counts[k] == 0 {
+			// The oldest comes first.
+			ages[k] = req.age(now)
+		}
+		counts[k]++
+	}
+
+	reported := make(map[inflightKey]struct{}, len(counts))
+	for k, n := range counts {
+		metrics.InflightRequestGaugeVec.WithLabelValues(volume, k.block, k.typ).Set(float64(n))
+		metrics.InflightRequestOldestAgeGaugeVec.WithLabelValues(volume, k.block, k.typ).Set(ages[k].Seconds())
+		reported[k] = struct{}{}
+	}
+	for k := range t.reported {
+		if _, ok := reported[k]; !ok {
+			metrics.InflightRequestGaugeVec.DeleteLabelValues(volume, k.block, k.typ)
+			metrics.InflightRequestOldestAgeGaugeVec.DeleteLabelValues(volume, k.block, k.typ)
+		}
+	}
+	t.reported = reported
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+)
+
+func TestInflightTracker(t *testing.T) {
+	Convey("inflight tracker", t, func() {
+		var tracker inflightTracker
+		id1 := vanus.NewTestID()
+		id2 := vanus.NewTestID()
+
+		ctx1, req1, err := tracker.track(context.Background(), id1, inflightAppend)
+		So(err, ShouldBeNil)
+		time.Sleep(shortDelayInTest)
+		ctx2, req2, err := tracker.track(context.Background(), id2, inflightRead)
+		So(err, ShouldBeNil)
+
+		Convey("list requests", func() {
+			So(tracker.list(0), ShouldResemble, []*inflightRequest{req1, req2})
+			So(tracker.list(id2), ShouldResemble, []*inflightRequest{req2})
+
+			tracker.untrack(req1)
+			So(tracker.list(0), ShouldResemble, []*inflightRequest{req2})
+			So(tracker.list(id1), ShouldBeEmpty)
+		})
+
+		Convey("abort requests", func() {
+			So(tracker.abort(0, shortDelayInTest), ShouldEqual, 1)
+			So(req1.isAborted(), ShouldBeTrue)
+			So(ctx1.Err(), ShouldEqual, context.Canceled)
+			So(req2.isAborted(), ShouldBeFalse)
+			So(ctx2.Err(), ShouldBeNil)
+
+			// Requests are aborted only once.
+			So(tracker.abort(0, 0), ShouldEqual, 1)
+			So(req2.isAborted(), ShouldBeTrue)
+			So(tracker.abort(0, 0), ShouldEqual, 0)
+		})
+
+		Convey("report metrics", func() {
+			volume := "report-test"
+			gauge := metrics.InflightRequestGaugeVec
+			tracker.report(volume)
+			So(testutil.ToFloat64(gauge.WithLabelValues(volume, id1.String(), inflightAppend)), ShouldEqual, 1)
+
+			tracker.untrack(req1)
+			tracker.report(volume)
+			So(testutil.ToFloat64(gauge.WithLabelValues(volume, id2.String(), inflightRead)), ShouldEqual, 1)
+			// the series of the block without in-flight requests is removed.
+			So(gauge.DeleteLabelValues(volume, id1.String(), inflightAppend), ShouldBeFalse)
+			So(metrics.InflightRequestOldestAgeGaugeVec.DeleteLabelValues(volume, id1.String(), inflightAppend),
+				ShouldBeFalse)
+
+			tracker.untrack(req2)
+			tracker.report(volume)
+			So(gauge.DeleteLabelValues(volume, id2.String(), inflightRead), ShouldBeFalse)
+		})
+
+		Convey("drain requests", func() {
+			go func() {
+				time.Sleep(shortDelayInTest)
+				tracker.untrack(req1)
+				tracker.untrack(req2)
+			}()
+			So(tracker.drain(context.Background()), ShouldBeNil)
+			So(tracker.list(0), ShouldBeEmpty)
+
+			_, _, err = tracker.track(context.Background(), id1, inflightAppend)
+			So(errors.Is(err, errors.ErrBlockClosing), ShouldBeTrue)
+		})
+
+		Convey("drain requests until deadline", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), shortDelayInTest)
+			defer cancel()
+			So(stderr.Is(tracker.drain(ctx), context.DeadlineExceeded), ShouldBeTrue)
+			So(req1.isAborted(), ShouldBeTrue)
+			So(ctx2.Err(), ShouldEqual, context.Canceled)
+		})
+	})
+}
+
+func TestServer_AbortInflightRequests(t *testing.T) {
+	Convey("abort in-flight append", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		id := vanus.NewTestID()
+		b := NewMockReplica(ctrl)
+		b.EXPECT().ID().AnyTimes().Return(id)
+		b.EXPECT().IDStr().AnyTimes().Return(id.String())
+		// The append is stuck and never completes.
+		b.EXPECT().Append(Any(), Any(), Any())
+		srv.replicas.Store(id, b)
+		srv.leases.grant(id, 1, time.Minute)
+
+		type result struct {
+			n   int
+			err error
+		}
+		resultC := make(chan result, 1)
+		go func() {
+			time.Sleep(shortDelayInTest)
+			n, err := srv.AbortInflightRequests(context.Background(), 0, 0)
+			resultC <- result{n: n, err: err}
+		}()
+
+		_, err := srv.AppendToBlock(context.Background(), id, []*cepb.CloudEvent{cetest.MakeEvent0()})
+		So(err, ShouldEqual, errRequestAborted)
+		res := <-resultC
+		So(res.err, ShouldBeNil)
+		So(res.n, ShouldEqual, 1)
+
+		reqs, err := srv.ListInflightRequests(context.Background(), id)
+		So(err, ShouldBeNil)
+		So(reqs, ShouldBeEmpty)
+	})
+}
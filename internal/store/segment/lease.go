@@ -0,0 +1,190 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	leaseFileName = "write_leases.json"
+	leaseFilePerm = 0o644
+)
+
+type writeLease struct {
+	epoch    uint64
+	expireAt time.Time
+}
+
+type persistedLease struct {
+	BlockID uint64 `json:"block_id"`
+	Epoch   uint64 `json:"epoch"`
+	// ExpireAt is in unix milliseconds.
+	ExpireAt int64 `json:"expire_at"`
+}
+
+// leaseTable holds write leases issued by controller. A block accepts appends only while it holds an
+// unexpired lease, so a block whose segment has been sealed or failed over elsewhere stops accepting
+// appends once the controller stops renewing its lease. Leases are persisted to a file once it's loaded,
+// so that blocks keep appending across restarts of the server until their leases expire, instead of
+// waiting for the controller to renew them. The zero value is ready to use.
+type leaseTable struct {
+	mu     sync.RWMutex
+	leases map[vanus.ID]writeLease
+
+	// fileMu serializes writes of the file.
+	fileMu sync.Mutex
+	path   string
+}
+
+// load restores unexpired leases persisted in path, and persists leases to it after then.
+func (t *leaseTable) load(path string, now time.Time) error {
+	t.fileMu.Lock()
+	defer t.fileMu.Unlock()
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var persisted []persistedLease
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &persisted); err != nil {
+			return err
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.path = path
+	if t.leases == nil {
+		t.leases = make(map[vanus.ID]writeLease)
+	}
+	for _, l := range persisted {
+		expireAt := time.UnixMilli(l.ExpireAt)
+		if !now.Before(expireAt) {
+			continue
+		}
+		id := vanus.NewIDFromUint64(l.BlockID)
+		if cur, ok := t.leases[id]; ok && cur.epoch >= l.Epoch {
+			continue
+		}
+		t.leases[id] = writeLease{epoch: l.Epoch, expireAt: expireAt}
+	}
+	return nil
+}
+
+// persist writes unexpired leases to the file loaded before, it does nothing if no file is loaded.
+func (t *leaseTable) persist(now time.Time) error {
+	t.fileMu.Lock()
+	defer t.fileMu.Unlock()
+
+	t.mu.RLock()
+	path := t.path
+	persisted := make([]persistedLease, 0, len(t.leases))
+	for id, l := range t.leases {
+		if now.Before(l.expireAt) {
+			persisted = append(persisted, persistedLease{
+				BlockID:  id.Uint64(),
+				Epoch:    l.epoch,
+				ExpireAt: l.expireAt.UnixMilli(),
+			})
+		}
+	}
+	t.mu.RUnlock()
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(persisted)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(path, data, leaseFilePerm)
+}
+
+// writeFileAtomically writes data to a temporary file first, and renames it to path once it's synced, so
+// that path is either the old file or the new one after a crash.
+func writeFileAtomically(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return err
+	}
+	dir, err := os.Open(filepath.Dir(path))
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
+
+// grant renews the lease of block id, it returns false if the epoch is stale.
+func (t *leaseTable) grant(id vanus.ID, epoch uint64, ttl time.Duration) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.leases == nil {
+		t.leases = make(map[vanus.ID]writeLease)
+	}
+	if l, ok := t.leases[id]; ok && l.epoch > epoch {
+		return false
+	}
+	t.leases[id] = writeLease{
+		epoch:    epoch,
+		expireAt: time.Now().Add(ttl),
+	}
+	return true
+}
+
+func (t *leaseTable) remove(id vanus.ID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.leases, id)
+}
+
+// check returns an error if block id doesn't hold an unexpired lease.
+func (t *leaseTable) check(id vanus.ID) error {
+	t.mu.RLock()
+	l, ok := t.leases[id]
+	t.mu.RUnlock()
+	if !ok {
+		return errors.ErrWriteLeaseExpired.WithMessage("the block has no write lease")
+	}
+	if !time.Now().Before(l.expireAt) {
+		return errors.ErrWriteLeaseExpired.WithMessage("the write lease of block is expired")
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+)
+
+func TestLeaseTable(t *testing.T) {
+	Convey("lease table", t, func() {
+		var table leaseTable
+		id := vanus.NewTestID()
+
+		So(errors.Is(table.check(id), errors.ErrWriteLeaseExpired), ShouldBeTrue)
+
+		So(table.grant(id, 2, time.Minute), ShouldBeTrue)
+		So(table.check(id), ShouldBeNil)
+
+		// A stale grant doesn't override the lease.
+		So(table.grant(id, 1, 0), ShouldBeFalse)
+		So(table.check(id), ShouldBeNil)
+
+		So(table.grant(id, 2, 0), ShouldBeTrue)
+		So(errors.Is(table.check(id), errors.ErrWriteLeaseExpired), ShouldBeTrue)
+
+		table.remove(id)
+		So(table.grant(id, 1, time.Minute), ShouldBeTrue)
+	})
+}
+
+func TestLeaseTable_Persist(t *testing.T) {
+	Convey("persist leases across restarts", t, func() {
+		path := filepath.Join(t.TempDir(), leaseFileName)
+		now := time.Now()
+		id1, id2 := vanus.NewTestID(), vanus.NewTestID()
+
+		var table leaseTable
+		So(table.load(path, now), ShouldBeNil)
+		So(table.grant(id1, 3, time.Minute), ShouldBeTrue)
+		So(table.grant(id2, 1, 0), ShouldBeTrue)
+		So(table.persist(now), ShouldBeNil)
+
+		var restarted leaseTable
+		So(restarted.load(path, now), ShouldBeNil)
+		So(restarted.check(id1), ShouldBeNil)
+		// expired leases stay expired.
+		So(errors.Is(restarted.check(id2), errors.ErrWriteLeaseExpired), ShouldBeTrue)
+		// epochs are restored, stale grants are still rejected.
+		So(restarted.grant(id1, 2, time.Minute), ShouldBeFalse)
+
+		// leases persisted long ago are expired.
+		var late leaseTable
+		So(late.load(path, now.Add(2*time.Minute)), ShouldBeNil)
+		So(errors.Is(late.check(id1), errors.ErrWriteLeaseExpired), ShouldBeTrue)
+
+		// nothing is persisted without a loaded file.
+		var memory leaseTable
+		So(memory.grant(id1, 1, time.Minute), ShouldBeTrue)
+		So(memory.persist(now), ShouldBeNil)
+	})
+}
+
+func TestServer_AppendWithoutLease(t *testing.T) {
+	Convey("append to block without write lease", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		id := vanus.NewTestID()
+		b := NewMockReplica(ctrl)
+		b.EXPECT().ID().AnyTimes().Return(id)
+		b.EXPECT().IDStr().AnyTimes().Return(id.String())
+		srv.replicas.Store(id, b)
+
+		events := []*cepb.CloudEvent{cetest.MakeEvent0()}
+		_, err := srv.AppendToBlock(context.Background(), id, events)
+		So(errors.Is(err, errors.ErrWriteLeaseExpired), ShouldBeTrue)
+
+		// Leases of unknown blocks are ignored.
+		b.EXPECT().RecordOrigin(Any(), vanus.ID(0), uint64(1)).Return(nil)
+		err = srv.RenewWriteLeases(context.Background(), &segpb.WriteLease{
+			BlockId: id.Uint64(), Epoch: 1, TtlMs: time.Minute.Milliseconds(),
+		}, &segpb.WriteLease{
+			BlockId: vanus.NewTestID().Uint64(), Epoch: 1, TtlMs: time.Minute.Milliseconds(),
+		})
+		So(err, ShouldBeNil)
+		So(srv.leases.leases, ShouldHaveLength, 1)
+
+		b.EXPECT().Append(Any(), Any(), Any()).DoAndReturn(
+			func(_ context.Context, _ []block.Entry, cb block.AppendCallback) {
+				cb([]int64{0}, nil)
+			})
+		srv.pm = &pollingMgr{}
+		seqs, err := srv.AppendToBlock(context.Background(), id, events)
+		So(err, ShouldBeNil)
+		So(seqs, ShouldResemble, []int64{0})
+	})
+}
@@ -0,0 +1,188 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/integrity"
+)
+
+const (
+	digestChunkNum  = 4096
+	digestChunkSize = 4 * 1024 * 1024
+
+	defaultManifestPageSize = 64
+)
+
+type rawReadFunc func(ctx context.Context, seq int64, num int, maxBytes int) ([]byte, int, error)
+
+// digestBlock hashes num events of a block read in chunks, it returns the digest and bytes hashed.
+func digestBlock(ctx context.Context, num int64, read rawReadFunc) ([]byte, int64, error) {
+	h := integrity.NewDigest()
+	var size int64
+	for seq := int64(0); seq < num; {
+		if err := ctx.Err(); err != nil {
+			return nil, 0, err
+		}
+		payload, n, err := read(ctx, seq, digestChunkNum, digestChunkSize)
+		if err != nil {
+			return nil, 0, err
+		}
+		if n == 0 {
+			return nil, 0, fmt.Errorf("no event is read at %d of %d events", seq, num)
+		}
+		_, _ = h.Write(payload)
+		size += int64(len(payload))
+		seq += int64(n)
+	}
+	return h.Sum(nil), size, nil
+}
+
+func (s *server) loadSigner() error {
+	if s.cfg.Integrity.SigningKeyFile == "" {
+		return nil
+	}
+	signer, err := integrity.LoadSigner(s.cfg.Integrity.SigningKeyFile)
+	if err != nil {
+		return err
+	}
+	s.signer = signer
+	return nil
+}
+
+// ExportBlockManifest returns the signed manifest of the specified blocks, or a page of sealed blocks in the
+// server whose ids are greater than startAfter if no id is specified. Digests recorded when blocks are sealed
+// are signed, and blocks are read in full to check that they still match.
+func (s *server) ExportBlockManifest(
+	ctx context.Context, startAfter vanus.ID, limit int, ids ...vanus.ID,
+) (*segpb.BlockManifest, error) {
+	ctx, span := s.tracer.Start(ctx, "ExportBlockManifest")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return nil, err
+	}
+	if s.signer == nil {
+		return nil, errors.ErrInvalidRequest.WithMessage("no signing key of manifests is configured")
+	}
+
+	var replicas []Replica
+	var next vanus.ID
+	if len(ids) == 0 {
+		replicas, next = s.sealedPage(startAfter, limit)
+	} else {
+		for _, id := range ids {
+			v, exist := s.replicas.Load(id)
+			if !exist {
+				return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("the block %s not found", id))
+			}
+			b, _ := v.(Replica)
+			if !b.Status().IsFull {
+				return nil, errors.ErrInvalidRequest.WithMessage(fmt.Sprintf("the block %s isn't sealed", id))
+			}
+			if _, ok := s.digests.get(id); !ok {
+				return nil, errors.ErrInvalidRequest.WithMessage(
+					fmt.Sprintf("the digest of the block %s isn't recorded yet", id))
+			}
+			replicas = append(replicas, b)
+		}
+	}
+
+	m := &segpb.BlockManifest{
+		VolumeId:       s.volumeID,
+		ServerId:       s.id.Uint64(),
+		GeneratedAt:    time.Now().UnixMilli(),
+		Blocks:         make([]*segpb.BlockDigest, 0, len(replicas)),
+		NextStartAfter: next.Uint64(),
+	}
+	for _, b := range replicas {
+		sealed, _ := s.digests.get(b.ID())
+		d, err := s.digestReplica(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+		if !d.equal(sealed) {
+			s.reportCorrupted(ctx, b, fmt.Errorf("events don't match the digest recorded when it's sealed"))
+			return nil, errors.ErrCorruptedEvent.WithMessage(
+				fmt.Sprintf("the block %s has been altered since it's sealed", b.ID()))
+		}
+		m.Blocks = append(m.Blocks, &segpb.BlockDigest{
+			Id:          b.ID().Uint64(),
+			EventNumber: sealed.EventNumber,
+			Size:        sealed.Size,
+			Sha256:      sealed.Sha256,
+		})
+	}
+	s.signer.Sign(m)
+	return m, nil
+}
+
+// sealedPage returns at most limit sealed blocks whose digests are recorded and ids are greater than
+// startAfter in order, and start of the next page, which is 0 if there are no more.
+func (s *server) sealedPage(startAfter vanus.ID, limit int) ([]Replica, vanus.ID) {
+	if limit <= 0 {
+		limit = defaultManifestPageSize
+	}
+	var replicas []Replica
+	s.replicas.Range(func(key, value interface{}) bool {
+		b, _ := value.(Replica)
+		if _, ok := s.digests.get(b.ID()); ok && b.ID() > startAfter && b.Status().IsFull {
+			replicas = append(replicas, b)
+		}
+		return true
+	})
+	sort.Slice(replicas, func(i, j int) bool {
+		return replicas[i].ID() < replicas[j].ID()
+	})
+	if len(replicas) <= limit {
+		return replicas, 0
+	}
+	return replicas[:limit], replicas[limit-1].ID()
+}
+
+// digestReplica acquires the block for each chunk rather than the whole block, so that deleting it isn't
+// held up by exporting.
+func (s *server) digestReplica(ctx context.Context, b Replica) (sealedDigest, error) {
+	id := b.ID()
+	num := int64(b.Status().EventNumber)
+	sum, size, err := digestBlock(ctx, num,
+		func(ctx context.Context, seq int64, num int, maxBytes int) ([]byte, int, error) {
+			r, ref, err := s.acquireReplica(id)
+			if err != nil {
+				return nil, 0, err
+			}
+			defer ref.release()
+			return r.ReadRaw(ctx, seq, num, maxBytes)
+		})
+	if err != nil {
+		return sealedDigest{}, s.processReadError(ctx, b, err)
+	}
+	return sealedDigest{
+		EventNumber: num,
+		Size:        size,
+		Sha256:      sum,
+	}, nil
+}
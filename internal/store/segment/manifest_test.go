@@ -0,0 +1,202 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/config"
+	"github.com/linkall-labs/vanus/internal/store/integrity"
+)
+
+func TestDigestBlock(t *testing.T) {
+	Convey("test digest block", t, func() {
+		ctx := context.Background()
+		// each event is a frame of 4 bytes.
+		frame := []byte{3, 'a', 'b', 'c'}
+
+		var reads []int64
+		readN := func(n int, err error) rawReadFunc {
+			return func(ctx context.Context, seq int64, num int, maxBytes int) ([]byte, int, error) {
+				So(num, ShouldEqual, digestChunkNum)
+				So(maxBytes, ShouldEqual, digestChunkSize)
+				if err != nil && seq >= 50 {
+					return nil, 0, err
+				}
+				reads = append(reads, seq)
+				return bytes.Repeat(frame, n), n, nil
+			}
+		}
+
+		Convey("digest in chunks", func() {
+			sum, size, err := digestBlock(ctx, 100, readN(25, nil))
+			So(err, ShouldBeNil)
+			So(reads, ShouldResemble, []int64{0, 25, 50, 75})
+			So(size, ShouldEqual, 400)
+			expected := sha256.Sum256(bytes.Repeat(frame, 100))
+			So(sum, ShouldResemble, expected[:])
+		})
+
+		Convey("digest an empty block", func() {
+			sum, size, err := digestBlock(ctx, 0, readN(25, nil))
+			So(err, ShouldBeNil)
+			So(reads, ShouldBeEmpty)
+			So(size, ShouldEqual, 0)
+			expected := sha256.Sum256(nil)
+			So(sum, ShouldResemble, expected[:])
+		})
+
+		Convey("stop at the corrupted entry", func() {
+			_, _, err := digestBlock(ctx, 100, readN(50, block.ErrCorrupted))
+			So(err, ShouldEqual, block.ErrCorrupted)
+			So(reads, ShouldResemble, []int64{0})
+		})
+
+		Convey("stop if no event is read", func() {
+			_, _, err := digestBlock(ctx, 100, readN(0, nil))
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("stop once canceled", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			cancel()
+			_, _, err := digestBlock(ctx, 100, readN(25, nil))
+			So(err, ShouldEqual, context.Canceled)
+			So(reads, ShouldBeEmpty)
+		})
+	})
+}
+
+func TestServer_ExportBlockManifest(t *testing.T) {
+	Convey("export manifests of sealed blocks", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		dir, err := os.MkdirTemp("", "manifest-*")
+		So(err, ShouldBeNil)
+		defer func() {
+			So(os.RemoveAll(dir), ShouldBeNil)
+		}()
+
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		So(err, ShouldBeNil)
+		cc := ctrlpb.NewMockSegmentControllerClient(ctrl)
+		cc.EXPECT().ReportSegmentBlockIsFull(Any(), Any()).AnyTimes().Return(nil, nil)
+		srv := &server{
+			state:    primitive.ServerStateRunning,
+			cc:       cc,
+			scrubber: newScrubber(config.Scrub{}, "1"),
+			digests:  newDigestLedger(),
+			signer:   integrity.NewSigner(key),
+		}
+		path := filepath.Join(dir, digestFileName)
+		So(srv.digests.load(path, func(vanus.ID) bool { return true }), ShouldBeNil)
+
+		frame := []byte{3, 'a', 'b', 'c'}
+		payloads := make(map[vanus.ID][]byte)
+		ids := make([]vanus.ID, 4)
+		for i := range ids {
+			id := vanus.NewTestID()
+			ids[i] = id
+			payloads[id] = bytes.Repeat(frame, i+1)
+			r := NewMockReplica(ctrl)
+			r.EXPECT().ID().AnyTimes().Return(id)
+			r.EXPECT().Status().AnyTimes().Return(&metapb.SegmentHealthInfo{
+				Id: id.Uint64(), IsFull: true, EventNumber: int32(i + 1),
+			})
+			r.EXPECT().ReadRaw(Any(), int64(0), Any(), Any()).AnyTimes().DoAndReturn(
+				func(context.Context, int64, int, int) ([]byte, int, error) {
+					return payloads[id], len(payloads[id]) / len(frame), nil
+				})
+			srv.replicas.Store(id, r)
+		}
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i] < ids[j]
+		})
+		// The last block is sealed but isn't digested yet.
+		for _, id := range ids[:3] {
+			srv.digestSealed(id)
+		}
+
+		Convey("page all sealed blocks", func() {
+			m, err := srv.ExportBlockManifest(ctx, 0, 2)
+			So(err, ShouldBeNil)
+			So(m.Blocks, ShouldHaveLength, 2)
+			So(m.Blocks[0].Id, ShouldEqual, ids[0].Uint64())
+			So(m.Blocks[1].Id, ShouldEqual, ids[1].Uint64())
+			So(m.NextStartAfter, ShouldEqual, ids[1].Uint64())
+			So(integrity.Verify(m, pub), ShouldBeNil)
+
+			m, err = srv.ExportBlockManifest(ctx, vanus.NewIDFromUint64(m.NextStartAfter), 2)
+			So(err, ShouldBeNil)
+			So(m.Blocks, ShouldHaveLength, 1)
+			So(m.Blocks[0].Id, ShouldEqual, ids[2].Uint64())
+			So(m.NextStartAfter, ShouldEqual, 0)
+		})
+
+		Convey("the block which isn't digested isn't exported", func() {
+			_, err := srv.ExportBlockManifest(ctx, 0, 0, ids[3])
+			So(errors.Is(err, errors.ErrInvalidRequest), ShouldBeTrue)
+		})
+
+		Convey("the altered block isn't signed", func() {
+			payloads[ids[1]] = bytes.Repeat([]byte{3, 'x', 'y', 'z'}, 2)
+			_, err := srv.ExportBlockManifest(ctx, 0, 0, ids[1])
+			So(errors.Is(err, errors.ErrCorruptedEvent), ShouldBeTrue)
+			So(srv.scrubber.isCorrupted(ids[1]), ShouldBeTrue)
+
+			m, err := srv.ExportBlockManifest(ctx, 0, 0, ids[0])
+			So(err, ShouldBeNil)
+			expected := sha256.Sum256(frame)
+			So(m.Blocks[0].Sha256, ShouldResemble, expected[:])
+		})
+
+		Convey("digests are recovered after a restart", func() {
+			l := newDigestLedger()
+			So(l.load(path, func(id vanus.ID) bool {
+				return id != ids[0]
+			}), ShouldBeNil)
+			_, ok := l.get(ids[0])
+			So(ok, ShouldBeFalse)
+			d, ok := l.get(ids[2])
+			So(ok, ShouldBeTrue)
+			So(d.EventNumber, ShouldEqual, 3)
+			So(d.Size, ShouldEqual, 12)
+		})
+	})
+}
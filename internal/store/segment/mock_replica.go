@@ -92,6 +92,21 @@ func (mr *MockReplicaMockRecorder) Delete(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReplica)(nil).Delete), ctx)
 }
 
+// Export mocks base method.
+func (m *MockReplica) Export(ctx context.Context) (block.Fragment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx)
+	ret0, _ := ret[0].(block.Fragment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockReplicaMockRecorder) Export(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockReplica)(nil).Export), ctx)
+}
+
 // ID mocks base method.
 func (m *MockReplica) ID() vanus.ID {
 	m.ctrl.T.Helper()
@@ -120,19 +135,78 @@ func (mr *MockReplicaMockRecorder) IDStr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDStr", reflect.TypeOf((*MockReplica)(nil).IDStr))
 }
 
+// Prefetch mocks base method.
+func (m *MockReplica) Prefetch(ctx context.Context, seq int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prefetch", ctx, seq)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prefetch indicates an expected call of Prefetch.
+func (mr *MockReplicaMockRecorder) Prefetch(ctx, seq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prefetch", reflect.TypeOf((*MockReplica)(nil).Prefetch), ctx, seq)
+}
+
 // Read mocks base method.
-func (m *MockReplica) Read(ctx context.Context, seq int64, num int) ([]block.Entry, error) {
+func (m *MockReplica) Read(ctx context.Context, seq int64, num, maxBytes int) ([]block.Entry, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Read", ctx, seq, num)
+	ret := m.ctrl.Call(m, "Read", ctx, seq, num, maxBytes)
 	ret0, _ := ret[0].([]block.Entry)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Read indicates an expected call of Read.
-func (mr *MockReplicaMockRecorder) Read(ctx, seq, num interface{}) *gomock.Call {
+func (mr *MockReplicaMockRecorder) Read(ctx, seq, num, maxBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReplica)(nil).Read), ctx, seq, num, maxBytes)
+}
+
+// ReadRaw mocks base method.
+func (m *MockReplica) ReadRaw(ctx context.Context, seq int64, num, maxBytes int) ([]byte, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRaw", ctx, seq, num, maxBytes)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ReadRaw indicates an expected call of ReadRaw.
+func (mr *MockReplicaMockRecorder) ReadRaw(ctx, seq, num, maxBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRaw", reflect.TypeOf((*MockReplica)(nil).ReadRaw), ctx, seq, num, maxBytes)
+}
+
+// RecordOrigin mocks base method.
+func (m *MockReplica) RecordOrigin(ctx context.Context, eventlogID vanus.ID, epoch uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordOrigin", ctx, eventlogID, epoch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordOrigin indicates an expected call of RecordOrigin.
+func (mr *MockReplicaMockRecorder) RecordOrigin(ctx, eventlogID, epoch interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockReplica)(nil).Read), ctx, seq, num)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordOrigin", reflect.TypeOf((*MockReplica)(nil).RecordOrigin), ctx, eventlogID, epoch)
+}
+
+// Restore mocks base method.
+func (m *MockReplica) Restore(ctx context.Context, snap block.Fragment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, snap)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockReplicaMockRecorder) Restore(ctx, snap interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockReplica)(nil).Restore), ctx, snap)
 }
 
 // Seek mocks base method.
@@ -150,6 +224,20 @@ func (mr *MockReplicaMockRecorder) Seek(ctx, index, key, flag interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seek", reflect.TypeOf((*MockReplica)(nil).Seek), ctx, index, key, flag)
 }
 
+// Stats mocks base method.
+func (m *MockReplica) Stats() block.Statistics {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats")
+	ret0, _ := ret[0].(block.Statistics)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockReplicaMockRecorder) Stats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockReplica)(nil).Stats))
+}
+
 // Status mocks base method.
 func (m *MockReplica) Status() *meta.SegmentHealthInfo {
 	m.ctrl.T.Helper()
@@ -163,3 +251,32 @@ func (mr *MockReplicaMockRecorder) Status() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockReplica)(nil).Status))
 }
+
+// UseDictionary mocks base method.
+func (m *MockReplica) UseDictionary(ctx context.Context, dict []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseDictionary", ctx, dict)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseDictionary indicates an expected call of UseDictionary.
+func (mr *MockReplicaMockRecorder) UseDictionary(ctx, dict interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseDictionary", reflect.TypeOf((*MockReplica)(nil).UseDictionary), ctx, dict)
+}
+
+// VerifyChecksum mocks base method.
+func (m *MockReplica) VerifyChecksum(ctx context.Context, p block.ChecksumProgress, maxBytes int) (block.ChecksumProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyChecksum", ctx, p, maxBytes)
+	ret0, _ := ret[0].(block.ChecksumProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyChecksum indicates an expected call of VerifyChecksum.
+func (mr *MockReplicaMockRecorder) VerifyChecksum(ctx, p, maxBytes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyChecksum", reflect.TypeOf((*MockReplica)(nil).VerifyChecksum), ctx, p, maxBytes)
+}
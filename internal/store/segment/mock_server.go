@@ -8,11 +8,14 @@ import (
 	context "context"
 	net "net"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	primitive "github.com/linkall-labs/vanus/internal/primitive"
 	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
 	cloudevents "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
+	segment "github.com/linkall-labs/vanus/proto/pkg/segment"
 )
 
 // MockServer is a mock of Server interface.
@@ -38,18 +41,33 @@ func (m *MockServer) EXPECT() *MockServerMockRecorder {
 	return m.recorder
 }
 
+// AbortInflightRequests mocks base method.
+func (m *MockServer) AbortInflightRequests(ctx context.Context, id vanus.ID, minAge time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortInflightRequests", ctx, id, minAge)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortInflightRequests indicates an expected call of AbortInflightRequests.
+func (mr *MockServerMockRecorder) AbortInflightRequests(ctx, id, minAge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortInflightRequests", reflect.TypeOf((*MockServer)(nil).AbortInflightRequests), ctx, id, minAge)
+}
+
 // ActivateSegment mocks base method.
-func (m *MockServer) ActivateSegment(ctx context.Context, logID, segID vanus.ID, replicas map[vanus.ID]string) error {
+func (m *MockServer) ActivateSegment(ctx context.Context, logID, segID vanus.ID, replicas map[vanus.ID]string, dict []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ActivateSegment", ctx, logID, segID, replicas)
+	ret := m.ctrl.Call(m, "ActivateSegment", ctx, logID, segID, replicas, dict)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ActivateSegment indicates an expected call of ActivateSegment.
-func (mr *MockServerMockRecorder) ActivateSegment(ctx, logID, segID, replicas interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) ActivateSegment(ctx, logID, segID, replicas, dict interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockServer)(nil).ActivateSegment), ctx, logID, segID, replicas)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateSegment", reflect.TypeOf((*MockServer)(nil).ActivateSegment), ctx, logID, segID, replicas, dict)
 }
 
 // AppendToBlock mocks base method.
@@ -67,18 +85,107 @@ func (mr *MockServerMockRecorder) AppendToBlock(ctx, id, events interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockServer)(nil).AppendToBlock), ctx, id, events)
 }
 
+// CopyBlock mocks base method.
+func (m *MockServer) CopyBlock(ctx context.Context, id, sourceID vanus.ID, addr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyBlock", ctx, id, sourceID, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyBlock indicates an expected call of CopyBlock.
+func (mr *MockServerMockRecorder) CopyBlock(ctx, id, sourceID, addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyBlock", reflect.TypeOf((*MockServer)(nil).CopyBlock), ctx, id, sourceID, addr)
+}
+
 // CreateBlock mocks base method.
-func (m *MockServer) CreateBlock(ctx context.Context, id vanus.ID, size int64) error {
+func (m *MockServer) CreateBlock(ctx context.Context, id vanus.ID, size int64, mode meta.StorageMode) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateBlock", ctx, id, size)
+	ret := m.ctrl.Call(m, "CreateBlock", ctx, id, size, mode)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateBlock indicates an expected call of CreateBlock.
-func (mr *MockServerMockRecorder) CreateBlock(ctx, id, size interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) CreateBlock(ctx, id, size, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockServer)(nil).CreateBlock), ctx, id, size, mode)
+}
+
+// ExportBlock mocks base method.
+func (m *MockServer) ExportBlock(ctx context.Context, id vanus.ID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBlock", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBlock indicates an expected call of ExportBlock.
+func (mr *MockServerMockRecorder) ExportBlock(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBlock", reflect.TypeOf((*MockServer)(nil).ExportBlock), ctx, id)
+}
+
+// ExportBlockManifest mocks base method.
+func (m *MockServer) ExportBlockManifest(ctx context.Context, startAfter vanus.ID, limit int, ids ...vanus.ID) (*segment.BlockManifest, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, startAfter, limit}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportBlockManifest", varargs...)
+	ret0, _ := ret[0].(*segment.BlockManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBlockManifest indicates an expected call of ExportBlockManifest.
+func (mr *MockServerMockRecorder) ExportBlockManifest(ctx, startAfter, limit interface{}, ids ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, startAfter, limit}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBlockManifest", reflect.TypeOf((*MockServer)(nil).ExportBlockManifest), varargs...)
+}
+
+// GetBlockInfo mocks base method.
+func (m *MockServer) GetBlockInfo(ctx context.Context, ids ...vanus.ID) ([]*meta.SegmentHealthInfo, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockInfo", varargs...)
+	ret0, _ := ret[0].([]*meta.SegmentHealthInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockInfo indicates an expected call of GetBlockInfo.
+func (mr *MockServerMockRecorder) GetBlockInfo(ctx interface{}, ids ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockServer)(nil).GetBlockInfo), varargs...)
+}
+
+// GetBlockStats mocks base method.
+func (m *MockServer) GetBlockStats(ctx context.Context, ids ...vanus.ID) ([]*segment.BlockStats, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockStats", varargs...)
+	ret0, _ := ret[0].([]*segment.BlockStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockStats indicates an expected call of GetBlockStats.
+func (mr *MockServerMockRecorder) GetBlockStats(ctx interface{}, ids ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockServer)(nil).CreateBlock), ctx, id, size)
+	varargs := append([]interface{}{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockStats", reflect.TypeOf((*MockServer)(nil).GetBlockStats), varargs...)
 }
 
 // InactivateSegment mocks base method.
@@ -109,6 +216,36 @@ func (mr *MockServerMockRecorder) Initialize(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Initialize", reflect.TypeOf((*MockServer)(nil).Initialize), arg0)
 }
 
+// ListInflightRequests mocks base method.
+func (m *MockServer) ListInflightRequests(ctx context.Context, id vanus.ID) ([]*segment.InflightRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInflightRequests", ctx, id)
+	ret0, _ := ret[0].([]*segment.InflightRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInflightRequests indicates an expected call of ListInflightRequests.
+func (mr *MockServerMockRecorder) ListInflightRequests(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInflightRequests", reflect.TypeOf((*MockServer)(nil).ListInflightRequests), ctx, id)
+}
+
+// LookupKeyInBlock mocks base method.
+func (m *MockServer) LookupKeyInBlock(ctx context.Context, id vanus.ID, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupKeyInBlock", ctx, id, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupKeyInBlock indicates an expected call of LookupKeyInBlock.
+func (mr *MockServerMockRecorder) LookupKeyInBlock(ctx, id, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupKeyInBlock", reflect.TypeOf((*MockServer)(nil).LookupKeyInBlock), ctx, id, key)
+}
+
 // LookupOffsetInBlock mocks base method.
 func (m *MockServer) LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -124,19 +261,49 @@ func (mr *MockServerMockRecorder) LookupOffsetInBlock(ctx, id, stime interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffsetInBlock", reflect.TypeOf((*MockServer)(nil).LookupOffsetInBlock), ctx, id, stime)
 }
 
+// PrefetchBlock mocks base method.
+func (m *MockServer) PrefetchBlock(ctx context.Context, id vanus.ID, seq int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrefetchBlock", ctx, id, seq)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrefetchBlock indicates an expected call of PrefetchBlock.
+func (mr *MockServerMockRecorder) PrefetchBlock(ctx, id, seq interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefetchBlock", reflect.TypeOf((*MockServer)(nil).PrefetchBlock), ctx, id, seq)
+}
+
 // ReadFromBlock mocks base method.
-func (m *MockServer) ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*cloudevents.CloudEvent, error) {
+func (m *MockServer) ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num, maxBytes int, pollingTimeout uint32) ([]*cloudevents.CloudEvent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReadFromBlock", ctx, id, seq, num, pollingTimeout)
+	ret := m.ctrl.Call(m, "ReadFromBlock", ctx, id, seq, num, maxBytes, pollingTimeout)
 	ret0, _ := ret[0].([]*cloudevents.CloudEvent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReadFromBlock indicates an expected call of ReadFromBlock.
-func (mr *MockServerMockRecorder) ReadFromBlock(ctx, id, seq, num, pollingTimeout interface{}) *gomock.Call {
+func (mr *MockServerMockRecorder) ReadFromBlock(ctx, id, seq, num, maxBytes, pollingTimeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockServer)(nil).ReadFromBlock), ctx, id, seq, num, maxBytes, pollingTimeout)
+}
+
+// ReadRawFromBlock mocks base method.
+func (m *MockServer) ReadRawFromBlock(ctx context.Context, id vanus.ID, seq int64, num, maxBytes int, pollingTimeout uint32) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadRawFromBlock", ctx, id, seq, num, maxBytes, pollingTimeout)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadRawFromBlock indicates an expected call of ReadRawFromBlock.
+func (mr *MockServerMockRecorder) ReadRawFromBlock(ctx, id, seq, num, maxBytes, pollingTimeout interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockServer)(nil).ReadFromBlock), ctx, id, seq, num, pollingTimeout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRawFromBlock", reflect.TypeOf((*MockServer)(nil).ReadRawFromBlock), ctx, id, seq, num, maxBytes, pollingTimeout)
 }
 
 // RemoveBlock mocks base method.
@@ -153,6 +320,25 @@ func (mr *MockServerMockRecorder) RemoveBlock(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockServer)(nil).RemoveBlock), ctx, id)
 }
 
+// RenewWriteLeases mocks base method.
+func (m *MockServer) RenewWriteLeases(ctx context.Context, leases ...*segment.WriteLease) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range leases {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenewWriteLeases", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenewWriteLeases indicates an expected call of RenewWriteLeases.
+func (mr *MockServerMockRecorder) RenewWriteLeases(ctx interface{}, leases ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, leases...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewWriteLeases", reflect.TypeOf((*MockServer)(nil).RenewWriteLeases), varargs...)
+}
+
 // Serve mocks base method.
 func (m *MockServer) Serve(lis net.Listener) error {
 	m.ctrl.T.Helper()
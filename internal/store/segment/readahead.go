@@ -0,0 +1,193 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"sync"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
+	ceconv "github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
+)
+
+const (
+	// sequentialReads is the number of reads in a row which makes a stream sequential.
+	sequentialReads = 2
+	// maxStreamsPerBlock bounds streams tracked in a block, the least recently read one is forgotten.
+	maxStreamsPerBlock = 16
+)
+
+// readStream is a consumer reading a block, it's told apart from others by the seq which it reads next.
+type readStream struct {
+	next int64
+	hits int
+	// window is the number of events read ahead of next last time.
+	window int
+	// ahead is the end of events read ahead.
+	ahead int64
+}
+
+// readAhead detects consumers reading blocks sequentially. Requests don't carry identities of consumers,
+// so a read which starts where a previous one ended continues its stream. A nil readAhead reads nothing
+// ahead.
+type readAhead struct {
+	maxEvents int
+	maxBytes  int
+	// slots limits reads ahead in flight.
+	slots chan struct{}
+
+	mu      sync.Mutex
+	streams map[vanus.ID][]*readStream // the most recently read is at the end.
+}
+
+func newReadAhead(cfg config.ReadCache) *readAhead {
+	if !cfg.Enable || !cfg.ReadAhead.Enable {
+		return nil
+	}
+	return &readAhead{
+		maxEvents: cfg.ReadAhead.GetMaxEvents(),
+		maxBytes:  cfg.ReadAhead.GetMaxBytes(),
+		slots:     make(chan struct{}, cfg.ReadAhead.GetConcurrency()),
+		streams:   make(map[vanus.ID][]*readStream),
+	}
+}
+
+// observe records a read of num events from seq in block, and returns the range to read ahead if the read
+// continues a sequential stream whose consumer has consumed half of events read ahead of it. The window
+// starts from twice the events of the read, and doubles each time up to maxEvents.
+func (ra *readAhead) observe(block vanus.ID, seq int64, num int) (int64, int, bool) {
+	if ra == nil || num <= 0 {
+		return 0, 0, false
+	}
+
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+	streams := ra.streams[block]
+	var st *readStream
+	for i, s := range streams {
+		if s.next == seq {
+			st = s
+			copy(streams[i:], streams[i+1:])
+			streams = streams[:len(streams)-1]
+			break
+		}
+	}
+	if st == nil {
+		if len(streams) >= maxStreamsPerBlock {
+			streams = append(streams[:0], streams[1:]...)
+		}
+		st = &readStream{}
+	}
+	ra.streams[block] = append(streams, st)
+
+	st.hits++
+	st.next = seq + int64(num)
+	if st.hits < sequentialReads || st.ahead-st.next > int64(st.window/2) {
+		return 0, 0, false
+	}
+	if st.window == 0 {
+		st.window = 2 * num
+	} else {
+		st.window *= 2
+	}
+	if st.window > ra.maxEvents {
+		st.window = ra.maxEvents
+	}
+	from := st.next
+	if st.ahead > from {
+		from = st.ahead
+	}
+	end := st.next + int64(st.window)
+	if end <= from {
+		return 0, 0, false
+	}
+	st.ahead = end
+	return from, int(end - from), true
+}
+
+// forget drops streams of block, e.g. it's removed.
+func (ra *readAhead) forget(block vanus.ID) {
+	if ra == nil {
+		return
+	}
+	ra.mu.Lock()
+	defer ra.mu.Unlock()
+	delete(ra.streams, block)
+}
+
+// tryAcquire takes a slot for a read ahead, it fails if all slots are taken.
+func (ra *readAhead) tryAcquire() bool {
+	select {
+	case ra.slots <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (ra *readAhead) release() {
+	<-ra.slots
+}
+
+// startReadAhead reads num events from seq in Block id into the read cache in the background, frames of
+// events are cached too if the stream reads raw. It's skipped if too many reads ahead are in flight, or the
+// block is being deleted.
+func (s *server) startReadAhead(id vanus.ID, seq int64, num int, raw bool) {
+	if !s.readAhead.tryAcquire() {
+		return
+	}
+	b, ref, err := s.acquireReplica(id)
+	if err != nil {
+		s.readAhead.release()
+		return
+	}
+	go func() {
+		defer s.readAhead.release()
+		// The reference is held while reading, so cached events of the block aren't added after it's deleted.
+		defer ref.release()
+
+		ctx, req, err := s.inflight.track(context.Background(), id, inflightRead)
+		if err != nil {
+			return
+		}
+		defer s.inflight.untrack(req)
+		ctx, cancel := context.WithTimeout(ctx, readAheadTimeout)
+		defer cancel()
+
+		// Reads beyond the end of the block fail, consumers catch up with writers there.
+		entries, err := b.Read(ctx, seq, num, s.readAhead.maxBytes)
+		if err != nil {
+			return
+		}
+		events := make([]*cepb.CloudEvent, len(entries))
+		var frames [][]byte
+		if raw {
+			frames = make([][]byte, len(entries))
+		}
+		for i, entry := range entries {
+			events[i] = ceconv.ToPb(entry)
+			if raw {
+				frames[i] = ceconv.AppendFrame(nil, entry)
+			}
+		}
+		s.cache.fill(id, seq, events, frames)
+	}()
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/config"
+	ceconv "github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
+)
+
+func TestReadAhead(t *testing.T) {
+	Convey("test read ahead", t, func() {
+		block := vanus.NewTestID()
+		ra := newReadAhead(config.ReadCache{
+			Enable:    true,
+			ReadAhead: config.ReadAhead{Enable: true, Concurrency: 1},
+		})
+		type window struct {
+			from int64
+			num  int
+		}
+		observe := func(seq int64, num int) *window {
+			from, n, ok := ra.observe(block, seq, num)
+			if !ok {
+				return nil
+			}
+			return &window{from: from, num: n}
+		}
+
+		Convey("disabled", func() {
+			ra = newReadAhead(config.ReadCache{Enable: true})
+			So(ra, ShouldBeNil)
+			So(observe(0, 100), ShouldBeNil)
+			So(observe(100, 100), ShouldBeNil)
+			ra.forget(block)
+		})
+
+		Convey("grow the window of a sequential stream", func() {
+			So(observe(0, 100), ShouldBeNil)
+			So(observe(100, 100), ShouldResemble, &window{from: 200, num: 200})
+			So(observe(200, 100), ShouldResemble, &window{from: 400, num: 300})
+			So(observe(300, 100), ShouldBeNil)
+			So(observe(400, 100), ShouldResemble, &window{from: 700, num: 600})
+			for seq := int64(500); seq < 800; seq += 100 {
+				So(observe(seq, 100), ShouldBeNil)
+			}
+			So(observe(800, 100), ShouldResemble, &window{from: 1300, num: 624})
+
+			Convey("consumers are told apart", func() {
+				So(observe(5000, 10), ShouldBeNil)
+				So(observe(900, 100), ShouldBeNil)
+				So(observe(5010, 10), ShouldResemble, &window{from: 5020, num: 20})
+			})
+
+			Convey("start over once the block is forgotten", func() {
+				ra.forget(block)
+				So(observe(900, 100), ShouldBeNil)
+				So(observe(1000, 100), ShouldResemble, &window{from: 1100, num: 200})
+			})
+		})
+
+		Convey("forget the least recently read stream", func() {
+			So(observe(0, 1), ShouldBeNil)
+			for i := 0; i < maxStreamsPerBlock-1; i++ {
+				So(observe(int64(1000+i*10), 1), ShouldBeNil)
+			}
+			So(observe(1, 1), ShouldNotBeNil)
+			for i := 0; i < maxStreamsPerBlock; i++ {
+				So(observe(int64(2000+i*10), 1), ShouldBeNil)
+			}
+			So(observe(2, 1), ShouldBeNil)
+		})
+
+		Convey("limit reads ahead in flight", func() {
+			So(ra.tryAcquire(), ShouldBeTrue)
+			So(ra.tryAcquire(), ShouldBeFalse)
+			ra.release()
+			So(ra.tryAcquire(), ShouldBeTrue)
+		})
+	})
+}
+
+func TestServer_ReadAheadOfRawReader(t *testing.T) {
+	Convey("read ahead of a sequential raw reader", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		cfg := config.ReadCache{Enable: true, ReadAhead: config.ReadAhead{Enable: true}}
+		srv := &server{
+			state:     primitive.ServerStateRunning,
+			cache:     newReadCache(cfg, "1"),
+			readAhead: newReadAhead(cfg),
+		}
+
+		const blockSize, batchSize = 1000, 10
+		id := vanus.NewTestID()
+		b := NewMockReplica(ctrl)
+		b.EXPECT().ID().AnyTimes().Return(id)
+		b.EXPECT().IDStr().AnyTimes().Return(id.String())
+		var mu sync.Mutex
+		var reads int
+		b.EXPECT().Read(Any(), Any(), Any(), Any()).AnyTimes().DoAndReturn(
+			func(_ context.Context, seq int64, num int, _ int) ([]block.Entry, error) {
+				mu.Lock()
+				reads++
+				mu.Unlock()
+				if seq >= blockSize {
+					return nil, block.ErrOnEnd
+				}
+				if seq+int64(num) > blockSize {
+					num = int(blockSize - seq)
+				}
+				entries := make([]block.Entry, num)
+				for i := range entries {
+					entries[i] = ceconv.ToEntry(&cepb.CloudEvent{Id: fmt.Sprintf("event-%04d", seq+int64(i))})
+				}
+				return entries, nil
+			})
+		srv.replicas.Store(id, b)
+
+		for seq := int64(0); seq < blockSize; seq += batchSize {
+			payload, err := srv.ReadRawFromBlock(context.Background(), id, seq, batchSize, 0, 0)
+			So(err, ShouldBeNil)
+			for i := int64(0); i < batchSize; i++ {
+				data, n := protowire.ConsumeBytes(payload)
+				So(n, ShouldBeGreaterThan, 0)
+				event := &cepb.CloudEvent{}
+				So(proto.Unmarshal(data, event), ShouldBeNil)
+				So(event.Id, ShouldEqual, fmt.Sprintf("event-%04d", seq+i))
+				payload = payload[n:]
+			}
+			So(payload, ShouldBeEmpty)
+			// Wait for the read ahead started by the read.
+			for len(srv.readAhead.slots) > 0 {
+				time.Sleep(time.Millisecond)
+			}
+		}
+
+		// Besides the first two reads, events are read ahead in growing windows and served by the cache, so
+		// the replica is read far fewer times than the reader reads.
+		mu.Lock()
+		defer mu.Unlock()
+		So(reads, ShouldBeLessThan, blockSize/batchSize/5)
+	})
+}
@@ -19,6 +19,9 @@ import (
 	"context"
 	"path/filepath"
 
+	// third-party libraries.
+	"github.com/google/uuid"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	raftlog "github.com/linkall-labs/vanus/internal/raft/log"
@@ -38,6 +41,7 @@ func (s *server) recover(ctx context.Context) error {
 		return err
 	}
 	s.metaStore = metaStore
+	s.nodeID = recoverNodeID(ctx, metaStore)
 
 	offsetStore, err := meta.RecoverAsyncStore(ctx, s.cfg.OffsetStore, filepath.Join(s.volumeDir, "offset"))
 	if err != nil {
@@ -60,32 +64,34 @@ func (s *server) recover(ctx context.Context) error {
 	return nil
 }
 
-func (s *server) recoverBlocks(ctx context.Context, logs map[vanus.ID]*raftlog.Log) error {
-	e, _ := raw.ResolveEngine(raw.VSB)
+var nodeIDKey = []byte("server/nodeID")
 
-	raws, err := e.Recover(ctx)
-	if err != nil {
-		return err
+// recoverNodeID loads the node ID of the volume, or generates one if the volume is new. A volume whose
+// data directory is lost comes back with a new ID, which is rejected by the controller if it owned blocks.
+func recoverNodeID(ctx context.Context, metaStore *meta.SyncStore) string {
+	if v, ok := metaStore.Load(nodeIDKey); ok {
+		if id, ok2 := v.(string); ok2 && id != "" {
+			return id
+		}
 	}
+	id := uuid.NewString()
+	metaStore.Store(ctx, nodeIDKey, id)
+	log.Info(ctx, "generate node ID of the volume", map[string]interface{}{
+		"node_id": id,
+	})
+	return id
+}
 
-	// Recover replicas.
-	for id, r := range raws {
-		l := logs[id]
-		// Raft log has been compacted.
-		if l == nil {
-			l, err = raftlog.RecoverLog(id, s.wal, s.metaStore, s.offsetStore, nil)
-			if err != nil {
-				return err
-			}
+func (s *server) recoverBlocks(ctx context.Context, logs map[vanus.ID]*raftlog.Log) error {
+	for _, name := range []string{raw.VSB, raw.Memory} {
+		e, err := raw.ResolveEngine(name)
+		if err != nil {
+			// The engine isn't loaded.
+			continue
+		}
+		if err = s.recoverReplicas(ctx, e, logs); err != nil {
+			return err
 		}
-		a := raft.NewAppender(context.TODO(), r, l, s.host, s.leaderChanged)
-		s.replicas.Store(id, &replica{
-			id:       id,
-			idStr:    id.String(),
-			engine:   e,
-			raw:      r,
-			appender: a,
-		})
 	}
 
 	for id, l := range logs {
@@ -112,3 +118,30 @@ func (s *server) recoverBlocks(ctx context.Context, logs map[vanus.ID]*raftlog.L
 
 	return nil
 }
+
+func (s *server) recoverReplicas(ctx context.Context, e raw.Engine, logs map[vanus.ID]*raftlog.Log) error {
+	raws, err := e.Recover(ctx)
+	if err != nil {
+		return err
+	}
+
+	for id, r := range raws {
+		l := logs[id]
+		// Raft log has been compacted.
+		if l == nil {
+			l, err = raftlog.RecoverLog(id, s.wal, s.metaStore, s.offsetStore, nil)
+			if err != nil {
+				return err
+			}
+		}
+		a := raft.NewAppender(context.TODO(), r, l, s.host, s.leaderChanged)
+		s.replicas.Store(id, &replica{
+			id:       id,
+			idStr:    id.String(),
+			engine:   e,
+			raw:      r,
+			appender: a,
+		})
+	}
+	return nil
+}
@@ -25,6 +25,8 @@ import (
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store"
+	"github.com/linkall-labs/vanus/internal/store/config"
+	"github.com/linkall-labs/vanus/internal/store/meta"
 )
 
 func TestServer_recover(t *testing.T) {
@@ -49,5 +51,32 @@ func TestServer_recover(t *testing.T) {
 		So(srv.metaStore, ShouldNotBeNil)
 		So(srv.offsetStore, ShouldNotBeNil)
 		So(srv.wal, ShouldNotBeNil)
+		So(srv.nodeID, ShouldNotBeEmpty)
+	})
+}
+
+func TestRecoverNodeID(t *testing.T) {
+	Convey("recover node ID", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+
+		metaStore, err := meta.RecoverSyncStore(ctx, config.SyncStore{}, dir)
+		So(err, ShouldBeNil)
+		id := recoverNodeID(ctx, metaStore)
+		So(id, ShouldNotBeEmpty)
+		So(recoverNodeID(ctx, metaStore), ShouldEqual, id)
+		metaStore.Close(ctx)
+
+		// The node ID survives restarts.
+		metaStore, err = meta.RecoverSyncStore(ctx, config.SyncStore{}, dir)
+		So(err, ShouldBeNil)
+		So(recoverNodeID(ctx, metaStore), ShouldEqual, id)
+		metaStore.Close(ctx)
+
+		// A lost data directory gets a new node ID.
+		metaStore, err = meta.RecoverSyncStore(ctx, config.SyncStore{}, t.TempDir())
+		So(err, ShouldBeNil)
+		So(recoverNodeID(ctx, metaStore), ShouldNotEqual, id)
+		metaStore.Close(ctx)
 	})
 }
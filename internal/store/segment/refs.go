@@ -0,0 +1,120 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"sync"
+	"sync/atomic"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+type blockRefState struct {
+	refs int
+	// onReleased is set once the block is pending deletion, it's called when the last reference is released.
+	onReleased func()
+}
+
+// blockRef is a reference of a block acquired by blockRefs.acquire, it must be released once the holder
+// doesn't touch the block anymore.
+type blockRef struct {
+	owner    *blockRefs
+	id       vanus.ID
+	released int32
+}
+
+// release drops the reference, it's safe to call more than once.
+func (r *blockRef) release() {
+	if atomic.CompareAndSwapInt32(&r.released, 0, 1) {
+		r.owner.release(r.id)
+	}
+}
+
+// blockRefs counts references of blocks held by reads, so that the file of a block isn't deleted under a
+// read. The zero value is ready to use.
+type blockRefs struct {
+	mu     sync.Mutex
+	blocks map[vanus.ID]*blockRefState
+}
+
+// acquire references block id, it fails with ErrBlockPendingDeletion if the block is going to be deleted.
+func (rs *blockRefs) acquire(id vanus.ID) (*blockRef, error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if rs.blocks == nil {
+		rs.blocks = make(map[vanus.ID]*blockRefState)
+	}
+	st, ok := rs.blocks[id]
+	if !ok {
+		st = &blockRefState{}
+		rs.blocks[id] = st
+	}
+	if st.onReleased != nil {
+		return nil, errors.ErrBlockPendingDeletion.WithMessage(
+			"the block is pending deletion")
+	}
+	st.refs++
+	return &blockRef{owner: rs, id: id}, nil
+}
+
+func (rs *blockRefs) release(id vanus.ID) {
+	rs.mu.Lock()
+	st, ok := rs.blocks[id]
+	if !ok {
+		rs.mu.Unlock()
+		return
+	}
+	st.refs--
+	if st.refs > 0 {
+		rs.mu.Unlock()
+		return
+	}
+	delete(rs.blocks, id)
+	onReleased := st.onReleased
+	rs.mu.Unlock()
+
+	if onReleased != nil {
+		onReleased()
+	}
+}
+
+// deferDeletion makes del run once block id isn't referenced, and further acquiring fails until then. It
+// returns false without keeping del if the block isn't referenced, the caller should delete it immediately.
+func (rs *blockRefs) deferDeletion(id vanus.ID, del func()) bool {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	st, ok := rs.blocks[id]
+	if !ok || st.refs == 0 {
+		delete(rs.blocks, id)
+		return false
+	}
+	st.onReleased = del
+	return true
+}
+
+// state returns the number of references of block id, and whether it's pending deletion.
+func (rs *blockRefs) state(id vanus.ID) (int, bool) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if st, ok := rs.blocks[id]; ok {
+		return st.refs, st.onReleased != nil
+	}
+	return 0, false
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+)
+
+func TestBlockRefs(t *testing.T) {
+	Convey("block refs", t, func() {
+		var refs blockRefs
+		id := vanus.NewTestID()
+
+		Convey("delete unreferenced block immediately", func() {
+			ref, err := refs.acquire(id)
+			So(err, ShouldBeNil)
+			ref.release()
+			// Release is idempotent.
+			ref.release()
+			n, pending := refs.state(id)
+			So(n, ShouldEqual, 0)
+			So(pending, ShouldBeFalse)
+
+			So(refs.deferDeletion(id, func() {}), ShouldBeFalse)
+		})
+
+		Convey("defer deletion of referenced block", func() {
+			ref1, err := refs.acquire(id)
+			So(err, ShouldBeNil)
+			ref2, err := refs.acquire(id)
+			So(err, ShouldBeNil)
+
+			deleted := 0
+			So(refs.deferDeletion(id, func() { deleted++ }), ShouldBeTrue)
+			n, pending := refs.state(id)
+			So(n, ShouldEqual, 2)
+			So(pending, ShouldBeTrue)
+
+			_, err = refs.acquire(id)
+			So(errors.Is(err, errors.ErrBlockPendingDeletion), ShouldBeTrue)
+
+			ref1.release()
+			ref1.release()
+			So(deleted, ShouldEqual, 0)
+			ref2.release()
+			So(deleted, ShouldEqual, 1)
+
+			n, pending = refs.state(id)
+			So(n, ShouldEqual, 0)
+			So(pending, ShouldBeFalse)
+		})
+	})
+}
+
+func TestServer_RemoveReferencedBlock(t *testing.T) {
+	Convey("remove block during read", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		id := vanus.NewTestID()
+		b := NewMockReplica(ctrl)
+		b.EXPECT().ID().AnyTimes().Return(id)
+		b.EXPECT().IDStr().AnyTimes().Return(id.String())
+		srv.replicas.Store(id, b)
+
+		ent0 := cetest.MakeStoredEntry0(ctrl)
+		readC := make(chan struct{})
+		resumeC := make(chan struct{})
+		b.EXPECT().Read(Any(), int64(0), 1, 0).DoAndReturn(
+			func(ctx context.Context, seq int64, num int, maxBytes int) ([]block.Entry, error) {
+				close(readC)
+				<-resumeC
+				return []block.Entry{ent0}, nil
+			})
+
+		errC := make(chan error, 1)
+		go func() {
+			_, err := srv.ReadFromBlock(context.Background(), id, 0, 1, 0, 0)
+			errC <- err
+		}()
+		<-readC
+
+		err := srv.RemoveBlock(context.Background(), id)
+		So(errors.Is(err, errors.ErrBlockPendingDeletion), ShouldBeTrue)
+
+		// The block can't be read or removed again until it has been deleted.
+		_, err = srv.ReadFromBlock(context.Background(), id, 0, 1, 0, 0)
+		So(errors.Is(err, errors.ErrBlockPendingDeletion), ShouldBeTrue)
+		err = srv.RemoveBlock(context.Background(), id)
+		So(errors.Is(err, errors.ErrBlockPendingDeletion), ShouldBeTrue)
+
+		deleteC := make(chan struct{})
+		b.EXPECT().Delete(Any()).DoAndReturn(func(ctx context.Context) error {
+			close(deleteC)
+			return nil
+		})
+		close(resumeC)
+		So(<-errC, ShouldBeNil)
+		<-deleteC
+
+		_, err = srv.ReadFromBlock(context.Background(), id, 0, 1, 0, 0)
+		So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/store/block"
 	"github.com/linkall-labs/vanus/internal/store/block/raft"
 	"github.com/linkall-labs/vanus/internal/store/block/raw"
+	ceconv "github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
 )
 
 type Replica interface {
@@ -35,9 +36,27 @@ type Replica interface {
 
 	IDStr() string
 	Bootstrap(ctx context.Context, blocks []raft.Peer) error
+	// RecordOrigin records the eventlog and the write lease epoch in metadata of the block if its engine
+	// supports it, zero values leave recorded ones unchanged.
+	RecordOrigin(ctx context.Context, eventlogID vanus.ID, epoch uint64) error
+	// UseDictionary binds the zstd dictionary of the eventbus to the block if its engine supports it.
+	UseDictionary(ctx context.Context, dict []byte) error
+	// Prefetch loads data of the block from seq into memory if its engine supports it.
+	Prefetch(ctx context.Context, seq int64) (int64, error)
+	// VerifyChecksum verifies the checksum of the whole archived block in steps if its engine keeps one, it
+	// returns block.ErrNotSupported otherwise.
+	VerifyChecksum(ctx context.Context, p block.ChecksumProgress, maxBytes int) (block.ChecksumProgress, error)
+	// ReadRaw reads entries as Read does, but returns them encoded as length-delimited CloudEvent messages,
+	// which are sent to consumers as is, along with the number of entries.
+	ReadRaw(ctx context.Context, seq int64, num int, maxBytes int) ([]byte, int, error)
 	Close(ctx context.Context) error
 	Delete(ctx context.Context) error
 	Status() *metapb.SegmentHealthInfo
+	// Stats returns statistics of the block kept by its engine.
+	Stats() block.Statistics
+	// Export returns the snapshot of data of the block, which is restored to an empty block by Restore.
+	Export(ctx context.Context) (block.Fragment, error)
+	Restore(ctx context.Context, snap block.Fragment) error
 }
 
 type replica struct {
@@ -62,6 +81,44 @@ func (r *replica) Bootstrap(ctx context.Context, blocks []raft.Peer) error {
 	return r.appender.Bootstrap(ctx, blocks)
 }
 
+func (r *replica) RecordOrigin(ctx context.Context, eventlogID vanus.ID, epoch uint64) error {
+	if rec, ok := r.raw.(block.OriginRecorder); ok {
+		return rec.RecordOrigin(ctx, eventlogID, epoch)
+	}
+	return nil
+}
+
+func (r *replica) UseDictionary(ctx context.Context, dict []byte) error {
+	if user, ok := r.raw.(block.DictionaryUser); ok {
+		return user.UseDictionary(ctx, dict)
+	}
+	return nil
+}
+
+func (r *replica) Prefetch(ctx context.Context, seq int64) (int64, error) {
+	if p, ok := r.raw.(block.Prefetcher); ok {
+		return p.Prefetch(ctx, seq)
+	}
+	return 0, nil
+}
+
+func (r *replica) VerifyChecksum(
+	ctx context.Context, p block.ChecksumProgress, maxBytes int,
+) (block.ChecksumProgress, error) {
+	if v, ok := r.raw.(block.ChecksumVerifier); ok {
+		return v.VerifyChecksum(ctx, p, maxBytes)
+	}
+	return p, block.ErrNotSupported
+}
+
+func (r *replica) Export(ctx context.Context) (block.Fragment, error) {
+	return r.raw.Snapshot(ctx)
+}
+
+func (r *replica) Restore(ctx context.Context, snap block.Fragment) error {
+	return r.raw.ApplySnapshot(ctx, snap)
+}
+
 func (r *replica) Close(ctx context.Context) error {
 	r.appender.Stop(ctx)
 	return r.raw.Close(ctx)
@@ -76,16 +133,33 @@ func (r *replica) Seek(ctx context.Context, index int64, key block.Entry, flag b
 	return r.raw.Seek(ctx, index, key, flag)
 }
 
-func (r *replica) Read(ctx context.Context, seq int64, num int) ([]block.Entry, error) {
-	return r.raw.Read(ctx, seq, num)
+func (r *replica) Read(ctx context.Context, seq int64, num int, maxBytes int) ([]block.Entry, error) {
+	return r.raw.Read(ctx, seq, num, maxBytes)
+}
+
+func (r *replica) ReadRaw(ctx context.Context, seq int64, num int, maxBytes int) ([]byte, int, error) {
+	entries, err := r.raw.Read(ctx, seq, num, maxBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+	var payload []byte
+	for _, entry := range entries {
+		payload = ceconv.AppendFrame(payload, entry)
+	}
+	return payload, len(entries), nil
 }
 
 func (r *replica) Append(ctx context.Context, entries []block.Entry, cb block.AppendCallback) {
 	r.appender.Append(ctx, entries, cb)
 }
 
-func (r *replica) Status() *metapb.SegmentHealthInfo {
+func (r *replica) Stats() block.Statistics {
 	stat, _ := r.engine.GetBlockStatistics(r.id, r.raw)
+	return stat
+}
+
+func (r *replica) Status() *metapb.SegmentHealthInfo {
+	stat := r.Stats()
 	cs := r.appender.Status()
 
 	// TODO(james.yin): fill EntLogId and SerializationVersion.
@@ -105,8 +179,11 @@ func (r *replica) Status() *metapb.SegmentHealthInfo {
 	return info
 }
 
-func (s *server) createBlock(ctx context.Context, id vanus.ID, size int64) (Replica, error) {
-	e, _ := raw.ResolveEngine(raw.VSB)
+func (s *server) createBlock(ctx context.Context, id vanus.ID, size int64, mode metapb.StorageMode) (Replica, error) {
+	e, err := raw.ResolveEngine(engineOf(mode))
+	if err != nil {
+		return nil, err
+	}
 
 	// Create block.
 	r, err := e.Create(ctx, id, size)
@@ -126,3 +203,11 @@ func (s *server) createBlock(ctx context.Context, id vanus.ID, size int64) (Repl
 		appender: a,
 	}, nil
 }
+
+// engineOf returns the block engine of mode.
+func engineOf(mode metapb.StorageMode) string {
+	if mode == metapb.StorageMode_STORAGE_MEMORY {
+		return raw.Memory
+	}
+	return raw.VSB
+}
@@ -0,0 +1,346 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/json"
+	stderr "errors"
+	"os"
+	"sync"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/pkg/util"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+const (
+	scrubChunkNum  = 4096
+	scrubChunkSize = 1024 * 1024
+
+	corruptedFileName = "corrupted_blocks.json"
+	corruptedFilePerm = 0o644
+)
+
+type scrubReadFunc func(ctx context.Context, seq int64, num int, maxBytes int) ([]block.Entry, error)
+
+type scrubVerifyFunc func(
+	ctx context.Context, p block.ChecksumProgress, maxBytes int) (block.ChecksumProgress, error)
+
+// scrubber verifies checksums of archived blocks, or of their events if blocks have no checksum, reading them
+// at a limited rate. Corrupted blocks
+// are remembered until they are deleted, whether they are found by scrubbing or by reads of consumers. Marks
+// are persisted to a file once it's loaded, so that a corrupted block keeps being reported after a restart,
+// until the controller replaces it. A nil scrubber remembers nothing.
+type scrubber struct {
+	rate   int
+	volume string
+
+	mu        sync.RWMutex
+	corrupted map[vanus.ID]struct{}
+
+	// fileMu serializes writes of the file.
+	fileMu sync.Mutex
+	path   string
+}
+
+func newScrubber(cfg config.Scrub, volume string) *scrubber {
+	return &scrubber{
+		rate:      cfg.GetRate(),
+		volume:    volume,
+		corrupted: make(map[vanus.ID]struct{}),
+	}
+}
+
+func (sc *scrubber) isCorrupted(id vanus.ID) bool {
+	if sc == nil {
+		return false
+	}
+	sc.mu.RLock()
+	defer sc.mu.RUnlock()
+	_, ok := sc.corrupted[id]
+	return ok
+}
+
+// markCorrupted returns false if the block has been marked.
+func (sc *scrubber) markCorrupted(id vanus.ID) bool {
+	if sc == nil {
+		return false
+	}
+	sc.mu.Lock()
+	if _, ok := sc.corrupted[id]; ok {
+		sc.mu.Unlock()
+		return false
+	}
+	sc.corrupted[id] = struct{}{}
+	metrics.CorruptedBlockGaugeVec.WithLabelValues(sc.volume).Set(float64(len(sc.corrupted)))
+	sc.mu.Unlock()
+
+	sc.persistOrWarn(id)
+	return true
+}
+
+func (sc *scrubber) forget(id vanus.ID) {
+	if sc == nil {
+		return
+	}
+	sc.mu.Lock()
+	if _, ok := sc.corrupted[id]; !ok {
+		sc.mu.Unlock()
+		return
+	}
+	delete(sc.corrupted, id)
+	metrics.CorruptedBlockGaugeVec.WithLabelValues(sc.volume).Set(float64(len(sc.corrupted)))
+	sc.mu.Unlock()
+
+	sc.persistOrWarn(id)
+}
+
+// load restores marks persisted in path of blocks which are known, and persists marks to it after then.
+func (sc *scrubber) load(path string, known func(id vanus.ID) bool) error {
+	if sc == nil {
+		return nil
+	}
+	sc.fileMu.Lock()
+	defer sc.fileMu.Unlock()
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	var persisted []uint64
+	if len(data) > 0 {
+		if err = json.Unmarshal(data, &persisted); err != nil {
+			return err
+		}
+	}
+
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	sc.path = path
+	for _, v := range persisted {
+		// The block may have been deleted after it's marked.
+		if id := vanus.NewIDFromUint64(v); known(id) {
+			sc.corrupted[id] = struct{}{}
+		}
+	}
+	metrics.CorruptedBlockGaugeVec.WithLabelValues(sc.volume).Set(float64(len(sc.corrupted)))
+	return nil
+}
+
+// persist writes marks to the file loaded before, it does nothing if no file is loaded.
+func (sc *scrubber) persist() error {
+	sc.fileMu.Lock()
+	defer sc.fileMu.Unlock()
+
+	sc.mu.RLock()
+	path := sc.path
+	persisted := make([]uint64, 0, len(sc.corrupted))
+	for id := range sc.corrupted {
+		persisted = append(persisted, id.Uint64())
+	}
+	sc.mu.RUnlock()
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(persisted)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomically(path, data, corruptedFilePerm)
+}
+
+func (sc *scrubber) persistOrWarn(id vanus.ID) {
+	if err := sc.persist(); err != nil {
+		log.Warning(context.Background(), "Persist corrupted blocks failed.", map[string]interface{}{
+			"block_id":   id,
+			log.KeyError: err,
+		})
+	}
+}
+
+// scrub reads num entries of a block in chunks, it returns block.ErrCorrupted once an entry mismatches its
+// checksum. Sizes of entries are estimated from size of the block for pacing, since entries don't expose
+// their encoded sizes.
+func (sc *scrubber) scrub(ctx context.Context, num int64, size int64, read scrubReadFunc) error {
+	avg := size / num
+	pace := sc.newPacer()
+	for seq := int64(0); seq < num; {
+		entries, err := read(ctx, seq, scrubChunkNum, scrubChunkSize)
+		if err != nil {
+			return err
+		}
+		seq += int64(len(entries))
+
+		if err = pace(ctx, int64(len(entries))*avg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verify verifies the checksum of a whole block in chunks, it returns block.ErrCorrupted once the checksum
+// mismatches, and block.ErrNotSupported if the block has no checksum.
+func (sc *scrubber) verify(ctx context.Context, verify scrubVerifyFunc) error {
+	pace := sc.newPacer()
+	p := block.ChecksumProgress{}
+	for !p.Finished() {
+		next, err := verify(ctx, p, scrubChunkSize)
+		if err != nil {
+			return err
+		}
+		n := next.Done - p.Done
+		if next.Size != p.Size {
+			// Verifying started over, e.g. the block was compressed.
+			n = next.Done
+		}
+		p = next
+
+		if err = pace(ctx, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newPacer returns a function which counts bytes read, and pauses until the average rate since the pacer is
+// created falls within the limit.
+func (sc *scrubber) newPacer() func(ctx context.Context, n int64) error {
+	start := time.Now()
+	var bytes int64
+	return func(ctx context.Context, n int64) error {
+		bytes += n
+		metrics.ScrubBytesCounterVec.WithLabelValues(sc.volume).Add(float64(n))
+		due := start.Add(time.Duration(float64(bytes) / float64(sc.rate) * float64(time.Second)))
+		if !util.SleepWithContext(ctx, time.Until(due)) {
+			return ctx.Err()
+		}
+		return nil
+	}
+}
+
+func (s *server) runScrubber() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-s.closeC
+		cancel()
+	}()
+
+	for {
+		s.replicas.Range(func(key, value interface{}) bool {
+			b, _ := value.(Replica)
+			s.scrubReplica(ctx, b)
+			return ctx.Err() == nil
+		})
+		if !util.SleepWithContext(ctx, s.cfg.Scrub.GetInterval()) {
+			return
+		}
+	}
+}
+
+// scrubReplica verifies the block if it's archived, by the checksum of the whole block if it's kept, or by
+// checksums of its events. The block is acquired for each chunk rather than the whole pass, so that deleting
+// it isn't held up by scrubbing.
+func (s *server) scrubReplica(ctx context.Context, b Replica) {
+	id := b.ID()
+	if s.scrubber.isCorrupted(id) {
+		return
+	}
+	info := b.Status()
+	if !info.IsFull || info.EventNumber == 0 {
+		return
+	}
+
+	err := s.scrubber.verify(ctx,
+		func(ctx context.Context, p block.ChecksumProgress, maxBytes int) (block.ChecksumProgress, error) {
+			r, ref, err := s.acquireReplica(id)
+			if err != nil {
+				return p, err
+			}
+			defer ref.release()
+			return r.VerifyChecksum(ctx, p, maxBytes)
+		})
+	if stderr.Is(err, block.ErrNotSupported) {
+		err = s.scrubber.scrub(ctx, int64(info.EventNumber), info.Size,
+			func(ctx context.Context, seq int64, num int, maxBytes int) ([]block.Entry, error) {
+				r, ref, err := s.acquireReplica(id)
+				if err != nil {
+					return nil, err
+				}
+				defer ref.release()
+				return r.Read(ctx, seq, num, maxBytes)
+			})
+	}
+	switch {
+	case err == nil:
+		log.Debug(ctx, "The block has been scrubbed.", map[string]interface{}{
+			"block_id": id,
+		})
+	case stderr.Is(err, block.ErrCorrupted):
+		s.reportCorrupted(ctx, b, err)
+	case ctx.Err() == nil:
+		// The block may have been deleted.
+		log.Info(ctx, "Scrub the block failed.", map[string]interface{}{
+			"block_id":   id,
+			log.KeyError: err,
+		})
+	}
+}
+
+// reportCorrupted reports the block to the controller at once, so that it stops routing to the replica.
+// Heartbeats keep reporting it afterwards.
+func (s *server) reportCorrupted(ctx context.Context, b Replica, cause error) {
+	if !s.scrubber.markCorrupted(b.ID()) {
+		return
+	}
+
+	log.Error(ctx, "The block is corrupted, report it to the controller.", map[string]interface{}{
+		"block_id":   b.ID(),
+		log.KeyError: cause,
+	})
+
+	info := s.healthInfo(b)
+	go func() {
+		_, err := s.cc.ReportSegmentBlockIsFull(context.Background(), &ctrlpb.SegmentHeartbeatRequest{
+			ServerId:   s.id.Uint64(),
+			VolumeId:   s.volumeID,
+			HealthInfo: []*metapb.SegmentHealthInfo{info},
+			ReportTime: util.FormatTime(time.Now()),
+			ServerAddr: s.localAddress,
+		})
+		if err != nil {
+			log.Warning(context.Background(), "Report the corrupted block failed.", map[string]interface{}{
+				"block_id":   info.Id,
+				log.KeyError: err,
+			})
+		}
+	}()
+}
+
+func (s *server) healthInfo(b Replica) *metapb.SegmentHealthInfo {
+	info := b.Status()
+	info.IsCorrupted = s.scrubber.isCorrupted(b.ID())
+	return info
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+func TestScrubber(t *testing.T) {
+	Convey("test scrubber", t, func() {
+		ctx := context.Background()
+		// 100 entries of 1KB are read at 200KB/s.
+		sc := newScrubber(config.Scrub{Rate: 200 * 1024}, "1")
+		const num, size = 100, 100 * 1024
+
+		var reads []int64
+		readN := func(n int, err error) scrubReadFunc {
+			return func(ctx context.Context, seq int64, num int, maxBytes int) ([]block.Entry, error) {
+				So(maxBytes, ShouldEqual, scrubChunkSize)
+				if err != nil && seq >= 50 {
+					return nil, err
+				}
+				reads = append(reads, seq)
+				return make([]block.Entry, n), nil
+			}
+		}
+
+		Convey("scrub at the rate", func() {
+			start := time.Now()
+			err := sc.scrub(ctx, num, size, readN(25, nil))
+			So(err, ShouldBeNil)
+			So(reads, ShouldResemble, []int64{0, 25, 50, 75})
+			So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 450*time.Millisecond)
+		})
+
+		Convey("stop at the corrupted entry", func() {
+			err := sc.scrub(ctx, num, size, readN(50, block.ErrCorrupted))
+			So(err, ShouldEqual, block.ErrCorrupted)
+			So(reads, ShouldResemble, []int64{0})
+		})
+
+		Convey("stop once canceled", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			cancel()
+			err := sc.scrub(ctx, num, size, readN(25, nil))
+			So(err, ShouldEqual, context.Canceled)
+			So(reads, ShouldHaveLength, 1)
+		})
+
+		Convey("verify the checksum at the rate", func() {
+			var steps []int64
+			verify := func(ctx context.Context, p block.ChecksumProgress, maxBytes int) (block.ChecksumProgress, error) {
+				So(maxBytes, ShouldEqual, scrubChunkSize)
+				steps = append(steps, p.Done)
+				switch p.Size {
+				case 0:
+					return block.ChecksumProgress{Size: 2 * size, Sum: 1, Done: size}, nil
+				case 2 * size:
+					// The block is compressed, verifying starts over.
+					return block.ChecksumProgress{Size: size / 2, Sum: 2, Done: size / 4}, nil
+				default:
+					p.Done += size / 4
+					return p, nil
+				}
+			}
+			start := time.Now()
+			err := sc.verify(ctx, verify)
+			So(err, ShouldBeNil)
+			So(steps, ShouldResemble, []int64{0, size, size / 4})
+			// 150KB are read.
+			So(time.Since(start), ShouldBeGreaterThanOrEqualTo, 700*time.Millisecond)
+		})
+
+		Convey("stop at the mismatched checksum", func() {
+			err := sc.verify(ctx,
+				func(ctx context.Context, p block.ChecksumProgress, maxBytes int) (block.ChecksumProgress, error) {
+					return p, block.ErrCorrupted
+				})
+			So(err, ShouldEqual, block.ErrCorrupted)
+		})
+
+		Convey("remember corrupted blocks", func() {
+			id := vanus.NewTestID()
+			So(sc.isCorrupted(id), ShouldBeFalse)
+			So(sc.markCorrupted(id), ShouldBeTrue)
+			So(sc.markCorrupted(id), ShouldBeFalse)
+			So(sc.isCorrupted(id), ShouldBeTrue)
+			sc.forget(id)
+			So(sc.isCorrupted(id), ShouldBeFalse)
+
+			var nilScrubber *scrubber
+			So(nilScrubber.markCorrupted(id), ShouldBeFalse)
+			nilScrubber.forget(id)
+		})
+
+		Convey("persist corrupted blocks once loaded", func() {
+			path := filepath.Join(t.TempDir(), corruptedFileName)
+			id1, id2, id3 := vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID()
+			So(sc.load(path, func(vanus.ID) bool { return true }), ShouldBeNil)
+			So(sc.markCorrupted(id1), ShouldBeTrue)
+			So(sc.markCorrupted(id2), ShouldBeTrue)
+			So(sc.markCorrupted(id3), ShouldBeTrue)
+			sc.forget(id2)
+
+			// Marks of blocks deleted while the server is down are dropped.
+			restored := newScrubber(config.Scrub{}, "1")
+			So(restored.load(path, func(id vanus.ID) bool { return id != id3 }), ShouldBeNil)
+			So(restored.isCorrupted(id1), ShouldBeTrue)
+			So(restored.isCorrupted(id2), ShouldBeFalse)
+			So(restored.isCorrupted(id3), ShouldBeFalse)
+		})
+	})
+}
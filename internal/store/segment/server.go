@@ -46,6 +46,7 @@ import (
 	"github.com/linkall-labs/vanus/pkg/cluster"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	"github.com/linkall-labs/vanus/pkg/util"
+	"github.com/linkall-labs/vanus/pkg/util/clock"
 	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
@@ -54,6 +55,9 @@ import (
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	"github.com/linkall-labs/vanus/internal/primitive/interceptor/correlationinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/interceptor/errinterceptor"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	raftlog "github.com/linkall-labs/vanus/internal/raft/log"
@@ -62,6 +66,9 @@ import (
 	"github.com/linkall-labs/vanus/internal/store/block"
 	"github.com/linkall-labs/vanus/internal/store/block/raft"
 	"github.com/linkall-labs/vanus/internal/store/config"
+	"github.com/linkall-labs/vanus/internal/store/integrity"
+	sio "github.com/linkall-labs/vanus/internal/store/io"
+	"github.com/linkall-labs/vanus/internal/store/memblock"
 	"github.com/linkall-labs/vanus/internal/store/meta"
 	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
 	ceconv "github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
@@ -72,6 +79,7 @@ const (
 	debugModeENV                = "SEGMENT_SERVER_DEBUG_MODE"
 	defaultLeaderInfoBufferSize = 256
 	defaultForceStopTimeout     = 30 * time.Second
+	readAheadTimeout            = 10 * time.Second
 )
 
 type Server interface {
@@ -83,19 +91,40 @@ type Server interface {
 	Stop(ctx context.Context) error
 	Status() primitive.ServerState
 
-	CreateBlock(ctx context.Context, id vanus.ID, size int64) error
+	CreateBlock(ctx context.Context, id vanus.ID, size int64, mode metapb.StorageMode) error
 	RemoveBlock(ctx context.Context, id vanus.ID) error
-	// GetBlockInfo(ctx context.Context, id vanus.ID) error
-
-	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string) error
+	GetBlockInfo(ctx context.Context, ids ...vanus.ID) ([]*metapb.SegmentHealthInfo, error)
+	GetBlockStats(ctx context.Context, ids ...vanus.ID) ([]*segpb.BlockStats, error)
+	ExportBlockManifest(
+		ctx context.Context, startAfter vanus.ID, limit int, ids ...vanus.ID,
+	) (*segpb.BlockManifest, error)
+	// ExportBlock returns the snapshot of full Block id, which is restored by CopyBlock of another server.
+	ExportBlock(ctx context.Context, id vanus.ID) ([]byte, error)
+	// CopyBlock fills empty Block id with the snapshot of full Block sourceID in the server at addr.
+	CopyBlock(ctx context.Context, id vanus.ID, sourceID vanus.ID, addr string) error
+
+	ActivateSegment(ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string,
+		dict []byte) error
 	InactivateSegment(ctx context.Context) error
+	RenewWriteLeases(ctx context.Context, leases ...*segpb.WriteLease) error
 
 	AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error)
-	ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32) ([]*cepb.CloudEvent, error)
+	ReadFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, maxBytes int,
+		pollingTimeout uint32) ([]*cepb.CloudEvent, error)
+	// ReadRawFromBlock is same as ReadFromBlock, but events are returned as length-delimited CloudEvent
+	// messages encoded from the storage directly.
+	ReadRawFromBlock(ctx context.Context, id vanus.ID, seq int64, num int, maxBytes int,
+		pollingTimeout uint32) ([]byte, error)
 	LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int64) (int64, error)
+	LookupKeyInBlock(ctx context.Context, id vanus.ID, key string) (int64, error)
+	PrefetchBlock(ctx context.Context, id vanus.ID, seq int64) (int64, error)
+
+	ListInflightRequests(ctx context.Context, id vanus.ID) ([]*segpb.InflightRequest, error)
+	AbortInflightRequests(ctx context.Context, id vanus.ID, minAge time.Duration) (int, error)
 }
 
 func NewServer(cfg store.Config) Server {
+	compression.Apply(cfg.GRPCCompression)
 	var debugModel bool
 	if strings.ToLower(os.Getenv(debugModeENV)) == "true" {
 		debugModel = true
@@ -103,6 +132,10 @@ func NewServer(cfg store.Config) Server {
 
 	localAddress := fmt.Sprintf("%s:%d", cfg.IP, cfg.Port)
 
+	if cfg.DisableDirectIO {
+		sio.SetOpener(sio.NewPlainOpener())
+	}
+
 	// Setup raft.
 	resolver := transport.NewSimpleResolver()
 	host := transport.NewHost(resolver, localAddress)
@@ -122,6 +155,12 @@ func NewServer(cfg store.Config) Server {
 		leaderC:      make(chan leaderInfo, defaultLeaderInfoBufferSize),
 		closeC:       make(chan struct{}),
 		pm:           &pollingMgr{},
+		appends:      newAppendScheduler(cfg.AppendFairness),
+		limiter:      newAppendLimiter(cfg.AppendRateLimit),
+		cache:        newReadCache(cfg.ReadCache, fmt.Sprintf("%d", cfg.Volume.ID)),
+		readAhead:    newReadAhead(cfg.ReadCache),
+		scrubber:     newScrubber(cfg.Scrub, fmt.Sprintf("%d", cfg.Volume.ID)),
+		digests:      newDigestLedger(),
 		tracer:       tracing.NewTracer("store.segment.server", trace.SpanKindServer),
 	}
 
@@ -153,9 +192,13 @@ func (af appendFuture) onAppended(seqs []int64, err error) {
 	}
 }
 
-func (af appendFuture) wait() ([]int64, error) {
-	res := <-af
-	return res.seqs, res.err
+func (af appendFuture) wait(ctx context.Context) ([]int64, error) {
+	select {
+	case res := <-af:
+		return res.seqs, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
 
 type server struct {
@@ -177,6 +220,8 @@ type server struct {
 	volumeID    uint64
 	volumeIDStr string
 	volumeDir   string
+	// nodeID identifies the data directory of the volume, the controller verifies it on registration.
+	nodeID string
 
 	ctrlAddress []string
 	credentials credentials.TransportCredentials
@@ -187,8 +232,24 @@ type server struct {
 	grpcSrv *grpc.Server
 	closeC  chan struct{}
 
-	pm     pollingManager
-	tracer *tracing.Tracer
+	pm        pollingManager
+	inflight  inflightTracker
+	refs      blockRefs
+	leases    leaseTable
+	appends   *appendScheduler
+	limiter   *appendLimiter
+	rates     blockRates
+	cache     *readCache
+	readAhead *readAhead
+	scrubber  *scrubber
+	signer    *integrity.Signer
+	digests   *digestLedger
+	tracer    *tracing.Tracer
+	// fetcher fetches snapshots of blocks in other servers, ExportBlock of them is called if it's nil.
+	fetcher snapshotFetcher
+
+	// ingestClock issues ingestion timestamps of events in all blocks of this server.
+	ingestClock clock.Monotonic
 }
 
 // Make sure server implements Server.
@@ -196,20 +257,25 @@ var _ Server = (*server)(nil)
 
 func (s *server) Serve(lis net.Listener) error {
 	segSrv := &segmentServer{
-		srv: s,
+		srv:            s,
+		maxSendMsgSize: s.cfg.GRPC.SendMsgSize(),
 	}
 
 	raftSrv := transport.NewServer(s.host)
 	srv := grpc.NewServer(
+		grpc.MaxRecvMsgSize(s.cfg.GRPC.RecvMsgSize()),
+		grpc.MaxSendMsgSize(s.cfg.GRPC.SendMsgSize()),
 		grpc.InTapHandle(s.preGrpcStream),
 		grpc.ChainStreamInterceptor(
 			recovery.StreamServerInterceptor(),
 			errinterceptor.StreamServerInterceptor(),
+			correlationinterceptor.StreamServerInterceptor(),
 			otelgrpc.StreamServerInterceptor(),
 		),
 		grpc.ChainUnaryInterceptor(
 			recovery.UnaryServerInterceptor(),
 			errinterceptor.UnaryServerInterceptor(),
+			correlationinterceptor.UnaryServerInterceptor(),
 			otelgrpc.UnaryServerInterceptor(
 				otelgrpc.WithPropagators(propagation.TraceContext{}),
 			),
@@ -242,11 +308,34 @@ func (s *server) Initialize(ctx context.Context) error {
 	if err := s.loadVSBEngine(ctx, s.cfg.VSB); err != nil {
 		return err
 	}
+	if err := s.loadMemoryEngine(ctx, s.cfg.MemoryBlock); err != nil {
+		return err
+	}
+	if err := s.loadSigner(); err != nil {
+		return err
+	}
 
 	// Recover state from volume.
 	if err := s.recover(ctx); err != nil {
 		return err
 	}
+	// Blocks keep leases granted before the restart, so appends don't wait for the controller to renew.
+	if err := s.leases.load(filepath.Join(s.cfg.Volume.Dir, leaseFileName), time.Now()); err != nil {
+		return err
+	}
+	// Corrupted blocks keep being reported, so the controller doesn't route to them until it replaces them.
+	if err := s.scrubber.load(filepath.Join(s.cfg.Volume.Dir, corruptedFileName), func(id vanus.ID) bool {
+		_, ok := s.replicas.Load(id)
+		return ok
+	}); err != nil {
+		return err
+	}
+	if err := s.digests.load(filepath.Join(s.cfg.Volume.Dir, digestFileName), func(id vanus.ID) bool {
+		_, ok := s.replicas.Load(id)
+		return ok
+	}); err != nil {
+		return err
+	}
 
 	// Fetch block information in volume from controller, and make state up to date.
 	if err := s.reconcileBlocks(ctx); err != nil {
@@ -276,10 +365,24 @@ func (s *server) loadVSBEngine(ctx context.Context, cfg config.VSB) error {
 	dir := filepath.Join(s.cfg.Volume.Dir, "block")
 	opts := append([]vsb.Option{
 		vsb.WithArchivedListener(block.ArchivedCallback(s.onBlockArchived)),
+		vsb.WithClock(&s.ingestClock),
 	}, cfg.Options()...)
+	if s.cfg.Volume.DirectIO {
+		opts = append(opts, vsb.WithDirectIO(true))
+	}
 	return vsb.Initialize(dir, opts...)
 }
 
+func (s *server) loadMemoryEngine(ctx context.Context, cfg config.MemoryBlock) error {
+	opts := []memblock.Option{
+		memblock.WithArchivedListener(block.ArchivedCallback(s.onBlockArchived)),
+		memblock.WithClock(&s.ingestClock),
+		memblock.WithFlush(filepath.Join(s.cfg.Volume.Dir, "memblock"), cfg.GetFlushInterval()),
+		memblock.WithMemoryLimit(cfg.GetMemoryLimit()),
+	}
+	return memblock.Initialize(opts...)
+}
+
 func (s *server) reconcileBlocks(ctx context.Context) error {
 	// TODO(james.yin): Fetch block information in volume from controller, and make state up to date.
 	return nil
@@ -296,6 +399,7 @@ func (s *server) registerSelf(ctx context.Context) error {
 		Address:  s.localAddress,
 		VolumeId: s.volumeID,
 		Capacity: s.cfg.Volume.Capacity,
+		NodeId:   s.nodeID,
 	})
 	if err != nil {
 		return err
@@ -352,6 +456,9 @@ func (s *server) reconcileSegments(ctx context.Context, segments map[uint64]*met
 
 func (s *server) registerReplicas(ctx context.Context, segment *metapb.Segment) {
 	for blockID, block := range segment.Replicas {
+		if block.VolumeID == s.volumeID {
+			s.appends.bind(vanus.NewIDFromUint64(blockID), vanus.NewIDFromUint64(segment.EventLogId))
+		}
 		if block.Endpoint == "" {
 			if block.VolumeID == s.volumeID {
 				block.Endpoint = s.localAddress
@@ -382,6 +489,17 @@ func (s *server) Start(ctx context.Context) error {
 	if err := s.startHeartbeatTask(ctx); err != nil {
 		return errors.ErrInternal.WithMessage("start heartbeat task failed")
 	}
+	go s.reportInflightRequests()
+	if s.cfg.Scrub.Enable {
+		go s.runScrubber()
+	}
+	if s.signer != nil {
+		go s.runDigester()
+	}
+	if !s.isDebugMode {
+		go s.detectClockSkew()
+		s.syncFeatureGates()
+	}
 
 	s.state = primitive.ServerStateRunning
 	return nil
@@ -427,7 +545,7 @@ func (s *server) runHeartbeat(_ context.Context) error {
 		infos := make([]*metapb.SegmentHealthInfo, 0)
 		s.replicas.Range(func(key, value interface{}) bool {
 			b, _ := value.(Replica)
-			infos = append(infos, b.Status())
+			infos = append(infos, s.healthInfo(b))
 			return true
 		})
 		return &ctrlpb.SegmentHeartbeatRequest{
@@ -486,6 +604,16 @@ func (s *server) Stop(ctx context.Context) error {
 }
 
 func (s *server) stop(ctx context.Context) error {
+	// Wait for in-flight requests before closing blocks, at most until the graceful stop times out.
+	drainCtx, cancel := context.WithTimeout(ctx, defaultForceStopTimeout)
+	err := s.inflight.drain(drainCtx)
+	cancel()
+	if err != nil {
+		log.Warning(ctx, "Abort in-flight requests which aren't completed in time.", map[string]interface{}{
+			log.KeyError: err,
+		})
+	}
+
 	// Close all blocks.
 	s.replicas.Range(func(key, value interface{}) bool {
 		b, _ := value.(Replica)
@@ -517,7 +645,7 @@ func (s *server) Status() primitive.ServerState {
 	return s.state
 }
 
-func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error {
+func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64, mode metapb.StorageMode) error {
 	ctx, span := s.tracer.Start(ctx, "CreateBlock")
 	defer span.End()
 
@@ -531,11 +659,12 @@ func (s *server) CreateBlock(ctx context.Context, id vanus.ID, size int64) error
 	}
 
 	log.Info(ctx, "Create block.", map[string]interface{}{
-		"block_id": id,
-		"size":     size,
+		"block_id":     id,
+		"size":         size,
+		"storage_mode": mode,
 	})
 
-	b, err := s.createBlock(ctx, id, size)
+	b, err := s.createBlock(ctx, id, size, mode)
 	if err != nil {
 		if stderr.Is(err, os.ErrExist) {
 			return errors.ErrResourceAlreadyExist.WithMessage("the block has already exist")
@@ -563,12 +692,45 @@ func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID) error {
 
 	v, exist := s.replicas.LoadAndDelete(blockID)
 	if !exist {
+		if _, pending := s.refs.state(blockID); pending {
+			return errors.ErrBlockPendingDeletion.WithMessage("the block is pending deletion")
+		}
 		return errors.ErrResourceNotFound.WithMessage("the block not found")
 	}
 
+	s.leases.remove(blockID)
+	s.appends.unbind(blockID)
+
 	b, _ := v.(Replica)
 	// TODO(james.yin): s.host.Unregister
+	if s.refs.deferDeletion(blockID, func() {
+		// The request which removes the block has been done when the last reader releases it.
+		_ = s.deleteReplica(context.Background(), b)
+	}) {
+		refs, _ := s.refs.state(blockID)
+		log.Info(ctx, "The block is referenced by in-flight reads, defer its deletion.", map[string]interface{}{
+			"block_id": b.ID(),
+			"refs":     refs,
+		})
+		return errors.ErrBlockPendingDeletion.WithMessage(
+			"the block is referenced by in-flight reads, it will be deleted once they are completed")
+	}
+	return s.deleteReplica(ctx, b)
+}
+
+func (s *server) deleteReplica(ctx context.Context, b Replica) error {
+	// No one reads the block now, so cached events of it aren't added again.
+	s.cache.invalidate(b.ID())
+	s.readAhead.forget(b.ID())
+	s.scrubber.forget(b.ID())
+	s.digests.forget(b.ID())
+	s.rates.remove(b.ID())
+	s.limiter.remove(b.ID())
 	if err := b.Delete(ctx); err != nil {
+		log.Warning(ctx, "Failed to delete the block.", map[string]interface{}{
+			"block_id":   b.ID(),
+			log.KeyError: err,
+		})
 		return err
 	}
 
@@ -578,21 +740,94 @@ func (s *server) RemoveBlock(ctx context.Context, blockID vanus.ID) error {
 		// "path":     blk.Path(),
 		// "metadata": blk.HealthInfo().String(),
 	})
-
 	return nil
 }
 
-// TODO(james.yin): implements GetBlockInfo.
-// func (s *server) GetBlockInfo(ctx context.Context, id vanus.ID) error {
-// 	if err := s.checkState(); err != nil {
-// 		return err
-// 	}
-// 	return nil
-// }
+// GetBlockInfo returns status of the specified blocks, or all blocks in the server if no id is specified.
+func (s *server) GetBlockInfo(ctx context.Context, ids ...vanus.ID) ([]*metapb.SegmentHealthInfo, error) {
+	_, span := s.tracer.Start(ctx, "GetBlockInfo")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		infos := make([]*metapb.SegmentHealthInfo, 0)
+		s.replicas.Range(func(key, value interface{}) bool {
+			b, _ := value.(Replica)
+			infos = append(infos, s.healthInfo(b))
+			return true
+		})
+		return infos, nil
+	}
+
+	infos := make([]*metapb.SegmentHealthInfo, 0, len(ids))
+	for _, id := range ids {
+		v, exist := s.replicas.Load(id)
+		if !exist {
+			return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("the block %s not found", id))
+		}
+		b, _ := v.(Replica)
+		infos = append(infos, s.healthInfo(b))
+	}
+	return infos, nil
+}
+
+// GetBlockStats returns statistics of the specified blocks, or all blocks in the server if no id is
+// specified.
+func (s *server) GetBlockStats(ctx context.Context, ids ...vanus.ID) ([]*segpb.BlockStats, error) {
+	_, span := s.tracer.Start(ctx, "GetBlockStats")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if len(ids) == 0 {
+		stats := make([]*segpb.BlockStats, 0)
+		s.replicas.Range(func(key, value interface{}) bool {
+			b, _ := value.(Replica)
+			stats = append(stats, s.blockStats(b, now))
+			return true
+		})
+		return stats, nil
+	}
 
-// ActivateSegment mark a block ready to using and preparing to initializing a replica group.
+	stats := make([]*segpb.BlockStats, 0, len(ids))
+	for _, id := range ids {
+		v, exist := s.replicas.Load(id)
+		if !exist {
+			return nil, errors.ErrResourceNotFound.WithMessage(fmt.Sprintf("the block %s not found", id))
+		}
+		b, _ := v.(Replica)
+		stats = append(stats, s.blockStats(b, now))
+	}
+	return stats, nil
+}
+
+func (s *server) blockStats(b Replica, now time.Time) *segpb.BlockStats {
+	stat := b.Stats()
+	m := s.rates.get(b.ID())
+	stats := &segpb.BlockStats{
+		Id:              b.ID().Uint64(),
+		Capacity:        int64(stat.Capacity),
+		Size:            int64(stat.EntrySize),
+		EventNumber:     int32(stat.EntryNum),
+		IsFull:          stat.Archived,
+		OldestEventTime: stat.FirstEntryStime,
+		NewestEventTime: stat.LastEntryStime,
+	}
+	stats.AppendEventsPerSecond, stats.AppendBytesPerSecond = m.appends.rate(now)
+	stats.ReadEventsPerSecond, stats.ReadBytesPerSecond = m.reads.rate(now)
+	return stats
+}
+
+// ActivateSegment mark a block ready to using and preparing to initializing a replica group. The block
+// compresses its data with dict once it's archived if dict isn't empty.
 func (s *server) ActivateSegment(
-	ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string,
+	ctx context.Context, logID vanus.ID, segID vanus.ID, replicas map[vanus.ID]string, dict []byte,
 ) error {
 	ctx, span := s.tracer.Start(ctx, "ActivateSegment")
 	defer span.End()
@@ -653,6 +888,26 @@ func (s *server) ActivateSegment(
 	if err := b.Bootstrap(ctx, peers); err != nil {
 		return err
 	}
+	s.appends.bind(myID, logID)
+
+	if err := b.RecordOrigin(ctx, logID, 0); err != nil {
+		log.Warning(ctx, "Record the eventlog of block failed.", map[string]interface{}{
+			log.KeyError:  err,
+			"block_id":    myID,
+			"eventlog_id": logID,
+		})
+	}
+
+	if len(dict) != 0 {
+		// Blocks can still be compressed without the dictionary.
+		if err := b.UseDictionary(ctx, dict); err != nil {
+			log.Warning(ctx, "Bind the compression dictionary to block failed.", map[string]interface{}{
+				log.KeyError:  err,
+				"block_id":    myID,
+				"eventlog_id": logID,
+			})
+		}
+	}
 
 	return nil
 }
@@ -665,6 +920,42 @@ func (s *server) InactivateSegment(ctx context.Context) error {
 	return nil
 }
 
+// RenewWriteLeases grants or renews write leases of blocks, leases of unknown blocks are ignored.
+func (s *server) RenewWriteLeases(ctx context.Context, leases ...*segpb.WriteLease) error {
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	for _, l := range leases {
+		id := vanus.NewIDFromUint64(l.BlockId)
+		v, ok := s.replicas.Load(id)
+		if !ok {
+			continue
+		}
+		if !s.leases.grant(id, l.Epoch, time.Duration(l.TtlMs)*time.Millisecond) {
+			log.Warning(ctx, "Ignore the stale write lease.", map[string]interface{}{
+				"block_id": id,
+				"epoch":    l.Epoch,
+			})
+			continue
+		}
+		b, _ := v.(Replica)
+		if err := b.RecordOrigin(ctx, 0, l.Epoch); err != nil {
+			log.Warning(ctx, "Record the write lease epoch of block failed.", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   id,
+				"epoch":      l.Epoch,
+			})
+		}
+	}
+	if err := s.leases.persist(time.Now()); err != nil {
+		log.Warning(ctx, "Persist write leases failed.", map[string]interface{}{
+			log.KeyError: err,
+		})
+	}
+	return nil
+}
+
 func (s *server) AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.CloudEvent) ([]int64, error) {
 	ctx, span := s.tracer.Start(ctx, "AppendToBlock")
 	defer span.End()
@@ -684,6 +975,10 @@ func (s *server) AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.
 		return nil, errors.ErrResourceNotFound.WithMessage("the block doesn't exist")
 	}
 
+	if err := s.leases.check(id); err != nil {
+		return nil, err
+	}
+
 	var size int
 	entries := make([]block.Entry, len(events))
 	for i, event := range events {
@@ -691,16 +986,49 @@ func (s *server) AppendToBlock(ctx context.Context, id vanus.ID, events []*cepb.
 		size += proto.Size(event)
 	}
 
+	if wait := s.limiter.reserve(id, len(events), size, time.Now()); wait > 0 {
+		metrics.ThrottledAppendCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Inc()
+		return nil, errors.ErrThrottled.WithMessage("the block exceeds its append rate limit").WithRetryAfter(wait)
+	}
+
 	metrics.WriteTPSCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(len(events)))
 	metrics.WriteThroughputCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(size))
 
+	ctx = tracing.WithRequestMetadata(ctx, tracing.RequestMetadata{
+		Operation: tracing.OperationAppend,
+		Block:     b.IDStr(),
+		Events:    len(events),
+		Bytes:     size,
+	})
+	start := time.Now()
+	ctx, req, err := s.inflight.track(ctx, id, inflightAppend)
+	if err != nil {
+		return nil, err
+	}
+	defer s.inflight.untrack(req)
 	future := newAppendFuture()
-	b.Append(ctx, entries, future.onAppended)
-	seqs, err := future.wait()
+	s.appends.submit(id, size, func(done func()) {
+		// The request has given up while it was queued.
+		if ctx.Err() != nil {
+			done()
+			return
+		}
+		b.Append(ctx, entries, func(seqs []int64, err error) {
+			done()
+			future.onAppended(seqs, err)
+		})
+	})
+	seqs, err := future.wait(ctx)
+	metrics.ObserveRequest(ctx, start, err)
 	if err != nil {
+		if req.isAborted() {
+			return nil, errRequestAborted
+		}
 		return nil, s.processAppendError(ctx, b, err)
 	}
 
+	s.rates.markAppend(id, len(seqs), size)
+
 	// TODO(weihe.yin) make this method deep to code
 	s.pm.NewMessageArrived(id)
 
@@ -712,6 +1040,10 @@ func (s *server) processAppendError(ctx context.Context, b Replica, err error) e
 		return err
 	}
 
+	if cerr := contextError(err); cerr != nil {
+		return cerr
+	}
+
 	if stderr.Is(err, block.ErrFull) {
 		log.Debug(ctx, "Append failed: block is full.", map[string]interface{}{
 			"block_id": b.ID(),
@@ -740,6 +1072,13 @@ func (s *server) onBlockArchived(stat block.Statistics) {
 		"block_id": id,
 	})
 
+	// Wake up reads polling at the end of the block, they get the continuation hint now.
+	s.pm.NewMessageArrived(id)
+
+	if stat.Archived && s.signer != nil {
+		s.digests.enqueue(id)
+	}
+
 	// FIXME(james.yin): leader info.
 	info := &metapb.SegmentHealthInfo{
 		Id:                 id.Uint64(),
@@ -767,32 +1106,67 @@ func (s *server) onBlockArchived(stat block.Statistics) {
 
 // ReadFromBlock returns at most num events from seq in Block id.
 func (s *server) ReadFromBlock(
-	ctx context.Context, id vanus.ID, seq int64, num int, pollingTimeout uint32,
+	ctx context.Context, id vanus.ID, seq int64, num int, maxBytes int, pollingTimeout uint32,
 ) ([]*cepb.CloudEvent, error) {
 	ctx, span := s.tracer.Start(ctx, "ReadFromBlock")
 	defer span.End()
 
-	if err := s.checkState(); err != nil {
+	var events []*cepb.CloudEvent
+	err := s.pollRead(ctx, id, pollingTimeout, func(b Replica) (err error) {
+		events, err = s.readEvents(ctx, b, seq, num, maxBytes)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
+	return events, nil
+}
 
-	var b Replica
-	if v, ok := s.replicas.Load(id); ok {
-		b, _ = v.(Replica)
-	} else {
-		return nil, errors.ErrResourceNotFound.WithMessage(
-			"the segment doesn't exist on this server")
+// ReadRawFromBlock returns at most num events from seq in Block id as length-delimited CloudEvent
+// messages. Events are served by the read cache as ReadFromBlock, frames of events are encoded from entries
+// directly and kept in the cache, so they aren't built and marshaled again by later raw reads.
+func (s *server) ReadRawFromBlock(
+	ctx context.Context, id vanus.ID, seq int64, num int, maxBytes int, pollingTimeout uint32,
+) ([]byte, error) {
+	ctx, span := s.tracer.Start(ctx, "ReadRawFromBlock")
+	defer span.End()
+
+	var payload []byte
+	err := s.pollRead(ctx, id, pollingTimeout, func(b Replica) (err error) {
+		payload, err = s.readRaw(ctx, b, seq, num, maxBytes)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return payload, nil
+}
 
-	if events, err := s.readEvents(ctx, b, seq, num); err == nil {
-		return events, nil
+// pollRead calls read with the replica of Block id, the read is retried once new events are appended if
+// it's on the end of the block and pollingTimeout is positive.
+func (s *server) pollRead(
+	ctx context.Context, id vanus.ID, pollingTimeout uint32, read func(b Replica) error,
+) error {
+	if err := s.checkState(); err != nil {
+		return err
+	}
+
+	b, ref, err := s.acquireReplica(id)
+	if err != nil {
+		return err
+	}
+	// The reference is held while polling, so the block isn't deleted before the read is retried.
+	defer ref.release()
+
+	if err = read(b); err == nil {
+		return nil
 	} else if !stderr.Is(err, block.ErrOnEnd) || pollingTimeout == 0 {
-		return nil, s.processReadError(ctx, b, err)
+		return s.processReadError(ctx, b, err)
 	}
 
 	doneC := s.pm.Add(ctx, id)
 	if doneC == nil {
-		return nil, errors.ErrOffsetOnEnd
+		return errors.ErrOffsetOnEnd
 	}
 
 	t := time.NewTimer(time.Duration(pollingTimeout) * time.Millisecond)
@@ -801,43 +1175,151 @@ func (s *server) ReadFromBlock(
 	select {
 	case <-doneC:
 		// FIXME(james.yin) It can't read message immediately because of async apply.
-		events, err := s.readEvents(ctx, b, seq, num)
-		if err != nil {
-			return nil, s.processReadError(ctx, b, err)
+		if err = read(b); err != nil {
+			return s.processReadError(ctx, b, err)
 		}
-		return events, nil
+		return nil
 	case <-t.C:
-		return nil, errors.ErrOffsetOnEnd
+		return errors.ErrOffsetOnEnd
 	case <-ctx.Done():
-		return nil, ctx.Err()
+		return ctx.Err()
 	}
 }
 
-func (s *server) readEvents(ctx context.Context, b Replica, seq int64, num int) ([]*cepb.CloudEvent, error) {
-	entries, err := b.Read(ctx, seq, num)
+// acquireReplica returns the replica of Block id with a reference, which must be released once the replica
+// isn't used, so the block isn't deleted in the meantime.
+func (s *server) acquireReplica(id vanus.ID) (Replica, *blockRef, error) {
+	ref, err := s.refs.acquire(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	v, ok := s.replicas.Load(id)
+	if !ok {
+		ref.release()
+		return nil, nil, errors.ErrResourceNotFound.WithMessage(
+			"the segment doesn't exist on this server")
+	}
+	b, _ := v.(Replica)
+	return b, ref, nil
+}
+
+func (s *server) readEvents(
+	ctx context.Context, b Replica, seq int64, num int, maxBytes int,
+) ([]*cepb.CloudEvent, error) {
+	ctx, req, err := s.inflight.track(ctx, b.ID(), inflightRead)
 	if err != nil {
 		return nil, err
 	}
+	defer s.inflight.untrack(req)
 
-	var size int
-	events := make([]*cepb.CloudEvent, len(entries))
-	for i, entry := range entries {
-		event := ceconv.ToPb(entry)
-		events[i] = event
-		size += proto.Size(event)
+	events, size, full := s.cache.get(b.ID(), seq, num, maxBytes)
+	if !full {
+		from, rest := seq+int64(len(events)), maxBytes
+		if maxBytes > 0 {
+			rest = maxBytes - size
+		}
+		entries, err := b.Read(ctx, from, num-len(events), rest)
+		if err != nil {
+			// Events served by the cache are returned, the error is returned again by the next read.
+			if len(events) > 0 {
+				return events, nil
+			}
+			if req.isAborted() {
+				return nil, errRequestAborted
+			}
+			return nil, err
+		}
+
+		read := make([]*cepb.CloudEvent, len(entries))
+		for i, entry := range entries {
+			event := ceconv.ToPb(entry)
+			read[i] = event
+			size += proto.Size(event)
+		}
+		s.cache.put(b.ID(), from, read)
+		events = append(events, read...)
+	}
+	if from, n, ok := s.readAhead.observe(b.ID(), seq, len(events)); ok {
+		s.startReadAhead(b.ID(), from, n, false)
 	}
 
 	metrics.ReadTPSCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(len(events)))
 	metrics.ReadThroughputCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(size))
+	s.rates.markRead(b.ID(), len(events), size)
 
 	return events, nil
 }
 
+func (s *server) readRaw(ctx context.Context, b Replica, seq int64, num int, maxBytes int) ([]byte, error) {
+	ctx, req, err := s.inflight.track(ctx, b.ID(), inflightRead)
+	if err != nil {
+		return nil, err
+	}
+	defer s.inflight.untrack(req)
+
+	payload, n, size, full := s.cache.getFrames(b.ID(), seq, num, maxBytes)
+	if !full {
+		from, rest := seq+int64(n), maxBytes
+		if maxBytes > 0 {
+			rest = maxBytes - size
+		}
+		read, m, err := s.readRawFrames(ctx, b, from, num-n, rest)
+		if err != nil {
+			// Events served by the cache are returned, the error is returned again by the next read.
+			if n > 0 {
+				return payload, nil
+			}
+			if req.isAborted() {
+				return nil, errRequestAborted
+			}
+			return nil, err
+		}
+		payload = append(payload, read...)
+		n += m
+	}
+	if from, ahead, ok := s.readAhead.observe(b.ID(), seq, n); ok {
+		s.startReadAhead(b.ID(), from, ahead, true)
+	}
+
+	metrics.ReadTPSCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(n))
+	metrics.ReadThroughputCounterVec.WithLabelValues(s.volumeIDStr, b.IDStr()).Add(float64(len(payload)))
+	s.rates.markRead(b.ID(), n, len(payload))
+
+	return payload, nil
+}
+
+// readRawFrames reads frames of events from the replica, which are cached along with events if the read
+// cache is enabled.
+func (s *server) readRawFrames(ctx context.Context, b Replica, seq int64, num int, maxBytes int) ([]byte, int, error) {
+	if s.cache == nil {
+		return b.ReadRaw(ctx, seq, num, maxBytes)
+	}
+
+	entries, err := b.Read(ctx, seq, num, maxBytes)
+	if err != nil {
+		return nil, 0, err
+	}
+	var payload []byte
+	events := make([]*cepb.CloudEvent, len(entries))
+	frames := make([][]byte, len(entries))
+	for i, entry := range entries {
+		events[i] = ceconv.ToPb(entry)
+		frames[i] = ceconv.AppendFrame(nil, entry)
+		payload = append(payload, frames[i]...)
+	}
+	s.cache.putFrames(b.ID(), seq, events, frames)
+	return payload, len(entries), nil
+}
+
 func (s *server) processReadError(ctx context.Context, b Replica, err error) error {
 	if stderr.As(err, &errors.ErrorType{}) {
 		return err
 	}
 
+	if cerr := contextError(err); cerr != nil {
+		return cerr
+	}
+
 	if stderr.Is(err, block.ErrOnEnd) {
 		log.Debug(ctx, "Read: arrive segment end.", map[string]interface{}{
 			"block_id": b.ID(),
@@ -852,6 +1334,11 @@ func (s *server) processReadError(ctx context.Context, b Replica, err error) err
 		return errors.ErrOffsetOverflow
 	}
 
+	if stderr.Is(err, block.ErrCorrupted) {
+		s.reportCorrupted(ctx, b, err)
+		return errors.ErrCorruptedEvent.WithMessage("the stored event is corrupted").Wrap(err)
+	}
+
 	log.Warning(ctx, "Read failed.", map[string]interface{}{
 		"block_id":   b.ID(),
 		log.KeyError: err,
@@ -867,13 +1354,11 @@ func (s *server) LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int
 		return -1, err
 	}
 
-	var b Replica
-	if v, ok := s.replicas.Load(id); ok {
-		b, _ = v.(Replica)
-	} else {
-		return -1, errors.ErrResourceNotFound.WithMessage(
-			"the segment doesn't exist on this server")
+	b, ref, err := s.acquireReplica(id)
+	if err != nil {
+		return -1, err
 	}
+	defer ref.release()
 
 	off, err := b.Seek(ctx, 0, ceschema.StimeKey(stime), block.SeekBeforeKey)
 	if err != nil {
@@ -882,6 +1367,144 @@ func (s *server) LookupOffsetInBlock(ctx context.Context, id vanus.ID, stime int
 	return off + 1, nil
 }
 
+// LookupKeyInBlock returns the sequence number of the latest entry with the partition key in Block id, it's
+// -1 if no entry has the key. Keys are located by indexes of Block, which keep hashes of keys.
+func (s *server) LookupKeyInBlock(ctx context.Context, id vanus.ID, key string) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "LookupKeyInBlock")
+	defer span.End()
+
+	if err := s.checkState(); err != nil {
+		return -1, err
+	}
+
+	b, ref, err := s.acquireReplica(id)
+	if err != nil {
+		return -1, err
+	}
+	defer ref.release()
+
+	seq, err := b.Seek(ctx, ceschema.PartitionKeyIndex, ceschema.PartitionKeyKey([]byte(key)), block.SeekLastKey)
+	if stderr.Is(err, block.ErrCorrupted) {
+		s.reportCorrupted(ctx, b, err)
+		return -1, errors.ErrCorruptedEvent.WithMessage("the stored event is corrupted").Wrap(err)
+	}
+	if err != nil {
+		return -1, errors.ErrInternal.WithMessage("lookup key failed").Wrap(err)
+	}
+	return seq, nil
+}
+
+// PrefetchBlock loads data of Block id from seq to its end into memory in the background, so that reads
+// expected soon, e.g. of a subscription reset to old offsets, don't wait for the disk.
+func (s *server) PrefetchBlock(ctx context.Context, id vanus.ID, seq int64) (int64, error) {
+	if err := s.checkState(); err != nil {
+		return 0, err
+	}
+
+	b, ref, err := s.acquireReplica(id)
+	if err != nil {
+		return 0, err
+	}
+	defer ref.release()
+
+	n, err := b.Prefetch(ctx, seq)
+	if err != nil {
+		log.Warning(ctx, "Prefetch block failed.", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   id,
+			"seq":        seq,
+		})
+		return 0, errors.ErrInternal.WithMessage("prefetch block failed").Wrap(err)
+	}
+	return n, nil
+}
+
+// ListInflightRequests returns uncompleted append and read requests of Block id, or all blocks if id is 0.
+func (s *server) ListInflightRequests(ctx context.Context, id vanus.ID) ([]*segpb.InflightRequest, error) {
+	if err := s.checkState(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reqs := s.inflight.list(id)
+	infos := make([]*segpb.InflightRequest, len(reqs))
+	for i, req := range reqs {
+		infos[i] = &segpb.InflightRequest{
+			BlockId: req.blockID.Uint64(),
+			Type:    req.typ,
+			AgeMs:   req.age(now).Milliseconds(),
+		}
+	}
+	return infos, nil
+}
+
+// AbortInflightRequests aborts requests of Block id, or all blocks if id is 0, which have been in flight for
+// at least minAge. Aborted requests fail immediately, but the I/O already issued to disk isn't revoked.
+func (s *server) AbortInflightRequests(ctx context.Context, id vanus.ID, minAge time.Duration) (int, error) {
+	if err := s.checkState(); err != nil {
+		return 0, err
+	}
+
+	n := s.inflight.abort(id, minAge)
+	log.Warning(ctx, "Abort in-flight requests.", map[string]interface{}{
+		"block_id": id,
+		"min_age":  minAge,
+		"aborted":  n,
+	})
+	return n, nil
+}
+
+func (s *server) reportInflightRequests() {
+	ticker := time.NewTicker(defaultInflightReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeC:
+			return
+		case <-ticker.C:
+			s.inflight.report(s.volumeIDStr)
+		}
+	}
+}
+
+// detectClockSkew measures the offset of the local clock from the controller, since ingestion timestamps
+// of events are issued by the local clock.
+func (s *server) detectClockSkew() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-s.closeC
+		cancel()
+	}()
+
+	d := clock.NewSkewDetector(s.ctrl.ServerTime, clock.WithObserver(func(skew clock.Skew) {
+		metrics.ClockSkewGauge.Set(skew.Offset.Seconds())
+		metrics.ClockSkewRTTGauge.Set(skew.RTT.Seconds())
+		metrics.IngestionClockStalledGauge.Set(float64(s.ingestClock.Stalled()))
+	}))
+	d.Run(ctx)
+}
+
+func (s *server) syncFeatureGates() {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-s.closeC
+		cancel()
+	}()
+	featuregate.Sync(ctx, s.ctrl.FeatureGateService().RawClient())
+}
+
+// contextError translates errors of done context, so that clients can tell their requests are given up.
+func contextError(err error) error {
+	switch {
+	case stderr.Is(err, context.Canceled):
+		return errors.ErrCanceled
+	case stderr.Is(err, context.DeadlineExceeded):
+		return errors.ErrDeadlineExceeded
+	default:
+		return nil
+	}
+}
+
 func (s *server) checkState() error {
 	if s.state != primitive.ServerStateRunning {
 		return errors.ErrServiceState.WithMessage(fmt.Sprintf(
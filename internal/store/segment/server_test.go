@@ -92,7 +92,7 @@ func TestServer_ReadFromBlock(t *testing.T) {
 			state: primitive.ServerStateRunning,
 		}
 
-		_, err := srv.ReadFromBlock(context.Background(), vanus.NewTestID(), 0, 3, uint32(0))
+		_, err := srv.ReadFromBlock(context.Background(), vanus.NewTestID(), 0, 3, 0, uint32(0))
 		So(err, ShouldNotBeNil)
 		So(err.(*errors.ErrorType).Code, ShouldEqual, errors.ErrorCode_RESOURCE_NOT_FOUND)
 	})
@@ -115,10 +115,10 @@ func TestServer_ReadFromBlock(t *testing.T) {
 		ent1 := cetest.MakeStoredEntry1(ctrl)
 
 		Convey("enable long-polling, but not wait", func() {
-			b.EXPECT().Read(Any(), int64(0), 3).Return([]block.Entry{ent0, ent1}, nil)
+			b.EXPECT().Read(Any(), int64(0), 3, 0).Return([]block.Entry{ent0, ent1}, nil)
 
 			start := time.Now()
-			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3, 0,
 				uint32(shortDelayInTest.Milliseconds()))
 			So(time.Now(), ShouldHappenBefore, start.Add(shortDelayInTest))
 			So(err, ShouldBeNil)
@@ -128,8 +128,8 @@ func TestServer_ReadFromBlock(t *testing.T) {
 		})
 
 		Convey("long-polling without timeout", func() {
-			b.EXPECT().Read(Any(), int64(0), 3).Return(nil, block.ErrOnEnd)
-			b.EXPECT().Read(Any(), int64(0), 3).Return([]block.Entry{ent0, ent1}, nil)
+			b.EXPECT().Read(Any(), int64(0), 3, 0).Return(nil, block.ErrOnEnd)
+			b.EXPECT().Read(Any(), int64(0), 3, 0).Return([]block.Entry{ent0, ent1}, nil)
 
 			mgr := NewMockpollingManager(ctrl)
 			ch := make(chan struct{})
@@ -142,7 +142,7 @@ func TestServer_ReadFromBlock(t *testing.T) {
 				close(ch)
 			}()
 
-			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+			events, err := srv.ReadFromBlock(context.Background(), id, 0, 3, 0,
 				uint32(longDelayInTest.Milliseconds()))
 			So(time.Now(), ShouldHappenBetween, start.Add(shortDelayInTest), start.Add(longDelayInTest))
 			So(err, ShouldBeNil)
@@ -152,7 +152,7 @@ func TestServer_ReadFromBlock(t *testing.T) {
 		})
 
 		Convey("long-polling with timeout", func() {
-			b.EXPECT().Read(Any(), int64(0), 3).Return(nil, block.ErrOnEnd)
+			b.EXPECT().Read(Any(), int64(0), 3, 0).Return(nil, block.ErrOnEnd)
 
 			mgr := NewMockpollingManager(ctrl)
 			ch := make(chan struct{})
@@ -160,14 +160,14 @@ func TestServer_ReadFromBlock(t *testing.T) {
 			srv.pm = mgr
 
 			start := time.Now()
-			_, err := srv.ReadFromBlock(context.Background(), id, 0, 3,
+			_, err := srv.ReadFromBlock(context.Background(), id, 0, 3, 0,
 				uint32(shortDelayInTest.Milliseconds()))
 			So(time.Now(), ShouldHappenAfter, start.Add(shortDelayInTest))
 			So(err, ShouldBeError, errors.ErrOffsetOnEnd)
 		})
 
 		Convey("long-polling with canceled request", func() {
-			b.EXPECT().Read(Any(), int64(0), 3).Return(nil, block.ErrOnEnd)
+			b.EXPECT().Read(Any(), int64(0), 3, 0).Return(nil, block.ErrOnEnd)
 
 			mgr := NewMockpollingManager(ctrl)
 			ch := make(chan struct{})
@@ -182,9 +182,16 @@ func TestServer_ReadFromBlock(t *testing.T) {
 				cancel()
 			}()
 
-			_, err := srv.ReadFromBlock(ctx, id, 0, 3, uint32(longDelayInTest.Milliseconds()))
+			_, err := srv.ReadFromBlock(ctx, id, 0, 3, 0, uint32(longDelayInTest.Milliseconds()))
 			So(time.Now(), ShouldHappenBetween, start.Add(shortDelayInTest), start.Add(longDelayInTest))
 			So(err, ShouldBeError, context.Canceled)
 		})
+
+		Convey("read with expired request", func() {
+			b.EXPECT().Read(Any(), int64(0), 3, 0).Return(nil, context.DeadlineExceeded)
+
+			_, err := srv.ReadFromBlock(context.Background(), id, 0, 3, 0, 0)
+			So(err, ShouldEqual, errors.ErrDeadlineExceeded)
+		})
 	})
 }
@@ -0,0 +1,103 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"sync"
+	"time"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+)
+
+const (
+	// rateWindow is the period rates of blocks are averaged over, it's made of one-second buckets.
+	rateWindow = 60
+)
+
+type rateBucket struct {
+	sec    int64
+	events uint64
+	bytes  uint64
+}
+
+// rateMeter measures events and bytes per second over the last rateWindow seconds. It keeps a bucket more
+// than rateWindow for the current second, so that it doesn't overwrite the oldest second of the window. The
+// zero value is ready to use.
+type rateMeter struct {
+	mu      sync.Mutex
+	buckets [rateWindow + 1]rateBucket
+}
+
+func (m *rateMeter) mark(events, bytes int, now time.Time) {
+	sec := now.Unix()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	b := &m.buckets[sec%int64(len(m.buckets))]
+	if b.sec != sec {
+		*b = rateBucket{sec: sec}
+	}
+	b.events += uint64(events)
+	b.bytes += uint64(bytes)
+}
+
+// rate returns events and bytes per second, the current second isn't counted since it isn't over yet.
+func (m *rateMeter) rate(now time.Time) (float64, float64) {
+	sec := now.Unix()
+	var events, bytes uint64
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i := range m.buckets {
+		b := &m.buckets[i]
+		if b.sec < sec && b.sec >= sec-rateWindow {
+			events += b.events
+			bytes += b.bytes
+		}
+	}
+	return float64(events) / rateWindow, float64(bytes) / rateWindow
+}
+
+type blockMeters struct {
+	appends rateMeter
+	reads   rateMeter
+}
+
+// blockRates keeps meters of appends and reads by blocks. The zero value is ready to use.
+type blockRates struct {
+	meters sync.Map // vanus.ID -> *blockMeters
+}
+
+func (r *blockRates) get(id vanus.ID) *blockMeters {
+	if v, ok := r.meters.Load(id); ok {
+		m, _ := v.(*blockMeters)
+		return m
+	}
+	v, _ := r.meters.LoadOrStore(id, &blockMeters{})
+	m, _ := v.(*blockMeters)
+	return m
+}
+
+func (r *blockRates) markAppend(id vanus.ID, events, bytes int) {
+	r.get(id).appends.mark(events, bytes, time.Now())
+}
+
+func (r *blockRates) markRead(id vanus.ID, events, bytes int) {
+	r.get(id).reads.mark(events, bytes, time.Now())
+}
+
+func (r *blockRates) remove(id vanus.ID) {
+	r.meters.Delete(id)
+}
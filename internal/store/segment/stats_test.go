@@ -0,0 +1,124 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"context"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+func TestRateMeter(t *testing.T) {
+	Convey("test rate meter", t, func() {
+		m := rateMeter{}
+		now := time.Unix(1000, 0)
+
+		m.mark(60, 600, now)
+		m.mark(60, 600, now.Add(500*time.Millisecond))
+		events, bytes := m.rate(now)
+		So(events, ShouldEqual, 0)
+		So(bytes, ShouldEqual, 0)
+
+		events, bytes = m.rate(now.Add(time.Second))
+		So(events, ShouldEqual, 2)
+		So(bytes, ShouldEqual, 20)
+
+		m.mark(120, 1200, now.Add(30*time.Second))
+		events, bytes = m.rate(now.Add(40 * time.Second))
+		So(events, ShouldEqual, 4)
+		So(bytes, ShouldEqual, 40)
+
+		// the first second is out of the window.
+		events, _ = m.rate(now.Add(61 * time.Second))
+		So(events, ShouldEqual, 2)
+
+		// the bucket of the first second is reused.
+		m.mark(6, 60, now.Add((rateWindow+1)*time.Second))
+		events, _ = m.rate(now.Add(62 * time.Second))
+		So(events, ShouldEqual, 2.1)
+
+		Convey("steady rate", func() {
+			m := rateMeter{}
+			for i := 0; i <= 2*rateWindow; i++ {
+				m.mark(10, 100, now.Add(time.Duration(i)*time.Second))
+			}
+			// the current second is being marked too.
+			events, bytes := m.rate(now.Add(2 * rateWindow * time.Second))
+			So(events, ShouldEqual, 10)
+			So(bytes, ShouldEqual, 100)
+		})
+	})
+}
+
+func TestServer_GetBlockStats(t *testing.T) {
+	Convey("get block stats", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		srv := &server{
+			state: primitive.ServerStateRunning,
+		}
+
+		id := vanus.NewTestID()
+		b := NewMockReplica(ctrl)
+		b.EXPECT().ID().AnyTimes().Return(id)
+		b.EXPECT().Stats().AnyTimes().Return(block.Statistics{
+			ID:              id,
+			Capacity:        64 * 1024 * 1024,
+			EntryNum:        2,
+			EntrySize:       256,
+			FirstEntryStime: 1000,
+			LastEntryStime:  2000,
+		})
+		srv.replicas.Store(id, b)
+
+		now := time.Now()
+		srv.rates.get(id).appends.mark(60, 6000, now.Add(-2*time.Second))
+		// reads out of the window.
+		srv.rates.get(id).reads.mark(60, 6000, now.Add(-2*rateWindow*time.Second))
+
+		stats, err := srv.GetBlockStats(context.Background(), id)
+		So(err, ShouldBeNil)
+		So(stats, ShouldHaveLength, 1)
+		So(stats[0].Id, ShouldEqual, id.Uint64())
+		So(stats[0].EventNumber, ShouldEqual, 2)
+		So(stats[0].Size, ShouldEqual, 256)
+		So(stats[0].OldestEventTime, ShouldEqual, 1000)
+		So(stats[0].NewestEventTime, ShouldEqual, 2000)
+		So(stats[0].AppendEventsPerSecond, ShouldEqual, 1)
+		So(stats[0].AppendBytesPerSecond, ShouldEqual, 100)
+		So(stats[0].ReadEventsPerSecond, ShouldEqual, 0)
+
+		stats, err = srv.GetBlockStats(context.Background())
+		So(err, ShouldBeNil)
+		So(stats, ShouldHaveLength, 1)
+
+		_, err = srv.GetBlockStats(context.Background(), vanus.NewTestID())
+		So(errors.Is(err, errors.ErrResourceNotFound), ShouldBeTrue)
+	})
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"sync"
+	"time"
+
+	// third-party libraries.
+	"golang.org/x/time/rate"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+type blockLimiter struct {
+	mu     sync.Mutex
+	bytes  *rate.Limiter
+	events *rate.Limiter
+}
+
+// appendLimiter limits appends of each block by token buckets of bytes and events. A nil appendLimiter
+// doesn't limit appends.
+type appendLimiter struct {
+	bytes    rate.Limit
+	events   rate.Limit
+	limiters sync.Map // vanus.ID -> *blockLimiter
+}
+
+func newAppendLimiter(cfg config.AppendRateLimit) *appendLimiter {
+	if !cfg.Enable || (cfg.BytesPerSecond == 0 && cfg.EventsPerSecond == 0) {
+		return nil
+	}
+	l := &appendLimiter{bytes: rate.Inf, events: rate.Inf}
+	if cfg.BytesPerSecond > 0 {
+		l.bytes = rate.Limit(cfg.BytesPerSecond)
+	}
+	if cfg.EventsPerSecond > 0 {
+		l.events = rate.Limit(cfg.EventsPerSecond)
+	}
+	return l
+}
+
+// reserve takes tokens of an append to block if both buckets have enough, otherwise it takes nothing and
+// returns how long the append should wait. An append larger than a bucket takes the whole bucket.
+func (l *appendLimiter) reserve(block vanus.ID, events, bytes int, now time.Time) time.Duration {
+	if l == nil {
+		return 0
+	}
+	bl := l.get(block)
+	bl.mu.Lock()
+	defer bl.mu.Unlock()
+
+	rb := bl.bytes.ReserveN(now, clamp(bytes, bl.bytes.Burst()))
+	re := bl.events.ReserveN(now, clamp(events, bl.events.Burst()))
+	wait := rb.DelayFrom(now)
+	if d := re.DelayFrom(now); d > wait {
+		wait = d
+	}
+	if wait > 0 {
+		rb.CancelAt(now)
+		re.CancelAt(now)
+	}
+	return wait
+}
+
+func (l *appendLimiter) get(block vanus.ID) *blockLimiter {
+	if v, ok := l.limiters.Load(block); ok {
+		return v.(*blockLimiter)
+	}
+	v, _ := l.limiters.LoadOrStore(block, &blockLimiter{
+		bytes:  rate.NewLimiter(l.bytes, burstOf(l.bytes)),
+		events: rate.NewLimiter(l.events, burstOf(l.events)),
+	})
+	return v.(*blockLimiter)
+}
+
+func (l *appendLimiter) remove(block vanus.ID) {
+	if l == nil {
+		return
+	}
+	l.limiters.Delete(block)
+}
+
+// burstOf returns tokens of one second, burst is ignored if the limit is infinite.
+func burstOf(limit rate.Limit) int {
+	if limit == rate.Inf {
+		return 0
+	}
+	if limit < 1 {
+		return 1
+	}
+	return int(limit)
+}
+
+func clamp(n, burst int) int {
+	if burst > 0 && n > burst {
+		return burst
+	}
+	return n
+}
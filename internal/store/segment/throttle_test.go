@@ -0,0 +1,69 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segment
+
+import (
+	// standard libraries.
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/config"
+)
+
+func TestAppendLimiter(t *testing.T) {
+	Convey("test append limiter", t, func() {
+		block1 := vanus.NewIDFromUint64(1)
+		block2 := vanus.NewIDFromUint64(2)
+		now := time.Now()
+
+		Convey("disabled", func() {
+			var l *appendLimiter
+			So(l.reserve(block1, 1000, 1000000, now), ShouldEqual, 0)
+			l = newAppendLimiter(config.AppendRateLimit{Enable: true})
+			So(l, ShouldBeNil)
+		})
+
+		Convey("limit bytes", func() {
+			l := newAppendLimiter(config.AppendRateLimit{Enable: true, BytesPerSecond: 1000})
+			So(l.reserve(block1, 1, 600, now), ShouldEqual, 0)
+			wait := l.reserve(block1, 1, 600, now)
+			So(wait, ShouldEqual, 200*time.Millisecond)
+			// The throttled append takes no tokens.
+			So(l.reserve(block1, 1, 400, now), ShouldEqual, 0)
+			So(l.reserve(block1, 1, 600, now.Add(600*time.Millisecond)), ShouldEqual, 0)
+
+			// Blocks are limited separately.
+			So(l.reserve(block2, 1, 1000, now), ShouldEqual, 0)
+
+			// An append larger than the bucket takes the whole bucket.
+			l.remove(block2)
+			So(l.reserve(block2, 1, 5000, now), ShouldEqual, 0)
+			So(l.reserve(block2, 1, 1, now), ShouldBeGreaterThan, 0)
+		})
+
+		Convey("limit events", func() {
+			l := newAppendLimiter(config.AppendRateLimit{Enable: true, BytesPerSecond: 1000, EventsPerSecond: 10})
+			So(l.reserve(block1, 10, 100, now), ShouldEqual, 0)
+			So(l.reserve(block1, 5, 100, now), ShouldEqual, 500*time.Millisecond)
+			// Bytes of the throttled append are given back.
+			So(l.reserve(block1, 10, 900, now.Add(time.Second)), ShouldEqual, 0)
+		})
+	})
+}
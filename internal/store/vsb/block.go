@@ -19,11 +19,14 @@ import (
 	"context"
 	"os"
 	"sync"
-	"sync/atomic"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/util/clock"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/io/engine/fsync"
 	"github.com/linkall-labs/vanus/internal/store/io/stream"
 	"github.com/linkall-labs/vanus/internal/store/io/zone"
 	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
@@ -54,30 +57,102 @@ type vsBlock struct {
 	indexOffset int64
 	indexLength int
 
-	fm      meta // flushed meta
-	actx    appendContext
-	indexes []index.Index
-	mu      sync.RWMutex
+	fm   meta // flushed meta
+	actx appendContext
+	// indexes holds indexes of committed entries, and endCommitted is the flag indicating the end entry is
+	// committed, they make up the commit watermark of Block. Entries are committed only after their data is
+	// durable, and Read never goes beyond them, so a reader can't observe an entry or the end of Block before
+	// it is durable. Both are guarded by mu.
+	indexes      []index.Index
+	endCommitted bool
+	// stride is the number of entries each index covers once Block is indexed sparsely, indexes holds the
+	// index of every stride-th entry followed by the one of the last entry then. It's 0 if every entry is
+	// indexed.
+	stride int
+	// sparseNum is the number of entries once Block is indexed sparsely, which indexes don't tell.
+	sparseNum int
+	// keyHashes holds key hashes of all entries once Block is indexed sparsely, so that entries can still be
+	// located by their partition keys. It's nil if no entry has a key.
+	keyHashes []uint32
+	mu        sync.RWMutex
 
 	enc codec.EntryEncoder
 	dec codec.EntryDecoder
 	lis block.ArchivedListener
+	// clk issues ingestion timestamps, it's shared by blocks of the engine.
+	clk *clock.Monotonic
+	// repair is the flag indicating inconsistent metadata is repaired when Block is opened.
+	repair bool
+	// buffered is the flag indicating the file isn't opened with O_SYNC, writes not through the stream must
+	// be synced explicitly.
+	buffered bool
+	// syncs notifies once data written by the stream is synced, it's nil if the io engine doesn't sync files
+	// after writes, the data is committed once written then.
+	syncs fsync.Notifier
+	// mmap maps archived blocks for reads if it's enabled.
+	mmap mapping
+	// compression compresses Block once it's archived if it isn't empty.
+	compression Compression
+	// pool recycles the file once Block is deleted, it's nil if files aren't preallocated.
+	pool *filePool
+	// compressQ is the queue Block waits in for compression after it's opened, it's nil if Block isn't queued.
+	compressQ *compressQueue
+	// dicts keeps zstd dictionaries of the engine.
+	dicts *dictionaries
+	// walEnabled is the flag indicating indexes of a working block are logged in its metadata WAL.
+	walEnabled bool
+	// walReplayed is the number of indexes replayed from the WAL when Block is opened.
+	walReplayed int
+	wal         *metaWAL
+	// sparse selects whether Block is indexed sparsely once it's archived.
+	sparse sparseIndex
+
+	// corrupted is the flag indicating data of the archived block mismatches its checksum.
+	corrupted uint32
+
+	// hmu serializes persisting the header, and guards hm.
+	hmu sync.Mutex
+	hm  headerMeta
+
+	// fmu guards f, codec and chunks against reads, which are swapped once Block is compressed.
+	fmu sync.RWMutex
+	// codec is the compression codec of the file, data isn't compressed if it's codecNone.
+	codec  uint8
+	chunks []chunk
+	cmp    compressor
 
 	f  *os.File
 	z  zone.Interface
 	s  stream.Stream
 	wg sync.WaitGroup
+	// df is the file which s writes to with direct I/O, it's nil if s writes to f.
+	df *os.File
 }
 
 // Make sure vsBlock implements block.File.
-var _ block.Raw = (*vsBlock)(nil)
+var (
+	_ block.Raw            = (*vsBlock)(nil)
+	_ block.OriginRecorder = (*vsBlock)(nil)
+	_ block.DictionaryUser = (*vsBlock)(nil)
+)
 
 func (b *vsBlock) ID() vanus.ID {
 	return b.id
 }
 
 func (b *vsBlock) Close(ctx context.Context) error {
-	b.wg.Wait()
+	// Sealing and compressing may be in progress, Block is left open if they aren't done before ctx.
+	b.compressQ.remove(b)
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 
 	m, indexes := b.makeSnapshot()
 
@@ -103,11 +178,36 @@ func (b *vsBlock) Close(ctx context.Context) error {
 		}
 	}
 
-	return b.f.Close()
+	if b.buffered {
+		if err := b.f.Sync(); err != nil {
+			return err
+		}
+	}
+
+	if err := b.unmap(); err != nil {
+		return err
+	}
+
+	if err := b.closeWAL(); err != nil {
+		return err
+	}
+
+	err := b.f.Close()
+	if b.df != nil {
+		if err2 := b.df.Close(); err == nil {
+			err = err2
+		}
+	}
+	return err
 }
 
-func (b *vsBlock) Delete(context.Context) error {
+func (b *vsBlock) Delete(ctx context.Context) error {
 	// FIXME(james.yin): make sure block is closed.
+	_ = os.Remove(b.path + compressingExt)
+	b.removeWAL(ctx)
+	if b.pool.recycle(b.path, b.capacity) {
+		return nil
+	}
 	return os.Remove(b.path)
 }
 
@@ -131,7 +231,3 @@ func (b *vsBlock) stat(m meta, indexes []index.Index) block.Statistics {
 	}
 	return s
 }
-
-func (b *vsBlock) full() bool {
-	return atomic.LoadUint32(&b.actx.archived) != 0
-}
@@ -29,6 +29,7 @@ import (
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/crashpoint"
 	"github.com/linkall-labs/vanus/internal/store/io"
 	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
 	"github.com/linkall-labs/vanus/internal/store/vsb/index"
@@ -96,7 +97,7 @@ func (b *vsBlock) PrepareAppend(
 	seqs := make([]int64, num)
 
 	// TODO(james.yin): fill auto fields in a general way.
-	now := time.Now().UnixMilli()
+	now := b.now()
 	for i := int64(0); i < num; i++ {
 		seq := actx.seq + i
 		ents[i] = wrapEntry(entries[i], ceschema.CloudEvent, seq, now)
@@ -118,7 +119,7 @@ func (b *vsBlock) PrepareArchive(ctx context.Context, appendCtx block.AppendCont
 
 	actx, _ := appendCtx.(*appendContext)
 
-	end := wrapEntry(&block.EmptyEntryExt{}, ceschema.End, actx.seq, time.Now().UnixMilli())
+	end := wrapEntry(&block.EmptyEntryExt{}, ceschema.End, actx.seq, b.now())
 	frag := newFragment(actx.offset, []block.Entry{end}, b.enc)
 
 	actx.offset += int64(frag.Size())
@@ -152,6 +153,17 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb bloc
 		// TODO(james.yin): invoke callback.
 		return
 	}
+	if frag.StartOffset() < off {
+		// The head of fragment was written before a crash, and the torn write of the rest was wiped when the
+		// block was opened, so only the rest is written again.
+		log.Info(ctx, "vsb: head of fragment has been written, write the rest.", map[string]interface{}{
+			"block_id":              b.id,
+			"expected":              off,
+			"fragment_start_offset": frag.StartOffset(),
+			"fragment_end_offset":   frag.EndOffset(),
+		})
+		frag = &tailFragment{Fragment: frag, offset: off}
+	}
 	if frag.StartOffset() != off {
 		log.Error(ctx, "vsb: missing some fragments.", map[string]interface{}{
 			"block_id": b.id,
@@ -162,23 +174,29 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb bloc
 		return
 	}
 
-	indexes, seq, archived, _ := b.buildIndexes(ctx, b.actx.seq, frag)
+	indexes, seq, archived, err := b.buildIndexes(ctx, b.actx.seq, frag)
+	if err != nil {
+		log.Error(ctx, "vsb: corrupted fragment.", map[string]interface{}{
+			"block_id":              b.id,
+			"fragment_start_offset": frag.StartOffset(),
+			log.KeyError:            err,
+		})
+		// TODO(james.yin): invoke callback.
+		return
+	}
 
 	b.actx.seq = seq
 	b.actx.offset = frag.EndOffset()
 
+	crashpoint.Inject(crashpoint.BlockAppendBeforeWrite)
+
 	if !archived {
 		b.s.Append(bytes.NewReader(frag.Payload()), func(n int, err error) {
-			log.Debug(context.Background(), "acquiring index write lock", map[string]interface{}{
-				"block_id": b.id,
-			})
-			b.mu.Lock()
-			b.indexes = append(b.indexes, indexes...)
-			log.Info(context.Background(), "release index write lock", map[string]interface{}{
-				"block_id": b.id,
+			b.afterSync(func() {
+				// Indexes are logged after data is durable, so the WAL never refers to a lost entry.
+				b.logIndexes(ctx, indexes)
+				b.commit(indexes, false)
 			})
-			b.mu.Unlock()
-
 			cb()
 		})
 		return
@@ -188,33 +206,67 @@ func (b *vsBlock) CommitAppend(ctx context.Context, frag block.Fragment, cb bloc
 
 	b.wg.Add(1)
 	b.s.Append(bytes.NewReader(frag.Payload()), func(n int, err error) {
-		if len(indexes) != 0 {
-			log.Debug(context.Background(), "acquiring index write lock", map[string]interface{}{
-				"block_id": b.id,
-			})
-			b.mu.Lock()
-			b.indexes = append(b.indexes, indexes...)
-			log.Info(context.Background(), "release index write lock", map[string]interface{}{
-				"block_id": b.id,
+		// Block is sealed once the end entry is durable.
+		b.afterSync(func() {
+			b.commit(indexes, true)
+			cb()
+
+			m, i := makeSnapshot(b.actx, b.indexes)
+
+			crashpoint.Inject(crashpoint.BlockSealBeforeIndex)
+			go b.appendIndexEntry(ctx, i, func(n int, err error) {
+				defer b.wg.Done()
+				b.indexOffset = m.writeOffset
+				b.indexLength = n
+				if err == nil {
+					// n isn't passed by the stream yet, the index entry ends at the write offset of it.
+					b.keepChecksum(ctx, b.s.WriteOffset())
+				}
+				crashpoint.Inject(crashpoint.BlockSealBeforeHeader)
+				if b.persistHeader(ctx, m) == nil {
+					b.removeWAL(ctx)
+				}
+				b.compressArchived(context.Background())
+				b.sparsify(context.Background())
 			})
-			b.mu.Unlock()
-		}
 
-		cb()
+			if b.lis != nil {
+				b.lis.OnArchived(b.stat(m, i))
+			}
+		})
+	})
+}
 
-		m, i := makeSnapshot(b.actx, b.indexes)
+// afterSync calls fn once data the stream wrote before is synced, or immediately if it's durable once written.
+func (b *vsBlock) afterSync(fn func()) {
+	if b.syncs == nil {
+		fn()
+		return
+	}
+	f := b.df
+	if f == nil {
+		f = b.f
+	}
+	b.syncs.AfterSync(f, fn)
+}
 
-		go b.appendIndexEntry(ctx, i, func(n int, err error) {
-			defer b.wg.Done()
-			b.indexOffset = m.writeOffset
-			b.indexLength = n
-			_ = b.persistHeader(ctx, m)
+// commit appends indexes of durable entries, and advances the commit watermark to them.
+func (b *vsBlock) commit(indexes []index.Index, archived bool) {
+	log.Debug(context.Background(), "acquiring index write lock", map[string]interface{}{
+		"block_id": b.id,
+	})
+	b.mu.Lock()
+	defer func() {
+		log.Debug(context.Background(), "release index write lock", map[string]interface{}{
+			"block_id": b.id,
 		})
+		b.mu.Unlock()
+	}()
 
-		if b.lis != nil {
-			b.lis.OnArchived(b.stat(m, i))
-		}
-	})
+	b.indexes = append(b.indexes, indexes...)
+	if archived {
+		b.endCommitted = true
+	}
 }
 
 func (b *vsBlock) buildIndexes(
@@ -264,3 +316,30 @@ func (b *vsBlock) appendIndexEntry(ctx context.Context, indexes []index.Index, c
 	b.s.Append(bytes.NewReader(data), cb)
 	// return sz, nil
 }
+
+// now returns the ingestion timestamp in milliseconds, it falls back to the wall clock if the block is
+// created without a clock.
+func (b *vsBlock) now() int64 {
+	if b.clk == nil {
+		return time.Now().UnixMilli()
+	}
+	return b.clk.NowMilli()
+}
+
+// tailFragment is the rest of a fragment from offset, which is the start of an entry in it.
+type tailFragment struct {
+	block.Fragment
+	offset int64
+}
+
+func (f *tailFragment) Payload() []byte {
+	return f.Fragment.Payload()[f.offset-f.Fragment.StartOffset():]
+}
+
+func (f *tailFragment) Size() int {
+	return int(f.Fragment.EndOffset() - f.offset)
+}
+
+func (f *tailFragment) StartOffset() int64 {
+	return f.offset
+}
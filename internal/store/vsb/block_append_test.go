@@ -17,7 +17,9 @@ package vsb
 import (
 	// standard libraries.
 	"context"
+	"hash/crc32"
 	"os"
+	"sync"
 	"testing"
 
 	// third-party libraries.
@@ -138,6 +140,7 @@ func TestVSBlock_Append(t *testing.T) {
 
 			So(b.indexes, ShouldHaveLength, 1)
 			idxtest.CheckIndex0(b.indexes[0], true)
+			So(b.endCommitted, ShouldBeFalse)
 
 			seqs, frag, full, err = b.PrepareAppend(ctx, actx, ent1)
 			So(err, ShouldBeNil)
@@ -197,6 +200,56 @@ func TestVSBlock_Append(t *testing.T) {
 			idxtest.CheckIndex1(b.indexes[1], true)
 		})
 
+		Convey("commit a fragment whose head has been written", func() {
+			_, head, _, err := b.PrepareAppend(ctx, b.NewAppendContext(nil), ent0)
+			So(err, ShouldBeNil)
+			_, frag, _, err := b.PrepareAppend(ctx, b.NewAppendContext(nil), ent0, ent1)
+			So(err, ShouldBeNil)
+
+			b.CommitAppend(ctx, head, func() {
+				ch <- struct{}{}
+			})
+			<-ch
+
+			b.CommitAppend(ctx, frag, func() {
+				ch <- struct{}{}
+			})
+			<-ch
+
+			stat := b.status()
+			So(stat.EntryNum, ShouldEqual, 2)
+			So(stat.EntrySize, ShouldEqual, vsbtest.EntrySize0+vsbtest.EntrySize1)
+
+			So(b.indexes, ShouldHaveLength, 2)
+			idxtest.CheckIndex0(b.indexes[0], true)
+			idxtest.CheckIndex1(b.indexes[1], true)
+		})
+
+		Convey("commit entries after their data is synced", func() {
+			syncs := &testNotifier{}
+			b.syncs = syncs
+
+			_, frag, _, err := b.PrepareAppend(ctx, b.NewAppendContext(nil), ent0, ent1)
+			So(err, ShouldBeNil)
+
+			b.CommitAppend(ctx, frag, func() {
+				ch <- struct{}{}
+			})
+			<-ch
+
+			// Written entries aren't readable before they are durable.
+			So(b.indexes, ShouldBeEmpty)
+			_, err = b.Read(ctx, 0, 1, 0)
+			So(err, ShouldBeError, block.ErrOnEnd)
+
+			syncs.sync()
+
+			So(b.indexes, ShouldHaveLength, 2)
+			entries, err := b.Read(ctx, 0, 2, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 2)
+		})
+
 		Reset(func() {
 			buf := make([]byte, vsbtest.EntrySize0+vsbtest.EntrySize1)
 			_, err := f.ReadAt(buf, headerBlockSize)
@@ -296,6 +349,7 @@ func TestVSBlock_Append(t *testing.T) {
 		So(b.indexes, ShouldHaveLength, 2)
 		idxtest.CheckIndex0(b.indexes[0], true)
 		idxtest.CheckIndex1(b.indexes[1], true)
+		So(b.endCommitted, ShouldBeTrue)
 
 		buf := make([]byte, vsbtest.EndEntrySize)
 		_, err = f.ReadAt(buf, vsbtest.EndEntryOffset)
@@ -317,9 +371,41 @@ func TestVSBlock_Append(t *testing.T) {
 		So(n, ShouldEqual, vsbtest.IndexEntrySize)
 		idxtest.CheckEntry(entry, true)
 
-		buf = make([]byte, headerSize)
+		buf = make([]byte, headerBlockSize)
 		_, err = f.ReadAt(buf, 0)
 		So(err, ShouldBeNil)
-		So(buf, ShouldResemble, vsbtest.ArchivedHeaderData)
+		So(buf[:metaLengthOffset], ShouldResemble, vsbtest.ArchivedHeaderDataV2[:metaLengthOffset])
+
+		// The checksum of data up to the index entry is kept in the header.
+		size := int64(vsbtest.IndexEntryOffset + vsbtest.IndexEntrySize - headerBlockSize)
+		data := make([]byte, size)
+		_, err = f.ReadAt(data, headerBlockSize)
+		So(err, ShouldBeNil)
+		c := &vsBlock{}
+		So(c.loadMeta(buf), ShouldBeNil)
+		So(c.hm.blockSize, ShouldEqual, size)
+		So(c.hm.blockCRC, ShouldEqual, crc32.Checksum(data, crc32q))
 	})
 }
+
+// testNotifier calls waiters back once sync is called.
+type testNotifier struct {
+	mu      sync.Mutex
+	waiters []func()
+}
+
+func (n *testNotifier) AfterSync(_ *os.File, fn func()) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.waiters = append(n.waiters, fn)
+}
+
+func (n *testNotifier) sync() {
+	n.mu.Lock()
+	waiters := n.waiters
+	n.waiters = nil
+	n.mu.Unlock()
+	for _, fn := range waiters {
+		fn()
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync/atomic"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+// checksumChunkSize bounds the memory of checksumming data.
+const checksumChunkSize = 1024 * 1024
+
+// Make sure block implements block.ChecksumVerifier.
+var _ block.ChecksumVerifier = (*vsBlock)(nil)
+
+// checksum updates crc with size bytes of f from off, it returns errCorrupted if f is shorter.
+func checksum(f *os.File, off, size int64, crc uint32) (uint32, error) {
+	n := int64(checksumChunkSize)
+	if size < n {
+		n = size
+	}
+	buf := make([]byte, n)
+	for size > 0 {
+		if size < n {
+			n = size
+		}
+		if _, err := f.ReadAt(buf[:n], off); err != nil {
+			if stderr.Is(err, io.EOF) {
+				return 0, errCorrupted
+			}
+			return 0, err
+		}
+		crc = crc32.Update(crc, crc32q, buf[:n])
+		off += n
+		size -= n
+	}
+	return crc, nil
+}
+
+// keepChecksum checksums data of the archived block up to end, the checksum is kept in the header once it's
+// persisted. Block is kept without a checksum if data can't be read.
+func (b *vsBlock) keepChecksum(ctx context.Context, end int64) {
+	size := end - b.dataOffset
+	crc, err := checksum(b.f, b.dataOffset, size, 0)
+	if err != nil {
+		log.Warning(ctx, "vsb: checksum the archived block failed.", map[string]interface{}{
+			"block_id":   b.id,
+			log.KeyError: err,
+		})
+		return
+	}
+	b.hmu.Lock()
+	b.hm.blockSize, b.hm.blockCRC = size, crc
+	b.hmu.Unlock()
+}
+
+// verifyChecksum checks data of the archived block against its checksum when it's opened. Block is still
+// opened if they mismatch, but it's marked corrupted, so that the scrubber reports it, and it's neither
+// compressed nor cloned.
+func (b *vsBlock) verifyChecksum(ctx context.Context) error {
+	if !b.fm.archived || b.hm.blockSize == 0 {
+		return nil
+	}
+	crc, err := checksum(b.f, b.dataOffset, b.hm.blockSize, 0)
+	if err == nil && crc != b.hm.blockCRC {
+		err = errCorrupted
+	}
+	if stderr.Is(err, errCorrupted) {
+		b.markCorrupted(ctx)
+		return nil
+	}
+	return err
+}
+
+func (b *vsBlock) markCorrupted(ctx context.Context) {
+	if atomic.SwapUint32(&b.corrupted, 1) == 0 {
+		log.Error(ctx, "vsb: the archived block mismatches its checksum.", map[string]interface{}{
+			"block_id": b.id,
+		})
+	}
+}
+
+func (b *vsBlock) isCorrupted() bool {
+	return atomic.LoadUint32(&b.corrupted) != 0
+}
+
+// VerifyChecksum checksums at most maxBytes of data after p, it's the data in the file, compressed or not.
+func (b *vsBlock) VerifyChecksum(
+	ctx context.Context, p block.ChecksumProgress, maxBytes int,
+) (block.ChecksumProgress, error) {
+	// The file and its checksum are swapped together once Block is compressed.
+	b.fmu.RLock()
+	defer b.fmu.RUnlock()
+
+	b.hmu.Lock()
+	size, sum := b.hm.blockSize, b.hm.blockCRC
+	b.hmu.Unlock()
+	if size == 0 {
+		return p, block.ErrNotSupported
+	}
+	if b.isCorrupted() {
+		return p, block.ErrCorrupted
+	}
+	if p.Size != size || p.Sum != sum || p.Done > size {
+		p = block.ChecksumProgress{Size: size, Sum: sum}
+	}
+
+	n := size - p.Done
+	if maxBytes > 0 && int64(maxBytes) < n {
+		n = int64(maxBytes)
+	}
+	crc, err := checksum(b.f, b.dataOffset+p.Done, n, p.Partial)
+	if stderr.Is(err, errCorrupted) {
+		b.markCorrupted(ctx)
+		return p, errors.Chain(block.ErrCorrupted, err)
+	}
+	if err != nil {
+		return p, err
+	}
+	p.Done += n
+	p.Partial = crc
+	if p.Finished() && p.Partial != p.Sum {
+		b.markCorrupted(ctx)
+		return p, block.ErrCorrupted
+	}
+	return p, nil
+}
@@ -0,0 +1,148 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+// verifyInSteps verifies the checksum of b in steps of 100 bytes from p.
+func verifyInSteps(ctx context.Context, b *vsBlock, p block.ChecksumProgress) (block.ChecksumProgress, error) {
+	for {
+		var err error
+		if p, err = b.VerifyChecksum(ctx, p, 100); err != nil || p.Finished() {
+			return p, err
+		}
+	}
+}
+
+func TestVSBlock_Checksum(t *testing.T) {
+	Convey("checksum of archived vsb", t, func() {
+		ctx := context.Background()
+		path := createArchivedFile()
+		defer func() {
+			So(os.Remove(path), ShouldBeNil)
+		}()
+		size := int64(vsbtest.IndexEntryOffset + vsbtest.IndexEntrySize - headerBlockSize)
+
+		b := &vsBlock{path: path}
+		So(b.Open(ctx), ShouldBeNil)
+		_, err := b.VerifyChecksum(ctx, block.ChecksumProgress{}, 0)
+		So(err, ShouldEqual, block.ErrNotSupported)
+		b.keepChecksum(ctx, headerBlockSize+size)
+		So(b.persistHeader(ctx, b.fm), ShouldBeNil)
+		So(b.f.Close(), ShouldBeNil)
+
+		Convey("verify when it's opened and in steps", func() {
+			b = &vsBlock{path: path}
+			So(b.Open(ctx), ShouldBeNil)
+			So(b.hm.blockSize, ShouldEqual, size)
+			So(b.isCorrupted(), ShouldBeFalse)
+
+			p, err := verifyInSteps(ctx, b, block.ChecksumProgress{})
+			So(err, ShouldBeNil)
+			So(p.Done, ShouldEqual, size)
+			So(p.Partial, ShouldEqual, b.hm.blockCRC)
+
+			// The checksum covers compressed data once it's compressed, verifying starts over.
+			saving := minCompressionSaving
+			minCompressionSaving = -1
+			defer func() {
+				minCompressionSaving = saving
+			}()
+			b.compression = CompressionSnappy
+			b.compressArchived(ctx)
+			So(b.codec, ShouldEqual, codecSnappy)
+			So(b.hm.blockSize, ShouldNotEqual, size)
+			half := block.ChecksumProgress{Size: p.Size, Sum: p.Sum, Done: size / 2}
+			p, err = verifyInSteps(ctx, b, half)
+			So(err, ShouldBeNil)
+			So(p.Size, ShouldEqual, b.hm.blockSize)
+			So(b.Close(ctx), ShouldBeNil)
+
+			b = &vsBlock{path: path}
+			So(b.Open(ctx), ShouldBeNil)
+			So(b.isCorrupted(), ShouldBeFalse)
+			_, err = verifyInSteps(ctx, b, block.ChecksumProgress{})
+			So(err, ShouldBeNil)
+			So(b.f.Close(), ShouldBeNil)
+		})
+
+		Convey("keep it in a copy", func() {
+			b = &vsBlock{path: path}
+			So(b.Open(ctx), ShouldBeNil)
+			defer func() {
+				So(b.f.Close(), ShouldBeNil)
+			}()
+			dir, err := os.MkdirTemp("", "clone-*")
+			So(err, ShouldBeNil)
+			defer func() {
+				So(os.RemoveAll(dir), ShouldBeNil)
+			}()
+			dest := filepath.Join(dir, "clone.vsb")
+			So(b.Clone(ctx, dest), ShouldBeNil)
+
+			c := &vsBlock{path: dest}
+			So(c.Open(ctx), ShouldBeNil)
+			So(c.hm.blockSize, ShouldEqual, size)
+			So(c.hm.blockCRC, ShouldEqual, b.hm.blockCRC)
+			So(c.isCorrupted(), ShouldBeFalse)
+			So(c.f.Close(), ShouldBeNil)
+		})
+
+		Convey("detect bit rot", func() {
+			f, err := os.OpenFile(path, os.O_RDWR, 0)
+			So(err, ShouldBeNil)
+			_, err = f.WriteAt([]byte{0xFF}, vsbtest.IndexEntryOffset+vsbtest.IndexEntrySize-1)
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+
+			// The corrupted block is still opened, but it's neither compressed nor cloned.
+			b = &vsBlock{path: path, compression: CompressionSnappy}
+			So(b.Open(ctx), ShouldBeNil)
+			So(b.isCorrupted(), ShouldBeTrue)
+			_, err = b.VerifyChecksum(ctx, block.ChecksumProgress{}, 0)
+			So(err, ShouldEqual, block.ErrCorrupted)
+			b.compressArchived(ctx)
+			So(b.codec, ShouldEqual, codecNone)
+			So(b.Clone(ctx, path+".clone"), ShouldEqual, block.ErrCorrupted)
+			So(b.f.Close(), ShouldBeNil)
+		})
+
+		Convey("detect bit rot when it's verified", func() {
+			b = &vsBlock{path: path}
+			So(b.Open(ctx), ShouldBeNil)
+			_, err = b.f.WriteAt([]byte{0xFF}, vsbtest.IndexEntryOffset+vsbtest.IndexEntrySize-1)
+			So(err, ShouldBeNil)
+
+			_, err = verifyInSteps(ctx, b, block.ChecksumProgress{})
+			So(stderr.Is(err, block.ErrCorrupted), ShouldBeTrue)
+			So(b.isCorrupted(), ShouldBeTrue)
+			So(b.f.Close(), ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"hash/crc32"
+	"os"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+const (
+	cloningExt = ".cloning"
+	// cloneChunkSize bounds the memory of copying data.
+	cloneChunkSize = 4 * 1024 * 1024
+)
+
+// Make sure block implements block.Cloner.
+var _ block.Cloner = (*vsBlock)(nil)
+
+// Clone copies committed entries of Block, with the header and the index entry if it's archived, to a new
+// block file at path. Appends aren't blocked, entries committed after the copy begins aren't in it. The copy
+// is always uncompressed, and it's renamed to path once it's synced, so path is either absent or complete.
+// A corrupted block isn't copied, since the copy would be checksummed again.
+func (b *vsBlock) Clone(ctx context.Context, path string) error {
+	if b.isCorrupted() {
+		return block.ErrCorrupted
+	}
+	m, indexes := b.committedSnapshot()
+
+	var ie []byte
+	indexOffset := m.writeOffset
+	if m.archived {
+		indexes, err := b.expandIndexes(ctx, m, indexes)
+		if err != nil {
+			return err
+		}
+		entry := index.NewEntry(indexes)
+		ie = make([]byte, b.enc.Size(entry))
+		if _, err := b.enc.MarshalTo(ctx, entry, ie); err != nil {
+			return err
+		}
+	}
+
+	tmp := path + cloningExt
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, defaultFilePerm)
+	if err != nil {
+		return err
+	}
+	crc, err := b.writeClone(ctx, f, m.writeOffset, ie)
+	if err == nil {
+		_, err = f.WriteAt(b.cloneHeader(m, indexOffset, crc, m.writeOffset+int64(len(ie))), 0)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		if err2 := os.Remove(tmp); err2 != nil && !os.IsNotExist(err2) {
+			return errors.Chain(err, err2)
+		}
+		return err
+	}
+	return nil
+}
+
+// committedSnapshot is like makeSnapshot, but it covers committed entries only, whose data are durable.
+func (b *vsBlock) committedSnapshot() (meta, []index.Index) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	indexes := b.indexes
+	m := meta{
+		writeOffset: b.dataOffset,
+		archived:    b.endCommitted,
+	}
+	if sz := len(indexes); sz != 0 {
+		m.writeOffset = indexes[sz-1].EndOffset()
+		m.entryLength = m.writeOffset - indexes[0].StartOffset()
+		m.entryNum = int64(b.committedNum())
+	}
+	if m.archived {
+		// The end entry follows the last entry.
+		m.writeOffset = b.actx.offset
+	}
+	return m, indexes
+}
+
+// cloneHeader encodes the header of the copy, whose data up to end is checksummed in crc if it's archived.
+func (b *vsBlock) cloneHeader(m meta, indexOffset int64, crc uint32, end int64) []byte {
+	b.hmu.Lock()
+	defer b.hmu.Unlock()
+	header := b.encodeHeader(m, codecNone, indexOffset)
+	hm := b.hm
+	// Data of the copy isn't compressed, and the dictionary may be unknown to the engine opening it.
+	hm.dictID, hm.dictOffset, hm.dictLength, hm.dictCRC = 0, 0, 0, 0
+	hm.blockSize, hm.blockCRC = 0, 0
+	if m.archived {
+		hm.blockSize, hm.blockCRC = end-b.dataOffset, crc
+	}
+	return encodeMeta(header, &hm)
+}
+
+// writeClone copies data up to end and the index entry after it, it returns the checksum of them.
+func (b *vsBlock) writeClone(ctx context.Context, f *os.File, end int64, ie []byte) (uint32, error) {
+	var crc uint32
+	buf := make([]byte, cloneChunkSize)
+	for off := b.dataOffset; off < end; {
+		n := int64(len(buf))
+		if end-off < n {
+			n = end - off
+		}
+		if err := b.readAt(ctx, buf[:n], off); err != nil {
+			return 0, err
+		}
+		if _, err := f.WriteAt(buf[:n], off); err != nil {
+			return 0, err
+		}
+		crc = crc32.Update(crc, crc32q, buf[:n])
+		off += n
+	}
+
+	if len(ie) != 0 {
+		if _, err := f.WriteAt(ie, end); err != nil {
+			return 0, err
+		}
+	}
+	return crc32.Update(crc, crc32q, ie), nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+func TestVSBlock_Clone(t *testing.T) {
+	Convey("clone vsb", t, func() {
+		ctx := context.Background()
+		dir, err := os.MkdirTemp("", "clone-*")
+		So(err, ShouldBeNil)
+		defer func() {
+			So(os.RemoveAll(dir), ShouldBeNil)
+		}()
+		dest := filepath.Join(dir, "clone.vsb")
+
+		Convey("archived block", func() {
+			path := createArchivedFile()
+			defer func() {
+				So(os.Remove(path), ShouldBeNil)
+			}()
+			b := &vsBlock{path: path}
+			So(b.Open(ctx), ShouldBeNil)
+			defer func() {
+				So(b.f.Close(), ShouldBeNil)
+			}()
+
+			So(b.Clone(ctx, dest), ShouldBeNil)
+			_, err = os.Stat(dest + cloningExt)
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			c := &vsBlock{path: dest}
+			So(c.Open(ctx), ShouldBeNil)
+			defer func() {
+				So(c.f.Close(), ShouldBeNil)
+			}()
+			So(c.status(), ShouldResemble, block.Statistics{
+				ID:              c.id,
+				Capacity:        uint64(b.capacity),
+				Archived:        true,
+				EntryNum:        2,
+				EntrySize:       vsbtest.EntrySize0 + vsbtest.EntrySize1,
+				FirstEntryStime: b.status().FirstEntryStime,
+				LastEntryStime:  b.status().LastEntryStime,
+			})
+			So(c.indexOffset, ShouldEqual, vsbtest.IndexEntryOffset)
+
+			entries, err := c.Read(ctx, 0, 3, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 2)
+			cetest.CheckEntry0(entries[0], false, false)
+			cetest.CheckEntry1(entries[1], false, false)
+		})
+
+		Convey("appendable block with uncommitted entries", func() {
+			f, err := os.CreateTemp("", "*.vsb")
+			So(err, ShouldBeNil)
+			_, err = f.WriteAt(vsbtest.EmptyHeaderData, 0)
+			So(err, ShouldBeNil)
+			_, err = f.WriteAt(vsbtest.EntryData0, vsbtest.EntryOffset0)
+			So(err, ShouldBeNil)
+			_, err = f.WriteAt(vsbtest.EntryData1, vsbtest.EntryOffset1)
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+			defer func() {
+				So(os.Remove(f.Name()), ShouldBeNil)
+			}()
+
+			b := &vsBlock{path: f.Name()}
+			So(b.Open(ctx), ShouldBeNil)
+			defer func() {
+				So(b.f.Close(), ShouldBeNil)
+			}()
+			// The second entry is being written.
+			b.indexes = b.indexes[:1]
+
+			So(b.Clone(ctx, dest), ShouldBeNil)
+
+			c := &vsBlock{path: dest}
+			So(c.Open(ctx), ShouldBeNil)
+			defer func() {
+				So(c.f.Close(), ShouldBeNil)
+			}()
+			stat := c.status()
+			So(stat.Archived, ShouldBeFalse)
+			So(stat.EntryNum, ShouldEqual, 1)
+			So(stat.EntrySize, ShouldEqual, vsbtest.EntrySize0)
+			So(c.actx.offset, ShouldEqual, vsbtest.EntryOffset1)
+
+			entries, err := c.Read(ctx, 0, 3, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+			cetest.CheckEntry0(entries[0], false, false)
+		})
+	})
+}
@@ -0,0 +1,407 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/dictionary"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+// A compressed block keeps the header, followed by compressed chunks of data, the index entry, the
+// dictionary if data is compressed with one, the chunk table and the footer. Offsets of indexes are still
+// offsets in uncompressed data, so that they're mapped to chunks by the chunk table.
+const (
+	compressingExt = ".compressing"
+	// compressChunkSize is the size of uncompressed data of a chunk, a chunk ends at the boundary of entries,
+	// so that a read decompresses only chunks covering its entries.
+	compressChunkSize = 64 * 1024
+	chunkMetaSize     = 4 + 4 + 4
+	footerSize        = 8 + 8 + 4 + 4
+)
+
+// minCompressionSaving is the fraction of space a compressed block saves at least, otherwise the block is
+// kept uncompressed.
+var minCompressionSaving = 0.1
+
+type chunk struct {
+	offset     int64
+	length     int32
+	physOffset int64
+	physLength int32
+	crc        uint32
+}
+
+// compressArchived rewrites an archived block in compressed chunks and swaps the file, reads are served
+// from the old file until the swap.
+func (b *vsBlock) compressArchived(ctx context.Context) {
+	if b.compression == CompressionNone || !featuregate.Enabled(featuregate.BlockCompression) {
+		return
+	}
+	b.fmu.RLock()
+	compressed := b.codec != codecNone
+	b.fmu.RUnlock()
+	// Data of a corrupted block would be checksummed again once it's compressed.
+	if compressed || b.isCorrupted() {
+		return
+	}
+	m, indexes := b.makeSnapshot()
+	if !m.archived || len(indexes) == 0 {
+		return
+	}
+	indexes, err := b.expandIndexes(ctx, m, indexes)
+	if err == nil {
+		err = b.compress(ctx, m, indexes)
+	}
+	if err != nil {
+		_ = os.Remove(b.path + compressingExt)
+		log.Warning(ctx, "compress the archived block failed", map[string]interface{}{
+			log.KeyError:  err,
+			"block_id":    b.id,
+			"compression": b.compression,
+		})
+	}
+}
+
+func (b *vsBlock) compress(ctx context.Context, m meta, indexes []index.Index) error {
+	codec, err := b.compression.codec()
+	if err != nil {
+		return err
+	}
+	cmp, dict := b.dictCompressor(ctx, codec)
+	if cmp != nil {
+		codec = codecZstdDict
+	} else if cmp, err = compressorOf(codec); err != nil {
+		return err
+	}
+
+	data := make([]byte, m.writeOffset-b.dataOffset)
+	if err = b.readAt(ctx, data, b.dataOffset); err != nil {
+		return err
+	}
+
+	var chunks []chunk
+	body := make([]byte, 0, len(data)/2)
+	start := b.dataOffset
+	cut := func(end int64) {
+		out := cmp.compress(data[start-b.dataOffset : end-b.dataOffset])
+		chunks = append(chunks, chunk{
+			offset:     start,
+			length:     int32(end - start),
+			physOffset: b.dataOffset + int64(len(body)),
+			physLength: int32(len(out)),
+			crc:        crc32.Checksum(out, crc32q),
+		})
+		body = append(body, out...)
+		start = end
+	}
+	for _, idx := range indexes {
+		if idx.StartOffset() > start && idx.EndOffset()-start > compressChunkSize {
+			cut(idx.StartOffset())
+		}
+	}
+	// The last chunk carries the end entry.
+	cut(m.writeOffset)
+
+	if float64(len(body)) > float64(len(data))*(1-minCompressionSaving) {
+		log.Debug(ctx, "the archived block is kept uncompressed", map[string]interface{}{
+			"block_id":        b.id,
+			"size":            len(data),
+			"compressed_size": len(body),
+		})
+		return nil
+	}
+
+	entry := index.NewEntry(indexes)
+	ie := make([]byte, b.enc.Size(entry))
+	if _, err = b.enc.MarshalTo(ctx, entry, ie); err != nil {
+		return err
+	}
+
+	f, hm, err := b.writeCompressed(m, codec, dict, body, ie, chunks)
+	if err != nil {
+		return err
+	}
+
+	b.fmu.Lock()
+	old := b.f
+	b.f, b.codec, b.chunks, b.cmp = f, codec, chunks, cmp
+	b.hmu.Lock()
+	if codec == codecZstdDict {
+		b.hm.dictID = hm.dictID
+		b.hm.dictOffset, b.hm.dictLength, b.hm.dictCRC = hm.dictOffset, hm.dictLength, hm.dictCRC
+	}
+	b.hm.blockSize, b.hm.blockCRC = hm.blockSize, hm.blockCRC
+	b.hmu.Unlock()
+	err = b.unmap()
+	b.fmu.Unlock()
+	if err != nil {
+		log.Warning(ctx, "unmap the compressed block failed", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   b.id,
+		})
+	}
+	_ = old.Close()
+
+	log.Info(ctx, "the archived block is compressed", map[string]interface{}{
+		"block_id":        b.id,
+		"compression":     b.compression,
+		"size":            len(data),
+		"compressed_size": len(body),
+	})
+	return nil
+}
+
+// dictCompressor returns the compressor of the dictionary bound to Block and the dictionary if it's compressed
+// in zstd, it returns nil if there is none, or the dictionary is unavailable, in which case Block is compressed
+// without it.
+func (b *vsBlock) dictCompressor(ctx context.Context, codec uint8) (compressor, []byte) {
+	b.hmu.Lock()
+	id := b.hm.dictID
+	b.hmu.Unlock()
+	if codec != codecZstd || id == 0 || b.dicts == nil {
+		return nil, nil
+	}
+	cmp, dict, err := b.dicts.compressor(id)
+	if err != nil {
+		log.Warning(ctx, "load the compression dictionary of block failed", map[string]interface{}{
+			log.KeyError:    err,
+			"block_id":      b.id,
+			"dictionary_id": id,
+		})
+		return nil, nil
+	}
+	return cmp, dict
+}
+
+// writeCompressed writes the compressed block to a temporary file, and renames it to the block file once
+// it's synced, so that either file is complete after a crash. The directory is synced after the rename,
+// so that the old file isn't back after a crash once it's replaced. dict is the dictionary data is
+// compressed with if codec is codecZstdDict, it's kept after the index entry. It returns the metadata in the
+// header of the file, the checksum of Block covers the compressed data.
+func (b *vsBlock) writeCompressed(
+	m meta, codec uint8, dict, body, ie []byte, chunks []chunk,
+) (*os.File, headerMeta, error) {
+	tmp := b.path + compressingExt
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, defaultFilePerm)
+	if err != nil {
+		return nil, headerMeta{}, err
+	}
+
+	ieOff := b.dataOffset + int64(len(body))
+	dictOff := ieOff + int64(len(ie))
+	ctOff := dictOff + int64(len(dict))
+	table := make([]byte, len(chunks)*chunkMetaSize+footerSize)
+	for i, c := range chunks {
+		buf := table[i*chunkMetaSize:]
+		binary.LittleEndian.PutUint32(buf, uint32(c.length))
+		binary.LittleEndian.PutUint32(buf[4:], uint32(c.physLength))
+		binary.LittleEndian.PutUint32(buf[8:], c.crc)
+	}
+	footer := table[len(chunks)*chunkMetaSize:]
+	binary.LittleEndian.PutUint64(footer, uint64(ieOff))
+	binary.LittleEndian.PutUint64(footer[8:], uint64(ctOff))
+	binary.LittleEndian.PutUint32(footer[16:], uint32(len(chunks)))
+	binary.LittleEndian.PutUint32(footer[20:], crc32.Checksum(table[:len(chunks)*chunkMetaSize], crc32q))
+
+	b.hmu.Lock()
+	hm := b.hm
+	if codec == codecZstdDict {
+		// The dictionary may be rebound during compression.
+		hm.dictID, _ = dictionary.ID(dict)
+		hm.dictOffset, hm.dictLength, hm.dictCRC = dictOff, uint32(len(dict)), crc32.Checksum(dict, crc32q)
+	}
+	hm.blockSize = ctOff + int64(len(table)) - b.dataOffset
+	hm.blockCRC = crc32.Checksum(body, crc32q)
+	for _, data := range [][]byte{ie, dict, table} {
+		hm.blockCRC = crc32.Update(hm.blockCRC, crc32q, data)
+	}
+	header := encodeMeta(b.encodeHeader(m, codec, m.writeOffset), &hm)
+	b.hmu.Unlock()
+	if _, err = f.WriteAt(header, 0); err == nil {
+		if _, err = f.WriteAt(body, b.dataOffset); err == nil {
+			if _, err = f.WriteAt(ie, ieOff); err == nil {
+				if _, err = f.WriteAt(dict, dictOff); err == nil {
+					_, err = f.WriteAt(table, ctOff)
+				}
+			}
+		}
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err == nil {
+		err = os.Rename(tmp, b.path)
+	}
+	if err == nil {
+		err = syncDir(filepath.Dir(b.path))
+	}
+	if err != nil {
+		if err2 := f.Close(); err2 != nil {
+			return nil, headerMeta{}, errors.Chain(err, err2)
+		}
+		return nil, headerMeta{}, err
+	}
+	return f, hm, nil
+}
+
+// loadCompressed loads the chunk table and indexes of a compressed block, which is always archived.
+func (b *vsBlock) loadCompressed(ctx context.Context) error {
+	if !b.fm.archived {
+		return errCorrupted
+	}
+
+	fi, err := b.f.Stat()
+	if err != nil {
+		return err
+	}
+	size := fi.Size()
+	if size < b.dataOffset+footerSize {
+		return errCorrupted
+	}
+	var footer [footerSize]byte
+	if _, err = b.f.ReadAt(footer[:], size-footerSize); err != nil {
+		return err
+	}
+	ieOff := int64(binary.LittleEndian.Uint64(footer[:]))
+	ctOff := int64(binary.LittleEndian.Uint64(footer[8:]))
+	num := int64(binary.LittleEndian.Uint32(footer[16:]))
+	if ieOff < b.dataOffset || ctOff <= ieOff || ctOff+num*chunkMetaSize+footerSize != size {
+		return errCorrupted
+	}
+
+	table := make([]byte, num*chunkMetaSize)
+	if _, err = b.f.ReadAt(table, ctOff); err != nil {
+		return err
+	}
+	if crc32.Checksum(table, crc32q) != binary.LittleEndian.Uint32(footer[20:]) {
+		return errCorrupted
+	}
+	chunks := make([]chunk, num)
+	off, physOff := b.dataOffset, b.dataOffset
+	for i := range chunks {
+		buf := table[i*chunkMetaSize:]
+		c := chunk{
+			offset:     off,
+			length:     int32(binary.LittleEndian.Uint32(buf)),
+			physOffset: physOff,
+			physLength: int32(binary.LittleEndian.Uint32(buf[4:])),
+			crc:        binary.LittleEndian.Uint32(buf[8:]),
+		}
+		chunks[i] = c
+		off += int64(c.length)
+		physOff += int64(c.physLength)
+	}
+	if physOff != ieOff {
+		return errCorrupted
+	}
+
+	var cmp compressor
+	ieEnd := ctOff
+	if b.codec == codecZstdDict {
+		dict, err2 := b.readDictionary(ieOff, ctOff)
+		if err2 != nil {
+			return err2
+		}
+		if cmp, err = b.dicts.embedded(dict); err != nil {
+			return errors.Chain(errCorrupted, err)
+		}
+		ieEnd = b.hm.dictOffset
+	} else if cmp, err = compressorOf(b.codec); err != nil {
+		return err
+	}
+
+	ie := make([]byte, ieEnd-ieOff)
+	if _, err = b.f.ReadAt(ie, ieOff); err != nil {
+		return err
+	}
+	_, entry, err := b.dec.Unmarshal(ie)
+	if err != nil || ceschema.EntryType(entry) != ceschema.Index {
+		return errCorrupted
+	}
+	indexes, _ := entry.Get(ceschema.IndexesOrdinal).([]index.Index)
+	if int64(len(indexes)) != b.fm.entryNum || (len(indexes) > 0 && indexes[len(indexes)-1].EndOffset() >= off) {
+		return errCorrupted
+	}
+
+	b.indexes = indexes
+	b.indexOffset = off
+	b.indexLength = len(ie)
+	b.fm.writeOffset = off
+	b.actx = appendContext{seq: int64(len(indexes)) + 1, offset: off, archived: 1}
+	b.endCommitted = true
+	b.chunks = chunks
+	b.cmp = cmp
+	return nil
+}
+
+// readDictionary reads the dictionary kept between the index entry at ieOff and the chunk table at ctOff.
+func (b *vsBlock) readDictionary(ieOff, ctOff int64) ([]byte, error) {
+	hm := &b.hm
+	if hm.dictLength == 0 || hm.dictOffset <= ieOff || hm.dictOffset+int64(hm.dictLength) != ctOff {
+		return nil, errCorrupted
+	}
+	dict := make([]byte, hm.dictLength)
+	if _, err := b.f.ReadAt(dict, hm.dictOffset); err != nil {
+		return nil, err
+	}
+	if crc32.Checksum(dict, crc32q) != hm.dictCRC {
+		return nil, errCorrupted
+	}
+	return dict, nil
+}
+
+// readCompressed reads uncompressed data at off by decompressing chunks covering it.
+func (b *vsBlock) readCompressed(data []byte, off int64) error {
+	i := sort.Search(len(b.chunks), func(i int) bool {
+		return b.chunks[i].offset+int64(b.chunks[i].length) > off
+	})
+	for n := 0; n < len(data); i++ {
+		if i >= len(b.chunks) {
+			return errCorrupted
+		}
+		c := b.chunks[i]
+		buf := make([]byte, c.physLength)
+		if _, err := b.f.ReadAt(buf, c.physOffset); err != nil {
+			return err
+		}
+		if crc32.Checksum(buf, crc32q) != c.crc {
+			return errCorrupted
+		}
+		plain, err := b.cmp.decompress(buf, int(c.length))
+		if err != nil {
+			return errors.Chain(errCorrupted, err)
+		}
+		if len(plain) != int(c.length) {
+			return errCorrupted
+		}
+		n += copy(data[n:], plain[off+int64(n)-c.offset:])
+	}
+	return nil
+}
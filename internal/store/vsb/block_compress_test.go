@@ -0,0 +1,176 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+	"github.com/linkall-labs/vanus/internal/store/vsb/dictionary"
+	dicttest "github.com/linkall-labs/vanus/internal/store/vsb/dictionary/testing"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+func createArchivedFile() string {
+	f, err := os.CreateTemp("", "*.vsb")
+	So(err, ShouldBeNil)
+	_, err = f.WriteAt(vsbtest.ArchivedHeaderData, 0)
+	So(err, ShouldBeNil)
+	_, err = f.WriteAt(vsbtest.EntryData0, vsbtest.EntryOffset0)
+	So(err, ShouldBeNil)
+	_, err = f.WriteAt(vsbtest.EntryData1, vsbtest.EntryOffset1)
+	So(err, ShouldBeNil)
+	_, err = f.WriteAt(vsbtest.EndEntryData, vsbtest.EndEntryOffset)
+	So(err, ShouldBeNil)
+	_, err = f.WriteAt(vsbtest.IndexEntryData, vsbtest.IndexEntryOffset)
+	So(err, ShouldBeNil)
+	So(f.Close(), ShouldBeNil)
+	return f.Name()
+}
+
+func TestVSBlock_Compress(t *testing.T) {
+	Convey("compress archived vsb", t, func() {
+		ctx := context.Background()
+
+		// entries of the test are too small to save space.
+		saving := minCompressionSaving
+		minCompressionSaving = -1
+		defer func() {
+			minCompressionSaving = saving
+		}()
+
+		for _, c := range []Compression{CompressionSnappy, CompressionZstd} {
+			path := createArchivedFile()
+			b := &vsBlock{path: path, compression: c}
+			So(b.Open(ctx), ShouldBeNil)
+			snap0, err := b.Snapshot(ctx)
+			So(err, ShouldBeNil)
+
+			b.compressArchived(ctx)
+			codec, _ := c.codec()
+			So(b.codec, ShouldEqual, codec)
+			So(b.chunks, ShouldHaveLength, 1)
+
+			check := func(b *vsBlock) {
+				entries, err := b.Read(ctx, 0, 3, 0)
+				So(err, ShouldBeNil)
+				So(entries, ShouldHaveLength, 2)
+				cetest.CheckEntry0(entries[0], false, false)
+				cetest.CheckEntry1(entries[1], false, false)
+
+				entries, err = b.Read(ctx, 1, 1, 0)
+				So(err, ShouldBeNil)
+				So(entries, ShouldHaveLength, 1)
+				cetest.CheckEntry1(entries[0], false, false)
+
+				snap, err := b.Snapshot(ctx)
+				So(err, ShouldBeNil)
+				So(snap.Payload(), ShouldResemble, snap0.Payload())
+			}
+			check(b)
+			So(b.Close(ctx), ShouldBeNil)
+
+			// the compressed block is recognized by the codec in the header.
+			b = &vsBlock{path: path}
+			So(b.Open(ctx), ShouldBeNil)
+			So(b.codec, ShouldEqual, codec)
+			stat := b.status()
+			So(stat.Archived, ShouldBeTrue)
+			So(stat.EntryNum, ShouldEqual, 2)
+			So(stat.EntrySize, ShouldEqual, vsbtest.EntrySize0+vsbtest.EntrySize1)
+			check(b)
+
+			// it isn't compressed again.
+			b.compression = c
+			b.compressArchived(ctx)
+			So(b.Close(ctx), ShouldBeNil)
+
+			_, err = os.Stat(path + compressingExt)
+			So(os.IsNotExist(err), ShouldBeTrue)
+			So(os.Remove(path), ShouldBeNil)
+		}
+	})
+}
+
+func TestVSBlock_CompressWithDictionary(t *testing.T) {
+	Convey("compress archived vsb with the dictionary", t, func() {
+		ctx := context.Background()
+		dir := t.TempDir()
+		dicts, err := newDictionaries(dir)
+		So(err, ShouldBeNil)
+
+		dict := dicttest.Dictionary
+		id, _ := dictionary.ID(dict)
+
+		saving := minCompressionSaving
+		minCompressionSaving = -1
+		defer func() {
+			minCompressionSaving = saving
+		}()
+
+		path := createArchivedFile()
+		defer func() {
+			_ = os.Remove(path)
+		}()
+		b := &vsBlock{path: path, compression: CompressionZstd, dicts: dicts}
+		So(b.Open(ctx), ShouldBeNil)
+		So(b.UseDictionary(ctx, dict), ShouldBeNil)
+		So(b.hm.dictID, ShouldEqual, id)
+		_, err = os.Stat(dicts.resolvePath(id))
+		So(err, ShouldBeNil)
+
+		b.compressArchived(ctx)
+		So(b.codec, ShouldEqual, codecZstdDict)
+		So(b.Close(ctx), ShouldBeNil)
+
+		// the block keeps the dictionary, so it's readable without dictionaries of the engine.
+		So(os.RemoveAll(dir), ShouldBeNil)
+		b = &vsBlock{path: path}
+		So(b.Open(ctx), ShouldBeNil)
+		So(b.codec, ShouldEqual, codecZstdDict)
+		So(b.hm.dictID, ShouldEqual, id)
+		So(b.hm.dictLength, ShouldEqual, len(dict))
+		entries, err := b.Read(ctx, 0, 3, 0)
+		So(err, ShouldBeNil)
+		So(entries, ShouldHaveLength, 2)
+		cetest.CheckEntry0(entries[0], false, false)
+		cetest.CheckEntry1(entries[1], false, false)
+
+		// the compressed block isn't bound to another dictionary.
+		dict2, err := dictionary.SetID(dict, dictionary.MinID+1)
+		So(err, ShouldBeNil)
+		b.dicts, err = newDictionaries(dir)
+		So(err, ShouldBeNil)
+		So(b.UseDictionary(ctx, dict2), ShouldBeNil)
+		So(b.hm.dictID, ShouldEqual, id)
+		So(b.Close(ctx), ShouldBeNil)
+
+		// it can't be opened once the dictionary is corrupted.
+		f, err := os.OpenFile(path, os.O_RDWR, 0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt([]byte{0}, b.hm.dictOffset+int64(len(dict))-1)
+		So(err, ShouldBeNil)
+		So(f.Close(), ShouldBeNil)
+		b = &vsBlock{path: path}
+		So(b.Open(ctx), ShouldNotBeNil)
+	})
+}
@@ -19,8 +19,13 @@ import (
 	"context"
 	"encoding/binary"
 	"hash/crc32"
+	"io"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
 
 	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block/raw"
 )
 
@@ -34,11 +39,36 @@ const (
 	breakFlagsOffset  = 12
 	dataOffsetOffset  = 16
 	stateOffset       = 20
+	versionOffset     = 21
 	indexSizeOffset   = 22
 	capacityOffset    = 24
 	entryLengthOffset = 32
 	entryNumOffset    = 40
 	indexOffsetOffset = 44
+	metaLengthOffset  = 46
+	metaCRCOffset     = 48
+	metaOffset        = 52
+
+	// headerVersion1 is the version of headers without metadata, the version byte was reserved in them.
+	headerVersion1 = 0
+	headerVersion2 = 1
+
+	metaEntryHeaderSize = 2 + 2
+	maxMetaLength       = headerBlockSize - metaOffset
+
+	metaTagChecksum   uint16 = 1
+	metaTagCreatedAt  uint16 = 2
+	metaTagEventlogID uint16 = 3
+	metaTagEpoch      uint16 = 4
+	metaTagDictionary uint16 = 5
+	// metaTagDictionaryData locates the dictionary kept in a compressed block, which can't be read without it.
+	metaTagDictionaryData        = 6 | metaTagRequired
+	metaTagBlockChecksum  uint16 = 7
+	// metaTagRequired is set in tags of metadata which can't be ignored, a block with unknown required
+	// metadata isn't opened.
+	metaTagRequired uint16 = 0x8000
+
+	checksumCRC32C uint8 = 1
 )
 
 var (
@@ -46,41 +76,263 @@ var (
 	emptyHeader = make([]byte, headerBlockSize)
 )
 
+// headerMeta is the metadata of Block kept in the v2 header. The compression codec isn't in it, but in
+// flags, so that versions unaware of compression refuse compressed blocks.
+type headerMeta struct {
+	// createdAt is the millisecond timestamp when Block is created, it's 0 if Block is migrated from v1.
+	createdAt  int64
+	eventlogID vanus.ID
+	// epoch is the latest write lease epoch granted to the replica.
+	epoch uint64
+	// dictID is the ID of the zstd dictionary of the eventbus, data is compressed with it if the codec is
+	// codecZstdDict.
+	dictID uint32
+	// dictOffset, dictLength and dictCRC locate the dictionary kept in Block if the codec is codecZstdDict.
+	dictOffset int64
+	dictLength uint32
+	dictCRC    uint32
+	// blockSize and blockCRC are the size and the checksum of data after the header block once Block is
+	// archived, blockSize is 0 if Block is working, or it's archived before checksums are kept.
+	blockSize int64
+	blockCRC  uint32
+	// unknown keeps metadata of newer versions as it is, so that it isn't lost once the header is persisted.
+	unknown []byte
+}
+
+func (hm *headerMeta) appendTo(buf []byte) []byte {
+	// Checksums of packets and chunks are always CRC-32c for now.
+	buf = appendMetaEntry(buf, metaTagChecksum, []byte{checksumCRC32C})
+	if hm.createdAt != 0 {
+		buf = appendMetaUint64(buf, metaTagCreatedAt, uint64(hm.createdAt))
+	}
+	if hm.eventlogID != 0 {
+		buf = appendMetaUint64(buf, metaTagEventlogID, hm.eventlogID.Uint64())
+	}
+	if hm.epoch != 0 {
+		buf = appendMetaUint64(buf, metaTagEpoch, hm.epoch)
+	}
+	if hm.dictID != 0 {
+		var v [4]byte
+		binary.LittleEndian.PutUint32(v[:], hm.dictID)
+		buf = appendMetaEntry(buf, metaTagDictionary, v[:])
+	}
+	if hm.dictLength != 0 {
+		var v [8 + 4 + 4]byte
+		binary.LittleEndian.PutUint64(v[:], uint64(hm.dictOffset))
+		binary.LittleEndian.PutUint32(v[8:], hm.dictLength)
+		binary.LittleEndian.PutUint32(v[12:], hm.dictCRC)
+		buf = appendMetaEntry(buf, metaTagDictionaryData, v[:])
+	}
+	if hm.blockSize != 0 {
+		var v [8 + 4]byte
+		binary.LittleEndian.PutUint64(v[:], uint64(hm.blockSize))
+		binary.LittleEndian.PutUint32(v[8:], hm.blockCRC)
+		buf = appendMetaEntry(buf, metaTagBlockChecksum, v[:])
+	}
+	if len(buf)+len(hm.unknown) > headerBlockSize {
+		// Drop unknown metadata rather than overwrite data.
+		return buf
+	}
+	return append(buf, hm.unknown...)
+}
+
+func appendMetaEntry(buf []byte, tag uint16, value []byte) []byte {
+	var head [metaEntryHeaderSize]byte
+	binary.LittleEndian.PutUint16(head[0:], tag)
+	binary.LittleEndian.PutUint16(head[2:], uint16(len(value)))
+	return append(append(buf, head[:]...), value...)
+}
+
+func appendMetaUint64(buf []byte, tag uint16, value uint64) []byte {
+	var v [8]byte
+	binary.LittleEndian.PutUint64(v[:], value)
+	return appendMetaEntry(buf, tag, v[:])
+}
+
+func (hm *headerMeta) decode(buf []byte) error {
+	for len(buf) != 0 {
+		if len(buf) < metaEntryHeaderSize {
+			return errCorrupted
+		}
+		tag := binary.LittleEndian.Uint16(buf[0:])
+		sz := metaEntryHeaderSize + int(binary.LittleEndian.Uint16(buf[2:]))
+		if len(buf) < sz {
+			return errCorrupted
+		}
+		value := buf[metaEntryHeaderSize:sz]
+		switch tag {
+		case metaTagChecksum:
+			if len(value) != 1 {
+				return errCorrupted
+			}
+			if value[0] != checksumCRC32C {
+				return raw.ErrInvalidFormat
+			}
+		case metaTagCreatedAt, metaTagEventlogID, metaTagEpoch:
+			if len(value) != 8 {
+				return errCorrupted
+			}
+			v := binary.LittleEndian.Uint64(value)
+			switch tag {
+			case metaTagCreatedAt:
+				hm.createdAt = int64(v)
+			case metaTagEventlogID:
+				hm.eventlogID = vanus.NewIDFromUint64(v)
+			default:
+				hm.epoch = v
+			}
+		case metaTagDictionary:
+			if len(value) != 4 {
+				return errCorrupted
+			}
+			hm.dictID = binary.LittleEndian.Uint32(value)
+		case metaTagDictionaryData:
+			if len(value) != 8+4+4 {
+				return errCorrupted
+			}
+			hm.dictOffset = int64(binary.LittleEndian.Uint64(value))
+			hm.dictLength = binary.LittleEndian.Uint32(value[8:])
+			hm.dictCRC = binary.LittleEndian.Uint32(value[12:])
+		case metaTagBlockChecksum:
+			if len(value) != 8+4 {
+				return errCorrupted
+			}
+			hm.blockSize = int64(binary.LittleEndian.Uint64(value))
+			hm.blockCRC = binary.LittleEndian.Uint32(value[8:])
+		default:
+			if tag&metaTagRequired != 0 {
+				return raw.ErrInvalidFormat
+			}
+			hm.unknown = append(hm.unknown, buf[:sz]...)
+		}
+		buf = buf[sz:]
+	}
+	return nil
+}
+
 func (b *vsBlock) persistHeader(ctx context.Context, m meta) error {
-	var buf [headerSize]byte
+	b.hmu.Lock()
+	defer b.hmu.Unlock()
+
+	buf := b.encodeHeader(m, b.codec, b.indexOffset)
+	if _, err := b.f.WriteAt(buf, 0); err != nil {
+		return err
+	}
+	if b.buffered {
+		if err := b.f.Sync(); err != nil {
+			return err
+		}
+	}
+
+	b.mu.Lock()
+	b.fm = m
+	b.mu.Unlock()
+
+	return nil
+}
+
+// RecordOrigin keeps the eventlog and the write lease epoch in the header, zero values leave recorded ones
+// unchanged. The header isn't persisted if nothing changes.
+func (b *vsBlock) RecordOrigin(ctx context.Context, eventlogID vanus.ID, epoch uint64) error {
+	b.hmu.Lock()
+	if eventlogID == 0 {
+		eventlogID = b.hm.eventlogID
+	}
+	if epoch == 0 {
+		epoch = b.hm.epoch
+	}
+	if b.hm.eventlogID == eventlogID && b.hm.epoch == epoch {
+		b.hmu.Unlock()
+		return nil
+	}
+	b.hm.eventlogID = eventlogID
+	b.hm.epoch = epoch
+	b.hmu.Unlock()
+
+	b.mu.RLock()
+	m := b.fm
+	b.mu.RUnlock()
+	return b.persistHeader(ctx, m)
+}
+
+// UseDictionary binds the zstd dictionary to Block, it's used once Block is compressed in zstd. The header
+// isn't persisted if the dictionary is bound already, or Block is compressed.
+func (b *vsBlock) UseDictionary(ctx context.Context, dict []byte) error {
+	if b.dicts == nil {
+		return nil
+	}
+	b.fmu.RLock()
+	compressed := b.codec != codecNone
+	b.fmu.RUnlock()
+	if compressed {
+		return nil
+	}
+	id, err := b.dicts.register(dict)
+	if err != nil {
+		return err
+	}
+
+	b.hmu.Lock()
+	if b.hm.dictID == id {
+		b.hmu.Unlock()
+		return nil
+	}
+	b.hm.dictID = id
+	b.hmu.Unlock()
+
+	b.mu.RLock()
+	m := b.fm
+	b.mu.RUnlock()
+	return b.persistHeader(ctx, m)
+}
+
+// encodeHeader encodes the header of meta, codec is the compression codec of data, which is kept in flags.
+// The caller must hold hmu.
+func (b *vsBlock) encodeHeader(m meta, codec uint8, indexOffset int64) []byte {
+	buf := make([]byte, metaOffset, headerBlockSize)
 	binary.LittleEndian.PutUint32(buf[magicOffset:], FormatMagic)               // magic
-	binary.LittleEndian.PutUint32(buf[flagsOffset:], 0)                         // flags
+	binary.LittleEndian.PutUint32(buf[flagsOffset:], uint32(codec))             // flags
 	binary.LittleEndian.PutUint32(buf[breakFlagsOffset:], 0)                    // break flags
 	binary.LittleEndian.PutUint32(buf[dataOffsetOffset:], uint32(b.dataOffset)) // data offset
 	if m.archived {                                                             // state
 		buf[stateOffset] = 1
 	}
+	buf[versionOffset] = headerVersion2                                           // version
 	binary.LittleEndian.PutUint16(buf[indexSizeOffset:], b.indexSize)             // index size
 	binary.LittleEndian.PutUint64(buf[capacityOffset:], uint64(b.capacity))       // capacity
 	binary.LittleEndian.PutUint64(buf[entryLengthOffset:], uint64(m.entryLength)) // entry length
 	binary.LittleEndian.PutUint32(buf[entryNumOffset:], uint32(m.entryNum))       // entry number
-	if eo := b.dataOffset + m.entryLength; b.indexOffset > eo {                   // index offset
-		off := b.indexOffset - eo
+	if eo := b.dataOffset + m.entryLength; indexOffset > eo {                     // index offset
+		off := indexOffset - eo
 		binary.LittleEndian.PutUint16(buf[indexOffsetOffset:], uint16(off))
 	}
-	crc := crc32.Checksum(buf[flagsOffset:], crc32q)
-	crc = crc32.Update(crc, crc32q, emptyHeader[headerSize:])
-	binary.LittleEndian.PutUint32(buf[crcOffset:], crc) // crc
+	binary.LittleEndian.PutUint32(buf[crcOffset:], fixedHeaderCRC(buf)) // crc
+	return encodeMeta(buf, &b.hm)
+}
 
-	if _, err := b.f.WriteAt(buf[:], 0); err != nil {
-		return err
-	}
+// fixedHeaderCRC is the checksum of fixed fields, the remainder of the header block is treated as zeros as
+// v1 does, so that v1 readers can still open blocks with v2 headers.
+func fixedHeaderCRC(buf []byte) uint32 {
+	crc := crc32.Checksum(buf[flagsOffset:headerSize], crc32q)
+	return crc32.Update(crc, crc32q, emptyHeader[headerSize:])
+}
 
-	b.mu.Lock()
-	b.fm = m
-	b.mu.Unlock()
+// encodeMeta appends metadata to fixed fields in buf, metadata is checked by its own checksum.
+func encodeMeta(buf []byte, hm *headerMeta) []byte {
+	buf = hm.appendTo(buf[:metaOffset])
+	binary.LittleEndian.PutUint16(buf[metaLengthOffset:], uint16(len(buf)-metaOffset)) // metadata length
+	binary.LittleEndian.PutUint32(buf[metaCRCOffset:], metaCRC(buf))                   // metadata crc
+	return buf
+}
 
-	return nil
+func metaCRC(buf []byte) uint32 {
+	crc := crc32.Checksum(buf[metaLengthOffset:metaCRCOffset], crc32q)
+	return crc32.Update(crc, crc32q, buf[metaOffset:])
 }
 
 func (b *vsBlock) loadHeader(ctx context.Context) error {
-	var buf [headerSize]byte
-	if _, err := b.f.ReadAt(buf[:], 0); err != nil {
+	buf := make([]byte, headerBlockSize)
+	if n, err := b.f.ReadAt(buf, 0); err != nil && (err != io.EOF || n < headerSize) {
 		return err
 	}
 
@@ -89,6 +341,12 @@ func (b *vsBlock) loadHeader(ctx context.Context) error {
 		return raw.ErrInvalidFormat
 	}
 
+	flags := binary.LittleEndian.Uint32(buf[flagsOffset:])
+	if flags > uint32(codecZstdDict) {
+		return raw.ErrInvalidFormat
+	}
+	b.codec = uint8(flags) // codec
+
 	breakFlags := binary.LittleEndian.Uint32(buf[breakFlagsOffset:])
 	if breakFlags != 0 {
 		return errIncomplete
@@ -101,12 +359,57 @@ func (b *vsBlock) loadHeader(ctx context.Context) error {
 	b.fm.entryLength = int64(binary.LittleEndian.Uint64(buf[entryLengthOffset:])) // entry length
 	b.fm.entryNum = int64(binary.LittleEndian.Uint32(buf[entryNumOffset:]))       // entry number
 
-	origin := binary.LittleEndian.Uint32(buf[crcOffset:])
-	crc := crc32.Checksum(buf[flagsOffset:], crc32q)
-	crc = crc32.Update(crc, crc32q, emptyHeader[headerSize:])
-	if origin != crc {
+	if binary.LittleEndian.Uint32(buf[crcOffset:]) != fixedHeaderCRC(buf) {
+		return errCorrupted
+	}
+
+	switch version := buf[versionOffset]; version {
+	case headerVersion1:
+		return b.migrateHeader(ctx, buf)
+	case headerVersion2:
+		return b.loadMeta(buf)
+	default:
+		return raw.ErrInvalidFormat
+	}
+}
+
+func (b *vsBlock) loadMeta(buf []byte) error {
+	length := int(binary.LittleEndian.Uint16(buf[metaLengthOffset:]))
+	if length > maxMetaLength {
+		return errCorrupted
+	}
+	buf = buf[:metaOffset+length]
+	if binary.LittleEndian.Uint32(buf[metaCRCOffset:]) != metaCRC(buf) {
 		return errCorrupted
 	}
 
+	hm := headerMeta{}
+	if err := hm.decode(buf[metaOffset:]); err != nil {
+		return err
+	}
+	b.hm = hm
+	return nil
+}
+
+// migrateHeader upgrades a v1 header to v2 in place. Fixed fields are kept as they are on disk, instead of
+// being encoded from Block, since some of them, e.g. the index offset of compressed blocks, aren't loaded.
+func (b *vsBlock) migrateHeader(ctx context.Context, buf []byte) error {
+	b.hm = headerMeta{}
+
+	buf[versionOffset] = headerVersion2
+	binary.LittleEndian.PutUint32(buf[crcOffset:], fixedHeaderCRC(buf))
+	buf = encodeMeta(buf, &b.hm)
+	if _, err := b.f.WriteAt(buf, 0); err != nil {
+		return err
+	}
+	if b.buffered {
+		if err := b.f.Sync(); err != nil {
+			return err
+		}
+	}
+
+	log.Info(ctx, "the header of block is migrated to v2", map[string]interface{}{
+		"block_id": b.id,
+	})
 	return nil
 }
@@ -0,0 +1,142 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/binary"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block/raw"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+func TestVSBlock_Header(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("block header", t, func() {
+		f, err := os.CreateTemp("", "*.vsb")
+		So(err, ShouldBeNil)
+
+		defer func() {
+			So(os.Remove(f.Name()), ShouldBeNil)
+		}()
+
+		_, err = f.WriteAt(vsbtest.ArchivedHeaderData, 0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EntryData0, vsbtest.EntryOffset0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EntryData1, vsbtest.EntryOffset1)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EndEntryData, vsbtest.EndEntryOffset)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.IndexEntryData, vsbtest.IndexEntryOffset)
+		So(err, ShouldBeNil)
+		So(f.Close(), ShouldBeNil)
+
+		open := func() *vsBlock {
+			b := &vsBlock{
+				path: f.Name(),
+			}
+			So(b.Open(ctx), ShouldBeNil)
+			return b
+		}
+		readHeader := func() []byte {
+			data, err2 := os.ReadFile(f.Name())
+			So(err2, ShouldBeNil)
+			return data[:headerBlockSize]
+		}
+
+		Convey("migrate v1 header", func() {
+			b := open()
+			So(b.f.Close(), ShouldBeNil)
+
+			buf := readHeader()
+			So(buf[:len(vsbtest.ArchivedHeaderDataV2)], ShouldResemble, vsbtest.ArchivedHeaderDataV2)
+
+			// Readers of v1 check fixed fields as if the remainder of the header block is empty.
+			crc := binary.LittleEndian.Uint32(buf[crcOffset:])
+			So(crc, ShouldEqual, fixedHeaderCRC(buf))
+
+			b = open()
+			So(b.hm, ShouldResemble, headerMeta{})
+			So(b.f.Close(), ShouldBeNil)
+			So(readHeader(), ShouldResemble, buf)
+		})
+
+		Convey("record origin", func() {
+			b := open()
+			logID := vanus.NewTestID()
+			So(b.RecordOrigin(ctx, logID, 0), ShouldBeNil)
+			So(b.RecordOrigin(ctx, 0, 3), ShouldBeNil)
+			So(b.f.Close(), ShouldBeNil)
+
+			b = open()
+			So(b.hm.eventlogID, ShouldEqual, logID)
+			So(b.hm.epoch, ShouldEqual, 3)
+			So(b.fm.archived, ShouldBeTrue)
+			So(b.fm.entryNum, ShouldEqual, 2)
+			So(b.f.Close(), ShouldBeNil)
+		})
+
+		Convey("keep unknown metadata", func() {
+			b := open()
+			b.hm.unknown = appendMetaUint64(nil, 0x100, 42)
+			So(b.persistHeader(ctx, b.fm), ShouldBeNil)
+			So(b.f.Close(), ShouldBeNil)
+
+			b = open()
+			So(b.hm.unknown, ShouldResemble, appendMetaUint64(nil, 0x100, 42))
+			So(b.RecordOrigin(ctx, vanus.NewTestID(), 1), ShouldBeNil)
+			So(b.f.Close(), ShouldBeNil)
+
+			b = open()
+			So(b.hm.unknown, ShouldResemble, appendMetaUint64(nil, 0x100, 42))
+			So(b.f.Close(), ShouldBeNil)
+		})
+
+		Convey("refuse unknown required metadata", func() {
+			b := open()
+			b.hm.unknown = appendMetaUint64(nil, 0x100|metaTagRequired, 42)
+			So(b.persistHeader(ctx, b.fm), ShouldBeNil)
+			So(b.f.Close(), ShouldBeNil)
+
+			b = &vsBlock{
+				path: f.Name(),
+			}
+			So(b.Open(ctx), ShouldEqual, raw.ErrInvalidFormat)
+		})
+
+		Convey("detect corrupted metadata", func() {
+			b := open()
+			So(b.RecordOrigin(ctx, vanus.NewTestID(), 1), ShouldBeNil)
+			_, err = b.f.WriteAt([]byte{0xFF}, metaOffset+metaEntryHeaderSize*2+1)
+			So(err, ShouldBeNil)
+			So(b.f.Close(), ShouldBeNil)
+
+			b = &vsBlock{
+				path: f.Name(),
+			}
+			So(b.Open(ctx), ShouldEqual, errCorrupted)
+		})
+	})
+}
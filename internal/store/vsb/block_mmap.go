@@ -0,0 +1,101 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"sync"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+// mapping is a read-only memory map of an archived block, whose entries are immutable, so that reads copy
+// data from it without a syscall. Reads fall back to ReadAt if mapping failed, e.g. mmap isn't supported.
+type mapping struct {
+	// enabled is set when Block is opened, and never changes.
+	enabled bool
+
+	mu     sync.RWMutex
+	data   []byte
+	failed bool
+	closed bool
+}
+
+// readMapped copies data at off from the memory map, the map is created by the first read after Block is
+// archived. It returns false if data should be read from the file.
+func (b *vsBlock) readMapped(ctx context.Context, data []byte, off int64) bool {
+	if !b.mmap.enabled {
+		return false
+	}
+
+	b.mmap.mu.RLock()
+	mapped := b.mmap.data
+	ok := mapped != nil && off+int64(len(data)) <= int64(len(mapped))
+	if ok {
+		copy(data, mapped[off:])
+	}
+	b.mmap.mu.RUnlock()
+
+	if ok || mapped != nil || !b.mapArchived(ctx) {
+		return ok
+	}
+	return b.readMapped(ctx, data, off)
+}
+
+// mapArchived maps the file up to the end of entries, it returns true if the map is created.
+func (b *vsBlock) mapArchived(ctx context.Context) bool {
+	b.mu.RLock()
+	archived := b.endCommitted
+	var end int64
+	if len(b.indexes) > 0 {
+		end = b.lastIndex().EndOffset()
+	}
+	b.mu.RUnlock()
+	if !archived || end <= 0 {
+		return false
+	}
+
+	b.mmap.mu.Lock()
+	defer b.mmap.mu.Unlock()
+	if b.mmap.data != nil || b.mmap.failed || b.mmap.closed {
+		return false
+	}
+	data, err := mmapFile(b.f, end)
+	if err != nil {
+		b.mmap.failed = true
+		log.Warning(ctx, "map the archived block failed, read it from the file instead", map[string]interface{}{
+			log.KeyError: err,
+			"block_id":   b.id,
+		})
+		return false
+	}
+	b.mmap.data = data
+	return true
+}
+
+// unmap releases the memory map, it waits for reads copying from it.
+func (b *vsBlock) unmap() error {
+	b.mmap.mu.Lock()
+	defer b.mmap.mu.Unlock()
+	b.mmap.closed = true
+	if b.mmap.data == nil {
+		return nil
+	}
+	data := b.mmap.data
+	b.mmap.data = nil
+	return munmapFile(data)
+}
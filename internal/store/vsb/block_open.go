@@ -43,14 +43,18 @@ func (b *vsBlock) Open(ctx context.Context) error {
 	}
 
 	// TODO(james.yin): use direct IO
-	f, err := os.OpenFile(b.path, os.O_RDWR|os.O_SYNC, 0)
+	f, err := os.OpenFile(b.path, openFlag(b.buffered), 0)
 	if err != nil {
 		return err
 	}
 	b.f = f
 
-	if err = b.init(ctx); err != nil {
-		if err2 := f.Close(); err2 != nil {
+	if err = b.init(ctx); err == nil {
+		err = b.openWAL(ctx)
+	}
+	if err != nil {
+		// Repairing may have replaced the file.
+		if err2 := b.f.Close(); err2 != nil {
 			return errors.Chain(err, err2)
 		}
 		b.f = nil
@@ -60,27 +64,52 @@ func (b *vsBlock) Open(ctx context.Context) error {
 	return nil
 }
 
+// openFlag returns the flag of opening block files, they are synced by the io engine if they are buffered.
+func openFlag(buffered bool) int {
+	if buffered {
+		return os.O_RDWR
+	}
+	return os.O_RDWR | os.O_SYNC
+}
+
 func (b *vsBlock) init(ctx context.Context) error {
 	if err := b.loadHeader(ctx); err != nil {
 		return err
 	}
 
 	b.enc = codec.NewEncoder()
-	if dec, err := codec.NewDecoder(false, int(b.indexSize)); err == nil {
+	// Checksums are validated, so that the scan of entries stops at a torn write instead of indexing it.
+	if dec, err := codec.NewDecoder(true, int(b.indexSize)); err == nil {
 		b.dec = dec
 	} else {
 		return err
 	}
 
-	if err := b.repairMeta(); err != nil {
-		return err
+	if b.codec != codecNone {
+		if err := b.loadCompressed(ctx); err != nil {
+			return err
+		}
+		b.sparsify(ctx)
+		return b.verifyChecksum(ctx)
 	}
 
-	if err := b.validate(ctx); err != nil {
+	err := b.repairMeta()
+	if err == nil {
+		err = b.validate(ctx)
+	}
+	if err != nil {
+		if b.repair && stderr.Is(err, errCorrupted) {
+			b.walReplayed = 0
+			if err = b.repairIndexes(ctx, err); err != nil {
+				return err
+			}
+			return b.wipeTornTail(ctx)
+		}
 		return err
 	}
 
-	return nil
+	b.sparsify(ctx)
+	return b.verifyChecksum(ctx)
 }
 
 func (b *vsBlock) repairMeta() error {
@@ -92,8 +121,15 @@ func (b *vsBlock) repairMeta() error {
 	var err error
 	var n, en int
 
+	// Entries logged in the WAL needn't be scanned again.
+	indexes := b.replayWAL()
+	b.walReplayed = len(indexes)
+	if sz := len(indexes); sz != 0 {
+		off = indexes[sz-1].EndOffset()
+		seq = int64(sz)
+	}
+
 	// Scan entries.
-	indexes := make([]index.Index, 0)
 	// Note: use math.MaxInt64-off to avoid overflow.
 	r := io.NewSectionReader(b.f, off, math.MaxInt64-off)
 	if full {
@@ -163,11 +199,21 @@ SET_META:
 	if full {
 		b.actx.archived = 1
 	}
+	b.endCommitted = full
 
 	return nil
 }
 
 func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
+	// Indexes replayed from the WAL lead tail, no entry is left before them.
+	if b.walReplayed != 0 {
+		if len(tail) != num {
+			return errCorrupted
+		}
+		b.indexes = tail
+		return nil
+	}
+
 	indexes := make([]index.Index, 0, num)
 
 	// Scan entries.
@@ -188,6 +234,7 @@ func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
 
 		idx := index.NewIndex(off, int32(n), index.WithEntry(entry))
 		indexes = append(indexes, idx)
+		off += int64(n)
 	}
 
 	if len(indexes)+len(tail) != num {
@@ -199,9 +246,44 @@ func (b *vsBlock) rebuildIndexes(num int, tail []index.Index) error {
 	return nil
 }
 
+// validate checks that the recovered indexes cover the data region contiguously and agree with the header
+// and the append context, then wipes the torn tail left by a crash and brings the header up to date.
 func (b *vsBlock) validate(ctx context.Context) error {
-	if len(b.indexes) < int(b.fm.entryNum) {
+	num := int64(len(b.indexes))
+	if num < b.fm.entryNum {
+		return errCorrupted
+	}
+
+	end := b.dataOffset
+	for _, idx := range b.indexes {
+		if idx.StartOffset() != end || idx.Length() <= 0 {
+			return errCorrupted
+		}
+		end = idx.EndOffset()
+	}
+	if end-b.dataOffset < b.fm.entryLength {
 		return errCorrupted
 	}
+	if b.actx.Archived() {
+		// The end entry follows the last entry.
+		if b.actx.seq != num+1 || b.actx.offset <= end {
+			return errCorrupted
+		}
+	} else if b.actx.seq != num || b.actx.offset != end {
+		return errCorrupted
+	}
+
+	if err := b.wipeTornTail(ctx); err != nil {
+		return err
+	}
+
+	// The header is persisted lazily, so it usually lags behind entries after a crash.
+	if m, _ := makeSnapshot(b.actx, b.indexes); m != b.fm {
+		if m.archived && b.indexLength != 0 && b.hm.blockSize == 0 {
+			// Block was sealed right before the crash.
+			b.keepChecksum(ctx, b.indexOffset+int64(b.indexLength))
+		}
+		return b.persistHeader(ctx, m)
+	}
 	return nil
 }
@@ -149,4 +149,56 @@ func TestVSBlock_Open(t *testing.T) {
 		idxtest.CheckIndex0(b.indexes[0], false)
 		idxtest.CheckIndex1(b.indexes[1], false)
 	})
+	Convey("open working vsb with torn tail", t, func() {
+		f, err := os.CreateTemp("", "*.vsb")
+		So(err, ShouldBeNil)
+
+		defer func() {
+			err = os.Remove(f.Name())
+			So(err, ShouldBeNil)
+		}()
+
+		_, err = f.WriteAt(vsbtest.EmptyHeaderData, 0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EntryData0, vsbtest.EntryOffset0)
+		So(err, ShouldBeNil)
+		// Only the first half of entry 1 is written.
+		_, err = f.WriteAt(vsbtest.EntryData1[:vsbtest.EntrySize1/2], vsbtest.EntryOffset1)
+		So(err, ShouldBeNil)
+
+		err = f.Close()
+		So(err, ShouldBeNil)
+
+		b := &vsBlock{
+			path: f.Name(),
+		}
+
+		err = b.Open(context.Background())
+		So(err, ShouldBeNil)
+
+		stat := b.status()
+		So(stat.Archived, ShouldBeFalse)
+		So(stat.EntryNum, ShouldEqual, 1)
+		So(stat.EntrySize, ShouldEqual, vsbtest.EntrySize0)
+		So(b.actx.offset, ShouldEqual, vsbtest.EntryOffset1)
+
+		// The header is repaired.
+		So(b.fm.entryNum, ShouldEqual, 1)
+		So(b.fm.entryLength, ShouldEqual, vsbtest.EntrySize0)
+
+		// The torn entry is wiped.
+		buf := make([]byte, vsbtest.EntrySize1/2)
+		_, err = b.f.ReadAt(buf, vsbtest.EntryOffset1)
+		So(err, ShouldBeNil)
+		So(buf, ShouldResemble, make([]byte, vsbtest.EntrySize1/2))
+		So(b.f.Close(), ShouldBeNil)
+
+		b = &vsBlock{
+			path: f.Name(),
+		}
+		So(b.Open(context.Background()), ShouldBeNil)
+		So(b.indexes, ShouldHaveLength, 1)
+		idxtest.CheckIndex0(b.indexes[0], false)
+		So(b.f.Close(), ShouldBeNil)
+	})
 }
@@ -0,0 +1,72 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"sort"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+)
+
+// Make sure block implements block.Prefetcher.
+var _ block.Prefetcher = (*vsBlock)(nil)
+
+// Prefetch advises the kernel to load data of entries from seq to the end of the archived block into the page
+// cache. Blocks which are still appendable are skipped, their data were written recently and are likely cached.
+func (b *vsBlock) Prefetch(ctx context.Context, seq int64) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	b.mu.RLock()
+	archived, num := b.endCommitted, b.committedNum()
+	if !archived || seq < 0 || int(seq) >= num {
+		b.mu.RUnlock()
+		return 0, nil
+	}
+	from, to := b.startOffset(int(seq)), b.lastIndex().EndOffset()
+	b.mu.RUnlock()
+
+	b.fmu.RLock()
+	defer b.fmu.RUnlock()
+	if b.codec != codecNone {
+		from, to = b.physicalRange(from, to)
+	}
+	if to <= from {
+		return 0, nil
+	}
+	if err := adviseWillNeed(b.f, from, to-from); err != nil {
+		return 0, err
+	}
+	return to - from, nil
+}
+
+// physicalRange returns the range in the compressed file which holds data of [from, to).
+func (b *vsBlock) physicalRange(from, to int64) (int64, int64) {
+	i := sort.Search(len(b.chunks), func(i int) bool {
+		return b.chunks[i].offset+int64(b.chunks[i].length) > from
+	})
+	j := sort.Search(len(b.chunks), func(i int) bool {
+		return b.chunks[i].offset >= to
+	})
+	if i >= j {
+		return 0, 0
+	}
+	last := b.chunks[j-1]
+	return b.chunks[i].physOffset, last.physOffset + int64(last.physLength)
+}
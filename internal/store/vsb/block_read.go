@@ -17,48 +17,101 @@ package vsb
 import (
 	// standard libraries.
 	"context"
+	stderr "errors"
+	"sort"
+	"syscall"
 
 	// third-party libraries.
 	"go.opentelemetry.io/otel/trace"
 
 	// first-party libraries.
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
 )
 
+const defaultReadAttempts = 3
+
 // Make sure block implements block.Reader.
 var _ block.Reader = (*vsBlock)(nil)
 
 // Read date from file.
-func (b *vsBlock) Read(ctx context.Context, seq int64, num int) ([]block.Entry, error) {
+func (b *vsBlock) Read(ctx context.Context, seq int64, num int, maxBytes int) ([]block.Entry, error) {
 	span := trace.SpanFromContext(ctx)
 	span.AddEvent("store.vsb.vsBlock.Read() Start")
 	defer span.AddEvent("store.vsb.vsBlock.Read() End")
 
-	from, to, num, err := b.entryRange(int(seq), num)
+	from, to, num, skip, err := b.entryRange(int(seq), num, maxBytes)
 	if err != nil {
 		return nil, err
 	}
 
 	length := int(to - from)
 	data := make([]byte, length)
-	if _, err = b.f.ReadAt(data, from); err != nil {
+	if err = b.readAt(ctx, data, from); err != nil {
 		return nil, err
 	}
 
+	// Entries before seq are read as well if Block is indexed sparsely, they are skipped.
 	entries := make([]block.Entry, 0, num)
-	for so := 0; so < length; {
-		n, entry, _ := b.dec.Unmarshal(data[so:])
-		entries = append(entries, entry)
+	size := 0
+	for so, i := 0, 0; so < length && len(entries) < num; i++ {
+		n, entry, err := b.dec.Unmarshal(data[so:])
+		if err != nil {
+			// Entries are indexed after they were written completely, so the mismatch isn't a torn write.
+			log.Error(ctx, "the entry in block is corrupted", map[string]interface{}{
+				log.KeyError: err,
+				"block_id":   b.id,
+				"seq":        int(seq) - skip + i,
+			})
+			return nil, errors.Chain(block.ErrCorrupted, err)
+		}
 		so += n
+		if i < skip {
+			continue
+		}
+		if maxBytes > 0 && len(entries) != 0 && size+n > maxBytes {
+			break
+		}
+		size += n
+		entries = append(entries, entry)
 	}
 
 	return entries, nil
 }
 
-func (b *vsBlock) entryRange(start, num int) (int64, int64, int, error) {
+// readAt reads data at off. The read isn't issued once ctx is done, which may happen while waiting for the
+// index lock, and transient failures are retried at most defaultReadAttempts times. Archived blocks are read
+// from the memory map if it's enabled, and compressed blocks are decompressed.
+func (b *vsBlock) readAt(ctx context.Context, data []byte, off int64) error {
+	b.fmu.RLock()
+	defer b.fmu.RUnlock()
+	if b.codec != codecNone {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return b.readCompressed(data, off)
+	}
+	if b.readMapped(ctx, data, off) {
+		return ctx.Err()
+	}
+	var err error
+	for i := 0; i < defaultReadAttempts; i++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if _, err = b.f.ReadAt(data, off); !stderr.Is(err, syscall.EAGAIN) && !stderr.Is(err, syscall.EINTR) {
+			return err
+		}
+	}
+	return err
+}
+
+// entryRange returns the range of data to read entries from start, the number of entries and the number of
+// entries before start in the range.
+func (b *vsBlock) entryRange(start, num, maxBytes int) (int64, int64, int, int, error) {
 	// TODO(james.yin): optimize lock.
 	log.Debug(context.Background(), "acquiring index read lock", map[string]interface{}{
 		"block_id": b.id,
@@ -73,19 +126,32 @@ func (b *vsBlock) entryRange(start, num int) (int64, int64, int, error) {
 		b.mu.RUnlock()
 	}()
 
-	sz := len(b.indexes)
+	// Only committed entries are readable.
+	sz := b.committedNum()
 
 	if start >= sz {
-		if start == sz && !b.full() {
-			return -1, -1, 0, block.ErrOnEnd
+		if start == sz && !b.endCommitted {
+			return -1, -1, 0, 0, block.ErrOnEnd
 		}
-		return -1, -1, 0, block.ErrExceeded
+		return -1, -1, 0, 0, block.ErrExceeded
 	}
 
 	end := start + num - 1
 	if end >= sz {
 		end = sz - 1
 	}
+	if b.stride != 0 {
+		from, to, skip := b.sparseRange(start, end, maxBytes)
+		return from, to, end - start + 1, skip, nil
+	}
+	if maxBytes > 0 {
+		// offsets of entries are increasing, so find the last entry which ends within budget.
+		limit := b.indexes[start].StartOffset() + int64(maxBytes)
+		n := sort.Search(end-start, func(i int) bool {
+			return b.indexes[start+i+1].EndOffset() > limit
+		})
+		end = start + n
+	}
 
-	return b.indexes[start].StartOffset(), b.indexes[end].EndOffset(), end - start + 1, nil
+	return b.indexes[start].StartOffset(), b.indexes[end].EndOffset(), end - start + 1, 0, nil
 }
@@ -17,6 +17,7 @@ package vsb
 import (
 	// standard libraries.
 	"context"
+	stderr "errors"
 	"os"
 	"testing"
 
@@ -57,7 +58,7 @@ func TestVSBlock_Read(t *testing.T) {
 		_, err = f.WriteAt(vsbtest.EntryData1, vsbtest.EntryOffset1)
 		So(err, ShouldBeNil)
 
-		dec, _ := codec.NewDecoder(false, codec.IndexSize)
+		dec, _ := codec.NewDecoder(true, codec.IndexSize)
 		b := &vsBlock{
 			dataOffset: dataOffset,
 			actx: appendContext{
@@ -68,33 +69,134 @@ func TestVSBlock_Read(t *testing.T) {
 			f:       f,
 		}
 
-		entries, err := b.Read(context.Background(), 0, 1)
+		entries, err := b.Read(context.Background(), 0, 1, 0)
 		So(err, ShouldBeNil)
 		So(entries, ShouldHaveLength, 1)
 		cetest.CheckEntry0(entries[0], false, false)
 
-		entries, err = b.Read(context.Background(), 0, 3)
+		entries, err = b.Read(context.Background(), 0, 3, 0)
 		So(err, ShouldBeNil)
 		So(entries, ShouldHaveLength, 2)
 		cetest.CheckEntry0(entries[0], false, false)
 		cetest.CheckEntry1(entries[1], false, false)
 
-		entries, err = b.Read(context.Background(), 1, 2)
+		entries, err = b.Read(context.Background(), 1, 2, 0)
 		So(err, ShouldBeNil)
 		So(entries, ShouldHaveLength, 1)
 		cetest.CheckEntry1(entries[0], false, false)
 
-		_, err = b.Read(context.Background(), 2, 1)
+		_, err = b.Read(context.Background(), 2, 1, 0)
 		So(err, ShouldBeError, block.ErrOnEnd)
 
-		_, err = b.Read(context.Background(), 3, 1)
+		Convey("read with max bytes", func() {
+			// only the first entry fits into the budget.
+			entries, err = b.Read(context.Background(), 0, 3, vsbtest.EntrySize0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+			cetest.CheckEntry0(entries[0], false, false)
+
+			// at least one entry is read.
+			entries, err = b.Read(context.Background(), 1, 3, 1)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+			cetest.CheckEntry1(entries[0], false, false)
+
+			entries, err = b.Read(context.Background(), 0, 3, 1<<20)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 2)
+		})
+
+		_, err = b.Read(context.Background(), 3, 1, 0)
 		So(err, ShouldBeError, block.ErrExceeded)
 
 		Convey("after block is full", func() {
 			b.actx.archived = 1
+			b.endCommitted = true
 
-			_, err = b.Read(context.Background(), 2, 1)
+			_, err = b.Read(context.Background(), 2, 1, 0)
 			So(err, ShouldBeError, block.ErrExceeded)
 		})
+
+		Convey("read archived block from memory map", func() {
+			b.actx.archived = 1
+			b.endCommitted = true
+			b.mmap.enabled = true
+
+			entries, err = b.Read(context.Background(), 0, 3, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 2)
+			cetest.CheckEntry0(entries[0], false, false)
+			cetest.CheckEntry1(entries[1], false, false)
+			// either mapped, or fell back to reading the file on platforms without mmap.
+			So(b.mmap.data != nil || b.mmap.failed, ShouldBeTrue)
+
+			So(b.unmap(), ShouldBeNil)
+			So(b.mmap.data, ShouldBeNil)
+
+			// reads fall back to the file once unmapped.
+			entries, err = b.Read(context.Background(), 1, 1, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+			cetest.CheckEntry1(entries[0], false, false)
+		})
+
+		Convey("prefetch entries", func() {
+			// appendable blocks are skipped.
+			n, err := b.Prefetch(context.Background(), 0)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 0)
+
+			b.actx.archived = 1
+			b.endCommitted = true
+
+			n, err = b.Prefetch(context.Background(), 0)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, vsbtest.EntrySize0+vsbtest.EntrySize1)
+
+			n, err = b.Prefetch(context.Background(), 1)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, vsbtest.EntrySize1)
+
+			n, err = b.Prefetch(context.Background(), 2)
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 0)
+		})
+
+		Convey("read corrupted entry", func() {
+			data := make([]byte, 1)
+			_, err = f.ReadAt(data, vsbtest.EntryOffset1+12)
+			So(err, ShouldBeNil)
+			data[0] ^= 0xFF
+			_, err = f.WriteAt(data, vsbtest.EntryOffset1+12)
+			So(err, ShouldBeNil)
+
+			entries, err = b.Read(context.Background(), 0, 1, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+
+			_, err = b.Read(context.Background(), 0, 2, 0)
+			So(stderr.Is(err, block.ErrCorrupted), ShouldBeTrue)
+		})
+
+		Convey("read with done context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err = b.Read(ctx, 0, 1, 0)
+			So(err, ShouldBeError, context.Canceled)
+		})
+
+		Convey("entries beyond commit watermark", func() {
+			// the end entry is appended, but the last entry isn't committed yet.
+			b.actx.archived = 1
+			b.indexes = b.indexes[:1]
+
+			entries, err = b.Read(context.Background(), 0, 3, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 1)
+			cetest.CheckEntry0(entries[0], false, false)
+
+			_, err = b.Read(context.Background(), 1, 1, 0)
+			So(err, ShouldBeError, block.ErrOnEnd)
+		})
 	})
 }
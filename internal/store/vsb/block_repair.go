@@ -0,0 +1,295 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/binary"
+	stderr "errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+const (
+	repairingExt = ".repairing"
+	// corruptedExt is the extension of original files of repaired blocks, which are kept for forensics.
+	corruptedExt = ".corrupted"
+
+	tailScanChunkSize = 64 * 1024
+	// tornTailWindow is the run of zeros after which the tail is considered clean. Writes in flight are
+	// far smaller than it, so nothing they left can be beyond it.
+	tornTailWindow = 1024 * 1024
+)
+
+// repairIndexes rebuilds indexes by rescanning the data region from the beginning, it is used when the
+// header or the index entry disagrees with entries. Entries are trusted up to the first one which can't be
+// decoded. The corrected header and trusted entries are written to a new file, which replaces the block
+// file once it's synced, so the index entry and the end entry which disagree with entries are dropped. The
+// index entry is written again when the block is closed. The original file is kept with corruptedExt.
+func (b *vsBlock) repairIndexes(ctx context.Context, cause error) error {
+	claimed := b.fm
+	claimedIndexes := len(b.indexes)
+
+	indexes := make([]index.Index, 0, claimed.entryNum)
+	// off is the end of trusted entries, and pos is the position of reader.
+	off := b.dataOffset
+	pos := off
+	full := false
+	endSeq := int64(-1)
+	var stale [][2]int64
+	// Note: use math.MaxInt64-off to avoid overflow.
+	r := io.NewSectionReader(b.f, off, math.MaxInt64-off)
+	for {
+		n, entry, err := b.dec.UnmarshalReader(r)
+		if err != nil {
+			break
+		}
+		typ := ceschema.EntryType(entry)
+		if len(stale) == 0 && !full {
+			switch typ {
+			case ceschema.CloudEvent:
+				indexes = append(indexes, index.NewIndex(pos, int32(n), index.WithEntry(entry)))
+				pos += int64(n)
+				off = pos
+				continue
+			case ceschema.End:
+				endSeq = ceschema.SequenceNumber(entry)
+				if endSeq == int64(len(indexes)) {
+					full = true
+					pos += int64(n)
+					off = pos
+					continue
+				}
+			}
+		}
+		if typ == ceschema.CloudEvent {
+			break
+		}
+		stale = append(stale, [2]int64{pos, int64(n)})
+		pos += int64(n)
+	}
+
+	m, _ := makeSnapshot(appendContext{offset: off}, indexes)
+	m.archived = full
+	indexOffset, indexLength := b.indexOffset, b.indexLength
+	b.indexOffset = 0
+	b.indexLength = 0
+
+	log.Warning(ctx, "The metadata of block is inconsistent with entries, repair it by rescanning.",
+		map[string]interface{}{
+			"block_id":               b.id,
+			"path":                   b.path,
+			log.KeyError:             cause,
+			"header_entry_num":       claimed.entryNum,
+			"header_entry_length":    claimed.entryLength,
+			"header_archived":        claimed.archived,
+			"index_entry_num":        claimedIndexes,
+			"scanned_entry_num":      m.entryNum,
+			"scanned_entry_length":   m.entryLength,
+			"scanned_archived":       m.archived,
+			"end_entry_sequence":     endSeq,
+			"repaired_write_offset":  m.writeOffset,
+			"discarded_index_offset": indexOffset,
+			"discarded_index_length": indexLength,
+			"dropped_entries":        stale,
+		})
+
+	kept, err := b.replaceRepaired(ctx, m, off)
+	if err != nil {
+		return err
+	}
+	log.Warning(ctx, "The block is repaired, the original file is kept.", map[string]interface{}{
+		"block_id":      b.id,
+		"path":          b.path,
+		"original_path": kept,
+	})
+
+	b.indexes = indexes
+	b.actx.seq = m.entryNum
+	b.actx.offset = off
+	b.actx.archived = 0
+	if full {
+		b.actx.seq++
+		b.actx.archived = 1
+	}
+	b.endCommitted = full
+
+	return nil
+}
+
+// replaceRepaired writes the header of m and entries before end to a new file, and replaces the block file
+// with it. The original file is linked to a path with corruptedExt before, which is returned.
+func (b *vsBlock) replaceRepaired(ctx context.Context, m meta, end int64) (string, error) {
+	// The checksum of the original data doesn't cover the repaired one.
+	b.hmu.Lock()
+	b.hm.blockSize, b.hm.blockCRC = 0, 0
+	header := b.encodeHeader(m, b.codec, 0)
+	b.hmu.Unlock()
+
+	tmp := b.path + repairingExt
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, defaultFilePerm)
+	if err != nil {
+		return "", err
+	}
+	if _, err = b.writeClone(ctx, f, end, nil); err == nil {
+		_, err = f.WriteAt(header, 0)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+
+	kept := fmt.Sprintf("%s.%d%s", b.path, time.Now().UnixMilli(), corruptedExt)
+	if err == nil {
+		err = os.Link(b.path, kept)
+	}
+	if err == nil {
+		err = os.Rename(tmp, b.path)
+	}
+	if err == nil {
+		err = syncDir(filepath.Dir(b.path))
+	}
+	if err != nil {
+		if err2 := os.Remove(tmp); err2 != nil && !os.IsNotExist(err2) {
+			return "", errors.Chain(err, err2)
+		}
+		return "", err
+	}
+
+	// The old descriptor refers to the original file now.
+	nf, err := os.OpenFile(b.path, openFlag(b.buffered), 0)
+	if err != nil {
+		return "", err
+	}
+	_ = b.f.Close()
+	b.f = nf
+
+	b.mu.Lock()
+	b.fm = m
+	b.mu.Unlock()
+	return kept, nil
+}
+
+// hasPacketIn reports whether an intact packet starts after start and ends before end.
+func (b *vsBlock) hasPacketIn(start, end int64) (bool, error) {
+	buf := make([]byte, end-start)
+	if _, err := b.f.ReadAt(buf, start); err != nil && !stderr.Is(err, io.EOF) {
+		return false, err
+	}
+	for i := 1; i+codec.PacketMetaSize <= len(buf); i++ {
+		length := int(binary.LittleEndian.Uint32(buf[i:]))
+		if length < codec.PacketMetaSize || length > len(buf)-i {
+			continue
+		}
+		if codec.CheckPacket(buf[i : i+length]) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// syncDir syncs the directory, so that renames in it survive a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	if err2 := d.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+// wipeTornTail zeroes bytes left after the last entry by writes which were interrupted by a crash, so that
+// they can't be taken as entries once new entries are appended in front of them. A block which has its
+// index entry was closed cleanly, so it has no torn tail.
+func (b *vsBlock) wipeTornTail(ctx context.Context) error {
+	if b.indexLength != 0 {
+		return nil
+	}
+
+	start := b.actx.offset
+	end := start
+	buf := make([]byte, tailScanChunkSize)
+	for off := start; off-end < tornTailWindow; {
+		n, err := b.f.ReadAt(buf, off)
+		for i := n - 1; i >= 0; i-- {
+			if buf[i] != 0 {
+				end = off + int64(i) + 1
+				break
+			}
+		}
+		if err != nil {
+			if stderr.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		off += int64(n)
+	}
+	if end == start {
+		return nil
+	}
+	// A torn tail is left by writes in flight, which are never followed by intact entries. Intact entries
+	// after the last one are acknowledged entries behind a corrupted one, e.g. by bit rot, which mustn't be
+	// wiped, the block is repaired into a new file and the original is kept instead.
+	if end-start > tornTailWindow {
+		return errCorrupted
+	}
+	if found, err := b.hasPacketIn(start, end); err != nil {
+		return err
+	} else if found {
+		return errCorrupted
+	}
+
+	log.Warning(ctx, "Found torn writes after the last entry of block, wipe them.", map[string]interface{}{
+		"block_id":     b.id,
+		"path":         b.path,
+		"tail_offset":  start,
+		"wiped_length": end - start,
+	})
+
+	zeros := make([]byte, tailScanChunkSize)
+	for off := start; off < end; off += tailScanChunkSize {
+		sz := end - off
+		if sz > tailScanChunkSize {
+			sz = tailScanChunkSize
+		}
+		if _, err := b.f.WriteAt(zeros[:sz], off); err != nil {
+			return err
+		}
+	}
+	if b.buffered {
+		return b.f.Sync()
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	stderr "errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	idxtest "github.com/linkall-labs/vanus/internal/store/vsb/index/testing"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+func TestVSBlock_Repair(t *testing.T) {
+	writeVSB := func(header []byte, entries map[int64][]byte) string {
+		f, err := os.CreateTemp("", "*.vsb")
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(header, 0)
+		So(err, ShouldBeNil)
+		for off, data := range entries {
+			_, err = f.WriteAt(data, off)
+			So(err, ShouldBeNil)
+		}
+		So(f.Close(), ShouldBeNil)
+		return f.Name()
+	}
+	// removeVSB removes the block file and original files kept by repairing.
+	removeVSB := func(path string) {
+		kept, err := filepath.Glob(path + ".*" + corruptedExt)
+		So(err, ShouldBeNil)
+		for _, p := range append(kept, path) {
+			So(os.Remove(p), ShouldBeNil)
+		}
+	}
+	// checkKept checks that the original file is kept once, and returns its data.
+	checkKept := func(path string) []byte {
+		kept, err := filepath.Glob(path + ".*" + corruptedExt)
+		So(err, ShouldBeNil)
+		So(kept, ShouldHaveLength, 1)
+		data, err := os.ReadFile(kept[0])
+		So(err, ShouldBeNil)
+		return data
+	}
+
+	Convey("repair vsb with stale index entry", t, func() {
+		path := writeVSB(vsbtest.EmptyHeaderData, map[int64][]byte{
+			vsbtest.EntryOffset0: vsbtest.EntryData0,
+			// The index entry claims 2 entries.
+			vsbtest.EntryOffset1: vsbtest.IndexEntryData,
+		})
+		defer removeVSB(path)
+
+		b := &vsBlock{path: path}
+		err := b.Open(context.Background())
+		So(stderr.Is(err, errCorrupted), ShouldBeTrue)
+
+		b = &vsBlock{path: path, repair: true}
+		So(b.Open(context.Background()), ShouldBeNil)
+
+		stat := b.status()
+		So(stat.Archived, ShouldBeFalse)
+		So(stat.EntryNum, ShouldEqual, 1)
+		So(stat.EntrySize, ShouldEqual, vsbtest.EntrySize0)
+		So(b.indexes, ShouldHaveLength, 1)
+		idxtest.CheckIndex0(b.indexes[0], false)
+		So(b.actx.offset, ShouldEqual, vsbtest.EntryOffset1)
+		So(b.f.Close(), ShouldBeNil)
+
+		// The original file is kept, and the stale index entry is dropped from the repaired one.
+		kept := checkKept(path)
+		So(kept[vsbtest.EntryOffset1:], ShouldResemble, vsbtest.IndexEntryData)
+		data, err := os.ReadFile(path)
+		So(err, ShouldBeNil)
+		So(data, ShouldHaveLength, vsbtest.EntryOffset1)
+		So(data[vsbtest.EntryOffset0:], ShouldResemble, vsbtest.EntryData0)
+		_, err = os.Stat(path + repairingExt)
+		So(os.IsNotExist(err), ShouldBeTrue)
+
+		// The repaired block is consistent.
+		b = &vsBlock{path: path}
+		So(b.Open(context.Background()), ShouldBeNil)
+		So(b.fm.entryNum, ShouldEqual, 1)
+		So(b.indexes, ShouldHaveLength, 1)
+		So(b.f.Close(), ShouldBeNil)
+	})
+
+	Convey("repair working vsb with intact entries behind a corrupted one", t, func() {
+		// A byte of entry 0 is flipped, e.g. by bit rot, entry 1 behind it is intact.
+		corrupted := append([]byte{}, vsbtest.EntryData0...)
+		corrupted[len(corrupted)/2] ^= 0xff
+		path := writeVSB(vsbtest.EmptyHeaderData, map[int64][]byte{
+			vsbtest.EntryOffset0: corrupted,
+			vsbtest.EntryOffset1: vsbtest.EntryData1,
+		})
+		defer removeVSB(path)
+
+		// Entries behind the corrupted one aren't wiped as a torn tail.
+		b := &vsBlock{path: path}
+		err := b.Open(context.Background())
+		So(stderr.Is(err, errCorrupted), ShouldBeTrue)
+		data, err := os.ReadFile(path)
+		So(err, ShouldBeNil)
+		So(data[vsbtest.EntryOffset1:], ShouldResemble, vsbtest.EntryData1)
+
+		b = &vsBlock{path: path, repair: true}
+		So(b.Open(context.Background()), ShouldBeNil)
+		So(b.status().EntryNum, ShouldEqual, 0)
+		So(b.actx.offset, ShouldEqual, vsbtest.EntryOffset0)
+		So(b.f.Close(), ShouldBeNil)
+
+		// The original file is kept with both entries.
+		kept := checkKept(path)
+		So(kept[vsbtest.EntryOffset0:vsbtest.EntryOffset1], ShouldResemble, corrupted)
+		So(kept[vsbtest.EntryOffset1:], ShouldResemble, vsbtest.EntryData1)
+	})
+
+	Convey("repair archived vsb with missing entries", t, func() {
+		path := writeVSB(vsbtest.ArchivedHeaderData, map[int64][]byte{
+			vsbtest.EntryOffset0: vsbtest.EntryData0,
+		})
+		defer removeVSB(path)
+
+		b := &vsBlock{path: path}
+		err := b.Open(context.Background())
+		So(stderr.Is(err, errCorrupted), ShouldBeTrue)
+
+		b = &vsBlock{path: path, repair: true}
+		So(b.Open(context.Background()), ShouldBeNil)
+
+		stat := b.status()
+		So(stat.Archived, ShouldBeFalse)
+		So(stat.EntryNum, ShouldEqual, 1)
+		So(b.fm.entryNum, ShouldEqual, 1)
+		So(b.fm.archived, ShouldBeFalse)
+		So(b.f.Close(), ShouldBeNil)
+
+		b = &vsBlock{path: path}
+		So(b.Open(context.Background()), ShouldBeNil)
+		So(b.indexes, ShouldHaveLength, 1)
+		idxtest.CheckIndex0(b.indexes[0], false)
+		So(b.f.Close(), ShouldBeNil)
+	})
+
+	Convey("repair archived vsb with mismatched end entry", t, func() {
+		path := writeVSB(vsbtest.ArchivedHeaderData, map[int64][]byte{
+			vsbtest.EntryOffset0: vsbtest.EntryData0,
+			// The end entry claims 2 entries.
+			vsbtest.EntryOffset1: vsbtest.EndEntryData,
+		})
+		defer removeVSB(path)
+
+		b := &vsBlock{path: path, repair: true}
+		So(b.Open(context.Background()), ShouldBeNil)
+		So(b.status().Archived, ShouldBeFalse)
+		So(b.indexes, ShouldHaveLength, 1)
+		So(b.f.Close(), ShouldBeNil)
+
+		b = &vsBlock{path: path}
+		So(b.Open(context.Background()), ShouldBeNil)
+		So(b.indexes, ShouldHaveLength, 1)
+		So(b.f.Close(), ShouldBeNil)
+	})
+}
@@ -16,8 +16,8 @@ package vsb
 
 import (
 	// standard libraries.
+	"bytes"
 	"context"
-	"sort"
 
 	// third-party libraries.
 	"go.opentelemetry.io/otel/trace"
@@ -36,58 +36,112 @@ func (b *vsBlock) Seek(ctx context.Context, index int64, key block.Entry, flag b
 	span.AddEvent("store.vsb.vsBlock.Seek() Start")
 	defer span.AddEvent("store.vsb.vsBlock.Seek() End")
 
+	if index == ceschema.PartitionKeyIndex {
+		if flag != block.SeekLastKey {
+			return -1, block.ErrNotSupported
+		}
+		return b.seekLastKey(ctx, ceschema.PartitionKey(key))
+	}
+
 	b.mu.RLock()
-	indexes := b.indexes
+	s := b.searcher()
 	b.mu.RUnlock()
 
+	cmp := b.selectComparer(index, key)
 	switch flag {
 	case block.SeekKeyExact:
-		return b.seekKeyExact(ctx, index, key, indexes)
+		return seekKeyExact(ctx, s, cmp)
 	case block.SeekKeyOrNext:
-		return b.seekKeyOrNext(ctx, index, key, indexes)
+		return seekKeyOrNext(ctx, s, cmp)
 	case block.SeekKeyOrPrev:
-		return b.seekKeyOrPrev(ctx, index, key, indexes)
+		return seekKeyOrPrev(ctx, s, cmp)
 	case block.SeekAfterKey:
-		return b.seekAfterKey(ctx, index, key, indexes)
+		return seekAfterKey(ctx, s, cmp)
 	case block.SeekBeforeKey:
-		return b.seekBeforeKey(ctx, index, key, indexes)
+		return seekBeforeKey(ctx, s, cmp)
 	default:
 		return -1, block.ErrNotSupported
 	}
 }
 
-func (b *vsBlock) seekKeyExact(ctx context.Context, idx int64, key block.Entry, indexes []index.Index) (int64, error) {
-	cmp := b.selectComparer(idx, key)
-	seq := searchGE(indexes, cmp)
-	if seq >= 0 && cmp(indexes[seq]) == 0 {
+func seekKeyExact(ctx context.Context, s indexSearcher, cmp func(index.Index) int) (int64, error) {
+	seq, i, err := s.search(ctx, ge(cmp))
+	if err != nil {
+		return -1, err
+	}
+	if seq >= 0 && cmp(i) == 0 {
 		return seq, nil
 	}
 	return -1, nil
 }
 
-func (b *vsBlock) seekKeyOrNext(ctx context.Context, idx int64, key block.Entry, indexes []index.Index) (int64, error) {
-	return searchGE(indexes, b.selectComparer(idx, key)), nil
+func seekKeyOrNext(ctx context.Context, s indexSearcher, cmp func(index.Index) int) (int64, error) {
+	seq, _, err := s.search(ctx, ge(cmp))
+	return seq, err
 }
 
-func (b *vsBlock) seekKeyOrPrev(ctx context.Context, idx int64, key block.Entry, indexes []index.Index) (int64, error) {
-	cmp := b.selectComparer(idx, key)
-	seq := searchGE(indexes, cmp)
-	if seq >= 0 && cmp(indexes[seq]) != 0 {
+func seekKeyOrPrev(ctx context.Context, s indexSearcher, cmp func(index.Index) int) (int64, error) {
+	seq, i, err := s.search(ctx, ge(cmp))
+	if err != nil {
+		return -1, err
+	}
+	if seq >= 0 && cmp(i) != 0 {
 		return seq - 1, nil
 	}
 	return seq, nil
 }
 
-func (b *vsBlock) seekAfterKey(ctx context.Context, idx int64, key block.Entry, indexes []index.Index) (int64, error) {
-	return searchGT(indexes, b.selectComparer(idx, key)), nil
+func seekAfterKey(ctx context.Context, s indexSearcher, cmp func(index.Index) int) (int64, error) {
+	seq, _, err := s.search(ctx, func(i index.Index) bool {
+		return cmp(i) > 0
+	})
+	return seq, err
 }
 
-func (b *vsBlock) seekBeforeKey(ctx context.Context, idx int64, key block.Entry, indexes []index.Index) (int64, error) {
-	seq := searchGE(indexes, b.selectComparer(idx, key))
+func seekBeforeKey(ctx context.Context, s indexSearcher, cmp func(index.Index) int) (int64, error) {
+	seq, _, err := s.search(ctx, ge(cmp))
+	if err != nil {
+		return -1, err
+	}
 	if seq >= 0 {
 		return seq - 1, nil
 	}
-	return int64(len(indexes)) - 1, nil
+	return int64(s.num) - 1, nil
+}
+
+// seekLastKey returns the sequence number of the last committed entry with the partition key, it's -1 if no
+// entry has the key. Indexes only keep hashes of keys, so entries with the hash are read to compare keys.
+func (b *vsBlock) seekLastKey(ctx context.Context, key []byte) (int64, error) {
+	if len(key) == 0 {
+		return -1, nil
+	}
+	hash := index.HashKey(key)
+
+	b.mu.RLock()
+	indexes, hashes, num := b.indexes, b.keyHashes, b.committedNum()
+	sparse := b.stride != 0
+	b.mu.RUnlock()
+
+	for seq := num - 1; seq >= 0; seq-- {
+		var h uint32
+		switch {
+		case !sparse:
+			h = indexes[seq].KeyHash()
+		case hashes != nil:
+			h = hashes[seq]
+		}
+		if h != hash {
+			continue
+		}
+		entries, err := b.Read(ctx, int64(seq), 1, 0)
+		if err != nil {
+			return -1, err
+		}
+		if bytes.Equal(ceschema.PartitionKey(entries[0]), key) {
+			return int64(seq), nil
+		}
+	}
+	return -1, nil
 }
 
 func (b *vsBlock) selectComparer(idx int64, key block.Entry) func(index.Index) int {
@@ -105,24 +159,8 @@ func (b *vsBlock) selectComparer(idx int64, key block.Entry) func(index.Index) i
 	}
 }
 
-func searchGE(indexes []index.Index, cmp func(index.Index) int) int64 {
-	sz := len(indexes)
-	seq := sort.Search(sz, func(i int) bool {
-		return cmp(indexes[i]) >= 0
-	})
-	if seq < sz {
-		return int64(seq)
-	}
-	return -1
-}
-
-func searchGT(indexes []index.Index, cmp func(index.Index) int) int64 {
-	sz := len(indexes)
-	seq := sort.Search(sz, func(i int) bool {
-		return cmp(indexes[i]) > 0
-	})
-	if seq < sz {
-		return int64(seq)
+func ge(cmp func(index.Index) int) func(index.Index) bool {
+	return func(i index.Index) bool {
+		return cmp(i) >= 0
 	}
-	return -1
 }
@@ -43,7 +43,11 @@ func (b *vsBlock) makeSnapshot() (meta, []index.Index) {
 		})
 		b.mu.RUnlock()
 	}()
-	return makeSnapshot(b.actx, b.indexes)
+	m, indexes := makeSnapshot(b.actx, b.indexes)
+	if b.stride != 0 {
+		m.entryNum = int64(b.sparseNum)
+	}
+	return m, indexes
 }
 
 func makeSnapshot(actx appendContext, indexes []index.Index) (meta, []index.Index) {
@@ -70,7 +74,7 @@ func (b *vsBlock) Snapshot(ctx context.Context) (block.Fragment, error) {
 	data := make([]byte, m.writeOffset-b.dataOffset+8)
 	binary.LittleEndian.PutUint64(data, uint64(b.dataOffset))
 
-	if _, err := b.f.ReadAt(data[8:], b.dataOffset); err != nil {
+	if err := b.readAt(ctx, data[8:], b.dataOffset); err != nil {
 		return nil, err
 	}
 
@@ -98,6 +102,7 @@ func (b *vsBlock) ApplySnapshot(ctx context.Context, snap block.Fragment) error
 	}
 
 	// Build indexes from data.
+	n0 := len(b.indexes)
 	for off := cur; off < eo; {
 		n, entry, _ := b.dec.Unmarshal(payload[off-headerBlockSize:])
 
@@ -114,6 +119,8 @@ func (b *vsBlock) ApplySnapshot(ctx context.Context, snap block.Fragment) error
 
 	b.actx.seq = int64(len(b.indexes))
 	b.actx.offset = eo
+	b.endCommitted = b.actx.Archived()
+	b.logIndexes(ctx, b.indexes[n0:])
 
 	return nil
 }
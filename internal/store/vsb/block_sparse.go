@@ -0,0 +1,224 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"sort"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/errors"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+// sparseIndex selects archived blocks which are indexed sparsely, it's disabled if stride is 0.
+type sparseIndex struct {
+	stride int
+	// maxEntrySize is the largest average size of entries of blocks indexed sparsely, blocks of larger
+	// entries have fewer entries, so their indexes are kept in full.
+	maxEntrySize int
+}
+
+// sparsify keeps indexes of every stride-th entry and the last entry of an archived block whose entries are
+// small enough, entries between them are found by scanning their stride. It's done only after the index
+// entry is persisted, which still holds indexes of all entries.
+func (b *vsBlock) sparsify(ctx context.Context) {
+	stride := b.sparse.stride
+	if stride <= 1 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	num := len(b.indexes)
+	if b.stride != 0 || !b.endCommitted || num <= stride || b.indexOffset != b.actx.offset {
+		return
+	}
+	indexes := b.indexes
+	size := indexes[num-1].EndOffset() - indexes[0].StartOffset()
+	if size > int64(num)*int64(b.sparse.maxEntrySize) {
+		return
+	}
+
+	samples := make([]index.Index, 0, (num-1)/stride+2)
+	for i := 0; i < num; i += stride {
+		samples = append(samples, indexes[i])
+	}
+	if (num-1)%stride != 0 {
+		samples = append(samples, indexes[num-1])
+	}
+	b.indexes = samples
+	b.stride = stride
+	b.sparseNum = num
+	b.keyHashes = keyHashesOf(indexes)
+
+	log.Debug(ctx, "the archived block is indexed sparsely", map[string]interface{}{
+		"block_id":  b.id,
+		"entry_num": num,
+		"stride":    stride,
+	})
+}
+
+// keyHashesOf returns key hashes of indexes, it's nil if no index has a key.
+func keyHashesOf(indexes []index.Index) []uint32 {
+	var hashes []uint32
+	for i, idx := range indexes {
+		if idx.KeyHash() == 0 {
+			continue
+		}
+		if hashes == nil {
+			hashes = make([]uint32, len(indexes))
+		}
+		hashes[i] = idx.KeyHash()
+	}
+	return hashes
+}
+
+// startOffset returns the start offset of the entry at seq, or of the stride covering it if Block is indexed
+// sparsely. The caller must hold mu.
+func (b *vsBlock) startOffset(seq int) int64 {
+	if b.stride != 0 {
+		return b.indexes[seq/b.stride].StartOffset()
+	}
+	return b.indexes[seq].StartOffset()
+}
+
+// committedNum returns the number of committed entries. The caller must hold mu.
+func (b *vsBlock) committedNum() int {
+	if b.stride != 0 {
+		return b.sparseNum
+	}
+	return len(b.indexes)
+}
+
+// lastIndex returns the index of the last committed entry. The caller must hold mu, and there must be one.
+// Indexes of Block indexed sparsely end with the one of the last entry as well.
+func (b *vsBlock) lastIndex() index.Index {
+	return b.indexes[len(b.indexes)-1]
+}
+
+// sparseRange returns the range of strides covering entries [start, end] of Block indexed sparsely, and the
+// number of entries before start in it. The range is cut at a stride boundary after maxBytes of entries
+// from start. The caller must hold mu.
+func (b *vsBlock) sparseRange(start, end, maxBytes int) (int64, int64, int) {
+	i := start / b.stride
+	last := end/b.stride + 1
+	if maxBytes > 0 && i+1 < last {
+		// The entry at start begins before the stride i+1, so entries within budget end before limit.
+		limit := b.strideStart(i+1) + int64(maxBytes)
+		last = i + 1 + sort.Search(last-i-1, func(k int) bool {
+			return b.strideStart(i+1+k) >= limit
+		})
+	}
+	return b.indexes[i].StartOffset(), b.strideStart(last), start - i*b.stride
+}
+
+// strideStart returns the start offset of the stride k, or the end of entries if k is after the last stride.
+// The caller must hold mu.
+func (b *vsBlock) strideStart(k int) int64 {
+	if k <= (b.sparseNum-1)/b.stride {
+		return b.indexes[k].StartOffset()
+	}
+	return b.indexes[len(b.indexes)-1].EndOffset()
+}
+
+// scanIndexes builds indexes of entries in [from, to) by reading them.
+func (b *vsBlock) scanIndexes(ctx context.Context, from, to int64) ([]index.Index, error) {
+	data := make([]byte, to-from)
+	if err := b.readAt(ctx, data, from); err != nil {
+		return nil, err
+	}
+	var indexes []index.Index
+	for so := 0; so < len(data); {
+		n, entry, err := b.dec.Unmarshal(data[so:])
+		if err != nil {
+			return nil, errors.Chain(block.ErrCorrupted, err)
+		}
+		indexes = append(indexes, index.NewIndex(from+int64(so), int32(n), index.WithEntry(entry)))
+		so += n
+	}
+	return indexes, nil
+}
+
+// expandIndexes returns indexes of all entries of a snapshot taken by makeSnapshot or committedSnapshot,
+// they are rebuilt by scanning entries if Block is indexed sparsely.
+func (b *vsBlock) expandIndexes(ctx context.Context, m meta, indexes []index.Index) ([]index.Index, error) {
+	if int64(len(indexes)) == m.entryNum {
+		return indexes, nil
+	}
+	return b.scanIndexes(ctx, indexes[0].StartOffset(), indexes[len(indexes)-1].EndOffset())
+}
+
+// indexSearcher searches committed entries of Block by their indexes.
+type indexSearcher struct {
+	b       *vsBlock
+	indexes []index.Index
+	stride  int
+	num     int
+}
+
+// searcher returns a searcher of committed entries. The caller must hold mu.
+func (b *vsBlock) searcher() indexSearcher {
+	if b.stride != 0 {
+		return indexSearcher{b: b, indexes: b.indexes, stride: b.stride, num: b.sparseNum}
+	}
+	return indexSearcher{b: b, indexes: b.indexes, num: len(b.indexes)}
+}
+
+// search returns the sequence number and the index of the first entry satisfying pred, which is monotone in
+// sequence numbers. The sequence number is -1 if no entry satisfies it.
+func (s indexSearcher) search(ctx context.Context, pred func(index.Index) bool) (int64, index.Index, error) {
+	if s.stride == 0 {
+		seq := sort.Search(s.num, func(i int) bool {
+			return pred(s.indexes[i])
+		})
+		if seq < s.num {
+			return int64(seq), s.indexes[seq], nil
+		}
+		return -1, nil, nil
+	}
+
+	samples := (s.num-1)/s.stride + 1
+	k := sort.Search(samples, func(i int) bool {
+		return pred(s.indexes[i])
+	})
+	if k == 0 {
+		return 0, s.indexes[0], nil
+	}
+	// The first entry satisfying pred is in the stride before the sample k, or it's the sample k.
+	to := s.indexes[len(s.indexes)-1].EndOffset()
+	if k < samples {
+		to = s.indexes[k].StartOffset()
+	}
+	indexes, err := s.b.scanIndexes(ctx, s.indexes[k-1].StartOffset(), to)
+	if err != nil {
+		return -1, nil, err
+	}
+	if j := sort.Search(len(indexes), func(i int) bool {
+		return pred(indexes[i])
+	}); j < len(indexes) {
+		return int64((k-1)*s.stride + j), indexes[j], nil
+	}
+	if k < samples {
+		return int64(k * s.stride), s.indexes[k], nil
+	}
+	return -1, nil, nil
+}
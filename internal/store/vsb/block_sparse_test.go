@@ -0,0 +1,216 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/block"
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/schema/ce/convert"
+	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+const sparseTestStime = 1000
+
+// writeSparseTestEntries writes num entries of different sizes, the stime of entry i is
+// sparseTestStime+2*i, and its partition key is sparseTestKey(i), and returns their indexes.
+func writeSparseTestEntries(f *os.File, off int64, num int) []index.Index {
+	enc := codec.NewEncoder()
+	indexes := make([]index.Index, 0, num)
+	for i := 0; i < num; i++ {
+		event := &cepb.CloudEvent{
+			Id:          fmt.Sprintf("event-%d", i),
+			Source:      "sparse",
+			SpecVersion: "1.0",
+			Type:        "test",
+		}
+		if key := sparseTestKey(i); key != "" {
+			event.Attributes = map[string]*cepb.CloudEvent_CloudEventAttributeValue{
+				"partitionkey": {Attr: &cepb.CloudEvent_CloudEventAttributeValue_CeString{CeString: key}},
+			}
+		}
+		e := &entryExtWrapper{
+			EntryExtWrapper: block.EntryExtWrapper{
+				E: convert.ToEntry(event),
+			},
+			t:     ceschema.CloudEvent,
+			seq:   int64(i),
+			stime: sparseTestStime + 2*int64(i),
+		}
+		data := make([]byte, enc.Size(e))
+		_, err := enc.MarshalTo(context.Background(), e, data)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(data, off)
+		So(err, ShouldBeNil)
+		indexes = append(indexes, index.NewIndex(off, int32(len(data)), index.WithEntry(e)))
+		off += int64(len(data))
+	}
+	return indexes
+}
+
+// sparseTestKey returns the partition key of entry i, every 7th entry has no key.
+func sparseTestKey(i int) string {
+	if i%7 == 6 {
+		return ""
+	}
+	return fmt.Sprintf("key-%d", i%5)
+}
+
+func TestVSBlock_SparseIndex(t *testing.T) {
+	Convey("index archived block sparsely", t, func() {
+		ctx := context.Background()
+		f, err := os.CreateTemp("", "*.vsb")
+		So(err, ShouldBeNil)
+		defer func() {
+			So(f.Close(), ShouldBeNil)
+			So(os.Remove(f.Name()), ShouldBeNil)
+		}()
+
+		num := 50
+		indexes := writeSparseTestEntries(f, vsbtest.EntryOffset0, num)
+		end := indexes[num-1].EndOffset()
+		dec, _ := codec.NewDecoder(true, codec.IndexSize)
+		newBlock := func(stride, maxEntrySize int) *vsBlock {
+			b := &vsBlock{
+				dataOffset:   vsbtest.EntryOffset0,
+				indexOffset:  end,
+				actx:         appendContext{seq: int64(num) + 1, offset: end, archived: 1},
+				indexes:      append([]index.Index(nil), indexes...),
+				endCommitted: true,
+				dec:          dec,
+				f:            f,
+				sparse:       sparseIndex{stride: stride, maxEntrySize: maxEntrySize},
+			}
+			b.sparsify(ctx)
+			return b
+		}
+		dense := newBlock(0, 0)
+		So(dense.stride, ShouldEqual, 0)
+
+		Convey("skip blocks of large entries", func() {
+			b := newBlock(4, 1)
+			So(b.stride, ShouldEqual, 0)
+			So(b.indexes, ShouldHaveLength, num)
+		})
+
+		b := newBlock(4, 1024)
+		So(b.stride, ShouldEqual, 4)
+		// entries 0, 4, ..., 48 and the last one.
+		So(b.indexes, ShouldHaveLength, 14)
+		So(b.indexes[13], ShouldEqual, indexes[num-1])
+
+		stat := b.status()
+		So(stat.EntryNum, ShouldEqual, num)
+		So(stat.EntrySize, ShouldEqual, dense.status().EntrySize)
+		So(stat.FirstEntryStime, ShouldEqual, sparseTestStime)
+		So(stat.LastEntryStime, ShouldEqual, sparseTestStime+2*(num-1))
+
+		Convey("read entries by scanning strides", func() {
+			seqs := func(entries []block.Entry) []int64 {
+				result := make([]int64, 0, len(entries))
+				for _, e := range entries {
+					result = append(result, ceschema.SequenceNumber(e))
+				}
+				return result
+			}
+			for start := 0; start < num; start++ {
+				for _, n := range []int{1, 3, 9} {
+					for _, maxBytes := range []int{0, 1, 100, 500} {
+						expected, err := dense.Read(ctx, int64(start), n, maxBytes)
+						So(err, ShouldBeNil)
+						entries, err := b.Read(ctx, int64(start), n, maxBytes)
+						So(err, ShouldBeNil)
+						So(seqs(entries), ShouldResemble, seqs(expected))
+					}
+				}
+			}
+			_, err = b.Read(ctx, int64(num), 1, 0)
+			So(err, ShouldBeError, block.ErrExceeded)
+		})
+
+		Convey("seek entries by scanning strides", func() {
+			flags := []block.SeekKeyFlag{
+				block.SeekKeyExact, block.SeekKeyOrNext, block.SeekKeyOrPrev, block.SeekAfterKey, block.SeekBeforeKey,
+			}
+			for stime := int64(sparseTestStime - 1); stime <= sparseTestStime+2*int64(num); stime++ {
+				key := ceschema.StimeKey(stime)
+				for _, flag := range flags {
+					expected, err := dense.Seek(ctx, 0, key, flag)
+					So(err, ShouldBeNil)
+					seq, err := b.Seek(ctx, 0, key, flag)
+					So(err, ShouldBeNil)
+					So(seq, ShouldEqual, expected)
+				}
+			}
+		})
+
+		Convey("seek the last entries of keys", func() {
+			last := map[string]int64{}
+			for i := 0; i < num; i++ {
+				if key := sparseTestKey(i); key != "" {
+					last[key] = int64(i)
+				}
+			}
+			So(last, ShouldHaveLength, 5)
+			for _, blk := range []*vsBlock{dense, b} {
+				for key, expected := range last {
+					seq, err := blk.Seek(ctx, ceschema.PartitionKeyIndex, ceschema.PartitionKeyKey([]byte(key)),
+						block.SeekLastKey)
+					So(err, ShouldBeNil)
+					So(seq, ShouldEqual, expected)
+				}
+				seq, err := blk.Seek(ctx, ceschema.PartitionKeyIndex, ceschema.PartitionKeyKey([]byte("key-5")),
+					block.SeekLastKey)
+				So(err, ShouldBeNil)
+				So(seq, ShouldEqual, -1)
+				_, err = blk.Seek(ctx, ceschema.PartitionKeyIndex, ceschema.PartitionKeyKey([]byte("key-0")),
+					block.SeekKeyExact)
+				So(err, ShouldEqual, block.ErrNotSupported)
+			}
+		})
+
+		Convey("expand indexes", func() {
+			m, sparse := b.makeSnapshot()
+			So(m.entryNum, ShouldEqual, num)
+			expanded, err := b.expandIndexes(ctx, m, sparse)
+			So(err, ShouldBeNil)
+			So(expanded, ShouldHaveLength, num)
+			for i, idx := range expanded {
+				So(idx.StartOffset(), ShouldEqual, indexes[i].StartOffset())
+				So(idx.Length(), ShouldEqual, indexes[i].Length())
+				So(idx.Stime(), ShouldEqual, indexes[i].Stime())
+				So(idx.KeyHash(), ShouldEqual, indexes[i].KeyHash())
+			}
+
+			m2, committed := b.committedSnapshot()
+			So(m2, ShouldResemble, m)
+			So(committed, ShouldHaveLength, len(sparse))
+		})
+	})
+}
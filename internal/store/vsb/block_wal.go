@@ -0,0 +1,219 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"encoding/binary"
+	stderr "errors"
+	"hash/crc32"
+	"io"
+	"math"
+	"os"
+	"sync"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+
+	// this project.
+	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+)
+
+// The metadata WAL of a working block is a sidecar file, which logs indexes of entries once they are written.
+// The header is persisted only when Block is closed or archived, so without the WAL, all entries are scanned
+// again to rebuild indexes after a crash. With it, only entries after the last logged one are scanned.
+//
+// The layout of `WAL Record` is:
+//
+//	┌───────────────────────────────────────────────────────────────────────┐
+//	│                               Offset(8)                               │
+//	├───────────────────────────────────┬───────────────────────────────────┤
+//	│               Length(4)           │            Key Hash(4)            │
+//	├───────────────────────────────────┴───────────────────────────────────┤
+//	│                                Stime(8)                               │
+//	├───────────────────────────────────┬───────────────────────────────────┘
+//	│               CRC(4)              │
+//	└───────────────────────────────────┘
+//
+// All values little-endian
+//
+//	+00 8B Offset
+//	+08 4B Length (in bytes)
+//	+0C 4B Key Hash (see `Index`)
+//	+10 8B Stime
+//	+18 4B CRC-32c of +00 to +18
+//
+// Records of older versions are shorter, their CRCs mismatch, so entries are scanned again instead.
+const (
+	walExt        = ".wal"
+	walRecordSize = 8 + 4 + 4 + 8 + 4
+	walCRCOffset  = walRecordSize - 4
+)
+
+// metaWAL isn't opened with O_SYNC, but records are only logged once data of their entries is synced, so a
+// record never outlives its entry, and a torn record is stopped at by its CRC. The entry of the last replayed
+// record is checked against its sequence number in case the block file was replaced, e.g. repaired.
+type metaWAL struct {
+	mu   sync.Mutex
+	f    *os.File
+	size int64
+}
+
+func (b *vsBlock) walPath() string {
+	return b.path + walExt
+}
+
+func encodeWALRecords(indexes []index.Index) []byte {
+	buf := make([]byte, len(indexes)*walRecordSize)
+	for i, idx := range indexes {
+		rec := buf[i*walRecordSize : (i+1)*walRecordSize]
+		binary.LittleEndian.PutUint64(rec[0:], uint64(idx.StartOffset()))
+		binary.LittleEndian.PutUint32(rec[8:], uint32(idx.Length()))
+		binary.LittleEndian.PutUint32(rec[12:], idx.KeyHash())
+		binary.LittleEndian.PutUint64(rec[16:], uint64(idx.Stime()))
+		binary.LittleEndian.PutUint32(rec[walCRCOffset:], crc32.Checksum(rec[:walCRCOffset], crc32q))
+	}
+	return buf
+}
+
+// decodeWALRecords returns indexes of records which are contiguous from the data offset, it stops at a torn
+// or stale record.
+func decodeWALRecords(buf []byte, dataOffset int64) []index.Index {
+	indexes := make([]index.Index, 0, len(buf)/walRecordSize)
+	end := dataOffset
+	for len(buf) >= walRecordSize {
+		rec := buf[:walRecordSize]
+		if crc32.Checksum(rec[:walCRCOffset], crc32q) != binary.LittleEndian.Uint32(rec[walCRCOffset:]) {
+			break
+		}
+		off := int64(binary.LittleEndian.Uint64(rec[0:]))
+		length := int32(binary.LittleEndian.Uint32(rec[8:]))
+		if off != end || length <= 0 {
+			break
+		}
+		keyHash := binary.LittleEndian.Uint32(rec[12:])
+		stime := int64(binary.LittleEndian.Uint64(rec[16:]))
+		indexes = append(indexes, index.NewIndex(off, length, index.WithStime(stime), index.WithKeyHash(keyHash)))
+		end += int64(length)
+		buf = buf[walRecordSize:]
+	}
+	return indexes
+}
+
+// replayWAL returns indexes logged in the WAL, it returns nil if they don't cover entries persisted in the
+// header, or the last one doesn't match its entry.
+func (b *vsBlock) replayWAL() []index.Index {
+	if !b.walEnabled || b.fm.archived {
+		return nil
+	}
+	data, err := os.ReadFile(b.walPath())
+	if err != nil {
+		return nil
+	}
+	indexes := decodeWALRecords(data, b.dataOffset)
+	n := len(indexes)
+	if n == 0 || int64(n) < b.fm.entryNum || indexes[n-1].EndOffset() < b.dataOffset+b.fm.entryLength {
+		return nil
+	}
+
+	last := indexes[n-1]
+	// Note: use math.MaxInt64-off to avoid overflow.
+	r := io.NewSectionReader(b.f, last.StartOffset(), math.MaxInt64-last.StartOffset())
+	sz, entry, err := b.dec.UnmarshalReader(r)
+	if err != nil || sz != int(last.Length()) || ceschema.EntryType(entry) != ceschema.CloudEvent ||
+		ceschema.SequenceNumber(entry) != int64(n-1) {
+		return nil
+	}
+	return indexes
+}
+
+// openWAL opens the WAL of a working block, and keeps records replayed if they are exactly indexes of Block,
+// otherwise records are written again. The WAL of an archived block is removed.
+func (b *vsBlock) openWAL(ctx context.Context) error {
+	if !b.walEnabled || b.actx.Archived() || b.codec != codecNone {
+		b.removeWAL(ctx)
+		return nil
+	}
+	f, err := os.OpenFile(b.walPath(), os.O_RDWR|os.O_CREATE, defaultFilePerm)
+	if err != nil {
+		return err
+	}
+	size := int64(b.walReplayed) * walRecordSize
+	if b.walReplayed != len(b.indexes) {
+		data := encodeWALRecords(b.indexes)
+		if _, err = f.WriteAt(data, 0); err != nil {
+			_ = f.Close()
+			return err
+		}
+		size = int64(len(data))
+	}
+	if err = f.Truncate(size); err != nil {
+		_ = f.Close()
+		return err
+	}
+	b.wal = &metaWAL{f: f, size: size}
+	return nil
+}
+
+// logIndexes appends indexes of synced entries to the WAL. A failed write only leaves a gap, which stops
+// the replay, so entries after it are scanned in recovery.
+func (b *vsBlock) logIndexes(ctx context.Context, indexes []index.Index) {
+	w := b.wal
+	if w == nil || len(indexes) == 0 {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return
+	}
+	data := encodeWALRecords(indexes)
+	if _, err := w.f.WriteAt(data, w.size); err != nil {
+		log.Warning(ctx, "vsb: log indexes to the metadata WAL failed.", map[string]interface{}{
+			"block_id":   b.id,
+			log.KeyError: err,
+		})
+		return
+	}
+	w.size += int64(len(data))
+}
+
+func (b *vsBlock) closeWAL() error {
+	w := b.wal
+	if w == nil {
+		return nil
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Close()
+	w.f = nil
+	return err
+}
+
+// removeWAL removes the WAL once the index entry and the header cover all entries, e.g. Block is archived.
+func (b *vsBlock) removeWAL(ctx context.Context) {
+	_ = b.closeWAL()
+	if err := os.Remove(b.walPath()); err != nil && !stderr.Is(err, os.ErrNotExist) {
+		log.Warning(ctx, "vsb: remove the metadata WAL failed.", map[string]interface{}{
+			"block_id":   b.id,
+			log.KeyError: err,
+		})
+	}
+}
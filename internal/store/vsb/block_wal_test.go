@@ -0,0 +1,126 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"os"
+	"testing"
+
+	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/vsb/index"
+	idxtest "github.com/linkall-labs/vanus/internal/store/vsb/index/testing"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
+)
+
+func TestWALRecords(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	Convey("encode and decode records of metadata WAL", t, func() {
+		data := encodeWALRecords([]index.Index{idxtest.MakeIndex0(ctrl), idxtest.MakeIndex1(ctrl)})
+		So(data, ShouldHaveLength, 2*walRecordSize)
+
+		indexes := decodeWALRecords(data, vsbtest.EntryOffset0)
+		So(indexes, ShouldHaveLength, 2)
+		idxtest.CheckIndex0(indexes[0], false)
+		idxtest.CheckIndex1(indexes[1], false)
+
+		Convey("stop at a torn record", func() {
+			So(decodeWALRecords(data[:2*walRecordSize-1], vsbtest.EntryOffset0), ShouldHaveLength, 1)
+			data[walRecordSize+1] ^= 0xff
+			So(decodeWALRecords(data, vsbtest.EntryOffset0), ShouldHaveLength, 1)
+		})
+
+		Convey("stop at a record which isn't contiguous", func() {
+			So(decodeWALRecords(data[walRecordSize:], vsbtest.EntryOffset0), ShouldBeEmpty)
+		})
+
+		Convey("keep key hashes", func() {
+			hash := index.HashKey([]byte("a"))
+			idx := index.NewIndex(vsbtest.EntryOffset0, vsbtest.EntrySize0, index.WithKeyHash(hash))
+			indexes := decodeWALRecords(encodeWALRecords([]index.Index{idx}), vsbtest.EntryOffset0)
+			So(indexes, ShouldHaveLength, 1)
+			So(indexes[0].KeyHash(), ShouldEqual, idx.KeyHash())
+		})
+	})
+}
+
+func TestVSBlock_OpenWithWAL(t *testing.T) {
+	ctrl := NewController(t)
+	defer ctrl.Finish()
+
+	Convey("open working vsb with metadata WAL", t, func() {
+		f, err := os.CreateTemp("", "*.vsb")
+		So(err, ShouldBeNil)
+		walPath := f.Name() + walExt
+
+		defer func() {
+			So(os.Remove(f.Name()), ShouldBeNil)
+			_ = os.Remove(walPath)
+		}()
+
+		_, err = f.WriteAt(vsbtest.EmptyHeaderData, 0)
+		So(err, ShouldBeNil)
+		_, err = f.WriteAt(vsbtest.EntryData0, vsbtest.EntryOffset0)
+		So(err, ShouldBeNil)
+		So(f.Close(), ShouldBeNil)
+
+		Convey("replay logged indexes", func() {
+			f, err = os.OpenFile(f.Name(), os.O_RDWR, 0)
+			So(err, ShouldBeNil)
+			_, err = f.WriteAt(vsbtest.EntryData1, vsbtest.EntryOffset1)
+			So(err, ShouldBeNil)
+			So(f.Close(), ShouldBeNil)
+			data := encodeWALRecords([]index.Index{idxtest.MakeIndex0(ctrl), idxtest.MakeIndex1(ctrl)})
+			So(os.WriteFile(walPath, data, defaultFilePerm), ShouldBeNil)
+
+			b := &vsBlock{path: f.Name(), walEnabled: true}
+			So(b.Open(context.Background()), ShouldBeNil)
+			So(b.walReplayed, ShouldEqual, 2)
+			So(b.indexes, ShouldHaveLength, 2)
+			idxtest.CheckIndex0(b.indexes[0], false)
+			idxtest.CheckIndex1(b.indexes[1], false)
+			So(b.actx.offset, ShouldEqual, vsbtest.EntryOffset1+vsbtest.EntrySize1)
+			So(b.wal.size, ShouldEqual, 2*walRecordSize)
+			So(b.closeWAL(), ShouldBeNil)
+			So(b.f.Close(), ShouldBeNil)
+		})
+
+		Convey("distrust the record of an entry which isn't written", func() {
+			data := encodeWALRecords([]index.Index{idxtest.MakeIndex0(ctrl), idxtest.MakeIndex1(ctrl)})
+			So(os.WriteFile(walPath, data, defaultFilePerm), ShouldBeNil)
+
+			b := &vsBlock{path: f.Name(), walEnabled: true}
+			So(b.Open(context.Background()), ShouldBeNil)
+			So(b.walReplayed, ShouldEqual, 0)
+			So(b.indexes, ShouldHaveLength, 1)
+			idxtest.CheckIndex0(b.indexes[0], false)
+
+			// Records are written again.
+			So(b.closeWAL(), ShouldBeNil)
+			data, err = os.ReadFile(walPath)
+			So(err, ShouldBeNil)
+			So(decodeWALRecords(data, vsbtest.EntryOffset0), ShouldHaveLength, 1)
+			So(data, ShouldHaveLength, walRecordSize)
+			So(b.f.Close(), ShouldBeNil)
+		})
+	})
+}
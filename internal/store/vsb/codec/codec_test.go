@@ -45,6 +45,19 @@ func TestAlignment(t *testing.T) {
 	})
 }
 
+func TestCheckPacket(t *testing.T) {
+	Convey("check packet", t, func() {
+		So(CheckPacket(vsbtest.EntryData0), ShouldBeTrue)
+		So(CheckPacket(vsbtest.EntryData1), ShouldBeTrue)
+		So(CheckPacket(vsbtest.EntryData0[:vsbtest.EntrySize0-1]), ShouldBeFalse)
+		So(CheckPacket(nil), ShouldBeFalse)
+
+		data := append([]byte(nil), vsbtest.EntryData0...)
+		data[vsbtest.EntrySize0/2] ^= 0xff
+		So(CheckPacket(data), ShouldBeFalse)
+	})
+}
+
 func TestEntryEncoder(t *testing.T) {
 	ctrl := NewController(t)
 	defer ctrl.Finish()
@@ -43,7 +43,7 @@ func (e *indexEntryEncoder) MarshalTo(entry block.Entry, buf []byte) (int, int,
 		data := buf[ordinal*e.indexSize : (ordinal+1)*e.indexSize]
 		binary.LittleEndian.PutUint64(data[0:], uint64(idx.StartOffset())) // offset
 		binary.LittleEndian.PutUint32(data[8:], uint32(idx.Length()))      // length
-		binary.LittleEndian.PutUint32(data[12:], 0)                        // reserved
+		binary.LittleEndian.PutUint32(data[12:], idx.KeyHash())            // key hash
 		binary.LittleEndian.PutUint64(data[16:], uint64(idx.Stime()))      // stime
 		num++
 	}))
@@ -72,9 +72,10 @@ func (d *indexEntryDecoder) Unmarshal(t uint16, offset int, data []byte) (block.
 		buf := payload[i : i+d.indexSize]
 		offset := int64(binary.LittleEndian.Uint64(buf[0:]))
 		length := int32(binary.LittleEndian.Uint32(buf[8:]))
+		keyHash := binary.LittleEndian.Uint32(buf[12:])
 		stime := int64(binary.LittleEndian.Uint64(buf[16:]))
 
-		idx := index.NewIndex(offset, length, index.WithStime(stime))
+		idx := index.NewIndex(offset, length, index.WithStime(stime), index.WithKeyHash(keyHash))
 		indexes = append(indexes, idx)
 	}
 
@@ -44,8 +44,25 @@ const (
 	packetCRCOffset     = packetLengthSize
 )
 
+// PacketMetaSize is the size of lengths and the checksum of a packet, no packet is smaller than it.
+const PacketMetaSize = packetMetaSize
+
 var crc32q = crc32.MakeTable(crc32.Castagnoli)
 
+// CheckPacket reports whether data is exactly one packet, whose lengths and checksum are intact. The entry
+// in it isn't decoded.
+func CheckPacket(data []byte) bool {
+	sz := len(data)
+	if sz < packetMetaSize {
+		return false
+	}
+	if int(binary.LittleEndian.Uint32(data[packetLengthOffset:])) != sz ||
+		int(binary.LittleEndian.Uint32(data[sz-packetFooterSize:])) != sz {
+		return false
+	}
+	return crc32.Checksum(data[:sz-packetCRCSize], crc32q) == binary.LittleEndian.Uint32(data[sz-packetCRCSize:])
+}
+
 type PacketDataEncoder interface {
 	Size(entry block.Entry) int
 	MarshalTo(entry block.Entry, buf []byte) (int, error)
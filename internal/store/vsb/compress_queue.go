@@ -0,0 +1,116 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"sync"
+)
+
+// compressWorkers is the number of blocks compressed at once after the engine is opened.
+const compressWorkers = 2
+
+// compressQueue compresses archived blocks which are opened uncompressed by a fixed number of workers, so
+// that opening many of them doesn't compress them all at once.
+type compressQueue struct {
+	mu     sync.Mutex
+	cond   *sync.Cond
+	blocks []*vsBlock
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func newCompressQueue(workers int) *compressQueue {
+	q := &compressQueue{}
+	q.cond = sync.NewCond(&q.mu)
+	q.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go q.run()
+	}
+	return q
+}
+
+// enqueue queues b for compression, b isn't closed until it's compressed or removed from the queue.
+func (q *compressQueue) enqueue(b *vsBlock) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	b.wg.Add(1)
+	b.compressQ = q
+	q.blocks = append(q.blocks, b)
+	q.cond.Signal()
+}
+
+// remove drops b from the queue if it's still waiting, so that closing it doesn't wait for other blocks.
+func (q *compressQueue) remove(b *vsBlock) {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, qb := range q.blocks {
+		if qb == b {
+			q.blocks = append(q.blocks[:i], q.blocks[i+1:]...)
+			b.wg.Done()
+			return
+		}
+	}
+}
+
+func (q *compressQueue) run() {
+	defer q.wg.Done()
+	for {
+		q.mu.Lock()
+		for len(q.blocks) == 0 && !q.closed {
+			q.cond.Wait()
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return
+		}
+		b := q.blocks[0]
+		q.blocks[0] = nil
+		q.blocks = q.blocks[1:]
+		q.mu.Unlock()
+
+		b.compressArchived(context.Background())
+		b.wg.Done()
+	}
+}
+
+// close stops workers once blocks being compressed are done, waiting blocks are left uncompressed until
+// they are opened again.
+func (q *compressQueue) close() {
+	if q == nil {
+		return
+	}
+	q.mu.Lock()
+	q.closed = true
+	blocks := q.blocks
+	q.blocks = nil
+	q.cond.Broadcast()
+	q.mu.Unlock()
+
+	for _, b := range blocks {
+		b.wg.Done()
+	}
+	q.wg.Wait()
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCompressQueue(t *testing.T) {
+	Convey("test compress queue", t, func() {
+		Convey("blocks are compressed by workers", func() {
+			q := newCompressQueue(1)
+			defer q.close()
+
+			blocks := []*vsBlock{{}, {}, {}}
+			for _, b := range blocks {
+				q.enqueue(b)
+			}
+			for _, b := range blocks {
+				b.wg.Wait()
+			}
+			q.mu.Lock()
+			So(q.blocks, ShouldBeEmpty)
+			q.mu.Unlock()
+		})
+
+		Convey("a waiting block is removed once it's closed", func() {
+			q := newCompressQueue(0)
+			defer q.close()
+
+			b0, b1 := &vsBlock{}, &vsBlock{}
+			q.enqueue(b0)
+			q.enqueue(b1)
+			q.remove(b0)
+			b0.wg.Wait()
+			So(q.blocks, ShouldResemble, []*vsBlock{b1})
+
+			// removing a block which isn't waiting is no-op.
+			q.remove(b0)
+			So(q.blocks, ShouldHaveLength, 1)
+		})
+
+		Convey("waiting blocks are dropped once the queue is closed", func() {
+			q := newCompressQueue(0)
+			b := &vsBlock{}
+			q.enqueue(b)
+			q.close()
+			b.wg.Wait()
+			So(q.blocks, ShouldBeEmpty)
+
+			q.enqueue(&vsBlock{})
+			So(q.blocks, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"fmt"
+	"sync"
+
+	// third-party libraries.
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression is the codec archived blocks are compressed by.
+type Compression string
+
+const (
+	CompressionNone   Compression = ""
+	CompressionSnappy Compression = "snappy"
+	CompressionZstd   Compression = "zstd"
+)
+
+// Codecs of block files, which are persisted in flags of the header.
+const (
+	codecNone   uint8 = 0
+	codecSnappy uint8 = 1
+	codecZstd   uint8 = 2
+	// codecZstdDict is zstd with the dictionary kept in the block, which is located by metadata of the header.
+	codecZstdDict uint8 = 3
+)
+
+func (c Compression) codec() (uint8, error) {
+	switch c {
+	case CompressionNone:
+		return codecNone, nil
+	case CompressionSnappy:
+		return codecSnappy, nil
+	case CompressionZstd:
+		return codecZstd, nil
+	}
+	return 0, fmt.Errorf("unknown compression: %s", c)
+}
+
+type compressor interface {
+	compress(src []byte) []byte
+	decompress(src []byte, size int) ([]byte, error)
+}
+
+func compressorOf(codec uint8) (compressor, error) {
+	switch codec {
+	case codecSnappy:
+		return snappyCompressor{}, nil
+	case codecZstd:
+		return newZstdCompressor()
+	}
+	return nil, fmt.Errorf("unknown codec of block: %d", codec)
+}
+
+type snappyCompressor struct{}
+
+func (snappyCompressor) compress(src []byte) []byte {
+	return snappy.Encode(nil, src)
+}
+
+func (snappyCompressor) decompress(src []byte, size int) ([]byte, error) {
+	return snappy.Decode(make([]byte, size), src)
+}
+
+type zstdCompressor struct {
+	enc *zstd.Encoder
+	dec *zstd.Decoder
+}
+
+var (
+	zstdOnce sync.Once
+	zstdC    *zstdCompressor
+	errZstd  error
+)
+
+// newZstdCompressor returns the shared compressor, both EncodeAll and DecodeAll are safe to be called
+// concurrently.
+func newZstdCompressor() (*zstdCompressor, error) {
+	zstdOnce.Do(func() {
+		enc, err := zstd.NewWriter(nil)
+		if err != nil {
+			errZstd = err
+			return
+		}
+		dec, err := zstd.NewReader(nil)
+		if err != nil {
+			errZstd = err
+			return
+		}
+		zstdC = &zstdCompressor{enc: enc, dec: dec}
+	})
+	return zstdC, errZstd
+}
+
+func (c *zstdCompressor) compress(src []byte) []byte {
+	return c.enc.EncodeAll(src, nil)
+}
+
+func (c *zstdCompressor) decompress(src []byte, size int) ([]byte, error) {
+	return c.dec.DecodeAll(src, make([]byte, 0, size))
+}
@@ -18,9 +18,13 @@ import (
 	// standard libraries.
 	"time"
 
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/util/clock"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
 	ioengine "github.com/linkall-labs/vanus/internal/store/io/engine"
+	"github.com/linkall-labs/vanus/internal/store/io/engine/fsync"
 	"github.com/linkall-labs/vanus/internal/store/io/engine/psync"
 )
 
@@ -34,6 +38,20 @@ type config struct {
 	flushBatchSize int
 	flushDelayTime time.Duration
 	lis            block.ArchivedListener
+	repairIndex    bool
+	clock          *clock.Monotonic
+	// syncMode is empty if block files are opened with O_SYNC, so that each write is synchronous.
+	syncMode     fsync.Mode
+	syncInterval time.Duration
+	mmapRead     bool
+	compression  Compression
+	// preallocate is the flag indicating files of blocks are allocated in advance, spares are kept by size.
+	preallocate bool
+	spares      int
+	// directIO is the flag indicating appends bypass the page cache.
+	directIO    bool
+	metaWAL     bool
+	sparseIndex sparseIndex
 }
 
 func defaultConfig() config {
@@ -54,6 +72,9 @@ func makeConfig(opts ...Option) config {
 	if cfg.engine == nil {
 		cfg.engine = defaultIOEngine()
 	}
+	if cfg.clock == nil {
+		cfg.clock = &clock.Monotonic{}
+	}
 	return cfg
 }
 
@@ -79,8 +100,85 @@ func WithFlushDelayTime(d time.Duration) Option {
 	}
 }
 
+// WithSyncMode opens block files without O_SYNC, and syncs them by mode instead of each write, interval
+// is the period of syncing in fsync.ModeInterval.
+func WithSyncMode(mode fsync.Mode, interval time.Duration) Option {
+	return func(cfg *config) {
+		cfg.syncMode = mode
+		cfg.syncInterval = interval
+	}
+}
+
+// WithMmapRead makes archived blocks read from read-only memory maps instead of ReadAt, blocks are read
+// from files as before on platforms without mmap.
+func WithMmapRead(enabled bool) Option {
+	return func(cfg *config) {
+		cfg.mmapRead = enabled
+	}
+}
+
+// WithCompression compresses blocks in chunks once they are archived, reads decompress them transparently.
+// Blocks which are already compressed are readable regardless of it.
+func WithCompression(c Compression) Option {
+	return func(cfg *config) {
+		cfg.compression = c
+	}
+}
+
+// WithPreallocation allocates files of blocks in full when they are created instead of growing them on
+// demand, and keeps the given number of spare files of each block size ready, files of deleted blocks are
+// recycled as spares.
+func WithPreallocation(spares int) Option {
+	return func(cfg *config) {
+		cfg.preallocate = true
+		cfg.spares = spares
+	}
+}
+
+// WithDirectIO makes appends to block files written with O_DIRECT in aligned buffers of the stream, and
+// with O_DSYNC unless a sync mode is set. Other I/O of blocks, e.g. headers and reads, goes through the
+// page cache as before. Blocks are written with buffered I/O if the file system rejects O_DIRECT.
+func WithDirectIO(enabled bool) Option {
+	return func(cfg *config) {
+		cfg.directIO = enabled
+	}
+}
+
+// WithMetaWAL logs indexes of entries appended to working blocks in a WAL beside each block file, so that
+// indexes are replayed from it after a crash instead of rescanning all entries of the block.
+func WithMetaWAL(enabled bool) Option {
+	return func(cfg *config) {
+		cfg.metaWAL = enabled
+	}
+}
+
+// WithSparseIndex keeps indexes of every stride-th entry in memory for archived blocks whose average entry
+// size is at most maxEntrySize, instead of indexes of all entries, entries between them are found by scanning
+// their stride when they are read. It saves memory of blocks holding many tiny entries at the cost of reading
+// up to a stride of extra entries.
+func WithSparseIndex(stride, maxEntrySize int) Option {
+	return func(cfg *config) {
+		cfg.sparseIndex = sparseIndex{stride: stride, maxEntrySize: maxEntrySize}
+	}
+}
+
+// WithIndexRepair makes blocks whose metadata is inconsistent with entries repaired by rescanning when they
+// are opened, instead of failing to open.
+func WithIndexRepair(enabled bool) Option {
+	return func(cfg *config) {
+		cfg.repairIndex = enabled
+	}
+}
+
 func WithArchivedListener(lis block.ArchivedListener) Option {
 	return func(cfg *config) {
 		cfg.lis = lis
 	}
 }
+
+// WithClock sets the clock issuing ingestion timestamps, which is shared by all blocks of the engine.
+func WithClock(clk *clock.Monotonic) Option {
+	return func(cfg *config) {
+		cfg.clock = clk
+	}
+}
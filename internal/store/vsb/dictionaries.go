@@ -0,0 +1,179 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	// third-party libraries.
+	"github.com/klauspost/compress/zstd"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/vsb/dictionary"
+)
+
+const (
+	dictDirName = "dicts"
+	dictExt     = ".dict"
+	dictTmpExt  = ".tmp"
+)
+
+// dictionaries keeps zstd dictionaries blocks are bound to. Dictionaries are saved in files named by their
+// IDs, which are kept in headers of blocks, so that blocks are compressed with them after a restart.
+// Compressed blocks keep their own copies, so they are readable without the files.
+type dictionaries struct {
+	dir string
+
+	mu     sync.Mutex
+	loaded map[uint32]*loadedDict
+}
+
+type loadedDict struct {
+	dict []byte
+	cmp  *zstdCompressor
+}
+
+func newDictionaries(dir string) (*dictionaries, error) {
+	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
+		return nil, err
+	}
+	return &dictionaries{
+		dir:    dir,
+		loaded: make(map[uint32]*loadedDict),
+	}, nil
+}
+
+// register saves dict if it's unknown, and returns its ID.
+func (d *dictionaries) register(dict []byte) (uint32, error) {
+	id, err := dictionary.ID(dict)
+	if err != nil {
+		return 0, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if ld, ok := d.loaded[id]; ok && bytes.Equal(ld.dict, dict) {
+		return id, nil
+	}
+	path := d.resolvePath(id)
+	if data, err2 := os.ReadFile(path); err2 == nil && bytes.Equal(data, dict) {
+		return id, d.load(id, dict)
+	}
+
+	// Write to a temporary file first, so that a dictionary file is either complete or absent.
+	tmp := path + dictTmpExt
+	if err = os.WriteFile(tmp, dict, defaultFilePerm); err != nil {
+		return 0, err
+	}
+	if err = syncFile(tmp); err != nil {
+		_ = os.Remove(tmp)
+		return 0, err
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return 0, err
+	}
+	return id, d.load(id, dict)
+}
+
+// compressor returns the compressor of the dictionary with id and the dictionary, the dictionary is loaded
+// from its file if it isn't cached.
+func (d *dictionaries) compressor(id uint32) (compressor, []byte, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if ld, ok := d.loaded[id]; ok {
+		return ld.cmp, ld.dict, nil
+	}
+	dict, err := os.ReadFile(d.resolvePath(id))
+	if err != nil {
+		return nil, nil, err
+	}
+	if got, err2 := dictionary.ID(dict); err2 != nil || got != id {
+		return nil, nil, fmt.Errorf("the dictionary file of %d is corrupted", id)
+	}
+	if err = d.load(id, dict); err != nil {
+		return nil, nil, err
+	}
+	return d.loaded[id].cmp, dict, nil
+}
+
+// embedded returns the compressor of dict kept in a compressed block. The compressor is shared by blocks
+// keeping the same dictionary, d may be nil, in which case it isn't shared.
+func (d *dictionaries) embedded(dict []byte) (compressor, error) {
+	id, err := dictionary.ID(dict)
+	if err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return newDictCompressor(dict)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if ld, ok := d.loaded[id]; ok && bytes.Equal(ld.dict, dict) {
+		return ld.cmp, nil
+	}
+	if err = d.load(id, dict); err != nil {
+		return nil, err
+	}
+	return d.loaded[id].cmp, nil
+}
+
+// load builds the compressor of dict, the caller must hold mu.
+func (d *dictionaries) load(id uint32, dict []byte) error {
+	cmp, err := newDictCompressor(dict)
+	if err != nil {
+		return err
+	}
+	d.loaded[id] = &loadedDict{dict: dict, cmp: cmp}
+	return nil
+}
+
+func newDictCompressor(dict []byte) (*zstdCompressor, error) {
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dict))
+	if err != nil {
+		return nil, err
+	}
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dict))
+	if err != nil {
+		_ = enc.Close()
+		return nil, err
+	}
+	return &zstdCompressor{enc: enc, dec: dec}, nil
+}
+
+func (d *dictionaries) resolvePath(id uint32) string {
+	return filepath.Join(d.dir, fmt.Sprintf("%d%s", id, dictExt))
+}
+
+func syncFile(path string) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dictionary checks zstd dictionaries of eventbuses. Small events, e.g. JSON of a few hundred bytes,
+// share little within themselves but much with each other, so they compress far better with a dictionary.
+// Dictionaries are trained by the reference trainer, i.e. zstd --train, which vsctl runs on sampled events,
+// since blocks compressed with them are archived for long.
+package dictionary
+
+import (
+	// standard libraries.
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	// third-party libraries.
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// DefaultSize is the default size of dictionaries.
+	DefaultSize = 16 * 1024
+	// MaxSize limits dictionaries, larger ones rarely pay off for small events, and every block compressed
+	// with a dictionary keeps a copy of it.
+	MaxSize = 64 * 1024
+
+	// MinID and MaxID bound IDs of dictionaries, IDs below 32768 and from 2^31 are reserved by zstd.
+	MinID = 1 << 15
+	MaxID = 1<<31 - 1
+
+	magic      = uint32(0xEC30A437)
+	headerSize = 4 + 4
+)
+
+// probe is compressed to check a dictionary can be used by both the encoder and the decoder.
+var probe = []byte(`{"specversion":"1.0","id":"probe","source":"/vanus","type":"probe","data":{}}`)
+
+var ErrInvalid = errors.New("invalid zstd dictionary")
+
+// ID returns the ID of a zstd dictionary, it only checks the header of dict.
+func ID(dict []byte) (uint32, error) {
+	if len(dict) <= headerSize || binary.LittleEndian.Uint32(dict) != magic {
+		return 0, ErrInvalid
+	}
+	id := binary.LittleEndian.Uint32(dict[4:])
+	if id == 0 {
+		return 0, ErrInvalid
+	}
+	return id, nil
+}
+
+// SetID returns a copy of dict with ID id.
+func SetID(dict []byte, id uint32) ([]byte, error) {
+	if _, err := ID(dict); err != nil {
+		return nil, err
+	}
+	if id < MinID || id > MaxID {
+		return nil, ErrInvalid
+	}
+	dup := append([]byte{}, dict...)
+	binary.LittleEndian.PutUint32(dup[4:], id)
+	return dup, nil
+}
+
+// Validate checks if dict is a zstd dictionary, e.g. one trained by zstd --train, which blocks can be
+// compressed and decompressed with.
+func Validate(dict []byte) error {
+	if _, err := ID(dict); err != nil {
+		return err
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dict))
+	if err != nil {
+		return ErrInvalid
+	}
+	defer func() {
+		_ = enc.Close()
+	}()
+	dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dict))
+	if err != nil {
+		return ErrInvalid
+	}
+	defer dec.Close()
+
+	data, err := dec.DecodeAll(enc.EncodeAll(probe, nil), nil)
+	if err != nil || !bytes.Equal(data, probe) {
+		return ErrInvalid
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dictionary
+
+import (
+	// standard libraries.
+	"testing"
+
+	// third-party libraries.
+	"github.com/klauspost/compress/zstd"
+	. "github.com/smartystreets/goconvey/convey"
+
+	// this project.
+	dicttest "github.com/linkall-labs/vanus/internal/store/vsb/dictionary/testing"
+)
+
+func TestValidate(t *testing.T) {
+	Convey("test validate dictionary trained by zstd", t, func() {
+		dict := dicttest.Dictionary
+		So(Validate(dict), ShouldBeNil)
+
+		id, err := ID(dict)
+		So(err, ShouldBeNil)
+		So(id, ShouldEqual, 0x63918461)
+
+		Convey("compress with the dictionary", func() {
+			enc, err := zstd.NewWriter(nil, zstd.WithEncoderDict(dict))
+			So(err, ShouldBeNil)
+			dec, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dict))
+			So(err, ShouldBeNil)
+			plain, err := zstd.NewWriter(nil)
+			So(err, ShouldBeNil)
+
+			var withDict, withoutDict int
+			for _, s := range dicttest.MakeSamples(2, 50) {
+				out := enc.EncodeAll(s, nil)
+				data, err := dec.DecodeAll(out, nil)
+				So(err, ShouldBeNil)
+				So(data, ShouldResemble, s)
+				withDict += len(out)
+				withoutDict += len(plain.EncodeAll(s, nil))
+			}
+			So(withDict, ShouldBeLessThan, withoutDict)
+		})
+
+		Convey("entropy tables are invalid", func() {
+			dict2 := append([]byte{}, dict...)
+			// the literal table is at the beginning of entropy tables.
+			for i := headerSize; i < headerSize+16; i++ {
+				dict2[i] = 0xFF
+			}
+			So(Validate(dict2), ShouldEqual, ErrInvalid)
+		})
+	})
+}
+
+func TestID(t *testing.T) {
+	Convey("test dictionary id", t, func() {
+		_, err := ID(nil)
+		So(err, ShouldEqual, ErrInvalid)
+		_, err = ID([]byte("not a zstd dictionary"))
+		So(err, ShouldEqual, ErrInvalid)
+		So(Validate([]byte("not a zstd dictionary")), ShouldEqual, ErrInvalid)
+
+		Convey("set id of dictionary", func() {
+			dict := dicttest.Dictionary
+			dict2, err := SetID(dict, MinID+1)
+			So(err, ShouldBeNil)
+			id, err := ID(dict2)
+			So(err, ShouldBeNil)
+			So(id, ShouldEqual, MinID+1)
+			So(dict2[headerSize:], ShouldResemble, dict[headerSize:])
+			So(Validate(dict2), ShouldBeNil)
+
+			// the dictionary isn't modified.
+			id, _ = ID(dict)
+			So(id, ShouldEqual, 0x63918461)
+
+			_, err = SetID(dict, MinID-1)
+			So(err, ShouldEqual, ErrInvalid)
+			_, err = SetID(dict, MaxID+1)
+			So(err, ShouldEqual, ErrInvalid)
+		})
+	})
+}
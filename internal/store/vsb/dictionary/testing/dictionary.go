@@ -0,0 +1,50 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testing
+
+import (
+	// standard libraries.
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math/rand"
+)
+
+// Dictionary is a 4KB dictionary trained by zstd --train with 500 samples of MakeSamples(1, 500), its ID
+// is 0x63918461.
+//
+//go:embed orders.dict
+var Dictionary []byte
+
+// MakeSamples returns n events of orders in JSON, the same seed makes the same events.
+func MakeSamples(seed int64, n int) [][]byte {
+	r := rand.New(rand.NewSource(seed)) //nolint:gosec // it's fine for tests.
+	fields := []string{"orderId", "customer", "status", "amount", "currency", "sku", "quantity"}
+	samples := make([][]byte, n)
+	for i := range samples {
+		var b bytes.Buffer
+		fmt.Fprintf(&b, `{"specversion":"1.0","type":"com.example.order.created","source":"/shop/%d",`, r.Intn(5))
+		fmt.Fprintf(&b, `"id":"%08x","data":{`, r.Uint32())
+		for j, f := range fields {
+			if j > 0 {
+				b.WriteByte(',')
+			}
+			fmt.Fprintf(&b, `%q:"%d"`, f, r.Intn(100000))
+		}
+		b.WriteString("}}")
+		samples[i] = b.Bytes()
+	}
+	return samples
+}
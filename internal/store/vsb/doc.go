@@ -28,23 +28,59 @@
 //	├─────────────────┼───┬───┬─────────┼─────────────────┴─────────────────┤
 //	│  Data Offset(4) │(1)│(1)│ Size(2) │            Capacity(8)            │
 //	├─────────────────┴───┴───┴─────────┼─────────────────┬─────────┬───────┤
-//	│          Entry Length(8)          │   Entry Num(4)  │Offset(2)│       ;
-//	└───────────────────────────────────┴─────────────────┴─────────┴-------'
+//	│          Entry Length(8)          │   Entry Num(4)  │Offset(2)│ Len(2)│
+//	├─────────────────┬─────────────────┴─────────────────┴─────────┴───────┤
+//	│ Metadata CRC(4) │                  Metadata ...                       │
+//	└─────────────────┴─────────────────────────────────────────────────────┘
 //
 // All values little-endian
 //
 //	+00 4B Magic number (0x00627376, "vsb" in ASCII)
-//	+04 4B CRC-32c of header block
+//	+04 4B CRC-32c of fixed fields (+08 to +2E), followed by zeros to the end of header block
 //	+08 4B Flags
 //	+0C 4B Break Flags
 //	+10 4B Data Offset (in bytes, currently 4096)
 //	+14 1B State (0: working, 1: archived)
-//	+15 1B Reserved
+//	+15 1B Version (0: v1, 1: v2)
 //	+16 2B Index Size (in bytes, currently 24)
 //	+18 8B Capacity (in bytes)
 //	+20 8B Entry Length (in bytes)
 //	+28 4B Entry Num (number of entries)
 //	+2C 2B Index Offset (in bytes)
+//	+2E 2B Metadata Length (in bytes, v2 only)
+//	+30 4B CRC-32c of Metadata Length and Metadata (v2 only)
+//	+34    Metadata (v2 only)
+//
+// v1 headers end at +2E, they are migrated to v2 once blocks are opened. Readers of v1 ignore the version
+// and metadata, so they can still open blocks with v2 headers.
+//
+// The layout of `Metadata` is:
+//
+//	┌─────────────────┬─────────────────┬───────────────────────────────────┐
+//	│      Tag(2)     │    Length(2)    │             Value ...             │
+//	├─────────────────┴─────────────────┴───────────────────────────────────┤
+//	│                                  ...                                  │
+//	└───────────────────────────────────────────────────────────────────────┘
+//
+// All values little-endian
+//
+//	+00 2B Tag (the high bit is set if readers unaware of it must refuse the block)
+//	+02 2B Length (in bytes)
+//	+04    Value
+//
+// Tags are:
+//
+//	0x0001 1B Checksum algorithm (1: CRC-32c)
+//	0x0002 8B Created-at (millisecond timestamp)
+//	0x0003 8B Eventlog ID
+//	0x0004 8B Write lease epoch of the replica
+//	0x0005 4B ID of the zstd dictionary of the eventbus
+//	0x8006 16B Offset(8), length(4) and CRC-32c(4) of the zstd dictionary kept in the compressed block
+//	0x0007 12B Size(8) and CRC-32c(4) of data after the header block, kept once the block is archived
+//
+// The compression codec is kept in Flags, so that readers unaware of compression refuse compressed blocks.
+// Codec 3 is zstd with the dictionary, which is kept between the index entry and the chunk table, so that
+// the block is readable without the dicts directory of the engine.
 //
 // The layout of `Packet` is:
 //
@@ -111,7 +147,7 @@
 //	┌───────────────────────────────────────────────────────────────────────┐
 //	│                               Offset(8)                               │
 //	├───────────────────────────────────┬───────────────────────────────────┤
-//	│               Length(4)           │            Reserved(4)            │
+//	│               Length(4)           │            Key Hash(4)            │
 //	├───────────────────────────────────┴───────────────────────────────────┤
 //	│                                Stime(8)                               │
 //	└───────────────────────────────────────────────────────────────────────┘
@@ -120,6 +156,7 @@
 //
 //	+00 8B Offset
 //	+08 4B Length (in bytes)
-//	+0C 4B Reserved (all 0)
+//	+0C 4B Key Hash (FNV-1a of the partitionkey extension, 0 if the entry has no key, or it's written by
+//	       older versions, which reserved it)
 //	+10 8B Stime
 package vsb
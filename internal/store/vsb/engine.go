@@ -16,12 +16,22 @@ package vsb
 
 import (
 	// standard libraries.
+	"fmt"
 	"os"
+	"path/filepath"
+
+	// third-party libraries.
+	"github.com/ncw/directio"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/pkg/util/clock"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block"
 	"github.com/linkall-labs/vanus/internal/store/block/raw"
+	sio "github.com/linkall-labs/vanus/internal/store/io"
+	"github.com/linkall-labs/vanus/internal/store/io/engine/fsync"
 	"github.com/linkall-labs/vanus/internal/store/io/stream"
 )
 
@@ -33,6 +43,29 @@ type engine struct {
 	dir string
 	s   stream.Scheduler
 	lis block.ArchivedListener
+	clk *clock.Monotonic
+
+	repairIndex bool
+	// buffered is the flag indicating block files are synced by the io engine instead of O_SYNC.
+	buffered bool
+	// syncs notifies once written data of block files is synced, it's nil if the io engine doesn't sync them.
+	syncs fsync.Notifier
+	// mmapRead is the flag indicating archived blocks are read from memory maps.
+	mmapRead bool
+	// compression compresses archived blocks.
+	compression Compression
+	// pool is nil if files of blocks aren't preallocated.
+	pool *filePool
+	// compressQ compresses blocks which are opened archived but uncompressed.
+	compressQ *compressQueue
+	// dicts keeps zstd dictionaries blocks are compressed with.
+	dicts *dictionaries
+	// direct opens files which streams of blocks write to, it's nil if direct I/O is disabled.
+	direct sio.Opener
+	// metaWAL is the flag indicating indexes of working blocks are logged, so recovery doesn't rescan them.
+	metaWAL bool
+	// sparseIndex selects archived blocks indexed sparsely.
+	sparseIndex sparseIndex
 }
 
 // Make sure engine implements raw.Engine.
@@ -40,7 +73,9 @@ var _ raw.Engine = (*engine)(nil)
 
 func (e *engine) Close() {
 	// TODO(james.yin): check me
+	e.compressQ.close()
 	e.s.Close()
+	e.pool.close()
 }
 
 func (e *engine) GetBlockStatistics(id vanus.ID, r block.Raw) (block.Statistics, error) {
@@ -63,11 +98,50 @@ func initialize(dir string, cfg config) error {
 		return err
 	}
 
-	s := stream.NewScheduler(cfg.engine, cfg.flushBatchSize, cfg.flushDelayTime)
+	e := cfg.engine
+	if cfg.syncMode != "" {
+		e = fsync.New(e, fsync.WithMode(cfg.syncMode), fsync.WithInterval(cfg.syncInterval))
+	}
+	syncs, _ := e.(fsync.Notifier)
+	var pool *filePool
+	if cfg.preallocate {
+		var err error
+		if pool, err = newFilePool(filepath.Join(dir, poolDirName), cfg.spares); err != nil {
+			return err
+		}
+	}
+
+	dicts, err := newDictionaries(filepath.Join(dir, dictDirName))
+	if err != nil {
+		return err
+	}
+
+	var direct sio.Opener
+	if cfg.directIO {
+		if cfg.flushBatchSize%directio.BlockSize != 0 {
+			return fmt.Errorf("flush batch size of vsb must be a multiple of %d for direct I/O", directio.BlockSize)
+		}
+		direct = sio.NewDirectOpener()
+	}
+
+	s := stream.NewScheduler(e, cfg.flushBatchSize, cfg.flushDelayTime)
 
 	return raw.RegisterEngine(raw.VSB, &engine{
 		dir: dir,
 		s:   s,
 		lis: cfg.lis,
+		clk: cfg.clock,
+
+		repairIndex: cfg.repairIndex,
+		buffered:    cfg.syncMode != "",
+		syncs:       syncs,
+		mmapRead:    cfg.mmapRead,
+		compression: cfg.compression,
+		pool:        pool,
+		compressQ:   newCompressQueue(compressWorkers),
+		dicts:       dicts,
+		direct:      direct,
+		metaWAL:     cfg.metaWAL,
+		sparseIndex: cfg.sparseIndex,
 	})
 }
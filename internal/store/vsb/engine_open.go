@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	// first-party libraries.
 	"github.com/linkall-labs/vanus/pkg/errors"
@@ -27,6 +28,7 @@ import (
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/store/block"
+	"github.com/linkall-labs/vanus/internal/store/io/zone"
 	"github.com/linkall-labs/vanus/internal/store/io/zone/file"
 	"github.com/linkall-labs/vanus/internal/store/vsb/codec"
 )
@@ -39,16 +41,12 @@ const (
 func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block.Raw, error) {
 	path := e.resolvePath(id)
 
-	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_RDWR|os.O_SYNC, defaultFilePerm)
+	f, err := e.createFile(path, capacity)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = f.Truncate(capacity); err != nil {
-		return nil, processError(err, f, path)
-	}
-
-	dec, _ := codec.NewDecoder(false, codec.IndexSize)
+	dec, _ := codec.NewDecoder(true, codec.IndexSize)
 	b := &vsBlock{
 		id:         id,
 		path:       path,
@@ -64,14 +62,30 @@ func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block
 		enc: codec.NewEncoder(),
 		dec: dec,
 		lis: e.lis,
+		clk: e.clk,
 		f:   f,
+
+		buffered:    e.buffered,
+		syncs:       e.syncs,
+		mmap:        mapping{enabled: e.mmapRead},
+		compression: e.compression,
+		pool:        e.pool,
+		dicts:       e.dicts,
+		walEnabled:  e.metaWAL,
+		sparse:      e.sparseIndex,
+		hm: headerMeta{
+			createdAt: time.Now().UnixMilli(),
+		},
 	}
 
 	if err := b.persistHeader(ctx, b.fm); err != nil {
 		return nil, processError(err, f, path)
 	}
+	if err := b.openWAL(ctx); err != nil {
+		return nil, processError(err, f, path)
+	}
 
-	if z, err := file.New(f); err == nil {
+	if z, err := e.openZone(b); err == nil {
 		b.z = z
 	} else {
 		return nil, processError(err, f, path)
@@ -82,6 +96,48 @@ func (e *engine) Create(ctx context.Context, id vanus.ID, capacity int64) (block
 	return b, nil
 }
 
+// createFile creates the file of a block in capacity, a spare file is taken from the pool if files are
+// preallocated.
+func (e *engine) createFile(path string, capacity int64) (*os.File, error) {
+	if e.pool != nil && e.pool.take(path, capacity) {
+		f, err := os.OpenFile(path, openFlag(e.buffered), 0)
+		if err != nil {
+			_ = os.Remove(path)
+			return nil, err
+		}
+		return f, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|openFlag(e.buffered), defaultFilePerm)
+	if err != nil {
+		return nil, err
+	}
+	if e.pool != nil {
+		err = preallocate(f, capacity)
+	} else {
+		err = f.Truncate(capacity)
+	}
+	if err != nil {
+		return nil, processError(err, f, path)
+	}
+	return f, nil
+}
+
+// openZone returns the zone which the stream of Block writes to. With direct I/O, the file is opened again
+// for the stream, whose buffers are aligned and written in whole, since other writes and reads of Block
+// aren't aligned. Archived blocks are never appended, so they don't need it.
+func (e *engine) openZone(b *vsBlock) (zone.Interface, error) {
+	if e.direct == nil || b.actx.Archived() {
+		return file.New(b.f)
+	}
+	f, err := e.direct.OpenFile(b.path, false, !e.buffered)
+	if err != nil {
+		return nil, err
+	}
+	b.df = f
+	return file.New(f)
+}
+
 func processError(err error, f *os.File, path string) error {
 	if err2 := f.Close(); err2 != nil {
 		return errors.Chain(err, err2)
@@ -96,23 +152,45 @@ func (e *engine) Open(ctx context.Context, id vanus.ID) (block.Raw, error) {
 	path := e.resolvePath(id)
 
 	b := &vsBlock{
-		id:   id,
-		path: path,
-		lis:  e.lis,
+		id:          id,
+		path:        path,
+		lis:         e.lis,
+		clk:         e.clk,
+		repair:      e.repairIndex,
+		buffered:    e.buffered,
+		syncs:       e.syncs,
+		mmap:        mapping{enabled: e.mmapRead},
+		compression: e.compression,
+		pool:        e.pool,
+		dicts:       e.dicts,
+		walEnabled:  e.metaWAL,
+		sparse:      e.sparseIndex,
 	}
 
 	if err := b.Open(ctx); err != nil {
 		return nil, err
 	}
 
-	if z, err := file.New(b.f); err == nil {
+	// Carry on from the last ingestion timestamp, in case the clock has been stepped back since it was
+	// written.
+	if n := len(b.indexes); n > 0 && e.clk != nil {
+		e.clk.Observe(b.indexes[n-1].Stime())
+	}
+
+	if z, err := e.openZone(b); err == nil {
 		b.z = z
 	} else {
+		_ = b.f.Close()
 		return nil, err
 	}
 
 	b.s = e.s.Register(b.z, b.actx.offset)
 
+	// Blocks archived before compression is enabled, or before they were compressed, are compressed now.
+	if b.compression != CompressionNone && b.codec == codecNone && b.actx.Archived() {
+		e.compressQ.enqueue(b)
+	}
+
 	return b, nil
 }
 
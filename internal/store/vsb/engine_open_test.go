@@ -16,16 +16,29 @@ package vsb
 
 import (
 	// standard libraries.
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"testing"
+	"unsafe"
 
 	// third-party libraries.
+	. "github.com/golang/mock/gomock"
+	"github.com/ncw/directio"
 	. "github.com/smartystreets/goconvey/convey"
 
 	// this project.
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/block"
+	sio "github.com/linkall-labs/vanus/internal/store/io"
+	ioengine "github.com/linkall-labs/vanus/internal/store/io/engine"
+	"github.com/linkall-labs/vanus/internal/store/io/engine/psync"
+	"github.com/linkall-labs/vanus/internal/store/io/stream"
+	"github.com/linkall-labs/vanus/internal/store/io/zone"
+	cetest "github.com/linkall-labs/vanus/internal/store/schema/ce/testing"
+	vsbtest "github.com/linkall-labs/vanus/internal/store/vsb/testing"
 )
 
 func TestEngine_ResolvePath(t *testing.T) {
@@ -48,3 +61,111 @@ func TestEngine_ResolvePath(t *testing.T) {
 		So(id2, ShouldEqual, id)
 	})
 }
+
+// writeRecorder records writes of streams, which must be aligned for direct I/O.
+type writeRecorder struct {
+	ioengine.Interface
+	mu     sync.Mutex
+	writes [][2]int64
+	// aligned records whether buffers are aligned in memory.
+	aligned []bool
+}
+
+func (r *writeRecorder) WriteAt(z zone.Interface, b []byte, off int64, so, eo int, cb sio.WriteCallback) {
+	r.mu.Lock()
+	r.writes = append(r.writes, [2]int64{off, int64(len(b))})
+	r.aligned = append(r.aligned, uintptr(unsafe.Pointer(&b[0]))%directio.AlignSize == 0)
+	r.mu.Unlock()
+	r.Interface.WriteAt(z, b, off, so, eo, cb)
+}
+
+func (r *writeRecorder) checkAligned() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	So(r.writes, ShouldNotBeEmpty)
+	for i, w := range r.writes {
+		So(w[0]%directio.BlockSize, ShouldEqual, 0)
+		So(w[1]%directio.BlockSize, ShouldEqual, 0)
+		So(r.aligned[i], ShouldBeTrue)
+	}
+}
+
+func TestEngine_DirectIO(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("flush batch size must be aligned for direct I/O", t, func() {
+		err := Initialize(t.TempDir(), WithDirectIO(true), WithFlushBatchSize(defaultFlushBatchSize+512))
+		So(err, ShouldNotBeNil)
+	})
+
+	Convey("append and read blocks with direct I/O", t, func() {
+		ctrl := NewController(t)
+		defer ctrl.Finish()
+
+		rec := &writeRecorder{Interface: psync.New()}
+		s := stream.NewScheduler(rec, defaultFlushBatchSize, defaultFlushDelayTime)
+		defer s.Close()
+		e := &engine{
+			dir:    t.TempDir(),
+			s:      s,
+			direct: sio.NewDirectOpener(),
+		}
+		id := vanus.NewTestID()
+
+		r, err := e.Create(ctx, id, 64*1024)
+		So(err, ShouldBeNil)
+		b, _ := r.(*vsBlock)
+		So(b.df, ShouldNotBeNil)
+
+		commit := func(b *vsBlock, entries ...block.Entry) {
+			actx := b.NewAppendContext(nil)
+			_, frag, _, err := b.PrepareAppend(ctx, actx, entries...)
+			So(err, ShouldBeNil)
+			ch := make(chan struct{})
+			b.CommitAppend(ctx, frag, func() {
+				close(ch)
+			})
+			<-ch
+		}
+
+		// Both entries are in the tail of the first buffer, which is written whole and aligned.
+		commit(b, cetest.MakeEntry0(ctrl))
+		commit(b, cetest.MakeEntry1(ctrl))
+		rec.checkAligned()
+
+		// The data is visible through the page cache, which other reads of the block go through.
+		data := make([]byte, vsbtest.EntrySize0+vsbtest.EntrySize1)
+		_, err = b.f.ReadAt(data, headerBlockSize)
+		So(err, ShouldBeNil)
+		// The ingestion timestamp differs, it follows the header of the entry.
+		So(data[:24], ShouldResemble, vsbtest.EntryData0[:24])
+
+		entries, err := b.Read(ctx, 0, 2, 0)
+		So(err, ShouldBeNil)
+		So(entries, ShouldHaveLength, 2)
+		cetest.CheckEntry0(entries[0], false, true)
+		cetest.CheckEntry1(entries[1], false, true)
+
+		So(b.Close(ctx), ShouldBeNil)
+
+		Convey("reopen and append after the partial tail", func() {
+			r, err = e.Open(ctx, id)
+			So(err, ShouldBeNil)
+			b, _ = r.(*vsBlock)
+			So(b.df, ShouldNotBeNil)
+			defer func() {
+				So(b.Close(ctx), ShouldBeNil)
+			}()
+
+			// The tail is recovered into an aligned buffer, and written back whole with the new entry.
+			commit(b, cetest.MakeEntry0(ctrl))
+			rec.checkAligned()
+
+			entries, err = b.Read(ctx, 0, 3, 0)
+			So(err, ShouldBeNil)
+			So(entries, ShouldHaveLength, 3)
+			cetest.CheckEntry0(entries[0], false, true)
+			cetest.CheckEntry1(entries[1], false, true)
+		})
+	})
+}
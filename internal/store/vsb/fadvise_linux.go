@@ -0,0 +1,31 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package vsb
+
+import (
+	// standard libraries.
+	"os"
+
+	// third-party libraries.
+	"golang.org/x/sys/unix"
+)
+
+// adviseWillNeed starts reading [off, off+length) of f into the page cache without waiting for it.
+func adviseWillNeed(f *os.File, off, length int64) error {
+	return unix.Fadvise(int(f.Fd()), off, length, unix.FADV_WILLNEED)
+}
@@ -16,6 +16,9 @@
 package index
 
 import (
+	// standard libraries.
+	"hash/fnv"
+
 	// this project.
 	"github.com/linkall-labs/vanus/internal/store/block"
 	ceschema "github.com/linkall-labs/vanus/internal/store/schema/ce"
@@ -26,6 +29,18 @@ type Index interface {
 	EndOffset() int64
 	Length() int32
 	Stime() int64
+	// KeyHash is the hash of the partition key of the entry, it's 0 if the entry has no key.
+	KeyHash() uint32
+}
+
+// HashKey returns the hash of a partition key kept in indexes, it's never 0, so that 0 means no key.
+func HashKey(key []byte) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write(key)
+	if sum := h.Sum32(); sum != 0 {
+		return sum
+	}
+	return 1
 }
 
 type Option func(*index)
@@ -33,6 +48,9 @@ type Option func(*index)
 func WithEntry(entry block.Entry) Option {
 	return func(i *index) {
 		i.stime = ceschema.Stime(entry)
+		if key := ceschema.PartitionKey(entry); len(key) != 0 {
+			i.keyHash = HashKey(key)
+		}
 	}
 }
 
@@ -42,6 +60,12 @@ func WithStime(stime int64) Option {
 	}
 }
 
+func WithKeyHash(hash uint32) Option {
+	return func(i *index) {
+		i.keyHash = hash
+	}
+}
+
 func NewIndex(offset int64, length int32, opts ...Option) Index {
 	i := &index{
 		offset: offset,
@@ -54,9 +78,10 @@ func NewIndex(offset int64, length int32, opts ...Option) Index {
 }
 
 type index struct {
-	offset int64
-	length int32
-	stime  int64
+	offset  int64
+	length  int32
+	stime   int64
+	keyHash uint32
 }
 
 // Make sure index implements Index.
@@ -77,3 +102,7 @@ func (i *index) Length() int32 {
 func (i *index) Stime() int64 {
 	return i.stime
 }
+
+func (i *index) KeyHash() uint32 {
+	return i.keyHash
+}
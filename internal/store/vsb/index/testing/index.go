@@ -31,6 +31,7 @@ func MakeIndex0(ctrl *Controller) index.Index {
 	i.EXPECT().EndOffset().AnyTimes().Return(vsbtest.EntryOffset0 + vsbtest.EntrySize0)
 	i.EXPECT().Length().AnyTimes().Return(int32(vsbtest.EntrySize0))
 	i.EXPECT().Stime().AnyTimes().Return(cetest.Stime)
+	i.EXPECT().KeyHash().AnyTimes().Return(uint32(0))
 	return i
 }
 
@@ -40,6 +41,7 @@ func MakeIndex1(ctrl *Controller) index.Index {
 	i.EXPECT().EndOffset().AnyTimes().Return(vsbtest.EntryOffset1 + vsbtest.EntrySize1)
 	i.EXPECT().Length().AnyTimes().Return(int32(vsbtest.EntrySize1))
 	i.EXPECT().Stime().AnyTimes().Return(cetest.Stime)
+	i.EXPECT().KeyHash().AnyTimes().Return(uint32(0))
 	return i
 }
 
@@ -47,6 +47,20 @@ func (mr *MockIndexMockRecorder) EndOffset() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndOffset", reflect.TypeOf((*MockIndex)(nil).EndOffset))
 }
 
+// KeyHash mocks base method.
+func (m *MockIndex) KeyHash() uint32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeyHash")
+	ret0, _ := ret[0].(uint32)
+	return ret0
+}
+
+// KeyHash indicates an expected call of KeyHash.
+func (mr *MockIndexMockRecorder) KeyHash() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeyHash", reflect.TypeOf((*MockIndex)(nil).KeyHash))
+}
+
 // Length mocks base method.
 func (m *MockIndex) Length() int32 {
 	m.ctrl.T.Helper()
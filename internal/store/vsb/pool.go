@@ -0,0 +1,282 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// first-party libraries.
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+const (
+	poolDirName = "pool"
+	pooledExt   = ".vsp"
+	// Files being zeroed or allocated are renamed to pooledExt once they are ready, they are removed by
+	// the next start if the server crashes in the meantime.
+	recyclingExt    = ".recycling"
+	allocatingExt   = ".allocating"
+	zeroChunkSize   = 1024 * 1024
+	poolQueueLength = 64
+)
+
+type poolTask struct {
+	size int64
+	// recycled is the file of a deleted block to zero, a new file is allocated if it's empty.
+	recycled string
+}
+
+// filePool keeps spare files of blocks which are allocated in advance, so that neither creating blocks nor
+// appending to them waits for the file system to allocate space, and files don't get fragmented by growing
+// on demand. Files of deleted blocks are recycled after they are zeroed, since recovery scans entries of a
+// block until zeros.
+type filePool struct {
+	dir    string
+	spares int
+
+	mu    sync.Mutex
+	files map[int64][]string
+	// pending is the number of files being prepared by size.
+	pending map[int64]int
+	seq     int64
+	// closed is the flag indicating taskC is closed, no task is submitted then.
+	closed bool
+
+	taskC chan poolTask
+	wg    sync.WaitGroup
+}
+
+func newFilePool(dir string, spares int) (*filePool, error) {
+	if err := os.MkdirAll(dir, defaultDirPerm); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filePool{
+		dir:     dir,
+		spares:  spares,
+		files:   make(map[int64][]string),
+		pending: make(map[int64]int),
+		seq:     time.Now().UnixNano(),
+		taskC:   make(chan poolTask, poolQueueLength),
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		size, ok := pooledSize(entry)
+		if !ok {
+			_ = os.Remove(path)
+			continue
+		}
+		p.files[size] = append(p.files[size], path)
+	}
+
+	p.wg.Add(1)
+	go p.run()
+	return p, nil
+}
+
+// pooledSize returns the size of a ready file, which is named by its size and a sequence.
+func pooledSize(entry os.DirEntry) (int64, bool) {
+	name := entry.Name()
+	if !entry.Type().IsRegular() || filepath.Ext(name) != pooledExt {
+		return 0, false
+	}
+	prefix, _, ok := strings.Cut(name, "-")
+	if !ok {
+		return 0, false
+	}
+	size, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	if fi, err := entry.Info(); err != nil || fi.Size() != size {
+		return 0, false
+	}
+	return size, true
+}
+
+func (p *filePool) close() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	p.closed = true
+	close(p.taskC)
+	p.mu.Unlock()
+	p.wg.Wait()
+}
+
+// take moves a spare file of size to path, it returns false if there is no spare file. Spare files are
+// refilled in the background either way.
+func (p *filePool) take(path string, size int64) bool {
+	defer p.refill(size)
+
+	if _, err := os.Lstat(path); err == nil {
+		return false
+	}
+
+	for {
+		p.mu.Lock()
+		files := p.files[size]
+		if len(files) == 0 {
+			p.mu.Unlock()
+			return false
+		}
+		file := files[len(files)-1]
+		p.files[size] = files[:len(files)-1]
+		p.mu.Unlock()
+
+		if err := os.Rename(file, path); err == nil {
+			return true
+		}
+		_ = os.Remove(file)
+	}
+}
+
+// recycle moves the file of a deleted block into the pool if it's short of files of the size, the file is
+// zeroed in the background.
+func (p *filePool) recycle(path string, size int64) bool {
+	if p == nil {
+		return false
+	}
+	if fi, err := os.Stat(path); err != nil || fi.Size() != size {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed || len(p.files[size])+p.pending[size] >= p.spares {
+		return false
+	}
+	tmp := p.nextPath(size, recyclingExt)
+	if err := os.Rename(path, tmp); err != nil {
+		return false
+	}
+	p.submit(poolTask{size: size, recycled: tmp})
+	return true
+}
+
+func (p *filePool) refill(size int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for n := len(p.files[size]) + p.pending[size]; n < p.spares; n++ {
+		p.submit(poolTask{size: size})
+	}
+}
+
+// submit must be called with mu held, a recycled file is removed if the queue is full or closed.
+func (p *filePool) submit(task poolTask) {
+	if p.closed {
+		if task.recycled != "" {
+			_ = os.Remove(task.recycled)
+		}
+		return
+	}
+	select {
+	case p.taskC <- task:
+		p.pending[task.size]++
+	default:
+		if task.recycled != "" {
+			_ = os.Remove(task.recycled)
+		}
+	}
+}
+
+func (p *filePool) nextPath(size int64, ext string) string {
+	p.seq++
+	return filepath.Join(p.dir, fmt.Sprintf("%d-%d%s", size, p.seq, ext))
+}
+
+func (p *filePool) run() {
+	defer p.wg.Done()
+	for task := range p.taskC {
+		path, err := p.prepare(task)
+		p.mu.Lock()
+		p.pending[task.size]--
+		if err == nil {
+			p.files[task.size] = append(p.files[task.size], path)
+		}
+		p.mu.Unlock()
+		if err != nil {
+			log.Warning(context.Background(), "prepare spare block file failed", map[string]interface{}{
+				log.KeyError: err,
+				"size":       task.size,
+				"recycled":   task.recycled,
+			})
+		}
+	}
+}
+
+func (p *filePool) prepare(task poolTask) (string, error) {
+	tmp := task.recycled
+	if tmp == "" {
+		p.mu.Lock()
+		tmp = p.nextPath(task.size, allocatingExt)
+		p.mu.Unlock()
+	}
+
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_RDWR, defaultFilePerm)
+	if err != nil {
+		return "", err
+	}
+	if task.recycled != "" {
+		err = zeroFile(f, task.size)
+	} else {
+		err = preallocate(f, task.size)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if err2 := f.Close(); err == nil {
+		err = err2
+	}
+	if err != nil {
+		_ = os.Remove(tmp)
+		return "", err
+	}
+
+	path := strings.TrimSuffix(tmp, filepath.Ext(tmp)) + pooledExt
+	if err = os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return "", err
+	}
+	return path, nil
+}
+
+// writeZeros zeroes f by writing, which is the fallback of file systems which can't zero ranges.
+func writeZeros(f *os.File, size int64) error {
+	zeros := make([]byte, zeroChunkSize)
+	for off := int64(0); off < size; off += zeroChunkSize {
+		n := size - off
+		if n > zeroChunkSize {
+			n = zeroChunkSize
+		}
+		if _, err := f.WriteAt(zeros[:n], off); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux
+// +build linux
+
+package vsb
+
+import (
+	// standard libraries.
+	stderr "errors"
+	"os"
+	"syscall"
+)
+
+// fallocZeroRange is FALLOC_FL_ZERO_RANGE, which isn't defined by syscall.
+const fallocZeroRange = 0x10
+
+// preallocate allocates size bytes of f, it falls back to a sparse file if the file system doesn't support
+// fallocate.
+func preallocate(f *os.File, size int64) error {
+	err := syscall.Fallocate(int(f.Fd()), 0, 0, size)
+	if stderr.Is(err, syscall.EOPNOTSUPP) {
+		return f.Truncate(size)
+	}
+	return err
+}
+
+// zeroFile zeroes f and keeps its space allocated, it's done by converting extents to unwritten ones if
+// the file system supports it, so no data is written.
+func zeroFile(f *os.File, size int64) error {
+	err := syscall.Fallocate(int(f.Fd()), fallocZeroRange, 0, size)
+	if stderr.Is(err, syscall.EOPNOTSUPP) {
+		return writeZeros(f, size)
+	}
+	return err
+}
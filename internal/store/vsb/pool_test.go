@@ -0,0 +1,108 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vsb
+
+import (
+	// standard libraries.
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFilePool(t *testing.T) {
+	Convey("test file pool", t, func() {
+		dir := t.TempDir()
+		poolDir := filepath.Join(dir, poolDirName)
+		const size = 4096
+
+		spares := func(p *filePool) int {
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			return len(p.files[size])
+		}
+
+		p, err := newFilePool(poolDir, 2)
+		So(err, ShouldBeNil)
+		defer func() {
+			p.close()
+		}()
+
+		Convey("refill spare files once a file is taken", func() {
+			path := filepath.Join(dir, "a.vsb")
+			So(p.take(path, size), ShouldBeFalse)
+			So(func() bool {
+				for i := 0; i < 100 && spares(p) < 2; i++ {
+					time.Sleep(10 * time.Millisecond)
+				}
+				return spares(p) == 2
+			}(), ShouldBeTrue)
+
+			So(p.take(path, size), ShouldBeTrue)
+			fi, err := os.Stat(path)
+			So(err, ShouldBeNil)
+			So(fi.Size(), ShouldEqual, size)
+
+			Convey("spare files are kept after restart", func() {
+				p.close()
+				_ = os.WriteFile(filepath.Join(poolDir, "4096-1"+recyclingExt), []byte{1}, defaultFilePerm)
+				p, err = newFilePool(poolDir, 2)
+				So(err, ShouldBeNil)
+				So(spares(p), ShouldBeGreaterThanOrEqualTo, 1)
+				_, err = os.Stat(filepath.Join(poolDir, "4096-1"+recyclingExt))
+				So(os.IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("recycle zeroed files of deleted blocks", func() {
+			path := filepath.Join(dir, "b.vsb")
+			data := make([]byte, size)
+			for i := range data {
+				data[i] = 0xff
+			}
+			So(os.WriteFile(path, data, defaultFilePerm), ShouldBeNil)
+			So(p.recycle(path, size*2), ShouldBeFalse)
+			So(p.recycle(path, size), ShouldBeTrue)
+			_, err = os.Stat(path)
+			So(os.IsNotExist(err), ShouldBeTrue)
+
+			for i := 0; i < 100 && spares(p) < 1; i++ {
+				time.Sleep(10 * time.Millisecond)
+			}
+			So(p.take(path, size), ShouldBeTrue)
+			data, err = os.ReadFile(path)
+			So(err, ShouldBeNil)
+			So(data, ShouldResemble, make([]byte, size))
+		})
+
+		Convey("no task is submitted once the pool is closed", func() {
+			cp, err := newFilePool(filepath.Join(dir, "closed"), 2)
+			So(err, ShouldBeNil)
+			cp.close()
+
+			path := filepath.Join(dir, "c.vsb")
+			So(os.WriteFile(path, make([]byte, size), defaultFilePerm), ShouldBeNil)
+			So(cp.recycle(path, size), ShouldBeFalse)
+			_, err = os.Stat(path)
+			So(err, ShouldBeNil)
+
+			So(func() { cp.take(filepath.Join(dir, "d.vsb"), size) }, ShouldNotPanic)
+			So(cp.pending[size], ShouldEqual, 0)
+		})
+	})
+}
@@ -129,6 +129,23 @@ var (
 		0x02, 0x00, 0x00, 0x00, // entry num
 		0x28, 0x00, // index offset
 	}
+	ArchivedHeaderDataV2 = []byte{
+		0x76, 0x73, 0x62, 0x00, // magic
+		0x54, 0x9E, 0x9B, 0x67, // crc
+		0x00, 0x00, 0x00, 0x00, // flags
+		0x00, 0x00, 0x00, 0x00, // break flags
+		0x00, 0x10, 0x00, 0x00, // data offset
+		0x01,       // state
+		0x01,       // version
+		0x18, 0x00, // index size
+		0x90, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // capacity
+		0x90, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, // entry length
+		0x02, 0x00, 0x00, 0x00, // entry num
+		0x28, 0x00, // index offset
+		0x05, 0x00, // metadata length
+		0xA5, 0xFD, 0xE6, 0x19, // metadata crc
+		0x01, 0x00, 0x01, 0x00, 0x01, // checksum
+	}
 	EmptyHeaderData = []byte{
 		0x76, 0x73, 0x62, 0x00, // magic
 		0x21, 0x99, 0xD5, 0xDA, // crc
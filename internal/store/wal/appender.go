@@ -23,10 +23,11 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	// this project.
+	"github.com/linkall-labs/vanus/internal/store/crashpoint"
 	"github.com/linkall-labs/vanus/internal/store/wal/record"
 )
 
-func (w *WAL) newAppender(ctx context.Context, entries [][]byte, callback AppendCallback) *appender {
+func (w *WAL) newAppender(ctx context.Context, entries [][][]byte, callback AppendCallback) *appender {
 	return &appender{
 		w:        w,
 		entries:  entries,
@@ -38,7 +39,7 @@ func (w *WAL) newAppender(ctx context.Context, entries [][]byte, callback Append
 
 type appender struct {
 	w       *WAL
-	entries [][]byte
+	entries [][][]byte
 	records []record.Record
 	padding int
 	i, j    int
@@ -90,7 +91,7 @@ func (a *appender) Read(b []byte) (int, error) {
 
 	if a.i < len(a.entries) {
 		a.ranges[a.i].SO = a.w.s.WriteOffset()
-		a.records, a.padding = record.Pack(a.entries[a.i], len(b), a.w.blockSize)
+		a.records, a.padding = record.PackVec(a.entries[a.i], len(b), a.w.blockSize)
 		a.ranges[a.i].EO = -int64(a.padding)
 		a.i++
 		a.j = 1
@@ -109,6 +110,8 @@ func (a *appender) onAppended(_ int, err error) {
 		panic(err)
 	}
 
+	crashpoint.Inject(crashpoint.WALAppendPersisted)
+
 	a.w.callbackC <- callbackTask{
 		ctx:      a.ctx,
 		callback: a.callback,
@@ -15,7 +15,14 @@
 package record
 
 func Pack(entry []byte, firstSize, otherSize int) ([]Record, int) {
-	num := calPacketNum(entry, firstSize, otherSize)
+	return PackVec([][]byte{entry}, firstSize, otherSize)
+}
+
+// PackVec packs an entry given in pieces as if they were joined, records reference the pieces, so that the
+// entry isn't copied into a joined buffer before records are marshaled.
+func PackVec(pieces [][]byte, firstSize, otherSize int) ([]Record, int) {
+	entry := vec(pieces)
+	num := calPacketNum(entry.size(), firstSize, otherSize)
 	if num == 1 {
 		packet := makePacket(Full, entry)
 		padding := firstSize - packet.Size()
@@ -28,18 +35,18 @@ func Pack(entry []byte, firstSize, otherSize int) ([]Record, int) {
 	packets := make([]Record, 0, num)
 
 	// first packet
-	packets = append(packets, makePacket(First, entry[:firstSize-HeaderSize]))
+	packets = append(packets, makePacket(First, entry.slice(0, firstSize-HeaderSize)))
 
 	// middle packet(s)
 	fo := firstSize - HeaderSize
 	for i := 0; i < num-2; i++ {
 		eo := fo + otherSize - HeaderSize
-		packets = append(packets, makePacket(Middle, entry[fo:eo]))
+		packets = append(packets, makePacket(Middle, entry.slice(fo, eo)))
 		fo = eo
 	}
 
 	// last packet
-	last := makePacket(Last, entry[fo:])
+	last := makePacket(Last, entry.slice(fo, entry.size()))
 	packets = append(packets, last)
 
 	padding := otherSize - last.Size()
@@ -50,8 +57,7 @@ func Pack(entry []byte, firstSize, otherSize int) ([]Record, int) {
 	return packets, padding
 }
 
-func calPacketNum(entry []byte, firstSize, otherSize int) int {
-	payload := len(entry)
+func calPacketNum(payload int, firstSize, otherSize int) int {
 	if payload <= firstSize-HeaderSize {
 		return 1
 	}
@@ -59,11 +65,51 @@ func calPacketNum(entry []byte, firstSize, otherSize int) int {
 	return 1 + (payload-firstSize+otherSize-1)/(otherSize-HeaderSize)
 }
 
-func makePacket(t Type, payload []byte) Record {
-	return Record{
+func makePacket(t Type, payload vec) Record {
+	r := Record{
 		CRC:    0,
-		Length: uint16(len(payload)),
+		Length: uint16(payload.size()),
 		Type:   t,
-		Data:   payload,
 	}
+	if len(payload) == 1 {
+		r.Data = payload[0]
+	} else {
+		r.pieces = payload
+	}
+	return r
+}
+
+// vec is data in pieces.
+type vec [][]byte
+
+func (v vec) size() int {
+	sz := 0
+	for _, p := range v {
+		sz += len(p)
+	}
+	return sz
+}
+
+// slice returns pieces of data in [from, to), they reference v.
+func (v vec) slice(from, to int) vec {
+	result := make(vec, 0, 1)
+	off := 0
+	for _, p := range v {
+		so, eo := off, off+len(p)
+		off = eo
+		if eo <= from || so >= to {
+			continue
+		}
+		if eo > to {
+			p = p[:to-so]
+		}
+		if so < from {
+			p = p[from-so:]
+		}
+		result = append(result, p)
+	}
+	if len(result) == 0 {
+		return vec{{}}
+	}
+	return result
 }
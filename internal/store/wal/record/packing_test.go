@@ -100,3 +100,39 @@ func TestPack(t *testing.T) {
 		So(bytes.Equal(r2.Data, bigData[2*(blockSize-HeaderSize):]), ShouldBeTrue)
 	})
 }
+
+func TestPackVec(t *testing.T) {
+	Convey("pack entry in pieces", t, func() {
+		pieces := [][]byte{
+			bytes.Repeat([]byte{'a'}, 16),
+			bytes.Repeat([]byte{'b'}, blockSize),
+			bytes.Repeat([]byte{'c'}, 32),
+		}
+		entry := bytes.Join(pieces, nil)
+
+		Convey("fit in one record", func() {
+			records, padding := PackVec(pieces[:1], blockSize, blockSize)
+			So(records, ShouldHaveLength, 1)
+			So(padding, ShouldEqual, 0)
+			So(records[0].Type, ShouldEqual, Full)
+			So(records[0].Data, ShouldResemble, pieces[0])
+		})
+
+		Convey("span records", func() {
+			records, _ := PackVec(pieces, blockSize, blockSize)
+			expected, _ := Pack(entry, blockSize, blockSize)
+			So(records, ShouldHaveLength, len(expected))
+			for i := range records {
+				So(records[i].Type, ShouldEqual, expected[i].Type)
+				So(records[i].Length, ShouldEqual, expected[i].Length)
+				So(records[i].Size(), ShouldEqual, expected[i].Size())
+
+				buf := make([]byte, records[i].Size())
+				n, err := records[i].MarshalTo(buf)
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, len(buf))
+				So(buf, ShouldResemble, expected[i].Marshal())
+			}
+		})
+	})
+}
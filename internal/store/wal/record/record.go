@@ -60,9 +60,14 @@ type Record struct {
 	Length uint16
 	Type   Type
 	Data   []byte
+	// pieces holds data instead of Data if the record is packed from pieces of an entry.
+	pieces vec
 }
 
 func (r *Record) Size() int {
+	if r.pieces != nil {
+		return typeFieldEO + r.pieces.size()
+	}
 	return typeFieldEO + len(r.Data)
 }
 
@@ -80,8 +85,13 @@ func (r *Record) MarshalTo(data []byte) (int, error) {
 	}
 	binary.BigEndian.PutUint16(data[lengthFieldSO:lengthFieldEO], r.Length)
 	data[typeFieldSO] = byte(r.Type)
-	ds := len(r.Data)
-	if ds != 0 {
+	ds := sz - dataFieldSO
+	if r.pieces != nil {
+		off := dataFieldSO
+		for _, p := range r.pieces {
+			off += copy(data[off:], p)
+		}
+	} else if ds != 0 {
 		copy(data[dataFieldSO:dataFieldSO+ds], r.Data)
 	}
 	// calculate CRC
@@ -60,8 +60,9 @@ func (re *Result) Range() Range {
 type AppendCallback func(Result)
 
 type appendTask struct {
-	ctx      context.Context
-	entries  [][]byte
+	ctx context.Context
+	// entries are in pieces, see AppendVec.
+	entries  [][][]byte
 	batching bool
 	callback AppendCallback
 }
@@ -211,6 +212,17 @@ func (f AppendFuture) Wait() ([]Range, error) {
 
 // Append appends entries to WAL.
 func (w *WAL) Append(ctx context.Context, entries [][]byte, opts ...AppendOption) AppendFuture {
+	vecs := make([][][]byte, len(entries))
+	for i := range entries {
+		vecs[i] = entries[i : i+1]
+	}
+	return w.AppendVec(ctx, vecs, opts...)
+}
+
+// AppendVec appends entries to WAL, each entry is given in pieces, which are written as if they were
+// joined. Records are marshaled from the pieces directly, so a large piece, e.g. data of a raft entry,
+// isn't copied into a joined entry first.
+func (w *WAL) AppendVec(ctx context.Context, entries [][][]byte, opts ...AppendOption) AppendFuture {
 	span := trace.SpanFromContext(ctx)
 	span.AddEvent("store.wal.WAL.Append() Start")
 	defer span.AddEvent("store.wal.WAL.Append() End")
@@ -178,6 +178,28 @@ func TestWAL_Append(t *testing.T) {
 				})
 		})
 
+		Convey("append entries in pieces", func() {
+			ranges, err := wal.AppendVec(ctx, [][][]byte{
+				{data0[:1], data0[1:]},
+				{data1[:2], nil, data1[2:]},
+			}, WithoutBatching()).Wait()
+
+			So(err, ShouldBeNil)
+			So(len(ranges), ShouldEqual, 2)
+			So(ranges[0].EO, ShouldEqual, 10)
+			So(ranges[1].EO, ShouldEqual, 21)
+
+			filePath := filepath.Join(walDir, fmt.Sprintf("%020d.log", 0))
+			data, err2 := os.ReadFile(filePath)
+			So(err2, ShouldBeNil)
+
+			So(data[:21], ShouldResemble,
+				[]byte{
+					0x7D, 0x7F, 0xEB, 0x7A, 0x00, 0x03, 0x01, 0x41, 0x42, 0x43,
+					0x52, 0x74, 0x2F, 0x51, 0x00, 0x04, 0x01, 0x44, 0x45, 0x46, 0x47,
+				})
+		})
+
 		Reset(func() {
 			wal.Close()
 			wal.Wait()
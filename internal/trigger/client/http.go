@@ -17,25 +17,59 @@ package client
 import (
 	"context"
 	"errors"
+	nethttp "net/http"
+	"sync"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	"github.com/linkall-labs/vanus/observability/log"
 )
 
 type http struct {
-	client ce.Client
+	url      string
+	signer   *Signer
+	resolver *resolver
+
+	mutex     sync.RWMutex
+	client    ce.Client
+	transport *nethttp.Transport
 }
 
-func NewHTTPClient(url string) EventClient {
-	c, _ := ce.NewClientHTTP(ce.WithTarget(url))
-	return &http{
-		client: c,
+// NewHTTPClient returns a client sending events to url, requests are signed if signer isn't nil.
+func NewHTTPClient(url string, signer *Signer) EventClient {
+	c := &http{
+		url:      url,
+		signer:   signer,
+		resolver: newResolver(url),
+	}
+	c.client, c.transport = newCEClient(url, signer)
+	if c.resolver != nil {
+		c.resolver.onChange = c.rebuild
 	}
+	return c
+}
+
+// newCEClient creates a client with its own transport, so that pooled connections can be dropped.
+func newCEClient(url string, signer *Signer) (ce.Client, *nethttp.Transport) {
+	transport, _ := nethttp.DefaultTransport.(*nethttp.Transport)
+	transport = transport.Clone()
+	var rt nethttp.RoundTripper = &tracingTransport{next: transport}
+	if signer != nil {
+		rt = &signingTransport{signer: signer, next: rt}
+	}
+	c, _ := ce.NewClientHTTP(cehttp.WithTarget(url), cehttp.WithRoundTripper(rt))
+	return c, transport
 }
 
 func (c *http) Send(ctx context.Context, events ...*ce.Event) Result {
 	event := events[0]
-	res := c.client.Send(ctx, *event)
+	c.mutex.RLock()
+	client := c.client
+	c.mutex.RUnlock()
+	res := client.Send(ctx, *event)
+	if c.resolver != nil {
+		c.resolver.observe(ce.IsACK(res))
+	}
 	if ce.IsACK(res) {
 		return Success
 	}
@@ -52,3 +86,32 @@ func (c *http) Send(ctx context.Context, events ...*ce.Event) Result {
 
 	return r
 }
+
+func (c *http) Resolution() *Resolution {
+	if c.resolver == nil {
+		return nil
+	}
+	return c.resolver.get()
+}
+
+// CloseIdleConnections closes pooled connections which aren't in use, requests in flight aren't affected.
+func (c *http) CloseIdleConnections() {
+	c.mutex.RLock()
+	transport := c.transport
+	c.mutex.RUnlock()
+	transport.CloseIdleConnections()
+}
+
+// rebuild is called after addresses of the sink changed, connections to stale addresses are closed.
+func (c *http) rebuild() {
+	client, transport := newCEClient(c.url, c.signer)
+	c.mutex.Lock()
+	stale := c.transport
+	c.client, c.transport = client, transport
+	c.mutex.Unlock()
+	stale.CloseIdleConnections()
+	log.Info(context.Background(), "addresses of sink changed, the client is rebuilt", map[string]interface{}{
+		"sink":  c.url,
+		"addrs": c.resolver.get().Addrs,
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultResolveInterval = 30 * time.Second
+	// resolve again after the number of consecutive failures.
+	defaultResolveFailures = 3
+	defaultResolveTimeout  = 3 * time.Second
+)
+
+// Resolution is the result of resolving the hostname of sink.
+type Resolution struct {
+	Host       string
+	Addrs      []string
+	ResolvedAt time.Time
+	Err        error
+}
+
+// Resolvable is implemented by clients which resolve the hostname of sink by themselves.
+type Resolvable interface {
+	Resolution() *Resolution
+}
+
+type lookupHostFunc func(ctx context.Context, host string) ([]string, error)
+
+// resolver tracks addresses of the hostname of sink, it's resolved again periodically and after consecutive
+// failures, so that the client can be rebuilt once addresses change, instead of sending to stale ones.
+// Hostnames are resolved in the background, requests don't wait for DNS.
+type resolver struct {
+	host       string
+	lookupHost lookupHostFunc
+	interval   time.Duration
+	failures   int
+	// onChange is called after addresses changed.
+	onChange func()
+
+	mutex      sync.Mutex
+	resolution Resolution
+	failed     int
+	resolving  bool
+}
+
+// newResolver returns nil if the host of sink isn't a hostname.
+func newResolver(sink string) *resolver {
+	u, err := url.Parse(sink)
+	if err != nil {
+		return nil
+	}
+	host := u.Hostname()
+	if host == "" || net.ParseIP(host) != nil {
+		return nil
+	}
+	return &resolver{
+		host:       host,
+		lookupHost: net.DefaultResolver.LookupHost,
+		interval:   defaultResolveInterval,
+		failures:   defaultResolveFailures,
+		resolution: Resolution{Host: host},
+	}
+}
+
+// observe records the result of a request, and starts resolving the hostname again in the background if
+// it's time. It returns true if resolving is started.
+func (r *resolver) observe(success bool) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if success {
+		r.failed = 0
+	} else {
+		r.failed++
+	}
+	if r.resolving || (r.failed < r.failures && time.Since(r.resolution.ResolvedAt) < r.interval) {
+		return false
+	}
+	r.failed = 0
+	r.resolving = true
+	go r.resolve()
+	return true
+}
+
+// resolve looks up the hostname without holding the mutex, onChange is called if addresses changed.
+func (r *resolver) resolve() {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultResolveTimeout)
+	defer cancel()
+	addrs, err := r.lookupHost(ctx, r.host)
+	sort.Strings(addrs)
+
+	r.mutex.Lock()
+	r.resolving = false
+	r.resolution.ResolvedAt = time.Now()
+	r.resolution.Err = err
+	changed := false
+	if err == nil {
+		changed = r.resolution.Addrs != nil && !equalAddrs(r.resolution.Addrs, addrs)
+		r.resolution.Addrs = addrs
+	}
+	r.mutex.Unlock()
+	if changed && r.onChange != nil {
+		r.onChange()
+	}
+}
+
+func (r *resolver) get() *Resolution {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	res := r.resolution
+	res.Addrs = append([]string(nil), r.resolution.Addrs...)
+	return &res
+}
+
+func equalAddrs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResolver(t *testing.T) {
+	Convey("test resolver", t, func() {
+		Convey("sink without hostname", func() {
+			So(newResolver("http://127.0.0.1:8080/hook"), ShouldBeNil)
+			So(newResolver("http://[::1]:8080"), ShouldBeNil)
+		})
+
+		r := newResolver("http://sink.default.svc:8080/hook")
+		So(r, ShouldNotBeNil)
+		So(r.host, ShouldEqual, "sink.default.svc")
+		addrs := []string{"10.0.0.2", "10.0.0.1"}
+		var lookupErr error
+		var lookups int32
+		release := make(chan struct{})
+		close(release)
+		r.lookupHost = func(_ context.Context, host string) ([]string, error) {
+			<-release
+			atomic.AddInt32(&lookups, 1)
+			return append([]string(nil), addrs...), lookupErr
+		}
+		changed := make(chan struct{}, 1)
+		r.onChange = func() {
+			changed <- struct{}{}
+		}
+		wait := func() {
+			for {
+				r.mutex.Lock()
+				resolving := r.resolving
+				r.mutex.Unlock()
+				if !resolving {
+					return
+				}
+				time.Sleep(time.Millisecond)
+			}
+		}
+
+		// resolved at the first time.
+		So(r.observe(true), ShouldBeTrue)
+		wait()
+		So(atomic.LoadInt32(&lookups), ShouldEqual, 1)
+		So(r.get().Addrs, ShouldResemble, []string{"10.0.0.1", "10.0.0.2"})
+		So(changed, ShouldBeEmpty)
+
+		Convey("resolve after consecutive failures", func() {
+			addrs = []string{"10.0.0.3"}
+			So(r.observe(false), ShouldBeFalse)
+			So(r.observe(true), ShouldBeFalse)
+			So(r.observe(false), ShouldBeFalse)
+			So(r.observe(false), ShouldBeFalse)
+			So(atomic.LoadInt32(&lookups), ShouldEqual, 1)
+			So(r.observe(false), ShouldBeTrue)
+			wait()
+			So(atomic.LoadInt32(&lookups), ShouldEqual, 2)
+			So(r.get().Addrs, ShouldResemble, []string{"10.0.0.3"})
+			So(changed, ShouldHaveLength, 1)
+		})
+
+		Convey("resolve periodically", func() {
+			r.interval = time.Millisecond
+			time.Sleep(2 * time.Millisecond)
+			So(r.observe(true), ShouldBeTrue)
+			wait()
+			So(atomic.LoadInt32(&lookups), ShouldEqual, 2)
+
+			lookupErr = errors.New("no such host")
+			time.Sleep(2 * time.Millisecond)
+			So(r.observe(true), ShouldBeTrue)
+			wait()
+			res := r.get()
+			So(res.Err, ShouldEqual, lookupErr)
+			// the last known addresses are kept.
+			So(res.Addrs, ShouldResemble, []string{"10.0.0.1", "10.0.0.2"})
+			So(changed, ShouldBeEmpty)
+		})
+
+		Convey("requests don't wait for resolving", func() {
+			release = make(chan struct{})
+			r.interval = time.Millisecond
+			time.Sleep(2 * time.Millisecond)
+			So(r.observe(true), ShouldBeTrue)
+			// only one lookup is in flight.
+			So(r.observe(false), ShouldBeFalse)
+			So(r.get().Addrs, ShouldResemble, []string{"10.0.0.1", "10.0.0.2"})
+			close(release)
+			wait()
+			So(atomic.LoadInt32(&lookups), ShouldEqual, 2)
+		})
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+)
+
+const (
+	HeaderSignature    = "X-Vanus-Signature"
+	HeaderJWSSignature = "X-Vanus-JWS-Signature"
+)
+
+// Signer signs bodies of requests delivering events with all keys of a subscription.
+type Signer struct {
+	method primitive.SigningMethod
+	keys   []primitive.SigningKey
+}
+
+// NewSigner returns nil if events aren't signed.
+func NewSigner(cfg *primitive.SigningConfig) *Signer {
+	if cfg == nil || len(cfg.Keys) == 0 {
+		return nil
+	}
+	return &Signer{method: cfg.Method, keys: cfg.Keys}
+}
+
+// Sign returns the header carrying signatures of body. Signatures of HMAC are computed over
+// "<timestamp>.<body>", JWS signatures are detached with unencoded payload (RFC 7797), both carry the time
+// of signing so that sinks can reject replayed requests.
+func (s *Signer) Sign(body []byte, now time.Time) (string, string) {
+	ts := now.Unix()
+	if s.method == primitive.SigningJWS {
+		signatures := make([]string, 0, len(s.keys))
+		for _, k := range s.keys {
+			signatures = append(signatures, signJWS(k, body, ts))
+		}
+		return HeaderJWSSignature, strings.Join(signatures, ",")
+	}
+	t := strconv.FormatInt(ts, 10)
+	var b strings.Builder
+	b.WriteString("t=")
+	b.WriteString(t)
+	for _, k := range s.keys {
+		mac := hmac.New(sha256.New, []byte(k.Secret))
+		mac.Write([]byte(t))
+		mac.Write([]byte{'.'})
+		mac.Write(body)
+		b.WriteString(",")
+		b.WriteString(k.ID)
+		b.WriteString("=")
+		b.WriteString(hex.EncodeToString(mac.Sum(nil)))
+	}
+	return HeaderSignature, b.String()
+}
+
+type jwsHeader struct {
+	Alg  string   `json:"alg"`
+	Kid  string   `json:"kid"`
+	B64  bool     `json:"b64"`
+	Crit []string `json:"crit"`
+	Iat  int64    `json:"iat"`
+}
+
+func signJWS(k primitive.SigningKey, body []byte, ts int64) string {
+	header, _ := json.Marshal(jwsHeader{Alg: "HS256", Kid: k.ID, B64: false, Crit: []string{"b64"}, Iat: ts})
+	protected := base64.RawURLEncoding.EncodeToString(header)
+	mac := hmac.New(sha256.New, []byte(k.Secret))
+	mac.Write([]byte(protected))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return protected + ".." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// signingTransport signs requests as they are sent, so that signatures cover exactly the bytes of bodies.
+type signingTransport struct {
+	signer *Signer
+	next   nethttp.RoundTripper
+}
+
+func (t *signingTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	// RoundTrip mustn't modify the request.
+	signed := req.Clone(req.Context())
+	signed.Body = io.NopCloser(bytes.NewReader(body))
+	signed.ContentLength = int64(len(body))
+	signed.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	name, value := t.signer.Sign(body, time.Now())
+	signed.Header.Set(name, value)
+	return t.next.RoundTrip(signed)
+}
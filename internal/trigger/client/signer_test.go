@@ -0,0 +1,114 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func hmacHex(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestSigner(t *testing.T) {
+	Convey("test signer", t, func() {
+		So(NewSigner(nil), ShouldBeNil)
+		So(NewSigner(&primitive.SigningConfig{Method: primitive.SigningHMAC}), ShouldBeNil)
+
+		keys := []primitive.SigningKey{{ID: "old", Secret: "secret-1"}, {ID: "new", Secret: "secret-2"}}
+		body := []byte(`{"a":1}`)
+		now := time.Unix(1665000000, 0)
+
+		Convey("hmac", func() {
+			s := NewSigner(&primitive.SigningConfig{Method: primitive.SigningHMAC, Keys: keys})
+			name, value := s.Sign(body, now)
+			So(name, ShouldEqual, HeaderSignature)
+			So(value, ShouldEqual, "t=1665000000"+
+				",old="+hmacHex("secret-1", `1665000000.{"a":1}`)+
+				",new="+hmacHex("secret-2", `1665000000.{"a":1}`))
+		})
+
+		Convey("jws", func() {
+			s := NewSigner(&primitive.SigningConfig{Method: primitive.SigningJWS, Keys: keys})
+			name, value := s.Sign(body, now)
+			So(name, ShouldEqual, HeaderJWSSignature)
+			signatures := strings.Split(value, ",")
+			So(signatures, ShouldHaveLength, 2)
+			for i, jws := range signatures {
+				parts := strings.Split(jws, ".")
+				So(parts, ShouldHaveLength, 3)
+				So(parts[1], ShouldBeEmpty)
+				data, err := base64.RawURLEncoding.DecodeString(parts[0])
+				So(err, ShouldBeNil)
+				header := map[string]interface{}{}
+				So(json.Unmarshal(data, &header), ShouldBeNil)
+				So(header["alg"], ShouldEqual, "HS256")
+				So(header["kid"], ShouldEqual, keys[i].ID)
+				So(header["b64"], ShouldEqual, false)
+				So(header["iat"], ShouldEqual, 1665000000)
+				sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+				So(err, ShouldBeNil)
+				So(hex.EncodeToString(sig), ShouldEqual, hmacHex(keys[i].Secret, parts[0]+"."+string(body)))
+			}
+		})
+	})
+}
+
+func TestHTTP_SendSigned(t *testing.T) {
+	Convey("test send signed events", t, func() {
+		var header, body string
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			data, _ := io.ReadAll(r.Body)
+			body = string(data)
+			header = r.Header.Get(HeaderSignature)
+			w.WriteHeader(nethttp.StatusOK)
+		}))
+		defer server.Close()
+
+		c := NewHTTPClient(server.URL, NewSigner(&primitive.SigningConfig{
+			Method: primitive.SigningHMAC,
+			Keys:   []primitive.SigningKey{{ID: "k1", Secret: "secret"}},
+		}))
+		e := ce.NewEvent()
+		e.SetID("1")
+		e.SetSource("test")
+		e.SetType("test")
+		_ = e.SetData(ce.ApplicationJSON, map[string]int{"a": 1})
+		So(c.Send(context.Background(), &e), ShouldResemble, Success)
+		So(body, ShouldEqual, `{"a":1}`)
+
+		fields := strings.Split(header, ",")
+		So(fields, ShouldHaveLength, 2)
+		So(fields[0], ShouldStartWith, "t=")
+		So(fields[1], ShouldEqual, "k1="+hmacHex("secret", strings.TrimPrefix(fields[0], "t=")+"."+body))
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/internal/primitive/sinktemplate"
+)
+
+// defaultMaxTemplateClients bounds clients of rendered sinks, an arbitrary one is dropped once it's exceeded.
+const defaultMaxTemplateClients = 1024
+
+// idleCloser is implemented by clients which pool connections, they are closed once the client is dropped.
+type idleCloser interface {
+	CloseIdleConnections()
+}
+
+type templateHTTP struct {
+	template   *sinktemplate.Template
+	maxClients int
+	newClient  func(url string) EventClient
+
+	mutex   sync.RWMutex
+	clients map[string]EventClient
+}
+
+// NewTemplateHTTPClient returns a client which sends each event to the sink rendered from it.
+func NewTemplateHTTPClient(template *sinktemplate.Template, signer *Signer) EventClient {
+	return &templateHTTP{
+		template:   template,
+		maxClients: defaultMaxTemplateClients,
+		newClient: func(url string) EventClient {
+			return NewHTTPClient(url, signer)
+		},
+		clients: map[string]EventClient{},
+	}
+}
+
+func (c *templateHTTP) Send(ctx context.Context, events ...*ce.Event) Result {
+	sink, err := c.template.Render(events[0])
+	if err != nil {
+		// the event can't be delivered no matter how many times it's retried.
+		return Result{
+			StatusCode: errStatusCode,
+			Err:        fmt.Errorf("render sink template %s failed: %w", c.template, err),
+		}
+	}
+	return c.getClient(sink).Send(ctx, events...)
+}
+
+func (c *templateHTTP) getClient(sink string) EventClient {
+	c.mutex.RLock()
+	cli, ok := c.clients[sink]
+	c.mutex.RUnlock()
+	if ok {
+		return cli
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if cli, ok = c.clients[sink]; ok {
+		return cli
+	}
+	if len(c.clients) >= c.maxClients {
+		for k, evicted := range c.clients {
+			delete(c.clients, k)
+			if ic, ok := evicted.(idleCloser); ok {
+				ic.CloseIdleConnections()
+			}
+			break
+		}
+	}
+	cli = c.newClient(sink)
+	c.clients[sink] = cli
+	return cli
+}
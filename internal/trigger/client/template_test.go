@@ -0,0 +1,62 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+type pooledClient struct {
+	closed int
+}
+
+func (c *pooledClient) Send(context.Context, ...*ce.Event) Result {
+	return Success
+}
+
+func (c *pooledClient) CloseIdleConnections() {
+	c.closed++
+}
+
+func TestTemplateHTTP_getClient(t *testing.T) {
+	Convey("test clients of rendered sinks", t, func() {
+		created := map[string]*pooledClient{}
+		c := &templateHTTP{
+			maxClients: 1,
+			newClient: func(url string) EventClient {
+				cli := &pooledClient{}
+				created[url] = cli
+				return cli
+			},
+			clients: map[string]EventClient{},
+		}
+
+		a := c.getClient("http://a")
+		So(c.getClient("http://a"), ShouldEqual, a)
+		So(created, ShouldHaveLength, 1)
+
+		Convey("close idle connections of evicted clients", func() {
+			b := c.getClient("http://b")
+			So(b, ShouldNotEqual, a)
+			So(c.clients, ShouldHaveLength, 1)
+			So(created["http://a"].closed, ShouldEqual, 1)
+			So(created["http://b"].closed, ShouldEqual, 0)
+		})
+	})
+}
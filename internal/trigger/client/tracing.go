@@ -0,0 +1,107 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	nethttp "net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	sinkTracerName = "trigger.sink"
+	sinkSpanName   = "trigger.sink/request"
+
+	attributeHTTPMethod        = "http.method"
+	attributeHTTPURL           = "http.url"
+	attributeHTTPContentLength = "http.request_content_length"
+	attributeHTTPStatusCode    = "http.status_code"
+	attributeSinkLatency       = "vanus.sink.latency_ms"
+)
+
+type parentSpansKey struct{}
+
+// WithParentSpans attaches spans of the events being sent to ctx, requests to the sink are traced as their
+// children, so that traces of the events show the time taken by the sink.
+func WithParentSpans(ctx context.Context, spans []oteltrace.Span) context.Context {
+	if len(spans) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, parentSpansKey{}, spans)
+}
+
+func parentSpansFrom(ctx context.Context) []oteltrace.Span {
+	spans, _ := ctx.Value(parentSpansKey{}).([]oteltrace.Span)
+	return spans
+}
+
+// tracingTransport starts a span for each sampled parent, since events sent in a request may belong to
+// different traces.
+type tracingTransport struct {
+	next nethttp.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *nethttp.Request) (*nethttp.Response, error) {
+	parents := parentSpansFrom(req.Context())
+	if len(parents) == 0 {
+		return t.next.RoundTrip(req)
+	}
+	// The query may carry credentials of the sink, so it isn't recorded.
+	url := *req.URL
+	url.User, url.RawQuery, url.Fragment = nil, "", ""
+	attrs := []attribute.KeyValue{
+		attribute.String(attributeHTTPMethod, req.Method),
+		attribute.String(attributeHTTPURL, url.String()),
+		attribute.Int64(attributeHTTPContentLength, req.ContentLength),
+	}
+	spans := make([]oteltrace.Span, 0, len(parents))
+	for _, parent := range parents {
+		if !parent.IsRecording() {
+			continue
+		}
+		_, span := parent.TracerProvider().Tracer(sinkTracerName).Start(
+			oteltrace.ContextWithSpan(req.Context(), parent), sinkSpanName,
+			oteltrace.WithSpanKind(oteltrace.SpanKindClient), oteltrace.WithAttributes(attrs...))
+		spans = append(spans, span)
+	}
+	if len(spans) == 0 {
+		return t.next.RoundTrip(req)
+	}
+
+	// The latency is until headers of the response are received, which is how long the sink takes to
+	// handle events.
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+	for _, span := range spans {
+		span.SetAttributes(attribute.Int64(attributeSinkLatency, latency.Milliseconds()))
+		switch {
+		case err != nil:
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		default:
+			span.SetAttributes(attribute.Int(attributeHTTPStatusCode, resp.StatusCode))
+			if resp.StatusCode >= nethttp.StatusBadRequest {
+				span.SetStatus(codes.Error, resp.Status)
+			}
+		}
+		span.End()
+	}
+	return resp, err
+}
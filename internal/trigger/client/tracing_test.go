@@ -0,0 +1,87 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	nethttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestTracingTransport(t *testing.T) {
+	Convey("test tracing transport", t, func() {
+		status := nethttp.StatusOK
+		server := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+			w.WriteHeader(status)
+		}))
+		defer server.Close()
+
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		ctx, parent := provider.Tracer("test").Start(context.Background(), "deliver")
+		defer parent.End()
+
+		c := NewHTTPClient(server.URL+"?token=secret", nil)
+		e := ce.NewEvent()
+		e.SetID("1")
+		e.SetSource("test")
+		e.SetType("test")
+
+		attrs := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+			m := map[attribute.Key]attribute.Value{}
+			for _, kv := range span.Attributes() {
+				m[kv.Key] = kv.Value
+			}
+			return m
+		}
+
+		Convey("without parent spans", func() {
+			So(c.Send(context.Background(), &e), ShouldResemble, Success)
+			So(recorder.Ended(), ShouldBeEmpty)
+		})
+
+		Convey("trace the request", func() {
+			So(c.Send(WithParentSpans(context.Background(), []oteltrace.Span{parent}), &e), ShouldResemble, Success)
+			spans := recorder.Ended()
+			So(spans, ShouldHaveLength, 1)
+			So(spans[0].Name(), ShouldEqual, sinkSpanName)
+			So(spans[0].Parent().SpanID(), ShouldEqual, oteltrace.SpanContextFromContext(ctx).SpanID())
+			m := attrs(spans[0])
+			So(m[attributeHTTPMethod].AsString(), ShouldEqual, nethttp.MethodPost)
+			So(m[attributeHTTPURL].AsString(), ShouldEqual, server.URL)
+			So(m[attributeHTTPStatusCode].AsInt64(), ShouldEqual, nethttp.StatusOK)
+			So(spans[0].Status().Code, ShouldEqual, codes.Unset)
+		})
+
+		Convey("trace the failed request", func() {
+			status = nethttp.StatusServiceUnavailable
+			r := c.Send(WithParentSpans(context.Background(), []oteltrace.Span{parent}), &e)
+			So(r.StatusCode, ShouldEqual, nethttp.StatusServiceUnavailable)
+			spans := recorder.Ended()
+			So(spans, ShouldHaveLength, 1)
+			So(attrs(spans[0])[attributeHTTPStatusCode].AsInt64(), ShouldEqual, nethttp.StatusServiceUnavailable)
+			So(spans[0].Status().Code, ShouldEqual, codes.Error)
+		})
+	})
+}
@@ -19,6 +19,8 @@ import (
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
 	"github.com/linkall-labs/vanus/observability"
 	"github.com/linkall-labs/vanus/pkg/util"
 )
@@ -29,6 +31,10 @@ type Config struct {
 	IP             string               `yaml:"ip"`
 	ControllerAddr []string             `yaml:"controllers"`
 	Observability  observability.Config `yaml:"observability"`
+	// labels of the worker, subscriptions with node selector only run on workers whose labels match it.
+	Labels map[string]string `yaml:"labels"`
+	// the controller doesn't assign new subscriptions to the worker once it reaches its capacity.
+	Capacity CapacityConfig `yaml:"capacity"`
 
 	HeartbeatInterval time.Duration `yaml:"heartbeat_interval"`
 	// send event goroutine size
@@ -37,8 +43,26 @@ type Config struct {
 	SendEventBatchSize int `yaml:"send_event_batch_size"`
 	// var client read event from segment batch size.
 	PullEventBatchSize int `yaml:"pull_event_batch_size"`
+	// var client read event from segment max bytes, 0 is unlimited.
+	PullEventMaxBytes int `yaml:"pull_event_max_bytes"`
+	// maximum message size in bytes received from segment, reads are split to fit it, 0 is 4MB.
+	MaxRecvMsgSize int `yaml:"max_recv_msg_size"`
 	// max uack event number
 	MaxUACKEventNumber int `yaml:"max_uack_event_number"`
+	// count delivered events for billing
+	Metering metering.Config `yaml:"metering"`
+	// write an event to the system eventbus __data_loss_eb once events are removed by retention before
+	// subscriptions consumed them.
+	DataLossEvents bool `yaml:"data_loss_events"`
+	// stop reading an eventlog once a gap of offsets not caused by retention is found, until the eventlog is
+	// resumed by `vsctl subscription resume-eventlogs` or the subscription is restarted, so that no event is
+	// consumed out of order with the missing ones.
+	StrictSequence bool `yaml:"strict_sequence"`
+	// share reads of an eventlog among subscriptions on the worker, so that subscriptions reading the same
+	// region of a fan-out eventbus issue one store read.
+	SharedFetch bool `yaml:"shared_fetch"`
+	// compress requests to controllers and stores, e.g. to cut cross-AZ traffic.
+	GRPCCompression compression.Config `yaml:"grpc_compression"`
 }
 
 func InitConfig(filename string) (*Config, error) {
@@ -47,6 +71,9 @@ func InitConfig(filename string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	if err = c.GRPCCompression.Validate(); err != nil {
+		return nil, err
+	}
 	if c.IP == "" {
 		c.IP = util.GetLocalIP()
 	}
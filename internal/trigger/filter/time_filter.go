@@ -0,0 +1,75 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/timewindow"
+	"github.com/linkall-labs/vanus/observability/log"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+)
+
+type timeFilter struct {
+	ingestion bool
+	window    *timewindow.Window
+}
+
+func NewTimeFilter(f *primitive.TimeFilter) Filter {
+	window, err := timewindow.Parse(timewindow.Spec{
+		Since:    f.Since,
+		Until:    f.Until,
+		Daily:    f.Daily,
+		Weekdays: f.Weekdays,
+		TimeZone: f.TimeZone,
+	})
+	if err != nil {
+		log.Info(context.Background(), "parse time filter error", map[string]interface{}{
+			"filter":     f,
+			log.KeyError: err,
+		})
+		return nil
+	}
+	return &timeFilter{ingestion: f.Basis == primitive.TimeBasisIngestion, window: window}
+}
+
+// Filter fails events without the time compared, e.g. events which have no time attribute.
+func (filter *timeFilter) Filter(event ce.Event) Result {
+	var t time.Time
+	if filter.ingestion {
+		v, ok := event.Extensions()[segpb.XVanusStime]
+		if !ok {
+			return FailFilter
+		}
+		stime, err := types.ToTime(v)
+		if err != nil {
+			return FailFilter
+		}
+		t = stime
+	} else {
+		t = event.Time()
+	}
+	if t.IsZero() || !filter.window.Contains(t) {
+		return FailFilter
+	}
+	return PassFilter
+}
+
+var _ Filter = (*timeFilter)(nil)
@@ -0,0 +1,64 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTimeFilter(t *testing.T) {
+	event := ce.NewEvent()
+	event.SetID("testID")
+	event.SetSource("testSource")
+	event.SetTime(time.Date(2022, 1, 3, 10, 0, 0, 0, time.UTC))
+	event.SetExtension(segpb.XVanusStime, time.Date(2022, 1, 3, 20, 0, 0, 0, time.UTC))
+
+	Convey("time filter nil", t, func() {
+		f := filter.NewTimeFilter(&primitive.TimeFilter{Daily: "9-17"})
+		So(f, ShouldBeNil)
+	})
+	Convey("time filter event time", t, func() {
+		f := filter.NewTimeFilter(&primitive.TimeFilter{Daily: "09:00-17:00"})
+		So(f.Filter(event), ShouldEqual, filter.PassFilter)
+
+		f = filter.NewTimeFilter(&primitive.TimeFilter{Since: "2022-01-04T00:00:00Z"})
+		So(f.Filter(event), ShouldEqual, filter.FailFilter)
+
+		noTime := ce.NewEvent()
+		noTime.SetID("testID")
+		So(f.Filter(noTime), ShouldEqual, filter.FailFilter)
+	})
+	Convey("time filter ingestion time", t, func() {
+		f := filter.NewTimeFilter(&primitive.TimeFilter{
+			Basis: primitive.TimeBasisIngestion,
+			Daily: "09:00-17:00",
+		})
+		So(f.Filter(event), ShouldEqual, filter.FailFilter)
+
+		f = filter.NewTimeFilter(&primitive.TimeFilter{
+			Basis: primitive.TimeBasisIngestion,
+			Until: "2022-01-04T00:00:00Z",
+		})
+		So(f.Filter(event), ShouldEqual, filter.PassFilter)
+	})
+}
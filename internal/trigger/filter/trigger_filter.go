@@ -48,6 +48,9 @@ func extractFilter(subscriptionFilter *primitive.SubscriptionFilter) Filter {
 	if len(subscriptionFilter.Any) > 0 {
 		return NewAnyFilter(extractFilters(subscriptionFilter.Any)...)
 	}
+	if subscriptionFilter.Time != nil {
+		return NewTimeFilter(subscriptionFilter.Time)
+	}
 	return nil
 }
 
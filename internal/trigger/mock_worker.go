@@ -106,6 +106,35 @@ func (mr *MockWorkerMockRecorder) RemoveSubscription(ctx, id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscription", reflect.TypeOf((*MockWorker)(nil).RemoveSubscription), ctx, id)
 }
 
+// ResumeEventlogs mocks base method.
+func (m *MockWorker) ResumeEventlogs(ctx context.Context, id vanus.ID) ([]vanus.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeEventlogs", ctx, id)
+	ret0, _ := ret[0].([]vanus.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeEventlogs indicates an expected call of ResumeEventlogs.
+func (mr *MockWorkerMockRecorder) ResumeEventlogs(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeEventlogs", reflect.TypeOf((*MockWorker)(nil).ResumeEventlogs), ctx, id)
+}
+
+// ResyncSubscriptions mocks base method.
+func (m *MockWorker) ResyncSubscriptions(ctx context.Context, subscriptions []*primitive.Subscription) []vanus.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResyncSubscriptions", ctx, subscriptions)
+	ret0, _ := ret[0].([]vanus.ID)
+	return ret0
+}
+
+// ResyncSubscriptions indicates an expected call of ResyncSubscriptions.
+func (mr *MockWorkerMockRecorder) ResyncSubscriptions(ctx, subscriptions interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncSubscriptions", reflect.TypeOf((*MockWorker)(nil).ResyncSubscriptions), ctx, subscriptions)
+}
+
 // Start mocks base method.
 func (m *MockWorker) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,186 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/option"
+	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/observability/metrics"
+)
+
+// fetcherCachedBatches is the number of recent batches kept for each eventlog, so that readers a little
+// behind others are served without reading stores again.
+const fetcherCachedBatches = 16
+
+type fetchKey struct {
+	eventbus   string
+	eventLogID uint64
+}
+
+type fetchCall struct {
+	done   chan struct{}
+	events []*ce.Event
+	err    error
+}
+
+type cachedBatch struct {
+	offset uint64
+	events []*ce.Event
+}
+
+// Fetcher coalesces reads of the same eventlog region issued by readers of different subscriptions on a
+// worker. Concurrent reads of the same offset share one store read, e.g. subscriptions tailing an eventbus,
+// and recent batches are kept for readers slightly behind. Readers join the fetcher when they start reading
+// an eventlog and leave it when they stop, states of an eventlog are evicted once no reader is left.
+type Fetcher struct {
+	mutex sync.Mutex
+	// readers is the number of readers of each eventlog.
+	readers map[fetchKey]int
+	calls   map[fetchKey]map[uint64]*fetchCall
+	batches map[fetchKey][]cachedBatch
+}
+
+func NewFetcher() *Fetcher {
+	return &Fetcher{
+		readers: make(map[fetchKey]int),
+		calls:   make(map[fetchKey]map[uint64]*fetchCall),
+		batches: make(map[fetchKey][]cachedBatch),
+	}
+}
+
+// Join registers a reader of the eventlog.
+func (f *Fetcher) Join(eventbus string, eventLogID uint64) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.readers[fetchKey{eventbus: eventbus, eventLogID: eventLogID}]++
+}
+
+// Leave unregisters a reader of the eventlog. Recent batches are dropped once there is one reader left, as
+// nobody shares them, and all states of the eventlog are evicted once there is none.
+func (f *Fetcher) Leave(eventbus string, eventLogID uint64) {
+	key := fetchKey{eventbus: eventbus, eventLogID: eventLogID}
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.readers[key]--
+	if f.readers[key] > 1 {
+		return
+	}
+	delete(f.batches, key)
+	if f.readers[key] > 0 {
+		return
+	}
+	delete(f.readers, key)
+	// in-flight calls still finish, they hold their own reference to the map.
+	delete(f.calls, key)
+	logLabel := strconv.FormatUint(eventLogID, 10)
+	metrics.TriggerCoalescedReadCounter.DeleteLabelValues(eventbus, logLabel)
+	metrics.TriggerStoreReadCounter.DeleteLabelValues(eventbus, logLabel)
+}
+
+// Fetch reads events of the eventlog from the offset through lr, which is the reader of the caller whose read
+// policy is at the offset. Events returned are owned by the caller. Reads of an eventlog with a single reader
+// aren't shared, they go to lr directly.
+func (f *Fetcher) Fetch(ctx context.Context, eventbus string, log api.Eventlog, offset uint64,
+	lr api.BusReader) ([]*ce.Event, error) {
+	key := fetchKey{eventbus: eventbus, eventLogID: log.ID()}
+	logLabel := strconv.FormatUint(key.eventLogID, 10)
+	f.mutex.Lock()
+	if f.readers[key] <= 1 {
+		f.mutex.Unlock()
+		return readEvents(ctx, lr)
+	}
+	if events := f.cached(key, offset); len(events) > 0 {
+		f.mutex.Unlock()
+		metrics.TriggerCoalescedReadCounter.WithLabelValues(eventbus, logLabel).Inc()
+		return cloneEvents(events), nil
+	}
+	calls, ok := f.calls[key]
+	if !ok {
+		calls = make(map[uint64]*fetchCall)
+		f.calls[key] = calls
+	}
+	if call, ok := calls[offset]; ok {
+		f.mutex.Unlock()
+		metrics.TriggerCoalescedReadCounter.WithLabelValues(eventbus, logLabel).Inc()
+		select {
+		case <-call.done:
+			return cloneEvents(call.events), call.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	call := &fetchCall{done: make(chan struct{})}
+	calls[offset] = call
+	f.mutex.Unlock()
+
+	// the read isn't canceled with ctx, which is of a single subscription while others may wait for it.
+	readCtx, cancel := context.WithTimeout(context.Background(), readEventTimeout)
+	call.events, _, _, call.err = lr.Read(readCtx,
+		option.WithReadPolicy(policy.NewManuallyReadPolicy(log, int64(offset))))
+	cancel()
+	metrics.TriggerStoreReadCounter.WithLabelValues(eventbus, logLabel).Inc()
+
+	f.mutex.Lock()
+	delete(calls, offset)
+	if call.err == nil && len(call.events) > 0 && f.readers[key] > 1 {
+		f.cache(key, offset, call.events)
+	}
+	f.mutex.Unlock()
+	close(call.done)
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		return cloneEvents(call.events), call.err
+	}
+}
+
+// cached returns events from the offset in a recent batch.
+func (f *Fetcher) cached(key fetchKey, offset uint64) []*ce.Event {
+	for _, b := range f.batches[key] {
+		if offset >= b.offset && offset < b.offset+uint64(len(b.events)) {
+			return b.events[offset-b.offset:]
+		}
+	}
+	return nil
+}
+
+func (f *Fetcher) cache(key fetchKey, offset uint64, events []*ce.Event) {
+	batches := f.batches[key]
+	if len(batches) >= fetcherCachedBatches {
+		batches = batches[1:]
+	}
+	f.batches[key] = append(batches, cachedBatch{offset: offset, events: events})
+}
+
+// cloneEvents copies events shared by a Fetcher.
+func cloneEvents(events []*ce.Event) []*ce.Event {
+	if events == nil {
+		return nil
+	}
+	clones := make([]*ce.Event, len(events))
+	for i := range events {
+		clone := events[i].Clone()
+		clones[i] = &clone
+	}
+	return clones
+}
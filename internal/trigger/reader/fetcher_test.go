@@ -0,0 +1,147 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reader
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	ce "github.com/cloudevents/sdk-go/v2"
+	. "github.com/golang/mock/gomock"
+	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFetcher_Fetch(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+	mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+
+	makeEvents := func(n int) []*ce.Event {
+		events := make([]*ce.Event, n)
+		for i := range events {
+			e := ce.NewEvent()
+			e.SetID("id")
+			events[i] = &e
+		}
+		return events
+	}
+
+	Convey("test fetcher fetch", t, func() {
+		ctx := context.Background()
+		f := NewFetcher()
+
+		Convey("a single reader reads directly", func() {
+			f.Join("bus", 1)
+			events := makeEvents(2)
+			mockBusReader.EXPECT().Read(Any()).Times(2).Return(events, int64(0), uint64(1), nil)
+			result, err := f.Fetch(ctx, "bus", mockEventlog, 100, mockBusReader)
+			So(err, ShouldBeNil)
+			So(result[0], ShouldEqual, events[0])
+			result, err = f.Fetch(ctx, "bus", mockEventlog, 101, mockBusReader)
+			So(err, ShouldBeNil)
+			So(result, ShouldHaveLength, 2)
+			So(f.batches, ShouldBeEmpty)
+		})
+
+		Convey("readers share reads", func() {
+			f.Join("bus", 1)
+			f.Join("bus", 1)
+
+			Convey("concurrent reads of the same offset", func() {
+				release := make(chan struct{})
+				mockBusReader.EXPECT().Read(Any(), Any()).Times(1).DoAndReturn(
+					func(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+						<-release
+						return makeEvents(3), 0, 1, nil
+					})
+				var wg sync.WaitGroup
+				results := make([][]*ce.Event, 5)
+				for i := range results {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						results[i], _ = f.Fetch(ctx, "bus", mockEventlog, 100, mockBusReader)
+					}(i)
+				}
+				time.Sleep(50 * time.Millisecond)
+				close(release)
+				wg.Wait()
+				for i := range results {
+					So(results[i], ShouldHaveLength, 3)
+				}
+				So(results[0][0], ShouldNotEqual, results[1][0])
+				results[0][0].SetID("changed")
+				So(results[1][0].ID(), ShouldEqual, "id")
+
+				Convey("serve readers behind from recent batches", func() {
+					events, err := f.Fetch(ctx, "bus", mockEventlog, 101, mockBusReader)
+					So(err, ShouldBeNil)
+					So(events, ShouldHaveLength, 2)
+					So(events[0].ID(), ShouldEqual, "id")
+				})
+
+				Convey("evict batches once readers leave", func() {
+					f.Leave("bus", 1)
+					So(f.batches, ShouldBeEmpty)
+					So(f.readers, ShouldContainKey, fetchKey{eventbus: "bus", eventLogID: 1})
+					f.Leave("bus", 1)
+					So(f.readers, ShouldBeEmpty)
+					So(f.calls, ShouldBeEmpty)
+				})
+			})
+
+			Convey("errors aren't cached", func() {
+				mockBusReader.EXPECT().Read(Any(), Any()).Times(2).Return(nil, int64(0), uint64(0),
+					errors.ErrOffsetOnEnd)
+				_, err := f.Fetch(ctx, "bus", mockEventlog, 100, mockBusReader)
+				So(errors.Is(err, errors.ErrOffsetOnEnd), ShouldBeTrue)
+				_, err = f.Fetch(ctx, "bus", mockEventlog, 100, mockBusReader)
+				So(errors.Is(err, errors.ErrOffsetOnEnd), ShouldBeTrue)
+			})
+
+			Convey("canceled waiter", func() {
+				release := make(chan struct{})
+				mockBusReader.EXPECT().Read(Any(), Any()).Times(1).DoAndReturn(
+					func(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+						<-release
+						return makeEvents(1), 0, 1, nil
+					})
+				go func() {
+					_, _ = f.Fetch(ctx, "bus", mockEventlog, 200, mockBusReader)
+				}()
+				time.Sleep(20 * time.Millisecond)
+				cancelCtx, cancel := context.WithCancel(ctx)
+				cancel()
+				_, err := f.Fetch(cancelCtx, "bus", mockEventlog, 200, mockBusReader)
+				So(err, ShouldEqual, context.Canceled)
+				close(release)
+			})
+		})
+	})
+
+	Convey("test clone events", t, func() {
+		events := makeEvents(2)
+		clones := cloneEvents(events)
+		So(clones, ShouldHaveLength, 2)
+		clones[0].SetID("changed")
+		So(events[0].ID(), ShouldEqual, "id")
+	})
+}
@@ -8,6 +8,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
 )
 
 // MockReader is a mock of Reader interface.
@@ -45,6 +46,20 @@ func (mr *MockReaderMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockReader)(nil).Close))
 }
 
+// Resume mocks base method.
+func (m *MockReader) Resume() []vanus.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume")
+	ret0, _ := ret[0].([]vanus.ID)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockReaderMockRecorder) Resume() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockReader)(nil).Resume))
+}
+
 // Start mocks base method.
 func (m *MockReader) Start() error {
 	m.ctrl.T.Helper()
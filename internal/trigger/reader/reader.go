@@ -19,15 +19,19 @@ import (
 	"context"
 	"encoding/binary"
 	stderr "errors"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/gap"
 	"github.com/linkall-labs/vanus/client/pkg/option"
 	"github.com/linkall-labs/vanus/client/pkg/policy"
+	"github.com/linkall-labs/vanus/internal/primitive"
 	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/info"
@@ -52,20 +56,36 @@ type Config struct {
 	SubscriptionIDStr string
 	Offset            EventLogOffset
 	BatchSize         int
+	MaxBytes          int
+	// CaughtUp is called once readers of all eventlogs reach the end of them.
+	CaughtUp func()
+	// Fetcher shares reads with readers of other subscriptions, events are read separately if it's nil.
+	Fetcher *Fetcher
+	// DataLost is called once events are removed by retention before they are read, the reader skips to the
+	// earliest offset of the eventlog.
+	DataLost func(loss primitive.DataLoss)
+	// GapDetector checks offsets of events read are continuous, in strict mode readers of eventlogs stop at
+	// gaps not caused by retention. Gaps aren't checked if it's nil.
+	GapDetector *gap.Detector
 }
 type EventLogOffset map[vanus.ID]uint64
 
 type Reader interface {
 	Start() error
 	Close()
+	// Resume resumes eventlogs paused by sequence gaps, it returns IDs of the eventlogs resumed.
+	Resume() []vanus.ID
 }
 
 type reader struct {
 	config   Config
-	elReader map[vanus.ID]struct{}
+	elReader map[vanus.ID]*eventLogReader
+	mutex    sync.Mutex
 	events   chan<- info.EventRecord
 	stop     context.CancelFunc
 	wg       sync.WaitGroup
+	// the number of eventlogs whose readers haven't reached the end.
+	behind int32
 }
 
 func NewReader(config Config, events chan<- info.EventRecord) Reader {
@@ -73,7 +93,7 @@ func NewReader(config Config, events chan<- info.EventRecord) Reader {
 	r := &reader{
 		config:   config,
 		events:   events,
-		elReader: make(map[vanus.ID]struct{}),
+		elReader: make(map[vanus.ID]*eventLogReader),
 	}
 	return r
 }
@@ -101,17 +121,30 @@ func (r *reader) Start() error {
 		})
 		return err
 	}
+	r.behind = int32(len(logs))
+	if len(logs) == 0 && r.config.CaughtUp != nil {
+		r.config.CaughtUp()
+	}
 	for _, l := range logs {
 		eventLogID := vanus.NewIDFromUint64(l.ID())
 		offset := r.getOffset(eventLogID)
+		if r.config.GapDetector != nil {
+			r.config.GapDetector.Reset(l.ID(), offset)
+		}
 		elc := &eventLogReader{
 			config:        r.config,
 			eventLogID:    eventLogID,
 			eventLogIDStr: eventLogID.String(),
+			log:           l,
 			policy:        policy.NewManuallyReadPolicy(l, int64(offset)),
 			events:        r.events,
 			offset:        offset,
+			onEnd:         r.onEnd,
+			resumeC:       make(chan struct{}, 1),
 		}
+		r.mutex.Lock()
+		r.elReader[eventLogID] = elc
+		r.mutex.Unlock()
 		r.wg.Add(1)
 		go func() {
 			defer r.wg.Done()
@@ -131,6 +164,32 @@ func (r *reader) Start() error {
 	return nil
 }
 
+func (r *reader) Resume() []vanus.ID {
+	if r.config.GapDetector == nil {
+		return nil
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var ids []vanus.ID
+	for id, elc := range r.elReader {
+		if !r.config.GapDetector.Resume(id.Uint64()) {
+			continue
+		}
+		select {
+		case elc.resumeC <- struct{}{}:
+		default:
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (r *reader) onEnd() {
+	if atomic.AddInt32(&r.behind, -1) == 0 && r.config.CaughtUp != nil {
+		r.config.CaughtUp()
+	}
+}
+
 func (r *reader) getOffset(eventLogID vanus.ID) uint64 {
 	v, exist := r.config.Offset[eventLogID]
 	if exist {
@@ -148,19 +207,31 @@ type eventLogReader struct {
 	config        Config
 	eventLogID    vanus.ID
 	eventLogIDStr string
+	log           api.Eventlog
 	policy        api.ReadPolicy
 	events        chan<- info.EventRecord
 	offset        uint64
+	onEnd         func()
+	reachedEnd    bool
+	paused        bool
+	// resumeC wakes the reader paused by a sequence gap once the eventlog is resumed.
+	resumeC chan struct{}
 }
 
 func (elReader *eventLogReader) run(ctx context.Context) {
 	r := elReader.config.Client.Eventbus(ctx, elReader.config.EventBusName).Reader(
-		option.WithReadPolicy(elReader.policy), option.WithBatchSize(elReader.config.BatchSize))
+		option.WithReadPolicy(elReader.policy), option.WithBatchSize(elReader.config.BatchSize),
+		option.WithMaxBytes(elReader.config.MaxBytes))
 	log.Info(ctx, "eventlog reader init success", map[string]interface{}{
 		log.KeyEventbusName: elReader.config.EventBusName,
 		log.KeyEventlogID:   elReader.eventLogID,
 		"offset":            elReader.offset,
 	})
+	defer elReader.setPaused(false)
+	if elReader.config.Fetcher != nil {
+		elReader.config.Fetcher.Join(elReader.config.EventBusName, elReader.log.ID())
+		defer elReader.config.Fetcher.Leave(elReader.config.EventBusName, elReader.log.ID())
+	}
 	for {
 		select {
 		case <-ctx.Done():
@@ -169,12 +240,38 @@ func (elReader *eventLogReader) run(ctx context.Context) {
 		}
 		err := elReader.loop(ctx, r)
 		switch {
-		case err == nil, errors.Is(err, errors.ErrOffsetOnEnd), errors.Is(err, errors.ErrTryAgain):
+		case stderr.Is(err, gap.ErrPaused):
+			// The gap is logged by the detector, the reader waits until the eventlog is resumed.
+			elReader.setPaused(true)
+			select {
+			case <-ctx.Done():
+				return
+			case <-elReader.resumeC:
+				elReader.setPaused(false)
+			}
+		case errors.Is(err, errors.ErrOffsetOnEnd):
+			if !elReader.reachedEnd {
+				elReader.reachedEnd = true
+				if elReader.onEnd != nil {
+					elReader.onEnd()
+				}
+			}
+		case err == nil, errors.Is(err, errors.ErrTryAgain):
 			continue
 		case stderr.Is(err, context.Canceled), status.Convert(err).Code() == codes.Canceled:
 			return
 		case errors.Is(err, errors.ErrOffsetUnderflow):
-			// todo reset offset timestamp
+			if err = elReader.skipRemoved(ctx); err != nil {
+				log.Warning(ctx, "skip removed events error", map[string]interface{}{
+					log.KeyEventbusName: elReader.config.EventBusName,
+					log.KeyEventlogID:   elReader.eventLogID,
+					"offset":            elReader.policy.Offset(),
+					log.KeyError:        err,
+				})
+				if !util.SleepWithContext(ctx, readErrSleepTime) {
+					return
+				}
+			}
 		default:
 			log.Warning(ctx, "read event error", map[string]interface{}{
 				log.KeyEventbusName: elReader.config.EventBusName,
@@ -190,7 +287,14 @@ func (elReader *eventLogReader) run(ctx context.Context) {
 }
 
 func (elReader *eventLogReader) loop(ctx context.Context, lr api.BusReader) error {
-	events, err := readEvents(ctx, lr)
+	var events []*ce.Event
+	var err error
+	if elReader.config.Fetcher != nil {
+		events, err = elReader.config.Fetcher.Fetch(ctx, elReader.config.EventBusName, elReader.log,
+			uint64(elReader.policy.Offset()), lr)
+	} else {
+		events, err = readEvents(ctx, lr)
+	}
 	if err != nil {
 		return err
 	}
@@ -198,6 +302,13 @@ func (elReader *eventLogReader) loop(ctx context.Context, lr api.BusReader) erro
 		ec, _ := events[i].Context.(*ce.EventContextV1)
 		offsetByte, _ := ec.Extensions[eventlog.XVanusLogOffset].([]byte)
 		offset := binary.BigEndian.Uint64(offsetByte)
+		if elReader.config.GapDetector != nil {
+			if err = elReader.config.GapDetector.Observe(ctx, elReader.eventLogID.Uint64(), offset); err != nil {
+				// Events before the gap are consumed, the rest are read again.
+				elReader.policy.Forward(i)
+				return err
+			}
+		}
 		eo := info.EventRecord{Event: events[i], OffsetInfo: pInfo.OffsetInfo{
 			EventLogID: elReader.eventLogID,
 			Offset:     offset,
@@ -215,6 +326,54 @@ func (elReader *eventLogReader) loop(ctx context.Context, lr api.BusReader) erro
 	return nil
 }
 
+// skipRemoved moves the reader to the earliest offset of the eventlog, events before it were removed by
+// retention and are lost.
+func (elReader *eventLogReader) skipRemoved(ctx context.Context) error {
+	timeout, cancel := context.WithTimeout(ctx, readEventTimeout)
+	defer cancel()
+	earliest, err := elReader.log.EarliestOffset(timeout)
+	if err != nil {
+		return err
+	}
+	from := elReader.policy.Offset()
+	if earliest <= from {
+		return errors.ErrOffsetUnderflow.WithMessage(
+			fmt.Sprintf("the offset %d isn't before the earliest offset %d", from, earliest))
+	}
+	elReader.policy.Forward(int(earliest - from))
+	loss := primitive.DataLoss{
+		EventlogID: elReader.eventLogID,
+		FromOffset: uint64(from),
+		ToOffset:   uint64(earliest),
+		DetectedAt: time.Now(),
+	}
+	log.Warning(ctx, "events are removed before they are read, skip to the earliest offset", map[string]interface{}{
+		log.KeySubscriptionID: elReader.config.SubscriptionID,
+		log.KeyEventbusName:   elReader.config.EventBusName,
+		log.KeyEventlogID:     elReader.eventLogID,
+		"from":                loss.FromOffset,
+		"to":                  loss.ToOffset,
+	})
+	if elReader.config.DataLost != nil {
+		elReader.config.DataLost(loss)
+	}
+	return nil
+}
+
+func (elReader *eventLogReader) setPaused(paused bool) {
+	if elReader.paused == paused {
+		return
+	}
+	elReader.paused = paused
+	gauge := metrics.TriggerPausedEventlogGauge.WithLabelValues(
+		elReader.config.SubscriptionIDStr, elReader.config.EventBusName)
+	if paused {
+		gauge.Inc()
+	} else {
+		gauge.Dec()
+	}
+}
+
 func (elReader *eventLogReader) putEvent(ctx context.Context, event info.EventRecord) error {
 	select {
 	case elReader.events <- event:
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/binary"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -27,7 +28,11 @@ import (
 	"github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/client/pkg/gap"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/info"
+	"github.com/linkall-labs/vanus/pkg/errors"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -41,7 +46,7 @@ func TestReaderStart(t *testing.T) {
 	mockBusReader := api.NewMockBusReader(mockCtrl)
 	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
 	mockEventbus.EXPECT().Writer().AnyTimes().Return(mockBusWriter)
-	mockEventbus.EXPECT().Reader(Any(), Any()).AnyTimes().Return(mockBusReader)
+	mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
 	mockEventbus.EXPECT().GetLog(Any(), Any()).AnyTimes().Return(mockEventlog, nil)
 	mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
 	mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(0))
@@ -80,3 +85,233 @@ func TestReaderStart(t *testing.T) {
 		r.Close()
 	})
 }
+
+func TestReaderCaughtUp(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockClient := client.NewMockClient(mockCtrl)
+	mockEventbus := api.NewMockEventbus(mockCtrl)
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
+	mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+	mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(0))
+
+	Convey("test reader caught up", t, func() {
+		var index uint64
+		mockBusReader.EXPECT().Read(Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+				time.Sleep(time.Millisecond)
+				if index >= 10 {
+					return nil, 0, 0, errors.ErrOffsetOnEnd
+				}
+				e := ce.NewEvent()
+				e.SetID(uuid.NewString())
+				buf := make([]byte, 8)
+				binary.BigEndian.PutUint64(buf, index)
+				e.SetExtension(eventlog.XVanusLogOffset, buf)
+				index++
+				return []*ce.Event{&e}, int64(0), uint64(0), nil
+			})
+		var caughtUp int32
+		eventCh := make(chan info.EventRecord, 100)
+		r := NewReader(Config{EventBusName: "test", BatchSize: 1, CaughtUp: func() {
+			atomic.AddInt32(&caughtUp, 1)
+		}}, eventCh).(*reader)
+		r.config.Client = mockClient
+		So(r.Start(), ShouldBeNil)
+		for i := 0; i < 10; i++ {
+			<-eventCh
+		}
+		time.Sleep(50 * time.Millisecond)
+		r.Close()
+		So(atomic.LoadInt32(&caughtUp), ShouldEqual, 1)
+	})
+}
+
+func TestReaderSkipRemoved(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockClient := client.NewMockClient(mockCtrl)
+	mockEventbus := api.NewMockEventbus(mockCtrl)
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
+	mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+	mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+
+	Convey("test reader skip removed events", t, func() {
+		mockEventlog.EXPECT().EarliestOffset(Any()).AnyTimes().Return(int64(50), nil)
+		var removed int32 = 1
+		index := uint64(50)
+		mockBusReader.EXPECT().Read(Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+				time.Sleep(time.Millisecond)
+				if atomic.LoadInt32(&removed) == 1 {
+					return nil, 0, 0, errors.ErrOffsetUnderflow
+				}
+				e := ce.NewEvent()
+				e.SetID(uuid.NewString())
+				buf := make([]byte, 8)
+				binary.BigEndian.PutUint64(buf, index)
+				e.SetExtension(eventlog.XVanusLogOffset, buf)
+				index++
+				return []*ce.Event{&e}, int64(0), uint64(0), nil
+			})
+		losses := make(chan primitive.DataLoss, 1)
+		eventCh := make(chan info.EventRecord, 100)
+		r := NewReader(Config{EventBusName: "test", BatchSize: 1, DataLost: func(loss primitive.DataLoss) {
+			atomic.StoreInt32(&removed, 0)
+			losses <- loss
+		}}, eventCh).(*reader)
+		r.config.Client = mockClient
+		So(r.Start(), ShouldBeNil)
+		loss := <-losses
+		So(loss.EventlogID.Uint64(), ShouldEqual, 1)
+		So(loss.FromOffset, ShouldEqual, 0)
+		So(loss.ToOffset, ShouldEqual, 50)
+		e := <-eventCh
+		So(e.Offset, ShouldEqual, 50)
+		r.Close()
+	})
+}
+
+func TestReaderSequenceGap(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockClient := client.NewMockClient(mockCtrl)
+	mockEventbus := api.NewMockEventbus(mockCtrl)
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().Return(mockBusReader)
+	mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+	mockEventbus.EXPECT().GetLog(Any(), Any()).AnyTimes().Return(mockEventlog, nil)
+	mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+
+	Convey("test reader sequence gap", t, func() {
+		mockEventlog.EXPECT().EarliestOffset(Any()).AnyTimes().Return(int64(8), nil)
+		offsets := []uint64{0, 1, 2, 3, 4, 10, 11}
+		var next int32
+		mockBusReader.EXPECT().Read(Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+				time.Sleep(time.Millisecond)
+				i := atomic.AddInt32(&next, 1) - 1
+				if int(i) >= len(offsets) {
+					return nil, 0, 0, errors.ErrOffsetOnEnd
+				}
+				e := ce.NewEvent()
+				e.SetID(uuid.NewString())
+				buf := make([]byte, 8)
+				binary.BigEndian.PutUint64(buf, offsets[i])
+				e.SetExtension(eventlog.XVanusLogOffset, buf)
+				return []*ce.Event{&e}, int64(0), uint64(0), nil
+			})
+		gaps := make(chan gap.Gap, 2)
+		detector := gap.NewDetector(gap.Config{
+			Strict:   true,
+			Earliest: gap.EarliestOf(mockEventbus),
+			OnGap: func(g gap.Gap) {
+				gaps <- g
+			},
+		})
+		eventCh := make(chan info.EventRecord, 100)
+		r := NewReader(Config{EventBusName: "test", BatchSize: 1, GapDetector: detector}, eventCh).(*reader)
+		r.config.Client = mockClient
+		So(r.Start(), ShouldBeNil)
+		for i := 0; i < 5; i++ {
+			e := <-eventCh
+			So(e.Offset, ShouldEqual, i)
+		}
+		g := <-gaps
+		So(g.Cause, ShouldEqual, gap.CauseRetention)
+		So(g.From, ShouldEqual, 5)
+		So(g.To, ShouldEqual, 8)
+		g = <-gaps
+		So(g.Cause, ShouldEqual, gap.CauseMissing)
+		So(g.From, ShouldEqual, 8)
+		So(g.To, ShouldEqual, 10)
+
+		// the reader stops at the gap in strict mode.
+		time.Sleep(50 * time.Millisecond)
+		So(eventCh, ShouldBeEmpty)
+		stats := detector.Stats(1)
+		So(stats.Paused, ShouldNotBeNil)
+		So(stats.Events[gap.CauseRetention], ShouldEqual, 3)
+		So(stats.Events[gap.CauseMissing], ShouldEqual, 2)
+		r.Close()
+	})
+}
+
+func TestReaderResume(t *testing.T) {
+	mockCtrl := NewController(t)
+	defer mockCtrl.Finish()
+	mockClient := client.NewMockClient(mockCtrl)
+	mockEventbus := api.NewMockEventbus(mockCtrl)
+	mockEventlog := api.NewMockEventlog(mockCtrl)
+	mockBusReader := api.NewMockBusReader(mockCtrl)
+	mockClient.EXPECT().Eventbus(Any(), Any()).AnyTimes().Return(mockEventbus)
+	mockEventbus.EXPECT().ListLog(Any()).AnyTimes().Return([]api.Eventlog{mockEventlog}, nil)
+	mockEventbus.EXPECT().GetLog(Any(), Any()).AnyTimes().Return(mockEventlog, nil)
+	mockEventlog.EXPECT().ID().AnyTimes().Return(uint64(1))
+
+	Convey("test resume the eventlog paused by a sequence gap", t, func() {
+		mockEventlog.EXPECT().EarliestOffset(Any()).AnyTimes().Return(int64(0), nil)
+		// events are read from the position of the read policy, offsets jump from 2 to 5.
+		offsets := []uint64{0, 1, 2, 5, 6}
+		var readPolicy api.ReadPolicy
+		mockEventbus.EXPECT().Reader(Any(), Any(), Any()).AnyTimes().DoAndReturn(
+			func(opts ...api.ReadOption) api.BusReader {
+				options := &api.ReadOptions{}
+				for _, opt := range opts {
+					opt(options)
+				}
+				readPolicy = options.Policy
+				return mockBusReader
+			})
+		mockBusReader.EXPECT().Read(Any()).AnyTimes().DoAndReturn(
+			func(ctx context.Context, opts ...api.ReadOption) ([]*ce.Event, int64, uint64, error) {
+				time.Sleep(time.Millisecond)
+				i := readPolicy.Offset()
+				if int(i) >= len(offsets) {
+					return nil, 0, 0, errors.ErrOffsetOnEnd
+				}
+				e := ce.NewEvent()
+				e.SetID(uuid.NewString())
+				buf := make([]byte, 8)
+				binary.BigEndian.PutUint64(buf, offsets[i])
+				e.SetExtension(eventlog.XVanusLogOffset, buf)
+				return []*ce.Event{&e}, int64(0), uint64(0), nil
+			})
+		detector := gap.NewDetector(gap.Config{
+			Strict:   true,
+			Earliest: gap.EarliestOf(mockEventbus),
+		})
+		eventCh := make(chan info.EventRecord, 100)
+		r := NewReader(Config{EventBusName: "test", BatchSize: 1, GapDetector: detector}, eventCh).(*reader)
+		r.config.Client = mockClient
+		So(r.Start(), ShouldBeNil)
+		for i := 0; i < 3; i++ {
+			e := <-eventCh
+			So(e.Offset, ShouldEqual, i)
+		}
+		time.Sleep(50 * time.Millisecond)
+		So(eventCh, ShouldBeEmpty)
+		So(detector.Paused(), ShouldHaveLength, 1)
+
+		So(r.Resume(), ShouldResemble, []vanus.ID{1})
+		for _, offset := range []uint64{5, 6} {
+			select {
+			case e := <-eventCh:
+				So(e.Offset, ShouldEqual, offset)
+			case <-time.After(time.Second):
+				So("the resumed eventlog isn't read", ShouldBeEmpty)
+			}
+		}
+		So(detector.Paused(), ShouldBeEmpty)
+		So(r.Resume(), ShouldBeEmpty)
+		r.Close()
+	})
+}
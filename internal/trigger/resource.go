@@ -0,0 +1,134 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+// cgroupMemoryLimitFiles are the memory limit files of cgroup v2 and v1.
+var cgroupMemoryLimitFiles = []string{
+	"/sys/fs/cgroup/memory.max",
+	"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+}
+
+type CapacityConfig struct {
+	// maximum number of subscriptions, 0 is unlimited.
+	MaxSubscriptions uint32 `yaml:"max_subscriptions"`
+	// maximum number of delivered events per second, 0 is unlimited.
+	MaxEventsPerSecond float64 `yaml:"max_events_per_second"`
+	// CPU cores the worker can use, it's GOMAXPROCS by default.
+	CPULimit float64 `yaml:"cpu_limit"`
+	// memory in bytes the worker can use, it's the limit of the cgroup by default.
+	MemoryLimit uint64 `yaml:"memory_limit"`
+}
+
+func (c *CapacityConfig) init() {
+	if c.CPULimit <= 0 {
+		c.CPULimit = float64(runtime.GOMAXPROCS(0))
+	}
+	if c.MemoryLimit == 0 {
+		c.MemoryLimit = cgroupMemoryLimit()
+	}
+}
+
+// cgroupMemoryLimit returns 0 if the memory isn't limited or the limit is unknown.
+func cgroupMemoryLimit() uint64 {
+	for _, file := range cgroupMemoryLimitFiles {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		limit, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			// it's "max" if the memory isn't limited by cgroup v2.
+			return 0
+		}
+		// cgroup v1 reports a huge number if the memory isn't limited.
+		if limit >= 1<<62 {
+			return 0
+		}
+		return limit
+	}
+	return 0
+}
+
+// resourceSampler measures the usage of the worker between heartbeats.
+type resourceSampler struct {
+	capacity   CapacityConfig
+	sampledAt  time.Time
+	cpuTime    time.Duration
+	delivered  uint64
+	cpuTimeNow func() time.Duration
+	memoryNow  func() uint64
+}
+
+func newResourceSampler(capacity CapacityConfig) *resourceSampler {
+	capacity.init()
+	return &resourceSampler{
+		capacity:   capacity,
+		cpuTimeNow: processCPUTime,
+		memoryNow:  processMemory,
+	}
+}
+
+// sample returns the usage since the last sample, rates are 0 on the first sample. delivered is the
+// total of delivered events of subscriptions on the worker.
+func (s *resourceSampler) sample(now time.Time, subscriptions int, delivered uint64) *ctrlpb.TriggerWorkerResources {
+	res := &ctrlpb.TriggerWorkerResources{
+		CpuLimit:           s.capacity.CPULimit,
+		MemoryBytes:        s.memoryNow(),
+		MemoryLimit:        s.capacity.MemoryLimit,
+		Subscriptions:      uint32(subscriptions),
+		MaxSubscriptions:   s.capacity.MaxSubscriptions,
+		MaxEventsPerSecond: s.capacity.MaxEventsPerSecond,
+	}
+	cpuTime := s.cpuTimeNow()
+	if !s.sampledAt.IsZero() {
+		if elapsed := now.Sub(s.sampledAt).Seconds(); elapsed > 0 {
+			res.CpuCores = (cpuTime - s.cpuTime).Seconds() / elapsed
+			// the total goes backwards if a subscription is removed from the worker.
+			if delivered >= s.delivered {
+				res.EventsPerSecond = float64(delivered-s.delivered) / elapsed
+			}
+		}
+	}
+	s.sampledAt = now
+	s.cpuTime = cpuTime
+	s.delivered = delivered
+	return res
+}
+
+func processCPUTime() time.Duration {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return 0
+	}
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano())
+}
+
+// processMemory approximates the resident memory by memory obtained from the OS and not returned yet.
+func processMemory() uint64 {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.Sys - stats.HeapReleased
+}
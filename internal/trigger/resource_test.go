@@ -0,0 +1,68 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestResourceSampler_Sample(t *testing.T) {
+	Convey("test resource sampler sample", t, func() {
+		s := newResourceSampler(CapacityConfig{
+			MaxSubscriptions:   10,
+			MaxEventsPerSecond: 1000,
+			CPULimit:           2,
+			MemoryLimit:        1 << 30,
+		})
+		var cpuTime time.Duration
+		s.cpuTimeNow = func() time.Duration {
+			return cpuTime
+		}
+		s.memoryNow = func() uint64 {
+			return 1 << 20
+		}
+		now := time.Now()
+
+		res := s.sample(now, 3, 100)
+		So(res.CpuCores, ShouldEqual, 0)
+		So(res.EventsPerSecond, ShouldEqual, 0)
+		So(res.CpuLimit, ShouldEqual, 2)
+		So(res.MemoryBytes, ShouldEqual, 1<<20)
+		So(res.MemoryLimit, ShouldEqual, 1<<30)
+		So(res.Subscriptions, ShouldEqual, 3)
+		So(res.MaxSubscriptions, ShouldEqual, 10)
+		So(res.MaxEventsPerSecond, ShouldEqual, 1000)
+
+		cpuTime = 3 * time.Second
+		res = s.sample(now.Add(2*time.Second), 3, 300)
+		So(res.CpuCores, ShouldEqual, 1.5)
+		So(res.EventsPerSecond, ShouldEqual, 100)
+
+		Convey("delivered events go backwards", func() {
+			res = s.sample(now.Add(4*time.Second), 2, 50)
+			So(res.EventsPerSecond, ShouldEqual, 0)
+			res = s.sample(now.Add(6*time.Second), 2, 250)
+			So(res.EventsPerSecond, ShouldEqual, 100)
+		})
+	})
+
+	Convey("test capacity defaults", t, func() {
+		s := newResourceSampler(CapacityConfig{})
+		So(s.capacity.CPULimit, ShouldBeGreaterThan, 0)
+	})
+}
@@ -139,6 +139,45 @@ func (s *server) ResumeSubscription(ctx context.Context,
 	return &pbtrigger.ResumeSubscriptionResponse{}, nil
 }
 
+func (s *server) ResumeEventlogs(ctx context.Context,
+	request *pbtrigger.ResumeEventlogsRequest) (*pbtrigger.ResumeEventlogsResponse, error) {
+	log.Info(ctx, "subscription resume eventlogs ", map[string]interface{}{"request": request})
+	if s.state != primitive.ServerStateRunning {
+		return nil, errors.ErrWorkerNotStart
+	}
+	ids, err := s.worker.ResumeEventlogs(ctx, vanus.NewIDFromUint64(request.SubscriptionId))
+	if err != nil {
+		log.Error(ctx, "resume eventlogs error", map[string]interface{}{
+			log.KeySubscriptionID: request.SubscriptionId,
+			log.KeyError:          err,
+		})
+		return nil, err
+	}
+	resp := &pbtrigger.ResumeEventlogsResponse{EventlogIds: make([]uint64, len(ids))}
+	for i, id := range ids {
+		resp.EventlogIds[i] = id.Uint64()
+	}
+	return resp, nil
+}
+
+func (s *server) ResyncSubscriptions(ctx context.Context,
+	request *pbtrigger.ResyncSubscriptionsRequest) (*pbtrigger.ResyncSubscriptionsResponse, error) {
+	log.Info(ctx, "subscription resync ", map[string]interface{}{"count": len(request.Subscriptions)})
+	if s.state != primitive.ServerStateRunning {
+		return nil, errors.ErrWorkerNotStart
+	}
+	subscriptions := make([]*primitive.Subscription, len(request.Subscriptions))
+	for i, sub := range request.Subscriptions {
+		subscriptions[i] = convert.FromPbAddSubscription(sub)
+	}
+	started := s.worker.ResyncSubscriptions(ctx, subscriptions)
+	ids := make([]uint64, len(started))
+	for i, id := range started {
+		ids[i] = id.Uint64()
+	}
+	return &pbtrigger.ResyncSubscriptionsResponse{StartedSubscriptionIds: ids}, nil
+}
+
 func (s *server) Initialize(ctx context.Context) error {
 	err := s.worker.Init(ctx)
 	if err != nil {
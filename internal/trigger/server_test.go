@@ -21,6 +21,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	pbtrigger "github.com/linkall-labs/vanus/proto/pkg/trigger"
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -80,6 +81,15 @@ func TestServerApi(t *testing.T) {
 			_, err := s.ResumeSubscription(ctx, &pbtrigger.ResumeSubscriptionRequest{})
 			So(err, ShouldNotBeNil)
 		})
+		Convey("test resync subscriptions", func() {
+			id := vanus.NewTestID()
+			w.EXPECT().ResyncSubscriptions(gomock.Any(), gomock.Any()).Return([]vanus.ID{id})
+			resp, err := s.ResyncSubscriptions(ctx, &pbtrigger.ResyncSubscriptionsRequest{
+				Subscriptions: []*pbtrigger.AddSubscriptionRequest{{Id: id.Uint64()}},
+			})
+			So(err, ShouldBeNil)
+			So(resp.StartedSubscriptionIds, ShouldResemble, []uint64{id.Uint64()})
+		})
 	})
 }
 
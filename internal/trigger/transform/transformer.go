@@ -15,22 +15,31 @@
 package transform
 
 import (
+	stdctx "context"
 	"encoding/json"
 	"runtime"
 
 	ce "github.com/cloudevents/sdk-go/v2"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	"github.com/linkall-labs/vanus/internal/primitive/transform/context"
+	"github.com/linkall-labs/vanus/internal/primitive/transform/wasm"
 	"github.com/linkall-labs/vanus/internal/trigger/transform/define"
 	"github.com/linkall-labs/vanus/internal/trigger/transform/pipeline"
 	"github.com/linkall-labs/vanus/internal/trigger/transform/template"
 	"github.com/pkg/errors"
 )
 
+// ErrFiltered is returned by Execute if the event is filtered out by the wasm function.
+var ErrFiltered = errors.New("the event is filtered out by the wasm function")
+
 type Transformer struct {
 	define   *define.Define
 	pipeline *pipeline.Pipeline
 	template *template.Template
+	builtin  bool
+	wasm     *wasm.Function
+	// wasmErr is the error of compiling the wasm function, events fail with it.
+	wasmErr error
 }
 
 func NewTransformer(transformer *primitive.Transformer) *Transformer {
@@ -41,10 +50,14 @@ func NewTransformer(transformer *primitive.Transformer) *Transformer {
 		define:   define.NewDefine(),
 		pipeline: pipeline.NewPipeline(),
 		template: template.NewTemplate(),
+		builtin:  transformer.HasBuiltin(),
 	}
 	tf.define.Parse(transformer.Define)
 	tf.pipeline.Parse(transformer.Pipeline)
 	tf.template.Parse(transformer.Template)
+	if transformer.HasWasm() {
+		tf.wasm, tf.wasmErr = wasm.New(stdctx.Background(), transformer.Wasm)
+	}
 	return tf
 }
 
@@ -58,8 +71,36 @@ func (tf *Transformer) Execute(event *ce.Event) (err error) {
 		}
 	}()
 
+	if tf.builtin {
+		if err = tf.executeBuiltin(event); err != nil {
+			return err
+		}
+	}
+	if tf.wasmErr != nil {
+		return tf.wasmErr
+	}
+	if tf.wasm != nil {
+		keep, err := tf.wasm.Run(stdctx.Background(), event)
+		if err != nil {
+			return err
+		}
+		if !keep {
+			return ErrFiltered
+		}
+	}
+	return nil
+}
+
+// Close releases the wasm function, it waits for events being transformed.
+func (tf *Transformer) Close() {
+	if tf != nil && tf.wasm != nil {
+		_ = tf.wasm.Close(stdctx.Background())
+	}
+}
+
+func (tf *Transformer) executeBuiltin(event *ce.Event) error {
 	var data interface{}
-	err = json.Unmarshal(event.Data(), &data)
+	err := json.Unmarshal(event.Data(), &data)
 	if err != nil {
 		return err
 	}
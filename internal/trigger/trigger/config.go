@@ -18,6 +18,8 @@ import (
 	"time"
 
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
+	"github.com/linkall-labs/vanus/internal/trigger/reader"
 
 	"go.uber.org/ratelimit"
 )
@@ -29,21 +31,37 @@ const (
 	defaultGoroutineSize   = 10000
 	defaultMaxUACKNumber   = 10000
 	defaultBatchSize       = 32
+	// events are sent to the sink once more before they're written to the retry eventbus.
+	defaultMaxDeliveryAttempt = 2
+
+	// writes to system eventbuses stop retrying after 5 failures in a row, and retry again once a write succeeds.
+	writeBudgetTokens = 10
+	writeBudgetRatio  = 0.5
+	// deliveries stop retrying in place after 5 failures in a row, e.g. the sink is down, and retry again
+	// after 10 deliveries succeed. Failed events are written to the retry eventbus meanwhile.
+	deliveryBudgetTokens = 10
+	deliveryBudgetRatio  = 0.1
 )
 
 type Config struct {
 	BufferSize         int
 	MaxRetryAttempts   int32
 	DeliveryTimeout    time.Duration
+	DeliveryDeadline   time.Duration
 	RateLimit          uint32
+	BackfillRateLimit  uint32
 	Controllers        []string
 	DeadLetterEventbus string
 	MaxWriteAttempt    int
+	MaxDeliveryAttempt int
 	Ordered            bool
+	DataLossEvents     bool
+	StrictSequence     bool
 
 	GoroutineSize int
 	SendBatchSize int
 	PullBatchSize int
+	PullMaxBytes  int
 	MaxUACKNumber int
 }
 
@@ -54,6 +72,7 @@ func defaultConfig() Config {
 		DeliveryTimeout:    defaultDeliveryTimeout,
 		DeadLetterEventbus: primitive.DeadLetterEventbusName,
 		MaxWriteAttempt:    defaultMaxWriteAttempt,
+		MaxDeliveryAttempt: defaultMaxDeliveryAttempt,
 		GoroutineSize:      defaultGoroutineSize,
 		SendBatchSize:      defaultBatchSize,
 		MaxUACKNumber:      defaultMaxUACKNumber,
@@ -92,6 +111,13 @@ func WithDeliveryTimeout(timeout uint32) Option {
 	}
 }
 
+// WithDeliveryDeadline limits the time failed events are retried for in milliseconds, 0 is unlimited.
+func WithDeliveryDeadline(deadline uint32) Option {
+	return func(t *trigger) {
+		t.config.DeliveryDeadline = time.Duration(deadline) * time.Millisecond
+	}
+}
+
 func WithOrdered(ordered bool) Option {
 	return func(t *trigger) {
 		t.config.Ordered = ordered
@@ -101,14 +127,24 @@ func WithOrdered(ordered bool) Option {
 func WithRateLimit(rateLimit uint32) Option {
 	return func(t *trigger) {
 		t.config.RateLimit = rateLimit
-		if rateLimit == 0 {
-			t.rateLimiter = ratelimit.NewUnlimited()
-			return
-		}
-		t.rateLimiter = ratelimit.New(int(rateLimit))
+		t.rateLimiter = newRateLimiter(rateLimit)
+	}
+}
+
+// WithBackfillRateLimit limits the rate until the trigger catches up with the eventbus, 0 disables backfilling.
+func WithBackfillRateLimit(rateLimit uint32) Option {
+	return func(t *trigger) {
+		t.config.BackfillRateLimit = rateLimit
 	}
 }
 
+func newRateLimiter(rateLimit uint32) ratelimit.Limiter {
+	if rateLimit == 0 {
+		return ratelimit.NewUnlimited()
+	}
+	return ratelimit.New(int(rateLimit))
+}
+
 func WithControllers(controllers []string) Option {
 	return func(t *trigger) {
 		t.config.Controllers = controllers
@@ -151,6 +187,15 @@ func WithPullBatchSize(batchSize int) Option {
 	}
 }
 
+func WithPullMaxBytes(maxBytes int) Option {
+	return func(t *trigger) {
+		if maxBytes <= 0 {
+			return
+		}
+		t.config.PullMaxBytes = maxBytes
+	}
+}
+
 func WithMaxUACKNumber(maxUACKNumber int) Option {
 	return func(t *trigger) {
 		if maxUACKNumber <= 0 {
@@ -159,3 +204,31 @@ func WithMaxUACKNumber(maxUACKNumber int) Option {
 		t.config.MaxUACKNumber = maxUACKNumber
 	}
 }
+
+func WithMeter(meter metering.Meter) Option {
+	return func(t *trigger) {
+		t.meter = meter
+	}
+}
+
+// WithDataLossEvents writes an event to the system eventbus __data_loss_eb once events are removed by retention
+// before the trigger consumed them.
+func WithDataLossEvents(enable bool) Option {
+	return func(t *trigger) {
+		t.config.DataLossEvents = enable
+	}
+}
+
+// WithStrictSequence stops reading an eventlog once events of it are skipped not because of retention.
+func WithStrictSequence(strict bool) Option {
+	return func(t *trigger) {
+		t.config.StrictSequence = strict
+	}
+}
+
+// WithFetcher shares reads of eventlogs with other triggers on the worker.
+func WithFetcher(fetcher *reader.Fetcher) Option {
+	return func(t *trigger) {
+		t.fetcher = fetcher
+	}
+}
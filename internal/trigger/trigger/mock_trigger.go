@@ -9,8 +9,12 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	gap "github.com/linkall-labs/vanus/client/pkg/gap"
 	primitive "github.com/linkall-labs/vanus/internal/primitive"
 	info "github.com/linkall-labs/vanus/internal/primitive/info"
+	latency "github.com/linkall-labs/vanus/internal/primitive/latency"
+	vanus "github.com/linkall-labs/vanus/internal/primitive/vanus"
+	client "github.com/linkall-labs/vanus/internal/trigger/client"
 )
 
 // MockTrigger is a mock of Trigger interface.
@@ -50,6 +54,77 @@ func (mr *MockTriggerMockRecorder) Change(ctx, subscription interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Change", reflect.TypeOf((*MockTrigger)(nil).Change), ctx, subscription)
 }
 
+// GetCrashStatus mocks base method.
+func (m *MockTrigger) GetCrashStatus() *primitive.CrashStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCrashStatus")
+	ret0, _ := ret[0].(*primitive.CrashStatus)
+	return ret0
+}
+
+// GetCrashStatus indicates an expected call of GetCrashStatus.
+func (mr *MockTriggerMockRecorder) GetCrashStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCrashStatus", reflect.TypeOf((*MockTrigger)(nil).GetCrashStatus))
+}
+
+// GetDataLosses mocks base method.
+func (m *MockTrigger) GetDataLosses() []primitive.DataLoss {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDataLosses")
+	ret0, _ := ret[0].([]primitive.DataLoss)
+	return ret0
+}
+
+// GetDataLosses indicates an expected call of GetDataLosses.
+func (mr *MockTriggerMockRecorder) GetDataLosses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataLosses", reflect.TypeOf((*MockTrigger)(nil).GetDataLosses))
+}
+
+// GetDeliveryLatencies mocks base method.
+func (m *MockTrigger) GetDeliveryLatencies() latency.Histogram {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryLatencies")
+	ret0, _ := ret[0].(latency.Histogram)
+	return ret0
+}
+
+// GetDeliveryLatencies indicates an expected call of GetDeliveryLatencies.
+func (mr *MockTriggerMockRecorder) GetDeliveryLatencies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryLatencies", reflect.TypeOf((*MockTrigger)(nil).GetDeliveryLatencies))
+}
+
+// GetDeliveryPhase mocks base method.
+func (m *MockTrigger) GetDeliveryPhase() primitive.DeliveryPhase {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryPhase")
+	ret0, _ := ret[0].(primitive.DeliveryPhase)
+	return ret0
+}
+
+// GetDeliveryPhase indicates an expected call of GetDeliveryPhase.
+func (mr *MockTriggerMockRecorder) GetDeliveryPhase() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryPhase", reflect.TypeOf((*MockTrigger)(nil).GetDeliveryPhase))
+}
+
+// GetDeliveryStats mocks base method.
+func (m *MockTrigger) GetDeliveryStats() (uint64, uint64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveryStats")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(uint64)
+	return ret0, ret1
+}
+
+// GetDeliveryStats indicates an expected call of GetDeliveryStats.
+func (mr *MockTriggerMockRecorder) GetDeliveryStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveryStats", reflect.TypeOf((*MockTrigger)(nil).GetDeliveryStats))
+}
+
 // GetOffsets mocks base method.
 func (m *MockTrigger) GetOffsets(ctx context.Context) info.ListOffsetInfo {
 	m.ctrl.T.Helper()
@@ -64,6 +139,34 @@ func (mr *MockTriggerMockRecorder) GetOffsets(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffsets", reflect.TypeOf((*MockTrigger)(nil).GetOffsets), ctx)
 }
 
+// GetPausedGaps mocks base method.
+func (m *MockTrigger) GetPausedGaps() []gap.Gap {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPausedGaps")
+	ret0, _ := ret[0].([]gap.Gap)
+	return ret0
+}
+
+// GetPausedGaps indicates an expected call of GetPausedGaps.
+func (mr *MockTriggerMockRecorder) GetPausedGaps() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPausedGaps", reflect.TypeOf((*MockTrigger)(nil).GetPausedGaps))
+}
+
+// GetSinkResolution mocks base method.
+func (m *MockTrigger) GetSinkResolution() *client.Resolution {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSinkResolution")
+	ret0, _ := ret[0].(*client.Resolution)
+	return ret0
+}
+
+// GetSinkResolution indicates an expected call of GetSinkResolution.
+func (mr *MockTriggerMockRecorder) GetSinkResolution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSinkResolution", reflect.TypeOf((*MockTrigger)(nil).GetSinkResolution))
+}
+
 // Init mocks base method.
 func (m *MockTrigger) Init(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -78,6 +181,20 @@ func (mr *MockTriggerMockRecorder) Init(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockTrigger)(nil).Init), ctx)
 }
 
+// ResumeEventlogs mocks base method.
+func (m *MockTrigger) ResumeEventlogs() []vanus.ID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeEventlogs")
+	ret0, _ := ret[0].([]vanus.ID)
+	return ret0
+}
+
+// ResumeEventlogs indicates an expected call of ResumeEventlogs.
+func (mr *MockTriggerMockRecorder) ResumeEventlogs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeEventlogs", reflect.TypeOf((*MockTrigger)(nil).ResumeEventlogs))
+}
+
 // Start mocks base method.
 func (m *MockTrigger) Start(ctx context.Context) error {
 	m.ctrl.T.Helper()
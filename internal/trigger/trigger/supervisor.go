@@ -0,0 +1,179 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/retry"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/pkg/util"
+)
+
+const (
+	stageFilterTransform      = "filter_transform"
+	stageRetryFilterTransform = "retry_filter_transform"
+	stageBatch                = "batch"
+	stageSend                 = "send"
+
+	// a stage which panics again within stableRunTime is restarted with a longer backoff.
+	stableRunTime       = time.Minute
+	restartInitialDelay = time.Second
+	restartMaxDelay     = time.Minute
+	// the pipeline is crash looping if it panics crashLoopPanics times within crashLoopWindow.
+	crashLoopPanics = 5
+	crashLoopWindow = 5 * time.Minute
+	maxStackSize    = 4096
+)
+
+// supervisor isolates panics in the delivery pipeline of a subscription, so that a bad filter, transformer
+// or sink takes down neither other subscriptions nor the trigger worker. A stage which panics is restarted
+// with backoff, and an event which makes a pool task panic fails alone.
+type supervisor struct {
+	subscriptionID vanus.ID
+	restart        retry.Policy
+
+	mutex       sync.Mutex
+	panics      uint64
+	recent      []time.Time
+	lastStage   string
+	lastPanic   string
+	lastPanicAt time.Time
+}
+
+func newSupervisor(id vanus.ID) *supervisor {
+	return &supervisor{
+		subscriptionID: id,
+		restart: retry.Policy{
+			InitialBackoff: restartInitialDelay,
+			MaxBackoff:     restartMaxDelay,
+			Multiplier:     2, //nolint:gomnd
+			Jitter:         0.2,
+		},
+	}
+}
+
+// supervise returns a function which runs stage until ctx is done, the stage is restarted if it panics.
+// Stages holding received events must defer failHeld, otherwise offsets of the events held when the stage
+// panics are never committed.
+func (s *supervisor) supervise(stage string, fn func(ctx context.Context)) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		attempt := 0
+		for {
+			start := time.Now()
+			if !s.run(ctx, stage, fn) || ctx.Err() != nil {
+				return
+			}
+			if time.Since(start) >= stableRunTime {
+				attempt = 0
+			}
+			attempt++
+			if !util.SleepWithContext(ctx, s.restart.Backoff(attempt)) {
+				return
+			}
+			log.Info(ctx, "restart the stage of trigger after panic", map[string]interface{}{
+				log.KeySubscriptionID: s.subscriptionID,
+				"stage":               stage,
+				"attempt":             attempt,
+			})
+		}
+	}
+}
+
+// run reports whether fn panicked.
+func (s *supervisor) run(ctx context.Context, stage string, fn func(ctx context.Context)) (panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			s.recovered(ctx, stage, r)
+		}
+	}()
+	fn(ctx)
+	return false
+}
+
+// recoverEvent must be deferred by pool tasks, failed is called with the panic of the task. Panics after
+// the trigger stopped, e.g. sending to a closed channel, are expected and ignored.
+func (s *supervisor) recoverEvent(ctx context.Context, stage string, failed func(err error)) {
+	r := recover()
+	if r == nil || ctx.Err() != nil {
+		return
+	}
+	err := s.recovered(ctx, stage, r)
+	failed(err)
+}
+
+// failHeld must be deferred by stages which hold events, failed is called with the panic of the stage so
+// that held events are failed and their offsets keep moving. The panic is passed on, so the stage is still
+// restarted.
+func (s *supervisor) failHeld(ctx context.Context, stage string, failed func(err error)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if ctx.Err() == nil {
+		failed(fmt.Errorf("panic in %s: %v", stage, r))
+	}
+	panic(r)
+}
+
+func (s *supervisor) recovered(ctx context.Context, stage string, r interface{}) error {
+	stack := make([]byte, maxStackSize)
+	stack = stack[:runtime.Stack(stack, false)]
+	err := fmt.Errorf("panic in %s: %v", stage, r)
+
+	now := time.Now()
+	s.mutex.Lock()
+	s.panics++
+	s.lastStage = stage
+	s.lastPanic = fmt.Sprint(r)
+	s.lastPanicAt = now
+	s.recent = append(s.recent, now)
+	if len(s.recent) > crashLoopPanics {
+		s.recent = s.recent[len(s.recent)-crashLoopPanics:]
+	}
+	s.mutex.Unlock()
+
+	log.Error(ctx, "recovered from panic in the delivery pipeline", map[string]interface{}{
+		log.KeyError:          err,
+		log.KeySubscriptionID: s.subscriptionID,
+		"stage":               stage,
+		"stack":               string(stack),
+	})
+	return err
+}
+
+// status returns nil if the pipeline never panicked.
+func (s *supervisor) status() *primitive.CrashStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.panics == 0 {
+		return nil
+	}
+	return &primitive.CrashStatus{
+		Panics:      s.panics,
+		LastStage:   s.lastStage,
+		LastPanic:   s.lastPanic,
+		LastPanicAt: s.lastPanicAt,
+		CrashLooping: len(s.recent) == crashLoopPanics &&
+			time.Since(s.recent[0]) < crashLoopWindow,
+	}
+}
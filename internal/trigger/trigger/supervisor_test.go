@@ -0,0 +1,115 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trigger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSupervisor(t *testing.T) {
+	Convey("test supervisor", t, func() {
+		s := newSupervisor(vanus.NewTestID())
+		s.restart.InitialBackoff = time.Millisecond
+		s.restart.MaxBackoff = time.Millisecond
+		So(s.status(), ShouldBeNil)
+
+		Convey("restart the stage after panic", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			runs := 0
+			s.supervise(stageBatch, func(ctx context.Context) {
+				runs++
+				if runs < 3 {
+					panic("bad template")
+				}
+			})(ctx)
+			So(runs, ShouldEqual, 3)
+
+			status := s.status()
+			So(status, ShouldNotBeNil)
+			So(status.Panics, ShouldEqual, 2)
+			So(status.LastStage, ShouldEqual, stageBatch)
+			So(status.LastPanic, ShouldEqual, "bad template")
+			So(status.CrashLooping, ShouldBeFalse)
+		})
+
+		Convey("crash looping", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			runs := 0
+			s.supervise(stageSend, func(ctx context.Context) {
+				runs++
+				if runs == crashLoopPanics {
+					cancel()
+				}
+				panic(errors.New("nil sink"))
+			})(ctx)
+			So(runs, ShouldEqual, crashLoopPanics)
+			So(s.status().CrashLooping, ShouldBeTrue)
+
+			s.recent[0] = time.Now().Add(-crashLoopWindow)
+			So(s.status().CrashLooping, ShouldBeFalse)
+		})
+
+		Convey("recover event", func() {
+			var failed error
+			func() {
+				defer s.recoverEvent(context.Background(), stageFilterTransform, func(err error) {
+					failed = err
+				})
+				panic("bad event")
+			}()
+			So(failed, ShouldNotBeNil)
+			So(s.status().Panics, ShouldEqual, 1)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			failed = nil
+			func() {
+				defer s.recoverEvent(ctx, stageFilterTransform, func(err error) {
+					failed = err
+				})
+				panic("send on closed channel")
+			}()
+			So(failed, ShouldBeNil)
+			So(s.status().Panics, ShouldEqual, 1)
+		})
+
+		Convey("fail held events", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			runs, failures := 0, 0
+			s.supervise(stageBatch, func(ctx context.Context) {
+				defer s.failHeld(ctx, stageBatch, func(err error) {
+					So(err.Error(), ShouldEqual, "panic in batch: bad batch")
+					failures++
+				})
+				runs++
+				if runs < 3 {
+					panic("bad batch")
+				}
+			})(ctx)
+			So(runs, ShouldEqual, 3)
+			So(failures, ShouldEqual, 2)
+			So(s.status().Panics, ShouldEqual, 2)
+			So(s.status().LastPanic, ShouldEqual, "bad batch")
+		})
+	})
+}
@@ -17,15 +17,25 @@ package trigger
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
+	"github.com/linkall-labs/vanus/client/pkg/gap"
 	"github.com/linkall-labs/vanus/internal/primitive"
 	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/latency"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
+	"github.com/linkall-labs/vanus/internal/primitive/protodecode"
+	"github.com/linkall-labs/vanus/internal/primitive/retry"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
 	"github.com/linkall-labs/vanus/internal/trigger/filter"
@@ -35,13 +45,23 @@ import (
 	"github.com/linkall-labs/vanus/internal/trigger/transform"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/linkall-labs/vanus/pkg/util"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
 	"github.com/panjf2000/ants/v2"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/ratelimit"
 )
 
 type State string
 
+const (
+	// maxDataLosses is the number of data losses kept by a trigger.
+	maxDataLosses        = 10
+	dataLossEventTimeout = 5 * time.Second
+)
+
 const (
 	TriggerCreated   = "created"
 	TriggerPending   = "pending"
@@ -58,6 +78,23 @@ type Trigger interface {
 	Stop(ctx context.Context) error
 	Change(ctx context.Context, subscription *primitive.Subscription) error
 	GetOffsets(ctx context.Context) pInfo.ListOffsetInfo
+	// GetSinkResolution returns nil if the hostname of sink isn't resolved by the trigger.
+	GetSinkResolution() *client.Resolution
+	// GetDeliveryStats returns the number of events delivered and failed to deliver since created.
+	GetDeliveryStats() (delivered, failed uint64)
+	// GetDeliveryPhase returns empty if backfilling is disabled.
+	GetDeliveryPhase() primitive.DeliveryPhase
+	// GetDataLosses returns the most recent events removed by retention before the trigger consumed them.
+	GetDataLosses() []primitive.DataLoss
+	// GetCrashStatus returns nil if the delivery pipeline never panicked.
+	GetCrashStatus() *primitive.CrashStatus
+	// GetDeliveryLatencies returns the number of events in each latency bucket since created, the latency is
+	// from appending an event to delivering it.
+	GetDeliveryLatencies() latency.Histogram
+	// GetPausedGaps returns gaps which eventlogs are paused at in strict sequence mode.
+	GetPausedGaps() []gap.Gap
+	// ResumeEventlogs resumes eventlogs paused by sequence gaps, it returns IDs of the eventlogs resumed.
+	ResumeEventlogs() []vanus.ID
 }
 
 type trigger struct {
@@ -66,6 +103,7 @@ type trigger struct {
 	subscription  *primitive.Subscription
 	offsetManager *offset.SubscriptionOffset
 	reader        reader.Reader
+	gapDetector   *gap.Detector
 	eventCh       chan info.EventRecord
 	sendCh        chan *toSendEvent
 	batchSendCh   chan []*toSendEvent
@@ -73,21 +111,39 @@ type trigger struct {
 	client        eb.Client
 	filter        filter.Filter
 	transformer   *transform.Transformer
+	decoder       *protodecode.Decoder
 	rateLimiter   ratelimit.Limiter
+	meter         metering.Meter
+	fetcher       *reader.Fetcher
+	tracer        *tracing.Tracer
 	config        Config
 	batch         bool
+	// deliveryPhase is backfilling until the reader catches up with the eventbus if backfilling is enabled.
+	deliveryPhase primitive.DeliveryPhase
+	dataLosses    []primitive.DataLoss
 
 	retryEventCh     chan info.EventRecord
 	retryEventReader reader.Reader
 	timerEventWriter api.BusWriter
 	dlEventWriter    api.BusWriter
+	dataLossWriter   api.BusWriter
+	// writeBudget is shared by writes to the retry eventbus, writes to dead letter eventbuses are exempt
+	// because events are dropped once they fail.
+	writeBudget *retry.Budget
+	// deliveryBudget is shared by deliveries to the sink.
+	deliveryBudget *retry.Budget
 
-	state State
-	stop  context.CancelFunc
-	lock  sync.RWMutex
-	wg    util.Group
+	state      State
+	stop       context.CancelFunc
+	lock       sync.RWMutex
+	wg         util.Group
+	supervisor *supervisor
 
 	pool *ants.Pool
+
+	deliveredEvents uint64
+	failedEvents    uint64
+	latencies       latency.Counter
 }
 
 type toSendEvent struct {
@@ -104,6 +160,11 @@ func NewTrigger(subscription *primitive.Subscription, opts ...Option) Trigger {
 		subscription:      subscription,
 		subscriptionIDStr: subscription.ID.String(),
 		transformer:       transform.NewTransformer(subscription.Transformer),
+		decoder:           newDecoder(subscription.ID, subscription.Config.ProtobufDecoding),
+		tracer:            tracing.NewTracer("trigger", oteltrace.SpanKindClient),
+		writeBudget:       retry.NewBudget(writeBudgetTokens, writeBudgetRatio),
+		deliveryBudget:    retry.NewBudget(deliveryBudgetTokens, deliveryBudgetRatio),
+		supervisor:        newSupervisor(subscription.ID),
 	}
 	if subscription.Protocol == primitive.GRPC {
 		t.batch = true
@@ -112,6 +173,13 @@ func NewTrigger(subscription *primitive.Subscription, opts ...Option) Trigger {
 	if t.rateLimiter == nil {
 		t.rateLimiter = ratelimit.NewUnlimited()
 	}
+	if t.config.BackfillRateLimit > 0 {
+		t.deliveryPhase = primitive.DeliveryPhaseBackfilling
+		t.rateLimiter = newRateLimiter(t.config.BackfillRateLimit)
+	}
+	if t.meter == nil {
+		t.meter = metering.NewMeter(metering.Config{}, "", nil)
+	}
 	t.offsetManager = offset.NewSubscriptionOffset(subscription.ID, t.config.MaxUACKNumber, subscription.Offsets)
 	t.pool, _ = ants.NewPool(t.config.GoroutineSize)
 	return t
@@ -137,14 +205,21 @@ func (t *trigger) getClient() client.EventClient {
 
 func (t *trigger) changeTarget(sink primitive.URI,
 	protocol primitive.Protocol,
-	credential primitive.SinkCredential) error {
-	eventCli := newEventClient(sink, protocol, credential)
+	credential primitive.SinkCredential,
+	signing *primitive.SigningConfig,
+	allowedHosts []string) error {
+	eventCli, err := newEventClient(sink, protocol, credential, signing, allowedHosts)
+	if err != nil {
+		return err
+	}
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	t.eventCli = eventCli
 	t.subscription.Sink = sink
 	t.subscription.Protocol = protocol
 	t.subscription.SinkCredential = credential
+	t.subscription.Signing = signing
+	t.subscription.Config.SinkAllowedHosts = allowedHosts
 	return nil
 }
 
@@ -172,25 +247,170 @@ func (t *trigger) changeTransformer(transformer *primitive.Transformer) {
 	trans := transform.NewTransformer(transformer)
 	t.lock.Lock()
 	defer t.lock.Unlock()
+	// The old transformer may be transforming events, it's closed once they're done.
+	go t.transformer.Close()
 	t.transformer = trans
 	t.subscription.Transformer = transformer
 }
 
+func (t *trigger) getDecoder() *protodecode.Decoder {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.decoder
+}
+
+func (t *trigger) getDeliveryContentType() string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.subscription.Config.DeliveryContentType
+}
+
+// convertEvent converts the data of the event to the datacontenttype with the protobuf decoder, JSON and
+// protobuf payloads can be converted to each other.
+func (t *trigger) convertEvent(event *ce.Event, contentType string) error {
+	from := event.DataMediaType()
+	if from == "" {
+		from = ce.ApplicationJSON
+	}
+	if strings.EqualFold(from, contentType) {
+		return nil
+	}
+	decoder := t.getDecoder()
+	if decoder == nil {
+		return fmt.Errorf("convert %s to %s failed: protobuf decoding isn't configured", from, contentType)
+	}
+	var (
+		data []byte
+		err  error
+	)
+	switch {
+	case protodecode.IsProtobuf(from) && contentType == ce.ApplicationJSON:
+		data, err = decoder.Decode(event.Data())
+	case from == ce.ApplicationJSON && protodecode.IsProtobuf(contentType):
+		data, err = decoder.Encode(event.Data())
+	default:
+		return fmt.Errorf("convert %s to %s isn't supported", from, contentType)
+	}
+	if err != nil {
+		return err
+	}
+	event.DataBase64 = false
+	if err = event.SetData(contentType, data); err != nil {
+		return err
+	}
+	keepDataEncoded(event)
+	return nil
+}
+
+// decodeEvent decodes protobuf payloads to JSON in place before filtering, so retried and dead letter events
+// carry the JSON too.
+func (t *trigger) decodeEvent(event *ce.Event) error {
+	decoder := t.getDecoder()
+	if decoder == nil || !protodecode.IsProtobuf(event.DataMediaType()) {
+		return nil
+	}
+	data, err := decoder.Decode(event.Data())
+	if err != nil {
+		return err
+	}
+	event.DataBase64 = false
+	return event.SetData(ce.ApplicationJSON, data)
+}
+
+func (t *trigger) isPassThrough() bool {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.subscription.Config.PassThrough
+}
+
 func (t *trigger) changeConfig(config primitive.SubscriptionConfig) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
-	if config.RateLimit != t.subscription.Config.RateLimit {
-		t.applyOptions(WithRateLimit(config.RateLimit))
+	if config.RateLimit != t.subscription.Config.RateLimit ||
+		config.BackfillRateLimit != t.subscription.Config.BackfillRateLimit {
+		t.applyOptions(WithRateLimit(config.RateLimit), WithBackfillRateLimit(config.BackfillRateLimit))
+		switch {
+		case config.BackfillRateLimit == 0:
+			t.deliveryPhase = ""
+		case t.deliveryPhase == primitive.DeliveryPhaseBackfilling:
+			t.rateLimiter = newRateLimiter(config.BackfillRateLimit)
+		case t.deliveryPhase == "":
+			// backfilling is enabled for a running trigger, which is already following the eventbus.
+			t.deliveryPhase = primitive.DeliveryPhaseFollowing
+		}
 	}
 	if config.DeliveryTimeout != t.subscription.Config.DeliveryTimeout {
 		t.applyOptions(WithDeliveryTimeout(config.DeliveryTimeout))
 	}
+	if config.DeliveryDeadline != t.subscription.Config.DeliveryDeadline {
+		t.applyOptions(WithDeliveryDeadline(config.DeliveryDeadline))
+	}
 	if config.GetMaxRetryAttempts() != t.subscription.Config.GetMaxRetryAttempts() {
 		t.applyOptions(WithMaxRetryAttempts(config.GetMaxRetryAttempts()))
 	}
+	if !reflect.DeepEqual(config.ProtobufDecoding, t.subscription.Config.ProtobufDecoding) {
+		t.decoder = newDecoder(t.subscription.ID, config.ProtobufDecoding)
+	}
 	t.subscription.Config = config
 }
 
+// caughtUp switches the backfilling trigger to RateLimit, events read before are still in buffers and are
+// delivered at RateLimit too.
+func (t *trigger) caughtUp() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.deliveryPhase != primitive.DeliveryPhaseBackfilling {
+		return
+	}
+	t.deliveryPhase = primitive.DeliveryPhaseFollowing
+	t.rateLimiter = newRateLimiter(t.config.RateLimit)
+	log.Info(context.TODO(), "subscription caught up with the eventbus, backfilling is finished", map[string]interface{}{
+		log.KeySubscriptionID: t.subscription.ID,
+	})
+}
+
+// dataLost keeps the most recent data losses for the controller, and writes an event for each of them if
+// data loss events are enabled.
+func (t *trigger) dataLost(loss primitive.DataLoss) {
+	t.lock.Lock()
+	t.dataLosses = append(t.dataLosses, loss)
+	if len(t.dataLosses) > maxDataLosses {
+		t.dataLosses = append([]primitive.DataLoss(nil), t.dataLosses[len(t.dataLosses)-maxDataLosses:]...)
+	}
+	eventbus := t.subscription.EventBus
+	writer := t.dataLossWriter
+	t.lock.Unlock()
+
+	metrics.TriggerLostEventCounter.WithLabelValues(t.subscriptionIDStr, eventbus).
+		Add(float64(loss.ToOffset - loss.FromOffset))
+	if writer == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), dataLossEventTimeout)
+	defer cancel()
+	if _, err := writer.AppendOne(ctx, newDataLossEvent(t.subscription.ID, eventbus, loss)); err != nil {
+		log.Warning(ctx, "write data loss event failed", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: t.subscription.ID,
+			log.KeyEventlogID:     loss.EventlogID,
+		})
+	}
+}
+
+func (t *trigger) sequenceGap(g gap.Gap) {
+	t.lock.RLock()
+	eventbus := t.subscription.EventBus
+	t.lock.RUnlock()
+	metrics.TriggerSequenceGapEventCounter.WithLabelValues(t.subscriptionIDStr, eventbus,
+		vanus.NewIDFromUint64(g.EventlogID).String(), string(g.Cause)).Add(float64(g.Size()))
+}
+
+func (t *trigger) getRateLimiter() ratelimit.Limiter {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.rateLimiter
+}
+
 // eventArrived for test.
 func (t *trigger) eventArrived(ctx context.Context, event info.EventRecord) error {
 	select {
@@ -202,8 +422,12 @@ func (t *trigger) eventArrived(ctx context.Context, event info.EventRecord) erro
 }
 
 func (t *trigger) transformEvent(record info.EventRecord) (*toSendEvent, error) {
-	transformer := t.getTransformer()
 	event := record.Event
+	if t.isPassThrough() {
+		keepDataEncoded(event)
+		return &toSendEvent{record: record, transform: event}, nil
+	}
+	transformer := t.getTransformer()
 	if transformer != nil {
 		// transform will chang event which lost origin event
 		clone := record.Event.Clone()
@@ -215,19 +439,63 @@ func (t *trigger) transformEvent(record info.EventRecord) (*toSendEvent, error)
 			return nil, err
 		}
 	}
+	if contentType := t.getDeliveryContentType(); contentType != "" {
+		// the converted data is only delivered, retried events are filtered and converted again.
+		if event == record.Event {
+			clone := record.Event.Clone()
+			event = &clone
+		}
+		if err := t.convertEvent(event, contentType); err != nil {
+			return nil, err
+		}
+	}
 	return &toSendEvent{record: record, transform: event}, nil
 }
 
 func (t *trigger) sendEvent(ctx context.Context, events ...*ce.Event) (int, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, t.getConfig().DeliveryTimeout)
-	defer cancel()
-	t.rateLimiter.Take()
-	startTime := time.Now()
-	r := t.getClient().Send(timeoutCtx, events...)
-	if r == client.Success {
-		metrics.TriggerPushEventTime.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+	var code int
+	err := t.deliverOperation(&code).Do(ctx, func(ctx context.Context) error {
+		timeoutCtx, cancel := context.WithTimeout(ctx, t.getConfig().DeliveryTimeout)
+		defer cancel()
+		t.getRateLimiter().Take()
+		startTime := time.Now()
+		r := t.getClient().Send(timeoutCtx, events...)
+		if r == client.Success {
+			metrics.TriggerPushEventTime.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
+		}
+		code = r.StatusCode
+		return r.Err
+	})
+	return code, err
+}
+
+// deliverOperation retries sending events to the sink in place, before they're written to the retry
+// eventbus which delays them by seconds at least. Whether a failure is retryable is decided by the status
+// code of the last attempt.
+func (t *trigger) deliverOperation(code *int) *retry.Operation {
+	return &retry.Operation{
+		Name: "deliver",
+		Policy: retry.Policy{
+			MaxAttempts:    t.getConfig().MaxDeliveryAttempt,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     time.Second,
+			Multiplier:     2,
+			Jitter:         0.2,
+		},
+		Retryable: func(error) bool {
+			retryable, _ := isShouldRetry(*code)
+			return retryable
+		},
+		Budget: t.deliveryBudget,
+		OnRetry: func(ctx context.Context, attempt int, err error) {
+			log.Debug(ctx, "send event failed, retry it", map[string]interface{}{
+				log.KeyError:          err,
+				log.KeySubscriptionID: t.subscription.ID,
+				"code":                *code,
+				"attempt":             attempt,
+			})
+		},
 	}
-	return r.StatusCode, r.Err
 }
 
 func (t *trigger) runRetryEventFilterTransform(ctx context.Context) {
@@ -241,6 +509,10 @@ func (t *trigger) runRetryEventFilterTransform(ctx context.Context) {
 			}
 			t.offsetManager.EventReceive(record.OffsetInfo)
 			_ = t.pool.Submit(func() {
+				defer t.supervisor.recoverEvent(ctx, stageRetryFilterTransform, func(err error) {
+					t.writeFailEvent(ctx, record.Event, ErrPanicCode, err)
+					t.offsetManager.EventCommit(record.OffsetInfo)
+				})
 				ec, _ := record.Event.Context.(*ce.EventContextV1)
 				if len(ec.Extensions) == 0 {
 					t.offsetManager.EventCommit(record.OffsetInfo)
@@ -251,6 +523,11 @@ func (t *trigger) runRetryEventFilterTransform(ctx context.Context) {
 					t.offsetManager.EventCommit(record.OffsetInfo)
 					return
 				}
+				if err := t.decodeEvent(record.Event); err != nil {
+					t.writeFailEvent(ctx, record.Event, ErrTransformCode, err)
+					t.offsetManager.EventCommit(record.OffsetInfo)
+					return
+				}
 				startTime := time.Now()
 				res := filter.Run(t.getFilter(), *record.Event)
 				metrics.TriggerFilterCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
@@ -260,6 +537,10 @@ func (t *trigger) runRetryEventFilterTransform(ctx context.Context) {
 				}
 				metrics.TriggerFilterMatchRetryEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
 				event, err := t.transformEvent(record)
+				if errors.Is(err, transform.ErrFiltered) {
+					t.offsetManager.EventCommit(record.OffsetInfo)
+					return
+				}
 				if err != nil {
 					t.writeFailEvent(ctx, record.Event, ErrTransformCode, err)
 					t.offsetManager.EventCommit(record.OffsetInfo)
@@ -282,6 +563,15 @@ func (t *trigger) runEventFilterTransform(ctx context.Context) {
 			}
 			t.offsetManager.EventReceive(record.OffsetInfo)
 			_ = t.pool.Submit(func() {
+				defer t.supervisor.recoverEvent(ctx, stageFilterTransform, func(err error) {
+					t.writeFailEvent(ctx, record.Event, ErrPanicCode, err)
+					t.offsetManager.EventCommit(record.OffsetInfo)
+				})
+				if err := t.decodeEvent(record.Event); err != nil {
+					t.writeFailEvent(ctx, record.Event, ErrTransformCode, err)
+					t.offsetManager.EventCommit(record.OffsetInfo)
+					return
+				}
 				startTime := time.Now()
 				res := filter.Run(t.getFilter(), *record.Event)
 				metrics.TriggerFilterCostSecond.WithLabelValues(t.subscriptionIDStr).Observe(time.Since(startTime).Seconds())
@@ -291,6 +581,10 @@ func (t *trigger) runEventFilterTransform(ctx context.Context) {
 				}
 				metrics.TriggerFilterMatchEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
 				event, err := t.transformEvent(record)
+				if errors.Is(err, transform.ErrFiltered) {
+					t.offsetManager.EventCommit(record.OffsetInfo)
+					return
+				}
 				if err != nil {
 					t.writeFailEvent(ctx, record.Event, ErrTransformCode, err)
 					t.offsetManager.EventCommit(record.OffsetInfo)
@@ -304,6 +598,14 @@ func (t *trigger) runEventFilterTransform(ctx context.Context) {
 
 func (t *trigger) runEventToBatch(ctx context.Context) {
 	var events []*toSendEvent
+	defer t.supervisor.failHeld(ctx, stageBatch, func(err error) {
+		defer func() {
+			for _, event := range events {
+				t.offsetManager.EventCommit(event.record.OffsetInfo)
+			}
+		}()
+		t.failPanicked(ctx, events, err)
+	})
 	ticker := time.NewTicker(500 * time.Millisecond) ////nolint:gomnd
 	defer ticker.Stop()
 	var lock sync.Mutex
@@ -364,14 +666,28 @@ func (t *trigger) processEvent(ctx context.Context, events ...*toSendEvent) {
 			t.offsetManager.EventCommit(event.record.OffsetInfo)
 		}
 	}()
+	// Events are failed before their offsets are committed.
+	defer t.supervisor.recoverEvent(ctx, stageSend, func(err error) {
+		t.failPanicked(ctx, events, err)
+	})
 	es := make([]*ce.Event, len(events))
 	for i := range events {
 		es[i] = events[i].transform
 	}
-	code, err := t.sendEvent(ctx, es...)
+	spans := t.startDeliverSpans(ctx, events)
+	code, err := t.sendEvent(client.WithParentSpans(ctx, spans), es...)
+	for _, span := range spans {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}
 	if err != nil {
 		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventFail).
 			Add(float64(len(es)))
+		atomic.AddUint64(&t.failedEvents, uint64(len(es)))
+		t.latencies.Fail(len(es))
 		log.Info(ctx, "send event fail", map[string]interface{}{
 			log.KeyError: err,
 			"count":      len(es),
@@ -386,12 +702,65 @@ func (t *trigger) processEvent(ctx context.Context, events ...*toSendEvent) {
 	} else {
 		metrics.TriggerPushEventCounter.WithLabelValues(t.subscriptionIDStr, metrics.LabelValuePushEventSuccess).
 			Add(float64(len(es)))
+		atomic.AddUint64(&t.deliveredEvents, uint64(len(es)))
+		now := time.Now()
+		for i := range events {
+			origin := events[i].record.Event
+			t.observeLatency(origin, now)
+			t.meter.Record(metering.TenantOf(origin), metering.EventbusOf(origin), metering.KindDelivered,
+				1, len(es[i].Data()))
+		}
 		log.Debug(ctx, "send event success", map[string]interface{}{
 			"count": len(es),
 		})
 	}
 }
 
+// failPanicked fails events which a panic interrupted, callers commit their offsets.
+func (t *trigger) failPanicked(ctx context.Context, events []*toSendEvent, err error) {
+	atomic.AddUint64(&t.failedEvents, uint64(len(events)))
+	t.latencies.Fail(len(events))
+	for _, event := range events {
+		t.writeFailEvent(ctx, event.record.Event, ErrPanicCode, err)
+	}
+}
+
+// observeLatency counts the latency from appending the event to delivering it, events appended by store
+// servers not recording the write time are skipped.
+func (t *trigger) observeLatency(event *ce.Event, now time.Time) {
+	v, ok := event.Extensions()[segpb.XVanusStime]
+	if !ok {
+		return
+	}
+	stime, err := types.ToTime(v)
+	if err != nil {
+		return
+	}
+	t.latencies.Observe(now.Sub(stime), 1)
+}
+
+// startDeliverSpans starts spans of events whose traces were sampled when they were produced, so that the
+// trace covers the delivery. The caller must end them.
+func (t *trigger) startDeliverSpans(ctx context.Context, events []*toSendEvent) []oteltrace.Span {
+	var spans []oteltrace.Span
+	for _, event := range events {
+		origin := event.record.Event
+		traceParent, _ := origin.Extensions()[primitive.XVanusTraceParent].(string)
+		if traceParent == "" {
+			continue
+		}
+		_ctx := tracing.WithRequestMetadata(tracing.ExtractTraceParent(ctx, traceParent), tracing.RequestMetadata{
+			Operation: tracing.OperationDeliver,
+			Eventbus:  metering.EventbusOf(origin),
+			Events:    1,
+			Bytes:     len(event.transform.Data()),
+		})
+		_, span := t.tracer.Start(_ctx, "deliver")
+		spans = append(spans, span)
+	}
+	return spans
+}
+
 func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, err error) {
 	needRetry, reason := isShouldRetry(code)
 	ec, _ := e.Context.(*ce.EventContextV1)
@@ -414,6 +783,10 @@ func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, err
 				reason = "MaxDeliveryAttemptExceeded"
 			}
 		}
+		if needRetry && t.deadlineExceeded(ec, attempts) {
+			needRetry = false
+			reason = "DeliveryDeadlineExceeded"
+		}
 	}
 	if !needRetry {
 		// dead letter
@@ -426,35 +799,43 @@ func (t *trigger) writeFailEvent(ctx context.Context, e *ce.Event, code int, err
 	metrics.TriggerRetryEventCounter.WithLabelValues(t.subscriptionIDStr).Inc()
 }
 
+// deadlineExceeded reports whether the next attempt of the event is after the delivery deadline, which is
+// counted from the time its first attempt failed.
+func (t *trigger) deadlineExceeded(ec *ce.EventContextV1, attempts int32) bool {
+	deadline := t.getConfig().DeliveryDeadline
+	if deadline <= 0 {
+		return false
+	}
+	now := time.Now()
+	first := firstAttemptTime(ec, now)
+	return now.Add(calDeliveryTime(attempts + 1)).After(first.Add(deadline))
+}
+
 func (t *trigger) writeEventToRetry(ctx context.Context, e *ce.Event, attempts int32) {
 	ec, _ := e.Context.(*ce.EventContextV1)
+	if _, ok := ec.Extensions[primitive.XVanusFirstAttemptTime]; !ok {
+		ec.Extensions[primitive.XVanusFirstAttemptTime] = ce.Timestamp{Time: time.Now().UTC()}.Format(time.RFC3339)
+	}
 	attempts++
 	ec.Extensions[primitive.XVanusRetryAttempts] = attempts
 	delayTime := calDeliveryTime(attempts)
 	ec.Extensions[primitive.XVanusDeliveryTime] = ce.Timestamp{Time: time.Now().Add(delayTime).UTC()}.Format(time.RFC3339)
 	ec.Extensions[primitive.XVanusSubscriptionID] = t.subscriptionIDStr
 	ec.Extensions[primitive.XVanusEventbus] = primitive.RetryEventbusName
-	var writeAttempt int
-	for {
-		writeAttempt++
+	err := t.writeOperation("write_retry_event", t.writeBudget).Do(ctx, func(ctx context.Context) error {
 		startTime := time.Now()
 		_, err := t.timerEventWriter.AppendOne(ctx, e)
 		metrics.TriggerRetryEventAppendSecond.WithLabelValues(t.subscriptionIDStr).
 			Observe(time.Since(startTime).Seconds())
-		if err != nil {
-			log.Info(ctx, "write retry event error", map[string]interface{}{
-				log.KeyError:          err,
-				log.KeySubscriptionID: t.subscription.ID,
-				"attempt":             writeAttempt,
-				"event":               e,
-			})
-			if writeAttempt >= t.config.MaxWriteAttempt {
-				return
-			}
-			time.Sleep(time.Second)
-		} else {
-			break
-		}
+		return err
+	})
+	if err != nil {
+		log.Info(ctx, "write retry event error", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: t.subscription.ID,
+			"event":               e,
+		})
+		return
 	}
 	log.Debug(ctx, "write retry event success", map[string]interface{}{
 		log.KeyEventlogID: t.subscription.ID,
@@ -469,27 +850,20 @@ func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, reaso
 	ec.Extensions[primitive.LastDeliveryTime] = ce.Timestamp{Time: time.Now().UTC()}.Format(time.RFC3339)
 	ec.Extensions[primitive.LastDeliveryError] = errorMsg
 	ec.Extensions[primitive.DeadLetterReason] = reason
-	var writeAttempt int
-	for {
-		writeAttempt++
+	err := t.writeOperation("write_dead_letter_event", nil).Do(ctx, func(ctx context.Context) error {
 		startTime := time.Now()
 		_, err := t.dlEventWriter.AppendOne(ctx, e)
 		metrics.TriggerDeadLetterEventAppendSecond.WithLabelValues(t.subscriptionIDStr).
 			Observe(time.Since(startTime).Seconds())
-		if err != nil {
-			log.Info(ctx, "write dl event error", map[string]interface{}{
-				log.KeyError:          err,
-				log.KeySubscriptionID: t.subscription.ID,
-				"attempt":             writeAttempt,
-				"event":               e,
-			})
-			if writeAttempt >= t.config.MaxWriteAttempt {
-				return
-			}
-			time.Sleep(time.Second)
-		} else {
-			break
-		}
+		return err
+	})
+	if err != nil {
+		log.Info(ctx, "write dl event error", map[string]interface{}{
+			log.KeyError:          err,
+			log.KeySubscriptionID: t.subscription.ID,
+			"event":               e,
+		})
+		return
 	}
 	log.Debug(ctx, "write dl event success", map[string]interface{}{
 		log.KeyEventlogID: t.subscription.ID,
@@ -497,13 +871,43 @@ func (t *trigger) writeEventToDeadLetter(ctx context.Context, e *ce.Event, reaso
 	})
 }
 
+// writeOperation retries writes to system eventbuses up to MaxWriteAttempt times. Retries stop once most
+// writes sharing budget fail, e.g. the eventbus is unavailable, so that backoffs don't hold up delivering
+// other events, a nil budget doesn't stop them.
+func (t *trigger) writeOperation(name string, budget *retry.Budget) *retry.Operation {
+	return &retry.Operation{
+		Name: name,
+		Policy: retry.Policy{
+			MaxAttempts:    t.config.MaxWriteAttempt,
+			InitialBackoff: time.Second,
+			Multiplier:     1,
+			Jitter:         0.2,
+		},
+		Retryable: retry.Always,
+		Budget:    budget,
+		OnRetry: func(ctx context.Context, attempt int, err error) {
+			log.Info(ctx, "write event failed, retry it", map[string]interface{}{
+				log.KeyError:          err,
+				log.KeySubscriptionID: t.subscription.ID,
+				"operation":           name,
+				"attempt":             attempt,
+			})
+		},
+	}
+}
+
 func (t *trigger) getReaderConfig() reader.Config {
 	return reader.Config{
 		EventBusName:   t.subscription.EventBus,
 		Client:         t.client,
 		SubscriptionID: t.subscription.ID,
 		BatchSize:      t.config.PullBatchSize,
+		MaxBytes:       t.config.PullMaxBytes,
 		Offset:         getOffset(t.subscription),
+		Fetcher:        t.fetcher,
+		CaughtUp:       t.caughtUp,
+		DataLost:       t.dataLost,
+		GapDetector:    t.gapDetector,
 	}
 }
 
@@ -514,7 +918,9 @@ func (t *trigger) getRetryEventReaderConfig() reader.Config {
 		Client:         t.client,
 		SubscriptionID: t.subscription.ID,
 		BatchSize:      t.config.PullBatchSize,
+		MaxBytes:       t.config.PullMaxBytes,
 		Offset:         getOffset(t.subscription),
+		Fetcher:        t.fetcher,
 	}
 }
 
@@ -529,15 +935,30 @@ func getOffset(sub *primitive.Subscription) map[vanus.ID]uint64 {
 }
 
 func (t *trigger) Init(ctx context.Context) error {
-	t.eventCli = newEventClient(t.subscription.Sink, t.subscription.Protocol, t.subscription.SinkCredential)
+	eventCli, err := newEventClient(t.subscription.Sink, t.subscription.Protocol, t.subscription.SinkCredential,
+		t.subscription.Signing, t.subscription.Config.SinkAllowedHosts)
+	if err != nil {
+		return err
+	}
+	t.eventCli = eventCli
 	t.client = eb.Connect(t.config.Controllers)
 
 	t.timerEventWriter = t.client.Eventbus(ctx, primitive.TimerEventbusName).Writer()
 	t.dlEventWriter = t.client.Eventbus(ctx, t.config.DeadLetterEventbus).Writer()
+	if t.config.DataLossEvents {
+		t.dataLossWriter = t.client.Eventbus(ctx, primitive.DataLossEventbusName).Writer()
+	}
 	t.eventCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.sendCh = make(chan *toSendEvent, t.config.BufferSize)
 	t.batchSendCh = make(chan []*toSendEvent, t.config.BufferSize)
+	t.lock.Lock()
+	t.gapDetector = gap.NewDetector(gap.Config{
+		Strict:   t.config.StrictSequence,
+		Earliest: gap.EarliestOf(t.client.Eventbus(context.Background(), t.subscription.EventBus)),
+		OnGap:    t.sequenceGap,
+	})
 	t.reader = reader.NewReader(t.getReaderConfig(), t.eventCh)
+	t.lock.Unlock()
 	t.retryEventCh = make(chan info.EventRecord, t.config.BufferSize)
 	t.retryEventReader = reader.NewReader(t.getRetryEventReaderConfig(), t.retryEventCh)
 	return nil
@@ -560,10 +981,10 @@ func (t *trigger) Start(ctx context.Context) error {
 		t.reader.Close()
 		return err
 	}
-	t.wg.StartWithContext(ctx, t.runEventFilterTransform)
-	t.wg.StartWithContext(ctx, t.runEventToBatch)
-	t.wg.StartWithContext(ctx, t.runEventSend)
-	t.wg.StartWithContext(ctx, t.runRetryEventFilterTransform)
+	t.wg.StartWithContext(ctx, t.supervisor.supervise(stageFilterTransform, t.runEventFilterTransform))
+	t.wg.StartWithContext(ctx, t.supervisor.supervise(stageBatch, t.runEventToBatch))
+	t.wg.StartWithContext(ctx, t.supervisor.supervise(stageSend, t.runEventSend))
+	t.wg.StartWithContext(ctx, t.supervisor.supervise(stageRetryFilterTransform, t.runRetryEventFilterTransform))
 	t.state = TriggerRunning
 	log.Info(ctx, "trigger started", map[string]interface{}{
 		log.KeySubscriptionID: t.subscription.ID,
@@ -588,6 +1009,7 @@ func (t *trigger) Stop(ctx context.Context) error {
 	t.wg.Wait()
 	t.pool.Release()
 	t.offsetManager.Close()
+	t.getTransformer().Close()
 	t.state = TriggerStopped
 	log.Info(ctx, "trigger stopped", map[string]interface{}{
 		log.KeySubscriptionID: t.subscription.ID,
@@ -598,8 +1020,11 @@ func (t *trigger) Stop(ctx context.Context) error {
 func (t *trigger) Change(ctx context.Context, subscription *primitive.Subscription) error {
 	if t.subscription.Sink != subscription.Sink ||
 		t.subscription.Protocol != subscription.Protocol ||
-		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) {
-		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential)
+		!reflect.DeepEqual(t.subscription.SinkCredential, subscription.SinkCredential) ||
+		!reflect.DeepEqual(t.subscription.Signing, subscription.Signing) ||
+		!reflect.DeepEqual(t.subscription.Config.SinkAllowedHosts, subscription.Config.SinkAllowedHosts) {
+		err := t.changeTarget(subscription.Sink, subscription.Protocol, subscription.SinkCredential,
+			subscription.Signing, subscription.Config.SinkAllowedHosts)
 		if err != nil {
 			return err
 		}
@@ -620,3 +1045,57 @@ func (t *trigger) Change(ctx context.Context, subscription *primitive.Subscripti
 func (t *trigger) GetOffsets(ctx context.Context) pInfo.ListOffsetInfo {
 	return t.offsetManager.GetCommit()
 }
+
+func (t *trigger) GetDeliveryStats() (uint64, uint64) {
+	return atomic.LoadUint64(&t.deliveredEvents), atomic.LoadUint64(&t.failedEvents)
+}
+
+func (t *trigger) GetDeliveryLatencies() latency.Histogram {
+	return t.latencies.Histogram()
+}
+
+func (t *trigger) GetDeliveryPhase() primitive.DeliveryPhase {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	return t.deliveryPhase
+}
+
+func (t *trigger) GetDataLosses() []primitive.DataLoss {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	if len(t.dataLosses) == 0 {
+		return nil
+	}
+	losses := make([]primitive.DataLoss, len(t.dataLosses))
+	copy(losses, t.dataLosses)
+	return losses
+}
+
+func (t *trigger) GetPausedGaps() []gap.Gap {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	if t.gapDetector == nil {
+		return nil
+	}
+	return t.gapDetector.Paused()
+}
+
+func (t *trigger) ResumeEventlogs() []vanus.ID {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	if t.reader == nil {
+		return nil
+	}
+	return t.reader.Resume()
+}
+
+func (t *trigger) GetCrashStatus() *primitive.CrashStatus {
+	return t.supervisor.status()
+}
+
+func (t *trigger) GetSinkResolution() *client.Resolution {
+	if r, ok := t.getClient().(client.Resolvable); ok {
+		return r.Resolution()
+	}
+	return nil
+}
@@ -30,11 +30,17 @@ import (
 	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/client/pkg/api"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	pInfo "github.com/linkall-labs/vanus/internal/primitive/info"
+	"github.com/linkall-labs/vanus/internal/primitive/retry"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
 	"github.com/linkall-labs/vanus/internal/trigger/info"
+	"github.com/linkall-labs/vanus/internal/trigger/offset"
 	"github.com/linkall-labs/vanus/internal/trigger/reader"
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestTrigger_Options(t *testing.T) {
@@ -134,11 +140,17 @@ func TestTriggerWriteFailEvent(t *testing.T) {
 			tg.writeFailEvent(ctx, e.Event, 400, fmt.Errorf("400 error"))
 			So(e.Event.Extensions()[primitive.DeadLetterReason], ShouldNotBeNil)
 		})
+		Convey("test dlq with exhausted write budget", func() {
+			tg.writeBudget = retry.NewBudget(0, writeBudgetRatio)
+			tg.writeFailEvent(ctx, e.Event, 400, fmt.Errorf("400 error"))
+			So(callCount, ShouldEqual, 2)
+		})
 		Convey("test first retry,in retry", func() {
 			tg.writeFailEvent(ctx, e.Event, 500, fmt.Errorf("500 error"))
 			So(e.Event.Extensions()[primitive.XVanusRetryAttempts], ShouldEqual, 1)
 			So(e.Event.Extensions()[primitive.XVanusEventbus], ShouldEqual, primitive.RetryEventbusName)
 			So(e.Event.Extensions()[primitive.XVanusSubscriptionID], ShouldEqual, id.String())
+			So(e.Event.Extensions()[primitive.XVanusFirstAttemptTime], ShouldNotBeNil)
 		})
 		Convey("test retry again,in retry", func() {
 			attempts := 1
@@ -155,6 +167,23 @@ func TestTriggerWriteFailEvent(t *testing.T) {
 			So(e.Event.Extensions()[primitive.XVanusRetryAttempts], ShouldEqual, strconv.Itoa(attempts))
 			So(e.Event.Extensions()[primitive.DeadLetterReason], ShouldNotBeNil)
 		})
+		Convey("test delivery deadline", func() {
+			tg.applyOptions(WithDeliveryDeadline(uint32(time.Minute.Milliseconds())))
+			e.Event.SetExtension(primitive.XVanusRetryAttempts, "1")
+			Convey("before the deadline,in retry", func() {
+				first := time.Now().Add(-10 * time.Second).UTC().Format(time.RFC3339)
+				e.Event.SetExtension(primitive.XVanusFirstAttemptTime, first)
+				tg.writeFailEvent(ctx, e.Event, 500, fmt.Errorf("500 error"))
+				So(e.Event.Extensions()[primitive.XVanusRetryAttempts], ShouldEqual, 2)
+				So(e.Event.Extensions()[primitive.XVanusFirstAttemptTime], ShouldEqual, first)
+			})
+			Convey("after the deadline,in dlq", func() {
+				first := time.Now().Add(-2 * time.Minute).UTC().Format(time.RFC3339)
+				e.Event.SetExtension(primitive.XVanusFirstAttemptTime, first)
+				tg.writeFailEvent(ctx, e.Event, 500, fmt.Errorf("500 error"))
+				So(e.Event.Extensions()[primitive.DeadLetterReason], ShouldEqual, "DeliveryDeadlineExceeded")
+			})
+		})
 	})
 }
 
@@ -206,6 +235,99 @@ func TestTriggerRunEventSend(t *testing.T) {
 	})
 }
 
+func TestTriggerBatchPanic(t *testing.T) {
+	Convey("test panic in the batch stage", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		tg := NewTrigger(makeSubscription(vanus.NewTestID()), WithControllers([]string{"test"})).(*trigger)
+		tg.batch = true
+		tg.config.SendBatchSize = 100
+		tg.supervisor.restart.InitialBackoff = time.Millisecond
+		tg.supervisor.restart.MaxBackoff = time.Millisecond
+		mockBusWriter := api.NewMockBusWriter(ctrl)
+		tg.timerEventWriter = mockBusWriter
+		tg.dlEventWriter = mockBusWriter
+		tg.sendCh = make(chan *toSendEvent, 10)
+		tg.batchSendCh = make(chan []*toSendEvent)
+		// sending the batch panics.
+		close(tg.batchSendCh)
+
+		// committed is what the offsets are once the held events are committed.
+		committed := offset.NewSubscriptionOffset(tg.subscription.ID, tg.config.MaxUACKNumber, nil)
+		elID := vanus.NewTestID()
+		size := 2
+		mockBusWriter.EXPECT().AppendOne(gomock.Any(), gomock.Any()).Times(size).Return("", nil)
+		for i := 0; i < size; i++ {
+			record := makeEventRecord("test")
+			record.OffsetInfo = pInfo.OffsetInfo{EventLogID: elID, Offset: uint64(i)}
+			tg.offsetManager.EventReceive(record.OffsetInfo)
+			committed.EventReceive(record.OffsetInfo)
+			tg.sendCh <- &toSendEvent{record: record, transform: record.Event}
+		}
+		for i := 0; i < size; i++ {
+			committed.EventCommit(pInfo.OffsetInfo{EventLogID: elID, Offset: uint64(i)})
+		}
+		So(tg.offsetManager.GetCommit(), ShouldNotResemble, committed.GetCommit())
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tg.supervisor.supervise(stageBatch, tg.runEventToBatch)(ctx)
+		}()
+		time.Sleep(time.Second)
+		cancel()
+		wg.Wait()
+
+		So(tg.supervisor.status(), ShouldNotBeNil)
+		So(tg.supervisor.status().LastStage, ShouldEqual, stageBatch)
+		So(atomic.LoadUint64(&tg.failedEvents), ShouldEqual, size)
+		So(tg.offsetManager.GetCommit(), ShouldResemble, committed.GetCommit())
+	})
+}
+
+func TestTriggerSendEventRetry(t *testing.T) {
+	Convey("test retry sending events in place", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		cli := client.NewMockEventClient(ctrl)
+		ctx := context.Background()
+		tg := NewTrigger(makeSubscription(vanus.NewTestID()), WithControllers([]string{"test"})).(*trigger)
+		tg.eventCli = cli
+		event := makeEventRecord("test").Event
+
+		Convey("retry retryable failures", func() {
+			gomock.InOrder(
+				cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).
+					Return(client.Result{StatusCode: 503, Err: fmt.Errorf("503 error")}),
+				cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).Return(client.Success),
+			)
+			_, err := tg.sendEvent(ctx, event)
+			So(err, ShouldBeNil)
+		})
+
+		Convey("don't retry failures which aren't retryable", func() {
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(1).
+				Return(client.Result{StatusCode: 400, Err: fmt.Errorf("400 error")})
+			code, err := tg.sendEvent(ctx, event)
+			So(err, ShouldNotBeNil)
+			So(code, ShouldEqual, 400)
+		})
+
+		Convey("stop retrying once the budget runs out", func() {
+			cli.EXPECT().Send(gomock.Any(), gomock.Any()).Times(7).
+				Return(client.Result{StatusCode: 500, Err: fmt.Errorf("500 error")})
+			// each of the first 2 deliveries is retried once, the others aren't.
+			for i := 0; i < 5; i++ {
+				code, err := tg.sendEvent(ctx, event)
+				So(err, ShouldNotBeNil)
+				So(code, ShouldEqual, 500)
+			}
+		})
+	})
+}
+
 func TestTriggerRateLimit(t *testing.T) {
 	Convey("test rate limit", t, func() {
 		ctrl := gomock.NewController(t)
@@ -225,6 +347,21 @@ func TestTriggerRateLimit(t *testing.T) {
 			c := testSendEvent(tg)
 			So(c, ShouldBeLessThanOrEqualTo, 1*rateLimit+10)
 		})
+		Convey("test backfill then follow", func() {
+			backfillRateLimit := uint32(1000)
+			tg = NewTrigger(makeSubscription(id), WithControllers([]string{"test"}), WithRateLimit(rateLimit),
+				WithBackfillRateLimit(backfillRateLimit)).(*trigger)
+			tg.eventCli = cli
+			So(tg.GetDeliveryPhase(), ShouldEqual, primitive.DeliveryPhaseBackfilling)
+			c := testSendEvent(tg)
+			So(c, ShouldBeLessThanOrEqualTo, backfillRateLimit+10)
+
+			tg.caughtUp()
+			So(tg.GetDeliveryPhase(), ShouldEqual, primitive.DeliveryPhaseFollowing)
+			c = testSendEvent(tg)
+			So(c, ShouldBeGreaterThan, backfillRateLimit+10)
+			So(c, ShouldBeLessThanOrEqualTo, rateLimit+10)
+		})
 	})
 }
 
@@ -301,5 +438,210 @@ func TestChangeSubscription(t *testing.T) {
 			err := tg.Change(ctx, &primitive.Subscription{Config: primitive.SubscriptionConfig{RateLimit: 100}})
 			So(err, ShouldBeNil)
 		})
+		Convey("change backfill rate limit", func() {
+			So(tg.GetDeliveryPhase(), ShouldBeEmpty)
+			err := tg.Change(ctx, &primitive.Subscription{Config: primitive.SubscriptionConfig{BackfillRateLimit: 100}})
+			So(err, ShouldBeNil)
+			So(tg.GetDeliveryPhase(), ShouldEqual, primitive.DeliveryPhaseFollowing)
+
+			tg = NewTrigger(makeSubscription(id), WithBackfillRateLimit(100)).(*trigger)
+			So(tg.GetDeliveryPhase(), ShouldEqual, primitive.DeliveryPhaseBackfilling)
+			err = tg.Change(ctx, &primitive.Subscription{Config: primitive.SubscriptionConfig{BackfillRateLimit: 200}})
+			So(err, ShouldBeNil)
+			So(tg.GetDeliveryPhase(), ShouldEqual, primitive.DeliveryPhaseBackfilling)
+			err = tg.Change(ctx, &primitive.Subscription{Config: primitive.SubscriptionConfig{}})
+			So(err, ShouldBeNil)
+			So(tg.GetDeliveryPhase(), ShouldBeEmpty)
+		})
+	})
+}
+
+func TestTriggerTransformEvent(t *testing.T) {
+	Convey("test transform event", t, func() {
+		sub := makeSubscription(vanus.NewTestID())
+		sub.Transformer = &primitive.Transformer{Template: `{"id": "<id>"}`}
+		tg := NewTrigger(sub).(*trigger)
+		e := ce.NewEvent()
+		e.SetID("id")
+		_ = e.SetData("application/protobuf", []byte{0x0a, 0x02, 0x08, 0x01})
+		record := info.EventRecord{Event: &e}
+		Convey("transform binary data", func() {
+			_, err := tg.transformEvent(record)
+			So(err, ShouldNotBeNil)
+		})
+		Convey("pass through binary data", func() {
+			tg.changeConfig(primitive.SubscriptionConfig{PassThrough: true})
+			event, err := tg.transformEvent(record)
+			So(err, ShouldBeNil)
+			So(event.transform.DataContentType(), ShouldEqual, "application/protobuf")
+			So(event.transform.Data(), ShouldResemble, []byte{0x0a, 0x02, 0x08, 0x01})
+			So(event.transform.DataBase64, ShouldBeTrue)
+		})
+	})
+}
+
+func TestTriggerDecodeEvent(t *testing.T) {
+	Convey("test decode event", t, func() {
+		descriptorSet, _ := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("order.proto"),
+			Package: proto.String("example.v1"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("OrderCreated"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:   proto.String("order_id"),
+					Number: proto.Int32(1),
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				}},
+			}},
+		}}})
+		tg := NewTrigger(makeSubscription(vanus.NewTestID())).(*trigger)
+		e := ce.NewEvent()
+		e.SetID("id")
+		_ = e.SetData("application/x-protobuf", []byte{0x0a, 0x03, 'o', '-', '1'})
+
+		Convey("decoding is disabled", func() {
+			So(tg.decodeEvent(&e), ShouldBeNil)
+			So(e.DataContentType(), ShouldEqual, "application/x-protobuf")
+		})
+
+		tg.changeConfig(primitive.SubscriptionConfig{ProtobufDecoding: &primitive.ProtobufDecoding{
+			DescriptorSet: descriptorSet,
+			MessageName:   "example.v1.OrderCreated",
+		}})
+		So(tg.getDecoder(), ShouldNotBeNil)
+
+		Convey("decode protobuf payloads", func() {
+			So(tg.decodeEvent(&e), ShouldBeNil)
+			So(e.DataContentType(), ShouldEqual, ce.ApplicationJSON)
+			So(string(e.Data()), ShouldEqualJSON, `{"order_id":"o-1"}`)
+			So(filter.Run(filter.GetFilter([]*primitive.SubscriptionFilter{{
+				Exact: map[string]string{"data.order_id": "o-1"},
+			}}), e), ShouldEqual, filter.PassFilter)
+		})
+
+		Convey("skip other payloads", func() {
+			_ = e.SetData(ce.ApplicationJSON, map[string]string{"order_id": "o-2"})
+			So(tg.decodeEvent(&e), ShouldBeNil)
+			So(string(e.Data()), ShouldEqualJSON, `{"order_id":"o-2"}`)
+		})
+
+		Convey("invalid payloads", func() {
+			_ = e.SetData("application/protobuf", []byte{0xff, 0xff})
+			So(tg.decodeEvent(&e), ShouldNotBeNil)
+		})
+	})
+}
+
+func TestTriggerConvertEvent(t *testing.T) {
+	Convey("test convert event", t, func() {
+		descriptorSet, _ := proto.Marshal(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("order.proto"),
+			Package: proto.String("example.v1"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("OrderCreated"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:   proto.String("order_id"),
+					Number: proto.Int32(1),
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				}},
+			}},
+		}}})
+		payload := []byte{0x0a, 0x03, 'o', '-', '1'}
+		tg := NewTrigger(makeSubscription(vanus.NewTestID())).(*trigger)
+		e := ce.NewEvent()
+		e.SetID("id")
+		_ = e.SetData(ce.ApplicationJSON, map[string]string{"order_id": "o-1"})
+		record := info.EventRecord{Event: &e}
+
+		Convey("conversion is disabled", func() {
+			event, err := tg.transformEvent(record)
+			So(err, ShouldBeNil)
+			So(event.transform.DataContentType(), ShouldEqual, ce.ApplicationJSON)
+		})
+
+		Convey("protobuf decoding isn't configured", func() {
+			tg.changeConfig(primitive.SubscriptionConfig{DeliveryContentType: "application/protobuf"})
+			_, err := tg.transformEvent(record)
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("convert payloads", func() {
+			tg.changeConfig(primitive.SubscriptionConfig{
+				ProtobufDecoding: &primitive.ProtobufDecoding{
+					DescriptorSet: descriptorSet,
+					MessageName:   "example.v1.OrderCreated",
+				},
+				DeliveryContentType: "application/protobuf",
+			})
+
+			Convey("JSON to protobuf", func() {
+				event, err := tg.transformEvent(record)
+				So(err, ShouldBeNil)
+				So(event.transform.DataContentType(), ShouldEqual, "application/protobuf")
+				So(event.transform.Data(), ShouldResemble, payload)
+				So(event.transform.DataBase64, ShouldBeTrue)
+				// the stored event is unchanged for retrying.
+				So(record.Event.DataContentType(), ShouldEqual, ce.ApplicationJSON)
+			})
+
+			Convey("protobuf to JSON", func() {
+				_ = e.SetData("application/x-protobuf", payload)
+				So(tg.convertEvent(&e, ce.ApplicationJSON), ShouldBeNil)
+				So(e.DataContentType(), ShouldEqual, ce.ApplicationJSON)
+				So(string(e.Data()), ShouldEqualJSON, `{"order_id":"o-1"}`)
+			})
+
+			Convey("unsupported conversion", func() {
+				_ = e.SetData("text/plain", []byte("o-1"))
+				_, err := tg.transformEvent(record)
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("invalid payloads", func() {
+				_ = e.SetData(ce.ApplicationJSON, map[string]string{"unknown": "o-1"})
+				_, err := tg.transformEvent(record)
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestTriggerDataLost(t *testing.T) {
+	Convey("test data lost", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		id := vanus.NewTestID()
+		tg := NewTrigger(makeSubscription(id), WithDataLossEvents(true)).(*trigger)
+		loss := primitive.DataLoss{EventlogID: vanus.NewTestID(), FromOffset: 10, ToOffset: 30, DetectedAt: time.Now()}
+
+		Convey("keep recent data losses", func() {
+			So(tg.GetDataLosses(), ShouldBeEmpty)
+			for i := 0; i < maxDataLosses+2; i++ {
+				l := loss
+				l.FromOffset = uint64(i)
+				tg.dataLost(l)
+			}
+			losses := tg.GetDataLosses()
+			So(losses, ShouldHaveLength, maxDataLosses)
+			So(losses[0].FromOffset, ShouldEqual, 2)
+		})
+
+		Convey("write data loss events", func() {
+			writer := api.NewMockBusWriter(ctrl)
+			tg.dataLossWriter = writer
+			writer.EXPECT().AppendOne(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, event *ce.Event, opts ...api.WriteOption) (string, error) {
+					So(event.Type(), ShouldEqual, EventTypeDataLoss)
+					So(event.Subject(), ShouldEqual, id.String())
+					So(string(event.Data()), ShouldContainSubstring, `"lost_events":20`)
+					return "", nil
+				})
+			tg.dataLost(loss)
+			So(tg.GetDataLosses(), ShouldHaveLength, 1)
+		})
 	})
 }
@@ -15,35 +15,116 @@
 package trigger
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strconv"
+	"strings"
 	"time"
 
+	ce "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/uuid"
+
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/protodecode"
+	"github.com/linkall-labs/vanus/internal/primitive/sinktemplate"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	"github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/observability/log"
 )
 
 func newEventClient(sink primitive.URI,
 	protocol primitive.Protocol,
-	credential primitive.SinkCredential) client.EventClient {
+	credential primitive.SinkCredential,
+	signing *primitive.SigningConfig,
+	allowedHosts []string) (client.EventClient, error) {
 	switch protocol {
 	case primitive.AwsLambdaProtocol:
 		_credential, _ := credential.(*primitive.AkSkSinkCredential)
-		return client.NewAwsLambdaClient(_credential.AccessKeyID, _credential.SecretAccessKey, string(sink))
+		return client.NewAwsLambdaClient(_credential.AccessKeyID, _credential.SecretAccessKey, string(sink)), nil
 	case primitive.GCloudFunctions:
 		_credential, _ := credential.(*primitive.GCloudSinkCredential)
-		return client.NewGCloudFunctionClient(string(sink), _credential.CredentialJSON)
+		return client.NewGCloudFunctionClient(string(sink), _credential.CredentialJSON), nil
 	case primitive.GRPC:
-		return client.NewGRPCClient(string(sink))
+		return client.NewGRPCClient(string(sink)), nil
 	default:
-		return client.NewHTTPClient(string(sink))
+		if !sinktemplate.IsTemplate(string(sink)) {
+			return client.NewHTTPClient(string(sink), client.NewSigner(signing)), nil
+		}
+		template, err := sinktemplate.Parse(string(sink), allowedHosts)
+		if err != nil {
+			return nil, err
+		}
+		return client.NewTemplateHTTPClient(template, client.NewSigner(signing)), nil
 	}
 }
 
+const (
+	EventTypeDataLoss = "com.linkall.vanus.subscription.data_loss"
+	eventSource       = "https://linkall.com/vanus"
+)
+
+type dataLossEventData struct {
+	SubscriptionID string `json:"subscription_id"`
+	Eventbus       string `json:"eventbus"`
+	EventlogID     string `json:"eventlog_id"`
+	FromOffset     uint64 `json:"from_offset"`
+	ToOffset       uint64 `json:"to_offset"`
+	LostEvents     uint64 `json:"lost_events"`
+}
+
+func newDataLossEvent(id vanus.ID, eventbus string, loss primitive.DataLoss) *ce.Event {
+	e := ce.NewEvent()
+	e.SetID(uuid.NewString())
+	e.SetType(EventTypeDataLoss)
+	e.SetSource(eventSource)
+	e.SetSubject(id.String())
+	e.SetTime(loss.DetectedAt)
+	_ = e.SetData(ce.ApplicationJSON, dataLossEventData{
+		SubscriptionID: id.String(),
+		Eventbus:       eventbus,
+		EventlogID:     loss.EventlogID.String(),
+		FromOffset:     loss.FromOffset,
+		ToOffset:       loss.ToOffset,
+		LostEvents:     loss.ToOffset - loss.FromOffset,
+	})
+	return &e
+}
+
+// newDecoder returns nil if decoding is disabled or the config is invalid, which is validated by the
+// controller.
+func newDecoder(id vanus.ID, cfg *primitive.ProtobufDecoding) *protodecode.Decoder {
+	if cfg == nil {
+		return nil
+	}
+	decoder, err := protodecode.NewDecoder(cfg.DescriptorSet, cfg.MessageName)
+	if err != nil {
+		log.Warning(context.Background(), "invalid protobuf decoding config, payloads aren't decoded",
+			map[string]interface{}{
+				log.KeyError:          err,
+				log.KeySubscriptionID: id,
+			})
+		return nil
+	}
+	return decoder
+}
+
+// keepDataEncoded makes the data of a non-text event be encoded as data_base64 in structured mode, e.g. by
+// lambda and gcloud functions, otherwise it's written as a JSON string and binary data is corrupted.
+func keepDataEncoded(event *ce.Event) {
+	contentType := event.DataMediaType()
+	if contentType == "" || contentType == ce.ApplicationJSON ||
+		strings.HasSuffix(contentType, "+json") || strings.HasPrefix(contentType, "text/") {
+		return
+	}
+	event.DataBase64 = true
+}
+
 const (
 	OrderEventCode   = -1
 	ErrTransformCode = 1
+	// ErrPanicCode fails events which made the pipeline panic, they would panic again if they were retried.
+	ErrPanicCode = 2
 )
 
 func isShouldRetry(statusCode int) (bool, string) {
@@ -52,6 +133,8 @@ func isShouldRetry(statusCode int) (bool, string) {
 		return false, "TransformError"
 	case OrderEventCode:
 		return false, "OrderEvent"
+	case ErrPanicCode:
+		return false, "Panic"
 	case 400:
 		return false, "BadRequest"
 	case 403:
@@ -78,6 +161,20 @@ func calDeliveryTime(attempts int32) time.Duration {
 	return time.Duration(v) * time.Second
 }
 
+// firstAttemptTime returns the time the first attempt of the event failed, or now if it's the first one.
+func firstAttemptTime(ec *ce.EventContextV1, now time.Time) time.Time {
+	v, ok := ec.Extensions[primitive.XVanusFirstAttemptTime]
+	if !ok {
+		return now
+	}
+	s, _ := v.(string)
+	first, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return now
+	}
+	return first
+}
+
 func getRetryAttempts(attempts interface{}) (int32, error) {
 	switch v := attempts.(type) {
 	case int32:
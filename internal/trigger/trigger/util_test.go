@@ -20,21 +20,52 @@ import (
 
 	"github.com/linkall-labs/vanus/internal/primitive"
 
+	ce "github.com/cloudevents/sdk-go/v2"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
 func TestNewEventClient(t *testing.T) {
 	Convey("test new event client", t, func() {
 		Convey("new lambda client", func() {
-			cli := newEventClient("test", primitive.AwsLambdaProtocol,
-				primitive.NewAkSkSinkCredential("ak", "sk"))
+			cli, err := newEventClient("test", primitive.AwsLambdaProtocol,
+				primitive.NewAkSkSinkCredential("ak", "sk"), nil, nil)
+			So(err, ShouldBeNil)
 			So(cli, ShouldNotBeNil)
 		})
 		Convey("new http client", func() {
-			cli := newEventClient("test", primitive.HTTPProtocol,
-				primitive.NewPlainSinkCredential("identifier", "secret"))
+			cli, err := newEventClient("test", primitive.HTTPProtocol,
+				primitive.NewPlainSinkCredential("identifier", "secret"), nil, nil)
+			So(err, ShouldBeNil)
 			So(cli, ShouldNotBeNil)
 		})
+		Convey("new http client with sink template", func() {
+			_, err := newEventClient("http://{data.region}.example.com", primitive.HTTPProtocol, nil, nil, nil)
+			So(err, ShouldNotBeNil)
+			cli, err := newEventClient("http://{data.region}.example.com", primitive.HTTPProtocol, nil, nil,
+				[]string{"*.example.com"})
+			So(err, ShouldBeNil)
+			So(cli, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestKeepDataEncoded(t *testing.T) {
+	Convey("test keep data encoded", t, func() {
+		for contentType, base64 := range map[string]bool{
+			"":                             false,
+			ce.ApplicationJSON:             false,
+			"application/cloudevents+json": false,
+			"text/plain; charset=utf-8":    false,
+			"application/protobuf":         true,
+			"image/png":                    true,
+		} {
+			e := ce.NewEvent()
+			// Events read from eventbuses carry encoded data, SetData of bytes would set DataBase64.
+			e.SetDataContentType(contentType)
+			e.DataEncoded = []byte{0x89, 0x50, 0x4e, 0x47}
+			keepDataEncoded(&e)
+			So(e.DataBase64, ShouldEqual, base64)
+		}
 	})
 }
 
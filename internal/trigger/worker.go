@@ -17,13 +17,20 @@ package trigger
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"sync"
 	"time"
 
+	eb "github.com/linkall-labs/vanus/client"
 	"github.com/linkall-labs/vanus/internal/convert"
 	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/primitive/compression"
+	"github.com/linkall-labs/vanus/internal/primitive/featuregate"
+	"github.com/linkall-labs/vanus/internal/primitive/metering"
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/trigger/client"
+	"github.com/linkall-labs/vanus/internal/trigger/reader"
 	"github.com/linkall-labs/vanus/internal/trigger/trigger"
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/metrics"
@@ -44,6 +51,8 @@ type Worker interface {
 	RemoveSubscription(ctx context.Context, id vanus.ID) error
 	PauseSubscription(ctx context.Context, id vanus.ID) error
 	StartSubscription(ctx context.Context, id vanus.ID) error
+	ResumeEventlogs(ctx context.Context, id vanus.ID) ([]vanus.ID, error)
+	ResyncSubscriptions(ctx context.Context, subscriptions []*primitive.Subscription) []vanus.ID
 }
 
 const (
@@ -64,20 +73,35 @@ type worker struct {
 	tgLock     sync.RWMutex
 	client     ctrlpb.TriggerControllerClient
 	ctrl       cluster.Cluster
+	meter      metering.Meter
+	sampler    *resourceSampler
+	fetcher    *reader.Fetcher
+
+	// revision of subscription which the trigger runs with
+	revisionMap map[vanus.ID]uint64
 }
 
 func NewWorker(config Config) Worker {
+	eb.SetMaxRecvMsgSize(config.MaxRecvMsgSize)
+	compression.Apply(config.GRPCCompression)
 	if config.HeartbeatInterval == 0 {
 		config.HeartbeatInterval = defaultHeartbeatInterval
 	}
 
 	m := &worker{
-		config:     config,
-		ctrl:       cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
-		triggerMap: make(map[vanus.ID]trigger.Trigger),
-		newTrigger: trigger.NewTrigger,
+		config:      config,
+		ctrl:        cluster.NewClusterController(config.ControllerAddr, insecure.NewCredentials()),
+		triggerMap:  make(map[vanus.ID]trigger.Trigger),
+		revisionMap: make(map[vanus.ID]uint64),
+		newTrigger:  trigger.NewTrigger,
+		sampler:     newResourceSampler(config.Capacity),
 	}
 	m.client = m.ctrl.TriggerService().RawClient()
+	if config.SharedFetch {
+		m.fetcher = reader.NewFetcher()
+	}
+	m.meter = metering.NewMeter(config.Metering, fmt.Sprintf("trigger-%s", config.TriggerAddr),
+		eb.Connect(config.ControllerAddr))
 	m.ctx, m.stop = context.WithCancel(context.Background())
 	return m
 }
@@ -89,26 +113,60 @@ func (w *worker) getTrigger(id vanus.ID) (trigger.Trigger, bool) {
 	return t, exist
 }
 
-func (w *worker) addTrigger(id vanus.ID, t trigger.Trigger) {
+func (w *worker) getRevision(id vanus.ID) (uint64, bool) {
+	w.tgLock.RLock()
+	defer w.tgLock.RUnlock()
+	revision, exist := w.revisionMap[id]
+	return revision, exist
+}
+
+func (w *worker) getTriggerIDs() []vanus.ID {
+	w.tgLock.RLock()
+	defer w.tgLock.RUnlock()
+	ids := make([]vanus.ID, 0, len(w.triggerMap))
+	for id := range w.triggerMap {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (w *worker) addTrigger(id vanus.ID, t trigger.Trigger, revision uint64) {
 	w.tgLock.Lock()
 	defer w.tgLock.Unlock()
 	w.triggerMap[id] = t
+	w.revisionMap[id] = revision
 }
 
 func (w *worker) deleteTrigger(id vanus.ID) {
 	w.tgLock.Lock()
 	defer w.tgLock.Unlock()
 	delete(w.triggerMap, id)
+	delete(w.revisionMap, id)
 }
 
 func (w *worker) Init(ctx context.Context) error {
 	err := w.ctrl.WaitForControllerReady(false)
-	return err
+	if err != nil {
+		return err
+	}
+	if w.config.Metering.Enable {
+		err = w.ctrl.EventbusService().CreateSystemEventbusIfNotExist(ctx, primitive.MeteringEventbusName,
+			"System Eventbus For Usage Metering")
+		if err != nil {
+			return err
+		}
+	}
+	if w.config.DataLossEvents {
+		return w.ctrl.EventbusService().CreateSystemEventbusIfNotExist(ctx, primitive.DataLossEventbusName,
+			"System Eventbus For Data Loss Of Subscriptions")
+	}
+	return nil
 }
 
 func (w *worker) Register(ctx context.Context) error {
 	_, err := w.client.RegisterTriggerWorker(ctx, &ctrlpb.RegisterTriggerWorkerRequest{
 		Address: w.config.TriggerAddr,
+		Labels:  w.config.Labels,
 	})
 	return err
 }
@@ -121,6 +179,8 @@ func (w *worker) Unregister(ctx context.Context) error {
 }
 
 func (w *worker) Start(ctx context.Context) error {
+	w.meter.Start(w.ctx)
+	featuregate.Sync(w.ctx, w.ctrl.FeatureGateService().RawClient())
 	return w.startHeartbeat(w.ctx)
 }
 
@@ -143,11 +203,14 @@ func (w *worker) Stop(ctx context.Context) error {
 			log.KeyError: err,
 		})
 	}
+	// flush usage of delivered events
+	w.meter.Stop(ctx)
 	// stop heartbeat
 	w.stop()
 	// clean trigger
 	for id := range w.triggerMap {
 		delete(w.triggerMap, id)
+		delete(w.revisionMap, id)
 	}
 	w.wg.Wait()
 	if closer, ok := w.client.(io.Closer); ok {
@@ -162,7 +225,11 @@ func (w *worker) AddSubscription(ctx context.Context, subscription *primitive.Su
 	t, exist := w.getTrigger(subscription.ID)
 	if exist {
 		err := t.Change(ctx, subscription)
-		return err
+		if err != nil {
+			return err
+		}
+		w.addTrigger(subscription.ID, t, subscription.Revision)
+		return nil
 	}
 	t = w.newTrigger(subscription, w.getTriggerOptions(subscription)...)
 	err := t.Init(ctx)
@@ -173,7 +240,7 @@ func (w *worker) AddSubscription(ctx context.Context, subscription *primitive.Su
 	if err != nil {
 		return err
 	}
-	w.addTrigger(subscription.ID, t)
+	w.addTrigger(subscription.ID, t, subscription.Revision)
 	metrics.TriggerGauge.WithLabelValues(w.config.IP).Inc()
 	return nil
 }
@@ -195,13 +262,67 @@ func (w *worker) StartSubscription(ctx context.Context, id vanus.ID) error {
 	return w.startSubscription(ctx, id)
 }
 
+// ResumeEventlogs resumes eventlogs of the subscription paused by sequence gaps, it returns IDs of the
+// eventlogs resumed.
+func (w *worker) ResumeEventlogs(_ context.Context, id vanus.ID) ([]vanus.ID, error) {
+	t, exist := w.getTrigger(id)
+	if !exist {
+		return nil, errors.ErrResourceNotFound.WithMessage("subscription not exist")
+	}
+	return t.ResumeEventlogs(), nil
+}
+
+// ResyncSubscriptions makes the worker run exactly the given subscriptions, which are all the subscriptions
+// the controller assigns to the worker. A running subscription not in the set is removed, so a subscription
+// deleted while the worker was away can't be resumed from stale local state. It returns IDs of subscriptions
+// which are running after resync.
+func (w *worker) ResyncSubscriptions(ctx context.Context, subscriptions []*primitive.Subscription) []vanus.ID {
+	assigned := make(map[vanus.ID]struct{}, len(subscriptions))
+	for _, sub := range subscriptions {
+		assigned[sub.ID] = struct{}{}
+	}
+	for _, id := range w.getTriggerIDs() {
+		if _, exist := assigned[id]; exist {
+			continue
+		}
+		log.Info(ctx, "resync remove subscription which is not assigned", map[string]interface{}{
+			log.KeySubscriptionID: id,
+		})
+		_ = w.RemoveSubscription(ctx, id)
+	}
+	started := make([]vanus.ID, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		if revision, exist := w.getRevision(sub.ID); exist && revision == sub.Revision {
+			// running with the same revision, keep the offsets of the trigger which are newer.
+			started = append(started, sub.ID)
+			continue
+		}
+		err := w.AddSubscription(ctx, sub)
+		if err != nil {
+			log.Warning(ctx, "resync add subscription error", map[string]interface{}{
+				log.KeySubscriptionID: sub.ID,
+				log.KeyError:          err,
+			})
+			continue
+		}
+		started = append(started, sub.ID)
+	}
+	return started
+}
+
 func (w *worker) startHeartbeat(ctx context.Context) error {
 	w.wg.Add(1)
 	defer w.wg.Done()
 	f := func() interface{} {
+		subInfos := w.getAllSubscriptionInfo(ctx)
+		var delivered uint64
+		for _, info := range subInfos {
+			delivered += info.DeliveredEvents
+		}
 		return &ctrlpb.TriggerWorkerHeartbeatRequest{
 			Address:          w.config.TriggerAddr,
-			SubscriptionInfo: w.getAllSubscriptionInfo(ctx),
+			SubscriptionInfo: subInfos,
+			Resources:        w.sampler.sample(time.Now(), len(subInfos), delivered),
 		}
 	}
 	return w.ctrl.TriggerService().RegisterHeartbeat(ctx, w.config.HeartbeatInterval, f)
@@ -240,25 +361,57 @@ func (w *worker) getAllSubscriptionInfo(ctx context.Context) []*metapb.Subscript
 	defer w.tgLock.RUnlock()
 	subInfos := make([]*metapb.SubscriptionInfo, 0, len(w.triggerMap))
 	for id, t := range w.triggerMap {
+		delivered, failed := t.GetDeliveryStats()
 		subInfos = append(subInfos, &metapb.SubscriptionInfo{
-			SubscriptionId: uint64(id),
-			Offsets:        convert.ToPbOffsetInfos(t.GetOffsets(ctx)),
+			SubscriptionId:  uint64(id),
+			Offsets:         convert.ToPbOffsetInfos(t.GetOffsets(ctx)),
+			SinkResolution:  toPbSinkResolution(t.GetSinkResolution()),
+			DeliveredEvents: delivered,
+			FailedEvents:    failed,
+			DeliveryPhase:   string(t.GetDeliveryPhase()),
+			DataLosses:      convert.ToPbDataLosses(t.GetDataLosses()),
+			CrashStatus:     convert.ToPbCrashStatus(t.GetCrashStatus()),
+			PausedGaps:      convert.ToPbSequenceGaps(t.GetPausedGaps()),
+			// Cumulative like DeliveredEvents, since offsets committed by CommitOffset carry them too.
+			DeliveryLatencies: t.GetDeliveryLatencies(),
 		})
 	}
 	return subInfos
 }
 
+func toPbSinkResolution(r *client.Resolution) *metapb.SinkResolution {
+	if r == nil {
+		return nil
+	}
+	res := &metapb.SinkResolution{
+		Host:       r.Host,
+		Addresses:  r.Addrs,
+		ResolvedAt: r.ResolvedAt.UnixMilli(),
+	}
+	if r.Err != nil {
+		res.Error = r.Err.Error()
+	}
+	return res
+}
+
 func (w *worker) getTriggerOptions(subscription *primitive.Subscription) []trigger.Option {
 	opts := []trigger.Option{trigger.WithControllers(w.config.ControllerAddr)}
 	config := subscription.Config
 	opts = append(opts, trigger.WithRateLimit(config.RateLimit),
+		trigger.WithBackfillRateLimit(config.BackfillRateLimit),
 		trigger.WithDeliveryTimeout(config.DeliveryTimeout),
+		trigger.WithDeliveryDeadline(config.DeliveryDeadline),
 		trigger.WithMaxRetryAttempts(config.GetMaxRetryAttempts()),
 		trigger.WithDeadLetterEventbus(config.DeadLetterEventbus),
 		trigger.WithOrdered(config.OrderedEvent),
 		trigger.WithGoroutineSize(w.config.SendEventGoroutineSize),
 		trigger.WithSendBatchSize(w.config.SendEventBatchSize),
 		trigger.WithPullBatchSize(w.config.PullEventBatchSize),
-		trigger.WithMaxUACKNumber(w.config.MaxUACKEventNumber))
+		trigger.WithPullMaxBytes(w.config.PullEventMaxBytes),
+		trigger.WithMaxUACKNumber(w.config.MaxUACKEventNumber),
+		trigger.WithMeter(w.meter),
+		trigger.WithFetcher(w.fetcher),
+		trigger.WithDataLossEvents(w.config.DataLossEvents),
+		trigger.WithStrictSequence(w.config.StrictSequence))
 	return opts
 }
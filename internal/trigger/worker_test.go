@@ -158,6 +158,36 @@ func TestPauseStartSubscription(t *testing.T) {
 	})
 }
 
+func TestWorker_ResyncSubscriptions(t *testing.T) {
+	ctx := context.Background()
+	Convey("resync subscriptions", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		tg := trigger.NewMockTrigger(ctrl)
+		m := NewWorker(Config{}).(*worker)
+		m.newTrigger = testNewTrigger(tg)
+		kept, removed, added := vanus.NewTestID(), vanus.NewTestID(), vanus.NewTestID()
+		tg.EXPECT().Init(gomock.Any()).Times(3).Return(nil)
+		tg.EXPECT().Start(gomock.Any()).Times(3).Return(nil)
+		err := m.AddSubscription(ctx, &primitive.Subscription{ID: kept, Revision: 1})
+		So(err, ShouldBeNil)
+		err = m.AddSubscription(ctx, &primitive.Subscription{ID: removed, Revision: 1})
+		So(err, ShouldBeNil)
+
+		tg.EXPECT().Stop(gomock.Any()).Times(1).Return(nil)
+		started := m.ResyncSubscriptions(ctx, []*primitive.Subscription{
+			{ID: kept, Revision: 1},
+			{ID: added, Revision: 2},
+		})
+		So(started, ShouldResemble, []vanus.ID{kept, added})
+		_, exist := m.getTrigger(removed)
+		So(exist, ShouldBeFalse)
+		revision, exist := m.getRevision(added)
+		So(exist, ShouldBeTrue)
+		So(revision, ShouldEqual, 2)
+	})
+}
+
 func TestWorker_Stop(t *testing.T) {
 	ctx := context.Background()
 	Convey("start stop", t, func() {
@@ -181,6 +211,13 @@ func TestWorker_Stop(t *testing.T) {
 		tg.EXPECT().Stop(gomock.Any()).AnyTimes().Return(nil)
 		offsets := info.ListOffsetInfo{{EventLogID: vanus.NewTestID(), Offset: uint64(100)}}
 		tg.EXPECT().GetOffsets(gomock.Any()).AnyTimes().Return(offsets)
+		tg.EXPECT().GetSinkResolution().AnyTimes().Return(nil)
+		tg.EXPECT().GetDeliveryStats().AnyTimes().Return(uint64(0), uint64(0))
+		tg.EXPECT().GetDeliveryPhase().AnyTimes().Return(primitive.DeliveryPhase(""))
+		tg.EXPECT().GetDataLosses().AnyTimes().Return(nil)
+		tg.EXPECT().GetCrashStatus().AnyTimes().Return(nil)
+		tg.EXPECT().GetPausedGaps().AnyTimes().Return(nil)
+		tg.EXPECT().GetDeliveryLatencies().AnyTimes().Return(nil)
 		triggerClient.EXPECT().CommitOffset(gomock.Any(), gomock.Any()).Return(nil, nil)
 		err = m.Stop(ctx)
 		So(err, ShouldBeNil)
@@ -17,6 +17,8 @@ package log
 const (
 	KeyError   = "error"
 	KeyUnknown = "known"
+	// KeyCorrelationID is added by the default logger if the context carries a correlation ID.
+	KeyCorrelationID = "correlation_id"
 
 	KeySegmentID         = "segment_id"
 	KeySegmentServerID   = "segment_server_id"
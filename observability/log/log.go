@@ -70,35 +70,44 @@ func (l *defaultLogger) Debug(ctx context.Context, msg string, fields map[string
 	if msg == "" && len(fields) == 0 {
 		return
 	}
-	l.logger.WithFields(fields).Debug(msg)
+	l.entry(ctx, fields).Debug(msg)
 }
 
 func (l *defaultLogger) Info(ctx context.Context, msg string, fields map[string]interface{}) {
 	if msg == "" && len(fields) == 0 {
 		return
 	}
-	l.logger.WithFields(fields).Info(msg)
+	l.entry(ctx, fields).Info(msg)
 }
 
 func (l *defaultLogger) Warning(ctx context.Context, msg string, fields map[string]interface{}) {
 	if msg == "" && len(fields) == 0 {
 		return
 	}
-	l.logger.WithFields(fields).Warning(msg)
+	l.entry(ctx, fields).Warning(msg)
 }
 
 func (l *defaultLogger) Error(ctx context.Context, msg string, fields map[string]interface{}) {
 	if msg == "" && len(fields) == 0 {
 		return
 	}
-	l.logger.WithFields(fields).WithFields(fields).Error(msg)
+	l.entry(ctx, fields).Error(msg)
 }
 
 func (l *defaultLogger) Fatal(ctx context.Context, msg string, fields map[string]interface{}) {
 	if msg == "" && len(fields) == 0 {
 		return
 	}
-	l.logger.WithFields(fields).Fatal(msg)
+	l.entry(ctx, fields).Fatal(msg)
+}
+
+// entry adds the correlation ID carried by ctx, if any, to fields.
+func (l *defaultLogger) entry(ctx context.Context, fields map[string]interface{}) *logrus.Entry {
+	e := l.logger.WithFields(fields)
+	if id := CorrelationID(ctx); id != "" {
+		e = e.WithField(KeyCorrelationID, id)
+	}
+	return e
 }
 
 func (l *defaultLogger) SetLevel(level string) {
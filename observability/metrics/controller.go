@@ -54,6 +54,20 @@ var (
 		Help:      "The number of deleted Segment.",
 	}, []string{LabelType})
 
+	BlockReconciledCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "reconciled_block_number",
+		Help:      "The number of blocks repaired by reconciliation with segment servers.",
+	}, []string{LabelType})
+
+	RetentionReclaimedBytesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "retention_reclaimed_bytes",
+		Help:      "The bytes of segments deleted because they are older than the retention of their eventbuses.",
+	})
+
 	SubscriptionGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfController,
@@ -74,4 +88,53 @@ var (
 		Name:      "trigger_number",
 		Help:      "The number of trigger",
 	}, []string{LabelTriggerWorker})
+
+	EventbusPublishRateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "eventbus_publish_rate",
+		Help:      "The rolling rate of events appended to each eventbus per second.",
+	}, []string{LabelEventbus})
+
+	SubscriptionDeliveryFailureRateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "subscription_delivery_failure_rate",
+		Help:      "The rolling rate of events failed to deliver by each subscription per second.",
+	}, []string{LabelTrigger})
+
+	AnomalyEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "anomaly_event_number",
+		Help:      "The number of anomaly events written to the system eventbus.",
+	}, []string{LabelType})
+
+	ThrottledRequestCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "throttled_request_number",
+		Help:      "The number of admin API requests rejected by quotas of principals.",
+	}, []string{LabelOperation})
+
+	SLOBurnRateGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "slo_burn_rate",
+		Help:      "The ratio of bad events to the error budget of each latency SLO over the short or long window.",
+	}, []string{LabelType, LabelResource, LabelWindow})
+
+	SLOP99LatencyGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "slo_p99_latency_seconds",
+		Help:      "The 99th percentile latency of each latency SLO over the long window.",
+	}, []string{LabelType, LabelResource})
+
+	SLOAlertEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfController,
+		Name:      "slo_alert_event_number",
+		Help:      "The number of SLO alert events written to the system eventbus.",
+	}, []string{LabelType})
 )
@@ -0,0 +1,57 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	moduleOfGateway = "gateway"
+
+	GatewayConnectionGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfGateway,
+		Name:      "connection_count",
+		Help:      "The number of open connections",
+	}, []string{LabelServer})
+
+	GatewayConnectionAcceptedCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfGateway,
+		Name:      "connection_accepted_count",
+		Help:      "Total accepted connections",
+	}, []string{LabelServer})
+
+	GatewayConnectionDurationHistogramVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfGateway,
+		Name:      "connection_duration_seconds",
+		Help:      "The lifetime of closed connections",
+		Buckets:   []float64{1, 10, 60, 300, 900, 3600, 4 * 3600, 24 * 3600},
+	}, []string{LabelServer})
+
+	GatewayConnectionByteCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfGateway,
+		Name:      "connection_byte_count",
+		Help:      "Total bytes transferred by connections",
+	}, []string{LabelServer, LabelDirection})
+
+	GatewayShadowEventCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfGateway,
+		Name:      "shadow_event_count",
+		Help:      "Total events mirrored to the shadow cluster by results",
+	}, []string{LabelEventbus, LabelResult})
+)
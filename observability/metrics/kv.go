@@ -0,0 +1,44 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	moduleOfKV = "kv"
+
+	KVOperationLatencyHistogramVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfKV,
+		Name:      "operation_latency_seconds",
+		Help:      "The latency of operations on the kv store.",
+		Buckets:   []float64{0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1, 0.2, 0.5, 1, 2, 5},
+	}, []string{LabelOperation, LabelPrefix})
+
+	KVOperationCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfKV,
+		Name:      "operation_total",
+		Help:      "The number of operations on the kv store by result.",
+	}, []string{LabelOperation, LabelPrefix, LabelResult})
+
+	KVPayloadBytesHistogramVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfKV,
+		Name:      "payload_bytes",
+		Help:      "The size of values written to or read from the kv store.",
+		Buckets:   prometheus.ExponentialBuckets(64, 4, 8),
+	}, []string{LabelOperation, LabelPrefix})
+)
@@ -24,11 +24,27 @@ const (
 	LabelTrigger       = "trigger"
 	LabelResult        = "result"
 	LabelBlock         = "block"
+	LabelCause         = "cause"
 
 	LabelTimer = "timer"
+
+	LabelOperation = "operation"
+
+	LabelServer    = "server"
+	LabelDirection = "direction"
+
+	LabelResource = "resource"
+	LabelWindow   = "window"
+
+	LabelPrefix = "prefix"
+
+	LabelTraffic    = "traffic"
+	LabelCompressor = "compressor"
 )
 
 const (
+	LabelValueDirectionIn                  = "in"
+	LabelValueDirectionOut                 = "out"
 	LabelValueResourceDynamicCreate        = "dynamic"
 	LabelValueResourceManualCreate         = "manual"
 	LabelValuePushEventSuccess             = "success"
@@ -36,6 +52,21 @@ const (
 	LabelSegmentDeletedBecauseExpired      = "segment_expired"
 	LabelSegmentDeletedBecauseCreateFailed = "segment_create_failed"
 	LabelSegmentDeletedBecauseDeleted      = "segment_deleted"
+	LabelSegmentDeletedBecauseTruncated    = "segment_truncated"
+	LabelBlockReconciledLost               = "lost"
+	LabelBlockReconciledAdopted            = "adopted"
+	LabelBlockReconciledRemoved            = "removed"
+	LabelBlockReconciledCorrupted          = "corrupted"
+	LabelValueRequestSuccess               = "success"
+	LabelValueRequestFail                  = "fail"
+	LabelValueShadowDropped                = "dropped"
+	LabelValueCacheHit                     = "hit"
+	LabelValueCacheMiss                    = "miss"
+	LabelValueCacheReadAhead               = "read_ahead"
+	LabelValueKVRejected                   = "rejected"
+	LabelValueTrafficControl               = "control"
+	LabelValueTrafficData                  = "data"
+	LabelValueCompressorNone               = "none"
 )
 
 const (
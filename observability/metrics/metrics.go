@@ -15,12 +15,8 @@
 package metrics
 
 import (
-	"context"
-	"sync"
-
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
-	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -34,15 +30,31 @@ func RegisterControllerMetrics() {
 	prometheus.MustRegister(SegmentGaugeVec)
 	prometheus.MustRegister(SegmentCreationRuntimeCounterVec)
 	prometheus.MustRegister(SegmentDeletedCounterVec)
+	prometheus.MustRegister(BlockReconciledCounterVec)
+	prometheus.MustRegister(RetentionReclaimedBytesCounter)
 	prometheus.MustRegister(SubscriptionGauge)
 	prometheus.MustRegister(SubscriptionTransformerGauge)
 	prometheus.MustRegister(CtrlTriggerGauge)
+	prometheus.MustRegister(EventbusPublishRateGauge)
+	prometheus.MustRegister(SubscriptionDeliveryFailureRateGauge)
+	prometheus.MustRegister(AnomalyEventCounter)
+	prometheus.MustRegister(ThrottledRequestCounter)
+	prometheus.MustRegister(SLOBurnRateGauge)
+	prometheus.MustRegister(SLOP99LatencyGauge)
+	prometheus.MustRegister(SLOAlertEventCounter)
+	registerKVMetrics()
+	registerTransportMetrics()
 }
 
 func RegisterTriggerMetrics() {
 	registerGoRuntimeMetrics()
 	prometheus.MustRegister(TriggerGauge)
 	prometheus.MustRegister(TriggerPullEventCounter)
+	prometheus.MustRegister(TriggerStoreReadCounter)
+	prometheus.MustRegister(TriggerCoalescedReadCounter)
+	prometheus.MustRegister(TriggerLostEventCounter)
+	prometheus.MustRegister(TriggerSequenceGapEventCounter)
+	prometheus.MustRegister(TriggerPausedEventlogGauge)
 	prometheus.MustRegister(TriggerFilterCostSecond)
 	prometheus.MustRegister(TriggerTransformCostSecond)
 	prometheus.MustRegister(TriggerFilterMatchEventCounter)
@@ -53,6 +65,7 @@ func RegisterTriggerMetrics() {
 	prometheus.MustRegister(TriggerDeadLetterEventAppendSecond)
 	prometheus.MustRegister(TriggerPushEventCounter)
 	prometheus.MustRegister(TriggerPushEventTime)
+	registerTransportMetrics()
 }
 
 func RegisterTimerMetrics() {
@@ -64,6 +77,13 @@ func RegisterTimerMetrics() {
 	prometheus.MustRegister(TimerScheduledEventDelayTime)
 	prometheus.MustRegister(TimerPushEventTime)
 	prometheus.MustRegister(TimerDeliverEventTime)
+	registerKVMetrics()
+}
+
+func registerKVMetrics() {
+	prometheus.MustRegister(KVOperationLatencyHistogramVec)
+	prometheus.MustRegister(KVOperationCounterVec)
+	prometheus.MustRegister(KVPayloadBytesHistogramVec)
 }
 
 func RegisterSegmentServerMetrics() {
@@ -71,6 +91,29 @@ func RegisterSegmentServerMetrics() {
 	prometheus.MustRegister(WriteThroughputCounterVec)
 	prometheus.MustRegister(ReadTPSCounterVec)
 	prometheus.MustRegister(ReadThroughputCounterVec)
+	prometheus.MustRegister(ThrottledAppendCounterVec)
+	prometheus.MustRegister(ReadCacheEventCounterVec)
+	prometheus.MustRegister(ReadCacheBytesGaugeVec)
+	prometheus.MustRegister(ScrubBytesCounterVec)
+	prometheus.MustRegister(CorruptedBlockGaugeVec)
+	prometheus.MustRegister(InflightRequestGaugeVec)
+	prometheus.MustRegister(InflightRequestOldestAgeGaugeVec)
+	prometheus.MustRegister(ClockSkewGauge)
+	prometheus.MustRegister(ClockSkewRTTGauge)
+	prometheus.MustRegister(IngestionClockStalledGauge)
+	prometheus.MustRegister(RequestLatencyHistogramVec)
+	registerTransportMetrics()
+}
+
+func RegisterGatewayMetrics() {
+	registerGoRuntimeMetrics()
+	prometheus.MustRegister(RequestLatencyHistogramVec)
+	prometheus.MustRegister(GatewayConnectionGaugeVec)
+	prometheus.MustRegister(GatewayConnectionAcceptedCounterVec)
+	prometheus.MustRegister(GatewayConnectionDurationHistogramVec)
+	prometheus.MustRegister(GatewayConnectionByteCounterVec)
+	prometheus.MustRegister(GatewayShadowEventCounterVec)
+	registerTransportMetrics()
 }
 
 func registerGoRuntimeMetrics() {
@@ -78,139 +121,3 @@ func registerGoRuntimeMetrics() {
 	collectors.NewProcessCollector(collectors.ProcessCollectorOpts{})
 	collectors.NewGoCollector(collectors.WithGoCollections(collectors.GoRuntimeMetricsCollection))
 }
-
-type Config struct {
-	ModuleName string
-}
-
-var mCfg Config
-
-func Init(cfg Config) {
-	mCfg = cfg
-}
-
-type unit string
-
-const (
-	UnitMillisecond   = unit("ms")
-	UnitByte          = unit("byte")
-	UnitDimensionless = unit("1")
-)
-
-var (
-	metricCreateMutex = sync.Mutex{}
-	countMap          = make(map[string]ICounter, 0)
-	gaugeMap          = make(map[string]IGauge, 0)
-	histogramMap      = make(map[string]IHistogram, 0)
-	emptyCount        = &promCounter{}
-	emptyGauge        = &promGauge{}
-	emptyHistogram    = &promHistogram{}
-)
-
-type metricKey struct {
-	name        string
-	description string
-	unit        unit
-}
-
-func NewMetricKey(name string, u unit, desc string) *metricKey {
-	return &metricKey{
-		name:        name,
-		description: desc,
-		unit:        u,
-	}
-}
-
-type ICounter interface {
-	IncrInt(int64, ...attribute.KeyValue)
-	IncrFloat(float64, ...attribute.KeyValue)
-	Async(func(context.Context, ICounter))
-}
-
-func newCounter(k *metricKey) ICounter {
-	return emptyCount
-}
-
-type IGauge interface {
-	IncrInt(int64, ...attribute.KeyValue)
-	IncrFloat(float64, ...attribute.KeyValue)
-	Async(func(context.Context, IGauge))
-}
-
-func newGauge(k *metricKey) IGauge {
-	return emptyGauge
-}
-
-type IHistogram interface {
-	RecordInt(int64, ...attribute.KeyValue)
-	RecordFloat(float64, ...attribute.KeyValue)
-	Async(func(context.Context, IHistogram))
-}
-
-func newHistogram(k *metricKey) IHistogram {
-	return emptyHistogram
-}
-
-func GetCounter(key *metricKey) ICounter {
-	if !isValidKey(key) {
-		return emptyCount
-	}
-	v, exist := countMap[key.name]
-	if !exist {
-		metricCreateMutex.Lock()
-		v, exist = countMap[key.name]
-		if !exist {
-			v = newCounter(key)
-			countMap[key.name] = v
-		}
-		metricCreateMutex.Unlock()
-	}
-	return v
-}
-
-func GetGauge(key *metricKey) IGauge {
-	if !isValidKey(key) {
-		return emptyGauge
-	}
-	v, exist := gaugeMap[key.name]
-	if !exist {
-		metricCreateMutex.Lock()
-		v, exist = gaugeMap[key.name]
-		if !exist {
-			v = newGauge(key)
-			gaugeMap[key.name] = v
-		}
-		metricCreateMutex.Unlock()
-	}
-	return v
-}
-
-func GetHistogram(key *metricKey) IHistogram {
-	if !isValidKey(key) {
-		return emptyHistogram
-	}
-	v, exist := histogramMap[key.name]
-	if !exist {
-		metricCreateMutex.Lock()
-		v, exist = histogramMap[key.name]
-		if !exist {
-			v = newHistogram(key)
-			histogramMap[key.name] = v
-		}
-		metricCreateMutex.Unlock()
-	}
-	return v
-}
-
-func isValidKey(k *metricKey) bool {
-	if k == nil {
-		return false
-	}
-	if k.name == "" {
-		return false
-	}
-	if k.unit == "" {
-		return false
-	}
-	return true
-}
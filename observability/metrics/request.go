@@ -0,0 +1,64 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	moduleOfRequest = "request"
+
+	exemplarTraceID = "trace_id"
+)
+
+var RequestLatencyHistogramVec = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: moduleOfRequest,
+	Name:      "latency_seconds",
+	Help:      "The latency of data-plane requests, observations are linked to traces by exemplars",
+	Buckets:   []float64{0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1, 0.2, 0.5, 1, 2, 5},
+}, []string{LabelOperation, LabelEventbus, LabelResult})
+
+// ObserveRequest observes the latency of the request described by the metadata carried by ctx, and
+// attaches the metadata to the span of ctx. The observation carries the trace ID as an exemplar, so a
+// slow request found in metrics can be looked up in traces directly.
+func ObserveRequest(ctx context.Context, start time.Time, err error) {
+	md, ok := tracing.RequestMetadataFrom(ctx)
+	if !ok {
+		return
+	}
+	span := oteltrace.SpanFromContext(ctx)
+	span.SetAttributes(md.Attributes()...)
+
+	result := LabelValueRequestSuccess
+	if err != nil {
+		result = LabelValueRequestFail
+		span.RecordError(err)
+	}
+	observer := RequestLatencyHistogramVec.WithLabelValues(md.Operation, md.Eventbus, result)
+	latency := time.Since(start).Seconds()
+	sc := span.SpanContext()
+	if eo, ok := observer.(prometheus.ExemplarObserver); ok && sc.HasTraceID() {
+		eo.ObserveWithExemplar(latency, prometheus.Labels{exemplarTraceID: sc.TraceID().String()})
+		return
+	}
+	observer.Observe(latency)
+}
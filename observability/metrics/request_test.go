@@ -0,0 +1,93 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+// requestSamples returns the number of observations and the trace IDs of exemplars of requests to eventbus.
+func requestSamples(t *testing.T, eventbus, result string) (uint64, []string) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(metrics.RequestLatencyHistogramVec)
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var count uint64
+	var traceIDs []string
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+			if labels[metrics.LabelEventbus] != eventbus || labels[metrics.LabelResult] != result {
+				continue
+			}
+			count += m.GetHistogram().GetSampleCount()
+			for _, b := range m.GetHistogram().GetBucket() {
+				for _, l := range b.GetExemplar().GetLabel() {
+					traceIDs = append(traceIDs, l.GetValue())
+				}
+			}
+		}
+	}
+	return count, traceIDs
+}
+
+func TestObserveRequest(t *testing.T) {
+	start := time.Now()
+	metrics.ObserveRequest(context.Background(), start, nil)
+	if count, _ := requestSamples(t, "", metrics.LabelValueRequestSuccess); count != 0 {
+		t.Errorf("requests without metadata shouldn't be observed, got %d", count)
+	}
+
+	ctx := tracing.WithRequestMetadata(context.Background(), tracing.RequestMetadata{
+		Operation: tracing.OperationAppend,
+		Eventbus:  "observe-without-span",
+	})
+	metrics.ObserveRequest(ctx, start, nil)
+	metrics.ObserveRequest(ctx, start, errors.New("append failed"))
+	count, traceIDs := requestSamples(t, "observe-without-span", metrics.LabelValueRequestSuccess)
+	if count != 1 || len(traceIDs) != 0 {
+		t.Errorf("got %d successful requests with exemplars %v, want 1 without exemplars", count, traceIDs)
+	}
+	if count, _ = requestSamples(t, "observe-without-span", metrics.LabelValueRequestFail); count != 1 {
+		t.Errorf("got %d failed requests, want 1", count)
+	}
+
+	tp := trace.NewTracerProvider()
+	ctx, span := tp.Tracer("test").Start(context.Background(), "append")
+	defer span.End()
+	ctx = tracing.WithRequestMetadata(ctx, tracing.RequestMetadata{
+		Operation: tracing.OperationAppend,
+		Eventbus:  "observe-with-span",
+	})
+	metrics.ObserveRequest(ctx, start, nil)
+	count, traceIDs = requestSamples(t, "observe-with-span", metrics.LabelValueRequestSuccess)
+	if count != 1 || len(traceIDs) != 1 || traceIDs[0] != span.SpanContext().TraceID().String() {
+		t.Errorf("got %d requests with exemplars %v, want 1 linked to trace %s",
+			count, traceIDs, span.SpanContext().TraceID())
+	}
+}
@@ -47,6 +47,76 @@ var (
 		Help:      "Total bytes for reading",
 	}, []string{LabelVolume, LabelBlock})
 
+	ThrottledAppendCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "throttled_append_count",
+		Help:      "Total appends rejected by the append rate limit",
+	}, []string{LabelVolume, LabelBlock})
+
+	ReadCacheEventCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "read_cache_event_count",
+		Help:      "Total events read from the read cache, missing in it or read ahead into it",
+	}, []string{LabelVolume, LabelResult})
+
+	ReadCacheBytesGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "read_cache_bytes",
+		Help:      "The size of events in the read cache",
+	}, []string{LabelVolume})
+
+	ScrubBytesCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "scrub_bytes",
+		Help:      "Total bytes of blocks verified by the scrubber",
+	}, []string{LabelVolume})
+
+	CorruptedBlockGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "corrupted_block_number",
+		Help:      "The number of blocks whose events are corrupted",
+	}, []string{LabelVolume})
+
+	InflightRequestGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "inflight_request_count",
+		Help:      "The number of uncompleted requests",
+	}, []string{LabelVolume, LabelBlock, LabelType})
+
+	InflightRequestOldestAgeGaugeVec = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "inflight_request_oldest_age_seconds",
+		Help:      "The age of the oldest uncompleted request",
+	}, []string{LabelVolume, LabelBlock, LabelType})
+
+	ClockSkewGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "clock_skew_seconds",
+		Help:      "The offset of the controller clock from the local clock",
+	})
+
+	ClockSkewRTTGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "clock_skew_rtt_seconds",
+		Help:      "The round trip time of the last clock skew measurement",
+	})
+
+	IngestionClockStalledGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfSegmentServer,
+		Name:      "ingestion_clock_stalled_count",
+		Help:      "Total ingestion timestamps held back because the local clock went backwards",
+	})
+
 	WALEntryWriteCounter = prometheus.NewCounter(prometheus.CounterOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfSegmentServer,
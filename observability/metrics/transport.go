@@ -0,0 +1,102 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/stats"
+)
+
+// grpcHeaderLen is the length of the prefix of each gRPC message, it's included in the wire length.
+const grpcHeaderLen = 5
+
+var (
+	moduleOfTransport = "transport"
+
+	TransportMessageBytesCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTransport,
+		Name:      "message_bytes_total",
+		Help:      "The size of gRPC messages sent or received by clients before compression.",
+	}, []string{LabelTraffic, LabelCompressor, LabelDirection})
+
+	TransportWireBytesCounterVec = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTransport,
+		Name:      "wire_bytes_total",
+		Help: "The size of gRPC messages sent or received by clients after compression, " +
+			"divide it by message_bytes_total for the compression ratio.",
+	}, []string{LabelTraffic, LabelCompressor, LabelDirection})
+)
+
+func registerTransportMetrics() {
+	prometheus.MustRegister(TransportMessageBytesCounterVec)
+	prometheus.MustRegister(TransportWireBytesCounterVec)
+}
+
+// transportStatsHandler counts bytes of messages of client connections, both requests and responses of
+// a connection are compressed by the compressor of its calls.
+type transportStatsHandler struct {
+	in  [2]prometheus.Counter
+	out [2]prometheus.Counter
+}
+
+// NewTransportStatsHandler returns a gRPC stats handler for client connections of traffic, which is
+// LabelValueTrafficControl or LabelValueTrafficData. The compressor is empty if it's disabled.
+func NewTransportStatsHandler(traffic, compressor string) stats.Handler {
+	if compressor == "" {
+		compressor = LabelValueCompressorNone
+	}
+	return &transportStatsHandler{
+		in: [2]prometheus.Counter{
+			TransportMessageBytesCounterVec.WithLabelValues(traffic, compressor, LabelValueDirectionIn),
+			TransportWireBytesCounterVec.WithLabelValues(traffic, compressor, LabelValueDirectionIn),
+		},
+		out: [2]prometheus.Counter{
+			TransportMessageBytesCounterVec.WithLabelValues(traffic, compressor, LabelValueDirectionOut),
+			TransportWireBytesCounterVec.WithLabelValues(traffic, compressor, LabelValueDirectionOut),
+		},
+	}
+}
+
+func (h *transportStatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+func (h *transportStatsHandler) HandleRPC(_ context.Context, s stats.RPCStats) {
+	switch p := s.(type) {
+	case *stats.InPayload:
+		observePayload(h.in, p.Length, p.WireLength)
+	case *stats.OutPayload:
+		observePayload(h.out, p.Length, p.WireLength)
+	}
+}
+
+func (h *transportStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (h *transportStatsHandler) HandleConn(context.Context, stats.ConnStats) {}
+
+func observePayload(counters [2]prometheus.Counter, length, wireLength int) {
+	wireLength -= grpcHeaderLen
+	if wireLength < 0 {
+		wireLength = 0
+	}
+	counters[0].Add(float64(length))
+	counters[1].Add(float64(wireLength))
+}
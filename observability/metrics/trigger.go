@@ -33,6 +33,41 @@ var (
 		Help:      "The event number of trigger pull",
 	}, []string{LabelTrigger, LabelEventbus, LabelEventlog})
 
+	TriggerStoreReadCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "store_read_number",
+		Help:      "The number of reads from stores shared by triggers",
+	}, []string{LabelEventbus, LabelEventlog})
+
+	TriggerCoalescedReadCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "coalesced_read_number",
+		Help:      "The number of trigger reads served by reads of other triggers",
+	}, []string{LabelEventbus, LabelEventlog})
+
+	TriggerLostEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "lost_event_number",
+		Help:      "The number of events removed by retention before triggers consumed them",
+	}, []string{LabelTrigger, LabelEventbus})
+
+	TriggerSequenceGapEventCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "sequence_gap_event_number",
+		Help:      "The number of events skipped by gaps of offsets which triggers read, by their causes",
+	}, []string{LabelTrigger, LabelEventbus, LabelEventlog, LabelCause})
+
+	TriggerPausedEventlogGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: moduleOfTriggerWorker,
+		Name:      "paused_eventlog_number",
+		Help:      "The number of eventlogs which triggers stop reading because of gaps in strict mode",
+	}, []string{LabelTrigger, LabelEventbus})
+
 	TriggerFilterCostSecond = prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Namespace: namespace,
 		Subsystem: moduleOfTriggerWorker,
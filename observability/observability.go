@@ -21,6 +21,7 @@ import (
 
 	"github.com/linkall-labs/vanus/observability/log"
 	"github.com/linkall-labs/vanus/observability/tracing"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
@@ -30,7 +31,9 @@ func Initialize(cfg Config, metricsFunc func()) error {
 			metricsFunc()
 		}
 		go func() {
-			http.Handle("/metrics", promhttp.Handler())
+			// OpenMetrics is required to expose exemplars which link metrics to traces.
+			http.Handle("/metrics", promhttp.InstrumentMetricHandler(prometheus.DefaultRegisterer,
+				promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true})))
 			if err := http.ListenAndServe(fmt.Sprintf(":%d", cfg.M.GetPort()), nil); err != nil {
 				log.Error(context.Background(), "Metrics listen and serve failed.", map[string]interface{}{
 					log.KeyError: err,
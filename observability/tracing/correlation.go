@@ -0,0 +1,91 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/linkall-labs/vanus/observability/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// CorrelationHeader is the HTTP header or gRPC metadata key which carries the correlation ID of a request.
+	CorrelationHeader = "x-vanus-correlation-id"
+
+	attributeCorrelationID = "vanus.correlation_id"
+	maxCorrelationIDLength = 64
+)
+
+// NewCorrelationID returns a random ID for a request entering Vanus.
+func NewCorrelationID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// CorrelationIDFromIncoming returns the correlation ID forwarded by the caller, or an empty string.
+func CorrelationIDFromIncoming(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if v := md.Get(CorrelationHeader); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func withOutgoingCorrelationID(ctx context.Context) context.Context {
+	if id := log.CorrelationID(ctx); id != "" {
+		return metadata.AppendToOutgoingContext(ctx, CorrelationHeader, id)
+	}
+	return ctx
+}
+
+// CorrelationUnaryClientInterceptor forwards the correlation ID carried by the context to the callee.
+func CorrelationUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{},
+		cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		return invoker(withOutgoingCorrelationID(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// CorrelationStreamClientInterceptor forwards the correlation ID carried by the context to the callee.
+func CorrelationStreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		return streamer(withOutgoingCorrelationID(ctx), desc, cc, method, opts...)
+	}
+}
+
+// CorrelationIDOrNew returns id if it's a valid correlation ID given by the caller, otherwise a new one, so
+// that an ID chosen by the user can be used to find the request, but an arbitrary value isn't logged.
+func CorrelationIDOrNew(id string) string {
+	if id == "" || len(id) > maxCorrelationIDLength {
+		return NewCorrelationID()
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.') {
+			return NewCorrelationID()
+		}
+	}
+	return id
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	OperationPublish = "publish"
+	OperationAppend  = "append"
+	OperationDeliver = "deliver"
+
+	attributeOperation = "vanus.operation"
+	attributeEventbus  = "vanus.eventbus"
+	attributeEventlog  = "vanus.eventlog"
+	attributeBlock     = "vanus.block"
+	attributeEvents    = "vanus.events"
+	attributeBytes     = "vanus.bytes"
+)
+
+type requestMetadataKey struct{}
+
+// RequestMetadata describes a data-plane request. It's carried by context from where the request enters,
+// each layer fills what it knows, and every span started by Tracer in the request carries it as attributes.
+type RequestMetadata struct {
+	Operation string
+	Eventbus  string
+	Eventlog  string
+	Block     string
+	Events    int
+	Bytes     int
+}
+
+// WithRequestMetadata returns a copy of ctx which carries a copy of md.
+func WithRequestMetadata(ctx context.Context, md RequestMetadata) context.Context {
+	return context.WithValue(ctx, requestMetadataKey{}, md)
+}
+
+// RequestMetadataFrom returns the metadata carried by ctx.
+func RequestMetadataFrom(ctx context.Context) (RequestMetadata, bool) {
+	if ctx == nil {
+		return RequestMetadata{}, false
+	}
+	md, ok := ctx.Value(requestMetadataKey{}).(RequestMetadata)
+	return md, ok
+}
+
+// UpdateRequestMetadata returns a copy of ctx whose metadata is updated by fn, the metadata carried by ctx
+// is kept unchanged, so it's safe to be called by concurrent sub-requests. ctx is returned as is if it
+// carries no metadata.
+func UpdateRequestMetadata(ctx context.Context, fn func(md *RequestMetadata)) context.Context {
+	md, ok := RequestMetadataFrom(ctx)
+	if !ok {
+		return ctx
+	}
+	fn(&md)
+	return WithRequestMetadata(ctx, md)
+}
+
+// Attributes returns span attributes of non-empty fields.
+func (md RequestMetadata) Attributes() []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, 6)
+	if md.Operation != "" {
+		attrs = append(attrs, attribute.String(attributeOperation, md.Operation))
+	}
+	if md.Eventbus != "" {
+		attrs = append(attrs, attribute.String(attributeEventbus, md.Eventbus))
+	}
+	if md.Eventlog != "" {
+		attrs = append(attrs, attribute.String(attributeEventlog, md.Eventlog))
+	}
+	if md.Block != "" {
+		attrs = append(attrs, attribute.String(attributeBlock, md.Block))
+	}
+	if md.Events > 0 {
+		attrs = append(attrs, attribute.Int(attributeEvents, md.Events))
+	}
+	if md.Bytes > 0 {
+		attrs = append(attrs, attribute.Int(attributeBytes, md.Bytes))
+	}
+	return attrs
+}
+
+// AnnotateSpan attaches the metadata carried by ctx to the span of ctx, it's useful after the metadata
+// is updated by UpdateRequestMetadata.
+func AnnotateSpan(ctx context.Context) {
+	if md, ok := RequestMetadataFrom(ctx); ok {
+		oteltrace.SpanFromContext(ctx).SetAttributes(md.Attributes()...)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	if _, ok := RequestMetadataFrom(context.Background()); ok {
+		t.Error("a context without metadata should have none")
+	}
+	//nolint:staticcheck // a nil context is tolerated on purpose.
+	if _, ok := RequestMetadataFrom(nil); ok {
+		t.Error("a nil context should have no metadata")
+	}
+	if ctx := context.Background(); UpdateRequestMetadata(ctx, func(md *RequestMetadata) {
+		md.Events = 1
+	}) != ctx {
+		t.Error("updating a context without metadata should return it as is")
+	}
+
+	ctx := WithRequestMetadata(context.Background(), RequestMetadata{Operation: OperationAppend, Eventbus: "eb"})
+	updated := UpdateRequestMetadata(ctx, func(md *RequestMetadata) {
+		md.Eventlog = "1"
+		md.Events = 2
+	})
+	md, _ := RequestMetadataFrom(ctx)
+	if md.Eventlog != "" || md.Events != 0 {
+		t.Errorf("the metadata of the parent context should be unchanged, got %+v", md)
+	}
+	md, ok := RequestMetadataFrom(updated)
+	if !ok {
+		t.Fatal("the updated context should have metadata")
+	}
+	want := RequestMetadata{Operation: OperationAppend, Eventbus: "eb", Eventlog: "1", Events: 2}
+	if md != want {
+		t.Errorf("got metadata %+v, want %+v", md, want)
+	}
+}
+
+func TestRequestMetadata_Attributes(t *testing.T) {
+	if attrs := (RequestMetadata{}).Attributes(); len(attrs) != 0 {
+		t.Errorf("empty metadata should have no attributes, got %v", attrs)
+	}
+	md := RequestMetadata{
+		Operation: OperationPublish,
+		Eventbus:  "eb",
+		Eventlog:  "1",
+		Block:     "2",
+		Events:    3,
+		Bytes:     1024,
+	}
+	want := []attribute.KeyValue{
+		attribute.String(attributeOperation, OperationPublish),
+		attribute.String(attributeEventbus, "eb"),
+		attribute.String(attributeEventlog, "1"),
+		attribute.String(attributeBlock, "2"),
+		attribute.Int(attributeEvents, 3),
+		attribute.Int(attributeBytes, 1024),
+	}
+	attrs := md.Attributes()
+	if len(attrs) != len(want) {
+		t.Fatalf("got attributes %v, want %v", attrs, want)
+	}
+	for i := range want {
+		if attrs[i] != want[i] {
+			t.Errorf("got attribute %v, want %v", attrs[i], want[i])
+		}
+	}
+}
+
+func TestAnnotateSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(recorder))
+	ctx, span := tp.Tracer("test").Start(context.Background(), "append")
+	AnnotateSpan(ctx)
+	ctx = WithRequestMetadata(ctx, RequestMetadata{Operation: OperationAppend, Eventbus: "eb", Events: 2})
+	AnnotateSpan(ctx)
+	span.End()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(spans))
+	}
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range spans[0].Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	if len(attrs) != 3 || attrs[attributeOperation].AsString() != OperationAppend ||
+		attrs[attributeEventbus].AsString() != "eb" || attrs[attributeEvents].AsInt64() != 2 {
+		t.Errorf("the span should be annotated with the metadata, got %v", attrs)
+	}
+}
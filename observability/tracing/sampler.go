@@ -0,0 +1,266 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	attributeSampled = "vanus.sampled"
+
+	traceParentHeader = "traceparent"
+	defaultSampleRate = 1.0
+)
+
+type SamplingConfig struct {
+	// DefaultRate is the fraction of traces sampled for eventbuses without their own rate, all traces are
+	// sampled if it's nil.
+	DefaultRate *float64           `yaml:"default_rate"`
+	Eventbus    map[string]float64 `yaml:"eventbus"`
+	// ListenAddr serves SamplingHandler at /tracing/sampling, e.g. 127.0.0.1:2113. Rates can't be changed at
+	// runtime if it's empty. The endpoint isn't authenticated, so bind it to the loopback or a network only
+	// operators can reach.
+	ListenAddr string `yaml:"listen_addr"`
+}
+
+func (c SamplingConfig) GetDefaultRate() float64 {
+	if c.DefaultRate == nil {
+		return defaultSampleRate
+	}
+	return *c.DefaultRate
+}
+
+// eventbusSampler samples root spans by the rate of eventbus which the request belongs to. Rates can be
+// changed at runtime, which take effect on traces started afterwards.
+type eventbusSampler struct {
+	mu          sync.RWMutex
+	defaultRate float64
+	rates       map[string]float64
+	samplers    map[float64]trace.Sampler
+}
+
+func newEventbusSampler(cfg SamplingConfig) (*eventbusSampler, error) {
+	s := &eventbusSampler{
+		rates:    make(map[string]float64, len(cfg.Eventbus)),
+		samplers: make(map[float64]trace.Sampler),
+	}
+	if err := s.setDefaultRate(cfg.GetDefaultRate()); err != nil {
+		return nil, err
+	}
+	for eventbus, rate := range cfg.Eventbus {
+		if err := s.setRate(eventbus, rate); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *eventbusSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	eventbus := ""
+	for _, attr := range p.Attributes {
+		if attr.Key == attributeEventbus {
+			eventbus = attr.Value.AsString()
+			break
+		}
+	}
+	res := s.samplerOf(eventbus).ShouldSample(p)
+	if res.Decision == trace.RecordAndSample {
+		res.Attributes = append(res.Attributes, attribute.Bool(attributeSampled, true))
+	}
+	return res
+}
+
+func (s *eventbusSampler) Description() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fmt.Sprintf("EventbusSampler{default:%g,eventbus:%d}", s.defaultRate, len(s.rates))
+}
+
+func (s *eventbusSampler) samplerOf(eventbus string) trace.Sampler {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rate, ok := s.rates[eventbus]
+	if !ok {
+		rate = s.defaultRate
+	}
+	return s.samplers[rate]
+}
+
+func (s *eventbusSampler) setDefaultRate(rate float64) error {
+	if err := checkRate(rate); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.defaultRate = rate
+	s.ensureSampler(rate)
+	return nil
+}
+
+func (s *eventbusSampler) setRate(eventbus string, rate float64) error {
+	if err := checkRate(rate); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rates[eventbus] = rate
+	s.ensureSampler(rate)
+	return nil
+}
+
+func (s *eventbusSampler) resetRate(eventbus string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.rates, eventbus)
+}
+
+// ensureSampler creates the sampler of rate if it doesn't exist, the caller must hold the lock.
+func (s *eventbusSampler) ensureSampler(rate float64) {
+	if _, ok := s.samplers[rate]; !ok {
+		s.samplers[rate] = trace.TraceIDRatioBased(rate)
+	}
+}
+
+func (s *eventbusSampler) snapshot() (float64, map[string]float64) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rates := make(map[string]float64, len(s.rates))
+	for eventbus, rate := range s.rates {
+		rates[eventbus] = rate
+	}
+	return s.defaultRate, rates
+}
+
+func checkRate(rate float64) error {
+	if rate < 0 || rate > 1 {
+		return fmt.Errorf("invalid sample rate %g, it must be in [0, 1]", rate)
+	}
+	return nil
+}
+
+var sampler *eventbusSampler
+
+// SetSampleRate changes the sample rate of eventbus at runtime, the default rate is changed if eventbus
+// is empty.
+func SetSampleRate(eventbus string, rate float64) error {
+	if sampler == nil {
+		return fmt.Errorf("tracing isn't enabled")
+	}
+	if eventbus == "" {
+		return sampler.setDefaultRate(rate)
+	}
+	return sampler.setRate(eventbus, rate)
+}
+
+// ResetSampleRate makes eventbus sampled by the default rate.
+func ResetSampleRate(eventbus string) {
+	if sampler != nil {
+		sampler.resetRate(eventbus)
+	}
+}
+
+// SampleRates returns the default rate and rates of eventbuses.
+func SampleRates() (float64, map[string]float64) {
+	if sampler == nil {
+		return 0, map[string]float64{}
+	}
+	return sampler.snapshot()
+}
+
+// serveSampling serves SamplingHandler on its own listener rather than the one of metrics, which is
+// usually exposed to scrapers.
+func serveSampling(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/tracing/sampling", SamplingHandler())
+	srv := &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.ListenAndServe(); err != nil {
+			log.Error(context.Background(), "the listener of tracing sampling failed", map[string]interface{}{
+				log.KeyError: err,
+				"addr":       addr,
+			})
+		}
+	}()
+}
+
+// SamplingHandler serves sample rates. GET returns rates, PUT sets the rate of the eventbus in query, or the
+// default rate if no eventbus is given, and DELETE resets the rate of the eventbus to the default.
+func SamplingHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		eventbus := r.URL.Query().Get("eventbus")
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			rate, err := strconv.ParseFloat(r.URL.Query().Get("rate"), 64)
+			if err == nil {
+				err = SetSampleRate(eventbus, rate)
+			}
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		case http.MethodDelete:
+			if eventbus == "" {
+				http.Error(w, "eventbus is required", http.StatusBadRequest)
+				return
+			}
+			ResetSampleRate(eventbus)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		defaultRate, rates := SampleRates()
+		data, _ := json.Marshal(map[string]interface{}{
+			"default_rate": defaultRate,
+			"eventbus":     rates,
+		})
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	})
+}
+
+// InjectTraceParent returns the W3C traceparent of the span of ctx if it's sampled, so that the trace can
+// be continued where the event is delivered. It returns empty if the span isn't sampled.
+func InjectTraceParent(ctx context.Context) string {
+	if !oteltrace.SpanContextFromContext(ctx).IsSampled() {
+		return ""
+	}
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(ctx, carrier)
+	return carrier.Get(traceParentHeader)
+}
+
+// ExtractTraceParent returns a copy of ctx which carries the remote span of traceparent as parent.
+func ExtractTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	carrier := propagation.MapCarrier{traceParentHeader: traceParent}
+	return propagation.TraceContext{}.Extract(ctx, carrier)
+}
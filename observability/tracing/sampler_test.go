@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func sampled(s trace.Sampler, eventbus string) bool {
+	p := trace.SamplingParameters{
+		TraceID: oteltrace.TraceID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+		Name:    "test",
+	}
+	if eventbus != "" {
+		p.Attributes = []attribute.KeyValue{attribute.String(attributeEventbus, eventbus)}
+	}
+	return s.ShouldSample(p).Decision == trace.RecordAndSample
+}
+
+func TestEventbusSampler(t *testing.T) {
+	zero := 0.0
+	s, err := newEventbusSampler(SamplingConfig{DefaultRate: &zero, Eventbus: map[string]float64{"eb1": 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sampled(s, "eb1") {
+		t.Error("eb1 should be sampled by its own rate")
+	}
+	if sampled(s, "eb2") || sampled(s, "") {
+		t.Error("eventbuses without rates should be sampled by the default rate")
+	}
+
+	if err = s.setRate("eb2", 1); err != nil {
+		t.Fatal(err)
+	}
+	if !sampled(s, "eb2") {
+		t.Error("the rate changed at runtime should take effect")
+	}
+	s.resetRate("eb2")
+	if sampled(s, "eb2") {
+		t.Error("the reset eventbus should be sampled by the default rate")
+	}
+	if err = s.setDefaultRate(1); err != nil {
+		t.Fatal(err)
+	}
+	if !sampled(s, "eb2") {
+		t.Error("the default rate changed at runtime should take effect")
+	}
+
+	if err = s.setRate("eb1", 1.5); err == nil {
+		t.Error("rates out of [0, 1] should be rejected")
+	}
+	invalid := -1.0
+	if _, err = newEventbusSampler(SamplingConfig{DefaultRate: &invalid}); err == nil {
+		t.Error("invalid default rates should be rejected")
+	}
+	defaultRate, rates := s.snapshot()
+	if defaultRate != 1 || len(rates) != 1 || rates["eb1"] != 1 {
+		t.Errorf("unexpected rates: %v %v", defaultRate, rates)
+	}
+}
+
+func TestSamplingHandler(t *testing.T) {
+	h := SamplingHandler()
+	serve := func(method, query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(method, "/tracing/sampling?"+query, nil))
+		return w
+	}
+
+	sampler = nil
+	if w := serve(http.MethodPut, "rate=0.5"); w.Code != http.StatusBadRequest {
+		t.Errorf("rates can't be set if tracing isn't enabled, got %d", w.Code)
+	}
+
+	s, err := newEventbusSampler(SamplingConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sampler = s
+	defer func() {
+		sampler = nil
+	}()
+	if w := serve(http.MethodPut, "eventbus=eb1&rate=0.5"); w.Code != http.StatusOK {
+		t.Fatalf("set the rate of eb1 failed: %d %s", w.Code, w.Body.String())
+	}
+	for _, query := range []string{"eventbus=eb1&rate=2", "eventbus=eb1&rate=x"} {
+		if w := serve(http.MethodPut, query); w.Code != http.StatusBadRequest {
+			t.Errorf("%s should be rejected, got %d", query, w.Code)
+		}
+	}
+	w := serve(http.MethodGet, "")
+	body := struct {
+		DefaultRate float64            `json:"default_rate"`
+		Eventbus    map[string]float64 `json:"eventbus"`
+	}{}
+	if err = json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.DefaultRate != 1 || body.Eventbus["eb1"] != 0.5 {
+		t.Errorf("unexpected rates: %s", w.Body.String())
+	}
+
+	if w = serve(http.MethodDelete, ""); w.Code != http.StatusBadRequest {
+		t.Errorf("delete without eventbus should be rejected, got %d", w.Code)
+	}
+	if w = serve(http.MethodDelete, "eventbus=eb1"); w.Code != http.StatusOK {
+		t.Errorf("reset the rate of eb1 failed: %d", w.Code)
+	}
+	if _, rates := SampleRates(); len(rates) != 0 {
+		t.Errorf("eb1 should be reset, got %v", rates)
+	}
+	if w = serve(http.MethodPost, ""); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("post should be rejected, got %d", w.Code)
+	}
+}
@@ -40,9 +40,10 @@ const (
 )
 
 type Config struct {
-	ServerName    string `yaml:"-"`
-	Enable        bool   `yaml:"enable"`
-	OtelCollector string `yaml:"otel_collector"`
+	ServerName    string         `yaml:"-"`
+	Enable        bool           `yaml:"enable"`
+	OtelCollector string         `yaml:"otel_collector"`
+	Sampling      SamplingConfig `yaml:"sampling"`
 }
 
 var tp *tracerProvider
@@ -57,11 +58,19 @@ func Init(cfg Config) {
 	}
 	if cfg.Enable {
 		if cfg.OtelCollector != "" {
-			provider, err := newTracerProvider(p.serverName, cfg.OtelCollector)
+			s, err := newEventbusSampler(cfg.Sampling)
+			if err != nil {
+				panic("init tracer error: " + err.Error())
+			}
+			provider, err := newTracerProvider(p.serverName, cfg.OtelCollector, s)
 			if err != nil {
 				panic("init tracer error: " + err.Error())
 			}
 			p.p = provider
+			sampler = s
+			if cfg.Sampling.ListenAddr != "" {
+				serveSampling(cfg.Sampling.ListenAddr)
+			}
 			log.Info(context.Background(), "tracing module started, OpenTelemetry is enable", map[string]interface{}{
 				"otel_collector": cfg.OtelCollector,
 			})
@@ -101,8 +110,14 @@ func (t *Tracer) Start(ctx context.Context, methodName string, opts ...oteltrace
 	if t == nil {
 		return ctx, emptySpan("test")
 	}
-	return t.tracer.Start(ctx, strings.Join([]string{t.moduleName, methodName}, "/"),
-		append(opts, oteltrace.WithSpanKind(t.kind))...)
+	opts = append(opts, oteltrace.WithSpanKind(t.kind))
+	if md, ok := RequestMetadataFrom(ctx); ok {
+		opts = append(opts, oteltrace.WithAttributes(md.Attributes()...))
+	}
+	if id := log.CorrelationID(ctx); id != "" {
+		opts = append(opts, oteltrace.WithAttributes(attribute.String(attributeCorrelationID, id)))
+	}
+	return t.tracer.Start(ctx, strings.Join([]string{t.moduleName, methodName}, "/"), opts...)
 }
 
 func NewTracer(moduleName string, kind oteltrace.SpanKind) *Tracer {
@@ -121,7 +136,9 @@ func NewTracer(moduleName string, kind oteltrace.SpanKind) *Tracer {
 	}
 }
 
-func newTracerProvider(serviceName string, collectorEndpoint string) (*trace.TracerProvider, error) {
+func newTracerProvider(
+	serviceName string, collectorEndpoint string, sampler trace.Sampler,
+) (*trace.TracerProvider, error) {
 	ctx := context.Background()
 	res, err := resource.New(ctx, resource.WithContainer())
 	if err != nil {
@@ -164,7 +181,8 @@ func newTracerProvider(serviceName string, collectorEndpoint string) (*trace.Tra
 	// span processor to aggregate spans before export.
 	bsp := trace.NewBatchSpanProcessor(traceExporter)
 	tracerProvider := trace.NewTracerProvider(
-		trace.WithSampler(trace.AlwaysSample()),
+		// Spans of a sampled trace are all sampled, so that a trace is either complete or absent.
+		trace.WithSampler(trace.ParentBased(sampler)),
 		trace.WithResource(res),
 		trace.WithSpanProcessor(bsp),
 	)
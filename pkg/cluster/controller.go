@@ -47,6 +47,11 @@ type Cluster interface {
 	EventlogService() EventlogService
 	TriggerService() TriggerService
 	IDService() IDService
+	JobService() JobService
+	FeatureGateService() FeatureGateService
+	UsageService() UsageService
+	// ServerTime returns the clock of the controller, which is the reference clock of the cluster.
+	ServerTime(ctx context.Context) (time.Time, error)
 }
 
 type EventbusService interface {
@@ -69,6 +74,18 @@ type IDService interface {
 	RawClient() ctrlpb.SnowflakeControllerClient
 }
 
+type JobService interface {
+	RawClient() ctrlpb.JobControllerClient
+}
+
+type FeatureGateService interface {
+	RawClient() ctrlpb.FeatureGateControllerClient
+}
+
+type UsageService interface {
+	RawClient() ctrlpb.UsageControllerClient
+}
+
 type SegmentService interface {
 	RegisterHeartbeat(ctx context.Context, interval time.Duration, reqFunc func() interface{}) error
 	RawClient() ctrlpb.SegmentControllerClient
@@ -93,6 +110,9 @@ func NewClusterController(endpoints []string, credentials credentials.TransportC
 			elSvc:             newEventlogService(cc),
 			triggerSvc:        newTriggerService(cc),
 			idSvc:             newIDService(cc),
+			jobSvc:            newJobService(cc),
+			featureGateSvc:    newFeatureGateService(cc),
+			usageSvc:          newUsageService(cc),
 			ping:              raw_client.NewPingClient(cc),
 			controllerAddress: endpoints,
 		}
@@ -107,6 +127,9 @@ type cluster struct {
 	elSvc             EventlogService
 	triggerSvc        TriggerService
 	idSvc             IDService
+	jobSvc            JobService
+	featureGateSvc    FeatureGateService
+	usageSvc          UsageService
 	segmentSvc        SegmentService
 	ping              ctrlpb.PingServerClient
 }
@@ -145,6 +168,17 @@ func (c *cluster) IsReady(createEventbus bool) bool {
 	return !createEventbus || (createEventbus && res.GetIsEventbusReady())
 }
 
+func (c *cluster) ServerTime(ctx context.Context) (time.Time, error) {
+	res, err := c.ping.Ping(ctx, &emptypb.Empty{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if res.ServerTime == nil {
+		return time.Time{}, errors.New("the controller doesn't report its time")
+	}
+	return res.ServerTime.AsTime(), nil
+}
+
 func (c *cluster) Status() Topology {
 	// TODO(wenfeng)
 	return Topology{}
@@ -169,3 +203,15 @@ func (c *cluster) TriggerService() TriggerService {
 func (c *cluster) IDService() IDService {
 	return c.idSvc
 }
+
+func (c *cluster) JobService() JobService {
+	return c.jobSvc
+}
+
+func (c *cluster) FeatureGateService() FeatureGateService {
+	return c.featureGateSvc
+}
+
+func (c *cluster) UsageService() UsageService {
+	return c.usageSvc
+}
@@ -0,0 +1,18 @@
+package cluster
+
+import (
+	"github.com/linkall-labs/vanus/pkg/cluster/raw_client"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+type featureGateService struct {
+	client ctrlpb.FeatureGateControllerClient
+}
+
+func newFeatureGateService(cc *raw_client.Conn) FeatureGateService {
+	return &featureGateService{client: raw_client.NewFeatureGateClient(cc)}
+}
+
+func (fs *featureGateService) RawClient() ctrlpb.FeatureGateControllerClient {
+	return fs.client
+}
@@ -0,0 +1,18 @@
+package cluster
+
+import (
+	"github.com/linkall-labs/vanus/pkg/cluster/raw_client"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+type jobService struct {
+	client ctrlpb.JobControllerClient
+}
+
+func newJobService(cc *raw_client.Conn) JobService {
+	return &jobService{client: raw_client.NewJobClient(cc)}
+}
+
+func (js *jobService) RawClient() ctrlpb.JobControllerClient {
+	return js.client
+}
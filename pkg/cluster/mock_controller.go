@@ -64,6 +64,20 @@ func (mr *MockClusterMockRecorder) EventlogService() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventlogService", reflect.TypeOf((*MockCluster)(nil).EventlogService))
 }
 
+// FeatureGateService mocks base method.
+func (m *MockCluster) FeatureGateService() FeatureGateService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeatureGateService")
+	ret0, _ := ret[0].(FeatureGateService)
+	return ret0
+}
+
+// FeatureGateService indicates an expected call of FeatureGateService.
+func (mr *MockClusterMockRecorder) FeatureGateService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeatureGateService", reflect.TypeOf((*MockCluster)(nil).FeatureGateService))
+}
+
 // IDService mocks base method.
 func (m *MockCluster) IDService() IDService {
 	m.ctrl.T.Helper()
@@ -92,6 +106,20 @@ func (mr *MockClusterMockRecorder) IsReady(createEventbus interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsReady", reflect.TypeOf((*MockCluster)(nil).IsReady), createEventbus)
 }
 
+// JobService mocks base method.
+func (m *MockCluster) JobService() JobService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobService")
+	ret0, _ := ret[0].(JobService)
+	return ret0
+}
+
+// JobService indicates an expected call of JobService.
+func (mr *MockClusterMockRecorder) JobService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobService", reflect.TypeOf((*MockCluster)(nil).JobService))
+}
+
 // SegmentService mocks base method.
 func (m *MockCluster) SegmentService() SegmentService {
 	m.ctrl.T.Helper()
@@ -106,6 +134,21 @@ func (mr *MockClusterMockRecorder) SegmentService() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SegmentService", reflect.TypeOf((*MockCluster)(nil).SegmentService))
 }
 
+// ServerTime mocks base method.
+func (m *MockCluster) ServerTime(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServerTime", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ServerTime indicates an expected call of ServerTime.
+func (mr *MockClusterMockRecorder) ServerTime(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServerTime", reflect.TypeOf((*MockCluster)(nil).ServerTime), ctx)
+}
+
 // Status mocks base method.
 func (m *MockCluster) Status() Topology {
 	m.ctrl.T.Helper()
@@ -134,6 +177,20 @@ func (mr *MockClusterMockRecorder) TriggerService() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerService", reflect.TypeOf((*MockCluster)(nil).TriggerService))
 }
 
+// UsageService mocks base method.
+func (m *MockCluster) UsageService() UsageService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsageService")
+	ret0, _ := ret[0].(UsageService)
+	return ret0
+}
+
+// UsageService indicates an expected call of UsageService.
+func (mr *MockClusterMockRecorder) UsageService() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsageService", reflect.TypeOf((*MockCluster)(nil).UsageService))
+}
+
 // WaitForControllerReady mocks base method.
 func (m *MockCluster) WaitForControllerReady(createEventbus bool) error {
 	m.ctrl.T.Helper()
@@ -352,6 +409,117 @@ func (mr *MockIDServiceMockRecorder) RawClient() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RawClient", reflect.TypeOf((*MockIDService)(nil).RawClient))
 }
 
+// MockJobService is a mock of JobService interface.
+type MockJobService struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobServiceMockRecorder
+}
+
+// MockJobServiceMockRecorder is the mock recorder for MockJobService.
+type MockJobServiceMockRecorder struct {
+	mock *MockJobService
+}
+
+// NewMockJobService creates a new mock instance.
+func NewMockJobService(ctrl *gomock.Controller) *MockJobService {
+	mock := &MockJobService{ctrl: ctrl}
+	mock.recorder = &MockJobServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobService) EXPECT() *MockJobServiceMockRecorder {
+	return m.recorder
+}
+
+// RawClient mocks base method.
+func (m *MockJobService) RawClient() controller.JobControllerClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RawClient")
+	ret0, _ := ret[0].(controller.JobControllerClient)
+	return ret0
+}
+
+// RawClient indicates an expected call of RawClient.
+func (mr *MockJobServiceMockRecorder) RawClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RawClient", reflect.TypeOf((*MockJobService)(nil).RawClient))
+}
+
+// MockFeatureGateService is a mock of FeatureGateService interface.
+type MockFeatureGateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeatureGateServiceMockRecorder
+}
+
+// MockFeatureGateServiceMockRecorder is the mock recorder for MockFeatureGateService.
+type MockFeatureGateServiceMockRecorder struct {
+	mock *MockFeatureGateService
+}
+
+// NewMockFeatureGateService creates a new mock instance.
+func NewMockFeatureGateService(ctrl *gomock.Controller) *MockFeatureGateService {
+	mock := &MockFeatureGateService{ctrl: ctrl}
+	mock.recorder = &MockFeatureGateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeatureGateService) EXPECT() *MockFeatureGateServiceMockRecorder {
+	return m.recorder
+}
+
+// RawClient mocks base method.
+func (m *MockFeatureGateService) RawClient() controller.FeatureGateControllerClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RawClient")
+	ret0, _ := ret[0].(controller.FeatureGateControllerClient)
+	return ret0
+}
+
+// RawClient indicates an expected call of RawClient.
+func (mr *MockFeatureGateServiceMockRecorder) RawClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RawClient", reflect.TypeOf((*MockFeatureGateService)(nil).RawClient))
+}
+
+// MockUsageService is a mock of UsageService interface.
+type MockUsageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageServiceMockRecorder
+}
+
+// MockUsageServiceMockRecorder is the mock recorder for MockUsageService.
+type MockUsageServiceMockRecorder struct {
+	mock *MockUsageService
+}
+
+// NewMockUsageService creates a new mock instance.
+func NewMockUsageService(ctrl *gomock.Controller) *MockUsageService {
+	mock := &MockUsageService{ctrl: ctrl}
+	mock.recorder = &MockUsageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageService) EXPECT() *MockUsageServiceMockRecorder {
+	return m.recorder
+}
+
+// RawClient mocks base method.
+func (m *MockUsageService) RawClient() controller.UsageControllerClient {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RawClient")
+	ret0, _ := ret[0].(controller.UsageControllerClient)
+	return ret0
+}
+
+// RawClient indicates an expected call of RawClient.
+func (mr *MockUsageServiceMockRecorder) RawClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RawClient", reflect.TypeOf((*MockUsageService)(nil).RawClient))
+}
+
 // MockSegmentService is a mock of SegmentService interface.
 type MockSegmentService struct {
 	ctrl     *gomock.Controller
@@ -20,9 +20,12 @@ import (
 	"os"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/linkall-labs/vanus/observability/log"
+	"github.com/linkall-labs/vanus/observability/metrics"
+	"github.com/linkall-labs/vanus/observability/tracing"
 	"github.com/linkall-labs/vanus/pkg/errors"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"google.golang.org/grpc"
@@ -37,6 +40,21 @@ const (
 	vanusConnBypass = "VANUS_CONN_BYPASS"
 )
 
+var compressor atomic.Value
+
+// SetCompressor sets the name of the gRPC compressor for requests to controllers, e.g. gzip, it only
+// affects connections made after it. An empty name disables compression, the compressor must have been
+// registered with encoding.RegisterCompressor.
+func SetCompressor(name string) {
+	compressor.Store(name)
+}
+
+// Compressor returns the name of the gRPC compressor for requests to controllers.
+func Compressor() string {
+	name, _ := compressor.Load().(string)
+	return name
+}
+
 type Conn struct {
 	mutex        sync.Mutex
 	leader       string
@@ -93,6 +111,25 @@ func (c *Conn) invoke(ctx context.Context, method string, args, reply interface{
 	return err
 }
 
+// openStream opens a stream to the leader, the connection is renewed and the stream is opened again once if
+// the leader is unavailable. Errors after the stream is opened aren't retried, callers resume the stream if
+// they need.
+func (c *Conn) openStream(ctx context.Context, open func(conn *grpc.ClientConn) error) error {
+	conn := c.makeSureClient(ctx, false)
+	if conn == nil {
+		return errors.ErrNoControllerLeader
+	}
+	err := open(conn)
+	if isNeedRetry(err) {
+		conn = c.makeSureClient(ctx, true)
+		if conn == nil {
+			return errors.ErrNoControllerLeader
+		}
+		err = open(conn)
+	}
+	return err
+}
+
 func (c *Conn) close() error {
 	var err error
 	for ip, conn := range c.grpcConn {
@@ -170,6 +207,13 @@ func (c *Conn) getGRPCConn(ctx context.Context, addr string) *grpc.ClientConn {
 	var opts []grpc.DialOption
 	opts = append(opts, grpc.WithTransportCredentials(c.credentials))
 	opts = append(opts, grpc.WithBlock())
+	opts = append(opts, grpc.WithUnaryInterceptor(tracing.CorrelationUnaryClientInterceptor()))
+	opts = append(opts, grpc.WithStreamInterceptor(tracing.CorrelationStreamClientInterceptor()))
+	opts = append(opts, grpc.WithStatsHandler(metrics.NewTransportStatsHandler(
+		metrics.LabelValueTrafficControl, Compressor())))
+	if name := Compressor(); name != "" {
+		opts = append(opts, grpc.WithDefaultCallOptions(grpc.UseCompressor(name)))
+	}
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
 	conn, err = grpc.DialContext(ctx, addr, opts...)
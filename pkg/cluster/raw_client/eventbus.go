@@ -95,3 +95,95 @@ func (ec *eventbusClient) UpdateEventBus(ctx context.Context, in *ctrlpb.UpdateE
 	}
 	return out, nil
 }
+
+func (ec *eventbusClient) AnnotateEventBus(ctx context.Context, in *ctrlpb.AnnotateEventBusRequest, opts ...grpc.CallOption) (*metapb.EventBus, error) {
+	out := new(metapb.EventBus)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/AnnotateEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) SetEventBusDictionary(ctx context.Context, in *ctrlpb.SetEventBusDictionaryRequest, opts ...grpc.CallOption) (*metapb.EventBus, error) {
+	out := new(metapb.EventBus)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/SetEventBusDictionary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) SetEventBusRetention(ctx context.Context, in *ctrlpb.SetEventBusRetentionRequest, opts ...grpc.CallOption) (*metapb.EventBus, error) {
+	out := new(metapb.EventBus)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/SetEventBusRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ImportEventBus(ctx context.Context, in *ctrlpb.ImportEventBusRequest, opts ...grpc.CallOption) (*metapb.Job, error) {
+	out := new(metapb.Job)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ImportEventBus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) VerifyEventBusOrdering(ctx context.Context, in *ctrlpb.VerifyEventBusOrderingRequest, opts ...grpc.CallOption) (*metapb.Job, error) {
+	out := new(metapb.Job)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/VerifyEventBusOrdering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) CreateEventbusProfile(ctx context.Context, in *ctrlpb.EventbusProfile, opts ...grpc.CallOption) (*ctrlpb.EventbusProfile, error) {
+	out := new(ctrlpb.EventbusProfile)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/CreateEventbusProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) DeleteEventbusProfile(ctx context.Context, in *ctrlpb.DeleteEventbusProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/DeleteEventbusProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListEventbusProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ctrlpb.ListEventbusProfileResponse, error) {
+	out := new(ctrlpb.ListEventbusProfileResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListEventbusProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ReportPublishLatencies(ctx context.Context, in *ctrlpb.ReportLatenciesRequest,
+	opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ReportPublishLatencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (ec *eventbusClient) ListEventbusSLO(ctx context.Context, in *ctrlpb.ListSLORequest,
+	opts ...grpc.CallOption) (*ctrlpb.ListSLOResponse, error) {
+	out := new(ctrlpb.ListSLOResponse)
+	err := ec.cc.invoke(ctx, "/linkall.vanus.controller.EventBusController/ListEventbusSLO", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
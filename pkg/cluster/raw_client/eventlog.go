@@ -50,6 +50,19 @@ func (elc *eventlogClient) ListSegment(ctx context.Context,
 	return out, nil
 }
 
+func (elc *eventlogClient) StreamSegments(ctx context.Context, in *ctrlpb.StreamListRequest,
+	opts ...grpc.CallOption) (ctrlpb.EventLogController_StreamSegmentsClient, error) {
+	var stream ctrlpb.EventLogController_StreamSegmentsClient
+	err := elc.cc.openStream(ctx, func(conn *grpc.ClientConn) (err error) {
+		stream, err = ctrlpb.NewEventLogControllerClient(conn).StreamSegments(ctx, in, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
 func (elc *eventlogClient) GetAppendableSegment(ctx context.Context,
 	in *ctrlpb.GetAppendableSegmentRequest, opts ...grpc.CallOption) (*ctrlpb.GetAppendableSegmentResponse, error) {
 	out := new(ctrlpb.GetAppendableSegmentResponse)
@@ -59,3 +72,33 @@ func (elc *eventlogClient) GetAppendableSegment(ctx context.Context,
 	}
 	return out, nil
 }
+
+func (elc *eventlogClient) TruncateEventLog(ctx context.Context,
+	in *ctrlpb.TruncateEventLogRequest, opts ...grpc.CallOption) (*ctrlpb.TruncateEventLogResponse, error) {
+	out := new(ctrlpb.TruncateEventLogResponse)
+	err := elc.cc.invoke(ctx, "/linkall.vanus.controller.EventLogController/TruncateEventLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (elc *eventlogClient) GetEventlogStats(ctx context.Context,
+	in *ctrlpb.GetEventlogStatsRequest, opts ...grpc.CallOption) (*ctrlpb.GetEventlogStatsResponse, error) {
+	out := new(ctrlpb.GetEventlogStatsResponse)
+	err := elc.cc.invoke(ctx, "/linkall.vanus.controller.EventLogController/GetEventlogStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (elc *eventlogClient) PrefetchEventlogs(ctx context.Context,
+	in *ctrlpb.PrefetchEventlogsRequest, opts ...grpc.CallOption) (*ctrlpb.PrefetchEventlogsResponse, error) {
+	out := new(ctrlpb.PrefetchEventlogsResponse)
+	err := elc.cc.invoke(ctx, "/linkall.vanus.controller.EventLogController/PrefetchEventlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw_client
+
+import (
+	"context"
+	"io"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	_ io.Closer = (*featureGateClient)(nil)
+)
+
+func NewFeatureGateClient(cc *Conn) ctrlpb.FeatureGateControllerClient {
+	return &featureGateClient{
+		cc: cc,
+	}
+}
+
+type featureGateClient struct {
+	cc *Conn
+}
+
+func (fc *featureGateClient) Close() error {
+	return fc.cc.close()
+}
+
+func (fc *featureGateClient) ListFeatureGates(ctx context.Context,
+	in *emptypb.Empty, opts ...grpc.CallOption) (*ctrlpb.ListFeatureGatesResponse, error) {
+	out := new(ctrlpb.ListFeatureGatesResponse)
+	err := fc.cc.invoke(ctx, "/linkall.vanus.controller.FeatureGateController/ListFeatureGates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (fc *featureGateClient) SetFeatureGate(ctx context.Context,
+	in *ctrlpb.SetFeatureGateRequest, opts ...grpc.CallOption) (*metapb.FeatureGate, error) {
+	out := new(metapb.FeatureGate)
+	err := fc.cc.invoke(ctx, "/linkall.vanus.controller.FeatureGateController/SetFeatureGate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw_client
+
+import (
+	"context"
+	"io"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"google.golang.org/grpc"
+)
+
+var (
+	_ io.Closer = (*jobClient)(nil)
+)
+
+func NewJobClient(cc *Conn) ctrlpb.JobControllerClient {
+	return &jobClient{
+		cc: cc,
+	}
+}
+
+type jobClient struct {
+	cc *Conn
+}
+
+func (jc *jobClient) Close() error {
+	return jc.cc.close()
+}
+
+func (jc *jobClient) ListJob(ctx context.Context,
+	in *ctrlpb.ListJobRequest, opts ...grpc.CallOption) (*ctrlpb.ListJobResponse, error) {
+	out := new(ctrlpb.ListJobResponse)
+	err := jc.cc.invoke(ctx, "/linkall.vanus.controller.JobController/ListJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (jc *jobClient) GetJob(ctx context.Context,
+	in *ctrlpb.GetJobRequest, opts ...grpc.CallOption) (*metapb.Job, error) {
+	out := new(metapb.Job)
+	err := jc.cc.invoke(ctx, "/linkall.vanus.controller.JobController/GetJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (jc *jobClient) CancelJob(ctx context.Context,
+	in *ctrlpb.CancelJobRequest, opts ...grpc.CallOption) (*metapb.Job, error) {
+	out := new(metapb.Job)
+	err := jc.cc.invoke(ctx, "/linkall.vanus.controller.JobController/CancelJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -152,6 +152,49 @@ func (tc *triggerClient) ListSubscription(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
+func (tc *triggerClient) StreamSubscriptions(ctx context.Context, in *ctrlpb.StreamListRequest,
+	opts ...grpc.CallOption) (ctrlpb.TriggerController_StreamSubscriptionsClient, error) {
+	var stream ctrlpb.TriggerController_StreamSubscriptionsClient
+	err := tc.cc.openStream(ctx, func(conn *grpc.ClientConn) (err error) {
+		stream, err = ctrlpb.NewTriggerControllerClient(conn).StreamSubscriptions(ctx, in, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (tc *triggerClient) ListTriggerWorker(ctx context.Context, in *emptypb.Empty,
+	opts ...grpc.CallOption) (*ctrlpb.ListTriggerWorkerResponse, error) {
+	out := new(ctrlpb.ListTriggerWorkerResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListTriggerWorker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) PlanRebalance(ctx context.Context, in *ctrlpb.PlanRebalanceRequest,
+	opts ...grpc.CallOption) (*ctrlpb.RebalancePlan, error) {
+	out := new(ctrlpb.RebalancePlan)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/PlanRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ApproveRebalance(ctx context.Context, in *ctrlpb.ApproveRebalanceRequest,
+	opts ...grpc.CallOption) (*ctrlpb.RebalancePlan, error) {
+	out := new(ctrlpb.RebalancePlan)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ApproveRebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (tc *triggerClient) RegisterTriggerWorker(ctx context.Context, in *ctrlpb.RegisterTriggerWorkerRequest,
 	opts ...grpc.CallOption) (*ctrlpb.RegisterTriggerWorkerResponse, error) {
 	out := new(ctrlpb.RegisterTriggerWorkerResponse)
@@ -192,6 +235,46 @@ func (tc *triggerClient) CommitOffset(ctx context.Context, in *ctrlpb.CommitOffs
 	return out, nil
 }
 
+func (tc *triggerClient) ExportOffsets(ctx context.Context, in *ctrlpb.ExportOffsetsRequest,
+	opts ...grpc.CallOption) (*ctrlpb.ExportOffsetsResponse, error) {
+	out := new(ctrlpb.ExportOffsetsResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ExportOffsets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ImportOffsets(ctx context.Context, in *ctrlpb.ImportOffsetsRequest,
+	opts ...grpc.CallOption) (*ctrlpb.ImportOffsetsResponse, error) {
+	out := new(ctrlpb.ImportOffsetsResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ImportOffsets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ListNamespaceUsage(ctx context.Context, in *ctrlpb.ListNamespaceUsageRequest,
+	opts ...grpc.CallOption) (*ctrlpb.ListNamespaceUsageResponse, error) {
+	out := new(ctrlpb.ListNamespaceUsageResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListNamespaceUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) ListSubscriptionSLO(ctx context.Context, in *ctrlpb.ListSLORequest,
+	opts ...grpc.CallOption) (*ctrlpb.ListSLOResponse, error) {
+	out := new(ctrlpb.ListSLOResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ListSubscriptionSLO", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (tc *triggerClient) DisableSubscription(ctx context.Context, in *ctrlpb.DisableSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/DisableSubscription", in, out, opts...)
@@ -210,6 +293,33 @@ func (tc *triggerClient) ResumeSubscription(ctx context.Context, in *ctrlpb.Resu
 	return out, nil
 }
 
+func (tc *triggerClient) ResumeEventlogs(ctx context.Context, in *ctrlpb.ResumeEventlogsRequest, opts ...grpc.CallOption) (*ctrlpb.ResumeEventlogsResponse, error) {
+	out := new(ctrlpb.ResumeEventlogsResponse)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/ResumeEventlogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) AnnotateSubscription(ctx context.Context, in *ctrlpb.AnnotateSubscriptionRequest, opts ...grpc.CallOption) (*metapb.Subscription, error) {
+	out := new(metapb.Subscription)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/AnnotateSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (tc *triggerClient) RotateSigningKey(ctx context.Context, in *ctrlpb.RotateSigningKeyRequest, opts ...grpc.CallOption) (*metapb.Subscription, error) {
+	out := new(metapb.Subscription)
+	err := tc.cc.invoke(ctx, "/linkall.vanus.controller.TriggerController/RotateSigningKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (tc *triggerClient) TriggerWorkerHeartbeat(_ context.Context,
 	_ ...grpc.CallOption) (ctrlpb.TriggerController_TriggerWorkerHeartbeatClient, error) {
 	panic("unsupported method, please use controller.RegisterHeartbeat")
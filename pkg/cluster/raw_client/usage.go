@@ -0,0 +1,51 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw_client
+
+import (
+	"context"
+	"io"
+
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"google.golang.org/grpc"
+)
+
+var (
+	_ io.Closer = (*usageClient)(nil)
+)
+
+func NewUsageClient(cc *Conn) ctrlpb.UsageControllerClient {
+	return &usageClient{
+		cc: cc,
+	}
+}
+
+type usageClient struct {
+	cc *Conn
+}
+
+func (uc *usageClient) Close() error {
+	return uc.cc.close()
+}
+
+func (uc *usageClient) ListTopUsage(ctx context.Context,
+	in *ctrlpb.ListTopUsageRequest, opts ...grpc.CallOption) (*ctrlpb.ListTopUsageResponse, error) {
+	out := new(ctrlpb.ListTopUsageResponse)
+	err := uc.cc.invoke(ctx, "/linkall.vanus.controller.UsageController/ListTopUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
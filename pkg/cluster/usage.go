@@ -0,0 +1,18 @@
+package cluster
+
+import (
+	"github.com/linkall-labs/vanus/pkg/cluster/raw_client"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+)
+
+type usageService struct {
+	client ctrlpb.UsageControllerClient
+}
+
+func newUsageService(cc *raw_client.Conn) UsageService {
+	return &usageService{client: raw_client.NewUsageClient(cc)}
+}
+
+func (us *usageService) RawClient() ctrlpb.UsageControllerClient {
+	return us.client
+}
@@ -17,6 +17,7 @@ package errors
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 )
 
 func New(desc string) *ErrorType {
@@ -34,9 +35,13 @@ func Convert(str string) (*ErrorType, bool) {
 }
 
 type ErrorType struct {
-	Description    string    `json:"description"`
-	Message        string    `json:"message"`
-	Code           ErrorCode `json:"code"`
+	Description string    `json:"description"`
+	Message     string    `json:"message"`
+	Code        ErrorCode `json:"code"`
+	// RetryAfterMs hints how long the request should wait before being retried, e.g. it's throttled.
+	RetryAfterMs int64 `json:"retry_after_ms,omitempty"`
+	// CorrelationID identifies the request which failed, it's used to find logs and spans of the request.
+	CorrelationID  string `json:"correlation_id,omitempty"`
 	underlayErrors []error
 }
 
@@ -55,6 +60,23 @@ func (e *ErrorType) WithMessage(str string) *ErrorType {
 	return _e
 }
 
+// WithRetryAfter hints the caller to retry the request after d.
+func (e *ErrorType) WithRetryAfter(d time.Duration) *ErrorType {
+	_e := e.copy()
+	_e.RetryAfterMs = d.Milliseconds()
+	if _e.RetryAfterMs == 0 && d > 0 {
+		_e.RetryAfterMs = 1
+	}
+	return _e
+}
+
+// WithCorrelationID attaches the correlation ID of the request which failed.
+func (e *ErrorType) WithCorrelationID(id string) *ErrorType {
+	_e := e.copy()
+	_e.CorrelationID = id
+	return _e
+}
+
 // Wrap the other error as the underlay errors of this error. sometimes we return an error because
 // of another error(named underlay error). So, we should add the underlay error to this error's context.
 // By this, the people can understand why this error they received
@@ -85,6 +107,8 @@ func (e *ErrorType) copy() *ErrorType {
 		Description:    e.Description,
 		Message:        e.Message,
 		Code:           e.Code,
+		RetryAfterMs:   e.RetryAfterMs,
+		CorrelationID:  e.CorrelationID,
 		underlayErrors: errs,
 	}
 }
@@ -95,6 +119,12 @@ func (e ErrorType) Error() string {
 	if e.Message != "" {
 		str = fmt.Sprintf("%s, \"message\": \"%s\"", str, e.Message)
 	}
+	if e.RetryAfterMs != 0 {
+		str = fmt.Sprintf("%s, \"retry_after_ms\": %d", str, e.RetryAfterMs)
+	}
+	if e.CorrelationID != "" {
+		str = fmt.Sprintf("%s, \"correlation_id\": \"%s\"", str, e.CorrelationID)
+	}
 
 	for idx := range e.underlayErrors {
 		v := e.underlayErrors[idx]
@@ -37,6 +37,7 @@ const (
 	ErrorCode_SEGMENT_SERVER_HAS_BEEN_ADDED ErrorCode = 9201
 	ErrorCode_SERVICE_STATE_ERROR           ErrorCode = 9202
 	ErrorCode_WORKER_NOT_RUNNING            ErrorCode = 9203
+	ErrorCode_SEGMENT_SERVER_MISMATCH       ErrorCode = 9204
 
 	// ErrorCode_RESOURCE_EXIST 93xx
 	ErrorCode_RESOURCE_EXIST ErrorCode = 9300
@@ -75,6 +76,10 @@ const (
 	ErrorCode_TRY_AGAIN               ErrorCode = 9608
 	ErrorCode_NO_ENDPOINT             ErrorCode = 9609
 	ErrorCode_CLOSED                  ErrorCode = 9610
+	ErrorCode_WRITE_LEASE_EXPIRED     ErrorCode = 9611
+	ErrorCode_BLOCK_PENDING_DELETION  ErrorCode = 9612
+	ErrorCode_BLOCK_CLOSING           ErrorCode = 9613
+	ErrorCode_BLOCK_THROTTLED         ErrorCode = 9614
 
 	// ErrorCode_NOT_LEADER 97xx
 	ErrorCode_NOT_LEADER           ErrorCode = 9700
@@ -86,6 +91,10 @@ const (
 	// ErrorCode_OTHERS 99xx
 	ErrorCode_RESOURCE_EXHAUSTED  ErrorCode = 9901
 	ErrorCode_RESOURCE_CAN_NOT_OP ErrorCode = 9902
+	ErrorCode_CANCELED            ErrorCode = 9903
+	ErrorCode_DEADLINE_EXCEEDED   ErrorCode = 9904
+	ErrorCode_UNAUTHENTICATED     ErrorCode = 9905
+	ErrorCode_PERMISSION_DENIED   ErrorCode = 9906
 )
 
 var (
@@ -104,6 +113,7 @@ var (
 	ErrSegmentServerHasBeenAdded = New("the segment server has been added").WithGRPCCode(ErrorCode_SEGMENT_SERVER_HAS_BEEN_ADDED)
 	ErrServiceState              = New("service state error").WithGRPCCode(ErrorCode_SERVICE_STATE_ERROR)
 	ErrWorkerNotStart            = New("worker not start").WithGRPCCode(ErrorCode_WORKER_NOT_RUNNING)
+	ErrSegmentServerMismatch     = New("the segment server doesn't match the volume").WithGRPCCode(ErrorCode_SEGMENT_SERVER_MISMATCH)
 
 	// SEGMENT_FULL
 	ErrSegmentFull           = New("segment full").WithGRPCCode(ErrorCode_SEGMENT_FULL)
@@ -117,6 +127,10 @@ var (
 	ErrTryAgain              = New("try again").WithGRPCCode(ErrorCode_TRY_AGAIN)
 	ErrNoEndpoint            = New("no endpoint").WithGRPCCode(ErrorCode_NO_ENDPOINT)
 	ErrClosed                = New("closed").WithGRPCCode(ErrorCode_CLOSED)
+	ErrWriteLeaseExpired     = New("write lease expired").WithGRPCCode(ErrorCode_WRITE_LEASE_EXPIRED)
+	ErrBlockPendingDeletion  = New("block pending deletion").WithGRPCCode(ErrorCode_BLOCK_PENDING_DELETION)
+	ErrBlockClosing          = New("block closing").WithGRPCCode(ErrorCode_BLOCK_CLOSING)
+	ErrThrottled             = New("append throttled").WithGRPCCode(ErrorCode_BLOCK_THROTTLED)
 
 	// INTERNAL
 	ErrInternal               = New("internal error").WithGRPCCode(ErrorCode_INTERNAL)
@@ -154,9 +168,23 @@ var (
 
 	// RESOURCE_EXHAUSTED
 	ErrNoAvailableEventLog = New("no eventlog available").WithGRPCCode(ErrorCode_RESOURCE_EXHAUSTED)
+	ErrTooManyRequests     = New("too many requests").WithGRPCCode(ErrorCode_RESOURCE_EXHAUSTED)
+	ErrQuotaExceeded       = New("quota exceeded").WithGRPCCode(ErrorCode_RESOURCE_EXHAUSTED)
 
 	// NO_MORE_MESSAGE
 
 	// RESOURCE_CAN_NOT_OP
 	ErrResourceCanNotOp = New("resource can not operation").WithGRPCCode(ErrorCode_RESOURCE_CAN_NOT_OP)
+
+	// CANCELED
+	ErrCanceled = New("request canceled").WithGRPCCode(ErrorCode_CANCELED)
+
+	// DEADLINE_EXCEEDED
+	ErrDeadlineExceeded = New("request deadline exceeded").WithGRPCCode(ErrorCode_DEADLINE_EXCEEDED)
+
+	// UNAUTHENTICATED
+	ErrUnauthenticated = New("unauthenticated").WithGRPCCode(ErrorCode_UNAUTHENTICATED)
+
+	// PERMISSION_DENIED
+	ErrPermissionDenied = New("permission denied").WithGRPCCode(ErrorCode_PERMISSION_DENIED)
 )
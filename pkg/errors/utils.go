@@ -16,6 +16,7 @@ package errors
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/status"
@@ -68,6 +69,54 @@ func Is(err error, target error) bool {
 	return errType != nil && errType.Code == targetType.Code
 }
 
+// RetryAfter returns the retry hint carried by err, which may be received from a gRPC call. It's 0 if
+// there is no hint.
+func RetryAfter(err error) time.Duration {
+	errType, ok := err.(*ErrorType)
+	if !ok {
+		errStatus, ok := status.FromError(err)
+		if !ok {
+			return 0
+		}
+		if errType, ok = Convert(errStatus.Message()); !ok {
+			return 0
+		}
+	}
+	return time.Duration(errType.RetryAfterMs) * time.Millisecond
+}
+
+// CorrelationID returns the correlation ID carried by err, which may be received from a gRPC call. It's
+// empty if there is none.
+func CorrelationID(err error) string {
+	errType, ok := err.(*ErrorType)
+	if !ok {
+		errStatus, ok := status.FromError(err)
+		if !ok {
+			return ""
+		}
+		if errType, ok = Convert(errStatus.Message()); !ok {
+			return ""
+		}
+	}
+	return errType.CorrelationID
+}
+
+// CodeOf returns the code of err, which may be received from a gRPC call. It's ErrorCode_UNKNOWN if err
+// doesn't carry a code.
+func CodeOf(err error) ErrorCode {
+	errType, ok := err.(*ErrorType)
+	if !ok {
+		errStatus, ok := status.FromError(err)
+		if !ok {
+			return ErrorCode_UNKNOWN
+		}
+		if errType, ok = Convert(errStatus.Message()); !ok {
+			return ErrorCode_UNKNOWN
+		}
+	}
+	return errType.Code
+}
+
 // ConvertToGRPCError convert an internal error to an exported error defined in gRPC.
 func ConvertToGRPCError(err error) error {
 	if err == nil {
@@ -75,8 +124,14 @@ func ConvertToGRPCError(err error) error {
 	}
 	e, ok := err.(*ErrorType)
 	if ok {
-		return fmt.Errorf("{\"code\":%d,\"message\":\"%s\"}",
-			e.Code, e.Message)
+		str := fmt.Sprintf("{\"code\":%d,\"message\":\"%s\"", e.Code, e.Message)
+		if e.RetryAfterMs != 0 {
+			str = fmt.Sprintf("%s,\"retry_after_ms\":%d", str, e.RetryAfterMs)
+		}
+		if e.CorrelationID != "" {
+			str = fmt.Sprintf("%s,\"correlation_id\":\"%s\"", str, e.CorrelationID)
+		}
+		return fmt.Errorf("%s}", str)
 	}
 	return fmt.Errorf("{\"code\":%d,\"message\":\"%s\"}",
 		ErrorCode_UNKNOWN, err.Error())
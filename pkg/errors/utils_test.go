@@ -17,8 +17,11 @@ package errors
 import (
 	"errors"
 	"testing"
+	"time"
 
 	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestChain(t *testing.T) {
@@ -39,3 +42,58 @@ func TestChain(t *testing.T) {
 		So(errors.Unwrap(err).Error(), ShouldResemble, "err4: err3: err2: err1")
 	})
 }
+
+func TestRetryAfter(t *testing.T) {
+	Convey("test retry after", t, func() {
+		So(RetryAfter(errors.New("err")), ShouldEqual, 0)
+		So(RetryAfter(ErrThrottled), ShouldEqual, 0)
+
+		err := ErrThrottled.WithMessage("too fast").WithRetryAfter(150 * time.Millisecond)
+		So(RetryAfter(err), ShouldEqual, 150*time.Millisecond)
+		So(ErrThrottled.RetryAfterMs, ShouldEqual, 0)
+
+		Convey("received from gRPC", func() {
+			received := status.Error(codes.Unknown, err.Error())
+			So(Is(received, ErrThrottled), ShouldBeTrue)
+			So(RetryAfter(received), ShouldEqual, 150*time.Millisecond)
+
+			received = status.Error(codes.Unknown, ConvertToGRPCError(err).Error())
+			So(Is(received, ErrThrottled), ShouldBeTrue)
+			So(RetryAfter(received), ShouldEqual, 150*time.Millisecond)
+		})
+	})
+}
+
+func TestCorrelationID(t *testing.T) {
+	Convey("test correlation id", t, func() {
+		So(CorrelationID(errors.New("err")), ShouldBeEmpty)
+		So(CorrelationID(ErrResourceNotFound), ShouldBeEmpty)
+
+		err := ErrResourceNotFound.WithMessage("eventbus not found").WithCorrelationID("abc")
+		So(CorrelationID(err), ShouldEqual, "abc")
+		So(ErrResourceNotFound.CorrelationID, ShouldBeEmpty)
+
+		Convey("received from gRPC", func() {
+			received := status.Error(codes.Unknown, ConvertToGRPCError(err).Error())
+			So(Is(received, ErrResourceNotFound), ShouldBeTrue)
+			So(CorrelationID(received), ShouldEqual, "abc")
+		})
+	})
+}
+
+func TestCodeOf(t *testing.T) {
+	Convey("test code of", t, func() {
+		So(CodeOf(errors.New("err")), ShouldEqual, ErrorCode_UNKNOWN)
+		So(CodeOf(status.Error(codes.Unavailable, "connection refused")), ShouldEqual, ErrorCode_UNKNOWN)
+		So(CodeOf(ErrResourceNotFound), ShouldEqual, ErrorCode_RESOURCE_NOT_FOUND)
+		So(CodeOf(ErrInvalidArgument.Wrap(errors.New("err"))), ShouldEqual, ErrorCode_INVALID_ARGUMENT)
+
+		Convey("received from gRPC", func() {
+			err := ErrEventLogNotFound.WithMessage("eventlog not found")
+			received := status.Error(codes.Unknown, ConvertToGRPCError(err).Error())
+			So(CodeOf(received), ShouldEqual, ErrorCode_EVENTLOG_NOT_FOUND)
+			received = status.Error(codes.Unknown, ConvertToGRPCError(errors.New("err")).Error())
+			So(CodeOf(received), ShouldEqual, ErrorCode_UNKNOWN)
+		})
+	})
+}
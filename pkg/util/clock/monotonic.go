@@ -0,0 +1,62 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Monotonic issues wall clock timestamps in milliseconds which never go backwards, even if the wall clock
+// is stepped back, e.g. by NTP. It's used for ingestion timestamps, since seeking by time assumes they are
+// ordered. The zero value is ready to use.
+type Monotonic struct {
+	last    int64
+	stalled uint64
+}
+
+// NowMilli returns the current wall clock time in milliseconds, or the last issued one if the wall clock
+// is behind it.
+func (m *Monotonic) NowMilli() int64 {
+	now := time.Now().UnixMilli()
+	for {
+		last := atomic.LoadInt64(&m.last)
+		if now <= last {
+			if now < last {
+				atomic.AddUint64(&m.stalled, 1)
+			}
+			return last
+		}
+		if atomic.CompareAndSwapInt64(&m.last, last, now) {
+			return now
+		}
+	}
+}
+
+// Observe makes timestamps issued later not less than ts, it's used to carry on from timestamps persisted
+// before restarting.
+func (m *Monotonic) Observe(ts int64) {
+	for {
+		last := atomic.LoadInt64(&m.last)
+		if ts <= last || atomic.CompareAndSwapInt64(&m.last, last, ts) {
+			return
+		}
+	}
+}
+
+// Stalled returns how many timestamps have been issued while the wall clock is behind the last one.
+func (m *Monotonic) Stalled() uint64 {
+	return atomic.LoadUint64(&m.stalled)
+}
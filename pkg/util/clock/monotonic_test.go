@@ -0,0 +1,44 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMonotonic(t *testing.T) {
+	Convey("monotonic clock", t, func() {
+		var m Monotonic
+		t1 := m.NowMilli()
+		So(t1, ShouldBeGreaterThan, 0)
+		So(m.NowMilli(), ShouldBeGreaterThanOrEqualTo, t1)
+		So(m.Stalled(), ShouldEqual, 0)
+
+		Convey("carry on from a future timestamp", func() {
+			future := time.Now().Add(time.Hour).UnixMilli()
+			m.Observe(future)
+			So(m.NowMilli(), ShouldEqual, future)
+			So(m.Stalled(), ShouldEqual, 1)
+
+			// An older timestamp is ignored.
+			m.Observe(t1)
+			So(m.NowMilli(), ShouldEqual, future)
+			So(m.Stalled(), ShouldEqual, 2)
+		})
+	})
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/linkall-labs/vanus/observability/log"
+)
+
+const (
+	defaultSkewInterval  = 30 * time.Second
+	defaultSkewThreshold = 500 * time.Millisecond
+)
+
+// RemoteTime returns the time of the reference clock, e.g. the leader controller.
+type RemoteTime func(ctx context.Context) (time.Time, error)
+
+// Skew is the result of a measurement.
+type Skew struct {
+	// Offset is the reference clock minus the local clock, a positive offset means the local clock is behind.
+	Offset time.Duration
+	// RTT is the round trip time of the measurement, the error of Offset is at most a half of it.
+	RTT time.Duration
+}
+
+// Exceeds reports whether the offset is beyond threshold, even taking the error of measurement into account.
+func (s Skew) Exceeds(threshold time.Duration) bool {
+	offset := s.Offset
+	if offset < 0 {
+		offset = -offset
+	}
+	return offset-s.RTT/2 > threshold
+}
+
+// Measure estimates the offset of the local clock like NTP does, assuming the request and the response
+// take the same time.
+func Measure(ctx context.Context, remote RemoteTime) (Skew, error) {
+	start := time.Now()
+	ref, err := remote(ctx)
+	if err != nil {
+		return Skew{}, err
+	}
+	rtt := time.Since(start)
+	return Skew{
+		Offset: ref.Sub(start.Add(rtt / 2)),
+		RTT:    rtt,
+	}, nil
+}
+
+type Option func(*SkewDetector)
+
+// WithInterval sets the interval of measurements.
+func WithInterval(interval time.Duration) Option {
+	return func(d *SkewDetector) {
+		d.interval = interval
+	}
+}
+
+// WithThreshold sets the offset beyond which a warning is logged.
+func WithThreshold(threshold time.Duration) Option {
+	return func(d *SkewDetector) {
+		d.threshold = threshold
+	}
+}
+
+// WithObserver sets the callback of each successful measurement, it's used to record metrics.
+func WithObserver(observe func(Skew)) Option {
+	return func(d *SkewDetector) {
+		d.observe = observe
+	}
+}
+
+// SkewDetector measures the offset of the local clock periodically.
+type SkewDetector struct {
+	remote    RemoteTime
+	interval  time.Duration
+	threshold time.Duration
+	observe   func(Skew)
+
+	mu   sync.RWMutex
+	last Skew
+	ok   bool
+}
+
+func NewSkewDetector(remote RemoteTime, opts ...Option) *SkewDetector {
+	d := &SkewDetector{
+		remote:    remote,
+		interval:  defaultSkewInterval,
+		threshold: defaultSkewThreshold,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Run measures until ctx is done.
+func (d *SkewDetector) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	for {
+		_, _ = d.Detect(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Detect measures once and returns the result, a failed measurement keeps the last result.
+func (d *SkewDetector) Detect(ctx context.Context) (Skew, error) {
+	skew, err := Measure(ctx, d.remote)
+	if err != nil {
+		log.Debug(ctx, "measure clock skew failed", map[string]interface{}{
+			log.KeyError: err,
+		})
+		return Skew{}, err
+	}
+
+	d.mu.Lock()
+	d.last = skew
+	d.ok = true
+	d.mu.Unlock()
+
+	if d.observe != nil {
+		d.observe(skew)
+	}
+	if skew.Exceeds(d.threshold) {
+		log.Warning(ctx, "the local clock drifts from the cluster, time-based seeking and expiration may be "+
+			"inaccurate", map[string]interface{}{
+			"offset":    skew.Offset,
+			"rtt":       skew.RTT,
+			"threshold": d.threshold,
+		})
+	}
+	return skew, nil
+}
+
+// Skew returns the last result, ok is false if no measurement has succeeded.
+func (d *SkewDetector) Skew() (skew Skew, ok bool) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.last, d.ok
+}
+
+// Now returns the local time corrected by the last result, which approximates the time of the reference
+// clock. Unlike time.Now, it may go backwards when the offset is updated.
+func (d *SkewDetector) Now() time.Time {
+	skew, _ := d.Skew()
+	return time.Now().Add(skew.Offset)
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSkew_Exceeds(t *testing.T) {
+	Convey("skew exceeds threshold", t, func() {
+		So(Skew{Offset: time.Second}.Exceeds(500*time.Millisecond), ShouldBeTrue)
+		So(Skew{Offset: -time.Second}.Exceeds(500*time.Millisecond), ShouldBeTrue)
+		So(Skew{Offset: 100 * time.Millisecond}.Exceeds(500*time.Millisecond), ShouldBeFalse)
+		// The offset may be caused by the error of measurement.
+		So(Skew{Offset: time.Second, RTT: 2 * time.Second}.Exceeds(500*time.Millisecond), ShouldBeFalse)
+	})
+}
+
+func TestSkewDetector(t *testing.T) {
+	ctx := context.Background()
+
+	Convey("detect clock skew", t, func() {
+		offset := time.Hour
+		var observed []Skew
+		d := NewSkewDetector(func(ctx context.Context) (time.Time, error) {
+			return time.Now().Add(offset), nil
+		}, WithObserver(func(s Skew) {
+			observed = append(observed, s)
+		}))
+
+		_, ok := d.Skew()
+		So(ok, ShouldBeFalse)
+
+		skew, err := d.Detect(ctx)
+		So(err, ShouldBeNil)
+		So(skew.Offset, ShouldAlmostEqual, offset, time.Second)
+		So(skew.Exceeds(time.Minute), ShouldBeTrue)
+		So(observed, ShouldHaveLength, 1)
+		So(d.Now(), ShouldHappenWithin, time.Second, time.Now().Add(offset))
+
+		Convey("keep the last result if measuring fails", func() {
+			d.remote = func(ctx context.Context) (time.Time, error) {
+				return time.Time{}, fmt.Errorf("test")
+			}
+			_, err = d.Detect(ctx)
+			So(err, ShouldNotBeNil)
+			last, ok := d.Skew()
+			So(ok, ShouldBeTrue)
+			So(last, ShouldResemble, skew)
+			So(observed, ShouldHaveLength, 1)
+		})
+	})
+}
@@ -17,6 +17,41 @@ import (
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// MockisImportEventBusRequest_Source is a mock of isImportEventBusRequest_Source interface.
+type MockisImportEventBusRequest_Source struct {
+	ctrl     *gomock.Controller
+	recorder *MockisImportEventBusRequest_SourceMockRecorder
+}
+
+// MockisImportEventBusRequest_SourceMockRecorder is the mock recorder for MockisImportEventBusRequest_Source.
+type MockisImportEventBusRequest_SourceMockRecorder struct {
+	mock *MockisImportEventBusRequest_Source
+}
+
+// NewMockisImportEventBusRequest_Source creates a new mock instance.
+func NewMockisImportEventBusRequest_Source(ctrl *gomock.Controller) *MockisImportEventBusRequest_Source {
+	mock := &MockisImportEventBusRequest_Source{ctrl: ctrl}
+	mock.recorder = &MockisImportEventBusRequest_SourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockisImportEventBusRequest_Source) EXPECT() *MockisImportEventBusRequest_SourceMockRecorder {
+	return m.recorder
+}
+
+// isImportEventBusRequest_Source mocks base method.
+func (m *MockisImportEventBusRequest_Source) isImportEventBusRequest_Source() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "isImportEventBusRequest_Source")
+}
+
+// isImportEventBusRequest_Source indicates an expected call of isImportEventBusRequest_Source.
+func (mr *MockisImportEventBusRequest_SourceMockRecorder) isImportEventBusRequest_Source() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "isImportEventBusRequest_Source", reflect.TypeOf((*MockisImportEventBusRequest_Source)(nil).isImportEventBusRequest_Source))
+}
+
 // MockPingServerClient is a mock of PingServerClient interface.
 type MockPingServerClient struct {
 	ctrl     *gomock.Controller
@@ -121,6 +156,26 @@ func (m *MockEventBusControllerClient) EXPECT() *MockEventBusControllerClientMoc
 	return m.recorder
 }
 
+// AnnotateEventBus mocks base method.
+func (m *MockEventBusControllerClient) AnnotateEventBus(ctx context.Context, in *AnnotateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AnnotateEventBus", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateEventBus indicates an expected call of AnnotateEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) AnnotateEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).AnnotateEventBus), varargs...)
+}
+
 // CreateEventBus mocks base method.
 func (m *MockEventBusControllerClient) CreateEventBus(ctx context.Context, in *CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +196,26 @@ func (mr *MockEventBusControllerClientMockRecorder) CreateEventBus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateEventBus), varargs...)
 }
 
+// CreateEventbusProfile mocks base method.
+func (m *MockEventBusControllerClient) CreateEventbusProfile(ctx context.Context, in *EventbusProfile, opts ...grpc.CallOption) (*EventbusProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateEventbusProfile", varargs...)
+	ret0, _ := ret[0].(*EventbusProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventbusProfile indicates an expected call of CreateEventbusProfile.
+func (mr *MockEventBusControllerClientMockRecorder) CreateEventbusProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventbusProfile", reflect.TypeOf((*MockEventBusControllerClient)(nil).CreateEventbusProfile), varargs...)
+}
+
 // CreateSystemEventBus mocks base method.
 func (m *MockEventBusControllerClient) CreateSystemEventBus(ctx context.Context, in *CreateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +256,26 @@ func (mr *MockEventBusControllerClientMockRecorder) DeleteEventBus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteEventBus), varargs...)
 }
 
+// DeleteEventbusProfile mocks base method.
+func (m *MockEventBusControllerClient) DeleteEventbusProfile(ctx context.Context, in *DeleteEventbusProfileRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteEventbusProfile", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventbusProfile indicates an expected call of DeleteEventbusProfile.
+func (mr *MockEventBusControllerClientMockRecorder) DeleteEventbusProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbusProfile", reflect.TypeOf((*MockEventBusControllerClient)(nil).DeleteEventbusProfile), varargs...)
+}
+
 // GetEventBus mocks base method.
 func (m *MockEventBusControllerClient) GetEventBus(ctx context.Context, in *meta.EventBus, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +296,26 @@ func (mr *MockEventBusControllerClientMockRecorder) GetEventBus(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).GetEventBus), varargs...)
 }
 
+// ImportEventBus mocks base method.
+func (m *MockEventBusControllerClient) ImportEventBus(ctx context.Context, in *ImportEventBusRequest, opts ...grpc.CallOption) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportEventBus", varargs...)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEventBus indicates an expected call of ImportEventBus.
+func (mr *MockEventBusControllerClientMockRecorder) ImportEventBus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).ImportEventBus), varargs...)
+}
+
 // ListEventBus mocks base method.
 func (m *MockEventBusControllerClient) ListEventBus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventbusResponse, error) {
 	m.ctrl.T.Helper()
@@ -221,6 +336,106 @@ func (mr *MockEventBusControllerClientMockRecorder) ListEventBus(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventBus), varargs...)
 }
 
+// ListEventbusProfile mocks base method.
+func (m *MockEventBusControllerClient) ListEventbusProfile(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListEventbusProfileResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventbusProfile", varargs...)
+	ret0, _ := ret[0].(*ListEventbusProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventbusProfile indicates an expected call of ListEventbusProfile.
+func (mr *MockEventBusControllerClientMockRecorder) ListEventbusProfile(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusProfile", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventbusProfile), varargs...)
+}
+
+// ListEventbusSLO mocks base method.
+func (m *MockEventBusControllerClient) ListEventbusSLO(ctx context.Context, in *ListSLORequest, opts ...grpc.CallOption) (*ListSLOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventbusSLO", varargs...)
+	ret0, _ := ret[0].(*ListSLOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventbusSLO indicates an expected call of ListEventbusSLO.
+func (mr *MockEventBusControllerClientMockRecorder) ListEventbusSLO(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusSLO", reflect.TypeOf((*MockEventBusControllerClient)(nil).ListEventbusSLO), varargs...)
+}
+
+// ReportPublishLatencies mocks base method.
+func (m *MockEventBusControllerClient) ReportPublishLatencies(ctx context.Context, in *ReportLatenciesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReportPublishLatencies", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportPublishLatencies indicates an expected call of ReportPublishLatencies.
+func (mr *MockEventBusControllerClientMockRecorder) ReportPublishLatencies(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportPublishLatencies", reflect.TypeOf((*MockEventBusControllerClient)(nil).ReportPublishLatencies), varargs...)
+}
+
+// SetEventBusDictionary mocks base method.
+func (m *MockEventBusControllerClient) SetEventBusDictionary(ctx context.Context, in *SetEventBusDictionaryRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetEventBusDictionary", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEventBusDictionary indicates an expected call of SetEventBusDictionary.
+func (mr *MockEventBusControllerClientMockRecorder) SetEventBusDictionary(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventBusDictionary", reflect.TypeOf((*MockEventBusControllerClient)(nil).SetEventBusDictionary), varargs...)
+}
+
+// SetEventBusRetention mocks base method.
+func (m *MockEventBusControllerClient) SetEventBusRetention(ctx context.Context, in *SetEventBusRetentionRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetEventBusRetention", varargs...)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEventBusRetention indicates an expected call of SetEventBusRetention.
+func (mr *MockEventBusControllerClientMockRecorder) SetEventBusRetention(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventBusRetention", reflect.TypeOf((*MockEventBusControllerClient)(nil).SetEventBusRetention), varargs...)
+}
+
 // UpdateEventBus mocks base method.
 func (m *MockEventBusControllerClient) UpdateEventBus(ctx context.Context, in *UpdateEventBusRequest, opts ...grpc.CallOption) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +456,26 @@ func (mr *MockEventBusControllerClientMockRecorder) UpdateEventBus(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventBus", reflect.TypeOf((*MockEventBusControllerClient)(nil).UpdateEventBus), varargs...)
 }
 
+// VerifyEventBusOrdering mocks base method.
+func (m *MockEventBusControllerClient) VerifyEventBusOrdering(ctx context.Context, in *VerifyEventBusOrderingRequest, opts ...grpc.CallOption) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyEventBusOrdering", varargs...)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEventBusOrdering indicates an expected call of VerifyEventBusOrdering.
+func (mr *MockEventBusControllerClientMockRecorder) VerifyEventBusOrdering(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEventBusOrdering", reflect.TypeOf((*MockEventBusControllerClient)(nil).VerifyEventBusOrdering), varargs...)
+}
+
 // MockEventBusControllerServer is a mock of EventBusControllerServer interface.
 type MockEventBusControllerServer struct {
 	ctrl     *gomock.Controller
@@ -264,6 +499,21 @@ func (m *MockEventBusControllerServer) EXPECT() *MockEventBusControllerServerMoc
 	return m.recorder
 }
 
+// AnnotateEventBus mocks base method.
+func (m *MockEventBusControllerServer) AnnotateEventBus(arg0 context.Context, arg1 *AnnotateEventBusRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnotateEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateEventBus indicates an expected call of AnnotateEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) AnnotateEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).AnnotateEventBus), arg0, arg1)
+}
+
 // CreateEventBus mocks base method.
 func (m *MockEventBusControllerServer) CreateEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -279,6 +529,21 @@ func (mr *MockEventBusControllerServerMockRecorder) CreateEventBus(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateEventBus), arg0, arg1)
 }
 
+// CreateEventbusProfile mocks base method.
+func (m *MockEventBusControllerServer) CreateEventbusProfile(arg0 context.Context, arg1 *EventbusProfile) (*EventbusProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventbusProfile", arg0, arg1)
+	ret0, _ := ret[0].(*EventbusProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEventbusProfile indicates an expected call of CreateEventbusProfile.
+func (mr *MockEventBusControllerServerMockRecorder) CreateEventbusProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventbusProfile", reflect.TypeOf((*MockEventBusControllerServer)(nil).CreateEventbusProfile), arg0, arg1)
+}
+
 // CreateSystemEventBus mocks base method.
 func (m *MockEventBusControllerServer) CreateSystemEventBus(arg0 context.Context, arg1 *CreateEventBusRequest) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +574,21 @@ func (mr *MockEventBusControllerServerMockRecorder) DeleteEventBus(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventBus), arg0, arg1)
 }
 
+// DeleteEventbusProfile mocks base method.
+func (m *MockEventBusControllerServer) DeleteEventbusProfile(arg0 context.Context, arg1 *DeleteEventbusProfileRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventbusProfile", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventbusProfile indicates an expected call of DeleteEventbusProfile.
+func (mr *MockEventBusControllerServerMockRecorder) DeleteEventbusProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventbusProfile", reflect.TypeOf((*MockEventBusControllerServer)(nil).DeleteEventbusProfile), arg0, arg1)
+}
+
 // GetEventBus mocks base method.
 func (m *MockEventBusControllerServer) GetEventBus(arg0 context.Context, arg1 *meta.EventBus) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +604,21 @@ func (mr *MockEventBusControllerServerMockRecorder) GetEventBus(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).GetEventBus), arg0, arg1)
 }
 
+// ImportEventBus mocks base method.
+func (m *MockEventBusControllerServer) ImportEventBus(arg0 context.Context, arg1 *ImportEventBusRequest) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportEventBus", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportEventBus indicates an expected call of ImportEventBus.
+func (mr *MockEventBusControllerServerMockRecorder) ImportEventBus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).ImportEventBus), arg0, arg1)
+}
+
 // ListEventBus mocks base method.
 func (m *MockEventBusControllerServer) ListEventBus(arg0 context.Context, arg1 *emptypb.Empty) (*ListEventbusResponse, error) {
 	m.ctrl.T.Helper()
@@ -339,6 +634,81 @@ func (mr *MockEventBusControllerServerMockRecorder) ListEventBus(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventBus), arg0, arg1)
 }
 
+// ListEventbusProfile mocks base method.
+func (m *MockEventBusControllerServer) ListEventbusProfile(arg0 context.Context, arg1 *emptypb.Empty) (*ListEventbusProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventbusProfile", arg0, arg1)
+	ret0, _ := ret[0].(*ListEventbusProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventbusProfile indicates an expected call of ListEventbusProfile.
+func (mr *MockEventBusControllerServerMockRecorder) ListEventbusProfile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusProfile", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventbusProfile), arg0, arg1)
+}
+
+// ListEventbusSLO mocks base method.
+func (m *MockEventBusControllerServer) ListEventbusSLO(arg0 context.Context, arg1 *ListSLORequest) (*ListSLOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventbusSLO", arg0, arg1)
+	ret0, _ := ret[0].(*ListSLOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventbusSLO indicates an expected call of ListEventbusSLO.
+func (mr *MockEventBusControllerServerMockRecorder) ListEventbusSLO(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventbusSLO", reflect.TypeOf((*MockEventBusControllerServer)(nil).ListEventbusSLO), arg0, arg1)
+}
+
+// ReportPublishLatencies mocks base method.
+func (m *MockEventBusControllerServer) ReportPublishLatencies(arg0 context.Context, arg1 *ReportLatenciesRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportPublishLatencies", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReportPublishLatencies indicates an expected call of ReportPublishLatencies.
+func (mr *MockEventBusControllerServerMockRecorder) ReportPublishLatencies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportPublishLatencies", reflect.TypeOf((*MockEventBusControllerServer)(nil).ReportPublishLatencies), arg0, arg1)
+}
+
+// SetEventBusDictionary mocks base method.
+func (m *MockEventBusControllerServer) SetEventBusDictionary(arg0 context.Context, arg1 *SetEventBusDictionaryRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventBusDictionary", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEventBusDictionary indicates an expected call of SetEventBusDictionary.
+func (mr *MockEventBusControllerServerMockRecorder) SetEventBusDictionary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventBusDictionary", reflect.TypeOf((*MockEventBusControllerServer)(nil).SetEventBusDictionary), arg0, arg1)
+}
+
+// SetEventBusRetention mocks base method.
+func (m *MockEventBusControllerServer) SetEventBusRetention(arg0 context.Context, arg1 *SetEventBusRetentionRequest) (*meta.EventBus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEventBusRetention", arg0, arg1)
+	ret0, _ := ret[0].(*meta.EventBus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEventBusRetention indicates an expected call of SetEventBusRetention.
+func (mr *MockEventBusControllerServerMockRecorder) SetEventBusRetention(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEventBusRetention", reflect.TypeOf((*MockEventBusControllerServer)(nil).SetEventBusRetention), arg0, arg1)
+}
+
 // UpdateEventBus mocks base method.
 func (m *MockEventBusControllerServer) UpdateEventBus(arg0 context.Context, arg1 *UpdateEventBusRequest) (*meta.EventBus, error) {
 	m.ctrl.T.Helper()
@@ -354,6 +724,21 @@ func (mr *MockEventBusControllerServerMockRecorder) UpdateEventBus(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEventBus", reflect.TypeOf((*MockEventBusControllerServer)(nil).UpdateEventBus), arg0, arg1)
 }
 
+// VerifyEventBusOrdering mocks base method.
+func (m *MockEventBusControllerServer) VerifyEventBusOrdering(arg0 context.Context, arg1 *VerifyEventBusOrderingRequest) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEventBusOrdering", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEventBusOrdering indicates an expected call of VerifyEventBusOrdering.
+func (mr *MockEventBusControllerServerMockRecorder) VerifyEventBusOrdering(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEventBusOrdering", reflect.TypeOf((*MockEventBusControllerServer)(nil).VerifyEventBusOrdering), arg0, arg1)
+}
+
 // MockEventLogControllerClient is a mock of EventLogControllerClient interface.
 type MockEventLogControllerClient struct {
 	ctrl     *gomock.Controller
@@ -397,6 +782,26 @@ func (mr *MockEventLogControllerClientMockRecorder) GetAppendableSegment(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppendableSegment", reflect.TypeOf((*MockEventLogControllerClient)(nil).GetAppendableSegment), varargs...)
 }
 
+// GetEventlogStats mocks base method.
+func (m *MockEventLogControllerClient) GetEventlogStats(ctx context.Context, in *GetEventlogStatsRequest, opts ...grpc.CallOption) (*GetEventlogStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEventlogStats", varargs...)
+	ret0, _ := ret[0].(*GetEventlogStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventlogStats indicates an expected call of GetEventlogStats.
+func (mr *MockEventLogControllerClientMockRecorder) GetEventlogStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventlogStats", reflect.TypeOf((*MockEventLogControllerClient)(nil).GetEventlogStats), varargs...)
+}
+
 // ListSegment mocks base method.
 func (m *MockEventLogControllerClient) ListSegment(ctx context.Context, in *ListSegmentRequest, opts ...grpc.CallOption) (*ListSegmentResponse, error) {
 	m.ctrl.T.Helper()
@@ -417,11 +822,194 @@ func (mr *MockEventLogControllerClientMockRecorder) ListSegment(ctx, in interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegment", reflect.TypeOf((*MockEventLogControllerClient)(nil).ListSegment), varargs...)
 }
 
-// MockEventLogControllerServer is a mock of EventLogControllerServer interface.
-type MockEventLogControllerServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockEventLogControllerServerMockRecorder
-}
+// PrefetchEventlogs mocks base method.
+func (m *MockEventLogControllerClient) PrefetchEventlogs(ctx context.Context, in *PrefetchEventlogsRequest, opts ...grpc.CallOption) (*PrefetchEventlogsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PrefetchEventlogs", varargs...)
+	ret0, _ := ret[0].(*PrefetchEventlogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrefetchEventlogs indicates an expected call of PrefetchEventlogs.
+func (mr *MockEventLogControllerClientMockRecorder) PrefetchEventlogs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefetchEventlogs", reflect.TypeOf((*MockEventLogControllerClient)(nil).PrefetchEventlogs), varargs...)
+}
+
+// StreamSegments mocks base method.
+func (m *MockEventLogControllerClient) StreamSegments(ctx context.Context, in *StreamListRequest, opts ...grpc.CallOption) (EventLogController_StreamSegmentsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamSegments", varargs...)
+	ret0, _ := ret[0].(EventLogController_StreamSegmentsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamSegments indicates an expected call of StreamSegments.
+func (mr *MockEventLogControllerClientMockRecorder) StreamSegments(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSegments", reflect.TypeOf((*MockEventLogControllerClient)(nil).StreamSegments), varargs...)
+}
+
+// TruncateEventLog mocks base method.
+func (m *MockEventLogControllerClient) TruncateEventLog(ctx context.Context, in *TruncateEventLogRequest, opts ...grpc.CallOption) (*TruncateEventLogResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TruncateEventLog", varargs...)
+	ret0, _ := ret[0].(*TruncateEventLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TruncateEventLog indicates an expected call of TruncateEventLog.
+func (mr *MockEventLogControllerClientMockRecorder) TruncateEventLog(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateEventLog", reflect.TypeOf((*MockEventLogControllerClient)(nil).TruncateEventLog), varargs...)
+}
+
+// MockEventLogController_StreamSegmentsClient is a mock of EventLogController_StreamSegmentsClient interface.
+type MockEventLogController_StreamSegmentsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogController_StreamSegmentsClientMockRecorder
+}
+
+// MockEventLogController_StreamSegmentsClientMockRecorder is the mock recorder for MockEventLogController_StreamSegmentsClient.
+type MockEventLogController_StreamSegmentsClientMockRecorder struct {
+	mock *MockEventLogController_StreamSegmentsClient
+}
+
+// NewMockEventLogController_StreamSegmentsClient creates a new mock instance.
+func NewMockEventLogController_StreamSegmentsClient(ctrl *gomock.Controller) *MockEventLogController_StreamSegmentsClient {
+	mock := &MockEventLogController_StreamSegmentsClient{ctrl: ctrl}
+	mock.recorder = &MockEventLogController_StreamSegmentsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventLogController_StreamSegmentsClient) EXPECT() *MockEventLogController_StreamSegmentsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockEventLogController_StreamSegmentsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockEventLogController_StreamSegmentsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockEventLogController_StreamSegmentsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockEventLogController_StreamSegmentsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockEventLogController_StreamSegmentsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockEventLogController_StreamSegmentsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockEventLogController_StreamSegmentsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockEventLogController_StreamSegmentsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockEventLogController_StreamSegmentsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockEventLogController_StreamSegmentsClient) Recv() (*StreamSegmentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*StreamSegmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockEventLogController_StreamSegmentsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockEventLogController_StreamSegmentsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockEventLogController_StreamSegmentsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockEventLogController_StreamSegmentsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockEventLogController_StreamSegmentsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockEventLogController_StreamSegmentsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockEventLogController_StreamSegmentsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockEventLogController_StreamSegmentsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockEventLogController_StreamSegmentsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockEventLogController_StreamSegmentsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockEventLogController_StreamSegmentsClient)(nil).Trailer))
+}
+
+// MockEventLogControllerServer is a mock of EventLogControllerServer interface.
+type MockEventLogControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogControllerServerMockRecorder
+}
 
 // MockEventLogControllerServerMockRecorder is the mock recorder for MockEventLogControllerServer.
 type MockEventLogControllerServerMockRecorder struct {
@@ -455,6 +1043,21 @@ func (mr *MockEventLogControllerServerMockRecorder) GetAppendableSegment(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppendableSegment", reflect.TypeOf((*MockEventLogControllerServer)(nil).GetAppendableSegment), arg0, arg1)
 }
 
+// GetEventlogStats mocks base method.
+func (m *MockEventLogControllerServer) GetEventlogStats(arg0 context.Context, arg1 *GetEventlogStatsRequest) (*GetEventlogStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventlogStats", arg0, arg1)
+	ret0, _ := ret[0].(*GetEventlogStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventlogStats indicates an expected call of GetEventlogStats.
+func (mr *MockEventLogControllerServerMockRecorder) GetEventlogStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventlogStats", reflect.TypeOf((*MockEventLogControllerServer)(nil).GetEventlogStats), arg0, arg1)
+}
+
 // ListSegment mocks base method.
 func (m *MockEventLogControllerServer) ListSegment(arg0 context.Context, arg1 *ListSegmentRequest) (*ListSegmentResponse, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +1073,169 @@ func (mr *MockEventLogControllerServerMockRecorder) ListSegment(arg0, arg1 inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegment", reflect.TypeOf((*MockEventLogControllerServer)(nil).ListSegment), arg0, arg1)
 }
 
+// PrefetchEventlogs mocks base method.
+func (m *MockEventLogControllerServer) PrefetchEventlogs(arg0 context.Context, arg1 *PrefetchEventlogsRequest) (*PrefetchEventlogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrefetchEventlogs", arg0, arg1)
+	ret0, _ := ret[0].(*PrefetchEventlogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrefetchEventlogs indicates an expected call of PrefetchEventlogs.
+func (mr *MockEventLogControllerServerMockRecorder) PrefetchEventlogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefetchEventlogs", reflect.TypeOf((*MockEventLogControllerServer)(nil).PrefetchEventlogs), arg0, arg1)
+}
+
+// StreamSegments mocks base method.
+func (m *MockEventLogControllerServer) StreamSegments(arg0 *StreamListRequest, arg1 EventLogController_StreamSegmentsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamSegments", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamSegments indicates an expected call of StreamSegments.
+func (mr *MockEventLogControllerServerMockRecorder) StreamSegments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSegments", reflect.TypeOf((*MockEventLogControllerServer)(nil).StreamSegments), arg0, arg1)
+}
+
+// TruncateEventLog mocks base method.
+func (m *MockEventLogControllerServer) TruncateEventLog(arg0 context.Context, arg1 *TruncateEventLogRequest) (*TruncateEventLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TruncateEventLog", arg0, arg1)
+	ret0, _ := ret[0].(*TruncateEventLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TruncateEventLog indicates an expected call of TruncateEventLog.
+func (mr *MockEventLogControllerServerMockRecorder) TruncateEventLog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TruncateEventLog", reflect.TypeOf((*MockEventLogControllerServer)(nil).TruncateEventLog), arg0, arg1)
+}
+
+// MockEventLogController_StreamSegmentsServer is a mock of EventLogController_StreamSegmentsServer interface.
+type MockEventLogController_StreamSegmentsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventLogController_StreamSegmentsServerMockRecorder
+}
+
+// MockEventLogController_StreamSegmentsServerMockRecorder is the mock recorder for MockEventLogController_StreamSegmentsServer.
+type MockEventLogController_StreamSegmentsServerMockRecorder struct {
+	mock *MockEventLogController_StreamSegmentsServer
+}
+
+// NewMockEventLogController_StreamSegmentsServer creates a new mock instance.
+func NewMockEventLogController_StreamSegmentsServer(ctrl *gomock.Controller) *MockEventLogController_StreamSegmentsServer {
+	mock := &MockEventLogController_StreamSegmentsServer{ctrl: ctrl}
+	mock.recorder = &MockEventLogController_StreamSegmentsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventLogController_StreamSegmentsServer) EXPECT() *MockEventLogController_StreamSegmentsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockEventLogController_StreamSegmentsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockEventLogController_StreamSegmentsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockEventLogController_StreamSegmentsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockEventLogController_StreamSegmentsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockEventLogController_StreamSegmentsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockEventLogController_StreamSegmentsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockEventLogController_StreamSegmentsServer) Send(arg0 *StreamSegmentsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockEventLogController_StreamSegmentsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockEventLogController_StreamSegmentsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockEventLogController_StreamSegmentsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockEventLogController_StreamSegmentsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockEventLogController_StreamSegmentsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockEventLogController_StreamSegmentsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockEventLogController_StreamSegmentsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockEventLogController_StreamSegmentsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockEventLogController_StreamSegmentsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockEventLogController_StreamSegmentsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockEventLogController_StreamSegmentsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockEventLogController_StreamSegmentsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockEventLogController_StreamSegmentsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockEventLogController_StreamSegmentsServer)(nil).SetTrailer), arg0)
+}
+
 // MockSegmentControllerClient is a mock of SegmentControllerClient interface.
 type MockSegmentControllerClient struct {
 	ctrl     *gomock.Controller
@@ -1019,14 +1785,54 @@ func (m *MockTriggerControllerClient) EXPECT() *MockTriggerControllerClientMockR
 	return m.recorder
 }
 
-// CommitOffset mocks base method.
-func (m *MockTriggerControllerClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error) {
+// AnnotateSubscription mocks base method.
+func (m *MockTriggerControllerClient) AnnotateSubscription(ctx context.Context, in *AnnotateSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "CommitOffset", varargs...)
+	ret := m.ctrl.Call(m, "AnnotateSubscription", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateSubscription indicates an expected call of AnnotateSubscription.
+func (mr *MockTriggerControllerClientMockRecorder) AnnotateSubscription(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).AnnotateSubscription), varargs...)
+}
+
+// ApproveRebalance mocks base method.
+func (m *MockTriggerControllerClient) ApproveRebalance(ctx context.Context, in *ApproveRebalanceRequest, opts ...grpc.CallOption) (*RebalancePlan, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ApproveRebalance", varargs...)
+	ret0, _ := ret[0].(*RebalancePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveRebalance indicates an expected call of ApproveRebalance.
+func (mr *MockTriggerControllerClientMockRecorder) ApproveRebalance(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRebalance", reflect.TypeOf((*MockTriggerControllerClient)(nil).ApproveRebalance), varargs...)
+}
+
+// CommitOffset mocks base method.
+func (m *MockTriggerControllerClient) CommitOffset(ctx context.Context, in *CommitOffsetRequest, opts ...grpc.CallOption) (*CommitOffsetResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitOffset", varargs...)
 	ret0, _ := ret[0].(*CommitOffsetResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
@@ -1099,6 +1905,26 @@ func (mr *MockTriggerControllerClientMockRecorder) DisableSubscription(ctx, in i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).DisableSubscription), varargs...)
 }
 
+// ExportOffsets mocks base method.
+func (m *MockTriggerControllerClient) ExportOffsets(ctx context.Context, in *ExportOffsetsRequest, opts ...grpc.CallOption) (*ExportOffsetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportOffsets", varargs...)
+	ret0, _ := ret[0].(*ExportOffsetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOffsets indicates an expected call of ExportOffsets.
+func (mr *MockTriggerControllerClientMockRecorder) ExportOffsets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOffsets", reflect.TypeOf((*MockTriggerControllerClient)(nil).ExportOffsets), varargs...)
+}
+
 // GetSubscription mocks base method.
 func (m *MockTriggerControllerClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -1119,6 +1945,46 @@ func (mr *MockTriggerControllerClientMockRecorder) GetSubscription(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).GetSubscription), varargs...)
 }
 
+// ImportOffsets mocks base method.
+func (m *MockTriggerControllerClient) ImportOffsets(ctx context.Context, in *ImportOffsetsRequest, opts ...grpc.CallOption) (*ImportOffsetsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ImportOffsets", varargs...)
+	ret0, _ := ret[0].(*ImportOffsetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportOffsets indicates an expected call of ImportOffsets.
+func (mr *MockTriggerControllerClientMockRecorder) ImportOffsets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportOffsets", reflect.TypeOf((*MockTriggerControllerClient)(nil).ImportOffsets), varargs...)
+}
+
+// ListNamespaceUsage mocks base method.
+func (m *MockTriggerControllerClient) ListNamespaceUsage(ctx context.Context, in *ListNamespaceUsageRequest, opts ...grpc.CallOption) (*ListNamespaceUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNamespaceUsage", varargs...)
+	ret0, _ := ret[0].(*ListNamespaceUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceUsage indicates an expected call of ListNamespaceUsage.
+func (mr *MockTriggerControllerClientMockRecorder) ListNamespaceUsage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceUsage", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListNamespaceUsage), varargs...)
+}
+
 // ListSubscription mocks base method.
 func (m *MockTriggerControllerClient) ListSubscription(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1139,6 +2005,66 @@ func (mr *MockTriggerControllerClientMockRecorder) ListSubscription(ctx, in inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListSubscription), varargs...)
 }
 
+// ListSubscriptionSLO mocks base method.
+func (m *MockTriggerControllerClient) ListSubscriptionSLO(ctx context.Context, in *ListSLORequest, opts ...grpc.CallOption) (*ListSLOResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSubscriptionSLO", varargs...)
+	ret0, _ := ret[0].(*ListSLOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionSLO indicates an expected call of ListSubscriptionSLO.
+func (mr *MockTriggerControllerClientMockRecorder) ListSubscriptionSLO(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionSLO", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListSubscriptionSLO), varargs...)
+}
+
+// ListTriggerWorker mocks base method.
+func (m *MockTriggerControllerClient) ListTriggerWorker(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTriggerWorker", varargs...)
+	ret0, _ := ret[0].(*ListTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTriggerWorker indicates an expected call of ListTriggerWorker.
+func (mr *MockTriggerControllerClientMockRecorder) ListTriggerWorker(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggerWorker", reflect.TypeOf((*MockTriggerControllerClient)(nil).ListTriggerWorker), varargs...)
+}
+
+// PlanRebalance mocks base method.
+func (m *MockTriggerControllerClient) PlanRebalance(ctx context.Context, in *PlanRebalanceRequest, opts ...grpc.CallOption) (*RebalancePlan, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PlanRebalance", varargs...)
+	ret0, _ := ret[0].(*RebalancePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRebalance indicates an expected call of PlanRebalance.
+func (mr *MockTriggerControllerClientMockRecorder) PlanRebalance(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRebalance", reflect.TypeOf((*MockTriggerControllerClient)(nil).PlanRebalance), varargs...)
+}
+
 // RegisterTriggerWorker mocks base method.
 func (m *MockTriggerControllerClient) RegisterTriggerWorker(ctx context.Context, in *RegisterTriggerWorkerRequest, opts ...grpc.CallOption) (*RegisterTriggerWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -1179,6 +2105,26 @@ func (mr *MockTriggerControllerClientMockRecorder) ResetOffsetToTimestamp(ctx, i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOffsetToTimestamp", reflect.TypeOf((*MockTriggerControllerClient)(nil).ResetOffsetToTimestamp), varargs...)
 }
 
+// ResumeEventlogs mocks base method.
+func (m *MockTriggerControllerClient) ResumeEventlogs(ctx context.Context, in *ResumeEventlogsRequest, opts ...grpc.CallOption) (*ResumeEventlogsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeEventlogs", varargs...)
+	ret0, _ := ret[0].(*ResumeEventlogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeEventlogs indicates an expected call of ResumeEventlogs.
+func (mr *MockTriggerControllerClientMockRecorder) ResumeEventlogs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeEventlogs", reflect.TypeOf((*MockTriggerControllerClient)(nil).ResumeEventlogs), varargs...)
+}
+
 // ResumeSubscription mocks base method.
 func (m *MockTriggerControllerClient) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -1199,6 +2145,46 @@ func (mr *MockTriggerControllerClientMockRecorder) ResumeSubscription(ctx, in in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSubscription", reflect.TypeOf((*MockTriggerControllerClient)(nil).ResumeSubscription), varargs...)
 }
 
+// RotateSigningKey mocks base method.
+func (m *MockTriggerControllerClient) RotateSigningKey(ctx context.Context, in *RotateSigningKeyRequest, opts ...grpc.CallOption) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RotateSigningKey", varargs...)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSigningKey indicates an expected call of RotateSigningKey.
+func (mr *MockTriggerControllerClientMockRecorder) RotateSigningKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSigningKey", reflect.TypeOf((*MockTriggerControllerClient)(nil).RotateSigningKey), varargs...)
+}
+
+// StreamSubscriptions mocks base method.
+func (m *MockTriggerControllerClient) StreamSubscriptions(ctx context.Context, in *StreamListRequest, opts ...grpc.CallOption) (TriggerController_StreamSubscriptionsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamSubscriptions", varargs...)
+	ret0, _ := ret[0].(TriggerController_StreamSubscriptionsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamSubscriptions indicates an expected call of StreamSubscriptions.
+func (mr *MockTriggerControllerClientMockRecorder) StreamSubscriptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSubscriptions", reflect.TypeOf((*MockTriggerControllerClient)(nil).StreamSubscriptions), varargs...)
+}
+
 // TriggerWorkerHeartbeat mocks base method.
 func (m *MockTriggerControllerClient) TriggerWorkerHeartbeat(ctx context.Context, opts ...grpc.CallOption) (TriggerController_TriggerWorkerHeartbeatClient, error) {
 	m.ctrl.T.Helper()
@@ -1396,6 +2382,129 @@ func (mr *MockTriggerController_TriggerWorkerHeartbeatClientMockRecorder) Traile
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatClient)(nil).Trailer))
 }
 
+// MockTriggerController_StreamSubscriptionsClient is a mock of TriggerController_StreamSubscriptionsClient interface.
+type MockTriggerController_StreamSubscriptionsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_StreamSubscriptionsClientMockRecorder
+}
+
+// MockTriggerController_StreamSubscriptionsClientMockRecorder is the mock recorder for MockTriggerController_StreamSubscriptionsClient.
+type MockTriggerController_StreamSubscriptionsClientMockRecorder struct {
+	mock *MockTriggerController_StreamSubscriptionsClient
+}
+
+// NewMockTriggerController_StreamSubscriptionsClient creates a new mock instance.
+func NewMockTriggerController_StreamSubscriptionsClient(ctrl *gomock.Controller) *MockTriggerController_StreamSubscriptionsClient {
+	mock := &MockTriggerController_StreamSubscriptionsClient{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_StreamSubscriptionsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_StreamSubscriptionsClient) EXPECT() *MockTriggerController_StreamSubscriptionsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockTriggerController_StreamSubscriptionsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_StreamSubscriptionsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockTriggerController_StreamSubscriptionsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsClient) Recv() (*StreamSubscriptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*StreamSubscriptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockTriggerController_StreamSubscriptionsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_StreamSubscriptionsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_StreamSubscriptionsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_StreamSubscriptionsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_StreamSubscriptionsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockTriggerController_StreamSubscriptionsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsClient)(nil).Trailer))
+}
+
 // MockTriggerControllerServer is a mock of TriggerControllerServer interface.
 type MockTriggerControllerServer struct {
 	ctrl     *gomock.Controller
@@ -1419,6 +2528,36 @@ func (m *MockTriggerControllerServer) EXPECT() *MockTriggerControllerServerMockR
 	return m.recorder
 }
 
+// AnnotateSubscription mocks base method.
+func (m *MockTriggerControllerServer) AnnotateSubscription(arg0 context.Context, arg1 *AnnotateSubscriptionRequest) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnnotateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnnotateSubscription indicates an expected call of AnnotateSubscription.
+func (mr *MockTriggerControllerServerMockRecorder) AnnotateSubscription(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnnotateSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).AnnotateSubscription), arg0, arg1)
+}
+
+// ApproveRebalance mocks base method.
+func (m *MockTriggerControllerServer) ApproveRebalance(arg0 context.Context, arg1 *ApproveRebalanceRequest) (*RebalancePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveRebalance", arg0, arg1)
+	ret0, _ := ret[0].(*RebalancePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveRebalance indicates an expected call of ApproveRebalance.
+func (mr *MockTriggerControllerServerMockRecorder) ApproveRebalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRebalance", reflect.TypeOf((*MockTriggerControllerServer)(nil).ApproveRebalance), arg0, arg1)
+}
+
 // CommitOffset mocks base method.
 func (m *MockTriggerControllerServer) CommitOffset(arg0 context.Context, arg1 *CommitOffsetRequest) (*CommitOffsetResponse, error) {
 	m.ctrl.T.Helper()
@@ -1479,7 +2618,22 @@ func (mr *MockTriggerControllerServerMockRecorder) DisableSubscription(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).DisableSubscription), arg0, arg1)
 }
 
-// GetSubscription mocks base method.
+// ExportOffsets mocks base method.
+func (m *MockTriggerControllerServer) ExportOffsets(arg0 context.Context, arg1 *ExportOffsetsRequest) (*ExportOffsetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOffsets", arg0, arg1)
+	ret0, _ := ret[0].(*ExportOffsetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOffsets indicates an expected call of ExportOffsets.
+func (mr *MockTriggerControllerServerMockRecorder) ExportOffsets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOffsets", reflect.TypeOf((*MockTriggerControllerServer)(nil).ExportOffsets), arg0, arg1)
+}
+
+// GetSubscription mocks base method.
 func (m *MockTriggerControllerServer) GetSubscription(arg0 context.Context, arg1 *GetSubscriptionRequest) (*meta.Subscription, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSubscription", arg0, arg1)
@@ -1494,6 +2648,36 @@ func (mr *MockTriggerControllerServerMockRecorder) GetSubscription(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).GetSubscription), arg0, arg1)
 }
 
+// ImportOffsets mocks base method.
+func (m *MockTriggerControllerServer) ImportOffsets(arg0 context.Context, arg1 *ImportOffsetsRequest) (*ImportOffsetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportOffsets", arg0, arg1)
+	ret0, _ := ret[0].(*ImportOffsetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportOffsets indicates an expected call of ImportOffsets.
+func (mr *MockTriggerControllerServerMockRecorder) ImportOffsets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportOffsets", reflect.TypeOf((*MockTriggerControllerServer)(nil).ImportOffsets), arg0, arg1)
+}
+
+// ListNamespaceUsage mocks base method.
+func (m *MockTriggerControllerServer) ListNamespaceUsage(arg0 context.Context, arg1 *ListNamespaceUsageRequest) (*ListNamespaceUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNamespaceUsage", arg0, arg1)
+	ret0, _ := ret[0].(*ListNamespaceUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNamespaceUsage indicates an expected call of ListNamespaceUsage.
+func (mr *MockTriggerControllerServerMockRecorder) ListNamespaceUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNamespaceUsage", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListNamespaceUsage), arg0, arg1)
+}
+
 // ListSubscription mocks base method.
 func (m *MockTriggerControllerServer) ListSubscription(arg0 context.Context, arg1 *emptypb.Empty) (*ListSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1509,6 +2693,51 @@ func (mr *MockTriggerControllerServerMockRecorder) ListSubscription(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListSubscription), arg0, arg1)
 }
 
+// ListSubscriptionSLO mocks base method.
+func (m *MockTriggerControllerServer) ListSubscriptionSLO(arg0 context.Context, arg1 *ListSLORequest) (*ListSLOResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptionSLO", arg0, arg1)
+	ret0, _ := ret[0].(*ListSLOResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptionSLO indicates an expected call of ListSubscriptionSLO.
+func (mr *MockTriggerControllerServerMockRecorder) ListSubscriptionSLO(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionSLO", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListSubscriptionSLO), arg0, arg1)
+}
+
+// ListTriggerWorker mocks base method.
+func (m *MockTriggerControllerServer) ListTriggerWorker(arg0 context.Context, arg1 *emptypb.Empty) (*ListTriggerWorkerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTriggerWorker", arg0, arg1)
+	ret0, _ := ret[0].(*ListTriggerWorkerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTriggerWorker indicates an expected call of ListTriggerWorker.
+func (mr *MockTriggerControllerServerMockRecorder) ListTriggerWorker(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTriggerWorker", reflect.TypeOf((*MockTriggerControllerServer)(nil).ListTriggerWorker), arg0, arg1)
+}
+
+// PlanRebalance mocks base method.
+func (m *MockTriggerControllerServer) PlanRebalance(arg0 context.Context, arg1 *PlanRebalanceRequest) (*RebalancePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanRebalance", arg0, arg1)
+	ret0, _ := ret[0].(*RebalancePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanRebalance indicates an expected call of PlanRebalance.
+func (mr *MockTriggerControllerServerMockRecorder) PlanRebalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanRebalance", reflect.TypeOf((*MockTriggerControllerServer)(nil).PlanRebalance), arg0, arg1)
+}
+
 // RegisterTriggerWorker mocks base method.
 func (m *MockTriggerControllerServer) RegisterTriggerWorker(arg0 context.Context, arg1 *RegisterTriggerWorkerRequest) (*RegisterTriggerWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -1539,6 +2768,21 @@ func (mr *MockTriggerControllerServerMockRecorder) ResetOffsetToTimestamp(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetOffsetToTimestamp", reflect.TypeOf((*MockTriggerControllerServer)(nil).ResetOffsetToTimestamp), arg0, arg1)
 }
 
+// ResumeEventlogs mocks base method.
+func (m *MockTriggerControllerServer) ResumeEventlogs(arg0 context.Context, arg1 *ResumeEventlogsRequest) (*ResumeEventlogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeEventlogs", arg0, arg1)
+	ret0, _ := ret[0].(*ResumeEventlogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeEventlogs indicates an expected call of ResumeEventlogs.
+func (mr *MockTriggerControllerServerMockRecorder) ResumeEventlogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeEventlogs", reflect.TypeOf((*MockTriggerControllerServer)(nil).ResumeEventlogs), arg0, arg1)
+}
+
 // ResumeSubscription mocks base method.
 func (m *MockTriggerControllerServer) ResumeSubscription(arg0 context.Context, arg1 *ResumeSubscriptionRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -1554,6 +2798,35 @@ func (mr *MockTriggerControllerServerMockRecorder) ResumeSubscription(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSubscription", reflect.TypeOf((*MockTriggerControllerServer)(nil).ResumeSubscription), arg0, arg1)
 }
 
+// RotateSigningKey mocks base method.
+func (m *MockTriggerControllerServer) RotateSigningKey(arg0 context.Context, arg1 *RotateSigningKeyRequest) (*meta.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSigningKey", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateSigningKey indicates an expected call of RotateSigningKey.
+func (mr *MockTriggerControllerServerMockRecorder) RotateSigningKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSigningKey", reflect.TypeOf((*MockTriggerControllerServer)(nil).RotateSigningKey), arg0, arg1)
+}
+
+// StreamSubscriptions mocks base method.
+func (m *MockTriggerControllerServer) StreamSubscriptions(arg0 *StreamListRequest, arg1 TriggerController_StreamSubscriptionsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamSubscriptions indicates an expected call of StreamSubscriptions.
+func (mr *MockTriggerControllerServerMockRecorder) StreamSubscriptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamSubscriptions", reflect.TypeOf((*MockTriggerControllerServer)(nil).StreamSubscriptions), arg0, arg1)
+}
+
 // TriggerWorkerHeartbeat mocks base method.
 func (m *MockTriggerControllerServer) TriggerWorkerHeartbeat(arg0 TriggerController_TriggerWorkerHeartbeatServer) error {
 	m.ctrl.T.Helper()
@@ -1732,6 +3005,125 @@ func (mr *MockTriggerController_TriggerWorkerHeartbeatServerMockRecorder) SetTra
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTriggerController_TriggerWorkerHeartbeatServer)(nil).SetTrailer), arg0)
 }
 
+// MockTriggerController_StreamSubscriptionsServer is a mock of TriggerController_StreamSubscriptionsServer interface.
+type MockTriggerController_StreamSubscriptionsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockTriggerController_StreamSubscriptionsServerMockRecorder
+}
+
+// MockTriggerController_StreamSubscriptionsServerMockRecorder is the mock recorder for MockTriggerController_StreamSubscriptionsServer.
+type MockTriggerController_StreamSubscriptionsServerMockRecorder struct {
+	mock *MockTriggerController_StreamSubscriptionsServer
+}
+
+// NewMockTriggerController_StreamSubscriptionsServer creates a new mock instance.
+func NewMockTriggerController_StreamSubscriptionsServer(ctrl *gomock.Controller) *MockTriggerController_StreamSubscriptionsServer {
+	mock := &MockTriggerController_StreamSubscriptionsServer{ctrl: ctrl}
+	mock.recorder = &MockTriggerController_StreamSubscriptionsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTriggerController_StreamSubscriptionsServer) EXPECT() *MockTriggerController_StreamSubscriptionsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockTriggerController_StreamSubscriptionsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockTriggerController_StreamSubscriptionsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockTriggerController_StreamSubscriptionsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsServer) Send(arg0 *StreamSubscriptionsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockTriggerController_StreamSubscriptionsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockTriggerController_StreamSubscriptionsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockTriggerController_StreamSubscriptionsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockTriggerController_StreamSubscriptionsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockTriggerController_StreamSubscriptionsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockTriggerController_StreamSubscriptionsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockTriggerController_StreamSubscriptionsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockTriggerController_StreamSubscriptionsServer)(nil).SetTrailer), arg0)
+}
+
 // MockSnowflakeControllerClient is a mock of SnowflakeControllerClient interface.
 type MockSnowflakeControllerClient struct {
 	ctrl     *gomock.Controller
@@ -1882,3 +3274,351 @@ func (mr *MockSnowflakeControllerServerMockRecorder) UnregisterNode(arg0, arg1 i
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterNode", reflect.TypeOf((*MockSnowflakeControllerServer)(nil).UnregisterNode), arg0, arg1)
 }
+
+// MockJobControllerClient is a mock of JobControllerClient interface.
+type MockJobControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobControllerClientMockRecorder
+}
+
+// MockJobControllerClientMockRecorder is the mock recorder for MockJobControllerClient.
+type MockJobControllerClientMockRecorder struct {
+	mock *MockJobControllerClient
+}
+
+// NewMockJobControllerClient creates a new mock instance.
+func NewMockJobControllerClient(ctrl *gomock.Controller) *MockJobControllerClient {
+	mock := &MockJobControllerClient{ctrl: ctrl}
+	mock.recorder = &MockJobControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobControllerClient) EXPECT() *MockJobControllerClientMockRecorder {
+	return m.recorder
+}
+
+// CancelJob mocks base method.
+func (m *MockJobControllerClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CancelJob", varargs...)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockJobControllerClientMockRecorder) CancelJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockJobControllerClient)(nil).CancelJob), varargs...)
+}
+
+// GetJob mocks base method.
+func (m *MockJobControllerClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetJob", varargs...)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockJobControllerClientMockRecorder) GetJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobControllerClient)(nil).GetJob), varargs...)
+}
+
+// ListJob mocks base method.
+func (m *MockJobControllerClient) ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*ListJobResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListJob", varargs...)
+	ret0, _ := ret[0].(*ListJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJob indicates an expected call of ListJob.
+func (mr *MockJobControllerClientMockRecorder) ListJob(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJob", reflect.TypeOf((*MockJobControllerClient)(nil).ListJob), varargs...)
+}
+
+// MockJobControllerServer is a mock of JobControllerServer interface.
+type MockJobControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobControllerServerMockRecorder
+}
+
+// MockJobControllerServerMockRecorder is the mock recorder for MockJobControllerServer.
+type MockJobControllerServerMockRecorder struct {
+	mock *MockJobControllerServer
+}
+
+// NewMockJobControllerServer creates a new mock instance.
+func NewMockJobControllerServer(ctrl *gomock.Controller) *MockJobControllerServer {
+	mock := &MockJobControllerServer{ctrl: ctrl}
+	mock.recorder = &MockJobControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobControllerServer) EXPECT() *MockJobControllerServerMockRecorder {
+	return m.recorder
+}
+
+// CancelJob mocks base method.
+func (m *MockJobControllerServer) CancelJob(arg0 context.Context, arg1 *CancelJobRequest) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelJob", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelJob indicates an expected call of CancelJob.
+func (mr *MockJobControllerServerMockRecorder) CancelJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelJob", reflect.TypeOf((*MockJobControllerServer)(nil).CancelJob), arg0, arg1)
+}
+
+// GetJob mocks base method.
+func (m *MockJobControllerServer) GetJob(arg0 context.Context, arg1 *GetJobRequest) (*meta.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", arg0, arg1)
+	ret0, _ := ret[0].(*meta.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockJobControllerServerMockRecorder) GetJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockJobControllerServer)(nil).GetJob), arg0, arg1)
+}
+
+// ListJob mocks base method.
+func (m *MockJobControllerServer) ListJob(arg0 context.Context, arg1 *ListJobRequest) (*ListJobResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJob", arg0, arg1)
+	ret0, _ := ret[0].(*ListJobResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJob indicates an expected call of ListJob.
+func (mr *MockJobControllerServerMockRecorder) ListJob(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJob", reflect.TypeOf((*MockJobControllerServer)(nil).ListJob), arg0, arg1)
+}
+
+// MockFeatureGateControllerClient is a mock of FeatureGateControllerClient interface.
+type MockFeatureGateControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeatureGateControllerClientMockRecorder
+}
+
+// MockFeatureGateControllerClientMockRecorder is the mock recorder for MockFeatureGateControllerClient.
+type MockFeatureGateControllerClientMockRecorder struct {
+	mock *MockFeatureGateControllerClient
+}
+
+// NewMockFeatureGateControllerClient creates a new mock instance.
+func NewMockFeatureGateControllerClient(ctrl *gomock.Controller) *MockFeatureGateControllerClient {
+	mock := &MockFeatureGateControllerClient{ctrl: ctrl}
+	mock.recorder = &MockFeatureGateControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeatureGateControllerClient) EXPECT() *MockFeatureGateControllerClientMockRecorder {
+	return m.recorder
+}
+
+// ListFeatureGates mocks base method.
+func (m *MockFeatureGateControllerClient) ListFeatureGates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListFeatureGatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFeatureGates", varargs...)
+	ret0, _ := ret[0].(*ListFeatureGatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatureGates indicates an expected call of ListFeatureGates.
+func (mr *MockFeatureGateControllerClientMockRecorder) ListFeatureGates(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatureGates", reflect.TypeOf((*MockFeatureGateControllerClient)(nil).ListFeatureGates), varargs...)
+}
+
+// SetFeatureGate mocks base method.
+func (m *MockFeatureGateControllerClient) SetFeatureGate(ctx context.Context, in *SetFeatureGateRequest, opts ...grpc.CallOption) (*meta.FeatureGate, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetFeatureGate", varargs...)
+	ret0, _ := ret[0].(*meta.FeatureGate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFeatureGate indicates an expected call of SetFeatureGate.
+func (mr *MockFeatureGateControllerClientMockRecorder) SetFeatureGate(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeatureGate", reflect.TypeOf((*MockFeatureGateControllerClient)(nil).SetFeatureGate), varargs...)
+}
+
+// MockFeatureGateControllerServer is a mock of FeatureGateControllerServer interface.
+type MockFeatureGateControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeatureGateControllerServerMockRecorder
+}
+
+// MockFeatureGateControllerServerMockRecorder is the mock recorder for MockFeatureGateControllerServer.
+type MockFeatureGateControllerServerMockRecorder struct {
+	mock *MockFeatureGateControllerServer
+}
+
+// NewMockFeatureGateControllerServer creates a new mock instance.
+func NewMockFeatureGateControllerServer(ctrl *gomock.Controller) *MockFeatureGateControllerServer {
+	mock := &MockFeatureGateControllerServer{ctrl: ctrl}
+	mock.recorder = &MockFeatureGateControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeatureGateControllerServer) EXPECT() *MockFeatureGateControllerServerMockRecorder {
+	return m.recorder
+}
+
+// ListFeatureGates mocks base method.
+func (m *MockFeatureGateControllerServer) ListFeatureGates(arg0 context.Context, arg1 *emptypb.Empty) (*ListFeatureGatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFeatureGates", arg0, arg1)
+	ret0, _ := ret[0].(*ListFeatureGatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFeatureGates indicates an expected call of ListFeatureGates.
+func (mr *MockFeatureGateControllerServerMockRecorder) ListFeatureGates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFeatureGates", reflect.TypeOf((*MockFeatureGateControllerServer)(nil).ListFeatureGates), arg0, arg1)
+}
+
+// SetFeatureGate mocks base method.
+func (m *MockFeatureGateControllerServer) SetFeatureGate(arg0 context.Context, arg1 *SetFeatureGateRequest) (*meta.FeatureGate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFeatureGate", arg0, arg1)
+	ret0, _ := ret[0].(*meta.FeatureGate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFeatureGate indicates an expected call of SetFeatureGate.
+func (mr *MockFeatureGateControllerServerMockRecorder) SetFeatureGate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFeatureGate", reflect.TypeOf((*MockFeatureGateControllerServer)(nil).SetFeatureGate), arg0, arg1)
+}
+
+// MockUsageControllerClient is a mock of UsageControllerClient interface.
+type MockUsageControllerClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageControllerClientMockRecorder
+}
+
+// MockUsageControllerClientMockRecorder is the mock recorder for MockUsageControllerClient.
+type MockUsageControllerClientMockRecorder struct {
+	mock *MockUsageControllerClient
+}
+
+// NewMockUsageControllerClient creates a new mock instance.
+func NewMockUsageControllerClient(ctrl *gomock.Controller) *MockUsageControllerClient {
+	mock := &MockUsageControllerClient{ctrl: ctrl}
+	mock.recorder = &MockUsageControllerClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageControllerClient) EXPECT() *MockUsageControllerClientMockRecorder {
+	return m.recorder
+}
+
+// ListTopUsage mocks base method.
+func (m *MockUsageControllerClient) ListTopUsage(ctx context.Context, in *ListTopUsageRequest, opts ...grpc.CallOption) (*ListTopUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTopUsage", varargs...)
+	ret0, _ := ret[0].(*ListTopUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopUsage indicates an expected call of ListTopUsage.
+func (mr *MockUsageControllerClientMockRecorder) ListTopUsage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopUsage", reflect.TypeOf((*MockUsageControllerClient)(nil).ListTopUsage), varargs...)
+}
+
+// MockUsageControllerServer is a mock of UsageControllerServer interface.
+type MockUsageControllerServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsageControllerServerMockRecorder
+}
+
+// MockUsageControllerServerMockRecorder is the mock recorder for MockUsageControllerServer.
+type MockUsageControllerServerMockRecorder struct {
+	mock *MockUsageControllerServer
+}
+
+// NewMockUsageControllerServer creates a new mock instance.
+func NewMockUsageControllerServer(ctrl *gomock.Controller) *MockUsageControllerServer {
+	mock := &MockUsageControllerServer{ctrl: ctrl}
+	mock.recorder = &MockUsageControllerServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsageControllerServer) EXPECT() *MockUsageControllerServerMockRecorder {
+	return m.recorder
+}
+
+// ListTopUsage mocks base method.
+func (m *MockUsageControllerServer) ListTopUsage(arg0 context.Context, arg1 *ListTopUsageRequest) (*ListTopUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTopUsage", arg0, arg1)
+	ret0, _ := ret[0].(*ListTopUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTopUsage indicates an expected call of ListTopUsage.
+func (mr *MockUsageControllerServerMockRecorder) ListTopUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTopUsage", reflect.TypeOf((*MockUsageControllerServer)(nil).ListTopUsage), arg0, arg1)
+}
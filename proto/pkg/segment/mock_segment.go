@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -36,6 +37,26 @@ func (m *MockSegmentServerClient) EXPECT() *MockSegmentServerClientMockRecorder
 	return m.recorder
 }
 
+// AbortInflightRequests mocks base method.
+func (m *MockSegmentServerClient) AbortInflightRequests(ctx context.Context, in *AbortInflightRequestsRequest, opts ...grpc.CallOption) (*AbortInflightRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AbortInflightRequests", varargs...)
+	ret0, _ := ret[0].(*AbortInflightRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortInflightRequests indicates an expected call of AbortInflightRequests.
+func (mr *MockSegmentServerClientMockRecorder) AbortInflightRequests(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortInflightRequests", reflect.TypeOf((*MockSegmentServerClient)(nil).AbortInflightRequests), varargs...)
+}
+
 // ActivateSegment mocks base method.
 func (m *MockSegmentServerClient) ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error) {
 	m.ctrl.T.Helper()
@@ -76,6 +97,26 @@ func (mr *MockSegmentServerClientMockRecorder) AppendToBlock(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).AppendToBlock), varargs...)
 }
 
+// CopyBlock mocks base method.
+func (m *MockSegmentServerClient) CopyBlock(ctx context.Context, in *CopyBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CopyBlock", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyBlock indicates an expected call of CopyBlock.
+func (mr *MockSegmentServerClientMockRecorder) CopyBlock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).CopyBlock), varargs...)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerClient) CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +137,46 @@ func (mr *MockSegmentServerClientMockRecorder) CreateBlock(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).CreateBlock), varargs...)
 }
 
+// ExportBlock mocks base method.
+func (m *MockSegmentServerClient) ExportBlock(ctx context.Context, in *ExportBlockRequest, opts ...grpc.CallOption) (SegmentServer_ExportBlockClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportBlock", varargs...)
+	ret0, _ := ret[0].(SegmentServer_ExportBlockClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBlock indicates an expected call of ExportBlock.
+func (mr *MockSegmentServerClientMockRecorder) ExportBlock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).ExportBlock), varargs...)
+}
+
+// ExportBlockManifest mocks base method.
+func (m *MockSegmentServerClient) ExportBlockManifest(ctx context.Context, in *ExportBlockManifestRequest, opts ...grpc.CallOption) (*BlockManifest, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportBlockManifest", varargs...)
+	ret0, _ := ret[0].(*BlockManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBlockManifest indicates an expected call of ExportBlockManifest.
+func (mr *MockSegmentServerClientMockRecorder) ExportBlockManifest(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBlockManifest", reflect.TypeOf((*MockSegmentServerClient)(nil).ExportBlockManifest), varargs...)
+}
+
 // GetBlockInfo mocks base method.
 func (m *MockSegmentServerClient) GetBlockInfo(ctx context.Context, in *GetBlockInfoRequest, opts ...grpc.CallOption) (*GetBlockInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +197,26 @@ func (mr *MockSegmentServerClientMockRecorder) GetBlockInfo(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockSegmentServerClient)(nil).GetBlockInfo), varargs...)
 }
 
+// GetBlockStats mocks base method.
+func (m *MockSegmentServerClient) GetBlockStats(ctx context.Context, in *GetBlockStatsRequest, opts ...grpc.CallOption) (*GetBlockStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBlockStats", varargs...)
+	ret0, _ := ret[0].(*GetBlockStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockStats indicates an expected call of GetBlockStats.
+func (mr *MockSegmentServerClientMockRecorder) GetBlockStats(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockStats", reflect.TypeOf((*MockSegmentServerClient)(nil).GetBlockStats), varargs...)
+}
+
 // InactivateSegment mocks base method.
 func (m *MockSegmentServerClient) InactivateSegment(ctx context.Context, in *InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +237,46 @@ func (mr *MockSegmentServerClientMockRecorder) InactivateSegment(ctx, in interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InactivateSegment", reflect.TypeOf((*MockSegmentServerClient)(nil).InactivateSegment), varargs...)
 }
 
+// ListInflightRequests mocks base method.
+func (m *MockSegmentServerClient) ListInflightRequests(ctx context.Context, in *ListInflightRequestsRequest, opts ...grpc.CallOption) (*ListInflightRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInflightRequests", varargs...)
+	ret0, _ := ret[0].(*ListInflightRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInflightRequests indicates an expected call of ListInflightRequests.
+func (mr *MockSegmentServerClientMockRecorder) ListInflightRequests(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInflightRequests", reflect.TypeOf((*MockSegmentServerClient)(nil).ListInflightRequests), varargs...)
+}
+
+// LookupKeyInBlock mocks base method.
+func (m *MockSegmentServerClient) LookupKeyInBlock(ctx context.Context, in *LookupKeyInBlockRequest, opts ...grpc.CallOption) (*LookupKeyInBlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LookupKeyInBlock", varargs...)
+	ret0, _ := ret[0].(*LookupKeyInBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupKeyInBlock indicates an expected call of LookupKeyInBlock.
+func (mr *MockSegmentServerClientMockRecorder) LookupKeyInBlock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupKeyInBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).LookupKeyInBlock), varargs...)
+}
+
 // LookupOffsetInBlock mocks base method.
 func (m *MockSegmentServerClient) LookupOffsetInBlock(ctx context.Context, in *LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*LookupOffsetInBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -156,6 +297,26 @@ func (mr *MockSegmentServerClientMockRecorder) LookupOffsetInBlock(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffsetInBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).LookupOffsetInBlock), varargs...)
 }
 
+// PrefetchBlock mocks base method.
+func (m *MockSegmentServerClient) PrefetchBlock(ctx context.Context, in *PrefetchBlockRequest, opts ...grpc.CallOption) (*PrefetchBlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PrefetchBlock", varargs...)
+	ret0, _ := ret[0].(*PrefetchBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrefetchBlock indicates an expected call of PrefetchBlock.
+func (mr *MockSegmentServerClientMockRecorder) PrefetchBlock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefetchBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).PrefetchBlock), varargs...)
+}
+
 // ReadFromBlock mocks base method.
 func (m *MockSegmentServerClient) ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -176,6 +337,26 @@ func (mr *MockSegmentServerClientMockRecorder) ReadFromBlock(ctx, in interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).ReadFromBlock), varargs...)
 }
 
+// ReadFromBlockStream mocks base method.
+func (m *MockSegmentServerClient) ReadFromBlockStream(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (SegmentServer_ReadFromBlockStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadFromBlockStream", varargs...)
+	ret0, _ := ret[0].(SegmentServer_ReadFromBlockStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFromBlockStream indicates an expected call of ReadFromBlockStream.
+func (mr *MockSegmentServerClientMockRecorder) ReadFromBlockStream(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlockStream", reflect.TypeOf((*MockSegmentServerClient)(nil).ReadFromBlockStream), varargs...)
+}
+
 // RemoveBlock mocks base method.
 func (m *MockSegmentServerClient) RemoveBlock(ctx context.Context, in *RemoveBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +377,26 @@ func (mr *MockSegmentServerClientMockRecorder) RemoveBlock(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockSegmentServerClient)(nil).RemoveBlock), varargs...)
 }
 
+// RenewWriteLeases mocks base method.
+func (m *MockSegmentServerClient) RenewWriteLeases(ctx context.Context, in *RenewWriteLeasesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RenewWriteLeases", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewWriteLeases indicates an expected call of RenewWriteLeases.
+func (mr *MockSegmentServerClientMockRecorder) RenewWriteLeases(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewWriteLeases", reflect.TypeOf((*MockSegmentServerClient)(nil).RenewWriteLeases), varargs...)
+}
+
 // Start mocks base method.
 func (m *MockSegmentServerClient) Start(ctx context.Context, in *StartSegmentServerRequest, opts ...grpc.CallOption) (*StartSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +457,252 @@ func (mr *MockSegmentServerClientMockRecorder) Stop(ctx, in interface{}, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSegmentServerClient)(nil).Stop), varargs...)
 }
 
+// MockSegmentServer_ExportBlockClient is a mock of SegmentServer_ExportBlockClient interface.
+type MockSegmentServer_ExportBlockClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentServer_ExportBlockClientMockRecorder
+}
+
+// MockSegmentServer_ExportBlockClientMockRecorder is the mock recorder for MockSegmentServer_ExportBlockClient.
+type MockSegmentServer_ExportBlockClientMockRecorder struct {
+	mock *MockSegmentServer_ExportBlockClient
+}
+
+// NewMockSegmentServer_ExportBlockClient creates a new mock instance.
+func NewMockSegmentServer_ExportBlockClient(ctrl *gomock.Controller) *MockSegmentServer_ExportBlockClient {
+	mock := &MockSegmentServer_ExportBlockClient{ctrl: ctrl}
+	mock.recorder = &MockSegmentServer_ExportBlockClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentServer_ExportBlockClient) EXPECT() *MockSegmentServer_ExportBlockClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockSegmentServer_ExportBlockClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockSegmentServer_ExportBlockClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSegmentServer_ExportBlockClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockSegmentServer_ExportBlockClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentServer_ExportBlockClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentServer_ExportBlockClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockSegmentServer_ExportBlockClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockSegmentServer_ExportBlockClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSegmentServer_ExportBlockClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockSegmentServer_ExportBlockClient) Recv() (*ExportBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*ExportBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSegmentServer_ExportBlockClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSegmentServer_ExportBlockClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentServer_ExportBlockClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentServer_ExportBlockClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentServer_ExportBlockClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentServer_ExportBlockClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentServer_ExportBlockClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentServer_ExportBlockClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockSegmentServer_ExportBlockClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockSegmentServer_ExportBlockClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSegmentServer_ExportBlockClient)(nil).Trailer))
+}
+
+// MockSegmentServer_ReadFromBlockStreamClient is a mock of SegmentServer_ReadFromBlockStreamClient interface.
+type MockSegmentServer_ReadFromBlockStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentServer_ReadFromBlockStreamClientMockRecorder
+}
+
+// MockSegmentServer_ReadFromBlockStreamClientMockRecorder is the mock recorder for MockSegmentServer_ReadFromBlockStreamClient.
+type MockSegmentServer_ReadFromBlockStreamClientMockRecorder struct {
+	mock *MockSegmentServer_ReadFromBlockStreamClient
+}
+
+// NewMockSegmentServer_ReadFromBlockStreamClient creates a new mock instance.
+func NewMockSegmentServer_ReadFromBlockStreamClient(ctrl *gomock.Controller) *MockSegmentServer_ReadFromBlockStreamClient {
+	mock := &MockSegmentServer_ReadFromBlockStreamClient{ctrl: ctrl}
+	mock.recorder = &MockSegmentServer_ReadFromBlockStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentServer_ReadFromBlockStreamClient) EXPECT() *MockSegmentServer_ReadFromBlockStreamClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockSegmentServer_ReadFromBlockStreamClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentServer_ReadFromBlockStreamClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockSegmentServer_ReadFromBlockStreamClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamClient) Recv() (*ReadFromBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*ReadFromBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSegmentServer_ReadFromBlockStreamClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentServer_ReadFromBlockStreamClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentServer_ReadFromBlockStreamClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentServer_ReadFromBlockStreamClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentServer_ReadFromBlockStreamClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockSegmentServer_ReadFromBlockStreamClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamClient)(nil).Trailer))
+}
+
 // MockSegmentServerServer is a mock of SegmentServerServer interface.
 type MockSegmentServerServer struct {
 	ctrl     *gomock.Controller
@@ -279,6 +726,21 @@ func (m *MockSegmentServerServer) EXPECT() *MockSegmentServerServerMockRecorder
 	return m.recorder
 }
 
+// AbortInflightRequests mocks base method.
+func (m *MockSegmentServerServer) AbortInflightRequests(arg0 context.Context, arg1 *AbortInflightRequestsRequest) (*AbortInflightRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortInflightRequests", arg0, arg1)
+	ret0, _ := ret[0].(*AbortInflightRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortInflightRequests indicates an expected call of AbortInflightRequests.
+func (mr *MockSegmentServerServerMockRecorder) AbortInflightRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortInflightRequests", reflect.TypeOf((*MockSegmentServerServer)(nil).AbortInflightRequests), arg0, arg1)
+}
+
 // ActivateSegment mocks base method.
 func (m *MockSegmentServerServer) ActivateSegment(arg0 context.Context, arg1 *ActivateSegmentRequest) (*ActivateSegmentResponse, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +771,21 @@ func (mr *MockSegmentServerServerMockRecorder) AppendToBlock(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendToBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).AppendToBlock), arg0, arg1)
 }
 
+// CopyBlock mocks base method.
+func (m *MockSegmentServerServer) CopyBlock(arg0 context.Context, arg1 *CopyBlockRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyBlock", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyBlock indicates an expected call of CopyBlock.
+func (mr *MockSegmentServerServerMockRecorder) CopyBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).CopyBlock), arg0, arg1)
+}
+
 // CreateBlock mocks base method.
 func (m *MockSegmentServerServer) CreateBlock(arg0 context.Context, arg1 *CreateBlockRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +801,35 @@ func (mr *MockSegmentServerServerMockRecorder) CreateBlock(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).CreateBlock), arg0, arg1)
 }
 
+// ExportBlock mocks base method.
+func (m *MockSegmentServerServer) ExportBlock(arg0 *ExportBlockRequest, arg1 SegmentServer_ExportBlockServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBlock", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportBlock indicates an expected call of ExportBlock.
+func (mr *MockSegmentServerServerMockRecorder) ExportBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).ExportBlock), arg0, arg1)
+}
+
+// ExportBlockManifest mocks base method.
+func (m *MockSegmentServerServer) ExportBlockManifest(arg0 context.Context, arg1 *ExportBlockManifestRequest) (*BlockManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBlockManifest", arg0, arg1)
+	ret0, _ := ret[0].(*BlockManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBlockManifest indicates an expected call of ExportBlockManifest.
+func (mr *MockSegmentServerServerMockRecorder) ExportBlockManifest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBlockManifest", reflect.TypeOf((*MockSegmentServerServer)(nil).ExportBlockManifest), arg0, arg1)
+}
+
 // GetBlockInfo mocks base method.
 func (m *MockSegmentServerServer) GetBlockInfo(arg0 context.Context, arg1 *GetBlockInfoRequest) (*GetBlockInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -339,6 +845,21 @@ func (mr *MockSegmentServerServerMockRecorder) GetBlockInfo(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockSegmentServerServer)(nil).GetBlockInfo), arg0, arg1)
 }
 
+// GetBlockStats mocks base method.
+func (m *MockSegmentServerServer) GetBlockStats(arg0 context.Context, arg1 *GetBlockStatsRequest) (*GetBlockStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockStats", arg0, arg1)
+	ret0, _ := ret[0].(*GetBlockStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockStats indicates an expected call of GetBlockStats.
+func (mr *MockSegmentServerServerMockRecorder) GetBlockStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockStats", reflect.TypeOf((*MockSegmentServerServer)(nil).GetBlockStats), arg0, arg1)
+}
+
 // InactivateSegment mocks base method.
 func (m *MockSegmentServerServer) InactivateSegment(arg0 context.Context, arg1 *InactivateSegmentRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -354,6 +875,36 @@ func (mr *MockSegmentServerServerMockRecorder) InactivateSegment(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InactivateSegment", reflect.TypeOf((*MockSegmentServerServer)(nil).InactivateSegment), arg0, arg1)
 }
 
+// ListInflightRequests mocks base method.
+func (m *MockSegmentServerServer) ListInflightRequests(arg0 context.Context, arg1 *ListInflightRequestsRequest) (*ListInflightRequestsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInflightRequests", arg0, arg1)
+	ret0, _ := ret[0].(*ListInflightRequestsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInflightRequests indicates an expected call of ListInflightRequests.
+func (mr *MockSegmentServerServerMockRecorder) ListInflightRequests(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInflightRequests", reflect.TypeOf((*MockSegmentServerServer)(nil).ListInflightRequests), arg0, arg1)
+}
+
+// LookupKeyInBlock mocks base method.
+func (m *MockSegmentServerServer) LookupKeyInBlock(arg0 context.Context, arg1 *LookupKeyInBlockRequest) (*LookupKeyInBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupKeyInBlock", arg0, arg1)
+	ret0, _ := ret[0].(*LookupKeyInBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupKeyInBlock indicates an expected call of LookupKeyInBlock.
+func (mr *MockSegmentServerServerMockRecorder) LookupKeyInBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupKeyInBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).LookupKeyInBlock), arg0, arg1)
+}
+
 // LookupOffsetInBlock mocks base method.
 func (m *MockSegmentServerServer) LookupOffsetInBlock(arg0 context.Context, arg1 *LookupOffsetInBlockRequest) (*LookupOffsetInBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -369,6 +920,21 @@ func (mr *MockSegmentServerServerMockRecorder) LookupOffsetInBlock(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupOffsetInBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).LookupOffsetInBlock), arg0, arg1)
 }
 
+// PrefetchBlock mocks base method.
+func (m *MockSegmentServerServer) PrefetchBlock(arg0 context.Context, arg1 *PrefetchBlockRequest) (*PrefetchBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrefetchBlock", arg0, arg1)
+	ret0, _ := ret[0].(*PrefetchBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrefetchBlock indicates an expected call of PrefetchBlock.
+func (mr *MockSegmentServerServerMockRecorder) PrefetchBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrefetchBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).PrefetchBlock), arg0, arg1)
+}
+
 // ReadFromBlock mocks base method.
 func (m *MockSegmentServerServer) ReadFromBlock(arg0 context.Context, arg1 *ReadFromBlockRequest) (*ReadFromBlockResponse, error) {
 	m.ctrl.T.Helper()
@@ -384,6 +950,20 @@ func (mr *MockSegmentServerServerMockRecorder) ReadFromBlock(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).ReadFromBlock), arg0, arg1)
 }
 
+// ReadFromBlockStream mocks base method.
+func (m *MockSegmentServerServer) ReadFromBlockStream(arg0 *ReadFromBlockRequest, arg1 SegmentServer_ReadFromBlockStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFromBlockStream", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadFromBlockStream indicates an expected call of ReadFromBlockStream.
+func (mr *MockSegmentServerServerMockRecorder) ReadFromBlockStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFromBlockStream", reflect.TypeOf((*MockSegmentServerServer)(nil).ReadFromBlockStream), arg0, arg1)
+}
+
 // RemoveBlock mocks base method.
 func (m *MockSegmentServerServer) RemoveBlock(arg0 context.Context, arg1 *RemoveBlockRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -399,6 +979,21 @@ func (mr *MockSegmentServerServerMockRecorder) RemoveBlock(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBlock", reflect.TypeOf((*MockSegmentServerServer)(nil).RemoveBlock), arg0, arg1)
 }
 
+// RenewWriteLeases mocks base method.
+func (m *MockSegmentServerServer) RenewWriteLeases(arg0 context.Context, arg1 *RenewWriteLeasesRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenewWriteLeases", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenewWriteLeases indicates an expected call of RenewWriteLeases.
+func (mr *MockSegmentServerServerMockRecorder) RenewWriteLeases(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenewWriteLeases", reflect.TypeOf((*MockSegmentServerServer)(nil).RenewWriteLeases), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockSegmentServerServer) Start(arg0 context.Context, arg1 *StartSegmentServerRequest) (*StartSegmentServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -444,37 +1039,240 @@ func (mr *MockSegmentServerServerMockRecorder) Stop(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockSegmentServerServer)(nil).Stop), arg0, arg1)
 }
 
-// MockUnsafeSegmentServerServer is a mock of UnsafeSegmentServerServer interface.
-type MockUnsafeSegmentServerServer struct {
+// MockSegmentServer_ExportBlockServer is a mock of SegmentServer_ExportBlockServer interface.
+type MockSegmentServer_ExportBlockServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSegmentServer_ExportBlockServerMockRecorder
+}
+
+// MockSegmentServer_ExportBlockServerMockRecorder is the mock recorder for MockSegmentServer_ExportBlockServer.
+type MockSegmentServer_ExportBlockServerMockRecorder struct {
+	mock *MockSegmentServer_ExportBlockServer
+}
+
+// NewMockSegmentServer_ExportBlockServer creates a new mock instance.
+func NewMockSegmentServer_ExportBlockServer(ctrl *gomock.Controller) *MockSegmentServer_ExportBlockServer {
+	mock := &MockSegmentServer_ExportBlockServer{ctrl: ctrl}
+	mock.recorder = &MockSegmentServer_ExportBlockServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSegmentServer_ExportBlockServer) EXPECT() *MockSegmentServer_ExportBlockServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockSegmentServer_ExportBlockServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentServer_ExportBlockServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentServer_ExportBlockServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentServer_ExportBlockServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentServer_ExportBlockServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentServer_ExportBlockServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockSegmentServer_ExportBlockServer) Send(arg0 *ExportBlockResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSegmentServer_ExportBlockServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSegmentServer_ExportBlockServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockSegmentServer_ExportBlockServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockSegmentServer_ExportBlockServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSegmentServer_ExportBlockServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentServer_ExportBlockServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentServer_ExportBlockServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentServer_ExportBlockServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockSegmentServer_ExportBlockServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockSegmentServer_ExportBlockServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSegmentServer_ExportBlockServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockSegmentServer_ExportBlockServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockSegmentServer_ExportBlockServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSegmentServer_ExportBlockServer)(nil).SetTrailer), arg0)
+}
+
+// MockSegmentServer_ReadFromBlockStreamServer is a mock of SegmentServer_ReadFromBlockStreamServer interface.
+type MockSegmentServer_ReadFromBlockStreamServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockUnsafeSegmentServerServerMockRecorder
+	recorder *MockSegmentServer_ReadFromBlockStreamServerMockRecorder
 }
 
-// MockUnsafeSegmentServerServerMockRecorder is the mock recorder for MockUnsafeSegmentServerServer.
-type MockUnsafeSegmentServerServerMockRecorder struct {
-	mock *MockUnsafeSegmentServerServer
+// MockSegmentServer_ReadFromBlockStreamServerMockRecorder is the mock recorder for MockSegmentServer_ReadFromBlockStreamServer.
+type MockSegmentServer_ReadFromBlockStreamServerMockRecorder struct {
+	mock *MockSegmentServer_ReadFromBlockStreamServer
 }
 
-// NewMockUnsafeSegmentServerServer creates a new mock instance.
-func NewMockUnsafeSegmentServerServer(ctrl *gomock.Controller) *MockUnsafeSegmentServerServer {
-	mock := &MockUnsafeSegmentServerServer{ctrl: ctrl}
-	mock.recorder = &MockUnsafeSegmentServerServerMockRecorder{mock}
+// NewMockSegmentServer_ReadFromBlockStreamServer creates a new mock instance.
+func NewMockSegmentServer_ReadFromBlockStreamServer(ctrl *gomock.Controller) *MockSegmentServer_ReadFromBlockStreamServer {
+	mock := &MockSegmentServer_ReadFromBlockStreamServer{ctrl: ctrl}
+	mock.recorder = &MockSegmentServer_ReadFromBlockStreamServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUnsafeSegmentServerServer) EXPECT() *MockUnsafeSegmentServerServerMockRecorder {
+func (m *MockSegmentServer_ReadFromBlockStreamServer) EXPECT() *MockSegmentServer_ReadFromBlockStreamServerMockRecorder {
 	return m.recorder
 }
 
-// mustEmbedUnimplementedSegmentServerServer mocks base method.
-func (m *MockUnsafeSegmentServerServer) mustEmbedUnimplementedSegmentServerServer() {
+// Context mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockSegmentServer_ReadFromBlockStreamServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockSegmentServer_ReadFromBlockStreamServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSegmentServer_ReadFromBlockStreamServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamServer) Send(arg0 *ReadFromBlockResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSegmentServer_ReadFromBlockStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockSegmentServer_ReadFromBlockStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockSegmentServer_ReadFromBlockStreamServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockSegmentServer_ReadFromBlockStreamServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockSegmentServer_ReadFromBlockStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockSegmentServer_ReadFromBlockStreamServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedSegmentServerServer")
+	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
-// mustEmbedUnimplementedSegmentServerServer indicates an expected call of mustEmbedUnimplementedSegmentServerServer.
-func (mr *MockUnsafeSegmentServerServerMockRecorder) mustEmbedUnimplementedSegmentServerServer() *gomock.Call {
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockSegmentServer_ReadFromBlockStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSegmentServerServer", reflect.TypeOf((*MockUnsafeSegmentServerServer)(nil).mustEmbedUnimplementedSegmentServerServer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSegmentServer_ReadFromBlockStreamServer)(nil).SetTrailer), arg0)
 }
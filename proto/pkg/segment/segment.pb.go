@@ -24,6 +24,7 @@ import (
 	context "context"
 	cloudevents "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
 	config "github.com/linkall-labs/vanus/proto/pkg/config"
+	meta "github.com/linkall-labs/vanus/proto/pkg/meta"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -217,6 +218,8 @@ type CreateBlockRequest struct {
 
 	Id   uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Size int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// the block is created in the block engine of the mode.
+	StorageMode meta.StorageMode `protobuf:"varint,3,opt,name=storage_mode,json=storageMode,proto3,enum=linkall.vanus.meta.StorageMode" json:"storage_mode,omitempty"`
 }
 
 func (x *CreateBlockRequest) Reset() {
@@ -265,6 +268,13 @@ func (x *CreateBlockRequest) GetSize() int64 {
 	return 0
 }
 
+func (x *CreateBlockRequest) GetStorageMode() meta.StorageMode {
+	if x != nil {
+		return x.StorageMode
+	}
+	return meta.StorageMode_STORAGE_DURABLE
+}
+
 type RemoveBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -316,6 +326,9 @@ type GetBlockInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// empty means all blocks in the server.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
 func (x *GetBlockInfoRequest) Reset() {
@@ -350,10 +363,19 @@ func (*GetBlockInfoRequest) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{6}
 }
 
+func (x *GetBlockInfoRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
 type GetBlockInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Blocks []*meta.SegmentHealthInfo `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
 func (x *GetBlockInfoResponse) Reset() {
@@ -388,19 +410,24 @@ func (*GetBlockInfoResponse) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{7}
 }
 
-type ActivateSegmentRequest struct {
+func (x *GetBlockInfoResponse) GetBlocks() []*meta.SegmentHealthInfo {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type GetBlockStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventLogId     uint64 `protobuf:"varint,1,opt,name=event_log_id,json=eventLogId,proto3" json:"event_log_id,omitempty"`
-	ReplicaGroupId uint64 `protobuf:"varint,2,opt,name=replica_group_id,json=replicaGroupId,proto3" json:"replica_group_id,omitempty"`
-	// block ID and its server endpoint.
-	Replicas map[uint64]string `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// empty means all blocks in the server.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *ActivateSegmentRequest) Reset() {
-	*x = ActivateSegmentRequest{}
+func (x *GetBlockStatsRequest) Reset() {
+	*x = GetBlockStatsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_segment_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -408,13 +435,13 @@ func (x *ActivateSegmentRequest) Reset() {
 	}
 }
 
-func (x *ActivateSegmentRequest) String() string {
+func (x *GetBlockStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateSegmentRequest) ProtoMessage() {}
+func (*GetBlockStatsRequest) ProtoMessage() {}
 
-func (x *ActivateSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBlockStatsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_segment_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -426,40 +453,43 @@ func (x *ActivateSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateSegmentRequest.ProtoReflect.Descriptor instead.
-func (*ActivateSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockStatsRequest) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ActivateSegmentRequest) GetEventLogId() uint64 {
-	if x != nil {
-		return x.EventLogId
-	}
-	return 0
-}
-
-func (x *ActivateSegmentRequest) GetReplicaGroupId() uint64 {
-	if x != nil {
-		return x.ReplicaGroupId
-	}
-	return 0
-}
-
-func (x *ActivateSegmentRequest) GetReplicas() map[uint64]string {
+func (x *GetBlockStatsRequest) GetIds() []uint64 {
 	if x != nil {
-		return x.Replicas
+		return x.Ids
 	}
 	return nil
 }
 
-type ActivateSegmentResponse struct {
+type BlockStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *ActivateSegmentResponse) Reset() {
-	*x = ActivateSegmentResponse{}
+	Id       uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Capacity int64  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// size is bytes used by events.
+	Size        int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	EventNumber int32 `protobuf:"varint,4,opt,name=event_number,json=eventNumber,proto3" json:"event_number,omitempty"`
+	IsFull      bool  `protobuf:"varint,5,opt,name=is_full,json=isFull,proto3" json:"is_full,omitempty"`
+	// Unix timestamp, unit is millisecond, it's -1 if the block is empty.
+	OldestEventTime int64 `protobuf:"varint,6,opt,name=oldest_event_time,json=oldestEventTime,proto3" json:"oldest_event_time,omitempty"`
+	// Unix timestamp, unit is millisecond, it's -1 if the block is empty.
+	NewestEventTime int64 `protobuf:"varint,7,opt,name=newest_event_time,json=newestEventTime,proto3" json:"newest_event_time,omitempty"`
+	// rates are averaged over the last minute, reads served by the cache are
+	// counted.
+	AppendEventsPerSecond float64 `protobuf:"fixed64,8,opt,name=append_events_per_second,json=appendEventsPerSecond,proto3" json:"append_events_per_second,omitempty"`
+	AppendBytesPerSecond  float64 `protobuf:"fixed64,9,opt,name=append_bytes_per_second,json=appendBytesPerSecond,proto3" json:"append_bytes_per_second,omitempty"`
+	ReadEventsPerSecond   float64 `protobuf:"fixed64,10,opt,name=read_events_per_second,json=readEventsPerSecond,proto3" json:"read_events_per_second,omitempty"`
+	ReadBytesPerSecond    float64 `protobuf:"fixed64,11,opt,name=read_bytes_per_second,json=readBytesPerSecond,proto3" json:"read_bytes_per_second,omitempty"`
+}
+
+func (x *BlockStats) Reset() {
+	*x = BlockStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_segment_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -467,13 +497,13 @@ func (x *ActivateSegmentResponse) Reset() {
 	}
 }
 
-func (x *ActivateSegmentResponse) String() string {
+func (x *BlockStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivateSegmentResponse) ProtoMessage() {}
+func (*BlockStats) ProtoMessage() {}
 
-func (x *ActivateSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *BlockStats) ProtoReflect() protoreflect.Message {
 	mi := &file_segment_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -485,19 +515,98 @@ func (x *ActivateSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivateSegmentResponse.ProtoReflect.Descriptor instead.
-func (*ActivateSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockStats.ProtoReflect.Descriptor instead.
+func (*BlockStats) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{9}
 }
 
-type InactivateSegmentRequest struct {
+func (x *BlockStats) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BlockStats) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *BlockStats) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BlockStats) GetEventNumber() int32 {
+	if x != nil {
+		return x.EventNumber
+	}
+	return 0
+}
+
+func (x *BlockStats) GetIsFull() bool {
+	if x != nil {
+		return x.IsFull
+	}
+	return false
+}
+
+func (x *BlockStats) GetOldestEventTime() int64 {
+	if x != nil {
+		return x.OldestEventTime
+	}
+	return 0
+}
+
+func (x *BlockStats) GetNewestEventTime() int64 {
+	if x != nil {
+		return x.NewestEventTime
+	}
+	return 0
+}
+
+func (x *BlockStats) GetAppendEventsPerSecond() float64 {
+	if x != nil {
+		return x.AppendEventsPerSecond
+	}
+	return 0
+}
+
+func (x *BlockStats) GetAppendBytesPerSecond() float64 {
+	if x != nil {
+		return x.AppendBytesPerSecond
+	}
+	return 0
+}
+
+func (x *BlockStats) GetReadEventsPerSecond() float64 {
+	if x != nil {
+		return x.ReadEventsPerSecond
+	}
+	return 0
+}
+
+func (x *BlockStats) GetReadBytesPerSecond() float64 {
+	if x != nil {
+		return x.ReadBytesPerSecond
+	}
+	return 0
+}
+
+type GetBlockStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Blocks []*BlockStats `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-func (x *InactivateSegmentRequest) Reset() {
-	*x = InactivateSegmentRequest{}
+func (x *GetBlockStatsResponse) Reset() {
+	*x = GetBlockStatsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_segment_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -505,13 +614,13 @@ func (x *InactivateSegmentRequest) Reset() {
 	}
 }
 
-func (x *InactivateSegmentRequest) String() string {
+func (x *GetBlockStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InactivateSegmentRequest) ProtoMessage() {}
+func (*GetBlockStatsResponse) ProtoMessage() {}
 
-func (x *InactivateSegmentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBlockStatsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_segment_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,19 +632,34 @@ func (x *InactivateSegmentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InactivateSegmentRequest.ProtoReflect.Descriptor instead.
-func (*InactivateSegmentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockStatsResponse) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{10}
 }
 
-type InactivateSegmentResponse struct {
+func (x *GetBlockStatsResponse) GetBlocks() []*BlockStats {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type ExportBlockManifestRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// empty means all sealed blocks in the server, which are paged by
+	// start_after and limit.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// only blocks whose ids are greater than it are exported.
+	StartAfter uint64 `protobuf:"varint,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	// the max number of blocks in a page, 0 is 64.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *InactivateSegmentResponse) Reset() {
-	*x = InactivateSegmentResponse{}
+func (x *ExportBlockManifestRequest) Reset() {
+	*x = ExportBlockManifestRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_segment_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -543,13 +667,13 @@ func (x *InactivateSegmentResponse) Reset() {
 	}
 }
 
-func (x *InactivateSegmentResponse) String() string {
+func (x *ExportBlockManifestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InactivateSegmentResponse) ProtoMessage() {}
+func (*ExportBlockManifestRequest) ProtoMessage() {}
 
-func (x *InactivateSegmentResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportBlockManifestRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_segment_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -561,22 +685,48 @@ func (x *InactivateSegmentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InactivateSegmentResponse.ProtoReflect.Descriptor instead.
-func (*InactivateSegmentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportBlockManifestRequest.ProtoReflect.Descriptor instead.
+func (*ExportBlockManifestRequest) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{11}
 }
 
-type AppendToBlockRequest struct {
+func (x *ExportBlockManifestRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *ExportBlockManifestRequest) GetStartAfter() uint64 {
+	if x != nil {
+		return x.StartAfter
+	}
+	return 0
+}
+
+func (x *ExportBlockManifestRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BlockDigest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockId uint64                       `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	Events  *cloudevents.CloudEventBatch `protobuf:"bytes,2,opt,name=events,proto3" json:"events,omitempty"`
+	Id          uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventNumber int64  `protobuf:"varint,2,opt,name=event_number,json=eventNumber,proto3" json:"event_number,omitempty"`
+	// size is bytes of events which are hashed.
+	Size int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// SHA-256 of events of the block in order, each of which is encoded as
+	// payload of ReadFromBlockResponse is when raw is set.
+	Sha256 []byte `protobuf:"bytes,4,opt,name=sha256,proto3" json:"sha256,omitempty"`
 }
 
-func (x *AppendToBlockRequest) Reset() {
-	*x = AppendToBlockRequest{}
+func (x *BlockDigest) Reset() {
+	*x = BlockDigest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_segment_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -584,13 +734,13 @@ func (x *AppendToBlockRequest) Reset() {
 	}
 }
 
-func (x *AppendToBlockRequest) String() string {
+func (x *BlockDigest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppendToBlockRequest) ProtoMessage() {}
+func (*BlockDigest) ProtoMessage() {}
 
-func (x *AppendToBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *BlockDigest) ProtoReflect() protoreflect.Message {
 	mi := &file_segment_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -602,35 +752,60 @@ func (x *AppendToBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppendToBlockRequest.ProtoReflect.Descriptor instead.
-func (*AppendToBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockDigest.ProtoReflect.Descriptor instead.
+func (*BlockDigest) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *AppendToBlockRequest) GetBlockId() uint64 {
+func (x *BlockDigest) GetId() uint64 {
 	if x != nil {
-		return x.BlockId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *AppendToBlockRequest) GetEvents() *cloudevents.CloudEventBatch {
+func (x *BlockDigest) GetEventNumber() int64 {
 	if x != nil {
-		return x.Events
+		return x.EventNumber
+	}
+	return 0
+}
+
+func (x *BlockDigest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BlockDigest) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
 	}
 	return nil
 }
 
-type AppendToBlockResponse struct {
+type BlockManifest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offsets []int64 `protobuf:"varint,1,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
-}
-
-func (x *AppendToBlockResponse) Reset() {
-	*x = AppendToBlockResponse{}
+	VolumeId uint64 `protobuf:"varint,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	ServerId uint64 `protobuf:"varint,2,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	// Unix timestamp, unit is millisecond.
+	GeneratedAt int64          `protobuf:"varint,3,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	Blocks      []*BlockDigest `protobuf:"bytes,4,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// the first 8 bytes of SHA-256 of the public key in hex.
+	KeyId string `protobuf:"bytes,5,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// the Ed25519 signature of the canonical form of other fields.
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// start_after of the next page, 0 means there are no more blocks. It isn't
+	// signed.
+	NextStartAfter uint64 `protobuf:"varint,7,opt,name=next_start_after,json=nextStartAfter,proto3" json:"next_start_after,omitempty"`
+}
+
+func (x *BlockManifest) Reset() {
+	*x = BlockManifest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_segment_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -638,13 +813,13 @@ func (x *AppendToBlockResponse) Reset() {
 	}
 }
 
-func (x *AppendToBlockResponse) String() string {
+func (x *BlockManifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AppendToBlockResponse) ProtoMessage() {}
+func (*BlockManifest) ProtoMessage() {}
 
-func (x *AppendToBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *BlockManifest) ProtoReflect() protoreflect.Message {
 	mi := &file_segment_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -656,32 +831,70 @@ func (x *AppendToBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AppendToBlockResponse.ProtoReflect.Descriptor instead.
-func (*AppendToBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockManifest.ProtoReflect.Descriptor instead.
+func (*BlockManifest) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *AppendToBlockResponse) GetOffsets() []int64 {
+func (x *BlockManifest) GetVolumeId() uint64 {
 	if x != nil {
-		return x.Offsets
+		return x.VolumeId
+	}
+	return 0
+}
+
+func (x *BlockManifest) GetServerId() uint64 {
+	if x != nil {
+		return x.ServerId
+	}
+	return 0
+}
+
+func (x *BlockManifest) GetGeneratedAt() int64 {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return 0
+}
+
+func (x *BlockManifest) GetBlocks() []*BlockDigest {
+	if x != nil {
+		return x.Blocks
 	}
 	return nil
 }
 
-type ReadFromBlockRequest struct {
+func (x *BlockManifest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *BlockManifest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BlockManifest) GetNextStartAfter() uint64 {
+	if x != nil {
+		return x.NextStartAfter
+	}
+	return 0
+}
+
+type ExportBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	Offset  int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Number  int64  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
-	// polling timeout in milliseconds, 0 is disable.
-	PollingTimeout uint32 `protobuf:"varint,4,opt,name=polling_timeout,json=pollingTimeout,proto3" json:"polling_timeout,omitempty"`
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ReadFromBlockRequest) Reset() {
-	*x = ReadFromBlockRequest{}
+func (x *ExportBlockRequest) Reset() {
+	*x = ExportBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_segment_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -689,13 +902,13 @@ func (x *ReadFromBlockRequest) Reset() {
 	}
 }
 
-func (x *ReadFromBlockRequest) String() string {
+func (x *ExportBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadFromBlockRequest) ProtoMessage() {}
+func (*ExportBlockRequest) ProtoMessage() {}
 
-func (x *ReadFromBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_segment_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -707,67 +920,97 @@ func (x *ReadFromBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadFromBlockRequest.ProtoReflect.Descriptor instead.
-func (*ReadFromBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportBlockRequest.ProtoReflect.Descriptor instead.
+func (*ExportBlockRequest) Descriptor() ([]byte, []int) {
 	return file_segment_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ReadFromBlockRequest) GetBlockId() uint64 {
+func (x *ExportBlockRequest) GetId() uint64 {
 	if x != nil {
-		return x.BlockId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ReadFromBlockRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
-	}
-	return 0
-}
+type ExportBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ReadFromBlockRequest) GetNumber() int64 {
-	if x != nil {
-		return x.Number
-	}
-	return 0
+	// a chunk of the snapshot of the block, the snapshot is the concatenation
+	// of chunks in order.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *ReadFromBlockRequest) GetPollingTimeout() uint32 {
-	if x != nil {
-		return x.PollingTimeout
+func (x *ExportBlockResponse) Reset() {
+	*x = ExportBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-type ReadFromBlockResponse struct {
+func (x *ExportBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportBlockResponse) ProtoMessage() {}
+
+func (x *ExportBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportBlockResponse.ProtoReflect.Descriptor instead.
+func (*ExportBlockResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportBlockResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CopyBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Events *cloudevents.CloudEventBatch `protobuf:"bytes,1,opt,name=events,proto3" json:"events,omitempty"`
-	// Don't use this now, just used to optimize cpu overhead of SegmentServer in
-	// the future for backward compatibility
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// the empty block which data is copied to.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the full block which data is copied from.
+	SourceId uint64 `protobuf:"varint,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"`
+	// the endpoint of the segment server which holds the source block.
+	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
 }
 
-func (x *ReadFromBlockResponse) Reset() {
-	*x = ReadFromBlockResponse{}
+func (x *CopyBlockRequest) Reset() {
+	*x = CopyBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_segment_proto_msgTypes[15]
+		mi := &file_segment_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadFromBlockResponse) String() string {
+func (x *CopyBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadFromBlockResponse) ProtoMessage() {}
+func (*CopyBlockRequest) ProtoMessage() {}
 
-func (x *ReadFromBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_segment_proto_msgTypes[15]
+func (x *CopyBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,51 +1021,184 @@ func (x *ReadFromBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadFromBlockResponse.ProtoReflect.Descriptor instead.
-func (*ReadFromBlockResponse) Descriptor() ([]byte, []int) {
-	return file_segment_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CopyBlockRequest.ProtoReflect.Descriptor instead.
+func (*CopyBlockRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *ReadFromBlockResponse) GetEvents() *cloudevents.CloudEventBatch {
+func (x *CopyBlockRequest) GetId() uint64 {
 	if x != nil {
-		return x.Events
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CopyBlockRequest) GetSourceId() uint64 {
+	if x != nil {
+		return x.SourceId
+	}
+	return 0
+}
+
+func (x *CopyBlockRequest) GetSourceAddress() string {
+	if x != nil {
+		return x.SourceAddress
+	}
+	return ""
+}
+
+type ActivateSegmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventLogId     uint64 `protobuf:"varint,1,opt,name=event_log_id,json=eventLogId,proto3" json:"event_log_id,omitempty"`
+	ReplicaGroupId uint64 `protobuf:"varint,2,opt,name=replica_group_id,json=replicaGroupId,proto3" json:"replica_group_id,omitempty"`
+	// block ID and its server endpoint.
+	Replicas map[uint64]string `protobuf:"bytes,3,rep,name=replicas,proto3" json:"replicas,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the write lease of the leader block.
+	Lease *WriteLease `protobuf:"bytes,4,opt,name=lease,proto3" json:"lease,omitempty"`
+	// the zstd dictionary of the eventbus, archived blocks are compressed with it
+	// if they are compressed by zstd.
+	CompressionDictionary []byte `protobuf:"bytes,5,opt,name=compression_dictionary,json=compressionDictionary,proto3" json:"compression_dictionary,omitempty"`
+}
+
+func (x *ActivateSegmentRequest) Reset() {
+	*x = ActivateSegmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateSegmentRequest) ProtoMessage() {}
+
+func (x *ActivateSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateSegmentRequest.ProtoReflect.Descriptor instead.
+func (*ActivateSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ActivateSegmentRequest) GetEventLogId() uint64 {
+	if x != nil {
+		return x.EventLogId
+	}
+	return 0
+}
+
+func (x *ActivateSegmentRequest) GetReplicaGroupId() uint64 {
+	if x != nil {
+		return x.ReplicaGroupId
+	}
+	return 0
+}
+
+func (x *ActivateSegmentRequest) GetReplicas() map[uint64]string {
+	if x != nil {
+		return x.Replicas
 	}
 	return nil
 }
 
-func (x *ReadFromBlockResponse) GetPayload() []byte {
+func (x *ActivateSegmentRequest) GetLease() *WriteLease {
 	if x != nil {
-		return x.Payload
+		return x.Lease
 	}
 	return nil
 }
 
-type LookupOffsetInBlockRequest struct {
+func (x *ActivateSegmentRequest) GetCompressionDictionary() []byte {
+	if x != nil {
+		return x.CompressionDictionary
+	}
+	return nil
+}
+
+type ActivateSegmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ActivateSegmentResponse) Reset() {
+	*x = ActivateSegmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateSegmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateSegmentResponse) ProtoMessage() {}
+
+func (x *ActivateSegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateSegmentResponse.ProtoReflect.Descriptor instead.
+func (*ActivateSegmentResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{18}
+}
+
+// WriteLease permits a block to accept appends until it expires, the controller
+// renews leases of appendable segments only.
+type WriteLease struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	Stime   int64  `protobuf:"varint,2,opt,name=stime,proto3" json:"stime,omitempty"`
+	// grants with an epoch less than the current one of the block are ignored.
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// the lease expires ttl_ms after it is received.
+	TtlMs int64 `protobuf:"varint,3,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
 }
 
-func (x *LookupOffsetInBlockRequest) Reset() {
-	*x = LookupOffsetInBlockRequest{}
+func (x *WriteLease) Reset() {
+	*x = WriteLease{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_segment_proto_msgTypes[16]
+		mi := &file_segment_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupOffsetInBlockRequest) String() string {
+func (x *WriteLease) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupOffsetInBlockRequest) ProtoMessage() {}
+func (*WriteLease) ProtoMessage() {}
 
-func (x *LookupOffsetInBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_segment_proto_msgTypes[16]
+func (x *WriteLease) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,50 +1209,57 @@ func (x *LookupOffsetInBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupOffsetInBlockRequest.ProtoReflect.Descriptor instead.
-func (*LookupOffsetInBlockRequest) Descriptor() ([]byte, []int) {
-	return file_segment_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use WriteLease.ProtoReflect.Descriptor instead.
+func (*WriteLease) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *LookupOffsetInBlockRequest) GetBlockId() uint64 {
+func (x *WriteLease) GetBlockId() uint64 {
 	if x != nil {
 		return x.BlockId
 	}
 	return 0
 }
 
-func (x *LookupOffsetInBlockRequest) GetStime() int64 {
+func (x *WriteLease) GetEpoch() uint64 {
 	if x != nil {
-		return x.Stime
+		return x.Epoch
 	}
 	return 0
 }
 
-type LookupOffsetInBlockResponse struct {
+func (x *WriteLease) GetTtlMs() int64 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+type RenewWriteLeasesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Leases []*WriteLease `protobuf:"bytes,1,rep,name=leases,proto3" json:"leases,omitempty"`
 }
 
-func (x *LookupOffsetInBlockResponse) Reset() {
-	*x = LookupOffsetInBlockResponse{}
+func (x *RenewWriteLeasesRequest) Reset() {
+	*x = RenewWriteLeasesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_segment_proto_msgTypes[17]
+		mi := &file_segment_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupOffsetInBlockResponse) String() string {
+func (x *RenewWriteLeasesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupOffsetInBlockResponse) ProtoMessage() {}
+func (*RenewWriteLeasesRequest) ProtoMessage() {}
 
-func (x *LookupOffsetInBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_segment_proto_msgTypes[17]
+func (x *RenewWriteLeasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -887,43 +1270,982 @@ func (x *LookupOffsetInBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupOffsetInBlockResponse.ProtoReflect.Descriptor instead.
-func (*LookupOffsetInBlockResponse) Descriptor() ([]byte, []int) {
-	return file_segment_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RenewWriteLeasesRequest.ProtoReflect.Descriptor instead.
+func (*RenewWriteLeasesRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *LookupOffsetInBlockResponse) GetOffset() int64 {
+func (x *RenewWriteLeasesRequest) GetLeases() []*WriteLease {
 	if x != nil {
-		return x.Offset
+		return x.Leases
 	}
-	return 0
+	return nil
 }
 
-type StatusResponse struct {
+type InactivateSegmentRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *InactivateSegmentRequest) Reset() {
+	*x = InactivateSegmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *StatusResponse) Reset() {
-	*x = StatusResponse{}
+func (x *InactivateSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InactivateSegmentRequest) ProtoMessage() {}
+
+func (x *InactivateSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InactivateSegmentRequest.ProtoReflect.Descriptor instead.
+func (*InactivateSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{21}
+}
+
+type InactivateSegmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InactivateSegmentResponse) Reset() {
+	*x = InactivateSegmentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InactivateSegmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InactivateSegmentResponse) ProtoMessage() {}
+
+func (x *InactivateSegmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InactivateSegmentResponse.ProtoReflect.Descriptor instead.
+func (*InactivateSegmentResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{22}
+}
+
+type AppendToBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId uint64                       `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Events  *cloudevents.CloudEventBatch `protobuf:"bytes,2,opt,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *AppendToBlockRequest) Reset() {
+	*x = AppendToBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendToBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendToBlockRequest) ProtoMessage() {}
+
+func (x *AppendToBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendToBlockRequest.ProtoReflect.Descriptor instead.
+func (*AppendToBlockRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AppendToBlockRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *AppendToBlockRequest) GetEvents() *cloudevents.CloudEventBatch {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AppendToBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offsets []int64 `protobuf:"varint,1,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
+}
+
+func (x *AppendToBlockResponse) Reset() {
+	*x = AppendToBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendToBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendToBlockResponse) ProtoMessage() {}
+
+func (x *AppendToBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendToBlockResponse.ProtoReflect.Descriptor instead.
+func (*AppendToBlockResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AppendToBlockResponse) GetOffsets() []int64 {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+type ReadFromBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Offset  int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Number  int64  `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	// polling timeout in milliseconds, 0 is disable.
+	PollingTimeout uint32 `protobuf:"varint,4,opt,name=polling_timeout,json=pollingTimeout,proto3" json:"polling_timeout,omitempty"`
+	// the maximum bytes of events in a response, 0 is unlimited. At least one
+	// event is returned even if it exceeds the limit.
+	MaxBytes int64 `protobuf:"varint,5,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// the maximum size of a response message the client can receive, it's only
+	// used by ReadFromBlockStream. 0 means the default of the server.
+	MaxMessageBytes int64 `protobuf:"varint,6,opt,name=max_message_bytes,json=maxMessageBytes,proto3" json:"max_message_bytes,omitempty"`
+	// whether the client follows the continuation hint of a sealed block. If
+	// it's not set, reads at the end of a sealed block fail with OFFSET_OVERFLOW.
+	FollowSeal bool `protobuf:"varint,7,opt,name=follow_seal,json=followSeal,proto3" json:"follow_seal,omitempty"`
+	// whether events are returned in payload instead of events of the response.
+	// Frames of events read in this way are kept in the read cache of the
+	// server, so they aren't encoded again by later raw reads.
+	Raw bool `protobuf:"varint,8,opt,name=raw,proto3" json:"raw,omitempty"`
+}
+
+func (x *ReadFromBlockRequest) Reset() {
+	*x = ReadFromBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFromBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFromBlockRequest) ProtoMessage() {}
+
+func (x *ReadFromBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFromBlockRequest.ProtoReflect.Descriptor instead.
+func (*ReadFromBlockRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReadFromBlockRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetPollingTimeout() uint32 {
+	if x != nil {
+		return x.PollingTimeout
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetMaxMessageBytes() int64 {
+	if x != nil {
+		return x.MaxMessageBytes
+	}
+	return 0
+}
+
+func (x *ReadFromBlockRequest) GetFollowSeal() bool {
+	if x != nil {
+		return x.FollowSeal
+	}
+	return false
+}
+
+func (x *ReadFromBlockRequest) GetRaw() bool {
+	if x != nil {
+		return x.Raw
+	}
+	return false
+}
+
+type ReadFromBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events *cloudevents.CloudEventBatch `protobuf:"bytes,1,opt,name=events,proto3" json:"events,omitempty"`
+	// events encoded as a sequence of CloudEvent messages, each of which is
+	// preceded by its size in varint. It's only set if raw is set in the
+	// request, servers which don't support it set events instead.
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// the continuation hint, it's set if the read reaches the end of a sealed
+	// block, which won't grow anymore. Events from sealed_offset on are in the
+	// successor segment of the eventlog.
+	Sealed bool `protobuf:"varint,3,opt,name=sealed,proto3" json:"sealed,omitempty"`
+	// the number of events in the sealed block.
+	SealedOffset int64 `protobuf:"varint,4,opt,name=sealed_offset,json=sealedOffset,proto3" json:"sealed_offset,omitempty"`
+}
+
+func (x *ReadFromBlockResponse) Reset() {
+	*x = ReadFromBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadFromBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadFromBlockResponse) ProtoMessage() {}
+
+func (x *ReadFromBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadFromBlockResponse.ProtoReflect.Descriptor instead.
+func (*ReadFromBlockResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReadFromBlockResponse) GetEvents() *cloudevents.CloudEventBatch {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ReadFromBlockResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ReadFromBlockResponse) GetSealed() bool {
+	if x != nil {
+		return x.Sealed
+	}
+	return false
+}
+
+func (x *ReadFromBlockResponse) GetSealedOffset() int64 {
+	if x != nil {
+		return x.SealedOffset
+	}
+	return 0
+}
+
+type LookupOffsetInBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Stime   int64  `protobuf:"varint,2,opt,name=stime,proto3" json:"stime,omitempty"`
+}
+
+func (x *LookupOffsetInBlockRequest) Reset() {
+	*x = LookupOffsetInBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupOffsetInBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupOffsetInBlockRequest) ProtoMessage() {}
+
+func (x *LookupOffsetInBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupOffsetInBlockRequest.ProtoReflect.Descriptor instead.
+func (*LookupOffsetInBlockRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *LookupOffsetInBlockRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *LookupOffsetInBlockRequest) GetStime() int64 {
+	if x != nil {
+		return x.Stime
+	}
+	return 0
+}
+
+type LookupOffsetInBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *LookupOffsetInBlockResponse) Reset() {
+	*x = LookupOffsetInBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupOffsetInBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupOffsetInBlockResponse) ProtoMessage() {}
+
+func (x *LookupOffsetInBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupOffsetInBlockResponse.ProtoReflect.Descriptor instead.
+func (*LookupOffsetInBlockResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LookupOffsetInBlockResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type LookupKeyInBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId      uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	PartitionKey string `protobuf:"bytes,2,opt,name=partition_key,json=partitionKey,proto3" json:"partition_key,omitempty"`
+}
+
+func (x *LookupKeyInBlockRequest) Reset() {
+	*x = LookupKeyInBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupKeyInBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupKeyInBlockRequest) ProtoMessage() {}
+
+func (x *LookupKeyInBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupKeyInBlockRequest.ProtoReflect.Descriptor instead.
+func (*LookupKeyInBlockRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LookupKeyInBlockRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *LookupKeyInBlockRequest) GetPartitionKey() string {
+	if x != nil {
+		return x.PartitionKey
+	}
+	return ""
+}
+
+type LookupKeyInBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *LookupKeyInBlockResponse) Reset() {
+	*x = LookupKeyInBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupKeyInBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupKeyInBlockResponse) ProtoMessage() {}
+
+func (x *LookupKeyInBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupKeyInBlockResponse.ProtoReflect.Descriptor instead.
+func (*LookupKeyInBlockResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LookupKeyInBlockResponse) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type PrefetchBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Offset  int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *PrefetchBlockRequest) Reset() {
+	*x = PrefetchBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefetchBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchBlockRequest) ProtoMessage() {}
+
+func (x *PrefetchBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchBlockRequest.ProtoReflect.Descriptor instead.
+func (*PrefetchBlockRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PrefetchBlockRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *PrefetchBlockRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type PrefetchBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// bytes of the block which are requested to be loaded.
+	Bytes int64 `protobuf:"varint,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *PrefetchBlockResponse) Reset() {
+	*x = PrefetchBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefetchBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefetchBlockResponse) ProtoMessage() {}
+
+func (x *PrefetchBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefetchBlockResponse.ProtoReflect.Descriptor instead.
+func (*PrefetchBlockResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PrefetchBlockResponse) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type StatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StatusResponse) Reset() {
+	*x = StatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse) ProtoMessage() {}
+
+func (x *StatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
+func (*StatusResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type InflightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// append or read.
+	Type  string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	AgeMs int64  `protobuf:"varint,3,opt,name=age_ms,json=ageMs,proto3" json:"age_ms,omitempty"`
+}
+
+func (x *InflightRequest) Reset() {
+	*x = InflightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InflightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InflightRequest) ProtoMessage() {}
+
+func (x *InflightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InflightRequest.ProtoReflect.Descriptor instead.
+func (*InflightRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InflightRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *InflightRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InflightRequest) GetAgeMs() int64 {
+	if x != nil {
+		return x.AgeMs
+	}
+	return 0
+}
+
+type ListInflightRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 means all blocks in the server.
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+}
+
+func (x *ListInflightRequestsRequest) Reset() {
+	*x = ListInflightRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInflightRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInflightRequestsRequest) ProtoMessage() {}
+
+func (x *ListInflightRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInflightRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListInflightRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListInflightRequestsRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+type ListInflightRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*InflightRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *ListInflightRequestsResponse) Reset() {
+	*x = ListInflightRequestsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListInflightRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInflightRequestsResponse) ProtoMessage() {}
+
+func (x *ListInflightRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInflightRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListInflightRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListInflightRequestsResponse) GetRequests() []*InflightRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type AbortInflightRequestsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 means all blocks in the server.
+	BlockId uint64 `protobuf:"varint,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// only requests in flight for at least min_age_ms are aborted.
+	MinAgeMs int64 `protobuf:"varint,2,opt,name=min_age_ms,json=minAgeMs,proto3" json:"min_age_ms,omitempty"`
+}
+
+func (x *AbortInflightRequestsRequest) Reset() {
+	*x = AbortInflightRequestsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_segment_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbortInflightRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortInflightRequestsRequest) ProtoMessage() {}
+
+func (x *AbortInflightRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortInflightRequestsRequest.ProtoReflect.Descriptor instead.
+func (*AbortInflightRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AbortInflightRequestsRequest) GetBlockId() uint64 {
+	if x != nil {
+		return x.BlockId
+	}
+	return 0
+}
+
+func (x *AbortInflightRequestsRequest) GetMinAgeMs() int64 {
+	if x != nil {
+		return x.MinAgeMs
+	}
+	return 0
+}
+
+type AbortInflightRequestsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aborted int32 `protobuf:"varint,1,opt,name=aborted,proto3" json:"aborted,omitempty"`
+}
+
+func (x *AbortInflightRequestsResponse) Reset() {
+	*x = AbortInflightRequestsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_segment_proto_msgTypes[18]
+		mi := &file_segment_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StatusResponse) String() string {
+func (x *AbortInflightRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse) ProtoMessage() {}
+func (*AbortInflightRequestsResponse) ProtoMessage() {}
 
-func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_segment_proto_msgTypes[18]
+func (x *AbortInflightRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_segment_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,16 +2256,16 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
-func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_segment_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AbortInflightRequestsResponse.ProtoReflect.Descriptor instead.
+func (*AbortInflightRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_segment_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *StatusResponse) GetStatus() string {
+func (x *AbortInflightRequestsResponse) GetAborted() int32 {
 	if x != nil {
-		return x.Status
+		return x.Aborted
 	}
-	return ""
+	return 0
 }
 
 var File_segment_proto protoreflect.FileDescriptor
@@ -955,162 +2277,388 @@ var file_segment_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a,
-	0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x70,
-	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x38, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x52,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x74, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x1b, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7c, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0b,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x24, 0x0a, 0x12, 0x52,
 	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xfa, 0x01, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x28, 0x0a,
-	0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73,
-	0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x19, 0x0a,
-	0x17, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x49, 0x6e, 0x61, 0x63,
-	0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x75, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68,
-	0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x65,
-	0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x03, 0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x14,
-	0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x64, 0x22, 0x27, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x55, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x22, 0x28, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xb8, 0x03, 0x0a, 0x0a,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x69, 0x73, 0x46, 0x75, 0x6c, 0x6c, 0x12, 0x2a, 0x0a, 0x11, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x65, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6e,
+	0x65, 0x77, 0x65, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x18, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x15, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x33,
+	0x0a, 0x16, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13,
+	0x72, 0x65, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22, 0x52, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x39, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x65, 0x0a, 0x1a, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x6c, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22,
+	0x87, 0x02, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a,
+	0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x24, 0x0a, 0x12, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x29, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x66, 0x0a, 0x10, 0x43, 0x6f,
+	0x70, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0xea, 0x02, 0x0a, 0x16, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x08, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x16, 0x63,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x15, 0x63, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x1a, 0x3b, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x19, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x0a, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x74, 0x6c,
+	0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x74, 0x6c, 0x4d, 0x73,
+	0x22, 0x54, 0x0a, 0x17, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x65,
+	0x61, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x52, 0x06,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x75, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x31, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x07, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x22, 0x86, 0x02, 0x0a, 0x14, 0x52, 0x65,
+	0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x61, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x61, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72,
+	0x61, 0x77, 0x22, 0xb2, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x63, 0x6c, 0x6f,
+	0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
+	0x61, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x61, 0x6c,
+	0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x4d, 0x0a, 0x1a, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x59, 0x0a,
+	0x17, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x32, 0x0a, 0x18, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x49, 0x0a, 0x14,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12,
 	0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
-	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e,
-	0x67, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x75, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64,
-	0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x43, 0x6c,
-	0x6f, 0x75, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x06, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
-	0x4d, 0x0a, 0x1a, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x35,
-	0x0a, 0x1b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32,
-	0xe4, 0x08, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x12, 0x6c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x69, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
-	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
-	0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x74, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x57, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x22, 0x38, 0x0a, 0x1b, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x49, 0x64, 0x22, 0x62, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x1c, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x41, 0x67, 0x65, 0x4d, 0x73,
+	0x22, 0x39, 0x0a, 0x1d, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x32, 0xad, 0x11, 0x0a, 0x0d,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x6c, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
 	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
-	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x0b,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29, 0x2e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x67,
-	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a,
-	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x69, 0x6e,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x04, 0x53,
+	0x74, 0x6f, 0x70, 0x12, 0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61,
+	0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x67, 0x0a, 0x0c, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e, 0x6c, 0x69, 0x6e,
 	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65,
 	0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6e, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
+	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
 	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x49, 0x6e, 0x61,
-	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f,
+	0x74, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x66, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29,
 	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x6a, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e,
-	0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x09, 0x43, 0x6f, 0x70, 0x79, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x27, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x70,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x70, 0x0a, 0x0f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
 	0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
-	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70,
-	0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
-	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
-	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72,
-	0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x7c, 0x0a, 0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c,
-	0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x49, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x49, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5a, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x57, 0x72,
+	0x69, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b,
 	0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x25, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
-	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2d, 0x6c, 0x61, 0x62,
-	0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x74, 0x2e, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x6a, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e,
+	0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e,
+	0x64, 0x54, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2c, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x54, 0x6f,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a,
+	0x0d, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2b,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x13, 0x52, 0x65, 0x61,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x12, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f,
+	0x6d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x7c, 0x0a,
+	0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x31, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76,
+	0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x10, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x2e, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4b, 0x65,
+	0x79, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e,
+	0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x4b, 0x65,
+	0x79, 0x49, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6a, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2b, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74,
+	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x74, 0x63, 0x68, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x32, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75,
+	0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x15, 0x41, 0x62, 0x6f, 0x72, 0x74,
+	0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x12, 0x33, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e, 0x76, 0x61, 0x6e, 0x75, 0x73,
+	0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c, 0x6c, 0x2e,
+	0x76, 0x61, 0x6e, 0x75, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x62,
+	0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x6c,
+	0x6c, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x76, 0x61, 0x6e, 0x75, 0x73, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1125,64 +2673,113 @@ func file_segment_proto_rawDescGZIP() []byte {
 	return file_segment_proto_rawDescData
 }
 
-var file_segment_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_segment_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_segment_proto_goTypes = []interface{}{
-	(*StartSegmentServerRequest)(nil),   // 0: linkall.vanus.segment.StartSegmentServerRequest
-	(*StartSegmentServerResponse)(nil),  // 1: linkall.vanus.segment.StartSegmentServerResponse
-	(*StopSegmentServerRequest)(nil),    // 2: linkall.vanus.segment.StopSegmentServerRequest
-	(*StopSegmentServerResponse)(nil),   // 3: linkall.vanus.segment.StopSegmentServerResponse
-	(*CreateBlockRequest)(nil),          // 4: linkall.vanus.segment.CreateBlockRequest
-	(*RemoveBlockRequest)(nil),          // 5: linkall.vanus.segment.RemoveBlockRequest
-	(*GetBlockInfoRequest)(nil),         // 6: linkall.vanus.segment.GetBlockInfoRequest
-	(*GetBlockInfoResponse)(nil),        // 7: linkall.vanus.segment.GetBlockInfoResponse
-	(*ActivateSegmentRequest)(nil),      // 8: linkall.vanus.segment.ActivateSegmentRequest
-	(*ActivateSegmentResponse)(nil),     // 9: linkall.vanus.segment.ActivateSegmentResponse
-	(*InactivateSegmentRequest)(nil),    // 10: linkall.vanus.segment.InactivateSegmentRequest
-	(*InactivateSegmentResponse)(nil),   // 11: linkall.vanus.segment.InactivateSegmentResponse
-	(*AppendToBlockRequest)(nil),        // 12: linkall.vanus.segment.AppendToBlockRequest
-	(*AppendToBlockResponse)(nil),       // 13: linkall.vanus.segment.AppendToBlockResponse
-	(*ReadFromBlockRequest)(nil),        // 14: linkall.vanus.segment.ReadFromBlockRequest
-	(*ReadFromBlockResponse)(nil),       // 15: linkall.vanus.segment.ReadFromBlockResponse
-	(*LookupOffsetInBlockRequest)(nil),  // 16: linkall.vanus.segment.LookupOffsetInBlockRequest
-	(*LookupOffsetInBlockResponse)(nil), // 17: linkall.vanus.segment.LookupOffsetInBlockResponse
-	(*StatusResponse)(nil),              // 18: linkall.vanus.segment.StatusResponse
-	nil,                                 // 19: linkall.vanus.segment.ActivateSegmentRequest.ReplicasEntry
-	(*config.ServerConfig)(nil),         // 20: linkall.vanus.config.ServerConfig
-	(*cloudevents.CloudEventBatch)(nil), // 21: linkall.vanus.cloudevents.CloudEventBatch
-	(*emptypb.Empty)(nil),               // 22: google.protobuf.Empty
+	(*StartSegmentServerRequest)(nil),     // 0: linkall.vanus.segment.StartSegmentServerRequest
+	(*StartSegmentServerResponse)(nil),    // 1: linkall.vanus.segment.StartSegmentServerResponse
+	(*StopSegmentServerRequest)(nil),      // 2: linkall.vanus.segment.StopSegmentServerRequest
+	(*StopSegmentServerResponse)(nil),     // 3: linkall.vanus.segment.StopSegmentServerResponse
+	(*CreateBlockRequest)(nil),            // 4: linkall.vanus.segment.CreateBlockRequest
+	(*RemoveBlockRequest)(nil),            // 5: linkall.vanus.segment.RemoveBlockRequest
+	(*GetBlockInfoRequest)(nil),           // 6: linkall.vanus.segment.GetBlockInfoRequest
+	(*GetBlockInfoResponse)(nil),          // 7: linkall.vanus.segment.GetBlockInfoResponse
+	(*GetBlockStatsRequest)(nil),          // 8: linkall.vanus.segment.GetBlockStatsRequest
+	(*BlockStats)(nil),                    // 9: linkall.vanus.segment.BlockStats
+	(*GetBlockStatsResponse)(nil),         // 10: linkall.vanus.segment.GetBlockStatsResponse
+	(*ExportBlockManifestRequest)(nil),    // 11: linkall.vanus.segment.ExportBlockManifestRequest
+	(*BlockDigest)(nil),                   // 12: linkall.vanus.segment.BlockDigest
+	(*BlockManifest)(nil),                 // 13: linkall.vanus.segment.BlockManifest
+	(*ExportBlockRequest)(nil),            // 14: linkall.vanus.segment.ExportBlockRequest
+	(*ExportBlockResponse)(nil),           // 15: linkall.vanus.segment.ExportBlockResponse
+	(*CopyBlockRequest)(nil),              // 16: linkall.vanus.segment.CopyBlockRequest
+	(*ActivateSegmentRequest)(nil),        // 17: linkall.vanus.segment.ActivateSegmentRequest
+	(*ActivateSegmentResponse)(nil),       // 18: linkall.vanus.segment.ActivateSegmentResponse
+	(*WriteLease)(nil),                    // 19: linkall.vanus.segment.WriteLease
+	(*RenewWriteLeasesRequest)(nil),       // 20: linkall.vanus.segment.RenewWriteLeasesRequest
+	(*InactivateSegmentRequest)(nil),      // 21: linkall.vanus.segment.InactivateSegmentRequest
+	(*InactivateSegmentResponse)(nil),     // 22: linkall.vanus.segment.InactivateSegmentResponse
+	(*AppendToBlockRequest)(nil),          // 23: linkall.vanus.segment.AppendToBlockRequest
+	(*AppendToBlockResponse)(nil),         // 24: linkall.vanus.segment.AppendToBlockResponse
+	(*ReadFromBlockRequest)(nil),          // 25: linkall.vanus.segment.ReadFromBlockRequest
+	(*ReadFromBlockResponse)(nil),         // 26: linkall.vanus.segment.ReadFromBlockResponse
+	(*LookupOffsetInBlockRequest)(nil),    // 27: linkall.vanus.segment.LookupOffsetInBlockRequest
+	(*LookupOffsetInBlockResponse)(nil),   // 28: linkall.vanus.segment.LookupOffsetInBlockResponse
+	(*LookupKeyInBlockRequest)(nil),       // 29: linkall.vanus.segment.LookupKeyInBlockRequest
+	(*LookupKeyInBlockResponse)(nil),      // 30: linkall.vanus.segment.LookupKeyInBlockResponse
+	(*PrefetchBlockRequest)(nil),          // 31: linkall.vanus.segment.PrefetchBlockRequest
+	(*PrefetchBlockResponse)(nil),         // 32: linkall.vanus.segment.PrefetchBlockResponse
+	(*StatusResponse)(nil),                // 33: linkall.vanus.segment.StatusResponse
+	(*InflightRequest)(nil),               // 34: linkall.vanus.segment.InflightRequest
+	(*ListInflightRequestsRequest)(nil),   // 35: linkall.vanus.segment.ListInflightRequestsRequest
+	(*ListInflightRequestsResponse)(nil),  // 36: linkall.vanus.segment.ListInflightRequestsResponse
+	(*AbortInflightRequestsRequest)(nil),  // 37: linkall.vanus.segment.AbortInflightRequestsRequest
+	(*AbortInflightRequestsResponse)(nil), // 38: linkall.vanus.segment.AbortInflightRequestsResponse
+	nil,                                   // 39: linkall.vanus.segment.ActivateSegmentRequest.ReplicasEntry
+	(*config.ServerConfig)(nil),           // 40: linkall.vanus.config.ServerConfig
+	(meta.StorageMode)(0),                 // 41: linkall.vanus.meta.StorageMode
+	(*meta.SegmentHealthInfo)(nil),        // 42: linkall.vanus.meta.SegmentHealthInfo
+	(*cloudevents.CloudEventBatch)(nil),   // 43: linkall.vanus.cloudevents.CloudEventBatch
+	(*emptypb.Empty)(nil),                 // 44: google.protobuf.Empty
 }
 var file_segment_proto_depIdxs = []int32{
-	20, // 0: linkall.vanus.segment.StartSegmentServerRequest.config:type_name -> linkall.vanus.config.ServerConfig
-	19, // 1: linkall.vanus.segment.ActivateSegmentRequest.replicas:type_name -> linkall.vanus.segment.ActivateSegmentRequest.ReplicasEntry
-	21, // 2: linkall.vanus.segment.AppendToBlockRequest.events:type_name -> linkall.vanus.cloudevents.CloudEventBatch
-	21, // 3: linkall.vanus.segment.ReadFromBlockResponse.events:type_name -> linkall.vanus.cloudevents.CloudEventBatch
-	0,  // 4: linkall.vanus.segment.SegmentServer.Start:input_type -> linkall.vanus.segment.StartSegmentServerRequest
-	2,  // 5: linkall.vanus.segment.SegmentServer.Stop:input_type -> linkall.vanus.segment.StopSegmentServerRequest
-	4,  // 6: linkall.vanus.segment.SegmentServer.CreateBlock:input_type -> linkall.vanus.segment.CreateBlockRequest
-	5,  // 7: linkall.vanus.segment.SegmentServer.RemoveBlock:input_type -> linkall.vanus.segment.RemoveBlockRequest
-	6,  // 8: linkall.vanus.segment.SegmentServer.GetBlockInfo:input_type -> linkall.vanus.segment.GetBlockInfoRequest
-	8,  // 9: linkall.vanus.segment.SegmentServer.ActivateSegment:input_type -> linkall.vanus.segment.ActivateSegmentRequest
-	10, // 10: linkall.vanus.segment.SegmentServer.InactivateSegment:input_type -> linkall.vanus.segment.InactivateSegmentRequest
-	12, // 11: linkall.vanus.segment.SegmentServer.AppendToBlock:input_type -> linkall.vanus.segment.AppendToBlockRequest
-	14, // 12: linkall.vanus.segment.SegmentServer.ReadFromBlock:input_type -> linkall.vanus.segment.ReadFromBlockRequest
-	16, // 13: linkall.vanus.segment.SegmentServer.LookupOffsetInBlock:input_type -> linkall.vanus.segment.LookupOffsetInBlockRequest
-	22, // 14: linkall.vanus.segment.SegmentServer.Status:input_type -> google.protobuf.Empty
-	1,  // 15: linkall.vanus.segment.SegmentServer.Start:output_type -> linkall.vanus.segment.StartSegmentServerResponse
-	3,  // 16: linkall.vanus.segment.SegmentServer.Stop:output_type -> linkall.vanus.segment.StopSegmentServerResponse
-	22, // 17: linkall.vanus.segment.SegmentServer.CreateBlock:output_type -> google.protobuf.Empty
-	22, // 18: linkall.vanus.segment.SegmentServer.RemoveBlock:output_type -> google.protobuf.Empty
-	7,  // 19: linkall.vanus.segment.SegmentServer.GetBlockInfo:output_type -> linkall.vanus.segment.GetBlockInfoResponse
-	9,  // 20: linkall.vanus.segment.SegmentServer.ActivateSegment:output_type -> linkall.vanus.segment.ActivateSegmentResponse
-	22, // 21: linkall.vanus.segment.SegmentServer.InactivateSegment:output_type -> google.protobuf.Empty
-	13, // 22: linkall.vanus.segment.SegmentServer.AppendToBlock:output_type -> linkall.vanus.segment.AppendToBlockResponse
-	15, // 23: linkall.vanus.segment.SegmentServer.ReadFromBlock:output_type -> linkall.vanus.segment.ReadFromBlockResponse
-	17, // 24: linkall.vanus.segment.SegmentServer.LookupOffsetInBlock:output_type -> linkall.vanus.segment.LookupOffsetInBlockResponse
-	18, // 25: linkall.vanus.segment.SegmentServer.Status:output_type -> linkall.vanus.segment.StatusResponse
-	15, // [15:26] is the sub-list for method output_type
-	4,  // [4:15] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	40, // 0: linkall.vanus.segment.StartSegmentServerRequest.config:type_name -> linkall.vanus.config.ServerConfig
+	41, // 1: linkall.vanus.segment.CreateBlockRequest.storage_mode:type_name -> linkall.vanus.meta.StorageMode
+	42, // 2: linkall.vanus.segment.GetBlockInfoResponse.blocks:type_name -> linkall.vanus.meta.SegmentHealthInfo
+	9,  // 3: linkall.vanus.segment.GetBlockStatsResponse.blocks:type_name -> linkall.vanus.segment.BlockStats
+	12, // 4: linkall.vanus.segment.BlockManifest.blocks:type_name -> linkall.vanus.segment.BlockDigest
+	39, // 5: linkall.vanus.segment.ActivateSegmentRequest.replicas:type_name -> linkall.vanus.segment.ActivateSegmentRequest.ReplicasEntry
+	19, // 6: linkall.vanus.segment.ActivateSegmentRequest.lease:type_name -> linkall.vanus.segment.WriteLease
+	19, // 7: linkall.vanus.segment.RenewWriteLeasesRequest.leases:type_name -> linkall.vanus.segment.WriteLease
+	43, // 8: linkall.vanus.segment.AppendToBlockRequest.events:type_name -> linkall.vanus.cloudevents.CloudEventBatch
+	43, // 9: linkall.vanus.segment.ReadFromBlockResponse.events:type_name -> linkall.vanus.cloudevents.CloudEventBatch
+	34, // 10: linkall.vanus.segment.ListInflightRequestsResponse.requests:type_name -> linkall.vanus.segment.InflightRequest
+	0,  // 11: linkall.vanus.segment.SegmentServer.Start:input_type -> linkall.vanus.segment.StartSegmentServerRequest
+	2,  // 12: linkall.vanus.segment.SegmentServer.Stop:input_type -> linkall.vanus.segment.StopSegmentServerRequest
+	4,  // 13: linkall.vanus.segment.SegmentServer.CreateBlock:input_type -> linkall.vanus.segment.CreateBlockRequest
+	5,  // 14: linkall.vanus.segment.SegmentServer.RemoveBlock:input_type -> linkall.vanus.segment.RemoveBlockRequest
+	6,  // 15: linkall.vanus.segment.SegmentServer.GetBlockInfo:input_type -> linkall.vanus.segment.GetBlockInfoRequest
+	8,  // 16: linkall.vanus.segment.SegmentServer.GetBlockStats:input_type -> linkall.vanus.segment.GetBlockStatsRequest
+	11, // 17: linkall.vanus.segment.SegmentServer.ExportBlockManifest:input_type -> linkall.vanus.segment.ExportBlockManifestRequest
+	14, // 18: linkall.vanus.segment.SegmentServer.ExportBlock:input_type -> linkall.vanus.segment.ExportBlockRequest
+	16, // 19: linkall.vanus.segment.SegmentServer.CopyBlock:input_type -> linkall.vanus.segment.CopyBlockRequest
+	17, // 20: linkall.vanus.segment.SegmentServer.ActivateSegment:input_type -> linkall.vanus.segment.ActivateSegmentRequest
+	21, // 21: linkall.vanus.segment.SegmentServer.InactivateSegment:input_type -> linkall.vanus.segment.InactivateSegmentRequest
+	20, // 22: linkall.vanus.segment.SegmentServer.RenewWriteLeases:input_type -> linkall.vanus.segment.RenewWriteLeasesRequest
+	23, // 23: linkall.vanus.segment.SegmentServer.AppendToBlock:input_type -> linkall.vanus.segment.AppendToBlockRequest
+	25, // 24: linkall.vanus.segment.SegmentServer.ReadFromBlock:input_type -> linkall.vanus.segment.ReadFromBlockRequest
+	25, // 25: linkall.vanus.segment.SegmentServer.ReadFromBlockStream:input_type -> linkall.vanus.segment.ReadFromBlockRequest
+	27, // 26: linkall.vanus.segment.SegmentServer.LookupOffsetInBlock:input_type -> linkall.vanus.segment.LookupOffsetInBlockRequest
+	29, // 27: linkall.vanus.segment.SegmentServer.LookupKeyInBlock:input_type -> linkall.vanus.segment.LookupKeyInBlockRequest
+	31, // 28: linkall.vanus.segment.SegmentServer.PrefetchBlock:input_type -> linkall.vanus.segment.PrefetchBlockRequest
+	44, // 29: linkall.vanus.segment.SegmentServer.Status:input_type -> google.protobuf.Empty
+	35, // 30: linkall.vanus.segment.SegmentServer.ListInflightRequests:input_type -> linkall.vanus.segment.ListInflightRequestsRequest
+	37, // 31: linkall.vanus.segment.SegmentServer.AbortInflightRequests:input_type -> linkall.vanus.segment.AbortInflightRequestsRequest
+	1,  // 32: linkall.vanus.segment.SegmentServer.Start:output_type -> linkall.vanus.segment.StartSegmentServerResponse
+	3,  // 33: linkall.vanus.segment.SegmentServer.Stop:output_type -> linkall.vanus.segment.StopSegmentServerResponse
+	44, // 34: linkall.vanus.segment.SegmentServer.CreateBlock:output_type -> google.protobuf.Empty
+	44, // 35: linkall.vanus.segment.SegmentServer.RemoveBlock:output_type -> google.protobuf.Empty
+	7,  // 36: linkall.vanus.segment.SegmentServer.GetBlockInfo:output_type -> linkall.vanus.segment.GetBlockInfoResponse
+	10, // 37: linkall.vanus.segment.SegmentServer.GetBlockStats:output_type -> linkall.vanus.segment.GetBlockStatsResponse
+	13, // 38: linkall.vanus.segment.SegmentServer.ExportBlockManifest:output_type -> linkall.vanus.segment.BlockManifest
+	15, // 39: linkall.vanus.segment.SegmentServer.ExportBlock:output_type -> linkall.vanus.segment.ExportBlockResponse
+	44, // 40: linkall.vanus.segment.SegmentServer.CopyBlock:output_type -> google.protobuf.Empty
+	18, // 41: linkall.vanus.segment.SegmentServer.ActivateSegment:output_type -> linkall.vanus.segment.ActivateSegmentResponse
+	44, // 42: linkall.vanus.segment.SegmentServer.InactivateSegment:output_type -> google.protobuf.Empty
+	44, // 43: linkall.vanus.segment.SegmentServer.RenewWriteLeases:output_type -> google.protobuf.Empty
+	24, // 44: linkall.vanus.segment.SegmentServer.AppendToBlock:output_type -> linkall.vanus.segment.AppendToBlockResponse
+	26, // 45: linkall.vanus.segment.SegmentServer.ReadFromBlock:output_type -> linkall.vanus.segment.ReadFromBlockResponse
+	26, // 46: linkall.vanus.segment.SegmentServer.ReadFromBlockStream:output_type -> linkall.vanus.segment.ReadFromBlockResponse
+	28, // 47: linkall.vanus.segment.SegmentServer.LookupOffsetInBlock:output_type -> linkall.vanus.segment.LookupOffsetInBlockResponse
+	30, // 48: linkall.vanus.segment.SegmentServer.LookupKeyInBlock:output_type -> linkall.vanus.segment.LookupKeyInBlockResponse
+	32, // 49: linkall.vanus.segment.SegmentServer.PrefetchBlock:output_type -> linkall.vanus.segment.PrefetchBlockResponse
+	33, // 50: linkall.vanus.segment.SegmentServer.Status:output_type -> linkall.vanus.segment.StatusResponse
+	36, // 51: linkall.vanus.segment.SegmentServer.ListInflightRequests:output_type -> linkall.vanus.segment.ListInflightRequestsResponse
+	38, // 52: linkall.vanus.segment.SegmentServer.AbortInflightRequests:output_type -> linkall.vanus.segment.AbortInflightRequestsResponse
+	32, // [32:53] is the sub-list for method output_type
+	11, // [11:32] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_segment_proto_init() }
@@ -1288,7 +2885,7 @@ func file_segment_proto_init() {
 			}
 		}
 		file_segment_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActivateSegmentRequest); i {
+			switch v := v.(*GetBlockStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1300,6 +2897,114 @@ func file_segment_proto_init() {
 			}
 		}
 		file_segment_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportBlockManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDigest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CopyBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActivateSegmentResponse); i {
 			case 0:
 				return &v.state
@@ -1311,8 +3016,140 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InactivateSegmentRequest); i {
+		file_segment_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteLease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenewWriteLeasesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InactivateSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InactivateSegmentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendToBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendToBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFromBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadFromBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOffsetInBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupOffsetInBlockResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupKeyInBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_segment_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LookupKeyInBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1323,8 +3160,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InactivateSegmentResponse); i {
+		file_segment_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefetchBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,8 +3172,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendToBlockRequest); i {
+		file_segment_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefetchBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1347,8 +3184,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppendToBlockResponse); i {
+		file_segment_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1359,8 +3196,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadFromBlockRequest); i {
+		file_segment_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InflightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1371,8 +3208,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadFromBlockResponse); i {
+		file_segment_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInflightRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1383,8 +3220,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupOffsetInBlockRequest); i {
+		file_segment_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInflightRequestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1395,8 +3232,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LookupOffsetInBlockResponse); i {
+		file_segment_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortInflightRequestsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1407,8 +3244,8 @@ func file_segment_proto_init() {
 				return nil
 			}
 		}
-		file_segment_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+		file_segment_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbortInflightRequestsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1426,7 +3263,7 @@ func file_segment_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_segment_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1457,12 +3294,37 @@ type SegmentServerClient interface {
 	CreateBlock(ctx context.Context, in *CreateBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	RemoveBlock(ctx context.Context, in *RemoveBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetBlockInfo(ctx context.Context, in *GetBlockInfoRequest, opts ...grpc.CallOption) (*GetBlockInfoResponse, error)
+	GetBlockStats(ctx context.Context, in *GetBlockStatsRequest, opts ...grpc.CallOption) (*GetBlockStatsResponse, error)
+	// ExportBlockManifest returns digests of sealed blocks signed by the key of
+	// the server, so that it can be proved offline that events in the blocks
+	// haven't been altered. Digests are recorded when blocks are sealed, and
+	// blocks which don't match them any more are reported as corrupted.
+	ExportBlockManifest(ctx context.Context, in *ExportBlockManifestRequest, opts ...grpc.CallOption) (*BlockManifest, error)
+	// ExportBlock sends data of the full block in chunks, which is copied by
+	// CopyBlock of another server.
+	ExportBlock(ctx context.Context, in *ExportBlockRequest, opts ...grpc.CallOption) (SegmentServer_ExportBlockClient, error)
+	// CopyBlock fills the empty block with data of a full block in another
+	// server, so that a corrupted replica of a sealed segment is replaced.
+	CopyBlock(ctx context.Context, in *CopyBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error)
 	InactivateSegment(ctx context.Context, in *InactivateSegmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RenewWriteLeases(ctx context.Context, in *RenewWriteLeasesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error)
 	ReadFromBlock(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (*ReadFromBlockResponse, error)
+	// ReadFromBlockStream is same as ReadFromBlock, but events are split into
+	// multiple responses, each of which is no larger than max_message_bytes.
+	ReadFromBlockStream(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (SegmentServer_ReadFromBlockStreamClient, error)
 	LookupOffsetInBlock(ctx context.Context, in *LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*LookupOffsetInBlockResponse, error)
+	// LookupKeyInBlock returns the offset of the latest event with the
+	// partition key in the block, it's -1 if no event has the key.
+	LookupKeyInBlock(ctx context.Context, in *LookupKeyInBlockRequest, opts ...grpc.CallOption) (*LookupKeyInBlockResponse, error)
+	// PrefetchBlock loads data of the block from the offset to its end into the
+	// page cache in the background, so that reads expected soon don't wait for
+	// the disk. It only applies to full blocks.
+	PrefetchBlock(ctx context.Context, in *PrefetchBlockRequest, opts ...grpc.CallOption) (*PrefetchBlockResponse, error)
 	Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListInflightRequests(ctx context.Context, in *ListInflightRequestsRequest, opts ...grpc.CallOption) (*ListInflightRequestsResponse, error)
+	AbortInflightRequests(ctx context.Context, in *AbortInflightRequestsRequest, opts ...grpc.CallOption) (*AbortInflightRequestsResponse, error)
 }
 
 type segmentServerClient struct {
@@ -1518,6 +3380,65 @@ func (c *segmentServerClient) GetBlockInfo(ctx context.Context, in *GetBlockInfo
 	return out, nil
 }
 
+func (c *segmentServerClient) GetBlockStats(ctx context.Context, in *GetBlockStatsRequest, opts ...grpc.CallOption) (*GetBlockStatsResponse, error) {
+	out := new(GetBlockStatsResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/GetBlockStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) ExportBlockManifest(ctx context.Context, in *ExportBlockManifestRequest, opts ...grpc.CallOption) (*BlockManifest, error) {
+	out := new(BlockManifest)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/ExportBlockManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) ExportBlock(ctx context.Context, in *ExportBlockRequest, opts ...grpc.CallOption) (SegmentServer_ExportBlockClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SegmentServer_serviceDesc.Streams[0], "/linkall.vanus.segment.SegmentServer/ExportBlock", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &segmentServerExportBlockClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SegmentServer_ExportBlockClient interface {
+	Recv() (*ExportBlockResponse, error)
+	grpc.ClientStream
+}
+
+type segmentServerExportBlockClient struct {
+	grpc.ClientStream
+}
+
+func (x *segmentServerExportBlockClient) Recv() (*ExportBlockResponse, error) {
+	m := new(ExportBlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *segmentServerClient) CopyBlock(ctx context.Context, in *CopyBlockRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/CopyBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *segmentServerClient) ActivateSegment(ctx context.Context, in *ActivateSegmentRequest, opts ...grpc.CallOption) (*ActivateSegmentResponse, error) {
 	out := new(ActivateSegmentResponse)
 	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/ActivateSegment", in, out, opts...)
@@ -1536,6 +3457,15 @@ func (c *segmentServerClient) InactivateSegment(ctx context.Context, in *Inactiv
 	return out, nil
 }
 
+func (c *segmentServerClient) RenewWriteLeases(ctx context.Context, in *RenewWriteLeasesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/RenewWriteLeases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *segmentServerClient) AppendToBlock(ctx context.Context, in *AppendToBlockRequest, opts ...grpc.CallOption) (*AppendToBlockResponse, error) {
 	out := new(AppendToBlockResponse)
 	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/AppendToBlock", in, out, opts...)
@@ -1554,6 +3484,38 @@ func (c *segmentServerClient) ReadFromBlock(ctx context.Context, in *ReadFromBlo
 	return out, nil
 }
 
+func (c *segmentServerClient) ReadFromBlockStream(ctx context.Context, in *ReadFromBlockRequest, opts ...grpc.CallOption) (SegmentServer_ReadFromBlockStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SegmentServer_serviceDesc.Streams[1], "/linkall.vanus.segment.SegmentServer/ReadFromBlockStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &segmentServerReadFromBlockStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SegmentServer_ReadFromBlockStreamClient interface {
+	Recv() (*ReadFromBlockResponse, error)
+	grpc.ClientStream
+}
+
+type segmentServerReadFromBlockStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *segmentServerReadFromBlockStreamClient) Recv() (*ReadFromBlockResponse, error) {
+	m := new(ReadFromBlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *segmentServerClient) LookupOffsetInBlock(ctx context.Context, in *LookupOffsetInBlockRequest, opts ...grpc.CallOption) (*LookupOffsetInBlockResponse, error) {
 	out := new(LookupOffsetInBlockResponse)
 	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/LookupOffsetInBlock", in, out, opts...)
@@ -1563,6 +3525,24 @@ func (c *segmentServerClient) LookupOffsetInBlock(ctx context.Context, in *Looku
 	return out, nil
 }
 
+func (c *segmentServerClient) LookupKeyInBlock(ctx context.Context, in *LookupKeyInBlockRequest, opts ...grpc.CallOption) (*LookupKeyInBlockResponse, error) {
+	out := new(LookupKeyInBlockResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/LookupKeyInBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) PrefetchBlock(ctx context.Context, in *PrefetchBlockRequest, opts ...grpc.CallOption) (*PrefetchBlockResponse, error) {
+	out := new(PrefetchBlockResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/PrefetchBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *segmentServerClient) Status(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
 	out := new(StatusResponse)
 	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/Status", in, out, opts...)
@@ -1572,6 +3552,24 @@ func (c *segmentServerClient) Status(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *segmentServerClient) ListInflightRequests(ctx context.Context, in *ListInflightRequestsRequest, opts ...grpc.CallOption) (*ListInflightRequestsResponse, error) {
+	out := new(ListInflightRequestsResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/ListInflightRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *segmentServerClient) AbortInflightRequests(ctx context.Context, in *AbortInflightRequestsRequest, opts ...grpc.CallOption) (*AbortInflightRequestsResponse, error) {
+	out := new(AbortInflightRequestsResponse)
+	err := c.cc.Invoke(ctx, "/linkall.vanus.segment.SegmentServer/AbortInflightRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SegmentServerServer is the server API for SegmentServer service.
 type SegmentServerServer interface {
 	Start(context.Context, *StartSegmentServerRequest) (*StartSegmentServerResponse, error)
@@ -1579,12 +3577,37 @@ type SegmentServerServer interface {
 	CreateBlock(context.Context, *CreateBlockRequest) (*emptypb.Empty, error)
 	RemoveBlock(context.Context, *RemoveBlockRequest) (*emptypb.Empty, error)
 	GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error)
+	GetBlockStats(context.Context, *GetBlockStatsRequest) (*GetBlockStatsResponse, error)
+	// ExportBlockManifest returns digests of sealed blocks signed by the key of
+	// the server, so that it can be proved offline that events in the blocks
+	// haven't been altered. Digests are recorded when blocks are sealed, and
+	// blocks which don't match them any more are reported as corrupted.
+	ExportBlockManifest(context.Context, *ExportBlockManifestRequest) (*BlockManifest, error)
+	// ExportBlock sends data of the full block in chunks, which is copied by
+	// CopyBlock of another server.
+	ExportBlock(*ExportBlockRequest, SegmentServer_ExportBlockServer) error
+	// CopyBlock fills the empty block with data of a full block in another
+	// server, so that a corrupted replica of a sealed segment is replaced.
+	CopyBlock(context.Context, *CopyBlockRequest) (*emptypb.Empty, error)
 	ActivateSegment(context.Context, *ActivateSegmentRequest) (*ActivateSegmentResponse, error)
 	InactivateSegment(context.Context, *InactivateSegmentRequest) (*emptypb.Empty, error)
+	RenewWriteLeases(context.Context, *RenewWriteLeasesRequest) (*emptypb.Empty, error)
 	AppendToBlock(context.Context, *AppendToBlockRequest) (*AppendToBlockResponse, error)
 	ReadFromBlock(context.Context, *ReadFromBlockRequest) (*ReadFromBlockResponse, error)
+	// ReadFromBlockStream is same as ReadFromBlock, but events are split into
+	// multiple responses, each of which is no larger than max_message_bytes.
+	ReadFromBlockStream(*ReadFromBlockRequest, SegmentServer_ReadFromBlockStreamServer) error
 	LookupOffsetInBlock(context.Context, *LookupOffsetInBlockRequest) (*LookupOffsetInBlockResponse, error)
+	// LookupKeyInBlock returns the offset of the latest event with the
+	// partition key in the block, it's -1 if no event has the key.
+	LookupKeyInBlock(context.Context, *LookupKeyInBlockRequest) (*LookupKeyInBlockResponse, error)
+	// PrefetchBlock loads data of the block from the offset to its end into the
+	// page cache in the background, so that reads expected soon don't wait for
+	// the disk. It only applies to full blocks.
+	PrefetchBlock(context.Context, *PrefetchBlockRequest) (*PrefetchBlockResponse, error)
 	Status(context.Context, *emptypb.Empty) (*StatusResponse, error)
+	ListInflightRequests(context.Context, *ListInflightRequestsRequest) (*ListInflightRequestsResponse, error)
+	AbortInflightRequests(context.Context, *AbortInflightRequestsRequest) (*AbortInflightRequestsResponse, error)
 }
 
 // UnimplementedSegmentServerServer can be embedded to have forward compatible implementations.
@@ -1606,24 +3629,54 @@ func (*UnimplementedSegmentServerServer) RemoveBlock(context.Context, *RemoveBlo
 func (*UnimplementedSegmentServerServer) GetBlockInfo(context.Context, *GetBlockInfoRequest) (*GetBlockInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockInfo not implemented")
 }
+func (*UnimplementedSegmentServerServer) GetBlockStats(context.Context, *GetBlockStatsRequest) (*GetBlockStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockStats not implemented")
+}
+func (*UnimplementedSegmentServerServer) ExportBlockManifest(context.Context, *ExportBlockManifestRequest) (*BlockManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportBlockManifest not implemented")
+}
+func (*UnimplementedSegmentServerServer) ExportBlock(*ExportBlockRequest, SegmentServer_ExportBlockServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBlock not implemented")
+}
+func (*UnimplementedSegmentServerServer) CopyBlock(context.Context, *CopyBlockRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyBlock not implemented")
+}
 func (*UnimplementedSegmentServerServer) ActivateSegment(context.Context, *ActivateSegmentRequest) (*ActivateSegmentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ActivateSegment not implemented")
 }
 func (*UnimplementedSegmentServerServer) InactivateSegment(context.Context, *InactivateSegmentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InactivateSegment not implemented")
 }
+func (*UnimplementedSegmentServerServer) RenewWriteLeases(context.Context, *RenewWriteLeasesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewWriteLeases not implemented")
+}
 func (*UnimplementedSegmentServerServer) AppendToBlock(context.Context, *AppendToBlockRequest) (*AppendToBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppendToBlock not implemented")
 }
 func (*UnimplementedSegmentServerServer) ReadFromBlock(context.Context, *ReadFromBlockRequest) (*ReadFromBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadFromBlock not implemented")
 }
+func (*UnimplementedSegmentServerServer) ReadFromBlockStream(*ReadFromBlockRequest, SegmentServer_ReadFromBlockStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReadFromBlockStream not implemented")
+}
 func (*UnimplementedSegmentServerServer) LookupOffsetInBlock(context.Context, *LookupOffsetInBlockRequest) (*LookupOffsetInBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupOffsetInBlock not implemented")
 }
+func (*UnimplementedSegmentServerServer) LookupKeyInBlock(context.Context, *LookupKeyInBlockRequest) (*LookupKeyInBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupKeyInBlock not implemented")
+}
+func (*UnimplementedSegmentServerServer) PrefetchBlock(context.Context, *PrefetchBlockRequest) (*PrefetchBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrefetchBlock not implemented")
+}
 func (*UnimplementedSegmentServerServer) Status(context.Context, *emptypb.Empty) (*StatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (*UnimplementedSegmentServerServer) ListInflightRequests(context.Context, *ListInflightRequestsRequest) (*ListInflightRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInflightRequests not implemented")
+}
+func (*UnimplementedSegmentServerServer) AbortInflightRequests(context.Context, *AbortInflightRequestsRequest) (*AbortInflightRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortInflightRequests not implemented")
+}
 
 func RegisterSegmentServerServer(s *grpc.Server, srv SegmentServerServer) {
 	s.RegisterService(&_SegmentServer_serviceDesc, srv)
@@ -1719,6 +3772,81 @@ func _SegmentServer_GetBlockInfo_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_GetBlockStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).GetBlockStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/GetBlockStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).GetBlockStats(ctx, req.(*GetBlockStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_ExportBlockManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportBlockManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).ExportBlockManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/ExportBlockManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).ExportBlockManifest(ctx, req.(*ExportBlockManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_ExportBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBlockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SegmentServerServer).ExportBlock(m, &segmentServerExportBlockServer{stream})
+}
+
+type SegmentServer_ExportBlockServer interface {
+	Send(*ExportBlockResponse) error
+	grpc.ServerStream
+}
+
+type segmentServerExportBlockServer struct {
+	grpc.ServerStream
+}
+
+func (x *segmentServerExportBlockServer) Send(m *ExportBlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SegmentServer_CopyBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).CopyBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/CopyBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).CopyBlock(ctx, req.(*CopyBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_ActivateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ActivateSegmentRequest)
 	if err := dec(in); err != nil {
@@ -1755,6 +3883,24 @@ func _SegmentServer_InactivateSegment_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_RenewWriteLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewWriteLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).RenewWriteLeases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/RenewWriteLeases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).RenewWriteLeases(ctx, req.(*RenewWriteLeasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_AppendToBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppendToBlockRequest)
 	if err := dec(in); err != nil {
@@ -1791,6 +3937,27 @@ func _SegmentServer_ReadFromBlock_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_ReadFromBlockStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadFromBlockRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SegmentServerServer).ReadFromBlockStream(m, &segmentServerReadFromBlockStreamServer{stream})
+}
+
+type SegmentServer_ReadFromBlockStreamServer interface {
+	Send(*ReadFromBlockResponse) error
+	grpc.ServerStream
+}
+
+type segmentServerReadFromBlockStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *segmentServerReadFromBlockStreamServer) Send(m *ReadFromBlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _SegmentServer_LookupOffsetInBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LookupOffsetInBlockRequest)
 	if err := dec(in); err != nil {
@@ -1809,6 +3976,42 @@ func _SegmentServer_LookupOffsetInBlock_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_LookupKeyInBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupKeyInBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).LookupKeyInBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/LookupKeyInBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).LookupKeyInBlock(ctx, req.(*LookupKeyInBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_PrefetchBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrefetchBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).PrefetchBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/PrefetchBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).PrefetchBlock(ctx, req.(*PrefetchBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SegmentServer_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -1827,6 +4030,42 @@ func _SegmentServer_Status_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SegmentServer_ListInflightRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInflightRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).ListInflightRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/ListInflightRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).ListInflightRequests(ctx, req.(*ListInflightRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SegmentServer_AbortInflightRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortInflightRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SegmentServerServer).AbortInflightRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/linkall.vanus.segment.SegmentServer/AbortInflightRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SegmentServerServer).AbortInflightRequests(ctx, req.(*AbortInflightRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SegmentServer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "linkall.vanus.segment.SegmentServer",
 	HandlerType: (*SegmentServerServer)(nil),
@@ -1851,6 +4090,18 @@ var _SegmentServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockInfo",
 			Handler:    _SegmentServer_GetBlockInfo_Handler,
 		},
+		{
+			MethodName: "GetBlockStats",
+			Handler:    _SegmentServer_GetBlockStats_Handler,
+		},
+		{
+			MethodName: "ExportBlockManifest",
+			Handler:    _SegmentServer_ExportBlockManifest_Handler,
+		},
+		{
+			MethodName: "CopyBlock",
+			Handler:    _SegmentServer_CopyBlock_Handler,
+		},
 		{
 			MethodName: "ActivateSegment",
 			Handler:    _SegmentServer_ActivateSegment_Handler,
@@ -1859,6 +4110,10 @@ var _SegmentServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InactivateSegment",
 			Handler:    _SegmentServer_InactivateSegment_Handler,
 		},
+		{
+			MethodName: "RenewWriteLeases",
+			Handler:    _SegmentServer_RenewWriteLeases_Handler,
+		},
 		{
 			MethodName: "AppendToBlock",
 			Handler:    _SegmentServer_AppendToBlock_Handler,
@@ -1871,11 +4126,38 @@ var _SegmentServer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupOffsetInBlock",
 			Handler:    _SegmentServer_LookupOffsetInBlock_Handler,
 		},
+		{
+			MethodName: "LookupKeyInBlock",
+			Handler:    _SegmentServer_LookupKeyInBlock_Handler,
+		},
+		{
+			MethodName: "PrefetchBlock",
+			Handler:    _SegmentServer_PrefetchBlock_Handler,
+		},
 		{
 			MethodName: "Status",
 			Handler:    _SegmentServer_Status_Handler,
 		},
+		{
+			MethodName: "ListInflightRequests",
+			Handler:    _SegmentServer_ListInflightRequests_Handler,
+		},
+		{
+			MethodName: "AbortInflightRequests",
+			Handler:    _SegmentServer_AbortInflightRequests_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportBlock",
+			Handler:       _SegmentServer_ExportBlock_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReadFromBlockStream",
+			Handler:       _SegmentServer_ReadFromBlockStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "segment.proto",
 }
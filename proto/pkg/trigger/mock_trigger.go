@@ -95,6 +95,26 @@ func (mr *MockTriggerWorkerClientMockRecorder) RemoveSubscription(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscription", reflect.TypeOf((*MockTriggerWorkerClient)(nil).RemoveSubscription), varargs...)
 }
 
+// ResumeEventlogs mocks base method.
+func (m *MockTriggerWorkerClient) ResumeEventlogs(ctx context.Context, in *ResumeEventlogsRequest, opts ...grpc.CallOption) (*ResumeEventlogsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResumeEventlogs", varargs...)
+	ret0, _ := ret[0].(*ResumeEventlogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeEventlogs indicates an expected call of ResumeEventlogs.
+func (mr *MockTriggerWorkerClientMockRecorder) ResumeEventlogs(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeEventlogs", reflect.TypeOf((*MockTriggerWorkerClient)(nil).ResumeEventlogs), varargs...)
+}
+
 // ResumeSubscription mocks base method.
 func (m *MockTriggerWorkerClient) ResumeSubscription(ctx context.Context, in *ResumeSubscriptionRequest, opts ...grpc.CallOption) (*ResumeSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +135,26 @@ func (mr *MockTriggerWorkerClientMockRecorder) ResumeSubscription(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSubscription", reflect.TypeOf((*MockTriggerWorkerClient)(nil).ResumeSubscription), varargs...)
 }
 
+// ResyncSubscriptions mocks base method.
+func (m *MockTriggerWorkerClient) ResyncSubscriptions(ctx context.Context, in *ResyncSubscriptionsRequest, opts ...grpc.CallOption) (*ResyncSubscriptionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResyncSubscriptions", varargs...)
+	ret0, _ := ret[0].(*ResyncSubscriptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResyncSubscriptions indicates an expected call of ResyncSubscriptions.
+func (mr *MockTriggerWorkerClientMockRecorder) ResyncSubscriptions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncSubscriptions", reflect.TypeOf((*MockTriggerWorkerClient)(nil).ResyncSubscriptions), varargs...)
+}
+
 // Start mocks base method.
 func (m *MockTriggerWorkerClient) Start(ctx context.Context, in *StartTriggerWorkerRequest, opts ...grpc.CallOption) (*StartTriggerWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +263,21 @@ func (mr *MockTriggerWorkerServerMockRecorder) RemoveSubscription(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscription", reflect.TypeOf((*MockTriggerWorkerServer)(nil).RemoveSubscription), arg0, arg1)
 }
 
+// ResumeEventlogs mocks base method.
+func (m *MockTriggerWorkerServer) ResumeEventlogs(arg0 context.Context, arg1 *ResumeEventlogsRequest) (*ResumeEventlogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeEventlogs", arg0, arg1)
+	ret0, _ := ret[0].(*ResumeEventlogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeEventlogs indicates an expected call of ResumeEventlogs.
+func (mr *MockTriggerWorkerServerMockRecorder) ResumeEventlogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeEventlogs", reflect.TypeOf((*MockTriggerWorkerServer)(nil).ResumeEventlogs), arg0, arg1)
+}
+
 // ResumeSubscription mocks base method.
 func (m *MockTriggerWorkerServer) ResumeSubscription(arg0 context.Context, arg1 *ResumeSubscriptionRequest) (*ResumeSubscriptionResponse, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +293,21 @@ func (mr *MockTriggerWorkerServerMockRecorder) ResumeSubscription(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSubscription", reflect.TypeOf((*MockTriggerWorkerServer)(nil).ResumeSubscription), arg0, arg1)
 }
 
+// ResyncSubscriptions mocks base method.
+func (m *MockTriggerWorkerServer) ResyncSubscriptions(arg0 context.Context, arg1 *ResyncSubscriptionsRequest) (*ResyncSubscriptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResyncSubscriptions", arg0, arg1)
+	ret0, _ := ret[0].(*ResyncSubscriptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResyncSubscriptions indicates an expected call of ResyncSubscriptions.
+func (mr *MockTriggerWorkerServerMockRecorder) ResyncSubscriptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResyncSubscriptions", reflect.TypeOf((*MockTriggerWorkerServer)(nil).ResyncSubscriptions), arg0, arg1)
+}
+
 // Start mocks base method.
 func (m *MockTriggerWorkerServer) Start(arg0 context.Context, arg1 *StartTriggerWorkerRequest) (*StartTriggerWorkerResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,238 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crash
+
+import (
+	// standard libraries.
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	// third-party libraries.
+	. "github.com/smartystreets/goconvey/convey"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store/crashpoint"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	blockID = 1
+	// smallBlockSize makes the block sealed after a few hundred events, largeBlockSize makes it never.
+	smallBlockSize = 64 * 1024
+	largeBlockSize = 64 * 1024 * 1024
+	batchSize      = 4
+)
+
+func TestMain(m *testing.M) {
+	RunServerIfRequested()
+	os.Exit(m.Run())
+}
+
+// workload appends events until the server dies or the block is full, it records events it has sent
+// and the offsets of acknowledged ones.
+type workload struct {
+	mu    sync.Mutex
+	next  int
+	sent  map[string]bool
+	acked map[int64]string
+}
+
+func newWorkload() *workload {
+	return &workload{sent: map[string]bool{}, acked: map[int64]string{}}
+}
+
+func (w *workload) run(ctx context.Context, client segpb.SegmentServerClient) error {
+	for {
+		if err := w.appendBatch(ctx, client); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *workload) appendBatch(ctx context.Context, client segpb.SegmentServerClient) error {
+	w.mu.Lock()
+	events := make([]*cepb.CloudEvent, batchSize)
+	for i := range events {
+		id := fmt.Sprintf("e-%d", w.next)
+		w.next++
+		w.sent[id] = true
+		events[i] = &cepb.CloudEvent{
+			Id:          id,
+			Source:      "crash-test",
+			SpecVersion: "1.0",
+			Type:        "test",
+			Data:        &cepb.CloudEvent_TextData{TextData: fmt.Sprintf("payload of %s", id)},
+		}
+	}
+	w.mu.Unlock()
+
+	res, err := client.AppendToBlock(ctx, &segpb.AppendToBlockRequest{
+		BlockId: blockID,
+		Events:  &cepb.CloudEventBatch{Events: events},
+	})
+	if err != nil {
+		return err
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for i, off := range res.Offsets {
+		w.acked[off] = events[i].Id
+	}
+	return nil
+}
+
+// verify checks that every acknowledged event is read at its offset, and every event read was sent.
+func (w *workload) verify(events []*cepb.CloudEvent) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	seen := make(map[string]bool, len(events))
+	for off, e := range events {
+		if !w.sent[e.Id] {
+			return fmt.Errorf("unknown event %q at offset %d", e.Id, off)
+		}
+		if seen[e.Id] {
+			return fmt.Errorf("duplicated event %q at offset %d", e.Id, off)
+		}
+		seen[e.Id] = true
+		// the store keeps data as bytes, events are read with binary data whatever they're sent with.
+		data := string(e.GetBinaryData())
+		if text := e.GetTextData(); text != "" {
+			data = text
+		}
+		if data != fmt.Sprintf("payload of %s", e.Id) {
+			return fmt.Errorf("corrupted data of event %q at offset %d: %q", e.Id, off, data)
+		}
+	}
+	for off, id := range w.acked {
+		if off >= int64(len(events)) {
+			return fmt.Errorf("acknowledged event %q at offset %d is lost, %d events read", id, off, len(events))
+		}
+		if events[off].Id != id {
+			return fmt.Errorf("acknowledged event %q at offset %d is replaced by %q", id, off, events[off].Id)
+		}
+	}
+	return nil
+}
+
+// end returns the offset after the last acknowledged event.
+func (w *workload) end() int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	end := int64(0)
+	for off := range w.acked {
+		if off >= end {
+			end = off + 1
+		}
+	}
+	return end
+}
+
+// readAcked reads the block until acknowledged events are all visible or it times out. An append is
+// acknowledged once it's committed, and becomes readable after it's applied to the block.
+func readAcked(ctx context.Context, h *Harness, w *workload) ([]*cepb.CloudEvent, error) {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		events, err := h.ReadAll(ctx, blockID)
+		if err != nil || int64(len(events)) >= w.end() || time.Now().After(deadline) {
+			return events, err
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestCrashRecovery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("crash recovery tests run segment servers in subprocesses")
+	}
+
+	cases := []struct {
+		name   string
+		point  string
+		sealed bool
+	}{
+		{name: "crash after WAL is persisted", point: crashpoint.WALAppendPersisted + ":50"},
+		{name: "crash before entries are written to block", point: crashpoint.BlockAppendBeforeWrite + ":30"},
+		{name: "crash before indexes of sealed block are written", point: crashpoint.BlockSealBeforeIndex, sealed: true},
+		{name: "crash before header of sealed block is written", point: crashpoint.BlockSealBeforeHeader, sealed: true},
+		{name: "kill during appending"},
+	}
+
+	for _, tc := range cases {
+		Convey(fmt.Sprintf("test crash recovery: %s", tc.name), t, func() {
+			ctx := context.Background()
+			h, err := NewHarness(t.TempDir())
+			So(err, ShouldBeNil)
+			defer h.Kill()
+
+			So(h.Start(ctx, tc.point), ShouldBeNil)
+			size := int64(largeBlockSize)
+			if tc.sealed {
+				size = smallBlockSize
+			}
+			So(h.CreateBlock(ctx, blockID, size), ShouldBeNil)
+			So(h.WaitWritable(ctx, blockID), ShouldBeNil)
+
+			w := newWorkload()
+			done := make(chan error, 1)
+			client := h.Client()
+			go func() {
+				done <- w.run(ctx, client)
+			}()
+
+			if tc.point == "" {
+				time.Sleep(500 * time.Millisecond)
+				h.Kill()
+			} else {
+				hit := false
+				select {
+				case <-h.Exited():
+					hit = true
+				case <-time.After(time.Minute):
+				}
+				So(hit, ShouldBeTrue)
+				So(h.Output(), ShouldContainSubstring, "crashpoint: ")
+				h.Kill()
+			}
+			So(<-done, ShouldNotBeNil)
+
+			So(h.Start(ctx), ShouldBeNil)
+			err = h.WaitWritable(ctx, blockID)
+			if tc.sealed {
+				So(errors.Is(err, errors.ErrSegmentFull), ShouldBeTrue)
+			} else {
+				So(err, ShouldBeNil)
+				// The recovered block accepts appends after the recovered events.
+				for i := 0; i < 10; i++ {
+					So(w.appendBatch(ctx, h.Client()), ShouldBeNil)
+				}
+			}
+
+			events, err := readAcked(ctx, h, w)
+			So(err, ShouldBeNil)
+			So(w.verify(events), ShouldBeNil)
+			if tc.sealed {
+				So(len(events), ShouldBeGreaterThanOrEqualTo, len(w.acked))
+			}
+		})
+	}
+}
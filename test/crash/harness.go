@@ -0,0 +1,324 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package crash runs a segment server in a subprocess and kills it, either from outside or at crash points
+// injected into the store, to check that recovery of WAL, block headers and indexes loses no acknowledged
+// events and serves no corrupted ones.
+package crash
+
+import (
+	// standard libraries.
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// third-party libraries.
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	// first-party libraries.
+	cepb "github.com/linkall-labs/vanus/proto/pkg/cloudevents"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+
+	// this project.
+	"github.com/linkall-labs/vanus/internal/store"
+	"github.com/linkall-labs/vanus/internal/store/config"
+	"github.com/linkall-labs/vanus/internal/store/crashpoint"
+	"github.com/linkall-labs/vanus/internal/store/segment"
+	"github.com/linkall-labs/vanus/pkg/errors"
+)
+
+const (
+	dirEnv  = "VANUS_CRASH_HARNESS_DIR"
+	portEnv = "VANUS_CRASH_HARNESS_PORT"
+	// debugModeEnv makes the segment server run without controller.
+	debugModeEnv = "SEGMENT_SERVER_DEBUG_MODE"
+
+	readyTimeout   = 30 * time.Second
+	volumeCapacity = 1 << 30
+	readBatchSize  = 64
+)
+
+// RunServerIfRequested runs the segment server and exits if the process is started by a Harness, it
+// must be called at the beginning of TestMain.
+func RunServerIfRequested() {
+	dir := os.Getenv(dirEnv)
+	if dir == "" {
+		return
+	}
+	port, err := strconv.Atoi(os.Getenv(portEnv))
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "invalid port: %s\n", err)
+		os.Exit(2)
+	}
+	if err = runServer(dir, port); err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "run segment server failed: %s\n", err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+func runServer(dir string, port int) error {
+	walCfg := config.WAL{IO: config.IO{Engine: config.Psync}}
+	cfg := store.Config{
+		IP:   "127.0.0.1",
+		Port: port,
+		Volume: store.VolumeInfo{
+			ID:       1,
+			Dir:      dir,
+			Capacity: volumeCapacity,
+		},
+		MetaStore:   config.SyncStore{WAL: walCfg},
+		OffsetStore: config.AsyncStore{WAL: walCfg},
+		Raft:        config.Raft{WAL: walCfg},
+		VSB:         config.VSB{IO: config.IO{Engine: config.Psync}},
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", cfg.IP, cfg.Port))
+	if err != nil {
+		return err
+	}
+	srv := segment.NewServer(cfg)
+	if err = srv.Initialize(context.Background()); err != nil {
+		return err
+	}
+	return srv.Serve(lis)
+}
+
+// Harness manages the segment server subprocess, data of the server is kept in dir across restarts.
+type Harness struct {
+	dir  string
+	port int
+
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	exited chan struct{}
+	output lockedBuffer
+	conn   *grpc.ClientConn
+	client segpb.SegmentServerClient
+}
+
+func NewHarness(dir string) (*Harness, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	port := lis.Addr().(*net.TCPAddr).Port
+	_ = lis.Close()
+	return &Harness{dir: dir, port: port}, nil
+}
+
+// Start starts the server with crash points, e.g. "vsb.seal.before_header" or "wal.append.persisted:10",
+// and waits until it's ready.
+func (h *Harness) Start(ctx context.Context, points ...string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.cmd != nil {
+		return fmt.Errorf("the server is running")
+	}
+
+	// The test binary is started again as the server, RunServerIfRequested takes over it in TestMain.
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", dirEnv, h.dir),
+		fmt.Sprintf("%s=%d", portEnv, h.port),
+		fmt.Sprintf("%s=true", debugModeEnv),
+		fmt.Sprintf("%s=%s", crashpoint.Env, strings.Join(points, ",")),
+	)
+	h.output.Reset()
+	cmd.Stdout = &h.output
+	cmd.Stderr = &h.output
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	exited := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		close(exited)
+	}()
+	h.cmd, h.exited = cmd, exited
+
+	conn, err := grpc.Dial(fmt.Sprintf("127.0.0.1:%d", h.port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		h.killLocked()
+		return err
+	}
+	h.conn, h.client = conn, segpb.NewSegmentServerClient(conn)
+
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+	for {
+		if _, err = h.client.Status(ctx, &emptypb.Empty{}); err == nil {
+			return nil
+		}
+		select {
+		case <-exited:
+			h.killLocked()
+			return fmt.Errorf("the server exited on startup: %s", h.output.String())
+		case <-ctx.Done():
+			h.killLocked()
+			return fmt.Errorf("the server isn't ready: %w", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// Kill kills the server by SIGKILL, it's a no-op if the server has exited.
+func (h *Harness) Kill() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.killLocked()
+}
+
+func (h *Harness) killLocked() {
+	if h.cmd == nil {
+		return
+	}
+	_ = h.cmd.Process.Kill()
+	<-h.exited
+	_ = h.conn.Close()
+	h.cmd, h.exited, h.conn, h.client = nil, nil, nil, nil
+}
+
+// Exited returns a channel which is closed once the server exits, e.g. killed at a crash point.
+func (h *Harness) Exited() <-chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.exited
+}
+
+// Output returns what the server printed to stdout and stderr.
+func (h *Harness) Output() string {
+	return h.output.String()
+}
+
+func (h *Harness) Client() segpb.SegmentServerClient {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.client
+}
+
+// CreateBlock creates a block with a single replica and grants it a write lease.
+func (h *Harness) CreateBlock(ctx context.Context, id uint64, size int64) error {
+	client := h.Client()
+	if _, err := client.CreateBlock(ctx, &segpb.CreateBlockRequest{Id: id, Size: size}); err != nil {
+		return err
+	}
+	_, err := client.ActivateSegment(ctx, &segpb.ActivateSegmentRequest{
+		EventLogId:     1,
+		ReplicaGroupId: 1,
+		Replicas:       map[uint64]string{id: fmt.Sprintf("127.0.0.1:%d", h.port)},
+		Lease:          h.lease(id),
+	})
+	return err
+}
+
+// GrantLease grants the write lease again, leases aren't persisted so that they're lost on restart.
+func (h *Harness) GrantLease(ctx context.Context, id uint64) error {
+	_, err := h.Client().RenewWriteLeases(ctx, &segpb.RenewWriteLeasesRequest{
+		Leases: []*segpb.WriteLease{h.lease(id)},
+	})
+	return err
+}
+
+func (h *Harness) lease(id uint64) *segpb.WriteLease {
+	return &segpb.WriteLease{BlockId: id, Epoch: 1, TtlMs: time.Hour.Milliseconds()}
+}
+
+// WaitWritable waits until the recovered replica of block id is elected as the leader and accepts appends,
+// it returns ErrSegmentFull if the block is sealed.
+func (h *Harness) WaitWritable(ctx context.Context, id uint64) error {
+	ctx, cancel := context.WithTimeout(ctx, readyTimeout)
+	defer cancel()
+	for {
+		if err := h.GrantLease(ctx, id); err != nil {
+			return err
+		}
+		res, err := h.Client().GetBlockInfo(ctx, &segpb.GetBlockInfoRequest{Ids: []uint64{id}})
+		if err != nil {
+			return err
+		}
+		if len(res.Blocks) != 1 {
+			return fmt.Errorf("the block %d is lost", id)
+		}
+		if info := res.Blocks[0]; info.IsFull {
+			return errors.ErrSegmentFull
+		} else if info.Leader == id {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("the block %d isn't writable: %w", id, ctx.Err())
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+// ReadAll reads all events of block id from the beginning.
+func (h *Harness) ReadAll(ctx context.Context, id uint64) ([]*cepb.CloudEvent, error) {
+	var events []*cepb.CloudEvent
+	for {
+		res, err := h.Client().ReadFromBlock(ctx, &segpb.ReadFromBlockRequest{
+			BlockId: id,
+			Offset:  int64(len(events)),
+			Number:  readBatchSize,
+		})
+		if err != nil {
+			if errors.Is(err, errors.ErrOffsetOnEnd) || errors.Is(err, errors.ErrOffsetOverflow) {
+				return events, nil
+			}
+			return nil, err
+		}
+		if res.Events == nil || len(res.Events.Events) == 0 {
+			return events, nil
+		}
+		events = append(events, res.Events.Events...)
+	}
+}
+
+// lockedBuffer collects output of the server, which is written by goroutines of exec.Cmd.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.buf.Reset()
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/store/vsb/dictionary"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+)
+
+// sampleBatchSize is the maximum number of events the gateway returns by a request.
+const sampleBatchSize = 64
+
+func eventbusDictionaryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dictionary",
+		Short: "train the zstd dictionary of a eventbus from its events, or remove it",
+		Long: "train the zstd dictionary of a eventbus from its events by zstd --train, upload a trained one " +
+			"by --file, or remove it. Blocks are compressed with the dictionary once they are archived if " +
+			"stores compress blocks in zstd, blocks created before it's set aren't affected.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			var dict []byte
+			var err error
+			switch {
+			case removeDictionary:
+			case dictFile != "":
+				if dict, err = os.ReadFile(dictFile); err != nil {
+					cmdFailedf(cmd, "read dictionary failed: %s", err)
+				}
+			default:
+				samples := sampleEvents(cmd, eventbus, offset, dictSamples)
+				if dict, err = trainDictionary(samples, dictSize); err != nil {
+					cmdFailedf(cmd, "train dictionary from %d events failed: %s", len(samples), err)
+				}
+			}
+			if dict != nil {
+				if err = dictionary.Validate(dict); err != nil {
+					cmdFailedf(cmd, "check dictionary failed: %s", err)
+				}
+			}
+			res, err := client.SetEventBusDictionary(context.Background(), &ctrlpb.SetEventBusDictionaryRequest{
+				Name:       eventbus,
+				Dictionary: dict,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "set dictionary of eventbus failed: %s", err)
+			}
+			printDictionary(cmd, res, len(dict))
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to train the dictionary of")
+	cmd.Flags().Int64Var(&offset, "offset", 0, "the offset to sample events from")
+	cmd.Flags().IntVar(&dictSamples, "samples", 1000, "the number of events to sample")
+	cmd.Flags().IntVar(&dictSize, "size", dictionary.DefaultSize, "the size of the dictionary in bytes")
+	cmd.Flags().StringVar(&zstdPath, "zstd", "zstd", "the zstd command to train the dictionary with")
+	cmd.Flags().StringVar(&dictFile, "file", "", "the dictionary trained by zstd --train to upload "+
+		"instead of training one")
+	cmd.Flags().BoolVar(&removeDictionary, "remove", false, "remove the dictionary instead of training one")
+	return cmd
+}
+
+// trainDictionary trains a dictionary of size bytes from samples by the zstd command, each sample is written
+// to a file, since zstd --train takes files as samples.
+func trainDictionary(samples [][]byte, size int) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "vsctl-dictionary-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	sampleDir := filepath.Join(dir, "samples")
+	if err = os.Mkdir(sampleDir, 0o700); err != nil {
+		return nil, err
+	}
+	for i, s := range samples {
+		if err = os.WriteFile(filepath.Join(sampleDir, strconv.Itoa(i)), s, 0o600); err != nil {
+			return nil, err
+		}
+	}
+	out := filepath.Join(dir, "dictionary")
+	//nolint:gosec // the command is given by the user.
+	train := exec.Command(zstdPath, "--train", "-q", "-r", sampleDir, "-o", out, "--maxdict="+strconv.Itoa(size))
+	if output, err := train.CombinedOutput(); err != nil {
+		if len(output) == 0 {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s", err, output)
+	}
+	return os.ReadFile(out)
+}
+
+// sampleEvents reads at most num events of the eventbus from off, events are sampled in their JSON format,
+// which shares attribute values and data with the format they are stored in.
+func sampleEvents(cmd *cobra.Command, eb string, off int64, num int) [][]byte {
+	samples := make([][]byte, 0, num)
+	for len(samples) < num {
+		n := num - len(samples)
+		if n > sampleBatchSize {
+			n = sampleBatchSize
+		}
+		res, err := client.GetEvent(context.Background(), &proxypb.GetEventRequest{
+			Eventbus: eb,
+			Offset:   off,
+			Number:   int32(n),
+		})
+		if err != nil {
+			if len(samples) != 0 {
+				// Reading beyond the end of the eventbus fails.
+				break
+			}
+			cmdFailedf(cmd, "sample events failed: %s", err)
+		}
+		for _, e := range res.Events {
+			samples = append(samples, e.Value)
+		}
+		if len(res.Events) < n {
+			break
+		}
+		off += int64(len(res.Events))
+	}
+	return samples
+}
+
+func printDictionary(cmd *cobra.Command, eb *metapb.EventBus, size int) {
+	result := "Train Success"
+	if eb.DictionaryId == 0 {
+		result = "Remove Success"
+	}
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, map[string]interface{}{
+			"Result":        result,
+			"Eventbus":      eb.Name,
+			"Dictionary_ID": eb.DictionaryId,
+			"Size":          size,
+		})
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Result", "Eventbus", "Dictionary_ID", "Size"})
+	t.AppendRow(table.Row{result, eb.Name, eb.DictionaryId, size})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, t)
+}
@@ -0,0 +1,97 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/doctor"
+	"github.com/spf13/cobra"
+)
+
+func NewDoctorCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "doctor <component>=<config file> ...",
+		Short: "check the host before deploying components on it",
+		Long: "check config files, kv connectivity and permissions, data dirs, clock skew, open files limit " +
+			"and ports of components, e.g. vsctl doctor controller=./config/controller.yaml " +
+			"store=./config/store.yaml, components are " + strings.Join(doctor.Components, ", "),
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "at least one component is required\n")
+			}
+			var results []doctor.Result
+			for _, arg := range args {
+				component, file, ok := strings.Cut(arg, "=")
+				if !ok || file == "" {
+					cmdFailedWithHelpNotice(cmd, "the argument must be <component>=<config file>\n")
+				}
+				results = append(results, doctor.Run(context.Background(), doctor.Options{
+					Component:    component,
+					ConfigFile:   file,
+					NTPServer:    ntpServer,
+					MaxClockSkew: maxClockSkew,
+					MinOpenFiles: minOpenFiles,
+				})...)
+			}
+			printDoctorResults(cmd, results)
+			for _, r := range results {
+				if r.Status == doctor.StatusFailed {
+					os.Exit(1)
+				}
+			}
+		},
+	}
+	cmd.Flags().StringVar(&ntpServer, "ntp-server", "", "the NTP server which the clock is compared with, "+
+		"e.g. pool.ntp.org, the clock isn't checked if it's empty")
+	cmd.Flags().DurationVar(&maxClockSkew, "max-clock-skew", 0, "the max offset of the clock from the NTP "+
+		"server, default is 0, means 1s")
+	cmd.Flags().Uint64Var(&minOpenFiles, "min-open-files", 0, "the min limit of open files, default is 0, "+
+		"means 65536")
+	return cmd
+}
+
+func printDoctorResults(cmd *cobra.Command, results []doctor.Result) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, results)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Component", "Check", "Status", "Detail"})
+	for _, r := range results {
+		status := string(r.Status)
+		switch r.Status {
+		case doctor.StatusWarning:
+			status = color.YellowString(status)
+		case doctor.StatusFailed:
+			status = color.RedString(status)
+		default:
+			status = color.GreenString(status)
+		}
+		t.AppendRow(table.Row{r.Component, r.Check, status, r.Detail})
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter, WidthMax: 80},
+	})
+	RenderTable(cmd, t)
+}
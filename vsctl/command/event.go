@@ -58,6 +58,7 @@ func NewEventCommand() *cobra.Command {
 	cmd.AddCommand(getEventCommand())
 	cmd.AddCommand(putEventCommand())
 	cmd.AddCommand(queryEventCommand())
+	cmd.AddCommand(subEventCommand())
 	return cmd
 }
 
@@ -75,7 +76,11 @@ func putEventCommand() *cobra.Command {
 			if len(args) == 0 {
 				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
 			}
-			c, err := v2.NewClientHTTP()
+			var opts []cehttp.Option
+			if token := getGatewayToken(cmd); token != "" {
+				opts = append(opts, cehttp.WithHeader("authorization", "Bearer "+token))
+			}
+			c, err := v2.NewClientHTTP(opts...)
 			if err != nil {
 				cmdFailedf(cmd, "create ce client error: %s\n", err)
 			}
@@ -105,8 +110,8 @@ func putEventCommand() *cobra.Command {
 		"event delay delivery time of CloudEvent, only support the unit of seconds, for example: 60")
 	cmd.Flags().StringVar(&eventType, "type", "cmd", "event type of CloudEvent")
 	cmd.Flags().StringVar(&eventData, "data", "", "event data of CloudEvent")
-	cmd.Flags().StringVar(&dataFile, "file", "", "the data file to send, each line represent a event "+
-		"and like [id],[source],[type],<body>")
+	cmd.Flags().StringVar(&dataFile, "file", "", "the data file to send, - for stdin, each line represent "+
+		"a event which is a CloudEvent in JSON format or like [id],[source],[type],<body>")
 	cmd.Flags().BoolVar(&printDataTemplate, "print-template", false, "print data template file")
 	cmd.Flags().BoolVar(&detail, "detail", false, "show detail of persistence event")
 	return cmd
@@ -140,7 +145,7 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 		m := make(map[string]interface{})
 		if err := json.Unmarshal([]byte(eventData), &m); err != nil {
 			color.White(eventData)
-			cmdFailedf(cmd, "invalid format of data body: %s, err: %s", eventData, err.Error())
+			cmdExitf(cmd, ExitCodeUsage, "invalid format of data body: %s, err: %s", eventData, err)
 		}
 		err = event.SetData(v2.ApplicationJSON, m)
 	} else {
@@ -160,19 +165,18 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 	}
 
 	if v2.IsUndelivered(res) {
-		cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+		cmdExitf(cmd, ExitCodeUnavailable, "failed to send: %s\n", res)
 	} else {
 		var httpResult *cehttp.Result
 		v2.ResultAs(res, &httpResult)
 		if httpResult == nil {
-			cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+			cmdFailedf(cmd, "failed to send: %s\n", res)
 		} else {
 			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{
+				PrintData(cmd, map[string]interface{}{
 					"Result": httpResult.StatusCode,
 					"Error":  fmt.Errorf(httpResult.Format, httpResult.Args...),
 				})
-				color.Green(string(data))
 			} else {
 				t := table.NewWriter()
 				tbcfg := []table.ColumnConfig{
@@ -188,22 +192,25 @@ func sendOne(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 					t.AppendRow(table.Row{httpResult.StatusCode, fmt.Errorf(httpResult.Format, httpResult.Args...)})
 				}
 				t.SetColumnConfigs(tbcfg)
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
 			}
 		}
 	}
 }
 
 func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
-	f, err := os.Open(dataFile)
-	defer func() {
-		_ = f.Close()
-	}()
-	if err != nil {
-		cmdFailedf(cmd, "open data file failed: %s\n", err)
+	var err error
+	f := os.Stdin
+	if dataFile != "-" {
+		f, err = os.Open(dataFile)
+		if err != nil {
+			cmdFailedf(cmd, "open data file failed: %s\n", err)
+		}
+		defer func() {
+			_ = f.Close()
+		}()
 	}
-	events := make([][]string, 0)
+	events := make([]*v2.Event, 0)
 	reader := bufio.NewReader(f)
 	for {
 		data, isPrx, _err := reader.ReadLine()
@@ -221,11 +228,10 @@ func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 			}
 			data = append(data, _data...)
 		}
-		arr := strings.Split(string(data), ",")
-		if len(arr) != cloudEventDataRowLength {
-			cmdFailedf(cmd, "invalid data file: %s, please see vsctl event put --print-template", string(data))
+		if len(strings.TrimSpace(string(data))) == 0 {
+			continue
 		}
-		events = append(events, arr)
+		events = append(events, parseEventLine(cmd, data))
 	}
 	t := table.NewWriter()
 	tbcfg := []table.ColumnConfig{
@@ -239,39 +245,28 @@ func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 		t.AppendHeader(table.Row{"No.", "Result"})
 	}
 	t.SetColumnConfigs(tbcfg)
-	t.SetOutputMirror(os.Stdout)
-	for idx, v := range events {
-		event := v2.NewEvent()
-		event.SetID(v[0])
-		event.SetSource(v[1])
-		event.SetType(v[2])
-		err = event.SetData(v2.ApplicationJSON, v[3])
-		if err != nil {
-			cmdFailedf(cmd, "set data failed: %s\n", err)
-		}
-
+	for idx, event := range events {
 		var res protocol.Result
 		var resEvent *v2.Event
 		if !detail {
-			resEvent, res = nil, ceClient.Send(ctx, event)
+			resEvent, res = nil, ceClient.Send(ctx, *event)
 		} else {
-			resEvent, res = ceClient.Request(ctx, event)
+			resEvent, res = ceClient.Request(ctx, *event)
 		}
 
 		if v2.IsUndelivered(res) {
-			cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+			cmdExitf(cmd, ExitCodeUnavailable, "failed to send: %s\n", res)
 		} else {
 			var httpResult *cehttp.Result
 			v2.ResultAs(res, &httpResult)
 			if httpResult == nil {
-				cmdFailedf(cmd, "failed to send: %s\n", res.Error())
+				cmdFailedf(cmd, "failed to send: %s\n", res)
 			} else {
 				if IsFormatJSON(cmd) {
-					data, _ := json.Marshal(map[string]interface{}{
+					PrintData(cmd, map[string]interface{}{
 						"No.":    idx,
 						"Result": httpResult.StatusCode,
 					})
-					color.Green(string(data))
 				} else {
 					if detail {
 						t.AppendRow(table.Row{idx, httpResult.StatusCode, resEvent})
@@ -279,13 +274,37 @@ func sendFile(ctx context.Context, cmd *cobra.Command, ceClient v2.Client) {
 						t.AppendRow(table.Row{idx, httpResult.StatusCode})
 					}
 					t.AppendSeparator()
-					t.Render()
+					RenderTable(cmd, t)
 				}
 			}
 		}
 	}
 }
 
+// parseEventLine parses a line of the data file, which is either a CloudEvent in JSON format or
+// like [id],[source],[type],<body>.
+func parseEventLine(cmd *cobra.Command, data []byte) *v2.Event {
+	event := v2.NewEvent()
+	if strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		// data is reused by the reader of the file.
+		if err := event.UnmarshalJSON(append([]byte{}, data...)); err != nil {
+			cmdFailedf(cmd, "invalid CloudEvent: %s, err: %s", string(data), err)
+		}
+		return &event
+	}
+	arr := strings.Split(string(data), ",")
+	if len(arr) != cloudEventDataRowLength {
+		cmdFailedf(cmd, "invalid data file: %s, please see vsctl event put --print-template", string(data))
+	}
+	event.SetID(arr[0])
+	event.SetSource(arr[1])
+	event.SetType(arr[2])
+	if err := event.SetData(v2.ApplicationJSON, arr[3]); err != nil {
+		cmdFailedf(cmd, "set data failed: %s\n", err)
+	}
+	return &event
+}
+
 func getEventCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get <eventbus-name or event-id> ",
@@ -296,11 +315,12 @@ func getEventCommand() *cobra.Command {
 			}
 
 			res, err := client.GetEvent(context.Background(), &proxypb.GetEventRequest{
-				Eventbus:   args[0],
-				EventlogId: eventlogID,
-				Offset:     offset,
-				EventId:    eventID,
-				Number:     int32(number),
+				Eventbus:     args[0],
+				EventlogId:   eventlogID,
+				Offset:       offset,
+				EventId:      eventID,
+				Number:       int32(number),
+				PartitionKey: partitionKey,
 			})
 
 			if err != nil {
@@ -308,14 +328,14 @@ func getEventCommand() *cobra.Command {
 			}
 
 			if IsFormatJSON(cmd) {
+				events := make([]map[string]interface{}, len(res.Events))
 				for idx := range res.Events {
-					data, _ := json.Marshal(map[string]interface{}{
+					events[idx] = map[string]interface{}{
 						"No.":   idx,
 						"Event": res.Events[idx].String(),
-					})
-
-					color.Yellow(string(data))
+					}
 				}
+				PrintData(cmd, events)
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"No.", "Event"})
@@ -327,8 +347,7 @@ func getEventCommand() *cobra.Command {
 					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 					{Number: 2, AlignHeader: text.AlignCenter},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
 			}
 		},
 	}
@@ -337,6 +356,8 @@ func getEventCommand() *cobra.Command {
 	cmd.Flags().Int16Var(&number, "number", 1, "the number of event you want to get")
 	cmd.Flags().Uint64Var(&eventlogID, "eventlog", 0, "get events from a specified eventlog")
 	cmd.Flags().StringVar(&eventID, "event-id", "", "get event by event ID")
+	cmd.Flags().StringVar(&partitionKey, "partition-key", "", "get the latest event with the partition "+
+		"key of an eventbus ordered by key")
 	return cmd
 }
 
@@ -366,7 +387,7 @@ func queryEventCommand() *cobra.Command {
 				Timestamp:  t.UnixMilli(),
 			})
 			if err != nil {
-				cmdFailedf(cmd, fmt.Sprintf("failed to query: %s.", err.Error()))
+				cmdFailedf(cmd, "failed to query: %s.", err)
 			}
 
 			result := make([]*QueryOutput, 0)
@@ -395,8 +416,7 @@ func queryEventCommand() *cobra.Command {
 				result = append(result, qo)
 			}
 			if IsFormatJSON(cmd) {
-				data, _ := json.MarshalIndent(result, "", " ")
-				color.Yellow(string(data))
+				PrintData(cmd, result)
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"Eventlog", "Offset", "Event"})
@@ -411,8 +431,7 @@ func queryEventCommand() *cobra.Command {
 					{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 					{Number: 3, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
 			}
 		},
 	}
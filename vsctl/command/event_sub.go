@@ -0,0 +1,181 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	v2 "github.com/cloudevents/sdk-go/v2"
+	"github.com/fatih/color"
+	"github.com/linkall-labs/vanus/client/pkg/eventlog"
+	"github.com/linkall-labs/vanus/internal/primitive"
+	"github.com/linkall-labs/vanus/internal/trigger/filter"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	subReadBatchSize = 32
+	subReadTimeout   = 5 * time.Second
+	subPollInterval  = time.Second
+)
+
+func subEventCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sub <eventbus-name> ",
+		Short: "print events of eventbus to stdout",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) == 0 {
+				cmdFailedWithHelpNotice(cmd, "eventbus name can't be empty\n")
+			}
+			var f filter.Filter
+			if filters != "" {
+				var subFilters []*primitive.SubscriptionFilter
+				if err := json.Unmarshal([]byte(filters), &subFilters); err != nil {
+					cmdFailedf(cmd, "the filter invalid: %s", err)
+				}
+				f = filter.GetFilter(subFilters)
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			bus, err := client.GetEventBus(ctx, &metapb.EventBus{Name: args[0]})
+			if err != nil {
+				cmdFailedf(cmd, "get eventbus failed: %s\n", err)
+			}
+
+			s := &eventPrinter{eventbus: bus.Name, filter: f}
+			wg := sync.WaitGroup{}
+			for _, l := range bus.Logs {
+				res, err := client.ListSegment(ctx, &ctrlpb.ListSegmentRequest{
+					EventBusId: bus.Id,
+					EventLogId: l.EventLogId,
+				})
+				if err != nil {
+					cmdFailedf(cmd, "list segments of eventlog failed: %s\n", err)
+				}
+				off := startOffsetOf(res.Segments, tail)
+				wg.Add(1)
+				go func(id uint64, off int64) {
+					defer wg.Done()
+					if err := s.run(ctx, id, off); err != nil {
+						color.Red("read eventlog %s failed: %s\n", formatID(id), err)
+					}
+				}(l.EventLogId, off)
+			}
+			wg.Wait()
+		},
+	}
+	cmd.Flags().StringVar(&filters, "filters", "", "filter events you interested, JSON format required")
+	cmd.Flags().BoolVar(&tail, "tail", false, "wait for new events appended after the command starts instead of "+
+		"printing existing events from the beginning")
+	return cmd
+}
+
+// startOffsetOf returns the offset to read an eventlog with the segments from, it's the offset of the next
+// event to be appended if tailing, or the earliest offset which isn't expired otherwise.
+func startOffsetOf(segments []*metapb.Segment, tailing bool) int64 {
+	if len(segments) == 0 {
+		return 0
+	}
+	if !tailing {
+		return segments[0].StartOffsetInLog
+	}
+	last := segments[len(segments)-1]
+	// the end offset of a segment is exclusive, and it's -1 if the segment is empty.
+	if last.EndOffsetInLog < 0 {
+		return last.StartOffsetInLog
+	}
+	return last.EndOffsetInLog
+}
+
+// eventPrinter prints events of eventlogs as JSON, one event per line.
+type eventPrinter struct {
+	eventbus string
+	filter   filter.Filter
+	mu       sync.Mutex
+}
+
+// run prints events of the eventlog from offset off. It returns when ctx is done, or the end of the eventlog
+// is reached if it isn't tailing.
+func (p *eventPrinter) run(ctx context.Context, id uint64, off int64) error {
+	for {
+		timeout, cancel := context.WithTimeout(ctx, subReadTimeout)
+		res, err := client.GetEvent(timeout, &proxypb.GetEventRequest{
+			Eventbus:   p.eventbus,
+			EventlogId: id,
+			Offset:     off,
+			Number:     subReadBatchSize,
+		})
+		cancel()
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err == nil && len(res.Events) > 0:
+		case err == nil, errors.Is(err, errors.ErrOffsetOnEnd), errors.Is(err, errors.ErrOffsetOverflow),
+			errors.Is(err, errors.ErrTryAgain), status.Code(err) == codes.DeadlineExceeded:
+			if !tail {
+				return nil
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(subPollInterval):
+			}
+			continue
+		default:
+			return err
+		}
+		for _, v := range res.Events {
+			p.print(v.Value)
+		}
+		off += int64(len(res.Events))
+	}
+}
+
+func (p *eventPrinter) print(data []byte) {
+	e := v2.NewEvent()
+	if err := e.UnmarshalJSON(data); err != nil {
+		color.Yellow("unmarshal event failed: %s\n", err)
+		return
+	}
+	if ec, ok := e.Context.(*v2.EventContextV1); ok {
+		delete(ec.Extensions, eventlog.XVanusLogOffset)
+	}
+	if p.filter != nil && p.filter.Filter(e) == filter.FailFilter {
+		return
+	}
+	data, err := e.MarshalJSON()
+	if err != nil {
+		color.Yellow("marshal event %s failed: %s\n", e.ID(), err)
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintln(os.Stdout, string(data))
+}
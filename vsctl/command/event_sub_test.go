@@ -0,0 +1,40 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"testing"
+
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestStartOffsetOf(t *testing.T) {
+	Convey("test start offset of eventlog", t, func() {
+		So(startOffsetOf(nil, false), ShouldEqual, 0)
+		So(startOffsetOf(nil, true), ShouldEqual, 0)
+
+		segments := []*metapb.Segment{
+			{StartOffsetInLog: 100, EndOffsetInLog: 200, NumberEventStored: 100},
+			{StartOffsetInLog: 200, EndOffsetInLog: 250, NumberEventStored: 50},
+		}
+		So(startOffsetOf(segments, false), ShouldEqual, 100)
+		// the last event at 249 isn't printed again.
+		So(startOffsetOf(segments, true), ShouldEqual, 250)
+
+		segments = append(segments, &metapb.Segment{StartOffsetInLog: 250, EndOffsetInLog: -1})
+		So(startOffsetOf(segments, true), ShouldEqual, 250)
+	})
+}
@@ -16,8 +16,7 @@ package command
 
 import (
 	"context"
-	"encoding/json"
-	"os"
+	"fmt"
 	"strings"
 	"time"
 
@@ -29,6 +28,7 @@ import (
 	"github.com/linkall-labs/vanus/internal/primitive/vanus"
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"github.com/spf13/cobra"
 )
 
@@ -47,6 +47,14 @@ func NewEventbusCommand() *cobra.Command {
 	cmd.AddCommand(deleteEventbusCommand())
 	cmd.AddCommand(getEventbusInfoCommand())
 	cmd.AddCommand(listEventbusInfoCommand())
+	cmd.AddCommand(truncateEventlogCommand())
+	cmd.AddCommand(eventlogStatsCommand())
+	cmd.AddCommand(importEventbusCommand())
+	cmd.AddCommand(verifyEventbusOrderingCommand())
+	cmd.AddCommand(annotateEventbusCommand())
+	cmd.AddCommand(eventbusProfileCommand())
+	cmd.AddCommand(eventbusDictionaryCommand())
+	cmd.AddCommand(eventbusRetentionCommand())
 	return cmd
 }
 
@@ -58,17 +66,33 @@ func createEventbusCommand() *cobra.Command {
 			if eventbus == "" {
 				cmdFailedf(cmd, "the --name flag MUST be set")
 			}
-			_, err := client.CreateEventBus(context.Background(), &ctrlpb.CreateEventBusRequest{
-				Name:        eventbus,
-				LogNumber:   eventlogNum,
-				Description: description,
+			scope, err := parseOrderingScope(orderingScope)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, err.Error())
+			}
+			mode, err := parseStorageMode(storageMode)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, err.Error())
+			}
+			logNum := eventlogNum
+			if profile != "" && !cmd.Flags().Changed("eventlog") {
+				// use the number of the profile.
+				logNum = 0
+			}
+			_, err = client.CreateEventBus(context.Background(), &ctrlpb.CreateEventBusRequest{
+				Name:          eventbus,
+				LogNumber:     logNum,
+				Description:   description,
+				Profile:       profile,
+				RetentionMs:   retention.Milliseconds(),
+				OrderingScope: scope,
+				StorageMode:   mode,
 			})
 			if err != nil {
 				cmdFailedf(cmd, "create eventbus failed: %s", err)
 			}
 			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"Result": "Create Success", "EventbusService": eventbus})
-				color.Green(string(data))
+				PrintData(cmd, map[string]interface{}{"Result": "Create Success", "EventbusService": eventbus})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"Result", "EventbusService"})
@@ -77,14 +101,21 @@ func createEventbusCommand() *cobra.Command {
 					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 					{Number: 2, AlignHeader: text.AlignCenter},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
 			}
 		},
 	}
 	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to deleting")
 	cmd.Flags().Int32Var(&eventlogNum, "eventlog", 1, "number of eventlog")
 	cmd.Flags().StringVar(&description, "description", "", "subscription description")
+	cmd.Flags().StringVar(&profile, "profile", "", "create the eventbus with settings of the profile")
+	cmd.Flags().DurationVar(&retention, "retention", 0, "how long events are kept, e.g. 168h, "+
+		"the default of the cluster is used if it's 0")
+	cmd.Flags().StringVar(&orderingScope, "ordering-scope", "none", "the ordering of events the eventbus "+
+		"guarantees, none, per-eventlog or per-key, events published to per-key eventbuses must have the "+
+		"partitionkey extension, it can't be changed after the eventbus is created")
+	cmd.Flags().StringVar(&storageMode, "storage-mode", "durable", "where blocks of the eventbus are stored, "+
+		"durable or memory, events of memory eventbuses are lost if a store restarts before they are flushed")
 	return cmd
 }
 
@@ -96,14 +127,19 @@ func deleteEventbusCommand() *cobra.Command {
 			if eventbus == "" {
 				cmdFailedf(cmd, "the --name flag MUST be set")
 			}
+			if !reportDeletionImpact(cmd, &proxypb.GetDeletionImpactRequest{Eventbus: eventbus}) {
+				return
+			}
 
-			_, err := client.DeleteEventBus(context.Background(), &metapb.EventBus{Name: eventbus})
+			_, err := client.DeleteEventBus(context.Background(), &proxypb.DeleteEventBusRequest{
+				Name:  eventbus,
+				Force: force,
+			})
 			if err != nil {
 				cmdFailedf(cmd, "delete eventbus failed: %s", err)
 			}
 			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"Result": "Delete Success", "EventbusService": eventbus})
-				color.Green(string(data))
+				PrintData(cmd, map[string]interface{}{"Result": "Delete Success", "EventbusService": eventbus})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"Result", "EventbusService"})
@@ -112,12 +148,13 @@ func deleteEventbusCommand() *cobra.Command {
 					{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 					{Number: 2, AlignHeader: text.AlignCenter},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
 			}
 		},
 	}
 	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to deleting")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only report subscriptions depending on the eventbus")
+	cmd.Flags().BoolVar(&force, "force", false, "delete even if some subscriptions depend on the eventbus")
 	return cmd
 }
 
@@ -161,13 +198,18 @@ func getEventbusInfoCommand() *cobra.Command {
 				}
 			}
 
-			t := table.NewWriter()
 			if IsFormatJSON(cmd) {
-				color.Yellow("WARN: this command doesn't support --output-format\n")
+				if showSegment || showBlock {
+					PrintData(cmd, map[string]interface{}{"eventbus": busMetas, "segments": segs})
+				} else {
+					PrintData(cmd, busMetas)
+				}
+				return
 			}
+			t := table.NewWriter()
 			if !showSegment && !showBlock {
 				t.AppendHeader(table.Row{"EventbusService", "Description", "Created_At", "Updated_At",
-					"Eventlog", "Segment Number"})
+					"Eventlog", "Segment Number", "Annotations", "Owner"})
 				for _, res := range busMetas {
 					for idx := 0; idx < len(res.Logs); idx++ {
 						if idx == 0 {
@@ -178,6 +220,8 @@ func getEventbusInfoCommand() *cobra.Command {
 								time.UnixMilli(res.UpdatedAt).Format(time.RFC3339),
 								formatID(res.Logs[idx].EventLogId),
 								res.Logs[idx].CurrentSegmentNumbers,
+								formatAnnotations(res.Annotations),
+								res.Owner,
 							})
 						}
 					}
@@ -238,11 +282,15 @@ func getEventbusInfoCommand() *cobra.Command {
 								sortkeys.Uint64s(vols)
 								for _, k := range vols {
 									blk := volMap[k]
+									endpoint := blk.Endpoint
+									if blk.Lost {
+										endpoint = "lost"
+									}
 									t.AppendRow(table.Row{res.Name, res.Description, time.UnixMilli(res.CreatedAt).Format(time.RFC3339),
 										time.UnixMilli(res.UpdatedAt).Format(time.RFC3339), formatID(res.Logs[idx].EventLogId),
 										formatID(seg.Id), seg.Capacity, seg.Size, seg.StartOffsetInLog,
 										seg.EndOffsetInLog, formatID(blk.Id), blk.Id == seg.LeaderBlockId,
-										blk.VolumeID, blk.Endpoint})
+										blk.VolumeID, endpoint})
 								}
 							}
 							t.AppendSeparator()
@@ -275,8 +323,7 @@ func getEventbusInfoCommand() *cobra.Command {
 			t.SetStyle(table.StyleLight)
 			t.Style().Options.SeparateRows = true
 			t.Style().Box = table.StyleBoxDefault
-			t.SetOutputMirror(os.Stdout)
-			t.Render()
+			RenderTable(cmd, t)
 		},
 	}
 	cmd.Flags().StringVar(&eventbus, "eventbus", "", "eventbus to show, use , to separate")
@@ -295,33 +342,338 @@ func listEventbusInfoCommand() *cobra.Command {
 				cmdFailedf(cmd, "list eventbus failed: %s", err)
 			}
 			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(res)
-				color.Green(string(data))
+				PrintData(cmd, res)
 			} else {
+				wide := isWideOutput(cmd)
 				t := table.NewWriter()
-				t.AppendHeader(table.Row{"Name", "Description", "Created_At",
-					"Updated_At", "Eventlog Number"})
+				header := table.Row{"Name", "Description", "Created_At", "Updated_At", "Eventlog Number"}
+				if wide {
+					header = append(header, "ID", "Profile", "Retention", "Ordering", "Owner")
+				}
+				t.AppendHeader(header)
 				for idx := range res.Eventbus {
 					eb := res.Eventbus[idx]
 					t.AppendSeparator()
-					t.AppendRow(table.Row{
+					row := table.Row{
 						eb.Name,
 						eb.Description,
 						time.UnixMilli(eb.CreatedAt).Format(time.RFC3339),
 						time.UnixMilli(eb.UpdatedAt).Format(time.RFC3339),
 						eb.LogNumber,
-					})
+					}
+					if wide {
+						row = append(row, formatID(eb.Id), eb.Profile, formatRetention(eb.RetentionMs),
+							formatOrderingScope(eb.OrderingScope), eb.Owner)
+					}
+					t.AppendRow(row)
 				}
 				cfgs := eventbusColConfigs()
 				for idx := range cfgs {
 					cfgs[idx].AutoMerge = false
 				}
+				if wide {
+					for idx := 0; idx < 5; idx++ {
+						cfgs = append(cfgs, table.ColumnConfig{
+							Number: len(cfgs) + 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter,
+						})
+					}
+				}
 				t.SetColumnConfigs(cfgs)
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
+			}
+		},
+	}
+	return cmd
+}
+
+func truncateEventlogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "truncate",
+		Short: "delete segments of the eventlog which are all below the offset",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventlogID == 0 {
+				cmdFailedf(cmd, "the --eventlog flag MUST be set")
+			}
+			if offset <= 0 {
+				cmdFailedf(cmd, "the --offset flag MUST be greater than 0")
+			}
+			res, err := client.TruncateEventLog(context.Background(), &ctrlpb.TruncateEventLogRequest{
+				EventLogId: eventlogID,
+				Offset:     offset,
+				Force:      force,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "truncate eventlog failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, res)
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Eventlog", "Segment", "Size", "Start", "End"})
+			for _, seg := range res.Segments {
+				t.AppendRow(table.Row{formatID(eventlogID), formatID(seg.Id), seg.Size,
+					seg.StartOffsetInLog, seg.EndOffsetInLog})
 			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, AutoMerge: true, Align: text.AlignCenter,
+					AlignHeader: text.AlignCenter},
+				{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 5, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			RenderTable(cmd, t)
 		},
 	}
+	cmd.Flags().Uint64Var(&eventlogID, "eventlog", 0, "the eventlog to truncate")
+	cmd.Flags().Int64Var(&offset, "offset", 0, "segments whose events are all below the offset will be deleted")
+	cmd.Flags().BoolVar(&force, "force", false, "truncate even if some subscriptions haven't consumed the events")
+	return cmd
+}
+
+func eventlogStatsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "show the growth of eventlogs and blocks for capacity planning",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.GetEventlogStats(context.Background(), &ctrlpb.GetEventlogStatsRequest{
+				Eventbus:   eventbus,
+				EventLogId: eventlogID,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "get eventlog stats failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, res)
+				return
+			}
+			t := table.NewWriter()
+			if showBlock {
+				t.AppendHeader(table.Row{"Eventlog", "Segment", "Block", "Volume", "Size", "Capacity",
+					"Events", "Bytes/Day", "Avg Event Size", "Fill Time", "Full"})
+				for _, el := range res.Eventlogs {
+					for _, b := range el.Blocks {
+						t.AppendRow(table.Row{formatID(el.EventLogId), formatID(b.SegmentId), formatID(b.Id),
+							formatID(b.VolumeId), b.Size, b.Capacity, b.EventNumber, int64(b.BytesPerDay),
+							int64(b.AverageEventSize), formatSeconds(b.FillSeconds), b.IsFull})
+					}
+				}
+			} else {
+				t.AppendHeader(table.Row{"Eventbus", "Eventlog", "Size", "Events", "Bytes/Day",
+					"Avg Event Size", "Avg Fill Time", "Writable Fill In"})
+				for _, el := range res.Eventlogs {
+					t.AppendRow(table.Row{el.Eventbus, formatID(el.EventLogId), el.Size, el.EventNumber,
+						int64(el.BytesPerDay), int64(el.AverageEventSize), formatSeconds(el.AverageFillSeconds),
+						formatSeconds(el.WritableFillSeconds)})
+				}
+			}
+			RenderTable(cmd, t)
+			color.White("rates are computed over the last %s", time.Duration(res.WindowSeconds)*time.Second)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "only show eventlogs of the eventbus")
+	cmd.Flags().Uint64Var(&eventlogID, "eventlog", 0, "only show the eventlog")
+	cmd.Flags().BoolVar(&showBlock, "block", false, "show statistics of each block")
+	return cmd
+}
+
+func verifyEventbusOrderingCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-ordering",
+		Short: "check events with the same partition key are in one eventlog of a eventbus in order",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --eventbus flag MUST be set")
+			}
+			res, err := client.VerifyEventBusOrdering(context.Background(),
+				&ctrlpb.VerifyEventBusOrderingRequest{Eventbus: eventbus})
+			if err != nil {
+				cmdFailedf(cmd, "verify eventbus failed: %s", err)
+			}
+			printJobs(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "the eventbus to verify")
+	return cmd
+}
+
+func importEventbusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "import records of a Kafka topic, or messages of a NATS subject or a RabbitMQ queue to a eventbus as events",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --eventbus flag MUST be set")
+			}
+			req := &ctrlpb.ImportEventBusRequest{Eventbus: eventbus}
+			attributes := &ctrlpb.AttributeMapping{
+				Type:    importEventType,
+				Source:  importEventSource,
+				Headers: importHeaderAttributes,
+			}
+			sources := 0
+			if len(kafkaBrokers) != 0 || kafkaTopic != "" {
+				sources++
+				if len(kafkaBrokers) == 0 || kafkaTopic == "" {
+					cmdFailedf(cmd, "the --kafka-brokers and --kafka-topic flags MUST be set")
+				}
+				req.Source = &ctrlpb.ImportEventBusRequest_Kafka{Kafka: &ctrlpb.KafkaSource{
+					Brokers:        kafkaBrokers,
+					Topic:          kafkaTopic,
+					Start:          importStart,
+					Continuous:     continuous,
+					Tls:            enableTLS,
+					SaslCredential: kafkaSASLCredential,
+				}}
+			}
+			if len(natsServers) != 0 || natsSubject != "" {
+				sources++
+				if len(natsServers) == 0 || natsSubject == "" {
+					cmdFailedf(cmd, "the --nats-servers and --nats-subject flags MUST be set")
+				}
+				req.Source = &ctrlpb.ImportEventBusRequest_Nats{Nats: &ctrlpb.NATSSource{
+					Servers:    natsServers,
+					Subject:    natsSubject,
+					Durable:    natsDurable,
+					Tls:        enableTLS,
+					Credential: importCredential,
+					Attributes: attributes,
+				}}
+			}
+			if rabbitMQURL != "" || rabbitMQQueue != "" {
+				sources++
+				if rabbitMQURL == "" || rabbitMQQueue == "" {
+					cmdFailedf(cmd, "the --rabbitmq-url and --rabbitmq-queue flags MUST be set")
+				}
+				req.Source = &ctrlpb.ImportEventBusRequest_Rabbitmq{Rabbitmq: &ctrlpb.RabbitMQSource{
+					Url:        rabbitMQURL,
+					Queue:      rabbitMQQueue,
+					Credential: importCredential,
+					Attributes: attributes,
+				}}
+			}
+			if sources != 1 {
+				cmdFailedf(cmd, "exactly one of Kafka, NATS and RabbitMQ sources MUST be set")
+			}
+			res, err := client.ImportEventBus(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "import eventbus failed: %s", err)
+			}
+			printJobs(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "the eventbus to import to")
+	cmd.Flags().StringSliceVar(&kafkaBrokers, "kafka-brokers", nil, "addresses of Kafka brokers")
+	cmd.Flags().StringVar(&kafkaTopic, "kafka-topic", "", "the Kafka topic to import from")
+	cmd.Flags().StringVar(&importStart, "start", "earliest", "where to start in each partition, one of "+
+		"earliest, latest, time:<RFC3339 time> like time:2022-10-01T00:00:00Z, offset:<offset> of all "+
+		"partitions like offset:100, or offset:<partition>=<offset>,... like offset:0=100,1=200 where "+
+		"unlisted partitions start from the earliest")
+	cmd.Flags().BoolVar(&continuous, "continuous", false, "keep importing new records instead of stopping "+
+		"at the end of the topic")
+	cmd.Flags().BoolVar(&enableTLS, "tls", false, "connect to Kafka brokers or NATS servers with TLS")
+	cmd.Flags().StringVar(&kafkaSASLCredential, "kafka-sasl-credential", "", "the name of a SASL "+
+		"credential configured in controllers to authenticate to Kafka brokers with")
+	cmd.Flags().StringSliceVar(&natsServers, "nats-servers", nil, "URLs of NATS servers")
+	cmd.Flags().StringVar(&natsSubject, "nats-subject", "", "the NATS subject to import from")
+	cmd.Flags().StringVar(&natsDurable, "nats-durable", "", "the durable JetStream consumer to pull "+
+		"messages from, the subject is subscribed and messages published while the import isn't running "+
+		"are missed if it isn't set")
+	cmd.Flags().StringVar(&rabbitMQURL, "rabbitmq-url", "", "the URL of RabbitMQ broker without user "+
+		"info, like amqp://localhost:5672/vhost")
+	cmd.Flags().StringVar(&rabbitMQQueue, "rabbitmq-queue", "", "the RabbitMQ queue to import from")
+	cmd.Flags().StringVar(&importCredential, "credential", "", "the name of a credential configured in "+
+		"controllers to authenticate to NATS servers or RabbitMQ brokers with")
+	cmd.Flags().StringVar(&importEventType, "event-type", "", "the type of events imported from NATS or "+
+		"RabbitMQ")
+	cmd.Flags().StringVar(&importEventSource, "event-source", "", "the source of events imported from NATS "+
+		"or RabbitMQ")
+	cmd.Flags().StringToStringVar(&importHeaderAttributes, "header-attribute", nil, "map headers of NATS "+
+		"or RabbitMQ messages to attributes of events, like Message-Id=id,Trace=traceid")
+	return cmd
+}
+
+// formatSeconds returns "-" for unknown durations.
+func formatSeconds(seconds int64) string {
+	if seconds <= 0 {
+		return "-"
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func annotateEventbusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annotate",
+		Short: "add, update or remove annotations of a eventbus",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			if len(annotations) == 0 && len(removedAnnotations) == 0 {
+				cmdFailedf(cmd, "at least one of --annotation and --remove MUST be set")
+			}
+			res, err := client.AnnotateEventBus(context.Background(), &ctrlpb.AnnotateEventBusRequest{
+				Name:        eventbus,
+				Annotations: annotations,
+				RemovedKeys: removedAnnotations,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "annotate eventbus failed: %s", err)
+			}
+			printAnnotations(cmd, "eventbus", res.Name, res.Annotations)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to annotate")
+	cmd.Flags().StringToStringVar(&annotations, "annotation", nil, "annotations to add or update, "+
+		"e.g. --annotation note=\"paused due to incident 123\"")
+	cmd.Flags().StringSliceVar(&removedAnnotations, "remove", nil, "keys of annotations to remove")
+	return cmd
+}
+
+func eventbusRetentionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retention",
+		Short: "set how long events of a eventbus are kept",
+		Long: "set how long events of a eventbus are kept. Full segments whose events are all older than the " +
+			"retention are deleted, set it to 0 to use the default of the cluster.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if eventbus == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			if !cmd.Flags().Changed("retention") {
+				cmdFailedf(cmd, "the --retention flag MUST be set")
+			}
+			res, err := client.SetEventBusRetention(context.Background(), &ctrlpb.SetEventBusRetentionRequest{
+				Name:        eventbus,
+				RetentionMs: retention.Milliseconds(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "set retention of eventbus failed: %s", err)
+			}
+			ret := formatRetention(res.RetentionMs)
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, map[string]interface{}{
+					"Result":    "Set Success",
+					"Eventbus":  res.Name,
+					"Retention": ret,
+				})
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"Result", "Eventbus", "Retention"})
+			t.AppendRow(table.Row{"Set Success", res.Name, ret})
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			RenderTable(cmd, t)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "name", "", "eventbus name to set the retention of")
+	cmd.Flags().DurationVar(&retention, "retention", 0, "how long events are kept, e.g. 168h, "+
+		"0 means the default of the cluster")
 	return cmd
 }
 
@@ -335,6 +687,50 @@ func eventbusColConfigs() []table.ColumnConfig {
 	}
 }
 
+// formatRetention formats retention_ms of eventbuses, 0 means the default of the cluster.
+func formatRetention(ms int64) string {
+	if ms <= 0 {
+		return "default"
+	}
+	return (time.Duration(ms) * time.Millisecond).String()
+}
+
+var orderingScopes = map[string]metapb.OrderingScope{
+	"none":         metapb.OrderingScope_ORDERING_NONE,
+	"per-eventlog": metapb.OrderingScope_ORDERING_PER_EVENTLOG,
+	"per-key":      metapb.OrderingScope_ORDERING_PER_KEY,
+}
+
+func parseOrderingScope(s string) (metapb.OrderingScope, error) {
+	scope, ok := orderingScopes[s]
+	if !ok {
+		return 0, fmt.Errorf("invalid ordering scope: %s, it's one of none, per-eventlog and per-key", s)
+	}
+	return scope, nil
+}
+
+func formatOrderingScope(scope metapb.OrderingScope) string {
+	for s, v := range orderingScopes {
+		if v == scope {
+			return s
+		}
+	}
+	return scope.String()
+}
+
+var storageModes = map[string]metapb.StorageMode{
+	"durable": metapb.StorageMode_STORAGE_DURABLE,
+	"memory":  metapb.StorageMode_STORAGE_MEMORY,
+}
+
+func parseStorageMode(s string) (metapb.StorageMode, error) {
+	mode, ok := storageModes[s]
+	if !ok {
+		return 0, fmt.Errorf("invalid storage mode: %s, it's one of durable and memory", s)
+	}
+	return mode, nil
+}
+
 func formatID(id uint64) string {
 	return vanus.NewIDFromUint64(id).String()
 }
@@ -0,0 +1,106 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func NewFeatureGateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feature-gate sub-command",
+		Short: "sub-commands for feature gates of the cluster",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(listFeatureGateCommand())
+	cmd.AddCommand(setFeatureGateCommand())
+	return cmd
+}
+
+func listFeatureGateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list feature gates",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListFeatureGates(context.Background(), &emptypb.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list feature gates failed: %s", err)
+			}
+			printFeatureGates(cmd, res.Gates...)
+		},
+	}
+	return cmd
+}
+
+func setFeatureGateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "enable or disable a feature gate in the cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			if gateName == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			if !gateReset && !cmd.Flags().Changed("enabled") {
+				cmdFailedf(cmd, "one of the --enabled and --reset flags MUST be set")
+			}
+			res, err := client.SetFeatureGate(context.Background(), &ctrlpb.SetFeatureGateRequest{
+				Name:    gateName,
+				Enabled: gateEnabled,
+				Reset_:  gateReset,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "set feature gate failed: %s", err)
+			}
+			printFeatureGates(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&gateName, "name", "", "feature gate name")
+	cmd.Flags().BoolVar(&gateEnabled, "enabled", false, "whether the feature gate is enabled")
+	cmd.Flags().BoolVar(&gateReset, "reset", false, "reset the feature gate to its default")
+	return cmd
+}
+
+func printFeatureGates(cmd *cobra.Command, gates ...*metapb.FeatureGate) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, gates)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Name", "Stage", "Enabled", "Default", "Overridden", "Description"})
+	for _, g := range gates {
+		t.AppendRow(table.Row{g.Name, g.Stage, g.Enabled, g.DefaultEnabled, g.Overridden, g.Description})
+		t.AppendSeparator()
+	}
+	cfgs := make([]table.ColumnConfig, 0, 5)
+	for idx := 1; idx <= 5; idx++ {
+		cfgs = append(cfgs, table.ColumnConfig{
+			Number: idx, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter,
+		})
+	}
+	t.SetColumnConfigs(cfgs)
+	RenderTable(cmd, t)
+}
@@ -14,6 +14,8 @@
 
 package command
 
+import "time"
+
 var (
 	// for vsctl event.
 	id                string
@@ -29,7 +31,9 @@ var (
 	number            int16
 	detail            bool
 	eventID           string
+	partitionKey      string
 	eventCreateTime   string
+	tail              bool
 
 	// for both of eventbus and subscription.
 	eventbus            string
@@ -37,25 +41,108 @@ var (
 	eventlogNum         int32
 	source              string
 	sink                string
+	sinkAllowedHosts    []string
 	filters             string
 	transformer         string
 	rateLimit           uint32
+	backfillRateLimit   uint32
 	from                string
 	subscriptionIDStr   string
 	description         string
 	subscriptionName    string
+	subNamespace        string
 	disableSubscription bool
 	orderedPushEvent    bool
+	passThrough         bool
+	protoDescriptorSet  string
+	protoMessage        string
+	deliveryContentType string
+	nodeSelector        map[string]string
+	annotations         map[string]string
+	removedAnnotations  []string
 
 	subProtocol        string
 	sinkCredentialType string
 	sinkCredential     string
 	deliveryTimeout    uint32
+	deliveryDeadline   uint32
 	maxRetryAttempts   int32
 	offsetTimestamp    uint64
+	offsetsFile        string
+	byTimestamp        bool
+	signingMethod      string
+	signingKeys        map[string]string
+	retiredSigningKey  string
+	wasmModule         string
+	wasmMemoryLimit    uint32
+	wasmTimeout        uint32
+
+	ntpServer    string
+	maxClockSkew time.Duration
+	minOpenFiles uint64
+
+	profile  string
+	replicas uint32
 
 	showSegment bool
 	showBlock   bool
+	force       bool
+	dryRun      bool
+
+	jobIDStr      string
+	jobKind       string
+	unfinishedJob bool
+
+	gateName    string
+	gateEnabled bool
+	gateReset   bool
+
+	kafkaBrokers        []string
+	kafkaTopic          string
+	importStart         string
+	continuous          bool
+	enableTLS           bool
+	kafkaSASLCredential string
+
+	natsServers            []string
+	natsSubject            string
+	natsDurable            string
+	rabbitMQURL            string
+	rabbitMQQueue          string
+	importCredential       string
+	importEventType        string
+	importEventSource      string
+	importHeaderAttributes map[string]string
+
+	dictSamples      int
+	dictSize         int
+	dictFile         string
+	zstdPath         string
+	removeDictionary bool
+
+	retention     time.Duration
+	orderingScope string
+	storageMode   string
+
+	storeEndpoint string
+	blockIDs      []string
+	manifestFile  string
+	publicKeyFile string
+	eventsDir     string
+	startAfter    string
+	pageSize      uint32
+
+	inflightBlock  string
+	inflightMinAge time.Duration
+
+	rebalanceMode     string
+	rebalanceMaxMoves int32
+	rebalancePlanID   string
+
+	usageKind   string
+	usageMetric string
+	usageWindow time.Duration
+	usageLimit  uint32
 )
 
 const (
@@ -28,15 +28,14 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
-const (
-	FormatJSON = "json"
-)
-
 type GlobalFlags struct {
 	Endpoint   string
 	Debug      bool
 	ConfigFile string
 	Format     string
+	Output     string
+	NoHeaders  bool
+	Token      string
 }
 
 var (
@@ -53,11 +52,14 @@ func InitGatewayClient(cmd *cobra.Command) {
 		grpc.WithBlock(),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 	}
+	if token := getGatewayToken(cmd); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials(token)))
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	conn, err := grpc.DialContext(ctx, endpoint, opts...)
 	if err != nil {
-		panic("failed to dial gateway: " + err.Error())
+		cmdExitf(cmd, ExitCodeUnavailable, "failed to dial gateway: %s", err)
 	}
 	cc = conn
 	client = proxypb.NewControllerProxyClient(conn)
@@ -89,10 +91,21 @@ func mustGetGatewayEndpoint(cmd *cobra.Command) string {
 	return endpoint
 }
 
-func IsFormatJSON(cmd *cobra.Command) bool {
-	v, err := cmd.Flags().GetString("format")
+func getGatewayToken(cmd *cobra.Command) string {
+	token, err := cmd.Flags().GetString("token")
 	if err != nil {
-		return false
+		return ""
 	}
-	return strings.ToLower(v) == FormatJSON
+	return token
+}
+
+// tokenCredentials attaches the bearer token to each RPC.
+type tokenCredentials string
+
+func (t tokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return false
 }
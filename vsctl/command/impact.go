@@ -0,0 +1,67 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
+	"github.com/spf13/cobra"
+)
+
+// reportDeletionImpact reports what depends on the resource to be deleted if --dry-run is set, it returns
+// false then, so that nothing is deleted. Gateways refuse to delete the resource which has dependents unless
+// --force is set.
+func reportDeletionImpact(cmd *cobra.Command, req *proxypb.GetDeletionImpactRequest) bool {
+	if !dryRun {
+		return true
+	}
+	res, err := client.GetDeletionImpact(context.Background(), req)
+	if err != nil {
+		cmdFailedf(cmd, "get deletion impact failed: %s", err)
+	}
+	printDeletionImpact(cmd, res)
+	return false
+}
+
+func printDeletionImpact(cmd *cobra.Command, res *proxypb.GetDeletionImpactResponse) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, res)
+		return
+	}
+	if res.Lag > 0 {
+		color.Yellow("the subscription has %d events not consumed yet\n", res.Lag)
+	}
+	if len(res.Dependents) == 0 {
+		color.Green("no dependents\n")
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Kind", "Subscription ID", "Subscription Name", "Lag"})
+	for _, d := range res.Dependents {
+		t.AppendRow(table.Row{d.Kind, vanus.NewIDFromUint64(d.SubscriptionId).String(), d.SubscriptionName, d.Lag})
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, t)
+}
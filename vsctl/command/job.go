@@ -0,0 +1,140 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	"github.com/spf13/cobra"
+)
+
+func NewJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "job sub-command",
+		Short: "sub-commands for long-running jobs of controller",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(listJobCommand())
+	cmd.AddCommand(getJobInfoCommand())
+	cmd.AddCommand(cancelJobCommand())
+	return cmd
+}
+
+func listJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list jobs",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListJob(context.Background(), &ctrlpb.ListJobRequest{
+				Kind:       jobKind,
+				Unfinished: unfinishedJob,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list job failed: %s", err)
+			}
+			printJobs(cmd, res.Jobs...)
+		},
+	}
+	cmd.Flags().StringVar(&jobKind, "kind", "", "only list jobs of the kind")
+	cmd.Flags().BoolVar(&unfinishedJob, "unfinished", false, "only list pending or running jobs")
+	return cmd
+}
+
+func getJobInfoCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "get the job info",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := parseJobID(cmd)
+			res, err := client.GetJob(context.Background(), &ctrlpb.GetJobRequest{Id: id.Uint64()})
+			if err != nil {
+				cmdFailedf(cmd, "get job failed: %s", err)
+			}
+			printJobs(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&jobIDStr, "id", "", "job id")
+	return cmd
+}
+
+func cancelJobCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "cancel a pending or running job",
+		Run: func(cmd *cobra.Command, args []string) {
+			id := parseJobID(cmd)
+			res, err := client.CancelJob(context.Background(), &ctrlpb.CancelJobRequest{Id: id.Uint64()})
+			if err != nil {
+				cmdFailedf(cmd, "cancel job failed: %s", err)
+			}
+			printJobs(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&jobIDStr, "id", "", "job id")
+	return cmd
+}
+
+func parseJobID(cmd *cobra.Command) vanus.ID {
+	if jobIDStr == "" {
+		cmdFailedf(cmd, "the --id flag MUST be set")
+	}
+	id, err := vanus.NewIDFromString(jobIDStr)
+	if err != nil {
+		cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid job id: %s\n", err.Error()))
+	}
+	return id
+}
+
+func printJobs(cmd *cobra.Command, jobs ...*metapb.Job) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, jobs)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"ID", "Kind", "Phase", "Progress", "Attempts", "Error", "Created_At", "Finished_At"})
+	for _, j := range jobs {
+		progress := fmt.Sprintf("%d/%d", j.ProgressDone, j.ProgressTotal)
+		if j.ProgressMessage != "" {
+			progress = fmt.Sprintf("%s %s", progress, j.ProgressMessage)
+		}
+		finishedAt := "-"
+		if j.FinishedAt > 0 {
+			finishedAt = time.UnixMilli(j.FinishedAt).Format(time.RFC3339)
+		}
+		t.AppendRow(table.Row{formatID(j.Id), j.Kind, j.Phase, progress, j.Attempts, j.Error,
+			time.UnixMilli(j.CreatedAt).Format(time.RFC3339), finishedAt})
+		t.AppendSeparator()
+	}
+	cfgs := make([]table.ColumnConfig, 0, 8)
+	for idx := 1; idx <= 8; idx++ {
+		cfgs = append(cfgs, table.ColumnConfig{
+			Number: idx, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter,
+		})
+	}
+	t.SetColumnConfigs(cfgs)
+	RenderTable(cmd, t)
+}
@@ -0,0 +1,290 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	"github.com/linkall-labs/vanus/internal/store/integrity"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	manifestReadNum      = 4096
+	manifestReadBytes    = 4 * 1024 * 1024
+	manifestMaxRecvBytes = 64 * 1024 * 1024
+	manifestEventsExt    = ".events"
+)
+
+func NewManifestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "manifest sub-command",
+		Short: "sub-commands for signed manifests of sealed blocks, which prove events haven't been altered",
+	}
+	cmd.AddCommand(exportManifestCommand())
+	cmd.AddCommand(verifyManifestCommand())
+	return cmd
+}
+
+func exportManifestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export the signed manifest of sealed blocks in a store",
+		Long: "export the signed manifest of sealed blocks in a store, which lists event numbers and SHA-256 " +
+			"digests of the blocks. Events of the blocks are exported to --events-dir as well if it's set, " +
+			"so that they can be verified against the manifest offline.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if storeEndpoint == "" {
+				cmdFailedf(cmd, "the --store flag MUST be set")
+			}
+			req := &segpb.ExportBlockManifestRequest{Limit: pageSize}
+			if startAfter != "" {
+				id, err := vanus.NewIDFromString(startAfter)
+				if err != nil {
+					cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid start after: %s\n", err.Error()))
+				}
+				req.StartAfter = id.Uint64()
+			}
+			for _, s := range blockIDs {
+				id, err := vanus.NewIDFromString(s)
+				if err != nil {
+					cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid block id: %s\n", err.Error()))
+				}
+				req.Ids = append(req.Ids, id.Uint64())
+			}
+
+			ctx := context.Background()
+			conn := dialStore(cmd, storeEndpoint)
+			defer func() {
+				_ = conn.Close()
+			}()
+			sc := segpb.NewSegmentServerClient(conn)
+			m, err := sc.ExportBlockManifest(ctx, req)
+			if err != nil {
+				cmdFailedf(cmd, "export manifest failed: %s", err)
+			}
+			if eventsDir != "" {
+				for _, d := range m.Blocks {
+					if err = exportBlockEvents(ctx, sc, d, eventsDir); err != nil {
+						cmdFailedf(cmd, "export events of block %s failed: %s", formatID(d.Id), err)
+					}
+				}
+			}
+
+			data, _ := json.MarshalIndent(m, "", "  ")
+			if manifestFile == "" {
+				color.Green(string(data))
+			} else {
+				if err = os.WriteFile(manifestFile, data, 0o600); err != nil {
+					cmdFailedf(cmd, "write manifest file failed: %s", err)
+				}
+				color.Green("export manifest of %d blocks to %s success\n", len(m.Blocks), manifestFile)
+			}
+			if m.NextStartAfter != 0 {
+				color.Yellow("there are more sealed blocks, export them with --start-after %s\n",
+					formatID(m.NextStartAfter))
+			}
+		},
+	}
+	cmd.Flags().StringVar(&storeEndpoint, "store", "", "the endpoint of the store, e.g. 127.0.0.1:11811")
+	cmd.Flags().StringSliceVar(&blockIDs, "block", nil, "only export these blocks, they must be sealed")
+	cmd.Flags().StringVar(&startAfter, "start-after", "", "only export sealed blocks whose ids are greater "+
+		"than it if --block isn't set")
+	cmd.Flags().Uint32Var(&pageSize, "limit", 0, "the max number of blocks to export if --block isn't set, "+
+		"0 is 64")
+	cmd.Flags().StringVar(&manifestFile, "file", "", "file to write the manifest to, print it if it's empty")
+	cmd.Flags().StringVar(&eventsDir, "events-dir", "", "directory to export events of each block to, "+
+		"as <block id>"+manifestEventsExt)
+	return cmd
+}
+
+func verifyManifestCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verify the signature of a manifest, and events exported along with it, without any server",
+		Run: func(cmd *cobra.Command, args []string) {
+			if manifestFile == "" {
+				cmdFailedf(cmd, "the --file flag MUST be set")
+			}
+			if publicKeyFile == "" {
+				cmdFailedf(cmd, "the --public-key flag MUST be set")
+			}
+			pub, err := integrity.LoadPublicKey(publicKeyFile)
+			if err != nil {
+				cmdFailedf(cmd, "load public key failed: %s", err)
+			}
+			data, err := os.ReadFile(manifestFile)
+			if err != nil {
+				cmdFailedf(cmd, "read manifest file failed: %s", err)
+			}
+			m := new(segpb.BlockManifest)
+			if err = json.Unmarshal(data, m); err != nil {
+				cmdFailedf(cmd, "the manifest file is invalid: %s", err)
+			}
+			if err = integrity.Verify(m, pub); err != nil {
+				cmdExitf(cmd, ExitCodeError, "verify manifest failed: %s", err)
+			}
+
+			results := make([]string, len(m.Blocks))
+			failed := false
+			for i, d := range m.Blocks {
+				results[i] = "Signed"
+				if eventsDir == "" {
+					continue
+				}
+				if err = verifyBlockEvents(d, eventsDir); err != nil {
+					results[i] = err.Error()
+					failed = true
+				} else {
+					results[i] = "Verified"
+				}
+			}
+			printManifest(cmd, m, results)
+			if failed {
+				os.Exit(ExitCodeError)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&manifestFile, "file", "", "the manifest file exported by manifest export")
+	cmd.Flags().StringVar(&publicKeyFile, "public-key", "", "the PEM file of the Ed25519 public key of the "+
+		"store, e.g. exported by openssl pkey -pubout")
+	cmd.Flags().StringVar(&eventsDir, "events-dir", "", "directory events of blocks are exported to, "+
+		"only the signature is verified if it's empty")
+	return cmd
+}
+
+func dialStore(cmd *cobra.Command, endpoint string) *grpc.ClientConn {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, endpoint,
+		grpc.WithBlock(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(manifestMaxRecvBytes)),
+	)
+	if err != nil {
+		cmdExitf(cmd, ExitCodeUnavailable, "failed to dial store: %s", err)
+	}
+	return conn
+}
+
+// exportBlockEvents writes events of the block as they are hashed, i.e. raw payloads of reads in order.
+func exportBlockEvents(ctx context.Context, sc segpb.SegmentServerClient, d *segpb.BlockDigest, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(dir, formatID(d.Id)+manifestEventsExt))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	for off := int64(0); off < d.EventNumber; {
+		res, err := sc.ReadFromBlock(ctx, &segpb.ReadFromBlockRequest{
+			BlockId:  d.Id,
+			Offset:   off,
+			Number:   manifestReadNum,
+			MaxBytes: manifestReadBytes,
+			Raw:      true,
+		})
+		if err != nil {
+			return err
+		}
+		n, err := countFrames(res.Payload)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return fmt.Errorf("no event is read at offset %d, the store may not support raw reads", off)
+		}
+		if _, err = f.Write(res.Payload); err != nil {
+			return err
+		}
+		off += int64(n)
+	}
+	return f.Sync()
+}
+
+func countFrames(payload []byte) (int, error) {
+	n := 0
+	for len(payload) > 0 {
+		size, l := binary.Uvarint(payload)
+		if l <= 0 || uint64(len(payload)-l) < size {
+			return 0, fmt.Errorf("malformed payload of raw read")
+		}
+		payload = payload[l+int(size):]
+		n++
+	}
+	return n, nil
+}
+
+func verifyBlockEvents(d *segpb.BlockDigest, dir string) error {
+	f, err := os.Open(filepath.Join(dir, formatID(d.Id)+manifestEventsExt))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	return integrity.VerifyEvents(d, f)
+}
+
+func printManifest(cmd *cobra.Command, m *segpb.BlockManifest, results []string) {
+	generatedAt := time.UnixMilli(m.GeneratedAt).Format(time.RFC3339)
+	if IsFormatJSON(cmd) {
+		blocks := make([]map[string]interface{}, len(m.Blocks))
+		for i, d := range m.Blocks {
+			blocks[i] = map[string]interface{}{
+				"Block_ID":     formatID(d.Id),
+				"Event_Number": d.EventNumber,
+				"Size":         d.Size,
+				"Result":       results[i],
+			}
+		}
+		PrintData(cmd, map[string]interface{}{
+			"Volume_ID":    m.VolumeId,
+			"Key_ID":       m.KeyId,
+			"Generated_At": generatedAt,
+			"Blocks":       blocks,
+		})
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Block_ID", "Event_Number", "Size", "Result"})
+	for i, d := range m.Blocks {
+		t.AppendRow(table.Row{formatID(d.Id), d.EventNumber, d.Size, results[i]})
+	}
+	t.SetTitle(fmt.Sprintf("volume %d, key %s, generated at %s", m.VolumeId, m.KeyId, generatedAt))
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, t)
+}
@@ -15,10 +15,14 @@
 package command
 
 import (
-	"os"
+	"context"
+	"fmt"
 
+	"github.com/fatih/color"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/jedib0t/go-pretty/v6/table"
 	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/spf13/cobra"
 )
 
@@ -34,6 +38,7 @@ func NewClusterCommand() *cobra.Command {
 		},
 	}
 	cmd.AddCommand(controllerCommand())
+	cmd.AddCommand(triggerWorkerCommand())
 	return cmd
 }
 
@@ -51,6 +56,13 @@ func getControllerTopology() *cobra.Command {
 		Use:   "topology",
 		Short: "get topology",
 		Run: func(cmd *cobra.Command, args []string) {
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, map[string]interface{}{
+					"gateway_endpoint":     mustGetGatewayEndpoint(cmd),
+					"cloudevents_endpoint": mustGetGatewayCloudEventsEndpoint(cmd),
+				})
+				return
+			}
 			t := table.NewWriter()
 			t.AppendHeader(table.Row{"Name", "Endpoint"})
 			t.AppendRows([]table.Row{
@@ -64,9 +76,90 @@ func getControllerTopology() *cobra.Command {
 			t.SetStyle(table.StyleLight)
 			t.Style().Options.SeparateRows = true
 			t.Style().Box = table.StyleBoxDefault
-			t.SetOutputMirror(os.Stdout)
-			t.Render()
+			RenderTable(cmd, t)
 		},
 	}
 	return cmd
 }
+
+func triggerWorkerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-worker sub-command",
+		Short: "get trigger workers and their resources",
+	}
+	cmd.AddCommand(listTriggerWorkerCommand())
+	return cmd
+}
+
+func listTriggerWorkerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list trigger workers with their resource usage and capacity",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListTriggerWorker(context.Background(), &empty.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list trigger worker failed: %s", err)
+			}
+			printTriggerWorkers(cmd, res)
+		},
+	}
+	return cmd
+}
+
+func printTriggerWorkers(cmd *cobra.Command, res *ctrlpb.ListTriggerWorkerResponse) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, res)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Address", "Phase", "Labels", "Subscriptions", "Events/s", "CPU", "Memory",
+		"Unavailable_Reason"})
+	for _, w := range res.Workers {
+		subscriptions := fmt.Sprintf("%d", w.AssignedSubscriptions)
+		eventRate, cpu, memory := "-", "-", "-"
+		if r := w.Resources; r != nil {
+			subscriptions = fmt.Sprintf("%d/%s", w.AssignedSubscriptions, formatCapacity(float64(r.MaxSubscriptions)))
+			eventRate = fmt.Sprintf("%.0f/%s", r.EventsPerSecond, formatCapacity(r.MaxEventsPerSecond))
+			cpu = fmt.Sprintf("%.2f/%s", r.CpuCores, formatCapacity(r.CpuLimit))
+			memory = formatBytes(r.MemoryBytes) + "/-"
+			if r.MemoryLimit > 0 {
+				memory = fmt.Sprintf("%s/%s", formatBytes(r.MemoryBytes), formatBytes(r.MemoryLimit))
+			}
+		}
+		t.AppendRow(table.Row{w.Address, w.Phase, formatAnnotations(w.Labels), subscriptions, eventRate, cpu,
+			memory, w.UnavailableReason})
+		t.AppendSeparator()
+	}
+	cfgs := make([]table.ColumnConfig, 0, 8)
+	for idx := 1; idx <= 8; idx++ {
+		cfgs = append(cfgs, table.ColumnConfig{
+			Number: idx, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter,
+		})
+	}
+	t.SetColumnConfigs(cfgs)
+	RenderTable(cmd, t)
+	if res.Condition != "" {
+		color.Yellow(res.Condition)
+	}
+}
+
+// formatCapacity shows an unlimited capacity as "-".
+func formatCapacity(v float64) string {
+	if v <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%g", v)
+}
+
+func formatBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%dB", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,190 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/ohler55/ojg/jp"
+	"github.com/ohler55/ojg/oj"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	OutputTable = "table"
+	// OutputWide is a table with additional columns, commands without them print the same as OutputTable.
+	OutputWide = "wide"
+	OutputJSON = "json"
+	OutputYAML = "yaml"
+	// OutputJSONPath prints fields selected by the expression after it, e.g. -o jsonpath='{.eventbus[*].name}'.
+	OutputJSONPath = "jsonpath"
+)
+
+// Exit codes of vsctl, so that scripts can tell why a command failed.
+const (
+	ExitCodeError       = 1
+	ExitCodeUsage       = 2
+	ExitCodeNotFound    = 3
+	ExitCodeUnavailable = 4
+)
+
+// outputOf returns the output format and the expression of OutputJSONPath, --format is honored if --output
+// isn't set.
+func outputOf(cmd *cobra.Command) (string, string) {
+	v, _ := cmd.Flags().GetString("output")
+	if v == "" {
+		v, _ = cmd.Flags().GetString("format")
+	}
+	if strings.HasPrefix(v, OutputJSONPath+"=") {
+		return OutputJSONPath, strings.TrimPrefix(v, OutputJSONPath+"=")
+	}
+	v = strings.ToLower(v)
+	if v == "" {
+		return OutputTable, ""
+	}
+	return v, ""
+}
+
+// IsFormatJSON reports whether the output is structured, i.e. json, yaml or jsonpath, which are all rendered
+// from the data printed by PrintData.
+func IsFormatJSON(cmd *cobra.Command) bool {
+	switch format, _ := outputOf(cmd); format {
+	case OutputJSON, OutputYAML, OutputJSONPath:
+		return true
+	}
+	return false
+}
+
+func isWideOutput(cmd *cobra.Command) bool {
+	format, _ := outputOf(cmd)
+	return format == OutputWide
+}
+
+// PrintData prints v in the structured output format, fields are named by their JSON tags in all formats.
+func PrintData(cmd *cobra.Command, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		cmdFailedf(cmd, "marshal output failed: %s", err)
+	}
+	switch format, expr := outputOf(cmd); format {
+	case OutputYAML:
+		var obj interface{}
+		_ = json.Unmarshal(data, &obj)
+		out, _ := yaml.Marshal(obj)
+		fmt.Print(string(out))
+	case OutputJSONPath:
+		printJSONPath(cmd, data, expr)
+	default:
+		color.Green(string(data))
+	}
+}
+
+// printJSONPath prints each value selected by expr in a line, see selectJSONPath.
+func printJSONPath(cmd *cobra.Command, data []byte, expr string) {
+	obj, err := oj.Parse(data)
+	if err != nil {
+		cmdFailedf(cmd, "parse output failed: %s", err)
+	}
+	lines, err := selectJSONPath(obj, expr)
+	if err != nil {
+		cmdExitf(cmd, ExitCodeUsage, "%s", err)
+	}
+	for _, line := range lines {
+		fmt.Println(line)
+	}
+}
+
+// selectJSONPath returns each value of obj selected by expr as a line, strings are returned without quotes.
+// Both JSONPath ($.a.b) and the kubectl style ({.a.b}) are accepted.
+func selectJSONPath(obj interface{}, expr string) ([]string, error) {
+	path := strings.TrimSpace(expr)
+	if strings.HasPrefix(path, "{") && strings.HasSuffix(path, "}") {
+		path = strings.TrimSpace(path[1 : len(path)-1])
+	}
+	if !strings.HasPrefix(path, "$") {
+		if !strings.HasPrefix(path, ".") && !strings.HasPrefix(path, "[") {
+			path = "." + path
+		}
+		path = "$" + path
+	}
+	x, err := jp.ParseString(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jsonpath %s: %w", expr, err)
+	}
+	results := x.Get(obj)
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no field matches jsonpath %s", expr)
+	}
+	lines := make([]string, 0, len(results))
+	for _, r := range results {
+		if s, ok := r.(string); ok {
+			lines = append(lines, s)
+			continue
+		}
+		out, _ := json.Marshal(r)
+		lines = append(lines, string(out))
+	}
+	return lines, nil
+}
+
+// RenderTable prints t to stdout, headers are omitted if --no-headers is set.
+func RenderTable(cmd *cobra.Command, t table.Writer) {
+	if noHeaders, _ := cmd.Flags().GetBool("no-headers"); noHeaders {
+		t.ResetHeaders()
+	}
+	t.SetOutputMirror(os.Stdout)
+	t.Render()
+}
+
+// exitCodeOf derives the exit code from the first error in args of cmdFailedf, failures without an error are
+// caused by invalid flags or arguments.
+func exitCodeOf(args []interface{}) int {
+	for _, arg := range args {
+		if err, ok := arg.(error); ok {
+			return exitCodeOfError(err)
+		}
+	}
+	return ExitCodeUsage
+}
+
+// exitCodeOfError maps the gRPC code of err, or else the code of pkg/errors it carries, to an exit code.
+func exitCodeOfError(err error) int {
+	if s, ok := status.FromError(err); ok {
+		switch s.Code() {
+		case codes.NotFound:
+			return ExitCodeNotFound
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return ExitCodeUnavailable
+		case codes.InvalidArgument:
+			return ExitCodeUsage
+		}
+	}
+	switch errors.CodeOf(err) / 100 {
+	case errors.ErrorCode_RESOURCE_NOT_FOUND / 100:
+		return ExitCodeNotFound
+	case errors.ErrorCode_INVALID_REQUEST / 100:
+		return ExitCodeUsage
+	}
+	return ExitCodeError
+}
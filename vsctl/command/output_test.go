@@ -0,0 +1,123 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/linkall-labs/vanus/pkg/errors"
+	"github.com/ohler55/ojg/oj"
+	. "github.com/smartystreets/goconvey/convey"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOutputOf(t *testing.T) {
+	Convey("test output of", t, func() {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("output", "", "")
+		cmd.Flags().String("format", "", "")
+
+		format, _ := outputOf(cmd)
+		So(format, ShouldEqual, OutputTable)
+		So(IsFormatJSON(cmd), ShouldBeFalse)
+
+		_ = cmd.Flags().Set("format", "JSON")
+		format, _ = outputOf(cmd)
+		So(format, ShouldEqual, OutputJSON)
+
+		_ = cmd.Flags().Set("output", "wide")
+		So(isWideOutput(cmd), ShouldBeTrue)
+		So(IsFormatJSON(cmd), ShouldBeFalse)
+
+		_ = cmd.Flags().Set("output", "jsonpath={.eventbus[*].Name}")
+		format, expr := outputOf(cmd)
+		So(format, ShouldEqual, OutputJSONPath)
+		So(expr, ShouldEqual, "{.eventbus[*].Name}")
+		So(IsFormatJSON(cmd), ShouldBeTrue)
+	})
+}
+
+func TestSelectJSONPath(t *testing.T) {
+	Convey("test select jsonpath", t, func() {
+		obj, err := oj.ParseString(`{"eventbus":[{"name":"a","logs":2},{"name":"b","logs":1}],"total":2}`)
+		So(err, ShouldBeNil)
+
+		Convey("select by kubectl style", func() {
+			lines, err := selectJSONPath(obj, "{.eventbus[*].name}")
+			So(err, ShouldBeNil)
+			So(lines, ShouldResemble, []string{"a", "b"})
+		})
+
+		Convey("select by jsonpath", func() {
+			lines, err := selectJSONPath(obj, "$.eventbus[0]")
+			So(err, ShouldBeNil)
+			So(lines, ShouldResemble, []string{`{"logs":2,"name":"a"}`})
+
+			lines, err = selectJSONPath(obj, "total")
+			So(err, ShouldBeNil)
+			So(lines, ShouldResemble, []string{"2"})
+		})
+
+		Convey("invalid jsonpath", func() {
+			_, err := selectJSONPath(obj, "{.eventbus[}")
+			So(err, ShouldNotBeNil)
+		})
+
+		Convey("no field matches", func() {
+			_, err := selectJSONPath(obj, "{.subscription}")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestExitCodeOf(t *testing.T) {
+	Convey("test exit code of", t, func() {
+		Convey("failures without an error", func() {
+			So(exitCodeOf(nil), ShouldEqual, ExitCodeUsage)
+			So(exitCodeOf([]interface{}{"name", 1}), ShouldEqual, ExitCodeUsage)
+		})
+
+		Convey("local errors", func() {
+			So(exitCodeOf([]interface{}{"name", stderrors.New("err")}), ShouldEqual, ExitCodeError)
+			So(exitCodeOf([]interface{}{errors.ErrInvalidArgument.Wrap(stderrors.New("err"))}),
+				ShouldEqual, ExitCodeUsage)
+			So(exitCodeOf([]interface{}{errors.ErrResourceNotFound}), ShouldEqual, ExitCodeNotFound)
+		})
+
+		Convey("gRPC errors", func() {
+			So(exitCodeOfError(status.Error(codes.NotFound, "not found")), ShouldEqual, ExitCodeNotFound)
+			So(exitCodeOfError(status.Error(codes.Unavailable, "connection refused")),
+				ShouldEqual, ExitCodeUnavailable)
+			So(exitCodeOfError(status.Error(codes.DeadlineExceeded, "timeout")), ShouldEqual, ExitCodeUnavailable)
+			So(exitCodeOfError(status.Error(codes.InvalidArgument, "bad request")), ShouldEqual, ExitCodeUsage)
+			So(exitCodeOfError(status.Error(codes.Unknown, "unknown")), ShouldEqual, ExitCodeError)
+		})
+
+		Convey("errors of the controller", func() {
+			received := func(err error) error {
+				return status.Error(codes.Unknown, errors.ConvertToGRPCError(err).Error())
+			}
+			So(exitCodeOfError(received(errors.ErrEventLogNotFound.WithMessage("eventlog not found"))),
+				ShouldEqual, ExitCodeNotFound)
+			So(exitCodeOfError(received(errors.ErrInvalidRequest.WithMessage("name is empty"))),
+				ShouldEqual, ExitCodeUsage)
+			So(exitCodeOfError(received(errors.ErrInternal)), ShouldEqual, ExitCodeError)
+			So(exitCodeOfError(received(stderrors.New("err"))), ShouldEqual, ExitCodeError)
+		})
+	})
+}
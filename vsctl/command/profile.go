@@ -0,0 +1,143 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+
+	"github.com/fatih/color"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+func eventbusProfileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile sub-command",
+		Short: "sub-commands for profiles which bundle settings of eventbus",
+	}
+	cmd.AddCommand(createProfileCommand())
+	cmd.AddCommand(deleteProfileCommand())
+	cmd.AddCommand(listProfileCommand())
+	return cmd
+}
+
+func createProfileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create or update a profile",
+		Run: func(cmd *cobra.Command, args []string) {
+			if profile == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			scope, err := parseOrderingScope(orderingScope)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, err.Error())
+			}
+			res, err := client.CreateEventbusProfile(context.Background(), &ctrlpb.EventbusProfile{
+				Name:          profile,
+				Description:   description,
+				LogNumber:     eventlogNum,
+				Replicas:      replicas,
+				RetentionMs:   retention.Milliseconds(),
+				OrderingScope: scope,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "create profile failed: %s", err)
+			}
+			printProfiles(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&profile, "name", "", "profile name")
+	cmd.Flags().StringVar(&description, "description", "", "profile description")
+	cmd.Flags().Int32Var(&eventlogNum, "eventlog", 1, "number of eventlog")
+	cmd.Flags().Uint32Var(&replicas, "replicas", 0, "replicas of segment, default is 0, "+
+		"means using server-side default value")
+	cmd.Flags().DurationVar(&retention, "retention", 0, "how long events of eventbuses are kept, e.g. 720h, "+
+		"the default of the cluster is used if it's 0")
+	cmd.Flags().StringVar(&orderingScope, "ordering-scope", "none", "the ordering of events eventbuses "+
+		"guarantee, none, per-eventlog or per-key")
+	return cmd
+}
+
+func deleteProfileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "delete a profile, eventbuses created from it aren't affected",
+		Run: func(cmd *cobra.Command, args []string) {
+			if profile == "" {
+				cmdFailedf(cmd, "the --name flag MUST be set")
+			}
+			_, err := client.DeleteEventbusProfile(context.Background(),
+				&ctrlpb.DeleteEventbusProfileRequest{Name: profile})
+			if err != nil {
+				cmdFailedf(cmd, "delete profile failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, map[string]interface{}{"Result": "Delete Success", "Profile": profile})
+			} else {
+				color.Green("profile %s deleted\n", profile)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&profile, "name", "", "profile name")
+	return cmd
+}
+
+func listProfileCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list profiles",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListEventbusProfile(context.Background(), &empty.Empty{})
+			if err != nil {
+				cmdFailedf(cmd, "list profile failed: %s", err)
+			}
+			printProfiles(cmd, res.Profiles...)
+		},
+	}
+	return cmd
+}
+
+func printProfiles(cmd *cobra.Command, profiles ...*ctrlpb.EventbusProfile) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, profiles)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Name", "Description", "Eventlog Number", "Replicas", "Retention", "Ordering Scope",
+		"Built-in"})
+	for _, p := range profiles {
+		r := interface{}(p.Replicas)
+		if p.Replicas == 0 {
+			r = "default"
+		}
+		t.AppendRow(table.Row{p.Name, p.Description, p.LogNumber, r, formatRetention(p.RetentionMs),
+			formatOrderingScope(p.OrderingScope), p.Builtin})
+		t.AppendSeparator()
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 5, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 6, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 7, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, t)
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+func rebalanceSubscriptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebalance sub-command",
+		Short: "move subscriptions from busy trigger workers to idle ones",
+	}
+	cmd.AddCommand(planRebalanceCommand())
+	cmd.AddCommand(approveRebalanceCommand())
+	return cmd
+}
+
+func planRebalanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "plan",
+		Short: "plan moves of subscriptions, they are executed after being approved in approve mode, " +
+			"or at once in auto mode",
+		Run: func(cmd *cobra.Command, args []string) {
+			mode, ok := ctrlpb.RebalanceMode_value["REBALANCE_"+strings.ToUpper(rebalanceMode)]
+			if !ok {
+				cmdFailedf(cmd, "the --mode flag MUST be approve or auto")
+			}
+			res, err := client.PlanRebalance(context.Background(), &ctrlpb.PlanRebalanceRequest{
+				Mode:     ctrlpb.RebalanceMode(mode),
+				MaxMoves: rebalanceMaxMoves,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "plan rebalance failed: %s", err)
+			}
+			printRebalancePlan(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&rebalanceMode, "mode", "approve", "approve or auto, moves are executed after "+
+		"being approved by 'rebalance approve' in approve mode")
+	cmd.Flags().Int32Var(&rebalanceMaxMoves, "max-moves", 0, "the max number of subscriptions to move, "+
+		"0 is unlimited")
+	return cmd
+}
+
+func approveRebalanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "approve a rebalance plan, moves are executed by a job",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(rebalancePlanID)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid plan id: %s\n", err.Error()))
+			}
+			res, err := client.ApproveRebalance(context.Background(), &ctrlpb.ApproveRebalanceRequest{
+				PlanId: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "approve rebalance failed: %s", err)
+			}
+			printRebalancePlan(cmd, res)
+		},
+	}
+	cmd.Flags().StringVar(&rebalancePlanID, "plan", "", "the plan id to approve")
+	return cmd
+}
+
+func printRebalancePlan(cmd *cobra.Command, plan *ctrlpb.RebalancePlan) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, plan)
+		return
+	}
+	if len(plan.Moves) == 0 {
+		color.Green("trigger workers are balanced, nothing to move\n")
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"subscription_id", "name", "from", "to", "paused_events_per_second"})
+	for _, m := range plan.Moves {
+		t.AppendRow(table.Row{formatID(m.SubscriptionId), m.SubscriptionName, m.From, m.To,
+			fmt.Sprintf("%.1f", m.PausedEventsPerSecond)})
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 4, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 5, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, t)
+
+	w := table.NewWriter()
+	w.AppendHeader(table.Row{"trigger_worker", "subscriptions_before", "subscriptions_after"})
+	for _, l := range plan.Workers {
+		w.AppendRow(table.Row{l.Addr, l.SubscriptionsBefore, l.SubscriptionsAfter})
+	}
+	w.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, w)
+
+	color.Yellow("moved subscriptions pause briefly, about %.1f events per second in total\n",
+		plan.PausedEventsPerSecond)
+	if plan.JobId != 0 {
+		color.Green("moves are executed by job %s, see 'vsctl job info --id %s'\n",
+			formatID(plan.JobId), formatID(plan.JobId))
+		return
+	}
+	color.Green("plan %s expires at %s, approve it by 'vsctl subscription rebalance approve --plan %s'\n",
+		formatID(plan.Id), time.UnixMilli(plan.ExpiresAt).Format(time.RFC3339), formatID(plan.Id))
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/linkall-labs/vanus/internal/primitive/vanus"
+	segpb "github.com/linkall-labs/vanus/proto/pkg/segment"
+	"github.com/spf13/cobra"
+)
+
+func NewStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store sub-command",
+		Short: "sub-commands for a store, which are sent to the store directly",
+	}
+	cmd.AddCommand(inflightCommand())
+	return cmd
+}
+
+func inflightCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inflight sub-command",
+		Short: "list or abort append and read requests in flight in a store",
+	}
+	cmd.AddCommand(listInflightCommand())
+	cmd.AddCommand(abortInflightCommand())
+	return cmd
+}
+
+func listInflightCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list requests in flight, the oldest first",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &segpb.ListInflightRequestsRequest{BlockId: parseInflightBlock(cmd)}
+			conn := dialStore(cmd, mustGetStoreEndpoint(cmd))
+			defer func() {
+				_ = conn.Close()
+			}()
+			res, err := segpb.NewSegmentServerClient(conn).ListInflightRequests(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "list in-flight requests failed: %s", err)
+			}
+			printInflightRequests(cmd, res.Requests)
+		},
+	}
+	cmd.Flags().StringVar(&storeEndpoint, "store", "", "the endpoint of the store, e.g. 127.0.0.1:11811")
+	cmd.Flags().StringVar(&inflightBlock, "block", "", "only list requests of the block")
+	return cmd
+}
+
+func abortInflightCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "abort",
+		Short: "abort requests in flight, e.g. which are stuck on a block",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &segpb.AbortInflightRequestsRequest{
+				BlockId:  parseInflightBlock(cmd),
+				MinAgeMs: inflightMinAge.Milliseconds(),
+			}
+			conn := dialStore(cmd, mustGetStoreEndpoint(cmd))
+			defer func() {
+				_ = conn.Close()
+			}()
+			res, err := segpb.NewSegmentServerClient(conn).AbortInflightRequests(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "abort in-flight requests failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, res)
+				return
+			}
+			color.Green("abort %d in-flight requests success\n", res.Aborted)
+		},
+	}
+	cmd.Flags().StringVar(&storeEndpoint, "store", "", "the endpoint of the store, e.g. 127.0.0.1:11811")
+	cmd.Flags().StringVar(&inflightBlock, "block", "", "only abort requests of the block, "+
+		"requests of all blocks are aborted if it's empty")
+	cmd.Flags().DurationVar(&inflightMinAge, "min-age", 0, "only abort requests in flight for at least "+
+		"the duration, e.g. 30s")
+	return cmd
+}
+
+func mustGetStoreEndpoint(cmd *cobra.Command) string {
+	if storeEndpoint == "" {
+		cmdFailedf(cmd, "the --store flag MUST be set")
+	}
+	return storeEndpoint
+}
+
+func parseInflightBlock(cmd *cobra.Command) uint64 {
+	if inflightBlock == "" {
+		return 0
+	}
+	id, err := vanus.NewIDFromString(inflightBlock)
+	if err != nil {
+		cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid block id: %s\n", err.Error()))
+	}
+	return id.Uint64()
+}
+
+func printInflightRequests(cmd *cobra.Command, requests []*segpb.InflightRequest) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, requests)
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{"Block", "Type", "Age"})
+	for _, r := range requests {
+		t.AppendRow(table.Row{formatID(r.BlockId), r.Type, time.Duration(r.AgeMs) * time.Millisecond})
+	}
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, t)
+}
@@ -33,7 +33,10 @@ import (
 	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
 	"github.com/linkall-labs/vanus/proto/pkg/meta"
 	metapb "github.com/linkall-labs/vanus/proto/pkg/meta"
+	proxypb "github.com/linkall-labs/vanus/proto/pkg/proxy"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/utils/strings/slices"
 )
 
@@ -52,9 +55,16 @@ func NewSubscriptionCommand() *cobra.Command {
 	cmd.AddCommand(deleteSubscriptionCommand())
 	cmd.AddCommand(disableSubscriptionCommand())
 	cmd.AddCommand(resumeSubscriptionCommand())
+	cmd.AddCommand(resumeEventlogsCommand())
 	cmd.AddCommand(getSubscriptionCommand())
 	cmd.AddCommand(listSubscriptionCommand())
 	cmd.AddCommand(resetOffsetCommand())
+	cmd.AddCommand(annotateSubscriptionCommand())
+	cmd.AddCommand(rotateSigningKeyCommand())
+	cmd.AddCommand(exportOffsetsCommand())
+	cmd.AddCommand(importOffsetsCommand())
+	cmd.AddCommand(namespaceUsageCommand())
+	cmd.AddCommand(rebalanceSubscriptionCommand())
 	return cmd
 }
 
@@ -77,7 +87,7 @@ func createSubscriptionCommand() *cobra.Command {
 			case "aws-lambda":
 				p = meta.Protocol_AWS_LAMBDA
 				if _, err := arn.Parse(sink); err != nil {
-					cmdFailedf(cmd, "protocol is aws-lambda sink is aws arn, arn parse error: %s\n", err.Error())
+					cmdExitf(cmd, ExitCodeUsage, "protocol is aws-lambda sink is aws arn, arn parse error: %s\n", err)
 				}
 				if sinkCredentialType != AWSCredentialType {
 					cmdFailedf(cmd, "protocol is aws-lambda, credential-type must be %s\n", AWSCredentialType)
@@ -101,7 +111,7 @@ func createSubscriptionCommand() *cobra.Command {
 				if sinkCredential[0] == '@' {
 					credentialBytes, err := ioutil.ReadFile(sinkCredential[1:])
 					if err != nil {
-						cmdFailedf(cmd, "read sinkCredential file:%s error:%s\n", sinkCredential, err.Error())
+						cmdFailedf(cmd, "read sinkCredential file:%s error:%s\n", sinkCredential, err)
 					}
 					sinkCredential = string(credentialBytes)
 					fmt.Println(sinkCredential)
@@ -113,7 +123,7 @@ func createSubscriptionCommand() *cobra.Command {
 					var akSK *meta.AKSKCredential
 					err := json.Unmarshal([]byte(sinkCredential), &akSK)
 					if err != nil {
-						cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+						cmdExitf(cmd, ExitCodeUsage, "the sink credential unmarshal json error: %s", err)
 					}
 					if akSK.AccessKeyId == "" || akSK.SecretAccessKey == "" {
 						cmdFailedf(cmd, "credential-type is aws, access_key_id and secret_access_key must not be empty\n")
@@ -128,7 +138,7 @@ func createSubscriptionCommand() *cobra.Command {
 					var m map[string]string
 					err := json.Unmarshal([]byte(sinkCredential), &m)
 					if err != nil {
-						cmdFailedf(cmd, "the sink credential unmarshal json error: %s", err.Error())
+						cmdExitf(cmd, ExitCodeUsage, "the sink credential unmarshal json error: %s", err)
 					}
 					credential = &meta.SinkCredential{
 						CredentialType: meta.SinkCredential_GCLOUD,
@@ -143,6 +153,22 @@ func createSubscriptionCommand() *cobra.Command {
 				}
 			}
 
+			var signing *meta.SigningConfig
+			if len(signingKeys) > 0 {
+				signing = &meta.SigningConfig{}
+				switch signingMethod {
+				case "hmac":
+					signing.Method = meta.SigningConfig_HMAC
+				case "jws":
+					signing.Method = meta.SigningConfig_JWS
+				default:
+					cmdFailedf(cmd, "signing-method is invalid\n")
+				}
+				for id, secret := range signingKeys {
+					signing.Keys = append(signing.Keys, &meta.SigningKey{Id: id, Secret: os.ExpandEnv(secret)})
+				}
+			}
+
 			var filter []*meta.Filter
 			if filters != "" {
 				err := json.Unmarshal([]byte(filters), &filter)
@@ -160,17 +186,49 @@ func createSubscriptionCommand() *cobra.Command {
 				}
 				trans = convert.ToPbTransformer(_transformer)
 			}
+			if wasmModule != "" {
+				module, err := os.ReadFile(wasmModule)
+				if err != nil {
+					cmdFailedf(cmd, "read wasm module file:%s error:%s\n", wasmModule, err)
+				}
+				if trans == nil {
+					trans = &meta.Transformer{}
+				}
+				trans.Wasm = &meta.WasmFunction{
+					Module:        module,
+					MemoryLimitMb: wasmMemoryLimit,
+					TimeoutMs:     wasmTimeout,
+				}
+			}
 
 			// subscription config
 			config := &meta.SubscriptionConfig{
-				RateLimit:       rateLimit,
-				DeliveryTimeout: deliveryTimeout,
-				OrderedEvent:    orderedPushEvent,
+				RateLimit:           rateLimit,
+				DeliveryTimeout:     deliveryTimeout,
+				DeliveryDeadline:    deliveryDeadline,
+				OrderedEvent:        orderedPushEvent,
+				SinkAllowedHosts:    sinkAllowedHosts,
+				PassThrough:         passThrough,
+				BackfillRateLimit:   backfillRateLimit,
+				DeliveryContentType: deliveryContentType,
 			}
 			if maxRetryAttempts >= 0 {
 				value := uint32(maxRetryAttempts)
 				config.MaxRetryAttempts = &value
 			}
+			if protoMessage != "" {
+				if protoDescriptorSet == "" {
+					cmdFailedf(cmd, "protobuf-message is set but protobuf-descriptor-set is empty\n")
+				}
+				descriptorSet, err := ioutil.ReadFile(protoDescriptorSet)
+				if err != nil {
+					cmdFailedf(cmd, "read protobuf descriptor set file:%s error:%s\n", protoDescriptorSet, err)
+				}
+				config.ProtobufDecoding = &meta.ProtobufDecoding{
+					DescriptorSet: descriptorSet,
+					MessageName:   protoMessage,
+				}
+			}
 			if from != "" {
 				switch from {
 				case "latest":
@@ -201,6 +259,9 @@ func createSubscriptionCommand() *cobra.Command {
 					Name:           subscriptionName,
 					Description:    description,
 					Disable:        disableSubscription,
+					NodeSelector:   nodeSelector,
+					Signing:        signing,
+					Namespace:      subNamespace,
 				},
 			})
 			if err != nil {
@@ -210,22 +271,52 @@ func createSubscriptionCommand() *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVar(&eventbus, "eventbus", "", "eventbus name to consuming")
-	cmd.Flags().StringVar(&sink, "sink", "", "the event you want to send to")
+	cmd.Flags().StringVar(&sink, "sink", "", "the event you want to send to, it can be a template "+
+		"rendered from the event, e.g. https://{data.region}.example.com/hook")
+	cmd.Flags().StringSliceVar(&sinkAllowedHosts, "sink-allowed-hosts", nil, "hosts which the sink "+
+		"template can be rendered to, e.g. *.example.com, required if the sink is a template")
 	cmd.Flags().StringVar(&filters, "filters", "", "filter event you interested, JSON format required")
 	cmd.Flags().StringVar(&transformer, "transformer", "", "transformer, JSON format required")
+	cmd.Flags().StringVar(&wasmModule, "transformer-wasm", "", "the file of a WebAssembly module which "+
+		"transforms or filters events after the transformer, it exports alloc and transform")
+	cmd.Flags().Uint32Var(&wasmMemoryLimit, "transformer-wasm-memory-limit", 0, "the memory limit of the "+
+		"WebAssembly module in MB, default is 0, means 16MB")
+	cmd.Flags().Uint32Var(&wasmTimeout, "transformer-wasm-timeout", 0, "the time limit of the WebAssembly "+
+		"module for an event in milliseconds, default is 0, means 100ms")
 	cmd.Flags().Uint32Var(&rateLimit, "rate-limit", 0, "max event number pushing to sink per second, default is 0, means unlimited")
+	cmd.Flags().Uint32Var(&backfillRateLimit, "backfill-rate-limit", 0, "max event number pushing to sink per "+
+		"second until the subscription catches up with the eventbus, then --rate-limit applies, default is 0, "+
+		"means no backfilling")
 	cmd.Flags().StringVar(&from, "from", "", "consume events from, latest,earliest or RFC3339 format time")
 	cmd.Flags().StringVar(&subProtocol, "protocol", "http", "protocol,http or aws-lambda or gcloud-functions or grpc")
 	cmd.Flags().StringVar(&sinkCredentialType, "credential-type", "", "sink credential type: aws or gcloud")
 	cmd.Flags().StringVar(&sinkCredential, "credential", "", "sink credential info, JSON format or @file")
 	cmd.Flags().Uint32Var(&deliveryTimeout, "delivery-timeout", 0, "event delivery to sink timeout by millisecond, default is 0, means using server-side default value: 5s")
+	cmd.Flags().Uint32Var(&deliveryDeadline, "delivery-deadline", 0, "total time by millisecond failed events "+
+		"are retried for before they are sent to the dead letter eventbus, default is 0, means unlimited")
 	cmd.Flags().Int32Var(&maxRetryAttempts, "max-retry-attempts", -1, "event delivery fail max retry attempts, default is -1, means using server-side max retry attempts: 32")
 	cmd.Flags().StringVar(&subscriptionName, "name", "", "subscription name")
 	cmd.Flags().StringVar(&description, "description", "", "subscription description")
+	cmd.Flags().StringVar(&subNamespace, "namespace", "", "the namespace whose quotas the subscription "+
+		"is counted against, default is used if it's empty")
 	cmd.Flags().BoolVar(&disableSubscription, "disable", false, "whether disable the "+
 		"subscription (just create if disable=true)")
 	cmd.Flags().BoolVar(&orderedPushEvent, "ordered-event", false, "whether push the "+
 		"event with ordered")
+	cmd.Flags().BoolVar(&passThrough, "pass-through", false, "deliver events without parsing or changing "+
+		"the data, for non-JSON payloads like protobuf or images, filters and transformer can't use the data")
+	cmd.Flags().StringVar(&protoDescriptorSet, "protobuf-descriptor-set", "", "the file of a protobuf "+
+		"FileDescriptorSet, e.g. generated by protoc --include_imports --descriptor_set_out")
+	cmd.Flags().StringVar(&protoMessage, "protobuf-message", "", "the full name of the message in "+
+		"--protobuf-descriptor-set, protobuf payloads are decoded to JSON before filtering if it's set")
+	cmd.Flags().StringVar(&deliveryContentType, "delivery-content-type", "", "the datacontenttype events are "+
+		"delivered in, application/json or application/protobuf, the data is converted with --protobuf-message")
+	cmd.Flags().StringVar(&signingMethod, "signing-method", "hmac", "how events delivered over http are "+
+		"signed: hmac or jws")
+	cmd.Flags().StringToStringVar(&signingKeys, "signing-key", nil, "keys signing delivered events, e.g. "+
+		"--signing-key key1=$SECRET, events are signed with all keys so that keys can be rotated")
+	cmd.Flags().StringToStringVar(&nodeSelector, "node-selector", nil, "only run the subscription on "+
+		"trigger workers with these labels, e.g. region=eu")
 	return cmd
 }
 
@@ -238,17 +329,20 @@ func deleteSubscriptionCommand() *cobra.Command {
 			if err != nil {
 				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
 			}
+			if !reportDeletionImpact(cmd, &proxypb.GetDeletionImpactRequest{SubscriptionId: id.Uint64()}) {
+				return
+			}
 
 			_, err = client.DeleteSubscription(context.Background(), &ctrlpb.DeleteSubscriptionRequest{
-				Id: id.Uint64(),
+				Id:    id.Uint64(),
+				Force: force,
 			})
 			if err != nil {
 				cmdFailedf(cmd, "delete subscription failed: %s", err)
 			}
 
 			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"subscription_id": subscriptionIDStr})
-				color.Green(string(data))
+				PrintData(cmd, map[string]interface{}{"subscription_id": subscriptionIDStr})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"subscription_id"})
@@ -256,13 +350,14 @@ func deleteSubscriptionCommand() *cobra.Command {
 				t.SetColumnConfigs([]table.ColumnConfig{
 					{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
+				color.Green("delete subscription: %s success\n", subscriptionIDStr)
 			}
-			color.Green("delete subscription: %d success\n", subscriptionIDStr)
 		},
 	}
 	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id to deleting")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only report events not consumed by the subscription")
+	cmd.Flags().BoolVar(&force, "force", false, "delete even if the subscription hasn't consumed all events")
 	return cmd
 }
 
@@ -284,8 +379,7 @@ func resumeSubscriptionCommand() *cobra.Command {
 			}
 
 			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"subscription_id": subscriptionIDStr})
-				color.Green(string(data))
+				PrintData(cmd, map[string]interface{}{"subscription_id": subscriptionIDStr})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"subscription_id"})
@@ -293,16 +387,57 @@ func resumeSubscriptionCommand() *cobra.Command {
 				t.SetColumnConfigs([]table.ColumnConfig{
 					{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
+				color.Green("resume subscription: %s success\n", subscriptionIDStr)
 			}
-			color.Green("resume subscription: %d success\n", subscriptionIDStr)
 		},
 	}
 	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id to resume")
 	return cmd
 }
 
+func resumeEventlogsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-eventlogs",
+		Short: "resume eventlogs of a subscription paused by sequence gaps",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+
+			res, err := client.ResumeEventlogs(context.Background(), &ctrlpb.ResumeEventlogsRequest{
+				SubscriptionId: id.Uint64(),
+			})
+			if err != nil {
+				cmdFailedf(cmd, "resume eventlogs failed: %s", err)
+			}
+			eventlogs := make([]string, len(res.EventlogIds))
+			for i, eventlogID := range res.EventlogIds {
+				eventlogs[i] = vanus.NewIDFromUint64(eventlogID).String()
+			}
+
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, map[string]interface{}{"subscription_id": subscriptionIDStr, "eventlogs": eventlogs})
+			} else {
+				t := table.NewWriter()
+				t.AppendHeader(table.Row{"subscription_id", "eventlog_id"})
+				for _, eventlog := range eventlogs {
+					t.AppendRow(table.Row{subscriptionIDStr, eventlog})
+				}
+				t.SetColumnConfigs([]table.ColumnConfig{
+					{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+					{Number: 2, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				})
+				RenderTable(cmd, t)
+				color.Green("resume %d eventlogs of subscription: %s success\n", len(eventlogs), subscriptionIDStr)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id to resume eventlogs of")
+	return cmd
+}
+
 func disableSubscriptionCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "disable",
@@ -321,8 +456,7 @@ func disableSubscriptionCommand() *cobra.Command {
 			}
 
 			if IsFormatJSON(cmd) {
-				data, _ := json.Marshal(map[string]interface{}{"subscription_id": subscriptionIDStr})
-				color.Green(string(data))
+				PrintData(cmd, map[string]interface{}{"subscription_id": subscriptionIDStr})
 			} else {
 				t := table.NewWriter()
 				t.AppendHeader(table.Row{"subscription_id"})
@@ -330,10 +464,9 @@ func disableSubscriptionCommand() *cobra.Command {
 				t.SetColumnConfigs([]table.ColumnConfig{
 					{Number: 1, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				RenderTable(cmd, t)
+				color.Green("disable subscription: %s success\n", subscriptionIDStr)
 			}
-			color.Green("disable subscription: %d success\n", subscriptionIDStr)
 		},
 	}
 	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id to disable")
@@ -359,21 +492,20 @@ func resetOffsetCommand() *cobra.Command {
 			if err != nil {
 				cmdFailedf(cmd, "reset offset subscription failed: %s", err)
 			}
-			data, _ := json.MarshalIndent(res.Offsets, "", "  ")
 			if IsFormatJSON(cmd) {
-				color.Green(string(data))
-			} else {
-				t := table.NewWriter()
-				t.AppendHeader(table.Row{"subscription_id", "filters"})
-				t.AppendSeparator()
-				t.AppendRow(table.Row{subscriptionIDStr, string(data)})
-				t.SetColumnConfigs([]table.ColumnConfig{
-					{Number: 1, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
-					{Number: 2, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
-				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				PrintData(cmd, res.Offsets)
+				return
 			}
+			data, _ := json.MarshalIndent(res.Offsets, "", "  ")
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"subscription_id", "filters"})
+			t.AppendSeparator()
+			t.AppendRow(table.Row{subscriptionIDStr, string(data)})
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
+				{Number: 2, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
+			})
+			RenderTable(cmd, t)
 			color.Green("reset offset by subscription: %s success\n", subscriptionIDStr)
 		},
 	}
@@ -382,6 +514,203 @@ func resetOffsetCommand() *cobra.Command {
 	return cmd
 }
 
+func annotateSubscriptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "annotate",
+		Short: "add, update or remove annotations of a subscription",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+			if len(annotations) == 0 && len(removedAnnotations) == 0 {
+				cmdFailedf(cmd, "at least one of --annotation and --remove MUST be set")
+			}
+			res, err := client.AnnotateSubscription(context.Background(), &ctrlpb.AnnotateSubscriptionRequest{
+				Id:          id.Uint64(),
+				Annotations: annotations,
+				RemovedKeys: removedAnnotations,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "annotate subscription failed: %s", err)
+			}
+			printAnnotations(cmd, "subscription_id", subscriptionIDStr, res.Annotations)
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id to annotate")
+	cmd.Flags().StringToStringVar(&annotations, "annotation", nil, "annotations to add or update, "+
+		"e.g. --annotation reason=disabled-due-to-incident-123")
+	cmd.Flags().StringSliceVar(&removedAnnotations, "remove", nil, "keys of annotations to remove")
+	return cmd
+}
+
+func rotateSigningKeyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-signing-key",
+		Short: "add a new signing key or retire an old one of a running subscription",
+		Long: "events are signed with all keys, so a key is rotated by adding the new key, switching the sink " +
+			"to verify with it, and then retiring the old key",
+		Run: func(cmd *cobra.Command, args []string) {
+			id, err := vanus.NewIDFromString(subscriptionIDStr)
+			if err != nil {
+				cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+			}
+			if len(signingKeys) > 1 {
+				cmdFailedf(cmd, "only one key can be added at a time")
+			}
+			req := &ctrlpb.RotateSigningKeyRequest{Id: id.Uint64(), RetiredKeyId: retiredSigningKey}
+			for keyID, secret := range signingKeys {
+				req.NewKey = &meta.SigningKey{Id: keyID, Secret: os.ExpandEnv(secret)}
+			}
+			if req.NewKey == nil && req.RetiredKeyId == "" {
+				cmdFailedf(cmd, "at least one of --signing-key and --retire MUST be set")
+			}
+			res, err := client.RotateSigningKey(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "rotate signing key failed: %s", err)
+			}
+			printSubscription(cmd, false, false, false, res)
+		},
+	}
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "subscription id to rotate the signing key of")
+	cmd.Flags().StringToStringVar(&signingKeys, "signing-key", nil, "the key to add, e.g. "+
+		"--signing-key key2=$SECRET")
+	cmd.Flags().StringVar(&retiredSigningKey, "retire", "", "id of the key to retire")
+	return cmd
+}
+
+func exportOffsetsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-offsets",
+		Short: "export committed offsets of subscriptions, which can be imported after a restore or into another cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &ctrlpb.ExportOffsetsRequest{Eventbus: eventbus}
+			if subscriptionIDStr != "" {
+				id, err := vanus.NewIDFromString(subscriptionIDStr)
+				if err != nil {
+					cmdFailedWithHelpNotice(cmd, fmt.Sprintf("invalid subscription id: %s\n", err.Error()))
+				}
+				req.SubscriptionIds = []uint64{id.Uint64()}
+			}
+			res, err := client.ExportOffsets(context.Background(), req)
+			if err != nil {
+				cmdFailedf(cmd, "export offsets failed: %s", err)
+			}
+			data, _ := json.MarshalIndent(res, "", "  ")
+			if offsetsFile == "" {
+				color.Green(string(data))
+				return
+			}
+			if err = os.WriteFile(offsetsFile, data, 0o600); err != nil {
+				cmdFailedf(cmd, "write offsets file failed: %s", err)
+			}
+			color.Green("export offsets of %d subscriptions to %s success\n", len(res.Subscriptions), offsetsFile)
+		},
+	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "only export subscriptions of the eventbus")
+	cmd.Flags().StringVar(&subscriptionIDStr, "id", "", "only export the subscription")
+	cmd.Flags().StringVar(&offsetsFile, "file", "", "file to write offsets to, print them if it's empty")
+	return cmd
+}
+
+func namespaceUsageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace-usage",
+		Short: "show resources taken by subscriptions of namespaces and their quotas",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListNamespaceUsage(context.Background(), &ctrlpb.ListNamespaceUsageRequest{
+				Namespace: subNamespace,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list namespace usage failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, res.Usages)
+				return
+			}
+			// quotas of 0 are unlimited.
+			usageOf := func(used, quota uint64) string {
+				if quota == 0 {
+					return fmt.Sprintf("%d", used)
+				}
+				return fmt.Sprintf("%d/%d", used, quota)
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"namespace", "subscriptions", "delivery_rate", "transformer_actions"})
+			for _, u := range res.Usages {
+				t.AppendRow(table.Row{
+					u.Namespace,
+					usageOf(uint64(u.Subscriptions), uint64(u.Quota.GetMaxSubscriptions())),
+					usageOf(u.DeliveryRate, u.Quota.GetMaxDeliveryRate()),
+					usageOf(uint64(u.TransformerActions), uint64(u.Quota.GetMaxTransformerActions())),
+				})
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 4, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+			})
+			RenderTable(cmd, t)
+		},
+	}
+	cmd.Flags().StringVar(&subNamespace, "namespace", "", "only show the namespace")
+	return cmd
+}
+
+func importOffsetsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-offsets",
+		Short: "import offsets exported by export-offsets, subscriptions must be disabled",
+		Run: func(cmd *cobra.Command, args []string) {
+			if offsetsFile == "" {
+				cmdFailedf(cmd, "the --file flag MUST be set")
+			}
+			data, err := os.ReadFile(offsetsFile)
+			if err != nil {
+				cmdFailedf(cmd, "read offsets file failed: %s", err)
+			}
+			exported := new(ctrlpb.ExportOffsetsResponse)
+			if err = json.Unmarshal(data, exported); err != nil {
+				cmdFailedf(cmd, "the offsets file is invalid: %s", err)
+			}
+			res, err := client.ImportOffsets(context.Background(), &ctrlpb.ImportOffsetsRequest{
+				Subscriptions: exported.Subscriptions,
+				ByTimestamp:   byTimestamp,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "import offsets failed: %s", err)
+			}
+			if IsFormatJSON(cmd) {
+				PrintData(cmd, res.Results)
+				return
+			}
+			t := table.NewWriter()
+			t.AppendHeader(table.Row{"source_subscription_id", "subscription_id", "offsets", "error"})
+			for _, r := range res.Results {
+				offsets, _ := json.MarshalIndent(r.Offsets, "", "  ")
+				target := ""
+				if r.SubscriptionId != 0 {
+					target = formatID(r.SubscriptionId)
+				}
+				t.AppendRow(table.Row{formatID(r.SourceSubscriptionId), target, string(offsets), r.Error})
+				t.AppendSeparator()
+			}
+			t.SetColumnConfigs([]table.ColumnConfig{
+				{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+				{Number: 3, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
+				{Number: 4, VAlign: text.VAlignMiddle, AlignHeader: text.AlignCenter},
+			})
+			RenderTable(cmd, t)
+		},
+	}
+	cmd.Flags().StringVar(&offsetsFile, "file", "", "file of offsets exported by export-offsets")
+	cmd.Flags().BoolVar(&byTimestamp, "by-timestamp", false, "re-map offsets by the time of events, "+
+		"it's required if eventlogs differ, e.g. importing into another cluster")
+	return cmd
+}
+
 func getSubscriptionCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "info",
@@ -410,20 +739,74 @@ func listSubscriptionCommand() *cobra.Command {
 		Use:   "list",
 		Short: "list the subscription ",
 		Run: func(cmd *cobra.Command, args []string) {
-			res, err := client.ListSubscription(context.Background(), &empty.Empty{})
+			subs, err := listSubscriptions(context.Background(), eventbus)
 			if err != nil {
 				cmdFailedf(cmd, "list subscription failed: %s", err)
 			}
-			printSubscription(cmd, true, false, false, res.Subscription...)
+			wide := isWideOutput(cmd)
+			printSubscription(cmd, true, wide, wide, subs...)
 		},
 	}
+	cmd.Flags().StringVar(&eventbus, "eventbus", "", "only list subscriptions of the eventbus")
 	return cmd
 }
 
+const maxStreamResumes = 3
+
+// listSubscriptions receives subscriptions in batches, the list is resumed from the last bookmark if the
+// stream is broken. ListSubscription is used if the gateway doesn't support streaming.
+func listSubscriptions(ctx context.Context, eventbus string) ([]*metapb.Subscription, error) {
+	var subs []*metapb.Subscription
+	req := &ctrlpb.StreamListRequest{Eventbus: eventbus}
+	for resumes := 0; ; resumes++ {
+		err := receiveSubscriptions(ctx, req, func(resp *ctrlpb.StreamSubscriptionsResponse) {
+			subs = append(subs, resp.Subscriptions...)
+			req.Bookmark = resp.Bookmark
+		})
+		if err == nil {
+			return subs, nil
+		}
+		if status.Code(err) == codes.Unimplemented && req.Bookmark == "" {
+			res, err := client.ListSubscription(ctx, &empty.Empty{})
+			if err != nil {
+				return nil, err
+			}
+			for _, sub := range res.Subscription {
+				if eventbus == "" || sub.EventBus == eventbus {
+					subs = append(subs, sub)
+				}
+			}
+			return subs, nil
+		}
+		if resumes >= maxStreamResumes {
+			return nil, err
+		}
+	}
+}
+
+func receiveSubscriptions(ctx context.Context, req *ctrlpb.StreamListRequest,
+	fn func(resp *ctrlpb.StreamSubscriptionsResponse)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.StreamSubscriptions(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		fn(resp)
+		if resp.End {
+			return nil
+		}
+	}
+}
+
 func printSubscription(cmd *cobra.Command, showNo, showFilters, showTransformer bool, data ...*metapb.Subscription) {
 	if IsFormatJSON(cmd) {
-		data, _ := json.Marshal(data)
-		color.Green(string(data))
+		PrintData(cmd, data)
 	} else {
 		t := table.NewWriter()
 		header := getSubscriptionHeader(showNo)
@@ -454,13 +837,12 @@ func printSubscription(cmd *cobra.Command, showNo, showFilters, showTransformer
 			t.AppendSeparator()
 		}
 		t.SetColumnConfigs(getSubscriptionColumnConfig(header))
-		t.SetOutputMirror(os.Stdout)
-		t.Render()
+		RenderTable(cmd, t)
 	}
 }
 
 var subscriptionHeaders = []interface{}{"id", "name", "disable", "eventbus", "sink", "description", "protocol", "sinkCredential",
-	"config", "offsets", "filter", "transformer", "created_at", "updated_at"}
+	"config", "offsets", "annotations", "owner", "namespace", "filter", "transformer", "created_at", "updated_at"}
 
 func getSubscriptionHeader(showNo bool) table.Row {
 	var result []interface{}
@@ -501,6 +883,9 @@ func getSubscriptionRow(sub *meta.Subscription) []interface{} {
 
 	offsets, _ := json.MarshalIndent(sub.Offsets, "", "  ")
 	result = append(result, string(offsets))
+	result = append(result, formatAnnotations(sub.Annotations))
+	result = append(result, sub.Owner)
+	result = append(result, sub.Namespace)
 	return result
 }
 
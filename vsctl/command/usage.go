@@ -0,0 +1,108 @@
+// Copyright 2022 Linkall Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	ctrlpb "github.com/linkall-labs/vanus/proto/pkg/controller"
+	"github.com/spf13/cobra"
+)
+
+func NewUsageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage sub-command",
+		Short: "sub-commands for usages of eventbuses and subscriptions",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			InitGatewayClient(cmd)
+		},
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			DestroyGatewayClient()
+		},
+	}
+	cmd.AddCommand(topUsageCommand())
+	return cmd
+}
+
+func topUsageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top",
+		Short: "list eventbuses or subscriptions which take the most of a metric over a window",
+		Run: func(cmd *cobra.Command, args []string) {
+			res, err := client.ListTopUsage(context.Background(), &ctrlpb.ListTopUsageRequest{
+				Kind:          usageKind,
+				Metric:        usageMetric,
+				WindowSeconds: uint32(usageWindow / time.Second),
+				Limit:         usageLimit,
+			})
+			if err != nil {
+				cmdFailedf(cmd, "list top usage failed: %s", err)
+			}
+			printTopUsage(cmd, res.Entries)
+		},
+	}
+	cmd.Flags().StringVar(&usageKind, "kind", "eventbus", "eventbus or subscription")
+	cmd.Flags().StringVar(&usageMetric, "metric", "throughput", "throughput or storage of eventbuses, "+
+		"throughput, failure_rate or lag of subscriptions")
+	cmd.Flags().DurationVar(&usageWindow, "window", time.Hour, "the window which rates are computed over "+
+		"and gauges peak in, e.g. 10m, 1h or 24h")
+	cmd.Flags().Uint32Var(&usageLimit, "limit", 10, "the number of resources listed")
+	return cmd
+}
+
+func printTopUsage(cmd *cobra.Command, entries []*ctrlpb.UsageEntry) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, entries)
+		return
+	}
+	t := table.NewWriter()
+	header := table.Row{"Rank", "Eventbus", "Value"}
+	if usageKind == "subscription" {
+		header = table.Row{"Rank", "Subscription", "Eventbus", "Value"}
+	}
+	t.AppendHeader(header)
+	for idx, e := range entries {
+		if usageKind == "subscription" {
+			t.AppendRow(table.Row{idx + 1, e.Resource, e.Eventbus, formatUsage(e.Value)})
+		} else {
+			t.AppendRow(table.Row{idx + 1, e.Resource, formatUsage(e.Value)})
+		}
+		t.AppendSeparator()
+	}
+	cfgs := make([]table.ColumnConfig, 0, len(header))
+	for idx := 1; idx <= len(header); idx++ {
+		cfgs = append(cfgs, table.ColumnConfig{
+			Number: idx, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter,
+		})
+	}
+	t.SetColumnConfigs(cfgs)
+	RenderTable(cmd, t)
+}
+
+func formatUsage(v float64) string {
+	switch usageMetric {
+	case "throughput":
+		return fmt.Sprintf("%.2f events/s", v)
+	case "storage":
+		return formatBytes(uint64(v))
+	case "failure_rate":
+		return fmt.Sprintf("%.2f%%", v*100)
+	}
+	return fmt.Sprintf("%.0f", v)
+}
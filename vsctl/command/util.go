@@ -18,6 +18,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -25,15 +27,21 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// cmdFailedf prints the error and exits, the exit code is derived from the error in a, see exitCodeOf.
 func cmdFailedf(cmd *cobra.Command, format string, a ...interface{}) {
+	cmdExitf(cmd, exitCodeOf(a), format, a...)
+}
+
+// cmdExitf prints the error to stderr and exits with code.
+func cmdExitf(cmd *cobra.Command, code int, format string, a ...interface{}) {
 	errStr := format
 	if a != nil {
 		errStr = fmt.Sprintf(format, a...)
 	}
 	if IsFormatJSON(cmd) {
-		m := map[string]string{"ERROR": errStr}
+		m := map[string]interface{}{"ERROR": errStr, "EXIT_CODE": code}
 		data, _ := json.Marshal(m)
-		color.Red(string(data))
+		_, _ = color.New(color.FgRed).Fprintln(os.Stderr, string(data))
 	} else {
 		t := table.NewWriter()
 		t.AppendHeader(table.Row{"ERROR"})
@@ -42,16 +50,41 @@ func cmdFailedf(cmd *cobra.Command, format string, a ...interface{}) {
 			{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 			{Number: 2, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
 		})
-		t.SetOutputMirror(os.Stdout)
+		t.SetOutputMirror(os.Stderr)
 		t.Render()
 	}
 
-	os.Exit(-1)
+	os.Exit(code)
 }
 
 func cmdFailedWithHelpNotice(cmd *cobra.Command, format string) {
 	color.White(format)
 	color.Cyan("\n============ see below for right usage ============\n\n")
 	_ = cmd.Help()
-	os.Exit(-1)
+	os.Exit(ExitCodeUsage)
+}
+
+// formatAnnotations renders annotations as sorted key=value lines.
+func formatAnnotations(annotations map[string]string) string {
+	lines := make([]string, 0, len(annotations))
+	for k, v := range annotations {
+		lines = append(lines, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
+
+func printAnnotations(cmd *cobra.Command, header, resource string, annotations map[string]string) {
+	if IsFormatJSON(cmd) {
+		PrintData(cmd, map[string]interface{}{header: resource, "annotations": annotations})
+		return
+	}
+	t := table.NewWriter()
+	t.AppendHeader(table.Row{header, "annotations"})
+	t.AppendRow(table.Row{resource, formatAnnotations(annotations)})
+	t.SetColumnConfigs([]table.ColumnConfig{
+		{Number: 1, VAlign: text.VAlignMiddle, Align: text.AlignCenter, AlignHeader: text.AlignCenter},
+		{Number: 2, AlignHeader: text.AlignCenter},
+	})
+	RenderTable(cmd, t)
 }
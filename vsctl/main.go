@@ -46,18 +46,35 @@ func init() {
 		"~/.vanus/vanus.yml", "the config file of vsctl")
 	rootCmd.PersistentFlags().BoolVarP(&globalFlags.Debug, "debug", "D", false,
 		"is debug mode enable")
-	rootCmd.PersistentFlags().StringVar(&globalFlags.Format, "format", "table",
+	rootCmd.PersistentFlags().StringVar(&globalFlags.Format, "format", "",
 		"the output format of vsctl, json or table")
+	_ = rootCmd.PersistentFlags().MarkDeprecated("format", "use --output instead")
+	rootCmd.PersistentFlags().StringVarP(&globalFlags.Output, "output", "o", "",
+		"the output format of vsctl, one of table, wide, json, yaml and jsonpath=<expression>, "+
+			"e.g. -o jsonpath='{.name}', table by default")
+	rootCmd.PersistentFlags().BoolVar(&globalFlags.NoHeaders, "no-headers", false,
+		"don't print headers of tables")
+	rootCmd.PersistentFlags().StringVar(&globalFlags.Token, "token", "",
+		"the token to authenticate to vanus gateway")
 
 	if os.Getenv("VANUS_GATEWAY") != "" {
 		globalFlags.Endpoint = os.Getenv("VANUS_GATEWAY")
 	}
+	if os.Getenv("VANUS_TOKEN") != "" {
+		globalFlags.Token = os.Getenv("VANUS_TOKEN")
+	}
 
 	rootCmd.AddCommand(
 		command.NewEventCommand(),
 		command.NewEventbusCommand(),
 		command.NewSubscriptionCommand(),
 		command.NewClusterCommand(),
+		command.NewJobCommand(),
+		command.NewFeatureGateCommand(),
+		command.NewUsageCommand(),
+		command.NewManifestCommand(),
+		command.NewStoreCommand(),
+		command.NewDoctorCommand(),
 		newVersionCommand(),
 	)
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
@@ -74,6 +91,7 @@ func Start() error {
 func MustStart() {
 	if err := Start(); err != nil {
 		color.Red("vsctl run error: %s", err)
-		os.Exit(-1)
+		// errors returned by cobra are caused by unknown commands or invalid flags.
+		os.Exit(command.ExitCodeUsage)
 	}
 }
@@ -16,7 +16,6 @@ package main
 
 import (
 	"encoding/json"
-	"os"
 
 	"github.com/fatih/color"
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -49,8 +48,7 @@ func newVersionCommand() *cobra.Command {
 					{Number: 1, Align: text.AlignCenter},
 					{Number: 2, Align: text.AlignLeft},
 				})
-				t.SetOutputMirror(os.Stdout)
-				t.Render()
+				command.RenderTable(cmd, t)
 			} else {
 				info := map[string]string{
 					"Version":   Version,